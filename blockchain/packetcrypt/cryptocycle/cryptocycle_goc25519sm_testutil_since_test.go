@@ -0,0 +1,62 @@
+// Copyright © 2021 Jeffrey H. Johnson. <trnsz@pobox.com>
+// Copyright © 2021 Gridfinity, LLC.
+// Copyright © 2020 The Go Authors.
+//
+// All rights reserved.
+//
+// Use of this source code is governed by the BSD-style
+// license that can be found in the LICENSE file.
+
+// +build leaktest
+
+package cryptocycle_test
+
+import (
+	"testing"
+
+	u "github.com/pkt-cash/pktd/blockchain/packetcrypt/cryptocycle/testutil"
+)
+
+func TestLeakVerifyNoneSinceDetected(
+	t *testing.T,
+) {
+	done := make(
+		chan struct{},
+	)
+	preexisting := make(
+		chan struct{},
+	)
+	go func() {
+		<-preexisting
+	}()
+	defer close(
+		preexisting,
+	)
+
+	b := u.BaselineGoroutines()
+	err := u.LeakVerifyNoneSince(
+		t,
+		b,
+	)
+	if err != nil {
+		t.Fatal(
+			"\ngoc25519sm_testutil_since_test.TestLeakVerifyNoneSinceDetected.LeakVerifyNoneSince FAILURE: pre-existing goroutine reported",
+		)
+	}
+
+	go func() {
+		<-done
+	}()
+	err = u.LeakVerifyNoneSince(
+		t,
+		b,
+	)
+	close(
+		done,
+	)
+	if err == nil {
+		t.Fatal(
+			"\ngoc25519sm_testutil_since_test.TestLeakVerifyNoneSinceDetected.LeakVerifyNoneSince FAILURE: new goroutine not reported",
+		)
+	}
+}
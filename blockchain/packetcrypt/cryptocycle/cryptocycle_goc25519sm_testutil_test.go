@@ -10,10 +10,14 @@
 package cryptocycle_test
 
 import (
+	"encoding/binary"
+	"errors"
 	"fmt"
 	"testing"
 
+	"github.com/pkt-cash/pktd/blockchain/packetcrypt/cryptocycle"
 	u "github.com/pkt-cash/pktd/blockchain/packetcrypt/cryptocycle/testutil"
+	"github.com/pkt-cash/pktd/blockchain/packetcrypt/pcutil"
 )
 
 func TestLeakVerifyNoneDisabled(
@@ -47,3 +51,129 @@ func TestLeakVerifyNoneEnabled(
 		)
 	}
 }
+
+func TestLeakVerifyNoneSince(
+	t *testing.T,
+) {
+	b := u.BaselineGoroutines()
+	err := u.LeakVerifyNoneSince(
+		t,
+		b,
+	)
+	if err != nil {
+		t.Fatal(
+			fmt.Sprintf(
+				"\ngoc25519sm_testutil_test.TestLeakVerifyNoneSince.LeakVerifyNoneSince FAILURE:\n	%v",
+				err,
+			),
+		)
+	}
+}
+
+func TestStressConcurrent(
+	t *testing.T,
+) {
+	const (
+		goroutines = 16
+		iterations = 64
+	)
+	// The expected states are computed up front, one at a time.
+	var (
+		seed   [32]byte
+		states [iterations]cryptocycle.State
+		want   [iterations]cryptocycle.State
+	)
+	for i := range states {
+		binary.LittleEndian.PutUint32(
+			seed[:],
+			uint32(i),
+		)
+		cryptocycle.Init(
+			&states[i],
+			seed[:],
+			uint64(i),
+		)
+		pcutil.HashExpand(
+			states[i].Bytes[32:],
+			seed[:],
+			1,
+		)
+		states[i].MakeFuzzable()
+		want[i] = states[i]
+		cryptocycle.CryptoCycle(
+			&want[i],
+		)
+	}
+	err := u.StressConcurrent(
+		t,
+		goroutines,
+		iterations,
+		func(
+			_ int,
+			i int,
+		) error {
+			s := states[i]
+			cryptocycle.CryptoCycle(
+				&s,
+			)
+			if s != want[i] {
+				return fmt.Errorf(
+					"state %d differs",
+					i,
+				)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		t.Fatal(
+			fmt.Sprintf(
+				"\ngoc25519sm_testutil_test.TestStressConcurrent.StressConcurrent FAILURE:\n	%v",
+				err,
+			),
+		)
+	}
+}
+
+func TestStressConcurrentFailure(
+	t *testing.T,
+) {
+	errWrong := errors.New(
+		"wrong result",
+	)
+	err := u.StressConcurrent(
+		t,
+		4,
+		8,
+		func(
+			g int,
+			i int,
+		) error {
+			if g == 2 && i == 5 {
+				return errWrong
+			}
+			return nil
+		},
+	)
+	if err == nil {
+		t.Fatal(
+			"\ngoc25519sm_testutil_test.TestStressConcurrentFailure.StressConcurrent FAILURE:\n	expected an error",
+		)
+	}
+	err = u.StressConcurrent(
+		t,
+		0,
+		8,
+		func(
+			_ int,
+			_ int,
+		) error {
+			return nil
+		},
+	)
+	if err == nil {
+		t.Fatal(
+			"\ngoc25519sm_testutil_test.TestStressConcurrentFailure.StressConcurrent FAILURE:\n	expected an error for zero goroutines",
+		)
+	}
+}
@@ -0,0 +1,196 @@
+// Copyright © 2021 Jeffrey H. Johnson. <trnsz@pobox.com>
+//
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package cryptocycle
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+
+	goc25519sm "github.com/johnsonjh/goc25519sm"
+)
+
+// cycleVector is a known answer test of a single CryptoCycle.  The content is
+// padded with zeros to a multiple of 16 bytes, and so is the additional data,
+// which makes the cycle equivalent to the ChaCha20-Poly1305 AEAD of RFC 8439.
+type cycleVector struct {
+	name    string
+	key     string
+	nonce   string
+	aad     string
+	in      string
+	out     string
+	tag     string
+	decrypt bool
+}
+
+// smulVector is a known answer test of the scalar multiplication used by
+// Smul.
+type smulVector struct {
+	scalar string
+	point  string
+	expect string
+}
+
+const (
+	rfc8439Key       = "808182838485868788898a8b8c8d8e8f909192939495969798999a9b9c9d9e9f"
+	rfc8439Nonce     = "070000004041424344454647"
+	rfc8439AAD       = "50515253c0c1c2c3c4c5c6c7"
+	rfc8439Plaintext = "4c616469657320616e642047656e746c656d656e206f662074686520636c6173" +
+		"73206f66202739393a204966204920636f756c64206f6666657220796f75206f" +
+		"6e6c79206f6e652074697020666f7220746865206675747572652c2073756e73" +
+		"637265656e20776f756c642062652069742e"
+	rfc8439Ciphertext = "d31a8d34648e60db7b86afbc53ef7ec2a4aded51296e08fea9e2b5a736ee62d6" +
+		"3dbea45e8ca9671282fafb69da92728b1a71de0a9e060b2905d6a5b67ecd3b36" +
+		"92ddbd7f2d778b8c9803aee328091b58fab324e4fad675945585808b4831d7bc" +
+		"3ff4def08e4b7a9de576d26586cec64b6116"
+	rfc8439Tag = "1ae10b594f09e26a7e902ecbd0600691"
+)
+
+// selfTestCycleVectors are the CryptoCycle known answer tests, based on the
+// AEAD test vector of RFC 8439 section 2.8.2.
+var selfTestCycleVectors = []cycleVector{
+	{
+		name:  "rfc8439 encrypt",
+		key:   rfc8439Key,
+		nonce: rfc8439Nonce,
+		aad:   rfc8439AAD,
+		in:    rfc8439Plaintext,
+		out:   rfc8439Ciphertext,
+		tag:   rfc8439Tag,
+	},
+	{
+		name:    "rfc8439 decrypt",
+		key:     rfc8439Key,
+		nonce:   rfc8439Nonce,
+		aad:     rfc8439AAD,
+		in:      rfc8439Ciphertext,
+		out:     rfc8439Plaintext,
+		tag:     rfc8439Tag,
+		decrypt: true,
+	},
+}
+
+// selfTestSmulVectors are the scalar multiplication known answer tests, taken
+// from BoringSSL.
+var selfTestSmulVectors = []smulVector{
+	{
+		scalar: "668fb9f76ad971c81ac900071a1560bce2ca00cac7e67af99348913761434014",
+		point:  "db5f32b7f841e7a1a00968effded12735fc47a3eb13b579aacadeae80939a7dd",
+		expect: "090d85e599ea8e2beeb61304d37be10ec5c905f9927d32f42a9a0afb3e0b4074",
+	},
+}
+
+// SelfTest runs the embedded known answer tests against CryptoCycle and
+// against the scalar multiplication, and returns an error describing the
+// first mismatch.  It is meant to be run at startup to catch miscompilations
+// and faulty hardware before they produce invalid proofs.
+func SelfTest() error {
+	return selfTest(selfTestCycleVectors, selfTestSmulVectors)
+}
+
+func selfTest(cycles []cycleVector, smuls []smulVector) error {
+	for _, v := range cycles {
+		if err := checkCycleVector(&v); err != nil {
+			return fmt.Errorf(
+				"cryptocycle self test %q failed: %v",
+				v.name, err,
+			)
+		}
+	}
+	for i, v := range smuls {
+		if err := checkSmulVector(&v); err != nil {
+			return fmt.Errorf(
+				"cryptocycle scalar mult self test %d failed: %v",
+				i, err,
+			)
+		}
+	}
+	return nil
+}
+
+func checkCycleVector(v *cycleVector) error {
+	key, err := hex.DecodeString(v.key)
+	if err != nil {
+		return err
+	}
+	nonce, err := hex.DecodeString(v.nonce)
+	if err != nil {
+		return err
+	}
+	aad, err := hex.DecodeString(v.aad)
+	if err != nil {
+		return err
+	}
+	in, err := hex.DecodeString(v.in)
+	if err != nil {
+		return err
+	}
+	out, err := hex.DecodeString(v.out)
+	if err != nil {
+		return err
+	}
+	tag, err := hex.DecodeString(v.tag)
+	if err != nil {
+		return err
+	}
+	aadLen := (len(aad) + 15) / 16
+	msgLen := (len(in) + 15) / 16
+	if len(key) != 32 || len(nonce) != 12 || aadLen > 7 ||
+		len(in) != len(out) || msgLen > 125-aadLen {
+
+		return fmt.Errorf("malformed vector")
+	}
+
+	var s State
+	copy(s.Nonce(), nonce)
+	copy(s.Key(), key)
+	s.SetVersion(0)
+	s.SetLength(byte(msgLen))
+	s.SetAddLen(byte(aadLen))
+	s.SetDecrypt(v.decrypt)
+	s.SetTrailingZeros(byte(msgLen*16 - len(in)))
+	s.SetAdditionalZeros(byte(aadLen*16 - len(aad)))
+	copy(s.Bytes[hdrSz:], aad)
+	copy(s.Bytes[hdrSz+aadLen*16:], in)
+
+	CryptoCycle(&s)
+
+	content := s.Bytes[hdrSz+aadLen*16:][:len(out)]
+	if !bytes.Equal(content, out) {
+		return fmt.Errorf("content mismatch: got %x, want %x",
+			content, out)
+	}
+	if !bytes.Equal(s.Poly(), tag) {
+		return fmt.Errorf("tag mismatch: got %x, want %x",
+			s.Poly(), tag)
+	}
+	return nil
+}
+
+func checkSmulVector(v *smulVector) error {
+	var scalar, point, expect, got [goc25519sm.X25519Size]byte
+	for _, x := range []struct {
+		dst *[goc25519sm.X25519Size]byte
+		src string
+	}{{&scalar, v.scalar}, {&point, v.point}, {&expect, v.expect}} {
+		b, err := hex.DecodeString(x.src)
+		if err != nil {
+			return err
+		}
+		if len(b) != goc25519sm.X25519Size {
+			return fmt.Errorf("malformed vector")
+		}
+		copy(x.dst[:], b)
+	}
+	if err := goc25519sm.OldScalarMult(&got, &scalar, &point); err != nil {
+		return err
+	}
+	if got != expect {
+		return fmt.Errorf("got %x, want %x", got, expect)
+	}
+	return nil
+}
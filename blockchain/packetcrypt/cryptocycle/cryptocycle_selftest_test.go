@@ -0,0 +1,34 @@
+// Copyright © 2021 Jeffrey H. Johnson. <trnsz@pobox.com>
+//
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package cryptocycle
+
+import (
+	"strings"
+	"testing"
+)
+
+// TestSelfTest ensures that the embedded known answer tests pass, and that a
+// corrupted vector makes the self test fail.
+func TestSelfTest(t *testing.T) {
+	if err := SelfTest(); err != nil {
+		t.Fatalf("self test failed: %v", err)
+	}
+
+	// Flip a bit of the expected tag.
+	cycles := append([]cycleVector(nil), selfTestCycleVectors...)
+	cycles[1].tag = "0" + cycles[1].tag[1:]
+	err := selfTest(cycles, selfTestSmulVectors)
+	if err == nil || !strings.Contains(err.Error(), "tag mismatch") {
+		t.Fatalf("expected a tag mismatch, got %v", err)
+	}
+
+	// Corrupt the expected scalar multiplication result.
+	smuls := append([]smulVector(nil), selfTestSmulVectors...)
+	smuls[0].expect = strings.Repeat("00", 32)
+	if err := selfTest(selfTestCycleVectors, smuls); err == nil {
+		t.Fatalf("expected the corrupted scalar mult vector to fail")
+	}
+}
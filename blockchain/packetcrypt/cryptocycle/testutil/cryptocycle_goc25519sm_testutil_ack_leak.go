@@ -32,3 +32,41 @@ func leakVerifyNone(
 	)
 	return nil
 }
+
+type goroutineSnapshot struct {
+	ignore goleak.Option
+}
+
+func baselineGoroutines(
+	r bool,
+) (
+	goroutineSnapshot,
+	error,
+) {
+	if r != true {
+		return goroutineSnapshot{}, fmt.Errorf(
+			"testutil.baselineGoroutines: r != true",
+		)
+	}
+	return goroutineSnapshot{
+		ignore: goleak.IgnoreCurrent(),
+	}, nil
+}
+
+func leakVerifyNoneSince(
+	_ *testing.T,
+	s goroutineSnapshot,
+	r bool,
+) error {
+	if r != true {
+		return fmt.Errorf(
+			"testutil.leakVerifyNoneSince: r != true",
+		)
+	}
+	if s.ignore == nil {
+		return goleak.Find()
+	}
+	return goleak.Find(
+		s.ignore,
+	)
+}
@@ -0,0 +1,18 @@
+// Copyright © 2021 Jeffrey H. Johnson. <trnsz@pobox.com>
+// Copyright © 2021 Gridfinity, LLC.
+// Copyright © 2020 The Go Authors.
+//
+// All rights reserved.
+//
+// Use of this source code is governed by the BSD-style
+// license that can be found in the LICENSE file.
+
+package testutil
+
+// GoroutineBaseline -> opaque snapshot of the running goroutines, taken by
+// BaselineGoroutines, so that LeakVerifyNoneSince only reports goroutines
+// created after it (e.g. ignoring pre-existing framework goroutines when the
+// check is embedded deep in a test)
+type GoroutineBaseline struct {
+	snapshot goroutineSnapshot
+}
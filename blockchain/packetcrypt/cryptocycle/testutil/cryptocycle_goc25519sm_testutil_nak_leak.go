@@ -27,3 +27,32 @@ func leakVerifyNone(
 	}
 	return nil
 }
+
+type goroutineSnapshot struct{}
+
+func baselineGoroutines(
+	r bool,
+) (
+	goroutineSnapshot,
+	error,
+) {
+	if r {
+		return goroutineSnapshot{}, fmt.Errorf(
+			"testutil.baselineGoroutines: r != false",
+		)
+	}
+	return goroutineSnapshot{}, nil
+}
+
+func leakVerifyNoneSince(
+	_ *testing.T,
+	_ goroutineSnapshot,
+	r bool,
+) error {
+	if r {
+		return fmt.Errorf(
+			"testutil.leakVerifyNoneSince: r != false",
+		)
+	}
+	return nil
+}
@@ -34,3 +34,42 @@ func LeakVerifyNone(
 	}
 	return nil
 }
+
+// BaselineGoroutines -> disabled (wrapper function)
+func BaselineGoroutines() GoroutineBaseline {
+	s, err := baselineGoroutines(
+		false,
+	)
+	if err != nil {
+		panic(
+			fmt.Sprintf(
+				"	%v",
+				err,
+			),
+		)
+	}
+	return GoroutineBaseline{
+		snapshot: s,
+	}
+}
+
+// LeakVerifyNoneSince -> disabled (wrapper function)
+func LeakVerifyNoneSince(
+	t *testing.T,
+	b GoroutineBaseline,
+) error {
+	err := leakVerifyNoneSince(
+		t,
+		b.snapshot,
+		false,
+	)
+	if err != nil {
+		return fmt.Errorf(
+			fmt.Sprintf(
+				"	%v",
+				err,
+			),
+		)
+	}
+	return nil
+}
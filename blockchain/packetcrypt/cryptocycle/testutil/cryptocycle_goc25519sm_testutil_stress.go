@@ -0,0 +1,89 @@
+// Copyright © 2021 Jeffrey H. Johnson. <trnsz@pobox.com>
+// Copyright © 2021 Gridfinity, LLC.
+// Copyright © 2020 The Go Authors.
+//
+// All rights reserved.
+//
+// Use of this source code is governed by the BSD-style
+// license that can be found in the LICENSE file.
+
+package testutil
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+)
+
+// StressConcurrent -> runs op from the given number of goroutines at once,
+// each of them calling it for the given number of iterations, to shake out
+// data races in shared state (best run with -race); op is expected to verify
+// its own result, the first error it returns is reported, otherwise the
+// goroutines are checked for leaks with LeakVerifyNone
+func StressConcurrent(
+	t *testing.T,
+	goroutines int,
+	iterations int,
+	op func(
+		goroutine int,
+		iteration int,
+	) error,
+) error {
+	if goroutines < 1 || iterations < 1 {
+		return fmt.Errorf(
+			"testutil.StressConcurrent: goroutines (%d) and iterations (%d) must be positive",
+			goroutines,
+			iterations,
+		)
+	}
+	var (
+		wg    sync.WaitGroup
+		once  sync.Once
+		first error
+	)
+	// All goroutines are released together, so that they overlap as much
+	// as possible.
+	start := make(
+		chan struct{},
+	)
+	for g := 0; g < goroutines; g++ {
+		wg.Add(
+			1,
+		)
+		go func(
+			g int,
+		) {
+			defer wg.Done()
+			<-start
+			for i := 0; i < iterations; i++ {
+				err := op(
+					g,
+					i,
+				)
+				if err != nil {
+					once.Do(func() {
+						first = fmt.Errorf(
+							"testutil.StressConcurrent: goroutine %d, iteration %d: %v",
+							g,
+							i,
+							err,
+						)
+					})
+					return
+				}
+			}
+		}(
+			g,
+		)
+	}
+	close(
+		start,
+	)
+	wg.Wait()
+	if first != nil {
+		return first
+	}
+	return LeakVerifyNone(
+		t,
+	)
+}
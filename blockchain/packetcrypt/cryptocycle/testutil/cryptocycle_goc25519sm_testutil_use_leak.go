@@ -34,3 +34,42 @@ func LeakVerifyNone(
 	}
 	return nil
 }
+
+// BaselineGoroutines -> enabled (wrapper function)
+func BaselineGoroutines() GoroutineBaseline {
+	s, err := baselineGoroutines(
+		true,
+	)
+	if err != nil {
+		panic(
+			fmt.Sprintf(
+				"	%v",
+				err,
+			),
+		)
+	}
+	return GoroutineBaseline{
+		snapshot: s,
+	}
+}
+
+// LeakVerifyNoneSince -> enabled (wrapper function)
+func LeakVerifyNoneSince(
+	t *testing.T,
+	b GoroutineBaseline,
+) error {
+	err := leakVerifyNoneSince(
+		t,
+		b.snapshot,
+		true,
+	)
+	if err != nil {
+		return fmt.Errorf(
+			fmt.Sprintf(
+				"	%v",
+				err,
+			),
+		)
+	}
+	return nil
+}
@@ -54,9 +54,17 @@ type LockedUnspent struct {
 	LockName string `json:"lockname"`
 }
 
+// CreateRawTransactionInput represents an input of the createrawtransaction
+// JSON-RPC command, a transaction input which may set its sequence number.
+type CreateRawTransactionInput struct {
+	Txid     string  `json:"txid"`
+	Vout     uint32  `json:"vout"`
+	Sequence *uint32 `json:"sequence,omitempty" jsonrpcusage:"\"sequence\":n"`
+}
+
 // CreateRawTransactionCmd defines the createrawtransaction JSON-RPC command.
 type CreateRawTransactionCmd struct {
-	Inputs   []TransactionInput
+	Inputs   []CreateRawTransactionInput
 	Amounts  map[string]float64 `jsonrpcusage:"{\"address\":amount,...}"` // In BTC
 	LockTime *int64
 }
@@ -65,7 +73,7 @@ type CreateRawTransactionCmd struct {
 // a createrawtransaction JSON-RPC command.
 //
 // Amounts are in BTC.
-func NewCreateRawTransactionCmd(inputs []TransactionInput, amounts map[string]float64,
+func NewCreateRawTransactionCmd(inputs []CreateRawTransactionInput, amounts map[string]float64,
 	lockTime *int64) *CreateRawTransactionCmd {
 	return &CreateRawTransactionCmd{
 		Inputs:   inputs,
@@ -47,7 +47,7 @@ func TestChainSvrCmds(t *testing.T) {
 					`{"456":0.0123}`)
 			},
 			staticCmd: func() interface{} {
-				txInputs := []btcjson.TransactionInput{
+				txInputs := []btcjson.CreateRawTransactionInput{
 					{Txid: "123", Vout: 1},
 				}
 				amounts := map[string]float64{"456": .0123}
@@ -55,7 +55,7 @@ func TestChainSvrCmds(t *testing.T) {
 			},
 			marshaled: `{"jsonrpc":"1.0","method":"createrawtransaction","params":[[{"txid":"123","vout":1}],{"456":0.0123}],"id":1}`,
 			unmarshaled: &btcjson.CreateRawTransactionCmd{
-				Inputs:  []btcjson.TransactionInput{{Txid: "123", Vout: 1}},
+				Inputs:  []btcjson.CreateRawTransactionInput{{Txid: "123", Vout: 1}},
 				Amounts: map[string]float64{"456": .0123},
 			},
 		},
@@ -66,7 +66,7 @@ func TestChainSvrCmds(t *testing.T) {
 					`{"456":0.0123}`, int64(12312333333))
 			},
 			staticCmd: func() interface{} {
-				txInputs := []btcjson.TransactionInput{
+				txInputs := []btcjson.CreateRawTransactionInput{
 					{Txid: "123", Vout: 1},
 				}
 				amounts := map[string]float64{"456": .0123}
@@ -74,11 +74,31 @@ func TestChainSvrCmds(t *testing.T) {
 			},
 			marshaled: `{"jsonrpc":"1.0","method":"createrawtransaction","params":[[{"txid":"123","vout":1}],{"456":0.0123},12312333333],"id":1}`,
 			unmarshaled: &btcjson.CreateRawTransactionCmd{
-				Inputs:   []btcjson.TransactionInput{{Txid: "123", Vout: 1}},
+				Inputs:   []btcjson.CreateRawTransactionInput{{Txid: "123", Vout: 1}},
 				Amounts:  map[string]float64{"456": .0123},
 				LockTime: btcjson.Int64(12312333333),
 			},
 		},
+		{
+			name: "createrawtransaction sequence",
+			newCmd: func() (interface{}, er.R) {
+				return btcjson.NewCmd("createrawtransaction", `[{"txid":"123","vout":1,"sequence":4294967293}]`,
+					`{"456":0.0123}`, int64(650000))
+			},
+			staticCmd: func() interface{} {
+				txInputs := []btcjson.CreateRawTransactionInput{
+					{Txid: "123", Vout: 1, Sequence: btcjson.Uint32(4294967293)},
+				}
+				amounts := map[string]float64{"456": .0123}
+				return btcjson.NewCreateRawTransactionCmd(txInputs, amounts, btcjson.Int64(650000))
+			},
+			marshaled: `{"jsonrpc":"1.0","method":"createrawtransaction","params":[[{"txid":"123","vout":1,"sequence":4294967293}],{"456":0.0123},650000],"id":1}`,
+			unmarshaled: &btcjson.CreateRawTransactionCmd{
+				Inputs:   []btcjson.CreateRawTransactionInput{{Txid: "123", Vout: 1, Sequence: btcjson.Uint32(4294967293)}},
+				Amounts:  map[string]float64{"456": .0123},
+				LockTime: btcjson.Int64(650000),
+			},
+		},
 
 		{
 			name: "decoderawtransaction",
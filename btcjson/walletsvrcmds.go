@@ -47,6 +47,36 @@ func NewCreateMultisigCmd(nRequired int, keys []string) *CreateMultisigCmd {
 	}
 }
 
+// CreateWalletCmd defines the createwallet JSON-RPC command.
+type CreateWalletCmd struct {
+	WalletName       string
+	Passphrase       string
+	PublicPassphrase *string
+	Seed             *string
+	SeedPassphrase   *string
+	WatchOnly        *bool `jsonrpcdefault:"false"`
+	Load             *bool `jsonrpcdefault:"false"`
+}
+
+// NewCreateWalletCmd returns a new instance which can be used to issue a
+// createwallet JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewCreateWalletCmd(walletName, passphrase string, publicPassphrase,
+	seed, seedPassphrase *string, watchOnly, load *bool) *CreateWalletCmd {
+
+	return &CreateWalletCmd{
+		WalletName:       walletName,
+		Passphrase:       passphrase,
+		PublicPassphrase: publicPassphrase,
+		Seed:             seed,
+		SeedPassphrase:   seedPassphrase,
+		WatchOnly:        watchOnly,
+		Load:             load,
+	}
+}
+
 // DumpPrivKeyCmd defines the dumpprivkey JSON-RPC command.
 type DumpPrivKeyCmd struct {
 	Address string
@@ -66,8 +96,34 @@ type GetAddressBalancesCmd struct {
 	ShowZeroBalance *bool
 }
 
+// GetAddressesByLabelCmd defines the getaddressesbylabel JSON-RPC command.
+type GetAddressesByLabelCmd struct {
+	Label string
+}
+
+// NewGetAddressesByLabelCmd returns a new instance which can be used to issue
+// a getaddressesbylabel JSON-RPC command.
+func NewGetAddressesByLabelCmd(label string) *GetAddressesByLabelCmd {
+	return &GetAddressesByLabelCmd{
+		Label: label,
+	}
+}
+
 type GetWalletSeedCmd struct{}
 
+// VerifyWalletSeedCmd defines the verifywalletseed JSON-RPC command.
+type VerifyWalletSeedCmd struct {
+	Seed string
+}
+
+// NewVerifyWalletSeedCmd returns a new instance which can be used to issue a
+// verifywalletseed JSON-RPC command.
+func NewVerifyWalletSeedCmd(seed string) *VerifyWalletSeedCmd {
+	return &VerifyWalletSeedCmd{
+		Seed: seed,
+	}
+}
+
 type GetSecretCmd struct {
 	Name string
 }
@@ -86,6 +142,12 @@ type GetBalanceCmd struct {
 	MinConf *int `jsonrpcdefault:"1"`
 }
 
+// GetBalancesCmd defines the getbalances JSON-RPC command.
+type GetBalancesCmd struct {
+	MinConf      *int `jsonrpcdefault:"1"`
+	MatureWithin *int
+}
+
 type GetNetworkStewardVoteCmd struct{}
 
 // GetNewAddressCmd defines the getnewaddress JSON-RPC command.
@@ -150,6 +212,33 @@ func NewImportPrivKeyCmd(privKey string, label *string, rescan *bool) *ImportPri
 	}
 }
 
+// ImportWalletCmd defines the importwallet JSON-RPC command.
+type ImportWalletCmd struct {
+	Filename string
+	Legacy   *bool `jsonrpcdefault:"false"`
+}
+
+// NewImportWalletCmd returns a new instance which can be used to issue a
+// importwallet JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewImportWalletCmd(filename string, legacy *bool) *ImportWalletCmd {
+	return &ImportWalletCmd{
+		Filename: filename,
+		Legacy:   legacy,
+	}
+}
+
+// ListLabelsCmd defines the listlabels JSON-RPC command.
+type ListLabelsCmd struct{}
+
+// NewListLabelsCmd returns a new instance which can be used to issue a
+// listlabels JSON-RPC command.
+func NewListLabelsCmd() *ListLabelsCmd {
+	return &ListLabelsCmd{}
+}
+
 // ListLockUnspentCmd defines the listlockunspent JSON-RPC command.
 type ListLockUnspentCmd struct{}
 
@@ -225,6 +314,24 @@ func NewListUnspentCmd(minConf, maxConf *int, addresses *[]string) *ListUnspentC
 	}
 }
 
+// LoadWalletCmd defines the loadwallet JSON-RPC command.
+type LoadWalletCmd struct {
+	WalletName       string
+	PublicPassphrase *string
+}
+
+// NewLoadWalletCmd returns a new instance which can be used to issue a
+// loadwallet JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewLoadWalletCmd(walletName string, publicPassphrase *string) *LoadWalletCmd {
+	return &LoadWalletCmd{
+		WalletName:       walletName,
+		PublicPassphrase: publicPassphrase,
+	}
+}
+
 // LockUnspentCmd defines the lockunspent JSON-RPC command.
 type LockUnspentCmd struct {
 	Unlock       bool
@@ -281,6 +388,8 @@ type CreateTransactionCmd struct {
 	Vote           *bool
 	MaxInputs      *int
 	AutoLock       *string
+	LockTime       *int64
+	Sequence       *int64
 }
 
 // SendManyCmd defines the sendmany JSON-RPC command.
@@ -328,6 +437,21 @@ func NewSendToAddressCmd(address string, amount float64, comment, commentTo *str
 	}
 }
 
+// SetAddressLabelCmd defines the setaddresslabel JSON-RPC command.
+type SetAddressLabelCmd struct {
+	Address string
+	Label   string
+}
+
+// NewSetAddressLabelCmd returns a new instance which can be used to issue a
+// setaddresslabel JSON-RPC command.
+func NewSetAddressLabelCmd(address, label string) *SetAddressLabelCmd {
+	return &SetAddressLabelCmd{
+		Address: address,
+		Label:   label,
+	}
+}
+
 // SetTxFeeCmd defines the settxfee JSON-RPC command.
 type SetTxFeeCmd struct {
 	Amount float64 // In BTC
@@ -356,6 +480,26 @@ func NewSignMessageCmd(address, message string) *SignMessageCmd {
 	}
 }
 
+// SignWithAddressCmd defines the signwithaddress JSON-RPC command.
+type SignWithAddressCmd struct {
+	Address    string
+	Data       string
+	InputIndex *int
+}
+
+// NewSignWithAddressCmd returns a new instance which can be used to issue a
+// signwithaddress JSON-RPC command. If inputIndex is nil, data is signed as a
+// message, otherwise it is treated as a base64 encoded PSBT.
+func NewSignWithAddressCmd(address, data string,
+	inputIndex *int) *SignWithAddressCmd {
+
+	return &SignWithAddressCmd{
+		Address:    address,
+		Data:       data,
+		InputIndex: inputIndex,
+	}
+}
+
 // RawTxInput models the data needed for raw transaction input that is used in
 // the SignRawTransactionCmd struct.
 type RawTxInput struct {
@@ -387,6 +531,15 @@ func NewSignRawTransactionCmd(hexEncodedTx string, inputs *[]RawTxInput, privKey
 	}
 }
 
+// UnloadWalletCmd defines the unloadwallet JSON-RPC command.
+type UnloadWalletCmd struct{}
+
+// NewUnloadWalletCmd returns a new instance which can be used to issue an
+// unloadwallet JSON-RPC command.
+func NewUnloadWalletCmd() *UnloadWalletCmd {
+	return &UnloadWalletCmd{}
+}
+
 // WalletLockCmd defines the walletlock JSON-RPC command.
 type WalletLockCmd struct{}
 
@@ -428,6 +581,159 @@ func NewWalletPassphraseChangeCmd(oldPassphrase, newPassphrase string) *WalletPa
 
 type WalletMempoolCmd struct{}
 
+// GetSyncProgressCmd defines the getsyncprogress JSON-RPC command.
+type GetSyncProgressCmd struct{}
+
+// NewGetSyncProgressCmd returns a new instance which can be used to issue a
+// getsyncprogress JSON-RPC command.
+func NewGetSyncProgressCmd() *GetSyncProgressCmd {
+	return &GetSyncProgressCmd{}
+}
+
+// ScanBlocksCmd defines the scanblocks JSON-RPC command.
+type ScanBlocksCmd struct {
+	Scripts     []string
+	StartHeight *int32
+	StopHeight  *int32
+	FetchBlocks *bool `jsonrpcdefault:"false"`
+}
+
+// NewScanBlocksCmd returns a new instance which can be used to issue a
+// scanblocks JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewScanBlocksCmd(scripts []string, startHeight, stopHeight *int32,
+	fetchBlocks *bool) *ScanBlocksCmd {
+
+	return &ScanBlocksCmd{
+		Scripts:     scripts,
+		StartHeight: startHeight,
+		StopHeight:  stopHeight,
+		FetchBlocks: fetchBlocks,
+	}
+}
+
+// ConsolidateCmd defines the consolidate JSON-RPC command.
+type ConsolidateCmd struct {
+	Threshold float64 // In BTC
+	MaxInputs *int
+	FeeRate   *float64 // In BTC/kB
+	MinConf   *int     `jsonrpcdefault:"1"`
+	DryRun    *bool    `jsonrpcdefault:"false"`
+}
+
+// NewConsolidateCmd returns a new instance which can be used to issue a
+// consolidate JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewConsolidateCmd(threshold float64, maxInputs *int, feeRate *float64,
+	minConf *int, dryRun *bool) *ConsolidateCmd {
+
+	return &ConsolidateCmd{
+		Threshold: threshold,
+		MaxInputs: maxInputs,
+		FeeRate:   feeRate,
+		MinConf:   minConf,
+		DryRun:    dryRun,
+	}
+}
+
+// ExportUtxosCmd defines the exportutxos JSON-RPC command.
+type ExportUtxosCmd struct {
+	Count *int `jsonrpcdefault:"1000"`
+	After *string
+}
+
+// NewExportUtxosCmd returns a new instance which can be used to issue an
+// exportutxos JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewExportUtxosCmd(count *int, after *string) *ExportUtxosCmd {
+	return &ExportUtxosCmd{
+		Count: count,
+		After: after,
+	}
+}
+
+// ListDescriptorsCmd defines the listdescriptors JSON-RPC command.
+type ListDescriptorsCmd struct {
+	Private  *bool `jsonrpcdefault:"false"`
+	Checksum *bool `jsonrpcdefault:"true"`
+}
+
+// NewListDescriptorsCmd returns a new instance which can be used to issue a
+// listdescriptors JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewListDescriptorsCmd(private, checksum *bool) *ListDescriptorsCmd {
+	return &ListDescriptorsCmd{
+		Private:  private,
+		Checksum: checksum,
+	}
+}
+
+// GetFeeHistoryCmd defines the getfeehistory JSON-RPC command.
+type GetFeeHistoryCmd struct {
+	Count *int `jsonrpcdefault:"10"`
+}
+
+// NewGetFeeHistoryCmd returns a new instance which can be used to issue a
+// getfeehistory JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewGetFeeHistoryCmd(count *int) *GetFeeHistoryCmd {
+	return &GetFeeHistoryCmd{
+		Count: count,
+	}
+}
+
+// AbandonTransactionCmd defines the abandontransaction JSON-RPC command.
+type AbandonTransactionCmd struct {
+	TxID string
+}
+
+// NewAbandonTransactionCmd returns a new instance which can be used to issue
+// an abandontransaction JSON-RPC command.
+func NewAbandonTransactionCmd(txID string) *AbandonTransactionCmd {
+	return &AbandonTransactionCmd{
+		TxID: txID,
+	}
+}
+
+// GetDescriptorInfoCmd defines the getdescriptorinfo JSON-RPC command.
+type GetDescriptorInfoCmd struct {
+	Descriptor string
+}
+
+// NewGetDescriptorInfoCmd returns a new instance which can be used to issue a
+// getdescriptorinfo JSON-RPC command.
+func NewGetDescriptorInfoCmd(descriptor string) *GetDescriptorInfoCmd {
+	return &GetDescriptorInfoCmd{
+		Descriptor: descriptor,
+	}
+}
+
+// WaitForSyncCmd defines the waitforsync JSON-RPC command.
+type WaitForSyncCmd struct {
+	Timeout *int `jsonrpcdefault:"60"`
+}
+
+// NewWaitForSyncCmd returns a new instance which can be used to issue a
+// waitforsync JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewWaitForSyncCmd(timeout *int) *WaitForSyncCmd {
+	return &WaitForSyncCmd{
+		Timeout: timeout,
+	}
+}
+
 // SetNetworkStewardVoteCmd is the argument to the wallet command setnetworkstewardvote
 type SetNetworkStewardVoteCmd struct {
 	VoteFor     *string `json:"votefor"`
@@ -443,11 +749,14 @@ func init() {
 	MustRegisterCmd("addwitnessaddress", (*AddWitnessAddressCmd)(nil), flags)
 	MustRegisterCmd("createmultisig", (*CreateMultisigCmd)(nil), flags)
 	MustRegisterCmd("createtransaction", (*CreateTransactionCmd)(nil), flags)
+	MustRegisterCmd("createwallet", (*CreateWalletCmd)(nil), flags)
 	MustRegisterCmd("getaddressbalances", (*GetAddressBalancesCmd)(nil), flags)
+	MustRegisterCmd("getaddressesbylabel", (*GetAddressesByLabelCmd)(nil), flags)
 	MustRegisterCmd("resync", (*ResyncCmd)(nil), flags)
 	MustRegisterCmd("stopresync", (*StopResyncCmd)(nil), flags)
 	MustRegisterCmd("dumpprivkey", (*DumpPrivKeyCmd)(nil), flags)
 	MustRegisterCmd("getbalance", (*GetBalanceCmd)(nil), flags)
+	MustRegisterCmd("getbalances", (*GetBalancesCmd)(nil), flags)
 	MustRegisterCmd("getnetworkstewardvote", (*GetNetworkStewardVoteCmd)(nil), flags)
 	MustRegisterCmd("getnewaddress", (*GetNewAddressCmd)(nil), flags)
 	MustRegisterCmd("getreceivedbyaddress", (*GetReceivedByAddressCmd)(nil), flags)
@@ -455,21 +764,37 @@ func init() {
 	MustRegisterCmd("getwalletseed", (*GetWalletSeedCmd)(nil), flags)
 	MustRegisterCmd("getsecret", (*GetSecretCmd)(nil), flags)
 	MustRegisterCmd("importprivkey", (*ImportPrivKeyCmd)(nil), flags)
+	MustRegisterCmd("importwallet", (*ImportWalletCmd)(nil), flags)
+	MustRegisterCmd("listlabels", (*ListLabelsCmd)(nil), flags)
 	MustRegisterCmd("listlockunspent", (*ListLockUnspentCmd)(nil), flags)
 	MustRegisterCmd("listreceivedbyaddress", (*ListReceivedByAddressCmd)(nil), flags)
 	MustRegisterCmd("listsinceblock", (*ListSinceBlockCmd)(nil), flags)
 	MustRegisterCmd("listtransactions", (*ListTransactionsCmd)(nil), flags)
 	MustRegisterCmd("listunspent", (*ListUnspentCmd)(nil), flags)
+	MustRegisterCmd("loadwallet", (*LoadWalletCmd)(nil), flags)
 	MustRegisterCmd("lockunspent", (*LockUnspentCmd)(nil), flags)
 	MustRegisterCmd("sendfrom", (*SendFromCmd)(nil), flags)
 	MustRegisterCmd("sendmany", (*SendManyCmd)(nil), flags)
 	MustRegisterCmd("sendtoaddress", (*SendToAddressCmd)(nil), flags)
+	MustRegisterCmd("setaddresslabel", (*SetAddressLabelCmd)(nil), flags)
 	MustRegisterCmd("setnetworkstewardvote", (*SetNetworkStewardVoteCmd)(nil), flags)
 	MustRegisterCmd("settxfee", (*SetTxFeeCmd)(nil), flags)
 	MustRegisterCmd("signmessage", (*SignMessageCmd)(nil), flags)
 	MustRegisterCmd("signrawtransaction", (*SignRawTransactionCmd)(nil), flags)
+	MustRegisterCmd("signwithaddress", (*SignWithAddressCmd)(nil), flags)
+	MustRegisterCmd("unloadwallet", (*UnloadWalletCmd)(nil), flags)
 	MustRegisterCmd("walletlock", (*WalletLockCmd)(nil), flags)
 	MustRegisterCmd("walletpassphrase", (*WalletPassphraseCmd)(nil), flags)
 	MustRegisterCmd("walletpassphrasechange", (*WalletPassphraseChangeCmd)(nil), flags)
 	MustRegisterCmd("walletmempool", (*WalletMempoolCmd)(nil), flags)
+	MustRegisterCmd("waitforsync", (*WaitForSyncCmd)(nil), flags)
+	MustRegisterCmd("getsyncprogress", (*GetSyncProgressCmd)(nil), flags)
+	MustRegisterCmd("scanblocks", (*ScanBlocksCmd)(nil), flags)
+	MustRegisterCmd("consolidate", (*ConsolidateCmd)(nil), flags)
+	MustRegisterCmd("exportutxos", (*ExportUtxosCmd)(nil), flags)
+	MustRegisterCmd("listdescriptors", (*ListDescriptorsCmd)(nil), flags)
+	MustRegisterCmd("getfeehistory", (*GetFeeHistoryCmd)(nil), flags)
+	MustRegisterCmd("abandontransaction", (*AbandonTransactionCmd)(nil), flags)
+	MustRegisterCmd("getdescriptorinfo", (*GetDescriptorInfoCmd)(nil), flags)
+	MustRegisterCmd("verifywalletseed", (*VerifyWalletSeedCmd)(nil), flags)
 }
@@ -68,6 +68,46 @@ func TestWalletSvrCmds(t *testing.T) {
 				Keys:      []string{"031234", "035678"},
 			},
 		},
+		{
+			name: "createwallet",
+			newCmd: func() (interface{}, er.R) {
+				return btcjson.NewCmd("createwallet", "savings", "pass")
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewCreateWalletCmd("savings", "pass", nil,
+					nil, nil, nil, nil)
+			},
+			marshaled: `{"jsonrpc":"1.0","method":"createwallet","params":["savings","pass"],"id":1}`,
+			unmarshaled: &btcjson.CreateWalletCmd{
+				WalletName: "savings",
+				Passphrase: "pass",
+				WatchOnly:  btcjson.Bool(false),
+				Load:       btcjson.Bool(false),
+			},
+		},
+		{
+			name: "createwallet optional",
+			newCmd: func() (interface{}, er.R) {
+				return btcjson.NewCmd("createwallet", "savings", "pass",
+					"pub", "seed words", "seedpass", true, true)
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewCreateWalletCmd("savings", "pass",
+					btcjson.String("pub"), btcjson.String("seed words"),
+					btcjson.String("seedpass"), btcjson.Bool(true),
+					btcjson.Bool(true))
+			},
+			marshaled: `{"jsonrpc":"1.0","method":"createwallet","params":["savings","pass","pub","seed words","seedpass",true,true],"id":1}`,
+			unmarshaled: &btcjson.CreateWalletCmd{
+				WalletName:       "savings",
+				Passphrase:       "pass",
+				PublicPassphrase: btcjson.String("pub"),
+				Seed:             btcjson.String("seed words"),
+				SeedPassphrase:   btcjson.String("seedpass"),
+				WatchOnly:        btcjson.Bool(true),
+				Load:             btcjson.Bool(true),
+			},
+		},
 		{
 			name: "dumpprivkey",
 			newCmd: func() (interface{}, er.R) {
@@ -94,6 +134,19 @@ func TestWalletSvrCmds(t *testing.T) {
 				NumBlocks: 6,
 			},
 		},
+		{
+			name: "getaddressesbylabel",
+			newCmd: func() (interface{}, er.R) {
+				return btcjson.NewCmd("getaddressesbylabel", "friends")
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewGetAddressesByLabelCmd("friends")
+			},
+			marshaled: `{"jsonrpc":"1.0","method":"getaddressesbylabel","params":["friends"],"id":1}`,
+			unmarshaled: &btcjson.GetAddressesByLabelCmd{
+				Label: "friends",
+			},
+		},
 		{
 			name: "getbalance",
 			newCmd: func() (interface{}, er.R) {
@@ -104,6 +157,37 @@ func TestWalletSvrCmds(t *testing.T) {
 				MinConf: btcjson.Int(1),
 			},
 		},
+		{
+			name: "getbalances",
+			newCmd: func() (interface{}, er.R) {
+				return btcjson.NewCmd("getbalances")
+			},
+			marshaled: `{"jsonrpc":"1.0","method":"getbalances","params":[],"id":1}`,
+			unmarshaled: &btcjson.GetBalancesCmd{
+				MinConf: btcjson.Int(1),
+			},
+		},
+		{
+			name: "getbalances optional",
+			newCmd: func() (interface{}, er.R) {
+				return btcjson.NewCmd("getbalances", 6)
+			},
+			marshaled: `{"jsonrpc":"1.0","method":"getbalances","params":[6],"id":1}`,
+			unmarshaled: &btcjson.GetBalancesCmd{
+				MinConf: btcjson.Int(6),
+			},
+		},
+		{
+			name: "getbalances maturewithin",
+			newCmd: func() (interface{}, er.R) {
+				return btcjson.NewCmd("getbalances", 6, 10)
+			},
+			marshaled: `{"jsonrpc":"1.0","method":"getbalances","params":[6,10],"id":1}`,
+			unmarshaled: &btcjson.GetBalancesCmd{
+				MinConf:      btcjson.Int(6),
+				MatureWithin: btcjson.Int(10),
+			},
+		},
 		{
 			name: "getnewaddress",
 			newCmd: func() (interface{}, er.R) {
@@ -223,6 +307,45 @@ func TestWalletSvrCmds(t *testing.T) {
 				Rescan:  btcjson.Bool(false),
 			},
 		},
+		{
+			name: "importwallet",
+			newCmd: func() (interface{}, er.R) {
+				return btcjson.NewCmd("importwallet", "wallet.dump")
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewImportWalletCmd("wallet.dump", nil)
+			},
+			marshaled: `{"jsonrpc":"1.0","method":"importwallet","params":["wallet.dump"],"id":1}`,
+			unmarshaled: &btcjson.ImportWalletCmd{
+				Filename: "wallet.dump",
+				Legacy:   btcjson.Bool(false),
+			},
+		},
+		{
+			name: "importwallet optional",
+			newCmd: func() (interface{}, er.R) {
+				return btcjson.NewCmd("importwallet", "wallet.dump", true)
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewImportWalletCmd("wallet.dump", btcjson.Bool(true))
+			},
+			marshaled: `{"jsonrpc":"1.0","method":"importwallet","params":["wallet.dump",true],"id":1}`,
+			unmarshaled: &btcjson.ImportWalletCmd{
+				Filename: "wallet.dump",
+				Legacy:   btcjson.Bool(true),
+			},
+		},
+		{
+			name: "listlabels",
+			newCmd: func() (interface{}, er.R) {
+				return btcjson.NewCmd("listlabels")
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewListLabelsCmd()
+			},
+			marshaled:   `{"jsonrpc":"1.0","method":"listlabels","params":[],"id":1}`,
+			unmarshaled: &btcjson.ListLabelsCmd{},
+		},
 		{
 			name: "listlockunspent",
 			newCmd: func() (interface{}, er.R) {
@@ -437,6 +560,34 @@ func TestWalletSvrCmds(t *testing.T) {
 				Addresses: &[]string{"1Address", "1Address2"},
 			},
 		},
+		{
+			name: "loadwallet",
+			newCmd: func() (interface{}, er.R) {
+				return btcjson.NewCmd("loadwallet", "savings")
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewLoadWalletCmd("savings", nil)
+			},
+			marshaled: `{"jsonrpc":"1.0","method":"loadwallet","params":["savings"],"id":1}`,
+			unmarshaled: &btcjson.LoadWalletCmd{
+				WalletName: "savings",
+			},
+		},
+		{
+			name: "loadwallet optional",
+			newCmd: func() (interface{}, er.R) {
+				return btcjson.NewCmd("loadwallet", "savings", "pub")
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewLoadWalletCmd("savings",
+					btcjson.String("pub"))
+			},
+			marshaled: `{"jsonrpc":"1.0","method":"loadwallet","params":["savings","pub"],"id":1}`,
+			unmarshaled: &btcjson.LoadWalletCmd{
+				WalletName:       "savings",
+				PublicPassphrase: btcjson.String("pub"),
+			},
+		},
 		{
 			name: "lockunspent",
 			newCmd: func() (interface{}, er.R) {
@@ -614,6 +765,20 @@ func TestWalletSvrCmds(t *testing.T) {
 				CommentTo: btcjson.String("commentto"),
 			},
 		},
+		{
+			name: "setaddresslabel",
+			newCmd: func() (interface{}, er.R) {
+				return btcjson.NewCmd("setaddresslabel", "1Address", "friends")
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewSetAddressLabelCmd("1Address", "friends")
+			},
+			marshaled: `{"jsonrpc":"1.0","method":"setaddresslabel","params":["1Address","friends"],"id":1}`,
+			unmarshaled: &btcjson.SetAddressLabelCmd{
+				Address: "1Address",
+				Label:   "friends",
+			},
+		},
 		{
 			name: "settxfee",
 			newCmd: func() (interface{}, er.R) {
@@ -641,6 +806,35 @@ func TestWalletSvrCmds(t *testing.T) {
 				Message: "message",
 			},
 		},
+		{
+			name: "signwithaddress",
+			newCmd: func() (interface{}, er.R) {
+				return btcjson.NewCmd("signwithaddress", "1Address", "message")
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewSignWithAddressCmd("1Address", "message", nil)
+			},
+			marshaled: `{"jsonrpc":"1.0","method":"signwithaddress","params":["1Address","message"],"id":1}`,
+			unmarshaled: &btcjson.SignWithAddressCmd{
+				Address: "1Address",
+				Data:    "message",
+			},
+		},
+		{
+			name: "signwithaddress optional",
+			newCmd: func() (interface{}, er.R) {
+				return btcjson.NewCmd("signwithaddress", "1Address", "cHNidP8=", 1)
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewSignWithAddressCmd("1Address", "cHNidP8=", btcjson.Int(1))
+			},
+			marshaled: `{"jsonrpc":"1.0","method":"signwithaddress","params":["1Address","cHNidP8=",1],"id":1}`,
+			unmarshaled: &btcjson.SignWithAddressCmd{
+				Address:    "1Address",
+				Data:       "cHNidP8=",
+				InputIndex: btcjson.Int(1),
+			},
+		},
 		{
 			name: "signrawtransaction",
 			newCmd: func() (interface{}, er.R) {
@@ -726,6 +920,17 @@ func TestWalletSvrCmds(t *testing.T) {
 				Flags:    btcjson.String("ALL"),
 			},
 		},
+		{
+			name: "unloadwallet",
+			newCmd: func() (interface{}, er.R) {
+				return btcjson.NewCmd("unloadwallet")
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewUnloadWalletCmd()
+			},
+			marshaled:   `{"jsonrpc":"1.0","method":"unloadwallet","params":[],"id":1}`,
+			unmarshaled: &btcjson.UnloadWalletCmd{},
+		},
 		{
 			name: "walletlock",
 			newCmd: func() (interface{}, er.R) {
@@ -765,6 +970,232 @@ func TestWalletSvrCmds(t *testing.T) {
 				NewPassphrase: "new",
 			},
 		},
+		{
+			name: "verifywalletseed",
+			newCmd: func() (interface{}, er.R) {
+				return btcjson.NewCmd("verifywalletseed", "seed words")
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewVerifyWalletSeedCmd("seed words")
+			},
+			marshaled: `{"jsonrpc":"1.0","method":"verifywalletseed","params":["seed words"],"id":1}`,
+			unmarshaled: &btcjson.VerifyWalletSeedCmd{
+				Seed: "seed words",
+			},
+		},
+		{
+			name: "waitforsync",
+			newCmd: func() (interface{}, er.R) {
+				return btcjson.NewCmd("waitforsync")
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewWaitForSyncCmd(nil)
+			},
+			marshaled: `{"jsonrpc":"1.0","method":"waitforsync","params":[],"id":1}`,
+			unmarshaled: &btcjson.WaitForSyncCmd{
+				Timeout: btcjson.Int(60),
+			},
+		},
+		{
+			name: "waitforsync optional",
+			newCmd: func() (interface{}, er.R) {
+				return btcjson.NewCmd("waitforsync", 10)
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewWaitForSyncCmd(btcjson.Int(10))
+			},
+			marshaled: `{"jsonrpc":"1.0","method":"waitforsync","params":[10],"id":1}`,
+			unmarshaled: &btcjson.WaitForSyncCmd{
+				Timeout: btcjson.Int(10),
+			},
+		},
+		{
+			name: "getsyncprogress",
+			newCmd: func() (interface{}, er.R) {
+				return btcjson.NewCmd("getsyncprogress")
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewGetSyncProgressCmd()
+			},
+			marshaled:   `{"jsonrpc":"1.0","method":"getsyncprogress","params":[],"id":1}`,
+			unmarshaled: &btcjson.GetSyncProgressCmd{},
+		},
+		{
+			name: "consolidate",
+			newCmd: func() (interface{}, er.R) {
+				return btcjson.NewCmd("consolidate", 0.5)
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewConsolidateCmd(0.5, nil, nil, nil, nil)
+			},
+			marshaled: `{"jsonrpc":"1.0","method":"consolidate","params":[0.5],"id":1}`,
+			unmarshaled: &btcjson.ConsolidateCmd{
+				Threshold: 0.5,
+				MinConf:   btcjson.Int(1),
+				DryRun:    btcjson.Bool(false),
+			},
+		},
+		{
+			name: "consolidate optional",
+			newCmd: func() (interface{}, er.R) {
+				return btcjson.NewCmd("consolidate", 0.5, 100, 0.0001, 6, true)
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewConsolidateCmd(0.5, btcjson.Int(100),
+					btcjson.Float64(0.0001), btcjson.Int(6), btcjson.Bool(true))
+			},
+			marshaled: `{"jsonrpc":"1.0","method":"consolidate","params":[0.5,100,0.0001,6,true],"id":1}`,
+			unmarshaled: &btcjson.ConsolidateCmd{
+				Threshold: 0.5,
+				MaxInputs: btcjson.Int(100),
+				FeeRate:   btcjson.Float64(0.0001),
+				MinConf:   btcjson.Int(6),
+				DryRun:    btcjson.Bool(true),
+			},
+		},
+		{
+			name: "exportutxos",
+			newCmd: func() (interface{}, er.R) {
+				return btcjson.NewCmd("exportutxos")
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewExportUtxosCmd(nil, nil)
+			},
+			marshaled: `{"jsonrpc":"1.0","method":"exportutxos","params":[],"id":1}`,
+			unmarshaled: &btcjson.ExportUtxosCmd{
+				Count: btcjson.Int(1000),
+			},
+		},
+		{
+			name: "exportutxos optional",
+			newCmd: func() (interface{}, er.R) {
+				return btcjson.NewCmd("exportutxos", 10, "abcd:1")
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewExportUtxosCmd(btcjson.Int(10),
+					btcjson.String("abcd:1"))
+			},
+			marshaled: `{"jsonrpc":"1.0","method":"exportutxos","params":[10,"abcd:1"],"id":1}`,
+			unmarshaled: &btcjson.ExportUtxosCmd{
+				Count: btcjson.Int(10),
+				After: btcjson.String("abcd:1"),
+			},
+		},
+		{
+			name: "listdescriptors",
+			newCmd: func() (interface{}, er.R) {
+				return btcjson.NewCmd("listdescriptors")
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewListDescriptorsCmd(nil, nil)
+			},
+			marshaled: `{"jsonrpc":"1.0","method":"listdescriptors","params":[],"id":1}`,
+			unmarshaled: &btcjson.ListDescriptorsCmd{
+				Private:  btcjson.Bool(false),
+				Checksum: btcjson.Bool(true),
+			},
+		},
+		{
+			name: "listdescriptors optional",
+			newCmd: func() (interface{}, er.R) {
+				return btcjson.NewCmd("listdescriptors", true, false)
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewListDescriptorsCmd(btcjson.Bool(true),
+					btcjson.Bool(false))
+			},
+			marshaled: `{"jsonrpc":"1.0","method":"listdescriptors","params":[true,false],"id":1}`,
+			unmarshaled: &btcjson.ListDescriptorsCmd{
+				Private:  btcjson.Bool(true),
+				Checksum: btcjson.Bool(false),
+			},
+		},
+		{
+			name: "getfeehistory",
+			newCmd: func() (interface{}, er.R) {
+				return btcjson.NewCmd("getfeehistory")
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewGetFeeHistoryCmd(nil)
+			},
+			marshaled: `{"jsonrpc":"1.0","method":"getfeehistory","params":[],"id":1}`,
+			unmarshaled: &btcjson.GetFeeHistoryCmd{
+				Count: btcjson.Int(10),
+			},
+		},
+		{
+			name: "getfeehistory optional",
+			newCmd: func() (interface{}, er.R) {
+				return btcjson.NewCmd("getfeehistory", 50)
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewGetFeeHistoryCmd(btcjson.Int(50))
+			},
+			marshaled: `{"jsonrpc":"1.0","method":"getfeehistory","params":[50],"id":1}`,
+			unmarshaled: &btcjson.GetFeeHistoryCmd{
+				Count: btcjson.Int(50),
+			},
+		},
+		{
+			name: "abandontransaction",
+			newCmd: func() (interface{}, er.R) {
+				return btcjson.NewCmd("abandontransaction", "123")
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewAbandonTransactionCmd("123")
+			},
+			marshaled: `{"jsonrpc":"1.0","method":"abandontransaction","params":["123"],"id":1}`,
+			unmarshaled: &btcjson.AbandonTransactionCmd{
+				TxID: "123",
+			},
+		},
+		{
+			name: "getdescriptorinfo",
+			newCmd: func() (interface{}, er.R) {
+				return btcjson.NewCmd("getdescriptorinfo", "raw(deadbeef)")
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewGetDescriptorInfoCmd("raw(deadbeef)")
+			},
+			marshaled: `{"jsonrpc":"1.0","method":"getdescriptorinfo","params":["raw(deadbeef)"],"id":1}`,
+			unmarshaled: &btcjson.GetDescriptorInfoCmd{
+				Descriptor: "raw(deadbeef)",
+			},
+		},
+		{
+			name: "scanblocks",
+			newCmd: func() (interface{}, er.R) {
+				return btcjson.NewCmd("scanblocks", []string{"0014ab"})
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewScanBlocksCmd([]string{"0014ab"},
+					nil, nil, nil)
+			},
+			marshaled: `{"jsonrpc":"1.0","method":"scanblocks","params":[["0014ab"]],"id":1}`,
+			unmarshaled: &btcjson.ScanBlocksCmd{
+				Scripts:     []string{"0014ab"},
+				FetchBlocks: btcjson.Bool(false),
+			},
+		},
+		{
+			name: "scanblocks optional",
+			newCmd: func() (interface{}, er.R) {
+				return btcjson.NewCmd("scanblocks", []string{"0014ab"},
+					100, 200, true)
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewScanBlocksCmd([]string{"0014ab"},
+					btcjson.Int32(100), btcjson.Int32(200),
+					btcjson.Bool(true))
+			},
+			marshaled: `{"jsonrpc":"1.0","method":"scanblocks","params":[["0014ab"],100,200,true],"id":1}`,
+			unmarshaled: &btcjson.ScanBlocksCmd{
+				Scripts:     []string{"0014ab"},
+				StartHeight: btcjson.Int32(100),
+				StopHeight:  btcjson.Int32(200),
+				FetchBlocks: btcjson.Bool(true),
+			},
+		},
 	}
 
 	t.Logf("Running %d tests", len(tests))
@@ -26,12 +26,23 @@ type GetTransactionDetailsResult struct {
 	Vout              uint32   `json:"vout"`
 }
 
+// GetTransactionOutputResult models an output of the transaction returned by
+// the gettransaction command, along with who it pays.
+type GetTransactionOutputResult struct {
+	Vout     uint32  `json:"vout"`
+	Amount   float64 `json:"amount"`
+	Address  string  `json:"address,omitempty"`
+	Category string  `json:"category"`
+}
+
 // GetTransactionResult models the data from the gettransaction command.
 type GetTransactionResult struct {
 	Amount          float64                       `json:"amount"`
 	Fee             float64                       `json:"fee,omitempty"`
+	Net             float64                       `json:"net"`
 	Confirmations   int64                         `json:"confirmations"`
 	BlockHash       string                        `json:"blockhash"`
+	BlockHeight     int32                         `json:"blockheight,omitempty"`
 	BlockIndex      int64                         `json:"blockindex"`
 	BlockTime       int64                         `json:"blocktime"`
 	TxID            string                        `json:"txid"`
@@ -39,6 +50,7 @@ type GetTransactionResult struct {
 	Time            int64                         `json:"time"`
 	TimeReceived    int64                         `json:"timereceived"`
 	Details         []GetTransactionDetailsResult `json:"details"`
+	Outputs         []GetTransactionOutputResult  `json:"outputs"`
 	Hex             string                        `json:"hex"`
 }
 
@@ -100,6 +112,11 @@ type WalletStats struct {
 
 	// General info
 	BirthdayBlock int32
+
+	// Number of addresses watched for transactions and the configured
+	// maximum, 0 if there is no limit
+	WatchedAddrs    int
+	MaxWatchedAddrs int
 }
 
 type WalletInfoResult struct {
@@ -123,6 +140,7 @@ type ListTransactionsResult struct {
 	Abandoned         bool     `json:"abandoned"`
 	Account           string   `json:"account"`
 	Address           string   `json:"address,omitempty"`
+	Label             string   `json:"label,omitempty"`
 	Amount            float64  `json:"amount"`
 	BIP125Replaceable string   `json:"bip125-replaceable,omitempty"`
 	BlockHash         string   `json:"blockhash,omitempty"`
@@ -193,6 +211,22 @@ type SignRawTransactionResult struct {
 	Errors   []SignRawTransactionError `json:"errors,omitempty"`
 }
 
+// CreateWalletResult models the data from the createwallet command.
+type CreateWalletResult struct {
+	Name        string `json:"name"`
+	Fingerprint string `json:"fingerprint"`
+	Seed        string `json:"seed,omitempty"`
+	Loaded      bool   `json:"loaded"`
+	Warning     string `json:"warning,omitempty"`
+}
+
+// SignWithAddressResult models the data from the signwithaddress command.
+type SignWithAddressResult struct {
+	PubKey    string `json:"pubkey"`
+	Signature string `json:"signature"`
+	Psbt      string `json:"psbt,omitempty"`
+}
+
 // ValidateAddressWalletResult models the data returned by the wallet server
 // validateaddress command.
 type ValidateAddressWalletResult struct {
@@ -216,6 +250,148 @@ type GetBestBlockResult struct {
 	Height int32  `json:"height"`
 }
 
+// GetBlockChainInfoWalletResult models the data from the wallet's
+// getblockchaininfo command.  When the chain backend can't be reached, the
+// last cached chain state is returned with Stale set.
+type GetBlockChainInfoWalletResult struct {
+	Chain                string  `json:"chain"`
+	Blocks               int32   `json:"blocks"`
+	BestBlockHash        string  `json:"bestblockhash"`
+	BestBlockTime        int64   `json:"bestblocktime,omitempty"`
+	InitialBlockDownload bool    `json:"initialblockdownload"`
+	VerificationProgress float64 `json:"verificationprogress"`
+	Stale                bool    `json:"stale"`
+	CachedAt             int64   `json:"cachedat,omitempty"`
+}
+
+// SyncProgressResult models the data from the getsyncprogress command and the
+// syncprogress notification.
+type SyncProgressResult struct {
+	CurrentHeight      int32   `json:"currentheight"`
+	TargetHeight       int32   `json:"targetheight"`
+	FilterHeaderHeight int32   `json:"filterheaderheight"`
+	Percent            float64 `json:"percent"`
+	Synced             bool    `json:"synced"`
+}
+
+// ScanBlocksBlockResult models a block of the scanblocks command whose compact
+// filter matched one of the scripts.
+type ScanBlocksBlockResult struct {
+	Height int32    `json:"height"`
+	Hash   string   `json:"hash"`
+	TxIDs  []string `json:"txids,omitempty"`
+}
+
+// ScanBlocksResult models the data from the scanblocks command.
+type ScanBlocksResult struct {
+	FromHeight     int32                   `json:"fromheight"`
+	ToHeight       int32                   `json:"toheight"`
+	RelevantBlocks []ScanBlocksBlockResult `json:"relevantblocks"`
+}
+
+// ImportWalletResult models the data from the importwallet command.
+type ImportWalletResult struct {
+	Imported     int   `json:"imported"`
+	Existing     int   `json:"existing"`
+	RescanHeight int32 `json:"rescanheight"`
+}
+
+// ConsolidateResult models the data from the consolidate command. Skipped is
+// set when there was nothing worth consolidating, in which case no
+// transaction was created.
+type ConsolidateResult struct {
+	TxID    string  `json:"txid,omitempty"`
+	Inputs  int     `json:"inputs"`
+	Amount  float64 `json:"amount"`
+	Fee     float64 `json:"fee"`
+	Skipped bool    `json:"skipped"`
+}
+
+// ExportedUtxoResult models an unspent output of the exportutxos command.
+type ExportedUtxoResult struct {
+	TxID           string  `json:"txid"`
+	Vout           uint32  `json:"vout"`
+	Address        string  `json:"address,omitempty"`
+	Account        string  `json:"account,omitempty"`
+	ScriptPubKey   string  `json:"scriptPubKey"`
+	Amount         float64 `json:"amount"`
+	Confirmations  int64   `json:"confirmations"`
+	Height         int32   `json:"height"`
+	Coinbase       bool    `json:"coinbase"`
+	Spendable      bool    `json:"spendable"`
+	DerivationPath string  `json:"derivationpath,omitempty"`
+	Locked         bool    `json:"locked"`
+	LockName       string  `json:"lockname,omitempty"`
+	Frozen         bool    `json:"frozen"`
+	FrozenUntil    int64   `json:"frozenuntil,omitempty"`
+}
+
+// ExportUtxosResult models the data from the exportutxos command. Count and
+// Amount cover all unspent outputs, Utxos only the requested page. Next is
+// set when there are more outputs, and is passed as after to get the next
+// page.
+type ExportUtxosResult struct {
+	Height    int32                `json:"height"`
+	BlockHash string               `json:"blockhash"`
+	Count     int                  `json:"count"`
+	Amount    float64              `json:"amount"`
+	Utxos     []ExportedUtxoResult `json:"utxos"`
+	Next      string               `json:"next,omitempty"`
+}
+
+// DescriptorResult models an output descriptor of the listdescriptors
+// command, describing the external or internal addresses of an account. Next
+// is the index of the next address which will be derived from the descriptor.
+type DescriptorResult struct {
+	Desc          string `json:"desc"`
+	Account       string `json:"account"`
+	AccountNumber uint32 `json:"accountnumber"`
+	Internal      bool   `json:"internal"`
+	Next          uint32 `json:"next"`
+}
+
+// ListDescriptorsResult models the data from the listdescriptors command.
+type ListDescriptorsResult struct {
+	Descriptors []DescriptorResult `json:"descriptors"`
+}
+
+// GetDescriptorInfoResult models the data from the getdescriptorinfo command.
+type GetDescriptorInfoResult struct {
+	Descriptor     string `json:"descriptor"`
+	Checksum       string `json:"checksum"`
+	IsRange        bool   `json:"isrange"`
+	IsSolvable     bool   `json:"issolvable"`
+	HasPrivateKeys bool   `json:"hasprivatekeys"`
+}
+
+// FeeHistoryResult models a transaction broadcast by the wallet of the
+// getfeehistory command, ConfirmationDelay is only set for confirmed and Age
+// only for unconfirmed transactions.
+type FeeHistoryResult struct {
+	TxID              string  `json:"txid"`
+	FeeRate           float64 `json:"feerate"`
+	Time              int64   `json:"time"`
+	Confirmed         bool    `json:"confirmed"`
+	ConfirmationDelay int32   `json:"confirmationdelay,omitempty"`
+	Age               int64   `json:"age,omitempty"`
+}
+
+// MempoolTxResult models the data of the mempooltx notification.
+type MempoolTxResult struct {
+	TxID      string   `json:"txid"`
+	Received  float64  `json:"received"`
+	Sent      float64  `json:"sent"`
+	Addresses []string `json:"addresses"`
+	Hex       string   `json:"hex"`
+}
+
+// WaitForSyncResult models the data from the waitforsync command.
+type WaitForSyncResult struct {
+	Synced        bool  `json:"synced"`
+	Height        int32 `json:"height"`
+	BackendHeight int32 `json:"backendheight,omitempty"`
+}
+
 // SetNetworkStewardVoteResult is the result of the wallet command setnetworkstewardvote
 type SetNetworkStewardVoteResult struct{}
 
@@ -243,6 +419,43 @@ type GetAddressBalancesResult struct {
 	OutputCount int32 `json:"outputcount"`
 }
 
+// GetAddressesByLabelResult models the data from the getaddressesbylabel
+// command. Results are keyed by address.
+type GetAddressesByLabelResult struct {
+	Purpose string `json:"purpose"`
+}
+
+// GetBalancesResult models the data from the getbalances command.
+type GetBalancesResult struct {
+	Total  float64 `json:"total"`
+	Stotal string  `json:"stotal"`
+
+	Spendable  float64 `json:"spendable"`
+	Sspendable string  `json:"sspendable"`
+
+	ImmatureReward  float64 `json:"immaturereward"`
+	SimmatureReward string  `json:"simmaturereward"`
+
+	Unconfirmed  float64 `json:"unconfirmed"`
+	Sunconfirmed string  `json:"sunconfirmed"`
+
+	Final  float64 `json:"final"`
+	Sfinal string  `json:"sfinal"`
+
+	OutputCount int32 `json:"outputcount"`
+
+	ImmatureRewardDetail []ImmatureRewardResult `json:"immaturerewarddetail,omitempty"`
+}
+
+// ImmatureRewardResult models the immature coinbase outputs which mature after
+// the same number of blocks, as part of the getbalances result.
+type ImmatureRewardResult struct {
+	BlocksToMaturity int32   `json:"blockstomaturity"`
+	Amount           float64 `json:"amount"`
+	Samount          string  `json:"samount"`
+	OutputCount      int32   `json:"outputcount"`
+}
+
 type MaintenanceStats struct {
 	// Burned           int
 	// Orphaned         int
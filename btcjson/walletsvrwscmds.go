@@ -113,6 +113,31 @@ func NewWalletIsLockedCmd() *WalletIsLockedCmd {
 	return &WalletIsLockedCmd{}
 }
 
+// NotifySyncProgressCmd defines the notifysyncprogress JSON-RPC command.
+type NotifySyncProgressCmd struct {
+	Interval *int `jsonrpcdefault:"5"`
+}
+
+// NewNotifySyncProgressCmd returns a new instance which can be used to issue a
+// notifysyncprogress JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewNotifySyncProgressCmd(interval *int) *NotifySyncProgressCmd {
+	return &NotifySyncProgressCmd{
+		Interval: interval,
+	}
+}
+
+// NotifyMempoolTxsCmd defines the notifymempooltxs JSON-RPC command.
+type NotifyMempoolTxsCmd struct{}
+
+// NewNotifyMempoolTxsCmd returns a new instance which can be used to issue a
+// notifymempooltxs JSON-RPC command.
+func NewNotifyMempoolTxsCmd() *NotifyMempoolTxsCmd {
+	return &NotifyMempoolTxsCmd{}
+}
+
 func init() {
 	// The commands in this file are only usable with a wallet server via
 	// websockets.
@@ -123,6 +148,8 @@ func init() {
 	MustRegisterCmd("getunconfirmedbalance", (*GetUnconfirmedBalanceCmd)(nil), flags)
 	MustRegisterCmd("listaddresstransactions", (*ListAddressTransactionsCmd)(nil), flags)
 	MustRegisterCmd("listalltransactions", (*ListAllTransactionsCmd)(nil), flags)
+	MustRegisterCmd("notifysyncprogress", (*NotifySyncProgressCmd)(nil), flags)
+	MustRegisterCmd("notifymempooltxs", (*NotifyMempoolTxsCmd)(nil), flags)
 	MustRegisterCmd("recoveraddresses", (*RecoverAddressesCmd)(nil), flags)
 	MustRegisterCmd("walletislocked", (*WalletIsLockedCmd)(nil), flags)
 }
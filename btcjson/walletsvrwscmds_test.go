@@ -189,6 +189,43 @@ func TestWalletSvrWsCmds(t *testing.T) {
 			marshaled:   `{"jsonrpc":"1.0","method":"walletislocked","params":[],"id":1}`,
 			unmarshaled: &btcjson.WalletIsLockedCmd{},
 		},
+		{
+			name: "notifysyncprogress",
+			newCmd: func() (interface{}, er.R) {
+				return btcjson.NewCmd("notifysyncprogress")
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewNotifySyncProgressCmd(nil)
+			},
+			marshaled: `{"jsonrpc":"1.0","method":"notifysyncprogress","params":[],"id":1}`,
+			unmarshaled: &btcjson.NotifySyncProgressCmd{
+				Interval: btcjson.Int(5),
+			},
+		},
+		{
+			name: "notifysyncprogress optional",
+			newCmd: func() (interface{}, er.R) {
+				return btcjson.NewCmd("notifysyncprogress", 30)
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewNotifySyncProgressCmd(btcjson.Int(30))
+			},
+			marshaled: `{"jsonrpc":"1.0","method":"notifysyncprogress","params":[30],"id":1}`,
+			unmarshaled: &btcjson.NotifySyncProgressCmd{
+				Interval: btcjson.Int(30),
+			},
+		},
+		{
+			name: "notifymempooltxs",
+			newCmd: func() (interface{}, er.R) {
+				return btcjson.NewCmd("notifymempooltxs")
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewNotifyMempoolTxsCmd()
+			},
+			marshaled:   `{"jsonrpc":"1.0","method":"notifymempooltxs","params":[],"id":1}`,
+			unmarshaled: &btcjson.NotifyMempoolTxsCmd{},
+		},
 	}
 
 	t.Logf("Running %d tests", len(tests))
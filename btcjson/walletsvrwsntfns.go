@@ -23,6 +23,14 @@ const (
 	// NewTxNtfnMethod is the method used to notify that a wallet server has
 	// added a new transaction to the transaction store.
 	NewTxNtfnMethod = "newtx"
+
+	// SyncProgressNtfnMethod is the method used to periodically notify
+	// the progress of the chain sync.
+	SyncProgressNtfnMethod = "syncprogress"
+
+	// MempoolTxNtfnMethod is the method used to notify that a transaction
+	// paying to or spending from the wallet was accepted to the mempool.
+	MempoolTxNtfnMethod = "mempooltx"
 )
 
 // AccountBalanceNtfn defines the accountbalance JSON-RPC notification.
@@ -83,6 +91,32 @@ func NewNewTxNtfn(account string, details ListTransactionsResult) *NewTxNtfn {
 	}
 }
 
+// SyncProgressNtfn defines the syncprogress JSON-RPC notification.
+type SyncProgressNtfn struct {
+	Progress SyncProgressResult
+}
+
+// NewSyncProgressNtfn returns a new instance which can be used to issue a
+// syncprogress JSON-RPC notification.
+func NewSyncProgressNtfn(progress SyncProgressResult) *SyncProgressNtfn {
+	return &SyncProgressNtfn{
+		Progress: progress,
+	}
+}
+
+// MempoolTxNtfn defines the mempooltx JSON-RPC notification.
+type MempoolTxNtfn struct {
+	Details MempoolTxResult
+}
+
+// NewMempoolTxNtfn returns a new instance which can be used to issue a
+// mempooltx JSON-RPC notification.
+func NewMempoolTxNtfn(details MempoolTxResult) *MempoolTxNtfn {
+	return &MempoolTxNtfn{
+		Details: details,
+	}
+}
+
 func init() {
 	// The commands in this file are only usable with a wallet server via
 	// websockets and are notifications.
@@ -92,4 +126,6 @@ func init() {
 	MustRegisterCmd(BtcdConnectedNtfnMethod, (*BtcdConnectedNtfn)(nil), flags)
 	MustRegisterCmd(WalletLockStateNtfnMethod, (*WalletLockStateNtfn)(nil), flags)
 	MustRegisterCmd(NewTxNtfnMethod, (*NewTxNtfn)(nil), flags)
+	MustRegisterCmd(SyncProgressNtfnMethod, (*SyncProgressNtfn)(nil), flags)
+	MustRegisterCmd(MempoolTxNtfnMethod, (*MempoolTxNtfn)(nil), flags)
 }
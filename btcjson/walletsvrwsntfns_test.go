@@ -116,6 +116,54 @@ func TestWalletSvrWsNtfns(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "syncprogress",
+			newNtfn: func() (interface{}, er.R) {
+				return btcjson.NewCmd("syncprogress", `{"currentheight":150,"targetheight":200,"filterheaderheight":150,"percent":75,"synced":false}`)
+			},
+			staticNtfn: func() interface{} {
+				return btcjson.NewSyncProgressNtfn(btcjson.SyncProgressResult{
+					CurrentHeight:      150,
+					TargetHeight:       200,
+					FilterHeaderHeight: 150,
+					Percent:            75,
+					Synced:             false,
+				})
+			},
+			marshaled: `{"jsonrpc":"1.0","method":"syncprogress","params":[{"currentheight":150,"targetheight":200,"filterheaderheight":150,"percent":75,"synced":false}],"id":null}`,
+			unmarshaled: &btcjson.SyncProgressNtfn{
+				Progress: btcjson.SyncProgressResult{
+					CurrentHeight:      150,
+					TargetHeight:       200,
+					FilterHeaderHeight: 150,
+					Percent:            75,
+					Synced:             false,
+				},
+			},
+		},
+		{
+			name: "mempooltx",
+			newNtfn: func() (interface{}, er.R) {
+				return btcjson.NewCmd("mempooltx", `{"txid":"456","received":1.5,"sent":0,"addresses":["1Address"],"hex":"0100"}`)
+			},
+			staticNtfn: func() interface{} {
+				return btcjson.NewMempoolTxNtfn(btcjson.MempoolTxResult{
+					TxID:      "456",
+					Received:  1.5,
+					Addresses: []string{"1Address"},
+					Hex:       "0100",
+				})
+			},
+			marshaled: `{"jsonrpc":"1.0","method":"mempooltx","params":[{"txid":"456","received":1.5,"sent":0,"addresses":["1Address"],"hex":"0100"}],"id":null}`,
+			unmarshaled: &btcjson.MempoolTxNtfn{
+				Details: btcjson.MempoolTxResult{
+					TxID:      "456",
+					Received:  1.5,
+					Addresses: []string{"1Address"},
+					Hex:       "0100",
+				},
+			},
+		},
 	}
 
 	t.Logf("Running %d tests", len(tests))
@@ -15,21 +15,82 @@ const charset = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
 
 var gen = []int{0x3b6a57b2, 0x26508e6d, 0x1ea119fa, 0x3d4233dd, 0x2a1462b3}
 
+// Version is the checksum variant of a bech32 string, which is the constant
+// the checksum polymod is xored with.
+type Version int
+
+const (
+	// Version0 is the original bech32 checksum defined in BIP 173, used
+	// for segwit version 0 addresses.
+	Version0 Version = 1
+
+	// VersionM is the bech32m checksum defined in BIP 350, used for segwit
+	// version 1 and later addresses.
+	VersionM Version = 0x2bc830a3
+
+	// VersionUnknown is returned when the checksum matches neither
+	// variant.
+	VersionUnknown Version = -1
+)
+
 // Decode decodes a bech32 encoded string, returning the human-readable
-// part and the data part excluding the checksum.
+// part and the data part excluding the checksum. Only the original BIP 173
+// checksum is accepted, use DecodeGeneric to also accept bech32m strings.
 func Decode(bech string) (string, []byte, er.R) {
+	hrp, decoded, version, err := decode(bech)
+	if err != nil {
+		return "", nil, err
+	}
+	if version != Version0 {
+		moreInfo := ""
+		checksum := bech[len(bech)-6:]
+		expected, err := toChars(bech32Checksum(hrp,
+			decoded[:len(decoded)-6], Version0))
+		if err == nil {
+			moreInfo = fmt.Sprintf("Expected %v, got %v.",
+				expected, checksum)
+		}
+		return "", nil, er.Errorf("checksum failed. " + moreInfo)
+	}
+
+	// We exclude the last 6 bytes, which is the checksum.
+	return hrp, decoded[:len(decoded)-6], nil
+}
+
+// DecodeGeneric decodes a bech32 or bech32m encoded string, returning the
+// human-readable part, the data part excluding the checksum and the checksum
+// variant. Which variant is valid depends on the data, for addresses it is
+// decided by the witness version.
+func DecodeGeneric(bech string) (string, []byte, Version, er.R) {
+	hrp, decoded, version, err := decode(bech)
+	if err != nil {
+		return "", nil, VersionUnknown, err
+	}
+	if version == VersionUnknown {
+		return "", nil, VersionUnknown, er.Errorf("checksum failed, " +
+			"neither bech32 nor bech32m")
+	}
+
+	// We exclude the last 6 bytes, which is the checksum.
+	return hrp, decoded[:len(decoded)-6], version, nil
+}
+
+// decode decodes a bech32 encoded string, returning the human-readable part,
+// the data part including the checksum and the checksum variant, which is
+// VersionUnknown if the checksum is invalid.
+func decode(bech string) (string, []byte, Version, er.R) {
 	// The maximum allowed length for a bech32 string is 90. It must also
 	// be at least 8 characters, since it needs a non-empty HRP, a
 	// separator, and a 6 character checksum.
 	if len(bech) < 8 || len(bech) > 90 {
-		return "", nil, er.Errorf("invalid bech32 string length %d",
-			len(bech))
+		return "", nil, VersionUnknown, er.Errorf("invalid bech32 "+
+			"string length %d", len(bech))
 	}
 	// Only	ASCII characters between 33 and 126 are allowed.
 	for i := 0; i < len(bech); i++ {
 		if bech[i] < 33 || bech[i] > 126 {
-			return "", nil, er.Errorf("invalid character in "+
-				"string: '%c'", bech[i])
+			return "", nil, VersionUnknown, er.Errorf("invalid "+
+				"character in string: '%c'", bech[i])
 		}
 	}
 
@@ -37,8 +98,8 @@ func Decode(bech string) (string, []byte, er.R) {
 	lower := strings.ToLower(bech)
 	upper := strings.ToUpper(bech)
 	if bech != lower && bech != upper {
-		return "", nil, er.Errorf("string not all lowercase or all " +
-			"uppercase")
+		return "", nil, VersionUnknown, er.Errorf("string not all " +
+			"lowercase or all uppercase")
 	}
 
 	// We'll work with the lowercase string from now on.
@@ -50,7 +111,7 @@ func Decode(bech string) (string, []byte, er.R) {
 	// or if the string is more than 90 characters in total.
 	one := strings.LastIndexByte(bech, '1')
 	if one < 1 || one+7 > len(bech) {
-		return "", nil, er.Errorf("invalid index of 1")
+		return "", nil, VersionUnknown, er.Errorf("invalid index of 1")
 	}
 
 	// The human-readable part is everything before the last '1'.
@@ -61,32 +122,32 @@ func Decode(bech string) (string, []byte, er.R) {
 	// 'charset'.
 	decoded, err := toBytes(data)
 	if err != nil {
-		return "", nil, er.Errorf("failed converting data to bytes: "+
-			"%v", err)
+		return "", nil, VersionUnknown, er.Errorf("failed converting "+
+			"data to bytes: %v", err)
 	}
 
-	if !bech32VerifyChecksum(hrp, decoded) {
-		moreInfo := ""
-		checksum := bech[len(bech)-6:]
-		expected, err := toChars(bech32Checksum(hrp,
-			decoded[:len(decoded)-6]))
-		if err == nil {
-			moreInfo = fmt.Sprintf("Expected %v, got %v.",
-				expected, checksum)
-		}
-		return "", nil, er.Errorf("checksum failed. " + moreInfo)
-	}
-
-	// We exclude the last 6 bytes, which is the checksum.
-	return hrp, decoded[:len(decoded)-6], nil
+	return hrp, decoded, bech32ChecksumVersion(hrp, decoded), nil
 }
 
 // Encode encodes a byte slice into a bech32 string with the
 // human-readable part hrb. Note that the bytes must each encode 5 bits
 // (base32).
 func Encode(hrp string, data []byte) (string, er.R) {
+	return encode(hrp, data, Version0)
+}
+
+// EncodeM encodes a byte slice into a bech32m string with the human-readable
+// part hrp, using the checksum defined in BIP 350. Note that the bytes must
+// each encode 5 bits (base32).
+func EncodeM(hrp string, data []byte) (string, er.R) {
+	return encode(hrp, data, VersionM)
+}
+
+// encode encodes a byte slice into a string with the checksum variant given
+// by version.
+func encode(hrp string, data []byte, version Version) (string, er.R) {
 	// Calculate the checksum of the data and append it at the end.
-	checksum := bech32Checksum(hrp, data)
+	checksum := bech32Checksum(hrp, data, version)
 	combined := append(data, checksum...)
 
 	// The resulting bech32 string is the concatenation of the hrp, the
@@ -204,8 +265,9 @@ func ConvertBits(data []byte, fromBits, toBits uint8, pad bool) ([]byte, er.R) {
 	return regrouped, nil
 }
 
-// For more details on the checksum calculation, please refer to BIP 173.
-func bech32Checksum(hrp string, data []byte) []byte {
+// For more details on the checksum calculation, please refer to BIP 173 and,
+// for the bech32m variant, BIP 350.
+func bech32Checksum(hrp string, data []byte, version Version) []byte {
 	// Convert the bytes to list of integers, as this is needed for the
 	// checksum calculation.
 	integers := make([]int, len(data))
@@ -214,7 +276,7 @@ func bech32Checksum(hrp string, data []byte) []byte {
 	}
 	values := append(bech32HrpExpand(hrp), integers...)
 	values = append(values, []int{0, 0, 0, 0, 0, 0}...)
-	polymod := bech32Polymod(values) ^ 1
+	polymod := bech32Polymod(values) ^ int(version)
 	var res []byte
 	for i := 0; i < 6; i++ {
 		res = append(res, byte((polymod>>uint(5*(5-i)))&31))
@@ -250,12 +312,22 @@ func bech32HrpExpand(hrp string) []int {
 	return v
 }
 
-// For more details on the checksum verification, please refer to BIP 173.
-func bech32VerifyChecksum(hrp string, data []byte) bool {
+// bech32ChecksumVersion verifies the checksum of the data, returning which
+// variant it matches or VersionUnknown if it matches neither.
+// For more details on the checksum verification, please refer to BIP 173 and
+// BIP 350.
+func bech32ChecksumVersion(hrp string, data []byte) Version {
 	integers := make([]int, len(data))
 	for i, b := range data {
 		integers[i] = int(b)
 	}
 	concat := append(bech32HrpExpand(hrp), integers...)
-	return bech32Polymod(concat) == 1
+	switch Version(bech32Polymod(concat)) {
+	case Version0:
+		return Version0
+	case VersionM:
+		return VersionM
+	default:
+		return VersionUnknown
+	}
 }
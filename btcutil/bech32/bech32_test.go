@@ -67,3 +67,71 @@ func TestBech32(t *testing.T) {
 		}
 	}
 }
+
+// TestBech32M tests the bech32m test vectors of BIP 350, and that strings are
+// only accepted with the checksum variant they were encoded with.
+func TestBech32M(t *testing.T) {
+	tests := []struct {
+		str   string
+		valid bool
+	}{
+		{"A1LQFN3A", true},
+		{"a1lqfn3a", true},
+		{"an83characterlonghumanreadablepartthatcontainsthetheexcludedcharactersbioandnumber11sg7hg6", true},
+		{"abcdef1l7aum6echk45nj3s0wdvt2fg8x9yrzpqzd3ryx", true},
+		{"11llllllllllllllllllllllllllllllllllllllllllllllllllllllllllllllllllllllllllllllllllludsr8", true},
+		{"split1checkupstagehandshakeupstreamerranterredcaperredlc445v", true},
+		{"?1v759aa", true},
+		{"M1VUXWEZ", false},     // invalid checksum
+		{"16plkw9", false},      // empty hrp
+		{"1p2gdwpf", false},     // empty hrp
+		{"qyrz8wqd2c9m", false}, // no separator character
+		{"y1b0jsk6g", false},    // invalid data character
+		{"lt1igcx5c0", false},   // invalid data character
+		{"in1muywd", false},     // too short checksum
+		{"mm1crxm3i", false},    // invalid character in checksum
+		{"au1s5cgom", false},    // invalid character in checksum
+	}
+
+	for _, test := range tests {
+		hrp, decoded, version, err := bech32.DecodeGeneric(test.str)
+		if !test.valid {
+			if err == nil {
+				t.Errorf("expected decoding to fail for "+
+					"invalid string %v", test.str)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("expected string to be valid bech32m: %v", err)
+			continue
+		}
+		if version != bech32.VersionM {
+			t.Errorf("expected bech32m checksum for %v", test.str)
+		}
+
+		// A bech32m string isn't valid bech32.
+		if _, _, err := bech32.Decode(test.str); err == nil {
+			t.Errorf("expected bech32 decoding of %v to fail",
+				test.str)
+		}
+
+		encoded, err := bech32.EncodeM(hrp, decoded)
+		if err != nil {
+			t.Errorf("encoding failed: %v", err)
+		}
+		if encoded != strings.ToLower(test.str) {
+			t.Errorf("expected data to encode to %v, but got %v",
+				test.str, encoded)
+		}
+	}
+
+	// Bech32 strings are decoded with their own checksum variant.
+	_, _, version, err := bech32.DecodeGeneric("A12UEL5L")
+	if err != nil {
+		t.Fatalf("expected string to be valid bech32: %v", err)
+	}
+	if version != bech32.Version0 {
+		t.Fatal("expected bech32 checksum")
+	}
+}
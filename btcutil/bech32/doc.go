@@ -10,6 +10,10 @@ Bech32 strings consist of a human-readable part (hrp), followed by the
 separator 1, then a checksummed data part encoded using the 32 characters
 "qpzry9x8gf2tvdw0s3jn54khce6mua7l".
 
+The bech32m variant specified in BIP 350 only differs in the checksum constant
+and is used for segwit version 1 and later addresses.
+
 More info: https://github.com/bitcoin/bips/blob/master/bip-0173.mediawiki
+and https://github.com/bitcoin/bips/blob/master/bip-0350.mediawiki
 */
 package bech32
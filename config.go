@@ -10,7 +10,6 @@ import (
 	"encoding/binary"
 	"encoding/hex"
 	"fmt"
-	"io/ioutil"
 	"math/rand"
 	"net"
 	"os"
@@ -32,6 +31,7 @@ import (
 	"github.com/pkt-cash/pktd/mempool"
 	"github.com/pkt-cash/pktd/peer"
 	"github.com/pkt-cash/pktd/pktlog/log"
+	"github.com/pkt-cash/pktd/rpcclient"
 )
 
 const (
@@ -115,6 +115,7 @@ type config struct {
 	RPCListeners         []string      `long:"rpclisten" description:"Add an interface/port to listen for RPC connections (default port: 8334, testnet: 18334)"`
 	RPCCert              string        `long:"rpccert" description:"File containing the certificate file"`
 	RPCKey               string        `long:"rpckey" description:"File containing the certificate key"`
+	RPCCookieKey         string        `long:"rpccookiekey" description:"File containing a secret key used to add an HMAC to the generated .pktcookie file, so that clients reading this option from pktd.conf, like pktwallet and pktctl, can detect a modified cookie file (the key is generated if the file does not exist)"`
 	RPCMaxClients        int           `long:"rpcmaxclients" description:"Max number of RPC clients for standard connections"`
 	RPCMaxWebsockets     int           `long:"rpcmaxwebsockets" description:"Max number of RPC websocket connections"`
 	RPCMaxConcurrentReqs int           `long:"rpcmaxconcurrentreqs" description:"Max number of concurrent RPC requests that may be processed concurrently"`
@@ -681,11 +682,14 @@ func loadConfig() (*config, []string, er.R) {
 		}
 		cfg.RPCUser = "__PKT_COOKIE__"
 		cfg.RPCPass = hex.EncodeToString(buf[:])
-		cookie := cfg.RPCUser + ":" + cfg.RPCPass
-		if errr := ioutil.WriteFile(cookiePath, []byte(cookie), 0o600); errr != nil {
-			err := er.E(errr)
-			err.AddMessage("Could not write .pktcookie file")
-			return nil, nil, err
+		if cfg.RPCCookieKey != "" {
+			cfg.RPCCookieKey = cleanAndExpandPath(cfg.RPCCookieKey)
+		}
+		err := rpcclient.WriteCookieFile(cookiePath, cfg.RPCUser, cfg.RPCPass,
+			cfg.RPCCookieKey)
+		if err != nil {
+			return nil, nil, er.Errorf("Could not write .pktcookie file: %v",
+				err)
 		}
 	}
 
@@ -49,6 +49,11 @@ Application Options:
                             (default port: 8334, testnet: 18334)
       --rpccert=            File containing the certificate file
       --rpckey=             File containing the certificate key
+      --rpccookiekey=       File containing a secret key used to add an HMAC to
+                            the generated .pktcookie file, so that clients
+                            reading this option from pktd.conf, like pktwallet
+                            and pktctl, can detect a modified cookie file (the
+                            key is generated if the file does not exist)
       --rpcmaxclients=      Max number of RPC clients for standard connections
                             (10)
       --rpcmaxwebsockets=   Max number of RPC websocket connections (25)
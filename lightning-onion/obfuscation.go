@@ -116,6 +116,14 @@ func (c *Circuit) encode(w io.Writer) error {
 	return nil
 }
 
+// SharedSecrets derives the shared secret of each hop in the circuit's payment
+// path, in path order. Anyone holding these secrets is able to decrypt the
+// failure messages returned for the onion, so they should be handled with the
+// same care as the session key itself.
+func (c *Circuit) SharedSecrets() ([]Hash256, er.R) {
+	return generateSharedSecrets(c.PaymentPath, c.SessionKey)
+}
+
 // OnionErrorDecrypter is a struct that's used to decrypt onion errors in
 // response to failed HTLC routing attempts according to BOLT#4.
 type OnionErrorDecrypter struct {
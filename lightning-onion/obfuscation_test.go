@@ -291,3 +291,46 @@ func TestOnionFailureSpecVector(t *testing.T) {
 			"the path we received an error")
 	}
 }
+
+// TestCircuitSharedSecrets checks that the shared secrets derived from a
+// circuit match the ones specified for the spec test vector, in path order.
+func TestCircuitSharedSecrets(t *testing.T) {
+	paymentPath, err := getSpecPubKeys()
+	if err != nil {
+		t.Fatalf("unable to get specification public keys: %v", err)
+	}
+
+	sessionKey, err := getSpecSessionKey()
+	if err != nil {
+		t.Fatalf("unable to get specification session key: %v", err)
+	}
+
+	circuit := &Circuit{
+		SessionKey:  sessionKey,
+		PaymentPath: paymentPath,
+	}
+	sharedSecrets, err := circuit.SharedSecrets()
+	if err != nil {
+		t.Fatalf("unable to derive shared secrets: %v", err)
+	}
+	if len(sharedSecrets) != len(paymentPath) {
+		t.Fatalf("expected %d shared secrets, got %d",
+			len(paymentPath), len(sharedSecrets))
+	}
+
+	// The spec vector lists the secrets starting from the erring (last)
+	// hop, so walk it backwards.
+	for i, test := range onionErrorData {
+		expected, err := util.DecodeHex(test.sharedSecret)
+		if err != nil {
+			t.Fatalf("unable to decode spec shared secret: %v",
+				err)
+		}
+
+		got := sharedSecrets[len(sharedSecrets)-1-i]
+		if !bytes.Equal(expected, got[:]) {
+			t.Fatalf("shared secret %d mismatch: expected %x, "+
+				"got %x", i, expected, got[:])
+		}
+	}
+}
@@ -0,0 +1,307 @@
+package chanbackup
+
+import (
+	"bytes"
+	"sync"
+
+	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/pktlog/log"
+	"github.com/pkt-cash/pktd/wire"
+)
+
+// DefaultBackupSubscriptionBuffer is the default number of backup events that
+// are buffered for a subscriber before it is considered too slow and dropped.
+const DefaultBackupSubscriptionBuffer = 100
+
+var (
+	// ErrBackupSubscriberTooSlow is the reason a backup subscription is
+	// terminated when the subscriber fails to keep up with the events.
+	ErrBackupSubscriberTooSlow = er.GenericErrorType.CodeWithDetail(
+		"ErrBackupSubscriberTooSlow",
+		"backup subscriber fell too far behind")
+
+	// ErrBackupNotifierStopped is the reason a backup subscription is
+	// terminated when the BackupNotifier shuts down.
+	ErrBackupNotifierStopped = er.GenericErrorType.CodeWithDetail(
+		"ErrBackupNotifierStopped", "backup notifier stopped")
+)
+
+// BackupEventType is the kind of change a BackupEvent describes.
+type BackupEventType uint8
+
+const (
+	// BackupUpdated signals that a channel was opened, or that the backup
+	// of an existing channel changed.
+	BackupUpdated BackupEventType = iota
+
+	// BackupRemoved signals that a channel was closed and its backup is
+	// no longer needed.
+	BackupRemoved
+)
+
+// String returns a human readable name of the event type.
+func (t BackupEventType) String() string {
+	switch t {
+	case BackupUpdated:
+		return "updated"
+	case BackupRemoved:
+		return "removed"
+	default:
+		return "unknown"
+	}
+}
+
+// BackupEvent describes a change to the backup of a single channel.
+type BackupEvent struct {
+	// Type is the kind of change.
+	Type BackupEventType
+
+	// ChanPoint is the funding outpoint of the channel.
+	ChanPoint wire.OutPoint
+
+	// Single is the latest backup of the channel. It is nil for
+	// BackupRemoved events.
+	Single *Single
+}
+
+// BackupSubscription is an active subscription to per channel backup events.
+type BackupSubscription struct {
+	// Updates delivers the backup events. It is closed once the
+	// subscription ends, after which Err reports why.
+	Updates <-chan BackupEvent
+
+	updates  chan BackupEvent
+	id       uint64
+	notifier *BackupNotifier
+	err      er.R
+}
+
+// Err returns the reason the subscription ended. It must only be called once
+// the Updates channel has been closed, and returns nil if the subscription was
+// cancelled by the subscriber.
+func (s *BackupSubscription) Err() er.R {
+	return s.err
+}
+
+// Cancel ends the subscription and closes the Updates channel.
+func (s *BackupSubscription) Cancel() {
+	s.notifier.mu.Lock()
+	defer s.notifier.mu.Unlock()
+
+	s.notifier.removeClient(s.id, nil)
+}
+
+// BackupNotifier is the push based counterpart of FetchStaticChanBackups. It
+// follows the channel events of a ChannelNotifier and tells its subscribers
+// about every channel whose backup was created, changed or became obsolete.
+//
+// Each subscriber has a buffer of events. A subscriber that lets its buffer
+// fill up is dropped rather than blocking the notifier or the other
+// subscribers; its Updates channel is closed and Err returns
+// ErrBackupSubscriberTooSlow. It must then resubscribe to learn the current
+// set of backups again.
+type BackupNotifier struct {
+	started sync.Once
+	stopped sync.Once
+
+	// chanEvents is an active subscription to receive new channel state
+	// over.
+	chanEvents *ChannelSubscription
+
+	// bufferSize is the number of events buffered for each subscriber.
+	bufferSize int
+
+	// mu guards the fields below.
+	mu sync.Mutex
+
+	// backupState are the set of SCBs for all open channels we know of.
+	backupState map[wire.OutPoint]Single
+
+	clients      map[uint64]*BackupSubscription
+	nextClientID uint64
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+// NewBackupNotifier creates a new BackupNotifier given the starting set of
+// channels and the ChannelNotifier to learn about channel changes from. Each
+// subscriber buffers up to bufferSize events, or DefaultBackupSubscriptionBuffer
+// if bufferSize is zero.
+func NewBackupNotifier(startingChans []Single, chanNotifier ChannelNotifier,
+	bufferSize int) (*BackupNotifier, er.R) {
+
+	knownChans := make(map[wire.OutPoint]struct{})
+	backupState := make(map[wire.OutPoint]Single)
+	for _, chanBackup := range startingChans {
+		knownChans[chanBackup.FundingOutpoint] = struct{}{}
+		backupState[chanBackup.FundingOutpoint] = chanBackup
+	}
+	chanEvents, err := chanNotifier.SubscribeChans(knownChans)
+	if err != nil {
+		return nil, err
+	}
+
+	if bufferSize <= 0 {
+		bufferSize = DefaultBackupSubscriptionBuffer
+	}
+
+	return &BackupNotifier{
+		chanEvents:  chanEvents,
+		bufferSize:  bufferSize,
+		backupState: backupState,
+		clients:     make(map[uint64]*BackupSubscription),
+		quit:        make(chan struct{}),
+	}, nil
+}
+
+// Start starts the BackupNotifier.
+func (n *BackupNotifier) Start() er.R {
+	n.started.Do(func() {
+		log.Infof("Starting chanbackup.BackupNotifier")
+
+		n.wg.Add(1)
+		go n.eventHandler()
+	})
+	return nil
+}
+
+// Stop shuts down the BackupNotifier, ending all subscriptions with
+// ErrBackupNotifierStopped.
+func (n *BackupNotifier) Stop() er.R {
+	n.stopped.Do(func() {
+		log.Infof("Stopping chanbackup.BackupNotifier")
+
+		close(n.quit)
+		n.wg.Wait()
+
+		n.mu.Lock()
+		for id := range n.clients {
+			n.removeClient(id, ErrBackupNotifierStopped.Default())
+		}
+		n.mu.Unlock()
+	})
+	return nil
+}
+
+// SubscribeBackups returns a new subscription to backup events, along with the
+// backups of all currently open channels. No events are missed between the
+// snapshot and the subscription.
+func (n *BackupNotifier) SubscribeBackups() (*BackupSubscription, []Single) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	updates := make(chan BackupEvent, n.bufferSize)
+	sub := &BackupSubscription{
+		Updates:  updates,
+		updates:  updates,
+		id:       n.nextClientID,
+		notifier: n,
+	}
+	n.nextClientID++
+
+	select {
+	case <-n.quit:
+		sub.err = ErrBackupNotifierStopped.Default()
+		close(updates)
+		return sub, nil
+	default:
+	}
+	n.clients[sub.id] = sub
+
+	backups := make([]Single, 0, len(n.backupState))
+	for _, backup := range n.backupState {
+		backups = append(backups, backup)
+	}
+	return sub, backups
+}
+
+// removeClient ends the subscription with the given id for the given reason.
+// The caller must hold mu.
+func (n *BackupNotifier) removeClient(id uint64, reason er.R) {
+	sub, ok := n.clients[id]
+	if !ok {
+		return
+	}
+	delete(n.clients, id)
+	sub.err = reason
+	close(sub.updates)
+}
+
+// dispatch sends the event to every subscriber, dropping those whose buffer is
+// full. The caller must hold mu.
+func (n *BackupNotifier) dispatch(event BackupEvent) {
+	for id, sub := range n.clients {
+		select {
+		case sub.updates <- event:
+		default:
+			log.Warnf("Dropping backup subscriber %d, it fell more "+
+				"than %d events behind", id, n.bufferSize)
+			n.removeClient(id, ErrBackupSubscriberTooSlow.Default())
+		}
+	}
+}
+
+// eventHandler is the main goroutine of the BackupNotifier. It turns channel
+// events into backup events for the subscribers.
+func (n *BackupNotifier) eventHandler() {
+	defer n.chanEvents.Cancel()
+	defer n.wg.Done()
+
+	for {
+		select {
+		case chanUpdate := <-n.chanEvents.ChanUpdates:
+			n.mu.Lock()
+			for _, newChan := range chanUpdate.NewChans {
+				single := NewSingle(
+					newChan.OpenChannel, newChan.Addrs,
+				)
+				chanPoint := single.FundingOutpoint
+
+				// A channel we already know of is announced
+				// again when it confirms or its peer's
+				// addresses change. Only tell subscribers if
+				// the backup actually changed.
+				old, ok := n.backupState[chanPoint]
+				if ok && singlesEqual(&old, &single) {
+					continue
+				}
+				n.backupState[chanPoint] = single
+
+				n.dispatch(BackupEvent{
+					Type:      BackupUpdated,
+					ChanPoint: chanPoint,
+					Single:    &single,
+				})
+			}
+
+			for _, closedChan := range chanUpdate.ClosedChans {
+				if _, ok := n.backupState[closedChan]; !ok {
+					continue
+				}
+				delete(n.backupState, closedChan)
+
+				n.dispatch(BackupEvent{
+					Type:      BackupRemoved,
+					ChanPoint: closedChan,
+				})
+			}
+			n.mu.Unlock()
+
+		case <-n.quit:
+			return
+		}
+	}
+}
+
+// singlesEqual returns whether two backups serialize to the same bytes.
+func singlesEqual(a, b *Single) bool {
+	var bufA, bufB bytes.Buffer
+	if err := a.Serialize(&bufA); err != nil {
+		return false
+	}
+	if err := b.Serialize(&bufB); err != nil {
+		return false
+	}
+	return bytes.Equal(bufA.Bytes(), bufB.Bytes())
+}
@@ -0,0 +1,209 @@
+package chanbackup
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/pkt-cash/pktd/wire"
+)
+
+func sendChanEvent(t *testing.T, notifier *mockChannelNotifier,
+	event ChannelEvent) {
+
+	select {
+	case notifier.chanEvents <- event:
+	case <-time.After(time.Second * 5):
+		t.Fatalf("backup notifier didn't read channel event")
+	}
+}
+
+func assertBackupEvent(t *testing.T, sub *BackupSubscription,
+	eventType BackupEventType, chanPoint wire.OutPoint) *BackupEvent {
+
+	select {
+	case event, ok := <-sub.Updates:
+		if !ok {
+			t.Fatalf("subscription ended: %v", sub.Err())
+		}
+		if event.Type != eventType || event.ChanPoint != chanPoint {
+			t.Fatalf("expected %v event for %v, got %v event for %v",
+				eventType, chanPoint, event.Type, event.ChanPoint)
+		}
+		return &event
+	case <-time.After(time.Second * 5):
+		t.Fatalf("no %v event for %v", eventType, chanPoint)
+	}
+	return nil
+}
+
+func assertNoBackupEvent(t *testing.T, sub *BackupSubscription) {
+	select {
+	case event := <-sub.Updates:
+		t.Fatalf("unexpected %v event for %v", event.Type,
+			event.ChanPoint)
+	case <-time.After(time.Millisecond * 100):
+	}
+}
+
+// TestBackupNotifier tests that subscribers are told about new, changed and
+// closed channels, and that unchanged channels don't produce events.
+func TestBackupNotifier(t *testing.T) {
+	t.Parallel()
+
+	initialChan, err := genRandomOpenChannelShell()
+	if err != nil {
+		t.Fatalf("unable to make test chan: %v", err)
+	}
+	initialSingle := NewSingle(initialChan, nil)
+
+	chanNotifier := newMockChannelNotifier()
+	notifier, err := NewBackupNotifier(
+		[]Single{initialSingle}, chanNotifier, 0,
+	)
+	if err != nil {
+		t.Fatalf("unable to make backup notifier: %v", err)
+	}
+	if err := notifier.Start(); err != nil {
+		t.Fatalf("unable to start backup notifier: %v", err)
+	}
+	defer notifier.Stop()
+
+	// The subscriber first learns about the channels we started with.
+	sub, backups := notifier.SubscribeBackups()
+	defer sub.Cancel()
+	if len(backups) != 1 ||
+		backups[0].FundingOutpoint != initialChan.FundingOutpoint {
+
+		t.Fatalf("unexpected initial backups: %v", backups)
+	}
+
+	// A newly opened channel is sent along with its backup.
+	newChan, err := genRandomOpenChannelShell()
+	if err != nil {
+		t.Fatalf("unable to make test chan: %v", err)
+	}
+	sendChanEvent(t, chanNotifier, ChannelEvent{
+		NewChans: []ChannelWithAddrs{{OpenChannel: newChan}},
+	})
+	event := assertBackupEvent(
+		t, sub, BackupUpdated, newChan.FundingOutpoint,
+	)
+	if event.Single == nil ||
+		event.Single.FundingOutpoint != newChan.FundingOutpoint {
+
+		t.Fatalf("unexpected backup in event: %v", event.Single)
+	}
+
+	// Announcing the same channel again doesn't change its backup.
+	sendChanEvent(t, chanNotifier, ChannelEvent{
+		NewChans: []ChannelWithAddrs{{OpenChannel: newChan}},
+	})
+	assertNoBackupEvent(t, sub)
+
+	// A new address for the peer does.
+	addr := &net.TCPAddr{IP: net.ParseIP("127.0.0.1"), Port: 9735}
+	sendChanEvent(t, chanNotifier, ChannelEvent{
+		NewChans: []ChannelWithAddrs{{
+			OpenChannel: newChan,
+			Addrs:       []net.Addr{addr},
+		}},
+	})
+	event = assertBackupEvent(
+		t, sub, BackupUpdated, newChan.FundingOutpoint,
+	)
+	if len(event.Single.Addresses) != 1 ||
+		event.Single.Addresses[0].String() != addr.String() {
+
+		t.Fatalf("expected address %v, got %v", addr,
+			event.Single.Addresses)
+	}
+
+	// Closing a channel removes its backup, closing an unknown channel is
+	// ignored.
+	sendChanEvent(t, chanNotifier, ChannelEvent{
+		ClosedChans: []wire.OutPoint{
+			initialChan.FundingOutpoint, {Index: 99},
+		},
+	})
+	event = assertBackupEvent(
+		t, sub, BackupRemoved, initialChan.FundingOutpoint,
+	)
+	if event.Single != nil {
+		t.Fatalf("expected no backup in removal event")
+	}
+	assertNoBackupEvent(t, sub)
+
+	// A new subscriber only sees the channel that is still open.
+	sub2, backups := notifier.SubscribeBackups()
+	defer sub2.Cancel()
+	if len(backups) != 1 ||
+		backups[0].FundingOutpoint != newChan.FundingOutpoint {
+
+		t.Fatalf("unexpected backups: %v", backups)
+	}
+
+	// Cancelling closes the updates without an error.
+	sub.Cancel()
+	if _, ok := <-sub.Updates; ok {
+		t.Fatalf("expected updates to be closed")
+	}
+	if sub.Err() != nil {
+		t.Fatalf("expected no error, got %v", sub.Err())
+	}
+
+	// Stopping the notifier ends the remaining subscriptions.
+	notifier.Stop()
+	if _, ok := <-sub2.Updates; ok {
+		t.Fatalf("expected updates to be closed")
+	}
+	if !ErrBackupNotifierStopped.Is(sub2.Err()) {
+		t.Fatalf("expected ErrBackupNotifierStopped, got %v", sub2.Err())
+	}
+}
+
+// TestBackupNotifierSlowSubscriber tests that a subscriber which doesn't keep
+// up with the events is dropped without holding up other subscribers.
+func TestBackupNotifierSlowSubscriber(t *testing.T) {
+	t.Parallel()
+
+	chanNotifier := newMockChannelNotifier()
+	notifier, err := NewBackupNotifier(nil, chanNotifier, 1)
+	if err != nil {
+		t.Fatalf("unable to make backup notifier: %v", err)
+	}
+	if err := notifier.Start(); err != nil {
+		t.Fatalf("unable to start backup notifier: %v", err)
+	}
+	defer notifier.Stop()
+
+	slow, _ := notifier.SubscribeBackups()
+	defer slow.Cancel()
+	fast, _ := notifier.SubscribeBackups()
+	defer fast.Cancel()
+
+	var chans []wire.OutPoint
+	for i := 0; i < 2; i++ {
+		channel, err := genRandomOpenChannelShell()
+		if err != nil {
+			t.Fatalf("unable to make test chan: %v", err)
+		}
+		chans = append(chans, channel.FundingOutpoint)
+
+		sendChanEvent(t, chanNotifier, ChannelEvent{
+			NewChans: []ChannelWithAddrs{{OpenChannel: channel}},
+		})
+		assertBackupEvent(t, fast, BackupUpdated, channel.FundingOutpoint)
+	}
+
+	// The slow subscriber still gets what fit in its buffer, after which
+	// its subscription ends.
+	assertBackupEvent(t, slow, BackupUpdated, chans[0])
+	if _, ok := <-slow.Updates; ok {
+		t.Fatalf("expected updates to be closed")
+	}
+	if !ErrBackupSubscriberTooSlow.Is(slow.Err()) {
+		t.Fatalf("expected ErrBackupSubscriberTooSlow, got %v",
+			slow.Err())
+	}
+}
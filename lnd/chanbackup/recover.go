@@ -85,6 +85,89 @@ func Recover(backups []Single, restorer ChannelRestorer,
 	return nil
 }
 
+// ErrNoPeerAddresses is reported for a channel peer that could not be contacted
+// because none of its backups carry an address for it.
+var ErrNoPeerAddresses = er.GenericErrorType.CodeWithDetail("ErrNoPeerAddresses",
+	"no addresses known for peer")
+
+// PeerDialer is an interface that allows ContactPeersForRecovery to attempt a
+// connection to a channel peer at a single address.
+type PeerDialer interface {
+	// DialPeer attempts to connect to the target node at the given
+	// address, returning a non-nil error if the peer can't be reached.
+	DialPeer(node *btcec.PublicKey, addr net.Addr) er.R
+}
+
+// RecoveryContact is the outcome of trying to contact a channel peer in order
+// to trigger the data loss recovery protocol.
+type RecoveryContact struct {
+	// RemoteNodePub is the identity public key of the channel peer.
+	RemoteNodePub *btcec.PublicKey
+
+	// Addr is the address the peer was reached at, or nil if it could not
+	// be reached.
+	Addr net.Addr
+
+	// Err is the reason the peer could not be reached. It is nil if the
+	// peer was reached.
+	Err er.R
+}
+
+// Reachable returns whether the channel peer was reached.
+func (c *RecoveryContact) Reachable() bool {
+	return c.Addr != nil
+}
+
+// ContactPeersForRecovery attempts to connect to the remote peer of each of the
+// passed backups using the addresses stored within them. The addresses of a
+// peer are dialed in order until one succeeds. Peers with several channels are
+// only reached once. A contact is returned for every distinct peer, in the
+// order they first appear in the backups, reporting whether it was reachable.
+func ContactPeersForRecovery(backups []Single,
+	dialer PeerDialer) []RecoveryContact {
+
+	var contacts []RecoveryContact
+	peerIndex := make(map[[33]byte]int)
+
+	for _, backup := range backups {
+		var nodeKey [33]byte
+		copy(nodeKey[:], backup.RemoteNodePub.SerializeCompressed())
+
+		idx, ok := peerIndex[nodeKey]
+		if !ok {
+			idx = len(contacts)
+			peerIndex[nodeKey] = idx
+			contacts = append(contacts, RecoveryContact{
+				RemoteNodePub: backup.RemoteNodePub,
+				Err:           ErrNoPeerAddresses.Default(),
+			})
+		}
+
+		// Another channel with this peer may have gotten us through
+		// already.
+		contact := &contacts[idx]
+		if contact.Reachable() {
+			continue
+		}
+
+		for _, addr := range backup.Addresses {
+			err := dialer.DialPeer(backup.RemoteNodePub, addr)
+			if err != nil {
+				log.Debugf("Unable to reach node=%x at %v for "+
+					"recovery: %v", nodeKey, addr, err)
+				contact.Err = err
+				continue
+			}
+
+			contact.Addr = addr
+			contact.Err = nil
+			break
+		}
+	}
+
+	return contacts
+}
+
 // TODO(roasbeef): more specific keychain interface?
 
 // UnpackAndRecoverSingles is a one-shot method, that given a set of packed
@@ -229,3 +229,102 @@ func TestUnpackAndRecoverMulti(t *testing.T) {
 
 	// TODO(roasbeef): verify proper call args
 }
+
+// mockPeerDialer is a PeerDialer that can only reach a fixed set of addresses,
+// recording every address it dials.
+type mockPeerDialer struct {
+	reachable map[string]struct{}
+
+	dialed []string
+}
+
+func (m *mockPeerDialer) DialPeer(node *btcec.PublicKey, addr net.Addr) er.R {
+	m.dialed = append(m.dialed, addr.String())
+	if _, ok := m.reachable[addr.String()]; !ok {
+		return er.Errorf("unreachable")
+	}
+	return nil
+}
+
+// TestContactPeersForRecovery tests that the addresses of each peer are dialed
+// in order until the first success, and that unreachable peers are reported.
+func TestContactPeersForRecovery(t *testing.T) {
+	t.Parallel()
+
+	newKey := func() *btcec.PublicKey {
+		priv, err := btcec.NewPrivateKey(btcec.S256())
+		if err != nil {
+			t.Fatalf("unable to create key: %v", err)
+		}
+		return priv.PubKey()
+	}
+	newAddr := func(port int) net.Addr {
+		return &net.TCPAddr{IP: net.ParseIP("10.0.0.1"), Port: port}
+	}
+
+	alice, bob, carol := newKey(), newKey(), newKey()
+	backups := []Single{
+		// Alice is reachable at her second address.
+		{
+			RemoteNodePub: alice,
+			Addresses:     []net.Addr{newAddr(1), newAddr(2), newAddr(3)},
+		},
+		// Bob can't be reached at all.
+		{
+			RemoteNodePub: bob,
+			Addresses:     []net.Addr{newAddr(4)},
+		},
+		// A second channel with Alice doesn't dial her again.
+		{
+			RemoteNodePub: alice,
+			Addresses:     []net.Addr{newAddr(2)},
+		},
+		// Carol has no addresses.
+		{
+			RemoteNodePub: carol,
+		},
+	}
+
+	dialer := &mockPeerDialer{
+		reachable: map[string]struct{}{
+			newAddr(2).String(): {},
+			newAddr(3).String(): {},
+		},
+	}
+	contacts := ContactPeersForRecovery(backups, dialer)
+
+	expDialed := []string{
+		newAddr(1).String(), newAddr(2).String(), newAddr(4).String(),
+	}
+	if len(dialer.dialed) != len(expDialed) {
+		t.Fatalf("expected dials %v, got %v", expDialed, dialer.dialed)
+	}
+	for i := range expDialed {
+		if dialer.dialed[i] != expDialed[i] {
+			t.Fatalf("expected dials %v, got %v", expDialed,
+				dialer.dialed)
+		}
+	}
+
+	if len(contacts) != 3 {
+		t.Fatalf("expected 3 contacts, got %d", len(contacts))
+	}
+	if !contacts[0].RemoteNodePub.IsEqual(alice) ||
+		!contacts[0].Reachable() ||
+		contacts[0].Addr.String() != newAddr(2).String() {
+
+		t.Fatalf("expected alice to be reached at %v, got %v",
+			newAddr(2), contacts[0].Addr)
+	}
+	if !contacts[1].RemoteNodePub.IsEqual(bob) ||
+		contacts[1].Reachable() || contacts[1].Err == nil {
+
+		t.Fatal("expected bob to be unreachable")
+	}
+	if !contacts[2].RemoteNodePub.IsEqual(carol) ||
+		!ErrNoPeerAddresses.Is(contacts[2].Err) {
+
+		t.Fatalf("expected ErrNoPeerAddresses for carol, got %v",
+			contacts[2].Err)
+	}
+}
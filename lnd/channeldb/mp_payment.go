@@ -131,6 +131,10 @@ type MPPayment struct {
 
 	// Status is the current PaymentStatus of this payment.
 	Status PaymentStatus
+
+	// TransitionSeq is the sequence number of the last transition
+	// recorded for this payment, zero if none was recorded.
+	TransitionSeq uint64
 }
 
 // TerminalInfo returns any HTLC settle info recorded. If no settle info is
@@ -164,6 +168,17 @@ func (m *MPPayment) SentAmt() (lnwire.MilliSatoshi, lnwire.MilliSatoshi) {
 	return sent, fees
 }
 
+// AttemptedFees returns the sum of the fees of all HTLC attempts of the
+// payment, including the ones that failed.
+func (m *MPPayment) AttemptedFees() lnwire.MilliSatoshi {
+	var fees lnwire.MilliSatoshi
+	for _, h := range m.HTLCs {
+		fees += h.Route.TotalFees()
+	}
+
+	return fees
+}
+
 // InFlightHTLCs returns the HTLCs that are still in-flight, meaning they have
 // not been settled or failed.
 func (m *MPPayment) InFlightHTLCs() []HTLCAttempt {
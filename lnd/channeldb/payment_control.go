@@ -55,6 +55,12 @@ var (
 	ErrValueExceedsAmt = Err.CodeWithDetail("ErrValueExceedsAmt",
 		"attempted value exceeds payment amount")
 
+	// ErrFeeCapExceeded is returned if we try to register an attempt that
+	// would take the fees of all attempts of the payment above its maximum
+	// total fee.
+	ErrFeeCapExceeded = Err.CodeWithDetail("ErrFeeCapExceeded",
+		"attempted fees exceed the payment's maximum total fee")
+
 	// ErrNonMPPayment is returned if we try to register an MPP attempt for
 	// a payment that already has a non-MPP attempt regitered.
 	ErrNonMPPayment = Err.CodeWithDetail("ErrNonMPPayment", "payment has non-MPP attempts")
@@ -198,7 +204,22 @@ func (p *PaymentControl) InitPayment(paymentHash lntypes.Hash,
 
 		// Also delete any lingering failure info now that we are
 		// re-attempting.
-		return bucket.Delete(paymentFailInfoKey)
+		if err := bucket.Delete(paymentFailInfoKey); err != nil {
+			return err
+		}
+
+		// The transition log starts over with the new attempt, but
+		// keeps numbering after the transitions of the earlier one so
+		// that subscribers which replay from a sequence number they
+		// saw before don't miss the new transitions.
+		seqNum := lastPaymentTransition(bucket) + 1
+		err = bucket.DeleteNestedBucket(paymentTransitionsBucket)
+		if err != nil && !kvdb.ErrBucketNotFound.Is(err) {
+			return err
+		}
+		return putPaymentTransition(
+			bucket, seqNum, TransitionInitiated, 0, StatusInFlight,
+		)
 	})
 	if err != nil {
 		return err
@@ -343,6 +364,15 @@ func (p *PaymentControl) RegisterAttempt(paymentHash lntypes.Hash,
 			return ErrValueExceedsAmt.Default()
 		}
 
+		// Ensure the fees of all attempts, including the failed ones,
+		// stay within the maximum total fee of the payment.
+		if p.Info.MaxTotalFee != 0 {
+			fees := p.AttemptedFees() + attempt.Route.TotalFees()
+			if fees > p.Info.MaxTotalFee {
+				return ErrFeeCapExceeded.Default()
+			}
+		}
+
 		htlcsBucket, err := bucket.CreateBucketIfNotExists(
 			paymentHtlcsBucket,
 		)
@@ -363,7 +393,9 @@ func (p *PaymentControl) RegisterAttempt(paymentHash lntypes.Hash,
 		}
 
 		// Retrieve attempt info for the notification.
-		payment, err = fetchPayment(bucket)
+		payment, err = recordPaymentTransition(
+			bucket, TransitionAttemptRegistered, attempt.AttemptID,
+		)
 		return err
 	})
 	if err != nil {
@@ -388,7 +420,10 @@ func (p *PaymentControl) SettleAttempt(hash lntypes.Hash,
 	}
 	settleBytes := b.Bytes()
 
-	return p.updateHtlcKey(hash, attemptID, htlcSettleInfoKey, settleBytes)
+	return p.updateHtlcKey(
+		hash, attemptID, htlcSettleInfoKey, settleBytes,
+		TransitionAttemptSettled,
+	)
 }
 
 // FailAttempt marks the given payment attempt failed.
@@ -400,12 +435,17 @@ func (p *PaymentControl) FailAttempt(hash lntypes.Hash,
 	}
 	failBytes := b.Bytes()
 
-	return p.updateHtlcKey(hash, attemptID, htlcFailInfoKey, failBytes)
+	return p.updateHtlcKey(
+		hash, attemptID, htlcFailInfoKey, failBytes,
+		TransitionAttemptFailed,
+	)
 }
 
-// updateHtlcKey updates a database key for the specified htlc.
+// updateHtlcKey updates a database key for the specified htlc, recording the
+// change as a transition of type t.
 func (p *PaymentControl) updateHtlcKey(paymentHash lntypes.Hash,
-	attemptID uint64, key, value []byte) (*MPPayment, er.R) {
+	attemptID uint64, key, value []byte, t PaymentTransitionType) (
+	*MPPayment, er.R) {
 	htlcIDBytes := make([]byte, 8)
 	binary.BigEndian.PutUint64(htlcIDBytes, attemptID)
 
@@ -457,7 +497,7 @@ func (p *PaymentControl) updateHtlcKey(paymentHash lntypes.Hash,
 		}
 
 		// Retrieve attempt info for the notification.
-		payment, err = fetchPayment(bucket)
+		payment, err = recordPaymentTransition(bucket, t, attemptID)
 		return err
 	})
 	if err != nil {
@@ -513,7 +553,9 @@ func (p *PaymentControl) Fail(paymentHash lntypes.Hash,
 		}
 
 		// Retrieve attempt info for the notification, if available.
-		payment, err = fetchPayment(bucket)
+		payment, err = recordPaymentTransition(
+			bucket, TransitionFailed, 0,
+		)
 		if err != nil {
 			return err
 		}
@@ -527,6 +569,60 @@ func (p *PaymentControl) Fail(paymentHash lntypes.Hash,
 	return payment, updateErr
 }
 
+// QueryPayments returns the payments matching the query, see
+// DB.QueryPayments.
+func (p *PaymentControl) QueryPayments(query PaymentsQuery) (PaymentsResponse,
+	er.R) {
+
+	return p.db.QueryPayments(query)
+}
+
+// DeletePayment removes a payment and its sequence number indexes from the
+// database, so that a new payment to the same payment hash can be made. As
+// htlcs of an in-flight payment may still be resolved, in-flight payments are
+// only deleted if force is set. With force set, the payment is also deleted
+// if its state can't be read. This is only meant for recovering payments that
+// are stuck in an inconsistent state.
+func (p *PaymentControl) DeletePayment(paymentHash lntypes.Hash,
+	force bool) er.R {
+
+	return kvdb.Update(p.db, func(tx kvdb.RwTx) er.R {
+		bucket, err := fetchPaymentBucketUpdate(tx, paymentHash)
+		if err != nil {
+			return err
+		}
+
+		paymentStatus, err := fetchPaymentStatus(bucket)
+		switch {
+		case err != nil && !force:
+			return err
+		case paymentStatus == StatusInFlight && !force:
+			return ErrPaymentInFlight.Default()
+		}
+
+		// Get all the sequence numbers associated with the payment,
+		// including duplicates.
+		seqNrs, err := fetchSequenceNumbers(bucket)
+		if err != nil {
+			return err
+		}
+
+		payments := tx.ReadWriteBucket(paymentsRootBucket)
+		if err := payments.DeleteNestedBucket(paymentHash[:]); err != nil {
+			return err
+		}
+
+		indexBucket := tx.ReadWriteBucket(paymentsIndexBucket)
+		for _, k := range seqNrs {
+			if err := indexBucket.Delete(k); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}, func() {})
+}
+
 // FetchPayment returns information about a payment from the database.
 func (p *PaymentControl) FetchPayment(paymentHash lntypes.Hash) (
 	*MPPayment, er.R) {
@@ -509,6 +509,73 @@ func TestPaymentControlDeleteNonInFligt(t *testing.T) {
 	require.Equal(t, 1, indexCount)
 }
 
+// TestPaymentControlDeletePayment checks that DeletePayment only deletes an
+// in-flight payment when forced, and that a deleted payment can be retried.
+func TestPaymentControlDeletePayment(t *testing.T) {
+	t.Parallel()
+
+	db, cleanup, err := MakeTestDB()
+	defer cleanup()
+
+	if err != nil {
+		t.Fatalf("unable to init db: %v", err)
+	}
+
+	pControl := NewPaymentControl(db)
+
+	info, attempt, _, err := genInfo()
+	if err != nil {
+		t.Fatalf("unable to generate htlc message: %v", err)
+	}
+
+	// Deleting an unknown payment fails.
+	err = pControl.DeletePayment(info.PaymentHash, true)
+	if !ErrPaymentNotInitiated.Is(err) {
+		t.Fatalf("expected ErrPaymentNotInitiated, got %v", err)
+	}
+
+	// Sends base htlc message which initiate StatusInFlight.
+	err = pControl.InitPayment(info.PaymentHash, info)
+	if err != nil {
+		t.Fatalf("unable to send htlc message: %v", err)
+	}
+	_, err = pControl.RegisterAttempt(info.PaymentHash, attempt)
+	if err != nil {
+		t.Fatalf("unable to send htlc message: %v", err)
+	}
+
+	pmt, err := pControl.FetchPayment(info.PaymentHash)
+	util.RequireNoErr(t, err)
+
+	// The in-flight payment is kept unless the deletion is forced.
+	err = pControl.DeletePayment(info.PaymentHash, false)
+	if !ErrPaymentInFlight.Is(err) {
+		t.Fatalf("expected ErrPaymentInFlight, got %v", err)
+	}
+	assertPaymentStatus(t, pControl, info.PaymentHash, StatusInFlight)
+	assertPaymentIndex(t, pControl, info.PaymentHash)
+
+	err = pControl.DeletePayment(info.PaymentHash, true)
+	util.RequireNoErr(t, err)
+	assertPaymentStatus(t, pControl, info.PaymentHash, StatusUnknown)
+	assertNoIndex(t, pControl, pmt.SequenceNum)
+
+	// The payment can be made again, and once it has failed it can be
+	// deleted without forcing it.
+	err = pControl.InitPayment(info.PaymentHash, info)
+	if err != nil {
+		t.Fatalf("unable to send htlc message: %v", err)
+	}
+	_, err = pControl.Fail(info.PaymentHash, FailureReasonNoRoute)
+	if err != nil {
+		t.Fatalf("unable to fail payment hash: %v", err)
+	}
+
+	err = pControl.DeletePayment(info.PaymentHash, false)
+	util.RequireNoErr(t, err)
+	assertPaymentStatus(t, pControl, info.PaymentHash, StatusUnknown)
+}
+
 // TestPaymentControlMultiShard checks the ability of payment control to
 // have multiple in-flight HTLCs for a single payment.
 func TestPaymentControlMultiShard(t *testing.T) {
@@ -874,6 +941,193 @@ func TestPaymentControlMPPRecordValidation(t *testing.T) {
 	}
 }
 
+// TestPaymentControlMaxTotalFee checks that attempts are rejected once the fees
+// of all attempts of a payment, including the failed ones, would exceed its
+// maximum total fee.
+func TestPaymentControlMaxTotalFee(t *testing.T) {
+	t.Parallel()
+
+	db, cleanup, err := MakeTestDB()
+	defer cleanup()
+
+	if err != nil {
+		t.Fatalf("unable to init db: %v", err)
+	}
+
+	pControl := NewPaymentControl(db)
+
+	info, attempt, _, err := genInfo()
+	if err != nil {
+		t.Fatalf("unable to generate htlc message: %v", err)
+	}
+
+	// Allow for one and a half attempts worth of fees.
+	attemptFees := attempt.Route.TotalFees()
+	info.MaxTotalFee = attemptFees + attemptFees/2
+
+	err = pControl.InitPayment(info.PaymentHash, info)
+	if err != nil {
+		t.Fatalf("unable to send htlc message: %v", err)
+	}
+
+	// The first attempt is within the maximum total fee.
+	_, err = pControl.RegisterAttempt(info.PaymentHash, attempt)
+	if err != nil {
+		t.Fatalf("unable to register attempt: %v", err)
+	}
+
+	_, err = pControl.FailAttempt(
+		info.PaymentHash, attempt.AttemptID,
+		&HTLCFailInfo{
+			Reason: HTLCFailUnreadable,
+		},
+	)
+	if err != nil {
+		t.Fatalf("unable to fail htlc: %v", err)
+	}
+
+	// Although the first attempt failed, its fees still count towards the
+	// maximum total fee, so a second attempt is rejected.
+	b := *attempt
+	b.AttemptID = 1
+	_, err = pControl.RegisterAttempt(info.PaymentHash, &b)
+	if !ErrFeeCapExceeded.Is(err) {
+		t.Fatalf("expected ErrFeeCapExceeded, got: %v", err)
+	}
+
+	// The payment can then be failed with the dedicated reason.
+	payment, err := pControl.Fail(
+		info.PaymentHash, FailureReasonFeeCapExceeded,
+	)
+	if err != nil {
+		t.Fatalf("unable to fail payment: %v", err)
+	}
+	if payment.Info.MaxTotalFee != info.MaxTotalFee {
+		t.Fatalf("expected max total fee %v, got %v",
+			info.MaxTotalFee, payment.Info.MaxTotalFee)
+	}
+	if *payment.FailureReason != FailureReasonFeeCapExceeded {
+		t.Fatalf("expected failure reason %v, got %v",
+			FailureReasonFeeCapExceeded, *payment.FailureReason)
+	}
+}
+
+// TestPaymentControlTransitions checks that every change of a payment is
+// appended to its transition log, that the state after each transition can
+// be recovered from the log, and that the log starts over when a failed
+// payment is initiated again without reusing sequence numbers.
+func TestPaymentControlTransitions(t *testing.T) {
+	t.Parallel()
+
+	db, cleanup, err := MakeTestDB()
+	defer cleanup()
+	if err != nil {
+		t.Fatalf("unable to init db: %v", err)
+	}
+
+	pControl := NewPaymentControl(db)
+
+	info, attempt, _, err := genInfo()
+	if err != nil {
+		t.Fatalf("unable to generate htlc message: %v", err)
+	}
+
+	err = pControl.InitPayment(info.PaymentHash, info)
+	if err != nil {
+		t.Fatalf("unable to send htlc message: %v", err)
+	}
+	payment, err := pControl.RegisterAttempt(info.PaymentHash, attempt)
+	if err != nil {
+		t.Fatalf("unable to register attempt: %v", err)
+	}
+	if payment.TransitionSeq != 2 {
+		t.Fatalf("expected transition 2, got %d",
+			payment.TransitionSeq)
+	}
+	_, err = pControl.FailAttempt(
+		info.PaymentHash, attempt.AttemptID,
+		&HTLCFailInfo{Reason: HTLCFailUnreadable},
+	)
+	if err != nil {
+		t.Fatalf("unable to fail htlc: %v", err)
+	}
+	_, err = pControl.Fail(info.PaymentHash, FailureReasonNoRoute)
+	if err != nil {
+		t.Fatalf("unable to fail payment hash: %v", err)
+	}
+
+	payment, history, err := pControl.FetchPaymentTransitions(
+		info.PaymentHash,
+	)
+	if err != nil {
+		t.Fatalf("unable to fetch transitions: %v", err)
+	}
+	expected := []struct {
+		typ    PaymentTransitionType
+		status PaymentStatus
+	}{
+		{TransitionInitiated, StatusInFlight},
+		{TransitionAttemptRegistered, StatusInFlight},
+		{TransitionAttemptFailed, StatusInFlight},
+		{TransitionFailed, StatusFailed},
+	}
+	if len(history) != len(expected) {
+		t.Fatalf("expected %d transitions, got %d", len(expected),
+			len(history))
+	}
+	for i, exp := range expected {
+		transition := history[i]
+		if transition.SeqNum != uint64(i+1) ||
+			transition.Type != exp.typ ||
+			transition.Status != exp.status {
+
+			t.Fatalf("unexpected transition %d: %v", i,
+				spew.Sdump(transition))
+		}
+	}
+	if payment.TransitionSeq != 4 {
+		t.Fatalf("expected transition 4, got %d",
+			payment.TransitionSeq)
+	}
+
+	// The state after the registration has the attempt in flight and no
+	// failure reason.
+	state := payment.StateAt(history[:2])
+	if state.Status != StatusInFlight || state.FailureReason != nil ||
+		len(state.HTLCs) != 1 || state.HTLCs[0].Failure != nil {
+
+		t.Fatalf("unexpected state: %v", spew.Sdump(state))
+	}
+
+	// Initiating the payment again starts a new log, which is numbered
+	// after the transitions of the earlier attempt.
+	err = pControl.InitPayment(info.PaymentHash, info)
+	if err != nil {
+		t.Fatalf("unable to send htlc message: %v", err)
+	}
+	payment, history, err = pControl.FetchPaymentTransitions(
+		info.PaymentHash,
+	)
+	if err != nil {
+		t.Fatalf("unable to fetch transitions: %v", err)
+	}
+	if len(history) != 1 || history[0].Type != TransitionInitiated ||
+		history[0].SeqNum != 5 || payment.TransitionSeq != 5 {
+
+		t.Fatalf("expected a new transition log at 5, got %v",
+			spew.Sdump(history))
+	}
+
+	payment, err = pControl.RegisterAttempt(info.PaymentHash, attempt)
+	if err != nil {
+		t.Fatalf("unable to register attempt: %v", err)
+	}
+	if payment.TransitionSeq != 6 {
+		t.Fatalf("expected transition 6, got %d",
+			payment.TransitionSeq)
+	}
+}
+
 // assertPaymentStatus retrieves the status of the payment referred to by hash
 // and compares it with the expected state.
 func assertPaymentStatus(t *testing.T, p *PaymentControl,
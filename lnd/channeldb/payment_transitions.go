@@ -0,0 +1,270 @@
+package channeldb
+
+import (
+	"encoding/binary"
+	"time"
+
+	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/lnd/channeldb/kvdb"
+	"github.com/pkt-cash/pktd/lnd/lntypes"
+)
+
+var (
+	// paymentTransitionsBucket is a bucket in the payment's sub-bucket
+	// which holds the log of the state transitions of the payment, keyed
+	// by their sequence number. The log is cleared when a failed payment
+	// is initiated again, but its numbering continues so that sequence
+	// numbers of the earlier attempt are never reused.
+	//
+	// payment-transitions-bucket
+	// 	|--<transition seq>: <type><attempt id><status><timestamp>
+	// 	|--...
+	paymentTransitionsBucket = []byte("payment-transitions-bucket")
+)
+
+// paymentTransitionLen is the length of a serialized PaymentTransition,
+// without its sequence number which is the key.
+const paymentTransitionLen = 1 + 8 + 1 + 8
+
+// PaymentTransitionType is the kind of change a PaymentTransition records.
+type PaymentTransitionType uint8
+
+const (
+	// TransitionInitiated is recorded when the payment is initiated.
+	TransitionInitiated PaymentTransitionType = iota
+
+	// TransitionAttemptRegistered is recorded when an HTLC attempt is
+	// registered.
+	TransitionAttemptRegistered
+
+	// TransitionAttemptSettled is recorded when an HTLC attempt settles.
+	TransitionAttemptSettled
+
+	// TransitionAttemptFailed is recorded when an HTLC attempt fails.
+	TransitionAttemptFailed
+
+	// TransitionFailed is recorded when the failure reason of the payment
+	// is set.
+	TransitionFailed
+)
+
+// String returns a human readable name of the transition type.
+func (t PaymentTransitionType) String() string {
+	switch t {
+	case TransitionInitiated:
+		return "Initiated"
+	case TransitionAttemptRegistered:
+		return "AttemptRegistered"
+	case TransitionAttemptSettled:
+		return "AttemptSettled"
+	case TransitionAttemptFailed:
+		return "AttemptFailed"
+	case TransitionFailed:
+		return "Failed"
+	default:
+		return "Unknown"
+	}
+}
+
+// PaymentTransition is a compact record of a change of a payment. Together
+// with the current state of the payment, the log of its transitions allows
+// recovering the state of the payment after each of them.
+type PaymentTransition struct {
+	// SeqNum is the position of the transition in the log of the
+	// payment, starting at 1. It keeps increasing when the payment is
+	// initiated again.
+	SeqNum uint64
+
+	// Type is the kind of change.
+	Type PaymentTransitionType
+
+	// AttemptID is the HTLC attempt which was registered, settled or
+	// failed, it is zero for other transition types.
+	AttemptID uint64
+
+	// Status is the status of the payment after the transition.
+	Status PaymentStatus
+
+	// Timestamp is the time the transition was recorded.
+	Timestamp time.Time
+}
+
+// recordPaymentTransition fetches the payment in the bucket after it was
+// changed and appends the transition to its log. The returned payment
+// includes the transition.
+func recordPaymentTransition(bucket kvdb.RwBucket, t PaymentTransitionType,
+	attemptID uint64) (*MPPayment, er.R) {
+
+	payment, err := fetchPayment(bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	payment.TransitionSeq = lastPaymentTransition(bucket) + 1
+	err = putPaymentTransition(
+		bucket, payment.TransitionSeq, t, attemptID, payment.Status,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return payment, nil
+}
+
+// putPaymentTransition adds a transition with the given sequence number to
+// the log of the payment in the bucket.
+func putPaymentTransition(bucket kvdb.RwBucket, seqNum uint64,
+	t PaymentTransitionType, attemptID uint64, status PaymentStatus) er.R {
+
+	transitions, err := bucket.CreateBucketIfNotExists(
+		paymentTransitionsBucket,
+	)
+	if err != nil {
+		return err
+	}
+
+	var key [8]byte
+	binary.BigEndian.PutUint64(key[:], seqNum)
+
+	var value [paymentTransitionLen]byte
+	value[0] = byte(t)
+	binary.BigEndian.PutUint64(value[1:9], attemptID)
+	value[9] = byte(status)
+	binary.BigEndian.PutUint64(value[10:], uint64(time.Now().UnixNano()))
+
+	return transitions.Put(key[:], value[:])
+}
+
+// fetchPaymentTransitions returns the transition log of the payment in the
+// bucket, oldest first.
+func fetchPaymentTransitions(bucket kvdb.RBucket) ([]PaymentTransition, er.R) {
+	transitions := bucket.NestedReadBucket(paymentTransitionsBucket)
+	if transitions == nil {
+		return nil, nil
+	}
+
+	var history []PaymentTransition
+	err := transitions.ForEach(func(k, v []byte) er.R {
+		if len(k) != 8 || len(v) != paymentTransitionLen {
+			return er.Errorf("malformed payment transition %x", k)
+		}
+		history = append(history, PaymentTransition{
+			SeqNum:    binary.BigEndian.Uint64(k),
+			Type:      PaymentTransitionType(v[0]),
+			AttemptID: binary.BigEndian.Uint64(v[1:9]),
+			Status:    PaymentStatus(v[9]),
+			Timestamp: time.Unix(
+				0, int64(binary.BigEndian.Uint64(v[10:])),
+			),
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return history, nil
+}
+
+// lastPaymentTransition returns the sequence number of the last transition
+// of the payment in the bucket, or zero if none was recorded.
+func lastPaymentTransition(bucket kvdb.RBucket) uint64 {
+	transitions := bucket.NestedReadBucket(paymentTransitionsBucket)
+	if transitions == nil {
+		return 0
+	}
+
+	k, _ := transitions.ReadCursor().Last()
+	if len(k) != 8 {
+		return 0
+	}
+	return binary.BigEndian.Uint64(k)
+}
+
+// FetchPaymentTransitions returns the current state of the payment together
+// with the log of its transitions, oldest first. Payments which were
+// initiated before transitions were recorded have an empty log.
+func (p *PaymentControl) FetchPaymentTransitions(paymentHash lntypes.Hash) (
+	*MPPayment, []PaymentTransition, er.R) {
+
+	var (
+		payment *MPPayment
+		history []PaymentTransition
+	)
+	err := kvdb.View(p.db, func(tx kvdb.RTx) er.R {
+		bucket, err := fetchPaymentBucket(tx, paymentHash)
+		if err != nil {
+			return err
+		}
+
+		payment, err = fetchPayment(bucket)
+		if err != nil {
+			return err
+		}
+
+		history, err = fetchPaymentTransitions(bucket)
+		return err
+	}, func() {
+		payment = nil
+		history = nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return payment, history, nil
+}
+
+// StateAt returns the state the payment had right after the last of the
+// given transitions, which must be a prefix of the transition log of the
+// payment. HTLC attempts, their outcomes and the failure reason that were
+// recorded by later transitions are left out.
+func (m *MPPayment) StateAt(history []PaymentTransition) *MPPayment {
+	if len(history) == 0 {
+		return m
+	}
+
+	var (
+		registered = make(map[uint64]bool)
+		settled    = make(map[uint64]bool)
+		failed     = make(map[uint64]bool)
+		paymentErr bool
+	)
+	for _, t := range history {
+		switch t.Type {
+		case TransitionAttemptRegistered:
+			registered[t.AttemptID] = true
+		case TransitionAttemptSettled:
+			settled[t.AttemptID] = true
+		case TransitionAttemptFailed:
+			failed[t.AttemptID] = true
+		case TransitionFailed:
+			paymentErr = true
+		}
+	}
+
+	last := history[len(history)-1]
+	state := &MPPayment{
+		SequenceNum:   m.SequenceNum,
+		Info:          m.Info,
+		Status:        last.Status,
+		TransitionSeq: last.SeqNum,
+	}
+	for _, h := range m.HTLCs {
+		if !registered[h.AttemptID] {
+			continue
+		}
+		if !settled[h.AttemptID] {
+			h.Settle = nil
+		}
+		if !failed[h.AttemptID] {
+			h.Failure = nil
+		}
+		state.HTLCs = append(state.HTLCs, h)
+	}
+	if paymentErr {
+		state.FailureReason = m.FailureReason
+	}
+
+	return state
+}
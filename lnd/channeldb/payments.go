@@ -33,6 +33,9 @@ var (
 	//      |        |--creation-info-key: <creation info>
 	//      |        |--fail-info-key: <(optional) fail info>
 	//      |        |
+	//      |        |--payment-transitions-bucket
+	//      |        |        |--<transition seq>: <transition>
+	//      |        |
 	//      |        |--payment-htlcs-bucket (shard-bucket)
 	//      |        |        |
 	//      |        |        |-- <htlc attempt ID>
@@ -147,6 +150,15 @@ const (
 	// balance to complete the payment.
 	FailureReasonInsufficientBalance FailureReason = 4
 
+	// FailureReasonFeeCapExceeded indicates that another attempt would
+	// have taken the fees of all attempts of the payment above its maximum
+	// total fee.
+	FailureReasonFeeCapExceeded FailureReason = 5
+
+	// FailureReasonAttemptLimit indicates that the payment used up its
+	// maximum number of htlc attempts without completing.
+	FailureReasonAttemptLimit FailureReason = 6
+
 	// TODO(halseth): cancel state.
 
 	// TODO(joostjager): Add failure reasons for:
@@ -171,6 +183,10 @@ func (r FailureReason) String() string {
 		return "incorrect_payment_details"
 	case FailureReasonInsufficientBalance:
 		return "insufficient_balance"
+	case FailureReasonFeeCapExceeded:
+		return "fee_cap_exceeded"
+	case FailureReasonAttemptLimit:
+		return "attempt_limit"
 	}
 
 	return "unknown"
@@ -227,6 +243,21 @@ type PaymentCreationInfo struct {
 
 	// PaymentRequest is the full payment request, if any.
 	PaymentRequest []byte
+
+	// MaxTotalFee is the maximum that the fees of all attempts of the
+	// payment may add up to, including the attempts that failed. Zero
+	// means no limit.
+	MaxTotalFee lnwire.MilliSatoshi
+
+	// MaxInflightHtlcs is the maximum number of partial payments that may
+	// be in flight at once, so it still applies once the payment is
+	// resumed after a restart. Zero means no limit.
+	MaxInflightHtlcs uint32
+
+	// MaxAttempts is the maximum number of htlc attempts that are made for
+	// the payment, including the attempts that failed, so it still applies
+	// once the payment is resumed after a restart. Zero means no limit.
+	MaxAttempts uint32
 }
 
 // FetchPayments returns all sent payments found in the DB.
@@ -370,6 +401,7 @@ func fetchPayment(bucket kvdb.RBucket) (*MPPayment, er.R) {
 		HTLCs:         htlcs,
 		FailureReason: failureReason,
 		Status:        paymentStatus,
+		TransitionSeq: lastPaymentTransition(bucket),
 	}, nil
 }
 
@@ -480,6 +512,21 @@ type PaymentsQuery struct {
 	// fully completed. This means that pending payments, as well as failed
 	// payments will show up if this field is set to true.
 	IncludeIncomplete bool
+
+	// Statuses restricts the query to payments with one of the given
+	// statuses. If it is set, IncludeIncomplete is ignored. Payments that
+	// don't match don't count towards MaxPayments.
+	Statuses []PaymentStatus
+}
+
+// hasStatus returns true if status is one of the statuses of the query.
+func (q *PaymentsQuery) hasStatus(status PaymentStatus) bool {
+	for _, s := range q.Statuses {
+		if s == status {
+			return true
+		}
+	}
+	return false
 }
 
 // PaymentsResponse contains the result of a query to the payments database.
@@ -544,12 +591,20 @@ func (db *DB) QueryPayments(query PaymentsQuery) (PaymentsResponse, er.R) {
 				return false, err
 			}
 
+			switch {
+			// If a set of statuses is requested, we only return
+			// payments with one of them.
+			case len(query.Statuses) > 0:
+				if !query.hasStatus(payment.Status) {
+					return false, nil
+				}
+
 			// To keep compatibility with the old API, we only
 			// return non-succeeded payments if requested.
-			if payment.Status != StatusSucceeded &&
-				!query.IncludeIncomplete {
+			case payment.Status != StatusSucceeded &&
+				!query.IncludeIncomplete:
 
-				return false, err
+				return false, nil
 			}
 
 			// At this point, we've exhausted the offset, so we'll
@@ -804,6 +859,21 @@ func serializePaymentCreationInfo(w io.Writer, c *PaymentCreationInfo) er.R {
 		return err
 	}
 
+	byteOrder.PutUint64(scratch[:], uint64(c.MaxTotalFee))
+	if _, err := util.Write(w, scratch[:]); err != nil {
+		return err
+	}
+
+	byteOrder.PutUint32(scratch[:4], c.MaxInflightHtlcs)
+	if _, err := util.Write(w, scratch[:4]); err != nil {
+		return err
+	}
+
+	byteOrder.PutUint32(scratch[:4], c.MaxAttempts)
+	if _, err := util.Write(w, scratch[:4]); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -840,6 +910,40 @@ func deserializePaymentCreationInfo(r io.Reader) (*PaymentCreationInfo, er.R) {
 	}
 	c.PaymentRequest = payReq
 
+	// The maximum total fee is optional, as payments created before it was
+	// added don't have it.
+	_, err = util.ReadFull(r, scratch[:])
+	switch {
+	case er.EOF.Is(err):
+		return c, nil
+
+	case err != nil:
+		return nil, err
+	}
+	c.MaxTotalFee = lnwire.MilliSatoshi(byteOrder.Uint64(scratch[:]))
+
+	// Likewise for the in-flight htlc limit.
+	_, err = util.ReadFull(r, scratch[:4])
+	switch {
+	case er.EOF.Is(err):
+		return c, nil
+
+	case err != nil:
+		return nil, err
+	}
+	c.MaxInflightHtlcs = byteOrder.Uint32(scratch[:4])
+
+	// And for the attempt limit.
+	_, err = util.ReadFull(r, scratch[:4])
+	switch {
+	case er.EOF.Is(err):
+		return c, nil
+
+	case err != nil:
+		return nil, err
+	}
+	c.MaxAttempts = byteOrder.Uint32(scratch[:4])
+
 	return c, nil
 }
 
@@ -63,8 +63,11 @@ func makeFakeInfo() (*PaymentCreationInfo, *HTLCAttemptInfo) {
 		Value:       1000,
 		// Use single second precision to avoid false positive test
 		// failures due to the monotonic time component.
-		CreationTime:   time.Unix(time.Now().Unix(), 0),
-		PaymentRequest: []byte(""),
+		CreationTime:     time.Unix(time.Now().Unix(), 0),
+		PaymentRequest:   []byte(""),
+		MaxTotalFee:      100,
+		MaxInflightHtlcs: 3,
+		MaxAttempts:      5,
 	}
 
 	a := &HTLCAttemptInfo{
@@ -392,6 +395,51 @@ func TestQueryPayments(t *testing.T) {
 			lastIndex:      4,
 			expectedSeqNrs: []uint64{3, 4},
 		},
+		{
+			name: "query in flight payments",
+			query: PaymentsQuery{
+				IndexOffset: 0,
+				MaxPayments: 7,
+				Statuses:    []PaymentStatus{StatusInFlight},
+			},
+			firstIndex:     1,
+			lastIndex:      6,
+			expectedSeqNrs: []uint64{1, 3, 4, 5, 6},
+		},
+		{
+			name: "query in flight payments paginated",
+			query: PaymentsQuery{
+				IndexOffset: 3,
+				MaxPayments: 2,
+				Statuses:    []PaymentStatus{StatusInFlight},
+			},
+			firstIndex:     4,
+			lastIndex:      5,
+			expectedSeqNrs: []uint64{4, 5},
+		},
+		{
+			name: "query in flight payments reversed",
+			query: PaymentsQuery{
+				IndexOffset: 0,
+				MaxPayments: 2,
+				Reversed:    true,
+				Statuses:    []PaymentStatus{StatusInFlight},
+			},
+			firstIndex:     5,
+			lastIndex:      6,
+			expectedSeqNrs: []uint64{5, 6},
+		},
+		{
+			name: "query succeeded payments",
+			query: PaymentsQuery{
+				IndexOffset: 0,
+				MaxPayments: 7,
+				Statuses:    []PaymentStatus{StatusSucceeded},
+			},
+			firstIndex:     0,
+			lastIndex:      0,
+			expectedSeqNrs: nil,
+		},
 	}
 
 	for _, tt := range tests {
@@ -38,6 +38,24 @@ var buildRouteCommand = cli.Command{
 				"use for the first hop of the payment",
 			Value: 0,
 		},
+		cli.StringFlag{
+			Name: "payload_formats",
+			Usage: "comma separated onion payload format of each " +
+				"hop, either tlv or legacy. If not set, every " +
+				"hop uses the format it supports",
+		},
+		cli.BoolFlag{
+			Name: "return_to_self",
+			Usage: "continue the route from the last hop back to " +
+				"this node, which requires outgoing_chan_id to " +
+				"be set",
+		},
+		cli.BoolFlag{
+			Name: "probe",
+			Usage: "build a liquidity probe route with a random " +
+				"payment hash, so that an htlc sent along it " +
+				"fails at the last hop",
+		},
 	},
 }
 
@@ -62,6 +80,23 @@ func buildRoute(ctx *cli.Context) er.R {
 		rpcHops = append(rpcHops, pubkey[:])
 	}
 
+	var payloadFormats []routerrpc.HopPayloadFormat
+	if ctx.IsSet("payload_formats") {
+		formats := strings.Split(ctx.String("payload_formats"), ",")
+		for _, f := range formats {
+			switch f {
+			case "tlv":
+				payloadFormats = append(payloadFormats,
+					routerrpc.HopPayloadFormat_TLV_PAYLOAD)
+			case "legacy":
+				payloadFormats = append(payloadFormats,
+					routerrpc.HopPayloadFormat_LEGACY_PAYLOAD)
+			default:
+				return er.Errorf("unknown payload format %v", f)
+			}
+		}
+	}
+
 	var amtMsat int64
 	hasAmt := ctx.IsSet("amt")
 	if hasAmt {
@@ -73,10 +108,13 @@ func buildRoute(ctx *cli.Context) er.R {
 
 	// Call BuildRoute rpc.
 	req := &routerrpc.BuildRouteRequest{
-		AmtMsat:        amtMsat,
-		FinalCltvDelta: int32(ctx.Int64("final_cltv_delta")),
-		HopPubkeys:     rpcHops,
-		OutgoingChanId: ctx.Uint64("outgoing_chan_id"),
+		AmtMsat:           amtMsat,
+		FinalCltvDelta:    int32(ctx.Int64("final_cltv_delta")),
+		HopPubkeys:        rpcHops,
+		OutgoingChanId:    ctx.Uint64("outgoing_chan_id"),
+		HopPayloadFormats: payloadFormats,
+		ReturnToSelf:      ctx.Bool("return_to_self"),
+		Probe:             ctx.Bool("probe"),
 	}
 
 	rpcCtx := context.Background()
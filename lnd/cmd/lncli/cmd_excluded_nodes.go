@@ -0,0 +1,101 @@
+package main
+
+import (
+	"context"
+
+	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/lnd/lnrpc/routerrpc"
+	"github.com/pkt-cash/pktd/lnd/routing/route"
+	"github.com/urfave/cli"
+)
+
+var addExcludedNodeCommand = cli.Command{
+	Name:     "addexcludednode",
+	Category: "Payments",
+	Usage:    "Never route payments through the given node.",
+	Description: `
+	Add a node to the persistent set of nodes that payments are never routed
+	through. The set is merged with the nodes excluded by the individual
+	payments and survives restarts.`,
+	ArgsUsage: "node-pubkey",
+	Action:    actionDecorator(addExcludedNode),
+}
+
+func addExcludedNode(ctx *cli.Context) er.R {
+	if ctx.NArg() != 1 {
+		return er.E(cli.ShowCommandHelp(ctx, "addexcludednode"))
+	}
+
+	node, err := route.NewVertexFromStr(ctx.Args().First())
+	if err != nil {
+		return er.Errorf("invalid node key: %v", err)
+	}
+
+	conn := getClientConn(ctx, false)
+	defer conn.Close()
+
+	client := routerrpc.NewRouterClient(conn)
+
+	req := &routerrpc.AddExcludedNodeRequest{
+		Node: node[:],
+	}
+	rpcCtx := context.Background()
+	_, errr := client.AddExcludedNode(rpcCtx, req)
+	return er.E(errr)
+}
+
+var removeExcludedNodeCommand = cli.Command{
+	Name:      "removeexcludednode",
+	Category:  "Payments",
+	Usage:     "Allow routing payments through an excluded node again.",
+	ArgsUsage: "node-pubkey",
+	Action:    actionDecorator(removeExcludedNode),
+}
+
+func removeExcludedNode(ctx *cli.Context) er.R {
+	if ctx.NArg() != 1 {
+		return er.E(cli.ShowCommandHelp(ctx, "removeexcludednode"))
+	}
+
+	node, err := route.NewVertexFromStr(ctx.Args().First())
+	if err != nil {
+		return er.Errorf("invalid node key: %v", err)
+	}
+
+	conn := getClientConn(ctx, false)
+	defer conn.Close()
+
+	client := routerrpc.NewRouterClient(conn)
+
+	req := &routerrpc.RemoveExcludedNodeRequest{
+		Node: node[:],
+	}
+	rpcCtx := context.Background()
+	_, errr := client.RemoveExcludedNode(rpcCtx, req)
+	return er.E(errr)
+}
+
+var listExcludedNodesCommand = cli.Command{
+	Name:     "listexcludednodes",
+	Category: "Payments",
+	Usage:    "List the nodes payments are never routed through.",
+	Action:   actionDecorator(listExcludedNodes),
+}
+
+func listExcludedNodes(ctx *cli.Context) er.R {
+	conn := getClientConn(ctx, false)
+	defer conn.Close()
+
+	client := routerrpc.NewRouterClient(conn)
+
+	req := &routerrpc.ListExcludedNodesRequest{}
+	rpcCtx := context.Background()
+	resp, errr := client.ListExcludedNodes(rpcCtx, req)
+	if errr != nil {
+		return er.E(errr)
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
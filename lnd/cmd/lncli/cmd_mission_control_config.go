@@ -0,0 +1,147 @@
+package main
+
+import (
+	"context"
+
+	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/lnd/lnrpc/routerrpc"
+
+	"github.com/urfave/cli"
+)
+
+var getCfgCommand = cli.Command{
+	Name:     "getmccfg",
+	Category: "Payments",
+	Usage:    "Display mission control's config.",
+	Description: `
+	Returns the config currently being used by mission control for
+	probability estimation.`,
+	Action: actionDecorator(getCfg),
+}
+
+func getCfg(ctx *cli.Context) er.R {
+	conn := getClientConn(ctx, false)
+	defer conn.Close()
+
+	client := routerrpc.NewRouterClient(conn)
+
+	resp, errr := client.GetMissionControlConfig(
+		context.Background(), &routerrpc.GetMissionControlConfigRequest{},
+	)
+	if errr != nil {
+		return er.E(errr)
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var setCfgCommand = cli.Command{
+	Name:     "setmccfg",
+	Category: "Payments",
+	Usage:    "Set mission control's config.",
+	Description: `
+	Update the config values being used by mission control for probability
+	estimation. Values which aren't set are left unchanged. The new values
+	apply to the next probability estimate, the history of mission control
+	is kept.`,
+	Flags: []cli.Flag{
+		cli.Uint64Flag{
+			Name: "halflife",
+			Usage: "the amount of time in seconds after which a " +
+				"penalized node or channel is back at 50% " +
+				"success probability",
+		},
+		cli.Float64Flag{
+			Name: "hopprob",
+			Usage: "the probability of success assigned to hops " +
+				"that we have no information about, in [0, 1]",
+		},
+		cli.Float64Flag{
+			Name: "weight",
+			Usage: "the degree to which mission control should " +
+				"rely on historical results, in [0, 1]",
+		},
+		cli.StringFlag{
+			Name: "estimator",
+			Usage: "the probability estimator to use, either " +
+				"apriori or bimodal",
+		},
+		cli.Uint64Flag{
+			Name: "bimodalscale",
+			Usage: "the scale in msat of the liquidity " +
+				"distribution of the bimodal estimator",
+		},
+		cli.Float64Flag{
+			Name: "bimodalnodeweight",
+			Usage: "the degree to which the bimodal estimator " +
+				"takes the results of the other channels of " +
+				"a node into account, in [0, 1]",
+		},
+		cli.Uint64Flag{
+			Name: "bimodaldecaytime",
+			Usage: "the amount of time in seconds after which " +
+				"the liquidity bounds of the bimodal " +
+				"estimator have relaxed by a factor of e",
+		},
+	},
+	Action: actionDecorator(setCfg),
+}
+
+func setCfg(ctx *cli.Context) er.R {
+	conn := getClientConn(ctx, false)
+	defer conn.Close()
+
+	client := routerrpc.NewRouterClient(conn)
+
+	rpcCtx := context.Background()
+	resp, errr := client.GetMissionControlConfig(
+		rpcCtx, &routerrpc.GetMissionControlConfigRequest{},
+	)
+	if errr != nil {
+		return er.E(errr)
+	}
+
+	var haveValue bool
+	if ctx.IsSet("halflife") {
+		haveValue = true
+		resp.Config.HalfLifeSeconds = ctx.Uint64("halflife")
+	}
+	if ctx.IsSet("hopprob") {
+		haveValue = true
+		resp.Config.HopProbability = ctx.Float64("hopprob")
+	}
+	if ctx.IsSet("weight") {
+		haveValue = true
+		resp.Config.Weight = ctx.Float64("weight")
+	}
+	if ctx.IsSet("estimator") {
+		haveValue = true
+		resp.Config.Estimator = ctx.String("estimator")
+	}
+	if ctx.IsSet("bimodalscale") {
+		haveValue = true
+		resp.Config.BimodalScaleMsat = ctx.Uint64("bimodalscale")
+	}
+	if ctx.IsSet("bimodalnodeweight") {
+		haveValue = true
+		resp.Config.BimodalNodeWeight = ctx.Float64("bimodalnodeweight")
+	}
+	if ctx.IsSet("bimodaldecaytime") {
+		haveValue = true
+		resp.Config.BimodalDecayTimeSeconds = ctx.Uint64(
+			"bimodaldecaytime",
+		)
+	}
+	if !haveValue {
+		return er.E(cli.ShowCommandHelp(ctx, "setmccfg"))
+	}
+
+	_, errr = client.SetMissionControlConfig(
+		rpcCtx, &routerrpc.SetMissionControlConfigRequest{
+			Config: resp.Config,
+		},
+	)
+	return er.E(errr)
+}
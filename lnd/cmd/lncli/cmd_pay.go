@@ -44,6 +44,13 @@ var (
 			"to route through for this payment",
 	}
 
+	excludeNodeFlag = cli.StringSliceFlag{
+		Name: "exclude_node",
+		Usage: "pubkey of a node the payment may not be routed " +
+			"through, in addition to the nodes excluded with " +
+			"addexcludednode. Can be specified multiple times",
+	}
+
 	dataFlag = cli.StringFlag{
 		Name: "data",
 		Usage: "attach custom data to the payment. The required " +
@@ -65,6 +72,12 @@ var (
 		Value: 1,
 	}
 
+	maxInflightHtlcsFlag = cli.UintFlag{
+		Name: "max_inflight_htlcs",
+		Usage: "the maximum number of partial payments that may be " +
+			"in flight at the same time; 0 means no limit",
+	}
+
 	jsonFlag = cli.BoolFlag{
 		Name: "json",
 		Usage: "if set, payment updates are printed as json " +
@@ -91,6 +104,24 @@ func paymentFlags() []cli.Flag {
 				"the maximum fee allowed when sending the " +
 				"payment",
 		},
+		cli.Int64Flag{
+			Name: "max_total_fee",
+			Usage: "maximum in satoshis that the fees of all " +
+				"attempts of the payment may add up to, " +
+				"including the failed ones; 0 means no cap",
+		},
+		cli.UintFlag{
+			Name: "max_attempts",
+			Usage: "maximum number of htlc attempts that are made " +
+				"for the payment, including the failed ones; " +
+				"0 means no limit",
+		},
+		cli.UintFlag{
+			Name: "final_cltv_buffer",
+			Usage: "number of blocks added to the final cltv " +
+				"delta of the payment to give the final hop " +
+				"a larger safety margin",
+		},
 		cli.DurationFlag{
 			Name: "timeout",
 			Usage: "the maximum amount of time we should spend " +
@@ -100,12 +131,19 @@ func paymentFlags() []cli.Flag {
 		},
 		cltvLimitFlag,
 		lastHopFlag,
+		excludeNodeFlag,
 		cli.Uint64Flag{
 			Name: "outgoing_chan_id",
 			Usage: "short channel id of the outgoing channel to " +
 				"use for the first hop of the payment",
 			Value: 0,
 		},
+		cli.Uint64Flag{
+			Name: "incoming_chan_id",
+			Usage: "short channel id of the channel to use for the " +
+				"last hop of a circular payment to self, to " +
+				"rebalance from the outgoing channel into it",
+		},
 		cli.BoolFlag{
 			Name:  "force, f",
 			Usage: "will skip payment request confirmation",
@@ -114,7 +152,14 @@ func paymentFlags() []cli.Flag {
 			Name:  "allow_self_payment",
 			Usage: "allow sending a circular payment to self",
 		},
-		dataFlag, inflightUpdatesFlag, maxPartsFlag, jsonFlag,
+		cli.BoolFlag{
+			Name: "plan_only",
+			Usage: "only show the shards the payment would be " +
+				"split into and their total fee, without " +
+				"sending it",
+		},
+		dataFlag, inflightUpdatesFlag, maxPartsFlag,
+		maxInflightHtlcsFlag, jsonFlag,
 	}
 }
 
@@ -332,6 +377,7 @@ func sendPaymentRequest(ctx *cli.Context,
 	if outChan != 0 {
 		req.OutgoingChanIds = []uint64{outChan}
 	}
+	req.IncomingChanId = ctx.Uint64("incoming_chan_id")
 	if ctx.IsSet(lastHopFlag.Name) {
 		lastHop, err := route.NewVertexFromStr(
 			ctx.String(lastHopFlag.Name),
@@ -341,6 +387,13 @@ func sendPaymentRequest(ctx *cli.Context,
 		}
 		req.LastHopPubkey = lastHop[:]
 	}
+	for _, pubkey := range ctx.StringSlice(excludeNodeFlag.Name) {
+		node, err := route.NewVertexFromStr(pubkey)
+		if err != nil {
+			return er.Errorf("invalid excluded node key: %v", err)
+		}
+		req.ExcludedNodes = append(req.ExcludedNodes, node[:])
+	}
 
 	req.CltvLimit = int32(ctx.Int(cltvLimitFlag.Name))
 
@@ -351,8 +404,13 @@ func sendPaymentRequest(ctx *cli.Context,
 	req.TimeoutSeconds = int32(pmtTimeout.Seconds())
 
 	req.AllowSelfPayment = ctx.Bool("allow_self_payment")
+	req.PlanOnly = ctx.Bool("plan_only")
 
 	req.MaxParts = uint32(ctx.Uint(maxPartsFlag.Name))
+	req.MaxInflightHtlcs = uint32(ctx.Uint(maxInflightHtlcsFlag.Name))
+	req.MaxTotalFeeMsat = ctx.Int64("max_total_fee") * 1000
+	req.MaxAttempts = uint32(ctx.Uint("max_attempts"))
+	req.FinalCltvBuffer = uint32(ctx.Uint("final_cltv_buffer"))
 	var err er.R
 
 	// Parse custom data records.
@@ -408,8 +466,8 @@ func sendPaymentRequest(ctx *cli.Context,
 		}
 
 		// Ask for confirmation of amount and fee limit if payment is
-		// forced.
-		if !ctx.Bool("force") {
+		// forced. Nothing is sent if the payment is only planned.
+		if !ctx.Bool("force") && !req.PlanOnly {
 			err := confirmPayReq(decodeResp, amt, feeLimit)
 			if err != nil {
 				return err
@@ -441,6 +499,16 @@ func sendPaymentRequest(ctx *cli.Context,
 		return err
 	}
 
+	// A planned payment isn't sent, so only an incomplete plan is an
+	// error.
+	if req.PlanOnly {
+		reason := finalState.FailureReason
+		if reason != lnrpc.PaymentFailureReason_FAILURE_REASON_NONE {
+			return er.New(reason.String())
+		}
+		return nil
+	}
+
 	// If we get a payment error back, we pass an error up
 	// to main which eventually calls fatal() and returns
 	// with a non-zero exit code.
@@ -460,7 +528,15 @@ var trackPaymentCommand = cli.Command{
 	specified by the hash argument.
 	`,
 	ArgsUsage: "hash",
-	Action:    actionDecorator(trackPayment),
+	Flags: []cli.Flag{
+		cli.Uint64Flag{
+			Name: "from_seq",
+			Usage: "replay the updates of the payment from the " +
+				"transition with this sequence number on, " +
+				"to catch up after the stream was lost",
+		},
+	},
+	Action: actionDecorator(trackPayment),
 }
 
 func trackPayment(ctx *cli.Context) er.R {
@@ -482,6 +558,7 @@ func trackPayment(ctx *cli.Context) er.R {
 
 	req := &routerrpc.TrackPaymentRequest{
 		PaymentHash: hash,
+		FromSeq:     ctx.Uint64("from_seq"),
 	}
 
 	stream, errr := routerClient.TrackPaymentV2(context.Background(), req)
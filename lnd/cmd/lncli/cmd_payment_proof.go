@@ -0,0 +1,106 @@
+package main
+
+import (
+	"context"
+	"encoding/hex"
+
+	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/lnd/lnrpc/routerrpc"
+	"github.com/urfave/cli"
+)
+
+var exportPaymentProofCommand = cli.Command{
+	Name:     "exportpaymentproof",
+	Category: "Payments",
+	Usage:    "Export a proof of payment for a settled payment.",
+	Description: `
+	Export a proof that the payment with the given hash was made. The proof
+	binds the payment request, the amount paid and the preimage, and can be
+	checked by anyone with verifypaymentproof without access to this node.`,
+	ArgsUsage: "payment-hash",
+	Action:    actionDecorator(exportPaymentProof),
+}
+
+func exportPaymentProof(ctx *cli.Context) er.R {
+	if ctx.NArg() != 1 {
+		return er.E(cli.ShowCommandHelp(ctx, "exportpaymentproof"))
+	}
+
+	paymentHash, errr := hex.DecodeString(ctx.Args().First())
+	if errr != nil {
+		return er.Errorf("invalid payment hash: %v", errr)
+	}
+
+	conn := getClientConn(ctx, false)
+	defer conn.Close()
+
+	client := routerrpc.NewRouterClient(conn)
+
+	req := &routerrpc.ExportPaymentProofRequest{
+		PaymentHash: paymentHash,
+	}
+	resp, errr := client.ExportPaymentProof(context.Background(), req)
+	if errr != nil {
+		return er.E(errr)
+	}
+
+	printJSON(struct {
+		Proof string `json:"proof"`
+	}{
+		Proof: hex.EncodeToString(resp.Proof),
+	})
+
+	return nil
+}
+
+var verifyPaymentProofCommand = cli.Command{
+	Name:     "verifypaymentproof",
+	Category: "Payments",
+	Usage:    "Verify a proof of payment.",
+	Description: `
+	Verify that the preimage of a proof exported with exportpaymentproof
+	hashes to the payment hash of its payment request, and that the amount
+	paid covers the amount of the payment request.`,
+	ArgsUsage: "proof",
+	Action:    actionDecorator(verifyPaymentProof),
+}
+
+func verifyPaymentProof(ctx *cli.Context) er.R {
+	if ctx.NArg() != 1 {
+		return er.E(cli.ShowCommandHelp(ctx, "verifypaymentproof"))
+	}
+
+	proof, errr := hex.DecodeString(ctx.Args().First())
+	if errr != nil {
+		return er.Errorf("invalid proof: %v", errr)
+	}
+
+	conn := getClientConn(ctx, false)
+	defer conn.Close()
+
+	client := routerrpc.NewRouterClient(conn)
+
+	req := &routerrpc.VerifyPaymentProofRequest{
+		Proof: proof,
+	}
+	resp, errr := client.VerifyPaymentProof(context.Background(), req)
+	if errr != nil {
+		return er.E(errr)
+	}
+
+	printJSON(struct {
+		PaymentHash    string `json:"payment_hash"`
+		PaymentRequest string `json:"payment_request"`
+		Preimage       string `json:"preimage"`
+		ValueMsat      int64  `json:"value_msat"`
+		Destination    string `json:"destination"`
+	}{
+		PaymentHash:    hex.EncodeToString(resp.PaymentHash),
+		PaymentRequest: resp.PaymentRequest,
+		Preimage:       hex.EncodeToString(resp.Preimage),
+		ValueMsat:      resp.ValueMsat,
+		Destination:    hex.EncodeToString(resp.Destination),
+	})
+
+	return nil
+}
@@ -3,6 +3,7 @@ package main
 import (
 	"context"
 	"strconv"
+	"strings"
 
 	"github.com/pkt-cash/pktd/btcutil"
 	"github.com/pkt-cash/pktd/btcutil/er"
@@ -66,3 +67,61 @@ func queryProb(ctx *cli.Context) er.R {
 
 	return nil
 }
+
+var queryRouteProbCommand = cli.Command{
+	Name:     "queryrouteprob",
+	Category: "Payments",
+	Usage:    "Estimate the success probability of a route.",
+	Description: `
+	Estimate the success probability of the route from this node through the
+	given comma separated list of hop pubkeys, along with the probabilities
+	of the individual hops.`,
+	ArgsUsage: "hops amt",
+	Action:    actionDecorator(queryRouteProb),
+}
+
+func queryRouteProb(ctx *cli.Context) er.R {
+	args := ctx.Args()
+
+	if len(args) != 2 {
+		return er.E(cli.ShowCommandHelp(ctx, "queryrouteprob"))
+	}
+
+	hops := strings.Split(args.Get(0), ",")
+	rpcHops := make([][]byte, 0, len(hops))
+	for _, k := range hops {
+		pubkey, err := route.NewVertexFromStr(k)
+		if err != nil {
+			return er.Errorf("error parsing %v: %v", k, err)
+		}
+		rpcHops = append(rpcHops, pubkey[:])
+	}
+
+	amtSat, errr := strconv.ParseUint(args.Get(1), 10, 64)
+	if errr != nil {
+		return er.Errorf("invalid amt: %v", errr)
+	}
+
+	amtMsat := lnwire.NewMSatFromSatoshis(
+		btcutil.Amount(amtSat),
+	)
+
+	conn := getClientConn(ctx, false)
+	defer conn.Close()
+
+	client := routerrpc.NewRouterClient(conn)
+
+	req := &routerrpc.QueryRouteProbabilityRequest{
+		AmtMsat:    int64(amtMsat),
+		HopPubkeys: rpcHops,
+	}
+	rpcCtx := context.Background()
+	response, errr := client.QueryRouteProbability(rpcCtx, req)
+	if errr != nil {
+		return er.E(errr)
+	}
+
+	printRespJSON(response)
+
+	return nil
+}
@@ -7,7 +7,15 @@ func routerCommands() []cli.Command {
 	return []cli.Command{
 		queryMissionControlCommand,
 		queryProbCommand,
+		queryRouteProbCommand,
 		resetMissionControlCommand,
+		getCfgCommand,
+		setCfgCommand,
 		buildRouteCommand,
+		addExcludedNodeCommand,
+		removeExcludedNodeCommand,
+		listExcludedNodesCommand,
+		exportPaymentProofCommand,
+		verifyPaymentProofCommand,
 	}
 }
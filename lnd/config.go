@@ -303,6 +303,8 @@ type Config struct {
 
 	Caches *lncfg.Caches `group:"caches" namespace:"caches"`
 
+	Sweeper *lncfg.Sweeper `group:"sweeper" namespace:"sweeper"`
+
 	Prometheus lncfg.Prometheus `group:"prometheus" namespace:"prometheus"`
 
 	WtClient *lncfg.WtClient `group:"wtclient" namespace:"wtclient"`
@@ -1240,10 +1242,12 @@ func ValidateConfig(cfg Config, usageMessage string) (*Config, er.R) {
 			maxRemoteHtlcs)
 	}
 
-	// Validate the subconfigs for workers, caches, and the tower client.
+	// Validate the subconfigs for workers, caches, the sweeper and the
+	// tower client.
 	err = lncfg.Validate(
 		cfg.Workers,
 		cfg.Caches,
+		cfg.Sweeper,
 		cfg.WtClient,
 		cfg.DB,
 		cfg.HealthChecks,
@@ -0,0 +1,53 @@
+// +build gofuzz
+
+package routerrpcfuzz
+
+import (
+	"bytes"
+
+	"github.com/pkt-cash/pktd/lnd/lnrpc/routerrpc"
+)
+
+// Fuzz_build_route_hops is used by go-fuzz.
+func Fuzz_build_route_hops(data []byte) int {
+	// Split the input into hop pubkeys, each prefixed by a length byte so
+	// that go-fuzz can produce empty, short and long keys.
+	var hopPubkeys [][]byte
+	for len(data) > 0 {
+		n := int(data[0])
+		data = data[1:]
+		if n > len(data) {
+			n = len(data)
+		}
+		if n == 0 {
+			hopPubkeys = append(hopPubkeys, nil)
+			continue
+		}
+		hopPubkeys = append(hopPubkeys, data[:n])
+		data = data[n:]
+	}
+
+	hops, err := routerrpc.UnmarshalHopPubkeys(hopPubkeys)
+	if err != nil {
+		// Malformed input must always be reported with one of the
+		// typed errors.
+		if !routerrpc.ErrNilHopPubkey.Is(err) &&
+			!routerrpc.ErrHopPubkeyLength.Is(err) &&
+			!routerrpc.ErrHopPubkeyNotCanonical.Is(err) {
+
+			panic(err)
+		}
+		return 0
+	}
+
+	// Every accepted key must map onto the vertex it was given as.
+	if len(hops) != len(hopPubkeys) {
+		panic("hop count mismatch")
+	}
+	for i, hop := range hops {
+		if !bytes.Equal(hop[:], hopPubkeys[i]) {
+			panic("hop pubkey mismatch")
+		}
+	}
+	return 1
+}
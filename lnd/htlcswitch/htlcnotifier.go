@@ -1,6 +1,7 @@
 package htlcswitch
 
 import (
+	"bytes"
 	"fmt"
 	"strings"
 	"sync"
@@ -9,6 +10,7 @@ import (
 	"github.com/pkt-cash/pktd/btcutil/er"
 	"github.com/pkt-cash/pktd/lnd/channeldb"
 	"github.com/pkt-cash/pktd/lnd/htlcswitch/hop"
+	"github.com/pkt-cash/pktd/lnd/lntypes"
 	"github.com/pkt-cash/pktd/lnd/lnwire"
 	"github.com/pkt-cash/pktd/lnd/subscribe"
 	"github.com/pkt-cash/pktd/pktlog/log"
@@ -254,11 +256,11 @@ type LinkFailEvent struct {
 }
 
 // ForwardingFailEvent represents a htlc failure which occurred down the line
-// after we forwarded a htlc onwards. An error is not included in this event
-// because errors returned down the route are encrypted. HtlcInfo is not
-// reliably available for forwarding failures, so it is omitted. These events
-// should be matched with their corresponding forward event to obtain this
-// information.
+// after we forwarded a htlc onwards. Errors returned down the route are
+// encrypted, so the failure message is only included if the next hop failed
+// the htlc as malformed. HtlcInfo is not reliably available for forwarding
+// failures, so it is omitted. These events should be matched with their
+// corresponding forward event to obtain this information.
 type ForwardingFailEvent struct {
 	// HtlcKey uniquely identifies the htlc, and can be used to match the
 	// htlc with its corresponding forwarding event.
@@ -268,6 +270,11 @@ type ForwardingFailEvent struct {
 	// receive, or as part of a forward.
 	HtlcEventType
 
+	// FailureMessage is the failure returned by the next hop, it is nil
+	// unless the next hop failed the htlc as malformed, because other
+	// failures are encrypted for the sender.
+	FailureMessage lnwire.FailureMessage
+
 	// Timestamp is the time when the forwarding failure was received.
 	Timestamp time.Time
 }
@@ -281,6 +288,13 @@ type SettleEvent struct {
 	// forwards with their corresponding forwarding event.
 	HtlcKey
 
+	// Preimage is the preimage that was revealed to settle the htlc.
+	Preimage lntypes.Preimage
+
+	// Fee is the routing fee earned by forwarding the htlc, it is zero
+	// for sends and receives.
+	Fee lnwire.MilliSatoshi
+
 	// HtlcEventType classifies the event as part of a local send or
 	// receive, or as part of a forward.
 	HtlcEventType
@@ -338,11 +352,12 @@ func (h *HtlcNotifier) NotifyLinkFailEvent(key HtlcKey, info HtlcInfo,
 //
 // Note this is part of the htlcNotifier interface.
 func (h *HtlcNotifier) NotifyForwardingFailEvent(key HtlcKey,
-	eventType HtlcEventType) {
+	eventType HtlcEventType, failure lnwire.FailureMessage) {
 	event := &ForwardingFailEvent{
-		HtlcKey:       key,
-		HtlcEventType: eventType,
-		Timestamp:     h.now(),
+		HtlcKey:        key,
+		HtlcEventType:  eventType,
+		FailureMessage: failure,
+		Timestamp:      h.now(),
 	}
 
 	log.Tracef("Notifying forwarding failure event: %v over %v", eventType,
@@ -357,9 +372,13 @@ func (h *HtlcNotifier) NotifyForwardingFailEvent(key HtlcKey,
 // to as part of a forward or a receive to our node has been settled.
 //
 // Note this is part of the htlcNotifier interface.
-func (h *HtlcNotifier) NotifySettleEvent(key HtlcKey, eventType HtlcEventType) {
+func (h *HtlcNotifier) NotifySettleEvent(key HtlcKey,
+	preimage lntypes.Preimage, fee lnwire.MilliSatoshi,
+	eventType HtlcEventType) {
 	event := &SettleEvent{
 		HtlcKey:       key,
+		Preimage:      preimage,
+		Fee:           fee,
 		HtlcEventType: eventType,
 		Timestamp:     h.now(),
 	}
@@ -410,6 +429,27 @@ func newHtlcInfo(pkt *htlcPacket) HtlcInfo {
 	}
 }
 
+// settleFee returns the routing fee earned by forwarding the htlc of a settle
+// packet, it is zero for sends and receives.
+func settleFee(pkt *htlcPacket) lnwire.MilliSatoshi {
+	if pkt.circuit == nil || getEventType(pkt) != HtlcEventTypeForward {
+		return 0
+	}
+	return pkt.circuit.IncomingAmount - pkt.circuit.OutgoingAmount
+}
+
+// decodeConvertedError decodes the failure message of a htlc which the next
+// hop failed as malformed, which our link converted into a plain text fail.
+// It returns nil if the failure can't be decoded.
+func decodeConvertedError(reason lnwire.OpaqueReason) lnwire.FailureMessage {
+	failure, err := lnwire.DecodeFailure(bytes.NewReader(reason), 0)
+	if err != nil {
+		log.Warnf("Unable to decode converted error: %v", err)
+		return nil
+	}
+	return failure
+}
+
 // getEventType returns the htlc type based on the fields set in the htlc
 // packet. Sends that originate at our node have the source (zero) incoming
 // channel ID. Receives to our node have the exit (zero) outgoing channel ID
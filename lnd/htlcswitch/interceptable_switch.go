@@ -10,9 +10,17 @@ import (
 	"github.com/pkt-cash/pktd/lnd/lnwire"
 )
 
-// ErrFwdNotExists is an error returned when the caller tries to resolve
-// a forward that doesn't exist anymore.
-var ErrFwdNotExists = Err.CodeWithDetail("ErrFwdNotExists", "forward does not exist")
+var (
+	// ErrFwdNotExists is an error returned when the caller tries to resolve
+	// a forward that doesn't exist anymore.
+	ErrFwdNotExists = Err.CodeWithDetail("ErrFwdNotExists", "forward does not exist")
+
+	// ErrUnsupportedFailureCode is an error returned when the caller tries
+	// to fail a forward with a failure code that can't be used for
+	// intercepted forwards.
+	ErrUnsupportedFailureCode = Err.CodeWithDetail("ErrUnsupportedFailureCode",
+		"failure code not supported for intercepted forwards")
+)
 
 // InterceptableSwitch is an implementation of ForwardingSwitch interface.
 // This implementation is used like a proxy that wraps the switch and
@@ -133,7 +141,67 @@ func (f *interceptedForward) Resume() er.R {
 
 // Fail forward a failed packet to the switch.
 func (f *interceptedForward) Fail() er.R {
-	reason, err := f.packet.obfuscator.EncryptFirstHop(lnwire.NewTemporaryChannelFailure(nil))
+	return f.fail(lnwire.NewTemporaryChannelFailure(nil))
+}
+
+// FailWithCode forwards a packet failed with the given failure code to the
+// switch.
+func (f *interceptedForward) FailWithCode(code lnwire.FailCode) er.R {
+	failure, err := interceptFailure(
+		code, f.packet.incomingAmount, f.htlcSwitch.BestHeight(),
+	)
+	if err != nil {
+		return err
+	}
+	return f.fail(failure)
+}
+
+// IsInterceptFailureCode returns whether intercepted forwards can be failed
+// with the given failure code.
+func IsInterceptFailureCode(code lnwire.FailCode) bool {
+	_, err := interceptFailure(code, 0, 0)
+	return err == nil
+}
+
+// interceptFailure creates the failure message of the given code for an
+// intercepted forward of the incoming amount at the given height. Failures
+// which need a channel update or details of the onion are not supported.
+func interceptFailure(code lnwire.FailCode, amt lnwire.MilliSatoshi,
+	height uint32) (lnwire.FailureMessage, er.R) {
+
+	switch code {
+	case lnwire.CodeTemporaryChannelFailure:
+		return lnwire.NewTemporaryChannelFailure(nil), nil
+	case lnwire.CodeTemporaryNodeFailure:
+		return &lnwire.FailTemporaryNodeFailure{}, nil
+	case lnwire.CodePermanentNodeFailure:
+		return &lnwire.FailPermanentNodeFailure{}, nil
+	case lnwire.CodePermanentChannelFailure:
+		return &lnwire.FailPermanentChannelFailure{}, nil
+	case lnwire.CodeRequiredNodeFeatureMissing:
+		return &lnwire.FailRequiredNodeFeatureMissing{}, nil
+	case lnwire.CodeRequiredChannelFeatureMissing:
+		return &lnwire.FailRequiredChannelFeatureMissing{}, nil
+	case lnwire.CodeUnknownNextPeer:
+		return &lnwire.FailUnknownNextPeer{}, nil
+	case lnwire.CodeIncorrectOrUnknownPaymentDetails:
+		return lnwire.NewFailIncorrectDetails(amt, height), nil
+	case lnwire.CodeIncorrectPaymentAmount:
+		return &lnwire.FailIncorrectPaymentAmount{}, nil
+	case lnwire.CodeFinalExpiryTooSoon:
+		return lnwire.NewFinalExpiryTooSoon(), nil
+	case lnwire.CodeExpiryTooFar:
+		return &lnwire.FailExpiryTooFar{}, nil
+	case lnwire.CodeMPPTimeout:
+		return &lnwire.FailMPPTimeout{}, nil
+	default:
+		return nil, ErrUnsupportedFailureCode.New(code.String(), nil)
+	}
+}
+
+// fail forwards a packet failed with the given failure message to the switch.
+func (f *interceptedForward) fail(failure lnwire.FailureMessage) er.R {
+	reason, err := f.packet.obfuscator.EncryptFirstHop(failure)
 	if err != nil {
 		return er.Errorf("failed to encrypt failure reason %v", err)
 	}
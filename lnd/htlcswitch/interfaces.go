@@ -257,6 +257,12 @@ type InterceptedForward interface {
 
 	// Fails notifies the intention to fail an existing hold forward
 	Fail() er.R
+
+	// FailWithCode notifies the intention to fail an existing hold forward
+	// with a failure message of the given code. Only failures which don't
+	// carry a channel update or details of the onion are supported, other
+	// codes are rejected with ErrUnsupportedFailureCode.
+	FailWithCode(code lnwire.FailCode) er.R
 }
 
 // htlcNotifier is an interface which represents the input side of the
@@ -277,10 +283,13 @@ type htlcNotifier interface {
 
 	// NotifyForwardingFailEvent notifies the HtlcNotifier that a htlc we
 	// forwarded has failed down the line.
-	NotifyForwardingFailEvent(key HtlcKey, eventType HtlcEventType)
+	// The failure is nil unless it is readable by our node.
+	NotifyForwardingFailEvent(key HtlcKey, eventType HtlcEventType,
+		failure lnwire.FailureMessage)
 
 	// NotifySettleEvent notifies the HtlcNotifier that a htlc that we
 	// committed to as part of a forward or a receive to our node has been
-	// settled.
-	NotifySettleEvent(key HtlcKey, eventType HtlcEventType)
+	// settled with the preimage, earning the fee if it was forwarded.
+	NotifySettleEvent(key HtlcKey, preimage lntypes.Preimage,
+		fee lnwire.MilliSatoshi, eventType HtlcEventType)
 }
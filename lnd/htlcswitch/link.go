@@ -1438,6 +1438,8 @@ func (l *channelLink) handleDownstreamPkt(pkt *htlcPacket) {
 		// Send a settle event notification to htlcNotifier.
 		l.cfg.HtlcNotifier.NotifySettleEvent(
 			newHtlcKey(pkt),
+			htlc.PaymentPreimage,
+			settleFee(pkt),
 			getEventType(pkt),
 		)
 
@@ -1517,6 +1519,7 @@ func (l *channelLink) handleDownstreamPkt(pkt *htlcPacket) {
 		} else {
 			l.cfg.HtlcNotifier.NotifyForwardingFailEvent(
 				newHtlcKey(pkt), getEventType(pkt),
+				pkt.downstreamFailure,
 			)
 		}
 
@@ -2969,7 +2972,7 @@ func (l *channelLink) settleHTLC(preimage lntypes.Preimage,
 				HtlcID: pd.HtlcIndex,
 			},
 		},
-		HtlcEventTypeReceive,
+		preimage, 0, HtlcEventTypeReceive,
 	)
 
 	return nil
@@ -68,6 +68,12 @@ type htlcPacket struct {
 	// taken place.
 	convertedError bool
 
+	// downstreamFailure is the decoded failure of a converted error. It
+	// is only used to notify the failure to the htlc notifier, because a
+	// converted error is the only failure from down the route which is
+	// readable by our node.
+	downstreamFailure lnwire.FailureMessage
+
 	// hasSource is set to true if the incomingChanID and incomingHTLCID
 	// fields of a forwarded fail packet are already set and do not need to
 	// be looked up in the circuit map.
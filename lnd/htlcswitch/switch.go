@@ -835,12 +835,16 @@ func (s *Switch) handleLocalResponse(pkt *htlcPacket) {
 	key := newHtlcKey(pkt)
 	eventType := getEventType(pkt)
 
-	switch pkt.htlc.(type) {
+	switch htlc := pkt.htlc.(type) {
 	case *lnwire.UpdateFulfillHTLC:
-		s.cfg.HtlcNotifier.NotifySettleEvent(key, eventType)
+		s.cfg.HtlcNotifier.NotifySettleEvent(
+			key, htlc.PaymentPreimage, 0, eventType,
+		)
 
 	case *lnwire.UpdateFailHTLC:
-		s.cfg.HtlcNotifier.NotifyForwardingFailEvent(key, eventType)
+		s.cfg.HtlcNotifier.NotifyForwardingFailEvent(
+			key, eventType, pkt.downstreamFailure,
+		)
 	}
 }
 
@@ -1101,7 +1105,13 @@ func (s *Switch) handlePacketForward(packet *htlcPacket) er.R {
 			return nil
 		}
 
+		// A converted error is still readable by our node, so we decode
+		// it for the htlc notifier before it is encrypted below.
 		fail, isFail := htlc.(*lnwire.UpdateFailHTLC)
+		if isFail && packet.convertedError {
+			packet.downstreamFailure = decodeConvertedError(fail.Reason)
+		}
+
 		if isFail && !packet.hasSource {
 			switch {
 			// No message to encrypt, locally sourced payment.
@@ -2764,7 +2764,7 @@ func TestInvalidFailure(t *testing.T) {
 // external systems (such as our default timelock delta) do not break
 // these tests.
 type htlcNotifierEvents func(channels *clusterChannels, htlcID uint64,
-	ts time.Time, htlc *lnwire.UpdateAddHTLC,
+	ts time.Time, htlc *lnwire.UpdateAddHTLC, preimage lntypes.Preimage,
 	hops []*hop.Payload) ([]interface{}, []interface{}, []interface{})
 
 // TestHtlcNotifier tests the notifying of htlc events that are routed over a
@@ -2796,10 +2796,12 @@ func TestHtlcNotifier(t *testing.T) {
 			expectedEvents: func(channels *clusterChannels,
 				htlcID uint64, ts time.Time,
 				htlc *lnwire.UpdateAddHTLC,
+				preimage lntypes.Preimage,
 				hops []*hop.Payload) ([]interface{},
 				[]interface{}, []interface{}) {
 				return getThreeHopEvents(
-					channels, htlcID, ts, htlc, hops, nil,
+					channels, htlcID, ts, htlc, preimage,
+					hops, nil,
 				)
 			},
 			iterations: 2,
@@ -2814,10 +2816,12 @@ func TestHtlcNotifier(t *testing.T) {
 			expectedEvents: func(channels *clusterChannels,
 				htlcID uint64, ts time.Time,
 				htlc *lnwire.UpdateAddHTLC,
+				preimage lntypes.Preimage,
 				hops []*hop.Payload) ([]interface{},
 				[]interface{}, []interface{}) {
 				return getThreeHopEvents(
-					channels, htlcID, ts, htlc, hops,
+					channels, htlcID, ts, htlc, preimage,
+					hops,
 					&LinkError{
 						msg:           &lnwire.FailChannelDisabled{},
 						FailureDetail: OutgoingFailureForwardsDisabled,
@@ -2931,10 +2935,10 @@ func testHtcNotifier(t *testing.T, testOpts []serverOption, iterations int,
 	for i := 0; i < iterations; i++ {
 		// We'll start off by making a payment from
 		// Alice -> Bob -> Carol.
-		htlc, hops := n.sendThreeHopPayment(t)
+		htlc, preimage, hops := n.sendThreeHopPayment(t)
 
 		alice, bob, carol := getEvents(
-			channels, uint64(i), now, htlc, hops,
+			channels, uint64(i), now, htlc, preimage, hops,
 		)
 
 		checkHtlcEvents(t, aliceEvents.Updates(), alice)
@@ -2965,10 +2969,10 @@ func checkHtlcEvents(t *testing.T, events <-chan interface{},
 }
 
 // sendThreeHopPayment is a helper function which sends a payment over
-// Alice -> Bob -> Carol in a three hop network and returns Alice's first htlc
-// and the remainder of the hops.
+// Alice -> Bob -> Carol in a three hop network and returns Alice's first htlc,
+// the preimage of the payment and the remainder of the hops.
 func (n *threeHopNetwork) sendThreeHopPayment(t *testing.T) (*lnwire.UpdateAddHTLC,
-	[]*hop.Payload) {
+	lntypes.Preimage, []*hop.Payload) {
 	amount := lnwire.NewMSatFromSatoshis(btcutil.UnitsPerCoin())
 
 	htlcAmt, totalTimelock, hops := generateHops(amount, testStartingHeight,
@@ -2995,15 +2999,16 @@ func (n *threeHopNetwork) sendThreeHopPayment(t *testing.T) (*lnwire.UpdateAddHT
 		t.Fatalf("could not send htlc")
 	}
 
-	return htlc, hops
+	return htlc, *invoice.Terms.PaymentPreimage, hops
 }
 
 // getThreeHopEvents gets the set of htlc events that we expect for a payment
 // from Alice -> Bob -> Carol. If a non-nil link error is provided, the set
 // of events will fail on Bob's outgoing link.
 func getThreeHopEvents(channels *clusterChannels, htlcID uint64,
-	ts time.Time, htlc *lnwire.UpdateAddHTLC, hops []*hop.Payload,
-	linkError *LinkError) ([]interface{}, []interface{}, []interface{}) {
+	ts time.Time, htlc *lnwire.UpdateAddHTLC, preimage lntypes.Preimage,
+	hops []*hop.Payload, linkError *LinkError) ([]interface{},
+	[]interface{}, []interface{}) {
 	aliceKey := HtlcKey{
 		IncomingCircuit: zeroCircuit,
 		OutgoingCircuit: channeldb.CircuitKey{
@@ -3076,6 +3081,7 @@ func getThreeHopEvents(channels *clusterChannels, htlcID uint64,
 		aliceEvents,
 		&SettleEvent{
 			HtlcKey:       aliceKey,
+			Preimage:      preimage,
 			HtlcEventType: HtlcEventTypeSend,
 			Timestamp:     ts,
 		},
@@ -3090,6 +3096,8 @@ func getThreeHopEvents(channels *clusterChannels, htlcID uint64,
 		},
 		&SettleEvent{
 			HtlcKey:       bobKey,
+			Preimage:      preimage,
+			Fee:           htlc.Amount - hops[1].FwdInfo.AmountToForward,
 			HtlcEventType: HtlcEventTypeForward,
 			Timestamp:     ts,
 		},
@@ -3104,6 +3112,7 @@ func getThreeHopEvents(channels *clusterChannels, htlcID uint64,
 				},
 				OutgoingCircuit: zeroCircuit,
 			},
+			Preimage:      preimage,
 			HtlcEventType: HtlcEventTypeReceive,
 			Timestamp:     ts,
 		},
@@ -3129,6 +3138,10 @@ func (m *mockForwardInterceptor) fail() er.R {
 	return m.intercepted.Fail()
 }
 
+func (m *mockForwardInterceptor) failWithCode(code lnwire.FailCode) er.R {
+	return m.intercepted.FailWithCode(code)
+}
+
 func (m *mockForwardInterceptor) resume() er.R {
 	return m.intercepted.Resume()
 }
@@ -3278,6 +3291,36 @@ func TestSwitchHoldForward(t *testing.T) {
 	assertOutgoingLinkReceive(t, aliceChannelLink, true)
 	assertNumCircuits(t, s, 0, 0)
 
+	// Test failing a hold forward with a chosen failure code. Codes that
+	// need a channel update are rejected and the forward stays on hold.
+	if err := switchForwardInterceptor.ForwardPackets(linkQuit, ogPacket); err != nil {
+		t.Fatalf("can't forward htlc packet: %v", err)
+	}
+	assertNumCircuits(t, s, 0, 0)
+	assertOutgoingLinkReceive(t, bobChannelLink, false)
+
+	err = forwardInterceptor.failWithCode(lnwire.CodeFeeInsufficient)
+	if !ErrUnsupportedFailureCode.Is(err) {
+		t.Fatalf("expected ErrUnsupportedFailureCode, got %v", err)
+	}
+	assertOutgoingLinkReceive(t, aliceChannelLink, false)
+
+	err = forwardInterceptor.failWithCode(
+		lnwire.CodeIncorrectOrUnknownPaymentDetails,
+	)
+	if err != nil {
+		t.Fatalf("failed to cancel forward %v", err)
+	}
+	assertOutgoingLinkReceive(t, bobChannelLink, false)
+	assertOutgoingLinkReceive(t, aliceChannelLink, true)
+	assertNumCircuits(t, s, 0, 0)
+
+	failure := ogPacket.obfuscator.(*mockObfuscator).failure
+	if failure.Code() != lnwire.CodeIncorrectOrUnknownPaymentDetails {
+		t.Fatalf("expected %v failure, got %v",
+			lnwire.CodeIncorrectOrUnknownPaymentDetails, failure.Code())
+	}
+
 	// Test settling a hold forward
 	if err := switchForwardInterceptor.ForwardPackets(linkQuit, ogPacket); err != nil {
 		t.Fatalf("can't forward htlc packet: %v", err)
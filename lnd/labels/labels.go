@@ -12,6 +12,8 @@
 // For version 0 we have the following optional data fields defined:
 // - shortchanid: the short channel ID that a transaction is associated with,
 //   with its value set to the uint64 short channel id.
+// - kind: the kind of outputs that a sweep transaction spends, such as
+//   htlc-timeout.
 package labels
 
 import (
@@ -77,6 +79,10 @@ type LabelField string
 const (
 	// ShortChanID is used to tag short channel id values in our labels.
 	ShortChanID LabelField = "shortchanid"
+
+	// SweepKind is used to tag the kind of outputs swept by a sweep
+	// transaction in our labels.
+	SweepKind LabelField = "kind"
 )
 
 // MakeLabel creates a label with the provided type and short channel id. If
@@ -91,3 +97,10 @@ func MakeLabel(labelType LabelType, channelID *lnwire.ShortChannelID) string {
 	return fmt.Sprintf("%v:%v:%v-%v", LabelVersionZero, labelType,
 		ShortChanID, channelID.ToUint64())
 }
+
+// MakeSweepLabel creates a label for a sweep transaction which notes the kind
+// of outputs that it spends: version:sweep:kind-{sweep kind}.
+func MakeSweepLabel(kind string) string {
+	return fmt.Sprintf("%v:%v:%v-%v", LabelVersionZero,
+		LabelTypeSweepTransaction, SweepKind, kind)
+}
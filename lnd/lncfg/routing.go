@@ -1,6 +1,56 @@
 package lncfg
 
+import (
+	"strconv"
+	"strings"
+
+	"github.com/pkt-cash/pktd/btcutil"
+	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/lnd/lnwire"
+	"github.com/pkt-cash/pktd/lnd/routing/route"
+)
+
 // Routing holds the configuration options for routing.
 type Routing struct {
 	AssumeChannelValid bool `long:"assumechanvalid" description:"Skip checking channel spentness during graph validation. This speedup comes at the risk of using an unvalidated view of the network for routing. (default: false)"`
+
+	PeerFeeLimits []string `long:"peerfeelimit" description:"The default fee limit in satoshis of payments whose last hop is the given peer, specified as '<pubkey>:<fee_limit_sat>'. Only applies to payments that don't set a fee limit themselves and whose last hop is known up front: pinned with last_hop_pubkey or incoming_chan_id, or shared by all of their route hints. Can be specified multiple times."`
+}
+
+// ParsePeerFeeLimits parses the configured per peer fee limits into a map
+// keyed by the pubkey of the peer.
+func (r *Routing) ParsePeerFeeLimits() (map[route.Vertex]lnwire.MilliSatoshi,
+	er.R) {
+
+	limits := make(map[route.Vertex]lnwire.MilliSatoshi, len(r.PeerFeeLimits))
+	for _, entry := range r.PeerFeeLimits {
+		parts := strings.Split(entry, ":")
+		if len(parts) != 2 {
+			return nil, er.Errorf("invalid peer fee limit %q, "+
+				"expected <pubkey>:<fee_limit_sat>", entry)
+		}
+
+		peer, err := route.NewVertexFromStr(parts[0])
+		if err != nil {
+			return nil, er.Errorf("invalid peer fee limit %q: %v",
+				entry, err)
+		}
+
+		limitSat, errr := strconv.ParseInt(parts[1], 10, 64)
+		if errr != nil || limitSat < 0 {
+			return nil, er.Errorf("invalid peer fee limit %q: fee "+
+				"limit must be a non-negative number of "+
+				"satoshis", entry)
+		}
+
+		if _, ok := limits[peer]; ok {
+			return nil, er.Errorf("duplicate peer fee limit for %v",
+				peer)
+		}
+		limits[peer] = lnwire.NewMSatFromSatoshis(
+			btcutil.Amount(limitSat),
+		)
+	}
+
+	return limits, nil
 }
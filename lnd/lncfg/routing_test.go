@@ -0,0 +1,102 @@
+package lncfg_test
+
+import (
+	"testing"
+
+	"github.com/pkt-cash/pktd/lnd/lncfg"
+	"github.com/pkt-cash/pktd/lnd/lnwire"
+	"github.com/pkt-cash/pktd/lnd/routing/route"
+)
+
+const (
+	testPeer1 = "02a1633cafcc01ebfb6d78e39f687a1f0995c62fc95f51ead10a02ee0be551b5dc"
+	testPeer2 = "0236e6f4a7e0b6d6c4b0b3e0c5f2a4d8a4d6c6b5f2b9d3e1a7c8f0e2d4b6a8c0e2"
+)
+
+// TestParsePeerFeeLimits asserts that valid per peer fee limits are parsed into
+// a map keyed by the peer, and that malformed, negative and duplicate ones are
+// rejected.
+func TestParsePeerFeeLimits(t *testing.T) {
+	peer1, err := route.NewVertexFromStr(testPeer1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	peer2, err := route.NewVertexFromStr(testPeer2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name   string
+		limits []string
+		want   map[route.Vertex]lnwire.MilliSatoshi
+		valid  bool
+	}{
+		{
+			name:  "none",
+			want:  map[route.Vertex]lnwire.MilliSatoshi{},
+			valid: true,
+		},
+		{
+			name: "two peers",
+			limits: []string{
+				testPeer1 + ":10", testPeer2 + ":0",
+			},
+			want: map[route.Vertex]lnwire.MilliSatoshi{
+				peer1: 10000,
+				peer2: 0,
+			},
+			valid: true,
+		},
+		{
+			name:   "missing limit",
+			limits: []string{testPeer1},
+		},
+		{
+			name:   "too many parts",
+			limits: []string{testPeer1 + ":10:20"},
+		},
+		{
+			name:   "invalid pubkey",
+			limits: []string{"02abcd:10"},
+		},
+		{
+			name:   "invalid limit",
+			limits: []string{testPeer1 + ":ten"},
+		},
+		{
+			name:   "negative limit",
+			limits: []string{testPeer1 + ":-1"},
+		},
+		{
+			name: "duplicate peer",
+			limits: []string{
+				testPeer1 + ":10", testPeer1 + ":20",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		cfg := &lncfg.Routing{PeerFeeLimits: test.limits}
+		limits, err := cfg.ParsePeerFeeLimits()
+		if !test.valid {
+			if err == nil {
+				t.Fatalf("%s: expected an error", test.name)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", test.name, err)
+		}
+		if len(limits) != len(test.want) {
+			t.Fatalf("%s: expected %v limits, got %v", test.name,
+				len(test.want), len(limits))
+		}
+		for peer, want := range test.want {
+			if got, ok := limits[peer]; !ok || got != want {
+				t.Fatalf("%s: expected limit %v for %v, got %v",
+					test.name, want, peer, got)
+			}
+		}
+	}
+}
@@ -0,0 +1,47 @@
+package lncfg
+
+import "github.com/pkt-cash/pktd/btcutil/er"
+
+// Sweeper holds the configuration options for the sweeper, which sweeps the
+// outputs of closed channels back into the wallet.
+type Sweeper struct {
+	// MinFeeRate is the floor in sat/byte that the fee rate of sweep
+	// transactions is raised to before they are published. The minimum
+	// relay fee rate is used if it is higher.
+	MinFeeRate uint64 `long:"min-fee-rate" description:"The minimum fee rate in sat/byte of sweep transactions. Lower fee rates are raised to it before the transaction is published. The minimum relay fee rate is used if it is higher."`
+
+	// MaxFeeRate is the ceiling in sat/byte that the fee rate of sweep
+	// transactions is lowered to before they are published. If zero, the
+	// maximum fee rate that the sweeper accepts for its inputs is used.
+	MaxFeeRate uint64 `long:"max-fee-rate" description:"The maximum fee rate in sat/byte of sweep transactions. Higher fee rates are lowered to it before the transaction is published. If zero, the maximum fee rate that is accepted for inputs to sweep is used."`
+
+	// AnchorReserve is the amount in satoshis of the confirmed wallet
+	// balance that the sweeper never spends to bump the fee of anchor
+	// sweeps, so that it stays available for other uses.
+	AnchorReserve uint64 `long:"anchor-reserve" description:"The amount in satoshis of the confirmed wallet balance that is never used to bump the fee of sweep transactions, such as the CPFP of an anchor output."`
+
+	// AnchorMinConfs is the number of confirmations that a wallet utxo
+	// needs before the sweeper uses it to bump the fee of an anchor sweep.
+	AnchorMinConfs uint32 `long:"anchor-min-confs" description:"The number of confirmations that a wallet UTXO needs before it is used to bump the fee of sweep transactions. Unconfirmed UTXOs are never used. (default: 1)"`
+
+	// AnchorCoinSelection is the order in which the sweeper uses wallet
+	// utxos to bump the fee of anchor sweeps.
+	AnchorCoinSelection string `long:"anchor-coin-selection" description:"The order in which wallet UTXOs are used to bump the fee of sweep transactions. Using the largest first keeps the fee low, using the smallest first keeps large UTXOs available for channel funding. (default: largest)" choice:"largest" choice:"smallest"`
+}
+
+// Validate ensures that the fee rate floor of the sweeper isn't above its
+// ceiling.
+//
+// NOTE: Part of the Validator interface.
+func (s *Sweeper) Validate() er.R {
+	if s.MaxFeeRate != 0 && s.MinFeeRate > s.MaxFeeRate {
+		return er.Errorf("sweeper min fee rate %d sat/byte is above "+
+			"the max fee rate %d sat/byte", s.MinFeeRate,
+			s.MaxFeeRate)
+	}
+
+	return nil
+}
+
+// Compile-time constraint to ensure Sweeper implements the Validator interface.
+var _ Validator = (*Sweeper)(nil)
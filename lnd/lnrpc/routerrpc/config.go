@@ -1,6 +1,8 @@
 package routerrpc
 
 import (
+	"time"
+
 	"github.com/pkt-cash/pktd/lnd/macaroons"
 	"github.com/pkt-cash/pktd/lnd/routing"
 )
@@ -18,6 +20,15 @@ type Config struct {
 	// directory, named DefaultRouterMacFilename.
 	RouterMacPath string `long:"routermacaroonpath" description:"Path to the router macaroon"`
 
+	// NoMacaroonFile, if set, keeps the router macaroon from being written
+	// to disk. Authentication stays enabled, and the serialized macaroon
+	// is made available through the GetMacaroon RPC instead.
+	NoMacaroonFile bool `long:"nomacaroonfile" description:"Don't write the router macaroon to disk"`
+
+	// NodeMetricsInterval is the interval at which the node metrics
+	// served by GetNodeMetrics are recomputed.
+	NodeMetricsInterval time.Duration `long:"nodemetricsinterval" description:"How often the node metrics returned by GetNodeMetrics are recomputed"`
+
 	// NetworkDir is the main network directory wherein the router rpc
 	// server will find the macaroon named DefaultRouterMacFilename.
 	NetworkDir string
@@ -49,10 +60,15 @@ func DefaultConfig() *Config {
 		AttemptCost:           routing.DefaultAttemptCost.ToSatoshis(),
 		AttemptCostPPM:        routing.DefaultAttemptCostPPM,
 		MaxMcHistory:          routing.DefaultMaxMcHistory,
+		Estimator:             routing.DefaultEstimator,
+		BimodalScale:          routing.DefaultBimodalScale,
+		BimodalNodeWeight:     routing.DefaultBimodalNodeWeight,
+		BimodalDecayTime:      routing.DefaultBimodalDecayTime,
 	}
 
 	return &Config{
-		RoutingConfig: defaultRoutingConfig,
+		RoutingConfig:       defaultRoutingConfig,
+		NodeMetricsInterval: DefaultNodeMetricsInterval,
 	}
 }
 
@@ -66,5 +82,9 @@ func GetRoutingConfig(cfg *Config) *RoutingConfig {
 		AttemptCostPPM:        cfg.AttemptCostPPM,
 		PenaltyHalfLife:       cfg.PenaltyHalfLife,
 		MaxMcHistory:          cfg.MaxMcHistory,
+		Estimator:             cfg.Estimator,
+		BimodalScale:          cfg.BimodalScale,
+		BimodalNodeWeight:     cfg.BimodalNodeWeight,
+		BimodalDecayTime:      cfg.BimodalDecayTime,
 	}
 }
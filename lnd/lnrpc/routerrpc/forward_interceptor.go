@@ -6,8 +6,10 @@ import (
 	"github.com/pkt-cash/pktd/btcutil/er"
 	"github.com/pkt-cash/pktd/lnd/channeldb"
 	"github.com/pkt-cash/pktd/lnd/htlcswitch"
+	"github.com/pkt-cash/pktd/lnd/lnrpc"
 	"github.com/pkt-cash/pktd/lnd/lntypes"
 	"github.com/pkt-cash/pktd/lnd/lnwire"
+	"github.com/pkt-cash/pktd/lnd/record"
 	"github.com/pkt-cash/pktd/pktlog/log"
 )
 
@@ -22,6 +24,54 @@ var (
 	ErrMissingPreimage = Err.CodeWithDetail("ErrMissingPreimage", "missing preimage")
 )
 
+// rpcFailureCodes maps the failure codes of the rpc interface to their wire
+// failure codes.
+var rpcFailureCodes = map[lnrpc.Failure_FailureCode]lnwire.FailCode{
+	lnrpc.Failure_INCORRECT_OR_UNKNOWN_PAYMENT_DETAILS: lnwire.CodeIncorrectOrUnknownPaymentDetails,
+	lnrpc.Failure_INCORRECT_PAYMENT_AMOUNT:             lnwire.CodeIncorrectPaymentAmount,
+	lnrpc.Failure_FINAL_INCORRECT_CLTV_EXPIRY:          lnwire.CodeFinalIncorrectCltvExpiry,
+	lnrpc.Failure_FINAL_INCORRECT_HTLC_AMOUNT:          lnwire.CodeFinalIncorrectHtlcAmount,
+	lnrpc.Failure_FINAL_EXPIRY_TOO_SOON:                lnwire.CodeFinalExpiryTooSoon,
+	lnrpc.Failure_INVALID_REALM:                        lnwire.CodeInvalidRealm,
+	lnrpc.Failure_EXPIRY_TOO_SOON:                      lnwire.CodeExpiryTooSoon,
+	lnrpc.Failure_INVALID_ONION_VERSION:                lnwire.CodeInvalidOnionVersion,
+	lnrpc.Failure_INVALID_ONION_HMAC:                   lnwire.CodeInvalidOnionHmac,
+	lnrpc.Failure_INVALID_ONION_KEY:                    lnwire.CodeInvalidOnionKey,
+	lnrpc.Failure_AMOUNT_BELOW_MINIMUM:                 lnwire.CodeAmountBelowMinimum,
+	lnrpc.Failure_FEE_INSUFFICIENT:                     lnwire.CodeFeeInsufficient,
+	lnrpc.Failure_INCORRECT_CLTV_EXPIRY:                lnwire.CodeIncorrectCltvExpiry,
+	lnrpc.Failure_CHANNEL_DISABLED:                     lnwire.CodeChannelDisabled,
+	lnrpc.Failure_TEMPORARY_CHANNEL_FAILURE:            lnwire.CodeTemporaryChannelFailure,
+	lnrpc.Failure_REQUIRED_NODE_FEATURE_MISSING:        lnwire.CodeRequiredNodeFeatureMissing,
+	lnrpc.Failure_REQUIRED_CHANNEL_FEATURE_MISSING:     lnwire.CodeRequiredChannelFeatureMissing,
+	lnrpc.Failure_UNKNOWN_NEXT_PEER:                    lnwire.CodeUnknownNextPeer,
+	lnrpc.Failure_TEMPORARY_NODE_FAILURE:               lnwire.CodeTemporaryNodeFailure,
+	lnrpc.Failure_PERMANENT_NODE_FAILURE:               lnwire.CodePermanentNodeFailure,
+	lnrpc.Failure_PERMANENT_CHANNEL_FAILURE:            lnwire.CodePermanentChannelFailure,
+	lnrpc.Failure_EXPIRY_TOO_FAR:                       lnwire.CodeExpiryTooFar,
+	lnrpc.Failure_MPP_TIMEOUT:                          lnwire.CodeMPPTimeout,
+}
+
+// unmarshallInterceptFailureCode returns the wire failure code to fail an
+// intercepted forward with. An unset code defaults to
+// TEMPORARY_CHANNEL_FAILURE, the codes which are supported otherwise are
+// decided by the switch.
+func unmarshallInterceptFailureCode(
+	code lnrpc.Failure_FailureCode) (lnwire.FailCode, er.R) {
+
+	if code == lnrpc.Failure_RESERVED {
+		return lnwire.CodeTemporaryChannelFailure, nil
+	}
+
+	wireCode, ok := rpcFailureCodes[code]
+	if !ok || !htlcswitch.IsInterceptFailureCode(wireCode) {
+		return 0, htlcswitch.ErrUnsupportedFailureCode.New(
+			code.String(), nil,
+		)
+	}
+	return wireCode, nil
+}
+
 // forwardInterceptor is a helper struct that handles the lifecycle of an rpc
 // interceptor streaming session.
 // It is created when the stream opens and disconnects when the stream closes.
@@ -162,13 +212,32 @@ func (r *forwardInterceptor) holdAndForwardToClient(
 		OutgoingExpiry:          htlc.OutgoingExpiry,
 		IncomingAmountMsat:      uint64(htlc.IncomingAmount),
 		IncomingExpiry:          htlc.IncomingExpiry,
-		CustomRecords:           htlc.CustomRecords,
+		CustomRecords:           interceptCustomRecords(htlc.CustomRecords),
 		OnionBlob:               htlc.OnionBlob[:],
 	}
 
 	return er.E(r.stream.Send(interceptionRequest))
 }
 
+// interceptCustomRecords returns a copy of the custom records of an
+// intercepted htlc to send to the client. Only records in the custom type
+// range, which the payer is permitted to set, are included so that no other
+// onion payload records are leaked to the client.
+func interceptCustomRecords(records record.CustomSet) map[uint64][]byte {
+	if len(records) == 0 {
+		return nil
+	}
+
+	customRecords := make(map[uint64][]byte, len(records))
+	for key, value := range records {
+		if key < record.CustomTypeStart {
+			continue
+		}
+		customRecords[key] = append([]byte(nil), value...)
+	}
+	return customRecords
+}
+
 // resolveFromClient handles a resolution arrived from the client.
 func (r *forwardInterceptor) resolveFromClient(
 	in *ForwardHtlcInterceptResponse) er.R {
@@ -181,13 +250,25 @@ func (r *forwardInterceptor) resolveFromClient(
 	if !ok {
 		return ErrFwdNotExists.Default()
 	}
+
+	// Validate the failure code before releasing the forward, so that a
+	// resolution with an unsupported code leaves the htlc on hold and the
+	// client can resolve it again.
+	var failCode lnwire.FailCode
+	if in.Action == ResolveHoldForwardAction_FAIL {
+		var err er.R
+		failCode, err = unmarshallInterceptFailureCode(in.FailureCode)
+		if err != nil {
+			return err
+		}
+	}
 	delete(r.holdForwards, circuitKey)
 
 	switch in.Action {
 	case ResolveHoldForwardAction_RESUME:
 		return interceptedForward.Resume()
 	case ResolveHoldForwardAction_FAIL:
-		return interceptedForward.Fail()
+		return interceptedForward.FailWithCode(failCode)
 	case ResolveHoldForwardAction_SETTLE:
 		if in.Preimage == nil {
 			return ErrMissingPreimage.Default()
@@ -0,0 +1,226 @@
+package routerrpc
+
+import (
+	"io"
+	"reflect"
+	"testing"
+
+	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/lnd/channeldb"
+	"github.com/pkt-cash/pktd/lnd/htlcswitch"
+	"github.com/pkt-cash/pktd/lnd/lnrpc"
+	"github.com/pkt-cash/pktd/lnd/lntypes"
+	"github.com/pkt-cash/pktd/lnd/lnwire"
+	"github.com/pkt-cash/pktd/lnd/record"
+	"google.golang.org/grpc"
+)
+
+// mockInterceptedForward records how an intercepted forward was resolved.
+type mockInterceptedForward struct {
+	packet   htlcswitch.InterceptedPacket
+	failCode *lnwire.FailCode
+}
+
+func (m *mockInterceptedForward) Packet() htlcswitch.InterceptedPacket {
+	return m.packet
+}
+
+func (m *mockInterceptedForward) Resume() er.R {
+	return nil
+}
+
+func (m *mockInterceptedForward) Settle(lntypes.Preimage) er.R {
+	return nil
+}
+
+func (m *mockInterceptedForward) Fail() er.R {
+	return m.FailWithCode(lnwire.CodeTemporaryChannelFailure)
+}
+
+func (m *mockInterceptedForward) FailWithCode(code lnwire.FailCode) er.R {
+	m.failCode = &code
+	return nil
+}
+
+// mockInterceptorStream records the intercept requests sent to the client.
+type mockInterceptorStream struct {
+	grpc.ServerStream
+
+	sent []*ForwardHtlcInterceptRequest
+}
+
+func (m *mockInterceptorStream) Send(req *ForwardHtlcInterceptRequest) error {
+	m.sent = append(m.sent, req)
+	return nil
+}
+
+func (m *mockInterceptorStream) Recv() (*ForwardHtlcInterceptResponse, error) {
+	return nil, io.EOF
+}
+
+// TestResolveFailureCode tests that intercepted forwards are failed with the
+// failure code chosen by the client, and that unsupported codes are rejected
+// without releasing the forward.
+func TestResolveFailureCode(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		code     lnrpc.Failure_FailureCode
+		expected lnwire.FailCode
+		err      *er.ErrorCode
+	}{
+		{
+			name:     "default",
+			code:     lnrpc.Failure_RESERVED,
+			expected: lnwire.CodeTemporaryChannelFailure,
+		},
+		{
+			name:     "incorrect payment details",
+			code:     lnrpc.Failure_INCORRECT_OR_UNKNOWN_PAYMENT_DETAILS,
+			expected: lnwire.CodeIncorrectOrUnknownPaymentDetails,
+		},
+		{
+			name:     "mpp timeout",
+			code:     lnrpc.Failure_MPP_TIMEOUT,
+			expected: lnwire.CodeMPPTimeout,
+		},
+		{
+			name: "needs channel update",
+			code: lnrpc.Failure_FEE_INSUFFICIENT,
+			err:  htlcswitch.ErrUnsupportedFailureCode,
+		},
+		{
+			name: "unknown",
+			code: lnrpc.Failure_FailureCode(1234),
+			err:  htlcswitch.ErrUnsupportedFailureCode,
+		},
+	}
+
+	circuitKey := channeldb.CircuitKey{
+		ChanID: lnwire.NewShortChanIDFromInt(1),
+		HtlcID: 2,
+	}
+	for _, test := range tests {
+		forward := &mockInterceptedForward{}
+		interceptor := &forwardInterceptor{
+			holdForwards: map[channeldb.CircuitKey]htlcswitch.InterceptedForward{
+				circuitKey: forward,
+			},
+		}
+
+		err := interceptor.resolveFromClient(&ForwardHtlcInterceptResponse{
+			IncomingCircuitKey: &CircuitKey{
+				ChanId: circuitKey.ChanID.ToUint64(),
+				HtlcId: circuitKey.HtlcID,
+			},
+			Action:      ResolveHoldForwardAction_FAIL,
+			FailureCode: test.code,
+		})
+
+		if test.err != nil {
+			if !test.err.Is(err) {
+				t.Fatalf("%s: expected %v, got %v", test.name,
+					test.err.Default(), err)
+			}
+			if forward.failCode != nil {
+				t.Fatalf("%s: forward was failed", test.name)
+			}
+			if _, ok := interceptor.holdForwards[circuitKey]; !ok {
+				t.Fatalf("%s: forward no longer held", test.name)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Fatalf("%s: unable to resolve: %v", test.name, err)
+		}
+		if forward.failCode == nil || *forward.failCode != test.expected {
+			t.Fatalf("%s: expected forward to fail with %v, got %v",
+				test.name, test.expected, forward.failCode)
+		}
+		if _, ok := interceptor.holdForwards[circuitKey]; ok {
+			t.Fatalf("%s: forward still held", test.name)
+		}
+	}
+}
+
+// TestInterceptRequest tests that the intercept request sent to the client
+// describes the held htlc, including its custom records but no records outside
+// of the custom type range.
+func TestInterceptRequest(t *testing.T) {
+	t.Parallel()
+
+	circuitKey := channeldb.CircuitKey{
+		ChanID: lnwire.NewShortChanIDFromInt(1),
+		HtlcID: 2,
+	}
+	forward := &mockInterceptedForward{
+		packet: htlcswitch.InterceptedPacket{
+			IncomingCircuit: circuitKey,
+			OutgoingChanID:  lnwire.NewShortChanIDFromInt(3),
+			Hash:            lntypes.Hash{4},
+			OutgoingExpiry:  500,
+			OutgoingAmount:  1000,
+			IncomingExpiry:  540,
+			IncomingAmount:  1100,
+			CustomRecords: record.CustomSet{
+				record.CustomTypeStart:     []byte{1, 2},
+				record.CustomTypeStart + 7: []byte{3},
+				record.CustomTypeStart - 1: []byte{4},
+			},
+		},
+	}
+
+	stream := &mockInterceptorStream{}
+	interceptor := &forwardInterceptor{
+		stream: stream,
+		holdForwards: make(
+			map[channeldb.CircuitKey]htlcswitch.InterceptedForward,
+		),
+	}
+	if err := interceptor.holdAndForwardToClient(forward); err != nil {
+		t.Fatalf("unable to forward to client: %v", err)
+	}
+	if _, ok := interceptor.holdForwards[circuitKey]; !ok {
+		t.Fatalf("forward not held")
+	}
+	if len(stream.sent) != 1 {
+		t.Fatalf("expected 1 request, got %v", len(stream.sent))
+	}
+
+	req := stream.sent[0]
+	if req.IncomingCircuitKey.ChanId != 1 ||
+		req.IncomingCircuitKey.HtlcId != 2 {
+
+		t.Fatalf("unexpected circuit key %v", req.IncomingCircuitKey)
+	}
+	if req.OutgoingRequestedChanId != 3 {
+		t.Fatalf("unexpected outgoing channel %v",
+			req.OutgoingRequestedChanId)
+	}
+	if req.OutgoingAmountMsat != 1000 || req.OutgoingExpiry != 500 {
+		t.Fatalf("unexpected outgoing amount %v and expiry %v",
+			req.OutgoingAmountMsat, req.OutgoingExpiry)
+	}
+	if req.IncomingAmountMsat != 1100 || req.IncomingExpiry != 540 {
+		t.Fatalf("unexpected incoming amount %v and expiry %v",
+			req.IncomingAmountMsat, req.IncomingExpiry)
+	}
+
+	expected := map[uint64][]byte{
+		record.CustomTypeStart:     {1, 2},
+		record.CustomTypeStart + 7: {3},
+	}
+	if !reflect.DeepEqual(req.CustomRecords, expected) {
+		t.Fatalf("expected custom records %v, got %v", expected,
+			req.CustomRecords)
+	}
+
+	// The records sent to the client must not alias the records of the
+	// held htlc.
+	req.CustomRecords[record.CustomTypeStart][0] = 9
+	if forward.packet.CustomRecords[record.CustomTypeStart][0] != 1 {
+		t.Fatalf("custom records of the held htlc were modified")
+	}
+}
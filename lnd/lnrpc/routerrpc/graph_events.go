@@ -0,0 +1,124 @@
+package routerrpc
+
+import (
+	"encoding/hex"
+
+	"github.com/pkt-cash/pktd/btcec"
+	"github.com/pkt-cash/pktd/lnd/lnrpc"
+	"github.com/pkt-cash/pktd/lnd/routing"
+)
+
+// graphSnapshotBatchSize is the maximum number of node and channel updates
+// sent in a single message of a channel graph snapshot, to keep the messages
+// well below the gRPC message size limit on large graphs.
+const graphSnapshotBatchSize = 1000
+
+// MarshalTopologyChange performs a mapping from the topology change struct
+// returned by the router to the form of notifications expected by the current
+// gRPC service.
+func MarshalTopologyChange(topChange *routing.TopologyChange) *lnrpc.GraphTopologyUpdate {
+	// encodeKey is a simple helper function that converts a live public
+	// key into a hex-encoded version of the compressed serialization for
+	// the public key.
+	encodeKey := func(k *btcec.PublicKey) string {
+		return hex.EncodeToString(k.SerializeCompressed())
+	}
+
+	nodeUpdates := make([]*lnrpc.NodeUpdate, len(topChange.NodeUpdates))
+	for i, nodeUpdate := range topChange.NodeUpdates {
+		addrs := make([]string, len(nodeUpdate.Addresses))
+		for i, addr := range nodeUpdate.Addresses {
+			addrs[i] = addr.String()
+		}
+
+		nodeUpdates[i] = &lnrpc.NodeUpdate{
+			Addresses:      addrs,
+			IdentityKey:    encodeKey(nodeUpdate.IdentityKey),
+			GlobalFeatures: nodeUpdate.GlobalFeatures,
+			Alias:          nodeUpdate.Alias,
+			Color:          nodeUpdate.Color,
+		}
+	}
+
+	channelUpdates := make([]*lnrpc.ChannelEdgeUpdate, len(topChange.ChannelEdgeUpdates))
+	for i, channelUpdate := range topChange.ChannelEdgeUpdates {
+		channelUpdates[i] = &lnrpc.ChannelEdgeUpdate{
+			ChanId: channelUpdate.ChanID,
+			ChanPoint: &lnrpc.ChannelPoint{
+				FundingTxid: &lnrpc.ChannelPoint_FundingTxidBytes{
+					FundingTxidBytes: channelUpdate.ChanPoint.Hash[:],
+				},
+				OutputIndex: channelUpdate.ChanPoint.Index,
+			},
+			Capacity: int64(channelUpdate.Capacity),
+			RoutingPolicy: &lnrpc.RoutingPolicy{
+				TimeLockDelta:    uint32(channelUpdate.TimeLockDelta),
+				MinHtlc:          int64(channelUpdate.MinHTLC),
+				MaxHtlcMsat:      uint64(channelUpdate.MaxHTLC),
+				FeeBaseMsat:      int64(channelUpdate.BaseFee),
+				FeeRateMilliMsat: int64(channelUpdate.FeeRate),
+				Disabled:         channelUpdate.Disabled,
+			},
+			AdvertisingNode: encodeKey(channelUpdate.AdvertisingNode),
+			ConnectingNode:  encodeKey(channelUpdate.ConnectingNode),
+		}
+	}
+
+	closedChans := make([]*lnrpc.ClosedChannelUpdate, len(topChange.ClosedChannels))
+	for i, closedChan := range topChange.ClosedChannels {
+		closedChans[i] = &lnrpc.ClosedChannelUpdate{
+			ChanId:       closedChan.ChanID,
+			Capacity:     int64(closedChan.Capacity),
+			ClosedHeight: closedChan.ClosedHeight,
+			ChanPoint: &lnrpc.ChannelPoint{
+				FundingTxid: &lnrpc.ChannelPoint_FundingTxidBytes{
+					FundingTxidBytes: closedChan.ChanPoint.Hash[:],
+				},
+				OutputIndex: closedChan.ChanPoint.Index,
+			},
+		}
+	}
+
+	return &lnrpc.GraphTopologyUpdate{
+		NodeUpdates:    nodeUpdates,
+		ChannelUpdates: channelUpdates,
+		ClosedChans:    closedChans,
+	}
+}
+
+// splitTopologyChange splits a topology change into a sequence of changes each
+// holding at most batchSize node, channel and close updates. At least one,
+// possibly empty, change is always returned.
+func splitTopologyChange(change *routing.TopologyChange,
+	batchSize int) []*routing.TopologyChange {
+
+	batch := &routing.TopologyChange{}
+	batches := []*routing.TopologyChange{batch}
+	size := 0
+	next := func() {
+		size++
+		if size <= batchSize {
+			return
+		}
+		batch = &routing.TopologyChange{}
+		batches = append(batches, batch)
+		size = 1
+	}
+
+	for _, nodeUpdate := range change.NodeUpdates {
+		next()
+		batch.NodeUpdates = append(batch.NodeUpdates, nodeUpdate)
+	}
+	for _, edgeUpdate := range change.ChannelEdgeUpdates {
+		next()
+		batch.ChannelEdgeUpdates = append(
+			batch.ChannelEdgeUpdates, edgeUpdate,
+		)
+	}
+	for _, closedChan := range change.ClosedChannels {
+		next()
+		batch.ClosedChannels = append(batch.ClosedChannels, closedChan)
+	}
+
+	return batches
+}
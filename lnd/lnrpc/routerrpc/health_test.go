@@ -0,0 +1,102 @@
+package routerrpc
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"google.golang.org/grpc"
+)
+
+// TestHealth asserts that the health of the sub-server follows its
+// transitions from created to started to stopped, and that the health handler
+// is served by the REST mux the sub-server registers with and reports
+// readiness through its status code.
+func TestHealth(t *testing.T) {
+	s := &Server{
+		cfg:  &Config{},
+		quit: make(chan struct{}),
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	mux := runtime.NewServeMux()
+	err := s.RegisterWithRestServer(
+		ctx, mux, "localhost:10009",
+		[]grpc.DialOption{grpc.WithInsecure()},
+	)
+	if err != nil {
+		t.Fatalf("unable to register with REST server: %v", err)
+	}
+
+	assertHealth := func(expected Health, expectedStatus int) {
+		t.Helper()
+
+		if health := s.Health(); health != expected {
+			t.Fatalf("expected health %+v, got %+v", expected,
+				health)
+		}
+
+		req := httptest.NewRequest(http.MethodGet, HealthPath, nil)
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, req)
+
+		if rec.Code != expectedStatus {
+			t.Fatalf("expected status %v, got %v", expectedStatus,
+				rec.Code)
+		}
+
+		var health Health
+		if errr := json.Unmarshal(rec.Body.Bytes(), &health); errr != nil {
+			t.Fatalf("unable to decode health: %v", errr)
+		}
+		if health != expected {
+			t.Fatalf("expected reported health %+v, got %+v",
+				expected, health)
+		}
+	}
+
+	// A server which hasn't been started isn't ready.
+	assertHealth(Health{}, http.StatusServiceUnavailable)
+
+	if err := s.Start(); err != nil {
+		t.Fatalf("unable to start server: %v", err)
+	}
+	assertHealth(Health{Started: true}, http.StatusOK)
+
+	// Active streams and the interceptor are reported while they last.
+	streamEnded := s.streamStarted()
+	atomic.StoreInt32(&s.forwardInterceptorActive, 1)
+	assertHealth(Health{
+		Started:           true,
+		InterceptorActive: true,
+		ActiveStreams:     1,
+	}, http.StatusOK)
+
+	streamEnded()
+	atomic.StoreInt32(&s.forwardInterceptorActive, 0)
+	assertHealth(Health{Started: true}, http.StatusOK)
+
+	// Once stopped, the server isn't ready anymore, also after a repeated
+	// stop.
+	if err := s.Stop(); err != nil {
+		t.Fatalf("unable to stop server: %v", err)
+	}
+	assertHealth(
+		Health{Started: true, ShuttingDown: true},
+		http.StatusServiceUnavailable,
+	)
+
+	if err := s.Stop(); err != nil {
+		t.Fatalf("unable to stop server: %v", err)
+	}
+	assertHealth(
+		Health{Started: true, ShuttingDown: true},
+		http.StatusServiceUnavailable,
+	)
+}
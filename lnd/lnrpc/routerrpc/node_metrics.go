@@ -0,0 +1,153 @@
+package routerrpc
+
+import (
+	"encoding/hex"
+	"runtime"
+	"sync"
+	"time"
+
+	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/lnd/autopilot"
+	"github.com/pkt-cash/pktd/lnd/lnrpc"
+	"github.com/pkt-cash/pktd/pktlog/log"
+)
+
+// DefaultNodeMetricsInterval is the default interval at which the node
+// metrics are recomputed.
+const DefaultNodeMetricsInterval = 30 * time.Minute
+
+// nodeMetrics computes node metrics over the channel graph in the background
+// and caches the latest result. Computing them can take minutes on a large
+// graph, so they are recomputed on a schedule rather than per request, and
+// only the metrics that have been requested at least once are computed.
+type nodeMetrics struct {
+	graph    autopilot.ChannelGraph
+	interval time.Duration
+
+	// refresh signals the metrics loop to compute the metrics right away,
+	// after a metric has been requested for the first time.
+	refresh chan struct{}
+
+	mtx sync.RWMutex
+
+	// wantCentrality is set once betweenness centrality was requested.
+	wantCentrality bool
+
+	// centrality maps the hex encoded pubkey of each node to its
+	// betweenness centrality, nil until it has been computed.
+	centrality map[string]*lnrpc.FloatMetric
+
+	// computedAt is the time at which the cached metrics were computed.
+	computedAt time.Time
+
+	quit chan struct{}
+}
+
+// newNodeMetrics creates the node metrics cache for the graph, recomputing
+// the requested metrics every interval once started.
+func newNodeMetrics(graph autopilot.ChannelGraph,
+	interval time.Duration) *nodeMetrics {
+
+	return &nodeMetrics{
+		graph:    graph,
+		interval: interval,
+		refresh:  make(chan struct{}, 1),
+		quit:     make(chan struct{}),
+	}
+}
+
+// start launches the metrics loop.
+func (m *nodeMetrics) start() {
+	go m.metricsLoop()
+}
+
+// stop signals the metrics loop to exit. A computation which is in progress
+// isn't interrupted, the loop exits once it has finished.
+func (m *nodeMetrics) stop() {
+	close(m.quit)
+}
+
+// metricsLoop recomputes the requested metrics every interval, and right away
+// when a metric is requested for the first time.
+func (m *nodeMetrics) metricsLoop() {
+	ticker := time.NewTicker(m.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+		case <-m.refresh:
+		case <-m.quit:
+			return
+		}
+
+		if err := m.compute(); err != nil {
+			log.Errorf("Unable to compute node metrics: %v", err)
+		}
+	}
+}
+
+// compute computes the requested metrics and replaces the cached ones.
+func (m *nodeMetrics) compute() er.R {
+	m.mtx.RLock()
+	wantCentrality := m.wantCentrality
+	m.mtx.RUnlock()
+
+	if !wantCentrality {
+		return nil
+	}
+
+	log.Debugf("Computing betweenness centrality of the channel graph")
+
+	start := time.Now()
+	centralityMetric, err := autopilot.NewBetweennessCentralityMetric(
+		runtime.NumCPU(),
+	)
+	if err != nil {
+		return err
+	}
+	if err := centralityMetric.Refresh(m.graph); err != nil {
+		return err
+	}
+
+	// Fill normalized and non normalized centrality.
+	centrality := make(map[string]*lnrpc.FloatMetric)
+	normalized := centralityMetric.GetMetric(true)
+	for nodeID, val := range centralityMetric.GetMetric(false) {
+		centrality[hex.EncodeToString(nodeID[:])] = &lnrpc.FloatMetric{
+			Value:           val,
+			NormalizedValue: normalized[nodeID],
+		}
+	}
+
+	log.Debugf("Computed betweenness centrality of %d nodes in %v",
+		len(centrality), time.Since(start))
+
+	m.mtx.Lock()
+	m.centrality = centrality
+	m.computedAt = time.Now()
+	m.mtx.Unlock()
+
+	return nil
+}
+
+// betweennessCentrality returns the cached betweenness centrality along with
+// the time it was computed at. If it hasn't been requested before, its
+// computation is started and nil is returned.
+func (m *nodeMetrics) betweennessCentrality() (map[string]*lnrpc.FloatMetric,
+	time.Time) {
+
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	if !m.wantCentrality {
+		m.wantCentrality = true
+
+		select {
+		case m.refresh <- struct{}{}:
+		default:
+		}
+	}
+
+	return m.centrality, m.computedAt
+}
@@ -0,0 +1,144 @@
+package routerrpc
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/chaincfg"
+	"github.com/pkt-cash/pktd/lnd/lntypes"
+	"github.com/pkt-cash/pktd/lnd/lnwire"
+	"github.com/pkt-cash/pktd/lnd/tlv"
+	"github.com/pkt-cash/pktd/lnd/zpay32"
+)
+
+const (
+	// proofPaymentRequestType is the tlv type of the payment request of a
+	// payment proof.
+	proofPaymentRequestType tlv.Type = 0
+
+	// proofPreimageType is the tlv type of the preimage of a payment
+	// proof.
+	proofPreimageType tlv.Type = 2
+
+	// proofAmtPaidType is the tlv type of the amount paid of a payment
+	// proof.
+	proofAmtPaidType tlv.Type = 4
+)
+
+// ErrInvalidPaymentProof is returned when a payment proof can't be decoded
+// or doesn't prove the payment of its payment request.
+var ErrInvalidPaymentProof = Err.CodeWithDetail("ErrInvalidPaymentProof",
+	"invalid payment proof")
+
+// PaymentProof is a proof of payment that can be handed to a third party. It
+// binds the payment request that was paid to the amount paid and the preimage
+// revealed by the payee, which only the payee could have known before the
+// payment settled.
+type PaymentProof struct {
+	// PaymentRequest is the payment request that was paid.
+	PaymentRequest string
+
+	// Preimage is the preimage of the payment hash of the payment request.
+	Preimage lntypes.Preimage
+
+	// AmtPaid is the amount paid to the payee, excluding fees.
+	AmtPaid lnwire.MilliSatoshi
+}
+
+// Serialize encodes the payment proof as a tlv stream.
+func (p *PaymentProof) Serialize() ([]byte, er.R) {
+	payReq := []byte(p.PaymentRequest)
+	preimage := [32]byte(p.Preimage)
+	amtPaid := uint64(p.AmtPaid)
+
+	tlvStream, err := tlv.NewStream(
+		tlv.MakePrimitiveRecord(proofPaymentRequestType, &payReq),
+		tlv.MakePrimitiveRecord(proofPreimageType, &preimage),
+		tlv.MakePrimitiveRecord(proofAmtPaidType, &amtPaid),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var b bytes.Buffer
+	if err := tlvStream.Encode(&b); err != nil {
+		return nil, err
+	}
+
+	return b.Bytes(), nil
+}
+
+// DeserializePaymentProof decodes a payment proof encoded with Serialize. All
+// of its records must be present.
+func DeserializePaymentProof(b []byte) (*PaymentProof, er.R) {
+	var (
+		payReq   []byte
+		preimage [32]byte
+		amtPaid  uint64
+	)
+
+	tlvStream, err := tlv.NewStream(
+		tlv.MakePrimitiveRecord(proofPaymentRequestType, &payReq),
+		tlv.MakePrimitiveRecord(proofPreimageType, &preimage),
+		tlv.MakePrimitiveRecord(proofAmtPaidType, &amtPaid),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	parsedTypes, err := tlvStream.DecodeWithParsedTypes(
+		bytes.NewReader(b),
+	)
+	if err != nil {
+		return nil, ErrInvalidPaymentProof.New("", err)
+	}
+
+	for _, typ := range []tlv.Type{
+		proofPaymentRequestType, proofPreimageType, proofAmtPaidType,
+	} {
+		if _, ok := parsedTypes[typ]; !ok {
+			return nil, ErrInvalidPaymentProof.New(
+				fmt.Sprintf("missing record %d", typ), nil,
+			)
+		}
+	}
+
+	return &PaymentProof{
+		PaymentRequest: string(payReq),
+		Preimage:       lntypes.Preimage(preimage),
+		AmtPaid:        lnwire.MilliSatoshi(amtPaid),
+	}, nil
+}
+
+// Verify checks that the payment request of the proof is valid for the passed
+// network, that the preimage hashes to its payment hash and that the amount
+// paid covers its amount. The decoded payment request is returned.
+func (p *PaymentProof) Verify(params *chaincfg.Params) (*zpay32.Invoice,
+	er.R) {
+
+	payReq, err := zpay32.Decode(p.PaymentRequest, params)
+	if err != nil {
+		return nil, ErrInvalidPaymentProof.New("invalid payment request",
+			err)
+	}
+	if payReq.PaymentHash == nil {
+		return nil, ErrInvalidPaymentProof.New(
+			"payment request has no payment hash", nil,
+		)
+	}
+
+	if !p.Preimage.Matches(lntypes.Hash(*payReq.PaymentHash)) {
+		return nil, ErrInvalidPaymentProof.New(
+			"preimage doesn't match the payment hash", nil,
+		)
+	}
+
+	if payReq.MilliSat != nil && p.AmtPaid < *payReq.MilliSat {
+		return nil, ErrInvalidPaymentProof.New(fmt.Sprintf("amount "+
+			"paid %v is less than the requested %v", p.AmtPaid,
+			*payReq.MilliSat), nil)
+	}
+
+	return payReq, nil
+}
@@ -0,0 +1,115 @@
+package routerrpc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pkt-cash/pktd/btcec"
+	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/chaincfg"
+	"github.com/pkt-cash/pktd/lnd/lntypes"
+	"github.com/pkt-cash/pktd/lnd/lnwire"
+	"github.com/pkt-cash/pktd/lnd/zpay32"
+)
+
+var proofNetParams = &chaincfg.MainNetParams
+
+// newTestPaymentRequest returns a payment request over the passed amount for
+// the payment hash of the preimage, signed by a fixed key.
+func newTestPaymentRequest(t *testing.T, preimage lntypes.Preimage,
+	amt lnwire.MilliSatoshi) string {
+
+	privKey, _ := btcec.PrivKeyFromBytes(btcec.S256(), []byte{1, 2, 3})
+	invoice, err := zpay32.NewInvoice(
+		proofNetParams, preimage.Hash(), time.Unix(1000, 0),
+		zpay32.Amount(amt), zpay32.Description("proof"),
+	)
+	if err != nil {
+		t.Fatalf("unable to create invoice: %v", err)
+	}
+
+	payReq, err := invoice.Encode(zpay32.MessageSigner{
+		SignCompact: func(hash []byte) ([]byte, er.R) {
+			return btcec.SignCompact(btcec.S256(), privKey, hash, true)
+		},
+	})
+	if err != nil {
+		t.Fatalf("unable to encode invoice: %v", err)
+	}
+
+	return payReq
+}
+
+// TestPaymentProof asserts that a payment proof survives serialization and
+// only verifies if the preimage and the amount match the payment request.
+func TestPaymentProof(t *testing.T) {
+	preimage := lntypes.Preimage{1}
+	proof := &PaymentProof{
+		PaymentRequest: newTestPaymentRequest(t, preimage, 1000),
+		Preimage:       preimage,
+		AmtPaid:        1000,
+	}
+
+	b, err := proof.Serialize()
+	if err != nil {
+		t.Fatalf("unable to serialize proof: %v", err)
+	}
+	decoded, err := DeserializePaymentProof(b)
+	if err != nil {
+		t.Fatalf("unable to deserialize proof: %v", err)
+	}
+	if *decoded != *proof {
+		t.Fatalf("expected proof %v, got %v", proof, decoded)
+	}
+
+	payReq, err := decoded.Verify(proofNetParams)
+	if err != nil {
+		t.Fatalf("unable to verify proof: %v", err)
+	}
+	if lntypes.Hash(*payReq.PaymentHash) != preimage.Hash() {
+		t.Fatalf("unexpected payment hash %x", *payReq.PaymentHash)
+	}
+
+	tests := []struct {
+		name  string
+		proof PaymentProof
+	}{
+		{
+			name: "wrong preimage",
+			proof: PaymentProof{
+				PaymentRequest: proof.PaymentRequest,
+				Preimage:       lntypes.Preimage{2},
+				AmtPaid:        1000,
+			},
+		},
+		{
+			name: "underpaid",
+			proof: PaymentProof{
+				PaymentRequest: proof.PaymentRequest,
+				Preimage:       preimage,
+				AmtPaid:        999,
+			},
+		},
+		{
+			name: "invalid payment request",
+			proof: PaymentProof{
+				PaymentRequest: "lnpkt1invalid",
+				Preimage:       preimage,
+				AmtPaid:        1000,
+			},
+		},
+	}
+	for _, test := range tests {
+		_, err := test.proof.Verify(proofNetParams)
+		if !ErrInvalidPaymentProof.Is(err) {
+			t.Fatalf("%v: expected ErrInvalidPaymentProof, got %v",
+				test.name, err)
+		}
+	}
+
+	// A truncated proof misses records.
+	_, err = DeserializePaymentProof(b[:len(b)-10])
+	if !ErrInvalidPaymentProof.Is(err) {
+		t.Fatalf("expected ErrInvalidPaymentProof, got %v", err)
+	}
+}
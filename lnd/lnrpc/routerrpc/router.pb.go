@@ -200,6 +200,33 @@ func (ResolveHoldForwardAction) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_7a0613f69d37b0a5, []int{2}
 }
 
+type HopPayloadFormat int32
+
+const (
+	// The hop payload is encoded as a TLV stream.
+	HopPayloadFormat_TLV_PAYLOAD HopPayloadFormat = 0
+	// The hop payload uses the fixed size legacy format.
+	HopPayloadFormat_LEGACY_PAYLOAD HopPayloadFormat = 1
+)
+
+var HopPayloadFormat_name = map[int32]string{
+	0: "TLV_PAYLOAD",
+	1: "LEGACY_PAYLOAD",
+}
+
+var HopPayloadFormat_value = map[string]int32{
+	"TLV_PAYLOAD":    0,
+	"LEGACY_PAYLOAD": 1,
+}
+
+func (x HopPayloadFormat) String() string {
+	return proto.EnumName(HopPayloadFormat_name, int32(x))
+}
+
+func (HopPayloadFormat) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_7a0613f69d37b0a5, []int{3}
+}
+
 type HtlcEvent_EventType int32
 
 const (
@@ -261,7 +288,8 @@ type SendPaymentRequest struct {
 	//An upper limit on the amount of time we should spend when attempting to
 	//fulfill the payment. This is expressed in seconds. If we cannot make a
 	//successful payment within this time frame, an error will be returned.
-	//This field must be non-zero.
+	//This field must be positive. Once the time is up, no further attempts are
+	//made and the payment fails with FAILURE_REASON_TIMEOUT.
 	TimeoutSeconds int32 `protobuf:"varint,6,opt,name=timeout_seconds,json=timeoutSeconds,proto3" json:"timeout_seconds,omitempty"`
 	//
 	//The maximum number of satoshis that will be paid as a fee of the payment.
@@ -313,8 +341,10 @@ type SendPaymentRequest struct {
 	//Features assumed to be supported by the final node. All transitive feature
 	//dependencies must also be set properly. For a given feature bit pair, either
 	//optional or remote may be set, but not both. If this field is nil or empty,
-	//the router will try to load destination features from the graph as a
-	//fallback.
+	//the router will try to load destination features from the payment request
+	//or the graph as a fallback. If set, it overrides the features of both, which
+	//allows paying a node that under-advertises its features. Unknown feature
+	//bits are rejected.
 	DestFeatures []lnrpc.FeatureBit `protobuf:"varint,16,rep,packed,name=dest_features,json=destFeatures,proto3,enum=lnrpc.FeatureBit" json:"dest_features,omitempty"`
 	//
 	//The maximum number of partial payments that may be use to complete the full
@@ -323,7 +353,79 @@ type SendPaymentRequest struct {
 	//
 	//If set, only the final payment update is streamed back. Intermediate updates
 	//that show which htlcs are still in flight are suppressed.
-	NoInflightUpdates    bool     `protobuf:"varint,18,opt,name=no_inflight_updates,json=noInflightUpdates,proto3" json:"no_inflight_updates,omitempty"`
+	NoInflightUpdates bool `protobuf:"varint,18,opt,name=no_inflight_updates,json=noInflightUpdates,proto3" json:"no_inflight_updates,omitempty"`
+	//
+	//An ordered list of indexes of route hints that path finding should try to
+	//route through, one at a time, before considering the remaining hints. The
+	//indexes refer to the route hints of the payment request if one is given,
+	//and to route_hints otherwise. The last hop of the route is pinned to the
+	//final node of the hint being tried. Cannot be combined with
+	//preferred_hint_pubkeys or last_hop_pubkey.
+	PreferredHintIndexes []uint32 `protobuf:"varint,20,rep,packed,name=preferred_hint_indexes,json=preferredHintIndexes,proto3" json:"preferred_hint_indexes,omitempty"`
+	//
+	//An ordered list of node pubkeys that selects route hints by their hops.
+	//Every route hint containing the given node is preferred, in the order the
+	//pubkeys are listed. Cannot be combined with preferred_hint_indexes or
+	//last_hop_pubkey.
+	PreferredHintPubkeys [][]byte `protobuf:"bytes,21,rep,name=preferred_hint_pubkeys,json=preferredHintPubkeys,proto3" json:"preferred_hint_pubkeys,omitempty"`
+	//
+	//If set, the payment fails rather than falling back to the remaining route
+	//hints when none of the preferred route hints are routable.
+	StrictHintPreference bool `protobuf:"varint,22,opt,name=strict_hint_preference,json=strictHintPreference,proto3" json:"strict_hint_preference,omitempty"`
+	//
+	//The maximum number of partial payments that may be in flight at the same
+	//time. Once reached, no new shards are launched until one of the in-flight
+	//shards has resolved. Zero means no limit. Must not exceed the maximum
+	//number of htlcs a single channel can carry.
+	MaxInflightHtlcs uint32 `protobuf:"varint,23,opt,name=max_inflight_htlcs,json=maxInflightHtlcs,proto3" json:"max_inflight_htlcs,omitempty"`
+	//
+	//The maximum fee of the payment, expressed as a percentage of the payment
+	//amount. Must be greater than 0 and at most 100. Cannot be combined with
+	//fee_limit_sat or fee_limit_msat.
+	FeeLimitPercent float64 `protobuf:"fixed64,24,opt,name=fee_limit_percent,json=feeLimitPercent,proto3" json:"fee_limit_percent,omitempty"`
+	//
+	//An optional list of node pubkeys the payment may not be routed through, in
+	//addition to the nodes excluded with AddExcludedNode.
+	ExcludedNodes [][]byte `protobuf:"bytes,25,rep,name=excluded_nodes,json=excludedNodes,proto3" json:"excluded_nodes,omitempty"`
+	//
+	//The channel id of the channel that must be taken for the last hop, back to
+	//this node. It pins both ends of a circular payment to self, which is used
+	//to rebalance channels: the payment must be to this node with
+	//allow_self_payment set, and exactly one outgoing channel must be given.
+	//Cannot be combined with last_hop_pubkey or the preferred route hints. The
+	//payment fails right away if no route over both channels exists.
+	IncomingChanId uint64 `protobuf:"varint,26,opt,name=incoming_chan_id,json=incomingChanId,proto3" json:"incoming_chan_id,omitempty"`
+	//
+	//If set, the payment is only planned and not sent. A single update is
+	//returned with status UNKNOWN, holding the shards the payment would be split
+	//into as htlcs and their total fee. The largest total_time_lock of the htlc
+	//routes is the longest the funds would be locked up. If the full amount
+	//can't be split within the constraints of the request, the update only
+	//covers part of the value and has a failure_reason. The payment can be sent
+	//for real afterwards, but may end up with different shards.
+	PlanOnly bool `protobuf:"varint,27,opt,name=plan_only,json=planOnly,proto3" json:"plan_only,omitempty"`
+	//
+	//The maximum that the fees of all attempts of the payment may add up to in
+	//millisatoshis, including the attempts that failed. Unlike the fee limit,
+	//which bounds the fees of the shards that are sent, this caps the running
+	//total across all retries and shards. The payment fails with
+	//FAILURE_REASON_FEE_CAP_EXCEEDED once another attempt would exceed it. Zero
+	//means no cap.
+	MaxTotalFeeMsat int64 `protobuf:"varint,28,opt,name=max_total_fee_msat,json=maxTotalFeeMsat,proto3" json:"max_total_fee_msat,omitempty"`
+	//
+	//The maximum number of htlc attempts that are made for the payment,
+	//including the attempts that failed. Once they are used up, no further
+	//attempts are made and the payment fails with FAILURE_REASON_ATTEMPT_LIMIT.
+	//Zero means no limit.
+	MaxAttempts uint32 `protobuf:"varint,29,opt,name=max_attempts,json=maxAttempts,proto3" json:"max_attempts,omitempty"`
+	//
+	//An additional number of blocks added to the final CLTV delta of the
+	//payment request or final_cltv_delta, giving the final hop a larger safety
+	//margin against blocks being found while the payment is in flight. It
+	//raises the time lock of every hop, so the funds may be locked up longer if
+	//the payment gets stuck. The final CLTV delta including the buffer must not
+	//exceed the CLTV limit of the payment.
+	FinalCltvBuffer      uint32   `protobuf:"varint,30,opt,name=final_cltv_buffer,json=finalCltvBuffer,proto3" json:"final_cltv_buffer,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -492,13 +594,98 @@ func (m *SendPaymentRequest) GetNoInflightUpdates() bool {
 	return false
 }
 
+func (m *SendPaymentRequest) GetPreferredHintIndexes() []uint32 {
+	if m != nil {
+		return m.PreferredHintIndexes
+	}
+	return nil
+}
+
+func (m *SendPaymentRequest) GetPreferredHintPubkeys() [][]byte {
+	if m != nil {
+		return m.PreferredHintPubkeys
+	}
+	return nil
+}
+
+func (m *SendPaymentRequest) GetStrictHintPreference() bool {
+	if m != nil {
+		return m.StrictHintPreference
+	}
+	return false
+}
+
+func (m *SendPaymentRequest) GetMaxInflightHtlcs() uint32 {
+	if m != nil {
+		return m.MaxInflightHtlcs
+	}
+	return 0
+}
+
+func (m *SendPaymentRequest) GetFeeLimitPercent() float64 {
+	if m != nil {
+		return m.FeeLimitPercent
+	}
+	return 0
+}
+
+func (m *SendPaymentRequest) GetExcludedNodes() [][]byte {
+	if m != nil {
+		return m.ExcludedNodes
+	}
+	return nil
+}
+
+func (m *SendPaymentRequest) GetIncomingChanId() uint64 {
+	if m != nil {
+		return m.IncomingChanId
+	}
+	return 0
+}
+
+func (m *SendPaymentRequest) GetPlanOnly() bool {
+	if m != nil {
+		return m.PlanOnly
+	}
+	return false
+}
+
+func (m *SendPaymentRequest) GetMaxTotalFeeMsat() int64 {
+	if m != nil {
+		return m.MaxTotalFeeMsat
+	}
+	return 0
+}
+
+func (m *SendPaymentRequest) GetMaxAttempts() uint32 {
+	if m != nil {
+		return m.MaxAttempts
+	}
+	return 0
+}
+
+func (m *SendPaymentRequest) GetFinalCltvBuffer() uint32 {
+	if m != nil {
+		return m.FinalCltvBuffer
+	}
+	return 0
+}
+
 type TrackPaymentRequest struct {
 	// The hash of the payment to look up.
 	PaymentHash []byte `protobuf:"bytes,1,opt,name=payment_hash,json=paymentHash,proto3" json:"payment_hash,omitempty"`
 	//
 	//If set, only the final payment update is streamed back. Intermediate updates
 	//that show which htlcs are still in flight are suppressed.
-	NoInflightUpdates    bool     `protobuf:"varint,2,opt,name=no_inflight_updates,json=noInflightUpdates,proto3" json:"no_inflight_updates,omitempty"`
+	NoInflightUpdates bool `protobuf:"varint,2,opt,name=no_inflight_updates,json=noInflightUpdates,proto3" json:"no_inflight_updates,omitempty"`
+	//
+	//If set, the states of the payment after each of its transitions, starting
+	//with the transition with this sequence number, are replayed before the
+	//stream continues with new updates. A client that reconnects after losing
+	//its stream can set it to the transition_seq of the last update it received
+	//plus one to catch up on the updates it missed. If there is nothing to
+	//replay, the current state is sent as usual.
+	FromSeq              uint64   `protobuf:"varint,3,opt,name=from_seq,json=fromSeq,proto3" json:"from_seq,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -547,13 +734,27 @@ func (m *TrackPaymentRequest) GetNoInflightUpdates() bool {
 	return false
 }
 
+func (m *TrackPaymentRequest) GetFromSeq() uint64 {
+	if m != nil {
+		return m.FromSeq
+	}
+	return 0
+}
+
 type RouteFeeRequest struct {
 	//
 	//The destination once wishes to obtain a routing fee quote to.
 	Dest []byte `protobuf:"bytes,1,opt,name=dest,proto3" json:"dest,omitempty"`
 	//
 	//The amount one wishes to send to the target destination.
-	AmtSat               int64    `protobuf:"varint,2,opt,name=amt_sat,json=amtSat,proto3" json:"amt_sat,omitempty"`
+	AmtSat int64 `protobuf:"varint,2,opt,name=amt_sat,json=amtSat,proto3" json:"amt_sat,omitempty"`
+	//
+	//The time preference for the route, in the range [-1, 1]. Towards -1 the fee
+	//of a route weighs more than its success probability and the fee of a
+	//cheaper route is estimated, towards 1 the success probability weighs more
+	//and the fee of a more reliable route is estimated. 0, the default, balances
+	//both the same way the router does when paying.
+	TimePref             float64  `protobuf:"fixed64,3,opt,name=time_pref,json=timePref,proto3" json:"time_pref,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -602,6 +803,13 @@ func (m *RouteFeeRequest) GetAmtSat() int64 {
 	return 0
 }
 
+func (m *RouteFeeRequest) GetTimePref() float64 {
+	if m != nil {
+		return m.TimePref
+	}
+	return 0
+}
+
 type RouteFeeResponse struct {
 	//
 	//A lower bound of the estimated fee to the target destination within the
@@ -1202,7 +1410,39 @@ type BuildRouteRequest struct {
 	//
 	//A list of hops that defines the route. This does not include the source hop
 	//pubkey.
-	HopPubkeys           [][]byte `protobuf:"bytes,4,rep,name=hop_pubkeys,json=hopPubkeys,proto3" json:"hop_pubkeys,omitempty"`
+	HopPubkeys [][]byte `protobuf:"bytes,4,rep,name=hop_pubkeys,json=hopPubkeys,proto3" json:"hop_pubkeys,omitempty"`
+	//
+	//If set, the response will also contain the serialized onion packet for the
+	//route and the per-hop shared secrets used to construct it. This is intended
+	//for custom probing and relaying tools.
+	//
+	//SECURITY: the shared secrets allow anyone holding them to decrypt the
+	//failure messages returned for this onion, and the onion itself links the
+	//route to the payment hash. Only request this material over a trusted
+	//connection and do not persist or forward it unless necessary.
+	IncludeOnion bool `protobuf:"varint,5,opt,name=include_onion,json=includeOnion,proto3" json:"include_onion,omitempty"`
+	//
+	//The payment hash the onion commits to. Only used when include_onion is set,
+	//in which case it must be exactly 32 bytes.
+	PaymentHash []byte `protobuf:"bytes,6,opt,name=payment_hash,json=paymentHash,proto3" json:"payment_hash,omitempty"`
+	//
+	//The onion payload format to use for each hop, in the same order as
+	//hop_pubkeys. If empty, every hop uses the format it advertises support
+	//for. The legacy format can't be used for hops that carry custom records.
+	HopPayloadFormats []HopPayloadFormat `protobuf:"varint,7,rep,packed,name=hop_payload_formats,json=hopPayloadFormats,proto3,enum=routerrpc.HopPayloadFormat" json:"hop_payload_formats,omitempty"`
+	//
+	//If set, the route continues from the last node in hop_pubkeys back to our
+	//own node. Together with a single hop this builds a route that goes out to a
+	//peer and back, which allows probing the liquidity of the channel given by
+	//outgoing_chan_id. The outgoing channel must be set and the route returns
+	//over a different channel.
+	ReturnToSelf bool `protobuf:"varint,8,opt,name=return_to_self,json=returnToSelf,proto3" json:"return_to_self,omitempty"`
+	//
+	//If set, the route is built for a liquidity probe. A random payment hash,
+	//that no node knows the preimage of, is generated and returned in the
+	//response, so that an htlc sent along the route fails at the last hop.
+	//payment_hash must not be set, the onion commits to the random hash instead.
+	Probe                bool     `protobuf:"varint,9,opt,name=probe,proto3" json:"probe,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1265,13 +1505,64 @@ func (m *BuildRouteRequest) GetHopPubkeys() [][]byte {
 	return nil
 }
 
+func (m *BuildRouteRequest) GetIncludeOnion() bool {
+	if m != nil {
+		return m.IncludeOnion
+	}
+	return false
+}
+
+func (m *BuildRouteRequest) GetPaymentHash() []byte {
+	if m != nil {
+		return m.PaymentHash
+	}
+	return nil
+}
+
+func (m *BuildRouteRequest) GetHopPayloadFormats() []HopPayloadFormat {
+	if m != nil {
+		return m.HopPayloadFormats
+	}
+	return nil
+}
+
+func (m *BuildRouteRequest) GetReturnToSelf() bool {
+	if m != nil {
+		return m.ReturnToSelf
+	}
+	return false
+}
+
+func (m *BuildRouteRequest) GetProbe() bool {
+	if m != nil {
+		return m.Probe
+	}
+	return false
+}
+
 type BuildRouteResponse struct {
 	//
 	//Fully specified route that can be used to execute the payment.
-	Route                *lnrpc.Route `protobuf:"bytes,1,opt,name=route,proto3" json:"route,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+	Route *lnrpc.Route `protobuf:"bytes,1,opt,name=route,proto3" json:"route,omitempty"`
+	//
+	//The serialized onion packet for the route. Only populated if include_onion
+	//was set in the request.
+	Onion []byte `protobuf:"bytes,2,opt,name=onion,proto3" json:"onion,omitempty"`
+	//
+	//The shared secrets for each hop of the route, in route order. Only
+	//populated if include_onion was set in the request.
+	SharedSecrets [][]byte `protobuf:"bytes,3,rep,name=shared_secrets,json=sharedSecrets,proto3" json:"shared_secrets,omitempty"`
+	//
+	//Set if the route was built for a liquidity probe. It can only be used with
+	//the returned payment hash, so an htlc sent along it never settles.
+	ProbeOnly bool `protobuf:"varint,4,opt,name=probe_only,json=probeOnly,proto3" json:"probe_only,omitempty"`
+	//
+	//The random payment hash of a probe route. Only populated if probe was set
+	//in the request.
+	PaymentHash          []byte   `protobuf:"bytes,5,opt,name=payment_hash,json=paymentHash,proto3" json:"payment_hash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *BuildRouteResponse) Reset()         { *m = BuildRouteResponse{} }
@@ -1310,6 +1601,34 @@ func (m *BuildRouteResponse) GetRoute() *lnrpc.Route {
 	return nil
 }
 
+func (m *BuildRouteResponse) GetOnion() []byte {
+	if m != nil {
+		return m.Onion
+	}
+	return nil
+}
+
+func (m *BuildRouteResponse) GetSharedSecrets() [][]byte {
+	if m != nil {
+		return m.SharedSecrets
+	}
+	return nil
+}
+
+func (m *BuildRouteResponse) GetProbeOnly() bool {
+	if m != nil {
+		return m.ProbeOnly
+	}
+	return false
+}
+
+func (m *BuildRouteResponse) GetPaymentHash() []byte {
+	if m != nil {
+		return m.PaymentHash
+	}
+	return nil
+}
+
 type SubscribeHtlcEventsRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -1647,9 +1966,14 @@ func (m *ForwardEvent) GetInfo() *HtlcInfo {
 }
 
 type ForwardFailEvent struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	//
+	//The BOLT error code for the failure. Failures from down the route are
+	//encrypted for the sender, so this is only set if the next hop failed the
+	//htlc as malformed, otherwise it is RESERVED.
+	WireFailure          lnrpc.Failure_FailureCode `protobuf:"varint,1,opt,name=wire_failure,json=wireFailure,proto3,enum=lnrpc.Failure_FailureCode" json:"wire_failure,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
 }
 
 func (m *ForwardFailEvent) Reset()         { *m = ForwardFailEvent{} }
@@ -1681,7 +2005,20 @@ func (m *ForwardFailEvent) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ForwardFailEvent proto.InternalMessageInfo
 
+func (m *ForwardFailEvent) GetWireFailure() lnrpc.Failure_FailureCode {
+	if m != nil {
+		return m.WireFailure
+	}
+	return lnrpc.Failure_RESERVED
+}
+
 type SettleEvent struct {
+	// The revealed preimage of the settled htlc.
+	Preimage []byte `protobuf:"bytes,1,opt,name=preimage,proto3" json:"preimage,omitempty"`
+	//
+	//The routing fee in millisatoshis earned by forwarding the htlc. This is zero
+	//for sends and receives.
+	FeeMsat              uint64   `protobuf:"varint,2,opt,name=fee_msat,json=feeMsat,proto3" json:"fee_msat,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1716,6 +2053,20 @@ func (m *SettleEvent) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_SettleEvent proto.InternalMessageInfo
 
+func (m *SettleEvent) GetPreimage() []byte {
+	if m != nil {
+		return m.Preimage
+	}
+	return nil
+}
+
+func (m *SettleEvent) GetFeeMsat() uint64 {
+	if m != nil {
+		return m.FeeMsat
+	}
+	return 0
+}
+
 type LinkFailEvent struct {
 	// Info contains details about the htlc that we failed.
 	Info *HtlcInfo `protobuf:"bytes,1,opt,name=info,proto3" json:"info,omitempty"`
@@ -1929,7 +2280,8 @@ type ForwardHtlcInterceptRequest struct {
 	OutgoingAmountMsat uint64 `protobuf:"varint,3,opt,name=outgoing_amount_msat,json=outgoingAmountMsat,proto3" json:"outgoing_amount_msat,omitempty"`
 	// The outgoing htlc expiry.
 	OutgoingExpiry uint32 `protobuf:"varint,4,opt,name=outgoing_expiry,json=outgoingExpiry,proto3" json:"outgoing_expiry,omitempty"`
-	// Any custom records that were present in the payload.
+	// Any custom records in the custom type range (>= 65536) that were present
+	// in the payload.
 	CustomRecords map[uint64][]byte `protobuf:"bytes,8,rep,name=custom_records,json=customRecords,proto3" json:"custom_records,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	// The onion blob for the next hop
 	OnionBlob            []byte   `protobuf:"bytes,9,opt,name=onion_blob,json=onionBlob,proto3" json:"onion_blob,omitempty"`
@@ -2034,7 +2386,7 @@ func (m *ForwardHtlcInterceptRequest) GetOnionBlob() []byte {
 //ForwardHtlcInterceptResponse enables the caller to resolve a previously hold
 //forward. The caller can choose either to:
 //- `Resume`: Execute the default behavior (usually forward).
-//- `Reject`: Fail the htlc backwards.
+//- `Reject`: Fail the htlc backwards, optionally with a given failure code.
 //- `Settle`: Settle this htlc with a given preimage.
 type ForwardHtlcInterceptResponse struct {
 	//*
@@ -2044,10 +2396,15 @@ type ForwardHtlcInterceptResponse struct {
 	// The resolve action for this intercepted htlc.
 	Action ResolveHoldForwardAction `protobuf:"varint,2,opt,name=action,proto3,enum=routerrpc.ResolveHoldForwardAction" json:"action,omitempty"`
 	// The preimage in case the resolve action is Settle.
-	Preimage             []byte   `protobuf:"bytes,3,opt,name=preimage,proto3" json:"preimage,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Preimage []byte `protobuf:"bytes,3,opt,name=preimage,proto3" json:"preimage,omitempty"`
+	//
+	//The failure code to fail the htlc back with in case the resolve action is
+	//Fail. If unset, the htlc is failed with TEMPORARY_CHANNEL_FAILURE. Only
+	//failures that don't carry a channel update are supported.
+	FailureCode          lnrpc.Failure_FailureCode `protobuf:"varint,4,opt,name=failure_code,json=failureCode,proto3,enum=lnrpc.Failure_FailureCode" json:"failure_code,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
 }
 
 func (m *ForwardHtlcInterceptResponse) Reset()         { *m = ForwardHtlcInterceptResponse{} }
@@ -2100,230 +2457,1852 @@ func (m *ForwardHtlcInterceptResponse) GetPreimage() []byte {
 	return nil
 }
 
-func init() {
-	proto.RegisterEnum("routerrpc.FailureDetail", FailureDetail_name, FailureDetail_value)
-	proto.RegisterEnum("routerrpc.PaymentState", PaymentState_name, PaymentState_value)
-	proto.RegisterEnum("routerrpc.ResolveHoldForwardAction", ResolveHoldForwardAction_name, ResolveHoldForwardAction_value)
-	proto.RegisterEnum("routerrpc.HtlcEvent_EventType", HtlcEvent_EventType_name, HtlcEvent_EventType_value)
-	proto.RegisterType((*SendPaymentRequest)(nil), "routerrpc.SendPaymentRequest")
-	proto.RegisterMapType((map[uint64][]byte)(nil), "routerrpc.SendPaymentRequest.DestCustomRecordsEntry")
-	proto.RegisterType((*TrackPaymentRequest)(nil), "routerrpc.TrackPaymentRequest")
-	proto.RegisterType((*RouteFeeRequest)(nil), "routerrpc.RouteFeeRequest")
-	proto.RegisterType((*RouteFeeResponse)(nil), "routerrpc.RouteFeeResponse")
-	proto.RegisterType((*SendToRouteRequest)(nil), "routerrpc.SendToRouteRequest")
-	proto.RegisterType((*SendToRouteResponse)(nil), "routerrpc.SendToRouteResponse")
-	proto.RegisterType((*ResetMissionControlRequest)(nil), "routerrpc.ResetMissionControlRequest")
-	proto.RegisterType((*ResetMissionControlResponse)(nil), "routerrpc.ResetMissionControlResponse")
-	proto.RegisterType((*QueryMissionControlRequest)(nil), "routerrpc.QueryMissionControlRequest")
-	proto.RegisterType((*QueryMissionControlResponse)(nil), "routerrpc.QueryMissionControlResponse")
-	proto.RegisterType((*PairHistory)(nil), "routerrpc.PairHistory")
-	proto.RegisterType((*PairData)(nil), "routerrpc.PairData")
-	proto.RegisterType((*QueryProbabilityRequest)(nil), "routerrpc.QueryProbabilityRequest")
-	proto.RegisterType((*QueryProbabilityResponse)(nil), "routerrpc.QueryProbabilityResponse")
-	proto.RegisterType((*BuildRouteRequest)(nil), "routerrpc.BuildRouteRequest")
-	proto.RegisterType((*BuildRouteResponse)(nil), "routerrpc.BuildRouteResponse")
-	proto.RegisterType((*SubscribeHtlcEventsRequest)(nil), "routerrpc.SubscribeHtlcEventsRequest")
-	proto.RegisterType((*HtlcEvent)(nil), "routerrpc.HtlcEvent")
-	proto.RegisterType((*HtlcInfo)(nil), "routerrpc.HtlcInfo")
-	proto.RegisterType((*ForwardEvent)(nil), "routerrpc.ForwardEvent")
-	proto.RegisterType((*ForwardFailEvent)(nil), "routerrpc.ForwardFailEvent")
-	proto.RegisterType((*SettleEvent)(nil), "routerrpc.SettleEvent")
-	proto.RegisterType((*LinkFailEvent)(nil), "routerrpc.LinkFailEvent")
-	proto.RegisterType((*PaymentStatus)(nil), "routerrpc.PaymentStatus")
-	proto.RegisterType((*CircuitKey)(nil), "routerrpc.CircuitKey")
-	proto.RegisterType((*ForwardHtlcInterceptRequest)(nil), "routerrpc.ForwardHtlcInterceptRequest")
-	proto.RegisterMapType((map[uint64][]byte)(nil), "routerrpc.ForwardHtlcInterceptRequest.CustomRecordsEntry")
-	proto.RegisterType((*ForwardHtlcInterceptResponse)(nil), "routerrpc.ForwardHtlcInterceptResponse")
+func (m *ForwardHtlcInterceptResponse) GetFailureCode() lnrpc.Failure_FailureCode {
+	if m != nil {
+		return m.FailureCode
+	}
+	return lnrpc.Failure_RESERVED
 }
 
-func init() { proto.RegisterFile("routerrpc/router.proto", fileDescriptor_7a0613f69d37b0a5) }
+type GetPaymentResultRequest struct {
+	// The hash of the payment to look up.
+	PaymentHash          []byte   `protobuf:"bytes,1,opt,name=payment_hash,json=paymentHash,proto3" json:"payment_hash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
 
-var fileDescriptor_7a0613f69d37b0a5 = []byte{
-	// 2601 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x59, 0x4d, 0x73, 0xdb, 0xc6,
-	0xf9, 0x0f, 0x48, 0x88, 0x22, 0x1f, 0xbe, 0x08, 0x5a, 0x29, 0x16, 0xff, 0x94, 0x9d, 0x30, 0x4c,
-	0x62, 0x73, 0xfc, 0x4f, 0x64, 0x45, 0xed, 0xb4, 0x69, 0xf3, 0xd2, 0x50, 0x24, 0x64, 0xc1, 0xa6,
-	0x48, 0x66, 0x49, 0x39, 0x49, 0x73, 0xd8, 0x42, 0xe4, 0x52, 0x44, 0x05, 0x02, 0x2c, 0xb0, 0xb4,
-	0xa3, 0x63, 0x6f, 0x9d, 0x7e, 0x91, 0xde, 0xfa, 0x09, 0x3a, 0xd3, 0x1e, 0xfa, 0x3d, 0x7a, 0xed,
-	0xbd, 0x33, 0x3d, 0x77, 0xf6, 0x05, 0x20, 0x20, 0x51, 0x76, 0x3b, 0xed, 0xc5, 0x26, 0x7e, 0xcf,
-	0x6f, 0x9f, 0x7d, 0x76, 0x9f, 0xb7, 0xdd, 0x15, 0xdc, 0x0b, 0xfc, 0x25, 0xa3, 0x41, 0xb0, 0x18,
-	0x3f, 0x91, 0xbf, 0x0e, 0x16, 0x81, 0xcf, 0x7c, 0x54, 0x88, 0xf1, 0x5a, 0x21, 0x58, 0x8c, 0x25,
-	0xda, 0xf8, 0x47, 0x0e, 0xd0, 0x90, 0x7a, 0x93, 0x81, 0x7d, 0x3d, 0xa7, 0x1e, 0xc3, 0xf4, 0x37,
-	0x4b, 0x1a, 0x32, 0x84, 0x40, 0x9f, 0xd0, 0x90, 0x55, 0xb5, 0xba, 0xd6, 0x2c, 0x61, 0xf1, 0x1b,
-	0x19, 0x90, 0xb5, 0xe7, 0xac, 0x9a, 0xa9, 0x6b, 0xcd, 0x2c, 0xe6, 0x3f, 0xd1, 0xff, 0x41, 0xde,
-	0x9e, 0x33, 0x32, 0x0f, 0x6d, 0x56, 0x2d, 0x09, 0x78, 0xd3, 0x9e, 0xb3, 0xb3, 0xd0, 0x66, 0xe8,
-	0x3d, 0x28, 0x2d, 0xa4, 0x4a, 0x32, 0xb3, 0xc3, 0x59, 0x35, 0x2b, 0x14, 0x15, 0x15, 0x76, 0x6a,
-	0x87, 0x33, 0xd4, 0x04, 0x63, 0xea, 0x78, 0xb6, 0x4b, 0xc6, 0x2e, 0x7b, 0x49, 0x26, 0xd4, 0x65,
-	0x76, 0x55, 0xaf, 0x6b, 0xcd, 0x0d, 0x5c, 0x11, 0x78, 0xdb, 0x65, 0x2f, 0x3b, 0x1c, 0x45, 0x8f,
-	0x60, 0x2b, 0x52, 0x16, 0x48, 0x03, 0xab, 0x1b, 0x75, 0xad, 0x59, 0xc0, 0x95, 0x45, 0xda, 0xec,
-	0x47, 0xb0, 0xc5, 0x9c, 0x39, 0xf5, 0x97, 0x8c, 0x84, 0x74, 0xec, 0x7b, 0x93, 0xb0, 0x9a, 0x93,
-	0x1a, 0x15, 0x3c, 0x94, 0x28, 0x6a, 0x40, 0x79, 0x4a, 0x29, 0x71, 0x9d, 0xb9, 0xc3, 0x08, 0x37,
-	0x7f, 0x53, 0x98, 0x5f, 0x9c, 0x52, 0xda, 0xe5, 0xd8, 0xd0, 0x66, 0xe8, 0x03, 0xa8, 0xac, 0x38,
-	0x62, 0x8d, 0x65, 0x41, 0x2a, 0x45, 0x24, 0xb1, 0xd0, 0x03, 0x30, 0xfc, 0x25, 0xbb, 0xf4, 0x1d,
-	0xef, 0x92, 0x8c, 0x67, 0xb6, 0x47, 0x9c, 0x49, 0x35, 0x5f, 0xd7, 0x9a, 0xfa, 0xb1, 0x5e, 0xd5,
-	0x0e, 0x35, 0x5c, 0x89, 0xa4, 0xed, 0x99, 0xed, 0x59, 0x13, 0xf4, 0x18, 0xb6, 0x6f, 0xf2, 0xc3,
-	0xea, 0x4e, 0x3d, 0xdb, 0xd4, 0xf1, 0x56, 0x9a, 0x1a, 0xa2, 0x87, 0xb0, 0xe5, 0xda, 0x21, 0x23,
-	0x33, 0x7f, 0x41, 0x16, 0xcb, 0x8b, 0x2b, 0x7a, 0x5d, 0xad, 0x88, 0x7d, 0x2c, 0x73, 0xf8, 0xd4,
-	0x5f, 0x0c, 0x04, 0x88, 0x1e, 0x00, 0x88, 0x3d, 0x14, 0xa6, 0x56, 0x0b, 0x62, 0xc5, 0x05, 0x8e,
-	0x08, 0x33, 0xd1, 0x27, 0x50, 0x14, 0xbe, 0x27, 0x33, 0xc7, 0x63, 0x61, 0x15, 0xea, 0xd9, 0x66,
-	0xf1, 0xc8, 0x38, 0x70, 0x3d, 0x1e, 0x06, 0x98, 0x4b, 0x4e, 0x1d, 0x8f, 0x61, 0x08, 0xa2, 0x9f,
-	0x21, 0x9a, 0xc0, 0x0e, 0xf7, 0x39, 0x19, 0x2f, 0x43, 0xe6, 0xcf, 0x49, 0x40, 0xc7, 0x7e, 0x30,
-	0x09, 0xab, 0x45, 0x31, 0xf4, 0xc7, 0x07, 0x71, 0x28, 0x1d, 0xdc, 0x8e, 0x9d, 0x83, 0x0e, 0x0d,
-	0x59, 0x5b, 0x8c, 0xc3, 0x72, 0x98, 0xe9, 0xb1, 0xe0, 0x1a, 0x6f, 0x4f, 0x6e, 0xe2, 0xe8, 0x23,
-	0x40, 0xb6, 0xeb, 0xfa, 0xaf, 0x48, 0x48, 0xdd, 0x29, 0x51, 0xbe, 0xac, 0x6e, 0xd5, 0xb5, 0x66,
-	0x1e, 0x1b, 0x42, 0x32, 0xa4, 0xee, 0x54, 0xa9, 0x47, 0x3f, 0x81, 0xb2, 0xb0, 0x69, 0x4a, 0x6d,
-	0xb6, 0x0c, 0x68, 0x58, 0x35, 0xea, 0xd9, 0x66, 0xe5, 0x68, 0x5b, 0x2d, 0xe4, 0x44, 0xc2, 0xc7,
-	0x0e, 0xc3, 0x25, 0xce, 0x53, 0xdf, 0x21, 0xda, 0x87, 0xc2, 0xdc, 0xfe, 0x81, 0x2c, 0xec, 0x80,
-	0x85, 0xd5, 0xed, 0xba, 0xd6, 0x2c, 0xe3, 0xfc, 0xdc, 0xfe, 0x61, 0xc0, 0xbf, 0xd1, 0x01, 0xec,
-	0x78, 0x3e, 0x71, 0xbc, 0xa9, 0xeb, 0x5c, 0xce, 0x18, 0x59, 0x2e, 0x26, 0x36, 0xa3, 0x61, 0x15,
-	0x09, 0x1b, 0xb6, 0x3d, 0xdf, 0x52, 0x92, 0x73, 0x29, 0xa8, 0x75, 0xe0, 0xde, 0xfa, 0xf5, 0xf1,
-	0xf4, 0xe0, 0x0e, 0xe2, 0x19, 0xa3, 0x63, 0xfe, 0x13, 0xed, 0xc2, 0xc6, 0x4b, 0xdb, 0x5d, 0x52,
-	0x91, 0x32, 0x25, 0x2c, 0x3f, 0x7e, 0x9e, 0xf9, 0x54, 0x6b, 0xcc, 0x60, 0x67, 0x14, 0xd8, 0xe3,
-	0xab, 0x1b, 0x59, 0x77, 0x33, 0x69, 0xb4, 0xdb, 0x49, 0x73, 0x87, 0xbd, 0x99, 0x3b, 0xec, 0x6d,
-	0x7c, 0x09, 0x5b, 0xc2, 0xc3, 0x27, 0x94, 0xbe, 0x2e, 0xb7, 0xf7, 0x80, 0x67, 0xae, 0xc8, 0x04,
-	0x99, 0xdf, 0x39, 0x7b, 0xce, 0x93, 0xa0, 0x31, 0x01, 0x63, 0x35, 0x3e, 0x5c, 0xf8, 0x5e, 0x48,
-	0x79, 0xe2, 0xf2, 0x00, 0xe0, 0x11, 0xcc, 0x13, 0x44, 0xa4, 0x86, 0x26, 0x46, 0x55, 0x14, 0x7e,
-	0x42, 0xa9, 0x48, 0x8e, 0x87, 0x32, 0x1f, 0x89, 0xeb, 0x8f, 0xaf, 0x78, 0x86, 0xdb, 0xd7, 0x4a,
-	0x7d, 0x99, 0xc3, 0x5d, 0x7f, 0x7c, 0xd5, 0xe1, 0x60, 0xe3, 0x7b, 0x59, 0x84, 0x46, 0xbe, 0x98,
-	0xeb, 0x3f, 0xd8, 0x8e, 0x06, 0x6c, 0x88, 0x58, 0x14, 0x6a, 0x8b, 0x47, 0xa5, 0x64, 0x50, 0x63,
-	0x29, 0x6a, 0x7c, 0x0f, 0x3b, 0x29, 0xe5, 0x6a, 0x15, 0x35, 0xc8, 0x2f, 0x02, 0xea, 0xcc, 0xed,
-	0x4b, 0xaa, 0x34, 0xc7, 0xdf, 0xa8, 0x09, 0x9b, 0x53, 0xdb, 0x71, 0x97, 0x41, 0xa4, 0xb8, 0x12,
-	0x05, 0x99, 0x44, 0x71, 0x24, 0x6e, 0xdc, 0x87, 0x1a, 0xa6, 0x21, 0x65, 0x67, 0x4e, 0x18, 0x3a,
-	0xbe, 0xd7, 0xf6, 0x3d, 0x16, 0xf8, 0xae, 0x5a, 0x41, 0xe3, 0x01, 0xec, 0xaf, 0x95, 0x4a, 0x13,
-	0xf8, 0xe0, 0xaf, 0x97, 0x34, 0xb8, 0x5e, 0x3f, 0xf8, 0x6b, 0xd8, 0x5f, 0x2b, 0x55, 0xf6, 0x7f,
-	0x04, 0x1b, 0x0b, 0xdb, 0x09, 0xb8, 0xef, 0x79, 0x52, 0xde, 0x4b, 0x24, 0xe5, 0xc0, 0x76, 0x82,
-	0x53, 0x27, 0x64, 0x7e, 0x70, 0x8d, 0x25, 0xe9, 0x99, 0x9e, 0xd7, 0x8c, 0x4c, 0xe3, 0xf7, 0x1a,
-	0x14, 0x13, 0x42, 0x9e, 0x1a, 0x9e, 0x3f, 0xa1, 0x64, 0x1a, 0xf8, 0xf3, 0x68, 0x13, 0x38, 0x70,
-	0x12, 0xf8, 0x73, 0x1e, 0x13, 0x42, 0xc8, 0x7c, 0x15, 0xc0, 0x39, 0xfe, 0x39, 0xf2, 0xd1, 0xc7,
-	0xb0, 0x39, 0x93, 0x0a, 0x44, 0xd9, 0x2c, 0x1e, 0xed, 0xdc, 0x98, 0xbb, 0x63, 0x33, 0x1b, 0x47,
-	0x9c, 0x67, 0x7a, 0x3e, 0x6b, 0xe8, 0xcf, 0xf4, 0xbc, 0x6e, 0x6c, 0x3c, 0xd3, 0xf3, 0x1b, 0x46,
-	0xee, 0x99, 0x9e, 0xcf, 0x19, 0x9b, 0x8d, 0xbf, 0x6b, 0x90, 0x8f, 0xd8, 0xdc, 0x12, 0xbe, 0xa5,
-	0x84, 0xc7, 0x85, 0x0a, 0xa6, 0x3c, 0x07, 0x46, 0xce, 0x9c, 0xa2, 0x3a, 0x94, 0x84, 0x30, 0x1d,
-	0xa2, 0xc0, 0xb1, 0x96, 0x08, 0x53, 0x51, 0xcf, 0x23, 0x86, 0x88, 0x47, 0x5d, 0xd5, 0x73, 0x49,
-	0x89, 0x5a, 0x52, 0xb8, 0x1c, 0x8f, 0x69, 0x18, 0xca, 0x59, 0x36, 0x24, 0x45, 0x61, 0x62, 0xa2,
-	0x87, 0xb0, 0x15, 0x51, 0xa2, 0xb9, 0x72, 0x32, 0x5e, 0x15, 0xac, 0xa6, 0x6b, 0x82, 0x91, 0xe4,
-	0xcd, 0x57, 0x1d, 0xa4, 0xb2, 0x22, 0xf2, 0x49, 0xe5, 0xe2, 0x1b, 0xbf, 0x86, 0x3d, 0xe1, 0xca,
-	0x41, 0xe0, 0x5f, 0xd8, 0x17, 0x8e, 0xeb, 0xb0, 0xeb, 0x28, 0xc8, 0xf9, 0xc2, 0x03, 0x7f, 0x4e,
-	0xf8, 0xde, 0x46, 0x2e, 0xe0, 0x40, 0xcf, 0x9f, 0x50, 0xee, 0x02, 0xe6, 0x4b, 0x91, 0x72, 0x01,
-	0xf3, 0x85, 0x20, 0xd9, 0x79, 0xb3, 0xa9, 0xce, 0xdb, 0xb8, 0x82, 0xea, 0xed, 0xb9, 0x54, 0xcc,
-	0xd4, 0xa1, 0xb8, 0x58, 0xc1, 0x62, 0x3a, 0x0d, 0x27, 0xa1, 0xa4, 0x6f, 0x33, 0x6f, 0xf6, 0x6d,
-	0xe3, 0x0f, 0x1a, 0x6c, 0x1f, 0x2f, 0x1d, 0x77, 0x92, 0x4a, 0xdc, 0xa4, 0x75, 0x5a, 0xfa, 0x5c,
-	0xb0, 0xae, 0xe9, 0x67, 0xd6, 0x36, 0xfd, 0x8f, 0xd6, 0x34, 0xd6, 0xac, 0x68, 0xac, 0x99, 0x35,
-	0x6d, 0xf5, 0x5d, 0x28, 0xae, 0xba, 0x64, 0x58, 0xd5, 0xeb, 0xd9, 0x66, 0x09, 0xc3, 0x2c, 0x6a,
-	0x91, 0x61, 0xe3, 0x53, 0x40, 0x49, 0x43, 0xd5, 0x86, 0xc4, 0xf5, 0x43, 0xbb, 0xbb, 0x7e, 0xdc,
-	0x87, 0xda, 0x70, 0x79, 0x11, 0x8e, 0x03, 0xe7, 0x82, 0x9e, 0x32, 0x77, 0x6c, 0xbe, 0xa4, 0x1e,
-	0x0b, 0xa3, 0x2c, 0xfd, 0xa7, 0x0e, 0x85, 0x18, 0xe5, 0xe5, 0xd9, 0xf1, 0xc6, 0xfe, 0x3c, 0x32,
-	0xda, 0xa3, 0x2e, 0xb7, 0x5b, 0x36, 0x85, 0xed, 0x48, 0xd4, 0x96, 0x12, 0x6b, 0xc2, 0xf9, 0xa9,
-	0x45, 0x2a, 0x7e, 0x46, 0xf2, 0x93, 0x6b, 0x94, 0xfc, 0x26, 0x18, 0xb1, 0xfe, 0x19, 0x73, 0xc7,
-	0xf1, 0xa6, 0xe0, 0x4a, 0x84, 0x73, 0x63, 0x24, 0x33, 0xd6, 0x1c, 0x31, 0x75, 0xc9, 0x8c, 0x70,
-	0xc5, 0x7c, 0x0f, 0x4a, 0x3c, 0x1f, 0x42, 0x66, 0xcf, 0x17, 0xc4, 0x0b, 0x45, 0x5e, 0xe8, 0xb8,
-	0x18, 0x63, 0xbd, 0x10, 0x7d, 0x01, 0x40, 0xf9, 0xfa, 0x08, 0xbb, 0x5e, 0x50, 0x91, 0x12, 0x95,
-	0xa3, 0x77, 0x12, 0x81, 0x11, 0x6f, 0xc0, 0x81, 0xf8, 0x77, 0x74, 0xbd, 0xa0, 0xb8, 0x40, 0xa3,
-	0x9f, 0xe8, 0x4b, 0x28, 0x4f, 0xfd, 0xe0, 0x95, 0x1d, 0x4c, 0x88, 0x00, 0x55, 0xd9, 0xd8, 0x4b,
-	0x68, 0x38, 0x91, 0x72, 0x31, 0xfc, 0xf4, 0x2d, 0x5c, 0x9a, 0x26, 0xbe, 0xd1, 0x73, 0x40, 0xd1,
-	0x78, 0x91, 0xe5, 0x52, 0x49, 0x5e, 0x28, 0xd9, 0xbf, 0xad, 0x84, 0x17, 0xe9, 0x48, 0x91, 0x31,
-	0xbd, 0x81, 0xa1, 0xcf, 0xa0, 0x14, 0x52, 0xc6, 0x5c, 0xaa, 0xd4, 0x14, 0x84, 0x9a, 0x7b, 0xa9,
-	0x33, 0x0d, 0x17, 0x47, 0x1a, 0x8a, 0xe1, 0xea, 0x13, 0x1d, 0xc3, 0x96, 0xeb, 0x78, 0x57, 0x49,
-	0x33, 0x40, 0x8c, 0xaf, 0x26, 0xc6, 0x77, 0x1d, 0xef, 0x2a, 0x69, 0x43, 0xd9, 0x4d, 0x02, 0x8d,
-	0xcf, 0xa1, 0x10, 0xef, 0x12, 0x2a, 0xc2, 0xe6, 0x79, 0xef, 0x79, 0xaf, 0xff, 0x4d, 0xcf, 0x78,
-	0x0b, 0xe5, 0x41, 0x1f, 0x9a, 0xbd, 0x8e, 0xa1, 0x71, 0x18, 0x9b, 0x6d, 0xd3, 0x7a, 0x61, 0x1a,
-	0x19, 0xfe, 0x71, 0xd2, 0xc7, 0xdf, 0xb4, 0x70, 0xc7, 0xc8, 0x1e, 0x6f, 0xc2, 0x86, 0x98, 0xb7,
-	0xf1, 0x27, 0x0d, 0xf2, 0xc2, 0x83, 0xde, 0xd4, 0x47, 0xff, 0x0f, 0x71, 0x70, 0x89, 0xe2, 0xc6,
-	0x1b, 0xae, 0x88, 0xba, 0x32, 0x8e, 0x03, 0x66, 0xa4, 0x70, 0x4e, 0x8e, 0x43, 0x23, 0x26, 0x67,
-	0x24, 0x39, 0x12, 0xc4, 0xe4, 0xc7, 0x09, 0xcd, 0xa9, 0x92, 0xa3, 0xe3, 0xad, 0x48, 0x10, 0x55,
-	0xd8, 0xe4, 0xd9, 0x36, 0x55, 0x89, 0x13, 0x67, 0x5b, 0xc5, 0x6d, 0xfc, 0x14, 0x4a, 0x49, 0x9f,
-	0xa3, 0x47, 0xa0, 0x3b, 0xde, 0xd4, 0x57, 0x89, 0xb8, 0x73, 0x23, 0xb8, 0xf8, 0x22, 0xb1, 0x20,
-	0x34, 0x10, 0x18, 0x37, 0xfd, 0xdc, 0x28, 0x43, 0x31, 0xe1, 0xb4, 0xc6, 0xdf, 0x34, 0x28, 0xa7,
-	0x9c, 0xf0, 0x6f, 0x6b, 0x47, 0x5f, 0x40, 0xe9, 0x95, 0x13, 0x50, 0x92, 0x6c, 0xff, 0x95, 0xa3,
-	0x5a, 0xba, 0xfd, 0x47, 0xff, 0xb7, 0xfd, 0x09, 0xc5, 0x45, 0xce, 0x57, 0x00, 0xfa, 0x05, 0x54,
-	0xd4, 0x48, 0x32, 0xa1, 0xcc, 0x76, 0x5c, 0xb1, 0x55, 0x95, 0x54, 0x78, 0x28, 0x6e, 0x47, 0xc8,
-	0x71, 0x79, 0x9a, 0xfc, 0x44, 0x1f, 0xae, 0x14, 0x84, 0x2c, 0x70, 0xbc, 0x4b, 0xb1, 0x7f, 0x85,
-	0x98, 0x36, 0x14, 0x20, 0x6f, 0xe4, 0x65, 0x75, 0x78, 0x1c, 0x32, 0x9b, 0x2d, 0x43, 0xf4, 0x31,
-	0x6c, 0x84, 0xcc, 0x56, 0x95, 0xac, 0x92, 0xca, 0xad, 0x04, 0x91, 0x62, 0xc9, 0x4a, 0x9d, 0x7e,
-	0x32, 0xb7, 0x4e, 0x3f, 0x1b, 0xbc, 0x62, 0xc8, 0x2a, 0x5a, 0x3c, 0x42, 0x6a, 0xf1, 0xa7, 0xa3,
-	0x6e, 0xbb, 0xc5, 0x18, 0x9d, 0x2f, 0x18, 0x96, 0x04, 0xd5, 0xdd, 0xbe, 0x04, 0x68, 0x3b, 0xc1,
-	0x78, 0xe9, 0xb0, 0xe7, 0xf4, 0x9a, 0xf7, 0xac, 0xa8, 0x5c, 0xcb, 0xb2, 0x97, 0x1b, 0xcb, 0x12,
-	0xbd, 0x07, 0x9b, 0x51, 0x21, 0x92, 0xf5, 0x2d, 0x37, 0x13, 0x05, 0xa8, 0xf1, 0x67, 0x1d, 0xf6,
-	0x95, 0x4b, 0xa5, 0x37, 0x18, 0x0d, 0xc6, 0x74, 0x11, 0x1f, 0x8b, 0x9f, 0xc2, 0xee, 0xaa, 0xa8,
-	0xca, 0x89, 0x48, 0x74, 0xd4, 0x2e, 0x1e, 0xbd, 0x9d, 0x58, 0xe9, 0xca, 0x0c, 0x8c, 0xe2, 0x62,
-	0xbb, 0x32, 0xed, 0x30, 0xa1, 0xc8, 0x9e, 0xfb, 0x4b, 0x4f, 0x85, 0xa8, 0xac, 0x78, 0x68, 0x15,
-	0xce, 0x5c, 0x24, 0x22, 0xfa, 0x11, 0xc4, 0x41, 0x4e, 0xe8, 0x0f, 0x0b, 0x27, 0xb8, 0x16, 0xd5,
-	0xaf, 0xbc, 0x2a, 0xb7, 0xa6, 0x40, 0x6f, 0x9d, 0x55, 0x33, 0xb7, 0xcf, 0xaa, 0x9f, 0x41, 0x2d,
-	0xce, 0x0e, 0x75, 0x8d, 0xa5, 0x93, 0xb8, 0xb5, 0x6d, 0x0a, 0x1b, 0xf6, 0x22, 0x06, 0x8e, 0x08,
-	0xaa, 0xbf, 0x1d, 0xc2, 0x6e, 0x22, 0xb5, 0x56, 0xa6, 0xcb, 0x4c, 0x44, 0xab, 0xec, 0x4a, 0x9a,
-	0x1e, 0x8f, 0x50, 0xa6, 0xeb, 0xd2, 0xf4, 0x08, 0x56, 0xa6, 0xff, 0x0a, 0x2a, 0x37, 0xae, 0x79,
-	0x79, 0xe1, 0xf7, 0x9f, 0xdd, 0xae, 0xac, 0xeb, 0xdc, 0x73, 0xb0, 0xe6, 0xae, 0x57, 0x1e, 0xa7,
-	0xee, 0x79, 0x0f, 0x00, 0x7c, 0xcf, 0xf1, 0x3d, 0x72, 0xe1, 0xfa, 0x17, 0xa2, 0xe0, 0x96, 0x70,
-	0x41, 0x20, 0xc7, 0xae, 0x7f, 0x51, 0xfb, 0x0a, 0xd0, 0x7f, 0x79, 0x9f, 0xfa, 0x8b, 0x06, 0xf7,
-	0xd7, 0x9b, 0xa8, 0xfa, 0xfc, 0xff, 0x2c, 0x84, 0x3e, 0x83, 0x9c, 0x3d, 0x66, 0x8e, 0xef, 0xa9,
-	0xca, 0xf0, 0x7e, 0x62, 0x28, 0xa6, 0xa1, 0xef, 0xbe, 0xa4, 0xa7, 0xbe, 0x3b, 0x51, 0xc6, 0xb4,
-	0x04, 0x15, 0xab, 0x21, 0xa9, 0xa4, 0xcb, 0xa6, 0x93, 0xee, 0xf1, 0x6f, 0x75, 0x28, 0xa7, 0x2a,
-	0x43, 0xba, 0x35, 0x94, 0xa1, 0xd0, 0xeb, 0x93, 0x8e, 0x39, 0x6a, 0x59, 0x5d, 0x43, 0x43, 0x06,
-	0x94, 0xfa, 0x3d, 0xab, 0xdf, 0x23, 0x1d, 0xb3, 0xdd, 0xef, 0xf0, 0x26, 0xf1, 0x36, 0x6c, 0x77,
-	0xad, 0xde, 0x73, 0xd2, 0xeb, 0x8f, 0x88, 0xd9, 0xb5, 0x9e, 0x5a, 0xc7, 0x5d, 0xd3, 0xc8, 0xa2,
-	0x5d, 0x30, 0xfa, 0x3d, 0xd2, 0x3e, 0x6d, 0x59, 0x3d, 0x32, 0xb2, 0xce, 0xcc, 0xfe, 0xf9, 0xc8,
-	0xd0, 0x39, 0xca, 0xb3, 0x99, 0x98, 0xdf, 0xb6, 0x4d, 0xb3, 0x33, 0x24, 0x67, 0xad, 0x6f, 0x8d,
-	0x0d, 0x54, 0x85, 0x5d, 0xab, 0x37, 0x3c, 0x3f, 0x39, 0xb1, 0xda, 0x96, 0xd9, 0x1b, 0x91, 0xe3,
-	0x56, 0xb7, 0xd5, 0x6b, 0x9b, 0x46, 0x0e, 0xdd, 0x03, 0x64, 0xf5, 0xda, 0xfd, 0xb3, 0x41, 0xd7,
-	0x1c, 0x99, 0x24, 0x6a, 0x46, 0x9b, 0x68, 0x07, 0xb6, 0x84, 0x9e, 0x56, 0xa7, 0x43, 0x4e, 0x5a,
-	0x56, 0xd7, 0xec, 0x18, 0x79, 0x6e, 0x89, 0x62, 0x0c, 0x49, 0xc7, 0x1a, 0xb6, 0x8e, 0x39, 0x5c,
-	0xe0, 0x73, 0x5a, 0xbd, 0x17, 0x7d, 0xab, 0x6d, 0x92, 0x36, 0x57, 0xcb, 0x51, 0xe0, 0xe4, 0x08,
-	0x3d, 0xef, 0x75, 0x4c, 0x3c, 0x68, 0x59, 0x1d, 0xa3, 0x88, 0xf6, 0x61, 0x2f, 0x82, 0xcd, 0x6f,
-	0x07, 0x16, 0xfe, 0x8e, 0x8c, 0xfa, 0x7d, 0x32, 0xec, 0xf7, 0x7b, 0x46, 0x29, 0xa9, 0x89, 0xaf,
-	0xb6, 0x3f, 0x30, 0x7b, 0x46, 0x19, 0xed, 0xc1, 0xce, 0xd9, 0x60, 0x40, 0x22, 0x49, 0xb4, 0xd8,
-	0x0a, 0xa7, 0xb7, 0x3a, 0x1d, 0x6c, 0x0e, 0x87, 0xe4, 0xcc, 0x1a, 0x9e, 0xb5, 0x46, 0xed, 0x53,
-	0x63, 0x8b, 0x2f, 0x69, 0x68, 0x8e, 0xc8, 0xa8, 0x3f, 0x6a, 0x75, 0x57, 0xb8, 0xc1, 0x0d, 0x5a,
-	0xe1, 0x7c, 0xd2, 0x6e, 0xff, 0x1b, 0x63, 0x9b, 0x6f, 0x38, 0x87, 0xfb, 0x2f, 0x94, 0x89, 0x88,
-	0xaf, 0x5d, 0xb9, 0x27, 0x9a, 0xd3, 0xd8, 0xe1, 0xa0, 0xd5, 0x7b, 0xd1, 0xea, 0x5a, 0x1d, 0xf2,
-	0xdc, 0xfc, 0x4e, 0x34, 0xf3, 0x5d, 0x0e, 0x4a, 0xcb, 0xc8, 0x00, 0xf7, 0x9f, 0x72, 0x43, 0x8c,
-	0xb7, 0x11, 0x82, 0x4a, 0xdb, 0xc2, 0xed, 0xf3, 0x6e, 0x0b, 0x13, 0xdc, 0x3f, 0x1f, 0x99, 0xc6,
-	0xbd, 0xc7, 0x7f, 0xd4, 0xa0, 0x94, 0x2c, 0xd6, 0xdc, 0xeb, 0x56, 0x8f, 0x9c, 0x74, 0xad, 0xa7,
-	0xa7, 0x23, 0x19, 0x04, 0xc3, 0xf3, 0x36, 0x77, 0x99, 0xc9, 0x0f, 0x09, 0x08, 0x2a, 0x72, 0xd3,
-	0xe3, 0xc5, 0x66, 0xf8, 0x5c, 0x0a, 0xeb, 0xf5, 0x95, 0xde, 0x2c, 0x37, 0x5e, 0x81, 0x26, 0xc6,
-	0x7d, 0x6c, 0xe8, 0xe8, 0x03, 0xa8, 0x2b, 0x84, 0xfb, 0x15, 0x63, 0xb3, 0x3d, 0x22, 0x83, 0xd6,
-	0x77, 0x67, 0xdc, 0xed, 0x32, 0xc8, 0x86, 0xc6, 0x06, 0x7a, 0x17, 0xf6, 0x63, 0xd6, 0xba, 0xb8,
-	0x78, 0xfc, 0x39, 0x54, 0xef, 0x0a, 0x7a, 0x04, 0x90, 0x1b, 0x9a, 0xa3, 0x51, 0xd7, 0x94, 0x07,
-	0x9b, 0x13, 0x19, 0xb8, 0x00, 0x39, 0x6c, 0x0e, 0xcf, 0xcf, 0x4c, 0x23, 0x73, 0xf4, 0xd7, 0x3c,
-	0xe4, 0xc4, 0x49, 0x3b, 0x40, 0x5f, 0x41, 0x39, 0xf1, 0x92, 0xf4, 0xe2, 0x08, 0x3d, 0x78, 0xed,
-	0x1b, 0x53, 0x2d, 0xba, 0x8f, 0x2b, 0xf8, 0x50, 0x43, 0xc7, 0x50, 0x49, 0x3e, 0xa9, 0xbc, 0x38,
-	0x42, 0xc9, 0x03, 0xea, 0x9a, 0xd7, 0x96, 0x35, 0x3a, 0x9e, 0x83, 0x61, 0x86, 0xcc, 0x99, 0xf3,
-	0x3e, 0xa9, 0x1e, 0x3d, 0x50, 0x2d, 0x99, 0xe0, 0xe9, 0x97, 0x94, 0xda, 0xfe, 0x5a, 0x99, 0x2a,
-	0x39, 0x5f, 0xf3, 0x33, 0x49, 0xfc, 0xec, 0x70, 0x6b, 0x41, 0xe9, 0xb7, 0x8e, 0xda, 0x3b, 0x77,
-	0x89, 0xd5, 0x53, 0x41, 0xf6, 0x77, 0x19, 0xbe, 0xc6, 0x72, 0x42, 0xb6, 0x66, 0x97, 0x6e, 0x28,
-	0x5d, 0xd3, 0xb9, 0xd1, 0x04, 0x76, 0xd6, 0x3c, 0x49, 0xa0, 0x0f, 0xd3, 0x75, 0xec, 0x8e, 0x07,
-	0x8d, 0xda, 0xc3, 0x37, 0xd1, 0xd4, 0xe2, 0x27, 0xb0, 0xb3, 0xe6, 0xed, 0x22, 0x35, 0xcb, 0xdd,
-	0x2f, 0x1f, 0xa9, 0x59, 0x5e, 0xf7, 0x04, 0xf2, 0x3d, 0x18, 0x37, 0xaf, 0xba, 0xa8, 0x71, 0x73,
-	0xec, 0xed, 0x3b, 0x77, 0xed, 0xfd, 0xd7, 0x72, 0x94, 0x72, 0x0b, 0x60, 0x75, 0x61, 0x44, 0xf7,
-	0x13, 0x43, 0x6e, 0x5d, 0x78, 0x6b, 0x0f, 0xee, 0x90, 0x2a, 0x55, 0x23, 0xd8, 0x59, 0x73, 0x83,
-	0x4c, 0xed, 0xc6, 0xdd, 0x37, 0xcc, 0xda, 0xee, 0xba, 0x8b, 0xd6, 0xa1, 0x86, 0xce, 0x64, 0x80,
-	0x45, 0xcf, 0xa3, 0x6f, 0xc8, 0x98, 0xea, 0xfa, 0x03, 0xe1, 0x32, 0x14, 0xa1, 0x75, 0xa8, 0xa1,
-	0x3e, 0x94, 0x92, 0x59, 0xf2, 0xc6, 0xf4, 0x79, 0xa3, 0xc2, 0x29, 0x6c, 0xa5, 0x9a, 0xb1, 0x1f,
-	0xa0, 0x47, 0x6f, 0x3c, 0x52, 0xc8, 0x1d, 0x4b, 0x45, 0xc0, 0x6b, 0xce, 0x1e, 0x4d, 0xed, 0x50,
-	0x3b, 0xfe, 0xe4, 0x97, 0x4f, 0x2e, 0x1d, 0x36, 0x5b, 0x5e, 0x1c, 0x8c, 0xfd, 0xf9, 0x13, 0xf1,
-	0xfa, 0xe9, 0x39, 0xde, 0xa5, 0x47, 0xd9, 0x2b, 0x3f, 0xb8, 0x7a, 0xe2, 0x7a, 0x93, 0x27, 0x22,
-	0x0d, 0x9e, 0xc4, 0x2a, 0x2f, 0x72, 0xe2, 0x8f, 0x1f, 0x3f, 0xfa, 0x57, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0x03, 0xef, 0xc7, 0x4a, 0x2c, 0x19, 0x00, 0x00,
+func (m *GetPaymentResultRequest) Reset()         { *m = GetPaymentResultRequest{} }
+func (m *GetPaymentResultRequest) String() string { return proto.CompactTextString(m) }
+func (*GetPaymentResultRequest) ProtoMessage()    {}
+func (*GetPaymentResultRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a0613f69d37b0a5, []int{27}
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var (
-	_ context.Context
-	_ grpc.ClientConn
-)
+func (m *GetPaymentResultRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPaymentResultRequest.Unmarshal(m, b)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *GetPaymentResultRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPaymentResultRequest.Marshal(b, m, deterministic)
+}
 
-// RouterClient is the client API for Router service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type RouterClient interface {
+func (m *GetPaymentResultRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPaymentResultRequest.Merge(m, src)
+}
+
+func (m *GetPaymentResultRequest) XXX_Size() int {
+	return xxx_messageInfo_GetPaymentResultRequest.Size(m)
+}
+
+func (m *GetPaymentResultRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPaymentResultRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPaymentResultRequest proto.InternalMessageInfo
+
+func (m *GetPaymentResultRequest) GetPaymentHash() []byte {
+	if m != nil {
+		return m.PaymentHash
+	}
+	return nil
+}
+
+type SubscribeChannelGraphRequest struct {
 	//
-	//SendPaymentV2 attempts to route a payment described by the passed
-	//PaymentRequest to the final destination. The call returns a stream of
-	//payment updates.
-	SendPaymentV2(ctx context.Context, in *SendPaymentRequest, opts ...grpc.CallOption) (Router_SendPaymentV2Client, error)
+	//If set, the current channel graph is sent as a series of updates marked as
+	//snapshot before any live changes.
+	IncludeSnapshot      bool     `protobuf:"varint,1,opt,name=include_snapshot,json=includeSnapshot,proto3" json:"include_snapshot,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SubscribeChannelGraphRequest) Reset()         { *m = SubscribeChannelGraphRequest{} }
+func (m *SubscribeChannelGraphRequest) String() string { return proto.CompactTextString(m) }
+func (*SubscribeChannelGraphRequest) ProtoMessage()    {}
+func (*SubscribeChannelGraphRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a0613f69d37b0a5, []int{28}
+}
+
+func (m *SubscribeChannelGraphRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubscribeChannelGraphRequest.Unmarshal(m, b)
+}
+
+func (m *SubscribeChannelGraphRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubscribeChannelGraphRequest.Marshal(b, m, deterministic)
+}
+
+func (m *SubscribeChannelGraphRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribeChannelGraphRequest.Merge(m, src)
+}
+
+func (m *SubscribeChannelGraphRequest) XXX_Size() int {
+	return xxx_messageInfo_SubscribeChannelGraphRequest.Size(m)
+}
+
+func (m *SubscribeChannelGraphRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribeChannelGraphRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscribeChannelGraphRequest proto.InternalMessageInfo
+
+func (m *SubscribeChannelGraphRequest) GetIncludeSnapshot() bool {
+	if m != nil {
+		return m.IncludeSnapshot
+	}
+	return false
+}
+
+type ChannelGraphUpdate struct {
+	// The nodes, channel policies and closed channels in this update.
+	Update *lnrpc.GraphTopologyUpdate `protobuf:"bytes,1,opt,name=update,proto3" json:"update,omitempty"`
+	//
+	//Whether this update is part of the initial snapshot of the channel graph
+	//rather than a live change.
+	Snapshot bool `protobuf:"varint,2,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+	//
+	//Set on the last update of the initial snapshot. All updates that follow are
+	//live changes, some of which may already be reflected in the snapshot.
+	SnapshotComplete     bool     `protobuf:"varint,3,opt,name=snapshot_complete,json=snapshotComplete,proto3" json:"snapshot_complete,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ChannelGraphUpdate) Reset()         { *m = ChannelGraphUpdate{} }
+func (m *ChannelGraphUpdate) String() string { return proto.CompactTextString(m) }
+func (*ChannelGraphUpdate) ProtoMessage()    {}
+func (*ChannelGraphUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a0613f69d37b0a5, []int{29}
+}
+
+func (m *ChannelGraphUpdate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChannelGraphUpdate.Unmarshal(m, b)
+}
+
+func (m *ChannelGraphUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChannelGraphUpdate.Marshal(b, m, deterministic)
+}
+
+func (m *ChannelGraphUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelGraphUpdate.Merge(m, src)
+}
+
+func (m *ChannelGraphUpdate) XXX_Size() int {
+	return xxx_messageInfo_ChannelGraphUpdate.Size(m)
+}
+
+func (m *ChannelGraphUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelGraphUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelGraphUpdate proto.InternalMessageInfo
+
+func (m *ChannelGraphUpdate) GetUpdate() *lnrpc.GraphTopologyUpdate {
+	if m != nil {
+		return m.Update
+	}
+	return nil
+}
+
+func (m *ChannelGraphUpdate) GetSnapshot() bool {
+	if m != nil {
+		return m.Snapshot
+	}
+	return false
+}
+
+func (m *ChannelGraphUpdate) GetSnapshotComplete() bool {
+	if m != nil {
+		return m.SnapshotComplete
+	}
+	return false
+}
+
+type AddExcludedNodeRequest struct {
+	// The identity pubkey of the node to exclude.
+	Node                 []byte   `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AddExcludedNodeRequest) Reset()         { *m = AddExcludedNodeRequest{} }
+func (m *AddExcludedNodeRequest) String() string { return proto.CompactTextString(m) }
+func (*AddExcludedNodeRequest) ProtoMessage()    {}
+func (*AddExcludedNodeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a0613f69d37b0a5, []int{30}
+}
+
+func (m *AddExcludedNodeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddExcludedNodeRequest.Unmarshal(m, b)
+}
+
+func (m *AddExcludedNodeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddExcludedNodeRequest.Marshal(b, m, deterministic)
+}
+
+func (m *AddExcludedNodeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddExcludedNodeRequest.Merge(m, src)
+}
+
+func (m *AddExcludedNodeRequest) XXX_Size() int {
+	return xxx_messageInfo_AddExcludedNodeRequest.Size(m)
+}
+
+func (m *AddExcludedNodeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddExcludedNodeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddExcludedNodeRequest proto.InternalMessageInfo
+
+func (m *AddExcludedNodeRequest) GetNode() []byte {
+	if m != nil {
+		return m.Node
+	}
+	return nil
+}
+
+type AddExcludedNodeResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AddExcludedNodeResponse) Reset()         { *m = AddExcludedNodeResponse{} }
+func (m *AddExcludedNodeResponse) String() string { return proto.CompactTextString(m) }
+func (*AddExcludedNodeResponse) ProtoMessage()    {}
+func (*AddExcludedNodeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a0613f69d37b0a5, []int{31}
+}
+
+func (m *AddExcludedNodeResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddExcludedNodeResponse.Unmarshal(m, b)
+}
+
+func (m *AddExcludedNodeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddExcludedNodeResponse.Marshal(b, m, deterministic)
+}
+
+func (m *AddExcludedNodeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddExcludedNodeResponse.Merge(m, src)
+}
+
+func (m *AddExcludedNodeResponse) XXX_Size() int {
+	return xxx_messageInfo_AddExcludedNodeResponse.Size(m)
+}
+
+func (m *AddExcludedNodeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddExcludedNodeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddExcludedNodeResponse proto.InternalMessageInfo
+
+type RemoveExcludedNodeRequest struct {
+	// The identity pubkey of the node to no longer exclude.
+	Node                 []byte   `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RemoveExcludedNodeRequest) Reset()         { *m = RemoveExcludedNodeRequest{} }
+func (m *RemoveExcludedNodeRequest) String() string { return proto.CompactTextString(m) }
+func (*RemoveExcludedNodeRequest) ProtoMessage()    {}
+func (*RemoveExcludedNodeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a0613f69d37b0a5, []int{32}
+}
+
+func (m *RemoveExcludedNodeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemoveExcludedNodeRequest.Unmarshal(m, b)
+}
+
+func (m *RemoveExcludedNodeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemoveExcludedNodeRequest.Marshal(b, m, deterministic)
+}
+
+func (m *RemoveExcludedNodeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveExcludedNodeRequest.Merge(m, src)
+}
+
+func (m *RemoveExcludedNodeRequest) XXX_Size() int {
+	return xxx_messageInfo_RemoveExcludedNodeRequest.Size(m)
+}
+
+func (m *RemoveExcludedNodeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveExcludedNodeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoveExcludedNodeRequest proto.InternalMessageInfo
+
+func (m *RemoveExcludedNodeRequest) GetNode() []byte {
+	if m != nil {
+		return m.Node
+	}
+	return nil
+}
+
+type RemoveExcludedNodeResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RemoveExcludedNodeResponse) Reset()         { *m = RemoveExcludedNodeResponse{} }
+func (m *RemoveExcludedNodeResponse) String() string { return proto.CompactTextString(m) }
+func (*RemoveExcludedNodeResponse) ProtoMessage()    {}
+func (*RemoveExcludedNodeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a0613f69d37b0a5, []int{33}
+}
+
+func (m *RemoveExcludedNodeResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemoveExcludedNodeResponse.Unmarshal(m, b)
+}
+
+func (m *RemoveExcludedNodeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemoveExcludedNodeResponse.Marshal(b, m, deterministic)
+}
+
+func (m *RemoveExcludedNodeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveExcludedNodeResponse.Merge(m, src)
+}
+
+func (m *RemoveExcludedNodeResponse) XXX_Size() int {
+	return xxx_messageInfo_RemoveExcludedNodeResponse.Size(m)
+}
+
+func (m *RemoveExcludedNodeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveExcludedNodeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoveExcludedNodeResponse proto.InternalMessageInfo
+
+type ListExcludedNodesRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListExcludedNodesRequest) Reset()         { *m = ListExcludedNodesRequest{} }
+func (m *ListExcludedNodesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListExcludedNodesRequest) ProtoMessage()    {}
+func (*ListExcludedNodesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a0613f69d37b0a5, []int{34}
+}
+
+func (m *ListExcludedNodesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListExcludedNodesRequest.Unmarshal(m, b)
+}
+
+func (m *ListExcludedNodesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListExcludedNodesRequest.Marshal(b, m, deterministic)
+}
+
+func (m *ListExcludedNodesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListExcludedNodesRequest.Merge(m, src)
+}
+
+func (m *ListExcludedNodesRequest) XXX_Size() int {
+	return xxx_messageInfo_ListExcludedNodesRequest.Size(m)
+}
+
+func (m *ListExcludedNodesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListExcludedNodesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListExcludedNodesRequest proto.InternalMessageInfo
+
+type ListExcludedNodesResponse struct {
+	// The identity pubkeys of the excluded nodes, sorted.
+	Nodes                [][]byte `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListExcludedNodesResponse) Reset()         { *m = ListExcludedNodesResponse{} }
+func (m *ListExcludedNodesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListExcludedNodesResponse) ProtoMessage()    {}
+func (*ListExcludedNodesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a0613f69d37b0a5, []int{35}
+}
+
+func (m *ListExcludedNodesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListExcludedNodesResponse.Unmarshal(m, b)
+}
+
+func (m *ListExcludedNodesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListExcludedNodesResponse.Marshal(b, m, deterministic)
+}
+
+func (m *ListExcludedNodesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListExcludedNodesResponse.Merge(m, src)
+}
+
+func (m *ListExcludedNodesResponse) XXX_Size() int {
+	return xxx_messageInfo_ListExcludedNodesResponse.Size(m)
+}
+
+func (m *ListExcludedNodesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListExcludedNodesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListExcludedNodesResponse proto.InternalMessageInfo
+
+func (m *ListExcludedNodesResponse) GetNodes() [][]byte {
+	if m != nil {
+		return m.Nodes
+	}
+	return nil
+}
+
+type QueryRouteProbabilityRequest struct {
+	// The amount for which to calculate the probabilities, in millisats.
+	AmtMsat int64 `protobuf:"varint,1,opt,name=amt_msat,json=amtMsat,proto3" json:"amt_msat,omitempty"`
+	//
+	//A list of hops that defines the route. This does not include the source
+	//hop pubkey, the route starts at our own node.
+	HopPubkeys           [][]byte `protobuf:"bytes,2,rep,name=hop_pubkeys,json=hopPubkeys,proto3" json:"hop_pubkeys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QueryRouteProbabilityRequest) Reset()         { *m = QueryRouteProbabilityRequest{} }
+func (m *QueryRouteProbabilityRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRouteProbabilityRequest) ProtoMessage()    {}
+func (*QueryRouteProbabilityRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a0613f69d37b0a5, []int{36}
+}
+
+func (m *QueryRouteProbabilityRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryRouteProbabilityRequest.Unmarshal(m, b)
+}
+
+func (m *QueryRouteProbabilityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryRouteProbabilityRequest.Marshal(b, m, deterministic)
+}
+
+func (m *QueryRouteProbabilityRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRouteProbabilityRequest.Merge(m, src)
+}
+
+func (m *QueryRouteProbabilityRequest) XXX_Size() int {
+	return xxx_messageInfo_QueryRouteProbabilityRequest.Size(m)
+}
+
+func (m *QueryRouteProbabilityRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRouteProbabilityRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRouteProbabilityRequest proto.InternalMessageInfo
+
+func (m *QueryRouteProbabilityRequest) GetAmtMsat() int64 {
+	if m != nil {
+		return m.AmtMsat
+	}
+	return 0
+}
+
+func (m *QueryRouteProbabilityRequest) GetHopPubkeys() [][]byte {
+	if m != nil {
+		return m.HopPubkeys
+	}
+	return nil
+}
+
+type HopProbability struct {
+	// The source node pubkey of the hop.
+	FromNode []byte `protobuf:"bytes,1,opt,name=from_node,json=fromNode,proto3" json:"from_node,omitempty"`
+	// The destination node pubkey of the hop.
+	ToNode []byte `protobuf:"bytes,2,opt,name=to_node,json=toNode,proto3" json:"to_node,omitempty"`
+	// The success probability for the hop.
+	Probability          float64  `protobuf:"fixed64,3,opt,name=probability,proto3" json:"probability,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HopProbability) Reset()         { *m = HopProbability{} }
+func (m *HopProbability) String() string { return proto.CompactTextString(m) }
+func (*HopProbability) ProtoMessage()    {}
+func (*HopProbability) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a0613f69d37b0a5, []int{37}
+}
+
+func (m *HopProbability) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HopProbability.Unmarshal(m, b)
+}
+
+func (m *HopProbability) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HopProbability.Marshal(b, m, deterministic)
+}
+
+func (m *HopProbability) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HopProbability.Merge(m, src)
+}
+
+func (m *HopProbability) XXX_Size() int {
+	return xxx_messageInfo_HopProbability.Size(m)
+}
+
+func (m *HopProbability) XXX_DiscardUnknown() {
+	xxx_messageInfo_HopProbability.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HopProbability proto.InternalMessageInfo
+
+func (m *HopProbability) GetFromNode() []byte {
+	if m != nil {
+		return m.FromNode
+	}
+	return nil
+}
+
+func (m *HopProbability) GetToNode() []byte {
+	if m != nil {
+		return m.ToNode
+	}
+	return nil
+}
+
+func (m *HopProbability) GetProbability() float64 {
+	if m != nil {
+		return m.Probability
+	}
+	return 0
+}
+
+type QueryRouteProbabilityResponse struct {
+	//
+	//The success probability for the whole route, the product of the
+	//probabilities of the individual hops.
+	Probability float64 `protobuf:"fixed64,1,opt,name=probability,proto3" json:"probability,omitempty"`
+	// The success probabilities of the individual hops, in route order.
+	Hops                 []*HopProbability `protobuf:"bytes,2,rep,name=hops,proto3" json:"hops,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *QueryRouteProbabilityResponse) Reset()         { *m = QueryRouteProbabilityResponse{} }
+func (m *QueryRouteProbabilityResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRouteProbabilityResponse) ProtoMessage()    {}
+func (*QueryRouteProbabilityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a0613f69d37b0a5, []int{38}
+}
+
+func (m *QueryRouteProbabilityResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryRouteProbabilityResponse.Unmarshal(m, b)
+}
+
+func (m *QueryRouteProbabilityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryRouteProbabilityResponse.Marshal(b, m, deterministic)
+}
+
+func (m *QueryRouteProbabilityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRouteProbabilityResponse.Merge(m, src)
+}
+
+func (m *QueryRouteProbabilityResponse) XXX_Size() int {
+	return xxx_messageInfo_QueryRouteProbabilityResponse.Size(m)
+}
+
+func (m *QueryRouteProbabilityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRouteProbabilityResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRouteProbabilityResponse proto.InternalMessageInfo
+
+func (m *QueryRouteProbabilityResponse) GetProbability() float64 {
+	if m != nil {
+		return m.Probability
+	}
+	return 0
+}
+
+func (m *QueryRouteProbabilityResponse) GetHops() []*HopProbability {
+	if m != nil {
+		return m.Hops
+	}
+	return nil
+}
+
+type GetNodeMetricsRequest struct {
+	// The requested node metrics.
+	Types                []lnrpc.NodeMetricType `protobuf:"varint,1,rep,packed,name=types,proto3,enum=lnrpc.NodeMetricType" json:"types,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *GetNodeMetricsRequest) Reset()         { *m = GetNodeMetricsRequest{} }
+func (m *GetNodeMetricsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetNodeMetricsRequest) ProtoMessage()    {}
+func (*GetNodeMetricsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a0613f69d37b0a5, []int{39}
+}
+
+func (m *GetNodeMetricsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetNodeMetricsRequest.Unmarshal(m, b)
+}
+
+func (m *GetNodeMetricsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetNodeMetricsRequest.Marshal(b, m, deterministic)
+}
+
+func (m *GetNodeMetricsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetNodeMetricsRequest.Merge(m, src)
+}
+
+func (m *GetNodeMetricsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetNodeMetricsRequest.Size(m)
+}
+
+func (m *GetNodeMetricsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetNodeMetricsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetNodeMetricsRequest proto.InternalMessageInfo
+
+func (m *GetNodeMetricsRequest) GetTypes() []lnrpc.NodeMetricType {
+	if m != nil {
+		return m.Types
+	}
+	return nil
+}
+
+type GetNodeMetricsResponse struct {
+	//
+	//Map of node pubkey to the betweenness centrality of the node, the sum of
+	//the ratio of shortest paths that pass through the node for each pair of
+	//nodes in the graph. Normalized values are in the [0,1] closed interval.
+	//Empty if betweenness centrality wasn't requested or hasn't been computed
+	//yet.
+	BetweennessCentrality map[string]*lnrpc.FloatMetric `protobuf:"bytes,1,rep,name=betweenness_centrality,json=betweennessCentrality,proto3" json:"betweenness_centrality,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	//
+	//The unix timestamp at which the returned metrics were computed, zero if
+	//they haven't been computed yet.
+	ComputedAt           int64    `protobuf:"varint,2,opt,name=computed_at,json=computedAt,proto3" json:"computed_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetNodeMetricsResponse) Reset()         { *m = GetNodeMetricsResponse{} }
+func (m *GetNodeMetricsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetNodeMetricsResponse) ProtoMessage()    {}
+func (*GetNodeMetricsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a0613f69d37b0a5, []int{40}
+}
+
+func (m *GetNodeMetricsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetNodeMetricsResponse.Unmarshal(m, b)
+}
+
+func (m *GetNodeMetricsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetNodeMetricsResponse.Marshal(b, m, deterministic)
+}
+
+func (m *GetNodeMetricsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetNodeMetricsResponse.Merge(m, src)
+}
+
+func (m *GetNodeMetricsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetNodeMetricsResponse.Size(m)
+}
+
+func (m *GetNodeMetricsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetNodeMetricsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetNodeMetricsResponse proto.InternalMessageInfo
+
+func (m *GetNodeMetricsResponse) GetBetweennessCentrality() map[string]*lnrpc.FloatMetric {
+	if m != nil {
+		return m.BetweennessCentrality
+	}
+	return nil
+}
+
+func (m *GetNodeMetricsResponse) GetComputedAt() int64 {
+	if m != nil {
+		return m.ComputedAt
+	}
+	return 0
+}
+
+type AbandonPaymentRequest struct {
+	// The hash of the payment to abandon.
+	PaymentHash []byte `protobuf:"bytes,1,opt,name=payment_hash,json=paymentHash,proto3" json:"payment_hash,omitempty"`
+	//
+	//If set, the payment is removed even if it is in flight. This is meant
+	//strictly for recovery.
+	Force                bool     `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AbandonPaymentRequest) Reset()         { *m = AbandonPaymentRequest{} }
+func (m *AbandonPaymentRequest) String() string { return proto.CompactTextString(m) }
+func (*AbandonPaymentRequest) ProtoMessage()    {}
+func (*AbandonPaymentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a0613f69d37b0a5, []int{41}
+}
+
+func (m *AbandonPaymentRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AbandonPaymentRequest.Unmarshal(m, b)
+}
+
+func (m *AbandonPaymentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AbandonPaymentRequest.Marshal(b, m, deterministic)
+}
+
+func (m *AbandonPaymentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AbandonPaymentRequest.Merge(m, src)
+}
+
+func (m *AbandonPaymentRequest) XXX_Size() int {
+	return xxx_messageInfo_AbandonPaymentRequest.Size(m)
+}
+
+func (m *AbandonPaymentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AbandonPaymentRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AbandonPaymentRequest proto.InternalMessageInfo
+
+func (m *AbandonPaymentRequest) GetPaymentHash() []byte {
+	if m != nil {
+		return m.PaymentHash
+	}
+	return nil
+}
+
+func (m *AbandonPaymentRequest) GetForce() bool {
+	if m != nil {
+		return m.Force
+	}
+	return false
+}
+
+type AbandonPaymentResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AbandonPaymentResponse) Reset()         { *m = AbandonPaymentResponse{} }
+func (m *AbandonPaymentResponse) String() string { return proto.CompactTextString(m) }
+func (*AbandonPaymentResponse) ProtoMessage()    {}
+func (*AbandonPaymentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a0613f69d37b0a5, []int{42}
+}
+
+func (m *AbandonPaymentResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AbandonPaymentResponse.Unmarshal(m, b)
+}
+
+func (m *AbandonPaymentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AbandonPaymentResponse.Marshal(b, m, deterministic)
+}
+
+func (m *AbandonPaymentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AbandonPaymentResponse.Merge(m, src)
+}
+
+func (m *AbandonPaymentResponse) XXX_Size() int {
+	return xxx_messageInfo_AbandonPaymentResponse.Size(m)
+}
+
+func (m *AbandonPaymentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AbandonPaymentResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AbandonPaymentResponse proto.InternalMessageInfo
+
+type GetMissionControlConfigRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetMissionControlConfigRequest) Reset()         { *m = GetMissionControlConfigRequest{} }
+func (m *GetMissionControlConfigRequest) String() string { return proto.CompactTextString(m) }
+func (*GetMissionControlConfigRequest) ProtoMessage()    {}
+func (*GetMissionControlConfigRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a0613f69d37b0a5, []int{43}
+}
+
+func (m *GetMissionControlConfigRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetMissionControlConfigRequest.Unmarshal(m, b)
+}
+
+func (m *GetMissionControlConfigRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetMissionControlConfigRequest.Marshal(b, m, deterministic)
+}
+
+func (m *GetMissionControlConfigRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetMissionControlConfigRequest.Merge(m, src)
+}
+
+func (m *GetMissionControlConfigRequest) XXX_Size() int {
+	return xxx_messageInfo_GetMissionControlConfigRequest.Size(m)
+}
+
+func (m *GetMissionControlConfigRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetMissionControlConfigRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetMissionControlConfigRequest proto.InternalMessageInfo
+
+type GetMissionControlConfigResponse struct {
+	// The parameters that mission control currently uses.
+	Config               *MissionControlConfig `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *GetMissionControlConfigResponse) Reset()         { *m = GetMissionControlConfigResponse{} }
+func (m *GetMissionControlConfigResponse) String() string { return proto.CompactTextString(m) }
+func (*GetMissionControlConfigResponse) ProtoMessage()    {}
+func (*GetMissionControlConfigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a0613f69d37b0a5, []int{44}
+}
+
+func (m *GetMissionControlConfigResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetMissionControlConfigResponse.Unmarshal(m, b)
+}
+
+func (m *GetMissionControlConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetMissionControlConfigResponse.Marshal(b, m, deterministic)
+}
+
+func (m *GetMissionControlConfigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetMissionControlConfigResponse.Merge(m, src)
+}
+
+func (m *GetMissionControlConfigResponse) XXX_Size() int {
+	return xxx_messageInfo_GetMissionControlConfigResponse.Size(m)
+}
+
+func (m *GetMissionControlConfigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetMissionControlConfigResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetMissionControlConfigResponse proto.InternalMessageInfo
+
+func (m *GetMissionControlConfigResponse) GetConfig() *MissionControlConfig {
+	if m != nil {
+		return m.Config
+	}
+	return nil
+}
+
+type SetMissionControlConfigRequest struct {
+	// The new parameters for mission control.
+	Config               *MissionControlConfig `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *SetMissionControlConfigRequest) Reset()         { *m = SetMissionControlConfigRequest{} }
+func (m *SetMissionControlConfigRequest) String() string { return proto.CompactTextString(m) }
+func (*SetMissionControlConfigRequest) ProtoMessage()    {}
+func (*SetMissionControlConfigRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a0613f69d37b0a5, []int{45}
+}
+
+func (m *SetMissionControlConfigRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetMissionControlConfigRequest.Unmarshal(m, b)
+}
+
+func (m *SetMissionControlConfigRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetMissionControlConfigRequest.Marshal(b, m, deterministic)
+}
+
+func (m *SetMissionControlConfigRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetMissionControlConfigRequest.Merge(m, src)
+}
+
+func (m *SetMissionControlConfigRequest) XXX_Size() int {
+	return xxx_messageInfo_SetMissionControlConfigRequest.Size(m)
+}
+
+func (m *SetMissionControlConfigRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetMissionControlConfigRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetMissionControlConfigRequest proto.InternalMessageInfo
+
+func (m *SetMissionControlConfigRequest) GetConfig() *MissionControlConfig {
+	if m != nil {
+		return m.Config
+	}
+	return nil
+}
+
+type SetMissionControlConfigResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetMissionControlConfigResponse) Reset()         { *m = SetMissionControlConfigResponse{} }
+func (m *SetMissionControlConfigResponse) String() string { return proto.CompactTextString(m) }
+func (*SetMissionControlConfigResponse) ProtoMessage()    {}
+func (*SetMissionControlConfigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a0613f69d37b0a5, []int{46}
+}
+
+func (m *SetMissionControlConfigResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetMissionControlConfigResponse.Unmarshal(m, b)
+}
+
+func (m *SetMissionControlConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetMissionControlConfigResponse.Marshal(b, m, deterministic)
+}
+
+func (m *SetMissionControlConfigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetMissionControlConfigResponse.Merge(m, src)
+}
+
+func (m *SetMissionControlConfigResponse) XXX_Size() int {
+	return xxx_messageInfo_SetMissionControlConfigResponse.Size(m)
+}
+
+func (m *SetMissionControlConfigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetMissionControlConfigResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetMissionControlConfigResponse proto.InternalMessageInfo
+
+type MissionControlConfig struct {
+	//
+	//The amount of time mission control will take to restore a penalized node
+	//or channel back to 50% success probability, expressed in seconds.
+	HalfLifeSeconds uint64 `protobuf:"varint,1,opt,name=half_life_seconds,json=halfLifeSeconds,proto3" json:"half_life_seconds,omitempty"`
+	//
+	//The probability of success mission control should assign to a hop in a
+	//route when it has no other information available, such as a destination
+	//that wasn't tried before. Lower values make the router more conservative
+	//on unknown routes. Valid values are in [0, 1].
+	HopProbability float64 `protobuf:"fixed64,2,opt,name=hop_probability,json=hopProbability,proto3" json:"hop_probability,omitempty"`
+	//
+	//The importance that mission control should place on historical results,
+	//expressed as a value in [0, 1]. Setting it to one ignores historical
+	//results and always assumes the a priori hop probability for untried
+	//connections.
+	Weight float64 `protobuf:"fixed64,3,opt,name=weight,proto3" json:"weight,omitempty"`
+	//
+	//The probability estimator that mission control uses, either "apriori" or
+	//"bimodal". The apriori estimator is the default and is used if this field
+	//is empty. It is configured by the fields above. The bimodal estimator
+	//models the liquidity of channels and is configured by the bimodal fields
+	//below. It falls back to the apriori estimator for our own channels and
+	//for channels of unknown capacity. Switching estimators keeps the history
+	//of mission control.
+	Estimator string `protobuf:"bytes,4,opt,name=estimator,proto3" json:"estimator,omitempty"`
+	//
+	//The scale of the liquidity distribution of the bimodal estimator in
+	//millisatoshis. It defines how far the liquidity of channels is assumed to
+	//spread from their edges. Must be positive for the bimodal estimator.
+	BimodalScaleMsat uint64 `protobuf:"varint,5,opt,name=bimodal_scale_msat,json=bimodalScaleMsat,proto3" json:"bimodal_scale_msat,omitempty"`
+	//
+	//The degree to which the bimodal estimator takes the results of the other
+	//channels of a node into account, expressed as a value in [0, 1].
+	BimodalNodeWeight float64 `protobuf:"fixed64,6,opt,name=bimodal_node_weight,json=bimodalNodeWeight,proto3" json:"bimodal_node_weight,omitempty"`
+	//
+	//The amount of time after which the liquidity bounds that payment results
+	//set in the bimodal estimator have relaxed by a factor of e, expressed in
+	//seconds. Must be positive for the bimodal estimator.
+	BimodalDecayTimeSeconds uint64   `protobuf:"varint,7,opt,name=bimodal_decay_time_seconds,json=bimodalDecayTimeSeconds,proto3" json:"bimodal_decay_time_seconds,omitempty"`
+	XXX_NoUnkeyedLiteral    struct{} `json:"-"`
+	XXX_unrecognized        []byte   `json:"-"`
+	XXX_sizecache           int32    `json:"-"`
+}
+
+func (m *MissionControlConfig) Reset()         { *m = MissionControlConfig{} }
+func (m *MissionControlConfig) String() string { return proto.CompactTextString(m) }
+func (*MissionControlConfig) ProtoMessage()    {}
+func (*MissionControlConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a0613f69d37b0a5, []int{47}
+}
+
+func (m *MissionControlConfig) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MissionControlConfig.Unmarshal(m, b)
+}
+
+func (m *MissionControlConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MissionControlConfig.Marshal(b, m, deterministic)
+}
+
+func (m *MissionControlConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MissionControlConfig.Merge(m, src)
+}
+
+func (m *MissionControlConfig) XXX_Size() int {
+	return xxx_messageInfo_MissionControlConfig.Size(m)
+}
+
+func (m *MissionControlConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_MissionControlConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MissionControlConfig proto.InternalMessageInfo
+
+func (m *MissionControlConfig) GetHalfLifeSeconds() uint64 {
+	if m != nil {
+		return m.HalfLifeSeconds
+	}
+	return 0
+}
+
+func (m *MissionControlConfig) GetHopProbability() float64 {
+	if m != nil {
+		return m.HopProbability
+	}
+	return 0
+}
+
+func (m *MissionControlConfig) GetWeight() float64 {
+	if m != nil {
+		return m.Weight
+	}
+	return 0
+}
+
+func (m *MissionControlConfig) GetEstimator() string {
+	if m != nil {
+		return m.Estimator
+	}
+	return ""
+}
+
+func (m *MissionControlConfig) GetBimodalScaleMsat() uint64 {
+	if m != nil {
+		return m.BimodalScaleMsat
+	}
+	return 0
+}
+
+func (m *MissionControlConfig) GetBimodalNodeWeight() float64 {
+	if m != nil {
+		return m.BimodalNodeWeight
+	}
+	return 0
+}
+
+func (m *MissionControlConfig) GetBimodalDecayTimeSeconds() uint64 {
+	if m != nil {
+		return m.BimodalDecayTimeSeconds
+	}
+	return 0
+}
+
+type ExportPaymentProofRequest struct {
+	// The hash of the settled payment.
+	PaymentHash          []byte   `protobuf:"bytes,1,opt,name=payment_hash,json=paymentHash,proto3" json:"payment_hash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExportPaymentProofRequest) Reset()         { *m = ExportPaymentProofRequest{} }
+func (m *ExportPaymentProofRequest) String() string { return proto.CompactTextString(m) }
+func (*ExportPaymentProofRequest) ProtoMessage()    {}
+func (*ExportPaymentProofRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a0613f69d37b0a5, []int{48}
+}
+
+func (m *ExportPaymentProofRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportPaymentProofRequest.Unmarshal(m, b)
+}
+
+func (m *ExportPaymentProofRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportPaymentProofRequest.Marshal(b, m, deterministic)
+}
+
+func (m *ExportPaymentProofRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportPaymentProofRequest.Merge(m, src)
+}
+
+func (m *ExportPaymentProofRequest) XXX_Size() int {
+	return xxx_messageInfo_ExportPaymentProofRequest.Size(m)
+}
+
+func (m *ExportPaymentProofRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportPaymentProofRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportPaymentProofRequest proto.InternalMessageInfo
+
+func (m *ExportPaymentProofRequest) GetPaymentHash() []byte {
+	if m != nil {
+		return m.PaymentHash
+	}
+	return nil
+}
+
+type ExportPaymentProofResponse struct {
+	// The serialized proof of payment.
+	Proof                []byte   `protobuf:"bytes,1,opt,name=proof,proto3" json:"proof,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExportPaymentProofResponse) Reset()         { *m = ExportPaymentProofResponse{} }
+func (m *ExportPaymentProofResponse) String() string { return proto.CompactTextString(m) }
+func (*ExportPaymentProofResponse) ProtoMessage()    {}
+func (*ExportPaymentProofResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a0613f69d37b0a5, []int{49}
+}
+
+func (m *ExportPaymentProofResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportPaymentProofResponse.Unmarshal(m, b)
+}
+
+func (m *ExportPaymentProofResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportPaymentProofResponse.Marshal(b, m, deterministic)
+}
+
+func (m *ExportPaymentProofResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportPaymentProofResponse.Merge(m, src)
+}
+
+func (m *ExportPaymentProofResponse) XXX_Size() int {
+	return xxx_messageInfo_ExportPaymentProofResponse.Size(m)
+}
+
+func (m *ExportPaymentProofResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportPaymentProofResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportPaymentProofResponse proto.InternalMessageInfo
+
+func (m *ExportPaymentProofResponse) GetProof() []byte {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+type VerifyPaymentProofRequest struct {
+	// The serialized proof of payment, as returned by ExportPaymentProof.
+	Proof                []byte   `protobuf:"bytes,1,opt,name=proof,proto3" json:"proof,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VerifyPaymentProofRequest) Reset()         { *m = VerifyPaymentProofRequest{} }
+func (m *VerifyPaymentProofRequest) String() string { return proto.CompactTextString(m) }
+func (*VerifyPaymentProofRequest) ProtoMessage()    {}
+func (*VerifyPaymentProofRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a0613f69d37b0a5, []int{50}
+}
+
+func (m *VerifyPaymentProofRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VerifyPaymentProofRequest.Unmarshal(m, b)
+}
+
+func (m *VerifyPaymentProofRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VerifyPaymentProofRequest.Marshal(b, m, deterministic)
+}
+
+func (m *VerifyPaymentProofRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifyPaymentProofRequest.Merge(m, src)
+}
+
+func (m *VerifyPaymentProofRequest) XXX_Size() int {
+	return xxx_messageInfo_VerifyPaymentProofRequest.Size(m)
+}
+
+func (m *VerifyPaymentProofRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifyPaymentProofRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VerifyPaymentProofRequest proto.InternalMessageInfo
+
+func (m *VerifyPaymentProofRequest) GetProof() []byte {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+type VerifyPaymentProofResponse struct {
+	// The payment hash of the payment request.
+	PaymentHash []byte `protobuf:"bytes,1,opt,name=payment_hash,json=paymentHash,proto3" json:"payment_hash,omitempty"`
+	// The payment request that was paid.
+	PaymentRequest string `protobuf:"bytes,2,opt,name=payment_request,json=paymentRequest,proto3" json:"payment_request,omitempty"`
+	// The preimage revealed by the payee.
+	Preimage []byte `protobuf:"bytes,3,opt,name=preimage,proto3" json:"preimage,omitempty"`
+	// The amount paid in millisatoshis.
+	ValueMsat int64 `protobuf:"varint,4,opt,name=value_msat,json=valueMsat,proto3" json:"value_msat,omitempty"`
+	// The public key of the payee.
+	Destination          []byte   `protobuf:"bytes,5,opt,name=destination,proto3" json:"destination,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VerifyPaymentProofResponse) Reset()         { *m = VerifyPaymentProofResponse{} }
+func (m *VerifyPaymentProofResponse) String() string { return proto.CompactTextString(m) }
+func (*VerifyPaymentProofResponse) ProtoMessage()    {}
+func (*VerifyPaymentProofResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a0613f69d37b0a5, []int{51}
+}
+
+func (m *VerifyPaymentProofResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VerifyPaymentProofResponse.Unmarshal(m, b)
+}
+
+func (m *VerifyPaymentProofResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VerifyPaymentProofResponse.Marshal(b, m, deterministic)
+}
+
+func (m *VerifyPaymentProofResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifyPaymentProofResponse.Merge(m, src)
+}
+
+func (m *VerifyPaymentProofResponse) XXX_Size() int {
+	return xxx_messageInfo_VerifyPaymentProofResponse.Size(m)
+}
+
+func (m *VerifyPaymentProofResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifyPaymentProofResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VerifyPaymentProofResponse proto.InternalMessageInfo
+
+func (m *VerifyPaymentProofResponse) GetPaymentHash() []byte {
+	if m != nil {
+		return m.PaymentHash
+	}
+	return nil
+}
+
+func (m *VerifyPaymentProofResponse) GetPaymentRequest() string {
+	if m != nil {
+		return m.PaymentRequest
+	}
+	return ""
+}
+
+func (m *VerifyPaymentProofResponse) GetPreimage() []byte {
+	if m != nil {
+		return m.Preimage
+	}
+	return nil
+}
+
+func (m *VerifyPaymentProofResponse) GetValueMsat() int64 {
+	if m != nil {
+		return m.ValueMsat
+	}
+	return 0
+}
+
+func (m *VerifyPaymentProofResponse) GetDestination() []byte {
+	if m != nil {
+		return m.Destination
+	}
+	return nil
+}
+
+type ListPaymentsRequest struct {
+	//
+	//If true, then return payments that have not yet fully completed. This means
+	//that pending payments, as well as failed payments will show up if this
+	//field is set to true. It is ignored if statuses is set.
+	IncludeIncomplete bool `protobuf:"varint,1,opt,name=include_incomplete,json=includeIncomplete,proto3" json:"include_incomplete,omitempty"`
+	//
+	//The index of a payment that will be used as either the start or end of a
+	//query to determine which payments should be returned in the response. The
+	//index_offset is exclusive. In the case of a zero index_offset, the query
+	//will start with the oldest payment when paginating forwards, or will end
+	//with the most recent payment when paginating backwards.
+	IndexOffset uint64 `protobuf:"varint,2,opt,name=index_offset,json=indexOffset,proto3" json:"index_offset,omitempty"`
+	//
+	//The maximal number of payments returned in the response to this query. If
+	//zero, a page of 100 payments is returned.
+	MaxPayments uint64 `protobuf:"varint,3,opt,name=max_payments,json=maxPayments,proto3" json:"max_payments,omitempty"`
+	//
+	//If set, the payments returned will result from seeking backwards from the
+	//specified index offset. This can be used to paginate backwards. The order
+	//of the returned payments is always oldest first (ascending index order).
+	Reversed bool `protobuf:"varint,4,opt,name=reversed,proto3" json:"reversed,omitempty"`
+	//
+	//If set, only payments with one of the given statuses are returned. Payments
+	//which don't match don't count towards max_payments.
+	Statuses             []lnrpc.Payment_PaymentStatus `protobuf:"varint,5,rep,packed,name=statuses,proto3,enum=lnrpc.Payment_PaymentStatus" json:"statuses,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
+	XXX_unrecognized     []byte                        `json:"-"`
+	XXX_sizecache        int32                         `json:"-"`
+}
+
+func (m *ListPaymentsRequest) Reset()         { *m = ListPaymentsRequest{} }
+func (m *ListPaymentsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListPaymentsRequest) ProtoMessage()    {}
+func (*ListPaymentsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a0613f69d37b0a5, []int{52}
+}
+
+func (m *ListPaymentsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListPaymentsRequest.Unmarshal(m, b)
+}
+
+func (m *ListPaymentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListPaymentsRequest.Marshal(b, m, deterministic)
+}
+
+func (m *ListPaymentsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListPaymentsRequest.Merge(m, src)
+}
+
+func (m *ListPaymentsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListPaymentsRequest.Size(m)
+}
+
+func (m *ListPaymentsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListPaymentsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListPaymentsRequest proto.InternalMessageInfo
+
+func (m *ListPaymentsRequest) GetIncludeIncomplete() bool {
+	if m != nil {
+		return m.IncludeIncomplete
+	}
+	return false
+}
+
+func (m *ListPaymentsRequest) GetIndexOffset() uint64 {
+	if m != nil {
+		return m.IndexOffset
+	}
+	return 0
+}
+
+func (m *ListPaymentsRequest) GetMaxPayments() uint64 {
+	if m != nil {
+		return m.MaxPayments
+	}
+	return 0
+}
+
+func (m *ListPaymentsRequest) GetReversed() bool {
+	if m != nil {
+		return m.Reversed
+	}
+	return false
+}
+
+func (m *ListPaymentsRequest) GetStatuses() []lnrpc.Payment_PaymentStatus {
+	if m != nil {
+		return m.Statuses
+	}
+	return nil
+}
+
+type ListPaymentsResponse struct {
+	// The list of payments, oldest first.
+	Payments []*lnrpc.Payment `protobuf:"bytes,1,rep,name=payments,proto3" json:"payments,omitempty"`
+	//
+	//The index of the first item in the set of returned payments. This can be
+	//used as the index_offset to continue seeking backwards in the next request.
+	FirstIndexOffset uint64 `protobuf:"varint,2,opt,name=first_index_offset,json=firstIndexOffset,proto3" json:"first_index_offset,omitempty"`
+	//
+	//The index of the last item in the set of returned payments. This can be used
+	//as the index_offset to continue seeking forwards in the next request.
+	LastIndexOffset      uint64   `protobuf:"varint,3,opt,name=last_index_offset,json=lastIndexOffset,proto3" json:"last_index_offset,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListPaymentsResponse) Reset()         { *m = ListPaymentsResponse{} }
+func (m *ListPaymentsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListPaymentsResponse) ProtoMessage()    {}
+func (*ListPaymentsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a0613f69d37b0a5, []int{53}
+}
+
+func (m *ListPaymentsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListPaymentsResponse.Unmarshal(m, b)
+}
+
+func (m *ListPaymentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListPaymentsResponse.Marshal(b, m, deterministic)
+}
+
+func (m *ListPaymentsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListPaymentsResponse.Merge(m, src)
+}
+
+func (m *ListPaymentsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListPaymentsResponse.Size(m)
+}
+
+func (m *ListPaymentsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListPaymentsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListPaymentsResponse proto.InternalMessageInfo
+
+func (m *ListPaymentsResponse) GetPayments() []*lnrpc.Payment {
+	if m != nil {
+		return m.Payments
+	}
+	return nil
+}
+
+func (m *ListPaymentsResponse) GetFirstIndexOffset() uint64 {
+	if m != nil {
+		return m.FirstIndexOffset
+	}
+	return 0
+}
+
+func (m *ListPaymentsResponse) GetLastIndexOffset() uint64 {
+	if m != nil {
+		return m.LastIndexOffset
+	}
+	return 0
+}
+
+type GetMacaroonRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetMacaroonRequest) Reset()         { *m = GetMacaroonRequest{} }
+func (m *GetMacaroonRequest) String() string { return proto.CompactTextString(m) }
+func (*GetMacaroonRequest) ProtoMessage()    {}
+func (*GetMacaroonRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a0613f69d37b0a5, []int{54}
+}
+
+func (m *GetMacaroonRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetMacaroonRequest.Unmarshal(m, b)
+}
+
+func (m *GetMacaroonRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetMacaroonRequest.Marshal(b, m, deterministic)
+}
+
+func (m *GetMacaroonRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetMacaroonRequest.Merge(m, src)
+}
+
+func (m *GetMacaroonRequest) XXX_Size() int {
+	return xxx_messageInfo_GetMacaroonRequest.Size(m)
+}
+
+func (m *GetMacaroonRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetMacaroonRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetMacaroonRequest proto.InternalMessageInfo
+
+type GetMacaroonResponse struct {
+	// The serialized router macaroon.
+	Macaroon             []byte   `protobuf:"bytes,1,opt,name=macaroon,proto3" json:"macaroon,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetMacaroonResponse) Reset()         { *m = GetMacaroonResponse{} }
+func (m *GetMacaroonResponse) String() string { return proto.CompactTextString(m) }
+func (*GetMacaroonResponse) ProtoMessage()    {}
+func (*GetMacaroonResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a0613f69d37b0a5, []int{55}
+}
+
+func (m *GetMacaroonResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetMacaroonResponse.Unmarshal(m, b)
+}
+
+func (m *GetMacaroonResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetMacaroonResponse.Marshal(b, m, deterministic)
+}
+
+func (m *GetMacaroonResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetMacaroonResponse.Merge(m, src)
+}
+
+func (m *GetMacaroonResponse) XXX_Size() int {
+	return xxx_messageInfo_GetMacaroonResponse.Size(m)
+}
+
+func (m *GetMacaroonResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetMacaroonResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetMacaroonResponse proto.InternalMessageInfo
+
+func (m *GetMacaroonResponse) GetMacaroon() []byte {
+	if m != nil {
+		return m.Macaroon
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("routerrpc.FailureDetail", FailureDetail_name, FailureDetail_value)
+	proto.RegisterEnum("routerrpc.PaymentState", PaymentState_name, PaymentState_value)
+	proto.RegisterEnum("routerrpc.ResolveHoldForwardAction", ResolveHoldForwardAction_name, ResolveHoldForwardAction_value)
+	proto.RegisterEnum("routerrpc.HopPayloadFormat", HopPayloadFormat_name, HopPayloadFormat_value)
+	proto.RegisterEnum("routerrpc.HtlcEvent_EventType", HtlcEvent_EventType_name, HtlcEvent_EventType_value)
+	proto.RegisterType((*SendPaymentRequest)(nil), "routerrpc.SendPaymentRequest")
+	proto.RegisterMapType((map[uint64][]byte)(nil), "routerrpc.SendPaymentRequest.DestCustomRecordsEntry")
+	proto.RegisterType((*TrackPaymentRequest)(nil), "routerrpc.TrackPaymentRequest")
+	proto.RegisterType((*RouteFeeRequest)(nil), "routerrpc.RouteFeeRequest")
+	proto.RegisterType((*RouteFeeResponse)(nil), "routerrpc.RouteFeeResponse")
+	proto.RegisterType((*SendToRouteRequest)(nil), "routerrpc.SendToRouteRequest")
+	proto.RegisterType((*SendToRouteResponse)(nil), "routerrpc.SendToRouteResponse")
+	proto.RegisterType((*ResetMissionControlRequest)(nil), "routerrpc.ResetMissionControlRequest")
+	proto.RegisterType((*ResetMissionControlResponse)(nil), "routerrpc.ResetMissionControlResponse")
+	proto.RegisterType((*QueryMissionControlRequest)(nil), "routerrpc.QueryMissionControlRequest")
+	proto.RegisterType((*QueryMissionControlResponse)(nil), "routerrpc.QueryMissionControlResponse")
+	proto.RegisterType((*PairHistory)(nil), "routerrpc.PairHistory")
+	proto.RegisterType((*PairData)(nil), "routerrpc.PairData")
+	proto.RegisterType((*QueryProbabilityRequest)(nil), "routerrpc.QueryProbabilityRequest")
+	proto.RegisterType((*QueryProbabilityResponse)(nil), "routerrpc.QueryProbabilityResponse")
+	proto.RegisterType((*BuildRouteRequest)(nil), "routerrpc.BuildRouteRequest")
+	proto.RegisterType((*BuildRouteResponse)(nil), "routerrpc.BuildRouteResponse")
+	proto.RegisterType((*SubscribeHtlcEventsRequest)(nil), "routerrpc.SubscribeHtlcEventsRequest")
+	proto.RegisterType((*HtlcEvent)(nil), "routerrpc.HtlcEvent")
+	proto.RegisterType((*HtlcInfo)(nil), "routerrpc.HtlcInfo")
+	proto.RegisterType((*ForwardEvent)(nil), "routerrpc.ForwardEvent")
+	proto.RegisterType((*ForwardFailEvent)(nil), "routerrpc.ForwardFailEvent")
+	proto.RegisterType((*SettleEvent)(nil), "routerrpc.SettleEvent")
+	proto.RegisterType((*LinkFailEvent)(nil), "routerrpc.LinkFailEvent")
+	proto.RegisterType((*PaymentStatus)(nil), "routerrpc.PaymentStatus")
+	proto.RegisterType((*CircuitKey)(nil), "routerrpc.CircuitKey")
+	proto.RegisterType((*ForwardHtlcInterceptRequest)(nil), "routerrpc.ForwardHtlcInterceptRequest")
+	proto.RegisterMapType((map[uint64][]byte)(nil), "routerrpc.ForwardHtlcInterceptRequest.CustomRecordsEntry")
+	proto.RegisterType((*ForwardHtlcInterceptResponse)(nil), "routerrpc.ForwardHtlcInterceptResponse")
+	proto.RegisterType((*GetPaymentResultRequest)(nil), "routerrpc.GetPaymentResultRequest")
+	proto.RegisterType((*SubscribeChannelGraphRequest)(nil), "routerrpc.SubscribeChannelGraphRequest")
+	proto.RegisterType((*ChannelGraphUpdate)(nil), "routerrpc.ChannelGraphUpdate")
+	proto.RegisterType((*AddExcludedNodeRequest)(nil), "routerrpc.AddExcludedNodeRequest")
+	proto.RegisterType((*AddExcludedNodeResponse)(nil), "routerrpc.AddExcludedNodeResponse")
+	proto.RegisterType((*RemoveExcludedNodeRequest)(nil), "routerrpc.RemoveExcludedNodeRequest")
+	proto.RegisterType((*RemoveExcludedNodeResponse)(nil), "routerrpc.RemoveExcludedNodeResponse")
+	proto.RegisterType((*ListExcludedNodesRequest)(nil), "routerrpc.ListExcludedNodesRequest")
+	proto.RegisterType((*ListExcludedNodesResponse)(nil), "routerrpc.ListExcludedNodesResponse")
+	proto.RegisterType((*QueryRouteProbabilityRequest)(nil), "routerrpc.QueryRouteProbabilityRequest")
+	proto.RegisterType((*HopProbability)(nil), "routerrpc.HopProbability")
+	proto.RegisterType((*QueryRouteProbabilityResponse)(nil), "routerrpc.QueryRouteProbabilityResponse")
+	proto.RegisterType((*GetNodeMetricsRequest)(nil), "routerrpc.GetNodeMetricsRequest")
+	proto.RegisterType((*GetNodeMetricsResponse)(nil), "routerrpc.GetNodeMetricsResponse")
+	proto.RegisterMapType((map[string]*lnrpc.FloatMetric)(nil), "routerrpc.GetNodeMetricsResponse.BetweennessCentralityEntry")
+	proto.RegisterType((*AbandonPaymentRequest)(nil), "routerrpc.AbandonPaymentRequest")
+	proto.RegisterType((*AbandonPaymentResponse)(nil), "routerrpc.AbandonPaymentResponse")
+	proto.RegisterType((*GetMissionControlConfigRequest)(nil), "routerrpc.GetMissionControlConfigRequest")
+	proto.RegisterType((*GetMissionControlConfigResponse)(nil), "routerrpc.GetMissionControlConfigResponse")
+	proto.RegisterType((*SetMissionControlConfigRequest)(nil), "routerrpc.SetMissionControlConfigRequest")
+	proto.RegisterType((*SetMissionControlConfigResponse)(nil), "routerrpc.SetMissionControlConfigResponse")
+	proto.RegisterType((*MissionControlConfig)(nil), "routerrpc.MissionControlConfig")
+	proto.RegisterType((*ExportPaymentProofRequest)(nil), "routerrpc.ExportPaymentProofRequest")
+	proto.RegisterType((*ExportPaymentProofResponse)(nil), "routerrpc.ExportPaymentProofResponse")
+	proto.RegisterType((*VerifyPaymentProofRequest)(nil), "routerrpc.VerifyPaymentProofRequest")
+	proto.RegisterType((*VerifyPaymentProofResponse)(nil), "routerrpc.VerifyPaymentProofResponse")
+	proto.RegisterType((*ListPaymentsRequest)(nil), "routerrpc.ListPaymentsRequest")
+	proto.RegisterType((*ListPaymentsResponse)(nil), "routerrpc.ListPaymentsResponse")
+	proto.RegisterType((*GetMacaroonRequest)(nil), "routerrpc.GetMacaroonRequest")
+	proto.RegisterType((*GetMacaroonResponse)(nil), "routerrpc.GetMacaroonResponse")
+}
+
+func init() { proto.RegisterFile("routerrpc/router.proto", fileDescriptor_7a0613f69d37b0a5) }
+
+var fileDescriptor_7a0613f69d37b0a5 = []byte{
+	// 4069 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xad, 0x3a, 0x4d, 0x77, 0xe3, 0xc8,
+	0x71, 0x26, 0x45, 0x51, 0x64, 0xf3, 0x43, 0x50, 0xeb, 0x8b, 0x43, 0xcd, 0xd7, 0x62, 0xbf, 0xc6,
+	0x93, 0xb5, 0x66, 0x57, 0xf1, 0xcb, 0x3a, 0xd9, 0xb5, 0x63, 0x8a, 0xa4, 0x46, 0xcc, 0x50, 0xa4,
+	0x16, 0xa4, 0x66, 0x77, 0xec, 0xbc, 0x20, 0x10, 0x09, 0x0e, 0xe1, 0x01, 0x01, 0x2e, 0x00, 0xce,
+	0xac, 0x8e, 0x7e, 0xbe, 0xe4, 0xe5, 0xf9, 0xe2, 0x7b, 0x7e, 0x43, 0xae, 0xb9, 0xf8, 0xbd, 0x9c,
+	0xf2, 0x33, 0xfc, 0xec, 0xa3, 0x6f, 0xb9, 0xe5, 0xf9, 0xe6, 0x54, 0xf5, 0x07, 0x08, 0x90, 0x20,
+	0x35, 0xe3, 0xe4, 0x42, 0xb2, 0xab, 0xaa, 0xab, 0xab, 0xba, 0xab, 0xaa, 0xab, 0xaa, 0x49, 0x0e,
+	0x3c, 0x77, 0x16, 0x98, 0x9e, 0x37, 0x1d, 0x3c, 0xe1, 0xbf, 0x8e, 0xa7, 0x9e, 0x1b, 0xb8, 0x34,
+	0x1f, 0xc2, 0xab, 0x79, 0xf8, 0xe0, 0x50, 0xf5, 0x77, 0x84, 0xd0, 0x9e, 0xe9, 0x0c, 0x2f, 0x8d,
+	0x9b, 0x89, 0xe9, 0x04, 0x9a, 0xf9, 0xed, 0xcc, 0xf4, 0x03, 0x4a, 0x49, 0x66, 0x08, 0xdf, 0x95,
+	0xd4, 0xc3, 0xd4, 0xa3, 0xa2, 0xc6, 0x7e, 0x53, 0x85, 0x6c, 0x18, 0x93, 0xa0, 0x92, 0x06, 0xd0,
+	0x86, 0x86, 0x3f, 0xe9, 0x1d, 0x92, 0x83, 0x2f, 0x7d, 0xe2, 0x1b, 0x41, 0xa5, 0xc8, 0xc0, 0x5b,
+	0x30, 0xbe, 0x80, 0x21, 0x7d, 0x8f, 0x14, 0xa7, 0x9c, 0xa5, 0x3e, 0x36, 0xfc, 0x71, 0x65, 0x83,
+	0x31, 0x2a, 0x08, 0xd8, 0x39, 0x80, 0xe8, 0x23, 0xa2, 0x8c, 0x2c, 0xc7, 0xb0, 0xf5, 0x81, 0x1d,
+	0xbc, 0xd6, 0x87, 0xa6, 0x1d, 0x18, 0x95, 0x0c, 0x90, 0x6d, 0x6a, 0x65, 0x06, 0xaf, 0x03, 0xb8,
+	0x81, 0x50, 0xfa, 0x31, 0xd9, 0x96, 0xcc, 0x3c, 0x2e, 0x60, 0x65, 0x13, 0x08, 0xf3, 0x5a, 0x79,
+	0x1a, 0x17, 0x1b, 0x08, 0x03, 0x6b, 0x62, 0x82, 0xa2, 0xba, 0x6f, 0x0e, 0x5c, 0x67, 0xe8, 0x57,
+	0xb2, 0x9c, 0xa3, 0x00, 0xf7, 0x38, 0x94, 0xaa, 0xa4, 0x34, 0x32, 0x4d, 0xdd, 0xb6, 0x26, 0x16,
+	0x90, 0x82, 0xf8, 0x5b, 0x4c, 0xfc, 0x02, 0x00, 0xdb, 0x08, 0xeb, 0x81, 0x0a, 0x1f, 0x90, 0xf2,
+	0x9c, 0x86, 0xe9, 0x58, 0x62, 0x44, 0x45, 0x49, 0xc4, 0x14, 0x3d, 0x26, 0x0a, 0xf0, 0x7d, 0xe9,
+	0x5a, 0xce, 0x4b, 0x7d, 0x30, 0x36, 0x1c, 0xdd, 0x1a, 0x56, 0x72, 0x40, 0x97, 0x39, 0xcd, 0x54,
+	0x52, 0x9f, 0xa6, 0xb4, 0xb2, 0xc4, 0xd6, 0x01, 0xd9, 0x1a, 0xd2, 0xc7, 0x64, 0x67, 0x91, 0xde,
+	0xaf, 0xec, 0x3e, 0xdc, 0x78, 0x94, 0xd1, 0xb6, 0xe3, 0xa4, 0x3e, 0xfd, 0x88, 0x6c, 0xdb, 0x86,
+	0x0f, 0x3b, 0xe8, 0x4e, 0xf5, 0xe9, 0xec, 0xfa, 0x95, 0x79, 0x53, 0x29, 0xb3, 0x7d, 0x2c, 0x21,
+	0xf8, 0xdc, 0x9d, 0x5e, 0x32, 0x20, 0xbd, 0x47, 0x08, 0xdb, 0x43, 0x26, 0x6a, 0x25, 0xcf, 0x34,
+	0xce, 0x23, 0x84, 0x89, 0x49, 0x3f, 0x23, 0x05, 0x76, 0xf6, 0xfa, 0xd8, 0x72, 0x02, 0xbf, 0x42,
+	0x60, 0xb1, 0xc2, 0x89, 0x72, 0x6c, 0x3b, 0x68, 0x06, 0x1a, 0x62, 0xce, 0x01, 0xa1, 0x11, 0x4f,
+	0xfe, 0xf4, 0xe9, 0x90, 0xec, 0xe2, 0x99, 0xeb, 0x83, 0x99, 0x1f, 0xb8, 0x13, 0xd8, 0xf5, 0x81,
+	0xeb, 0x81, 0x9c, 0x05, 0x36, 0xf5, 0x87, 0xc7, 0xa1, 0x29, 0x1d, 0x2f, 0xdb, 0xce, 0x71, 0x03,
+	0x3e, 0xea, 0x6c, 0x9e, 0xc6, 0xa7, 0x35, 0x9d, 0xc0, 0xbb, 0xd1, 0x76, 0x86, 0x8b, 0x70, 0xfa,
+	0x09, 0xa1, 0x86, 0x6d, 0xbb, 0x6f, 0xe0, 0xb0, 0xec, 0x91, 0x2e, 0xce, 0xb2, 0xb2, 0x0d, 0xf2,
+	0xe7, 0x34, 0x85, 0x61, 0x7a, 0x80, 0x10, 0xec, 0xe9, 0xdf, 0x90, 0x12, 0x93, 0x69, 0x64, 0x1a,
+	0xc1, 0xcc, 0x33, 0xfd, 0x8a, 0x02, 0xd2, 0x94, 0x4f, 0x76, 0x84, 0x22, 0x67, 0x1c, 0x7c, 0x6a,
+	0x05, 0x5a, 0x11, 0xe9, 0xc4, 0xd8, 0xa7, 0x47, 0x24, 0x3f, 0x31, 0xbe, 0x03, 0xf6, 0x1e, 0x28,
+	0xbf, 0x03, 0xcc, 0x4b, 0x5a, 0x0e, 0x00, 0x97, 0x38, 0x86, 0xe3, 0xdb, 0x75, 0x5c, 0xdd, 0x72,
+	0x46, 0xb6, 0xf5, 0x72, 0x1c, 0xe8, 0xb3, 0xe9, 0xd0, 0x08, 0x80, 0x35, 0x65, 0x32, 0xec, 0x38,
+	0x6e, 0x4b, 0x60, 0xae, 0x38, 0x82, 0xfe, 0x90, 0x1c, 0x4c, 0x3d, 0x73, 0x04, 0xca, 0x9b, 0x43,
+	0xb6, 0x9f, 0x30, 0x77, 0x68, 0x7e, 0x07, 0x53, 0xf6, 0x40, 0x9a, 0x92, 0xb6, 0x17, 0x62, 0x71,
+	0x23, 0x5b, 0x1c, 0x97, 0x30, 0x8b, 0x1f, 0xa7, 0x5f, 0xd9, 0x87, 0x59, 0xc5, 0x85, 0x59, 0xfc,
+	0x54, 0xd9, 0x2c, 0x3f, 0xf0, 0xac, 0x41, 0x20, 0xa6, 0x30, 0x1a, 0xd3, 0x19, 0x98, 0x95, 0x03,
+	0x26, 0xde, 0x1e, 0xc7, 0xb2, 0x29, 0x21, 0x0e, 0x37, 0x15, 0xd5, 0x0d, 0x55, 0x1a, 0x07, 0xf6,
+	0xc0, 0xaf, 0x1c, 0x32, 0xbd, 0x15, 0xc0, 0x48, 0x8d, 0xce, 0x11, 0x8e, 0xe6, 0x38, 0x37, 0xf2,
+	0xa9, 0xe9, 0x0d, 0xf0, 0x04, 0x2a, 0x40, 0x9c, 0xd2, 0xb6, 0xa5, 0x9d, 0x5f, 0x72, 0x30, 0xfd,
+	0x90, 0x94, 0xcd, 0xef, 0x06, 0xf6, 0x6c, 0x08, 0x4a, 0x38, 0x2e, 0xec, 0x71, 0xe5, 0x0e, 0x93,
+	0xbe, 0x24, 0xa1, 0x1d, 0x04, 0x82, 0x00, 0x8a, 0xe5, 0x0c, 0xdc, 0x49, 0xd4, 0x23, 0xaa, 0xcc,
+	0x23, 0xd2, 0xe8, 0x0f, 0x12, 0xc7, 0x8d, 0xbc, 0xda, 0x20, 0x07, 0xc9, 0x06, 0x83, 0xf1, 0x06,
+	0x2d, 0x1e, 0x43, 0x50, 0x46, 0xc3, 0x9f, 0x74, 0x8f, 0x6c, 0xbe, 0x36, 0xec, 0x99, 0xc9, 0x62,
+	0x50, 0x51, 0xe3, 0x83, 0xbf, 0x4b, 0xff, 0x28, 0x85, 0x67, 0x3c, 0xb5, 0x61, 0x29, 0xd7, 0xb1,
+	0x6f, 0x2a, 0x47, 0x6c, 0x77, 0x72, 0x08, 0xe8, 0xc2, 0x98, 0xfe, 0x15, 0xdf, 0x91, 0xc0, 0x0d,
+	0x20, 0xd8, 0xa0, 0xb6, 0xcc, 0x99, 0xef, 0x32, 0x67, 0xde, 0x06, 0x4c, 0x1f, 0x11, 0x67, 0xa6,
+	0x29, 0x03, 0x17, 0x12, 0x1b, 0x41, 0x60, 0x4e, 0xa6, 0x60, 0x30, 0xf7, 0xd8, 0xc6, 0x15, 0x00,
+	0x56, 0x13, 0x20, 0xb6, 0x67, 0xf3, 0xc0, 0x75, 0x3d, 0x1b, 0xc1, 0xd6, 0x57, 0xee, 0x33, 0xba,
+	0xed, 0x30, 0x72, 0x9d, 0x32, 0xb0, 0xfa, 0xab, 0x14, 0xd9, 0xed, 0x7b, 0xc6, 0xe0, 0xd5, 0x42,
+	0x80, 0x5d, 0x8c, 0x8f, 0xa9, 0xe5, 0xf8, 0xb8, 0xc2, 0x34, 0xd3, 0xab, 0x4c, 0x13, 0xa2, 0xf1,
+	0xc8, 0x03, 0x67, 0xf5, 0xcd, 0x6f, 0x59, 0xb8, 0xcd, 0x68, 0x5b, 0x38, 0xee, 0x99, 0xdf, 0xaa,
+	0x3f, 0x27, 0xdb, 0xcc, 0xcf, 0x41, 0xc9, 0x75, 0x11, 0xfe, 0x90, 0x60, 0xfc, 0x66, 0xf1, 0x90,
+	0x47, 0xf9, 0x2c, 0x0c, 0x31, 0x14, 0xc2, 0xf6, 0x62, 0x00, 0x65, 0x26, 0xc8, 0x78, 0xa7, 0xb4,
+	0x1c, 0x02, 0xd0, 0xec, 0xd4, 0x21, 0x51, 0xe6, 0xcc, 0xfd, 0xa9, 0xeb, 0xf8, 0x26, 0xc6, 0x76,
+	0x8c, 0x11, 0x68, 0x02, 0xe1, 0x86, 0xa7, 0x18, 0xcb, 0xb2, 0x80, 0xcb, 0xfd, 0xfe, 0x88, 0x87,
+	0x6c, 0xdd, 0x76, 0x07, 0xaf, 0xf0, 0x12, 0x30, 0x6e, 0xc4, 0xda, 0x25, 0x04, 0xb7, 0x01, 0xda,
+	0x40, 0x20, 0xa8, 0xc0, 0xee, 0xa9, 0xbe, 0xcb, 0xd6, 0x7a, 0x87, 0x6d, 0x54, 0xc9, 0x26, 0x0b,
+	0x57, 0x8c, 0x6d, 0xe1, 0xa4, 0x18, 0x8d, 0x7b, 0x1a, 0x47, 0x01, 0xf3, 0xdd, 0x18, 0x73, 0xa1,
+	0x45, 0x95, 0xe4, 0x40, 0x63, 0x6b, 0x62, 0xbc, 0x34, 0x05, 0xe7, 0x70, 0x0c, 0x1a, 0x6e, 0x8d,
+	0x0c, 0xcb, 0x86, 0x08, 0x23, 0x18, 0x97, 0x65, 0x1c, 0xe2, 0x50, 0x4d, 0xa2, 0xd5, 0xbb, 0xa4,
+	0x0a, 0x1c, 0xcd, 0xe0, 0xc2, 0xf2, 0x7d, 0xcb, 0x75, 0xea, 0x2e, 0x58, 0xb7, 0x6b, 0x0b, 0x0d,
+	0xd4, 0x7b, 0xe4, 0x28, 0x11, 0xcb, 0x45, 0xc0, 0xc9, 0x5f, 0xcd, 0x4c, 0xef, 0x26, 0x79, 0xf2,
+	0x57, 0xe4, 0x28, 0x11, 0x2b, 0xe4, 0xff, 0x84, 0x6c, 0x4e, 0x0d, 0xcb, 0x43, 0x9b, 0xc1, 0xb8,
+	0x7d, 0x10, 0x89, 0xdb, 0x97, 0x00, 0x3f, 0xb7, 0xc0, 0xe7, 0x20, 0x32, 0x73, 0xa2, 0x7f, 0xc8,
+	0xe4, 0x52, 0x4a, 0x5a, 0xfd, 0xd7, 0x14, 0x29, 0x44, 0x90, 0x78, 0xf4, 0xe8, 0xeb, 0x3a, 0x9a,
+	0x92, 0xdc, 0x04, 0x04, 0x9c, 0xc1, 0x18, 0x0d, 0x86, 0x21, 0x03, 0x57, 0xb8, 0x64, 0x16, 0x87,
+	0x7d, 0x97, 0xfe, 0x80, 0x6c, 0x8d, 0x39, 0x03, 0x76, 0xb3, 0x16, 0x4e, 0x76, 0x17, 0xd6, 0x6e,
+	0x18, 0x81, 0xa1, 0x49, 0x1a, 0x58, 0x7a, 0x43, 0xc9, 0xc0, 0x67, 0x46, 0xd9, 0x84, 0xcf, 0x4d,
+	0x25, 0x0b, 0x9f, 0x59, 0x65, 0x4b, 0xfd, 0x63, 0x8a, 0xe4, 0x24, 0x35, 0x4a, 0x82, 0x5b, 0xaa,
+	0xa3, 0x5d, 0x08, 0x63, 0xca, 0x21, 0xa0, 0x0f, 0x63, 0xfa, 0x90, 0x14, 0x19, 0x32, 0x6e, 0xbf,
+	0x04, 0x61, 0x35, 0x6e, 0xc3, 0x78, 0xe5, 0x4b, 0x0a, 0x66, 0x8f, 0x19, 0x71, 0xe5, 0x73, 0x12,
+	0xe9, 0xfc, 0xfe, 0x6c, 0x30, 0x30, 0x7d, 0x9f, 0xaf, 0xb2, 0xc9, 0x49, 0x04, 0x8c, 0x2d, 0x04,
+	0xf6, 0x2a, 0x49, 0xe4, 0x5a, 0x59, 0x6e, 0xaf, 0x02, 0x2c, 0x96, 0x03, 0x0f, 0x88, 0xd2, 0x4d,
+	0xe6, 0x49, 0x46, 0x79, 0x4e, 0x88, 0x8b, 0x72, 0xe5, 0xd5, 0x5f, 0x90, 0x43, 0x76, 0x94, 0x97,
+	0x9e, 0x7b, 0x6d, 0x5c, 0x5b, 0xb6, 0x15, 0xdc, 0x48, 0x23, 0x47, 0xc5, 0xd1, 0xb1, 0x71, 0x6f,
+	0xe5, 0x11, 0x20, 0x00, 0xc3, 0x2d, 0x1e, 0x41, 0xe0, 0x72, 0x94, 0x38, 0x82, 0xc0, 0x65, 0x88,
+	0x68, 0x72, 0xb6, 0x11, 0x4b, 0xce, 0xd4, 0x57, 0xa4, 0xb2, 0xbc, 0x96, 0xb0, 0x99, 0x87, 0xa4,
+	0x30, 0x9d, 0x83, 0xd9, 0x72, 0x29, 0x2d, 0x0a, 0x8a, 0x9e, 0x6d, 0xfa, 0xf6, 0xb3, 0x55, 0xff,
+	0x94, 0x26, 0x3b, 0xa7, 0x33, 0xcb, 0x1e, 0xc6, 0x1c, 0x37, 0x2a, 0x5d, 0x2a, 0x9e, 0x3a, 0x26,
+	0xe5, 0x85, 0xe9, 0xc4, 0xbc, 0xf0, 0x93, 0x84, 0xdc, 0x6b, 0x63, 0x7e, 0xd3, 0x2c, 0x64, 0x5e,
+	0x0f, 0x48, 0x61, 0x9e, 0x48, 0xf9, 0x70, 0xfc, 0x78, 0x77, 0x91, 0xb1, 0xcc, 0xa2, 0x7c, 0xfa,
+	0x3e, 0x29, 0xc1, 0xe5, 0x84, 0x37, 0x19, 0xdc, 0x23, 0xe0, 0x4e, 0xec, 0xf8, 0x73, 0x5a, 0x51,
+	0x00, 0xbb, 0x08, 0x5b, 0x8a, 0x38, 0xd9, 0xe5, 0x88, 0xf3, 0x8c, 0xec, 0xb2, 0x85, 0x8c, 0x1b,
+	0xdb, 0x35, 0x86, 0xfa, 0xc8, 0xf5, 0x26, 0x06, 0xdc, 0x24, 0x5b, 0x2c, 0x5d, 0x39, 0x8a, 0x6c,
+	0x16, 0x66, 0x70, 0x9c, 0xe8, 0x8c, 0xd1, 0x68, 0x3b, 0xe3, 0x05, 0x88, 0x8f, 0x59, 0xa8, 0x67,
+	0x42, 0x22, 0xe3, 0x80, 0x93, 0xb1, 0x3c, 0x89, 0x65, 0x97, 0x20, 0x15, 0x87, 0xf6, 0x5d, 0x4c,
+	0x91, 0xf0, 0x66, 0xc4, 0x23, 0x32, 0x59, 0xf2, 0x97, 0xd3, 0xf8, 0x40, 0xfd, 0x8f, 0x14, 0xa1,
+	0xd1, 0xad, 0x17, 0x47, 0x1c, 0x46, 0xc4, 0xd4, 0xca, 0x88, 0x88, 0x0c, 0xf9, 0x1e, 0x88, 0xab,
+	0x96, 0x0d, 0x30, 0x03, 0xf0, 0xc7, 0x06, 0x26, 0x31, 0x90, 0x5e, 0x83, 0x00, 0x3e, 0x6c, 0x37,
+	0xcb, 0x00, 0x38, 0xb4, 0xc7, 0x81, 0x98, 0x8f, 0x32, 0x01, 0xf8, 0x75, 0x9c, 0x61, 0x22, 0xe5,
+	0x19, 0x84, 0xdd, 0xc7, 0x8b, 0x5b, 0xb8, 0xb9, 0xb4, 0x85, 0x18, 0xf6, 0x7a, 0xb3, 0x6b, 0x7f,
+	0xe0, 0x59, 0xd7, 0x26, 0x26, 0x2a, 0xcd, 0xd7, 0x80, 0xf1, 0x65, 0xd8, 0xfb, 0x9f, 0x0c, 0xc9,
+	0x87, 0x50, 0xbc, 0x27, 0x63, 0xf9, 0x86, 0x63, 0xda, 0x68, 0x08, 0x3c, 0x6f, 0xd8, 0x89, 0xa6,
+	0x1b, 0x80, 0x01, 0x3b, 0x00, 0xfa, 0x98, 0xd5, 0x08, 0xfa, 0x34, 0xa7, 0x8f, 0x1a, 0x0d, 0xa7,
+	0x7f, 0x14, 0xc9, 0x67, 0x30, 0x99, 0x0a, 0xad, 0x6c, 0x9e, 0xcb, 0xa0, 0x30, 0x9c, 0x32, 0xe4,
+	0x2c, 0x29, 0x33, 0x9c, 0x52, 0xc2, 0x05, 0x25, 0x6c, 0x01, 0x06, 0x18, 0x3f, 0x30, 0x26, 0x53,
+	0xdd, 0xf1, 0xd9, 0x16, 0x64, 0xb4, 0x42, 0x08, 0xeb, 0xf8, 0xf4, 0xc7, 0x84, 0x98, 0xa8, 0x9f,
+	0x1e, 0xdc, 0x4c, 0x4d, 0x66, 0x66, 0xe5, 0x93, 0xfb, 0x51, 0xe3, 0x91, 0x1b, 0x70, 0xcc, 0x3e,
+	0xfb, 0x40, 0xa5, 0xe5, 0x4d, 0xf9, 0x93, 0xfe, 0x04, 0xc2, 0x9d, 0xeb, 0xbd, 0x31, 0xbc, 0xa1,
+	0xce, 0x80, 0x22, 0x0e, 0x1f, 0x46, 0x38, 0x9c, 0x71, 0x3c, 0x9b, 0x7e, 0xfe, 0x3d, 0xa8, 0x6b,
+	0x22, 0x63, 0x30, 0x62, 0x2a, 0xe7, 0xb3, 0xb0, 0xc9, 0x99, 0xe4, 0x18, 0x93, 0xa3, 0x65, 0x26,
+	0x78, 0xeb, 0x49, 0x46, 0xca, 0x68, 0x01, 0x46, 0xbf, 0x80, 0xb8, 0x6a, 0x06, 0x81, 0x6d, 0x0a,
+	0x36, 0x79, 0xc6, 0xe6, 0x20, 0x56, 0x47, 0x20, 0x5a, 0x72, 0x28, 0xf8, 0xf3, 0x21, 0x3d, 0x85,
+	0x2a, 0xc8, 0x72, 0x5e, 0x45, 0xc5, 0x20, 0x6c, 0x7e, 0x25, 0x32, 0xbf, 0x0d, 0x14, 0x51, 0x19,
+	0x4a, 0x76, 0x14, 0xa0, 0x7e, 0x49, 0xf2, 0xe1, 0x2e, 0xd1, 0x02, 0xd9, 0xba, 0xea, 0x3c, 0xeb,
+	0x74, 0xbf, 0xee, 0x28, 0xdf, 0xa3, 0x39, 0x92, 0xe9, 0x35, 0x3b, 0x0d, 0x25, 0x85, 0x60, 0xad,
+	0x59, 0x6f, 0xb6, 0x9e, 0x37, 0x95, 0x34, 0x0e, 0xce, 0xba, 0xda, 0xd7, 0x35, 0xad, 0xa1, 0x6c,
+	0x9c, 0x6e, 0x91, 0x4d, 0xb6, 0xae, 0xfa, 0x5b, 0xb8, 0x8f, 0xd8, 0x09, 0x3a, 0x23, 0x17, 0xd2,
+	0xca, 0xd0, 0xb8, 0xd8, 0x6d, 0x81, 0x19, 0x0c, 0xb3, 0x3a, 0xc8, 0xb3, 0x25, 0xa2, 0x2f, 0xe0,
+	0x48, 0x1c, 0x9a, 0x46, 0x48, 0x9c, 0xe6, 0xc4, 0x12, 0x11, 0x12, 0x3f, 0x8e, 0x70, 0x8e, 0xc5,
+	0x70, 0xa8, 0x11, 0x25, 0x42, 0x5e, 0x59, 0xd1, 0x7a, 0x32, 0x76, 0xb5, 0x45, 0xea, 0x49, 0x41,
+	0xab, 0x7e, 0x4e, 0x8a, 0xd1, 0x33, 0x87, 0x72, 0x39, 0x03, 0xe9, 0xa5, 0x2b, 0xe2, 0xc0, 0xee,
+	0x82, 0x71, 0xa1, 0x92, 0x1a, 0x23, 0x80, 0x3c, 0x43, 0x59, 0x3c, 0x67, 0xb0, 0xcf, 0xe2, 0x1b,
+	0xcb, 0x33, 0x75, 0x99, 0x05, 0xa5, 0x98, 0x85, 0x56, 0xe3, 0x59, 0x90, 0xfc, 0xae, 0xc3, 0x8d,
+	0xa4, 0x15, 0x90, 0x5e, 0x00, 0xd4, 0x06, 0x29, 0x44, 0xce, 0x7c, 0x6d, 0xaa, 0x85, 0x89, 0xad,
+	0x4c, 0x22, 0xd3, 0x22, 0xb1, 0xe5, 0xd9, 0xa3, 0xfa, 0xfb, 0x14, 0x29, 0xc5, 0x8e, 0xfe, 0xad,
+	0x75, 0x5a, 0x92, 0x3f, 0xfd, 0x4e, 0xf2, 0xd3, 0xbf, 0x27, 0x65, 0x31, 0x13, 0xae, 0xa8, 0x00,
+	0x7e, 0xb1, 0x03, 0x2a, 0xc7, 0x8c, 0x52, 0xd0, 0x36, 0x18, 0x5e, 0x2b, 0x8d, 0xa2, 0x43, 0x8c,
+	0xa5, 0x92, 0x01, 0xd6, 0x71, 0xce, 0x4b, 0x76, 0x6a, 0xf9, 0x90, 0xac, 0xc7, 0x80, 0x98, 0x8f,
+	0x95, 0x44, 0xed, 0xd0, 0x0b, 0xa0, 0xa2, 0xf5, 0xe1, 0xfe, 0xdd, 0x84, 0x18, 0x11, 0xc8, 0x1d,
+	0x3f, 0x8c, 0xdd, 0xbe, 0x21, 0x21, 0x44, 0x72, 0x46, 0x15, 0xdb, 0xd9, 0xf4, 0x52, 0x12, 0xbb,
+	0xc9, 0xcb, 0xc3, 0x0c, 0x4b, 0x10, 0xa9, 0x50, 0xfe, 0xbc, 0xdf, 0xae, 0x8b, 0x6a, 0x47, 0xe3,
+	0x04, 0x22, 0x49, 0xf9, 0x09, 0x21, 0x75, 0xcb, 0x1b, 0xcc, 0xac, 0xe0, 0x19, 0x94, 0x63, 0x90,
+	0x7a, 0xc8, 0x5b, 0x97, 0x07, 0xdb, 0xec, 0x80, 0xdf, 0xb4, 0x80, 0x90, 0xe1, 0x8f, 0x9f, 0x57,
+	0x76, 0xcc, 0xc2, 0x9e, 0xfa, 0x9f, 0x19, 0x72, 0x24, 0x0c, 0x89, 0x9f, 0x46, 0x80, 0xa5, 0xe5,
+	0x34, 0xac, 0x8a, 0x9e, 0x92, 0xbd, 0x79, 0x28, 0xe7, 0x0b, 0xe9, 0xb2, 0x06, 0x2c, 0x9c, 0xec,
+	0x47, 0x34, 0x9d, 0x8b, 0xa1, 0xd1, 0x30, 0xc4, 0xcf, 0x45, 0xfb, 0x34, 0xc2, 0xc8, 0x98, 0xb8,
+	0x33, 0x47, 0x38, 0x06, 0x8f, 0xb3, 0x74, 0xee, 0x44, 0x88, 0x62, 0x7e, 0xf4, 0x31, 0x09, 0x5d,
+	0x4b, 0x37, 0xbf, 0x9b, 0x5a, 0x90, 0xdd, 0x64, 0x99, 0x7b, 0x86, 0x41, 0xbe, 0xc9, 0xa0, 0x4b,
+	0xb7, 0x57, 0x7a, 0x39, 0x01, 0xf8, 0x82, 0x54, 0x43, 0x9f, 0x14, 0x0d, 0x2b, 0xb8, 0x32, 0xe5,
+	0x5e, 0x6d, 0x31, 0x19, 0x0e, 0x25, 0x85, 0x26, 0x09, 0x44, 0x9a, 0x02, 0xa2, 0x47, 0x1c, 0x7a,
+	0x2e, 0x3a, 0xf7, 0x7f, 0x3a, 0xf7, 0xe9, 0xa8, 0xe8, 0xe1, 0x0c, 0x21, 0x7a, 0x86, 0x8b, 0x2e,
+	0xc1, 0x42, 0xf4, 0x7f, 0x26, 0xe5, 0x85, 0x86, 0x4e, 0x8e, 0x9d, 0xfb, 0xdf, 0x2e, 0xc7, 0xf3,
+	0xa4, 0xe3, 0x39, 0x4e, 0xe8, 0xea, 0x94, 0x06, 0xb1, 0x8e, 0x0e, 0xdc, 0xfc, 0x2c, 0x53, 0xd0,
+	0xaf, 0x6d, 0xf7, 0x9a, 0x85, 0xf9, 0xa2, 0x96, 0x67, 0x90, 0x53, 0x00, 0x54, 0x7f, 0x4a, 0xe8,
+	0xff, 0xad, 0xd0, 0x57, 0xff, 0x9c, 0x22, 0x77, 0x93, 0x45, 0x14, 0xc9, 0xcd, 0xff, 0x9b, 0x09,
+	0x7d, 0x41, 0xb2, 0xc6, 0x20, 0x90, 0x29, 0x50, 0xf9, 0xe4, 0xfd, 0xc8, 0x54, 0x58, 0xcd, 0xb5,
+	0x5f, 0x9b, 0xe7, 0xae, 0x3d, 0x14, 0xc2, 0xd4, 0x18, 0xa9, 0x26, 0xa6, 0xc4, 0x9c, 0x6e, 0x63,
+	0xc1, 0xe9, 0x7e, 0xcc, 0x4b, 0x15, 0x74, 0xfc, 0x01, 0xa6, 0xed, 0x99, 0xdb, 0x03, 0xcf, 0x68,
+	0x3e, 0x80, 0xab, 0xec, 0xf0, 0xa9, 0x19, 0x84, 0xed, 0x04, 0x7f, 0x66, 0xbf, 0x43, 0x53, 0x41,
+	0x6d, 0x91, 0xbb, 0x61, 0x62, 0x25, 0x52, 0x9c, 0xa7, 0x9e, 0x31, 0x1d, 0x4b, 0x16, 0xdf, 0x67,
+	0xc9, 0x0e, 0xcb, 0x81, 0x7d, 0xc7, 0x98, 0xfa, 0x63, 0x97, 0xe7, 0xe7, 0x39, 0x76, 0xf3, 0x20,
+	0xbc, 0x27, 0xc0, 0xea, 0x6f, 0x20, 0xbb, 0x8c, 0xb2, 0xe0, 0x7d, 0x08, 0x7a, 0x42, 0xb2, 0xbc,
+	0x55, 0x21, 0xb6, 0x5c, 0x2a, 0xc6, 0x68, 0xfa, 0xee, 0xd4, 0xb5, 0xdd, 0x97, 0x37, 0x9c, 0x56,
+	0x13, 0x94, 0xb8, 0x5d, 0xe1, 0x6a, 0xbc, 0xbf, 0x11, 0x8e, 0xf1, 0xe6, 0x94, 0xbf, 0x61, 0xbf,
+	0x26, 0x53, 0xdb, 0x0c, 0xf8, 0x9e, 0xe6, 0x34, 0x45, 0x22, 0xea, 0x02, 0xae, 0x7e, 0x42, 0x0e,
+	0x6a, 0xc3, 0x61, 0x33, 0xd2, 0x8f, 0x8a, 0xf4, 0x3b, 0x22, 0xf5, 0x13, 0xfb, 0xad, 0xde, 0x21,
+	0x87, 0x4b, 0xd4, 0xa2, 0xee, 0x7e, 0x42, 0xee, 0x68, 0xe6, 0xc4, 0x7d, 0x6d, 0xbe, 0x2d, 0x2f,
+	0x56, 0xe5, 0x2f, 0x4f, 0x10, 0xec, 0xaa, 0xa4, 0xd2, 0x86, 0x7a, 0x28, 0x8a, 0x0b, 0xb3, 0xd9,
+	0xcf, 0xc8, 0x9d, 0x04, 0x9c, 0x30, 0x67, 0xf0, 0x04, 0xde, 0x6a, 0x4b, 0xb1, 0x44, 0x9b, 0x0f,
+	0xd4, 0x9f, 0x91, 0xbb, 0xac, 0x80, 0x63, 0x29, 0x7b, 0x42, 0xc5, 0xb8, 0xa6, 0xba, 0x5a, 0xa8,
+	0x82, 0xd2, 0x8b, 0x55, 0x90, 0x3a, 0x26, 0x65, 0xac, 0x4b, 0x22, 0x05, 0xdf, 0x5f, 0x56, 0x7f,
+	0x2e, 0x14, 0x92, 0x1b, 0x4b, 0x85, 0xa4, 0x3a, 0x25, 0xf7, 0x56, 0x68, 0xf1, 0x0e, 0xb5, 0x68,
+	0x06, 0x44, 0x97, 0x0d, 0x8e, 0x3b, 0x0b, 0xb5, 0x55, 0x84, 0x25, 0x23, 0x83, 0xa4, 0x63, 0x1f,
+	0x7c, 0x07, 0xc5, 0xbb, 0x30, 0xb1, 0x77, 0x2a, 0xcf, 0x00, 0x8c, 0x6c, 0x13, 0xd3, 0x6c, 0xbe,
+	0xcd, 0x65, 0x08, 0x13, 0xdc, 0x66, 0xe7, 0x94, 0x2c, 0xbd, 0xe6, 0x34, 0xea, 0xaf, 0xd3, 0xe4,
+	0x60, 0x91, 0x8d, 0x90, 0xd8, 0x27, 0x07, 0xd7, 0x66, 0xf0, 0xc6, 0x34, 0xc1, 0x2b, 0xa0, 0xf2,
+	0xc7, 0xb6, 0xa9, 0x67, 0x08, 0xe1, 0x51, 0xc2, 0x2f, 0x23, 0x12, 0x26, 0xb3, 0x38, 0x3e, 0x9d,
+	0xcf, 0xaf, 0x87, 0xd3, 0x79, 0xb0, 0xdd, 0xbf, 0x4e, 0xc2, 0xe1, 0x91, 0xa2, 0x63, 0xcc, 0xf0,
+	0x92, 0x99, 0xb7, 0x3e, 0x24, 0xa8, 0x16, 0x54, 0xff, 0x91, 0x54, 0x57, 0x73, 0x8d, 0x86, 0xdf,
+	0x3c, 0x0f, 0xbf, 0x8f, 0xa2, 0xe1, 0x77, 0x9e, 0x16, 0x9c, 0x41, 0x5d, 0x1a, 0x70, 0x71, 0xa3,
+	0x21, 0xf9, 0x92, 0xec, 0xd7, 0xae, 0x0d, 0x67, 0xe8, 0x3a, 0xef, 0xde, 0xe3, 0x04, 0xf3, 0x86,
+	0x62, 0x61, 0x60, 0x0a, 0xaf, 0xe7, 0x03, 0xb5, 0x02, 0x5e, 0xbc, 0xc0, 0x51, 0xf8, 0xd1, 0x43,
+	0x72, 0xff, 0xe9, 0x62, 0xaf, 0x0c, 0xbe, 0x46, 0x96, 0xbc, 0x46, 0xc1, 0x35, 0x1e, 0xac, 0xa4,
+	0x10, 0x87, 0xf4, 0x39, 0xc9, 0x0e, 0x18, 0x44, 0x44, 0xa8, 0x07, 0x91, 0x43, 0x49, 0x9c, 0x28,
+	0xc8, 0xd5, 0x17, 0xe4, 0x7e, 0x6f, 0xed, 0xea, 0x7f, 0x39, 0xeb, 0xf7, 0xc8, 0x83, 0xde, 0x7a,
+	0xb1, 0xd5, 0xdf, 0xa6, 0xc9, 0x5e, 0x12, 0x01, 0x96, 0x00, 0x63, 0xc3, 0x1e, 0xe9, 0xb6, 0x35,
+	0x32, 0xc3, 0x77, 0x2f, 0x7e, 0x9b, 0x6e, 0x23, 0xa2, 0x0d, 0x70, 0xf9, 0xf0, 0x05, 0xb9, 0x02,
+	0x73, 0xff, 0x88, 0x5b, 0xa5, 0x99, 0x5b, 0x95, 0xc7, 0x71, 0xa7, 0x3f, 0x20, 0xd9, 0x37, 0x26,
+	0xb6, 0x97, 0x85, 0xe7, 0x8a, 0x11, 0xbd, 0x4b, 0xf2, 0xa0, 0x28, 0xdc, 0x64, 0x81, 0xeb, 0x89,
+	0x8c, 0x75, 0x0e, 0xc0, 0xc7, 0x87, 0x6b, 0x6b, 0xe2, 0x0e, 0x0d, 0x5b, 0xf7, 0x07, 0x86, 0x6d,
+	0x46, 0xb3, 0x2e, 0x45, 0x60, 0x7a, 0x88, 0x10, 0x6f, 0x67, 0xbb, 0x92, 0x9a, 0xb5, 0x11, 0xc5,
+	0x82, 0x59, 0xb6, 0xe0, 0x8e, 0x40, 0xa1, 0x8f, 0x7c, 0xcd, 0xd7, 0x86, 0xbc, 0x4a, 0xd2, 0x0f,
+	0xcd, 0x81, 0x71, 0xc3, 0x2a, 0xa9, 0x50, 0x63, 0x91, 0x57, 0x09, 0x8a, 0x06, 0x12, 0x60, 0x45,
+	0x25, 0x34, 0x87, 0xdc, 0xf5, 0x0e, 0xa4, 0x41, 0xae, 0x27, 0xaf, 0x4e, 0x50, 0xd6, 0x1d, 0xbd,
+	0xc3, 0xcd, 0x79, 0x42, 0xaa, 0x49, 0xf3, 0xe7, 0x71, 0x7a, 0x8a, 0x00, 0x31, 0x93, 0x0f, 0x30,
+	0xb4, 0x3f, 0x37, 0x3d, 0x6b, 0x74, 0x93, 0xb4, 0x66, 0xf2, 0x94, 0xff, 0x4a, 0x91, 0x6a, 0xd2,
+	0x1c, 0xb1, 0xce, 0x5b, 0xf8, 0x54, 0xc2, 0x6b, 0x69, 0x3a, 0xf1, 0xb5, 0x74, 0x5d, 0x92, 0x02,
+	0x89, 0x1c, 0xf3, 0xf0, 0x68, 0xab, 0x34, 0xcf, 0x20, 0xec, 0xe4, 0x20, 0x32, 0xe3, 0x8b, 0x81,
+	0xe5, 0x18, 0x81, 0x6c, 0x94, 0x81, 0x14, 0x11, 0x90, 0xfa, 0x87, 0x14, 0xd9, 0xc5, 0x6b, 0x4d,
+	0x68, 0x11, 0x46, 0xda, 0x1f, 0x10, 0x2a, 0x13, 0x0c, 0x96, 0x74, 0xf1, 0xfb, 0x9c, 0xa7, 0x18,
+	0x3b, 0x02, 0xd3, 0x0a, 0x11, 0xa8, 0x2f, 0x7b, 0x60, 0xd3, 0xdd, 0xd1, 0xc8, 0x37, 0x65, 0xfd,
+	0x57, 0x60, 0xb0, 0x2e, 0x03, 0xc9, 0x17, 0x1b, 0xa1, 0x9c, 0x2f, 0x12, 0xe5, 0x02, 0x7b, 0xe2,
+	0xe3, 0x20, 0xd4, 0xd4, 0x83, 0x0a, 0xde, 0xf3, 0xcd, 0xa1, 0x68, 0x47, 0x85, 0x63, 0xfa, 0x23,
+	0xc8, 0x3d, 0x58, 0x61, 0x65, 0x62, 0x1b, 0x06, 0xa3, 0xff, 0x5d, 0x11, 0xef, 0xc4, 0xf4, 0xe3,
+	0x58, 0xf9, 0xa5, 0x85, 0xd4, 0xea, 0xbf, 0xa5, 0xc8, 0x5e, 0x5c, 0x45, 0x71, 0x48, 0x8f, 0x61,
+	0x63, 0xa5, 0x34, 0x3c, 0xee, 0x97, 0xe3, 0x2c, 0xb5, 0x10, 0x8f, 0x1e, 0x33, 0xb2, 0x3c, 0x5f,
+	0xbc, 0x23, 0xc6, 0xd5, 0x54, 0x18, 0xa6, 0x15, 0xd1, 0x15, 0x5c, 0x9d, 0xbd, 0x08, 0xc7, 0x88,
+	0x45, 0x67, 0x00, 0x11, 0x11, 0x5a, 0x75, 0x8f, 0x50, 0x8c, 0x84, 0xc6, 0xc0, 0x00, 0x0b, 0x72,
+	0xe6, 0xd9, 0xc6, 0x6e, 0x0c, 0x3a, 0x7f, 0xea, 0x98, 0x08, 0x98, 0xbc, 0xe2, 0xe5, 0xf8, 0xf1,
+	0x2f, 0x33, 0xa4, 0x14, 0x2b, 0x65, 0xe3, 0x1d, 0x94, 0x12, 0xc9, 0x77, 0xba, 0x7a, 0xa3, 0xd9,
+	0xaf, 0xb5, 0xda, 0x4a, 0x0a, 0xae, 0x93, 0x62, 0xb7, 0xd3, 0xea, 0x76, 0x00, 0x52, 0xef, 0x36,
+	0xb0, 0x97, 0xb2, 0x4f, 0x76, 0xda, 0xad, 0xce, 0x33, 0xbd, 0xd3, 0xed, 0xeb, 0xcd, 0x76, 0xeb,
+	0x69, 0xeb, 0xb4, 0xdd, 0x54, 0x36, 0xc0, 0xfe, 0x15, 0xa0, 0xaa, 0x9f, 0xd7, 0x5a, 0x1d, 0xbd,
+	0xdf, 0xba, 0x68, 0x76, 0xaf, 0xfa, 0x4a, 0x06, 0xa1, 0x58, 0x7e, 0xea, 0xcd, 0x6f, 0xea, 0xcd,
+	0x66, 0xa3, 0xa7, 0x5f, 0xd4, 0xbe, 0x51, 0x36, 0x69, 0x85, 0xec, 0xb5, 0x3a, 0xbd, 0xab, 0xb3,
+	0xb3, 0x56, 0xbd, 0xd5, 0xec, 0xf4, 0xf5, 0xd3, 0x5a, 0xbb, 0xd6, 0xa9, 0x37, 0x95, 0x2c, 0xc4,
+	0x29, 0xda, 0xea, 0xd4, 0xbb, 0x17, 0x97, 0xed, 0x66, 0xbf, 0xa9, 0xcb, 0x9e, 0xcd, 0x16, 0xdd,
+	0x25, 0xdb, 0x8c, 0x4f, 0xad, 0xd1, 0xd0, 0xcf, 0x40, 0xb2, 0x66, 0x43, 0xc9, 0xa1, 0x24, 0x82,
+	0xa2, 0xa7, 0x37, 0x5a, 0xbd, 0xda, 0x29, 0x82, 0xf3, 0xb8, 0x66, 0xab, 0xf3, 0xbc, 0xdb, 0xaa,
+	0x37, 0xf5, 0x3a, 0xb2, 0x45, 0x28, 0x41, 0x62, 0x09, 0xbd, 0xea, 0x34, 0x9a, 0xda, 0x65, 0xad,
+	0xd5, 0x50, 0x0a, 0x90, 0x0d, 0x1d, 0x4a, 0x70, 0xf3, 0x9b, 0xcb, 0x96, 0xf6, 0x42, 0xef, 0x77,
+	0xbb, 0x7a, 0xaf, 0xdb, 0xed, 0x28, 0xc5, 0x28, 0x27, 0xd4, 0xb6, 0x7b, 0xd9, 0xec, 0x28, 0x25,
+	0xc8, 0x91, 0x76, 0x2f, 0x2e, 0x2f, 0x75, 0x89, 0x91, 0xca, 0x96, 0x91, 0x1c, 0xe4, 0xd3, 0x9a,
+	0x3d, 0xd0, 0xb3, 0xd5, 0xbb, 0xa8, 0xf5, 0xeb, 0xe7, 0xca, 0x36, 0xaa, 0xd4, 0x6b, 0xf6, 0x81,
+	0x6d, 0xbf, 0xd6, 0x9e, 0xc3, 0x15, 0x14, 0x68, 0x0e, 0xc7, 0x45, 0xdb, 0xdd, 0xaf, 0x95, 0x1d,
+	0xdc, 0x70, 0x04, 0x77, 0x9f, 0x0b, 0x11, 0x29, 0xea, 0x2e, 0x8e, 0x47, 0xae, 0xa9, 0xec, 0x22,
+	0x10, 0x06, 0xb5, 0x76, 0xab, 0xa1, 0x3f, 0x6b, 0xbe, 0x60, 0x3d, 0xaf, 0x3d, 0x04, 0x72, 0xc9,
+	0xf4, 0x4b, 0xad, 0xfb, 0x14, 0x05, 0x51, 0xf6, 0x21, 0xbd, 0x2d, 0xd7, 0x5b, 0x5a, 0xfd, 0xaa,
+	0x5d, 0xd3, 0x74, 0x0d, 0x04, 0x6d, 0x2a, 0x07, 0x8f, 0xff, 0x3d, 0x45, 0x8a, 0xd1, 0xee, 0x02,
+	0x9e, 0x3a, 0xcc, 0x3a, 0x83, 0xe3, 0x3c, 0xef, 0x73, 0x23, 0xe8, 0x5d, 0xd5, 0xf1, 0xc8, 0x9a,
+	0xd8, 0x4b, 0x03, 0x16, 0x7c, 0xd3, 0x43, 0x65, 0xd3, 0xb8, 0x96, 0x80, 0x81, 0xb9, 0x70, 0xbe,
+	0x1b, 0x28, 0xbc, 0x00, 0x36, 0x35, 0xad, 0xab, 0x81, 0x01, 0x7c, 0x40, 0x1e, 0x0a, 0x08, 0x9e,
+	0xab, 0xa6, 0x35, 0xeb, 0x7d, 0xfd, 0xb2, 0xf6, 0xe2, 0x02, 0x8f, 0x9d, 0x1b, 0x59, 0x0f, 0x0c,
+	0xe2, 0x01, 0x39, 0x0a, 0xa9, 0x92, 0xec, 0xe2, 0xf1, 0x97, 0xa4, 0xb2, 0xaa, 0x4a, 0xa3, 0x84,
+	0x64, 0x61, 0xc7, 0xfa, 0x60, 0x85, 0xac, 0xff, 0x77, 0xc6, 0x0d, 0x17, 0xa0, 0xb0, 0x01, 0x57,
+	0x17, 0x60, 0xb2, 0x8f, 0x3f, 0x07, 0x2b, 0x5c, 0x68, 0xc5, 0xd3, 0x6d, 0x52, 0xe8, 0xb7, 0x9f,
+	0xa3, 0x2c, 0xed, 0x6e, 0xad, 0x01, 0x53, 0x41, 0xc9, 0x76, 0xf3, 0x69, 0xad, 0xfe, 0x22, 0x84,
+	0xa5, 0x4e, 0xfe, 0x9b, 0x02, 0x17, 0x76, 0xe5, 0xd3, 0x9f, 0x92, 0x52, 0xe4, 0xdf, 0x11, 0xcf,
+	0x4f, 0xe8, 0xbd, 0xb5, 0xff, 0x9b, 0xa8, 0x2e, 0xc4, 0x88, 0x4f, 0x53, 0xf4, 0x94, 0x94, 0xa3,
+	0x6f, 0xc7, 0xc0, 0x22, 0xda, 0x00, 0x4e, 0x78, 0x56, 0x4e, 0xe0, 0xf1, 0x8c, 0x28, 0x4d, 0x7e,
+	0x3d, 0x9b, 0xf2, 0x95, 0x96, 0x56, 0xa3, 0xa5, 0x6c, 0xfc, 0x5d, 0xb8, 0x7a, 0x94, 0x88, 0x13,
+	0x51, 0xe2, 0x2b, 0x6c, 0xda, 0x85, 0xef, 0xa4, 0x4b, 0x0a, 0xc5, 0x1f, 0x67, 0xab, 0xf7, 0x57,
+	0xa1, 0x45, 0x42, 0xb3, 0xf1, 0x2f, 0x69, 0xd4, 0xb1, 0x14, 0xc1, 0x25, 0xec, 0xd2, 0x02, 0xd3,
+	0x84, 0x1e, 0x15, 0xfe, 0x5b, 0x25, 0xe1, 0x0d, 0x95, 0x7e, 0x18, 0xaf, 0xd8, 0x57, 0xbc, 0xc0,
+	0x56, 0x3f, 0xba, 0x8d, 0x4c, 0x28, 0x0f, 0xab, 0x24, 0x3c, 0xb6, 0xc6, 0x56, 0x59, 0xfd, 0x54,
+	0x1b, 0x5b, 0x65, 0xdd, 0x9b, 0xed, 0xcf, 0x89, 0xb2, 0xf8, 0x36, 0x47, 0xd5, 0xc5, 0xb9, 0xcb,
+	0x25, 0x5f, 0xf5, 0xfd, 0xb5, 0x34, 0x82, 0x79, 0x8b, 0x90, 0xf9, 0x7b, 0x10, 0xbd, 0x1b, 0x99,
+	0xb2, 0xf4, 0x42, 0x57, 0xbd, 0xb7, 0x02, 0x2b, 0x58, 0xf5, 0xc9, 0x6e, 0xc2, 0x0b, 0x4d, 0x6c,
+	0x37, 0x56, 0xbf, 0xe0, 0x54, 0xf7, 0x92, 0x1e, 0x32, 0xc0, 0x5a, 0x2f, 0xb8, 0x81, 0xc9, 0xbf,
+	0xfc, 0xdc, 0xe2, 0x31, 0x95, 0xe4, 0xd6, 0xe7, 0xcc, 0x67, 0xa6, 0x05, 0xec, 0xba, 0xa4, 0x18,
+	0xf5, 0x92, 0x5b, 0xdd, 0xe7, 0x56, 0x86, 0x23, 0xb8, 0x55, 0xa2, 0x6d, 0x27, 0x48, 0x79, 0x3f,
+	0xbe, 0xb5, 0x79, 0xc6, 0x77, 0x2c, 0x66, 0x01, 0x6b, 0xba, 0x6c, 0x8f, 0x70, 0x9d, 0x33, 0xa2,
+	0x2c, 0x36, 0x79, 0x62, 0x56, 0xb0, 0xa2, 0x03, 0xb4, 0xe8, 0xff, 0xd4, 0x20, 0xfb, 0x89, 0xed,
+	0x9e, 0x98, 0xd4, 0xeb, 0x1a, 0x42, 0x31, 0x33, 0x58, 0xee, 0xf6, 0x80, 0xa8, 0xdf, 0x90, 0xed,
+	0x85, 0x26, 0x0a, 0x7d, 0x2f, 0x32, 0x27, 0xb9, 0x1d, 0x53, 0x55, 0xd7, 0x91, 0x08, 0x13, 0x33,
+	0x08, 0x5d, 0x6e, 0xa9, 0xd0, 0x0f, 0x62, 0xee, 0xba, 0xa2, 0x45, 0x53, 0xfd, 0xf0, 0x16, 0x2a,
+	0xb1, 0xc4, 0x3f, 0x41, 0x6a, 0xb2, 0xd8, 0x7b, 0xa1, 0xef, 0xc7, 0xde, 0x95, 0x92, 0xbb, 0x36,
+	0xd5, 0x0f, 0xd6, 0x13, 0x09, 0xfe, 0xbf, 0x20, 0xfb, 0x89, 0x2d, 0x8e, 0xd8, 0xfe, 0xaf, 0x6b,
+	0xe5, 0x54, 0x1f, 0xdd, 0x4e, 0x28, 0xd6, 0xba, 0x22, 0xe5, 0x78, 0x4b, 0x81, 0x3e, 0x5c, 0xd3,
+	0x6d, 0xe0, 0xdc, 0xdf, 0xbb, 0xb5, 0x1f, 0x81, 0x6c, 0xe3, 0xc5, 0x78, 0x8c, 0x6d, 0x62, 0xe5,
+	0x1f, 0x63, 0x9b, 0x5c, 0xc9, 0xd3, 0x29, 0x6b, 0x63, 0x26, 0xd6, 0xb3, 0xdf, 0x8f, 0x0b, 0xb5,
+	0xa6, 0xde, 0xae, 0x3e, 0x7e, 0x1b, 0xd2, 0xf9, 0x8a, 0xbd, 0xb7, 0x58, 0xb1, 0xf7, 0xf6, 0x2b,
+	0xde, 0x52, 0xb1, 0xa3, 0x01, 0x2f, 0x97, 0x8c, 0x31, 0x03, 0x5e, 0x59, 0x91, 0xc6, 0x0c, 0x78,
+	0x4d, 0xdd, 0x09, 0x4b, 0x2c, 0x57, 0x8b, 0xb1, 0x25, 0x56, 0x16, 0xa0, 0xb1, 0x25, 0xd6, 0x94,
+	0x9c, 0x10, 0x44, 0xa3, 0x55, 0x4e, 0x2c, 0x88, 0x26, 0x54, 0x78, 0xd5, 0x07, 0x2b, 0xf1, 0x82,
+	0x61, 0x9b, 0x14, 0x22, 0x25, 0x48, 0x2c, 0xc8, 0x2f, 0x17, 0x2c, 0xb1, 0x2c, 0x22, 0xa1, 0x72,
+	0x39, 0xfd, 0xec, 0x67, 0x4f, 0x5e, 0x5a, 0xc1, 0x78, 0x76, 0x7d, 0x0c, 0x45, 0xe3, 0x13, 0xf6,
+	0x8f, 0x38, 0xc7, 0x72, 0x5e, 0x3a, 0x66, 0xf0, 0xc6, 0xf5, 0x5e, 0x3d, 0xb1, 0x9d, 0xe1, 0x13,
+	0x16, 0x13, 0x9f, 0x84, 0x6c, 0xae, 0xb3, 0xec, 0xbf, 0xcf, 0x7f, 0xfd, 0xbf, 0xe6, 0x22, 0x4e,
+	0xc5, 0x2b, 0x2d, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ context.Context
+	_ grpc.ClientConn
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// RouterClient is the client API for Router service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type RouterClient interface {
+	//
+	//SendPaymentV2 attempts to route a payment described by the passed
+	//PaymentRequest to the final destination. The call returns a stream of
+	//payment updates.
+	SendPaymentV2(ctx context.Context, in *SendPaymentRequest, opts ...grpc.CallOption) (Router_SendPaymentV2Client, error)
 	//
 	//TrackPaymentV2 returns an update stream for the payment identified by the
 	//payment hash.
@@ -2382,6 +4361,83 @@ type RouterClient interface {
 	//In case of interception, the htlc can be either settled, canceled or
 	//resumed later by using the ResolveHoldForward endpoint.
 	HtlcInterceptor(ctx context.Context, opts ...grpc.CallOption) (Router_HtlcInterceptorClient, error)
+	//
+	//GetPaymentResult returns the current state of the payment identified by the
+	//payment hash in a single response. Unlike TrackPaymentV2, it doesn't wait
+	//for the payment to reach a final state.
+	GetPaymentResult(ctx context.Context, in *GetPaymentResultRequest, opts ...grpc.CallOption) (*lnrpc.Payment, error)
+	//
+	//SubscribeChannelGraph creates a uni-directional stream from the server to
+	//the client which delivers the changes of the channel graph as seen by the
+	//router: new and updated nodes, new and updated channel policies and closed
+	//channels. If requested, the current graph is sent first so that the client
+	//can mirror the graph without polling.
+	SubscribeChannelGraph(ctx context.Context, in *SubscribeChannelGraphRequest, opts ...grpc.CallOption) (Router_SubscribeChannelGraphClient, error)
+	//
+	//AddExcludedNode adds a node to the persistent set of nodes that payments are
+	//never routed through. The set is merged with the nodes excluded by the
+	//individual payment requests.
+	AddExcludedNode(ctx context.Context, in *AddExcludedNodeRequest, opts ...grpc.CallOption) (*AddExcludedNodeResponse, error)
+	//
+	//RemoveExcludedNode removes a node from the persistent set of excluded nodes.
+	RemoveExcludedNode(ctx context.Context, in *RemoveExcludedNodeRequest, opts ...grpc.CallOption) (*RemoveExcludedNodeResponse, error)
+	//
+	//ListExcludedNodes returns the persistent set of excluded nodes.
+	ListExcludedNodes(ctx context.Context, in *ListExcludedNodesRequest, opts ...grpc.CallOption) (*ListExcludedNodesResponse, error)
+	//
+	//QueryRouteProbability returns the current success probability estimate for
+	//a route, given as a list of hops starting at our own node, along with the
+	//estimates for the individual hops.
+	QueryRouteProbability(ctx context.Context, in *QueryRouteProbabilityRequest, opts ...grpc.CallOption) (*QueryRouteProbabilityResponse, error)
+	//
+	//GetNodeMetrics returns node metrics calculated from the channel graph. The
+	//metrics are expensive to calculate, so they are recomputed periodically in
+	//the background and the latest result is returned along with the time it
+	//was computed at. Only requested metrics are computed, a metric that is
+	//requested for the first time becomes available once its first computation
+	//has finished.
+	GetNodeMetrics(ctx context.Context, in *GetNodeMetricsRequest, opts ...grpc.CallOption) (*GetNodeMetricsResponse, error)
+	//
+	//AbandonPayment removes the state of a payment from the control tower, so
+	//that a new payment to the same payment hash can be made. In-flight
+	//payments are refused unless force is set. Forcing it is meant strictly for
+	//recovering a payment whose state is stuck after a crash, as htlcs of the
+	//payment which are still in flight may settle after all.
+	AbandonPayment(ctx context.Context, in *AbandonPaymentRequest, opts ...grpc.CallOption) (*AbandonPaymentResponse, error)
+	//
+	//GetMissionControlConfig returns the parameters that mission control
+	//currently uses for probability estimation.
+	GetMissionControlConfig(ctx context.Context, in *GetMissionControlConfigRequest, opts ...grpc.CallOption) (*GetMissionControlConfigResponse, error)
+	//
+	//SetMissionControlConfig updates the parameters that mission control uses
+	//for probability estimation. The new values apply to the next probability
+	//query, the recorded history is kept.
+	SetMissionControlConfig(ctx context.Context, in *SetMissionControlConfigRequest, opts ...grpc.CallOption) (*SetMissionControlConfigResponse, error)
+	//
+	//
+	//ExportPaymentProof returns a proof of payment for a settled payment. The
+	//proof binds the payment request, the amount paid and the preimage, and can
+	//be checked by anyone with VerifyPaymentProof.
+	ExportPaymentProof(ctx context.Context, in *ExportPaymentProofRequest, opts ...grpc.CallOption) (*ExportPaymentProofResponse, error)
+	//
+	//
+	//VerifyPaymentProof decodes a proof of payment and checks that its preimage
+	//hashes to the payment hash of the payment request, and that the amount
+	//paid covers the amount of the payment request.
+	VerifyPaymentProof(ctx context.Context, in *VerifyPaymentProofRequest, opts ...grpc.CallOption) (*VerifyPaymentProofResponse, error)
+	//
+	//ListPayments returns the payments recorded by the control tower, oldest
+	//first. The payments can be filtered by status and are returned in pages,
+	//the indices of the response can be used to request the next page in
+	//either direction.
+	ListPayments(ctx context.Context, in *ListPaymentsRequest, opts ...grpc.CallOption) (*ListPaymentsResponse, error)
+	//
+	//
+	//GetMacaroon returns the router macaroon if it is only kept in memory
+	//because lnd was started with routerrpc.nomacaroonfile. Otherwise the
+	//macaroon is found in the file at routerrpc.routermacaroonpath and an error
+	//is returned.
+	GetMacaroon(ctx context.Context, in *GetMacaroonRequest, opts ...grpc.CallOption) (*GetMacaroonResponse, error)
 }
 
 type routerClient struct {
@@ -2637,16 +4693,165 @@ type routerHtlcInterceptorClient struct {
 	grpc.ClientStream
 }
 
-func (x *routerHtlcInterceptorClient) Send(m *ForwardHtlcInterceptResponse) error {
-	return x.ClientStream.SendMsg(m)
+func (x *routerHtlcInterceptorClient) Send(m *ForwardHtlcInterceptResponse) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *routerHtlcInterceptorClient) Recv() (*ForwardHtlcInterceptRequest, error) {
+	m := new(ForwardHtlcInterceptRequest)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *routerClient) GetPaymentResult(ctx context.Context, in *GetPaymentResultRequest, opts ...grpc.CallOption) (*lnrpc.Payment, error) {
+	out := new(lnrpc.Payment)
+	err := c.cc.Invoke(ctx, "/routerrpc.Router/GetPaymentResult", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routerClient) SubscribeChannelGraph(ctx context.Context, in *SubscribeChannelGraphRequest, opts ...grpc.CallOption) (Router_SubscribeChannelGraphClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Router_serviceDesc.Streams[6], "/routerrpc.Router/SubscribeChannelGraph", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &routerSubscribeChannelGraphClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Router_SubscribeChannelGraphClient interface {
+	Recv() (*ChannelGraphUpdate, error)
+	grpc.ClientStream
+}
+
+type routerSubscribeChannelGraphClient struct {
+	grpc.ClientStream
+}
+
+func (x *routerSubscribeChannelGraphClient) Recv() (*ChannelGraphUpdate, error) {
+	m := new(ChannelGraphUpdate)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *routerClient) AddExcludedNode(ctx context.Context, in *AddExcludedNodeRequest, opts ...grpc.CallOption) (*AddExcludedNodeResponse, error) {
+	out := new(AddExcludedNodeResponse)
+	err := c.cc.Invoke(ctx, "/routerrpc.Router/AddExcludedNode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routerClient) RemoveExcludedNode(ctx context.Context, in *RemoveExcludedNodeRequest, opts ...grpc.CallOption) (*RemoveExcludedNodeResponse, error) {
+	out := new(RemoveExcludedNodeResponse)
+	err := c.cc.Invoke(ctx, "/routerrpc.Router/RemoveExcludedNode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routerClient) ListExcludedNodes(ctx context.Context, in *ListExcludedNodesRequest, opts ...grpc.CallOption) (*ListExcludedNodesResponse, error) {
+	out := new(ListExcludedNodesResponse)
+	err := c.cc.Invoke(ctx, "/routerrpc.Router/ListExcludedNodes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routerClient) QueryRouteProbability(ctx context.Context, in *QueryRouteProbabilityRequest, opts ...grpc.CallOption) (*QueryRouteProbabilityResponse, error) {
+	out := new(QueryRouteProbabilityResponse)
+	err := c.cc.Invoke(ctx, "/routerrpc.Router/QueryRouteProbability", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routerClient) GetNodeMetrics(ctx context.Context, in *GetNodeMetricsRequest, opts ...grpc.CallOption) (*GetNodeMetricsResponse, error) {
+	out := new(GetNodeMetricsResponse)
+	err := c.cc.Invoke(ctx, "/routerrpc.Router/GetNodeMetrics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routerClient) AbandonPayment(ctx context.Context, in *AbandonPaymentRequest, opts ...grpc.CallOption) (*AbandonPaymentResponse, error) {
+	out := new(AbandonPaymentResponse)
+	err := c.cc.Invoke(ctx, "/routerrpc.Router/AbandonPayment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routerClient) GetMissionControlConfig(ctx context.Context, in *GetMissionControlConfigRequest, opts ...grpc.CallOption) (*GetMissionControlConfigResponse, error) {
+	out := new(GetMissionControlConfigResponse)
+	err := c.cc.Invoke(ctx, "/routerrpc.Router/GetMissionControlConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routerClient) SetMissionControlConfig(ctx context.Context, in *SetMissionControlConfigRequest, opts ...grpc.CallOption) (*SetMissionControlConfigResponse, error) {
+	out := new(SetMissionControlConfigResponse)
+	err := c.cc.Invoke(ctx, "/routerrpc.Router/SetMissionControlConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routerClient) ExportPaymentProof(ctx context.Context, in *ExportPaymentProofRequest, opts ...grpc.CallOption) (*ExportPaymentProofResponse, error) {
+	out := new(ExportPaymentProofResponse)
+	err := c.cc.Invoke(ctx, "/routerrpc.Router/ExportPaymentProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routerClient) VerifyPaymentProof(ctx context.Context, in *VerifyPaymentProofRequest, opts ...grpc.CallOption) (*VerifyPaymentProofResponse, error) {
+	out := new(VerifyPaymentProofResponse)
+	err := c.cc.Invoke(ctx, "/routerrpc.Router/VerifyPaymentProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routerClient) ListPayments(ctx context.Context, in *ListPaymentsRequest, opts ...grpc.CallOption) (*ListPaymentsResponse, error) {
+	out := new(ListPaymentsResponse)
+	err := c.cc.Invoke(ctx, "/routerrpc.Router/ListPayments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (x *routerHtlcInterceptorClient) Recv() (*ForwardHtlcInterceptRequest, error) {
-	m := new(ForwardHtlcInterceptRequest)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
+func (c *routerClient) GetMacaroon(ctx context.Context, in *GetMacaroonRequest, opts ...grpc.CallOption) (*GetMacaroonResponse, error) {
+	out := new(GetMacaroonResponse)
+	err := c.cc.Invoke(ctx, "/routerrpc.Router/GetMacaroon", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	return m, nil
+	return out, nil
 }
 
 // RouterServer is the server API for Router service.
@@ -2714,6 +4919,83 @@ type RouterServer interface {
 	//In case of interception, the htlc can be either settled, canceled or
 	//resumed later by using the ResolveHoldForward endpoint.
 	HtlcInterceptor(Router_HtlcInterceptorServer) error
+	//
+	//GetPaymentResult returns the current state of the payment identified by the
+	//payment hash in a single response. Unlike TrackPaymentV2, it doesn't wait
+	//for the payment to reach a final state.
+	GetPaymentResult(context.Context, *GetPaymentResultRequest) (*lnrpc.Payment, error)
+	//
+	//SubscribeChannelGraph creates a uni-directional stream from the server to
+	//the client which delivers the changes of the channel graph as seen by the
+	//router: new and updated nodes, new and updated channel policies and closed
+	//channels. If requested, the current graph is sent first so that the client
+	//can mirror the graph without polling.
+	SubscribeChannelGraph(*SubscribeChannelGraphRequest, Router_SubscribeChannelGraphServer) error
+	//
+	//AddExcludedNode adds a node to the persistent set of nodes that payments are
+	//never routed through. The set is merged with the nodes excluded by the
+	//individual payment requests.
+	AddExcludedNode(context.Context, *AddExcludedNodeRequest) (*AddExcludedNodeResponse, error)
+	//
+	//RemoveExcludedNode removes a node from the persistent set of excluded nodes.
+	RemoveExcludedNode(context.Context, *RemoveExcludedNodeRequest) (*RemoveExcludedNodeResponse, error)
+	//
+	//ListExcludedNodes returns the persistent set of excluded nodes.
+	ListExcludedNodes(context.Context, *ListExcludedNodesRequest) (*ListExcludedNodesResponse, error)
+	//
+	//QueryRouteProbability returns the current success probability estimate for
+	//a route, given as a list of hops starting at our own node, along with the
+	//estimates for the individual hops.
+	QueryRouteProbability(context.Context, *QueryRouteProbabilityRequest) (*QueryRouteProbabilityResponse, error)
+	//
+	//GetNodeMetrics returns node metrics calculated from the channel graph. The
+	//metrics are expensive to calculate, so they are recomputed periodically in
+	//the background and the latest result is returned along with the time it
+	//was computed at. Only requested metrics are computed, a metric that is
+	//requested for the first time becomes available once its first computation
+	//has finished.
+	GetNodeMetrics(context.Context, *GetNodeMetricsRequest) (*GetNodeMetricsResponse, error)
+	//
+	//AbandonPayment removes the state of a payment from the control tower, so
+	//that a new payment to the same payment hash can be made. In-flight
+	//payments are refused unless force is set. Forcing it is meant strictly for
+	//recovering a payment whose state is stuck after a crash, as htlcs of the
+	//payment which are still in flight may settle after all.
+	AbandonPayment(context.Context, *AbandonPaymentRequest) (*AbandonPaymentResponse, error)
+	//
+	//GetMissionControlConfig returns the parameters that mission control
+	//currently uses for probability estimation.
+	GetMissionControlConfig(context.Context, *GetMissionControlConfigRequest) (*GetMissionControlConfigResponse, error)
+	//
+	//SetMissionControlConfig updates the parameters that mission control uses
+	//for probability estimation. The new values apply to the next probability
+	//query, the recorded history is kept.
+	SetMissionControlConfig(context.Context, *SetMissionControlConfigRequest) (*SetMissionControlConfigResponse, error)
+	//
+	//
+	//ExportPaymentProof returns a proof of payment for a settled payment. The
+	//proof binds the payment request, the amount paid and the preimage, and can
+	//be checked by anyone with VerifyPaymentProof.
+	ExportPaymentProof(context.Context, *ExportPaymentProofRequest) (*ExportPaymentProofResponse, error)
+	//
+	//
+	//VerifyPaymentProof decodes a proof of payment and checks that its preimage
+	//hashes to the payment hash of the payment request, and that the amount
+	//paid covers the amount of the payment request.
+	VerifyPaymentProof(context.Context, *VerifyPaymentProofRequest) (*VerifyPaymentProofResponse, error)
+	//
+	//ListPayments returns the payments recorded by the control tower, oldest
+	//first. The payments can be filtered by status and are returned in pages,
+	//the indices of the response can be used to request the next page in
+	//either direction.
+	ListPayments(context.Context, *ListPaymentsRequest) (*ListPaymentsResponse, error)
+	//
+	//
+	//GetMacaroon returns the router macaroon if it is only kept in memory
+	//because lnd was started with routerrpc.nomacaroonfile. Otherwise the
+	//macaroon is found in the file at routerrpc.routermacaroonpath and an error
+	//is returned.
+	GetMacaroon(context.Context, *GetMacaroonRequest) (*GetMacaroonResponse, error)
 }
 
 // UnimplementedRouterServer can be embedded to have forward compatible implementations.
@@ -2771,6 +5053,48 @@ func (*UnimplementedRouterServer) TrackPayment(req *TrackPaymentRequest, srv Rou
 func (*UnimplementedRouterServer) HtlcInterceptor(srv Router_HtlcInterceptorServer) error {
 	return status.Errorf(codes.Unimplemented, "method HtlcInterceptor not implemented")
 }
+func (*UnimplementedRouterServer) GetPaymentResult(ctx context.Context, req *GetPaymentResultRequest) (*lnrpc.Payment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPaymentResult not implemented")
+}
+func (*UnimplementedRouterServer) SubscribeChannelGraph(req *SubscribeChannelGraphRequest, srv Router_SubscribeChannelGraphServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeChannelGraph not implemented")
+}
+func (*UnimplementedRouterServer) AddExcludedNode(ctx context.Context, req *AddExcludedNodeRequest) (*AddExcludedNodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddExcludedNode not implemented")
+}
+func (*UnimplementedRouterServer) RemoveExcludedNode(ctx context.Context, req *RemoveExcludedNodeRequest) (*RemoveExcludedNodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveExcludedNode not implemented")
+}
+func (*UnimplementedRouterServer) ListExcludedNodes(ctx context.Context, req *ListExcludedNodesRequest) (*ListExcludedNodesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListExcludedNodes not implemented")
+}
+func (*UnimplementedRouterServer) QueryRouteProbability(ctx context.Context, req *QueryRouteProbabilityRequest) (*QueryRouteProbabilityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryRouteProbability not implemented")
+}
+func (*UnimplementedRouterServer) GetNodeMetrics(ctx context.Context, req *GetNodeMetricsRequest) (*GetNodeMetricsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNodeMetrics not implemented")
+}
+func (*UnimplementedRouterServer) AbandonPayment(ctx context.Context, req *AbandonPaymentRequest) (*AbandonPaymentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AbandonPayment not implemented")
+}
+func (*UnimplementedRouterServer) GetMissionControlConfig(ctx context.Context, req *GetMissionControlConfigRequest) (*GetMissionControlConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMissionControlConfig not implemented")
+}
+func (*UnimplementedRouterServer) SetMissionControlConfig(ctx context.Context, req *SetMissionControlConfigRequest) (*SetMissionControlConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMissionControlConfig not implemented")
+}
+func (*UnimplementedRouterServer) ExportPaymentProof(ctx context.Context, req *ExportPaymentProofRequest) (*ExportPaymentProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportPaymentProof not implemented")
+}
+func (*UnimplementedRouterServer) VerifyPaymentProof(ctx context.Context, req *VerifyPaymentProofRequest) (*VerifyPaymentProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyPaymentProof not implemented")
+}
+func (*UnimplementedRouterServer) ListPayments(ctx context.Context, req *ListPaymentsRequest) (*ListPaymentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPayments not implemented")
+}
+func (*UnimplementedRouterServer) GetMacaroon(ctx context.Context, req *GetMacaroonRequest) (*GetMacaroonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMacaroon not implemented")
+}
 
 func RegisterRouterServer(s *grpc.Server, srv RouterServer) {
 	s.RegisterService(&_Router_serviceDesc, srv)
@@ -3033,6 +5357,261 @@ func (x *routerHtlcInterceptorServer) Recv() (*ForwardHtlcInterceptResponse, err
 	return m, nil
 }
 
+func _Router_GetPaymentResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPaymentResultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouterServer).GetPaymentResult(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/routerrpc.Router/GetPaymentResult",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouterServer).GetPaymentResult(ctx, req.(*GetPaymentResultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Router_SubscribeChannelGraph_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeChannelGraphRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RouterServer).SubscribeChannelGraph(m, &routerSubscribeChannelGraphServer{stream})
+}
+
+type Router_SubscribeChannelGraphServer interface {
+	Send(*ChannelGraphUpdate) error
+	grpc.ServerStream
+}
+
+type routerSubscribeChannelGraphServer struct {
+	grpc.ServerStream
+}
+
+func (x *routerSubscribeChannelGraphServer) Send(m *ChannelGraphUpdate) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Router_AddExcludedNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddExcludedNodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouterServer).AddExcludedNode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/routerrpc.Router/AddExcludedNode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouterServer).AddExcludedNode(ctx, req.(*AddExcludedNodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Router_RemoveExcludedNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveExcludedNodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouterServer).RemoveExcludedNode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/routerrpc.Router/RemoveExcludedNode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouterServer).RemoveExcludedNode(ctx, req.(*RemoveExcludedNodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Router_ListExcludedNodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListExcludedNodesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouterServer).ListExcludedNodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/routerrpc.Router/ListExcludedNodes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouterServer).ListExcludedNodes(ctx, req.(*ListExcludedNodesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Router_QueryRouteProbability_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRouteProbabilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouterServer).QueryRouteProbability(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/routerrpc.Router/QueryRouteProbability",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouterServer).QueryRouteProbability(ctx, req.(*QueryRouteProbabilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Router_GetNodeMetrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNodeMetricsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouterServer).GetNodeMetrics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/routerrpc.Router/GetNodeMetrics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouterServer).GetNodeMetrics(ctx, req.(*GetNodeMetricsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Router_AbandonPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AbandonPaymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouterServer).AbandonPayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/routerrpc.Router/AbandonPayment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouterServer).AbandonPayment(ctx, req.(*AbandonPaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Router_GetMissionControlConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMissionControlConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouterServer).GetMissionControlConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/routerrpc.Router/GetMissionControlConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouterServer).GetMissionControlConfig(ctx, req.(*GetMissionControlConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Router_SetMissionControlConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMissionControlConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouterServer).SetMissionControlConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/routerrpc.Router/SetMissionControlConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouterServer).SetMissionControlConfig(ctx, req.(*SetMissionControlConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Router_ExportPaymentProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportPaymentProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouterServer).ExportPaymentProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/routerrpc.Router/ExportPaymentProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouterServer).ExportPaymentProof(ctx, req.(*ExportPaymentProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Router_VerifyPaymentProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyPaymentProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouterServer).VerifyPaymentProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/routerrpc.Router/VerifyPaymentProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouterServer).VerifyPaymentProof(ctx, req.(*VerifyPaymentProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Router_ListPayments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPaymentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouterServer).ListPayments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/routerrpc.Router/ListPayments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouterServer).ListPayments(ctx, req.(*ListPaymentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Router_GetMacaroon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMacaroonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouterServer).GetMacaroon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/routerrpc.Router/GetMacaroon",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouterServer).GetMacaroon(ctx, req.(*GetMacaroonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Router_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "routerrpc.Router",
 	HandlerType: (*RouterServer)(nil),
@@ -3065,6 +5644,58 @@ var _Router_serviceDesc = grpc.ServiceDesc{
 			MethodName: "BuildRoute",
 			Handler:    _Router_BuildRoute_Handler,
 		},
+		{
+			MethodName: "GetPaymentResult",
+			Handler:    _Router_GetPaymentResult_Handler,
+		},
+		{
+			MethodName: "AddExcludedNode",
+			Handler:    _Router_AddExcludedNode_Handler,
+		},
+		{
+			MethodName: "RemoveExcludedNode",
+			Handler:    _Router_RemoveExcludedNode_Handler,
+		},
+		{
+			MethodName: "ListExcludedNodes",
+			Handler:    _Router_ListExcludedNodes_Handler,
+		},
+		{
+			MethodName: "QueryRouteProbability",
+			Handler:    _Router_QueryRouteProbability_Handler,
+		},
+		{
+			MethodName: "GetNodeMetrics",
+			Handler:    _Router_GetNodeMetrics_Handler,
+		},
+		{
+			MethodName: "AbandonPayment",
+			Handler:    _Router_AbandonPayment_Handler,
+		},
+		{
+			MethodName: "GetMissionControlConfig",
+			Handler:    _Router_GetMissionControlConfig_Handler,
+		},
+		{
+			MethodName: "SetMissionControlConfig",
+			Handler:    _Router_SetMissionControlConfig_Handler,
+		},
+		{
+			MethodName: "ExportPaymentProof",
+			Handler:    _Router_ExportPaymentProof_Handler,
+		},
+		{
+			MethodName: "VerifyPaymentProof",
+			Handler:    _Router_VerifyPaymentProof_Handler,
+		},
+		{
+			MethodName: "ListPayments",
+			Handler:    _Router_ListPayments_Handler,
+		},
+		{
+			MethodName: "GetMacaroon",
+			Handler:    _Router_GetMacaroon_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -3098,6 +5729,11 @@ var _Router_serviceDesc = grpc.ServiceDesc{
 			ServerStreams: true,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "SubscribeChannelGraph",
+			Handler:       _Router_SubscribeChannelGraph_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "routerrpc/router.proto",
 }
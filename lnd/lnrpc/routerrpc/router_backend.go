@@ -1,11 +1,17 @@
 package routerrpc
 
 import (
+	"bytes"
 	"context"
+	"encoding/binary"
 	"encoding/hex"
+	"fmt"
+	"io/ioutil"
 	math "math"
 	"time"
 
+	"github.com/golang/protobuf/proto"
+
 	"github.com/pkt-cash/pktd/btcec"
 	"github.com/pkt-cash/pktd/btcutil/er"
 	"github.com/pkt-cash/pktd/btcutil/util"
@@ -13,6 +19,7 @@ import (
 
 	"github.com/pkt-cash/pktd/btcutil"
 	"github.com/pkt-cash/pktd/chaincfg"
+	"github.com/pkt-cash/pktd/lnd/autopilot"
 	"github.com/pkt-cash/pktd/lnd/channeldb"
 	"github.com/pkt-cash/pktd/lnd/htlcswitch"
 	"github.com/pkt-cash/pktd/lnd/lnrpc"
@@ -35,6 +42,12 @@ type RouterBackend struct {
 	// capacity of a channel to populate in responses.
 	FetchChannelCapacity func(chanID uint64) (btcutil.Amount, er.R)
 
+	// FetchPairCapacity returns the largest capacity of the channels
+	// between the two nodes, or zero if there are none. Nodes forward
+	// non-strict, so a payment may end up on any of the channels.
+	FetchPairCapacity func(fromNode, toNode route.Vertex) (btcutil.Amount,
+		er.R)
+
 	// FetchChannelEndpoints returns the pubkeys of both endpoints of the
 	// given channel id.
 	FetchChannelEndpoints func(chanID uint64) (route.Vertex,
@@ -72,17 +85,40 @@ type RouterBackend struct {
 	// htlc events.
 	SubscribeHtlcEvents func() (*subscribe.Client, er.R)
 
+	// SubscribeTopology returns a client that receives the changes of the
+	// channel graph.
+	SubscribeTopology func() (*routing.TopologyClient, er.R)
+
+	// TopologySnapshot returns the current channel graph in the form of a
+	// single topology change.
+	TopologySnapshot func() (*routing.TopologyChange, er.R)
+
 	// InterceptableForwarder exposes the ability to intercept forward events
 	// by letting the router register a ForwardInterceptor.
 	InterceptableForwarder htlcswitch.InterceptableHtlcForwarder
+
+	// ExcludedNodes is the persistent set of nodes that payments are never
+	// routed through.
+	ExcludedNodes *routing.NodeExclusions
+
+	// PeerFeeLimits holds the default fee limits of payments, keyed by
+	// the last hop of the payment. They only apply to payments that don't
+	// specify a fee limit themselves and whose last hop is pinned or
+	// implied by their route hints.
+	PeerFeeLimits map[route.Vertex]lnwire.MilliSatoshi
+
+	// ChannelGraph is the channel graph that node metrics are computed
+	// over.
+	ChannelGraph autopilot.ChannelGraph
 }
 
 // MissionControl defines the mission control dependencies of routerrpc.
 type MissionControl interface {
 	// GetProbability is expected to return the success probability of a
-	// payment from fromNode to toNode.
+	// payment from fromNode to toNode. The capacity of the channels is zero
+	// if it is unknown.
 	GetProbability(fromNode, toNode route.Vertex,
-		amt lnwire.MilliSatoshi) float64
+		amt lnwire.MilliSatoshi, capacity btcutil.Amount) float64
 
 	// ResetHistory resets the history of MissionControl returning it to a
 	// state as if no payment attempts have been made.
@@ -96,6 +132,168 @@ type MissionControl interface {
 	// pair.
 	GetPairHistorySnapshot(fromNode,
 		toNode route.Vertex) routing.TimedPairResult
+
+	// ImportHistory merges a previously taken snapshot into the current
+	// mission control state.
+	ImportHistory(history *routing.MissionControlSnapshot) er.R
+
+	// GetConfig returns the current mission control config.
+	GetConfig() *routing.MissionControlConfig
+
+	// SetConfig updates the probability estimation parameters of mission
+	// control without touching its history.
+	SetConfig(cfg *routing.MissionControlConfig) er.R
+}
+
+// missionControlFileVersion is the version of the on-disk mission control
+// format written by ExportMissionControl. The file consists of this version as
+// a big endian uint32, followed by a serialized QueryMissionControlResponse.
+const missionControlFileVersion uint32 = 1
+
+// ErrMissionControlVersion is returned when importing a mission control file
+// that was written in an unsupported format version.
+var ErrMissionControlVersion = er.GenericErrorType.CodeWithDetail(
+	"ErrMissionControlVersion",
+	"unsupported mission control file version",
+)
+
+// ExportMissionControl writes a snapshot of the current mission control state
+// to the file at the given path, so that it can later be restored with
+// ImportMissionControl. The snapshot uses the same serialization as the
+// QueryMissionControl RPC.
+func (r *RouterBackend) ExportMissionControl(path string) er.R {
+	snapshot := r.MissionControl.GetHistorySnapshot()
+
+	b, errr := proto.Marshal(marshalMissionControl(snapshot))
+	if errr != nil {
+		return er.E(errr)
+	}
+
+	var version [4]byte
+	binary.BigEndian.PutUint32(version[:], missionControlFileVersion)
+
+	errr = ioutil.WriteFile(path, append(version[:], b...), 0o600)
+	if errr != nil {
+		return er.E(errr)
+	}
+
+	log.Infof("Exported %v mission control pairs to %v",
+		len(snapshot.Pairs), path)
+
+	return nil
+}
+
+// ImportMissionControl reads a mission control snapshot written by
+// ExportMissionControl from the given path and merges it into the current
+// mission control state. Files written in an unknown format version are
+// rejected with ErrMissionControlVersion without touching the state.
+func (r *RouterBackend) ImportMissionControl(path string) er.R {
+	b, errr := ioutil.ReadFile(path)
+	if errr != nil {
+		return er.E(errr)
+	}
+
+	if len(b) < 4 {
+		return er.Errorf("mission control file %v too short", path)
+	}
+
+	version := binary.BigEndian.Uint32(b[:4])
+	if version != missionControlFileVersion {
+		return ErrMissionControlVersion.New(
+			fmt.Sprintf("file %v has version %v, expected %v",
+				path, version, missionControlFileVersion), nil,
+		)
+	}
+
+	var rpcSnapshot QueryMissionControlResponse
+	if errr := proto.Unmarshal(b[4:], &rpcSnapshot); errr != nil {
+		return er.E(errr)
+	}
+
+	snapshot, err := unmarshalMissionControl(&rpcSnapshot)
+	if err != nil {
+		return err
+	}
+
+	return r.MissionControl.ImportHistory(snapshot)
+}
+
+// marshalMissionControl marshals a mission control snapshot to its rpc
+// representation.
+func marshalMissionControl(
+	snapshot *routing.MissionControlSnapshot) *QueryMissionControlResponse {
+
+	rpcPairs := make([]*PairHistory, 0, len(snapshot.Pairs))
+	for _, p := range snapshot.Pairs {
+		// Prevent binding to loop variable.
+		pair := p
+
+		rpcPair := PairHistory{
+			NodeFrom: pair.Pair.From[:],
+			NodeTo:   pair.Pair.To[:],
+			History:  toRPCPairData(&pair.TimedPairResult),
+		}
+
+		rpcPairs = append(rpcPairs, &rpcPair)
+	}
+
+	return &QueryMissionControlResponse{
+		Pairs: rpcPairs,
+	}
+}
+
+// unmarshalMissionControl converts the rpc representation of a mission control
+// snapshot back to a snapshot that can be imported into mission control.
+func unmarshalMissionControl(
+	rpcSnapshot *QueryMissionControlResponse) (
+	*routing.MissionControlSnapshot, er.R) {
+
+	pairs := make(
+		[]routing.MissionControlPairSnapshot, 0, len(rpcSnapshot.Pairs),
+	)
+	for _, rpcPair := range rpcSnapshot.Pairs {
+		from, err := route.NewVertexFromBytes(rpcPair.NodeFrom)
+		if err != nil {
+			return nil, err
+		}
+
+		to, err := route.NewVertexFromBytes(rpcPair.NodeTo)
+		if err != nil {
+			return nil, err
+		}
+
+		if rpcPair.History == nil {
+			return nil, er.Errorf("no history for pair %v->%v",
+				from, to)
+		}
+
+		pairs = append(pairs, routing.MissionControlPairSnapshot{
+			Pair:            routing.NewDirectedNodePair(from, to),
+			TimedPairResult: fromRPCPairData(rpcPair.History),
+		})
+	}
+
+	return &routing.MissionControlSnapshot{
+		Pairs: pairs,
+	}, nil
+}
+
+// fromRPCPairData unmarshals rpc pair data to a mission control pair result.
+func fromRPCPairData(rpcData *PairData) routing.TimedPairResult {
+	data := routing.TimedPairResult{
+		FailAmt:    lnwire.MilliSatoshi(rpcData.FailAmtMsat),
+		SuccessAmt: lnwire.MilliSatoshi(rpcData.SuccessAmtMsat),
+	}
+
+	if rpcData.FailTime != 0 {
+		data.FailTime = time.Unix(rpcData.FailTime, 0)
+	}
+
+	if rpcData.SuccessTime != 0 {
+		data.SuccessTime = time.Unix(rpcData.SuccessTime, 0)
+	}
+
+	return data
 }
 
 // QueryRoutes attempts to query the daemons' Channel Router for a possible
@@ -216,7 +414,8 @@ func (r *RouterBackend) QueryRoutes(ctx context.Context,
 	restrictions := &routing.RestrictParams{
 		FeeLimit: feeLimit,
 		ProbabilitySource: func(fromNode, toNode route.Vertex,
-			amt lnwire.MilliSatoshi) float64 {
+			amt lnwire.MilliSatoshi, capacity btcutil.Amount) float64 {
+
 			if _, ok := ignoredNodes[fromNode]; ok {
 				return 0
 			}
@@ -234,12 +433,13 @@ func (r *RouterBackend) QueryRoutes(ctx context.Context,
 			}
 
 			return r.MissionControl.GetProbability(
-				fromNode, toNode, amt,
+				fromNode, toNode, amt, capacity,
 			)
 		},
 		DestCustomRecords: record.CustomSet(in.DestCustomRecords),
 		CltvLimit:         cltvLimit,
 		DestFeatures:      features,
+		ExcludedNodes:     r.ExcludedNodes.Union(nil),
 	}
 
 	// Pass along an outgoing channel restriction if specified.
@@ -318,8 +518,15 @@ func (r *RouterBackend) getSuccessProbability(rt *route.Route) float64 {
 	for _, hop := range rt.Hops {
 		toNode := hop.PubKeyBytes
 
+		// The estimate doesn't need the capacity, so a channel that
+		// can't be found is treated as one of unknown capacity.
+		capacity, err := r.FetchChannelCapacity(hop.ChannelID)
+		if err != nil {
+			capacity = 0
+		}
+
 		probability := r.MissionControl.GetProbability(
-			fromNode, toNode, amtToFwd,
+			fromNode, toNode, amtToFwd, capacity,
 		)
 
 		successProb *= probability
@@ -531,6 +738,18 @@ func (r *RouterBackend) extractIntentFromSendRequest(
 		payIntent.LastHop = &lastHop
 	}
 
+	// Pass along the nodes the payment may not be routed through. They are
+	// merged with the persistently excluded nodes when path finding.
+	if len(rpcPayReq.ExcludedNodes) > 0 {
+		excludedNodes, err := UnmarshalHopPubkeys(
+			rpcPayReq.ExcludedNodes,
+		)
+		if err != nil {
+			return nil, err
+		}
+		payIntent.ExcludedNodes = excludedNodes
+	}
+
 	// Take the CLTV limit from the request if set, otherwise use the max.
 	cltvLimit, err := ValidateCLTVLimit(
 		uint32(rpcPayReq.CltvLimit), r.MaxTotalTimelock,
@@ -548,6 +767,15 @@ func (r *RouterBackend) extractIntentFromSendRequest(
 	}
 	payIntent.MaxParts = maxParts
 
+	// Take the in-flight shard limit from the request, making sure it
+	// doesn't exceed the global ceiling. Zero means no limit.
+	if rpcPayReq.MaxInflightHtlcs > routing.MaxInflightHtlcs {
+		return nil, er.Errorf("max_inflight_htlcs of %v exceeds "+
+			"maximum allowed %v", rpcPayReq.MaxInflightHtlcs,
+			routing.MaxInflightHtlcs)
+	}
+	payIntent.MaxInflightHtlcs = rpcPayReq.MaxInflightHtlcs
+
 	// Take fee limit from request.
 	payIntent.FeeLimit, err = lnrpc.UnmarshalAmt(
 		rpcPayReq.FeeLimitSat, rpcPayReq.FeeLimitMsat,
@@ -556,10 +784,24 @@ func (r *RouterBackend) extractIntentFromSendRequest(
 		return nil, err
 	}
 
+	// Take the cap on the fees of all attempts from the request. Zero
+	// means no cap.
+	if rpcPayReq.MaxTotalFeeMsat < 0 {
+		return nil, er.New("max_total_fee_msat must not be negative")
+	}
+	payIntent.MaxTotalFee = lnwire.MilliSatoshi(rpcPayReq.MaxTotalFeeMsat)
+
 	// Set payment attempt timeout.
 	if rpcPayReq.TimeoutSeconds == 0 {
 		return nil, er.New("timeout_seconds must be specified")
 	}
+	if rpcPayReq.TimeoutSeconds < 0 {
+		return nil, er.New("timeout_seconds must not be negative")
+	}
+
+	// Take the cap on the number of attempts from the request. Zero means
+	// no cap.
+	payIntent.MaxAttempts = rpcPayReq.MaxAttempts
 
 	customRecords := record.CustomSet(rpcPayReq.DestCustomRecords)
 	if err := customRecords.Validate(); err != nil {
@@ -681,14 +923,70 @@ func (r *RouterBackend) extractIntentFromSendRequest(
 
 		// Payment hash.
 		copy(payIntent.PaymentHash[:], rpcPayReq.PaymentHash)
+	}
 
-		// Parse destination feature bits.
-		features, err := UnmarshalFeatures(rpcPayReq.DestFeatures)
+	// Add the requested buffer on top of the final CLTV delta, the final
+	// hop must still fit within the CLTV limit of the payment.
+	if rpcPayReq.FinalCltvBuffer != 0 {
+		finalCltvDelta := uint32(payIntent.FinalCLTVDelta) +
+			rpcPayReq.FinalCltvBuffer
+		if finalCltvDelta > payIntent.CltvLimit ||
+			finalCltvDelta > math.MaxUint16 {
+
+			return nil, er.Errorf("final_cltv_buffer of %v on top "+
+				"of the final CLTV delta of %v exceeds the CLTV "+
+				"limit of %v", rpcPayReq.FinalCltvBuffer,
+				payIntent.FinalCLTVDelta, payIntent.CltvLimit)
+		}
+		payIntent.FinalCLTVDelta = uint16(finalCltvDelta)
+	}
+
+	// Destination feature bits given with the request override the ones of
+	// the payment request or the graph, as the destination may support more
+	// than it advertises.
+	features, err := UnmarshalFeatures(rpcPayReq.DestFeatures)
+	if err != nil {
+		return nil, err
+	}
+	if features != nil {
+		payIntent.DestFeatures = features
+	}
+
+	// If the fee limit is given as a percentage, it can only be resolved
+	// now that the amount is known.
+	if rpcPayReq.FeeLimitPercent != 0 {
+		payIntent.FeeLimit, err = feeLimitFromPercent(
+			rpcPayReq, payIntent.Amount,
+		)
 		if err != nil {
 			return nil, err
 		}
+	}
 
-		payIntent.DestFeatures = features
+	// Pin the last hop of a circular payment to the incoming channel, if
+	// one is given. This also sets the last hop, so that its fee limit
+	// applies.
+	if rpcPayReq.IncomingChanId != 0 {
+		err := r.unmarshalIncomingChannel(rpcPayReq, payIntent)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Without a fee limit in the request, fall back to the default fee
+	// limit of the last hop, if one is configured. The last hop is the one
+	// pinned by the request, or else the one all route hints lead through.
+	// An explicit fee limit always takes precedence.
+	feeLimitSet := rpcPayReq.FeeLimitSat != 0 ||
+		rpcPayReq.FeeLimitMsat != 0 || rpcPayReq.FeeLimitPercent != 0
+	lastHop := payIntent.LastHop
+	if lastHop == nil {
+		lastHop = hintedLastHop(payIntent.RouteHints)
+	}
+	if !feeLimitSet && lastHop != nil {
+		if limit, ok := r.PeerFeeLimits[*lastHop]; ok {
+			payIntent.FeeLimit = limit
+		}
 	}
 
 	// Check for disallowed payments to self.
@@ -696,9 +994,233 @@ func (r *RouterBackend) extractIntentFromSendRequest(
 		return nil, er.New("self-payments not allowed")
 	}
 
+	// Resolve the route hint preference, if any. Hint indexes are relative
+	// to the payment request's hints, which follow the ones passed in
+	// route_hints.
+	hintOffset := 0
+	if rpcPayReq.PaymentRequest != "" {
+		hintOffset = len(rpcPayReq.RouteHints)
+	}
+	payIntent.PreferredRouteHints, err = unmarshalHintPreference(
+		rpcPayReq, payIntent.RouteHints, hintOffset,
+	)
+	if err != nil {
+		return nil, err
+	}
+	payIntent.StrictRouteHints = rpcPayReq.StrictHintPreference
+
+	// The route hints of the request and the payment request may overlap.
+	// Repeated hints are merged when the payment is attempted, but hints
+	// that contradict each other are rejected right away.
+	err = routing.ValidateRouteHints(payIntent.RouteHints, payIntent.Target)
+	if err != nil {
+		return nil, err
+	}
+
 	return payIntent, nil
 }
 
+// unmarshalIncomingChannel validates the incoming_chan_id of a circular
+// payment to self and pins the last hop of the payment to it. The payment must
+// leave through exactly one outgoing channel, which differs from the incoming
+// channel, and the incoming channel must be one of our own.
+func (r *RouterBackend) unmarshalIncomingChannel(rpcPayReq *SendPaymentRequest,
+	payIntent *routing.LightningPayment) er.R {
+
+	chanID := rpcPayReq.IncomingChanId
+
+	switch {
+	case payIntent.Target != r.SelfNode:
+		return er.New("incoming_chan_id requires a circular payment " +
+			"to self")
+
+	case len(payIntent.OutgoingChannelIDs) != 1:
+		return er.New("incoming_chan_id requires exactly one " +
+			"outgoing channel")
+
+	case payIntent.OutgoingChannelIDs[0] == chanID:
+		return er.New("incoming_chan_id must differ from the " +
+			"outgoing channel")
+
+	case payIntent.LastHop != nil:
+		return er.New("last_hop_pubkey cannot be combined with " +
+			"incoming_chan_id")
+	}
+
+	node1, node2, err := r.FetchChannelEndpoints(chanID)
+	if err != nil {
+		return er.Errorf("unable to find incoming channel %v: %v",
+			chanID, err)
+	}
+
+	var lastHop route.Vertex
+	switch r.SelfNode {
+	case node1:
+		lastHop = node2
+	case node2:
+		lastHop = node1
+	default:
+		return er.Errorf("incoming channel %v is not a channel of "+
+			"this node", chanID)
+	}
+
+	payIntent.LastHop = &lastHop
+	payIntent.IncomingChannelID = &chanID
+
+	return nil
+}
+
+// checkCircularRoute makes sure that a route exists for a circular payment
+// which is pinned to an outgoing and an incoming channel, so that a payment
+// that can't succeed fails right away with ErrNoCircularRoute rather than
+// after exhausting its attempts.
+func (r *RouterBackend) checkCircularRoute(
+	payment *routing.LightningPayment) er.R {
+
+	// The optimal path is independent of the final cltv delta, so it isn't
+	// part of the limit passed to path finding.
+	cltvLimit := payment.CltvLimit - uint32(payment.FinalCLTVDelta)
+
+	restrictions := &routing.RestrictParams{
+		ProbabilitySource:  r.MissionControl.GetProbability,
+		FeeLimit:           payment.FeeLimit,
+		OutgoingChannelIDs: payment.OutgoingChannelIDs,
+		LastHop:            payment.LastHop,
+		IncomingChannelID:  payment.IncomingChannelID,
+		CltvLimit:          cltvLimit,
+		DestCustomRecords:  payment.DestCustomRecords,
+		DestFeatures:       payment.DestFeatures,
+		PaymentAddr:        payment.PaymentAddr,
+		ExcludedNodes:      r.ExcludedNodes.Union(payment.ExcludedNodes),
+	}
+
+	_, err := r.FindRoute(
+		r.SelfNode, r.SelfNode, payment.Amount, restrictions,
+		payment.DestCustomRecords, nil, payment.FinalCLTVDelta,
+	)
+	if routing.ErrNoRouteFound.Is(err) {
+		return ErrNoCircularRoute.New(fmt.Sprintf("outgoing channel "+
+			"%v, incoming channel %v", payment.OutgoingChannelIDs[0],
+			*payment.IncomingChannelID), err)
+	}
+	return err
+}
+
+// feeLimitFromPercent computes the fee limit of a payment of the given amount
+// from the fee_limit_percent field of the request. The percentage must be in
+// the range (0, 100] and can't be combined with an absolute fee limit.
+func feeLimitFromPercent(rpcPayReq *SendPaymentRequest,
+	amt lnwire.MilliSatoshi) (lnwire.MilliSatoshi, er.R) {
+
+	if rpcPayReq.FeeLimitSat != 0 || rpcPayReq.FeeLimitMsat != 0 {
+		return 0, er.New("fee_limit_percent cannot be combined with " +
+			"fee_limit_sat or fee_limit_msat")
+	}
+
+	percent := rpcPayReq.FeeLimitPercent
+	if !(percent > 0 && percent <= 100) {
+		return 0, er.Errorf("fee_limit_percent of %v is not in the "+
+			"range (0, 100]", percent)
+	}
+
+	return lnwire.MilliSatoshi(float64(amt) * percent / 100), nil
+}
+
+// unmarshalHintPreference converts the route hint preference of a send
+// request into an ordered list of indexes into routeHints. Indexes given in
+// the request are shifted by offset.
+func unmarshalHintPreference(rpcPayReq *SendPaymentRequest,
+	routeHints [][]zpay32.HopHint, offset int) ([]int, er.R) {
+
+	indexes := rpcPayReq.PreferredHintIndexes
+	pubkeys := rpcPayReq.PreferredHintPubkeys
+
+	switch {
+	case len(indexes) == 0 && len(pubkeys) == 0:
+		if rpcPayReq.StrictHintPreference {
+			return nil, er.New("strict_hint_preference requires " +
+				"a route hint preference")
+		}
+		return nil, nil
+
+	case len(indexes) > 0 && len(pubkeys) > 0:
+		return nil, er.New("preferred_hint_indexes and " +
+			"preferred_hint_pubkeys are mutually exclusive")
+
+	case len(rpcPayReq.LastHopPubkey) > 0:
+		return nil, er.New("last_hop_pubkey cannot be combined " +
+			"with a route hint preference")
+
+	case rpcPayReq.IncomingChanId != 0:
+		return nil, er.New("incoming_chan_id cannot be combined " +
+			"with a route hint preference")
+	}
+
+	var preferred []int
+	seen := make(map[int]struct{})
+	addHint := func(idx int) {
+		if _, ok := seen[idx]; ok {
+			return
+		}
+		seen[idx] = struct{}{}
+		preferred = append(preferred, idx)
+	}
+
+	for _, rpcIdx := range indexes {
+		idx := offset + int(rpcIdx)
+		if idx >= len(routeHints) {
+			return nil, er.Errorf("route hint index %v out of "+
+				"range", rpcIdx)
+		}
+		addHint(idx)
+	}
+
+	for _, pubkeyBytes := range pubkeys {
+		pubkey, err := route.NewVertexFromBytes(pubkeyBytes)
+		if err != nil {
+			return nil, err
+		}
+
+		found := false
+		for idx, hint := range routeHints {
+			for _, hopHint := range hint {
+				if route.NewVertex(hopHint.NodeID) != pubkey {
+					continue
+				}
+
+				addHint(idx)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, er.Errorf("no route hint contains "+
+				"node %v", pubkey)
+		}
+	}
+
+	return preferred, nil
+}
+
+// hintedLastHop returns the last hop of a payment over the route hints, which
+// is the node at the start of the final hint of every route. It returns nil
+// if there are no route hints or they don't agree on the last hop, as the
+// last hop is then only known once a route is found.
+func hintedLastHop(routeHints [][]zpay32.HopHint) *route.Vertex {
+	var lastHop *route.Vertex
+	for _, hints := range routeHints {
+		if len(hints) == 0 {
+			return nil
+		}
+		hop := route.NewVertex(hints[len(hints)-1].NodeID)
+		if lastHop != nil && *lastHop != hop {
+			return nil
+		}
+		lastHop = &hop
+	}
+	return lastHop
+}
+
 // unmarshalRouteHints unmarshals a list of route hints.
 func unmarshalRouteHints(rpcRouteHints []*lnrpc.RouteHint) (
 	[][]zpay32.HopHint, er.R) {
@@ -755,6 +1277,9 @@ func UnmarshalFeatures(
 
 	raw := lnwire.NewRawFeatureVector()
 	for _, bit := range rpcFeatures {
+		if _, ok := lnrpc.FeatureBit_name[int32(bit)]; !ok {
+			return nil, er.Errorf("unknown feature bit: %d", bit)
+		}
 		err := raw.SafeSet(lnwire.FeatureBit(bit))
 		if err != nil {
 			return nil, err
@@ -791,6 +1316,110 @@ func ValidateCLTVLimit(val, max uint32) (uint32, er.R) {
 	}
 }
 
+var (
+	// ErrNilHopPubkey is returned when a hop in a BuildRoute request has no
+	// public key.
+	ErrNilHopPubkey = Err.CodeWithDetail("ErrNilHopPubkey",
+		"hop pubkey is missing")
+
+	// ErrHopPubkeyLength is returned when a hop public key in a BuildRoute
+	// request is not a 33 byte compressed key.
+	ErrHopPubkeyLength = Err.CodeWithDetail("ErrHopPubkeyLength",
+		"hop pubkey has invalid length")
+
+	// ErrHopPubkeyNotCanonical is returned when a hop public key in a
+	// BuildRoute request is not a valid compressed encoding of a point on
+	// the curve.
+	ErrHopPubkeyNotCanonical = Err.CodeWithDetail("ErrHopPubkeyNotCanonical",
+		"hop pubkey is not a canonical compressed public key")
+
+	// ErrLegacyHopRecords is returned when the legacy payload format is
+	// requested for a hop that carries records it can't encode.
+	ErrLegacyHopRecords = Err.CodeWithDetail("ErrLegacyHopRecords",
+		"legacy hop payload can't carry custom records")
+
+	// ErrNoCircularRoute is returned when no route over both the outgoing
+	// and the incoming channel of a circular payment exists.
+	ErrNoCircularRoute = Err.CodeWithDetail("ErrNoCircularRoute",
+		"no circular route over the outgoing and incoming channel")
+)
+
+// UnmarshalHopPubkeys converts the hop_pubkeys of a BuildRoute request into
+// route vertices. Every key must be a canonical 33 byte compressed public key,
+// anything else is rejected with ErrNilHopPubkey, ErrHopPubkeyLength or
+// ErrHopPubkeyNotCanonical.
+func UnmarshalHopPubkeys(hopPubkeys [][]byte) ([]route.Vertex, er.R) {
+	hops := make([]route.Vertex, len(hopPubkeys))
+	for i, pubkeyBytes := range hopPubkeys {
+		switch {
+		case len(pubkeyBytes) == 0:
+			return nil, ErrNilHopPubkey.New(
+				fmt.Sprintf("hop %d", i), nil,
+			)
+
+		case len(pubkeyBytes) != route.VertexSize:
+			return nil, ErrHopPubkeyLength.New(fmt.Sprintf(
+				"hop %d: got %d bytes, want %d", i,
+				len(pubkeyBytes), route.VertexSize), nil,
+			)
+		}
+
+		pubkey, err := btcec.ParsePubKey(pubkeyBytes, btcec.S256())
+		if err != nil {
+			return nil, ErrHopPubkeyNotCanonical.New(
+				fmt.Sprintf("hop %d", i), err,
+			)
+		}
+		if !bytes.Equal(pubkey.SerializeCompressed(), pubkeyBytes) {
+			return nil, ErrHopPubkeyNotCanonical.New(
+				fmt.Sprintf("hop %d", i), nil,
+			)
+		}
+
+		hops[i] = route.NewVertex(pubkey)
+	}
+
+	return hops, nil
+}
+
+// applyHopPayloadFormats sets the onion payload format of each hop of the
+// route to the one requested. An empty list leaves the route untouched.
+func applyHopPayloadFormats(rt *route.Route,
+	formats []HopPayloadFormat) er.R {
+
+	if len(formats) == 0 {
+		return nil
+	}
+	if len(formats) != len(rt.Hops) {
+		return er.Errorf("got %d hop payload formats for %d hops",
+			len(formats), len(rt.Hops))
+	}
+
+	for i, format := range formats {
+		hop := rt.Hops[i]
+		switch format {
+		case HopPayloadFormat_TLV_PAYLOAD:
+			hop.LegacyPayload = false
+
+		case HopPayloadFormat_LEGACY_PAYLOAD:
+			if len(hop.CustomRecords) > 0 || hop.MPP != nil ||
+				hop.AMP != nil {
+
+				return ErrLegacyHopRecords.New(
+					fmt.Sprintf("hop %d", i), nil,
+				)
+			}
+			hop.LegacyPayload = true
+
+		default:
+			return er.Errorf("unknown payload format %v for hop %d",
+				format, i)
+		}
+	}
+
+	return nil
+}
+
 // UnmarshalMPP accepts the mpp_total_amt_msat and mpp_payment_addr fields from
 // an RPC request and converts into an record.MPP object. An error is returned
 // if the payment address is not 0 or 32 bytes. If the total amount and payment
@@ -842,6 +1471,7 @@ func (r *RouterBackend) MarshalHTLCAttempt(
 	}
 
 	rpcAttempt := &lnrpc.HTLCAttempt{
+		AttemptId:     htlc.AttemptID,
 		AttemptTimeNs: MarshalTimeNano(htlc.AttemptTime),
 		Route:         route,
 	}
@@ -1140,6 +1770,46 @@ func (r *RouterBackend) MarshalPayment(payment *channeldb.MPPayment) (
 		Htlcs:           htlcs,
 		PaymentIndex:    payment.SequenceNum,
 		FailureReason:   failureReason,
+		TransitionSeq:   payment.TransitionSeq,
+	}, nil
+}
+
+// MarshalPaymentPlan marshals the plan of a payment to the rpc representation
+// of the payment. The planned shards are returned as htlcs and the value is
+// the amount they deliver. The status is unknown, since nothing was sent.
+func (r *RouterBackend) MarshalPaymentPlan(payment *routing.LightningPayment,
+	plan *routing.PaymentPlan) (*lnrpc.Payment, er.R) {
+
+	htlcs := make([]*lnrpc.HTLCAttempt, 0, len(plan.Routes))
+	for _, rt := range plan.Routes {
+		route, err := r.MarshalRoute(rt)
+		if err != nil {
+			return nil, err
+		}
+
+		htlcs = append(htlcs, &lnrpc.HTLCAttempt{
+			Route: route,
+		})
+	}
+
+	failureReason, err := marshalPaymentFailureReason(plan.FailureReason)
+	if err != nil {
+		return nil, err
+	}
+
+	satValue := int64(plan.Amount.ToSatoshis())
+	return &lnrpc.Payment{
+		PaymentHash:    hex.EncodeToString(payment.PaymentHash[:]),
+		Value:          satValue,
+		ValueMsat:      int64(plan.Amount),
+		ValueSat:       satValue,
+		Fee:            int64(plan.TotalFees.ToSatoshis()),
+		FeeSat:         int64(plan.TotalFees.ToSatoshis()),
+		FeeMsat:        int64(plan.TotalFees),
+		PaymentRequest: string(payment.PaymentRequest),
+		Status:         lnrpc.Payment_UNKNOWN,
+		Htlcs:          htlcs,
+		FailureReason:  failureReason,
 	}, nil
 }
 
@@ -1165,6 +1835,28 @@ func convertPaymentStatus(dbStatus channeldb.PaymentStatus) (
 	}
 }
 
+// unmarshalPaymentStatus converts an rpc payment status to the corresponding
+// database status.
+func unmarshalPaymentStatus(rpcStatus lnrpc.Payment_PaymentStatus) (
+	channeldb.PaymentStatus, er.R) {
+	switch rpcStatus {
+	case lnrpc.Payment_UNKNOWN:
+		return channeldb.StatusUnknown, nil
+
+	case lnrpc.Payment_IN_FLIGHT:
+		return channeldb.StatusInFlight, nil
+
+	case lnrpc.Payment_SUCCEEDED:
+		return channeldb.StatusSucceeded, nil
+
+	case lnrpc.Payment_FAILED:
+		return channeldb.StatusFailed, nil
+
+	default:
+		return 0, er.Errorf("unhandled payment status %v", rpcStatus)
+	}
+}
+
 // marshalPaymentFailureReason marshals the failure reason to the corresponding rpc
 // type.
 func marshalPaymentFailureReason(reason *channeldb.FailureReason) (
@@ -1189,6 +1881,12 @@ func marshalPaymentFailureReason(reason *channeldb.FailureReason) (
 
 	case channeldb.FailureReasonInsufficientBalance:
 		return lnrpc.PaymentFailureReason_FAILURE_REASON_INSUFFICIENT_BALANCE, nil
+
+	case channeldb.FailureReasonFeeCapExceeded:
+		return lnrpc.PaymentFailureReason_FAILURE_REASON_FEE_CAP_EXCEEDED, nil
+
+	case channeldb.FailureReasonAttemptLimit:
+		return lnrpc.PaymentFailureReason_FAILURE_REASON_ATTEMPT_LIMIT, nil
 	}
 
 	return 0, er.New("unknown failure reason")
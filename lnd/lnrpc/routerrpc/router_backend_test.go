@@ -3,16 +3,26 @@ package routerrpc
 import (
 	"bytes"
 	"context"
+	"encoding/binary"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
 	"testing"
+	"time"
 
+	"github.com/pkt-cash/pktd/btcec"
 	"github.com/pkt-cash/pktd/btcutil"
 	"github.com/pkt-cash/pktd/btcutil/er"
 	"github.com/pkt-cash/pktd/btcutil/util"
 	"github.com/pkt-cash/pktd/lnd/channeldb"
+	"github.com/pkt-cash/pktd/lnd/channeldb/kvdb"
 	"github.com/pkt-cash/pktd/lnd/lnwire"
 	"github.com/pkt-cash/pktd/lnd/record"
 	"github.com/pkt-cash/pktd/lnd/routing"
 	"github.com/pkt-cash/pktd/lnd/routing/route"
+	"github.com/pkt-cash/pktd/lnd/zpay32"
 
 	"github.com/pkt-cash/pktd/lnd/lnrpc"
 )
@@ -140,18 +150,18 @@ func testQueryRoutes(t *testing.T, useMissionControl, useMsat bool) {
 		}
 
 		if restrictions.ProbabilitySource(route.Vertex{2},
-			route.Vertex{1}, 0,
+			route.Vertex{1}, 0, 0,
 		) != 0 {
 			t.Fatal("expecting 0% probability for ignored edge")
 		}
 
 		if restrictions.ProbabilitySource(ignoreNodeVertex,
-			route.Vertex{6}, 0,
+			route.Vertex{6}, 0, 0,
 		) != 0 {
 			t.Fatal("expecting 0% probability for ignored node")
 		}
 
-		if restrictions.ProbabilitySource(node1, node2, 0) != 0 {
+		if restrictions.ProbabilitySource(node1, node2, 0, 0) != 0 {
 			t.Fatal("expecting 0% probability for ignored pair")
 		}
 
@@ -176,7 +186,7 @@ func testQueryRoutes(t *testing.T, useMissionControl, useMsat bool) {
 			expectedProb = testMissionControlProb
 		}
 		if restrictions.ProbabilitySource(route.Vertex{4},
-			route.Vertex{5}, 0,
+			route.Vertex{5}, 0, 0,
 		) != expectedProb {
 			t.Fatal("expecting 100% probability")
 		}
@@ -217,7 +227,7 @@ type mockMissionControl struct {
 }
 
 func (m *mockMissionControl) GetProbability(fromNode, toNode route.Vertex,
-	amt lnwire.MilliSatoshi) float64 {
+	amt lnwire.MilliSatoshi, capacity btcutil.Amount) float64 {
 	return testMissionControlProb
 }
 
@@ -234,6 +244,20 @@ func (m *mockMissionControl) GetPairHistorySnapshot(fromNode,
 	return routing.TimedPairResult{}
 }
 
+func (m *mockMissionControl) ImportHistory(
+	history *routing.MissionControlSnapshot) er.R {
+	return nil
+}
+
+func (m *mockMissionControl) GetConfig() *routing.MissionControlConfig {
+	return &routing.MissionControlConfig{}
+}
+
+func (m *mockMissionControl) SetConfig(
+	cfg *routing.MissionControlConfig) er.R {
+	return nil
+}
+
 type mppOutcome byte
 
 const (
@@ -353,3 +377,1374 @@ func testUnmarshalMPP(t *testing.T, test unmarshalMPPTest) {
 		t.Fatalf("test case has non-standard outcome")
 	}
 }
+
+// TestUnmarshalHintPreference asserts that route hint preferences given by
+// index or by pubkey are resolved to the expected ordered hint indexes.
+func TestUnmarshalHintPreference(t *testing.T) {
+	parseKey := func(hexKey string) *btcec.PublicKey {
+		keyBytes, err := util.DecodeHex(hexKey)
+		if err != nil {
+			t.Fatal(err)
+		}
+		pubKey, err := btcec.ParsePubKey(keyBytes, btcec.S256())
+		if err != nil {
+			t.Fatal(err)
+		}
+		return pubKey
+	}
+
+	hintNode := parseKey(hintNodeKey)
+	otherNode := parseKey(ignoreNodeKey)
+	unknownNode := parseKey(destKey)
+	routeHints := [][]zpay32.HopHint{
+		{{NodeID: otherNode, ChannelID: 1}},
+		{{NodeID: hintNode, ChannelID: 2}},
+		{{NodeID: otherNode, ChannelID: 3}},
+	}
+
+	tests := []struct {
+		name     string
+		req      *SendPaymentRequest
+		offset   int
+		expected []int
+		expErr   bool
+	}{
+		{
+			name: "no preference",
+			req:  &SendPaymentRequest{},
+		},
+		{
+			name: "strict without preference",
+			req: &SendPaymentRequest{
+				StrictHintPreference: true,
+			},
+			expErr: true,
+		},
+		{
+			name: "by index",
+			req: &SendPaymentRequest{
+				PreferredHintIndexes: []uint32{2, 0, 2},
+			},
+			expected: []int{2, 0},
+		},
+		{
+			name: "by index with offset",
+			req: &SendPaymentRequest{
+				PreferredHintIndexes: []uint32{1},
+			},
+			offset:   1,
+			expected: []int{2},
+		},
+		{
+			name: "index out of range",
+			req: &SendPaymentRequest{
+				PreferredHintIndexes: []uint32{3},
+			},
+			expErr: true,
+		},
+		{
+			name: "by pubkey",
+			req: &SendPaymentRequest{
+				PreferredHintPubkeys: [][]byte{
+					hintNode.SerializeCompressed(),
+					otherNode.SerializeCompressed(),
+				},
+			},
+			expected: []int{1, 0, 2},
+		},
+		{
+			name: "unknown pubkey",
+			req: &SendPaymentRequest{
+				PreferredHintPubkeys: [][]byte{
+					unknownNode.SerializeCompressed(),
+				},
+			},
+			expErr: true,
+		},
+		{
+			name: "index and pubkey",
+			req: &SendPaymentRequest{
+				PreferredHintIndexes: []uint32{0},
+				PreferredHintPubkeys: [][]byte{
+					hintNode.SerializeCompressed(),
+				},
+			},
+			expErr: true,
+		},
+		{
+			name: "last hop conflict",
+			req: &SendPaymentRequest{
+				PreferredHintIndexes: []uint32{0},
+				LastHopPubkey:        hintNode.SerializeCompressed(),
+			},
+			expErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			preferred, err := unmarshalHintPreference(
+				test.req, routeHints, test.offset,
+			)
+			if test.expErr {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(preferred, test.expected) {
+				t.Fatalf("expected preference %v, got %v",
+					test.expected, preferred)
+			}
+		})
+	}
+}
+
+// TestExtractMaxInflightHtlcs asserts that the in-flight htlc limit of a send
+// request is passed on to the payment and validated against the ceiling.
+func TestExtractMaxInflightHtlcs(t *testing.T) {
+	dest, err := util.DecodeHex(destKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	backend := &RouterBackend{
+		SelfNode:         sourceKey,
+		MaxTotalTimelock: 1000,
+	}
+
+	tests := []struct {
+		name   string
+		limit  uint32
+		expErr bool
+	}{
+		{name: "unlimited", limit: 0},
+		{name: "limited", limit: 5},
+		{name: "ceiling", limit: routing.MaxInflightHtlcs},
+		{
+			name:   "above ceiling",
+			limit:  routing.MaxInflightHtlcs + 1,
+			expErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			payment, err := backend.extractIntentFromSendRequest(
+				&SendPaymentRequest{
+					Dest:             dest,
+					Amt:              1000,
+					PaymentHash:      make([]byte, 32),
+					TimeoutSeconds:   60,
+					MaxInflightHtlcs: test.limit,
+				},
+			)
+			if test.expErr {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if payment.MaxInflightHtlcs != test.limit {
+				t.Fatalf("expected limit %v, got %v",
+					test.limit, payment.MaxInflightHtlcs)
+			}
+		})
+	}
+}
+
+// TestExtractPaymentLimits asserts that the attempt limit and the timeout of a
+// send request are passed on to the payment and that a negative timeout is
+// rejected.
+func TestExtractPaymentLimits(t *testing.T) {
+	dest, err := util.DecodeHex(destKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	backend := &RouterBackend{
+		SelfNode:         sourceKey,
+		MaxTotalTimelock: 1000,
+	}
+
+	tests := []struct {
+		name        string
+		maxAttempts uint32
+		timeout     int32
+		expErr      bool
+	}{
+		{name: "unlimited attempts", maxAttempts: 0, timeout: 60},
+		{name: "limited attempts", maxAttempts: 3, timeout: 60},
+		{name: "no timeout", maxAttempts: 3, timeout: 0, expErr: true},
+		{
+			name:        "negative timeout",
+			maxAttempts: 3,
+			timeout:     -1,
+			expErr:      true,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			payment, err := backend.extractIntentFromSendRequest(
+				&SendPaymentRequest{
+					Dest:           dest,
+					Amt:            1000,
+					PaymentHash:    make([]byte, 32),
+					TimeoutSeconds: test.timeout,
+					MaxAttempts:    test.maxAttempts,
+				},
+			)
+			if test.expErr {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if payment.MaxAttempts != test.maxAttempts {
+				t.Fatalf("expected attempt limit %v, got %v",
+					test.maxAttempts, payment.MaxAttempts)
+			}
+			expTimeout := time.Duration(test.timeout) * time.Second
+			if payment.PayAttemptTimeout != expTimeout {
+				t.Fatalf("expected timeout %v, got %v",
+					expTimeout, payment.PayAttemptTimeout)
+			}
+		})
+	}
+}
+
+// TestExtractFinalCltvBuffer asserts that the final cltv buffer of a send
+// request is added to the final cltv delta of the payment and that a buffer
+// which doesn't fit within the cltv limit is rejected.
+func TestExtractFinalCltvBuffer(t *testing.T) {
+	dest, err := util.DecodeHex(destKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	backend := &RouterBackend{
+		SelfNode:              sourceKey,
+		MaxTotalTimelock:      1000,
+		DefaultFinalCltvDelta: 40,
+	}
+
+	tests := []struct {
+		name           string
+		finalCltvDelta int32
+		buffer         uint32
+		cltvLimit      int32
+		expDelta       uint16
+		expErr         bool
+	}{
+		{name: "no buffer", expDelta: 40},
+		{name: "default delta", buffer: 20, expDelta: 60},
+		{
+			name:           "explicit delta",
+			finalCltvDelta: 100,
+			buffer:         50,
+			expDelta:       150,
+		},
+		{name: "max total timelock", buffer: 961, expErr: true},
+		{
+			name:      "cltv limit",
+			buffer:    100,
+			cltvLimit: 139,
+			expErr:    true,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			payment, err := backend.extractIntentFromSendRequest(
+				&SendPaymentRequest{
+					Dest:            dest,
+					Amt:             1000,
+					PaymentHash:     make([]byte, 32),
+					TimeoutSeconds:  60,
+					FinalCltvDelta:  test.finalCltvDelta,
+					FinalCltvBuffer: test.buffer,
+					CltvLimit:       test.cltvLimit,
+				},
+			)
+			if test.expErr {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if payment.FinalCLTVDelta != test.expDelta {
+				t.Fatalf("expected final cltv delta %v, got %v",
+					test.expDelta, payment.FinalCLTVDelta)
+			}
+		})
+	}
+}
+
+// TestExtractConflictingRouteHints asserts that route hints which repeat each
+// other are accepted, while route hints with contradictory policies for the
+// same channel are rejected.
+func TestExtractConflictingRouteHints(t *testing.T) {
+	dest, err := util.DecodeHex(destKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	backend := &RouterBackend{
+		SelfNode:         sourceKey,
+		MaxTotalTimelock: 1000,
+	}
+
+	newHint := func(feeBase uint32) *lnrpc.RouteHint {
+		return &lnrpc.RouteHint{
+			HopHints: []*lnrpc.HopHint{{
+				NodeId:          hintNodeKey,
+				ChanId:          1,
+				FeeBaseMsat:     feeBase,
+				CltvExpiryDelta: 40,
+			}},
+		}
+	}
+
+	tests := []struct {
+		name       string
+		routeHints []*lnrpc.RouteHint
+		expErr     bool
+	}{
+		{
+			name:       "duplicate hints",
+			routeHints: []*lnrpc.RouteHint{newHint(1000), newHint(1000)},
+		},
+		{
+			name:       "contradictory hints",
+			routeHints: []*lnrpc.RouteHint{newHint(1000), newHint(2000)},
+			expErr:     true,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			_, err := backend.extractIntentFromSendRequest(
+				&SendPaymentRequest{
+					Dest:           dest,
+					Amt:            1000,
+					PaymentHash:    make([]byte, 32),
+					TimeoutSeconds: 60,
+					RouteHints:     test.routeHints,
+				},
+			)
+			if test.expErr {
+				if !routing.ErrConflictingRouteHints.Is(err) {
+					t.Fatalf("expected conflicting route "+
+						"hints, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
+
+// TestExtractFeeLimitPercent asserts that a fee limit given as a percentage of
+// the payment amount is resolved and validated.
+func TestExtractFeeLimitPercent(t *testing.T) {
+	dest, err := util.DecodeHex(destKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	backend := &RouterBackend{
+		SelfNode:         sourceKey,
+		MaxTotalTimelock: 1000,
+	}
+
+	tests := []struct {
+		name        string
+		percent     float64
+		feeLimitSat int64
+		expFeeLimit lnwire.MilliSatoshi
+		expErr      bool
+	}{
+		{
+			name:        "absolute",
+			feeLimitSat: 5,
+			expFeeLimit: 5000,
+		},
+		{
+			name:        "percent",
+			percent:     2.5,
+			expFeeLimit: 50000,
+		},
+		{
+			name:        "whole amount",
+			percent:     100,
+			expFeeLimit: 2000000,
+		},
+		{
+			name:    "above range",
+			percent: 100.5,
+			expErr:  true,
+		},
+		{
+			name:    "negative",
+			percent: -1,
+			expErr:  true,
+		},
+		{
+			name:        "percent and absolute",
+			percent:     1,
+			feeLimitSat: 5,
+			expErr:      true,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			payment, err := backend.extractIntentFromSendRequest(
+				&SendPaymentRequest{
+					Dest:            dest,
+					Amt:             2000,
+					PaymentHash:     make([]byte, 32),
+					TimeoutSeconds:  60,
+					FeeLimitSat:     test.feeLimitSat,
+					FeeLimitPercent: test.percent,
+				},
+			)
+			if test.expErr {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if payment.FeeLimit != test.expFeeLimit {
+				t.Fatalf("expected fee limit %v, got %v",
+					test.expFeeLimit, payment.FeeLimit)
+			}
+		})
+	}
+}
+
+// TestExtractDestFeatures asserts that the destination features of a request
+// override the graph, and that unknown or conflicting feature bits are
+// rejected.
+func TestExtractDestFeatures(t *testing.T) {
+	dest, err := util.DecodeHex(destKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	backend := &RouterBackend{
+		SelfNode:         sourceKey,
+		MaxTotalTimelock: 1000,
+	}
+
+	tests := []struct {
+		name       string
+		features   []lnrpc.FeatureBit
+		expMPP     bool
+		expDefault bool
+		expErr     bool
+	}{
+		{
+			name:       "graph features",
+			expDefault: true,
+		},
+		{
+			name: "mpp override",
+			features: []lnrpc.FeatureBit{
+				lnrpc.FeatureBit_TLV_ONION_OPT,
+				lnrpc.FeatureBit_PAYMENT_ADDR_OPT,
+				lnrpc.FeatureBit_MPP_OPT,
+			},
+			expMPP: true,
+		},
+		{
+			name:     "unknown bit",
+			features: []lnrpc.FeatureBit{lnrpc.FeatureBit(101)},
+			expErr:   true,
+		},
+		{
+			name: "conflicting pair",
+			features: []lnrpc.FeatureBit{
+				lnrpc.FeatureBit_MPP_REQ,
+				lnrpc.FeatureBit_MPP_OPT,
+			},
+			expErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			payment, err := backend.extractIntentFromSendRequest(
+				&SendPaymentRequest{
+					Dest:           dest,
+					Amt:            2000,
+					PaymentHash:    make([]byte, 32),
+					TimeoutSeconds: 60,
+					DestFeatures:   test.features,
+				},
+			)
+			if test.expErr {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if test.expDefault {
+				if payment.DestFeatures != nil {
+					t.Fatalf("expected graph features, got %v",
+						payment.DestFeatures)
+				}
+				return
+			}
+			if payment.DestFeatures.HasFeature(lnwire.MPPOptional) !=
+				test.expMPP {
+
+				t.Fatalf("expected mpp %v, got %v", test.expMPP,
+					payment.DestFeatures)
+			}
+		})
+	}
+}
+
+// TestExtractPeerFeeLimit asserts that the configured fee limit of the last
+// hop is used for payments without a fee limit, whether the last hop is
+// pinned or implied by the route hints, and that a fee limit in the request
+// takes precedence.
+func TestExtractPeerFeeLimit(t *testing.T) {
+	dest, err := util.DecodeHex(destKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var (
+		expensivePeer = route.Vertex{10}
+		cheapPeer     = route.Vertex{11}
+		otherPeer     = route.Vertex{12}
+	)
+	hintedPeer, err := route.NewVertexFromStr(hintNodeKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	backend := &RouterBackend{
+		SelfNode:         sourceKey,
+		MaxTotalTimelock: 1000,
+		PeerFeeLimits: map[route.Vertex]lnwire.MilliSatoshi{
+			expensivePeer: 100000,
+			cheapPeer:     1000,
+			hintedPeer:    50000,
+		},
+	}
+
+	tests := []struct {
+		name         string
+		lastHop      *route.Vertex
+		hintNodes    []string
+		feeLimitSat  int64
+		feeLimitMsat int64
+		percent      float64
+		expFeeLimit  lnwire.MilliSatoshi
+	}{
+		{
+			// The last hop of an unpinned payment without route
+			// hints is only known once a route is found, so no
+			// default applies.
+			name:        "no last hop",
+			expFeeLimit: 0,
+		},
+		{
+			name:        "route hints through peer",
+			hintNodes:   []string{hintNodeKey, hintNodeKey},
+			expFeeLimit: 50000,
+		},
+		{
+			name:        "route hints through different peers",
+			hintNodes:   []string{hintNodeKey, ignoreNodeKey},
+			expFeeLimit: 0,
+		},
+		{
+			name:        "request fee limit over route hints",
+			hintNodes:   []string{hintNodeKey},
+			feeLimitSat: 5,
+			expFeeLimit: 5000,
+		},
+		{
+			name:        "expensive peer",
+			lastHop:     &expensivePeer,
+			expFeeLimit: 100000,
+		},
+		{
+			name:        "cheap peer",
+			lastHop:     &cheapPeer,
+			expFeeLimit: 1000,
+		},
+		{
+			name:        "peer without default",
+			lastHop:     &otherPeer,
+			expFeeLimit: 0,
+		},
+		{
+			name:        "request fee limit sat",
+			lastHop:     &expensivePeer,
+			feeLimitSat: 5,
+			expFeeLimit: 5000,
+		},
+		{
+			name:         "request fee limit msat",
+			lastHop:      &cheapPeer,
+			feeLimitMsat: 5000,
+			expFeeLimit:  5000,
+		},
+		{
+			name:        "request fee limit percent",
+			lastHop:     &expensivePeer,
+			percent:     1,
+			expFeeLimit: 20000,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			req := &SendPaymentRequest{
+				Dest:            dest,
+				Amt:             2000,
+				PaymentHash:     make([]byte, 32),
+				TimeoutSeconds:  60,
+				FeeLimitSat:     test.feeLimitSat,
+				FeeLimitMsat:    test.feeLimitMsat,
+				FeeLimitPercent: test.percent,
+			}
+			if test.lastHop != nil {
+				req.LastHopPubkey = test.lastHop[:]
+			}
+			for i, node := range test.hintNodes {
+				req.RouteHints = append(req.RouteHints,
+					&lnrpc.RouteHint{
+						HopHints: []*lnrpc.HopHint{{
+							NodeId:          node,
+							ChanId:          uint64(i + 1),
+							CltvExpiryDelta: 40,
+						}},
+					})
+			}
+
+			payment, err := backend.extractIntentFromSendRequest(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if payment.FeeLimit != test.expFeeLimit {
+				t.Fatalf("expected fee limit %v, got %v",
+					test.expFeeLimit, payment.FeeLimit)
+			}
+		})
+	}
+}
+
+// TestExtractIncomingChannel asserts that the incoming channel of a circular
+// payment is validated and pins the last hop, and that a circular payment
+// without a route over both channels is rejected.
+func TestExtractIncomingChannel(t *testing.T) {
+	dest, err := util.DecodeHex(destKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var (
+		findRouteErr er.R
+		circular     bool
+		restrictions *routing.RestrictParams
+	)
+	backend := &RouterBackend{
+		SelfNode:         sourceKey,
+		MaxTotalTimelock: 1000,
+		MissionControl:   &mockMissionControl{},
+		FetchChannelEndpoints: func(chanID uint64) (route.Vertex,
+			route.Vertex, er.R) {
+
+			switch chanID {
+			case 1:
+				return sourceKey, node1, nil
+			case 2:
+				return node2, sourceKey, nil
+			case 3:
+				return node1, node2, nil
+			}
+			return route.Vertex{}, route.Vertex{},
+				er.New("unknown channel")
+		},
+		FindRoute: func(source, target route.Vertex,
+			amt lnwire.MilliSatoshi,
+			r *routing.RestrictParams,
+			_ record.CustomSet,
+			_ map[route.Vertex][]*channeldb.ChannelEdgePolicy,
+			_ uint16) (*route.Route, er.R) {
+
+			circular = source == sourceKey && target == sourceKey
+			restrictions = r
+			return nil, findRouteErr
+		},
+	}
+
+	tests := []struct {
+		name        string
+		dest        []byte
+		outChanIDs  []uint64
+		inChanID    uint64
+		lastHop     []byte
+		expectedErr bool
+	}{
+		{
+			name:       "circular",
+			dest:       sourceKey[:],
+			outChanIDs: []uint64{1},
+			inChanID:   2,
+		},
+		{
+			name:        "not to self",
+			dest:        dest,
+			outChanIDs:  []uint64{1},
+			inChanID:    2,
+			expectedErr: true,
+		},
+		{
+			name:        "no outgoing channel",
+			dest:        sourceKey[:],
+			inChanID:    2,
+			expectedErr: true,
+		},
+		{
+			name:        "multiple outgoing channels",
+			dest:        sourceKey[:],
+			outChanIDs:  []uint64{1, 2},
+			inChanID:    2,
+			expectedErr: true,
+		},
+		{
+			name:        "same channel",
+			dest:        sourceKey[:],
+			outChanIDs:  []uint64{1},
+			inChanID:    1,
+			expectedErr: true,
+		},
+		{
+			name:        "last hop",
+			dest:        sourceKey[:],
+			outChanIDs:  []uint64{1},
+			inChanID:    2,
+			lastHop:     node2[:],
+			expectedErr: true,
+		},
+		{
+			name:        "not our channel",
+			dest:        sourceKey[:],
+			outChanIDs:  []uint64{1},
+			inChanID:    3,
+			expectedErr: true,
+		},
+		{
+			name:        "unknown channel",
+			dest:        sourceKey[:],
+			outChanIDs:  []uint64{1},
+			inChanID:    4,
+			expectedErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			req := &SendPaymentRequest{
+				Dest:             test.dest,
+				Amt:              2000,
+				PaymentHash:      make([]byte, 32),
+				TimeoutSeconds:   60,
+				AllowSelfPayment: true,
+				OutgoingChanIds:  test.outChanIDs,
+				IncomingChanId:   test.inChanID,
+				LastHopPubkey:    test.lastHop,
+			}
+
+			payment, err := backend.extractIntentFromSendRequest(req)
+			if test.expectedErr {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if *payment.IncomingChannelID != test.inChanID ||
+				*payment.LastHop != node2 {
+
+				t.Fatal("incoming channel not pinned")
+			}
+
+			findRouteErr = nil
+			if err := backend.checkCircularRoute(payment); err != nil {
+				t.Fatal(err)
+			}
+			if !circular {
+				t.Fatal("expected circular route")
+			}
+			if *restrictions.IncomingChannelID != test.inChanID ||
+				*restrictions.LastHop != node2 {
+
+				t.Fatal("incoming channel not restricted")
+			}
+
+			findRouteErr = routing.ErrNoRouteFound.Default()
+			err = backend.checkCircularRoute(payment)
+			if !ErrNoCircularRoute.Is(err) {
+				t.Fatalf("expected ErrNoCircularRoute, got %v",
+					err)
+			}
+		})
+	}
+}
+
+// newTestMissionControl creates a mission control instance backed by a
+// temporary database.
+func newTestMissionControl(t *testing.T) (*routing.MissionControl, func()) {
+	dbFile, errr := ioutil.TempFile("", "*.db")
+	if errr != nil {
+		t.Fatal(errr)
+	}
+
+	db, err := kvdb.Open(kvdb.BoltBackendName, dbFile.Name(), true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mc, err := routing.NewMissionControl(db, &routing.MissionControlConfig{
+		PenaltyHalfLife:       time.Hour,
+		AprioriHopProbability: 0.9,
+		AprioriWeight:         0.5,
+		SelfNode:              sourceKey,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return mc, func() {
+		db.Close()
+		os.Remove(dbFile.Name())
+	}
+}
+
+// sortedPairs returns the pairs of a snapshot in a deterministic order, with
+// timestamps truncated to the precision of the rpc serialization.
+func sortedPairs(
+	snapshot *routing.MissionControlSnapshot) []routing.MissionControlPairSnapshot {
+
+	truncate := func(t time.Time) time.Time {
+		if t.IsZero() {
+			return t
+		}
+		return time.Unix(t.Unix(), 0)
+	}
+
+	pairs := make([]routing.MissionControlPairSnapshot, len(snapshot.Pairs))
+	for i, pair := range snapshot.Pairs {
+		pair.FailTime = truncate(pair.FailTime)
+		pair.SuccessTime = truncate(pair.SuccessTime)
+		pairs[i] = pair
+	}
+
+	sort.Slice(pairs, func(i, j int) bool {
+		a, b := pairs[i].Pair, pairs[j].Pair
+		if a.From != b.From {
+			return bytes.Compare(a.From[:], b.From[:]) < 0
+		}
+		return bytes.Compare(a.To[:], b.To[:]) < 0
+	})
+
+	return pairs
+}
+
+// TestMissionControlFileRoundTrip asserts that mission control state exported
+// to a file is restored on import, and that files with an unknown version are
+// rejected.
+func TestMissionControlFileRoundTrip(t *testing.T) {
+	tempDir, errr := ioutil.TempDir("", "mcfile")
+	if errr != nil {
+		t.Fatal(errr)
+	}
+	defer os.RemoveAll(tempDir)
+
+	// Populate the source mission control with a success and a failure.
+	mc, cleanup := newTestMissionControl(t)
+	defer cleanup()
+
+	rt := &route.Route{
+		SourcePubKey: sourceKey,
+		TotalAmount:  1000,
+		Hops: []*route.Hop{
+			{
+				ChannelID:     1,
+				PubKeyBytes:   node1,
+				AmtToForward:  1000,
+				LegacyPayload: true,
+			},
+			{
+				ChannelID:     2,
+				PubKeyBytes:   node2,
+				AmtToForward:  1000,
+				LegacyPayload: true,
+			},
+		},
+	}
+	if err := mc.ReportPaymentSuccess(1, rt); err != nil {
+		t.Fatal(err)
+	}
+
+	failureSourceIdx := 1
+	_, err := mc.ReportPaymentFail(
+		2, rt, &failureSourceIdx, lnwire.NewTemporaryChannelFailure(nil),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := sortedPairs(mc.GetHistorySnapshot())
+	if len(expected) == 0 {
+		t.Fatal("expected populated mission control")
+	}
+
+	path := filepath.Join(tempDir, "mc.dat")
+	backend := &RouterBackend{MissionControl: mc}
+	if err := backend.ExportMissionControl(path); err != nil {
+		t.Fatal(err)
+	}
+
+	// Import the file into a fresh mission control instance and assert
+	// that the state matches.
+	importMc, importCleanup := newTestMissionControl(t)
+	defer importCleanup()
+
+	importBackend := &RouterBackend{MissionControl: importMc}
+	if err := importBackend.ImportMissionControl(path); err != nil {
+		t.Fatal(err)
+	}
+
+	imported := sortedPairs(importMc.GetHistorySnapshot())
+	if !reflect.DeepEqual(expected, imported) {
+		t.Fatalf("expected pairs %v, got %v", expected, imported)
+	}
+
+	// Bump the version of the file and assert that it is rejected without
+	// touching the state.
+	b, errr := ioutil.ReadFile(path)
+	if errr != nil {
+		t.Fatal(errr)
+	}
+	binary.BigEndian.PutUint32(b[:4], missionControlFileVersion+1)
+	if errr := ioutil.WriteFile(path, b, 0o600); errr != nil {
+		t.Fatal(errr)
+	}
+
+	emptyMc, emptyCleanup := newTestMissionControl(t)
+	defer emptyCleanup()
+
+	emptyBackend := &RouterBackend{MissionControl: emptyMc}
+	err = emptyBackend.ImportMissionControl(path)
+	if !ErrMissionControlVersion.Is(err) {
+		t.Fatalf("expected version error, got %v", err)
+	}
+	if len(emptyMc.GetHistorySnapshot().Pairs) != 0 {
+		t.Fatal("expected state to be untouched")
+	}
+}
+
+// TestUnmarshalHopPubkeys asserts that malformed BuildRoute hop pubkeys are
+// rejected with a typed error rather than causing a panic.
+func TestUnmarshalHopPubkeys(t *testing.T) {
+	dest, err := util.DecodeHex(destKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Flipping the parity prefix to an invalid value or using an x
+	// coordinate above the field prime makes the key non-canonical.
+	badPrefix := append([]byte{0x05}, dest[1:]...)
+	overflow := append([]byte{0x02}, bytes.Repeat([]byte{0xff}, 32)...)
+
+	tests := []struct {
+		name    string
+		hops    [][]byte
+		errCode *er.ErrorCode
+	}{
+		{
+			name: "valid",
+			hops: [][]byte{dest, dest},
+		},
+		{
+			name:    "nil hop",
+			hops:    [][]byte{dest, nil},
+			errCode: ErrNilHopPubkey,
+		},
+		{
+			name:    "short key",
+			hops:    [][]byte{dest[:32]},
+			errCode: ErrHopPubkeyLength,
+		},
+		{
+			name:    "uncompressed length",
+			hops:    [][]byte{make([]byte, 65)},
+			errCode: ErrHopPubkeyLength,
+		},
+		{
+			name:    "bad prefix",
+			hops:    [][]byte{badPrefix},
+			errCode: ErrHopPubkeyNotCanonical,
+		},
+		{
+			name:    "x above field prime",
+			hops:    [][]byte{overflow},
+			errCode: ErrHopPubkeyNotCanonical,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			hops, err := UnmarshalHopPubkeys(test.hops)
+			if test.errCode != nil {
+				if !test.errCode.Is(err) {
+					t.Fatalf("expected %v, got %v",
+						test.errCode.Default(), err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			for i, hop := range hops {
+				if !bytes.Equal(hop[:], test.hops[i]) {
+					t.Fatalf("hop %d: expected %x, got %x",
+						i, test.hops[i], hop[:])
+				}
+			}
+		})
+	}
+}
+
+// TestApplyHopPayloadFormats asserts that requested hop payload formats
+// override the ones picked by the router, and that the legacy format is
+// rejected for hops carrying records it can't encode.
+func TestApplyHopPayloadFormats(t *testing.T) {
+	newRoute := func() *route.Route {
+		return &route.Route{
+			Hops: []*route.Hop{
+				{PubKeyBytes: node1, LegacyPayload: true},
+				{PubKeyBytes: node2},
+			},
+		}
+	}
+
+	// Without formats the route is left as is.
+	rt := newRoute()
+	if err := applyHopPayloadFormats(rt, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !rt.Hops[0].LegacyPayload || rt.Hops[1].LegacyPayload {
+		t.Fatalf("expected payload formats to be unchanged")
+	}
+
+	rt = newRoute()
+	err := applyHopPayloadFormats(rt, []HopPayloadFormat{
+		HopPayloadFormat_TLV_PAYLOAD,
+		HopPayloadFormat_LEGACY_PAYLOAD,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if rt.Hops[0].LegacyPayload || !rt.Hops[1].LegacyPayload {
+		t.Fatalf("expected payload formats to be overridden")
+	}
+
+	// The number of formats must match the number of hops.
+	err = applyHopPayloadFormats(newRoute(), []HopPayloadFormat{
+		HopPayloadFormat_TLV_PAYLOAD,
+	})
+	if err == nil {
+		t.Fatalf("expected error for format count mismatch")
+	}
+
+	// Unknown formats are rejected.
+	err = applyHopPayloadFormats(newRoute(), []HopPayloadFormat{
+		HopPayloadFormat_TLV_PAYLOAD, HopPayloadFormat(5),
+	})
+	if err == nil {
+		t.Fatalf("expected error for unknown format")
+	}
+
+	// Legacy payloads can't carry custom records or an MPP record.
+	legacy := []HopPayloadFormat{
+		HopPayloadFormat_LEGACY_PAYLOAD,
+		HopPayloadFormat_LEGACY_PAYLOAD,
+	}
+	rt = newRoute()
+	rt.Hops[1].CustomRecords = record.CustomSet{65536: []byte{1}}
+	err = applyHopPayloadFormats(rt, legacy)
+	if !ErrLegacyHopRecords.Is(err) {
+		t.Fatalf("expected ErrLegacyHopRecords, got %v", err)
+	}
+
+	rt = newRoute()
+	rt.Hops[1].MPP = record.NewMPP(1000, [32]byte{1})
+	err = applyHopPayloadFormats(rt, legacy)
+	if !ErrLegacyHopRecords.Is(err) {
+		t.Fatalf("expected ErrLegacyHopRecords, got %v", err)
+	}
+}
+
+// TestMarshalPaymentAttempts asserts that a marshaled payment carries the full
+// history of its htlc attempts, including the failure details of every failed
+// attempt.
+func TestMarshalPaymentAttempts(t *testing.T) {
+	backend := &RouterBackend{
+		FetchChannelCapacity: func(chanID uint64) (btcutil.Amount,
+			er.R) {
+
+			return 1000000, nil
+		},
+	}
+
+	rt := route.Route{
+		TotalAmount:  10000,
+		SourcePubKey: sourceKey,
+		Hops: []*route.Hop{{
+			PubKeyBytes:  node1,
+			ChannelID:    12345,
+			AmtToForward: 10000,
+		}},
+	}
+	attemptTime := time.Unix(1000, 0)
+	resolveTime := time.Unix(2000, 0)
+	newAttempt := func(id uint64) channeldb.HTLCAttempt {
+		return channeldb.HTLCAttempt{
+			HTLCAttemptInfo: channeldb.HTLCAttemptInfo{
+				AttemptID:   id,
+				Route:       rt,
+				AttemptTime: attemptTime,
+			},
+		}
+	}
+
+	unreadable := newAttempt(3)
+	unreadable.Failure = &channeldb.HTLCFailInfo{
+		FailTime:           resolveTime,
+		Reason:             channeldb.HTLCFailUnreadable,
+		FailureSourceIndex: 1,
+	}
+
+	tempFailure := newAttempt(5)
+	tempFailure.Failure = &channeldb.HTLCFailInfo{
+		FailTime:           resolveTime,
+		Reason:             channeldb.HTLCFailMessage,
+		Message:            &lnwire.FailTemporaryNodeFailure{},
+		FailureSourceIndex: 2,
+	}
+
+	settled := newAttempt(8)
+	settled.Settle = &channeldb.HTLCSettleInfo{
+		Preimage:   [32]byte{1},
+		SettleTime: resolveTime,
+	}
+
+	payment := &channeldb.MPPayment{
+		Info: &channeldb.PaymentCreationInfo{
+			Value:        10000,
+			CreationTime: attemptTime,
+		},
+		HTLCs:  []channeldb.HTLCAttempt{unreadable, tempFailure, settled},
+		Status: channeldb.StatusSucceeded,
+	}
+
+	rpcPayment, err := backend.MarshalPayment(payment)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []struct {
+		id          uint64
+		status      lnrpc.HTLCAttempt_HTLCStatus
+		code        lnrpc.Failure_FailureCode
+		sourceIndex uint32
+	}{
+		{
+			id:          3,
+			status:      lnrpc.HTLCAttempt_FAILED,
+			code:        lnrpc.Failure_UNREADABLE_FAILURE,
+			sourceIndex: 1,
+		},
+		{
+			id:          5,
+			status:      lnrpc.HTLCAttempt_FAILED,
+			code:        lnrpc.Failure_TEMPORARY_NODE_FAILURE,
+			sourceIndex: 2,
+		},
+		{
+			id:     8,
+			status: lnrpc.HTLCAttempt_SUCCEEDED,
+		},
+	}
+
+	if len(rpcPayment.Htlcs) != len(expected) {
+		t.Fatalf("expected %d attempts, got %d", len(expected),
+			len(rpcPayment.Htlcs))
+	}
+	for i, exp := range expected {
+		htlc := rpcPayment.Htlcs[i]
+		if htlc.AttemptId != exp.id {
+			t.Fatalf("attempt %d: expected id %d, got %d", i,
+				exp.id, htlc.AttemptId)
+		}
+		if htlc.Status != exp.status {
+			t.Fatalf("attempt %d: expected status %v, got %v", i,
+				exp.status, htlc.Status)
+		}
+		if htlc.AttemptTimeNs != attemptTime.UnixNano() ||
+			htlc.ResolveTimeNs != resolveTime.UnixNano() {
+
+			t.Fatalf("attempt %d: unexpected timestamps", i)
+		}
+		if len(htlc.Route.Hops) != 1 {
+			t.Fatalf("attempt %d: expected route", i)
+		}
+
+		if exp.status != lnrpc.HTLCAttempt_FAILED {
+			if htlc.Failure != nil {
+				t.Fatalf("attempt %d: unexpected failure", i)
+			}
+			continue
+		}
+		if htlc.Failure == nil {
+			t.Fatalf("attempt %d: expected failure", i)
+		}
+		if htlc.Failure.Code != exp.code {
+			t.Fatalf("attempt %d: expected failure code %v, got %v",
+				i, exp.code, htlc.Failure.Code)
+		}
+		if htlc.Failure.FailureSourceIndex != exp.sourceIndex {
+			t.Fatalf("attempt %d: expected failure source index "+
+				"%d, got %d", i, exp.sourceIndex,
+				htlc.Failure.FailureSourceIndex)
+		}
+	}
+}
+
+// TestMarshalPaymentPlan asserts that a payment plan is marshaled with its
+// shards as htlcs, its total fee and the failure reason of an incomplete plan.
+func TestMarshalPaymentPlan(t *testing.T) {
+	backend := &RouterBackend{
+		FetchChannelCapacity: func(chanID uint64) (btcutil.Amount,
+			er.R) {
+
+			return 1000000, nil
+		},
+	}
+
+	newRoute := func(chanID uint64, timeLock uint32) *route.Route {
+		return &route.Route{
+			TotalAmount:   5000000 + 1000,
+			TotalTimeLock: timeLock,
+			SourcePubKey:  sourceKey,
+			Hops: []*route.Hop{
+				{
+					PubKeyBytes:  node1,
+					ChannelID:    chanID,
+					AmtToForward: 5000000,
+				},
+				{
+					PubKeyBytes:  node2,
+					ChannelID:    chanID + 1,
+					AmtToForward: 5000000,
+				},
+			},
+		}
+	}
+
+	reason := channeldb.FailureReasonNoRoute
+	plan := &routing.PaymentPlan{
+		Routes:        []*route.Route{newRoute(10, 120), newRoute(20, 140)},
+		Amount:        10000000,
+		TotalFees:     2000,
+		TotalTimeLock: 140,
+		FailureReason: &reason,
+	}
+	payment := &routing.LightningPayment{
+		PaymentHash: [32]byte{1},
+		Amount:      20000000,
+	}
+
+	rpcPayment, err := backend.MarshalPaymentPlan(payment, plan)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if rpcPayment.Status != lnrpc.Payment_UNKNOWN {
+		t.Fatalf("expected status unknown, got %v", rpcPayment.Status)
+	}
+	if rpcPayment.ValueMsat != 10000000 || rpcPayment.ValueSat != 10000 {
+		t.Fatalf("unexpected value %v msat", rpcPayment.ValueMsat)
+	}
+	if rpcPayment.FeeMsat != 2000 || rpcPayment.FeeSat != 2 {
+		t.Fatalf("unexpected fee %v msat", rpcPayment.FeeMsat)
+	}
+	if rpcPayment.FailureReason !=
+		lnrpc.PaymentFailureReason_FAILURE_REASON_NO_ROUTE {
+
+		t.Fatalf("unexpected failure reason %v",
+			rpcPayment.FailureReason)
+	}
+	if len(rpcPayment.Htlcs) != 2 {
+		t.Fatalf("expected 2 htlcs, got %d", len(rpcPayment.Htlcs))
+	}
+	for i, timeLock := range []uint32{120, 140} {
+		rt := rpcPayment.Htlcs[i].Route
+		if rt.TotalTimeLock != timeLock || len(rt.Hops) != 2 ||
+			rt.TotalFeesMsat != 1000 {
+
+			t.Fatalf("htlc %d: unexpected route %v", i, rt)
+		}
+	}
+}
@@ -2,10 +2,13 @@ package routerrpc
 
 import (
 	"context"
+	"crypto/rand"
 	"io/ioutil"
+	"math"
 	"os"
 	"path/filepath"
 	"sync/atomic"
+	"time"
 
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/pkt-cash/pktd/btcutil"
@@ -73,6 +76,18 @@ var (
 			Entity: "offchain",
 			Action: "read",
 		}},
+		"/routerrpc.Router/GetPaymentResult": {{
+			Entity: "offchain",
+			Action: "read",
+		}},
+		"/routerrpc.Router/ListPayments": {{
+			Entity: "offchain",
+			Action: "read",
+		}},
+		"/routerrpc.Router/GetMacaroon": {{
+			Entity: "macaroon",
+			Action: "generate",
+		}},
 		"/routerrpc.Router/EstimateRouteFee": {{
 			Entity: "offchain",
 			Action: "read",
@@ -89,6 +104,22 @@ var (
 			Entity: "offchain",
 			Action: "write",
 		}},
+		"/routerrpc.Router/GetMissionControlConfig": {{
+			Entity: "offchain",
+			Action: "read",
+		}},
+		"/routerrpc.Router/SetMissionControlConfig": {{
+			Entity: "offchain",
+			Action: "write",
+		}},
+		"/routerrpc.Router/ExportPaymentProof": {{
+			Entity: "offchain",
+			Action: "read",
+		}},
+		"/routerrpc.Router/VerifyPaymentProof": {{
+			Entity: "offchain",
+			Action: "read",
+		}},
 		"/routerrpc.Router/BuildRoute": {{
 			Entity: "offchain",
 			Action: "read",
@@ -97,6 +128,34 @@ var (
 			Entity: "offchain",
 			Action: "read",
 		}},
+		"/routerrpc.Router/SubscribeChannelGraph": {{
+			Entity: "info",
+			Action: "read",
+		}},
+		"/routerrpc.Router/AddExcludedNode": {{
+			Entity: "offchain",
+			Action: "write",
+		}},
+		"/routerrpc.Router/RemoveExcludedNode": {{
+			Entity: "offchain",
+			Action: "write",
+		}},
+		"/routerrpc.Router/ListExcludedNodes": {{
+			Entity: "offchain",
+			Action: "read",
+		}},
+		"/routerrpc.Router/QueryRouteProbability": {{
+			Entity: "offchain",
+			Action: "read",
+		}},
+		"/routerrpc.Router/GetNodeMetrics": {{
+			Entity: "info",
+			Action: "read",
+		}},
+		"/routerrpc.Router/AbandonPayment": {{
+			Entity: "offchain",
+			Action: "write",
+		}},
 		"/routerrpc.Router/SendPayment": {{
 			Entity: "offchain",
 			Action: "write",
@@ -123,9 +182,18 @@ type Server struct {
 	started                  int32 // To be used atomically.
 	shutdown                 int32 // To be used atomically.
 	forwardInterceptorActive int32 // To be used atomically.
+	activeStreams            int32 // To be used atomically.
 
 	cfg *Config
 
+	// macaroon is the serialized router macaroon, only set if it was
+	// baked without being written to disk.
+	macaroon []byte
+
+	// nodeMetrics caches the node metrics served by GetNodeMetrics, nil
+	// if there is no channel graph to compute them over.
+	nodeMetrics *nodeMetrics
+
 	quit chan struct{}
 }
 
@@ -138,6 +206,9 @@ var _ RouterServer = (*Server)(nil)
 // we're unable to create it, then an error will be returned. We also return
 // the set of permissions that we require as a server. At the time of writing
 // of this documentation, this is the same macaroon as as the admin macaroon.
+// If NoMacaroonFile is set, the macaroon is never written to disk and can be
+// retrieved with the Macaroon method of the returned server, or by clients
+// through the GetMacaroon RPC, instead.
 func New(cfg *Config) (*Server, lnrpc.MacaroonPerms, er.R) {
 	// If the path of the router macaroon wasn't generated, then we'll
 	// assume that it's found at the default network directory.
@@ -147,43 +218,101 @@ func New(cfg *Config) (*Server, lnrpc.MacaroonPerms, er.R) {
 		)
 	}
 
+	routerServer := &Server{
+		cfg:  cfg,
+		quit: make(chan struct{}),
+	}
+
+	if cfg.RouterBackend != nil && cfg.RouterBackend.ChannelGraph != nil {
+		interval := cfg.NodeMetricsInterval
+		if interval <= 0 {
+			interval = DefaultNodeMetricsInterval
+		}
+		routerServer.nodeMetrics = newNodeMetrics(
+			cfg.RouterBackend.ChannelGraph, interval,
+		)
+	}
+
 	// Now that we know the full path of the router macaroon, we can check
 	// to see if we need to create it or not. If stateless_init is set
 	// then we don't write the macaroons.
 	macFilePath := cfg.RouterMacPath
-	if cfg.MacService != nil && !cfg.MacService.StatelessInit &&
-		!lnrpc.FileExists(macFilePath) {
+	switch {
+	// Without a macaroon service, or in stateless init mode, there is no
+	// macaroon to bake.
+	case cfg.MacService == nil || cfg.MacService.StatelessInit:
+
+	// If the macaroon must not touch the disk, we bake it on every start
+	// and hand it to the caller instead.
+	case cfg.NoMacaroonFile:
+		log.Infof("Making macaroons for Router RPC Server without " +
+			"writing them to disk")
+
+		routerMacBytes, err := bakeMacaroon(cfg.MacService)
+		if err != nil {
+			return nil, nil, err
+		}
+		routerServer.macaroon = routerMacBytes
 
+	case !lnrpc.FileExists(macFilePath):
 		log.Infof("Making macaroons for Router RPC Server at: %v",
 			macFilePath)
 
 		// At this point, we know that the router macaroon doesn't yet,
 		// exist, so we need to create it with the help of the main
 		// macaroon service.
-		routerMac, err := cfg.MacService.NewMacaroon(
-			context.Background(), macaroons.DefaultRootKeyID,
-			macaroonOps...,
-		)
+		routerMacBytes, err := bakeMacaroon(cfg.MacService)
 		if err != nil {
 			return nil, nil, err
 		}
-		routerMacBytes, errr := routerMac.M().MarshalBinary()
-		if errr != nil {
-			return nil, nil, er.E(errr)
-		}
-		errr = ioutil.WriteFile(macFilePath, routerMacBytes, 0o644)
+		errr := ioutil.WriteFile(macFilePath, routerMacBytes, 0o644)
 		if errr != nil {
 			_ = os.Remove(macFilePath)
 			return nil, nil, er.E(errr)
 		}
 	}
 
-	routerServer := &Server{
-		cfg:  cfg,
-		quit: make(chan struct{}),
+	return routerServer, macPermissions, nil
+}
+
+// bakeMacaroon creates a new router macaroon with the help of the main macaroon
+// service and returns it serialized.
+func bakeMacaroon(macService *macaroons.Service) ([]byte, er.R) {
+	routerMac, err := macService.NewMacaroon(
+		context.Background(), macaroons.DefaultRootKeyID,
+		macaroonOps...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	routerMacBytes, errr := routerMac.M().MarshalBinary()
+	if errr != nil {
+		return nil, er.E(errr)
 	}
+	return routerMacBytes, nil
+}
 
-	return routerServer, macPermissions, nil
+// Macaroon returns the serialized router macaroon if it was baked without
+// being written to disk because NoMacaroonFile is set, and nil otherwise.
+func (s *Server) Macaroon() []byte {
+	return s.macaroon
+}
+
+// GetMacaroon returns the router macaroon if it is only kept in memory because
+// NoMacaroonFile is set. Like BakeMacaroon, it requires the permission to
+// generate macaroons.
+func (s *Server) GetMacaroon(ctx context.Context,
+	req *GetMacaroonRequest) (*GetMacaroonResponse, error) {
+
+	macBytes := s.Macaroon()
+	if macBytes == nil {
+		return nil, status.Error(codes.NotFound, "router macaroon is "+
+			"not kept in memory, it is found at "+s.cfg.RouterMacPath)
+	}
+
+	return &GetMacaroonResponse{
+		Macaroon: macBytes,
+	}, nil
 }
 
 // Start launches any helper goroutines required for the rpcServer to function.
@@ -194,6 +323,10 @@ func (s *Server) Start() er.R {
 		return nil
 	}
 
+	if s.nodeMetrics != nil {
+		s.nodeMetrics.start()
+	}
+
 	return nil
 }
 
@@ -205,6 +338,10 @@ func (s *Server) Stop() er.R {
 		return nil
 	}
 
+	if s.nodeMetrics != nil {
+		s.nodeMetrics.stop()
+	}
+
 	close(s.quit)
 	return nil
 }
@@ -248,6 +385,7 @@ func (s *Server) RegisterWithRestServer(ctx context.Context,
 			"with root REST server: %v", errr)
 		return er.E(errr)
 	}
+	s.RegisterHealthHandler(mux)
 
 	log.Debugf("Router REST server successfully registered with " +
 		"root REST server")
@@ -261,11 +399,31 @@ func (s *Server) RegisterWithRestServer(ctx context.Context,
 // pre-image, along with the final route will be returned.
 func (s *Server) SendPaymentV2(req *SendPaymentRequest,
 	stream Router_SendPaymentV2Server) error {
+	defer s.streamStarted()()
+
 	payment, err := s.cfg.RouterBackend.extractIntentFromSendRequest(req)
 	if err != nil {
 		return er.Native(err)
 	}
 
+	// Fail a circular payment right away if no route over both of its
+	// pinned channels exists.
+	if payment.IncomingChannelID != nil {
+		err := s.cfg.RouterBackend.checkCircularRoute(payment)
+		if ErrNoCircularRoute.Is(err) {
+			return status.Error(codes.NotFound, err.String())
+		}
+		if err != nil {
+			return er.Native(err)
+		}
+	}
+
+	// Only return the shards the payment would be split into if it is
+	// just planned.
+	if req.PlanOnly {
+		return s.planPayment(payment, stream)
+	}
+
 	err = s.cfg.Router.SendPaymentAsync(payment)
 	if err != nil {
 		// Transform user errors to grpc code.
@@ -283,19 +441,82 @@ func (s *Server) SendPaymentV2(req *SendPaymentRequest,
 		log.Errorf("SendPayment async error for hash %x: %v",
 			payment.PaymentHash, err)
 
+		return toRPCError(err)
+	}
+
+	return s.trackPayment(
+		payment.PaymentHash, stream, req.NoInflightUpdates, 0,
+	)
+}
+
+// planPayment sends the plan of the payment as a single update, without sending
+// the payment itself.
+func (s *Server) planPayment(payment *routing.LightningPayment,
+	stream Router_SendPaymentV2Server) error {
+
+	plan, err := s.cfg.Router.PlanPayment(payment)
+	if err != nil {
+		return toRPCError(err)
+	}
+
+	log.Debugf("Planned payment %x in %v shards, complete=%v",
+		payment.PaymentHash, len(plan.Routes), plan.Complete())
+
+	rpcPayment, err := s.cfg.RouterBackend.MarshalPaymentPlan(payment, plan)
+	if err != nil {
 		return er.Native(err)
 	}
 
-	return s.trackPayment(payment.PaymentHash, stream, req.NoInflightUpdates)
+	return stream.Send(rpcPayment)
+}
+
+// toRPCError converts a routing error into a gRPC error. Errors which clients
+// need to be able to tell apart from transient failures are given a distinct
+// status code, everything else is passed through as is.
+func toRPCError(err er.R) error {
+	if routing.ErrNoRouteFound.Is(err) {
+		return status.Error(codes.NotFound, err.String())
+	}
+	return er.Native(err)
+}
+
+// paymentLimitError maps a payment that failed because it reached its timeout
+// or its maximum number of attempts onto a distinct gRPC status code, so that
+// clients can tell these outcomes apart from other failures. Nil is returned
+// for all other payments.
+func paymentLimitError(payment *channeldb.MPPayment) error {
+	if payment.Status != channeldb.StatusFailed ||
+		payment.FailureReason == nil {
+
+		return nil
+	}
+
+	switch *payment.FailureReason {
+	case channeldb.FailureReasonTimeout:
+		return status.Error(codes.DeadlineExceeded,
+			"payment timeout reached")
+
+	case channeldb.FailureReasonAttemptLimit:
+		return status.Error(codes.ResourceExhausted,
+			"payment attempt limit reached")
+	}
+
+	return nil
 }
 
 // EstimateRouteFee allows callers to obtain a lower bound w.r.t how much it
-// may cost to send an HTLC to the target end destination.
+// may cost to send an HTLC to the target end destination. The time preference
+// of the request trades the fee of the route off against its success
+// probability.
 func (s *Server) EstimateRouteFee(ctx context.Context,
 	req *RouteFeeRequest) (*RouteFeeResponse, error) {
 	if len(req.Dest) != 33 {
 		return nil, er.Native(er.New("invalid length destination key"))
 	}
+	if math.IsNaN(req.TimePref) || req.TimePref < -1 || req.TimePref > 1 {
+		return nil, status.Error(codes.InvalidArgument,
+			"time preference must be in the range [-1, 1]")
+	}
 	var destNode route.Vertex
 	copy(destNode[:], req.Dest)
 
@@ -319,10 +540,11 @@ func (s *Server) EstimateRouteFee(ctx context.Context,
 			FeeLimit:          feeLimit,
 			CltvLimit:         s.cfg.RouterBackend.MaxTotalTimelock,
 			ProbabilitySource: mc.GetProbability,
+			TimePref:          req.TimePref,
 		}, nil, nil, s.cfg.RouterBackend.DefaultFinalCltvDelta,
 	)
 	if err != nil {
-		return nil, er.Native(err)
+		return nil, toRPCError(err)
 	}
 
 	return &RouteFeeResponse{
@@ -394,25 +616,125 @@ func (s *Server) QueryMissionControl(ctx context.Context,
 	req *QueryMissionControlRequest) (*QueryMissionControlResponse, error) {
 	snapshot := s.cfg.RouterBackend.MissionControl.GetHistorySnapshot()
 
-	rpcPairs := make([]*PairHistory, 0, len(snapshot.Pairs))
-	for _, p := range snapshot.Pairs {
-		// Prevent binding to loop variable.
-		pair := p
+	return marshalMissionControl(snapshot), nil
+}
 
-		rpcPair := PairHistory{
-			NodeFrom: pair.Pair.From[:],
-			NodeTo:   pair.Pair.To[:],
-			History:  toRPCPairData(&pair.TimedPairResult),
-		}
+// GetMissionControlConfig returns the parameters that mission control
+// currently uses for probability estimation.
+func (s *Server) GetMissionControlConfig(ctx context.Context,
+	req *GetMissionControlConfigRequest) (*GetMissionControlConfigResponse,
+	error) {
+
+	cfg := s.cfg.RouterBackend.MissionControl.GetConfig()
 
-		rpcPairs = append(rpcPairs, &rpcPair)
+	estimator := cfg.Estimator
+	if estimator == "" {
+		estimator = routing.AprioriEstimatorName
 	}
 
-	response := QueryMissionControlResponse{
-		Pairs: rpcPairs,
+	return &GetMissionControlConfigResponse{
+		Config: &MissionControlConfig{
+			HalfLifeSeconds:   uint64(cfg.PenaltyHalfLife.Seconds()),
+			HopProbability:    cfg.AprioriHopProbability,
+			Weight:            cfg.AprioriWeight,
+			Estimator:         estimator,
+			BimodalScaleMsat:  uint64(cfg.BimodalScale),
+			BimodalNodeWeight: cfg.BimodalNodeWeight,
+			BimodalDecayTimeSeconds: uint64(
+				cfg.BimodalDecayTime.Seconds(),
+			),
+		},
+	}, nil
+}
+
+// SetMissionControlConfig updates the parameters that mission control uses for
+// probability estimation, including the estimator itself. The new values apply
+// to the next probability query, the recorded history is kept.
+func (s *Server) SetMissionControlConfig(ctx context.Context,
+	req *SetMissionControlConfigRequest) (*SetMissionControlConfigResponse,
+	error) {
+
+	if req.Config == nil {
+		return nil, status.Error(codes.InvalidArgument,
+			"config is required")
 	}
 
-	return &response, nil
+	mc := s.cfg.RouterBackend.MissionControl
+	cfg := mc.GetConfig()
+	cfg.PenaltyHalfLife = time.Duration(req.Config.HalfLifeSeconds) *
+		time.Second
+	cfg.AprioriHopProbability = req.Config.HopProbability
+	cfg.AprioriWeight = req.Config.Weight
+	cfg.Estimator = req.Config.Estimator
+	cfg.BimodalScale = lnwire.MilliSatoshi(req.Config.BimodalScaleMsat)
+	cfg.BimodalNodeWeight = req.Config.BimodalNodeWeight
+	cfg.BimodalDecayTime = time.Duration(
+		req.Config.BimodalDecayTimeSeconds,
+	) * time.Second
+
+	err := mc.SetConfig(cfg)
+	if routing.ErrInvalidMcConfig.Is(err) {
+		return nil, status.Error(codes.InvalidArgument, err.String())
+	}
+	if err != nil {
+		return nil, er.Native(err)
+	}
+
+	return &SetMissionControlConfigResponse{}, nil
+}
+
+// AddExcludedNode adds a node to the persistent set of nodes that payments are
+// never routed through.
+func (s *Server) AddExcludedNode(ctx context.Context,
+	req *AddExcludedNodeRequest) (*AddExcludedNodeResponse, error) {
+
+	nodes, err := UnmarshalHopPubkeys([][]byte{req.Node})
+	if err != nil {
+		return nil, er.Native(err)
+	}
+
+	err = s.cfg.RouterBackend.ExcludedNodes.Add(nodes[0])
+	if err != nil {
+		return nil, er.Native(err)
+	}
+
+	return &AddExcludedNodeResponse{}, nil
+}
+
+// RemoveExcludedNode removes a node from the persistent set of excluded nodes.
+func (s *Server) RemoveExcludedNode(ctx context.Context,
+	req *RemoveExcludedNodeRequest) (*RemoveExcludedNodeResponse, error) {
+
+	nodes, err := UnmarshalHopPubkeys([][]byte{req.Node})
+	if err != nil {
+		return nil, er.Native(err)
+	}
+
+	err = s.cfg.RouterBackend.ExcludedNodes.Remove(nodes[0])
+	if routing.ErrNodeNotExcluded.Is(err) {
+		return nil, status.Error(codes.NotFound, err.String())
+	}
+	if err != nil {
+		return nil, er.Native(err)
+	}
+
+	return &RemoveExcludedNodeResponse{}, nil
+}
+
+// ListExcludedNodes returns the persistent set of excluded nodes.
+func (s *Server) ListExcludedNodes(ctx context.Context,
+	req *ListExcludedNodesRequest) (*ListExcludedNodesResponse, error) {
+
+	excluded := s.cfg.RouterBackend.ExcludedNodes.List()
+
+	nodes := make([][]byte, len(excluded))
+	for i := range excluded {
+		nodes[i] = excluded[i][:]
+	}
+
+	return &ListExcludedNodesResponse{
+		Nodes: nodes,
+	}, nil
 }
 
 // toRPCPairData marshals mission control pair data to the rpc struct.
@@ -451,8 +773,15 @@ func (s *Server) QueryProbability(ctx context.Context,
 
 	amt := lnwire.MilliSatoshi(req.AmtMsat)
 
+	capacity, err := s.cfg.RouterBackend.FetchPairCapacity(
+		fromNode, toNode,
+	)
+	if err != nil {
+		return nil, er.Native(err)
+	}
+
 	mc := s.cfg.RouterBackend.MissionControl
-	prob := mc.GetProbability(fromNode, toNode, amt)
+	prob := mc.GetProbability(fromNode, toNode, amt, capacity)
 	history := mc.GetPairHistorySnapshot(fromNode, toNode)
 
 	return &QueryProbabilityResponse{
@@ -461,28 +790,158 @@ func (s *Server) QueryProbability(ctx context.Context,
 	}, nil
 }
 
+// QueryRouteProbability returns the current success probability estimate for
+// a route starting at our own node, along with the estimates of its hops.
+func (s *Server) QueryRouteProbability(ctx context.Context,
+	req *QueryRouteProbabilityRequest) (*QueryRouteProbabilityResponse,
+	error) {
+
+	hops, err := UnmarshalHopPubkeys(req.HopPubkeys)
+	if err != nil {
+		return nil, er.Native(err)
+	}
+
+	if req.AmtMsat <= 0 {
+		return nil, status.Error(codes.InvalidArgument,
+			"amt_msat must be positive")
+	}
+	amt := lnwire.MilliSatoshi(req.AmtMsat)
+
+	prob, hopProbs, err := routeProbability(
+		s.cfg.RouterBackend.MissionControl,
+		s.cfg.RouterBackend.FetchPairCapacity,
+		s.cfg.RouterBackend.SelfNode, hops, amt,
+	)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.String())
+	}
+
+	return &QueryRouteProbabilityResponse{
+		Probability: prob,
+		Hops:        hopProbs,
+	}, nil
+}
+
+// routeProbability estimates the success probability of every hop of the
+// route from source through hops, and of the route as a whole. Hops paying to
+// themselves are rejected, but the route may end at the source.
+func routeProbability(mc MissionControl,
+	pairCapacity func(fromNode, toNode route.Vertex) (btcutil.Amount, er.R),
+	source route.Vertex, hops []route.Vertex, amt lnwire.MilliSatoshi) (
+	float64, []*HopProbability, er.R) {
+
+	if len(hops) == 0 {
+		return 0, nil, er.New("route has no hops")
+	}
+
+	var (
+		prob     = 1.0
+		hopProbs = make([]*HopProbability, 0, len(hops))
+		fromNode = source
+	)
+	for i, toNode := range hops {
+		if toNode == fromNode {
+			return 0, nil, er.Errorf("hop %d pays to itself (%v)",
+				i, toNode)
+		}
+
+		// The estimate doesn't need the capacity, so a pair whose
+		// channels can't be fetched is treated as one of unknown
+		// capacity.
+		capacity, err := pairCapacity(fromNode, toNode)
+		if err != nil {
+			capacity = 0
+		}
+
+		hopProb := mc.GetProbability(fromNode, toNode, amt, capacity)
+		prob *= hopProb
+
+		hopProbs = append(hopProbs, &HopProbability{
+			FromNode:    copyVertex(fromNode),
+			ToNode:      copyVertex(toNode),
+			Probability: hopProb,
+		})
+		fromNode = toNode
+	}
+
+	return prob, hopProbs, nil
+}
+
+// copyVertex returns the serialized pubkey of the vertex.
+func copyVertex(v route.Vertex) []byte {
+	b := make([]byte, route.VertexSize)
+	copy(b, v[:])
+	return b
+}
+
+// GetNodeMetrics returns the latest node metrics computed over the channel
+// graph, along with the time they were computed at. Metrics are computed in
+// the background, one that is requested for the first time is only returned
+// once its first computation has finished.
+func (s *Server) GetNodeMetrics(ctx context.Context,
+	req *GetNodeMetricsRequest) (*GetNodeMetricsResponse, error) {
+
+	if s.nodeMetrics == nil {
+		return nil, status.Error(codes.Unavailable,
+			"node metrics are unavailable")
+	}
+
+	// Only centrality can be requested for now.
+	getCentrality := false
+	for _, t := range req.Types {
+		if t == lnrpc.NodeMetricType_BETWEENNESS_CENTRALITY {
+			getCentrality = true
+		}
+	}
+
+	resp := &GetNodeMetricsResponse{}
+	if !getCentrality {
+		return resp, nil
+	}
+
+	centrality, computedAt := s.nodeMetrics.betweennessCentrality()
+	if centrality == nil {
+		return resp, nil
+	}
+
+	resp.BetweennessCentrality = centrality
+	resp.ComputedAt = computedAt.Unix()
+
+	return resp, nil
+}
+
 // TrackPaymentV2 returns a stream of payment state updates. The stream is
-// closed when the payment completes.
+// closed when the payment completes. If from_seq is set, the updates of the
+// transitions from that one on are replayed first, so a client that lost its
+// stream, for instance because of a restart, doesn't miss any of them.
 func (s *Server) TrackPaymentV2(request *TrackPaymentRequest,
 	stream Router_TrackPaymentV2Server) error {
+	defer s.streamStarted()()
+
 	paymentHash, err := lntypes.MakeHash(request.PaymentHash)
 	if err != nil {
 		return er.Native(err)
 	}
 
-	log.Debugf("TrackPayment called for payment %v", paymentHash)
+	log.Debugf("TrackPayment called for payment %v from transition %v",
+		paymentHash, request.FromSeq)
 
-	return s.trackPayment(paymentHash, stream, request.NoInflightUpdates)
+	return s.trackPayment(
+		paymentHash, stream, request.NoInflightUpdates,
+		request.FromSeq,
+	)
 }
 
-// trackPayment writes payment status updates to the provided stream.
+// trackPayment writes payment status updates to the provided stream, starting
+// with the replayed transitions from fromSeq on if it is not zero.
 func (s *Server) trackPayment(paymentHash lntypes.Hash,
-	stream Router_TrackPaymentV2Server, noInflightUpdates bool) error {
+	stream Router_TrackPaymentV2Server, noInflightUpdates bool,
+	fromSeq uint64) error {
 	router := s.cfg.RouterBackend
 
 	// Subscribe to the outcome of this payment.
-	subscription, err := router.Tower.SubscribePayment(
-		paymentHash,
+	subscription, err := router.Tower.SubscribePaymentFrom(
+		paymentHash, fromSeq,
 	)
 	switch {
 	case channeldb.ErrPaymentNotInitiated.Is(err):
@@ -521,6 +980,13 @@ func (s *Server) trackPayment(paymentHash lntypes.Hash,
 				return errr
 			}
 
+			// A payment that reached one of its limits ends the
+			// stream with a dedicated status code after the final
+			// update was sent.
+			if errr := paymentLimitError(result); errr != nil {
+				return errr
+			}
+
 		case <-s.quit:
 			return er.Native(errServerShuttingDown.Default())
 
@@ -531,17 +997,226 @@ func (s *Server) trackPayment(paymentHash lntypes.Hash,
 	}
 }
 
+// GetPaymentResult returns the current state of the payment identified by the
+// payment hash. In contrast to TrackPaymentV2, it doesn't wait for in-flight
+// payments to complete.
+func (s *Server) GetPaymentResult(ctx context.Context,
+	req *GetPaymentResultRequest) (*lnrpc.Payment, error) {
+	paymentHash, err := lntypes.MakeHash(req.PaymentHash)
+	if err != nil {
+		return nil, er.Native(err)
+	}
+
+	log.Debugf("GetPaymentResult called for payment %v", paymentHash)
+
+	router := s.cfg.RouterBackend
+	payment, err := router.Tower.FetchPayment(paymentHash)
+	switch {
+	case channeldb.ErrPaymentNotInitiated.Is(err):
+		return nil, status.Error(codes.NotFound, err.String())
+	case err != nil:
+		return nil, er.Native(err)
+	}
+
+	rpcPayment, err := router.MarshalPayment(payment)
+	if err != nil {
+		return nil, er.Native(err)
+	}
+
+	return rpcPayment, nil
+}
+
+// defaultMaxPayments is the number of payments returned by ListPayments if the
+// request doesn't limit it, so that large histories are returned in pages.
+const defaultMaxPayments = 100
+
+// ListPayments returns the payments recorded by the control tower, oldest
+// first. The payments can be filtered by status and are returned in pages.
+func (s *Server) ListPayments(ctx context.Context,
+	req *ListPaymentsRequest) (*ListPaymentsResponse, error) {
+
+	log.Debugf("ListPayments called with index_offset=%v, "+
+		"max_payments=%v, reversed=%v", req.IndexOffset,
+		req.MaxPayments, req.Reversed)
+
+	query := channeldb.PaymentsQuery{
+		IndexOffset:       req.IndexOffset,
+		MaxPayments:       req.MaxPayments,
+		Reversed:          req.Reversed,
+		IncludeIncomplete: req.IncludeIncomplete,
+	}
+	if query.MaxPayments == 0 {
+		query.MaxPayments = defaultMaxPayments
+	}
+
+	for _, rpcStatus := range req.Statuses {
+		paymentStatus, err := unmarshalPaymentStatus(rpcStatus)
+		if err != nil {
+			return nil, status.Error(
+				codes.InvalidArgument, err.String(),
+			)
+		}
+		query.Statuses = append(query.Statuses, paymentStatus)
+	}
+
+	router := s.cfg.RouterBackend
+	paymentsResp, err := router.Tower.QueryPayments(query)
+	if err != nil {
+		return nil, er.Native(err)
+	}
+
+	resp := &ListPaymentsResponse{
+		FirstIndexOffset: paymentsResp.FirstIndexOffset,
+		LastIndexOffset:  paymentsResp.LastIndexOffset,
+	}
+	for _, payment := range paymentsResp.Payments {
+		rpcPayment, err := router.MarshalPayment(payment)
+		if err != nil {
+			return nil, er.Native(err)
+		}
+		resp.Payments = append(resp.Payments, rpcPayment)
+	}
+
+	return resp, nil
+}
+
+// AbandonPayment removes the state of a payment from the control tower, so
+// that a new payment to the same payment hash can be made. In-flight payments
+// are only removed if force is set.
+func (s *Server) AbandonPayment(ctx context.Context,
+	req *AbandonPaymentRequest) (*AbandonPaymentResponse, error) {
+	paymentHash, err := lntypes.MakeHash(req.PaymentHash)
+	if err != nil {
+		return nil, er.Native(err)
+	}
+
+	if req.Force {
+		log.Warnf("FORCED abandon of payment %v requested, its state "+
+			"is removed even if it is in flight", paymentHash)
+	} else {
+		log.Debugf("AbandonPayment called for payment %v", paymentHash)
+	}
+
+	err = s.cfg.RouterBackend.Tower.DeletePayment(paymentHash, req.Force)
+	switch {
+	case channeldb.ErrPaymentNotInitiated.Is(err):
+		return nil, status.Error(codes.NotFound, err.String())
+	case channeldb.ErrPaymentInFlight.Is(err):
+		return nil, status.Error(codes.FailedPrecondition, err.String())
+	case err != nil:
+		return nil, er.Native(err)
+	}
+
+	log.Infof("Abandoned payment %v", paymentHash)
+
+	return &AbandonPaymentResponse{}, nil
+}
+
+// ExportPaymentProof returns a proof of payment for a settled payment. The
+// proof binds the payment request, the amount paid and the preimage, and can
+// be checked by anyone with VerifyPaymentProof.
+func (s *Server) ExportPaymentProof(ctx context.Context,
+	req *ExportPaymentProofRequest) (*ExportPaymentProofResponse, error) {
+
+	paymentHash, err := lntypes.MakeHash(req.PaymentHash)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.String())
+	}
+
+	payment, err := s.cfg.RouterBackend.Tower.FetchPayment(paymentHash)
+	if channeldb.ErrPaymentNotInitiated.Is(err) {
+		return nil, status.Error(codes.NotFound, err.String())
+	}
+	if err != nil {
+		return nil, er.Native(err)
+	}
+
+	settle, _ := payment.TerminalInfo()
+	if payment.Status != channeldb.StatusSucceeded || settle == nil {
+		return nil, status.Errorf(codes.NotFound, "payment %v is not "+
+			"settled", paymentHash)
+	}
+
+	// Without the payment request there is nothing the preimage can be
+	// checked against.
+	if len(payment.Info.PaymentRequest) == 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "payment "+
+			"%v has no payment request", paymentHash)
+	}
+
+	proof := &PaymentProof{
+		PaymentRequest: string(payment.Info.PaymentRequest),
+		Preimage:       settle.Preimage,
+		AmtPaid:        payment.Info.Value,
+	}
+	proofBytes, err := proof.Serialize()
+	if err != nil {
+		return nil, er.Native(err)
+	}
+
+	return &ExportPaymentProofResponse{
+		Proof: proofBytes,
+	}, nil
+}
+
+// VerifyPaymentProof decodes a proof of payment and checks that its preimage
+// hashes to the payment hash of the payment request, and that the amount paid
+// covers the amount of the payment request.
+func (s *Server) VerifyPaymentProof(ctx context.Context,
+	req *VerifyPaymentProofRequest) (*VerifyPaymentProofResponse, error) {
+
+	proof, err := DeserializePaymentProof(req.Proof)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.String())
+	}
+
+	payReq, err := proof.Verify(s.cfg.RouterBackend.ActiveNetParams)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.String())
+	}
+
+	var destination []byte
+	if payReq.Destination != nil {
+		destination = payReq.Destination.SerializeCompressed()
+	}
+
+	return &VerifyPaymentProofResponse{
+		PaymentHash:    payReq.PaymentHash[:],
+		PaymentRequest: proof.PaymentRequest,
+		Preimage:       proof.Preimage[:],
+		ValueMsat:      int64(proof.AmtPaid),
+		Destination:    destination,
+	}, nil
+}
+
 // BuildRoute builds a route from a list of hop addresses.
 func (s *Server) BuildRoute(ctx context.Context,
 	req *BuildRouteRequest) (*BuildRouteResponse, error) {
 	// Unmarshal hop list.
-	hops := make([]route.Vertex, len(req.HopPubkeys))
-	for i, pubkeyBytes := range req.HopPubkeys {
-		pubkey, err := route.NewVertexFromBytes(pubkeyBytes)
-		if err != nil {
-			return nil, er.Native(err)
+	hops, err := UnmarshalHopPubkeys(req.HopPubkeys)
+	if err != nil {
+		return nil, er.Native(err)
+	}
+
+	// Continue the route back to ourselves if requested, so that it goes
+	// out over the outgoing channel and returns over another one.
+	if req.ReturnToSelf {
+		hops = append(hops, s.cfg.RouterBackend.SelfNode)
+	}
+
+	// A probe route commits to a random payment hash that nobody knows
+	// the preimage of, so that an htlc sent along it fails at the last hop
+	// instead of settling.
+	var paymentHash lntypes.Hash
+	if req.Probe {
+		if len(req.PaymentHash) != 0 {
+			return nil, er.Native(er.New("payment hash can't be " +
+				"set for a probe route"))
+		}
+
+		if _, errr := rand.Read(paymentHash[:]); errr != nil {
+			return nil, errr
 		}
-		hops[i] = pubkey
 	}
 
 	// Prepare BuildRoute call parameters from rpc request.
@@ -564,6 +1239,12 @@ func (s *Server) BuildRoute(ctx context.Context,
 		return nil, er.Native(err)
 	}
 
+	// Override the payload formats picked by the router if requested.
+	err = applyHopPayloadFormats(route, req.HopPayloadFormats)
+	if err != nil {
+		return nil, er.Native(err)
+	}
+
 	rpcRoute, err := s.cfg.RouterBackend.MarshalRoute(route)
 	if err != nil {
 		return nil, er.Native(err)
@@ -572,6 +1253,39 @@ func (s *Server) BuildRoute(ctx context.Context,
 	routeResp := &BuildRouteResponse{
 		Route: rpcRoute,
 	}
+	if req.Probe {
+		routeResp.ProbeOnly = true
+		routeResp.PaymentHash = paymentHash[:]
+	}
+
+	// If requested, also hand back the onion for this route along with
+	// the shared secrets needed to decrypt failures returned for it.
+	if !req.IncludeOnion {
+		return routeResp, nil
+	}
+
+	if !req.Probe {
+		paymentHash, err = lntypes.MakeHash(req.PaymentHash)
+		if err != nil {
+			return nil, er.Native(err)
+		}
+	}
+
+	onion, circuit, err := routing.BuildOnion(route, paymentHash[:])
+	if err != nil {
+		return nil, er.Native(err)
+	}
+
+	sharedSecrets, err := circuit.SharedSecrets()
+	if err != nil {
+		return nil, er.Native(err)
+	}
+
+	routeResp.Onion = onion
+	routeResp.SharedSecrets = make([][]byte, len(sharedSecrets))
+	for i := range sharedSecrets {
+		routeResp.SharedSecrets[i] = sharedSecrets[i][:]
+	}
 
 	return routeResp, nil
 }
@@ -580,6 +1294,8 @@ func (s *Server) BuildRoute(ctx context.Context,
 // the client which delivers a stream of htlc events.
 func (s *Server) SubscribeHtlcEvents(req *SubscribeHtlcEventsRequest,
 	stream Router_SubscribeHtlcEventsServer) error {
+	defer s.streamStarted()()
+
 	htlcClient, err := s.cfg.RouterBackend.SubscribeHtlcEvents()
 	if err != nil {
 		return er.Native(err)
@@ -614,6 +1330,69 @@ func (s *Server) SubscribeHtlcEvents(req *SubscribeHtlcEventsRequest,
 	}
 }
 
+// SubscribeChannelGraph streams the changes of the channel graph to the
+// caller. If requested, the current graph is sent first, split into batches
+// marked as snapshot, the last of which is also marked as complete.
+func (s *Server) SubscribeChannelGraph(req *SubscribeChannelGraphRequest,
+	stream Router_SubscribeChannelGraphServer) error {
+	defer s.streamStarted()()
+
+	// We subscribe before taking the snapshot so that no change made in
+	// between is missed.
+	client, err := s.cfg.RouterBackend.SubscribeTopology()
+	if err != nil {
+		return er.Native(err)
+	}
+	defer client.Cancel()
+
+	if req.IncludeSnapshot {
+		snapshot, err := s.cfg.RouterBackend.TopologySnapshot()
+		if err != nil {
+			return er.Native(err)
+		}
+
+		batches := splitTopologyChange(snapshot, graphSnapshotBatchSize)
+		for i, batch := range batches {
+			err := stream.Send(&ChannelGraphUpdate{
+				Update:           MarshalTopologyChange(batch),
+				Snapshot:         true,
+				SnapshotComplete: i == len(batches)-1,
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	for {
+		select {
+		case topChange, ok := <-client.TopologyChanges:
+			// The channel is closed when the router shuts down.
+			if !ok {
+				return er.Native(
+					er.New("channel graph subscription terminated"),
+				)
+			}
+
+			err := stream.Send(&ChannelGraphUpdate{
+				Update: MarshalTopologyChange(topChange),
+			})
+			if err != nil {
+				return err
+			}
+
+		// If the stream's context is canceled, return an error.
+		case <-stream.Context().Done():
+			log.Debugf("channel graph stream canceled")
+			return stream.Context().Err()
+
+		// If the server has been signaled to shut down, exit.
+		case <-s.quit:
+			return er.Native(errServerShuttingDown.Default())
+		}
+	}
+}
+
 // HtlcInterceptor is a bidirectional stream for streaming interception
 // requests to the caller.
 // Upon connection it does the following:
@@ -628,6 +1407,7 @@ func (s *Server) HtlcInterceptor(stream Router_HtlcInterceptorServer) error {
 		return er.Native(ErrInterceptorAlreadyExists.Default())
 	}
 	defer atomic.CompareAndSwapInt32(&s.forwardInterceptorActive, 1, 0)
+	defer s.streamStarted()()
 
 	// run the forward interceptor.
 	return er.Native(newForwardInterceptor(s, stream).run())
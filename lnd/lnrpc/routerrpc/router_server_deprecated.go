@@ -43,6 +43,13 @@ func (i *legacyTrackPaymentServer) Send(p *lnrpc.Payment) error {
 		case lnrpc.PaymentFailureReason_FAILURE_REASON_INSUFFICIENT_BALANCE:
 			state = PaymentState_FAILED_INSUFFICIENT_BALANCE
 
+		// The legacy states have no dedicated state for an exceeded
+		// fee cap or attempt limit.
+		case lnrpc.PaymentFailureReason_FAILURE_REASON_FEE_CAP_EXCEEDED,
+			lnrpc.PaymentFailureReason_FAILURE_REASON_ATTEMPT_LIMIT:
+
+			state = PaymentState_FAILED_ERROR
+
 		default:
 			return er.Native(er.Errorf("unknown failure reason %v",
 				p.FailureReason))
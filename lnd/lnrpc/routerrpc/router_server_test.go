@@ -0,0 +1,1469 @@
+package routerrpc
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"io/ioutil"
+	"math"
+	"net"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/pkt-cash/pktd/btcec"
+	"github.com/pkt-cash/pktd/btcutil"
+	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/chaincfg"
+	sphinx "github.com/pkt-cash/pktd/lightning-onion"
+	"github.com/pkt-cash/pktd/lnd/autopilot"
+	"github.com/pkt-cash/pktd/lnd/channeldb"
+	"github.com/pkt-cash/pktd/lnd/channeldb/kvdb"
+	"github.com/pkt-cash/pktd/lnd/keychain"
+	"github.com/pkt-cash/pktd/lnd/lnrpc"
+	"github.com/pkt-cash/pktd/lnd/lntest/mock"
+	"github.com/pkt-cash/pktd/lnd/lntypes"
+	"github.com/pkt-cash/pktd/lnd/lnwire"
+	"github.com/pkt-cash/pktd/lnd/macaroons"
+	"github.com/pkt-cash/pktd/lnd/routing"
+	"github.com/pkt-cash/pktd/lnd/routing/route"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TestToRPCError asserts that no route errors are mapped onto a distinct gRPC
+// status code while other errors are passed through.
+func TestToRPCError(t *testing.T) {
+	err := toRPCError(routing.ErrNoRouteFound.Default())
+	if status.Code(err) != codes.NotFound {
+		t.Fatalf("expected code %v, got %v", codes.NotFound,
+			status.Code(err))
+	}
+
+	err = toRPCError(er.New("transient failure"))
+	if status.Code(err) != codes.Unknown {
+		t.Fatalf("expected code %v, got %v", codes.Unknown,
+			status.Code(err))
+	}
+}
+
+// TestPaymentLimitError asserts that payments which reached their timeout or
+// attempt limit are mapped onto distinct gRPC status codes, while all other
+// payments end their stream without an error.
+func TestPaymentLimitError(t *testing.T) {
+	reason := func(r channeldb.FailureReason) *channeldb.FailureReason {
+		return &r
+	}
+
+	tests := []struct {
+		name    string
+		payment *channeldb.MPPayment
+		code    codes.Code
+	}{
+		{
+			name: "timeout",
+			payment: &channeldb.MPPayment{
+				Status: channeldb.StatusFailed,
+				FailureReason: reason(
+					channeldb.FailureReasonTimeout,
+				),
+			},
+			code: codes.DeadlineExceeded,
+		},
+		{
+			name: "attempt limit",
+			payment: &channeldb.MPPayment{
+				Status: channeldb.StatusFailed,
+				FailureReason: reason(
+					channeldb.FailureReasonAttemptLimit,
+				),
+			},
+			code: codes.ResourceExhausted,
+		},
+		{
+			name: "no route",
+			payment: &channeldb.MPPayment{
+				Status: channeldb.StatusFailed,
+				FailureReason: reason(
+					channeldb.FailureReasonNoRoute,
+				),
+			},
+			code: codes.OK,
+		},
+		{
+			name: "in flight",
+			payment: &channeldb.MPPayment{
+				Status: channeldb.StatusInFlight,
+			},
+			code: codes.OK,
+		},
+		{
+			name: "succeeded",
+			payment: &channeldb.MPPayment{
+				Status: channeldb.StatusSucceeded,
+			},
+			code: codes.OK,
+		},
+	}
+
+	for _, test := range tests {
+		err := paymentLimitError(test.payment)
+		if status.Code(err) != test.code {
+			t.Fatalf("%v: expected code %v, got %v", test.name,
+				test.code, status.Code(err))
+		}
+	}
+}
+
+// mockPaymentTower is a control tower that only knows about a fixed set of
+// payments.
+type mockPaymentTower struct {
+	routing.ControlTower
+
+	payments map[lntypes.Hash]*channeldb.MPPayment
+
+	// query is the last query passed to QueryPayments.
+	query channeldb.PaymentsQuery
+}
+
+func (m *mockPaymentTower) FetchPayment(paymentHash lntypes.Hash) (
+	*channeldb.MPPayment, er.R) {
+	payment, ok := m.payments[paymentHash]
+	if !ok {
+		return nil, channeldb.ErrPaymentNotInitiated.Default()
+	}
+	return payment, nil
+}
+
+func (m *mockPaymentTower) DeletePayment(paymentHash lntypes.Hash,
+	force bool) er.R {
+
+	payment, ok := m.payments[paymentHash]
+	if !ok {
+		return channeldb.ErrPaymentNotInitiated.Default()
+	}
+	if payment.Status == channeldb.StatusInFlight && !force {
+		return channeldb.ErrPaymentInFlight.Default()
+	}
+	delete(m.payments, paymentHash)
+	return nil
+}
+
+// QueryPayments returns the payments with one of the statuses of the query,
+// ordered by sequence number. Paging is left to the database.
+func (m *mockPaymentTower) QueryPayments(query channeldb.PaymentsQuery) (
+	channeldb.PaymentsResponse, er.R) {
+
+	m.query = query
+
+	var resp channeldb.PaymentsResponse
+	for _, payment := range m.payments {
+		for _, s := range query.Statuses {
+			if payment.Status == s {
+				resp.Payments = append(resp.Payments, payment)
+				break
+			}
+		}
+	}
+	sort.Slice(resp.Payments, func(i, j int) bool {
+		return resp.Payments[i].SequenceNum <
+			resp.Payments[j].SequenceNum
+	})
+
+	if len(resp.Payments) > 0 {
+		resp.FirstIndexOffset = resp.Payments[0].SequenceNum
+		resp.LastIndexOffset =
+			resp.Payments[len(resp.Payments)-1].SequenceNum
+	}
+	return resp, nil
+}
+
+// TestGetPaymentResult asserts that the current state of a payment is returned
+// and that unknown payments are reported as not found.
+func TestGetPaymentResult(t *testing.T) {
+	settled := lntypes.Hash{1}
+	inFlight := lntypes.Hash{2}
+
+	tower := &mockPaymentTower{
+		payments: map[lntypes.Hash]*channeldb.MPPayment{
+			settled: {
+				Info: &channeldb.PaymentCreationInfo{
+					PaymentHash:  settled,
+					Value:        1000,
+					CreationTime: time.Unix(1000, 0),
+				},
+				Status: channeldb.StatusSucceeded,
+			},
+			inFlight: {
+				Info: &channeldb.PaymentCreationInfo{
+					PaymentHash:  inFlight,
+					Value:        2000,
+					CreationTime: time.Unix(2000, 0),
+				},
+				Status: channeldb.StatusInFlight,
+			},
+		},
+	}
+	s := &Server{
+		cfg: &Config{
+			RouterBackend: &RouterBackend{Tower: tower},
+		},
+	}
+
+	tests := []struct {
+		hash   lntypes.Hash
+		status lnrpc.Payment_PaymentStatus
+	}{
+		{settled, lnrpc.Payment_SUCCEEDED},
+		{inFlight, lnrpc.Payment_IN_FLIGHT},
+	}
+	for _, test := range tests {
+		payment, err := s.GetPaymentResult(
+			context.Background(), &GetPaymentResultRequest{
+				PaymentHash: test.hash[:],
+			},
+		)
+		if err != nil {
+			t.Fatalf("unable to get payment result: %v", err)
+		}
+		if payment.Status != test.status {
+			t.Fatalf("expected status %v, got %v", test.status,
+				payment.Status)
+		}
+		if payment.PaymentHash != test.hash.String() {
+			t.Fatalf("unexpected payment hash %v",
+				payment.PaymentHash)
+		}
+	}
+
+	unknown := lntypes.Hash{3}
+	_, err := s.GetPaymentResult(
+		context.Background(), &GetPaymentResultRequest{
+			PaymentHash: unknown[:],
+		},
+	)
+	if status.Code(err) != codes.NotFound {
+		t.Fatalf("expected code %v, got %v", codes.NotFound,
+			status.Code(err))
+	}
+}
+
+// TestListPayments asserts that the query is passed on to the control tower
+// with a default page size and that the returned payments are marshaled along
+// with the indices to continue paging.
+func TestListPayments(t *testing.T) {
+	newPayment := func(seqNr uint64, hash lntypes.Hash,
+		paymentStatus channeldb.PaymentStatus) *channeldb.MPPayment {
+
+		return &channeldb.MPPayment{
+			SequenceNum: seqNr,
+			Info: &channeldb.PaymentCreationInfo{
+				PaymentHash:  hash,
+				Value:        1000,
+				CreationTime: time.Unix(1000, 0),
+			},
+			Status: paymentStatus,
+		}
+	}
+
+	settled1 := lntypes.Hash{1}
+	inFlight := lntypes.Hash{2}
+	settled2 := lntypes.Hash{3}
+
+	tower := &mockPaymentTower{
+		payments: map[lntypes.Hash]*channeldb.MPPayment{
+			settled1: newPayment(
+				1, settled1, channeldb.StatusSucceeded,
+			),
+			inFlight: newPayment(
+				2, inFlight, channeldb.StatusInFlight,
+			),
+			settled2: newPayment(
+				4, settled2, channeldb.StatusSucceeded,
+			),
+		},
+	}
+	s := &Server{
+		cfg: &Config{
+			RouterBackend: &RouterBackend{Tower: tower},
+		},
+	}
+
+	resp, err := s.ListPayments(
+		context.Background(), &ListPaymentsRequest{
+			IndexOffset: 5,
+			Reversed:    true,
+			Statuses: []lnrpc.Payment_PaymentStatus{
+				lnrpc.Payment_SUCCEEDED,
+			},
+		},
+	)
+	if err != nil {
+		t.Fatalf("unable to list payments: %v", err)
+	}
+
+	expectedQuery := channeldb.PaymentsQuery{
+		IndexOffset: 5,
+		MaxPayments: defaultMaxPayments,
+		Reversed:    true,
+		Statuses: []channeldb.PaymentStatus{
+			channeldb.StatusSucceeded,
+		},
+	}
+	if !reflect.DeepEqual(tower.query, expectedQuery) {
+		t.Fatalf("expected query %+v, got %+v", expectedQuery,
+			tower.query)
+	}
+
+	if resp.FirstIndexOffset != 1 || resp.LastIndexOffset != 4 {
+		t.Fatalf("expected indices (1, 4), got (%v, %v)",
+			resp.FirstIndexOffset, resp.LastIndexOffset)
+	}
+	if len(resp.Payments) != 2 {
+		t.Fatalf("expected 2 payments, got %v", len(resp.Payments))
+	}
+	for i, hash := range []lntypes.Hash{settled1, settled2} {
+		payment := resp.Payments[i]
+		if payment.PaymentHash != hash.String() {
+			t.Fatalf("unexpected payment hash %v",
+				payment.PaymentHash)
+		}
+		if payment.Status != lnrpc.Payment_SUCCEEDED {
+			t.Fatalf("expected status %v, got %v",
+				lnrpc.Payment_SUCCEEDED, payment.Status)
+		}
+	}
+
+	// An explicit page size is passed on unchanged.
+	_, err = s.ListPayments(
+		context.Background(), &ListPaymentsRequest{
+			MaxPayments: 10,
+		},
+	)
+	if err != nil {
+		t.Fatalf("unable to list payments: %v", err)
+	}
+	if tower.query.MaxPayments != 10 {
+		t.Fatalf("expected max payments 10, got %v",
+			tower.query.MaxPayments)
+	}
+
+	// Unknown statuses are rejected.
+	_, err = s.ListPayments(
+		context.Background(), &ListPaymentsRequest{
+			Statuses: []lnrpc.Payment_PaymentStatus{100},
+		},
+	)
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected code %v, got %v", codes.InvalidArgument,
+			status.Code(err))
+	}
+}
+
+// TestAbandonPayment asserts that in-flight payments are only abandoned when
+// forced, and that unknown payments are reported as not found.
+func TestAbandonPayment(t *testing.T) {
+	inFlight := lntypes.Hash{1}
+
+	tower := &mockPaymentTower{
+		payments: map[lntypes.Hash]*channeldb.MPPayment{
+			inFlight: {
+				Info: &channeldb.PaymentCreationInfo{
+					PaymentHash: inFlight,
+				},
+				Status: channeldb.StatusInFlight,
+			},
+		},
+	}
+	s := &Server{
+		cfg: &Config{
+			RouterBackend: &RouterBackend{Tower: tower},
+		},
+	}
+
+	tests := []struct {
+		force bool
+		code  codes.Code
+	}{
+		{false, codes.FailedPrecondition},
+		{true, codes.OK},
+		{true, codes.NotFound},
+	}
+	for _, test := range tests {
+		_, err := s.AbandonPayment(
+			context.Background(), &AbandonPaymentRequest{
+				PaymentHash: inFlight[:],
+				Force:       test.force,
+			},
+		)
+		if status.Code(err) != test.code {
+			t.Fatalf("expected code %v, got %v", test.code,
+				status.Code(err))
+		}
+	}
+}
+
+// TestEstimateRouteFeeTimePref asserts that time preferences outside of the
+// range [-1, 1] and NaN are rejected.
+func TestEstimateRouteFeeTimePref(t *testing.T) {
+	s := &Server{cfg: &Config{}}
+
+	dest := make([]byte, 33)
+	for _, timePref := range []float64{-1.1, 1.1, math.NaN()} {
+		_, err := s.EstimateRouteFee(
+			context.Background(), &RouteFeeRequest{
+				Dest:     dest,
+				AmtSat:   100,
+				TimePref: timePref,
+			},
+		)
+		if status.Code(err) != codes.InvalidArgument {
+			t.Fatalf("expected code %v for time pref %v, got %v",
+				codes.InvalidArgument, timePref,
+				status.Code(err))
+		}
+	}
+}
+
+// TestExportPaymentProof asserts that a proof is only exported for settled
+// payments and that the exported proof verifies.
+func TestExportPaymentProof(t *testing.T) {
+	preimage := lntypes.Preimage{1}
+	settled := preimage.Hash()
+	inFlight := lntypes.Hash{2}
+
+	tower := &mockPaymentTower{
+		payments: map[lntypes.Hash]*channeldb.MPPayment{
+			settled: {
+				Info: &channeldb.PaymentCreationInfo{
+					PaymentHash: settled,
+					Value:       1000,
+					PaymentRequest: []byte(newTestPaymentRequest(
+						t, preimage, 1000,
+					)),
+				},
+				HTLCs: []channeldb.HTLCAttempt{{
+					Settle: &channeldb.HTLCSettleInfo{
+						Preimage: preimage,
+					},
+				}},
+				Status: channeldb.StatusSucceeded,
+			},
+			inFlight: {
+				Info: &channeldb.PaymentCreationInfo{
+					PaymentHash: inFlight,
+				},
+				Status: channeldb.StatusInFlight,
+			},
+		},
+	}
+	s := &Server{
+		cfg: &Config{
+			RouterBackend: &RouterBackend{
+				Tower:           tower,
+				ActiveNetParams: proofNetParams,
+			},
+		},
+	}
+
+	for _, hash := range []lntypes.Hash{inFlight, {3}} {
+		_, err := s.ExportPaymentProof(
+			context.Background(), &ExportPaymentProofRequest{
+				PaymentHash: hash[:],
+			},
+		)
+		if status.Code(err) != codes.NotFound {
+			t.Fatalf("expected code %v, got %v", codes.NotFound,
+				status.Code(err))
+		}
+	}
+
+	resp, err := s.ExportPaymentProof(
+		context.Background(), &ExportPaymentProofRequest{
+			PaymentHash: settled[:],
+		},
+	)
+	if err != nil {
+		t.Fatalf("unable to export proof: %v", err)
+	}
+
+	verified, err := s.VerifyPaymentProof(
+		context.Background(), &VerifyPaymentProofRequest{
+			Proof: resp.Proof,
+		},
+	)
+	if err != nil {
+		t.Fatalf("unable to verify proof: %v", err)
+	}
+	if !bytes.Equal(verified.PaymentHash, settled[:]) ||
+		!bytes.Equal(verified.Preimage, preimage[:]) ||
+		verified.ValueMsat != 1000 {
+
+		t.Fatalf("unexpected verified proof %v", verified)
+	}
+
+	_, err = s.VerifyPaymentProof(
+		context.Background(), &VerifyPaymentProofRequest{
+			Proof: resp.Proof[:len(resp.Proof)-1],
+		},
+	)
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected code %v, got %v", codes.InvalidArgument,
+			status.Code(err))
+	}
+}
+
+// TestMissionControlConfig asserts that the mission control config is applied
+// to the next probability query, and that out-of-range values are rejected
+// without changing the config.
+func TestMissionControlConfig(t *testing.T) {
+	mc, cleanup := newTestMissionControl(t)
+	defer cleanup()
+
+	s := &Server{
+		cfg: &Config{
+			RouterBackend: &RouterBackend{MissionControl: mc},
+		},
+	}
+	ctx := context.Background()
+
+	resp, err := s.GetMissionControlConfig(
+		ctx, &GetMissionControlConfigRequest{},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Config.HalfLifeSeconds != 3600 ||
+		resp.Config.HopProbability != 0.9 ||
+		resp.Config.Estimator != routing.AprioriEstimatorName {
+
+		t.Fatalf("unexpected config %v", resp.Config)
+	}
+
+	// Lowering the a priori hop probability applies to untried node
+	// pairs right away.
+	resp.Config.HopProbability = 0.2
+	_, err = s.SetMissionControlConfig(ctx, &SetMissionControlConfigRequest{
+		Config: resp.Config,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p := mc.GetProbability(node1, node2, 1000, 0); p != 0.2 {
+		t.Fatalf("expected probability 0.2, got %v", p)
+	}
+
+	invalid := []*MissionControlConfig{
+		nil,
+		{HalfLifeSeconds: 0, HopProbability: 0.2, Weight: 0.5},
+		{HalfLifeSeconds: 60, HopProbability: 2, Weight: 0.5},
+		{HalfLifeSeconds: 60, HopProbability: 0.2, Weight: -1},
+		{
+			HalfLifeSeconds: 60, HopProbability: 0.2, Weight: 0.5,
+			Estimator: "unknown",
+		},
+		{
+			HalfLifeSeconds: 60, HopProbability: 0.2, Weight: 0.5,
+			Estimator:               routing.BimodalEstimatorName,
+			BimodalDecayTimeSeconds: 60,
+		},
+	}
+	for _, cfg := range invalid {
+		_, err := s.SetMissionControlConfig(
+			ctx, &SetMissionControlConfigRequest{Config: cfg},
+		)
+		if status.Code(err) != codes.InvalidArgument {
+			t.Fatalf("expected code %v for %v, got %v",
+				codes.InvalidArgument, cfg, status.Code(err))
+		}
+	}
+	if p := mc.GetConfig().AprioriHopProbability; p != 0.2 {
+		t.Fatalf("expected config to be unchanged, got %v", p)
+	}
+
+	// Switch to the bimodal estimator.
+	resp.Config.Estimator = routing.BimodalEstimatorName
+	resp.Config.BimodalScaleMsat = 300000000
+	resp.Config.BimodalNodeWeight = 0.2
+	resp.Config.BimodalDecayTimeSeconds = 3600
+	_, err = s.SetMissionControlConfig(ctx, &SetMissionControlConfigRequest{
+		Config: resp.Config,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err = s.GetMissionControlConfig(
+		ctx, &GetMissionControlConfigRequest{},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Config.Estimator != routing.BimodalEstimatorName ||
+		resp.Config.BimodalScaleMsat != 300000000 ||
+		resp.Config.BimodalDecayTimeSeconds != 3600 {
+
+		t.Fatalf("unexpected config %v", resp.Config)
+	}
+}
+
+// TestNewNoMacaroonFile asserts that with NoMacaroonFile set, and stateless
+// init disabled, the router macaroon is handed to the caller instead of being
+// written to disk.
+func TestNewNoMacaroonFile(t *testing.T) {
+	tempDir, errr := ioutil.TempDir("", "routerrpc-macaroon")
+	if errr != nil {
+		t.Fatal(errr)
+	}
+	defer os.RemoveAll(tempDir)
+
+	macService, err := macaroons.NewService(tempDir, "lnd", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer macService.Close()
+	pw := []byte("hello")
+	if err := macService.CreateUnlock(&pw); err != nil {
+		t.Fatal(err)
+	}
+
+	server, perms, err := New(&Config{
+		NetworkDir:     tempDir,
+		MacService:     macService,
+		NoMacaroonFile: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(perms) == 0 {
+		t.Fatal("expected macaroon permissions")
+	}
+
+	macPath := filepath.Join(tempDir, DefaultRouterMacFilename)
+	if lnrpc.FileExists(macPath) {
+		t.Fatal("expected router macaroon not to be written")
+	}
+
+	if len(server.Macaroon()) == 0 {
+		t.Fatal("expected router macaroon to be returned")
+	}
+
+	// Clients retrieve the macaroon through the RPC.
+	resp, errr := server.GetMacaroon(
+		context.Background(), &GetMacaroonRequest{},
+	)
+	if errr != nil {
+		t.Fatal(errr)
+	}
+	if !bytes.Equal(resp.Macaroon, server.Macaroon()) {
+		t.Fatal("expected router macaroon from GetMacaroon")
+	}
+
+	// A macaroon which is written to disk is not returned.
+	server, _, err = New(&Config{
+		NetworkDir: tempDir,
+		MacService: macService,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !lnrpc.FileExists(macPath) {
+		t.Fatal("expected router macaroon to be written")
+	}
+	_, errr = server.GetMacaroon(
+		context.Background(), &GetMacaroonRequest{},
+	)
+	if status.Code(errr) != codes.NotFound {
+		t.Fatalf("expected NotFound, got %v", errr)
+	}
+}
+
+type mockChannelGraphStream struct {
+	Router_SubscribeChannelGraphServer
+
+	ctx     context.Context
+	updates chan *ChannelGraphUpdate
+}
+
+func (m *mockChannelGraphStream) Context() context.Context {
+	return m.ctx
+}
+
+func (m *mockChannelGraphStream) Send(update *ChannelGraphUpdate) error {
+	m.updates <- update
+	return nil
+}
+
+// TestSubscribeChannelGraph asserts that the channel graph stream starts with
+// the requested snapshot, followed by live updates, and ends once the caller
+// cancels the stream.
+func TestSubscribeChannelGraph(t *testing.T) {
+	priv, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		t.Fatalf("unable to create key: %v", err)
+	}
+	pub := priv.PubKey()
+
+	// The snapshot holds more updates than fit in a single batch.
+	snapshot := &routing.TopologyChange{}
+	for i := 0; i < graphSnapshotBatchSize+1; i++ {
+		snapshot.ClosedChannels = append(snapshot.ClosedChannels,
+			&routing.ClosedChanSummary{ChanID: uint64(i)})
+	}
+	snapshot.NodeUpdates = []*routing.NetworkNodeUpdate{{
+		IdentityKey: pub,
+		Alias:       "node",
+	}}
+
+	topologyChanges := make(chan *routing.TopologyChange)
+	canceled := make(chan struct{})
+	s := &Server{
+		cfg: &Config{
+			RouterBackend: &RouterBackend{
+				SubscribeTopology: func() (*routing.TopologyClient,
+					er.R) {
+
+					return &routing.TopologyClient{
+						TopologyChanges: topologyChanges,
+						Cancel: func() {
+							close(canceled)
+						},
+					}, nil
+				},
+				TopologySnapshot: func() (*routing.TopologyChange,
+					er.R) {
+
+					return snapshot, nil
+				},
+			},
+		},
+		quit: make(chan struct{}),
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream := &mockChannelGraphStream{
+		ctx:     ctx,
+		updates: make(chan *ChannelGraphUpdate),
+	}
+
+	errChan := make(chan error, 1)
+	go func() {
+		errChan <- s.SubscribeChannelGraph(
+			&SubscribeChannelGraphRequest{IncludeSnapshot: true},
+			stream,
+		)
+	}()
+
+	receive := func() *ChannelGraphUpdate {
+		select {
+		case update := <-stream.updates:
+			return update
+		case <-time.After(5 * time.Second):
+			t.Fatalf("no channel graph update received")
+		}
+		return nil
+	}
+
+	first := receive()
+	if !first.Snapshot || first.SnapshotComplete {
+		t.Fatalf("expected incomplete snapshot batch, got %v", first)
+	}
+	if len(first.Update.NodeUpdates) != 1 ||
+		first.Update.NodeUpdates[0].Alias != "node" ||
+		len(first.Update.ClosedChans) != graphSnapshotBatchSize-1 {
+
+		t.Fatalf("unexpected first snapshot batch: %v", first)
+	}
+
+	second := receive()
+	if !second.Snapshot || !second.SnapshotComplete {
+		t.Fatalf("expected final snapshot batch, got %v", second)
+	}
+	if len(second.Update.ClosedChans) != 2 {
+		t.Fatalf("expected 2 closed channels, got %v",
+			len(second.Update.ClosedChans))
+	}
+
+	// Live changes follow the snapshot.
+	topologyChanges <- &routing.TopologyChange{
+		ClosedChannels: []*routing.ClosedChanSummary{{
+			ChanID:       99,
+			ClosedHeight: 100,
+		}},
+	}
+	live := receive()
+	if live.Snapshot || live.SnapshotComplete {
+		t.Fatalf("expected live update, got %v", live)
+	}
+	if len(live.Update.ClosedChans) != 1 ||
+		live.Update.ClosedChans[0].ChanId != 99 ||
+		live.Update.ClosedChans[0].ClosedHeight != 100 {
+
+		t.Fatalf("unexpected live update: %v", live)
+	}
+
+	// Canceling the stream ends the subscription.
+	cancel()
+	select {
+	case err := <-errChan:
+		if err != context.Canceled {
+			t.Fatalf("expected context canceled, got %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("subscription didn't end")
+	}
+	select {
+	case <-canceled:
+	default:
+		t.Fatalf("topology client wasn't canceled")
+	}
+}
+
+// TestSplitTopologyChange asserts that topology changes are split into
+// batches of the requested size.
+func TestSplitTopologyChange(t *testing.T) {
+	batches := splitTopologyChange(&routing.TopologyChange{}, 2)
+	if len(batches) != 1 {
+		t.Fatalf("expected a single empty batch, got %v", len(batches))
+	}
+
+	change := &routing.TopologyChange{
+		NodeUpdates:        make([]*routing.NetworkNodeUpdate, 3),
+		ChannelEdgeUpdates: make([]*routing.ChannelEdgeUpdate, 1),
+		ClosedChannels:     make([]*routing.ClosedChanSummary, 1),
+	}
+	batches = splitTopologyChange(change, 2)
+	if len(batches) != 3 {
+		t.Fatalf("expected 3 batches, got %v", len(batches))
+	}
+	sizes := []int{2, 2, 1}
+	for i, batch := range batches {
+		size := len(batch.NodeUpdates) + len(batch.ChannelEdgeUpdates) +
+			len(batch.ClosedChannels)
+		if size != sizes[i] {
+			t.Fatalf("batch %d: expected %d updates, got %d", i,
+				sizes[i], size)
+		}
+	}
+	if len(batches[1].NodeUpdates) != 1 ||
+		len(batches[1].ChannelEdgeUpdates) != 1 ||
+		len(batches[2].ClosedChannels) != 1 {
+
+		t.Fatalf("updates out of order")
+	}
+}
+
+// pairMissionControl is a mission control mock that returns a fixed
+// probability per node pair and records the capacity it was queried with.
+type pairMissionControl struct {
+	mockMissionControl
+
+	probs      map[routing.DirectedNodePair]float64
+	capacities map[routing.DirectedNodePair]btcutil.Amount
+}
+
+func (m *pairMissionControl) GetProbability(fromNode, toNode route.Vertex,
+	amt lnwire.MilliSatoshi, capacity btcutil.Amount) float64 {
+
+	pair := routing.NewDirectedNodePair(fromNode, toNode)
+	m.capacities[pair] = capacity
+
+	return m.probs[pair]
+}
+
+// pairCapacities returns a FetchPairCapacity closure backed by the given
+// capacities. Pairs without an entry have no channels.
+func pairCapacities(caps map[routing.DirectedNodePair]btcutil.Amount) func(
+	route.Vertex, route.Vertex) (btcutil.Amount, er.R) {
+
+	return func(fromNode, toNode route.Vertex) (btcutil.Amount, er.R) {
+		return caps[routing.NewDirectedNodePair(fromNode, toNode)], nil
+	}
+}
+
+// TestQueryProbability asserts that the probability of a node pair is
+// estimated with the capacity of the channels between the nodes.
+func TestQueryProbability(t *testing.T) {
+	var from, to route.Vertex
+	from[0], to[0] = 2, 3
+	pair := routing.NewDirectedNodePair(from, to)
+
+	mc := &pairMissionControl{
+		probs: map[routing.DirectedNodePair]float64{pair: 0.7},
+		capacities: make(
+			map[routing.DirectedNodePair]btcutil.Amount,
+		),
+	}
+	backend := &RouterBackend{
+		MissionControl: mc,
+		FetchPairCapacity: pairCapacities(
+			map[routing.DirectedNodePair]btcutil.Amount{
+				pair: 500000,
+			},
+		),
+	}
+	server := &Server{cfg: &Config{RouterBackend: backend}}
+
+	req := &QueryProbabilityRequest{
+		FromNode: from[:],
+		ToNode:   to[:],
+		AmtMsat:  1000,
+	}
+	resp, err := server.QueryProbability(context.Background(), req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Probability != 0.7 {
+		t.Fatalf("expected probability 0.7, got %v", resp.Probability)
+	}
+	if mc.capacities[pair] != 500000 {
+		t.Fatalf("expected capacity 500000, got %v",
+			mc.capacities[pair])
+	}
+
+	// A failure to fetch the capacity fails the query.
+	backend.FetchPairCapacity = func(route.Vertex,
+		route.Vertex) (btcutil.Amount, er.R) {
+
+		return 0, er.New("graph unavailable")
+	}
+	if _, err := server.QueryProbability(
+		context.Background(), req,
+	); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+// TestQueryRouteProbability asserts that the probability of a route is the
+// product of the probabilities of its hops, and that invalid routes are
+// rejected.
+func TestQueryRouteProbability(t *testing.T) {
+	keys := make([][]byte, 3)
+	nodes := make([]route.Vertex, 3)
+	for i := range keys {
+		privKey, err := btcec.NewPrivateKey(btcec.S256())
+		if err != nil {
+			t.Fatal(err)
+		}
+		keys[i] = privKey.PubKey().SerializeCompressed()
+		nodes[i] = route.NewVertex(privKey.PubKey())
+	}
+	self, a, b := nodes[0], nodes[1], nodes[2]
+
+	caps := map[routing.DirectedNodePair]btcutil.Amount{
+		routing.NewDirectedNodePair(self, a): 100000,
+		routing.NewDirectedNodePair(a, b):    50000,
+	}
+	mc := &pairMissionControl{
+		probs: map[routing.DirectedNodePair]float64{
+			routing.NewDirectedNodePair(self, a): 0.5,
+			routing.NewDirectedNodePair(a, b):    0.4,
+			routing.NewDirectedNodePair(a, self): 0.8,
+		},
+		capacities: make(map[routing.DirectedNodePair]btcutil.Amount),
+	}
+	server := &Server{
+		cfg: &Config{
+			RouterBackend: &RouterBackend{
+				SelfNode:          self,
+				MissionControl:    mc,
+				FetchPairCapacity: pairCapacities(caps),
+			},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		amt      int64
+		hops     [][]byte
+		expProb  float64
+		expHops  []float64
+		expError bool
+	}{
+		{
+			name:    "single hop",
+			amt:     1000,
+			hops:    [][]byte{keys[1]},
+			expProb: 0.5,
+			expHops: []float64{0.5},
+		},
+		{
+			name:    "multi hop",
+			amt:     1000,
+			hops:    [][]byte{keys[1], keys[2]},
+			expProb: 0.2,
+			expHops: []float64{0.5, 0.4},
+		},
+		{
+			name:    "circular",
+			amt:     1000,
+			hops:    [][]byte{keys[1], keys[0]},
+			expProb: 0.4,
+			expHops: []float64{0.5, 0.8},
+		},
+		{
+			name:     "first hop to self",
+			amt:      1000,
+			hops:     [][]byte{keys[0], keys[1]},
+			expError: true,
+		},
+		{
+			name:     "self loop",
+			amt:      1000,
+			hops:     [][]byte{keys[1], keys[1]},
+			expError: true,
+		},
+		{
+			name:     "no hops",
+			amt:      1000,
+			expError: true,
+		},
+		{
+			name:     "invalid pubkey",
+			amt:      1000,
+			hops:     [][]byte{keys[1][:32]},
+			expError: true,
+		},
+		{
+			name:     "zero amount",
+			hops:     [][]byte{keys[1]},
+			expError: true,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			resp, err := server.QueryRouteProbability(
+				context.Background(),
+				&QueryRouteProbabilityRequest{
+					AmtMsat:    test.amt,
+					HopPubkeys: test.hops,
+				},
+			)
+			if test.expError {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if math.Abs(resp.Probability-test.expProb) > 1e-9 {
+				t.Fatalf("expected probability %v, got %v",
+					test.expProb, resp.Probability)
+			}
+			if len(resp.Hops) != len(test.expHops) {
+				t.Fatalf("expected %d hops, got %d",
+					len(test.expHops), len(resp.Hops))
+			}
+
+			from := keys[0]
+			for i, hop := range resp.Hops {
+				if !bytes.Equal(hop.FromNode, from) ||
+					!bytes.Equal(hop.ToNode, test.hops[i]) {
+
+					t.Fatalf("unexpected pair for hop %d", i)
+				}
+				if hop.Probability != test.expHops[i] {
+					t.Fatalf("expected probability %v for "+
+						"hop %d, got %v", test.expHops[i],
+						i, hop.Probability)
+				}
+				from = test.hops[i]
+			}
+		})
+	}
+
+	// Every hop is estimated with the capacity of its pair, which is zero
+	// for the pair without channels.
+	for pair, capacity := range mc.capacities {
+		if capacity != caps[pair] {
+			t.Fatalf("expected capacity %v for %v, got %v",
+				caps[pair], pair, capacity)
+		}
+	}
+	if len(mc.capacities) != 3 {
+		t.Fatalf("expected 3 estimated pairs, got %d",
+			len(mc.capacities))
+	}
+}
+
+// starNode is a node of starGraph.
+type starNode struct {
+	pubKey [33]byte
+	peers  []*starNode
+}
+
+func (n *starNode) PubKey() [33]byte {
+	return n.pubKey
+}
+
+func (n *starNode) Addrs() []net.Addr {
+	return nil
+}
+
+func (n *starNode) ForEachChannel(cb func(autopilot.ChannelEdge) er.R) er.R {
+	for _, peer := range n.peers {
+		if err := cb(autopilot.ChannelEdge{Peer: peer}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// starGraph is a channel graph with a single center node which has a
+// channel to each of the other nodes.
+type starGraph struct {
+	nodes []*starNode
+}
+
+func newStarGraph(leaves int) *starGraph {
+	center := &starNode{pubKey: [33]byte{1}}
+	g := &starGraph{nodes: []*starNode{center}}
+	for i := 0; i < leaves; i++ {
+		leaf := &starNode{
+			pubKey: [33]byte{2, byte(i)},
+			peers:  []*starNode{center},
+		}
+		center.peers = append(center.peers, leaf)
+		g.nodes = append(g.nodes, leaf)
+	}
+	return g
+}
+
+func (g *starGraph) ForEachNode(cb func(autopilot.Node) er.R) er.R {
+	for _, node := range g.nodes {
+		if err := cb(node); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// TestGetNodeMetrics asserts that betweenness centrality is only computed once
+// it has been requested and that the cached result is returned along with the
+// time it was computed at.
+func TestGetNodeMetrics(t *testing.T) {
+	graph := newStarGraph(3)
+	server, _, err := New(&Config{
+		RouterBackend: &RouterBackend{
+			ChannelGraph: graph,
+		},
+		NodeMetricsInterval: time.Hour,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := server.Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer server.Stop()
+
+	getMetrics := func(types ...lnrpc.NodeMetricType) *GetNodeMetricsResponse {
+		t.Helper()
+
+		resp, errr := server.GetNodeMetrics(
+			context.Background(), &GetNodeMetricsRequest{
+				Types: types,
+			},
+		)
+		if errr != nil {
+			t.Fatal(errr)
+		}
+		return resp
+	}
+
+	// Without requested metrics, nothing is returned or computed.
+	resp := getMetrics()
+	if resp.ComputedAt != 0 || len(resp.BetweennessCentrality) != 0 {
+		t.Fatalf("unexpected metrics: %v", resp)
+	}
+
+	// The first request starts the computation, the result becomes
+	// available once it has finished.
+	start := time.Now().Unix()
+	centrality := lnrpc.NodeMetricType_BETWEENNESS_CENTRALITY
+	timeout := time.After(5 * time.Second)
+	for {
+		resp = getMetrics(centrality)
+		if resp.ComputedAt != 0 {
+			break
+		}
+		if len(resp.BetweennessCentrality) != 0 {
+			t.Fatalf("metrics returned without timestamp")
+		}
+
+		select {
+		case <-timeout:
+			t.Fatalf("metrics not computed")
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+
+	if resp.ComputedAt < start {
+		t.Fatalf("unexpected computation time %v", resp.ComputedAt)
+	}
+	if len(resp.BetweennessCentrality) != len(graph.nodes) {
+		t.Fatalf("expected %v nodes, got %v", len(graph.nodes),
+			len(resp.BetweennessCentrality))
+	}
+	for i, node := range graph.nodes {
+		pubKey := node.PubKey()
+		metric, ok := resp.BetweennessCentrality[hex.EncodeToString(
+			pubKey[:],
+		)]
+		if !ok {
+			t.Fatalf("node %v missing", i)
+		}
+
+		// The center is on the shortest path between each pair of
+		// leaves, the leaves aren't on any.
+		expValue, expNormalized := 0.0, 0.0
+		if i == 0 {
+			expValue, expNormalized = 3, 1
+		}
+		if metric.Value != expValue ||
+			metric.NormalizedValue != expNormalized {
+
+			t.Fatalf("node %v: unexpected centrality %v", i,
+				metric)
+		}
+	}
+
+	// Later requests return the cached result.
+	if cached := getMetrics(centrality); cached.ComputedAt != resp.ComputedAt {
+		t.Fatalf("metrics recomputed")
+	}
+}
+
+// TestListExcludedNodes asserts that the excluded nodes are listed each with
+// its own key, rather than all of them sharing the storage of one.
+func TestListExcludedNodes(t *testing.T) {
+	file, errr := ioutil.TempFile("", "*.db")
+	if errr != nil {
+		t.Fatal(errr)
+	}
+	dbPath := file.Name()
+	defer os.Remove(dbPath)
+
+	db, err := kvdb.Create(kvdb.BoltBackendName, dbPath, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	exclusions, err := routing.NewNodeExclusions(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &Server{
+		cfg: &Config{
+			RouterBackend: &RouterBackend{ExcludedNodes: exclusions},
+		},
+	}
+
+	want := make(map[route.Vertex]struct{})
+	for i := 0; i < 3; i++ {
+		priv, err := btcec.NewPrivateKey(btcec.S256())
+		if err != nil {
+			t.Fatal(err)
+		}
+		node := priv.PubKey().SerializeCompressed()
+		_, errr := s.AddExcludedNode(
+			context.Background(), &AddExcludedNodeRequest{Node: node},
+		)
+		if errr != nil {
+			t.Fatal(errr)
+		}
+		want[route.NewVertex(priv.PubKey())] = struct{}{}
+	}
+
+	resp, errr := s.ListExcludedNodes(
+		context.Background(), &ListExcludedNodesRequest{},
+	)
+	if errr != nil {
+		t.Fatal(errr)
+	}
+	if len(resp.Nodes) != len(want) {
+		t.Fatalf("expected %v excluded nodes, got %v", len(want),
+			len(resp.Nodes))
+	}
+	for _, node := range resp.Nodes {
+		vertex, err := route.NewVertexFromBytes(node)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, ok := want[vertex]; !ok {
+			t.Fatalf("unexpected or repeated excluded node %v",
+				vertex)
+		}
+		delete(want, vertex)
+	}
+}
+
+// TestBuildRouteProbe asserts that a probe route is built for a random payment
+// hash, which is returned in the response and is the one that the onion of the
+// route commits to.
+func TestBuildRouteProbe(t *testing.T) {
+	tempDir, errr := ioutil.TempDir("", "routerrpc")
+	if errr != nil {
+		t.Fatal(errr)
+	}
+	defer os.RemoveAll(tempDir)
+
+	db, err := channeldb.Open(tempDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	graph := db.ChannelGraph()
+
+	// Create our own node and a peer, with a channel between them.
+	keys := make([]*btcec.PrivateKey, 2)
+	nodes := make([]*channeldb.LightningNode, 2)
+	for i := range nodes {
+		keys[i], err = btcec.NewPrivateKey(btcec.S256())
+		if err != nil {
+			t.Fatal(err)
+		}
+		nodes[i] = &channeldb.LightningNode{
+			HaveNodeAnnouncement: true,
+			LastUpdate:           time.Unix(123, 0),
+			Alias:                "node",
+			Features:             lnwire.EmptyFeatureVector(),
+		}
+		copy(nodes[i].PubKeyBytes[:],
+			keys[i].PubKey().SerializeCompressed())
+		if err := graph.AddLightningNode(nodes[i]); err != nil {
+			t.Fatal(err)
+		}
+	}
+	self, peer := nodes[0], nodes[1]
+	if err := graph.SetSourceNode(self); err != nil {
+		t.Fatal(err)
+	}
+
+	const chanID = 1
+	capacity := btcutil.Amount(100000)
+	edge := &channeldb.ChannelEdgeInfo{
+		ChannelID:        chanID,
+		Capacity:         capacity,
+		NodeKey1Bytes:    self.PubKeyBytes,
+		BitcoinKey1Bytes: self.PubKeyBytes,
+		NodeKey2Bytes:    peer.PubKeyBytes,
+		BitcoinKey2Bytes: peer.PubKeyBytes,
+	}
+	var chanFlags lnwire.ChanUpdateChanFlags
+	if bytes.Compare(self.PubKeyBytes[:], peer.PubKeyBytes[:]) > 0 {
+		edge.NodeKey1Bytes, edge.NodeKey2Bytes =
+			edge.NodeKey2Bytes, edge.NodeKey1Bytes
+		edge.BitcoinKey1Bytes, edge.BitcoinKey2Bytes =
+			edge.BitcoinKey2Bytes, edge.BitcoinKey1Bytes
+		chanFlags = lnwire.ChanUpdateDirection
+	}
+	if err := graph.AddChannelEdge(edge); err != nil {
+		t.Fatal(err)
+	}
+	err = graph.UpdateEdgePolicy(&channeldb.ChannelEdgePolicy{
+		SigBytes:      []byte{},
+		MessageFlags:  lnwire.ChanUpdateOptionMaxHtlc,
+		ChannelFlags:  chanFlags,
+		ChannelID:     chanID,
+		LastUpdate:    time.Unix(123, 0),
+		TimeLockDelta: 40,
+		MinHTLC:       1,
+		MaxHTLC:       lnwire.NewMSatFromSatoshis(capacity),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	router, err := routing.New(routing.Config{
+		Graph: graph,
+		Chain: &mock.ChainIO{BestHeight: 100},
+		QueryBandwidth: func(*channeldb.ChannelEdgeInfo) lnwire.MilliSatoshi {
+			return lnwire.NewMSatFromSatoshis(capacity)
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	server := &Server{
+		cfg: &Config{
+			Router: router,
+			RouterBackend: &RouterBackend{
+				SelfNode: self.PubKeyBytes,
+				FetchChannelCapacity: func(uint64) (btcutil.Amount,
+					er.R) {
+
+					return capacity, nil
+				},
+			},
+		},
+	}
+
+	req := &BuildRouteRequest{
+		AmtMsat:        1000,
+		FinalCltvDelta: 40,
+		OutgoingChanId: chanID,
+		HopPubkeys:     [][]byte{peer.PubKeyBytes[:]},
+		IncludeOnion:   true,
+		Probe:          true,
+	}
+	resp, errr := server.BuildRoute(context.Background(), req)
+	if errr != nil {
+		t.Fatal(errr)
+	}
+	if !resp.ProbeOnly {
+		t.Fatal("expected a probe only route")
+	}
+	if len(resp.PaymentHash) != lntypes.HashSize {
+		t.Fatalf("expected a payment hash, got %x", resp.PaymentHash)
+	}
+
+	// The peer can only process the onion with the returned payment hash.
+	var onion sphinx.OnionPacket
+	if err := onion.Decode(bytes.NewReader(resp.Onion)); err != nil {
+		t.Fatal(err)
+	}
+	sphinxRouter := sphinx.NewRouter(
+		&keychain.PrivKeyECDH{PrivKey: keys[1]},
+		&chaincfg.SimNetParams, sphinx.NewMemoryReplayLog(),
+	)
+	if err := sphinxRouter.Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer sphinxRouter.Stop()
+
+	var otherHash lntypes.Hash
+	_, err = sphinxRouter.ProcessOnionPacket(&onion, otherHash[:], 0)
+	if !sphinx.ErrInvalidOnionHMAC.Is(err) {
+		t.Fatalf("expected invalid hmac, got %v", err)
+	}
+	_, err = sphinxRouter.ProcessOnionPacket(&onion, resp.PaymentHash, 0)
+	if err != nil {
+		t.Fatalf("unable to process onion: %v", err)
+	}
+
+	// The next probe is built for another hash, and a probe can't be built
+	// for a given hash.
+	next, errr := server.BuildRoute(context.Background(), req)
+	if errr != nil {
+		t.Fatal(errr)
+	}
+	if bytes.Equal(next.PaymentHash, resp.PaymentHash) {
+		t.Fatal("expected a new payment hash for each probe")
+	}
+	req.PaymentHash = resp.PaymentHash
+	if _, errr := server.BuildRoute(context.Background(), req); errr == nil {
+		t.Fatal("expected a probe with a payment hash to be rejected")
+	}
+}
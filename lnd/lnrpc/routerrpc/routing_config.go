@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/pkt-cash/pktd/btcutil"
+	"github.com/pkt-cash/pktd/lnd/lnwire"
 )
 
 // RoutingConfig contains the configurable parameters that control routing.
@@ -43,4 +44,21 @@ type RoutingConfig struct {
 	// MaxMcHistory defines the maximum number of payment results that
 	// are held on disk by mission control.
 	MaxMcHistory int `long:"maxmchistory" description:"the maximum number of payment results that are held on disk by mission control"`
+
+	// Estimator is the name of the probability estimator that mission
+	// control uses, apriori (the default) or bimodal.
+	Estimator string `long:"estimator" description:"The probability estimator used by mission control" choice:"apriori" choice:"bimodal"`
+
+	// BimodalScale defines how far the liquidity of channels spreads from
+	// their edges in the bimodal estimator.
+	BimodalScale lnwire.MilliSatoshi `long:"bimodalscalemsat" description:"The scale in msat of the liquidity distribution of the bimodal estimator"`
+
+	// BimodalNodeWeight defines to what extent the bimodal estimator takes
+	// the results of the other channels of a node into account.
+	BimodalNodeWeight float64 `long:"bimodalnodeweight" description:"Weight of the results of the other channels of a node in the bimodal estimator. Valid values are in [0, 1]."`
+
+	// BimodalDecayTime is the time after which the liquidity bounds that
+	// payment results set in the bimodal estimator have relaxed by a
+	// factor of e.
+	BimodalDecayTime time.Duration `long:"bimodaldecaytime" description:"The duration after which the liquidity bounds set by payment results have relaxed by a factor of e in the bimodal estimator"`
 }
@@ -31,8 +31,22 @@ func rpcHtlcEvent(htlcEvent interface{}) (*HtlcEvent, er.R) {
 		timestamp = e.Timestamp
 
 	case *htlcswitch.ForwardingFailEvent:
+		// The failure is only readable if the next hop failed the htlc
+		// as malformed, otherwise we leave the code at its zero value.
+		var failureCode lnrpc.Failure_FailureCode
+		if e.FailureMessage != nil {
+			failure := &lnrpc.Failure{}
+			err := marshalWireError(e.FailureMessage, failure)
+			if err != nil {
+				return nil, err
+			}
+			failureCode = failure.Code
+		}
+
 		event = &HtlcEvent_ForwardFailEvent{
-			ForwardFailEvent: &ForwardFailEvent{},
+			ForwardFailEvent: &ForwardFailEvent{
+				WireFailure: failureCode,
+			},
 		}
 
 		key = e.HtlcKey
@@ -62,7 +76,10 @@ func rpcHtlcEvent(htlcEvent interface{}) (*HtlcEvent, er.R) {
 
 	case *htlcswitch.SettleEvent:
 		event = &HtlcEvent_SettleEvent{
-			SettleEvent: &SettleEvent{},
+			SettleEvent: &SettleEvent{
+				Preimage: e.Preimage[:],
+				FeeMsat:  uint64(e.Fee),
+			},
 		}
 
 		key = e.HtlcKey
@@ -0,0 +1,70 @@
+package routerrpc
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/pkt-cash/pktd/lnd/htlcswitch"
+	"github.com/pkt-cash/pktd/lnd/lnrpc"
+	"github.com/pkt-cash/pktd/lnd/lntypes"
+	"github.com/pkt-cash/pktd/lnd/lnwire"
+)
+
+// TestRpcHtlcEventResolution asserts that settle events carry the preimage
+// and the earned fee, and that forwarding fail events carry the wire failure
+// code when it is readable.
+func TestRpcHtlcEventResolution(t *testing.T) {
+	preimage := lntypes.Preimage{1, 2, 3}
+
+	event, err := rpcHtlcEvent(&htlcswitch.SettleEvent{
+		Preimage:      preimage,
+		Fee:           1000,
+		HtlcEventType: htlcswitch.HtlcEventTypeForward,
+		Timestamp:     time.Now(),
+	})
+	if err != nil {
+		t.Fatalf("unable to convert settle event: %v", err)
+	}
+	settle := event.GetSettleEvent()
+	if !bytes.Equal(settle.Preimage, preimage[:]) {
+		t.Fatalf("expected preimage %v, got %x", preimage,
+			settle.Preimage)
+	}
+	if settle.FeeMsat != 1000 {
+		t.Fatalf("expected fee 1000, got %v", settle.FeeMsat)
+	}
+
+	tests := []struct {
+		name     string
+		failure  lnwire.FailureMessage
+		expected lnrpc.Failure_FailureCode
+	}{
+		{
+			name:     "encrypted failure",
+			expected: lnrpc.Failure_RESERVED,
+		},
+		{
+			name:     "malformed htlc",
+			failure:  &lnwire.FailInvalidOnionHmac{},
+			expected: lnrpc.Failure_INVALID_ONION_HMAC,
+		},
+	}
+
+	for _, test := range tests {
+		event, err := rpcHtlcEvent(&htlcswitch.ForwardingFailEvent{
+			HtlcEventType:  htlcswitch.HtlcEventTypeForward,
+			FailureMessage: test.failure,
+			Timestamp:      time.Now(),
+		})
+		if err != nil {
+			t.Fatalf("%v: unable to convert fail event: %v",
+				test.name, err)
+		}
+		code := event.GetForwardFailEvent().WireFailure
+		if code != test.expected {
+			t.Fatalf("%v: expected failure code %v, got %v",
+				test.name, test.expected, code)
+		}
+	}
+}
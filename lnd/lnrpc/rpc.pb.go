@@ -309,6 +309,13 @@ const (
 	//
 	//Insufficient local balance.
 	PaymentFailureReason_FAILURE_REASON_INSUFFICIENT_BALANCE PaymentFailureReason = 5
+	//
+	//Another attempt would have taken the fees of all attempts of the payment
+	//above its maximum total fee.
+	PaymentFailureReason_FAILURE_REASON_FEE_CAP_EXCEEDED PaymentFailureReason = 6
+	//
+	//The payment used up its maximum number of htlc attempts.
+	PaymentFailureReason_FAILURE_REASON_ATTEMPT_LIMIT PaymentFailureReason = 7
 )
 
 var PaymentFailureReason_name = map[int32]string{
@@ -318,6 +325,8 @@ var PaymentFailureReason_name = map[int32]string{
 	3: "FAILURE_REASON_ERROR",
 	4: "FAILURE_REASON_INCORRECT_PAYMENT_DETAILS",
 	5: "FAILURE_REASON_INSUFFICIENT_BALANCE",
+	6: "FAILURE_REASON_FEE_CAP_EXCEEDED",
+	7: "FAILURE_REASON_ATTEMPT_LIMIT",
 }
 
 var PaymentFailureReason_value = map[string]int32{
@@ -327,6 +336,8 @@ var PaymentFailureReason_value = map[string]int32{
 	"FAILURE_REASON_ERROR":                     3,
 	"FAILURE_REASON_INCORRECT_PAYMENT_DETAILS": 4,
 	"FAILURE_REASON_INSUFFICIENT_BALANCE":      5,
+	"FAILURE_REASON_FEE_CAP_EXCEEDED":          6,
+	"FAILURE_REASON_ATTEMPT_LIMIT":             7,
 }
 
 func (x PaymentFailureReason) String() string {
@@ -10433,11 +10444,16 @@ type Payment struct {
 	//The creation index of this payment. Each payment can be uniquely identified
 	//by this index, which may not strictly increment by 1 for payments made in
 	//older versions of lnd.
-	PaymentIndex         uint64               `protobuf:"varint,15,opt,name=payment_index,json=paymentIndex,proto3" json:"payment_index,omitempty"`
-	FailureReason        PaymentFailureReason `protobuf:"varint,16,opt,name=failure_reason,json=failureReason,proto3,enum=lnrpc.PaymentFailureReason" json:"failure_reason,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+	PaymentIndex  uint64               `protobuf:"varint,15,opt,name=payment_index,json=paymentIndex,proto3" json:"payment_index,omitempty"`
+	FailureReason PaymentFailureReason `protobuf:"varint,16,opt,name=failure_reason,json=failureReason,proto3,enum=lnrpc.PaymentFailureReason" json:"failure_reason,omitempty"`
+	//
+	//The sequence number of the last recorded transition of the payment. As the
+	//from_seq of TrackPaymentV2 is inclusive, passing this sequence number plus
+	//one replays the transitions after it.
+	TransitionSeq        uint64   `protobuf:"varint,17,opt,name=transition_seq,json=transitionSeq,proto3" json:"transition_seq,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Payment) Reset()         { *m = Payment{} }
@@ -10577,6 +10593,13 @@ func (m *Payment) GetFailureReason() PaymentFailureReason {
 	return PaymentFailureReason_FAILURE_REASON_NONE
 }
 
+func (m *Payment) GetTransitionSeq() uint64 {
+	if m != nil {
+		return m.TransitionSeq
+	}
+	return 0
+}
+
 type HTLCAttempt struct {
 	// The status of the HTLC.
 	Status HTLCAttempt_HTLCStatus `protobuf:"varint,1,opt,name=status,proto3,enum=lnrpc.HTLCAttempt_HTLCStatus" json:"status,omitempty"`
@@ -10591,7 +10614,9 @@ type HTLCAttempt struct {
 	// Detailed htlc failure info.
 	Failure *Failure `protobuf:"bytes,5,opt,name=failure,proto3" json:"failure,omitempty"`
 	// The preimage that was used to settle the HTLC.
-	Preimage             []byte   `protobuf:"bytes,6,opt,name=preimage,proto3" json:"preimage,omitempty"`
+	Preimage []byte `protobuf:"bytes,6,opt,name=preimage,proto3" json:"preimage,omitempty"`
+	// The unique ID of the attempt within the payment.
+	AttemptId            uint64   `protobuf:"varint,7,opt,name=attempt_id,json=attemptId,proto3" json:"attempt_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -10668,6 +10693,13 @@ func (m *HTLCAttempt) GetPreimage() []byte {
 	return nil
 }
 
+func (m *HTLCAttempt) GetAttemptId() uint64 {
+	if m != nil {
+		return m.AttemptId
+	}
+	return 0
+}
+
 type ListPaymentsRequest struct {
 	//
 	//If true, then return payments that have not yet fully completed. This means
@@ -13459,777 +13491,770 @@ func init() {
 func init() { proto.RegisterFile("rpc.proto", fileDescriptor_77a6da22d6a3feb1) }
 
 var fileDescriptor_77a6da22d6a3feb1 = []byte{
-	// 12316 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0xbd, 0x6b, 0x6c, 0x23, 0xc9,
-	0x76, 0x18, 0x3c, 0x7c, 0x89, 0xe4, 0xe1, 0x43, 0x54, 0xe9, 0xc5, 0xd1, 0xec, 0xec, 0xcc, 0xf6,
-	0xee, 0xdd, 0x9d, 0x3b, 0xbb, 0xab, 0x9d, 0x9d, 0xdd, 0xd9, 0xc7, 0x9d, 0xcf, 0xf7, 0x5e, 0x8a,
-	0xa2, 0x46, 0xbc, 0x23, 0x91, 0xba, 0x4d, 0x6a, 0xd7, 0x6b, 0xd8, 0x6e, 0xb7, 0xc8, 0x92, 0xd4,
-	0xdf, 0x90, 0xdd, 0xdc, 0xee, 0xa6, 0x46, 0xba, 0x1f, 0x3e, 0xc0, 0x3f, 0x1c, 0x27, 0x30, 0x8c,
-	0x00, 0x01, 0xe2, 0x20, 0x2f, 0x23, 0x2f, 0x24, 0xf9, 0x67, 0x04, 0xb0, 0x93, 0x5f, 0xf9, 0x17,
-	0x20, 0x46, 0x80, 0x3c, 0x10, 0xc4, 0x41, 0x1e, 0x30, 0x0c, 0x04, 0x48, 0x9c, 0x1f, 0x01, 0x02,
-	0x03, 0xfe, 0x9b, 0x00, 0x41, 0x9d, 0x7a, 0x74, 0x75, 0xb3, 0x35, 0x33, 0x7b, 0xbd, 0xb9, 0x7f,
-	0x24, 0xd6, 0x39, 0xa7, 0xde, 0x55, 0xa7, 0x4e, 0x9d, 0x73, 0xea, 0x34, 0x94, 0xfd, 0xd9, 0x68,
-	0x7b, 0xe6, 0x7b, 0xa1, 0x47, 0x0a, 0x13, 0xd7, 0x9f, 0x8d, 0x8c, 0x3f, 0xce, 0x40, 0xfe, 0x38,
-	0xbc, 0xf4, 0xc8, 0x23, 0xa8, 0xda, 0xe3, 0xb1, 0x4f, 0x83, 0xc0, 0x0a, 0xaf, 0x66, 0xb4, 0x99,
-	0xb9, 0x9b, 0xb9, 0x57, 0x7f, 0x48, 0xb6, 0x91, 0x6c, 0xbb, 0xc5, 0x51, 0xc3, 0xab, 0x19, 0x35,
-	0x2b, 0x76, 0x94, 0x20, 0x4d, 0x28, 0x8a, 0x64, 0x33, 0x7b, 0x37, 0x73, 0xaf, 0x6c, 0xca, 0x24,
-	0xb9, 0x0d, 0x60, 0x4f, 0xbd, 0xb9, 0x1b, 0x5a, 0x81, 0x1d, 0x36, 0x73, 0x77, 0x33, 0xf7, 0x72,
-	0x66, 0x99, 0x43, 0x06, 0x76, 0x48, 0x6e, 0x41, 0x79, 0xf6, 0xcc, 0x0a, 0x46, 0xbe, 0x33, 0x0b,
-	0x9b, 0x79, 0xcc, 0x5a, 0x9a, 0x3d, 0x1b, 0x60, 0x9a, 0xbc, 0x0b, 0x25, 0x6f, 0x1e, 0xce, 0x3c,
-	0xc7, 0x0d, 0x9b, 0x85, 0xbb, 0x99, 0x7b, 0x95, 0x87, 0xcb, 0xa2, 0x21, 0xfd, 0x79, 0x78, 0xc4,
-	0xc0, 0xa6, 0x22, 0x20, 0x6f, 0x41, 0x6d, 0xe4, 0xb9, 0xa7, 0x8e, 0x3f, 0xb5, 0x43, 0xc7, 0x73,
-	0x83, 0xe6, 0x12, 0xd6, 0x15, 0x07, 0x1a, 0xff, 0x3c, 0x0b, 0x95, 0xa1, 0x6f, 0xbb, 0x81, 0x3d,
-	0x62, 0x00, 0xb2, 0x09, 0xc5, 0xf0, 0xd2, 0x3a, 0xb7, 0x83, 0x73, 0xec, 0x6a, 0xd9, 0x5c, 0x0a,
-	0x2f, 0xf7, 0xed, 0xe0, 0x9c, 0x6c, 0xc0, 0x12, 0x6f, 0x25, 0x76, 0x28, 0x67, 0x8a, 0x14, 0x79,
-	0x17, 0x56, 0xdc, 0xf9, 0xd4, 0x8a, 0x57, 0xc5, 0xba, 0x55, 0x30, 0x1b, 0xee, 0x7c, 0xda, 0xd6,
-	0xe1, 0xac, 0xf3, 0x27, 0x13, 0x6f, 0xf4, 0x8c, 0x57, 0xc0, 0xbb, 0x57, 0x46, 0x08, 0xd6, 0xf1,
-	0x06, 0x54, 0x05, 0x9a, 0x3a, 0x67, 0xe7, 0xbc, 0x8f, 0x05, 0xb3, 0xc2, 0x09, 0x10, 0xc4, 0x4a,
-	0x08, 0x9d, 0x29, 0xb5, 0x82, 0xd0, 0x9e, 0xce, 0x44, 0x97, 0xca, 0x0c, 0x32, 0x60, 0x00, 0x44,
-	0x7b, 0xa1, 0x3d, 0xb1, 0x4e, 0x29, 0x0d, 0x9a, 0x45, 0x81, 0x66, 0x90, 0x3d, 0x4a, 0x03, 0xf2,
-	0x1d, 0xa8, 0x8f, 0x69, 0x10, 0x5a, 0x62, 0x32, 0x68, 0xd0, 0x2c, 0xdd, 0xcd, 0xdd, 0x2b, 0x9b,
-	0x35, 0x06, 0x6d, 0x49, 0x20, 0x79, 0x0d, 0xc0, 0xb7, 0x9f, 0x5b, 0x6c, 0x20, 0xe8, 0x65, 0xb3,
-	0xcc, 0x67, 0xc1, 0xb7, 0x9f, 0x0f, 0x2f, 0xf7, 0xe9, 0x25, 0x59, 0x83, 0xc2, 0xc4, 0x3e, 0xa1,
-	0x93, 0x26, 0x20, 0x82, 0x27, 0x8c, 0x5f, 0x80, 0x8d, 0x27, 0x34, 0xd4, 0x86, 0x32, 0x30, 0xe9,
-	0xd7, 0x73, 0x1a, 0x84, 0xac, 0x57, 0x41, 0x68, 0xfb, 0xa1, 0xec, 0x55, 0x86, 0xf7, 0x0a, 0x61,
-	0x51, 0xaf, 0xa8, 0x3b, 0x96, 0x04, 0x59, 0x24, 0x28, 0x53, 0x77, 0xcc, 0xd1, 0xc6, 0x01, 0x10,
-	0xad, 0xe0, 0x5d, 0x1a, 0xda, 0xce, 0x24, 0x20, 0x9f, 0x40, 0x35, 0xd4, 0xaa, 0x6b, 0x66, 0xee,
-	0xe6, 0xee, 0x55, 0xd4, 0xd2, 0xd4, 0x32, 0x98, 0x31, 0x3a, 0xe3, 0x1c, 0x4a, 0x7b, 0x94, 0x1e,
-	0x38, 0x53, 0x27, 0x24, 0x1b, 0x50, 0x38, 0x75, 0x2e, 0xe9, 0x18, 0x1b, 0x95, 0xdb, 0xbf, 0x61,
-	0xf2, 0x24, 0xb9, 0x03, 0x80, 0x3f, 0xac, 0xa9, 0x5a, 0xa5, 0xfb, 0x37, 0xcc, 0x32, 0xc2, 0x0e,
-	0x03, 0x3b, 0x24, 0x5b, 0x50, 0x9c, 0x51, 0x7f, 0x44, 0xe5, 0x7a, 0xd8, 0xbf, 0x61, 0x4a, 0xc0,
-	0x4e, 0x11, 0x0a, 0x13, 0x56, 0xba, 0xf1, 0xfb, 0x05, 0xa8, 0x0c, 0xa8, 0x3b, 0x96, 0x23, 0x41,
-	0x20, 0xcf, 0x06, 0x1a, 0x2b, 0xab, 0x9a, 0xf8, 0x9b, 0xbc, 0x09, 0x15, 0x9c, 0x92, 0x20, 0xf4,
-	0x1d, 0xf7, 0x8c, 0xef, 0x96, 0x9d, 0x6c, 0x33, 0x63, 0x02, 0x03, 0x0f, 0x10, 0x4a, 0x1a, 0x90,
-	0xb3, 0xa7, 0x72, 0xb7, 0xb0, 0x9f, 0xe4, 0x26, 0x94, 0xec, 0x69, 0xc8, 0x9b, 0x57, 0x45, 0x70,
-	0xd1, 0x9e, 0x86, 0xd8, 0xb4, 0x37, 0xa0, 0x3a, 0xb3, 0xaf, 0xa6, 0xd4, 0x0d, 0xa3, 0x65, 0x56,
-	0x35, 0x2b, 0x02, 0x86, 0x0b, 0xed, 0x21, 0xac, 0xea, 0x24, 0xb2, 0xf2, 0x82, 0xaa, 0x7c, 0x45,
-	0xa3, 0x16, 0x6d, 0x78, 0x07, 0x96, 0x65, 0x1e, 0x9f, 0xf7, 0x07, 0x97, 0x5f, 0xd9, 0xac, 0x0b,
-	0xb0, 0xec, 0xe5, 0x3d, 0x68, 0x9c, 0x3a, 0xae, 0x3d, 0xb1, 0x46, 0x93, 0xf0, 0xc2, 0x1a, 0xd3,
-	0x49, 0x68, 0xe3, 0x4a, 0x2c, 0x98, 0x75, 0x84, 0xb7, 0x27, 0xe1, 0xc5, 0x2e, 0x83, 0x92, 0xf7,
-	0xa0, 0x7c, 0x4a, 0xa9, 0x85, 0x83, 0xd5, 0x2c, 0xc5, 0x36, 0xb4, 0x9c, 0x21, 0xb3, 0x74, 0x2a,
-	0xe7, 0xea, 0x3d, 0x68, 0x78, 0xf3, 0xf0, 0xcc, 0x73, 0xdc, 0x33, 0x6b, 0x74, 0x6e, 0xbb, 0x96,
-	0x33, 0xc6, 0xb5, 0x99, 0xdf, 0xc9, 0x3e, 0xc8, 0x98, 0x75, 0x89, 0x6b, 0x9f, 0xdb, 0x6e, 0x77,
-	0x4c, 0xde, 0x86, 0xe5, 0x89, 0x1d, 0x84, 0xd6, 0xb9, 0x37, 0xb3, 0x66, 0xf3, 0x93, 0x67, 0xf4,
-	0xaa, 0x59, 0xc3, 0x81, 0xa8, 0x31, 0xf0, 0xbe, 0x37, 0x3b, 0x42, 0x20, 0x5b, 0x7a, 0xd8, 0x4e,
-	0xde, 0x08, 0xb6, 0xa4, 0x6b, 0x66, 0x99, 0x41, 0x78, 0xa5, 0x5f, 0xc1, 0x2a, 0x4e, 0xcf, 0x68,
-	0x1e, 0x84, 0xde, 0xd4, 0xf2, 0xe9, 0xc8, 0xf3, 0xc7, 0x41, 0xb3, 0x82, 0x6b, 0xed, 0xbb, 0xa2,
-	0xb1, 0xda, 0x1c, 0x6f, 0xef, 0xd2, 0x20, 0x6c, 0x23, 0xb1, 0xc9, 0x69, 0x3b, 0x6e, 0xe8, 0x5f,
-	0x99, 0x2b, 0xe3, 0x24, 0x9c, 0xbc, 0x07, 0xc4, 0x9e, 0x4c, 0xbc, 0xe7, 0x56, 0x40, 0x27, 0xa7,
-	0x96, 0x18, 0xc4, 0x66, 0xfd, 0x6e, 0xe6, 0x5e, 0xc9, 0x6c, 0x20, 0x66, 0x40, 0x27, 0xa7, 0x47,
-	0x1c, 0x4e, 0x3e, 0x01, 0xdc, 0xa4, 0xd6, 0x29, 0xb5, 0xc3, 0xb9, 0x4f, 0x83, 0xe6, 0xf2, 0xdd,
-	0xdc, 0xbd, 0xfa, 0xc3, 0x15, 0x35, 0x5e, 0x08, 0xde, 0x71, 0x42, 0xb3, 0xca, 0xe8, 0x44, 0x3a,
-	0xd8, 0xda, 0x85, 0x8d, 0xf4, 0x26, 0xb1, 0x45, 0xc5, 0x46, 0x85, 0x2d, 0xc6, 0xbc, 0xc9, 0x7e,
-	0xb2, 0x9d, 0x7d, 0x61, 0x4f, 0xe6, 0x14, 0x57, 0x61, 0xd5, 0xe4, 0x89, 0xef, 0x65, 0x3f, 0xcb,
-	0x18, 0xbf, 0x97, 0x81, 0x2a, 0xef, 0x65, 0x30, 0xf3, 0xdc, 0x80, 0x92, 0x37, 0xa1, 0x26, 0x57,
-	0x03, 0xf5, 0x7d, 0xcf, 0x17, 0xdc, 0x52, 0xae, 0xbc, 0x0e, 0x83, 0x91, 0xef, 0x42, 0x43, 0x12,
-	0xcd, 0x7c, 0xea, 0x4c, 0xed, 0x33, 0x59, 0xb4, 0x5c, 0x4a, 0x47, 0x02, 0x4c, 0x3e, 0x8c, 0xca,
-	0xf3, 0xbd, 0x79, 0x48, 0x71, 0xad, 0x57, 0x1e, 0x56, 0x45, 0xf7, 0x4c, 0x06, 0x53, 0xa5, 0x63,
-	0xea, 0x15, 0xd6, 0xb9, 0xf1, 0x5b, 0x19, 0x20, 0xac, 0xd9, 0x43, 0x8f, 0x17, 0x10, 0x71, 0xa4,
-	0x58, 0xce, 0xcc, 0x2b, 0xef, 0x90, 0xec, 0x8b, 0x76, 0x88, 0x01, 0x05, 0xde, 0xf6, 0x7c, 0x4a,
-	0xdb, 0x39, 0xea, 0x47, 0xf9, 0x52, 0xae, 0x91, 0x37, 0xfe, 0x73, 0x0e, 0xd6, 0xd8, 0x3a, 0x75,
-	0xe9, 0xa4, 0x35, 0x1a, 0xd1, 0x99, 0xda, 0x3b, 0x77, 0xa0, 0xe2, 0x7a, 0x63, 0x2a, 0x57, 0x2c,
-	0x6f, 0x18, 0x30, 0x90, 0xb6, 0x5c, 0xcf, 0x6d, 0xc7, 0xe5, 0x0d, 0xe7, 0x83, 0x59, 0x46, 0x08,
-	0x36, 0xfb, 0x6d, 0x58, 0x9e, 0x51, 0x77, 0xac, 0x6f, 0x91, 0x1c, 0x5f, 0xf5, 0x02, 0x2c, 0x76,
-	0xc7, 0x1d, 0xa8, 0x9c, 0xce, 0x39, 0x1d, 0x63, 0x2c, 0x79, 0x5c, 0x03, 0x20, 0x40, 0x2d, 0xce,
-	0x5f, 0x66, 0xf3, 0xe0, 0x1c, 0xb1, 0x05, 0xc4, 0x16, 0x59, 0x9a, 0xa1, 0x6e, 0x03, 0x8c, 0xe7,
-	0x41, 0x28, 0x76, 0xcc, 0x12, 0x22, 0xcb, 0x0c, 0xc2, 0x77, 0xcc, 0xfb, 0xb0, 0x3a, 0xb5, 0x2f,
-	0x2d, 0x5c, 0x3b, 0x96, 0xe3, 0x5a, 0xa7, 0x13, 0x64, 0xea, 0x45, 0xa4, 0x6b, 0x4c, 0xed, 0xcb,
-	0x2f, 0x18, 0xa6, 0xeb, 0xee, 0x21, 0x9c, 0xb1, 0x95, 0x11, 0x1f, 0x09, 0xcb, 0xa7, 0x01, 0xf5,
-	0x2f, 0x28, 0x72, 0x82, 0xbc, 0x59, 0x17, 0x60, 0x93, 0x43, 0x59, 0x8b, 0xa6, 0xac, 0xdf, 0xe1,
-	0x64, 0xc4, 0xb7, 0xbd, 0x59, 0x9c, 0x3a, 0xee, 0x7e, 0x38, 0x19, 0xb1, 0xf3, 0x8a, 0xf1, 0x91,
-	0x19, 0xf5, 0xad, 0x67, 0xcf, 0x71, 0x0f, 0xe7, 0x91, 0x6f, 0x1c, 0x51, 0xff, 0xe9, 0x73, 0x26,
-	0x52, 0x8c, 0x02, 0x64, 0x44, 0xf6, 0x55, 0xb3, 0x82, 0x1b, 0xbc, 0x34, 0x0a, 0x18, 0x0b, 0xb2,
-	0xaf, 0xd8, 0x26, 0x64, 0xad, 0xb5, 0x71, 0x16, 0xe8, 0x18, 0x8b, 0x0f, 0x90, 0xa3, 0xd6, 0xb0,
-	0xb1, 0x2d, 0x81, 0x60, 0xf5, 0x04, 0x6c, 0xd5, 0xcb, 0xc6, 0x9e, 0x4e, 0xec, 0xb3, 0x00, 0x59,
-	0x4a, 0xcd, 0xac, 0x0a, 0xe0, 0x1e, 0x83, 0x19, 0x7f, 0x9a, 0x85, 0xf5, 0xc4, 0xe4, 0x8a, 0x4d,
-	0xc3, 0x64, 0x08, 0x84, 0xe0, 0xc4, 0x96, 0x4c, 0x91, 0x4a, 0x9b, 0xb5, 0x6c, 0xda, 0xac, 0xad,
-	0x41, 0x81, 0x6f, 0xb6, 0x1c, 0x3f, 0x79, 0xa9, 0xdc, 0x65, 0xf3, 0xd9, 0xa9, 0xef, 0x31, 0x91,
-	0xea, 0x7c, 0x1e, 0x8e, 0xbd, 0xe7, 0xae, 0x10, 0x2d, 0x96, 0x05, 0x7c, 0x20, 0xc0, 0xf1, 0xa1,
-	0x28, 0x24, 0x86, 0xe2, 0x0e, 0x54, 0xc4, 0x0c, 0xa0, 0x68, 0xc6, 0x27, 0x16, 0x04, 0x88, 0xc9,
-	0x66, 0xef, 0x02, 0x51, 0xf3, 0x69, 0xb1, 0x51, 0xc3, 0xd3, 0x87, 0x4f, 0xec, 0xb2, 0x23, 0x26,
-	0xf4, 0xd0, 0xbe, 0xc4, 0x53, 0xe8, 0x2d, 0xa8, 0x33, 0x12, 0x36, 0x9e, 0xd6, 0x08, 0xe5, 0xa6,
-	0x12, 0x1f, 0xab, 0xa9, 0x7d, 0xc9, 0x06, 0xb3, 0x8d, 0xd2, 0xd3, 0xeb, 0x50, 0x91, 0x93, 0x6a,
-	0x39, 0xae, 0x98, 0xd7, 0xb2, 0x98, 0xd7, 0xae, 0xcb, 0xce, 0x12, 0x86, 0xe7, 0xe3, 0x64, 0x8d,
-	0xe9, 0x2c, 0x3c, 0x17, 0x3c, 0xba, 0x3e, 0x75, 0x5c, 0x3e, 0xbc, 0xbb, 0x0c, 0x6a, 0xfc, 0x76,
-	0x06, 0xaa, 0x62, 0xd4, 0x51, 0x12, 0x24, 0xdb, 0x40, 0xe4, 0x12, 0x0f, 0x2f, 0x9d, 0xb1, 0x75,
-	0x72, 0x15, 0xd2, 0x80, 0xef, 0xa8, 0xfd, 0x1b, 0x66, 0x43, 0xe0, 0x86, 0x97, 0xce, 0x78, 0x87,
-	0x61, 0xc8, 0x7d, 0x68, 0xc4, 0xe8, 0x83, 0xd0, 0xe7, 0xdb, 0x7d, 0xff, 0x86, 0x59, 0xd7, 0xa8,
-	0x07, 0xa1, 0xcf, 0x18, 0x08, 0x93, 0x33, 0xe7, 0xa1, 0xe5, 0xb8, 0x63, 0x7a, 0x89, 0xf3, 0x51,
-	0x33, 0x2b, 0x1c, 0xd6, 0x65, 0xa0, 0x9d, 0x3a, 0x54, 0xf5, 0xe2, 0x8c, 0x33, 0x28, 0x49, 0x21,
-	0x15, 0xa5, 0xb4, 0x44, 0x93, 0xcc, 0x72, 0xa8, 0x5a, 0x72, 0x13, 0x4a, 0xf1, 0x16, 0x98, 0xc5,
-	0xf0, 0x95, 0x2b, 0x36, 0xbe, 0x0f, 0x8d, 0x03, 0x36, 0x11, 0x2e, 0xdb, 0xc9, 0x42, 0xe8, 0xde,
-	0x80, 0x25, 0x8d, 0xa3, 0x94, 0x4d, 0x91, 0x62, 0x02, 0xc9, 0xb9, 0x17, 0x84, 0xa2, 0x16, 0xfc,
-	0x6d, 0xfc, 0x7e, 0x06, 0x48, 0x27, 0x08, 0x9d, 0xa9, 0x1d, 0xd2, 0x3d, 0xaa, 0x78, 0x66, 0x1f,
-	0xaa, 0xac, 0xb4, 0xa1, 0xd7, 0xe2, 0x52, 0x30, 0x97, 0xb6, 0xde, 0x15, 0x3c, 0x6e, 0x31, 0xc3,
-	0xb6, 0x4e, 0xcd, 0xcf, 0xc0, 0x58, 0x01, 0x6c, 0xb9, 0x85, 0xb6, 0x7f, 0x46, 0x43, 0x94, 0x9d,
-	0x85, 0xd0, 0x07, 0x1c, 0xc4, 0xa4, 0xe6, 0xad, 0x1f, 0xc0, 0xca, 0x42, 0x19, 0xfa, 0xa1, 0x55,
-	0x4e, 0x39, 0xb4, 0x72, 0xfa, 0xa1, 0x65, 0xc1, 0x6a, 0xac, 0x5d, 0x62, 0x17, 0x6e, 0x42, 0x91,
-	0x71, 0x0b, 0xb6, 0x76, 0x33, 0x5c, 0x94, 0x3f, 0xa5, 0xb8, 0xbe, 0x3f, 0x80, 0xb5, 0x53, 0x4a,
-	0x7d, 0x3b, 0x44, 0x24, 0xb2, 0x13, 0x36, 0x43, 0xa2, 0xe0, 0x15, 0x81, 0x1b, 0xd8, 0xe1, 0x11,
-	0xf5, 0xd9, 0x4c, 0x19, 0xff, 0x2c, 0x0b, 0xcb, 0xec, 0x78, 0x39, 0xb4, 0xdd, 0x2b, 0x39, 0x4e,
-	0x07, 0xa9, 0xe3, 0x74, 0x4f, 0x93, 0x14, 0x34, 0xea, 0x6f, 0x3a, 0x48, 0xb9, 0xe4, 0x20, 0x91,
-	0xbb, 0x50, 0x8d, 0xb5, 0xb5, 0x80, 0x6d, 0x85, 0x40, 0x35, 0x32, 0x12, 0xd7, 0x97, 0x34, 0x71,
-	0x9d, 0x71, 0x02, 0xb6, 0xb1, 0x58, 0xa9, 0x81, 0x90, 0xce, 0x18, 0x7b, 0x65, 0x65, 0x06, 0xec,
-	0x4e, 0x13, 0x30, 0xce, 0x63, 0xcd, 0x5d, 0x71, 0xaf, 0xa1, 0x63, 0xdc, 0xbe, 0x25, 0xb3, 0x81,
-	0x88, 0xe3, 0x08, 0xfe, 0x67, 0x9f, 0xa6, 0xb7, 0xa1, 0x11, 0x0d, 0x8b, 0x98, 0x23, 0x02, 0x79,
-	0xb6, 0xe4, 0x45, 0x01, 0xf8, 0xdb, 0xf8, 0x5f, 0x19, 0x4e, 0xd8, 0xf6, 0x9c, 0xe8, 0x72, 0x41,
-	0x20, 0xcf, 0x2e, 0x33, 0x92, 0x90, 0xfd, 0xbe, 0xf6, 0xaa, 0xf6, 0x2d, 0x0c, 0xe6, 0x4d, 0x28,
-	0x05, 0x6c, 0x60, 0xec, 0x09, 0x1f, 0xcf, 0x92, 0x59, 0x64, 0xe9, 0xd6, 0x64, 0x12, 0x8d, 0x73,
-	0xf1, 0xda, 0x71, 0x2e, 0xbd, 0xca, 0x38, 0x97, 0xd3, 0xc7, 0xd9, 0x78, 0x07, 0x56, 0xb4, 0xde,
-	0xbf, 0x60, 0x9c, 0x7a, 0x40, 0x0e, 0x9c, 0x20, 0x3c, 0x76, 0x59, 0x11, 0x4a, 0xb2, 0x88, 0x35,
-	0x24, 0x93, 0x68, 0x08, 0x43, 0xda, 0x97, 0x02, 0x99, 0x15, 0x48, 0xfb, 0x12, 0x91, 0xc6, 0x67,
-	0xb0, 0x1a, 0x2b, 0x4f, 0x54, 0xfd, 0x06, 0x14, 0xe6, 0xe1, 0xa5, 0x27, 0xef, 0x5d, 0x15, 0xb1,
-	0xc2, 0x8f, 0xc3, 0x4b, 0xcf, 0xe4, 0x18, 0xe3, 0x31, 0xac, 0xf4, 0xe8, 0x73, 0xc1, 0x84, 0x64,
-	0x43, 0xde, 0x86, 0xfc, 0x4b, 0x34, 0x09, 0x88, 0x37, 0xb6, 0x81, 0xe8, 0x99, 0x45, 0xad, 0x9a,
-	0x62, 0x21, 0x13, 0x53, 0x2c, 0x18, 0x6f, 0x03, 0x19, 0x38, 0x67, 0xee, 0x21, 0x0d, 0x02, 0xfb,
-	0x4c, 0xb1, 0xad, 0x06, 0xe4, 0xa6, 0xc1, 0x99, 0xe0, 0xb1, 0xec, 0xa7, 0xf1, 0x11, 0xac, 0xc6,
-	0xe8, 0x44, 0xc1, 0xaf, 0x41, 0x39, 0x70, 0xce, 0x5c, 0x94, 0x9a, 0x45, 0xd1, 0x11, 0xc0, 0xd8,
-	0x83, 0xb5, 0x2f, 0xa8, 0xef, 0x9c, 0x5e, 0xbd, 0xac, 0xf8, 0x78, 0x39, 0xd9, 0x64, 0x39, 0x1d,
-	0x58, 0x4f, 0x94, 0x23, 0xaa, 0xe7, 0xdb, 0x43, 0xcc, 0x64, 0xc9, 0xe4, 0x09, 0x8d, 0x6f, 0x67,
-	0x75, 0xbe, 0x6d, 0x78, 0x40, 0xda, 0x9e, 0xeb, 0xd2, 0x51, 0x78, 0x44, 0xa9, 0x2f, 0x1b, 0xf3,
-	0xae, 0xb6, 0x17, 0x2a, 0x0f, 0x37, 0xc5, 0xc8, 0x26, 0x0f, 0x03, 0xb1, 0x49, 0x08, 0xe4, 0x67,
-	0xd4, 0x9f, 0x62, 0xc1, 0x25, 0x13, 0x7f, 0xb3, 0xc1, 0x0d, 0x9d, 0x29, 0xf5, 0xe6, 0xfc, 0xaa,
-	0x99, 0x37, 0x65, 0xd2, 0x58, 0x87, 0xd5, 0x58, 0x85, 0xbc, 0xd5, 0xc6, 0x03, 0x58, 0xdf, 0x75,
-	0x82, 0xd1, 0x62, 0x53, 0x36, 0xa1, 0x38, 0x9b, 0x9f, 0x58, 0xf1, 0x13, 0xe7, 0x29, 0xbd, 0x32,
-	0x9a, 0xb0, 0x91, 0xcc, 0x21, 0xca, 0xfa, 0xf5, 0x2c, 0xe4, 0xf7, 0x87, 0x07, 0x6d, 0xb2, 0x05,
-	0x25, 0xc7, 0x1d, 0x79, 0x53, 0x26, 0x6f, 0xf3, 0xd1, 0x50, 0xe9, 0x6b, 0xb7, 0xf6, 0x2d, 0x28,
-	0xa3, 0x98, 0x3e, 0xf1, 0x46, 0xcf, 0x84, 0xc4, 0x5b, 0x62, 0x80, 0x03, 0x6f, 0xf4, 0x8c, 0x6d,
-	0x33, 0x7a, 0x39, 0x73, 0x7c, 0x54, 0xc2, 0x48, 0x25, 0x43, 0x9e, 0x8b, 0x78, 0x11, 0x22, 0x52,
-	0x45, 0x08, 0x69, 0x84, 0x9d, 0xaf, 0x5c, 0xf4, 0x2d, 0x9f, 0xa3, 0x34, 0x32, 0xa6, 0x97, 0xe4,
-	0x7d, 0x20, 0xa7, 0x9e, 0xff, 0xdc, 0xf6, 0x95, 0xb4, 0xe6, 0x0a, 0xd6, 0x9a, 0x37, 0x57, 0x22,
-	0x8c, 0x90, 0x44, 0xc8, 0x43, 0x58, 0xd7, 0xc8, 0xb5, 0x82, 0xb9, 0xd4, 0xb4, 0x1a, 0x21, 0xf7,
-	0x65, 0x15, 0xc6, 0xaf, 0x65, 0x81, 0x88, 0xfc, 0x6d, 0xcf, 0x0d, 0x42, 0xdf, 0x76, 0xdc, 0x30,
-	0x88, 0xcb, 0x6e, 0x99, 0x84, 0xec, 0x76, 0x0f, 0x1a, 0x28, 0x39, 0xea, 0x02, 0x5c, 0x36, 0x12,
-	0xa3, 0xcd, 0x48, 0x88, 0x7b, 0x0b, 0xea, 0x91, 0xf4, 0xae, 0x74, 0x70, 0x79, 0xb3, 0xaa, 0x24,
-	0x78, 0x71, 0x14, 0x32, 0x86, 0x20, 0xa5, 0x52, 0xa5, 0x6a, 0xe0, 0x17, 0x85, 0x95, 0xa9, 0x7d,
-	0x79, 0x44, 0xe5, 0x5d, 0x01, 0xc5, 0x3d, 0x03, 0x6a, 0x4a, 0x90, 0x43, 0x4a, 0x3e, 0x72, 0x15,
-	0x21, 0xca, 0x21, 0x4d, 0xba, 0xac, 0xbd, 0x94, 0x2e, 0x6b, 0x1b, 0xff, 0xa1, 0x0c, 0x45, 0x39,
-	0x8c, 0x28, 0x38, 0x87, 0xce, 0x05, 0x8d, 0x04, 0x67, 0x96, 0x62, 0xf2, 0xb8, 0x4f, 0xa7, 0x5e,
-	0xa8, 0x2e, 0x4c, 0x7c, 0x9b, 0x54, 0x39, 0x50, 0x5c, 0x99, 0x34, 0xa1, 0x9d, 0xab, 0x0e, 0xb9,
-	0xf4, 0x2c, 0x85, 0x76, 0x2e, 0x92, 0xdd, 0x82, 0xa2, 0x14, 0xbd, 0xf3, 0x4a, 0xa7, 0xb0, 0x34,
-	0xe2, 0x72, 0xf7, 0x16, 0x94, 0x46, 0xf6, 0xcc, 0x1e, 0x39, 0xe1, 0x95, 0x38, 0x13, 0x54, 0x9a,
-	0x95, 0x3e, 0xf1, 0x46, 0xf6, 0xc4, 0x3a, 0xb1, 0x27, 0xb6, 0x3b, 0xa2, 0x42, 0x27, 0x57, 0x45,
-	0xe0, 0x0e, 0x87, 0x91, 0xef, 0x40, 0x5d, 0xb4, 0x53, 0x52, 0x71, 0xd5, 0x9c, 0x68, 0xbd, 0x24,
-	0x63, 0x97, 0x3b, 0x6f, 0xca, 0xe6, 0xe5, 0x94, 0xf2, 0x6b, 0x50, 0xce, 0x2c, 0x73, 0xc8, 0x1e,
-	0xc5, 0xde, 0x0a, 0xf4, 0x73, 0xbe, 0x86, 0xcb, 0xbc, 0x2a, 0x0e, 0xfc, 0x92, 0xaf, 0xdf, 0xc5,
-	0xbb, 0x50, 0x4e, 0xbb, 0x0b, 0xbd, 0x0b, 0x2b, 0x73, 0x37, 0xa0, 0x61, 0x38, 0xa1, 0x63, 0xd5,
-	0x96, 0x0a, 0x12, 0x35, 0x14, 0x42, 0x36, 0x67, 0x1b, 0x56, 0xb9, 0x32, 0x31, 0xb0, 0x43, 0x2f,
-	0x38, 0x77, 0x02, 0x2b, 0xa0, 0xae, 0x54, 0x37, 0xad, 0x20, 0x6a, 0x20, 0x30, 0x03, 0xae, 0xa2,
-	0xd8, 0x4c, 0xd0, 0xfb, 0x74, 0x44, 0x9d, 0x0b, 0x3a, 0xc6, 0x7b, 0x52, 0xce, 0x5c, 0x8f, 0xe5,
-	0x31, 0x05, 0x12, 0x2f, 0xbd, 0xf3, 0xa9, 0x35, 0x9f, 0x8d, 0x6d, 0x26, 0x0f, 0xd7, 0xf9, 0xc5,
-	0xc3, 0x9d, 0x4f, 0x8f, 0x39, 0x84, 0x3c, 0x00, 0x79, 0x11, 0x12, 0x6b, 0x66, 0x39, 0x76, 0xe4,
-	0x30, 0xae, 0x61, 0x56, 0x05, 0x05, 0xbf, 0xa8, 0xdd, 0xd1, 0x37, 0x4b, 0x83, 0xad, 0x30, 0xbc,
-	0xb4, 0x47, 0x1b, 0xa6, 0x09, 0xc5, 0x99, 0xef, 0x5c, 0xd8, 0x21, 0x6d, 0xae, 0xf0, 0x73, 0x5c,
-	0x24, 0x19, 0x03, 0x77, 0x5c, 0x27, 0x74, 0xec, 0xd0, 0xf3, 0x9b, 0x04, 0x71, 0x11, 0x80, 0xdc,
-	0x87, 0x15, 0x5c, 0x27, 0x41, 0x68, 0x87, 0xf3, 0x40, 0xdc, 0x02, 0x57, 0xf9, 0x6d, 0x8b, 0x21,
-	0x06, 0x08, 0xc7, 0x8b, 0x20, 0xf9, 0x14, 0x36, 0xf8, 0xd2, 0x58, 0xd8, 0x9a, 0x6b, 0x6c, 0x38,
-	0xb0, 0x45, 0xab, 0x48, 0xd1, 0x8e, 0xef, 0xd1, 0xcf, 0x61, 0x53, 0x2c, 0x97, 0x85, 0x9c, 0xeb,
-	0x2a, 0xe7, 0x1a, 0x27, 0x49, 0x64, 0xdd, 0x86, 0x15, 0xd6, 0x34, 0x67, 0x64, 0x89, 0x12, 0xd8,
-	0xae, 0xd8, 0x60, 0xbd, 0xc0, 0x4c, 0xcb, 0x1c, 0x69, 0x22, 0xee, 0x29, 0xbd, 0x22, 0xdf, 0x87,
-	0x65, 0xbe, 0x7c, 0x50, 0xd5, 0x81, 0x07, 0xf3, 0x16, 0x1e, 0xcc, 0xeb, 0x62, 0x70, 0xdb, 0x0a,
-	0x8b, 0x67, 0x73, 0x7d, 0x14, 0x4b, 0xb3, 0xad, 0x31, 0x71, 0x4e, 0x29, 0x3b, 0x27, 0x9a, 0x9b,
-	0x7c, 0xb1, 0xc9, 0x34, 0xdb, 0xb5, 0xf3, 0x19, 0x62, 0x9a, 0x9c, 0x59, 0xf3, 0x14, 0xae, 0xe3,
-	0x89, 0x17, 0x50, 0xa9, 0x86, 0x6e, 0xde, 0x14, 0x1b, 0x92, 0x01, 0xe5, 0x95, 0x85, 0xdd, 0x89,
-	0xb9, 0x02, 0x42, 0x19, 0x0b, 0x6e, 0xe1, 0xc2, 0xa8, 0x71, 0x3d, 0x84, 0x34, 0x18, 0x30, 0xa1,
-	0xee, 0xdc, 0x7e, 0x2e, 0xd9, 0xfa, 0x6b, 0xc8, 0x4d, 0x80, 0x81, 0x04, 0x43, 0xdf, 0x83, 0x15,
-	0x31, 0x0b, 0x11, 0x33, 0x6d, 0xde, 0xc6, 0x23, 0xf2, 0xa6, 0xec, 0xe3, 0x02, 0xb7, 0x35, 0x1b,
-	0x7c, 0x5e, 0x34, 0xfe, 0xbb, 0x0f, 0x44, 0x4e, 0x8a, 0x56, 0xd0, 0xeb, 0x2f, 0x2b, 0x68, 0x45,
-	0x4c, 0x53, 0x04, 0x32, 0x7e, 0x37, 0xc3, 0x25, 0x2a, 0x41, 0x1d, 0x68, 0xca, 0x1f, 0xce, 0xd7,
-	0x2c, 0xcf, 0x9d, 0x5c, 0x09, 0x56, 0x07, 0x1c, 0xd4, 0x77, 0x27, 0xc8, 0x6b, 0x1c, 0x57, 0x27,
-	0xe1, 0x87, 0x77, 0x55, 0x02, 0x91, 0xe8, 0x0e, 0x54, 0x66, 0xf3, 0x93, 0x89, 0x33, 0xe2, 0x24,
-	0x39, 0x5e, 0x0a, 0x07, 0x21, 0xc1, 0x1b, 0x50, 0x15, 0x6b, 0x9d, 0x53, 0xe4, 0x91, 0xa2, 0x22,
-	0x60, 0x48, 0x82, 0xc2, 0x01, 0xf5, 0x91, 0xd9, 0x55, 0x4d, 0xfc, 0x6d, 0xec, 0xc0, 0x5a, 0xbc,
-	0xd1, 0x42, 0x72, 0xb9, 0x0f, 0x25, 0xc1, 0x49, 0xa5, 0x5a, 0xb4, 0x1e, 0x1f, 0x0d, 0x53, 0xe1,
-	0x8d, 0xff, 0x58, 0x80, 0x55, 0x39, 0x46, 0x6c, 0xb2, 0x07, 0xf3, 0xe9, 0xd4, 0xf6, 0x53, 0x58,
-	0x74, 0xe6, 0xc5, 0x2c, 0x3a, 0xbb, 0xc0, 0xa2, 0xe3, 0x7a, 0x31, 0xce, 0xe1, 0xe3, 0x7a, 0x31,
-	0xb6, 0xba, 0xf8, 0x6d, 0x5c, 0xb7, 0xbe, 0xd4, 0x04, 0x78, 0xc8, 0xad, 0x3c, 0x0b, 0x07, 0x4a,
-	0x21, 0xe5, 0x40, 0xd1, 0x8f, 0x83, 0xa5, 0xc4, 0x71, 0xf0, 0x06, 0xf0, 0x65, 0x2c, 0xd7, 0x63,
-	0x91, 0x5f, 0xd0, 0x11, 0x26, 0x16, 0xe4, 0x3b, 0xb0, 0x9c, 0xe4, 0xc0, 0x9c, 0xd5, 0xd7, 0x53,
-	0xf8, 0xaf, 0x33, 0xa5, 0x28, 0xd4, 0x68, 0xc4, 0x65, 0xc1, 0x7f, 0x9d, 0x29, 0x3d, 0x40, 0x8c,
-	0xa4, 0xef, 0x00, 0xf0, 0xba, 0x71, 0x1b, 0x03, 0x6e, 0xe3, 0xb7, 0x13, 0x2b, 0x53, 0x1b, 0xf5,
-	0x6d, 0x96, 0x98, 0xfb, 0x14, 0xf7, 0x75, 0x19, 0x73, 0xe2, 0x96, 0xfe, 0x14, 0xea, 0xde, 0x8c,
-	0xba, 0x56, 0xc4, 0x05, 0x2b, 0x58, 0x54, 0x43, 0x14, 0xd5, 0x95, 0x70, 0xb3, 0xc6, 0xe8, 0x54,
-	0x92, 0x7c, 0xce, 0x07, 0x99, 0x6a, 0x39, 0xab, 0xd7, 0xe4, 0xac, 0x23, 0x61, 0x94, 0xf5, 0x23,
-	0xd4, 0x3d, 0x79, 0x93, 0x39, 0x37, 0xe5, 0xd4, 0x70, 0x1d, 0x49, 0xdd, 0xb6, 0xa9, 0x30, 0xa6,
-	0x4e, 0x65, 0xfc, 0x46, 0x06, 0x2a, 0x5a, 0x1f, 0xc8, 0x3a, 0xac, 0xb4, 0xfb, 0xfd, 0xa3, 0x8e,
-	0xd9, 0x1a, 0x76, 0xbf, 0xe8, 0x58, 0xed, 0x83, 0xfe, 0xa0, 0xd3, 0xb8, 0xc1, 0xc0, 0x07, 0xfd,
-	0x76, 0xeb, 0xc0, 0xda, 0xeb, 0x9b, 0x6d, 0x09, 0xce, 0x90, 0x0d, 0x20, 0x66, 0xe7, 0xb0, 0x3f,
-	0xec, 0xc4, 0xe0, 0x59, 0xd2, 0x80, 0xea, 0x8e, 0xd9, 0x69, 0xb5, 0xf7, 0x05, 0x24, 0x47, 0xd6,
-	0xa0, 0xb1, 0x77, 0xdc, 0xdb, 0xed, 0xf6, 0x9e, 0x58, 0xed, 0x56, 0xaf, 0xdd, 0x39, 0xe8, 0xec,
-	0x36, 0xf2, 0xa4, 0x06, 0xe5, 0xd6, 0x4e, 0xab, 0xb7, 0xdb, 0xef, 0x75, 0x76, 0x1b, 0x05, 0xe3,
-	0x7f, 0x64, 0x00, 0xa2, 0x86, 0x32, 0xbe, 0x1a, 0x35, 0x55, 0x37, 0x9d, 0xae, 0x2f, 0x74, 0x8a,
-	0xf3, 0x55, 0x3f, 0x96, 0x26, 0x0f, 0xa1, 0xe8, 0xcd, 0xc3, 0x91, 0x37, 0xe5, 0x97, 0x88, 0xfa,
-	0xc3, 0xe6, 0x42, 0xbe, 0x3e, 0xc7, 0x9b, 0x92, 0x30, 0x66, 0x1e, 0xcd, 0xbd, 0xcc, 0x3c, 0x1a,
-	0xb7, 0xc3, 0x72, 0xb9, 0x4e, 0xb3, 0xc3, 0xde, 0x06, 0x08, 0x9e, 0x53, 0x3a, 0x43, 0xe5, 0x95,
-	0xd8, 0x05, 0x65, 0x84, 0x0c, 0xd9, 0x1d, 0xf3, 0x8f, 0x32, 0xb0, 0x8e, 0x6b, 0x69, 0x9c, 0x64,
-	0x62, 0x77, 0xa1, 0x32, 0xf2, 0xbc, 0x19, 0x65, 0x42, 0xb5, 0x92, 0xd7, 0x74, 0x10, 0x63, 0x50,
-	0x9c, 0x21, 0x9f, 0x7a, 0xfe, 0x88, 0x0a, 0x1e, 0x06, 0x08, 0xda, 0x63, 0x10, 0xb6, 0x87, 0xc4,
-	0x26, 0xe4, 0x14, 0x9c, 0x85, 0x55, 0x38, 0x8c, 0x93, 0x6c, 0xc0, 0xd2, 0x89, 0x4f, 0xed, 0xd1,
-	0xb9, 0xe0, 0x5e, 0x22, 0x45, 0xbe, 0x1b, 0x29, 0xf1, 0x46, 0x6c, 0x4f, 0x4c, 0x28, 0x6f, 0x7c,
-	0xc9, 0x5c, 0x16, 0xf0, 0xb6, 0x00, 0xb3, 0x73, 0xde, 0x3e, 0xb1, 0xdd, 0xb1, 0xe7, 0xd2, 0xb1,
-	0xb8, 0xcb, 0x47, 0x00, 0xe3, 0x08, 0x36, 0x92, 0xfd, 0x13, 0xfc, 0xee, 0x13, 0x8d, 0xdf, 0xf1,
-	0xab, 0xef, 0xd6, 0xf5, 0x7b, 0x4c, 0xe3, 0x7d, 0xff, 0x3a, 0x0f, 0x79, 0x76, 0xe1, 0xb9, 0xf6,
-	0x6e, 0xa4, 0xdf, 0x6d, 0x73, 0x0b, 0x46, 0x73, 0xd4, 0x15, 0x72, 0x01, 0x4c, 0x4c, 0x16, 0x42,
-	0x50, 0xf0, 0x52, 0x68, 0x9f, 0x8e, 0x2e, 0xe4, 0x9d, 0x05, 0x21, 0x26, 0x1d, 0x5d, 0xa0, 0xd2,
-	0xc2, 0x0e, 0x79, 0x5e, 0xce, 0xaf, 0x8a, 0x81, 0x1d, 0x62, 0x4e, 0x81, 0xc2, 0x7c, 0x45, 0x85,
-	0xc2, 0x5c, 0x4d, 0x28, 0x3a, 0xee, 0x89, 0x37, 0x77, 0xa5, 0xea, 0x47, 0x26, 0xd1, 0x46, 0x8f,
-	0x9c, 0x94, 0x1d, 0xed, 0x9c, 0x1b, 0x95, 0x18, 0x60, 0xc8, 0x0e, 0xf7, 0x0f, 0xa1, 0x1c, 0x5c,
-	0xb9, 0x23, 0x9d, 0x07, 0xad, 0x89, 0xf1, 0x61, 0xbd, 0xdf, 0x1e, 0x5c, 0xb9, 0x23, 0x5c, 0xf1,
-	0xa5, 0x40, 0xfc, 0x22, 0x8f, 0xa0, 0xa4, 0xac, 0x5a, 0xfc, 0x04, 0xb9, 0xa9, 0xe7, 0x90, 0xa6,
-	0x2c, 0xae, 0x1f, 0x53, 0xa4, 0xe4, 0x03, 0x58, 0x42, 0x05, 0x78, 0xd0, 0xac, 0x62, 0x26, 0x79,
-	0xe1, 0x65, 0xcd, 0x40, 0xf3, 0x38, 0x1d, 0xa3, 0x19, 0xca, 0x14, 0x64, 0x6c, 0x98, 0x4e, 0x27,
-	0xf6, 0x4c, 0xa8, 0xa3, 0x6b, 0xdc, 0xca, 0xcc, 0x20, 0x5c, 0x17, 0x7d, 0x17, 0xaa, 0x68, 0x31,
-	0x44, 0x1a, 0x97, 0xcb, 0xa1, 0x39, 0x13, 0x18, 0x6c, 0x6f, 0x62, 0xcf, 0x7a, 0xc1, 0xd6, 0x53,
-	0xa8, 0xc5, 0x1a, 0xa3, 0xab, 0xb9, 0x6a, 0x5c, 0xcd, 0xf5, 0x96, 0xae, 0xe6, 0x8a, 0x8e, 0x42,
-	0x91, 0x4d, 0x57, 0x7b, 0xfd, 0x00, 0x4a, 0x72, 0x2c, 0x18, 0xcf, 0x39, 0xee, 0x3d, 0xed, 0xf5,
-	0xbf, 0xec, 0x59, 0x83, 0xaf, 0x7a, 0xed, 0xc6, 0x0d, 0xb2, 0x0c, 0x95, 0x56, 0x1b, 0xd9, 0x18,
-	0x02, 0x32, 0x8c, 0xe4, 0xa8, 0x35, 0x18, 0x28, 0x48, 0xd6, 0xd8, 0x83, 0x46, 0xb2, 0xab, 0x6c,
-	0x51, 0x87, 0x12, 0x26, 0x2c, 0x7b, 0x11, 0x20, 0xb2, 0x1f, 0x64, 0x35, 0xfb, 0x81, 0xf1, 0x08,
-	0x1a, 0xec, 0x60, 0x67, 0x63, 0xad, 0xdb, 0xec, 0x27, 0x4c, 0xf4, 0xd6, 0xad, 0x7b, 0x25, 0xb3,
-	0xc2, 0x61, 0x58, 0x95, 0xf1, 0x09, 0xac, 0x68, 0xd9, 0x22, 0xa5, 0x10, 0x13, 0x16, 0x92, 0x4a,
-	0x21, 0xbc, 0xe8, 0x73, 0x8c, 0xb1, 0x09, 0xeb, 0x2c, 0xd9, 0xb9, 0xa0, 0x6e, 0x38, 0x98, 0x9f,
-	0x70, 0x57, 0x0f, 0xc7, 0x73, 0x8d, 0x5f, 0xcb, 0x40, 0x59, 0x61, 0xae, 0xdf, 0x25, 0xdb, 0x42,
-	0x7f, 0xc4, 0xd9, 0xe2, 0x96, 0x56, 0x03, 0x66, 0xdc, 0xc6, 0xbf, 0x31, 0x3d, 0x52, 0x59, 0x81,
-	0xd8, 0xb0, 0x1e, 0x75, 0x3a, 0xa6, 0xd5, 0xef, 0x1d, 0x74, 0x7b, 0xec, 0x70, 0x60, 0xc3, 0x8a,
-	0x80, 0xbd, 0x3d, 0x84, 0x64, 0x8c, 0x06, 0xd4, 0x9f, 0xd0, 0xb0, 0xeb, 0x9e, 0x7a, 0x62, 0x30,
-	0x8c, 0x3f, 0xbf, 0x04, 0xcb, 0x0a, 0x14, 0xe9, 0xa1, 0x2e, 0xa8, 0x1f, 0x38, 0x9e, 0x8b, 0xeb,
-	0xa4, 0x6c, 0xca, 0x24, 0x63, 0x6f, 0xe2, 0x96, 0x86, 0x62, 0xc6, 0x1a, 0x62, 0xc5, 0xbd, 0x0e,
-	0x65, 0x8c, 0x77, 0x60, 0xd9, 0x19, 0x53, 0x37, 0x74, 0xc2, 0x2b, 0x2b, 0xa6, 0x95, 0xaf, 0x4b,
-	0xb0, 0x90, 0x33, 0xd6, 0xa0, 0x60, 0x4f, 0x1c, 0x5b, 0xba, 0xd0, 0xf0, 0x04, 0x83, 0x8e, 0xbc,
-	0x89, 0xe7, 0xe3, 0xbd, 0xa5, 0x6c, 0xf2, 0x04, 0x79, 0x00, 0x6b, 0xec, 0x0e, 0xa5, 0x9b, 0x91,
-	0x90, 0x43, 0x71, 0x03, 0x01, 0x71, 0xe7, 0xd3, 0xa3, 0xc8, 0x94, 0xc4, 0x30, 0x4c, 0xba, 0x60,
-	0x39, 0x84, 0x38, 0xa9, 0x32, 0x70, 0xbd, 0xc8, 0x8a, 0x3b, 0x9f, 0xb6, 0x10, 0xa3, 0xe8, 0x1f,
-	0xc2, 0x3a, 0xa3, 0x57, 0x02, 0xa8, 0xca, 0xb1, 0x8c, 0x39, 0x58, 0x61, 0x5d, 0x81, 0x53, 0x79,
-	0x6e, 0x41, 0x99, 0xb7, 0x8a, 0x2d, 0x09, 0x61, 0x6f, 0xc2, 0xa6, 0x50, 0x3f, 0x58, 0xf0, 0x76,
-	0xe1, 0x8a, 0x80, 0xa4, 0xb7, 0x8b, 0xe6, 0x2f, 0x53, 0x4a, 0xfa, 0xcb, 0x3c, 0x84, 0xf5, 0x13,
-	0xb6, 0x46, 0xcf, 0xa9, 0x3d, 0xa6, 0xbe, 0x15, 0xad, 0x7c, 0x7e, 0xdd, 0x5c, 0x65, 0xc8, 0x7d,
-	0xc4, 0xa9, 0x8d, 0xc2, 0x24, 0x41, 0xc6, 0x78, 0xe8, 0xd8, 0x0a, 0x3d, 0x0b, 0x05, 0x44, 0xa1,
-	0x71, 0xad, 0x71, 0xf0, 0xd0, 0x6b, 0x33, 0x60, 0x9c, 0xee, 0xcc, 0xb7, 0x67, 0xe7, 0xe2, 0x32,
-	0xa8, 0xe8, 0x9e, 0x30, 0x20, 0x79, 0x0d, 0x8a, 0x6c, 0x4f, 0xb8, 0x94, 0x3b, 0x0f, 0xf0, 0x6b,
-	0x96, 0x04, 0x91, 0xb7, 0x60, 0x09, 0xeb, 0x08, 0x9a, 0x0d, 0xdc, 0x10, 0xd5, 0xe8, 0xa8, 0x70,
-	0x5c, 0x53, 0xe0, 0x98, 0xb8, 0x3d, 0xf7, 0x1d, 0xce, 0xc7, 0xca, 0x26, 0xfe, 0x26, 0x3f, 0xd4,
-	0x98, 0xe2, 0x2a, 0xe6, 0x7d, 0x4b, 0xe4, 0x4d, 0x2c, 0xc5, 0xeb, 0xf8, 0xe3, 0xb7, 0xca, 0xad,
-	0x7e, 0x94, 0x2f, 0x55, 0x1a, 0x55, 0xa3, 0x89, 0x4e, 0x3e, 0x26, 0x1d, 0x79, 0x17, 0xd4, 0xbf,
-	0x8a, 0xed, 0x91, 0x0c, 0x6c, 0x2e, 0xa0, 0x22, 0x5f, 0x01, 0x5f, 0xc0, 0xad, 0xa9, 0x37, 0x96,
-	0x42, 0x41, 0x55, 0x02, 0x0f, 0xbd, 0x31, 0x13, 0x5e, 0x56, 0x14, 0xd1, 0xa9, 0xe3, 0x3a, 0xc1,
-	0x39, 0x1d, 0x0b, 0xd9, 0xa0, 0x21, 0x11, 0x7b, 0x02, 0xce, 0x24, 0xf0, 0x99, 0xef, 0x9d, 0xa9,
-	0xa3, 0x32, 0x63, 0xaa, 0xb4, 0xf1, 0x29, 0x14, 0xf8, 0x0c, 0xb2, 0x8d, 0x82, 0xf3, 0x9b, 0x11,
-	0x1b, 0x05, 0xa1, 0x4d, 0x28, 0xba, 0x34, 0x7c, 0xee, 0xf9, 0xcf, 0xa4, 0x6d, 0x4d, 0x24, 0x8d,
-	0x9f, 0xa0, 0x52, 0x55, 0x79, 0x6b, 0x71, 0xe5, 0x03, 0x5b, 0xc2, 0x7c, 0x09, 0x06, 0xe7, 0xb6,
-	0xd0, 0xf3, 0x96, 0x10, 0x30, 0x38, 0xb7, 0x17, 0x96, 0x70, 0x76, 0xd1, 0x61, 0xeb, 0x2d, 0xa8,
-	0x4b, 0xff, 0xb0, 0xc0, 0x9a, 0xd0, 0xd3, 0x50, 0x6c, 0xc9, 0xaa, 0x70, 0x0e, 0x0b, 0x0e, 0xe8,
-	0x69, 0x68, 0x1c, 0xc2, 0x8a, 0xd8, 0x34, 0xfd, 0x19, 0x95, 0x55, 0x7f, 0x96, 0x76, 0x2b, 0xaa,
-	0x3c, 0x5c, 0x8d, 0x8b, 0x1b, 0x5c, 0xb0, 0x8b, 0x5d, 0x95, 0x8c, 0x1f, 0x47, 0x1a, 0x44, 0x26,
-	0x8c, 0x88, 0xf2, 0xc4, 0xdd, 0x44, 0x9a, 0x24, 0xa5, 0xdb, 0x83, 0xba, 0x01, 0x39, 0x63, 0x36,
-	0x3a, 0xc1, 0x7c, 0x34, 0x92, 0x7e, 0x7b, 0x25, 0x53, 0x26, 0x8d, 0x7f, 0x97, 0x81, 0x55, 0x2c,
-	0x4c, 0xde, 0xea, 0xc4, 0x49, 0xf1, 0x53, 0x37, 0x92, 0xcd, 0x8f, 0x2e, 0x01, 0xf2, 0xc4, 0x37,
-	0x37, 0xd2, 0xe4, 0x17, 0x8c, 0x34, 0xdf, 0x85, 0xc6, 0x98, 0x4e, 0x1c, 0x5c, 0x4a, 0x52, 0xa0,
-	0xe2, 0x12, 0xec, 0xb2, 0x84, 0x0b, 0x2d, 0x83, 0xf1, 0x57, 0x32, 0xb0, 0xc2, 0xe5, 0x35, 0xd4,
-	0xdb, 0x88, 0x81, 0x7a, 0x2c, 0x15, 0x14, 0x82, 0x9d, 0x8a, 0x3e, 0x45, 0x72, 0x0c, 0x42, 0x39,
-	0xf1, 0xfe, 0x0d, 0xa1, 0xb8, 0x10, 0x50, 0xf2, 0x3d, 0xbc, 0x89, 0xba, 0x16, 0x02, 0x85, 0x1c,
-	0x7e, 0x33, 0x45, 0x42, 0x54, 0xd9, 0xd9, 0x35, 0xd5, 0x45, 0xd0, 0x4e, 0x09, 0x96, 0xb8, 0x16,
-	0xcc, 0xd8, 0x83, 0x5a, 0xac, 0x9a, 0x98, 0xa5, 0xa7, 0xca, 0x2d, 0x3d, 0x0b, 0xd6, 0xe0, 0xec,
-	0xa2, 0x35, 0xf8, 0x0a, 0x56, 0x4d, 0x6a, 0x8f, 0xaf, 0xf6, 0x3c, 0xff, 0x28, 0x38, 0x09, 0xf7,
-	0xb8, 0x10, 0xcc, 0xce, 0x20, 0xe5, 0xff, 0x11, 0x33, 0xa7, 0x48, 0x4b, 0xb7, 0x54, 0xc3, 0x7c,
-	0x07, 0xea, 0x91, 0xa3, 0x88, 0xa6, 0x78, 0xaf, 0x29, 0x5f, 0x11, 0x94, 0x9d, 0x08, 0xe4, 0x67,
-	0xc1, 0x49, 0x28, 0x54, 0xef, 0xf8, 0xdb, 0xf8, 0xab, 0x05, 0x20, 0x6c, 0x35, 0x27, 0x16, 0x4c,
-	0xc2, 0xc5, 0x25, 0xbb, 0xe0, 0xe2, 0xf2, 0x00, 0x88, 0x46, 0x20, 0x3d, 0x6f, 0x72, 0xca, 0xf3,
-	0xa6, 0x11, 0xd1, 0x0a, 0xc7, 0x9b, 0x07, 0xb0, 0x26, 0x6e, 0x14, 0xf1, 0xa6, 0xf2, 0xa5, 0x41,
-	0xf8, 0xd5, 0x22, 0xd6, 0x5e, 0xe9, 0xde, 0x22, 0x35, 0xd5, 0x39, 0xee, 0xde, 0x22, 0x15, 0x4a,
-	0xda, 0x02, 0x5c, 0x7a, 0xe9, 0x02, 0x2c, 0x2e, 0x2c, 0x40, 0x4d, 0xb9, 0x58, 0x8a, 0x2b, 0x17,
-	0x17, 0xd4, 0xe4, 0x5c, 0x7c, 0x8e, 0xa9, 0xc9, 0xef, 0x41, 0x43, 0x2a, 0x9a, 0x94, 0x0a, 0x53,
-	0xf8, 0x3c, 0x08, 0x5d, 0x92, 0x54, 0x62, 0xc6, 0x6c, 0x7a, 0x95, 0x57, 0x31, 0x2e, 0x56, 0xd3,
-	0x8d, 0x8b, 0x8b, 0x2a, 0xb9, 0x5a, 0x8a, 0x4a, 0xee, 0x51, 0xe4, 0xd2, 0x10, 0x9c, 0x3b, 0x53,
-	0x14, 0x7c, 0x22, 0x87, 0x4b, 0x31, 0xc0, 0x83, 0x73, 0x67, 0x6a, 0x4a, 0xe7, 0x22, 0x96, 0x20,
-	0x6d, 0xb8, 0x23, 0xfa, 0x93, 0xe2, 0x17, 0xc4, 0x47, 0x61, 0x19, 0x25, 0xd5, 0x2d, 0x4e, 0x76,
-	0x98, 0x70, 0x11, 0x4a, 0x0c, 0x8a, 0xf4, 0x2a, 0x09, 0xb8, 0x5e, 0x57, 0x0e, 0xca, 0x21, 0x77,
-	0x2b, 0x09, 0x70, 0x88, 0xed, 0x4b, 0x4b, 0xe8, 0xfc, 0x82, 0x0b, 0x94, 0x93, 0x6a, 0x66, 0x65,
-	0x6a, 0x5f, 0x1e, 0xa0, 0x4e, 0x2f, 0xb8, 0x30, 0xfe, 0x34, 0x03, 0x0d, 0xb6, 0x34, 0x63, 0xbb,
-	0xfe, 0x73, 0x40, 0xfe, 0xf4, 0x8a, 0x9b, 0xbe, 0xc2, 0x68, 0xe5, 0x9e, 0xff, 0x14, 0x70, 0x13,
-	0x5b, 0xde, 0x8c, 0xba, 0x62, 0xcb, 0x37, 0xe3, 0x5b, 0x3e, 0x62, 0xeb, 0xfb, 0x37, 0xf8, 0xa5,
-	0x90, 0x41, 0xc8, 0xe7, 0x50, 0x66, 0x7b, 0x05, 0x17, 0xae, 0x70, 0x69, 0xde, 0x52, 0x17, 0xfd,
-	0x85, 0x6d, 0xcb, 0xb2, 0xce, 0x44, 0x32, 0xcd, 0x69, 0x28, 0x9f, 0xe2, 0x34, 0xa4, 0xf1, 0x94,
-	0x7d, 0x80, 0xa7, 0xf4, 0x8a, 0x0d, 0x42, 0xe8, 0xf9, 0x4c, 0xb6, 0x62, 0xdb, 0xeb, 0xd4, 0x9e,
-	0x3a, 0x42, 0xd9, 0x58, 0x30, 0xcb, 0xcf, 0xe8, 0xd5, 0x1e, 0x02, 0xd8, 0xda, 0x62, 0xe8, 0x88,
-	0xb1, 0x14, 0xcc, 0xd2, 0x33, 0x7a, 0xc5, 0xb9, 0x8a, 0x05, 0xb5, 0xa7, 0xf4, 0x6a, 0x97, 0x72,
-	0xe1, 0xdd, 0xf3, 0xd9, 0xa0, 0xfb, 0xf6, 0x73, 0x26, 0xad, 0xc7, 0x9c, 0x5a, 0x2a, 0xbe, 0xfd,
-	0xfc, 0x29, 0xbd, 0x92, 0x0e, 0x36, 0x45, 0x86, 0x9f, 0x78, 0x23, 0x21, 0x6e, 0x48, 0xfd, 0x4e,
-	0xd4, 0x28, 0x73, 0xe9, 0x19, 0xfe, 0x36, 0xfe, 0x24, 0x03, 0x35, 0xd6, 0x7e, 0x3c, 0x29, 0x70,
-	0x15, 0x09, 0x17, 0xd8, 0x4c, 0xe4, 0x02, 0xfb, 0x50, 0x30, 0x5a, 0x7e, 0xec, 0x64, 0xaf, 0x3f,
-	0x76, 0x70, 0x6e, 0xf8, 0x99, 0xf3, 0x21, 0x94, 0xf9, 0xc2, 0x60, 0xac, 0x27, 0x17, 0x9b, 0xe0,
-	0x58, 0x87, 0xcc, 0x12, 0x92, 0x3d, 0xe5, 0x1e, 0x77, 0x9a, 0x2a, 0x9d, 0x0f, 0x71, 0xd9, 0x57,
-	0x0a, 0xf4, 0x94, 0x69, 0x28, 0x5c, 0xe3, 0x71, 0xa7, 0xeb, 0xa9, 0x97, 0x92, 0x7a, 0x6a, 0xc3,
-	0x85, 0x12, 0x9b, 0x6a, 0xec, 0x6c, 0x4a, 0xa1, 0x99, 0xb4, 0x42, 0x99, 0x70, 0x62, 0xb3, 0x73,
-	0x8a, 0xf1, 0xde, 0xac, 0x10, 0x4e, 0xec, 0x80, 0xb2, 0x82, 0x58, 0xc3, 0x5d, 0xcf, 0x42, 0xc5,
-	0xaf, 0x50, 0x89, 0x96, 0xcc, 0xb2, 0xeb, 0x1d, 0x71, 0x80, 0xf1, 0xe7, 0x32, 0x50, 0xd1, 0xf6,
-	0x2c, 0x5a, 0x02, 0xd4, 0x70, 0xf2, 0x0d, 0x1e, 0xdf, 0x01, 0xb1, 0xf9, 0xd8, 0xbf, 0x61, 0xd6,
-	0x46, 0xb1, 0x09, 0xda, 0x16, 0x4b, 0x19, 0x73, 0x66, 0x63, 0xea, 0x27, 0xd9, 0x2f, 0xb9, 0x7e,
-	0xd9, 0xef, 0x9d, 0x25, 0xc8, 0x33, 0x52, 0xe3, 0x31, 0xac, 0x68, 0xcd, 0xe0, 0xea, 0x99, 0x57,
-	0x1d, 0x00, 0xe3, 0x17, 0x55, 0x66, 0x56, 0x07, 0x37, 0xad, 0x4b, 0xe7, 0x46, 0x3a, 0xe6, 0xe3,
-	0x22, 0x9c, 0x28, 0x39, 0x08, 0x47, 0xe6, 0x15, 0xfd, 0xed, 0x8c, 0x5f, 0xcd, 0xc0, 0xaa, 0x56,
-	0xfc, 0x9e, 0xe3, 0xda, 0x13, 0xe7, 0x27, 0x28, 0xa3, 0x04, 0xce, 0x99, 0x9b, 0xa8, 0x80, 0x83,
-	0xbe, 0x49, 0x05, 0xec, 0x28, 0xe1, 0xae, 0xd2, 0xdc, 0xdd, 0x5e, 0x1c, 0x9f, 0x80, 0x30, 0xd3,
-	0x7e, 0x3e, 0xbc, 0x34, 0xfe, 0x5a, 0x16, 0xd6, 0x44, 0x13, 0xd0, 0xa3, 0xdd, 0x61, 0xa2, 0xe9,
-	0x61, 0x70, 0x46, 0x3e, 0x87, 0x1a, 0x1b, 0x3e, 0xcb, 0xa7, 0x67, 0x4e, 0x10, 0x52, 0x69, 0xf5,
-	0x4f, 0xe1, 0xc6, 0x4c, 0x42, 0x61, 0xa4, 0xa6, 0xa0, 0x24, 0x8f, 0xa1, 0x82, 0x59, 0xb9, 0x86,
-	0x4c, 0xcc, 0x55, 0x73, 0x31, 0x23, 0x9f, 0x8b, 0xfd, 0x1b, 0x26, 0x04, 0xd1, 0xcc, 0x3c, 0x86,
-	0x0a, 0x4e, 0xf3, 0x05, 0x8e, 0x75, 0x82, 0xd9, 0x2d, 0xcc, 0x05, 0xcb, 0x3c, 0x8b, 0x66, 0xa6,
-	0x05, 0x35, 0xce, 0xee, 0xc4, 0x48, 0x0a, 0x4f, 0xd9, 0xad, 0xc5, 0xec, 0x72, 0xac, 0x59, 0xe3,
-	0x67, 0x5a, 0x7a, 0xa7, 0x0c, 0xc5, 0xd0, 0x77, 0xce, 0xce, 0xa8, 0x6f, 0x6c, 0xa8, 0xa1, 0x61,
-	0x7c, 0x9c, 0x0e, 0x42, 0x3a, 0x63, 0x77, 0x0e, 0xe3, 0x5f, 0x66, 0xa0, 0x22, 0x38, 0xf3, 0x4f,
-	0xed, 0x50, 0xb0, 0x95, 0xd0, 0xa5, 0x96, 0x35, 0xd5, 0xe9, 0x3b, 0xb0, 0x3c, 0x65, 0x17, 0x24,
-	0x76, 0x81, 0x8f, 0x79, 0x13, 0xd4, 0x25, 0x58, 0xc8, 0xfe, 0xdb, 0xb0, 0x8a, 0x57, 0x81, 0xc0,
-	0x0a, 0x9d, 0x89, 0x25, 0x91, 0xe2, 0x59, 0xc7, 0x0a, 0x47, 0x0d, 0x9d, 0xc9, 0xa1, 0x40, 0x30,
-	0x89, 0x38, 0x08, 0xed, 0x33, 0x2a, 0xb8, 0x03, 0x4f, 0xb0, 0x4b, 0x57, 0xe2, 0xee, 0x2e, 0x2f,
-	0x5d, 0xff, 0x7b, 0x05, 0x36, 0x17, 0x50, 0xe2, 0xd2, 0xa5, 0x8c, 0xb7, 0x13, 0x67, 0x7a, 0xe2,
-	0x29, 0xe3, 0x41, 0x46, 0x33, 0xde, 0x1e, 0x30, 0x8c, 0x34, 0x1e, 0x50, 0x58, 0x97, 0x4b, 0x16,
-	0xb5, 0xff, 0xea, 0x7a, 0x9f, 0xc5, 0xcb, 0xe7, 0x87, 0xf1, 0x63, 0x30, 0x59, 0x9d, 0x84, 0xeb,
-	0xf2, 0xde, 0xea, 0x6c, 0x01, 0x16, 0x90, 0xff, 0x17, 0x9a, 0x6a, 0x67, 0x88, 0xbb, 0x88, 0xa6,
-	0xab, 0x60, 0x35, 0xbd, 0xf7, 0x92, 0x9a, 0x62, 0x6a, 0x59, 0x14, 0x08, 0x37, 0xe4, 0xa6, 0xe2,
-	0x05, 0xaa, 0xba, 0x2e, 0xe0, 0x75, 0x59, 0x17, 0xde, 0x2d, 0x16, 0x6b, 0xcc, 0xbf, 0x52, 0xdf,
-	0x50, 0xe5, 0x1c, 0xab, 0xd6, 0xbc, 0x25, 0x0a, 0x56, 0x28, 0xbd, 0xde, 0x73, 0xd8, 0x78, 0x6e,
-	0x3b, 0xa1, 0xec, 0xa3, 0xa6, 0x2a, 0x29, 0x60, 0x7d, 0x0f, 0x5f, 0x52, 0xdf, 0x97, 0x3c, 0x73,
-	0xec, 0xb6, 0xb5, 0xf6, 0x7c, 0x11, 0x18, 0x6c, 0xfd, 0x9d, 0x1c, 0xd4, 0xe3, 0xa5, 0x30, 0xd6,
-	0x23, 0x8e, 0x2b, 0x29, 0x44, 0x0b, 0xc9, 0x5e, 0x18, 0xb6, 0x7a, 0x5c, 0x78, 0x5e, 0x34, 0xb9,
-	0x65, 0x53, 0x4c, 0x6e, 0xba, 0xa5, 0x2b, 0xf7, 0x32, 0xc7, 0x87, 0xfc, 0x2b, 0x39, 0x3e, 0x14,
-	0xd2, 0x1c, 0x1f, 0x3e, 0xba, 0xd6, 0x52, 0xce, 0xf5, 0xd5, 0xa9, 0x56, 0xf2, 0x47, 0xd7, 0x5b,
-	0xc9, 0xb9, 0x48, 0x7e, 0x9d, 0x85, 0x5c, 0xb3, 0xef, 0x97, 0xae, 0xb1, 0x4f, 0x69, 0x16, 0xff,
-	0x14, 0x0b, 0x79, 0xf9, 0x1b, 0x58, 0xc8, 0xb7, 0xfe, 0x24, 0x03, 0x64, 0x71, 0x77, 0x90, 0x27,
-	0xdc, 0x9a, 0xe9, 0xd2, 0x89, 0xe0, 0xdc, 0xef, 0xbf, 0xda, 0x0e, 0x93, 0x0b, 0x42, 0xe6, 0x26,
-	0x1f, 0xc0, 0xaa, 0xfe, 0xf8, 0x4c, 0x57, 0x45, 0xd4, 0x4c, 0xa2, 0xa3, 0x22, 0xa5, 0x9a, 0xe6,
-	0x65, 0x92, 0x7f, 0xa9, 0x97, 0x49, 0xe1, 0xa5, 0x5e, 0x26, 0x4b, 0x71, 0x2f, 0x93, 0xad, 0x7f,
-	0x9b, 0x81, 0xd5, 0x94, 0x45, 0xfc, 0xed, 0xf5, 0x99, 0xad, 0xbd, 0x18, 0x5b, 0xcb, 0x8a, 0xb5,
-	0xa7, 0x73, 0xb4, 0x03, 0xa9, 0x88, 0x65, 0x53, 0x11, 0x88, 0x93, 0xea, 0xfe, 0xcb, 0xb8, 0x4b,
-	0x94, 0xc3, 0xd4, 0xb3, 0x6f, 0xfd, 0xbd, 0x2c, 0x54, 0x34, 0x24, 0x1b, 0x45, 0xbe, 0x64, 0x35,
-	0xff, 0x4b, 0x2e, 0x5b, 0xa2, 0x22, 0x05, 0x9d, 0xe9, 0x71, 0x71, 0x22, 0x9e, 0x6f, 0x2e, 0x21,
-	0x48, 0x22, 0xc1, 0x36, 0xac, 0x4a, 0x4b, 0x33, 0x8d, 0xdc, 0xc4, 0xc5, 0x59, 0x23, 0x9c, 0x06,
-	0x44, 0x23, 0x91, 0xfe, 0x03, 0x79, 0xc7, 0x8d, 0xe6, 0x4e, 0xb3, 0xdc, 0xad, 0x08, 0x77, 0x05,
-	0x31, 0x89, 0x6c, 0x9d, 0x7f, 0x08, 0xeb, 0xca, 0x5f, 0x21, 0x96, 0x83, 0xdb, 0x87, 0x88, 0xf4,
-	0x4b, 0xd0, 0xb2, 0xfc, 0x10, 0x6e, 0x27, 0xda, 0x94, 0xc8, 0xca, 0xfd, 0xdc, 0x6e, 0xc6, 0x5a,
-	0xa7, 0x97, 0xb0, 0xf5, 0xff, 0x41, 0x2d, 0xc6, 0x28, 0xbf, 0xbd, 0x29, 0x4f, 0x2a, 0xaf, 0xf8,
-	0x88, 0xea, 0xca, 0xab, 0xad, 0xff, 0x99, 0x03, 0xb2, 0xc8, 0xab, 0x7f, 0x96, 0x4d, 0x58, 0x5c,
-	0x98, 0xb9, 0x94, 0x85, 0xf9, 0x7f, 0x4d, 0x7e, 0x88, 0x74, 0xa8, 0x9a, 0xbb, 0x00, 0xdf, 0x9c,
-	0x0d, 0x85, 0x90, 0xad, 0xf8, 0x34, 0xe9, 0x54, 0x55, 0x8a, 0xbd, 0x9f, 0xd4, 0x04, 0xa8, 0x84,
-	0x6f, 0xd5, 0x31, 0x2c, 0xd9, 0xee, 0xe8, 0xdc, 0xf3, 0x05, 0x1f, 0xfc, 0xb9, 0x6f, 0x7c, 0x7c,
-	0x6e, 0xb7, 0x30, 0x3f, 0x4a, 0x6d, 0xa6, 0x28, 0xcc, 0xf8, 0x10, 0x2a, 0x1a, 0x98, 0x94, 0xa1,
-	0x70, 0xd0, 0x3d, 0xdc, 0xe9, 0x37, 0x6e, 0x90, 0x1a, 0x94, 0xcd, 0x4e, 0xbb, 0xff, 0x45, 0xc7,
-	0xec, 0xec, 0x36, 0x32, 0xa4, 0x04, 0xf9, 0x83, 0xfe, 0x60, 0xd8, 0xc8, 0x1a, 0x5b, 0xd0, 0x14,
-	0x25, 0x2e, 0x5a, 0x93, 0x7e, 0x2b, 0xaf, 0x74, 0xa0, 0x88, 0x14, 0x97, 0xfc, 0x8f, 0xa0, 0xaa,
-	0x8b, 0x37, 0x62, 0x45, 0x24, 0x3c, 0x56, 0xd8, 0xf5, 0xde, 0xd3, 0x78, 0x75, 0x1b, 0xb8, 0xbf,
-	0xc2, 0x58, 0x65, 0xcb, 0xc6, 0xe4, 0xd6, 0x14, 0xc3, 0x2f, 0xde, 0x8f, 0x62, 0xcb, 0xf0, 0xff,
-	0x81, 0x7a, 0xdc, 0x72, 0x22, 0x38, 0x52, 0xda, 0x95, 0x95, 0xe5, 0x8e, 0x99, 0x52, 0xc8, 0x0f,
-	0xa1, 0x91, 0xb4, 0xbc, 0x08, 0xe1, 0xf9, 0x9a, 0xfc, 0xcb, 0x4e, 0xdc, 0x18, 0x43, 0xf6, 0x61,
-	0x2d, 0x4d, 0xc0, 0xc3, 0xf5, 0x71, 0xbd, 0x9a, 0x83, 0x2c, 0x0a, 0x71, 0xe4, 0x33, 0x61, 0x81,
-	0x2b, 0xe0, 0xf4, 0xbf, 0x15, 0xaf, 0x5f, 0x1b, 0xec, 0x6d, 0xfe, 0x4f, 0xb3, 0xc5, 0x5d, 0x00,
-	0x44, 0x30, 0xd2, 0x80, 0x6a, 0xff, 0xa8, 0xd3, 0xb3, 0xda, 0xfb, 0xad, 0x5e, 0xaf, 0x73, 0xd0,
-	0xb8, 0x41, 0x08, 0xd4, 0xd1, 0xe9, 0x62, 0x57, 0xc1, 0x32, 0x0c, 0x26, 0x2c, 0xa1, 0x12, 0x96,
-	0x25, 0x6b, 0xd0, 0xe8, 0xf6, 0x12, 0xd0, 0x1c, 0x69, 0xc2, 0xda, 0x51, 0x87, 0xfb, 0x69, 0xc4,
-	0xca, 0xcd, 0xb3, 0x4b, 0x83, 0xe8, 0x2e, 0xbb, 0x34, 0x7c, 0x69, 0x4f, 0x26, 0x34, 0x14, 0xfb,
-	0x40, 0xca, 0xd2, 0x7f, 0x3d, 0x03, 0xeb, 0x09, 0x44, 0x64, 0xbe, 0xe0, 0x92, 0x74, 0x5c, 0x86,
-	0xae, 0x22, 0x50, 0xee, 0xa6, 0x77, 0x61, 0x45, 0x69, 0xd3, 0x12, 0xa7, 0x52, 0x43, 0x21, 0x24,
-	0xf1, 0x07, 0xb0, 0xaa, 0x29, 0xe5, 0x12, 0xbc, 0x82, 0x68, 0x28, 0x91, 0xc1, 0xd8, 0x86, 0x25,
-	0xa1, 0xb8, 0x6c, 0x40, 0x4e, 0x3e, 0x5c, 0xc9, 0x9b, 0xec, 0x27, 0x21, 0x90, 0x9f, 0x46, 0xee,
-	0xbe, 0xf8, 0xdb, 0xd8, 0x54, 0x2f, 0xd0, 0x12, 0xbd, 0xfc, 0xd5, 0x3c, 0x6c, 0x24, 0x31, 0xca,
-	0x01, 0xbe, 0x18, 0xeb, 0x20, 0x37, 0x64, 0x09, 0x10, 0xf9, 0x38, 0xb1, 0x7a, 0x62, 0x5d, 0x44,
-	0x52, 0x7d, 0xa5, 0xc8, 0x8e, 0x3e, 0x4c, 0xca, 0x88, 0x7c, 0xc9, 0xd7, 0xa4, 0xd3, 0x3f, 0xf6,
-	0x29, 0x21, 0x32, 0x7e, 0xbc, 0x20, 0x32, 0xe6, 0xd3, 0x32, 0x25, 0x24, 0xc8, 0x0e, 0x6c, 0x46,
-	0x8e, 0xad, 0xf1, 0x3a, 0x0b, 0x69, 0xd9, 0xd7, 0x15, 0xf5, 0x81, 0x5e, 0xf9, 0x13, 0x68, 0x46,
-	0xc5, 0x24, 0x9a, 0xb1, 0x94, 0x56, 0xce, 0x86, 0x22, 0x37, 0x63, 0xed, 0xf9, 0x11, 0x6c, 0xc5,
-	0xc6, 0x2b, 0xde, 0xa4, 0x62, 0x5a, 0x51, 0x9b, 0xda, 0x00, 0xc6, 0x1a, 0x75, 0x00, 0xb7, 0x62,
-	0x65, 0x25, 0xda, 0x55, 0x4a, 0x2b, 0xac, 0xa9, 0x15, 0x16, 0x6b, 0x99, 0xf1, 0x3b, 0x4b, 0x40,
-	0x7e, 0x3c, 0xa7, 0xfe, 0x15, 0xbe, 0x4b, 0x0d, 0x5e, 0xe6, 0xb1, 0x2f, 0x15, 0x6f, 0xd9, 0x57,
-	0x7a, 0x7b, 0x9e, 0xf6, 0xf6, 0x3b, 0xff, 0xf2, 0xb7, 0xdf, 0x85, 0x97, 0xbd, 0xfd, 0x7e, 0x13,
-	0x6a, 0xce, 0x99, 0xeb, 0xb1, 0x73, 0x8d, 0x5d, 0x6b, 0x82, 0xe6, 0xd2, 0xdd, 0xdc, 0xbd, 0xaa,
-	0x59, 0x15, 0x40, 0x76, 0xa9, 0x09, 0xc8, 0xe3, 0x88, 0x88, 0x8e, 0xcf, 0x30, 0xfe, 0x81, 0x7e,
-	0xa2, 0x75, 0xc6, 0x67, 0x54, 0xe8, 0x19, 0x71, 0xc1, 0xca, 0xcc, 0x0c, 0x1e, 0x90, 0xb7, 0xa0,
-	0x1e, 0x78, 0x73, 0x76, 0x4b, 0x94, 0xc3, 0xc0, 0xcd, 0xcd, 0x55, 0x0e, 0x3d, 0x92, 0xce, 0x07,
-	0xab, 0xf3, 0x80, 0x5a, 0x53, 0x27, 0x08, 0x98, 0xac, 0x3d, 0xf2, 0xdc, 0xd0, 0xf7, 0x26, 0xc2,
-	0x82, 0xbc, 0x32, 0x0f, 0xe8, 0x21, 0xc7, 0xb4, 0x39, 0x82, 0x7c, 0x1c, 0x35, 0x69, 0x66, 0x3b,
-	0x7e, 0xd0, 0x04, 0x6c, 0x92, 0xec, 0x29, 0x5e, 0xc6, 0x6c, 0xc7, 0x57, 0x6d, 0x61, 0x89, 0x20,
-	0xf1, 0x26, 0xbd, 0x92, 0x7c, 0x93, 0xfe, 0x2b, 0xe9, 0x6f, 0xd2, 0xb9, 0xd3, 0xdc, 0x03, 0x51,
-	0xf4, 0xe2, 0x14, 0x7f, 0xa3, 0xa7, 0xe9, 0x8b, 0x4f, 0xed, 0xeb, 0xdf, 0xe4, 0xa9, 0xfd, 0x72,
-	0xda, 0x53, 0xfb, 0x0f, 0xa1, 0x82, 0x8f, 0xa0, 0xad, 0x73, 0x74, 0x9d, 0xe5, 0x16, 0xf1, 0x86,
-	0xfe, 0x4a, 0x7a, 0xdf, 0x71, 0x43, 0x13, 0x7c, 0xf9, 0x33, 0x58, 0x7c, 0xf5, 0xbe, 0xf2, 0x33,
-	0x7c, 0xf5, 0x2e, 0x1e, 0x6b, 0x6f, 0x43, 0x49, 0xce, 0x13, 0x63, 0xb6, 0xa7, 0xbe, 0x37, 0x95,
-	0x56, 0x38, 0xf6, 0x9b, 0xd4, 0x21, 0x1b, 0x7a, 0x22, 0x73, 0x36, 0xf4, 0x8c, 0x5f, 0x82, 0x8a,
-	0xb6, 0xd4, 0xc8, 0x1b, 0x5c, 0x4d, 0xcd, 0x2e, 0xda, 0xe2, 0xa2, 0xc0, 0x47, 0xb1, 0x2c, 0xa0,
-	0xdd, 0x31, 0x3b, 0x3c, 0xc6, 0x8e, 0x4f, 0x31, 0x3e, 0x85, 0xe5, 0xd3, 0x0b, 0xea, 0x07, 0xd2,
-	0x2a, 0xda, 0x50, 0x08, 0x93, 0xc3, 0x8d, 0x5f, 0x86, 0xd5, 0xd8, 0xdc, 0x0a, 0xf6, 0xfd, 0x16,
-	0x2c, 0xe1, 0xb8, 0x49, 0xd7, 0x9b, 0xf8, 0xeb, 0x73, 0x81, 0xc3, 0x58, 0x1c, 0xdc, 0xa0, 0x6b,
-	0xcd, 0x7c, 0xef, 0x04, 0x2b, 0xc9, 0x98, 0x15, 0x01, 0x3b, 0xf2, 0xbd, 0x13, 0xe3, 0x0f, 0x73,
-	0x90, 0xdb, 0xf7, 0x66, 0xba, 0xbb, 0x6d, 0x66, 0xc1, 0xdd, 0x56, 0x68, 0x0f, 0x2c, 0xa5, 0x1d,
-	0x10, 0x17, 0x30, 0x34, 0x65, 0x4a, 0x0d, 0xc1, 0x3d, 0xa8, 0x33, 0x3e, 0x11, 0x7a, 0x96, 0x78,
-	0xe6, 0xc2, 0x4f, 0x38, 0xbe, 0xf9, 0xec, 0x69, 0x38, 0xf4, 0xf6, 0x38, 0x9c, 0xac, 0x41, 0x4e,
-	0xdd, 0x45, 0x11, 0xcd, 0x92, 0x64, 0x03, 0x96, 0xf0, 0x79, 0x8e, 0x7c, 0xaa, 0x2c, 0x52, 0xe4,
-	0x7d, 0x58, 0x8d, 0x97, 0xcb, 0x59, 0x91, 0x10, 0x74, 0xf5, 0x82, 0x91, 0x27, 0xdd, 0x04, 0xc6,
-	0x47, 0xa2, 0xc7, 0xca, 0x39, 0xb3, 0x78, 0x4a, 0x29, 0xa2, 0x34, 0xa6, 0x57, 0x8a, 0x31, 0xbd,
-	0x3b, 0x50, 0x09, 0x27, 0x17, 0xd6, 0xcc, 0xbe, 0x9a, 0x78, 0xb6, 0x7c, 0x93, 0x07, 0xe1, 0xe4,
-	0xe2, 0x88, 0x43, 0xc8, 0x07, 0x00, 0xd3, 0xd9, 0x4c, 0xec, 0x3d, 0x34, 0xcf, 0x45, 0x4b, 0xf9,
-	0xf0, 0xe8, 0x88, 0x2f, 0x39, 0xb3, 0x3c, 0x9d, 0xcd, 0xf8, 0x4f, 0xb2, 0x0b, 0xf5, 0xd4, 0x18,
-	0x12, 0xb7, 0xe5, 0x23, 0x06, 0x6f, 0xb6, 0x9d, 0xb2, 0x39, 0x6b, 0x23, 0x1d, 0xb6, 0xf5, 0x43,
-	0x20, 0x7f, 0xc6, 0x48, 0x0e, 0x43, 0x28, 0xab, 0xf6, 0xe9, 0x81, 0x10, 0xf0, 0xe5, 0x58, 0x25,
-	0x16, 0x08, 0xa1, 0x35, 0x1e, 0xfb, 0x8c, 0x2f, 0x72, 0xe9, 0x47, 0xb1, 0x7c, 0xd0, 0xc4, 0x1f,
-	0xf1, 0xfc, 0xc7, 0xf8, 0x2f, 0x19, 0x28, 0xf0, 0xa8, 0x0c, 0x6f, 0xc3, 0x32, 0xa7, 0x57, 0xae,
-	0xcb, 0xc2, 0xe1, 0x84, 0x0b, 0x51, 0x43, 0xe1, 0xb5, 0xcc, 0xb6, 0x85, 0x16, 0xa9, 0x26, 0x12,
-	0x23, 0xb4, 0x68, 0x35, 0x77, 0xa0, 0xac, 0xaa, 0xd6, 0x96, 0x4e, 0x49, 0xd6, 0x4c, 0x5e, 0x87,
-	0xfc, 0xb9, 0x37, 0x93, 0x6a, 0x3c, 0x88, 0x46, 0xd2, 0x44, 0x78, 0xd4, 0x16, 0x56, 0x47, 0xf4,
-	0x2c, 0x29, 0x27, 0xda, 0xc2, 0x2a, 0x91, 0x6f, 0xd5, 0x13, 0x7d, 0x5c, 0x4a, 0xe9, 0xe3, 0x31,
-	0x2c, 0x33, 0x3e, 0xa0, 0x79, 0xbd, 0x5c, 0x7f, 0x68, 0x7e, 0x97, 0x89, 0xeb, 0xa3, 0xc9, 0x7c,
-	0x4c, 0x75, 0x45, 0x2a, 0xfa, 0xa1, 0x0a, 0xb8, 0xbc, 0x26, 0x19, 0xbf, 0x93, 0xe1, 0xfc, 0x85,
-	0x95, 0x4b, 0xee, 0x41, 0xde, 0x95, 0x1e, 0x32, 0x91, 0x50, 0xae, 0x9e, 0xf0, 0x31, 0x3a, 0x13,
-	0x29, 0xd8, 0xd4, 0xa1, 0x5f, 0x89, 0x5e, 0x7a, 0xcd, 0xac, 0xb8, 0xf3, 0xa9, 0xd2, 0x43, 0x7e,
-	0x47, 0x76, 0x2b, 0xa1, 0xc3, 0xe3, 0xbd, 0x57, 0xdb, 0x74, 0x5b, 0x73, 0x68, 0xcd, 0xc7, 0x4e,
-	0x4c, 0x29, 0xd2, 0x8f, 0xcf, 0xa8, 0xe6, 0xc8, 0xfa, 0x7b, 0x59, 0xa8, 0xc5, 0x5a, 0x84, 0x1e,
-	0xbd, 0xec, 0x00, 0xe0, 0x76, 0x46, 0x31, 0xdf, 0xe8, 0x38, 0x29, 0x6e, 0x5d, 0xda, 0x38, 0x65,
-	0x63, 0xe3, 0xa4, 0x5c, 0xdc, 0x72, 0xba, 0x8b, 0xdb, 0x03, 0x28, 0x47, 0x11, 0x8a, 0xe2, 0x4d,
-	0x62, 0xf5, 0xc9, 0x87, 0x8c, 0x11, 0x51, 0xe4, 0x14, 0x57, 0xd0, 0x9d, 0xe2, 0xbe, 0xaf, 0xf9,
-	0x50, 0x2d, 0x61, 0x31, 0x46, 0xda, 0x88, 0xfe, 0x4c, 0x3c, 0xa8, 0x8c, 0xc7, 0x50, 0xd1, 0x1a,
-	0xaf, 0xfb, 0x21, 0x65, 0x62, 0x7e, 0x48, 0xea, 0x49, 0x73, 0x36, 0x7a, 0xd2, 0x6c, 0xfc, 0x7a,
-	0x16, 0x6a, 0x6c, 0x7f, 0x39, 0xee, 0xd9, 0x91, 0x37, 0x71, 0x46, 0x68, 0x77, 0x54, 0x3b, 0x4c,
-	0x08, 0x5a, 0x72, 0x9f, 0x89, 0x2d, 0xc6, 0xe5, 0x2c, 0x3d, 0x6c, 0x06, 0x67, 0xd2, 0x2a, 0x6c,
-	0x86, 0x01, 0x35, 0xc6, 0x18, 0xd1, 0x82, 0x18, 0xc5, 0x39, 0x32, 0x2b, 0xa7, 0x94, 0xee, 0xd8,
-	0x01, 0xe7, 0x90, 0xef, 0xc3, 0x2a, 0xa3, 0xc1, 0x47, 0xf1, 0x53, 0x67, 0x32, 0x71, 0xa2, 0x77,
-	0x80, 0x39, 0xb3, 0x71, 0x4a, 0xa9, 0x69, 0x87, 0xf4, 0x90, 0x21, 0x44, 0x58, 0xa4, 0xd2, 0xd8,
-	0x09, 0xec, 0x93, 0xc8, 0xef, 0x5a, 0xa5, 0xa5, 0x61, 0x3e, 0xf2, 0x7d, 0x58, 0x12, 0x4f, 0x04,
-	0xb9, 0xe5, 0x1e, 0xf3, 0x27, 0x56, 0x52, 0x31, 0xb9, 0x92, 0x8c, 0x7f, 0x9a, 0x85, 0x8a, 0xb6,
-	0x2c, 0x5f, 0xe5, 0x74, 0xbd, 0xbd, 0x60, 0x27, 0x2e, 0xeb, 0x26, 0xe1, 0x37, 0xe3, 0x55, 0xe6,
-	0xd4, 0x63, 0x31, 0x7d, 0x01, 0xdf, 0x82, 0x32, 0xdb, 0x75, 0x1f, 0xa2, 0x3e, 0x5d, 0x84, 0x25,
-	0x43, 0xc0, 0xd1, 0xfc, 0x44, 0x22, 0x1f, 0x22, 0xb2, 0x10, 0x21, 0x1f, 0x32, 0xe4, 0x8b, 0x1e,
-	0x8b, 0x7c, 0x0a, 0x55, 0x51, 0x2a, 0xce, 0xa9, 0xb8, 0x16, 0xac, 0x69, 0x27, 0xb7, 0x9a, 0x6f,
-	0xb3, 0xc2, 0xab, 0xe3, 0x93, 0x2f, 0x32, 0x3e, 0x94, 0x19, 0x4b, 0x2f, 0xcb, 0xf8, 0x90, 0x27,
-	0x8c, 0x3d, 0xf5, 0xfe, 0x06, 0xbd, 0x17, 0x25, 0x1f, 0xfb, 0x00, 0x56, 0x25, 0xbb, 0x9a, 0xbb,
-	0xb6, 0xeb, 0x7a, 0x73, 0x77, 0x44, 0xe5, 0x5b, 0x64, 0x22, 0x50, 0xc7, 0x11, 0xc6, 0x18, 0xab,
-	0x60, 0x1b, 0xdc, 0x0b, 0xf2, 0x3e, 0x14, 0xb8, 0x5c, 0xce, 0x85, 0x8f, 0x74, 0xc6, 0xc5, 0x49,
-	0xc8, 0x3d, 0x28, 0x70, 0xf1, 0x3c, 0x7b, 0x2d, 0xb3, 0xe1, 0x04, 0x46, 0x0b, 0x08, 0xcb, 0x78,
-	0x48, 0x43, 0xdf, 0x19, 0x05, 0xd1, 0x33, 0xe7, 0x42, 0x78, 0x35, 0x13, 0x75, 0x45, 0x6a, 0xf8,
-	0x88, 0x12, 0x15, 0x0e, 0x9c, 0x86, 0x1d, 0x4c, 0xab, 0xb1, 0x32, 0x84, 0xb8, 0x34, 0x81, 0x8d,
-	0x13, 0x1a, 0x3e, 0xa7, 0xd4, 0x75, 0x99, 0x30, 0x34, 0xa2, 0x6e, 0xe8, 0xdb, 0x13, 0x36, 0x49,
-	0xbc, 0x07, 0x8f, 0x16, 0x4a, 0x8d, 0x14, 0x5a, 0x3b, 0x51, 0xc6, 0xb6, 0xca, 0xc7, 0x79, 0xc7,
-	0xfa, 0x49, 0x1a, 0x6e, 0xeb, 0x17, 0x61, 0xeb, 0xfa, 0x4c, 0x29, 0xc1, 0x12, 0xee, 0xc5, 0xb9,
-	0x8a, 0x32, 0xea, 0x4e, 0x3c, 0x3b, 0xe4, 0xad, 0xd1, 0x39, 0x4b, 0x0f, 0x2a, 0x1a, 0x26, 0x3a,
-	0xfb, 0x33, 0x28, 0xdc, 0xf1, 0x04, 0x3b, 0x91, 0x5c, 0xcf, 0x9f, 0xa2, 0x11, 0x75, 0x6c, 0x45,
-	0xa5, 0x67, 0xcc, 0xe5, 0x08, 0x8e, 0x7e, 0x37, 0xc6, 0x36, 0x2c, 0xa3, 0x64, 0xaf, 0x1d, 0x74,
-	0x2f, 0x12, 0x06, 0x8d, 0x35, 0x20, 0x3d, 0xce, 0xbb, 0x74, 0x8f, 0xd0, 0x7f, 0x9f, 0x83, 0x8a,
-	0x06, 0x66, 0xa7, 0x11, 0xba, 0xd1, 0x5a, 0x63, 0xc7, 0x9e, 0x52, 0x69, 0xb1, 0xae, 0x99, 0x35,
-	0x84, 0xee, 0x0a, 0x20, 0x3b, 0x8b, 0xed, 0x8b, 0x33, 0xcb, 0x9b, 0x87, 0xd6, 0x98, 0x9e, 0xf9,
-	0x54, 0xb6, 0xb2, 0x6a, 0x5f, 0x9c, 0xf5, 0xe7, 0xe1, 0x2e, 0xc2, 0x64, 0x74, 0x19, 0x8d, 0x2a,
-	0xa7, 0xa2, 0xcb, 0x44, 0x54, 0xc2, 0xfd, 0x98, 0xaf, 0xcc, 0xbc, 0x72, 0x3f, 0xe6, 0xb7, 0xc5,
-	0xe4, 0x01, 0x5a, 0x58, 0x3c, 0x40, 0x3f, 0x86, 0x0d, 0x7e, 0x80, 0x0a, 0xd6, 0x6c, 0x25, 0x76,
-	0xf2, 0x1a, 0x62, 0x45, 0x27, 0x35, 0xb1, 0xb7, 0xc1, 0x7a, 0x20, 0xd9, 0x52, 0xe0, 0xfc, 0x84,
-	0x33, 0xb2, 0x8c, 0xc9, 0x7a, 0x26, 0x0a, 0x1f, 0x38, 0x3f, 0xa1, 0x32, 0xba, 0x4d, 0x8c, 0x52,
-	0x3c, 0x05, 0x9b, 0x3a, 0x6e, 0x92, 0xd2, 0xbe, 0x8c, 0x53, 0x96, 0x05, 0xa5, 0x7d, 0xa9, 0x53,
-	0x3e, 0x82, 0xcd, 0x29, 0x1d, 0x3b, 0x76, 0xbc, 0x58, 0x2b, 0x12, 0xdc, 0xd6, 0x38, 0x5a, 0xcb,
-	0x33, 0xe0, 0x17, 0x77, 0x36, 0x1a, 0x3f, 0xf1, 0xa6, 0x27, 0x0e, 0x97, 0x59, 0xb8, 0x47, 0x59,
-	0xde, 0xac, 0xbb, 0xf3, 0xe9, 0x2f, 0x20, 0x98, 0x65, 0x09, 0x8c, 0x1a, 0x54, 0x06, 0xa1, 0x37,
-	0x93, 0xd3, 0x5c, 0x87, 0x2a, 0x4f, 0x8a, 0x67, 0xfc, 0xb7, 0xe0, 0x26, 0xb2, 0x84, 0xa1, 0x37,
-	0xf3, 0x26, 0xde, 0xd9, 0x55, 0x4c, 0x29, 0xfb, 0xaf, 0x32, 0xb0, 0x1a, 0xc3, 0x0a, 0xf6, 0xfa,
-	0x31, 0xe7, 0x67, 0xea, 0x09, 0x70, 0x26, 0xf6, 0xfe, 0x8b, 0xcd, 0x17, 0x27, 0xe4, 0xcc, 0x4c,
-	0x3e, 0x0b, 0x6e, 0x45, 0xa1, 0xa3, 0x64, 0x46, 0xce, 0x52, 0x9a, 0x8b, 0x2c, 0x45, 0xe4, 0x97,
-	0x41, 0xa5, 0x64, 0x11, 0x3f, 0x27, 0x9e, 0xeb, 0x8d, 0x45, 0x97, 0x73, 0xf1, 0x07, 0x3d, 0xba,
-	0x02, 0x57, 0xb6, 0x20, 0xd2, 0xea, 0x06, 0xc6, 0xdf, 0xcd, 0x00, 0x44, 0xad, 0xc3, 0x27, 0x45,
-	0x4a, 0x6e, 0xc9, 0xa0, 0x33, 0xb7, 0x26, 0xa3, 0xbc, 0x01, 0x55, 0xe5, 0xf7, 0x1f, 0x49, 0x42,
-	0x15, 0x09, 0x63, 0xe2, 0xd0, 0x3b, 0xb0, 0x7c, 0x36, 0xf1, 0x4e, 0x50, 0x62, 0x15, 0x72, 0x0b,
-	0x77, 0x09, 0xa9, 0x73, 0xb0, 0x94, 0x46, 0x22, 0xb9, 0x29, 0x9f, 0xfa, 0x34, 0x40, 0x97, 0x82,
-	0x8c, 0xbf, 0x94, 0x55, 0xce, 0xc5, 0xd1, 0x48, 0xbc, 0xf8, 0x7a, 0xf7, 0xd3, 0xb8, 0x56, 0xbd,
-	0xc8, 0x56, 0xfc, 0x18, 0xea, 0x3e, 0x3f, 0x94, 0xe4, 0x89, 0x95, 0x7f, 0xc1, 0x89, 0x55, 0xf3,
-	0x63, 0x92, 0xce, 0x77, 0xa1, 0x61, 0x8f, 0x2f, 0xa8, 0x1f, 0x3a, 0x68, 0x7a, 0x41, 0xf9, 0x58,
-	0xb8, 0xf3, 0x6a, 0x70, 0x14, 0x44, 0xdf, 0x81, 0x65, 0x11, 0x5a, 0x42, 0x51, 0x8a, 0x18, 0x85,
-	0x11, 0x98, 0x11, 0x1a, 0xff, 0x50, 0x7a, 0x33, 0xc7, 0x67, 0xf7, 0xc5, 0xa3, 0xa2, 0xf7, 0x30,
-	0xbb, 0x68, 0x0d, 0x17, 0x0b, 0x49, 0x58, 0x74, 0x04, 0x3f, 0xe2, 0x40, 0x61, 0xcf, 0x89, 0x0f,
-	0x6b, 0xfe, 0x55, 0x86, 0xd5, 0xf8, 0x37, 0x19, 0x28, 0xee, 0x7b, 0xb3, 0x7d, 0x87, 0xbf, 0x89,
-	0xc1, 0x6d, 0xa2, 0x0c, 0x8e, 0x4b, 0x2c, 0x89, 0x7e, 0x60, 0x2f, 0x78, 0x1a, 0x9b, 0x2a, 0xe6,
-	0xd5, 0xe2, 0x62, 0xde, 0xf7, 0xe1, 0x16, 0xda, 0x73, 0x7d, 0x6f, 0xe6, 0xf9, 0x6c, 0xab, 0xda,
-	0x13, 0x2e, 0xee, 0x79, 0x6e, 0x78, 0x2e, 0x79, 0xe7, 0xcd, 0x53, 0x4a, 0x8f, 0x34, 0x8a, 0x43,
-	0x45, 0x80, 0xcf, 0xe2, 0x27, 0xe1, 0x85, 0xc5, 0x6f, 0xe8, 0x42, 0x1e, 0xe5, 0x1c, 0x75, 0x99,
-	0x21, 0x3a, 0x08, 0x47, 0x89, 0xd4, 0xf8, 0x0c, 0xca, 0x4a, 0xd9, 0x43, 0xde, 0x85, 0xf2, 0xb9,
-	0x37, 0x13, 0x1a, 0xa1, 0x4c, 0xec, 0xf9, 0xb0, 0xe8, 0xb5, 0x59, 0x3a, 0xe7, 0x3f, 0x02, 0xe3,
-	0x0f, 0x8b, 0x50, 0xec, 0xba, 0x17, 0x9e, 0x33, 0x42, 0x7f, 0xe8, 0x29, 0x9d, 0x7a, 0x32, 0xf2,
-	0x0d, 0xfb, 0x8d, 0xae, 0x7a, 0x51, 0xa4, 0xc1, 0x9c, 0x70, 0xd5, 0x53, 0x31, 0x06, 0xd7, 0x61,
-	0xc9, 0xd7, 0x43, 0x05, 0x16, 0x7c, 0x7c, 0x45, 0xa2, 0xce, 0xcb, 0x82, 0x16, 0x99, 0x88, 0x95,
-	0xc5, 0x5d, 0x55, 0x71, 0xc8, 0xf8, 0xd3, 0xf6, 0x32, 0x42, 0x70, 0xc0, 0x5e, 0x83, 0xa2, 0xd0,
-	0xfb, 0xf2, 0xb7, 0x83, 0x5c, 0x5b, 0x2e, 0x40, 0xb8, 0x1a, 0x7c, 0xca, 0xed, 0xf1, 0x4a, 0x90,
-	0xcd, 0x99, 0x55, 0x09, 0xdc, 0x65, 0x6b, 0xed, 0x0e, 0x54, 0x38, 0x3d, 0x27, 0x29, 0x09, 0x37,
-	0x62, 0x04, 0x21, 0x41, 0x4a, 0xc4, 0xcd, 0x72, 0x6a, 0xc4, 0x4d, 0x74, 0x78, 0x57, 0x5c, 0x96,
-	0x77, 0x11, 0x78, 0x9c, 0x45, 0x0d, 0x2e, 0xc3, 0xd8, 0x0a, 0x9d, 0x0a, 0x8f, 0xfa, 0x20, 0x75,
-	0x2a, 0x6f, 0x42, 0xed, 0xd4, 0x9e, 0x4c, 0x4e, 0xec, 0xd1, 0x33, 0xae, 0x0a, 0xa8, 0x72, 0xed,
-	0xa7, 0x04, 0xa2, 0x2e, 0xe0, 0x0e, 0x54, 0xb4, 0x59, 0x46, 0x1f, 0xe1, 0xbc, 0x09, 0xd1, 0xfc,
-	0x26, 0x35, 0x7c, 0xf5, 0x57, 0xd0, 0xf0, 0x69, 0xbe, 0xd2, 0xcb, 0x71, 0x5f, 0xe9, 0x5b, 0xc8,
-	0x4d, 0x85, 0x07, 0x6a, 0x83, 0x07, 0xf5, 0xb3, 0xc7, 0x63, 0x1e, 0x87, 0xe5, 0x0d, 0xa8, 0x8a,
-	0xc1, 0xe3, 0xf8, 0x15, 0x7e, 0x97, 0xe0, 0x30, 0x4e, 0x72, 0x9b, 0xab, 0xa9, 0x67, 0xb6, 0x33,
-	0xc6, 0xa7, 0x3b, 0xc2, 0xa2, 0x61, 0x4f, 0xc3, 0x23, 0xdb, 0x41, 0xdf, 0x3b, 0x89, 0xc6, 0xd3,
-	0x71, 0x95, 0x8f, 0xbf, 0x40, 0x0f, 0x78, 0x4c, 0x13, 0x45, 0x31, 0x55, 0x61, 0x1b, 0xcc, 0x8a,
-	0x20, 0xc1, 0x75, 0xf0, 0x21, 0xba, 0x6c, 0x85, 0x14, 0x03, 0x33, 0xd4, 0x1f, 0xde, 0x52, 0x9e,
-	0x24, 0xb8, 0x4a, 0xe5, 0x7f, 0x6e, 0xe9, 0xe4, 0x94, 0x4c, 0xb8, 0xe3, 0x06, 0xd7, 0x8d, 0x98,
-	0xfc, 0x2b, 0x48, 0xd1, 0xe0, 0xca, 0x09, 0xc8, 0x67, 0xda, 0xfd, 0xb5, 0x89, 0xc4, 0xaf, 0x25,
-	0xca, 0xbf, 0xee, 0x6d, 0xe4, 0x6d, 0x00, 0x27, 0x60, 0xa7, 0x4c, 0x40, 0xdd, 0x31, 0xc6, 0x57,
-	0x28, 0x99, 0x65, 0x27, 0x78, 0xca, 0x01, 0xdf, 0xee, 0xc5, 0xb6, 0x05, 0x55, 0xbd, 0x9b, 0xa4,
-	0x04, 0xf9, 0xfe, 0x51, 0xa7, 0xd7, 0xb8, 0x41, 0x2a, 0x50, 0x1c, 0x74, 0x86, 0xc3, 0x03, 0x34,
-	0xdb, 0x56, 0xa1, 0xa4, 0x5e, 0x4f, 0x67, 0x59, 0xaa, 0xd5, 0x6e, 0x77, 0x8e, 0x86, 0x9d, 0xdd,
-	0x46, 0xee, 0x47, 0xf9, 0x52, 0xb6, 0x91, 0x33, 0xfe, 0x28, 0x07, 0x15, 0x6d, 0x14, 0x5e, 0xcc,
-	0x8c, 0xe3, 0x71, 0x7a, 0xb2, 0xc9, 0x38, 0x3d, 0xba, 0x8d, 0x42, 0xc4, 0x32, 0x92, 0x36, 0x8a,
-	0x37, 0xa1, 0x26, 0xe2, 0x09, 0x6a, 0xc6, 0xf7, 0x82, 0x59, 0xe5, 0x40, 0xc1, 0xaa, 0x31, 0x16,
-	0x03, 0x12, 0xe1, 0x2b, 0x57, 0x11, 0x09, 0x8c, 0x83, 0xf0, 0x9d, 0x2b, 0x3e, 0x52, 0x0e, 0xbc,
-	0xc9, 0x05, 0xe5, 0x14, 0x5c, 0x22, 0xac, 0x08, 0xd8, 0x50, 0xc4, 0xb9, 0x10, 0xfc, 0x50, 0x0b,
-	0x06, 0x50, 0x30, 0xab, 0x1c, 0x28, 0x2a, 0x7a, 0x5f, 0x2e, 0x20, 0xee, 0x8a, 0xb4, 0xb9, 0xb8,
-	0x1a, 0x62, 0x8b, 0xe7, 0x60, 0x41, 0x8d, 0x58, 0xc6, 0x85, 0xf1, 0x9d, 0xc5, 0x7c, 0x2f, 0x57,
-	0x27, 0x92, 0x77, 0x81, 0x4c, 0x67, 0x33, 0x2b, 0x45, 0xc1, 0x97, 0x37, 0x97, 0xa7, 0xb3, 0xd9,
-	0x50, 0xd3, 0x7f, 0x7d, 0x0b, 0xba, 0xc7, 0xaf, 0x81, 0xb4, 0xd8, 0x06, 0xc6, 0x26, 0xaa, 0xab,
-	0x58, 0xc4, 0x96, 0x33, 0x3a, 0x5b, 0x4e, 0xe1, 0x7e, 0xd9, 0x54, 0xee, 0xf7, 0x22, 0x3e, 0x61,
-	0xec, 0x41, 0xe5, 0x48, 0x0b, 0xeb, 0x7a, 0x97, 0x9d, 0x10, 0x32, 0xa0, 0x2b, 0x3f, 0x3b, 0xb8,
-	0x4e, 0xd1, 0x17, 0x71, 0x5c, 0xb5, 0xd6, 0x64, 0xb5, 0xd6, 0x18, 0x7f, 0x3b, 0xc3, 0xa3, 0xaa,
-	0xa9, 0xc6, 0x47, 0x91, 0x64, 0xa5, 0x69, 0x2e, 0x8a, 0xd9, 0x51, 0x91, 0xc6, 0x37, 0x11, 0x6e,
-	0x03, 0x9b, 0x66, 0x79, 0xa7, 0xa7, 0x01, 0x95, 0x0e, 0x3b, 0x15, 0x84, 0xf5, 0x11, 0x24, 0x85,
-	0x6f, 0x26, 0xe1, 0x3b, 0xbc, 0xfc, 0x40, 0x78, 0xe9, 0x30, 0xe1, 0xfb, 0xd0, 0xbe, 0x14, 0xb5,
-	0x06, 0x4c, 0x04, 0x11, 0xf6, 0x01, 0xf9, 0x66, 0x5d, 0xa5, 0x8d, 0xbf, 0x21, 0xc2, 0x8a, 0x24,
-	0xc7, 0xf7, 0x3e, 0x94, 0x54, 0xa9, 0xf1, 0x13, 0x56, 0x52, 0x2a, 0x3c, 0x3b, 0xc7, 0x51, 0x19,
-	0x12, 0x6b, 0x31, 0xdf, 0x5c, 0x68, 0xe3, 0xe9, 0x6a, 0xad, 0x7e, 0x0f, 0xc8, 0xa9, 0xe3, 0x27,
-	0x89, 0xf9, 0x66, 0x6b, 0x20, 0x46, 0xa3, 0x36, 0x8e, 0x61, 0x55, 0x72, 0x09, 0xed, 0x46, 0x10,
-	0x9f, 0xbc, 0xcc, 0x4b, 0x98, 0x7c, 0x76, 0x81, 0xc9, 0x1b, 0xbf, 0x51, 0x80, 0xa2, 0x0c, 0x91,
-	0x9c, 0x16, 0xd6, 0xb7, 0x1c, 0x0f, 0xeb, 0xdb, 0x8c, 0x45, 0x21, 0xc4, 0xa9, 0x17, 0xe7, 0xfd,
-	0x3b, 0xc9, 0x23, 0x5b, 0xb3, 0x55, 0xc4, 0x8e, 0x6d, 0x61, 0xab, 0x28, 0xc4, 0x6d, 0x15, 0x69,
-	0xa1, 0x8e, 0xb9, 0xe8, 0xb9, 0x10, 0xea, 0xf8, 0x16, 0x70, 0x39, 0x42, 0xf3, 0x54, 0x2c, 0x21,
-	0x40, 0xc4, 0x5d, 0xd0, 0xc4, 0x8e, 0x52, 0x52, 0xec, 0x78, 0x65, 0x91, 0xe0, 0x63, 0x58, 0xe2,
-	0x21, 0x8a, 0xc4, 0x1b, 0x7c, 0x79, 0x70, 0x88, 0xb1, 0x92, 0xff, 0xf9, 0x03, 0x18, 0x53, 0xd0,
-	0xea, 0xa1, 0x31, 0x2b, 0xb1, 0xd0, 0x98, 0xba, 0x0d, 0xa5, 0x1a, 0xb7, 0xa1, 0xdc, 0x83, 0x86,
-	0x1a, 0x38, 0xd4, 0x48, 0xba, 0x81, 0x78, 0x7f, 0x5b, 0x97, 0x70, 0xc6, 0x0d, 0x7b, 0x41, 0x74,
-	0xf0, 0xd5, 0x63, 0x07, 0x1f, 0xe3, 0x55, 0xad, 0x30, 0xa4, 0xd3, 0x59, 0x28, 0x0f, 0x3e, 0x2d,
-	0xba, 0x34, 0x9f, 0x79, 0xfe, 0x40, 0x48, 0x4e, 0x2f, 0x5f, 0x1d, 0x3b, 0x50, 0x3f, 0xb5, 0x9d,
-	0xc9, 0xdc, 0xa7, 0x96, 0x4f, 0xed, 0xc0, 0x73, 0x71, 0xf3, 0x47, 0x67, 0xb0, 0xe8, 0xe2, 0x1e,
-	0xa7, 0x31, 0x91, 0xc4, 0xac, 0x9d, 0xea, 0x49, 0x7c, 0x66, 0xa7, 0x8f, 0x04, 0x3b, 0xb2, 0xc4,
-	0x4b, 0x7c, 0xee, 0x78, 0xd4, 0xed, 0x59, 0x7b, 0x07, 0xdd, 0x27, 0xfb, 0xc3, 0x46, 0x86, 0x25,
-	0x07, 0xc7, 0xed, 0x76, 0xa7, 0xb3, 0x8b, 0x47, 0x18, 0xc0, 0xd2, 0x5e, 0xab, 0x7b, 0x20, 0x0e,
-	0xb0, 0x7c, 0xa3, 0x60, 0xfc, 0x93, 0x2c, 0x54, 0xb4, 0xde, 0x90, 0x47, 0x6a, 0x12, 0x78, 0xec,
-	0x8f, 0xdb, 0x8b, 0x3d, 0xde, 0x96, 0x1c, 0x5e, 0x9b, 0x05, 0x15, 0x47, 0x3a, 0x7b, 0x6d, 0x1c,
-	0x69, 0xf2, 0x36, 0x2c, 0xdb, 0xbc, 0x04, 0x35, 0xe8, 0x42, 0xb9, 0x2f, 0xc0, 0x62, 0xcc, 0xdf,
-	0x16, 0x71, 0x48, 0xc4, 0x31, 0xc5, 0xe8, 0xf2, 0xd2, 0x03, 0x57, 0x9d, 0x54, 0x38, 0x37, 0x45,
-	0x31, 0x32, 0xc2, 0x18, 0xaf, 0x0e, 0x7c, 0x31, 0x5e, 0x12, 0xcd, 0xdf, 0xde, 0x6a, 0x2b, 0xbc,
-	0x6a, 0xaa, 0xb4, 0xf1, 0x09, 0x40, 0xd4, 0x9f, 0xf8, 0xf0, 0xdd, 0x88, 0x0f, 0x5f, 0x46, 0x1b,
-	0xbe, 0xac, 0xf1, 0x0f, 0x04, 0xeb, 0x12, 0x73, 0xa1, 0x54, 0x7d, 0xef, 0x83, 0x54, 0x3e, 0x5a,
-	0xe8, 0xb1, 0x3f, 0x9b, 0xd0, 0x50, 0x3e, 0x1f, 0x5e, 0x11, 0x98, 0xae, 0x42, 0x2c, 0xb0, 0xda,
-	0xec, 0x22, 0xab, 0x7d, 0x03, 0xaa, 0x18, 0xd8, 0x4e, 0x54, 0x24, 0xd8, 0x55, 0x65, 0x6a, 0x5f,
-	0xca, 0xba, 0x63, 0x3c, 0x36, 0x9f, 0xe0, 0xb1, 0x7f, 0x33, 0xc3, 0xa3, 0x20, 0x45, 0x0d, 0x8d,
-	0x98, 0xac, 0x2a, 0x33, 0xce, 0x64, 0x05, 0xa9, 0xa9, 0xf0, 0xd7, 0x30, 0xce, 0x6c, 0x3a, 0xe3,
-	0x4c, 0x67, 0xc9, 0xb9, 0x54, 0x96, 0x6c, 0x6c, 0x41, 0x73, 0x97, 0xb2, 0xa1, 0x68, 0x4d, 0x26,
-	0x89, 0xb1, 0x34, 0x6e, 0xc1, 0xcd, 0x14, 0x9c, 0xd0, 0xda, 0xfc, 0x66, 0x06, 0xd6, 0x5b, 0x3c,
-	0xf8, 0xc9, 0xb7, 0xf6, 0xbe, 0xf7, 0x73, 0xb8, 0xa9, 0xdc, 0xef, 0xb5, 0x67, 0x83, 0x7a, 0xe4,
-	0x2a, 0xe9, 0xb9, 0xaf, 0x3d, 0x3a, 0x61, 0x67, 0xa6, 0xd1, 0x84, 0x8d, 0x64, 0x6b, 0x44, 0x43,
-	0xf7, 0x60, 0x65, 0x97, 0x9e, 0xcc, 0xcf, 0x0e, 0xe8, 0x45, 0xd4, 0x46, 0x02, 0xf9, 0xe0, 0xdc,
-	0x7b, 0x2e, 0x16, 0x06, 0xfe, 0x46, 0xff, 0x5c, 0x46, 0x63, 0x05, 0x33, 0x3a, 0x92, 0x5a, 0x7f,
-	0x84, 0x0c, 0x66, 0x74, 0x64, 0x3c, 0x02, 0xa2, 0x97, 0x23, 0x66, 0x91, 0x5d, 0xc9, 0xe6, 0x27,
-	0x56, 0x70, 0x15, 0x84, 0x74, 0x2a, 0x9f, 0xc4, 0x42, 0x30, 0x3f, 0x19, 0x70, 0x88, 0xf1, 0x0e,
-	0x54, 0x8f, 0xec, 0x2b, 0x93, 0x7e, 0x2d, 0x5e, 0x9e, 0x6e, 0x42, 0x71, 0x66, 0x5f, 0x31, 0x5e,
-	0xac, 0x0c, 0x80, 0x88, 0x36, 0xfe, 0x51, 0x1e, 0x96, 0x38, 0x25, 0xb9, 0xcb, 0xbf, 0xf0, 0xe0,
-	0xb8, 0xc8, 0x0b, 0xe5, 0xa9, 0xa4, 0x81, 0x16, 0x0e, 0xae, 0xec, 0xe2, 0xc1, 0x25, 0xb4, 0x95,
-	0x32, 0xb2, 0x9e, 0x34, 0xd5, 0xb8, 0xf3, 0xa9, 0x0c, 0xa7, 0x17, 0x8f, 0xfd, 0x91, 0x8f, 0xbe,
-	0x0c, 0xc2, 0xe3, 0x1e, 0xc4, 0x8d, 0xe9, 0xd1, 0xc5, 0x8f, 0xb7, 0x4e, 0x9e, 0xc7, 0xe2, 0xcc,
-	0xd2, 0x41, 0xa9, 0xb7, 0xcb, 0xa2, 0x7c, 0x4e, 0x1d, 0xbf, 0x5d, 0x2e, 0xdc, 0x22, 0x4b, 0x2f,
-	0xbf, 0x45, 0x72, 0x35, 0xe6, 0x0b, 0x6e, 0x91, 0xf0, 0x0a, 0xb7, 0xc8, 0x57, 0x30, 0x64, 0xdf,
-	0x84, 0x12, 0x0a, 0x59, 0xda, 0x11, 0xc6, 0x84, 0x2b, 0x76, 0x84, 0x7d, 0xaa, 0xdd, 0xb3, 0xb8,
-	0x17, 0x8d, 0x76, 0x86, 0x98, 0xf4, 0xeb, 0x9f, 0x8d, 0x81, 0xf0, 0x2b, 0x28, 0x0a, 0x28, 0x5b,
-	0xd0, 0xae, 0x3d, 0x95, 0xf1, 0x63, 0xf1, 0x37, 0x1b, 0x36, 0x8c, 0xa8, 0xf8, 0xf5, 0xdc, 0xf1,
-	0xe9, 0x58, 0xc6, 0x75, 0x73, 0x70, 0x7f, 0x33, 0x08, 0xeb, 0x20, 0xbb, 0xf3, 0xb9, 0x32, 0xfe,
-	0x7b, 0xc9, 0x2c, 0x3a, 0xc1, 0x53, 0x96, 0x34, 0x08, 0x34, 0x30, 0x02, 0xf6, 0xcc, 0xf3, 0xa5,
-	0x84, 0x60, 0xfc, 0x6e, 0x06, 0x1a, 0x62, 0x77, 0x29, 0x9c, 0x7e, 0xe5, 0x2a, 0x5c, 0xe7, 0xf4,
-	0xf1, 0xe2, 0x28, 0x6d, 0x06, 0xd4, 0x50, 0xd3, 0xa4, 0xc4, 0x05, 0xae, 0x29, 0xab, 0x30, 0xe0,
-	0x9e, 0x10, 0x19, 0x5e, 0x87, 0x8a, 0x7c, 0x3d, 0x30, 0x75, 0x26, 0xf2, 0x23, 0x40, 0xfc, 0xf9,
-	0xc0, 0xa1, 0x33, 0x91, 0xd2, 0x86, 0x6f, 0x8b, 0xe7, 0xfd, 0x19, 0x94, 0x36, 0x4c, 0x3b, 0xa4,
-	0xc6, 0x3f, 0xce, 0xc0, 0x8a, 0xd6, 0x15, 0xb1, 0x6f, 0xbf, 0x07, 0x55, 0x15, 0x97, 0x9f, 0x2a,
-	0x31, 0x77, 0x33, 0xce, 0xa3, 0xa2, 0x6c, 0x95, 0x91, 0x82, 0x04, 0xac, 0x31, 0x63, 0xfb, 0x8a,
-	0xbb, 0xb8, 0xcf, 0xa7, 0xf2, 0x26, 0x39, 0xb6, 0xaf, 0xf6, 0x28, 0x1d, 0xcc, 0xa7, 0xe4, 0x2e,
-	0x54, 0x9f, 0x53, 0xfa, 0x4c, 0x11, 0x70, 0xd6, 0x0b, 0x0c, 0x26, 0x28, 0x0c, 0xa8, 0x4d, 0x3d,
-	0x37, 0x3c, 0x57, 0x24, 0x42, 0xc4, 0x47, 0x20, 0xa7, 0x31, 0xfe, 0x20, 0x0b, 0xab, 0x5c, 0x9f,
-	0x29, 0xf4, 0xc8, 0x82, 0x75, 0x35, 0x61, 0x89, 0xab, 0x76, 0x39, 0xf3, 0xda, 0xbf, 0x61, 0x8a,
-	0x34, 0xf9, 0xf8, 0x15, 0x75, 0xb0, 0x32, 0x82, 0xc0, 0x35, 0xc3, 0x9f, 0x5b, 0x1c, 0xfe, 0xeb,
-	0x87, 0x37, 0xcd, 0xaa, 0x5c, 0x48, 0xb3, 0x2a, 0xbf, 0x8a, 0x2d, 0x77, 0xe1, 0xad, 0x7b, 0x71,
-	0x31, 0x24, 0xec, 0x23, 0xd8, 0x8c, 0xd1, 0x20, 0xb7, 0x76, 0x4e, 0x1d, 0x15, 0x6f, 0x7c, 0x4d,
-	0xa3, 0x1e, 0x48, 0xdc, 0x4e, 0x11, 0x0a, 0xc1, 0xc8, 0x9b, 0x51, 0x63, 0x03, 0xd6, 0xe2, 0xa3,
-	0x2a, 0x8e, 0x89, 0xdf, 0xce, 0x40, 0x73, 0x2f, 0x8a, 0xad, 0xeb, 0x04, 0xa1, 0xe7, 0xab, 0x10,
-	0xed, 0xb7, 0x01, 0xf8, 0x07, 0x89, 0xf0, 0xe2, 0x2e, 0xa2, 0x24, 0x21, 0x04, 0xaf, 0xed, 0x37,
-	0xa1, 0x44, 0xdd, 0x31, 0x47, 0xf2, 0xd5, 0x50, 0xa4, 0xee, 0x58, 0x5e, 0xfa, 0x17, 0x8e, 0xe1,
-	0x5a, 0x5c, 0xc0, 0x10, 0xf1, 0x3e, 0xd8, 0xe8, 0xd0, 0x0b, 0x14, 0x07, 0xf2, 0x2a, 0xde, 0xc7,
-	0xa1, 0x7d, 0x89, 0xee, 0xd1, 0x81, 0xf1, 0x97, 0xb3, 0xb0, 0x1c, 0xb5, 0x8f, 0x47, 0x3c, 0x7a,
-	0x71, 0xec, 0xa6, 0xbb, 0x62, 0x39, 0x38, 0xec, 0xb2, 0xa4, 0x69, 0x79, 0x4b, 0x7c, 0x73, 0x76,
-	0x5d, 0x62, 0x40, 0x45, 0x52, 0x78, 0xf3, 0x50, 0x0b, 0x63, 0x5b, 0xe6, 0x24, 0xfd, 0x79, 0xc8,
-	0x6e, 0xb7, 0xec, 0x9a, 0xef, 0xb8, 0xe2, 0x7e, 0x59, 0xb0, 0xa7, 0x61, 0x17, 0xbf, 0x7a, 0xc5,
-	0xc0, 0x2c, 0x1b, 0x9f, 0x48, 0x46, 0xc5, 0xe8, 0x1b, 0xfc, 0xb2, 0xc3, 0x67, 0x0e, 0x2f, 0x3a,
-	0xfa, 0x4d, 0x80, 0x7f, 0xa8, 0x43, 0xdd, 0x04, 0x5e, 0x87, 0x0a, 0x2f, 0x3c, 0x0a, 0x6d, 0x80,
-	0x31, 0xe5, 0xc2, 0xae, 0x8b, 0x78, 0xa1, 0x71, 0xf3, 0xe6, 0x31, 0x3d, 0x03, 0xf0, 0xaa, 0xd0,
-	0xc5, 0xe6, 0x37, 0x33, 0x70, 0x33, 0x65, 0xda, 0xc4, 0x2e, 0x6f, 0x83, 0x16, 0x61, 0x59, 0x8e,
-	0x2e, 0xdf, 0xea, 0x1b, 0x92, 0xad, 0xc6, 0xc7, 0xd4, 0x6c, 0x9c, 0xc6, 0x01, 0xd1, 0x0d, 0x97,
-	0xcf, 0x60, 0x2c, 0x70, 0x06, 0x8a, 0x53, 0x7c, 0x1a, 0xf9, 0xe5, 0xf2, 0x08, 0xb6, 0x3a, 0x97,
-	0x8c, 0x63, 0x28, 0x97, 0xe9, 0xd1, 0xb3, 0xb9, 0xb4, 0x7c, 0x25, 0xb4, 0xf9, 0x99, 0x57, 0xd2,
-	0xe6, 0x8f, 0xf9, 0xb3, 0x76, 0x55, 0xd6, 0x4f, 0x53, 0x08, 0x1e, 0xa0, 0x2c, 0xcf, 0x09, 0x16,
-	0x21, 0x23, 0x68, 0x30, 0x10, 0x2f, 0xd4, 0x08, 0x60, 0xf9, 0x70, 0x3e, 0x09, 0x9d, 0xb6, 0x02,
-	0x91, 0x8f, 0x45, 0x1e, 0xac, 0x47, 0x8e, 0x5a, 0x6a, 0x45, 0xa0, 0x2a, 0xc2, 0xc1, 0x9a, 0xb2,
-	0x82, 0xac, 0xc5, 0xfa, 0x96, 0xa7, 0xf1, 0x1a, 0x8c, 0x9b, 0xb0, 0x19, 0xa5, 0xf8, 0xb0, 0xc9,
-	0xa3, 0xe6, 0x6f, 0x65, 0xf8, 0x5b, 0x0c, 0x8e, 0x1b, 0xb8, 0xf6, 0x2c, 0x38, 0xf7, 0x42, 0xd2,
-	0x81, 0xd5, 0xc0, 0x71, 0xcf, 0x26, 0x54, 0x2f, 0x3e, 0x10, 0x83, 0xb0, 0x1e, 0x6f, 0x1b, 0xcf,
-	0x1a, 0x98, 0x2b, 0x3c, 0x47, 0x54, 0x5a, 0x40, 0x76, 0xae, 0x6b, 0x64, 0xb4, 0x2c, 0x12, 0xa3,
-	0xb1, 0xd8, 0xf8, 0x2e, 0xd4, 0xe3, 0x15, 0x91, 0x4f, 0x45, 0x34, 0x88, 0xa8, 0x55, 0xb9, 0xc4,
-	0x5b, 0xf8, 0x68, 0x41, 0x54, 0xa2, 0xb1, 0x0f, 0x8c, 0xbf, 0x98, 0x81, 0xa6, 0x49, 0xd9, 0xca,
-	0xd5, 0x5a, 0x29, 0xd7, 0xcc, 0xf7, 0x16, 0x4a, 0xbd, 0xbe, 0xaf, 0x32, 0xc8, 0x84, 0x6c, 0xd1,
-	0x7b, 0xd7, 0x4e, 0xc6, 0xfe, 0x8d, 0x85, 0x1e, 0xed, 0x94, 0x60, 0x89, 0x93, 0x18, 0x9b, 0xb0,
-	0x2e, 0xda, 0x23, 0xdb, 0x12, 0x99, 0x6a, 0x63, 0x35, 0xc6, 0x4c, 0xb5, 0x5b, 0xd0, 0xe4, 0x8f,
-	0xb6, 0xf5, 0x4e, 0x88, 0x8c, 0xbb, 0x40, 0x0e, 0xed, 0x91, 0xed, 0x7b, 0x9e, 0x7b, 0x44, 0x7d,
-	0xe1, 0x0c, 0x8d, 0x12, 0x26, 0x5a, 0x32, 0xa5, 0x28, 0xcc, 0x53, 0x32, 0x78, 0xb7, 0xe7, 0x4a,
-	0xdf, 0x2f, 0x9e, 0x32, 0x7c, 0x58, 0xdd, 0xb1, 0x9f, 0x51, 0x59, 0x92, 0x1c, 0xa2, 0xc7, 0x50,
-	0x99, 0xa9, 0x42, 0xe5, 0xb8, 0xcb, 0x00, 0x3a, 0x8b, 0xd5, 0x9a, 0x3a, 0x35, 0x63, 0x41, 0xbe,
-	0xe7, 0x85, 0x18, 0x88, 0x42, 0x1a, 0xc3, 0xcc, 0x32, 0x03, 0x3d, 0xa5, 0x57, 0xdd, 0xb1, 0xf1,
-	0x10, 0xd6, 0xe2, 0x75, 0x0a, 0xd6, 0xb2, 0x05, 0xa5, 0xa9, 0x80, 0x89, 0xd6, 0xab, 0x34, 0xbb,
-	0x8c, 0xb0, 0x2b, 0x9f, 0xcc, 0xd3, 0xdd, 0x55, 0x57, 0xaa, 0xc7, 0xb0, 0xb9, 0x80, 0x11, 0x05,
-	0xde, 0x85, 0xaa, 0xd6, 0x10, 0xde, 0x8d, 0x3c, 0x13, 0x59, 0x45, 0x4b, 0x02, 0xe3, 0x73, 0xd8,
-	0xe4, 0xf7, 0xb1, 0x28, 0xbb, 0x1c, 0x82, 0x44, 0x2f, 0x32, 0xc9, 0x5e, 0x7c, 0x2c, 0xaf, 0x79,
-	0x7a, 0xd6, 0x28, 0x30, 0xdd, 0x18, 0x71, 0xd2, 0x7d, 0x47, 0x26, 0x8d, 0x63, 0xd8, 0x58, 0x1c,
-	0x3e, 0xd6, 0xfe, 0x3f, 0xd3, 0x90, 0xcb, 0xe1, 0x89, 0xd0, 0x6a, 0x78, 0xfe, 0x6b, 0x86, 0x8f,
-	0x4f, 0x0c, 0x25, 0x9a, 0x39, 0x06, 0x32, 0xa5, 0xe1, 0xb9, 0x37, 0xb6, 0x16, 0x6b, 0x7e, 0xa4,
-	0xbc, 0x87, 0x52, 0xf3, 0x6e, 0x1f, 0x62, 0x46, 0x0d, 0x23, 0xfc, 0xd8, 0xa7, 0x49, 0xf8, 0xd6,
-	0x08, 0x36, 0xd2, 0x89, 0x53, 0x7c, 0x6e, 0x3e, 0x8a, 0x0b, 0xea, 0xb7, 0xaf, 0xed, 0x3e, 0x6b,
-	0x96, 0x2e, 0xb7, 0xff, 0x56, 0x09, 0x8a, 0x42, 0x4b, 0x42, 0xb6, 0x21, 0x3f, 0x92, 0xfe, 0x9b,
-	0x51, 0x70, 0x42, 0x81, 0x95, 0xff, 0xdb, 0xe8, 0xc5, 0xc9, 0xe8, 0xc8, 0x63, 0xa8, 0xc7, 0x5d,
-	0x18, 0x12, 0x41, 0x49, 0xe2, 0xbe, 0x07, 0xb5, 0x51, 0xc2, 0x58, 0x5d, 0x8e, 0x84, 0x2b, 0x2e,
-	0x73, 0x96, 0xce, 0x35, 0xe9, 0xcb, 0x73, 0xd9, 0x7d, 0x2d, 0x38, 0xb7, 0xad, 0x87, 0x8f, 0x3e,
-	0x11, 0x51, 0x49, 0x2a, 0x08, 0x1c, 0x9c, 0xdb, 0x0f, 0x1f, 0x7d, 0x92, 0xbc, 0x89, 0x89, 0x98,
-	0x24, 0xda, 0x4d, 0x6c, 0x0d, 0x0a, 0x3c, 0xc2, 0x39, 0x77, 0xc4, 0xe3, 0x09, 0xf2, 0x00, 0xd6,
-	0xa4, 0xe2, 0x4d, 0x3c, 0x99, 0xe0, 0xa7, 0x28, 0xff, 0xc0, 0x13, 0x11, 0xb8, 0x01, 0xa2, 0xb8,
-	0xaa, 0x6e, 0x03, 0x96, 0xce, 0xa3, 0x90, 0xf5, 0x35, 0x53, 0xa4, 0x8c, 0x3f, 0x28, 0x40, 0x45,
-	0x1b, 0x14, 0x52, 0x85, 0x92, 0xd9, 0x19, 0x74, 0xcc, 0x2f, 0x3a, 0xbb, 0x8d, 0x1b, 0xe4, 0x1e,
-	0xbc, 0xd5, 0xed, 0xb5, 0xfb, 0xa6, 0xd9, 0x69, 0x0f, 0xad, 0xbe, 0x69, 0xc9, 0x10, 0x99, 0x47,
-	0xad, 0xaf, 0x0e, 0x3b, 0xbd, 0xa1, 0xb5, 0xdb, 0x19, 0xb6, 0xba, 0x07, 0x83, 0x46, 0x86, 0xbc,
-	0x06, 0xcd, 0x88, 0x52, 0xa2, 0x5b, 0x87, 0xfd, 0xe3, 0xde, 0xb0, 0x91, 0x25, 0x77, 0xe0, 0xd6,
-	0x5e, 0xb7, 0xd7, 0x3a, 0xb0, 0x22, 0x9a, 0xf6, 0xc1, 0xf0, 0x0b, 0xab, 0xf3, 0xf3, 0x47, 0x5d,
-	0xf3, 0xab, 0x46, 0x2e, 0x8d, 0x60, 0x7f, 0x78, 0xd0, 0x96, 0x25, 0xe4, 0xc9, 0x4d, 0x58, 0xe7,
-	0x04, 0x3c, 0x8b, 0x35, 0xec, 0xf7, 0xad, 0x41, 0xbf, 0xdf, 0x6b, 0x14, 0xc8, 0x0a, 0xd4, 0xba,
-	0xbd, 0x2f, 0x5a, 0x07, 0xdd, 0x5d, 0xcb, 0xec, 0xb4, 0x0e, 0x0e, 0x1b, 0x4b, 0x64, 0x15, 0x96,
-	0x93, 0x74, 0x45, 0x56, 0x84, 0xa4, 0xeb, 0xf7, 0xba, 0xfd, 0x9e, 0xf5, 0x45, 0xc7, 0x1c, 0x74,
-	0xfb, 0xbd, 0x46, 0x89, 0x6c, 0x00, 0x89, 0xa3, 0xf6, 0x0f, 0x5b, 0xed, 0x46, 0x99, 0xac, 0xc3,
-	0x4a, 0x1c, 0xfe, 0xb4, 0xf3, 0x55, 0x03, 0x48, 0x13, 0xd6, 0x78, 0xc3, 0xac, 0x9d, 0xce, 0x41,
-	0xff, 0x4b, 0xeb, 0xb0, 0xdb, 0xeb, 0x1e, 0x1e, 0x1f, 0x36, 0x2a, 0x18, 0xa8, 0xb8, 0xd3, 0xb1,
-	0xba, 0xbd, 0xc1, 0xf1, 0xde, 0x5e, 0xb7, 0xdd, 0xed, 0xf4, 0x86, 0x8d, 0x2a, 0xaf, 0x39, 0xad,
-	0xe3, 0x35, 0x96, 0x41, 0x3c, 0x92, 0xb3, 0x76, 0xbb, 0x83, 0xd6, 0xce, 0x41, 0x67, 0xb7, 0x51,
-	0x27, 0xb7, 0xe1, 0xe6, 0xb0, 0x73, 0x78, 0xd4, 0x37, 0x5b, 0xe6, 0x57, 0xf2, 0x11, 0x9d, 0xb5,
-	0xd7, 0xea, 0x1e, 0x1c, 0x9b, 0x9d, 0xc6, 0x32, 0x79, 0x03, 0x6e, 0x9b, 0x9d, 0x1f, 0x1f, 0x77,
-	0xcd, 0xce, 0xae, 0xd5, 0xeb, 0xef, 0x76, 0xac, 0xbd, 0x4e, 0x6b, 0x78, 0x6c, 0x76, 0xac, 0xc3,
-	0xee, 0x60, 0xd0, 0xed, 0x3d, 0x69, 0x34, 0xc8, 0x5b, 0x70, 0x57, 0x91, 0xa8, 0x02, 0x12, 0x54,
-	0x2b, 0xac, 0x7f, 0x72, 0x4a, 0x7b, 0x9d, 0x9f, 0x1f, 0x5a, 0x47, 0x9d, 0x8e, 0xd9, 0x20, 0x64,
-	0x0b, 0x36, 0xa2, 0xea, 0x79, 0x05, 0xa2, 0xee, 0x55, 0x86, 0x3b, 0xea, 0x98, 0x87, 0xad, 0x1e,
-	0x9b, 0xe0, 0x18, 0x6e, 0x8d, 0x35, 0x3b, 0xc2, 0x25, 0x9b, 0xbd, 0x4e, 0x08, 0xd4, 0xb5, 0x59,
-	0xd9, 0x6b, 0x99, 0x8d, 0x0d, 0xb2, 0x0c, 0x95, 0xc3, 0xa3, 0x23, 0x6b, 0xd8, 0x3d, 0xec, 0xf4,
-	0x8f, 0x87, 0x8d, 0x4d, 0xb2, 0x0e, 0x8d, 0x6e, 0x6f, 0xd8, 0x31, 0xd9, 0x5c, 0xcb, 0xac, 0xff,
-	0xad, 0x48, 0xd6, 0x60, 0x59, 0xb6, 0x54, 0x42, 0xff, 0xb8, 0x48, 0x36, 0x81, 0x1c, 0xf7, 0xcc,
-	0x4e, 0x6b, 0x97, 0x0d, 0x9c, 0x42, 0xfc, 0xf7, 0xa2, 0x30, 0x67, 0xfe, 0x6e, 0x4e, 0x09, 0x7b,
-	0x91, 0x7f, 0x50, 0xfc, 0x1b, 0x33, 0x55, 0xed, 0xdb, 0x30, 0x2f, 0xfb, 0xb4, 0x9f, 0x76, 0x35,
-	0xcf, 0x2d, 0x5c, 0xcd, 0x17, 0x74, 0x3f, 0x35, 0xfd, 0xee, 0xf0, 0x26, 0xd4, 0xa6, 0xfc, 0x7b,
-	0x33, 0xe2, 0x83, 0x05, 0x20, 0x9c, 0xe5, 0x38, 0x90, 0x7f, 0xad, 0x60, 0xe1, 0xdb, 0x76, 0x85,
-	0xc5, 0x6f, 0xdb, 0xa5, 0xdd, 0x0f, 0x97, 0xd2, 0xee, 0x87, 0xf7, 0x61, 0x85, 0xb3, 0x26, 0xc7,
-	0x75, 0xa6, 0x52, 0xeb, 0x22, 0xbe, 0x14, 0x87, 0x2c, 0x8a, 0xc3, 0xe5, 0x75, 0x54, 0x5e, 0x59,
-	0x05, 0x0b, 0x29, 0x8a, 0xdb, 0x6a, 0xec, 0xa6, 0xca, 0x39, 0x87, 0xba, 0xa9, 0xaa, 0x1a, 0xec,
-	0xcb, 0xa8, 0x86, 0x8a, 0x56, 0x03, 0x87, 0x63, 0x0d, 0xf7, 0x61, 0x85, 0x5e, 0x86, 0xbe, 0x6d,
-	0x79, 0x33, 0xfb, 0xeb, 0x39, 0xfa, 0x5b, 0xd8, 0xa8, 0x03, 0xaa, 0x9a, 0xcb, 0x88, 0xe8, 0x23,
-	0x7c, 0xd7, 0x0e, 0x6d, 0xe3, 0x97, 0x00, 0xd4, 0xa9, 0x8a, 0x5f, 0xdc, 0x73, 0x3d, 0xf9, 0x24,
-	0xb2, 0x6a, 0xf2, 0x04, 0xce, 0x63, 0xe8, 0xf9, 0xf6, 0x19, 0xed, 0xca, 0xc0, 0x3e, 0x11, 0x80,
-	0xdc, 0x82, 0x9c, 0x37, 0x93, 0xae, 0x64, 0x65, 0x19, 0x81, 0x7b, 0x66, 0x32, 0xa8, 0xf1, 0x09,
-	0x64, 0xfb, 0xb3, 0x6b, 0x45, 0xa5, 0x26, 0x14, 0xe5, 0xd7, 0x6c, 0xb3, 0xe8, 0x3e, 0x26, 0x93,
-	0xf7, 0xff, 0x7f, 0xa8, 0x68, 0x9f, 0x48, 0x22, 0x9b, 0xb0, 0xfa, 0x65, 0x77, 0xd8, 0xeb, 0x0c,
-	0x06, 0xd6, 0xd1, 0xf1, 0xce, 0xd3, 0xce, 0x57, 0xd6, 0x7e, 0x6b, 0xb0, 0xdf, 0xb8, 0xc1, 0x78,
-	0x49, 0xaf, 0x33, 0x18, 0x76, 0x76, 0x63, 0xf0, 0x0c, 0x79, 0x1d, 0xb6, 0x8e, 0x7b, 0xc7, 0x83,
-	0xce, 0xae, 0x95, 0x96, 0x2f, 0xcb, 0x36, 0x8f, 0xc0, 0xa7, 0x64, 0xcf, 0xdd, 0xff, 0x65, 0xa8,
-	0xc7, 0xc3, 0x5c, 0x10, 0x80, 0xa5, 0x83, 0xce, 0x93, 0x56, 0xfb, 0x2b, 0x1e, 0x61, 0x7d, 0x30,
-	0x6c, 0x0d, 0xbb, 0x6d, 0x4b, 0x44, 0x54, 0x67, 0x8c, 0x2a, 0x43, 0x2a, 0x50, 0x6c, 0xf5, 0xda,
-	0xfb, 0x7d, 0x73, 0xd0, 0xc8, 0x92, 0xd7, 0x60, 0x53, 0x6e, 0xa1, 0x76, 0xff, 0xf0, 0xb0, 0x3b,
-	0x44, 0x1e, 0x3d, 0xfc, 0xea, 0x88, 0xed, 0x98, 0xfb, 0x36, 0x94, 0xa3, 0x60, 0xf0, 0xc8, 0xf7,
-	0xba, 0xc3, 0x6e, 0x6b, 0x18, 0x31, 0xfd, 0xc6, 0x0d, 0xc6, 0x56, 0x23, 0x30, 0x46, 0x74, 0x6f,
-	0x64, 0xf8, 0x4b, 0x60, 0x09, 0xe4, 0xb5, 0x37, 0xb2, 0x6c, 0xaf, 0x47, 0xd0, 0x9d, 0xfe, 0x90,
-	0x75, 0xe1, 0x57, 0xa0, 0x1e, 0x8f, 0xb9, 0x4e, 0x1a, 0x50, 0x65, 0xf5, 0x6b, 0x55, 0x00, 0x2c,
-	0xf1, 0x16, 0x37, 0x32, 0x9c, 0xb1, 0xb7, 0xfb, 0x87, 0xdd, 0xde, 0x13, 0x3c, 0x0d, 0x1a, 0x59,
-	0x06, 0xea, 0x1f, 0x0f, 0x9f, 0xf4, 0x15, 0x28, 0xc7, 0x72, 0xf0, 0xee, 0x34, 0xf2, 0xf7, 0xbf,
-	0x86, 0x95, 0x85, 0xe8, 0xec, 0xac, 0xd5, 0xfd, 0xe3, 0x61, 0xbb, 0x7f, 0xa8, 0xd7, 0x53, 0x81,
-	0x62, 0xfb, 0xa0, 0xd5, 0x3d, 0x44, 0x43, 0x48, 0x0d, 0xca, 0xc7, 0x3d, 0x99, 0xcc, 0xc6, 0xe3,
-	0xca, 0xe7, 0x18, 0x8b, 0xda, 0xeb, 0x9a, 0x83, 0xa1, 0x35, 0x18, 0xb6, 0x9e, 0x74, 0x1a, 0x79,
-	0x96, 0x57, 0xf2, 0xab, 0xc2, 0xfd, 0xcf, 0xa1, 0x1e, 0xf7, 0x7b, 0x8e, 0x1b, 0xb0, 0xb6, 0x60,
-	0x63, 0xa7, 0x33, 0xfc, 0xb2, 0xd3, 0xe9, 0xe1, 0x94, 0xb7, 0x3b, 0xbd, 0xa1, 0xd9, 0x3a, 0xe8,
-	0x0e, 0xbf, 0x6a, 0x64, 0xee, 0x3f, 0x86, 0x46, 0xd2, 0xc9, 0x20, 0xe6, 0x95, 0xf1, 0x22, 0xf7,
-	0x8d, 0xfb, 0xff, 0x29, 0x03, 0x6b, 0x69, 0xf6, 0x35, 0xb6, 0x30, 0x05, 0x23, 0x64, 0xc7, 0xe1,
-	0xa0, 0xdf, 0xb3, 0x7a, 0x7d, 0x0c, 0xb4, 0xbc, 0x05, 0x1b, 0x09, 0x84, 0xec, 0x45, 0x86, 0xdc,
-	0x82, 0xcd, 0x85, 0x4c, 0x96, 0xd9, 0x3f, 0xc6, 0xb9, 0x6c, 0xc2, 0x5a, 0x02, 0xd9, 0x31, 0xcd,
-	0xbe, 0xd9, 0xc8, 0x91, 0xf7, 0xe0, 0x5e, 0x02, 0xb3, 0x28, 0x04, 0x48, 0x19, 0x21, 0x4f, 0xde,
-	0x81, 0x37, 0x17, 0xa8, 0xa3, 0x73, 0xd2, 0xda, 0x69, 0x1d, 0xb0, 0xee, 0x35, 0x0a, 0xf7, 0xff,
-	0x7e, 0x0e, 0x20, 0x7a, 0x58, 0xc8, 0xea, 0xdf, 0x6d, 0x0d, 0x5b, 0x07, 0x7d, 0xb6, 0x67, 0xcc,
-	0xfe, 0x90, 0x95, 0x6e, 0x76, 0x7e, 0xdc, 0xb8, 0x91, 0x8a, 0xe9, 0x1f, 0xb1, 0x0e, 0x6d, 0xc2,
-	0x2a, 0x5f, 0x7f, 0x07, 0xac, 0x1b, 0x6c, 0xb9, 0x60, 0xcc, 0x6e, 0x94, 0x34, 0x8e, 0x8f, 0xf6,
-	0xcc, 0x7e, 0x6f, 0x68, 0x0d, 0xf6, 0x8f, 0x87, 0xbb, 0x18, 0xf1, 0xbb, 0x6d, 0x76, 0x8f, 0x78,
-	0x99, 0xf9, 0x17, 0x11, 0xb0, 0xa2, 0x0b, 0x6c, 0x83, 0x3f, 0xe9, 0x0f, 0x06, 0xdd, 0x23, 0xeb,
-	0xc7, 0xc7, 0x1d, 0xb3, 0xdb, 0x19, 0x60, 0xc6, 0xa5, 0x14, 0x38, 0xa3, 0x2f, 0xb2, 0x35, 0x3b,
-	0x3c, 0xf8, 0x42, 0x08, 0x10, 0x8c, 0xb4, 0x14, 0x07, 0x31, 0xaa, 0x32, 0x9b, 0x1d, 0x76, 0x02,
-	0xa7, 0x94, 0x0c, 0xd7, 0xe0, 0x58, 0xbe, 0x0a, 0x93, 0x2d, 0x16, 0x76, 0x3e, 0x66, 0xab, 0xa6,
-	0xa3, 0x58, 0x2e, 0x14, 0x3b, 0x94, 0x90, 0xb6, 0xbb, 0x6b, 0x62, 0x86, 0xfa, 0x02, 0x94, 0xd1,
-	0x2e, 0xb3, 0x45, 0xc8, 0x8e, 0x68, 0x46, 0xd2, 0x90, 0x09, 0x86, 0x59, 0x79, 0xf8, 0x2f, 0xde,
-	0x80, 0xb2, 0x7a, 0x60, 0x40, 0x7e, 0x04, 0xb5, 0xd8, 0xf3, 0x7d, 0x22, 0x55, 0xf8, 0x69, 0xaf,
-	0xfd, 0xb7, 0x5e, 0x4b, 0x47, 0x8a, 0xcb, 0xc9, 0xa1, 0xa6, 0x0d, 0xe0, 0x85, 0xbd, 0x96, 0xbc,
-	0xa1, 0xc7, 0x4a, 0xbb, 0x7d, 0x0d, 0x56, 0x14, 0xf7, 0x14, 0xc3, 0x87, 0xeb, 0x9f, 0x46, 0x27,
-	0xb7, 0xa3, 0x58, 0xce, 0x29, 0x9f, 0x4c, 0xdf, 0xba, 0xb9, 0xf8, 0x11, 0x73, 0xf9, 0xd5, 0xf3,
-	0x5d, 0xa8, 0x68, 0x1f, 0xb5, 0x24, 0x37, 0xaf, 0xfd, 0x00, 0xe7, 0xd6, 0x56, 0x1a, 0x4a, 0x34,
-	0xe9, 0xfb, 0x50, 0x56, 0x1f, 0x13, 0x24, 0x9b, 0xda, 0xc7, 0x29, 0xf5, 0x8f, 0x2b, 0x6e, 0x35,
-	0x17, 0x11, 0x22, 0xff, 0x2e, 0x54, 0xb4, 0x6f, 0x02, 0xaa, 0x56, 0x2c, 0x7e, 0x77, 0x50, 0xb5,
-	0x22, 0xed, 0x13, 0x82, 0x07, 0xb0, 0x2e, 0x74, 0x0e, 0x27, 0xf4, 0x9b, 0x0c, 0x4f, 0xca, 0x37,
-	0xde, 0x1f, 0x64, 0xc8, 0x63, 0x28, 0xc9, 0xef, 0x48, 0x92, 0x8d, 0xf4, 0xef, 0x6d, 0x6e, 0x6d,
-	0x2e, 0xc0, 0x45, 0x53, 0x5a, 0x00, 0xd1, 0xd7, 0x06, 0x89, 0xec, 0xf8, 0xc2, 0xd7, 0x0b, 0xd5,
-	0xcc, 0xa4, 0x7c, 0x9a, 0x70, 0x17, 0x2a, 0xda, 0x87, 0x05, 0xd5, 0x98, 0x2c, 0x7e, 0x94, 0x50,
-	0x8d, 0x49, 0xda, 0x77, 0x08, 0x7f, 0x04, 0xb5, 0xd8, 0x17, 0x02, 0xd5, 0x3a, 0x4e, 0xfb, 0xfe,
-	0xa0, 0x5a, 0xc7, 0xe9, 0x1f, 0x15, 0xdc, 0x85, 0x8a, 0xf6, 0xd5, 0x3e, 0xd5, 0xa2, 0xc5, 0x4f,
-	0x07, 0xaa, 0x16, 0xa5, 0x7c, 0xe4, 0x8f, 0xed, 0x86, 0xf8, 0x27, 0xfb, 0xd4, 0x6e, 0x48, 0xfd,
-	0xf6, 0x9f, 0xda, 0x0d, 0xe9, 0xdf, 0xf9, 0x63, 0x4b, 0x4f, 0x7d, 0x37, 0x80, 0x6c, 0xc6, 0xae,
-	0xfa, 0xd1, 0x07, 0x08, 0xd4, 0xd2, 0x5b, 0xfc, 0xc4, 0xc0, 0x13, 0x58, 0x55, 0x8b, 0x46, 0x45,
-	0xfd, 0x0f, 0x54, 0x9b, 0x52, 0xbf, 0x2d, 0xb0, 0xd5, 0x48, 0x62, 0x1f, 0x64, 0xc8, 0x67, 0x50,
-	0x14, 0xa1, 0xd4, 0xc9, 0x7a, 0x32, 0xb4, 0x3a, 0x6f, 0xc4, 0x46, 0x7a, 0xc4, 0x75, 0x72, 0x84,
-	0x1b, 0x5a, 0x8f, 0x75, 0xae, 0xaf, 0xd8, 0x94, 0xf0, 0xe8, 0x5b, 0xaf, 0x5f, 0x87, 0x8e, 0x4a,
-	0x4c, 0xc6, 0xe7, 0xbf, 0x7d, 0x5d, 0x58, 0x9d, 0x78, 0x89, 0xd7, 0xc5, 0xff, 0x7b, 0x02, 0x55,
-	0xfd, 0x73, 0x4d, 0x44, 0xdf, 0x87, 0xc9, 0xb2, 0x6e, 0xa5, 0xe2, 0x44, 0x41, 0x5f, 0xc0, 0x86,
-	0x1a, 0x6f, 0x3d, 0xc6, 0x4b, 0x40, 0xee, 0xa4, 0x44, 0x7e, 0x89, 0x8d, 0xfa, 0xcd, 0x6b, 0x43,
-	0xc3, 0x3c, 0xc8, 0x20, 0x93, 0x8d, 0x7d, 0x61, 0x25, 0x62, 0xb2, 0x69, 0x1f, 0x96, 0x89, 0x98,
-	0x6c, 0xfa, 0x67, 0x59, 0x5a, 0xb0, 0xac, 0xc5, 0xa8, 0x19, 0x5c, 0xb9, 0x23, 0xb5, 0xde, 0x17,
-	0x83, 0x50, 0x6f, 0xa5, 0x69, 0xbe, 0x49, 0x1b, 0x2a, 0x7a, 0x98, 0x9b, 0x17, 0x64, 0xdf, 0xd4,
-	0x50, 0x7a, 0x0c, 0xe1, 0x07, 0x19, 0x72, 0x00, 0x8d, 0x64, 0x50, 0x4a, 0xb5, 0x85, 0xd3, 0x02,
-	0x79, 0x6e, 0x25, 0x90, 0xb1, 0x50, 0x96, 0x6c, 0x5d, 0xc4, 0x3e, 0x25, 0xee, 0xf9, 0xc9, 0xa3,
-	0x28, 0xfe, 0x89, 0x71, 0x55, 0x5a, 0xda, 0xd7, 0xe5, 0xef, 0x65, 0x1e, 0x64, 0xc8, 0x1e, 0x54,
-	0x63, 0x31, 0xd9, 0x62, 0x6f, 0x5d, 0x12, 0xdd, 0x6c, 0xea, 0xb8, 0x44, 0x3f, 0x0f, 0xa1, 0x1e,
-	0x77, 0xd1, 0x50, 0x0d, 0x4b, 0xf5, 0x23, 0x51, 0xd3, 0x97, 0xee, 0xd7, 0x41, 0x7e, 0x00, 0x15,
-	0xc6, 0x93, 0xa5, 0x2b, 0x1f, 0xd1, 0xf8, 0x74, 0x72, 0xce, 0x38, 0x4c, 0xa8, 0xa2, 0x73, 0x7f,
-	0x21, 0x9b, 0xc1, 0x7e, 0x7d, 0x8f, 0x7f, 0x8a, 0x59, 0x7a, 0x73, 0xb1, 0xf9, 0x7f, 0xd5, 0x42,
-	0xc8, 0x1e, 0xaf, 0x7c, 0xe8, 0xf1, 0x27, 0xec, 0x37, 0x35, 0x1a, 0x01, 0x7b, 0xb5, 0x36, 0xb4,
-	0x78, 0x1b, 0x44, 0x9e, 0xd8, 0x1a, 0x7c, 0xc5, 0xb2, 0xc8, 0xa7, 0x00, 0x91, 0x8b, 0x2c, 0x49,
-	0x38, 0x6a, 0xaa, 0x0d, 0x95, 0xe2, 0x45, 0xdb, 0xe1, 0xfb, 0x5d, 0x79, 0x8a, 0xea, 0x47, 0x72,
-	0xdc, 0x69, 0x35, 0x76, 0x24, 0x27, 0x8b, 0xf9, 0x08, 0x6a, 0x07, 0x9e, 0xf7, 0x6c, 0x3e, 0x53,
-	0xef, 0x2c, 0xe2, 0x6e, 0x4c, 0xfb, 0x76, 0x70, 0xbe, 0x95, 0x68, 0x16, 0x69, 0xc1, 0x8a, 0x62,
-	0x11, 0x91, 0xab, 0x6a, 0x9c, 0x28, 0xc6, 0x18, 0x12, 0x05, 0x3c, 0xc8, 0x90, 0x87, 0x50, 0xdd,
-	0xa5, 0x23, 0x0c, 0xb3, 0x81, 0x4e, 0x33, 0xab, 0x31, 0x07, 0x0c, 0xee, 0x6d, 0xb3, 0x55, 0x8b,
-	0x01, 0x25, 0x8b, 0x8b, 0x1c, 0xb7, 0xf4, 0x33, 0x23, 0xee, 0xfd, 0x14, 0x63, 0x71, 0x0b, 0xce,
-	0x5b, 0x5f, 0xc0, 0xca, 0x82, 0x6b, 0x94, 0xe2, 0x6e, 0xd7, 0x39, 0x54, 0x6d, 0xdd, 0xbd, 0x9e,
-	0x40, 0x94, 0xfb, 0x43, 0xa8, 0xf1, 0x90, 0xd2, 0x27, 0x94, 0x3f, 0x93, 0x4d, 0x04, 0x0c, 0xd3,
-	0xdf, 0xe0, 0x26, 0x59, 0x12, 0xcf, 0xf0, 0x04, 0x3f, 0x46, 0xa3, 0x3d, 0x42, 0x55, 0xf3, 0xba,
-	0xf8, 0x30, 0x56, 0xcd, 0x6b, 0xda, 0x7b, 0xd7, 0xcf, 0xa1, 0xf2, 0x84, 0x86, 0xf2, 0x59, 0xa7,
-	0x92, 0x8f, 0x12, 0xef, 0x3c, 0xb7, 0x52, 0x1e, 0xe3, 0x92, 0x4f, 0x30, 0xab, 0x0a, 0x51, 0xb0,
-	0xa1, 0xd5, 0xa2, 0x67, 0x5d, 0x4e, 0xc0, 0x99, 0xf4, 0xa1, 0x05, 0x2a, 0x51, 0x0d, 0x5f, 0x0c,
-	0x4c, 0xa3, 0x1a, 0x9e, 0x16, 0xd7, 0xe4, 0x07, 0x7c, 0x04, 0xb4, 0x87, 0xa4, 0x91, 0x08, 0x96,
-	0x7c, 0x73, 0xaa, 0x9a, 0xaf, 0x93, 0x3f, 0x02, 0x18, 0x84, 0xde, 0x6c, 0xd7, 0xa6, 0x53, 0xcf,
-	0x8d, 0x78, 0x42, 0xf4, 0x84, 0x31, 0xda, 0x88, 0xda, 0x3b, 0x46, 0xf2, 0xa5, 0x26, 0x9b, 0xc6,
-	0xa6, 0x44, 0x4e, 0xfb, 0xb5, 0xaf, 0x1c, 0x55, 0x77, 0x52, 0x5e, 0x3a, 0x22, 0x93, 0x80, 0xc8,
-	0xf3, 0x4c, 0x49, 0x9a, 0x0b, 0x4e, 0x6d, 0x6a, 0xaf, 0xa7, 0xb8, 0xa9, 0x7d, 0x1f, 0xca, 0x91,
-	0xcb, 0xce, 0x66, 0x14, 0x35, 0x29, 0xe6, 0xe0, 0xa3, 0xb8, 0xf7, 0xa2, 0xbb, 0x4c, 0x0f, 0x56,
-	0x79, 0x73, 0xd4, 0xf1, 0x87, 0x0f, 0xed, 0xd4, 0xb7, 0x94, 0x16, 0xfd, 0x54, 0xd4, 0xfe, 0x49,
-	0xf3, 0xb6, 0x60, 0xfb, 0x67, 0xc1, 0x6a, 0xaf, 0xf6, 0xcf, 0x75, 0x6e, 0x18, 0x6a, 0xff, 0x5c,
-	0x6f, 0xf0, 0xef, 0xc1, 0x6a, 0x8a, 0xfd, 0x9d, 0xbc, 0x21, 0x2f, 0x36, 0xd7, 0xda, 0xe6, 0xb7,
-	0x52, 0xed, 0xb4, 0x64, 0x08, 0x9b, 0x3c, 0x4f, 0x6b, 0x32, 0x49, 0x98, 0x7b, 0x5f, 0xd7, 0x32,
-	0xa4, 0x98, 0xb0, 0x63, 0xa2, 0x4c, 0xc2, 0x8c, 0xdd, 0x83, 0x46, 0xd2, 0x52, 0x4a, 0xae, 0x27,
-	0xdf, 0xba, 0x13, 0x13, 0xd9, 0x17, 0xad, 0xab, 0xe4, 0x0b, 0x65, 0xaf, 0x4d, 0xb4, 0xf1, 0x4e,
-	0xf4, 0x09, 0xc0, 0x54, 0xeb, 0xb2, 0xba, 0x0d, 0xa4, 0x9a, 0x7b, 0xc9, 0xcf, 0xc3, 0x66, 0x72,
-	0x45, 0xcb, 0x92, 0xef, 0xa6, 0x0d, 0xd7, 0xb5, 0xa2, 0x5c, 0xbc, 0x43, 0x0f, 0x32, 0x8c, 0x11,
-	0xeb, 0x56, 0x55, 0xb5, 0x90, 0x52, 0xcc, 0xbb, 0x6a, 0x21, 0xa5, 0x9a, 0x61, 0x8f, 0x60, 0x39,
-	0x61, 0x50, 0x55, 0x62, 0x70, 0xba, 0x09, 0x56, 0x89, 0xc1, 0xd7, 0xd9, 0x61, 0x07, 0xd0, 0x48,
-	0x9a, 0x4a, 0xd5, 0x5c, 0x5f, 0x63, 0x7e, 0xdd, 0xba, 0x73, 0x2d, 0x3e, 0xde, 0x4c, 0xcd, 0xa8,
-	0x18, 0x6b, 0xe6, 0xa2, 0x29, 0x34, 0xd6, 0xcc, 0x14, 0x93, 0xe6, 0xce, 0x3b, 0xbf, 0xf0, 0x9d,
-	0x33, 0x27, 0x3c, 0x9f, 0x9f, 0x6c, 0x8f, 0xbc, 0xe9, 0x07, 0x13, 0xa9, 0xd5, 0x10, 0xef, 0xce,
-	0x3f, 0x98, 0xb8, 0xe3, 0x0f, 0xb0, 0x80, 0x93, 0xa5, 0x99, 0xef, 0x85, 0xde, 0x47, 0xff, 0x27,
-	0x00, 0x00, 0xff, 0xff, 0x68, 0xba, 0x0c, 0x04, 0xb3, 0x8f, 0x00, 0x00,
+	// 12194 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbd, 0x7d, 0x59, 0x6c, 0x64, 0x59,
+	0x96, 0x50, 0xc5, 0x66, 0x47, 0xdc, 0x88, 0xb0, 0xc3, 0xcf, 0xe9, 0xb4, 0xd3, 0x55, 0x59, 0x55,
+	0xfd, 0xba, 0xba, 0xab, 0x3a, 0xbb, 0xdb, 0x55, 0x95, 0xb5, 0x77, 0x31, 0xdd, 0x1d, 0xb6, 0xc3,
+	0x99, 0xd1, 0xe5, 0xad, 0x5f, 0x84, 0xb3, 0xba, 0x5a, 0x33, 0x13, 0x13, 0x0e, 0x3f, 0xdb, 0x41,
+	0xc6, 0x56, 0xf1, 0xc2, 0xb9, 0x0c, 0x42, 0x9a, 0x8f, 0x61, 0x40, 0x08, 0x21, 0x21, 0xd1, 0x88,
+	0x6d, 0xc4, 0x00, 0x02, 0xfe, 0x46, 0xa0, 0x1e, 0xfe, 0xf8, 0x43, 0x62, 0x84, 0xc4, 0x22, 0xc4,
+	0x8c, 0x40, 0x68, 0x34, 0x12, 0x12, 0x0c, 0x1f, 0x48, 0x08, 0x69, 0x7e, 0x41, 0xe2, 0x6c, 0xf7,
+	0xbe, 0xfb, 0x96, 0xc8, 0x74, 0x75, 0x17, 0xfd, 0x63, 0xc7, 0x3d, 0x77, 0xbf, 0xf7, 0xdc, 0x73,
+	0xcf, 0x76, 0xcf, 0x53, 0xa5, 0xe9, 0xa4, 0xb7, 0x35, 0x99, 0x8e, 0x67, 0x63, 0xa7, 0x30, 0x18,
+	0x41, 0xc2, 0xfd, 0x93, 0x8c, 0xca, 0x9f, 0xcc, 0x9e, 0x8c, 0x9d, 0xf7, 0x54, 0xa5, 0x7b, 0x76,
+	0x36, 0xf5, 0x83, 0xa0, 0x33, 0x7b, 0x3a, 0xf1, 0x37, 0x32, 0xaf, 0x66, 0xde, 0x58, 0xba, 0xeb,
+	0x6c, 0x51, 0xb1, 0xad, 0x3a, 0x67, 0xb5, 0x21, 0xc7, 0x2b, 0x77, 0xc3, 0x84, 0xb3, 0xa1, 0x16,
+	0x25, 0xb9, 0x91, 0x85, 0x1a, 0x25, 0x4f, 0x27, 0x9d, 0xdb, 0x4a, 0x75, 0x87, 0xe3, 0xab, 0xd1,
+	0xac, 0x13, 0x74, 0x67, 0x1b, 0x39, 0xc8, 0xcc, 0x79, 0x25, 0x86, 0xb4, 0xba, 0x33, 0xe7, 0x45,
+	0x55, 0x9a, 0x3c, 0xec, 0x04, 0xbd, 0x69, 0x7f, 0x32, 0xdb, 0xc8, 0x53, 0xd5, 0xe2, 0xe4, 0x61,
+	0x8b, 0xd2, 0xce, 0x37, 0x55, 0x71, 0x7c, 0x35, 0x9b, 0x8c, 0xfb, 0xa3, 0xd9, 0x46, 0x01, 0xf2,
+	0xca, 0x77, 0x97, 0x65, 0x20, 0x47, 0x57, 0xb3, 0x63, 0x04, 0x7b, 0xa6, 0x80, 0xf3, 0x9a, 0xaa,
+	0xf6, 0xc6, 0xa3, 0xf3, 0xfe, 0x74, 0xd8, 0x9d, 0xf5, 0xc7, 0xa3, 0x60, 0x63, 0x81, 0xfa, 0x8a,
+	0x02, 0xdd, 0x7f, 0x95, 0x55, 0xe5, 0xf6, 0xb4, 0x3b, 0x0a, 0xba, 0x3d, 0x04, 0x38, 0xeb, 0x6a,
+	0x71, 0xf6, 0xa4, 0x73, 0xd9, 0x0d, 0x2e, 0x69, 0xaa, 0x25, 0x6f, 0x61, 0xf6, 0xe4, 0x3e, 0xa4,
+	0x9c, 0x9b, 0x6a, 0x81, 0x47, 0x49, 0x13, 0xca, 0x79, 0x92, 0x82, 0x31, 0xad, 0x8c, 0xae, 0x86,
+	0x9d, 0x68, 0x57, 0x38, 0xad, 0x82, 0x57, 0x83, 0x8c, 0x1d, 0x1b, 0x8e, 0x93, 0x3f, 0x1d, 0x8c,
+	0x7b, 0x0f, 0xb9, 0x03, 0x9e, 0x5e, 0x89, 0x20, 0xd4, 0xc7, 0x57, 0x54, 0x45, 0xb2, 0xfd, 0xfe,
+	0xc5, 0x25, 0xcf, 0xb1, 0xe0, 0x95, 0xb9, 0x00, 0x81, 0xb0, 0x85, 0x59, 0x7f, 0xe8, 0x77, 0x82,
+	0x59, 0x77, 0x38, 0x91, 0x29, 0x95, 0x10, 0xd2, 0x42, 0x00, 0x65, 0x8f, 0x67, 0xdd, 0x41, 0xe7,
+	0xdc, 0xf7, 0x83, 0x8d, 0x45, 0xc9, 0x46, 0xc8, 0x1e, 0x00, 0x9c, 0xaf, 0xa9, 0xa5, 0x33, 0x3f,
+	0x98, 0x75, 0x64, 0x33, 0xa0, 0x48, 0xf1, 0xd5, 0x1c, 0x8c, 0xa1, 0x8a, 0xd0, 0xba, 0x06, 0x3a,
+	0x2f, 0x29, 0x35, 0xed, 0x3e, 0xee, 0xe0, 0x42, 0xf8, 0x4f, 0x36, 0x4a, 0xbc, 0x0b, 0x00, 0x69,
+	0x3f, 0xb9, 0xef, 0x3f, 0x71, 0x6e, 0xa8, 0xc2, 0xa0, 0x7b, 0xea, 0x0f, 0x36, 0x14, 0x65, 0x70,
+	0xc2, 0xfd, 0xb1, 0xba, 0x79, 0xcf, 0x9f, 0x59, 0x4b, 0x19, 0x78, 0xfe, 0xe7, 0x57, 0xd0, 0x2c,
+	0xce, 0x0a, 0x46, 0x3b, 0x9d, 0xe9, 0x59, 0x65, 0x78, 0x56, 0x04, 0x0b, 0x67, 0xe5, 0x8f, 0xce,
+	0x74, 0x81, 0x2c, 0x15, 0x28, 0x01, 0x84, 0xb3, 0xdd, 0x7d, 0xe5, 0x58, 0x0d, 0xef, 0xfa, 0xb3,
+	0x6e, 0x7f, 0x10, 0x38, 0xef, 0xab, 0xca, 0xcc, 0xea, 0x0e, 0xda, 0xcd, 0x01, 0x46, 0x68, 0xd4,
+	0xb4, 0x2a, 0x78, 0x91, 0x72, 0xee, 0xa5, 0x2a, 0xc2, 0x62, 0xec, 0xf7, 0x87, 0xfd, 0x19, 0xec,
+	0x6a, 0xe1, 0xbc, 0xff, 0xc4, 0x3f, 0xa3, 0x41, 0xe5, 0xee, 0xbf, 0xe0, 0x71, 0xd2, 0x79, 0x45,
+	0x29, 0xfa, 0xd1, 0x19, 0x1a, 0x2c, 0x85, 0xcc, 0x12, 0xc1, 0x0e, 0x00, 0xe4, 0x6c, 0xaa, 0xc5,
+	0x89, 0x3f, 0xed, 0xf9, 0x1a, 0x1f, 0x20, 0x57, 0x03, 0xb6, 0x17, 0x61, 0x81, 0xb0, 0x75, 0xf7,
+	0xf7, 0x0b, 0xaa, 0xdc, 0x82, 0x69, 0xe8, 0x95, 0x70, 0x54, 0x1e, 0x17, 0x9a, 0x3a, 0xab, 0x78,
+	0xf4, 0xdb, 0xf9, 0xaa, 0x2a, 0xd3, 0x96, 0x04, 0xb3, 0x69, 0x7f, 0x74, 0xc1, 0xa7, 0x65, 0x3b,
+	0xbb, 0x91, 0xf1, 0x14, 0x82, 0x5b, 0x04, 0x75, 0x6a, 0x2a, 0xd7, 0x1d, 0xea, 0xd3, 0x82, 0x3f,
+	0x9d, 0x5b, 0xaa, 0x08, 0xff, 0x78, 0x78, 0x15, 0x02, 0x2f, 0x42, 0x9a, 0x86, 0x06, 0xeb, 0x3d,
+	0xe9, 0x3e, 0x1d, 0xc2, 0x48, 0x42, 0x34, 0xab, 0x78, 0x65, 0x81, 0x11, 0xa2, 0xdd, 0x55, 0xab,
+	0x76, 0x11, 0xdd, 0x79, 0xc1, 0x74, 0xbe, 0x62, 0x95, 0x96, 0x31, 0xbc, 0xae, 0x96, 0x75, 0x9d,
+	0x29, 0xcf, 0x87, 0xd0, 0xaf, 0xe4, 0x2d, 0x09, 0x58, 0xcf, 0xf2, 0x0d, 0x55, 0x3b, 0xef, 0x8f,
+	0x00, 0x07, 0x7b, 0x83, 0xd9, 0xa3, 0xce, 0x99, 0x3f, 0x98, 0x75, 0x09, 0x13, 0x0b, 0xde, 0x12,
+	0xc1, 0x77, 0x00, 0xbc, 0x8b, 0x50, 0xe7, 0x5b, 0xaa, 0x04, 0x78, 0xda, 0xa1, 0xc5, 0x02, 0x4c,
+	0xb4, 0x0f, 0xb4, 0xde, 0x21, 0xaf, 0x78, 0xae, 0xf7, 0xea, 0x5b, 0xaa, 0x06, 0x87, 0xfb, 0x02,
+	0x0e, 0xf7, 0x45, 0xa7, 0x77, 0xd9, 0x1d, 0x75, 0xfa, 0x67, 0x84, 0x9b, 0xf9, 0xed, 0xec, 0x5b,
+	0x19, 0x6f, 0x49, 0xe7, 0xed, 0x40, 0x56, 0xf3, 0xcc, 0xf9, 0xba, 0x5a, 0x1e, 0x74, 0x61, 0x5d,
+	0x2f, 0xc7, 0x93, 0xce, 0xe4, 0xea, 0xf4, 0xa1, 0xff, 0x74, 0xa3, 0x4a, 0x0b, 0x51, 0x45, 0xf0,
+	0xfd, 0xf1, 0xe4, 0x98, 0x80, 0x88, 0x7a, 0x34, 0x4e, 0x1e, 0x04, 0xa2, 0x74, 0xd5, 0x2b, 0x21,
+	0x84, 0x3b, 0xfd, 0x4c, 0xad, 0xd2, 0xf6, 0xf4, 0xae, 0x82, 0xd9, 0x78, 0x08, 0x33, 0xef, 0x8d,
+	0xa7, 0x67, 0xc1, 0x46, 0x99, 0x70, 0xed, 0x1b, 0x32, 0x58, 0x6b, 0x8f, 0xb7, 0x76, 0xe1, 0xcf,
+	0x0e, 0x15, 0xf6, 0xb8, 0x6c, 0x63, 0x34, 0x9b, 0x3e, 0xf5, 0x56, 0xce, 0xe2, 0x70, 0x98, 0x8f,
+	0xd3, 0x1d, 0x0c, 0xc6, 0x8f, 0x3b, 0x81, 0x3f, 0x38, 0xef, 0xc8, 0x22, 0x6e, 0x2c, 0xc1, 0x08,
+	0x8a, 0x5e, 0x8d, 0x72, 0x5a, 0x90, 0x71, 0xcc, 0x70, 0xc0, 0x76, 0x3a, 0xa4, 0x70, 0xb0, 0xbb,
+	0xb3, 0x2b, 0x38, 0xa7, 0x1b, 0xcb, 0x30, 0x84, 0xa5, 0xbb, 0x2b, 0x66, 0xbd, 0x08, 0xbc, 0x0d,
+	0x2b, 0x56, 0xc1, 0x72, 0x92, 0x0e, 0x36, 0x77, 0xd5, 0xcd, 0xf4, 0x21, 0x21, 0x52, 0xe1, 0xaa,
+	0x20, 0x32, 0xe6, 0x3d, 0xfc, 0x89, 0x27, 0xfb, 0x51, 0x77, 0x70, 0xe5, 0x13, 0x16, 0x56, 0x3c,
+	0x4e, 0x7c, 0x27, 0xfb, 0x61, 0xc6, 0xfd, 0xbd, 0x8c, 0xaa, 0xf0, 0x2c, 0x83, 0x09, 0x9c, 0x21,
+	0x1f, 0xd0, 0xb6, 0xaa, 0xb1, 0xc1, 0x9f, 0x4e, 0xc7, 0x53, 0xa1, 0x96, 0x1a, 0xf3, 0x1a, 0x08,
+	0x73, 0xbe, 0xa1, 0x6a, 0xba, 0xd0, 0x64, 0xea, 0xf7, 0x87, 0xdd, 0x0b, 0xdd, 0xb4, 0x46, 0xa5,
+	0x63, 0x01, 0x3b, 0x6f, 0x87, 0xed, 0x4d, 0x61, 0x27, 0x7d, 0xc2, 0xf5, 0xf2, 0xdd, 0x8a, 0x4c,
+	0xcf, 0x43, 0x98, 0x69, 0x9d, 0x52, 0xd7, 0xc0, 0x73, 0xf7, 0x27, 0x19, 0xe5, 0xe0, 0xb0, 0xdb,
+	0x63, 0x6e, 0x20, 0xa4, 0x48, 0x91, 0x9a, 0x99, 0x6b, 0x9f, 0x90, 0xec, 0xb3, 0x4e, 0x88, 0xab,
+	0x0a, 0x3c, 0xf6, 0x7c, 0xca, 0xd8, 0x39, 0xeb, 0x07, 0xf9, 0x62, 0xae, 0x96, 0x77, 0xff, 0x4b,
+	0x4e, 0xdd, 0x40, 0x3c, 0x1d, 0xf9, 0x83, 0x7a, 0xaf, 0xe7, 0x4f, 0xcc, 0xd9, 0x79, 0x45, 0x95,
+	0x47, 0xe3, 0x33, 0x5f, 0x63, 0x2c, 0x0f, 0x4c, 0x21, 0xc8, 0x42, 0xd7, 0xcb, 0x6e, 0x7f, 0xc4,
+	0x03, 0xe7, 0xc5, 0x2c, 0x11, 0x84, 0x86, 0x0d, 0x58, 0x3f, 0x81, 0xf9, 0xda, 0x47, 0x24, 0xc7,
+	0x58, 0x2f, 0x60, 0x39, 0x1d, 0xd0, 0xcf, 0xf9, 0x15, 0x97, 0x43, 0xc2, 0x92, 0x27, 0x1c, 0x50,
+	0x02, 0xaa, 0x33, 0x7d, 0x99, 0x5c, 0xc1, 0xbc, 0x31, 0xb7, 0x40, 0xb9, 0x8b, 0x98, 0xc6, 0x2c,
+	0x18, 0xc2, 0x19, 0x60, 0x93, 0x9c, 0x98, 0x05, 0xca, 0x2c, 0x21, 0x84, 0x4f, 0xcc, 0xb7, 0xd5,
+	0xea, 0xb0, 0xfb, 0xa4, 0x43, 0xb8, 0xd3, 0x81, 0x81, 0x9e, 0x0f, 0x88, 0xa8, 0x2f, 0x52, 0xb9,
+	0x1a, 0x64, 0x3d, 0xc0, 0x9c, 0xe6, 0x68, 0x8f, 0xe0, 0x48, 0x56, 0x7a, 0xbc, 0x12, 0x70, 0xb8,
+	0x02, 0x7f, 0xfa, 0xc8, 0x27, 0x4a, 0x90, 0xf7, 0x96, 0x04, 0xec, 0x31, 0x14, 0x47, 0x34, 0xc4,
+	0x79, 0xcf, 0x06, 0x3d, 0x3e, 0xf6, 0xde, 0x22, 0xa4, 0xef, 0x43, 0x12, 0xef, 0x2b, 0xa4, 0x23,
+	0x40, 0x7f, 0x3b, 0x0f, 0x1f, 0xd3, 0x19, 0xce, 0x13, 0xdd, 0x38, 0xf6, 0xa7, 0x9f, 0x3c, 0x46,
+	0x96, 0xa2, 0x17, 0x10, 0x21, 0xea, 0x3e, 0x85, 0x83, 0x8b, 0x07, 0xbc, 0x08, 0x80, 0x5d, 0x4c,
+	0xe3, 0x21, 0xc4, 0xd1, 0x76, 0x69, 0x17, 0x80, 0xde, 0x63, 0xf3, 0x01, 0x51, 0xd4, 0x2a, 0x0d,
+	0xb6, 0x2e, 0x19, 0xd8, 0x4f, 0x80, 0x58, 0xaf, 0x07, 0x7b, 0x3e, 0xe8, 0x5e, 0x04, 0x44, 0x52,
+	0xaa, 0x5e, 0x45, 0x80, 0x7b, 0x08, 0x73, 0xff, 0x34, 0xab, 0xd6, 0x62, 0x9b, 0x2b, 0x87, 0x06,
+	0x79, 0x08, 0x82, 0xd0, 0xc6, 0x16, 0x3d, 0x49, 0xa5, 0xed, 0x5a, 0x36, 0x6d, 0xd7, 0xe0, 0x7c,
+	0xf2, 0x61, 0xcb, 0xf1, 0xcd, 0xeb, 0xeb, 0x53, 0x76, 0x35, 0x39, 0x9f, 0x8e, 0x91, 0xa5, 0xba,
+	0xbc, 0x9a, 0x9d, 0x8d, 0x1f, 0x8f, 0x84, 0xb5, 0x58, 0x16, 0x78, 0x4b, 0xc0, 0xd1, 0xa5, 0x28,
+	0xc4, 0x96, 0x02, 0x70, 0x42, 0x76, 0x80, 0x58, 0x33, 0xde, 0x58, 0x25, 0x20, 0xe4, 0xcd, 0xbe,
+	0xa9, 0x1c, 0xb3, 0x9f, 0x1d, 0x5c, 0x35, 0xba, 0x7d, 0x78, 0x63, 0x97, 0xfb, 0xb2, 0xa1, 0x07,
+	0xdd, 0x27, 0x74, 0x0b, 0xbd, 0xa6, 0x96, 0xb0, 0x08, 0xae, 0x27, 0x30, 0x47, 0xc8, 0x37, 0x15,
+	0x79, 0xad, 0x00, 0x8a, 0x8b, 0xb9, 0x43, 0xdc, 0xd3, 0xcb, 0xaa, 0xac, 0x37, 0x15, 0x70, 0x45,
+	0xf6, 0xb5, 0x24, 0xfb, 0xda, 0x1c, 0xe1, 0x5d, 0x82, 0xf9, 0xbc, 0x4e, 0x30, 0xee, 0xc9, 0xec,
+	0x52, 0x68, 0xf4, 0x12, 0xc0, 0x79, 0x79, 0x77, 0x11, 0xea, 0xfe, 0x36, 0x50, 0x28, 0x59, 0x75,
+	0xe2, 0x04, 0x9d, 0x2d, 0xe5, 0x68, 0x14, 0x9f, 0x3d, 0xe9, 0x9f, 0x75, 0x4e, 0x9f, 0xce, 0xfc,
+	0x80, 0x4f, 0x14, 0x5c, 0xd6, 0x35, 0xc9, 0x6b, 0x43, 0xd6, 0x36, 0xe6, 0x38, 0x77, 0x54, 0x2d,
+	0x52, 0x1e, 0x4e, 0x3c, 0x1f, 0x77, 0x28, 0xbd, 0x64, 0x95, 0x86, 0xc3, 0x8e, 0x04, 0x04, 0xf9,
+	0xcc, 0xab, 0x19, 0x0c, 0xfa, 0x0c, 0x58, 0xa4, 0x1c, 0x0d, 0xa9, 0xcc, 0xb0, 0x26, 0x82, 0xb6,
+	0x97, 0x54, 0xc5, 0x6e, 0xce, 0xbd, 0x50, 0x45, 0xcd, 0xa4, 0x12, 0x97, 0x16, 0x1b, 0x12, 0x70,
+	0x69, 0x66, 0x24, 0x80, 0xe9, 0xd1, 0x11, 0x78, 0x8b, 0xb3, 0x6b, 0x77, 0xec, 0x7e, 0x57, 0xd5,
+	0xf6, 0x71, 0x23, 0x46, 0x78, 0x92, 0x85, 0xe9, 0x06, 0xc4, 0xb3, 0x28, 0x0a, 0x30, 0xb5, 0x9c,
+	0x42, 0x86, 0xe4, 0x72, 0x1c, 0xcc, 0xa4, 0x17, 0xfa, 0xed, 0xfe, 0x3e, 0xd0, 0xcc, 0x46, 0x00,
+	0x2c, 0x65, 0x77, 0xe6, 0xc3, 0x2d, 0xac, 0x29, 0xd3, 0x91, 0xaa, 0x60, 0x6b, 0xed, 0x71, 0x9d,
+	0xb9, 0x60, 0xe6, 0xb6, 0xbe, 0x29, 0x34, 0x2e, 0x59, 0x61, 0xcb, 0x2e, 0xcd, 0x77, 0x60, 0xa4,
+	0x01, 0x44, 0x37, 0xe0, 0x00, 0x2f, 0xfc, 0x19, 0xf1, 0xce, 0xc2, 0xf4, 0x29, 0x06, 0x21, 0xd7,
+	0xbc, 0xf9, 0x3d, 0xb5, 0x92, 0x68, 0xc3, 0xbe, 0xb4, 0x4a, 0x29, 0x97, 0x56, 0xce, 0xbe, 0xb4,
+	0x3a, 0x6a, 0x35, 0x32, 0x2e, 0x39, 0x85, 0xc0, 0xe2, 0x23, 0xb5, 0x40, 0xdc, 0xcd, 0x30, 0x2b,
+	0x0f, 0x49, 0xc4, 0xef, 0x37, 0xd5, 0x0d, 0xf8, 0x35, 0x85, 0xe2, 0x98, 0x49, 0xe4, 0x04, 0x77,
+	0x48, 0x1a, 0x5e, 0x91, 0x3c, 0x28, 0x09, 0x74, 0x05, 0x77, 0xca, 0xfd, 0x97, 0x59, 0xb5, 0x8c,
+	0xd7, 0xcb, 0x41, 0x77, 0xf4, 0x54, 0xaf, 0xd3, 0x7e, 0xea, 0x3a, 0xbd, 0x61, 0x71, 0x0a, 0x56,
+	0xe9, 0x2f, 0xba, 0x48, 0xb9, 0xf8, 0x22, 0x39, 0xaf, 0x02, 0x73, 0x6d, 0x8f, 0xb5, 0x40, 0x63,
+	0x55, 0x81, 0x19, 0x64, 0xc8, 0xae, 0x2f, 0x58, 0xec, 0x3a, 0x52, 0x02, 0x3c, 0x58, 0xd8, 0x6a,
+	0x20, 0xdc, 0x19, 0x92, 0x57, 0x6c, 0x33, 0x40, 0x99, 0x26, 0x40, 0xca, 0xd3, 0xb9, 0x1a, 0x89,
+	0x5c, 0x03, 0x1c, 0x72, 0x91, 0x19, 0x13, 0xca, 0x38, 0x09, 0xe1, 0x3f, 0xff, 0x36, 0x7d, 0x5d,
+	0xd5, 0xc2, 0x65, 0x91, 0x3d, 0x02, 0xc4, 0x44, 0x94, 0x97, 0x06, 0xe8, 0xb7, 0xfb, 0x7f, 0x32,
+	0x5c, 0x70, 0x07, 0xce, 0x50, 0x60, 0xb1, 0xd4, 0x28, 0xcc, 0xe8, 0x82, 0xf8, 0x7b, 0xae, 0xa8,
+	0xf6, 0x25, 0x2c, 0x26, 0x1c, 0xcd, 0x00, 0x17, 0x06, 0xd8, 0x33, 0x5a, 0xcf, 0xa2, 0xb7, 0x88,
+	0xe9, 0xfa, 0x60, 0x10, 0xae, 0xf3, 0xe2, 0xdc, 0x75, 0x2e, 0x5e, 0x67, 0x9d, 0x4b, 0xe9, 0xeb,
+	0xec, 0xbe, 0xae, 0x56, 0xac, 0xd9, 0x3f, 0x63, 0x9d, 0x0e, 0x95, 0xb3, 0xdf, 0x0f, 0x66, 0x27,
+	0x23, 0x6c, 0xc2, 0x70, 0x16, 0x91, 0x81, 0x64, 0x62, 0x03, 0xc1, 0x4c, 0x20, 0xd6, 0x9c, 0x99,
+	0x95, 0xcc, 0xee, 0x13, 0xca, 0x74, 0x3f, 0x54, 0xab, 0x91, 0xf6, 0xa4, 0xeb, 0xaf, 0xa8, 0xc2,
+	0xd5, 0xec, 0xc9, 0x58, 0xcb, 0x5d, 0x65, 0xc1, 0x70, 0xd4, 0x1a, 0x78, 0x9c, 0xe3, 0x7e, 0xac,
+	0x56, 0x0e, 0xfd, 0xc7, 0x42, 0x84, 0xf4, 0x40, 0xbe, 0x0e, 0x43, 0x7e, 0xb6, 0x26, 0x81, 0xf2,
+	0x5d, 0xa0, 0xdf, 0x76, 0x65, 0xe9, 0xd5, 0x52, 0x2c, 0x64, 0x22, 0x8a, 0x05, 0x40, 0x23, 0xa7,
+	0xd5, 0xbf, 0x18, 0x1d, 0xc0, 0x6f, 0x60, 0x28, 0x75, 0x6f, 0x80, 0x88, 0xc3, 0xe0, 0x42, 0x68,
+	0x2c, 0xfe, 0x74, 0xdf, 0x51, 0xab, 0x91, 0x72, 0xd2, 0xf0, 0x4b, 0xaa, 0x14, 0x00, 0x98, 0xb8,
+	0x66, 0x69, 0x3a, 0x04, 0xb8, 0x7b, 0xea, 0xc6, 0x03, 0x7f, 0xda, 0x3f, 0x7f, 0xfa, 0xbc, 0xe6,
+	0xa3, 0xed, 0x64, 0xe3, 0xed, 0x34, 0xd4, 0x5a, 0xac, 0x1d, 0xe9, 0x9e, 0x8f, 0x87, 0xec, 0x64,
+	0xd1, 0xe3, 0x84, 0x45, 0xb7, 0xb3, 0x36, 0xdd, 0x76, 0xc7, 0xca, 0x81, 0xbd, 0x19, 0xf9, 0x3d,
+	0x40, 0x4c, 0x7f, 0xaa, 0x07, 0xf3, 0x4d, 0xeb, 0x2c, 0x94, 0xef, 0xae, 0xcb, 0xca, 0xc6, 0x2f,
+	0x03, 0x39, 0x24, 0x80, 0x39, 0x80, 0xe7, 0x43, 0x6a, 0xb8, 0xe8, 0xd1, 0x6f, 0x5c, 0x5c, 0x54,
+	0x25, 0xc0, 0x6d, 0x42, 0x87, 0x03, 0x38, 0x2c, 0x49, 0xba, 0x6b, 0x6a, 0x35, 0xd2, 0x21, 0x8f,
+	0xda, 0x7d, 0x4b, 0xad, 0xed, 0xf6, 0x83, 0x5e, 0x72, 0x28, 0x40, 0x63, 0x61, 0xa8, 0x9d, 0xe8,
+	0x8d, 0xf3, 0x09, 0x8c, 0x7c, 0x03, 0xc4, 0x91, 0x58, 0x0d, 0x69, 0xeb, 0xb7, 0xb2, 0x2a, 0x7f,
+	0xbf, 0xbd, 0xbf, 0x03, 0xa2, 0x75, 0xb1, 0x0f, 0x78, 0x3f, 0x44, 0x7e, 0x9b, 0x57, 0xc3, 0xa4,
+	0xe7, 0x1e, 0x6d, 0x40, 0x60, 0x62, 0xd3, 0x51, 0x53, 0x22, 0x1c, 0x6f, 0x11, 0x01, 0xfb, 0x90,
+	0xc6, 0x63, 0xe6, 0x3f, 0x99, 0xf4, 0xa7, 0xa4, 0x84, 0xd1, 0x4a, 0x86, 0x3c, 0xb3, 0x78, 0x61,
+	0x46, 0xa8, 0x8a, 0x10, 0x6e, 0x04, 0xef, 0x57, 0x66, 0x7d, 0x4b, 0x97, 0xc4, 0x8d, 0x00, 0x00,
+	0xb8, 0x5b, 0xe7, 0x7c, 0x3c, 0x7d, 0xdc, 0x9d, 0x1a, 0x6e, 0x6d, 0x24, 0xa4, 0x35, 0x0f, 0x37,
+	0x84, 0xc9, 0x11, 0x4e, 0x04, 0xc4, 0x88, 0x35, 0xab, 0xb8, 0xd5, 0x30, 0x73, 0x4d, 0xab, 0x61,
+	0xe6, 0x7d, 0xdd, 0x85, 0xfb, 0x9b, 0x59, 0xd8, 0x5d, 0xae, 0x0f, 0x6b, 0x0e, 0x4c, 0x00, 0x30,
+	0xf7, 0xb3, 0x20, 0xca, 0xbb, 0x65, 0x62, 0xbc, 0x1b, 0xf0, 0x49, 0xc4, 0x39, 0xda, 0x0c, 0x5c,
+	0x36, 0x64, 0xa3, 0xbd, 0x90, 0x89, 0x03, 0xbe, 0x2c, 0xe4, 0xde, 0x8d, 0x0e, 0x2e, 0x0f, 0x52,
+	0xa3, 0xe6, 0xe0, 0xe5, 0x2a, 0x44, 0x82, 0xa0, 0xb9, 0x52, 0xa3, 0x6a, 0x60, 0x41, 0x61, 0x05,
+	0xf2, 0x8e, 0x7d, 0x2d, 0x2b, 0x10, 0xbb, 0xe7, 0xaa, 0xaa, 0x61, 0xe4, 0xa8, 0x24, 0xaf, 0x5c,
+	0x59, 0x58, 0x39, 0x2a, 0x93, 0xce, 0x6b, 0x2f, 0xa4, 0xf3, 0xda, 0xee, 0x7f, 0x2a, 0xa9, 0x45,
+	0xbd, 0x8c, 0xc4, 0x38, 0xcf, 0xfa, 0x8f, 0xfc, 0x90, 0x71, 0xc6, 0x14, 0xf2, 0xe3, 0x53, 0x7f,
+	0x38, 0x9e, 0x19, 0x81, 0x89, 0x8f, 0x49, 0x85, 0x81, 0x22, 0x32, 0x59, 0x4c, 0x3b, 0xab, 0x0e,
+	0x99, 0x7b, 0xd6, 0x4c, 0x3b, 0xb3, 0x64, 0x2f, 0xaa, 0x45, 0xcd, 0x7a, 0xe7, 0x8d, 0x4e, 0x61,
+	0xa1, 0xc7, 0x7c, 0x37, 0x60, 0x64, 0xaf, 0x3b, 0xe9, 0xf6, 0xfa, 0xb3, 0xa7, 0x72, 0x27, 0x98,
+	0x34, 0xb6, 0x0e, 0x48, 0xd7, 0x1d, 0x74, 0x4e, 0xbb, 0x83, 0xee, 0xa8, 0xe7, 0x8b, 0x4e, 0xae,
+	0x42, 0xc0, 0x6d, 0x86, 0xa1, 0xde, 0x4d, 0xc6, 0xa9, 0x4b, 0xb1, 0x6a, 0x4e, 0x46, 0xaf, 0x8b,
+	0xa1, 0x70, 0x37, 0x1e, 0xe2, 0xbe, 0x00, 0xaf, 0x41, 0xb7, 0x45, 0x0e, 0x84, 0x3b, 0x82, 0x00,
+	0x03, 0x43, 0x13, 0xe1, 0xec, 0xc7, 0x8c, 0xc3, 0x25, 0xee, 0x8a, 0x81, 0x9f, 0x32, 0xfe, 0x26,
+	0x65, 0xa1, 0x9c, 0x25, 0x0b, 0xc1, 0x51, 0xb8, 0x82, 0xc3, 0x36, 0x9b, 0x0d, 0x60, 0xfd, 0xf5,
+	0x58, 0xca, 0x54, 0xa8, 0x66, 0x32, 0xf4, 0x70, 0xb6, 0xd4, 0x2a, 0x2b, 0x13, 0x61, 0xf3, 0xc6,
+	0xc1, 0x65, 0x3f, 0xe8, 0x04, 0xa8, 0xa1, 0x60, 0x75, 0xd3, 0x0a, 0x65, 0xb5, 0x24, 0xa7, 0xc5,
+	0x2a, 0x8a, 0xf5, 0x58, 0xf9, 0xa9, 0xdf, 0xf3, 0x61, 0x9f, 0xce, 0x48, 0x4e, 0xca, 0x79, 0x6b,
+	0x91, 0x3a, 0x9e, 0x64, 0x92, 0xd0, 0x7b, 0x35, 0xec, 0x5c, 0x4d, 0xce, 0xba, 0xc8, 0x0f, 0x2f,
+	0xb1, 0xe0, 0x01, 0xa0, 0x13, 0x86, 0x38, 0x6f, 0x29, 0x2d, 0x08, 0x09, 0xce, 0x2c, 0x47, 0xae,
+	0x1c, 0xa4, 0x1a, 0x5e, 0x45, 0x4a, 0xb0, 0xa0, 0xf6, 0x8a, 0x7d, 0x58, 0x6a, 0x88, 0x61, 0x24,
+	0xb4, 0x87, 0x07, 0x06, 0x48, 0xdd, 0x64, 0xda, 0x7f, 0x04, 0xcd, 0x6f, 0xac, 0xf0, 0x3d, 0x2e,
+	0x49, 0x24, 0xe0, 0xfd, 0x51, 0x7f, 0xd6, 0x87, 0x51, 0x4e, 0x37, 0x1c, 0xca, 0x0b, 0x01, 0x20,
+	0x25, 0xac, 0x10, 0x9e, 0x04, 0x33, 0x20, 0xe8, 0x81, 0x48, 0x81, 0xab, 0x2c, 0x6d, 0x61, 0x46,
+	0x8b, 0xe0, 0x24, 0x08, 0x3a, 0x1f, 0xa8, 0x9b, 0x8c, 0x1a, 0x89, 0xa3, 0x79, 0x03, 0x97, 0x83,
+	0x46, 0xb4, 0x4a, 0x25, 0x76, 0xa2, 0x67, 0xf4, 0x23, 0xb5, 0x2e, 0xe8, 0x92, 0xa8, 0xb9, 0x66,
+	0x6a, 0xde, 0xe0, 0x22, 0xb1, 0xaa, 0x5b, 0xc0, 0x52, 0xc0, 0x10, 0xfa, 0xbd, 0x8e, 0xb4, 0x80,
+	0xa7, 0xe2, 0x26, 0xce, 0x82, 0x2a, 0x2d, 0x73, 0xa6, 0x47, 0x79, 0x40, 0x8f, 0x9d, 0xef, 0x82,
+	0xf8, 0x4d, 0xe8, 0x43, 0xaa, 0x0e, 0xba, 0x98, 0x37, 0xe9, 0x62, 0x5e, 0x93, 0xc5, 0xdd, 0x31,
+	0xb9, 0x74, 0x37, 0x2f, 0xf5, 0x22, 0x69, 0x3c, 0x1a, 0x83, 0xfe, 0xb9, 0x8f, 0xf7, 0xc4, 0xc6,
+	0x3a, 0x23, 0x9b, 0x4e, 0xe3, 0xa9, 0xbd, 0x9a, 0x50, 0xce, 0x06, 0x13, 0x6b, 0x4e, 0x11, 0x1e,
+	0x0f, 0xc6, 0x81, 0xaf, 0xd5, 0xd0, 0x1b, 0xb7, 0xe4, 0x40, 0x22, 0x50, 0x8b, 0x2c, 0x28, 0x13,
+	0xb3, 0x02, 0xc2, 0x18, 0x0b, 0x5e, 0x24, 0xc4, 0xa8, 0xb2, 0x1e, 0x42, 0x1b, 0x0c, 0x90, 0xa9,
+	0xbb, 0xec, 0x3e, 0xd6, 0x64, 0xfd, 0x25, 0xa2, 0x26, 0x0a, 0x41, 0x42, 0xd0, 0xf7, 0xd4, 0x8a,
+	0xec, 0x42, 0x48, 0x4c, 0x37, 0x6e, 0xd3, 0x15, 0x79, 0x4b, 0xcf, 0x31, 0x41, 0x6d, 0xbd, 0x1a,
+	0xef, 0x8b, 0x45, 0x7f, 0xef, 0x2b, 0x47, 0x6f, 0x8a, 0xd5, 0xd0, 0xcb, 0xcf, 0x6b, 0x68, 0x45,
+	0xb6, 0x29, 0x04, 0xb9, 0x3f, 0xcd, 0x30, 0x47, 0x25, 0xa5, 0x03, 0x4b, 0xf9, 0xc3, 0x74, 0xad,
+	0x33, 0x1e, 0x0d, 0x9e, 0x0a, 0xa9, 0x53, 0x0c, 0x3a, 0x02, 0x08, 0x2e, 0x5c, 0x7f, 0x64, 0x17,
+	0xe1, 0xcb, 0xbb, 0xa2, 0x81, 0x54, 0x08, 0x5a, 0x01, 0x62, 0x38, 0x00, 0x0c, 0xa0, 0x22, 0x39,
+	0x6e, 0x85, 0x41, 0x54, 0x00, 0xb5, 0x5f, 0x8c, 0xeb, 0x5c, 0x22, 0x4f, 0x25, 0xca, 0x02, 0xa3,
+	0x22, 0xc4, 0x1c, 0xf8, 0x53, 0x22, 0x76, 0x15, 0x8f, 0x7e, 0xbb, 0xdb, 0xea, 0x46, 0x74, 0xd0,
+	0xc2, 0xb9, 0xdc, 0x01, 0xe2, 0x28, 0x30, 0x51, 0x8b, 0x2e, 0x45, 0x57, 0xc3, 0x33, 0xf9, 0xee,
+	0x7f, 0x2e, 0x00, 0x1f, 0x21, 0x6b, 0x84, 0x9b, 0xdd, 0xba, 0x1a, 0x0e, 0xbb, 0xd3, 0x14, 0x12,
+	0x9d, 0x79, 0x36, 0x89, 0xce, 0x26, 0x48, 0x74, 0x54, 0x2f, 0xc6, 0x14, 0x3e, 0xaa, 0x17, 0x43,
+	0xec, 0x62, 0x69, 0xdc, 0xb6, 0xbe, 0x54, 0x05, 0xdc, 0x66, 0x2b, 0x4f, 0xe2, 0x42, 0x29, 0xa4,
+	0x5c, 0x28, 0xf6, 0x75, 0xb0, 0x10, 0xbb, 0x0e, 0x60, 0x71, 0x19, 0xb7, 0x05, 0x1f, 0x17, 0x59,
+	0x40, 0x27, 0x98, 0x20, 0xe4, 0xeb, 0x6a, 0x39, 0x4e, 0x81, 0x99, 0xd4, 0x2f, 0xa5, 0xd0, 0x5f,
+	0xb4, 0xf5, 0x20, 0x53, 0x63, 0x15, 0x2e, 0x09, 0xfd, 0x85, 0xac, 0x7d, 0xca, 0xd1, 0xe5, 0x1b,
+	0xa8, 0xca, 0xc6, 0xbe, 0xe9, 0x18, 0x2b, 0x3a, 0xc6, 0x5f, 0x8f, 0x61, 0xa6, 0xb5, 0xea, 0x5b,
+	0x98, 0x00, 0xa6, 0x94, 0xce, 0x75, 0x89, 0x6a, 0xd2, 0x91, 0xfe, 0x40, 0x2d, 0x8d, 0x81, 0x98,
+	0x76, 0x42, 0x2a, 0x58, 0xa6, 0xa6, 0x6a, 0xd2, 0x54, 0x53, 0xc3, 0xbd, 0x2a, 0x96, 0x33, 0x49,
+	0x20, 0x5b, 0xcb, 0xdc, 0x7f, 0x58, 0xb3, 0x32, 0xa7, 0xe6, 0x12, 0x15, 0x0c, 0xab, 0xbe, 0x43,
+	0xba, 0xa7, 0xf1, 0xe0, 0x8a, 0x4d, 0x39, 0x55, 0xc2, 0x23, 0xad, 0xdb, 0xf6, 0x4c, 0x8e, 0x67,
+	0x97, 0x72, 0xff, 0x72, 0x46, 0x95, 0xad, 0x39, 0x38, 0x6b, 0x6a, 0x65, 0xe7, 0xe8, 0xe8, 0xb8,
+	0xe1, 0xd5, 0xdb, 0xcd, 0x07, 0x8d, 0xce, 0xce, 0xfe, 0x51, 0xab, 0x51, 0x7b, 0x01, 0xc1, 0xfb,
+	0x47, 0x3b, 0xf5, 0xfd, 0xce, 0xde, 0x91, 0xb7, 0xa3, 0xc1, 0x19, 0xa0, 0x4e, 0x8e, 0xd7, 0x38,
+	0x38, 0x6a, 0x37, 0x22, 0xf0, 0x2c, 0xb0, 0xf4, 0x95, 0x6d, 0xaf, 0x51, 0xdf, 0xb9, 0x2f, 0x90,
+	0x1c, 0xf0, 0xe6, 0xb5, 0xbd, 0x93, 0xc3, 0xdd, 0xe6, 0xe1, 0xbd, 0xce, 0x4e, 0xfd, 0x70, 0xa7,
+	0xb1, 0xdf, 0xd8, 0xad, 0xe5, 0x9d, 0xaa, 0x2a, 0xd5, 0xb7, 0xeb, 0x87, 0xbb, 0x47, 0x87, 0x90,
+	0x2c, 0xb8, 0xff, 0x33, 0xa3, 0x54, 0x38, 0x50, 0xa4, 0xab, 0xe1, 0x50, 0x6d, 0xd3, 0xe9, 0x5a,
+	0x62, 0x52, 0x4c, 0x57, 0xa7, 0x91, 0x34, 0x30, 0x8e, 0x8b, 0xc0, 0x77, 0x03, 0xb1, 0x65, 0x21,
+	0x62, 0xe9, 0xee, 0x46, 0xa2, 0xde, 0x11, 0xe7, 0x7b, 0xba, 0x60, 0xc4, 0x3c, 0x9a, 0x7b, 0x9e,
+	0x79, 0x34, 0x6a, 0x87, 0x65, 0xbe, 0xce, 0xb2, 0xc3, 0x42, 0x76, 0xf0, 0xd8, 0xf7, 0x27, 0xa4,
+	0xbc, 0x92, 0x53, 0x50, 0x22, 0x08, 0xea, 0xc0, 0xdc, 0x3f, 0xce, 0xa8, 0x35, 0xc2, 0xa5, 0xb3,
+	0x38, 0x11, 0x7b, 0x55, 0x95, 0x7b, 0x63, 0xc0, 0x0b, 0x64, 0xaa, 0x0d, 0xbf, 0x66, 0x83, 0x90,
+	0x40, 0x31, 0x41, 0x06, 0xe6, 0xb7, 0xe7, 0x0b, 0x0d, 0x53, 0x04, 0xda, 0x43, 0x08, 0x9e, 0x21,
+	0x39, 0x84, 0x5c, 0x82, 0x49, 0x58, 0x99, 0x61, 0x5c, 0x04, 0xae, 0x96, 0xd3, 0xa9, 0xdf, 0xed,
+	0x5d, 0x0a, 0xf5, 0x92, 0x14, 0xea, 0x42, 0xb5, 0xd6, 0xad, 0x87, 0x67, 0x02, 0x4e, 0x13, 0x0d,
+	0xbe, 0xe8, 0x2d, 0x0b, 0x7c, 0x47, 0xc0, 0x78, 0xcf, 0x77, 0x4f, 0xbb, 0xa3, 0xb3, 0xf1, 0x08,
+	0xca, 0xb0, 0x2c, 0x1f, 0x02, 0xdc, 0x63, 0x75, 0x33, 0x3e, 0x3f, 0xa1, 0x77, 0xef, 0x5b, 0xf4,
+	0x8e, 0x45, 0xdf, 0xcd, 0xf9, 0x67, 0xcc, 0xa2, 0x7d, 0xff, 0x2e, 0xaf, 0xf2, 0x28, 0xf0, 0xcc,
+	0x95, 0x8d, 0x6c, 0xd9, 0x36, 0x97, 0x30, 0x9a, 0x93, 0xae, 0x90, 0x19, 0x30, 0xd9, 0x2c, 0x82,
+	0x10, 0xe3, 0x65, 0xb2, 0x81, 0xdf, 0x7a, 0xa4, 0x65, 0x16, 0x82, 0x00, 0x8f, 0xf5, 0x88, 0x94,
+	0x16, 0xdd, 0x19, 0xd7, 0x65, 0x7a, 0xb5, 0x08, 0x69, 0xaa, 0x29, 0x59, 0x54, 0x6f, 0xd1, 0x64,
+	0x51, 0x2d, 0x18, 0x4d, 0x7f, 0x74, 0x0a, 0xf8, 0xa0, 0x55, 0x3f, 0x3a, 0x49, 0x36, 0x7a, 0xa2,
+	0xa4, 0x78, 0xb5, 0x33, 0x35, 0x2a, 0x22, 0xa0, 0x8d, 0x97, 0xfb, 0xdb, 0x20, 0xff, 0x3e, 0x1d,
+	0xf5, 0x6c, 0x1a, 0x74, 0x43, 0xd6, 0x07, 0x67, 0xbf, 0xd5, 0x82, 0x4c, 0xc2, 0xf8, 0x62, 0x20,
+	0xbf, 0x9c, 0xf7, 0x54, 0xd1, 0x58, 0xb5, 0xf8, 0x06, 0xb9, 0x65, 0xd7, 0xd0, 0xa6, 0x2c, 0xd6,
+	0x8f, 0x99, 0xa2, 0x20, 0xa3, 0x2c, 0x90, 0x02, 0x1c, 0xd5, 0xf5, 0x39, 0x4b, 0xe0, 0xc5, 0x61,
+	0x90, 0x79, 0xdc, 0x3f, 0x23, 0x33, 0x94, 0x27, 0xc5, 0x70, 0x99, 0x80, 0x5f, 0x9b, 0x88, 0x3a,
+	0xba, 0xca, 0x56, 0x66, 0x84, 0xb0, 0x2e, 0xfa, 0x55, 0x55, 0x21, 0x8b, 0x21, 0x95, 0x19, 0x31,
+	0x1f, 0x9a, 0x03, 0xc4, 0x04, 0x18, 0xf0, 0x73, 0x93, 0xc3, 0x60, 0xf3, 0x13, 0x55, 0x8d, 0x0c,
+	0xc6, 0x56, 0x73, 0x55, 0x59, 0xcd, 0xf5, 0x9a, 0xad, 0xe6, 0x0a, 0xaf, 0x42, 0xa9, 0x66, 0xab,
+	0xbd, 0xbe, 0xa7, 0x8a, 0x7a, 0x2d, 0x90, 0xe6, 0x9c, 0x1c, 0x7e, 0x72, 0x78, 0xf4, 0xe9, 0x61,
+	0xa7, 0xf5, 0xd9, 0xe1, 0x0e, 0x10, 0xad, 0x65, 0x55, 0xae, 0xef, 0x10, 0x19, 0x23, 0x40, 0x06,
+	0x8b, 0x1c, 0xd7, 0x5b, 0x2d, 0x03, 0xc9, 0xba, 0x7b, 0xaa, 0x16, 0x9f, 0x2a, 0x22, 0xf5, 0x4c,
+	0xc3, 0xc4, 0xb2, 0x17, 0x02, 0x42, 0xfb, 0x41, 0xd6, 0xb2, 0x1f, 0xb8, 0xef, 0xa1, 0xc2, 0x38,
+	0x20, 0x61, 0xdc, 0xb6, 0xd9, 0x0f, 0x90, 0xf5, 0xb6, 0xad, 0x7b, 0x70, 0x04, 0x19, 0x46, 0x5d,
+	0xb9, 0xef, 0x03, 0x59, 0x0d, 0xab, 0x85, 0x4a, 0x21, 0x64, 0x16, 0xe2, 0x4a, 0x21, 0x12, 0xf4,
+	0x39, 0xc7, 0x5d, 0x57, 0x6b, 0x98, 0x6c, 0x3c, 0x02, 0xfc, 0x6b, 0x5d, 0x9d, 0xb2, 0xab, 0x07,
+	0x90, 0x33, 0xf7, 0x37, 0x33, 0xaa, 0x64, 0x72, 0xe6, 0x9f, 0x92, 0x2d, 0xd1, 0x1f, 0x31, 0x59,
+	0xdc, 0xb4, 0x7a, 0xa0, 0x8a, 0x5b, 0xf4, 0x37, 0xa2, 0x47, 0x2a, 0x19, 0x10, 0x2e, 0xeb, 0x71,
+	0xa3, 0xe1, 0x75, 0x8e, 0x0e, 0xf7, 0x9b, 0x87, 0x78, 0x39, 0xe0, 0xb2, 0x12, 0x60, 0x6f, 0x8f,
+	0x20, 0x19, 0xb7, 0xa6, 0x96, 0xee, 0xf9, 0xb3, 0xe6, 0xe8, 0x7c, 0x2c, 0x8b, 0xe1, 0xfe, 0xc5,
+	0x05, 0xb5, 0x6c, 0x40, 0xa1, 0x1e, 0xea, 0x11, 0xcc, 0x06, 0xc6, 0x4d, 0x78, 0x02, 0x67, 0x55,
+	0x92, 0x48, 0xde, 0x44, 0x4a, 0x23, 0x36, 0xe3, 0x06, 0xe5, 0x8a, 0x5c, 0x47, 0x3c, 0x06, 0xdc,
+	0xff, 0xfd, 0x33, 0x18, 0x10, 0xb0, 0x0b, 0x9d, 0x88, 0x56, 0x7e, 0x49, 0x83, 0x85, 0xcf, 0x80,
+	0xed, 0xea, 0x0e, 0xfa, 0x5d, 0xed, 0x42, 0xc3, 0x09, 0x84, 0xf6, 0xc6, 0x03, 0xd8, 0x93, 0x15,
+	0x86, 0x52, 0x02, 0x44, 0xa4, 0x1b, 0x28, 0x43, 0xd9, 0x66, 0x24, 0xa2, 0x50, 0x6c, 0x20, 0x70,
+	0x20, 0xef, 0x38, 0x34, 0x25, 0x61, 0x0e, 0x72, 0x17, 0x58, 0x43, 0xd8, 0x49, 0x53, 0x81, 0xf5,
+	0x22, 0xe8, 0xd3, 0x52, 0xa7, 0x1c, 0x53, 0xfe, 0xae, 0x5a, 0xc3, 0xf2, 0x86, 0x01, 0x35, 0x35,
+	0x96, 0xa9, 0x06, 0x36, 0xd6, 0x94, 0x3c, 0x53, 0x07, 0x28, 0x05, 0x8f, 0x0a, 0x51, 0x42, 0xec,
+	0x4d, 0x34, 0x14, 0x48, 0x27, 0xbc, 0x5d, 0x58, 0x11, 0x10, 0xf7, 0x76, 0xb1, 0xfc, 0x65, 0x8a,
+	0x71, 0x7f, 0x19, 0x18, 0xd2, 0x29, 0xe2, 0xe8, 0xa5, 0xdf, 0x3d, 0x03, 0x79, 0x37, 0xc4, 0x7c,
+	0x16, 0x37, 0x57, 0x31, 0xf3, 0x3e, 0xe5, 0x99, 0x83, 0x82, 0x9c, 0x20, 0x12, 0x1e, 0xe0, 0xa7,
+	0x66, 0xe3, 0x0e, 0x31, 0x88, 0xa2, 0x71, 0xad, 0x32, 0xb8, 0x3d, 0xde, 0x41, 0x60, 0xb4, 0xdc,
+	0xc5, 0xb4, 0x3b, 0xb9, 0x14, 0x61, 0xd0, 0x94, 0xbb, 0x87, 0x40, 0x38, 0x71, 0x8b, 0x78, 0x26,
+	0x46, 0x3e, 0x3b, 0x0f, 0xb0, 0x98, 0xa5, 0x41, 0x40, 0x0e, 0x16, 0xa8, 0x8f, 0x00, 0x84, 0xd0,
+	0x9c, 0x65, 0x13, 0xa6, 0x3e, 0x3c, 0xc9, 0x43, 0x76, 0xfb, 0x6a, 0xda, 0x67, 0x3a, 0x56, 0xf2,
+	0xe8, 0xb7, 0xf3, 0x7d, 0x8b, 0x28, 0xae, 0x52, 0xdd, 0xd7, 0xa4, 0x6e, 0x0c, 0x15, 0xe7, 0xd1,
+	0xc7, 0x2f, 0x95, 0x5a, 0xfd, 0x20, 0x5f, 0x2c, 0xd7, 0x2a, 0xa8, 0xbd, 0x83, 0xde, 0xd1, 0x8b,
+	0x00, 0xb0, 0xfd, 0x69, 0xe4, 0x8c, 0x64, 0xd4, 0x7a, 0x22, 0x2b, 0xf4, 0x15, 0x98, 0x0a, 0xbc,
+	0x33, 0x1c, 0x9f, 0x69, 0xa6, 0xa0, 0xa2, 0x81, 0x07, 0x00, 0x43, 0xcd, 0x84, 0x29, 0x74, 0x0e,
+	0x0c, 0x64, 0x70, 0xe9, 0x9f, 0x09, 0x6f, 0x50, 0xd3, 0x19, 0x7b, 0x02, 0x47, 0x0e, 0x7c, 0x32,
+	0x1d, 0x5f, 0x98, 0xab, 0x12, 0x24, 0x7b, 0x9d, 0x76, 0x3f, 0x50, 0x05, 0xde, 0x41, 0x3c, 0x28,
+	0xb4, 0xbf, 0x19, 0x39, 0x28, 0x04, 0x85, 0x83, 0x0b, 0x1b, 0xf3, 0x78, 0x3c, 0x7d, 0xa8, 0x6d,
+	0x6b, 0x92, 0x74, 0x7f, 0x9d, 0x94, 0xaa, 0xc6, 0x5b, 0x8b, 0x95, 0x0f, 0x88, 0xc2, 0x8c, 0x82,
+	0xc1, 0x65, 0x57, 0xf4, 0xbc, 0x45, 0x02, 0xb4, 0x2e, 0xbb, 0x09, 0x14, 0xce, 0x26, 0x1d, 0xb6,
+	0x5e, 0x53, 0x4b, 0xda, 0x3f, 0x2c, 0xe8, 0x0c, 0xfc, 0xf3, 0x99, 0x1c, 0xc9, 0x8a, 0x38, 0x87,
+	0x05, 0xfb, 0x00, 0x73, 0x0f, 0x80, 0x75, 0xe5, 0x43, 0x73, 0x04, 0x47, 0x58, 0xba, 0xfe, 0x30,
+	0x4d, 0x2a, 0x2a, 0xdf, 0x5d, 0x8d, 0xb2, 0x1b, 0xcc, 0xd8, 0x45, 0x44, 0x25, 0xf7, 0x87, 0xa1,
+	0x06, 0x11, 0x99, 0x11, 0x69, 0x4f, 0x64, 0x13, 0x6d, 0x92, 0xd4, 0x6e, 0x0f, 0x46, 0x02, 0xea,
+	0x9f, 0xe1, 0xea, 0x04, 0x57, 0xbd, 0x9e, 0xf6, 0xdb, 0x43, 0xf3, 0x06, 0x27, 0xdd, 0xff, 0x08,
+	0x42, 0x2b, 0x35, 0xa6, 0xa5, 0x3a, 0xb9, 0x29, 0x7e, 0xe6, 0x41, 0xe2, 0xfe, 0xd8, 0x1c, 0x20,
+	0x27, 0xbe, 0xb8, 0x91, 0x26, 0x9f, 0x30, 0xd2, 0x00, 0x13, 0x78, 0xe6, 0x0f, 0xfa, 0x84, 0x4a,
+	0x9a, 0xa1, 0x62, 0x0e, 0x76, 0x59, 0xc3, 0x45, 0xcb, 0xe0, 0xfe, 0x8d, 0x0c, 0x2c, 0x3c, 0xf1,
+	0x6b, 0xa4, 0xb7, 0x91, 0x85, 0xfa, 0x58, 0x2b, 0x28, 0x84, 0x9c, 0xca, 0x9c, 0x42, 0x3e, 0x86,
+	0xa0, 0x5c, 0xf8, 0xfe, 0x0b, 0xa2, 0xb8, 0x10, 0xa8, 0xf3, 0x1d, 0x92, 0x44, 0x47, 0x1d, 0x02,
+	0x0a, 0x1f, 0x7e, 0x2b, 0x85, 0x43, 0x34, 0xd5, 0x51, 0x4c, 0x1d, 0x11, 0x68, 0xbb, 0x88, 0x1a,
+	0x13, 0x04, 0xc3, 0xe5, 0x5e, 0x8d, 0x74, 0x13, 0xb1, 0xf4, 0x54, 0xd8, 0xd2, 0x93, 0xb0, 0x06,
+	0x67, 0x93, 0xd6, 0xe0, 0xa7, 0x6a, 0xd5, 0x03, 0x0a, 0xf8, 0x14, 0xd8, 0xe6, 0xe3, 0xe0, 0x74,
+	0xb6, 0xc7, 0x4c, 0x30, 0xde, 0x41, 0xc6, 0xff, 0x23, 0x62, 0x4e, 0xd1, 0x96, 0x6e, 0xad, 0x86,
+	0xf9, 0x9a, 0x5a, 0x0a, 0x1d, 0x45, 0x2c, 0xc5, 0x7b, 0xd5, 0xf8, 0x8a, 0x10, 0xef, 0x84, 0x0a,
+	0x03, 0x68, 0x5e, 0x54, 0xef, 0xf4, 0xdb, 0xfd, 0x9b, 0x05, 0xe5, 0x20, 0x36, 0xc7, 0x10, 0x26,
+	0xe6, 0xe2, 0x92, 0x4d, 0xb8, 0xb8, 0xbc, 0xa5, 0x1c, 0xab, 0x80, 0xf6, 0xbc, 0xc9, 0x19, 0xcf,
+	0x9b, 0x5a, 0x58, 0x56, 0x1c, 0x6f, 0xe0, 0xf2, 0x13, 0x89, 0x22, 0x3a, 0x54, 0x46, 0x0d, 0x87,
+	0x45, 0x8b, 0xc8, 0x78, 0xb5, 0x7b, 0x8b, 0xd6, 0x54, 0xe7, 0xd8, 0xbd, 0x45, 0x2b, 0x94, 0x2c,
+	0x04, 0x5c, 0x78, 0x2e, 0x02, 0x2e, 0x26, 0x10, 0xd0, 0x52, 0x2e, 0x16, 0xa3, 0xca, 0xc5, 0x84,
+	0x9a, 0x9c, 0xd9, 0xe7, 0x88, 0x9a, 0xfc, 0x0d, 0x55, 0xd3, 0x8a, 0x26, 0xa3, 0xc2, 0x14, 0x9f,
+	0x07, 0xd1, 0x25, 0x69, 0x25, 0x66, 0xc4, 0xa6, 0x57, 0xbe, 0x8e, 0x71, 0xb1, 0x92, 0x6e, 0x5c,
+	0x4c, 0xaa, 0xe4, 0xaa, 0x29, 0x2a, 0xb9, 0xf7, 0x42, 0x97, 0x86, 0xe0, 0xb2, 0x3f, 0x24, 0xc6,
+	0x27, 0x74, 0xb8, 0x94, 0x05, 0x6e, 0x41, 0x8e, 0xa7, 0x9d, 0x8b, 0x30, 0xe1, 0xec, 0xa8, 0x57,
+	0x64, 0x3e, 0x29, 0x7e, 0x41, 0xbc, 0x0a, 0xcb, 0xc4, 0xa9, 0x6e, 0x72, 0xb1, 0x83, 0x98, 0x8b,
+	0x50, 0x6c, 0x51, 0xb4, 0x57, 0x49, 0xc0, 0x7a, 0x5d, 0xbd, 0x28, 0x07, 0xec, 0x56, 0x12, 0xd0,
+	0x12, 0x43, 0x11, 0xd1, 0xf9, 0x05, 0x8f, 0x88, 0x4f, 0x82, 0x53, 0x01, 0xc0, 0x7d, 0xd2, 0xe9,
+	0x05, 0x8f, 0xdc, 0x3f, 0xcd, 0xa8, 0x1a, 0xa2, 0x66, 0xe4, 0xd4, 0x7f, 0xa4, 0x88, 0x3e, 0x5d,
+	0xf3, 0xd0, 0x97, 0xb1, 0xac, 0x3e, 0xf3, 0x1f, 0x28, 0x3a, 0xc4, 0x1d, 0xd4, 0x87, 0xc8, 0x91,
+	0xdf, 0x88, 0x1e, 0xf9, 0x90, 0xac, 0x43, 0x5d, 0x12, 0x0a, 0x11, 0x02, 0x7d, 0x96, 0xf0, 0xac,
+	0x10, 0xe2, 0x8a, 0x4b, 0xf3, 0xa6, 0x11, 0xf4, 0x13, 0xc7, 0x16, 0xab, 0x4e, 0x24, 0x99, 0xe6,
+	0x34, 0x94, 0x4f, 0x71, 0x1a, 0xb2, 0x68, 0xca, 0x7d, 0xa5, 0x80, 0x81, 0xc6, 0x45, 0x40, 0x95,
+	0x0b, 0xf0, 0x56, 0x78, 0xbc, 0xce, 0xbb, 0xc3, 0xbe, 0x28, 0x1b, 0x41, 0x1a, 0x02, 0xc8, 0x1e,
+	0x01, 0x10, 0xb7, 0x30, 0x3b, 0x24, 0x2c, 0x80, 0x5b, 0x00, 0x60, 0xaa, 0xd2, 0x51, 0x55, 0x68,
+	0x69, 0xd7, 0x67, 0xe6, 0x1d, 0x1a, 0x83, 0x45, 0x47, 0x8f, 0x61, 0xac, 0x61, 0x3b, 0xb5, 0x94,
+	0x01, 0x08, 0x05, 0xb5, 0x83, 0xcd, 0x22, 0xe6, 0xc3, 0xc6, 0x08, 0xbb, 0xa1, 0xf5, 0x3b, 0xe1,
+	0xa0, 0xbc, 0x85, 0x87, 0xf4, 0xdb, 0xfd, 0xdf, 0x19, 0x55, 0xc5, 0xf1, 0xd3, 0x4d, 0x41, 0x58,
+	0x24, 0x2e, 0xb0, 0x99, 0xd0, 0x05, 0xf6, 0xae, 0x10, 0x5a, 0xbe, 0x76, 0xb2, 0xf3, 0xaf, 0x1d,
+	0xda, 0x1b, 0xbe, 0x73, 0x40, 0x3a, 0x65, 0xc4, 0x40, 0xd2, 0x93, 0x8b, 0x6c, 0x70, 0x64, 0x42,
+	0x5e, 0x91, 0x8a, 0x7d, 0xc2, 0x1e, 0x77, 0x96, 0x2a, 0x9d, 0x97, 0xb8, 0x34, 0x35, 0x0a, 0xf4,
+	0x94, 0x6d, 0x28, 0xcc, 0xf1, 0xb8, 0xb3, 0xf5, 0xd4, 0x0b, 0x71, 0x3d, 0xb5, 0x3b, 0x52, 0x45,
+	0xdc, 0x6a, 0x9a, 0x6c, 0x4a, 0xa3, 0x99, 0xb4, 0x46, 0x91, 0x39, 0xe9, 0xe2, 0x3d, 0x85, 0xb4,
+	0x37, 0x2b, 0xcc, 0x09, 0x00, 0xb0, 0x21, 0x1c, 0xf8, 0x68, 0xdc, 0x21, 0xc5, 0xaf, 0xa8, 0x44,
+	0x8b, 0x5e, 0x69, 0x34, 0x3e, 0x66, 0x80, 0xfb, 0x17, 0x32, 0xaa, 0x6c, 0x9d, 0x59, 0xb2, 0x04,
+	0x98, 0xe5, 0xe4, 0x03, 0x1e, 0x3d, 0x01, 0x91, 0xfd, 0x00, 0x54, 0xac, 0xf6, 0x22, 0x1b, 0xb4,
+	0x25, 0xa8, 0x4c, 0x35, 0xb3, 0x11, 0xf5, 0x93, 0x9e, 0x97, 0xc6, 0x5f, 0xfc, 0xbd, 0xbd, 0xa0,
+	0xf2, 0x58, 0x14, 0x9d, 0x04, 0xac, 0x61, 0xb0, 0x7a, 0xe6, 0xba, 0x0b, 0xe0, 0xfe, 0xb2, 0xa9,
+	0x8c, 0x7d, 0xb0, 0x69, 0x5d, 0x3b, 0x37, 0x02, 0xeb, 0x4e, 0xeb, 0x22, 0x4e, 0x94, 0x0c, 0xa2,
+	0x95, 0xb9, 0xa6, 0xbf, 0x9d, 0xfb, 0x1b, 0xc0, 0xf3, 0x58, 0xcd, 0xef, 0xa1, 0xf7, 0x72, 0xff,
+	0xd7, 0x89, 0x47, 0x41, 0x93, 0x7e, 0xac, 0x03, 0x06, 0x7d, 0x91, 0x0e, 0xf0, 0x2a, 0x61, 0x57,
+	0x69, 0x76, 0xb7, 0x97, 0xeb, 0x53, 0x11, 0xcc, 0x43, 0x7f, 0x7b, 0xf7, 0x6f, 0x65, 0xd5, 0x0d,
+	0x19, 0x02, 0x79, 0xb4, 0xf7, 0x91, 0x35, 0x3d, 0x08, 0x2e, 0x80, 0x72, 0x54, 0x71, 0xf9, 0x3a,
+	0x53, 0xff, 0x02, 0xa4, 0x70, 0x5f, 0x5b, 0xfd, 0x53, 0xa8, 0x31, 0x72, 0x28, 0x58, 0xd4, 0x93,
+	0x92, 0xc0, 0xde, 0x94, 0xa9, 0x2a, 0x6b, 0xc8, 0x64, 0xaf, 0x36, 0x92, 0x15, 0x79, 0x2f, 0xa0,
+	0xba, 0x0a, 0xc2, 0x9d, 0x81, 0xca, 0xb4, 0xcd, 0x8f, 0x68, 0xad, 0x63, 0xc4, 0x2e, 0xb1, 0x17,
+	0x58, 0x79, 0x12, 0xee, 0x4c, 0x5d, 0x55, 0x99, 0xdc, 0xc9, 0x4a, 0x8a, 0xa7, 0xec, 0x66, 0xb2,
+	0xba, 0x5e, 0x6b, 0x1c, 0xfc, 0xc4, 0x4a, 0x6f, 0x97, 0x40, 0xde, 0x9a, 0xf6, 0x2f, 0x2e, 0xfc,
+	0xa9, 0x7b, 0xd3, 0x2c, 0x0d, 0xd2, 0x71, 0x60, 0xe1, 0xfc, 0x09, 0xca, 0x1c, 0xee, 0xbf, 0x01,
+	0xcc, 0x16, 0xca, 0xfc, 0x33, 0x3b, 0x14, 0x6c, 0xc6, 0x74, 0xa9, 0x25, 0x4b, 0x75, 0x0a, 0xcc,
+	0xd3, 0x10, 0x05, 0x24, 0x14, 0xe0, 0x23, 0xde, 0x04, 0x4b, 0x1a, 0x2c, 0xbc, 0x3f, 0x88, 0xd8,
+	0x24, 0x0a, 0x04, 0x20, 0x9a, 0x0e, 0x3a, 0x3a, 0x53, 0x9e, 0x75, 0xac, 0x70, 0x56, 0xbb, 0x3f,
+	0x38, 0x90, 0x0c, 0xe4, 0x88, 0x41, 0x48, 0xbd, 0xf0, 0x85, 0x3a, 0x70, 0x02, 0x85, 0xae, 0x98,
+	0xec, 0xae, 0x85, 0xae, 0xff, 0xbb, 0xa2, 0xd6, 0x13, 0x59, 0x22, 0x74, 0x19, 0xe3, 0xed, 0xa0,
+	0x3f, 0x3c, 0x1d, 0x1b, 0xe3, 0x41, 0xc6, 0x32, 0xde, 0xee, 0x63, 0x8e, 0x36, 0x1e, 0xf8, 0x6a,
+	0x4d, 0xa3, 0x2c, 0x69, 0xff, 0x8d, 0x78, 0x9f, 0x25, 0xe1, 0xf3, 0xed, 0xe8, 0x35, 0x18, 0xef,
+	0x4e, 0xc3, 0x6d, 0x7e, 0x6f, 0x75, 0x92, 0x80, 0x05, 0xce, 0x9f, 0x55, 0x1b, 0xe6, 0x64, 0x88,
+	0x2c, 0x62, 0xe9, 0x2a, 0xb0, 0xa7, 0x6f, 0x3d, 0xa7, 0xa7, 0x88, 0x5a, 0x96, 0x18, 0xc2, 0x9b,
+	0xfa, 0x50, 0x71, 0x83, 0xa6, 0xaf, 0x47, 0xea, 0x65, 0xdd, 0x17, 0xc9, 0x16, 0xc9, 0x1e, 0xf3,
+	0xd7, 0x9a, 0x1b, 0xa9, 0x9c, 0x23, 0xdd, 0x7a, 0x2f, 0x4a, 0xc3, 0x26, 0xcb, 0xee, 0xf7, 0x52,
+	0xdd, 0x7c, 0xdc, 0x85, 0x83, 0x2a, 0x73, 0xb4, 0x54, 0x25, 0x05, 0xea, 0xef, 0xee, 0x73, 0xfa,
+	0xfb, 0x94, 0x2b, 0x47, 0xa4, 0xad, 0x1b, 0x8f, 0x93, 0xc0, 0x60, 0xf3, 0xef, 0xe7, 0xd4, 0x52,
+	0xb4, 0x15, 0x24, 0x3d, 0x72, 0x5d, 0x69, 0x26, 0x5a, 0x38, 0x7b, 0x31, 0x6c, 0x1d, 0x32, 0xf3,
+	0x9c, 0x34, 0xb9, 0x65, 0x53, 0x4c, 0x6e, 0xb6, 0xa5, 0x2b, 0xf7, 0x3c, 0xc7, 0x87, 0xfc, 0xb5,
+	0x1c, 0x1f, 0x0a, 0x69, 0x8e, 0x0f, 0xef, 0xcc, 0xb5, 0x94, 0xb3, 0xbe, 0x3a, 0xd5, 0x4a, 0xfe,
+	0xde, 0x7c, 0x2b, 0x39, 0xb3, 0xe4, 0xf3, 0x2c, 0xe4, 0x96, 0x7d, 0xbf, 0x38, 0xc7, 0x3e, 0x65,
+	0x59, 0xfc, 0x53, 0x2c, 0xe4, 0xa5, 0x2f, 0x60, 0x21, 0xdf, 0x04, 0x56, 0xc6, 0x49, 0x9e, 0x0e,
+	0xe7, 0x1e, 0x5b, 0x33, 0xd1, 0x7b, 0x88, 0x29, 0xf7, 0xb7, 0xaf, 0x77, 0xc2, 0x34, 0x42, 0xe8,
+	0xda, 0xce, 0x9b, 0x6a, 0xd5, 0x7e, 0x7c, 0x66, 0xab, 0x22, 0xaa, 0x9e, 0x63, 0x67, 0x85, 0x4a,
+	0x35, 0xcb, 0xcb, 0x24, 0xff, 0x5c, 0x2f, 0x93, 0xc2, 0x73, 0xbd, 0x4c, 0x16, 0xa2, 0x5e, 0x26,
+	0x9b, 0xff, 0x01, 0xee, 0xcd, 0x14, 0x24, 0xfe, 0xf2, 0xe6, 0x8c, 0xb8, 0x17, 0x21, 0x6b, 0x59,
+	0xc1, 0x3d, 0x9b, 0xa2, 0xed, 0x6b, 0x45, 0x2c, 0x6e, 0x45, 0x20, 0x37, 0xd5, 0x9d, 0xe7, 0x51,
+	0x97, 0xb0, 0x86, 0x67, 0x57, 0xdf, 0xfc, 0x87, 0x59, 0x55, 0xb6, 0x32, 0x71, 0x15, 0x19, 0x65,
+	0x2d, 0xff, 0x4b, 0xe6, 0x2d, 0x49, 0x91, 0x42, 0xce, 0xf4, 0x84, 0x9c, 0x94, 0xcf, 0x87, 0x4b,
+	0x18, 0x49, 0x2a, 0x00, 0xf4, 0x59, 0x5b, 0x9a, 0xfd, 0xd0, 0x4d, 0x5c, 0xee, 0x1a, 0x71, 0x1a,
+	0x90, 0x41, 0x52, 0xf9, 0x37, 0xb5, 0x8c, 0x1b, 0xee, 0x9d, 0x65, 0xb9, 0x5b, 0x11, 0x77, 0x05,
+	0xd9, 0x44, 0xc4, 0xf3, 0xb7, 0xd5, 0x9a, 0xf1, 0x57, 0x88, 0xd4, 0x60, 0xfb, 0x90, 0xa3, 0xfd,
+	0x12, 0xac, 0x2a, 0xdf, 0x57, 0xb7, 0x63, 0x63, 0x8a, 0x55, 0x65, 0x3f, 0xb7, 0x5b, 0x91, 0xd1,
+	0xd9, 0x2d, 0x6c, 0xfe, 0x39, 0x60, 0xdb, 0x6d, 0x42, 0xf9, 0xe5, 0x6d, 0x79, 0x5c, 0x79, 0xc5,
+	0x2b, 0x6a, 0x2b, 0xaf, 0x36, 0xff, 0x57, 0x4e, 0x39, 0x49, 0x5a, 0xfd, 0x8b, 0x1c, 0x42, 0x12,
+	0x31, 0x73, 0x29, 0x88, 0xf9, 0xff, 0x8d, 0x7f, 0x08, 0x75, 0xa8, 0x96, 0xbb, 0x00, 0x1f, 0xce,
+	0x9a, 0xc9, 0xd0, 0xa3, 0xf8, 0x20, 0xee, 0x54, 0x55, 0x8c, 0xbc, 0x9f, 0xb4, 0x18, 0xa8, 0x98,
+	0x6f, 0xd5, 0x09, 0xb0, 0x4c, 0xa3, 0xde, 0x25, 0x50, 0x4f, 0xa6, 0x83, 0xbf, 0xf4, 0x85, 0xaf,
+	0xcf, 0xad, 0x3a, 0xd5, 0x27, 0xae, 0xcd, 0x93, 0xc6, 0xdc, 0xb7, 0x55, 0xd9, 0x02, 0x3b, 0x25,
+	0x55, 0xd8, 0x6f, 0x1e, 0x6c, 0x1f, 0xd5, 0x5e, 0x40, 0x4b, 0xbb, 0xd7, 0xd8, 0x39, 0x7a, 0xd0,
+	0xf0, 0x1a, 0xbb, 0xb5, 0x8c, 0x53, 0x54, 0xf9, 0xfd, 0xa3, 0x56, 0xbb, 0x96, 0x75, 0x37, 0xd5,
+	0x86, 0xb4, 0x98, 0xb4, 0x26, 0xfd, 0x24, 0x6f, 0x74, 0xa0, 0x94, 0x29, 0x42, 0xfe, 0x3b, 0xaa,
+	0x62, 0xb3, 0x37, 0x82, 0x11, 0x31, 0x8f, 0x15, 0x14, 0xef, 0xc7, 0x16, 0xad, 0xde, 0x51, 0xec,
+	0xaf, 0x70, 0x66, 0xaa, 0x65, 0x23, 0x7c, 0x6b, 0x8a, 0xe1, 0x97, 0xe4, 0xa3, 0x08, 0x1a, 0xfe,
+	0x19, 0xb5, 0x14, 0xb5, 0x9c, 0x08, 0x45, 0x4a, 0x13, 0x59, 0xb1, 0x76, 0xc4, 0x94, 0x02, 0x47,
+	0xb3, 0x16, 0xb7, 0xbc, 0x08, 0xf3, 0x3c, 0xa7, 0xfe, 0x72, 0x3f, 0x6a, 0x8c, 0x71, 0xee, 0xab,
+	0x1b, 0x69, 0x0c, 0x1e, 0xe1, 0xc7, 0x7c, 0x35, 0x87, 0x93, 0x64, 0xe2, 0x9c, 0x0f, 0xc5, 0x02,
+	0x57, 0xa0, 0xed, 0x7f, 0x2d, 0xda, 0xbf, 0xb5, 0xd8, 0x5b, 0xfc, 0xcf, 0xb2, 0xc5, 0x3d, 0x52,
+	0x2a, 0x84, 0xa1, 0xed, 0xed, 0xe8, 0xb8, 0x71, 0xd8, 0xd9, 0xb9, 0x5f, 0x3f, 0x3c, 0x6c, 0xec,
+	0xc3, 0x4e, 0x3b, 0x6a, 0x89, 0x9c, 0x2e, 0x76, 0x0d, 0x2c, 0x83, 0x30, 0xb1, 0x84, 0x6a, 0x58,
+	0x16, 0x3d, 0x32, 0x9a, 0x87, 0x31, 0x68, 0xce, 0xd9, 0x50, 0x37, 0xa0, 0x39, 0xf2, 0xd3, 0x88,
+	0xb4, 0x9b, 0x47, 0xa1, 0x41, 0xa6, 0x8b, 0x42, 0xc3, 0xa7, 0xdd, 0xc1, 0xc0, 0x9f, 0xc9, 0x39,
+	0xd0, 0xbc, 0xf4, 0xdf, 0xce, 0xa8, 0xb5, 0x58, 0x46, 0x68, 0xbe, 0x60, 0x4e, 0x3a, 0xca, 0x43,
+	0x57, 0x08, 0xa8, 0x4f, 0x13, 0x1c, 0x3d, 0xa3, 0x4d, 0x8b, 0xdd, 0x4a, 0x35, 0x93, 0xa1, 0x0b,
+	0xc3, 0x95, 0x6d, 0x29, 0xe5, 0x62, 0xb4, 0xc2, 0xb1, 0xb2, 0xa4, 0x82, 0xbb, 0xa5, 0x16, 0x44,
+	0x71, 0x59, 0x53, 0x39, 0xfd, 0x70, 0x25, 0xef, 0xe1, 0x4f, 0x54, 0xbd, 0x0e, 0x43, 0x77, 0x5f,
+	0xfa, 0x8d, 0x36, 0x56, 0xcd, 0x20, 0x47, 0x67, 0xf9, 0x1b, 0x79, 0x75, 0x33, 0x9e, 0x63, 0x1c,
+	0xe0, 0x17, 0x23, 0x13, 0x64, 0x43, 0x96, 0x80, 0x9c, 0x77, 0x63, 0xd8, 0x13, 0x99, 0x22, 0x15,
+	0xb5, 0x31, 0x45, 0x4f, 0xf4, 0x6e, 0x9c, 0x47, 0x64, 0x94, 0xaf, 0x6a, 0xa7, 0x7f, 0x9a, 0x53,
+	0x8c, 0x65, 0x7c, 0x37, 0xc1, 0x32, 0xe6, 0xd3, 0x2a, 0xc5, 0x38, 0xc8, 0x86, 0x5a, 0x0f, 0x1d,
+	0x5b, 0xa3, 0x7d, 0x16, 0xd2, 0xaa, 0xaf, 0x99, 0xd2, 0xfb, 0x76, 0xe7, 0xf7, 0xd4, 0x46, 0xd8,
+	0x4c, 0x6c, 0x18, 0x0b, 0x69, 0xed, 0xdc, 0x34, 0xc5, 0xbd, 0xc8, 0x78, 0x7e, 0xa0, 0x36, 0x23,
+	0xeb, 0x15, 0x1d, 0xd2, 0x62, 0x5a, 0x53, 0xeb, 0xd6, 0x02, 0x46, 0x06, 0xb5, 0xaf, 0x5e, 0x8c,
+	0xb4, 0x15, 0x1b, 0x57, 0x31, 0xad, 0xb1, 0x0d, 0xab, 0xb1, 0xc8, 0xc8, 0xdc, 0xdf, 0x5d, 0x50,
+	0xce, 0x0f, 0xaf, 0xfc, 0xe9, 0x53, 0x7a, 0x97, 0x1a, 0x3c, 0xcf, 0x63, 0x5f, 0x2b, 0xde, 0xb2,
+	0xd7, 0x7a, 0x7b, 0x9e, 0xf6, 0xf6, 0x3b, 0xff, 0xfc, 0xb7, 0xdf, 0x85, 0xe7, 0xbd, 0xfd, 0x46,
+	0xcf, 0xc7, 0x8b, 0xd1, 0x18, 0xef, 0x35, 0x14, 0x6b, 0xd0, 0x6b, 0x3c, 0xf7, 0x46, 0xc5, 0xab,
+	0x08, 0x10, 0x85, 0x9a, 0x00, 0xcd, 0x36, 0xba, 0x90, 0x7f, 0x76, 0x41, 0xf1, 0x0f, 0xec, 0x1b,
+	0xad, 0x01, 0x30, 0xd1, 0x33, 0x12, 0xc2, 0xea, 0xca, 0x08, 0x0f, 0xd0, 0x4e, 0x17, 0x8c, 0xaf,
+	0x50, 0x4a, 0xd4, 0xcb, 0xc0, 0xe6, 0xe6, 0x0a, 0x43, 0x8f, 0xb5, 0xf3, 0xc1, 0xea, 0x15, 0x08,
+	0x74, 0xc3, 0x7e, 0x80, 0xb6, 0x7e, 0xd4, 0xbc, 0xcf, 0xa6, 0xe3, 0x81, 0x58, 0x90, 0x57, 0x20,
+	0xeb, 0x80, 0x73, 0x76, 0x38, 0x03, 0x90, 0xd9, 0x0c, 0x69, 0xd2, 0xed, 0x4f, 0x83, 0x0d, 0x45,
+	0x43, 0xd2, 0x33, 0x25, 0x61, 0x0c, 0xe0, 0x66, 0x2c, 0x98, 0x08, 0x62, 0x6f, 0xd2, 0xcb, 0xf1,
+	0x37, 0xe9, 0xbf, 0x96, 0xfe, 0x26, 0x9d, 0x9d, 0xe6, 0xde, 0x92, 0xa6, 0x93, 0x5b, 0xfc, 0x85,
+	0x9e, 0xa6, 0x27, 0x9f, 0xda, 0x2f, 0x7d, 0x91, 0xa7, 0xf6, 0xcb, 0x69, 0x4f, 0xed, 0xe1, 0x86,
+	0xa7, 0x47, 0xd0, 0x9d, 0x4b, 0x72, 0x9d, 0x65, 0x8b, 0x78, 0xcd, 0x7e, 0x25, 0x7d, 0x1f, 0xd5,
+	0xb5, 0x6a, 0xaa, 0x7f, 0x06, 0xc9, 0x57, 0xef, 0x2b, 0xbf, 0xc0, 0x57, 0xef, 0xf2, 0x58, 0x7b,
+	0x4b, 0x15, 0xf5, 0x3e, 0x21, 0xb1, 0x3d, 0x9f, 0x8e, 0x87, 0xda, 0x0a, 0x87, 0xbf, 0x9d, 0x25,
+	0x95, 0x9d, 0x8d, 0xa5, 0x32, 0xfc, 0x72, 0x7f, 0x45, 0x95, 0x2d, 0x54, 0x03, 0xae, 0x51, 0x69,
+	0x41, 0x5b, 0x04, 0x05, 0x5e, 0xc5, 0x92, 0x40, 0x61, 0x01, 0xe1, 0xf2, 0x38, 0xeb, 0xc3, 0x36,
+	0x92, 0xfc, 0x36, 0xf5, 0xd1, 0x93, 0x44, 0x5b, 0x45, 0x6b, 0x26, 0xc3, 0x63, 0xb8, 0xfb, 0xab,
+	0x6a, 0x35, 0xb2, 0xb7, 0x42, 0xbe, 0x5f, 0x53, 0x0b, 0xb4, 0x6e, 0xda, 0xf5, 0x26, 0xfa, 0xfa,
+	0x5c, 0xf2, 0x28, 0x16, 0x07, 0x1b, 0x74, 0x3b, 0x93, 0xe9, 0xf8, 0x94, 0x3a, 0xc9, 0x78, 0x65,
+	0x81, 0x1d, 0x03, 0xc8, 0xfd, 0xa3, 0x9c, 0xca, 0xc1, 0x9e, 0xd9, 0xee, 0xb6, 0x99, 0x84, 0xbb,
+	0xad, 0x68, 0x0f, 0x3a, 0x46, 0x3b, 0x20, 0x02, 0x18, 0x99, 0x32, 0xb5, 0x86, 0xe0, 0x0d, 0xe0,
+	0x78, 0x80, 0x4e, 0xcc, 0xc6, 0x1d, 0x79, 0xe6, 0xc2, 0x37, 0x1c, 0x1f, 0x3e, 0xc8, 0x69, 0x8f,
+	0xf7, 0x18, 0x0e, 0x5b, 0x90, 0x33, 0xb2, 0x28, 0x65, 0x63, 0x12, 0x75, 0x73, 0xf4, 0x3c, 0x47,
+	0x3f, 0x55, 0x96, 0x14, 0xbe, 0x30, 0x8f, 0xb6, 0xcb, 0xa4, 0x48, 0x18, 0x5d, 0xbb, 0x61, 0xa2,
+	0x49, 0xb7, 0xd0, 0x93, 0xc2, 0x0f, 0x1f, 0x2b, 0x03, 0xb9, 0x82, 0x34, 0x65, 0x59, 0x44, 0xaf,
+	0x18, 0x21, 0x7a, 0xa8, 0xad, 0x1f, 0x3c, 0xc2, 0xa0, 0x0c, 0x83, 0x71, 0x57, 0xbf, 0xc9, 0x53,
+	0x00, 0x3a, 0x66, 0x08, 0x5c, 0xe1, 0x6a, 0x38, 0x99, 0xc8, 0xd9, 0x23, 0xf3, 0x5c, 0x88, 0xca,
+	0x07, 0xc7, 0xc7, 0x8c, 0x72, 0x5e, 0x09, 0xca, 0xf0, 0x4f, 0x67, 0x17, 0x78, 0xc8, 0xb4, 0x18,
+	0x12, 0xb7, 0xf5, 0x23, 0x86, 0xf1, 0x64, 0x2b, 0xe5, 0x70, 0x56, 0x7b, 0x36, 0x6c, 0xf3, 0xfb,
+	0xc0, 0xd4, 0xfe, 0x7c, 0x91, 0x1c, 0xda, 0xaa, 0x64, 0xc6, 0x67, 0x07, 0x42, 0xa0, 0x97, 0x63,
+	0xe5, 0x48, 0x20, 0x04, 0xb4, 0xfb, 0x21, 0x5d, 0x64, 0xee, 0xc7, 0x90, 0x7c, 0x65, 0xb1, 0x3f,
+	0xf2, 0xfc, 0xc7, 0xfd, 0xaf, 0x19, 0x55, 0xe0, 0xa8, 0x0c, 0x40, 0x0c, 0xb8, 0xbc, 0x71, 0x5d,
+	0x16, 0x87, 0x13, 0x66, 0xa2, 0xda, 0xe2, 0xb5, 0x8c, 0xc7, 0xc2, 0x8a, 0x54, 0x13, 0xb2, 0x11,
+	0x56, 0xb4, 0x9a, 0x57, 0x54, 0xc9, 0x74, 0x6d, 0xa1, 0x4e, 0x51, 0xf7, 0xec, 0xbc, 0x8c, 0xcf,
+	0x97, 0x27, 0x5a, 0x8d, 0xa7, 0xc2, 0x95, 0xf4, 0x08, 0x1e, 0x8e, 0x05, 0xfb, 0x08, 0x9f, 0x25,
+	0xe5, 0x64, 0x2c, 0xd8, 0x89, 0x7e, 0xab, 0x1e, 0x9b, 0xe3, 0x42, 0xca, 0x1c, 0x4f, 0xd4, 0x32,
+	0xd2, 0x01, 0xcb, 0xeb, 0x65, 0xfe, 0xa5, 0xf9, 0x0d, 0x64, 0xd7, 0x7b, 0x83, 0xab, 0x33, 0xdf,
+	0x56, 0xa4, 0x92, 0x1f, 0xaa, 0xc0, 0xb5, 0x98, 0xe4, 0xfe, 0x6e, 0x86, 0xe9, 0x0b, 0xb6, 0x0b,
+	0x47, 0x26, 0x3f, 0xd2, 0x1e, 0x32, 0x21, 0x53, 0x6e, 0x9e, 0xf0, 0x61, 0x39, 0x8f, 0x4a, 0xe0,
+	0xd6, 0x91, 0x5f, 0x89, 0xdd, 0x7a, 0xd5, 0xc3, 0x87, 0x34, 0x46, 0x0f, 0xf9, 0x35, 0x3d, 0xad,
+	0x98, 0x0e, 0x8f, 0x67, 0x6f, 0x8e, 0xe9, 0x96, 0xe5, 0xd0, 0x9a, 0x8f, 0xdc, 0x98, 0x9a, 0xa5,
+	0x07, 0x6a, 0x66, 0x39, 0xb2, 0xfe, 0x5e, 0x56, 0x55, 0x23, 0x23, 0x22, 0x8f, 0x5e, 0xbc, 0x00,
+	0xd8, 0xce, 0x28, 0xfb, 0x4d, 0x8e, 0x93, 0x22, 0x75, 0x59, 0xeb, 0x94, 0x8d, 0xac, 0x93, 0x71,
+	0x71, 0xcb, 0xd9, 0x2e, 0x6e, 0x6f, 0xa9, 0x52, 0x18, 0xa1, 0x28, 0x3a, 0x24, 0xec, 0x4f, 0x3f,
+	0x64, 0x0c, 0x0b, 0x85, 0x4e, 0x71, 0x05, 0xdb, 0x29, 0xee, 0xbb, 0x96, 0x0f, 0xd5, 0x02, 0x35,
+	0xe3, 0xa6, 0xad, 0xe8, 0x2f, 0xc4, 0x83, 0xca, 0xfd, 0x58, 0x95, 0xad, 0xc1, 0xdb, 0x7e, 0x48,
+	0x99, 0x88, 0x1f, 0x92, 0x79, 0xd2, 0x9c, 0x0d, 0x9f, 0x34, 0xe3, 0xe3, 0xc8, 0x2a, 0x9e, 0x2f,
+	0xb4, 0x8e, 0x8c, 0x07, 0xfd, 0x1e, 0xd9, 0x1d, 0xcd, 0x09, 0x13, 0x46, 0x4b, 0x9f, 0x33, 0x39,
+	0x62, 0xcc, 0x67, 0xd9, 0x61, 0x33, 0x98, 0x48, 0x9b, 0xb0, 0x19, 0xae, 0xaa, 0x22, 0x61, 0x24,
+	0x0b, 0x62, 0x18, 0xe7, 0xc8, 0x2b, 0x03, 0x70, 0x1b, 0x60, 0x74, 0x34, 0x80, 0xd6, 0x62, 0x19,
+	0x7a, 0x14, 0x3f, 0xec, 0x0f, 0x06, 0xfd, 0xf0, 0x1d, 0x20, 0xd0, 0x5a, 0xc8, 0xf2, 0x20, 0xe7,
+	0x00, 0x33, 0x24, 0x2c, 0x52, 0xf1, 0xac, 0x1f, 0x74, 0x4f, 0x43, 0xbf, 0x6b, 0x93, 0xd6, 0x86,
+	0xf9, 0xd0, 0xf7, 0x61, 0x41, 0x9e, 0x08, 0xb2, 0xe5, 0x9e, 0xea, 0xc7, 0x30, 0x69, 0x31, 0x8e,
+	0x49, 0xee, 0xbf, 0x40, 0x35, 0x5c, 0x88, 0x96, 0xd7, 0xb9, 0x5d, 0x6f, 0x27, 0xec, 0xc4, 0x25,
+	0xdb, 0x24, 0xfc, 0xd5, 0x68, 0x97, 0x39, 0xf3, 0x58, 0xcc, 0x46, 0x60, 0x74, 0x64, 0x84, 0xcd,
+	0x7b, 0x9b, 0xf4, 0xe9, 0x12, 0x96, 0x8c, 0x00, 0xa8, 0x4a, 0x97, 0xcc, 0xbb, 0x94, 0x59, 0x08,
+	0x33, 0xef, 0x62, 0xe6, 0xb3, 0x1e, 0x8b, 0x7c, 0x00, 0x67, 0x98, 0x5b, 0xa5, 0x3d, 0x15, 0xb1,
+	0xe0, 0x86, 0x75, 0x73, 0x9b, 0xfd, 0xf6, 0xca, 0xdc, 0x1d, 0x6f, 0xbe, 0x54, 0xbc, 0xab, 0x2b,
+	0x16, 0x9f, 0x57, 0xf1, 0x2e, 0x27, 0xdc, 0x3d, 0xf3, 0xfe, 0x86, 0xbc, 0x17, 0x35, 0x1d, 0x03,
+	0x81, 0x54, 0x93, 0xab, 0xab, 0x11, 0x64, 0x83, 0x08, 0xd1, 0xf3, 0xf5, 0x5b, 0x64, 0x47, 0xb2,
+	0x4e, 0xc2, 0x1c, 0xf7, 0xcc, 0x04, 0xdb, 0x60, 0x2f, 0xc8, 0x3b, 0xaa, 0xc0, 0x7c, 0x39, 0x33,
+	0x1f, 0xe9, 0x84, 0x8b, 0x8b, 0x00, 0x8d, 0x2b, 0x30, 0x7b, 0x9e, 0x9d, 0x4b, 0x6c, 0xb8, 0x80,
+	0x5b, 0x57, 0x0e, 0x56, 0x3c, 0xf0, 0x67, 0xd3, 0x7e, 0x2f, 0x08, 0x9f, 0x39, 0x17, 0x50, 0x99,
+	0xc0, 0x7d, 0x85, 0x6a, 0xf8, 0xb0, 0x24, 0x29, 0x1c, 0xb8, 0x0c, 0x5e, 0x4c, 0xab, 0x91, 0x36,
+	0x84, 0x5d, 0x1a, 0xa8, 0x9b, 0xa7, 0x70, 0xde, 0x7c, 0x1f, 0xfa, 0x04, 0x66, 0x08, 0xe3, 0x76,
+	0x4d, 0x81, 0xf8, 0xcc, 0x9e, 0xca, 0x0c, 0xde, 0x4b, 0xb4, 0x1a, 0x2a, 0xb4, 0xb6, 0xc3, 0x8a,
+	0x3b, 0xa6, 0x1e, 0xd3, 0x8e, 0xb5, 0xd3, 0xb4, 0xbc, 0xcd, 0x5f, 0x56, 0x9b, 0xf3, 0x2b, 0xa5,
+	0x04, 0x4b, 0x78, 0x23, 0x4a, 0x55, 0x8c, 0x51, 0x17, 0x58, 0x8f, 0x19, 0x8f, 0xc6, 0xa6, 0x2c,
+	0x87, 0xaa, 0x6c, 0xe5, 0x84, 0x77, 0x7f, 0x86, 0x98, 0x3b, 0x4e, 0xe0, 0x8d, 0x04, 0x12, 0xc6,
+	0x90, 0x8c, 0xa8, 0x67, 0x9d, 0xb0, 0xf5, 0x8c, 0xb7, 0x1c, 0xc2, 0xc9, 0xef, 0x06, 0x18, 0xde,
+	0x65, 0xe2, 0xec, 0xad, 0x8b, 0xee, 0x59, 0xcc, 0xa0, 0x7b, 0x03, 0x5f, 0xea, 0x13, 0xed, 0xb2,
+	0x3d, 0x42, 0xff, 0x30, 0x07, 0x04, 0x2f, 0x04, 0xe3, 0x6d, 0x44, 0x6e, 0xb4, 0x9d, 0xb3, 0x7e,
+	0x77, 0xe8, 0x6b, 0x8b, 0x35, 0xd0, 0x2b, 0x82, 0xee, 0x0a, 0x10, 0xef, 0xe2, 0xee, 0x23, 0x10,
+	0x74, 0xaf, 0x30, 0xdc, 0xcb, 0xc5, 0xd4, 0xd7, 0xa3, 0xac, 0x00, 0xf4, 0xe8, 0x6a, 0xb6, 0x4b,
+	0x30, 0x1d, 0x5d, 0xc6, 0x2a, 0x95, 0x33, 0xd1, 0x65, 0xc2, 0x52, 0xe2, 0x7e, 0xcc, 0x98, 0x99,
+	0x37, 0xee, 0xc7, 0x2c, 0x2d, 0xc6, 0x2f, 0xd0, 0x42, 0xf2, 0x02, 0x7d, 0x57, 0xdd, 0xe4, 0x0b,
+	0x54, 0x48, 0x73, 0x27, 0x76, 0x92, 0x6f, 0x50, 0xae, 0x4c, 0xd2, 0x62, 0x7b, 0x6b, 0x38, 0x03,
+	0x4d, 0x96, 0x02, 0xb4, 0x73, 0x2f, 0xd2, 0x1c, 0x70, 0x66, 0xd2, 0x78, 0x0b, 0xfd, 0x08, 0x24,
+	0xba, 0x4d, 0xa4, 0xa4, 0x3c, 0x05, 0x43, 0x37, 0xae, 0x58, 0x49, 0x0c, 0xd0, 0x60, 0x97, 0x2c,
+	0x49, 0xc9, 0xee, 0x13, 0xbb, 0xe4, 0x7b, 0x6a, 0x7d, 0xe8, 0xc3, 0x12, 0x47, 0x9b, 0xed, 0x84,
+	0x8c, 0xdb, 0x0d, 0xce, 0xb6, 0xea, 0xb4, 0x58, 0x70, 0xc7, 0xd5, 0xf8, 0xf5, 0xf1, 0xf0, 0xb4,
+	0xcf, 0x3c, 0x0b, 0x7b, 0x94, 0xe5, 0x3d, 0x74, 0x5f, 0xfd, 0x31, 0x81, 0xb1, 0x4a, 0xe0, 0x56,
+	0x55, 0xb9, 0x35, 0x03, 0x16, 0x4b, 0xb6, 0x79, 0x49, 0x55, 0x38, 0x29, 0xcf, 0xf8, 0x5f, 0x54,
+	0xb7, 0x88, 0x24, 0xb4, 0xc7, 0x40, 0x9b, 0xc6, 0x17, 0x4f, 0x23, 0x4a, 0xd9, 0x7f, 0x0b, 0xa7,
+	0x31, 0x92, 0x2b, 0xe4, 0xf5, 0x5d, 0xa6, 0x67, 0xe6, 0x09, 0x70, 0x26, 0xf2, 0xfe, 0x0b, 0xf7,
+	0x8b, 0x0b, 0x32, 0x31, 0xd3, 0xcf, 0x82, 0xeb, 0x61, 0xe8, 0x28, 0x5d, 0x91, 0x49, 0xca, 0x46,
+	0x92, 0xa4, 0x48, 0x7d, 0x1d, 0x54, 0x4a, 0x37, 0xf1, 0x4b, 0xf2, 0x5c, 0xef, 0x4c, 0xa6, 0x9c,
+	0x8b, 0x3e, 0xe8, 0xb1, 0x15, 0xb8, 0x7a, 0x04, 0xa1, 0x56, 0x37, 0x70, 0xff, 0x41, 0x46, 0xa9,
+	0x70, 0x74, 0xf4, 0xa4, 0xc8, 0xf0, 0x2d, 0x19, 0x72, 0xe6, 0xb6, 0x78, 0x14, 0x40, 0x38, 0xe3,
+	0xf7, 0x1f, 0x72, 0x42, 0x65, 0x0d, 0x43, 0x76, 0xe8, 0x75, 0xb5, 0x7c, 0x31, 0x18, 0x9f, 0x12,
+	0xc7, 0x2a, 0x7c, 0x0b, 0xbb, 0x84, 0x2c, 0x31, 0x58, 0x73, 0x23, 0x21, 0xdf, 0x94, 0x4f, 0x7d,
+	0x1a, 0x60, 0x73, 0x41, 0xee, 0x5f, 0xcb, 0x1a, 0xe7, 0xe2, 0x70, 0x25, 0x9e, 0x2d, 0xde, 0xfd,
+	0x2c, 0xae, 0x55, 0xcf, 0xb2, 0x15, 0x7f, 0xac, 0x96, 0xa6, 0x7c, 0x29, 0xe9, 0x1b, 0x2b, 0xff,
+	0x8c, 0x1b, 0xab, 0x3a, 0x8d, 0x70, 0x3a, 0x40, 0xb9, 0xba, 0x67, 0x20, 0xfb, 0xce, 0xfa, 0x64,
+	0x7a, 0x21, 0xfe, 0x58, 0xdc, 0x79, 0x2d, 0x38, 0x31, 0xa2, 0x18, 0x4c, 0x8c, 0x43, 0x4b, 0x98,
+	0x92, 0x12, 0xa3, 0x30, 0x04, 0x63, 0x41, 0xf7, 0x9f, 0x68, 0x6f, 0xe6, 0xe8, 0xee, 0x3e, 0x7b,
+	0x55, 0xec, 0x19, 0x66, 0x93, 0xd6, 0x70, 0x41, 0x24, 0xb1, 0xe8, 0x08, 0x3d, 0x62, 0xa0, 0xd8,
+	0x73, 0xa2, 0xcb, 0x9a, 0xbf, 0xce, 0xb2, 0xba, 0xff, 0x3e, 0xa3, 0x16, 0x41, 0xa2, 0x41, 0x75,
+	0x08, 0xb2, 0xd1, 0x74, 0x4c, 0x8c, 0xc1, 0x71, 0x01, 0x93, 0xe4, 0x07, 0xf6, 0x8c, 0xa7, 0xb1,
+	0xa9, 0x6c, 0x5e, 0x35, 0xca, 0xe6, 0x7d, 0x57, 0xbd, 0x48, 0xf6, 0xdc, 0x29, 0x9c, 0xcb, 0x29,
+	0x1e, 0x55, 0x40, 0x41, 0x62, 0xf7, 0xc6, 0xa3, 0xd9, 0xa5, 0xa6, 0x9d, 0xb7, 0xd0, 0xc0, 0x6b,
+	0x95, 0x38, 0x30, 0x05, 0xe8, 0x59, 0x3c, 0x6a, 0xac, 0x58, 0x42, 0x17, 0x7e, 0x94, 0x29, 0xea,
+	0x32, 0x66, 0x34, 0x08, 0x4e, 0x1c, 0xa9, 0xfb, 0xa1, 0x2a, 0x19, 0x65, 0x0f, 0x5c, 0xe6, 0x25,
+	0x54, 0x1b, 0xb1, 0x46, 0x28, 0x13, 0x79, 0x3e, 0x2c, 0xb3, 0xf6, 0x8a, 0x97, 0xfc, 0x23, 0x70,
+	0xff, 0x68, 0x51, 0x2d, 0x36, 0x47, 0x8f, 0xc6, 0xfd, 0x1e, 0xf9, 0x43, 0x0f, 0xfd, 0xe1, 0x58,
+	0x47, 0xbe, 0xc1, 0xdf, 0xe4, 0xaa, 0x17, 0x46, 0x1a, 0xcc, 0x89, 0xab, 0x9e, 0x89, 0x31, 0xb8,
+	0xa6, 0x16, 0xa6, 0x76, 0xa8, 0xc0, 0xc2, 0x94, 0x5e, 0x91, 0x98, 0xfb, 0xb2, 0x60, 0x45, 0x26,
+	0xc2, 0xb6, 0xd8, 0x55, 0x95, 0x96, 0x8c, 0x9f, 0xb6, 0x97, 0x08, 0x42, 0x0b, 0xf6, 0x92, 0x5a,
+	0x14, 0xbd, 0x2f, 0xbf, 0x1d, 0x64, 0x6d, 0xb9, 0x80, 0x08, 0x1b, 0xa6, 0x3e, 0xdb, 0xe3, 0x0d,
+	0x23, 0x8b, 0xea, 0x11, 0x01, 0xee, 0x22, 0xae, 0xa1, 0x17, 0x19, 0x95, 0xe7, 0x22, 0x45, 0x71,
+	0x23, 0x26, 0x10, 0x15, 0x48, 0x89, 0xb8, 0x59, 0x4a, 0x8d, 0xb8, 0x49, 0x0e, 0xef, 0x86, 0xca,
+	0xf2, 0x14, 0x15, 0xc7, 0x59, 0xb4, 0xe0, 0x3a, 0x8c, 0xad, 0xe8, 0x54, 0x38, 0xea, 0x83, 0xd6,
+	0xa9, 0xc0, 0x88, 0xcf, 0xbb, 0x83, 0xc1, 0x69, 0x17, 0xa4, 0x09, 0x92, 0x3e, 0x2a, 0xac, 0xfd,
+	0xd4, 0x40, 0xd2, 0x05, 0xe0, 0xd3, 0xa6, 0x70, 0x97, 0xc9, 0x47, 0x38, 0xef, 0xa9, 0x70, 0x7f,
+	0xe3, 0x1a, 0xbe, 0xa5, 0x6b, 0x68, 0xf8, 0x2c, 0x5f, 0xe9, 0xe5, 0xa8, 0xaf, 0xf4, 0x8b, 0x44,
+	0x4d, 0xc5, 0x03, 0xb5, 0xc6, 0x41, 0xfd, 0x00, 0xc0, 0x71, 0x58, 0x50, 0x91, 0xc5, 0x8b, 0xc7,
+	0xf9, 0x2b, 0x2c, 0x4b, 0x30, 0x8c, 0x8b, 0xdc, 0x66, 0x35, 0xf5, 0xa4, 0x0b, 0xa7, 0xc2, 0x09,
+	0x2d, 0x1a, 0x00, 0x3b, 0x06, 0x10, 0xfa, 0xde, 0xe9, 0x6c, 0xba, 0x1d, 0x57, 0x79, 0xfd, 0x25,
+	0xbb, 0xc5, 0x31, 0x4d, 0x4c, 0x89, 0xa1, 0x09, 0xdb, 0xe0, 0x95, 0xa5, 0x08, 0xe1, 0xc1, 0xdb,
+	0xe4, 0xb2, 0x05, 0x83, 0x5f, 0x23, 0x63, 0xd8, 0x8b, 0xc6, 0x93, 0x84, 0xb0, 0x54, 0xff, 0x67,
+	0x4b, 0x27, 0x97, 0x44, 0xe6, 0x8e, 0x0d, 0xae, 0x37, 0x23, 0xfc, 0xaf, 0x14, 0x25, 0x83, 0x2b,
+	0x17, 0x70, 0x3e, 0xb4, 0xe4, 0xd7, 0x0d, 0x2a, 0xfc, 0x52, 0xac, 0xfd, 0x79, 0x6f, 0x23, 0x01,
+	0x7b, 0xfb, 0x01, 0xde, 0x32, 0x18, 0x9d, 0x8a, 0xe2, 0x2b, 0x60, 0x14, 0x8b, 0xe0, 0x13, 0x06,
+	0x7c, 0xb9, 0x82, 0x6d, 0x5d, 0x55, 0xec, 0x69, 0xa2, 0x7d, 0x16, 0xcd, 0x6f, 0xb5, 0x17, 0x9c,
+	0xb2, 0x5a, 0x6c, 0x35, 0xda, 0xed, 0x7d, 0x32, 0xdb, 0x56, 0x54, 0xd1, 0xbc, 0x9e, 0xce, 0x62,
+	0xaa, 0xbe, 0xb3, 0xd3, 0x38, 0x6e, 0x43, 0x2a, 0xf7, 0x83, 0x7c, 0x31, 0x5b, 0xcb, 0xb9, 0x7f,
+	0x0c, 0x1c, 0xa3, 0xb5, 0x0a, 0xcf, 0x26, 0xc6, 0xd1, 0x38, 0x3d, 0xd9, 0x78, 0x9c, 0x1e, 0xdb,
+	0x46, 0x21, 0xb1, 0x8c, 0xb4, 0x8d, 0x02, 0x50, 0x5d, 0xe2, 0x09, 0x5a, 0xc6, 0xf7, 0x02, 0x30,
+	0x98, 0x04, 0x14, 0x52, 0x4d, 0xb1, 0x18, 0xa8, 0x10, 0xbd, 0x72, 0x95, 0x48, 0x60, 0x0c, 0xa2,
+	0x77, 0xae, 0xf4, 0x48, 0x39, 0x18, 0x0f, 0x1e, 0xf9, 0x5c, 0x82, 0x39, 0xc2, 0xb2, 0xc0, 0xda,
+	0x12, 0xe7, 0x42, 0xe8, 0xa1, 0x15, 0x0c, 0x00, 0x3a, 0x62, 0xa0, 0x74, 0xf4, 0x6d, 0x8d, 0x40,
+	0xec, 0x8a, 0xb4, 0x9e, 0xc4, 0x86, 0x08, 0xf2, 0xec, 0x27, 0xd4, 0x88, 0x25, 0x42, 0x8c, 0xaf,
+	0x25, 0xeb, 0x3d, 0x5f, 0x9d, 0x88, 0x11, 0x1d, 0x51, 0x8b, 0x99, 0xa2, 0xe0, 0xcb, 0x7b, 0xcb,
+	0x90, 0xd3, 0xb6, 0xf4, 0x5f, 0x5f, 0x82, 0xee, 0xf1, 0x73, 0xe5, 0xd4, 0xf1, 0x00, 0xd3, 0x10,
+	0x8d, 0x28, 0x16, 0x92, 0xe5, 0x8c, 0x4d, 0x96, 0x53, 0xa8, 0x5f, 0x36, 0x95, 0xfa, 0x3d, 0x8b,
+	0x4e, 0x80, 0xc0, 0x5b, 0x3e, 0xb6, 0xc2, 0xba, 0xbe, 0x8a, 0x37, 0x84, 0x0e, 0xe8, 0xca, 0x77,
+	0x07, 0xeb, 0x14, 0xa7, 0x12, 0xc7, 0xd5, 0x1a, 0x4d, 0xd6, 0x1a, 0x8d, 0xfb, 0x3b, 0x19, 0x8e,
+	0xaa, 0x66, 0x06, 0x1f, 0x46, 0x92, 0xd5, 0xa6, 0xb9, 0x30, 0x66, 0x47, 0x59, 0x1b, 0xdf, 0x24,
+	0xdc, 0x06, 0x0d, 0xad, 0x33, 0x3e, 0x3f, 0x07, 0xf2, 0x24, 0x0e, 0x3b, 0x65, 0x82, 0x1d, 0x11,
+	0x48, 0x33, 0xdf, 0xc8, 0xe1, 0xf7, 0xb9, 0xfd, 0x40, 0xbc, 0x74, 0x90, 0xf9, 0x3e, 0xe8, 0x3e,
+	0x91, 0x5e, 0x03, 0x64, 0x41, 0xc4, 0x3e, 0xa0, 0xdf, 0xac, 0x9b, 0xb4, 0xfb, 0x77, 0x24, 0xac,
+	0x48, 0x7c, 0x7d, 0xef, 0xa0, 0xfb, 0xab, 0xb4, 0x1a, 0xbd, 0x61, 0x75, 0x49, 0x93, 0x8f, 0xf7,
+	0x38, 0x29, 0x43, 0x22, 0x23, 0xe6, 0xc3, 0x45, 0x36, 0x9e, 0xa6, 0x35, 0xea, 0x6f, 0x29, 0xe7,
+	0xbc, 0x3f, 0x8d, 0x17, 0xe6, 0xc3, 0x56, 0xa3, 0x1c, 0xab, 0xb4, 0x7b, 0xa2, 0x56, 0x35, 0x95,
+	0xb0, 0x24, 0x82, 0xe8, 0xe6, 0x65, 0x9e, 0x43, 0xe4, 0xb3, 0x09, 0x22, 0xef, 0xfe, 0xb3, 0x82,
+	0x5a, 0xd4, 0x21, 0x92, 0xd3, 0xc2, 0xfa, 0x96, 0xa2, 0x61, 0x7d, 0x37, 0x22, 0x51, 0x08, 0x69,
+	0xeb, 0xe5, 0xbe, 0x7f, 0x3d, 0x7e, 0x65, 0x5b, 0xb6, 0x8a, 0xc8, 0xb5, 0x2d, 0xb6, 0x8a, 0x42,
+	0xd4, 0x56, 0x91, 0x16, 0xea, 0x98, 0x59, 0xcf, 0x44, 0xa8, 0x63, 0x98, 0x32, 0x73, 0x16, 0xa1,
+	0x41, 0xa2, 0x48, 0x00, 0x89, 0xbb, 0x60, 0xb1, 0x1d, 0xc5, 0x38, 0xdb, 0x71, 0x6d, 0x96, 0xe0,
+	0x5d, 0xb5, 0xc0, 0x21, 0x8a, 0xe4, 0x0d, 0xbe, 0xbe, 0x38, 0x64, 0xad, 0xf4, 0x7f, 0x7e, 0x00,
+	0xe3, 0x49, 0x59, 0x3b, 0x34, 0x66, 0x39, 0x12, 0x1a, 0xd3, 0xb6, 0xa1, 0x54, 0xa2, 0x36, 0x14,
+	0x0c, 0x3d, 0xa6, 0x17, 0x8e, 0x34, 0x92, 0xa3, 0x40, 0xde, 0xdf, 0x2e, 0x69, 0x38, 0x52, 0xc3,
+	0xc3, 0x20, 0xbc, 0xf8, 0x96, 0x22, 0x17, 0x1f, 0xd2, 0xaa, 0xfa, 0x6c, 0xe6, 0x0f, 0x27, 0x33,
+	0x7d, 0xf1, 0x59, 0xd1, 0xa5, 0x79, 0xe7, 0xf9, 0x81, 0x90, 0xde, 0x5e, 0xc6, 0x8e, 0x6d, 0xb5,
+	0x74, 0xde, 0xed, 0x0f, 0xe0, 0x36, 0x82, 0xb5, 0xe8, 0x06, 0xe3, 0x11, 0x1d, 0xfe, 0xf0, 0x0e,
+	0x96, 0x29, 0xee, 0x71, 0x19, 0x8f, 0x8a, 0x78, 0xd5, 0x73, 0x3b, 0x49, 0xcf, 0xec, 0xec, 0x95,
+	0xc0, 0x2b, 0x4b, 0x5e, 0xe2, 0xb3, 0xe3, 0x51, 0xf3, 0xb0, 0xb3, 0xb7, 0xdf, 0xbc, 0x77, 0xbf,
+	0x0d, 0x37, 0x18, 0x24, 0x5b, 0x27, 0x70, 0x69, 0x35, 0x76, 0xe9, 0x0a, 0x53, 0x6a, 0x61, 0xaf,
+	0xde, 0xdc, 0x97, 0x0b, 0x2c, 0x5f, 0x2b, 0x90, 0xc2, 0xdd, 0x38, 0xe9, 0x77, 0x02, 0xff, 0x73,
+	0x61, 0x4b, 0xaa, 0x21, 0xb4, 0xe5, 0x7f, 0xee, 0xfe, 0x61, 0x56, 0x95, 0xad, 0x49, 0x83, 0xc8,
+	0xae, 0xf7, 0x8a, 0x43, 0x84, 0xdc, 0x4e, 0x2e, 0xcc, 0x96, 0xbe, 0x08, 0xac, 0xcd, 0x32, 0xe1,
+	0xa6, 0xb3, 0x73, 0xc3, 0x4d, 0xa3, 0x96, 0xb8, 0xcb, 0x2d, 0x98, 0xbd, 0x11, 0x1b, 0x80, 0x80,
+	0x65, 0x6b, 0xbe, 0x2e, 0xe1, 0x4a, 0xe4, 0x36, 0xc3, 0x72, 0x79, 0xed, 0xa8, 0x6b, 0x2e, 0x34,
+	0xda, 0xc2, 0x45, 0x59, 0x40, 0xb1, 0xd9, 0x1b, 0xbe, 0x40, 0x96, 0x55, 0x67, 0xf3, 0x13, 0x5d,
+	0xeb, 0x20, 0x54, 0x3c, 0x93, 0xa6, 0xd8, 0x23, 0x32, 0x2a, 0xb8, 0xdb, 0x17, 0x25, 0xf6, 0x08,
+	0x43, 0x9a, 0x67, 0xee, 0xfb, 0x4a, 0x85, 0xd3, 0x8d, 0x6e, 0xc2, 0x0b, 0xd1, 0x4d, 0xc8, 0x58,
+	0x9b, 0x90, 0x75, 0xff, 0xb1, 0x10, 0x40, 0xd9, 0x51, 0xa3, 0x30, 0xfc, 0xb6, 0xd2, 0x2a, 0xcc,
+	0x0e, 0xf9, 0xfd, 0x4f, 0x06, 0xfe, 0x4c, 0x3f, 0x42, 0x5e, 0x91, 0x9c, 0xa6, 0xc9, 0x48, 0x10,
+	0xec, 0x6c, 0x92, 0x60, 0x43, 0x11, 0x0a, 0x8f, 0x27, 0x1d, 0x09, 0xd1, 0x43, 0x4d, 0xb6, 0xee,
+	0x3b, 0x42, 0xa9, 0xf3, 0x31, 0x4a, 0xfd, 0x77, 0x33, 0x1c, 0x4b, 0x29, 0x1c, 0x68, 0x48, 0xaa,
+	0x4d, 0x9b, 0x51, 0x52, 0x2d, 0x45, 0x3d, 0x93, 0x3f, 0x87, 0xfc, 0x66, 0xd3, 0xc9, 0x6f, 0x3a,
+	0x61, 0xcf, 0xa5, 0x12, 0x76, 0x74, 0xab, 0x03, 0x49, 0x0d, 0x96, 0xa2, 0x3e, 0x18, 0xc4, 0xd6,
+	0x12, 0xd5, 0x3b, 0x29, 0x79, 0xa2, 0xfb, 0xf9, 0x2b, 0x19, 0xb5, 0x56, 0xe7, 0x10, 0x2a, 0x5f,
+	0xda, 0x2b, 0xe1, 0x8f, 0xd4, 0x2d, 0xe3, 0xc4, 0x6f, 0x3d, 0x3e, 0xb4, 0xe3, 0x5f, 0x69, 0xff,
+	0x7f, 0xeb, 0xe9, 0x0a, 0xde, 0xbc, 0xf8, 0x70, 0x22, 0x3e, 0x1a, 0x19, 0xe8, 0x9e, 0x5a, 0xd9,
+	0xf5, 0x4f, 0xaf, 0x2e, 0xf6, 0x61, 0x47, 0x06, 0x56, 0x28, 0xd9, 0xe0, 0x72, 0xfc, 0x58, 0x10,
+	0x83, 0x7e, 0x93, 0x97, 0x2f, 0x96, 0xe9, 0x04, 0x13, 0xbf, 0xa7, 0x6d, 0x07, 0x04, 0x69, 0x01,
+	0xc0, 0x7d, 0x4f, 0x39, 0x76, 0x3b, 0xb2, 0x8b, 0x28, 0xd8, 0x5d, 0x9d, 0x76, 0x82, 0xa7, 0x01,
+	0x60, 0xb4, 0x7e, 0x58, 0xab, 0x00, 0xd4, 0x62, 0x88, 0xfb, 0xba, 0xaa, 0xc0, 0xda, 0x41, 0xbf,
+	0xf2, 0x7e, 0x15, 0xcd, 0x63, 0xdd, 0xa7, 0x48, 0xd1, 0x8d, 0x19, 0x91, 0xb2, 0xdd, 0x7f, 0x9a,
+	0x57, 0x0b, 0x5c, 0x12, 0xe3, 0xea, 0xa0, 0x87, 0x43, 0x7f, 0x44, 0x14, 0x55, 0xdf, 0x6d, 0x16,
+	0x28, 0x71, 0xfd, 0x65, 0x93, 0xd7, 0x9f, 0xe8, 0x3c, 0x75, 0x7c, 0x3e, 0x6d, 0xf0, 0x01, 0x98,
+	0x0e, 0xca, 0x17, 0x8d, 0x20, 0x92, 0x0f, 0xbf, 0x2f, 0xc2, 0xd1, 0x13, 0xa2, 0x26, 0xf9, 0x50,
+	0x7c, 0xe4, 0xd1, 0xe9, 0x5b, 0x5d, 0x6e, 0x3e, 0x1b, 0x94, 0x2a, 0xa3, 0x2e, 0xea, 0x47, 0xd9,
+	0x51, 0x19, 0x35, 0x21, 0x8b, 0x16, 0x9f, 0x2f, 0x8b, 0xb2, 0x32, 0xf4, 0x19, 0xb2, 0xa8, 0xba,
+	0x86, 0x2c, 0x7a, 0x0d, 0x73, 0x38, 0x5c, 0x84, 0xc4, 0xaa, 0x59, 0x17, 0x21, 0xb2, 0x68, 0x78,
+	0x11, 0x7e, 0x60, 0x49, 0x6b, 0xec, 0x8b, 0x63, 0xdd, 0x44, 0xb0, 0x85, 0xbf, 0x18, 0x33, 0xe3,
+	0x67, 0x6a, 0x51, 0xa0, 0x88, 0xd0, 0xa3, 0xee, 0x50, 0x47, 0xa1, 0xa5, 0xdf, 0xb8, 0x6c, 0x14,
+	0x97, 0xf1, 0xf3, 0xab, 0xfe, 0xd4, 0x3f, 0xd3, 0xd1, 0xe1, 0xfa, 0x74, 0xbe, 0x11, 0x82, 0x13,
+	0x44, 0xc9, 0x71, 0xa4, 0xa3, 0xc8, 0x63, 0xdc, 0x9f, 0xe0, 0x13, 0x4c, 0xba, 0x8e, 0xaa, 0x51,
+	0x1c, 0x6d, 0x54, 0x00, 0x69, 0x7a, 0xf0, 0xd3, 0x8c, 0xaa, 0xc9, 0xe9, 0x32, 0x79, 0xb6, 0xe0,
+	0x56, 0x98, 0xe7, 0x3a, 0xf2, 0xec, 0x58, 0x6f, 0x20, 0x7a, 0x93, 0xbe, 0xca, 0x30, 0x1d, 0xac,
+	0x6f, 0x2b, 0x23, 0x70, 0x4f, 0x18, 0x8f, 0x97, 0x55, 0x59, 0xbf, 0x41, 0x18, 0xf6, 0x07, 0xfa,
+	0x53, 0x42, 0xfc, 0x08, 0xe1, 0xa0, 0x3f, 0xd0, 0x3c, 0x0b, 0x9a, 0x2e, 0x69, 0x26, 0x19, 0xe2,
+	0x59, 0xd0, 0x5e, 0xe9, 0xfe, 0xf3, 0x8c, 0x5a, 0xb1, 0xa6, 0x22, 0xe7, 0xf6, 0x3b, 0xaa, 0x62,
+	0xa2, 0xfb, 0xfb, 0x86, 0x59, 0x5e, 0x8f, 0xd2, 0xa8, 0xb0, 0x5a, 0xb9, 0x67, 0x20, 0x01, 0x0e,
+	0xe6, 0x0c, 0x8e, 0x30, 0x71, 0x4f, 0x57, 0x43, 0x2d, 0x8f, 0x02, 0x08, 0x1d, 0xe3, 0xaf, 0x86,
+	0xa8, 0x6d, 0x78, 0xec, 0xfb, 0x0f, 0x4d, 0x01, 0x26, 0xbd, 0x0a, 0x61, 0x52, 0x02, 0xcd, 0xa3,
+	0xa8, 0x4c, 0x33, 0x45, 0x44, 0x50, 0x20, 0x20, 0x97, 0x71, 0xff, 0x20, 0xab, 0x56, 0x59, 0x2b,
+	0x2a, 0xda, 0x68, 0x21, 0x5d, 0x1b, 0x6a, 0x81, 0x15, 0xc4, 0x4c, 0xbc, 0xee, 0xbf, 0xe0, 0x49,
+	0x1a, 0xf8, 0xc0, 0xeb, 0x69, 0x72, 0x75, 0x1c, 0x82, 0x39, 0xcb, 0x9f, 0x4b, 0x2e, 0xff, 0xfc,
+	0xe5, 0x4d, 0xb3, 0x4d, 0x17, 0xd2, 0x6c, 0xd3, 0xd7, 0xb1, 0x08, 0x27, 0x5e, 0xcc, 0x2f, 0x26,
+	0x03, 0xcb, 0xa2, 0xcd, 0xc3, 0x2e, 0x43, 0xd4, 0xba, 0x7f, 0xde, 0x37, 0x51, 0xcb, 0x6f, 0x58,
+	0xa5, 0x5b, 0x3a, 0x0f, 0xbf, 0xd3, 0x13, 0xf4, 0xc6, 0x13, 0x1f, 0x7d, 0x82, 0xa3, 0xab, 0x2a,
+	0xd7, 0xc4, 0x6f, 0x67, 0xd4, 0xc6, 0x5e, 0x18, 0xa1, 0x17, 0x6e, 0xec, 0xf1, 0xd4, 0x04, 0x7a,
+	0xc7, 0x08, 0x69, 0xf4, 0x59, 0x23, 0x12, 0xff, 0x25, 0xd6, 0x12, 0x41, 0x48, 0xf8, 0x87, 0xe5,
+	0xc1, 0xc7, 0xf7, 0x94, 0xc9, 0xd8, 0xb0, 0x88, 0x1f, 0x22, 0x11, 0xd5, 0x41, 0xe2, 0x1a, 0xae,
+	0x46, 0x19, 0x0c, 0x89, 0x1a, 0x82, 0xab, 0xe3, 0x3f, 0x22, 0x76, 0x20, 0x6f, 0xa2, 0x86, 0x80,
+	0x3c, 0x48, 0x4e, 0xd6, 0x81, 0xfb, 0xd7, 0xb3, 0x6a, 0x39, 0x1c, 0x1f, 0xc7, 0x4d, 0x7a, 0x76,
+	0x04, 0xa8, 0x57, 0x05, 0x1d, 0xfa, 0x28, 0x72, 0x59, 0xba, 0xe2, 0x22, 0x1f, 0xce, 0xe6, 0x08,
+	0xd6, 0xbb, 0xac, 0x4b, 0x60, 0x1c, 0xe8, 0x7c, 0xd4, 0xa2, 0xde, 0x3c, 0x3b, 0xba, 0x9a, 0xa1,
+	0x8c, 0x8c, 0xca, 0x02, 0x68, 0x81, 0xa5, 0xd4, 0x02, 0xa4, 0x9a, 0xf4, 0xed, 0x2c, 0x04, 0x63,
+	0x35, 0xde, 0x48, 0x2c, 0x85, 0xe5, 0x6b, 0x2c, 0x32, 0xf1, 0xce, 0x91, 0xb8, 0x64, 0xcb, 0x13,
+	0xfc, 0xb9, 0x0f, 0x23, 0x4f, 0xc0, 0x49, 0xe2, 0xc6, 0xc3, 0x00, 0x09, 0x14, 0x99, 0x0e, 0x7a,
+	0xa0, 0x7c, 0xd1, 0xdb, 0xa1, 0xe9, 0xcf, 0xd2, 0x56, 0x28, 0xee, 0x8a, 0x1c, 0x75, 0x80, 0x0d,
+	0xb9, 0x95, 0xb2, 0x6d, 0x72, 0xca, 0x77, 0x94, 0x15, 0xa7, 0x59, 0xaf, 0x2e, 0x1f, 0xf5, 0x9b,
+	0x9a, 0xac, 0x46, 0xd7, 0x14, 0xd8, 0xa9, 0x28, 0x20, 0x94, 0x93, 0x79, 0x07, 0x23, 0xe1, 0x37,
+	0x88, 0x9d, 0xe2, 0x6d, 0x64, 0x11, 0xf5, 0x58, 0x6d, 0xc2, 0x95, 0x04, 0x14, 0xc3, 0x38, 0x5e,
+	0xf7, 0x1e, 0x5e, 0x69, 0xfb, 0x59, 0xcc, 0x26, 0x90, 0xb9, 0x96, 0x4d, 0xe0, 0x8c, 0x1f, 0xc7,
+	0x9b, 0xb6, 0x7e, 0x96, 0x46, 0xe8, 0x02, 0xc5, 0x3a, 0xa7, 0xd4, 0x84, 0x8e, 0xc3, 0x81, 0x20,
+	0x6e, 0xd4, 0x0d, 0xd4, 0xf2, 0xc1, 0xd5, 0x60, 0xd6, 0xdf, 0x31, 0x20, 0xa0, 0x26, 0xe5, 0xb0,
+	0x1f, 0xbd, 0x6a, 0xa9, 0x1d, 0x29, 0xd3, 0x11, 0x2d, 0xd6, 0x10, 0x1b, 0xea, 0x24, 0xfb, 0x5b,
+	0x1e, 0x46, 0x7b, 0x70, 0x6f, 0xa9, 0xf5, 0x30, 0xc5, 0xcb, 0xa6, 0xaf, 0x9a, 0xbf, 0x97, 0xe1,
+	0x17, 0x1d, 0x9c, 0xd7, 0x1a, 0x75, 0x27, 0xc0, 0xa2, 0xcd, 0x9c, 0x86, 0x5a, 0x45, 0xfb, 0xcf,
+	0xc0, 0xb7, 0x9b, 0x0f, 0x64, 0x11, 0xd6, 0xa2, 0x63, 0xe3, 0xaa, 0x81, 0xb7, 0xc2, 0x35, 0xc2,
+	0xd6, 0x02, 0x90, 0x26, 0xe7, 0x0c, 0x32, 0x44, 0x8b, 0xd8, 0x6a, 0x24, 0x07, 0xdf, 0x54, 0x4b,
+	0xd1, 0x8e, 0xd0, 0x51, 0x23, 0x36, 0xaa, 0x5c, 0xec, 0x45, 0x7d, 0x88, 0x10, 0xe5, 0x70, 0xed,
+	0x03, 0xf7, 0xaf, 0x02, 0xe9, 0x01, 0x94, 0x05, 0xcc, 0xb5, 0x46, 0xa9, 0x71, 0xe6, 0x3b, 0x89,
+	0x56, 0xe7, 0xcf, 0x55, 0x87, 0xaa, 0xd0, 0x23, 0xfa, 0xd6, 0xdc, 0xcd, 0xc0, 0x47, 0x23, 0xb1,
+	0x19, 0x61, 0xf0, 0x08, 0x2e, 0x82, 0x4f, 0x0a, 0x64, 0x3c, 0x7a, 0x2c, 0xa1, 0xc1, 0x37, 0xd2,
+	0x63, 0xc4, 0xe0, 0x0b, 0xa2, 0x04, 0x3f, 0xfd, 0xb6, 0x27, 0x21, 0x15, 0x77, 0x95, 0x73, 0xd0,
+	0xed, 0x75, 0xa7, 0xe3, 0xf1, 0x08, 0x6e, 0x6c, 0x71, 0xa9, 0x26, 0x0e, 0x93, 0xec, 0xa1, 0x9a,
+	0x15, 0xe6, 0x94, 0x0e, 0x01, 0x3e, 0x1e, 0x69, 0x0f, 0x32, 0x4e, 0xb9, 0x53, 0xb5, 0xba, 0xdd,
+	0x7d, 0xe8, 0xeb, 0x96, 0xf4, 0x12, 0xe1, 0x33, 0x75, 0xd3, 0xa8, 0x5e, 0x77, 0x1d, 0x86, 0x27,
+	0xd9, 0xad, 0x67, 0x97, 0x46, 0x12, 0x04, 0xd9, 0x33, 0x0a, 0x67, 0xa1, 0x4d, 0x6a, 0x5e, 0x09,
+	0x41, 0x9f, 0xf8, 0x4f, 0x41, 0x40, 0xbd, 0xab, 0x6e, 0x44, 0xfb, 0x14, 0xd2, 0x02, 0x32, 0xdf,
+	0x50, 0x60, 0x32, 0x7a, 0x93, 0x46, 0x61, 0x04, 0x45, 0x3e, 0x5d, 0xa7, 0xb9, 0x6b, 0x44, 0xaa,
+	0x8f, 0xd5, 0x7a, 0x22, 0x47, 0x1a, 0x04, 0x5a, 0x67, 0x0d, 0x84, 0xa7, 0x81, 0xdf, 0xe4, 0xd1,
+	0x23, 0x09, 0xdc, 0x8f, 0xd4, 0x3a, 0xcb, 0x63, 0x61, 0x75, 0xbd, 0x04, 0xb1, 0x59, 0x64, 0xe2,
+	0xb3, 0x78, 0x57, 0x8b, 0x79, 0x76, 0xd5, 0x30, 0xbc, 0xdd, 0x19, 0xe5, 0x69, 0x27, 0x20, 0x9d,
+	0x74, 0x4f, 0xd4, 0xcd, 0xe4, 0xf2, 0xe1, 0xf8, 0x7f, 0xae, 0x25, 0xd7, 0xcb, 0x13, 0x66, 0x9b,
+	0xe5, 0xf9, 0x6f, 0x19, 0x5e, 0x9f, 0x48, 0x96, 0x0c, 0xf3, 0x4c, 0x39, 0x43, 0x7f, 0x76, 0x39,
+	0x3e, 0xeb, 0x24, 0x7b, 0x7e, 0xcf, 0xf8, 0x20, 0xa5, 0xd6, 0xdd, 0x3a, 0xa0, 0x8a, 0x56, 0x8e,
+	0x78, 0xc3, 0x0f, 0xe3, 0xf0, 0xcd, 0x1e, 0x4c, 0x39, 0xb5, 0x70, 0x8a, 0xe7, 0xce, 0x3b, 0x51,
+	0x46, 0xfd, 0xf6, 0xdc, 0xe9, 0xe3, 0xb0, 0x6c, 0xbe, 0xfd, 0x27, 0x45, 0x60, 0xdc, 0x45, 0x77,
+	0xb2, 0xa5, 0xf2, 0x3d, 0xed, 0x05, 0x1a, 0x86, 0x38, 0x94, 0x5c, 0xfd, 0x7f, 0x87, 0x7c, 0x41,
+	0xb1, 0x1c, 0x9a, 0xd7, 0xa3, 0x8e, 0x10, 0xb1, 0xd0, 0x26, 0x51, 0x0f, 0x86, 0x6a, 0x2f, 0x66,
+	0xf2, 0x2e, 0x85, 0xcc, 0x15, 0xf3, 0x9c, 0xc5, 0x4b, 0x8b, 0xfb, 0x1a, 0x8f, 0x48, 0x99, 0x75,
+	0xd9, 0xed, 0xdc, 0x7d, 0xef, 0x7d, 0x89, 0x6d, 0x52, 0x26, 0x60, 0xeb, 0xb2, 0x0b, 0xa0, 0xb8,
+	0x24, 0x26, 0x91, 0x4d, 0x2c, 0x49, 0x0c, 0x03, 0x7d, 0x51, 0x9c, 0x74, 0x76, 0xe7, 0xe3, 0x04,
+	0x06, 0x6d, 0xd2, 0xea, 0x3b, 0x79, 0x78, 0xc1, 0xb7, 0x28, 0x7f, 0x26, 0xca, 0x91, 0xbc, 0x16,
+	0x65, 0xb1, 0xc2, 0x0f, 0x48, 0xc0, 0x65, 0x18, 0xf8, 0xbe, 0xea, 0x49, 0xca, 0xfd, 0x83, 0x82,
+	0x2a, 0x5b, 0x8b, 0x82, 0xb6, 0x25, 0xaf, 0xd1, 0x6a, 0x78, 0x0f, 0x1a, 0xbb, 0xb5, 0x17, 0x9c,
+	0x37, 0xd4, 0x6b, 0xcd, 0xc3, 0x9d, 0x23, 0xcf, 0x6b, 0xec, 0xb4, 0x3b, 0x47, 0x5e, 0x47, 0x07,
+	0xda, 0x3c, 0xae, 0x7f, 0x76, 0xd0, 0x38, 0x6c, 0x77, 0x76, 0x1b, 0xed, 0x7a, 0x73, 0xbf, 0x55,
+	0xcb, 0x00, 0xeb, 0xb4, 0x11, 0x96, 0xd4, 0xd9, 0xf5, 0x83, 0xa3, 0x93, 0xc3, 0x76, 0x2d, 0x0b,
+	0xd3, 0x7c, 0x71, 0xaf, 0x79, 0x58, 0xdf, 0xef, 0x84, 0x65, 0x76, 0xf6, 0xdb, 0x0f, 0x3a, 0x8d,
+	0x1f, 0x1d, 0x37, 0xbd, 0xcf, 0x6a, 0xb9, 0xb4, 0x02, 0xa8, 0xc6, 0xd2, 0x2d, 0xe4, 0x81, 0xe9,
+	0x59, 0xe3, 0x02, 0x5c, 0xa5, 0xd3, 0x3e, 0x3a, 0xea, 0xb4, 0x8e, 0x8e, 0x0e, 0x6b, 0x05, 0x67,
+	0x45, 0x55, 0x9b, 0x87, 0x0f, 0xea, 0xfb, 0xcd, 0xdd, 0x8e, 0xd7, 0xa8, 0xef, 0x1f, 0xd4, 0x16,
+	0x9c, 0x55, 0xb5, 0x1c, 0x2f, 0xb7, 0x88, 0x4d, 0xe8, 0x72, 0x47, 0x87, 0xcd, 0xa3, 0xc3, 0xce,
+	0x83, 0x86, 0xd7, 0x82, 0xff, 0xb5, 0x22, 0xc6, 0x33, 0x8e, 0x66, 0xdd, 0x3f, 0xa8, 0xef, 0xd4,
+	0x4a, 0x18, 0xfe, 0x38, 0x0a, 0xff, 0xa4, 0xf1, 0x59, 0x4d, 0xe1, 0x63, 0x39, 0x1e, 0x58, 0x67,
+	0xbb, 0xb1, 0x7f, 0xf4, 0x69, 0xe7, 0xa0, 0x79, 0xd8, 0x3c, 0x38, 0x39, 0xa8, 0x95, 0x29, 0xdc,
+	0x71, 0xa3, 0x01, 0xb3, 0x68, 0x9d, 0xec, 0xed, 0x35, 0x77, 0x9a, 0xb0, 0x0a, 0xb5, 0x0a, 0xf7,
+	0x9c, 0x36, 0xf1, 0x2a, 0x56, 0x90, 0xa7, 0x76, 0x9d, 0xdd, 0x66, 0xab, 0xbe, 0x8d, 0xda, 0xb8,
+	0x25, 0xe0, 0x8f, 0x6f, 0xb5, 0x1b, 0x07, 0xc7, 0x47, 0x5e, 0x1d, 0xa6, 0xa0, 0xf3, 0x51, 0x57,
+	0x77, 0xe2, 0x35, 0x6a, 0xcb, 0xc0, 0x04, 0xdf, 0xf6, 0x1a, 0x3f, 0x3c, 0x69, 0x7a, 0x8d, 0xdd,
+	0xce, 0xe1, 0xd1, 0x6e, 0xa3, 0xb3, 0xd7, 0xa8, 0xb7, 0x21, 0x0b, 0x06, 0xd2, 0x6a, 0x35, 0x0f,
+	0xef, 0xd5, 0x6a, 0xc0, 0x04, 0xbf, 0x6a, 0x8a, 0x98, 0x06, 0x62, 0xa5, 0x56, 0x70, 0x7e, 0x7a,
+	0x4b, 0x0f, 0x1b, 0x3f, 0x82, 0x8d, 0x6b, 0x34, 0xbc, 0x9a, 0x03, 0xb4, 0xf8, 0x66, 0xd8, 0x3d,
+	0x77, 0x20, 0x7d, 0xaf, 0x62, 0xde, 0x71, 0xc3, 0x3b, 0xa8, 0x1f, 0xe2, 0x06, 0x47, 0xf2, 0x6e,
+	0xe0, 0xb0, 0xc3, 0xbc, 0xf8, 0xb0, 0xd7, 0xf0, 0x35, 0xa2, 0xb5, 0x2b, 0x7b, 0x75, 0xaf, 0x76,
+	0x13, 0x83, 0x8a, 0x1e, 0x1c, 0x1f, 0x77, 0xda, 0xcd, 0x83, 0xc6, 0xd1, 0x49, 0xbb, 0xb6, 0x0e,
+	0x43, 0xaa, 0x35, 0x0f, 0xdb, 0x0d, 0x0f, 0xf7, 0x5a, 0x57, 0xfd, 0xef, 0x8b, 0xb0, 0x4e, 0xcb,
+	0x7a, 0xa4, 0x1a, 0xfa, 0x27, 0x8b, 0xc0, 0x35, 0x3b, 0x27, 0x87, 0xb0, 0xe9, 0xbb, 0xb8, 0x70,
+	0x26, 0xe3, 0x7f, 0x2c, 0x8a, 0x51, 0xf4, 0xa7, 0x39, 0xc3, 0xec, 0x85, 0x5e, 0x46, 0xd1, 0x2f,
+	0xd5, 0x54, 0xac, 0x2f, 0xcc, 0x3c, 0xef, 0x03, 0x81, 0x96, 0x68, 0x9e, 0x4b, 0x88, 0xe6, 0x09,
+	0xdd, 0x4f, 0xd5, 0x96, 0x1d, 0x40, 0x70, 0x1f, 0xf2, 0x57, 0x6b, 0xe4, 0xb3, 0x07, 0x4a, 0x5c,
+	0xee, 0x18, 0xc8, 0xdf, 0x3c, 0x48, 0x7c, 0x21, 0xaf, 0x90, 0xfc, 0x42, 0x5e, 0x9a, 0x7c, 0xb8,
+	0x90, 0x26, 0x1f, 0x02, 0xe3, 0xc8, 0xa4, 0xa9, 0x3f, 0xea, 0x0f, 0xb5, 0xd6, 0x45, 0xbe, 0x37,
+	0x47, 0x24, 0x8a, 0xe1, 0x5a, 0x1c, 0xd5, 0x22, 0xab, 0x90, 0x90, 0x45, 0x91, 0x56, 0x23, 0x92,
+	0x2a, 0x53, 0x0e, 0x23, 0xa9, 0x9a, 0x1e, 0xba, 0x4f, 0xc2, 0x1e, 0xca, 0x56, 0x0f, 0x0c, 0xa7,
+	0x1e, 0xee, 0xe0, 0x67, 0x64, 0x66, 0xd3, 0x6e, 0x67, 0x3c, 0xe9, 0xc2, 0xd5, 0x84, 0x56, 0xa2,
+	0x2e, 0xe9, 0x80, 0x80, 0x8d, 0xa5, 0x8c, 0x23, 0x82, 0xef, 0x02, 0xd8, 0xfd, 0x15, 0xa5, 0xcc,
+	0xad, 0x4a, 0xdf, 0xed, 0x1b, 0x8d, 0xf5, 0xc3, 0xca, 0x8a, 0xc7, 0x09, 0xda, 0x47, 0xe0, 0xa7,
+	0x60, 0xe9, 0x9a, 0x3a, 0x3c, 0x50, 0x08, 0x80, 0x8d, 0xca, 0xe1, 0xd3, 0x06, 0x76, 0x48, 0x2b,
+	0xe9, 0x38, 0xde, 0x13, 0x0f, 0xa1, 0xee, 0xfb, 0x2a, 0x7b, 0x34, 0x99, 0xcb, 0x2a, 0x61, 0x1c,
+	0x69, 0xf9, 0x26, 0x6e, 0x96, 0x9c, 0xd0, 0x74, 0xf2, 0xce, 0x9f, 0x57, 0x65, 0xeb, 0x43, 0x4b,
+	0x80, 0x7a, 0xab, 0x9f, 0x36, 0xdb, 0x87, 0x8d, 0x56, 0xab, 0x73, 0x7c, 0xb2, 0x0d, 0x74, 0xa1,
+	0x73, 0xbf, 0xde, 0xba, 0x0f, 0x34, 0x13, 0x68, 0x09, 0x40, 0xdb, 0x70, 0xee, 0x6c, 0x78, 0x06,
+	0x58, 0x8a, 0xcd, 0x93, 0xc3, 0x13, 0x7c, 0x9f, 0x9b, 0x56, 0x2f, 0x8b, 0x87, 0x47, 0xf2, 0x53,
+	0xaa, 0xe7, 0xee, 0xfc, 0x2a, 0xf0, 0xc7, 0xd1, 0xcf, 0x47, 0x28, 0xb5, 0xb0, 0xdf, 0xb8, 0x57,
+	0xdf, 0xf9, 0x8c, 0xe3, 0xb4, 0xb7, 0xda, 0xf5, 0x76, 0x73, 0xa7, 0x23, 0x71, 0xd9, 0x91, 0x50,
+	0x65, 0xd0, 0x22, 0x53, 0x3f, 0xdc, 0xb9, 0x7f, 0xe4, 0xb5, 0xa0, 0x83, 0x97, 0xd4, 0xba, 0x3e,
+	0x42, 0x3b, 0x47, 0x07, 0x07, 0xcd, 0x36, 0xd1, 0xe8, 0xf6, 0x67, 0xc7, 0x78, 0x62, 0xee, 0x74,
+	0x55, 0x29, 0x0c, 0x29, 0x4f, 0x74, 0xaf, 0xd9, 0x6e, 0xd6, 0xdb, 0x21, 0xd1, 0x87, 0x5e, 0x80,
+	0xac, 0x86, 0x60, 0x8a, 0x0b, 0x0f, 0x7d, 0xd0, 0x7b, 0x62, 0x0d, 0xe4, 0xde, 0xa1, 0x33, 0x38,
+	0xeb, 0x21, 0x74, 0xfb, 0xa8, 0x8d, 0x53, 0xf8, 0x35, 0xb5, 0x14, 0x8d, 0xdc, 0x8e, 0xaf, 0x98,
+	0xb1, 0x7f, 0xab, 0x0b, 0x98, 0x14, 0x8f, 0x18, 0x5a, 0x26, 0xc2, 0x0e, 0x43, 0xc5, 0x47, 0xc9,
+	0x78, 0x1b, 0x40, 0xb3, 0x00, 0x02, 0x32, 0x71, 0xef, 0xc8, 0x80, 0x72, 0x58, 0x83, 0xa7, 0x53,
+	0xcb, 0xdf, 0xf9, 0x5c, 0xad, 0x24, 0x62, 0xbc, 0xe3, 0xa8, 0xa1, 0x0e, 0x94, 0xb1, 0xfb, 0x81,
+	0x95, 0xd9, 0xd9, 0xaf, 0x03, 0xd5, 0xd9, 0x65, 0xe3, 0xd4, 0xc9, 0xa1, 0x4e, 0x66, 0xa3, 0xd1,
+	0xe9, 0x73, 0x48, 0xa2, 0xf6, 0x9a, 0x5e, 0xab, 0xdd, 0x81, 0x15, 0xbe, 0xd7, 0x80, 0xbb, 0x08,
+	0xea, 0x6a, 0x7a, 0x55, 0xb8, 0xf3, 0x91, 0x5a, 0x8a, 0x7a, 0x4f, 0x47, 0xcd, 0x60, 0x40, 0x2e,
+	0xb7, 0x1b, 0xed, 0x4f, 0x1b, 0x8d, 0x43, 0xda, 0xf2, 0x1d, 0x58, 0x72, 0x0f, 0xee, 0x93, 0x36,
+	0xec, 0xce, 0x9d, 0x8f, 0x61, 0xe5, 0x62, 0xae, 0x0a, 0x11, 0xdf, 0x8e, 0x67, 0x39, 0x81, 0xdc,
+	0xf9, 0x9d, 0xac, 0xba, 0x91, 0x66, 0xa5, 0x43, 0xc4, 0x14, 0x42, 0x88, 0xd7, 0x61, 0x0b, 0x2e,
+	0xad, 0xc3, 0x23, 0x0a, 0xd7, 0x0c, 0x43, 0x89, 0x65, 0xe8, 0x59, 0x64, 0xe0, 0xc4, 0xac, 0x27,
+	0x2a, 0x75, 0x3c, 0xc8, 0xc3, 0xbd, 0x84, 0xeb, 0x2e, 0x96, 0xd9, 0xf0, 0x3c, 0xd8, 0xa1, 0x1c,
+	0x08, 0x44, 0x6f, 0xc4, 0x72, 0x92, 0x4c, 0x80, 0xe6, 0x11, 0xf2, 0xce, 0xeb, 0xea, 0xab, 0x89,
+	0xd2, 0xe1, 0x3d, 0xd9, 0xd9, 0xae, 0xef, 0xe3, 0xf4, 0xe0, 0x46, 0xff, 0xaa, 0x7a, 0x25, 0x56,
+	0x10, 0x2f, 0xd5, 0x9d, 0xfa, 0x31, 0xdc, 0x9b, 0x62, 0xbd, 0x5a, 0x00, 0xfe, 0xfe, 0xa5, 0x58,
+	0xa1, 0x7a, 0x1b, 0x6f, 0xad, 0x76, 0x67, 0xbf, 0x89, 0xd8, 0xb0, 0x78, 0xe7, 0x1f, 0xe5, 0x94,
+	0x0a, 0x5f, 0x39, 0xe2, 0x34, 0x76, 0xeb, 0xed, 0xfa, 0xfe, 0x11, 0x1e, 0x3d, 0x0f, 0xd0, 0x14,
+	0x06, 0x09, 0x77, 0x24, 0xac, 0x4c, 0x5a, 0xce, 0xd1, 0x31, 0xae, 0x0b, 0x2c, 0x26, 0xa3, 0xf1,
+	0x3e, 0xae, 0x06, 0x62, 0x1d, 0x05, 0x10, 0x27, 0x86, 0xe5, 0xe4, 0x78, 0xcf, 0x3b, 0x82, 0x71,
+	0xb7, 0xee, 0x9f, 0xb4, 0x77, 0x29, 0xfc, 0xf8, 0x8e, 0xd7, 0x3c, 0xe6, 0x36, 0xf3, 0xcf, 0x2a,
+	0x80, 0x4d, 0x17, 0x90, 0x4e, 0xdc, 0x83, 0x0e, 0x9b, 0xc7, 0x9d, 0x1f, 0x9e, 0x34, 0xbc, 0x66,
+	0xa3, 0x45, 0x15, 0x17, 0x52, 0xe0, 0x58, 0x7e, 0x11, 0x51, 0xbf, 0xbd, 0xff, 0x40, 0xf8, 0x10,
+	0x2c, 0x5a, 0x8c, 0x82, 0xb0, 0x54, 0x09, 0x37, 0x19, 0x2f, 0xf2, 0x94, 0x96, 0xd5, 0x9c, 0x3c,
+	0xac, 0x57, 0x46, 0x16, 0x25, 0x41, 0x40, 0xa8, 0x5a, 0x25, 0x3d, 0x0b, 0x6b, 0x11, 0xf7, 0x62,
+	0x78, 0xbd, 0xdd, 0x5d, 0x8f, 0x2a, 0x2c, 0x25, 0xa0, 0x58, 0x76, 0x19, 0x71, 0x19, 0x6f, 0x7a,
+	0x2c, 0x52, 0xd3, 0x09, 0xcc, 0x59, 0xb9, 0xfb, 0xaf, 0xbf, 0xa2, 0x4a, 0xe6, 0xb5, 0x83, 0xf3,
+	0x03, 0x55, 0x8d, 0xc4, 0x12, 0x70, 0xb4, 0x25, 0x20, 0x2d, 0xf4, 0xc0, 0xe6, 0x4b, 0xe9, 0x99,
+	0x22, 0xe3, 0x1c, 0x58, 0x4a, 0x05, 0x6e, 0xec, 0xa5, 0xb8, 0xa0, 0x1f, 0x69, 0xed, 0xf6, 0x9c,
+	0x5c, 0x69, 0xee, 0x13, 0x8a, 0x65, 0x6e, 0x7f, 0xa7, 0xdd, 0xb9, 0x1d, 0x06, 0x96, 0x4e, 0xf9,
+	0x7e, 0xfb, 0xe6, 0xad, 0xe4, 0x17, 0xd5, 0xf5, 0x27, 0xd8, 0x77, 0x55, 0xd9, 0xfa, 0xc2, 0xa6,
+	0x73, 0x6b, 0xee, 0xd7, 0x40, 0x37, 0x37, 0xd3, 0xb2, 0x64, 0x48, 0xdf, 0x55, 0x25, 0xf3, 0x65,
+	0x43, 0x67, 0xdd, 0xfa, 0x52, 0xa6, 0xfd, 0xa5, 0xc7, 0xcd, 0x8d, 0x64, 0x86, 0xd4, 0x87, 0x51,
+	0x58, 0x1f, 0x28, 0x34, 0xa3, 0x48, 0x7e, 0x04, 0xd1, 0x8c, 0x22, 0xed, 0x7b, 0x86, 0xfb, 0x80,
+	0x22, 0xac, 0xba, 0x38, 0xf5, 0xbf, 0xc8, 0xf2, 0xa4, 0x7c, 0x70, 0xfe, 0xad, 0x0c, 0x88, 0x64,
+	0x45, 0xfd, 0x51, 0x4b, 0xe7, 0x66, 0xfa, 0xc7, 0x3f, 0x37, 0xd7, 0x13, 0x70, 0x19, 0x4a, 0x5d,
+	0xa9, 0xf0, 0xd3, 0x87, 0x8e, 0x9e, 0x78, 0xe2, 0x53, 0x8a, 0x66, 0x67, 0x52, 0xbe, 0x93, 0x08,
+	0x6b, 0x62, 0x7d, 0xe5, 0xd0, 0xac, 0x49, 0xf2, 0x0b, 0x89, 0x66, 0x4d, 0xd2, 0x3e, 0x8a, 0x08,
+	0x78, 0x1c, 0xf9, 0x5c, 0xa1, 0xc1, 0xe3, 0xb4, 0x8f, 0x21, 0x1a, 0x3c, 0x4e, 0xff, 0xc2, 0xe1,
+	0x2e, 0xc6, 0x4f, 0x32, 0x9f, 0xfd, 0x33, 0x23, 0x4a, 0x7e, 0xc7, 0xd0, 0x8c, 0x28, 0xe5, 0x8b,
+	0x83, 0x78, 0x1a, 0xa2, 0xdf, 0x0f, 0x34, 0xa7, 0x21, 0xf5, 0x43, 0x84, 0xe6, 0x34, 0xa4, 0x7f,
+	0x74, 0x10, 0x51, 0xcf, 0x7c, 0xc4, 0xc0, 0x59, 0x8f, 0x68, 0x0c, 0xc2, 0xaf, 0x21, 0x18, 0xd4,
+	0x4b, 0x7e, 0xef, 0xe0, 0x9e, 0x5a, 0x35, 0x48, 0x63, 0x3e, 0x41, 0x10, 0x98, 0x31, 0xa5, 0x7e,
+	0xe8, 0x60, 0xb3, 0x16, 0xcf, 0x05, 0x7c, 0xf9, 0x50, 0x2d, 0x4a, 0x5c, 0x77, 0x67, 0x2d, 0x1e,
+	0xe7, 0x9d, 0x07, 0x71, 0x33, 0x3d, 0xfc, 0xbb, 0x73, 0x4c, 0x07, 0xda, 0x0e, 0xbc, 0x6e, 0x63,
+	0x6c, 0x4a, 0xac, 0xf6, 0xcd, 0x97, 0xe7, 0x65, 0x87, 0x2d, 0xc6, 0x3f, 0x16, 0x70, 0x7b, 0x5e,
+	0x8c, 0x9f, 0x68, 0x8b, 0xf3, 0x82, 0x11, 0xde, 0x53, 0x15, 0xfb, 0xdb, 0x51, 0x8e, 0x7d, 0x0e,
+	0xe3, 0x6d, 0xbd, 0x98, 0x9a, 0x27, 0x0d, 0x3d, 0x50, 0x37, 0xcd, 0x7a, 0xdb, 0x01, 0x67, 0x02,
+	0xe7, 0x95, 0x94, 0x30, 0x34, 0x91, 0x55, 0xbf, 0x35, 0x37, 0x4e, 0x0d, 0x2c, 0x3f, 0x12, 0xd9,
+	0xc8, 0xe7, 0x5e, 0x42, 0x22, 0x9b, 0xf6, 0x95, 0x9b, 0x90, 0xc8, 0xa6, 0x7f, 0x23, 0xa6, 0x0e,
+	0xec, 0x5a, 0x18, 0x30, 0x07, 0x3f, 0xf3, 0x61, 0xf0, 0x3d, 0x19, 0x11, 0x7b, 0x33, 0x4d, 0x81,
+	0xee, 0xec, 0xa8, 0xb2, 0x1d, 0x73, 0xe7, 0x19, 0xd5, 0xd7, 0xad, 0x2c, 0x3b, 0xa0, 0x31, 0x4c,
+	0x6b, 0x1f, 0x44, 0xf9, 0x58, 0x84, 0x4c, 0x73, 0x84, 0xd3, 0xa2, 0x8a, 0x6e, 0xc6, 0x32, 0x23,
+	0x71, 0x35, 0x11, 0x2f, 0x22, 0xdf, 0x35, 0x1f, 0x4f, 0xe3, 0x57, 0x51, 0xf4, 0x7b, 0xe7, 0xa6,
+	0xb5, 0xb4, 0x4f, 0xdd, 0xbf, 0x91, 0x81, 0xf1, 0xed, 0xa9, 0x4a, 0x24, 0x40, 0x5c, 0xe4, 0xe1,
+	0x4d, 0x6c, 0x9a, 0x1b, 0x76, 0x5e, 0x6c, 0x9e, 0xb0, 0x7d, 0x51, 0x4f, 0x0f, 0x33, 0xb0, 0x54,
+	0x77, 0x14, 0xb3, 0x7d, 0xe9, 0xee, 0x21, 0xce, 0xf7, 0x80, 0x78, 0x02, 0x26, 0x6b, 0xbf, 0x42,
+	0xc7, 0xa2, 0xd3, 0xf1, 0x3d, 0x63, 0x98, 0x68, 0xb4, 0x73, 0x7f, 0x29, 0x9b, 0xa1, 0x79, 0x7d,
+	0x87, 0xbf, 0x0b, 0xad, 0x5d, 0xcb, 0x70, 0xff, 0xaf, 0xdb, 0x08, 0xac, 0x09, 0x75, 0xde, 0x1e,
+	0xf3, 0x7b, 0xfa, 0x5b, 0x56, 0x19, 0x81, 0x5d, 0x6f, 0x0c, 0x75, 0x1e, 0x83, 0xd4, 0x89, 0xe0,
+	0xe0, 0x35, 0xdb, 0x72, 0x3e, 0x50, 0x2a, 0xf4, 0xd7, 0x75, 0x62, 0x5e, 0xa3, 0xe6, 0x40, 0xa5,
+	0xb8, 0xf4, 0x36, 0xf8, 0xbc, 0x1b, 0xb7, 0x55, 0xfb, 0x4a, 0x8e, 0x7a, 0xd0, 0x46, 0xae, 0xe4,
+	0x78, 0x33, 0xef, 0xa8, 0xea, 0xfe, 0x78, 0xfc, 0xf0, 0x6a, 0x62, 0x1e, 0x7d, 0x44, 0xbd, 0xa1,
+	0x50, 0xa3, 0xb1, 0x19, 0x1b, 0x16, 0xcc, 0x7b, 0xc5, 0x90, 0x88, 0xd0, 0x6f, 0x36, 0x5a, 0x28,
+	0x42, 0x18, 0x62, 0x0d, 0xc0, 0xd2, 0xdd, 0x55, 0x95, 0x5d, 0xbf, 0x47, 0x31, 0x3f, 0xc8, 0xf7,
+	0x66, 0x35, 0xe2, 0xc7, 0xc1, 0x4e, 0x3b, 0x9b, 0xd5, 0x08, 0x50, 0x93, 0xb8, 0xd0, 0xff, 0xcb,
+	0xbe, 0x33, 0xa2, 0x4e, 0x54, 0x11, 0x12, 0x97, 0xf0, 0x01, 0x7b, 0x80, 0xbe, 0x49, 0x31, 0x0f,
+	0x2b, 0x43, 0xdd, 0xe6, 0xf9, 0x65, 0x6d, 0xbe, 0x3a, 0xbf, 0x80, 0xb4, 0xfb, 0x7d, 0x55, 0xe5,
+	0xf8, 0xd6, 0xa7, 0x3e, 0xbf, 0xd9, 0x8d, 0x45, 0x2f, 0xb3, 0x1f, 0x04, 0xc7, 0x49, 0x12, 0x57,
+	0xb8, 0x47, 0x5f, 0xc6, 0xb1, 0x5e, 0xc4, 0x9a, 0x7d, 0x4d, 0xbe, 0xd2, 0x35, 0xfb, 0x9a, 0xf6,
+	0xf8, 0xf6, 0x23, 0x55, 0x86, 0x86, 0xf4, 0x1b, 0x53, 0xc3, 0x1f, 0xc5, 0x1e, 0x9d, 0x6e, 0xa6,
+	0xbc, 0x0c, 0x76, 0xde, 0xa7, 0xaa, 0x26, 0x5e, 0xc2, 0x4d, 0xab, 0x17, 0xbb, 0xea, 0x72, 0x0c,
+	0x8e, 0xdc, 0x87, 0x15, 0x35, 0xc5, 0x0c, 0x3c, 0x19, 0x25, 0xc7, 0x0c, 0x3c, 0x2d, 0xc8, 0xca,
+	0xf7, 0x78, 0x05, 0xac, 0x57, 0xad, 0x21, 0x0b, 0x16, 0x7f, 0x00, 0x6b, 0x86, 0x6f, 0x17, 0x7f,
+	0x4f, 0x29, 0x7c, 0x2d, 0xb9, 0xdb, 0xf5, 0x87, 0x20, 0xe6, 0x1a, 0x9a, 0x10, 0xbe, 0xa7, 0x0c,
+	0x0f, 0xa2, 0xf5, 0xa8, 0xd2, 0xf9, 0xd4, 0xe2, 0x4d, 0x23, 0x5b, 0xa2, 0xb7, 0x7d, 0xee, 0x93,
+	0x4b, 0x33, 0x9d, 0x94, 0x67, 0x97, 0x44, 0x24, 0x54, 0xe8, 0xc0, 0x66, 0x38, 0xcd, 0x84, 0x6f,
+	0x9c, 0x39, 0xeb, 0x29, 0xde, 0x6e, 0xc0, 0x42, 0x85, 0x9e, 0x3f, 0xeb, 0x61, 0x08, 0xa7, 0x88,
+	0x9f, 0x90, 0xa1, 0xde, 0x49, 0xaf, 0x9b, 0x43, 0xb5, 0xca, 0xc3, 0x31, 0xd7, 0x1f, 0xbd, 0xfa,
+	0x33, 0x1f, 0x76, 0x4a, 0xba, 0xbb, 0x98, 0xf3, 0x93, 0xe6, 0xb4, 0x81, 0xe7, 0x27, 0x61, 0xfc,
+	0x37, 0xe7, 0x67, 0x9e, 0x37, 0x87, 0x39, 0x3f, 0xf3, 0xfd, 0x06, 0x60, 0x9c, 0x29, 0x66, 0x7c,
+	0xe7, 0x2b, 0x5a, 0xb0, 0x99, 0x6b, 0xe2, 0xdf, 0x4c, 0x35, 0xf7, 0x3a, 0x6d, 0xb5, 0xce, 0x75,
+	0xe0, 0xb0, 0xc6, 0xac, 0xc6, 0x2f, 0x5b, 0x15, 0x52, 0x2c, 0xe1, 0x11, 0x56, 0x26, 0x66, 0x0d,
+	0x3f, 0x54, 0xb5, 0xb8, 0xc1, 0xd5, 0x99, 0x5f, 0x7c, 0xf3, 0x95, 0x08, 0xcb, 0x9e, 0x34, 0xd2,
+	0xc2, 0x6a, 0xae, 0x59, 0x66, 0x68, 0x6b, 0x8c, 0xaf, 0x84, 0xdf, 0x23, 0x4c, 0x35, 0x52, 0x1b,
+	0x69, 0x20, 0xd5, 0x6a, 0xec, 0xfc, 0x48, 0xad, 0xc7, 0x31, 0x5a, 0xb7, 0xfc, 0x6a, 0xda, 0x72,
+	0xcd, 0x65, 0xe5, 0xa2, 0x13, 0x02, 0x94, 0x06, 0x42, 0x6c, 0x1b, 0x67, 0x0d, 0x22, 0xa5, 0x58,
+	0x89, 0x0d, 0x22, 0xa5, 0x5a, 0x73, 0x81, 0xdd, 0x89, 0xd9, 0x65, 0x0d, 0x1b, 0x9c, 0x6e, 0xc9,
+	0x35, 0x6c, 0xf0, 0x3c, 0x73, 0x6e, 0x4b, 0xd5, 0xe2, 0x16, 0x57, 0xb3, 0xd7, 0x73, 0xac, 0xb8,
+	0x9b, 0xaf, 0xcc, 0xcd, 0x8f, 0x0e, 0xd3, 0xb2, 0x4d, 0x46, 0x86, 0x99, 0xb4, 0xa8, 0x46, 0x86,
+	0x99, 0x62, 0x19, 0xdd, 0x7e, 0xfd, 0xc7, 0x5f, 0xbb, 0xe8, 0xcf, 0x2e, 0xaf, 0x4e, 0xb7, 0x7a,
+	0xe3, 0xe1, 0x9b, 0x03, 0xad, 0xd5, 0x90, 0x47, 0xf0, 0x6f, 0x0e, 0x46, 0x67, 0x6f, 0x52, 0x03,
+	0xa7, 0x0b, 0x93, 0xe9, 0x78, 0x36, 0x7e, 0xe7, 0xff, 0x01, 0x28, 0x79, 0x7d, 0xe1, 0x40, 0x90,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -0,0 +1,186 @@
+package routing
+
+import (
+	"math"
+	"time"
+
+	"github.com/pkt-cash/pktd/btcutil"
+	"github.com/pkt-cash/pktd/lnd/lnwire"
+	"github.com/pkt-cash/pktd/lnd/routing/route"
+)
+
+// bimodalEstimator estimates the success probability of a channel from the
+// distribution of its liquidity. Channels tend to be depleted towards one of
+// their sides, so the liquidity is modeled with a bimodal distribution that
+// peaks at zero and at the capacity of the channel. Payment results bound the
+// liquidity from below (successes) and from above (failures), and the bounds
+// relax back to the full capacity as the results age.
+type bimodalEstimator struct {
+	// scale defines how far the liquidity spreads from the edges into the
+	// channel. Small values mean that channels are assumed to be depleted
+	// to either side most of the time.
+	scale lnwire.MilliSatoshi
+
+	// nodeWeight is a value in the range [0, 1] that defines to what
+	// extent the results of the other channels of a node are taken into
+	// account for a channel.
+	nodeWeight float64
+
+	// decayTime is the time after which the bounds that a payment result
+	// put on the liquidity have relaxed by a factor of e.
+	decayTime time.Duration
+
+	// fallback is the estimator that is used for channels of which the
+	// capacity is unknown, as well as for our own local channels.
+	fallback *probabilityEstimator
+}
+
+// getPairProbability estimates the probability of successfully traversing to
+// toNode, combining the liquidity bounds of the pair itself with those of the
+// other channels of the node.
+func (p *bimodalEstimator) getPairProbability(now time.Time,
+	results NodeResults, toNode route.Vertex, amt lnwire.MilliSatoshi,
+	capacity btcutil.Amount) float64 {
+
+	// Without the capacity, there is no liquidity distribution to
+	// estimate the probability from.
+	if capacity == 0 {
+		return p.fallback.getPairProbability(
+			now, results, toNode, amt, capacity,
+		)
+	}
+
+	capMsat := float64(lnwire.NewMSatFromSatoshis(capacity))
+
+	success, fail := 0.0, capMsat
+	if result, ok := results[toNode]; ok {
+		success, fail = p.liquidityBounds(now, result, capMsat)
+	}
+	directProbability := p.probability(float64(amt), success, fail, capMsat)
+
+	if p.nodeWeight == 0 {
+		return directProbability
+	}
+
+	// Estimate how the node did on its other channels, weighing the
+	// results by their age. The capacities of those channels aren't known
+	// here, so they are assumed to be the same as the one of this channel.
+	var probabilitiesTotal, totalWeight float64
+	for peer, result := range results {
+		if peer == toNode {
+			continue
+		}
+
+		lastResult := result.FailTime
+		if result.SuccessTime.After(lastResult) {
+			lastResult = result.SuccessTime
+		}
+		weight := p.decay(now, lastResult)
+
+		success, fail := p.liquidityBounds(now, result, capMsat)
+		probabilitiesTotal += weight * p.probability(
+			float64(amt), success, fail, capMsat,
+		)
+		totalWeight += weight
+	}
+
+	if totalWeight == 0 {
+		return directProbability
+	}
+	nodeProbability := probabilitiesTotal / totalWeight
+
+	return (1-p.nodeWeight)*directProbability +
+		p.nodeWeight*nodeProbability
+}
+
+// getLocalPairProbability estimates the probability of successfully traversing
+// our own local channels to toNode. The balances of local channels are known,
+// so they are left to the fallback estimator.
+func (p *bimodalEstimator) getLocalPairProbability(now time.Time,
+	results NodeResults, toNode route.Vertex) float64 {
+
+	return p.fallback.getLocalPairProbability(now, results, toNode)
+}
+
+// liquidityBounds returns the range that the liquidity of a channel is known
+// to be in, given its last payment result. The success amount is a lower bound
+// that decays towards zero and the failure amount is an upper bound that
+// decays towards the capacity.
+func (p *bimodalEstimator) liquidityBounds(now time.Time,
+	result TimedPairResult, capacity float64) (float64, float64) {
+
+	success := 0.0
+	if !result.SuccessTime.IsZero() {
+		success = math.Min(float64(result.SuccessAmt), capacity) *
+			p.decay(now, result.SuccessTime)
+	}
+
+	fail := capacity
+	if !result.FailTime.IsZero() && float64(result.FailAmt) < capacity {
+		fail = capacity - (capacity-float64(result.FailAmt))*
+			p.decay(now, result.FailTime)
+	}
+
+	// The liquidity may have moved between a success and a failure so
+	// that they contradict each other. In that case only the newer result
+	// is kept.
+	if success >= fail {
+		if result.SuccessTime.After(result.FailTime) {
+			fail = capacity
+		} else {
+			success = 0
+		}
+	}
+
+	return success, fail
+}
+
+// decay returns the factor in the range [0, 1] by which a bound that was set at
+// the given time has relaxed.
+func (p *bimodalEstimator) decay(now, t time.Time) float64 {
+	age := now.Sub(t)
+	if age < 0 {
+		age = 0
+	}
+
+	return math.Exp(-float64(age) / float64(p.decayTime))
+}
+
+// probability returns the probability that a channel of the given capacity,
+// whose liquidity is known to be in the range [success, fail), can forward
+// amt.
+func (p *bimodalEstimator) probability(amt, success, fail,
+	capacity float64) float64 {
+
+	switch {
+	case amt <= success:
+		return 1
+
+	case amt >= fail:
+		return 0
+	}
+
+	// The probability is the share of the liquidity distribution within
+	// the bounds that lies at or above the amount.
+	norm := p.primitive(fail, capacity) - p.primitive(success, capacity)
+	if norm <= 0 || math.IsNaN(norm) {
+		// The distribution vanishes numerically if the bounds are far
+		// from the edges compared to the scale. It is about flat in
+		// that case.
+		return (fail - amt) / (fail - success)
+	}
+
+	probability := (p.primitive(fail, capacity) -
+		p.primitive(amt, capacity)) / norm
+
+	return math.Max(0, math.Min(1, probability))
+}
+
+// primitive returns the value at x of the primitive function of the
+// unnormalized liquidity distribution exp(-x/s) + exp((x-c)/s), leaving out the
+// factor s which cancels out in probabilities.
+func (p *bimodalEstimator) primitive(x, capacity float64) float64 {
+	s := float64(p.scale)
+
+	return -math.Exp(-x/s) + math.Exp((x-capacity)/s)
+}
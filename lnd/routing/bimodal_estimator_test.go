@@ -0,0 +1,165 @@
+package routing
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/pkt-cash/pktd/btcutil"
+	"github.com/pkt-cash/pktd/lnd/lnwire"
+	"github.com/pkt-cash/pktd/lnd/routing/route"
+)
+
+const (
+	// Define test bimodal estimator parameters.
+	bimodalTestCapacity  = btcutil.Amount(1000000)
+	bimodalTestScale     = lnwire.MilliSatoshi(300000000)
+	bimodalTestDecayTime = 24 * time.Hour
+)
+
+// TestBimodalEstimator tests the probability estimation of the bimodal
+// estimator.
+func TestBimodalEstimator(t *testing.T) {
+	decayed := testTime.Add(-bimodalTestDecayTime)
+
+	testCases := []struct {
+		name       string
+		results    map[int]TimedPairResult
+		nodeWeight float64
+		amt        lnwire.MilliSatoshi
+		capacity   btcutil.Amount
+
+		expectedProbability float64
+	}{
+		{
+			name:                "untried small amount",
+			amt:                 100000000,
+			capacity:            bimodalTestCapacity,
+			expectedProbability: 0.85,
+		},
+		{
+			name:                "untried half capacity",
+			amt:                 500000000,
+			capacity:            bimodalTestCapacity,
+			expectedProbability: 0.5,
+		},
+		{
+			name:                "exceeds capacity",
+			amt:                 1000000001,
+			capacity:            bimodalTestCapacity,
+			expectedProbability: 0,
+		},
+		{
+			name: "below success amount",
+			results: map[int]TimedPairResult{
+				node1: {
+					SuccessTime: testTime,
+					SuccessAmt:  600000000,
+				},
+			},
+			amt:                 600000000,
+			capacity:            bimodalTestCapacity,
+			expectedProbability: 1,
+		},
+		{
+			name: "above success amount",
+			results: map[int]TimedPairResult{
+				node1: {
+					SuccessTime: testTime,
+					SuccessAmt:  600000000,
+				},
+			},
+			amt:                 800000000,
+			capacity:            bimodalTestCapacity,
+			expectedProbability: 0.62,
+		},
+		{
+			name: "below failure amount",
+			results: map[int]TimedPairResult{
+				node1: {
+					FailTime: testTime,
+					FailAmt:  400000000,
+				},
+			},
+			amt:                 200000000,
+			capacity:            bimodalTestCapacity,
+			expectedProbability: 0.38,
+		},
+		{
+			name: "at failure amount",
+			results: map[int]TimedPairResult{
+				node1: {
+					FailTime: testTime,
+					FailAmt:  400000000,
+				},
+			},
+			amt:                 400000000,
+			capacity:            bimodalTestCapacity,
+			expectedProbability: 0,
+		},
+		{
+			// The failure bound relaxed to about 780000 sats.
+			name: "decayed failure",
+			results: map[int]TimedPairResult{
+				node1: {
+					FailTime: decayed,
+					FailAmt:  400000000,
+				},
+			},
+			amt:                 400000000,
+			capacity:            bimodalTestCapacity,
+			expectedProbability: 0.39,
+		},
+		{
+			// The node just failed to forward on another channel.
+			name: "node weight",
+			results: map[int]TimedPairResult{
+				node2: {
+					FailTime: testTime,
+				},
+			},
+			nodeWeight:          0.2,
+			amt:                 500000000,
+			capacity:            bimodalTestCapacity,
+			expectedProbability: 0.4,
+		},
+		{
+			// Without the capacity, the a priori estimator is used.
+			name:                "unknown capacity",
+			amt:                 500000000,
+			expectedProbability: aprioriHopProb,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			estimator := &bimodalEstimator{
+				scale:      bimodalTestScale,
+				nodeWeight: testCase.nodeWeight,
+				decayTime:  bimodalTestDecayTime,
+				fallback: &probabilityEstimator{
+					aprioriHopProbability:  aprioriHopProb,
+					aprioriWeight:          aprioriWeight,
+					penaltyHalfLife:        time.Hour,
+					prevSuccessProbability: aprioriPrevSucProb,
+				},
+			}
+
+			results := make(NodeResults)
+			for i, r := range testCase.results {
+				results[route.Vertex{byte(i)}] = r
+			}
+
+			p := estimator.getPairProbability(
+				testTime, results, route.Vertex{node1},
+				testCase.amt, testCase.capacity,
+			)
+			if math.Abs(p-testCase.expectedProbability) > 0.01 {
+				t.Fatalf("expected probability %v, got %v",
+					testCase.expectedProbability, p)
+			}
+		})
+	}
+}
@@ -53,11 +53,30 @@ type ControlTower interface {
 	// FetchInFlightPayments returns all payments with status InFlight.
 	FetchInFlightPayments() ([]*channeldb.InFlightPayment, er.R)
 
+	// DeletePayment removes the payment with the given hash, so that a new
+	// payment to the hash can be made. In-flight payments are only
+	// removed if force is set, which is meant strictly for recovering
+	// payments that are stuck in an inconsistent state.
+	DeletePayment(paymentHash lntypes.Hash, force bool) er.R
+
+	// QueryPayments returns the payments matching the query, in the order
+	// of their sequence numbers.
+	QueryPayments(query channeldb.PaymentsQuery) (channeldb.PaymentsResponse,
+		er.R)
+
 	// SubscribePayment subscribes to updates for the payment with the given
 	// hash. A first update with the current state of the payment is always
 	// sent out immediately.
 	SubscribePayment(paymentHash lntypes.Hash) (*ControlTowerSubscriber,
 		er.R)
+
+	// SubscribePaymentFrom is like SubscribePayment, but instead of the
+	// current state it first sends the state of the payment after each
+	// of its recorded transitions from the one with sequence number
+	// fromSeq on. If there is no such transition, the current state is
+	// sent as by SubscribePayment.
+	SubscribePaymentFrom(paymentHash lntypes.Hash, fromSeq uint64) (
+		*ControlTowerSubscriber, er.R)
 }
 
 // ControlTowerSubscriber contains the state for a payment update subscriber.
@@ -212,25 +231,84 @@ func (p *controlTower) FetchInFlightPayments() ([]*channeldb.InFlightPayment, er
 	return p.db.FetchInFlightPayments()
 }
 
+// DeletePayment removes the payment with the given hash, so that a new payment
+// to the hash can be made. In-flight payments are only removed if force is
+// set. Subscribers of a removed payment won't receive any more updates.
+func (p *controlTower) DeletePayment(paymentHash lntypes.Hash,
+	force bool) er.R {
+
+	p.paymentsMtx.Lock(paymentHash)
+	defer p.paymentsMtx.Unlock(paymentHash)
+
+	if err := p.db.DeletePayment(paymentHash, force); err != nil {
+		return err
+	}
+
+	// Close the update channels of the subscribers, there won't be a
+	// final update for the payment.
+	p.subscribersMtx.Lock()
+	list := p.subscribers[paymentHash]
+	delete(p.subscribers, paymentHash)
+	p.subscribersMtx.Unlock()
+
+	for _, subscriber := range list {
+		close(subscriber.queue.ChanIn())
+	}
+
+	return nil
+}
+
+// QueryPayments returns the payments matching the query, in the order of their
+// sequence numbers.
+func (p *controlTower) QueryPayments(query channeldb.PaymentsQuery) (
+	channeldb.PaymentsResponse, er.R) {
+
+	return p.db.QueryPayments(query)
+}
+
 // SubscribePayment subscribes to updates for the payment with the given hash. A
 // first update with the current state of the payment is always sent out
 // immediately.
 func (p *controlTower) SubscribePayment(paymentHash lntypes.Hash) (
 	*ControlTowerSubscriber, er.R) {
+
+	return p.SubscribePaymentFrom(paymentHash, 0)
+}
+
+// SubscribePaymentFrom subscribes to updates for the payment with the given
+// hash, replaying the states of the payment after each of its transitions
+// from the one with sequence number fromSeq on. With fromSeq zero, or if there
+// is nothing to replay, the current state of the payment is sent instead.
+func (p *controlTower) SubscribePaymentFrom(paymentHash lntypes.Hash,
+	fromSeq uint64) (*ControlTowerSubscriber, er.R) {
+
 	// Take lock before querying the db to prevent missing or duplicating an
 	// update.
 	p.paymentsMtx.Lock(paymentHash)
 	defer p.paymentsMtx.Unlock(paymentHash)
 
-	payment, err := p.db.FetchPayment(paymentHash)
+	payment, history, err := p.db.FetchPaymentTransitions(paymentHash)
 	if err != nil {
 		return nil, err
 	}
 
 	subscriber := newControlTowerSubscriber()
 
-	// Always write current payment state to the channel.
-	subscriber.queue.ChanIn() <- payment
+	// Replay the transitions the subscriber asked for. The state after
+	// the last of them is the current state.
+	replayed := false
+	for i, transition := range history {
+		if fromSeq == 0 || transition.SeqNum < fromSeq {
+			continue
+		}
+		subscriber.queue.ChanIn() <- payment.StateAt(history[:i+1])
+		replayed = true
+	}
+
+	// Otherwise write the current payment state to the channel.
+	if !replayed {
+		subscriber.queue.ChanIn() <- payment
+	}
 
 	// Payment is currently in flight. Register this subscriber for further
 	// updates. Otherwise this update is the final update and the incoming
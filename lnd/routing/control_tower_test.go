@@ -170,6 +170,205 @@ func TestControlTowerSubscribeSuccess(t *testing.T) {
 	}
 }
 
+// TestControlTowerDeletePayment tests that an in-flight payment is only deleted
+// when forced, and that its subscribers are released.
+func TestControlTowerDeletePayment(t *testing.T) {
+	t.Parallel()
+
+	db, err := initDB()
+	if err != nil {
+		t.Fatalf("unable to init db: %v", err)
+	}
+
+	pControl := NewControlTower(channeldb.NewPaymentControl(db))
+
+	// Initiate a payment.
+	info, _, _, err := genInfo()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = pControl.InitPayment(info.PaymentHash, info)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	subscriber, err := pControl.SubscribePayment(info.PaymentHash)
+	if err != nil {
+		t.Fatalf("expected subscribe to succeed, but got: %v", err)
+	}
+
+	// The in-flight payment must not be deleted without force.
+	err = pControl.DeletePayment(info.PaymentHash, false)
+	if !channeldb.ErrPaymentInFlight.Is(err) {
+		t.Fatalf("expected ErrPaymentInFlight, got: %v", err)
+	}
+
+	err = pControl.DeletePayment(info.PaymentHash, true)
+	if err != nil {
+		t.Fatalf("unable to delete payment: %v", err)
+	}
+
+	// The subscriber receives the initial state followed by the channel
+	// being closed.
+	select {
+	case item := <-subscriber.Updates:
+		result := item.(*channeldb.MPPayment)
+		if result.Status != channeldb.StatusInFlight {
+			t.Fatal("unexpected payment state")
+		}
+	case <-time.After(testTimeout):
+		t.Fatal("timeout waiting for payment update")
+	}
+	select {
+	case _, ok := <-subscriber.Updates:
+		if ok {
+			t.Fatal("expected channel to be closed")
+		}
+	case <-time.After(testTimeout):
+		t.Fatal("timeout waiting for result channel close")
+	}
+
+	// The payment is gone, so a new payment to the hash can be made.
+	_, err = pControl.FetchPayment(info.PaymentHash)
+	if !channeldb.ErrPaymentNotInitiated.Is(err) {
+		t.Fatalf("expected ErrPaymentNotInitiated, got: %v", err)
+	}
+	err = pControl.InitPayment(info.PaymentHash, info)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestControlTowerSubscribeFrom tests that a subscriber that reconnects after
+// a restart gets the states of the payment after each transition it missed
+// replayed, and the current state if it didn't miss any.
+func TestControlTowerSubscribeFrom(t *testing.T) {
+	t.Parallel()
+
+	db, err := initDB()
+	if err != nil {
+		t.Fatalf("unable to init db: %v", err)
+	}
+
+	pControl := NewControlTower(channeldb.NewPaymentControl(db))
+
+	info, attempt, preimg, err := genInfo()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Fail a first attempt and settle a second one, recording the
+	// transitions 1 to 5.
+	err = pControl.InitPayment(info.PaymentHash, info)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = pControl.RegisterAttempt(info.PaymentHash, attempt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = pControl.FailAttempt(
+		info.PaymentHash, attempt.AttemptID,
+		&channeldb.HTLCFailInfo{Reason: channeldb.HTLCFailInternal},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	attempt2 := *attempt
+	attempt2.AttemptID = 2
+	err = pControl.RegisterAttempt(info.PaymentHash, &attempt2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = pControl.SettleAttempt(
+		info.PaymentHash, attempt2.AttemptID,
+		&channeldb.HTLCSettleInfo{Preimage: preimg},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Restart with the same database.
+	dbPath := db.Path()
+	db.Close()
+	db, err = channeldb.Open(dbPath)
+	if err != nil {
+		t.Fatalf("unable to reopen db: %v", err)
+	}
+	defer db.Close()
+	pControl = NewControlTower(channeldb.NewPaymentControl(db))
+
+	// receive returns the updates sent to the subscriber until it is
+	// closed.
+	receive := func(s *ControlTowerSubscriber) []*channeldb.MPPayment {
+		t.Helper()
+		var updates []*channeldb.MPPayment
+		for {
+			select {
+			case item, ok := <-s.Updates:
+				if !ok {
+					return updates
+				}
+				updates = append(
+					updates, item.(*channeldb.MPPayment),
+				)
+			case <-time.After(testTimeout):
+				t.Fatal("timeout waiting for payment update")
+			}
+		}
+	}
+
+	// A subscriber which saw the failure of the first attempt gets the
+	// registration and settlement of the second one.
+	subscriber, err := pControl.SubscribePaymentFrom(info.PaymentHash, 4)
+	if err != nil {
+		t.Fatalf("expected subscribe to succeed, but got: %v", err)
+	}
+	updates := receive(subscriber)
+	if len(updates) != 2 {
+		t.Fatalf("expected 2 replayed updates, got %d", len(updates))
+	}
+
+	registered := updates[0]
+	if registered.TransitionSeq != 4 ||
+		registered.Status != channeldb.StatusInFlight {
+		t.Fatalf("unexpected replayed update: seq %d, status %v",
+			registered.TransitionSeq, registered.Status)
+	}
+	if len(registered.HTLCs) != 2 {
+		t.Fatalf("expected 2 htlcs, got %d", len(registered.HTLCs))
+	}
+	if registered.HTLCs[0].Failure == nil {
+		t.Fatal("expected first htlc to be failed")
+	}
+	if registered.HTLCs[1].Settle != nil {
+		t.Fatal("expected second htlc to be in flight")
+	}
+
+	settled := updates[1]
+	if settled.TransitionSeq != 5 ||
+		settled.Status != channeldb.StatusSucceeded {
+		t.Fatalf("unexpected replayed update: seq %d, status %v",
+			settled.TransitionSeq, settled.Status)
+	}
+	if settle, _ := settled.TerminalInfo(); settle.Preimage != preimg {
+		t.Fatal("unexpected preimage")
+	}
+
+	// A subscriber which missed nothing gets the current state only.
+	subscriber, err = pControl.SubscribePaymentFrom(info.PaymentHash, 6)
+	if err != nil {
+		t.Fatalf("expected subscribe to succeed, but got: %v", err)
+	}
+	updates = receive(subscriber)
+	if len(updates) != 1 || updates[0].TransitionSeq != 5 ||
+		updates[0].Status != channeldb.StatusSucceeded {
+		t.Fatalf("expected the current state only, got %v",
+			spew.Sdump(updates))
+	}
+}
+
 // TestPaymentControlSubscribeFail tests that payment updates for a
 // failed payment are properly sent to subscribers.
 func TestPaymentControlSubscribeFail(t *testing.T) {
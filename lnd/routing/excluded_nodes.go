@@ -0,0 +1,150 @@
+package routing
+
+import (
+	"bytes"
+	"sort"
+	"sync"
+
+	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/lnd/channeldb/kvdb"
+	"github.com/pkt-cash/pktd/lnd/routing/route"
+)
+
+var (
+	// excludedNodesKey is the fixed key under which the persistently
+	// excluded nodes are stored.
+	excludedNodesKey = []byte("excluded-nodes")
+
+	// ErrNodeNotExcluded is returned when the caller tries to remove a
+	// node from the exclusion set that isn't part of it.
+	ErrNodeNotExcluded = Err.CodeWithDetail("ErrNodeNotExcluded",
+		"node is not excluded")
+)
+
+// NodeExclusions is a persistent set of nodes that path finding never routes
+// through. The set is kept in memory and written through to the database, so
+// that it survives restarts and doesn't need to be sent along with every
+// payment.
+type NodeExclusions struct {
+	db kvdb.Backend
+
+	mu    sync.RWMutex
+	nodes map[route.Vertex]struct{}
+}
+
+// NewNodeExclusions loads the set of excluded nodes from the database.
+func NewNodeExclusions(db kvdb.Backend) (*NodeExclusions, er.R) {
+	nodes := make(map[route.Vertex]struct{})
+
+	err := kvdb.Update(db, func(tx kvdb.RwTx) er.R {
+		bucket, err := tx.CreateTopLevelBucket(excludedNodesKey)
+		if err != nil {
+			return er.Errorf("cannot create excluded nodes "+
+				"bucket: %v", err)
+		}
+
+		return bucket.ForEach(func(k, _ []byte) er.R {
+			node, err := route.NewVertexFromBytes(k)
+			if err != nil {
+				return err
+			}
+			nodes[node] = struct{}{}
+			return nil
+		})
+	}, func() {
+		nodes = make(map[route.Vertex]struct{})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &NodeExclusions{
+		db:    db,
+		nodes: nodes,
+	}, nil
+}
+
+// Add adds the node to the exclusion set. Adding a node that is already
+// excluded is a no-op.
+func (n *NodeExclusions) Add(node route.Vertex) er.R {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	if _, ok := n.nodes[node]; ok {
+		return nil
+	}
+
+	err := kvdb.Update(n.db, func(tx kvdb.RwTx) er.R {
+		bucket := tx.ReadWriteBucket(excludedNodesKey)
+		return bucket.Put(node[:], []byte{})
+	}, func() {})
+	if err != nil {
+		return err
+	}
+
+	n.nodes[node] = struct{}{}
+	return nil
+}
+
+// Remove removes the node from the exclusion set. ErrNodeNotExcluded is
+// returned if the node isn't excluded.
+func (n *NodeExclusions) Remove(node route.Vertex) er.R {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	if _, ok := n.nodes[node]; !ok {
+		return ErrNodeNotExcluded.Default()
+	}
+
+	err := kvdb.Update(n.db, func(tx kvdb.RwTx) er.R {
+		bucket := tx.ReadWriteBucket(excludedNodesKey)
+		return bucket.Delete(node[:])
+	}, func() {})
+	if err != nil {
+		return err
+	}
+
+	delete(n.nodes, node)
+	return nil
+}
+
+// List returns the excluded nodes, sorted by pubkey.
+func (n *NodeExclusions) List() []route.Vertex {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+
+	nodes := make([]route.Vertex, 0, len(n.nodes))
+	for node := range n.nodes {
+		nodes = append(nodes, node)
+	}
+	sort.Slice(nodes, func(i, j int) bool {
+		return bytes.Compare(nodes[i][:], nodes[j][:]) < 0
+	})
+
+	return nodes
+}
+
+// Union returns a new set holding both the excluded nodes and the given
+// nodes. It may be called on a nil NodeExclusions, in which case only the
+// given nodes are returned.
+func (n *NodeExclusions) Union(
+	nodes []route.Vertex) map[route.Vertex]struct{} {
+
+	union := make(map[route.Vertex]struct{}, len(nodes))
+	for _, node := range nodes {
+		union[node] = struct{}{}
+	}
+
+	if n == nil {
+		return union
+	}
+
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+
+	for node := range n.nodes {
+		union[node] = struct{}{}
+	}
+
+	return union
+}
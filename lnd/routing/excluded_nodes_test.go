@@ -0,0 +1,81 @@
+package routing
+
+import (
+	"io/ioutil"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/pkt-cash/pktd/lnd/channeldb/kvdb"
+	"github.com/pkt-cash/pktd/lnd/routing/route"
+)
+
+// TestNodeExclusions tests that nodes can be added to and removed from the
+// exclusion set, and that the set is restored from the database.
+func TestNodeExclusions(t *testing.T) {
+	t.Parallel()
+
+	file, errr := ioutil.TempFile("", "*.db")
+	if errr != nil {
+		t.Fatal(errr)
+	}
+
+	dbPath := file.Name()
+
+	db, err := kvdb.Create(kvdb.BoltBackendName, dbPath, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	defer os.Remove(dbPath)
+
+	exclusions, err := NewNodeExclusions(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if nodes := exclusions.List(); len(nodes) != 0 {
+		t.Fatalf("expected no excluded nodes, got %v", nodes)
+	}
+
+	// Add nodes out of order, and one of them twice.
+	for _, node := range []route.Vertex{{3}, {1}, {2}, {1}} {
+		if err := exclusions.Add(node); err != nil {
+			t.Fatalf("unable to exclude node: %v", err)
+		}
+	}
+	if err := exclusions.Remove(route.Vertex{2}); err != nil {
+		t.Fatalf("unable to remove node: %v", err)
+	}
+	err = exclusions.Remove(route.Vertex{4})
+	if !ErrNodeNotExcluded.Is(err) {
+		t.Fatalf("expected ErrNodeNotExcluded, got %v", err)
+	}
+
+	expected := []route.Vertex{{1}, {3}}
+	if nodes := exclusions.List(); !reflect.DeepEqual(nodes, expected) {
+		t.Fatalf("expected excluded nodes %v, got %v", expected, nodes)
+	}
+
+	// The exclusions survive a restart.
+	exclusions, err = NewNodeExclusions(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if nodes := exclusions.List(); !reflect.DeepEqual(nodes, expected) {
+		t.Fatalf("expected excluded nodes %v, got %v", expected, nodes)
+	}
+
+	// The per payment exclusions are merged with the persistent ones.
+	union := exclusions.Union([]route.Vertex{{3}, {5}})
+	expectedUnion := map[route.Vertex]struct{}{{1}: {}, {3}: {}, {5}: {}}
+	if !reflect.DeepEqual(union, expectedUnion) {
+		t.Fatalf("expected union %v, got %v", expectedUnion, union)
+	}
+
+	var noExclusions *NodeExclusions
+	union = noExclusions.Union([]route.Vertex{{5}})
+	expectedUnion = map[route.Vertex]struct{}{{5}: {}}
+	if !reflect.DeepEqual(union, expectedUnion) {
+		t.Fatalf("expected union %v, got %v", expectedUnion, union)
+	}
+}
@@ -96,63 +96,11 @@ func (c *integratedRoutingContext) testPayment(maxParts uint32) ([]htlcAttempt,
 		attempts []htlcAttempt
 	)
 
-	// Create temporary database for mission control.
-	file, errr := ioutil.TempFile("", "*.db")
-	if errr != nil {
-		c.t.Fatal(errr)
-	}
-
-	dbPath := file.Name()
-	defer os.Remove(dbPath)
-
-	db, err := kvdb.Open(kvdb.BoltBackendName, dbPath, true)
-	if err != nil {
-		c.t.Fatal(err)
-	}
-	defer db.Close()
-
-	// Instantiate a new mission control with the current configuration
-	// values.
-	mc, err := NewMissionControl(db, &c.mcCfg)
-	if err != nil {
-		c.t.Fatal(err)
-	}
-
-	getBandwidthHints := func() (map[uint64]lnwire.MilliSatoshi, er.R) {
-		// Create bandwidth hints based on local channel balances.
-		bandwidthHints := map[uint64]lnwire.MilliSatoshi{}
-		for _, ch := range c.graph.nodes[c.source.pubkey].channels {
-			bandwidthHints[ch.id] = ch.balance
-		}
-
-		return bandwidthHints, nil
-	}
-
-	var paymentAddr [32]byte
-	payment := LightningPayment{
-		FinalCLTVDelta: uint16(c.finalExpiry),
-		FeeLimit:       lnwire.MaxMilliSatoshi,
-		Target:         c.target.pubkey,
-		PaymentAddr:    &paymentAddr,
-		DestFeatures:   lnwire.NewFeatureVector(mppFeatures, nil),
-		Amount:         c.amt,
-		CltvLimit:      math.MaxUint32,
-		MaxParts:       maxParts,
-	}
-
-	session, err := newPaymentSession(
-		&payment, getBandwidthHints,
-		func() (routingGraph, func(), er.R) {
-			return c.graph, func() {}, nil
-		},
-		mc, c.pathFindingCfg,
-	)
-	if err != nil {
-		c.t.Fatal(err)
-	}
+	mc, cleanup := c.newMissionControl()
+	defer cleanup()
 
-	// Override default minimum shard amount.
-	session.minShardAmt = lnwire.NewMSatFromSatoshis(5000)
+	payment := c.newPayment(maxParts)
+	session := c.newPaymentSession(payment, mc)
 
 	// Now the payment control loop starts. It will keep trying routes until
 	// the payment succeeds.
@@ -233,6 +181,88 @@ func (c *integratedRoutingContext) testPayment(maxParts uint32) ([]htlcAttempt,
 	return attempts, nil
 }
 
+// newMissionControl instantiates a new mission control with the current
+// configuration values, backed by a temporary database which is removed by
+// the returned clean-up function.
+func (c *integratedRoutingContext) newMissionControl() (*MissionControl,
+	func()) {
+
+	// Create temporary database for mission control.
+	file, errr := ioutil.TempFile("", "*.db")
+	if errr != nil {
+		c.t.Fatal(errr)
+	}
+
+	dbPath := file.Name()
+
+	db, err := kvdb.Open(kvdb.BoltBackendName, dbPath, true)
+	if err != nil {
+		os.Remove(dbPath)
+		c.t.Fatal(err)
+	}
+	cleanup := func() {
+		db.Close()
+		os.Remove(dbPath)
+	}
+
+	mc, err := NewMissionControl(db, &c.mcCfg)
+	if err != nil {
+		cleanup()
+		c.t.Fatal(err)
+	}
+
+	return mc, cleanup
+}
+
+// newPayment returns an mpp payment of the context amount to the target.
+func (c *integratedRoutingContext) newPayment(
+	maxParts uint32) *LightningPayment {
+
+	var paymentAddr [32]byte
+	return &LightningPayment{
+		FinalCLTVDelta: uint16(c.finalExpiry),
+		FeeLimit:       lnwire.MaxMilliSatoshi,
+		Target:         c.target.pubkey,
+		PaymentAddr:    &paymentAddr,
+		DestFeatures:   lnwire.NewFeatureVector(mppFeatures, nil),
+		Amount:         c.amt,
+		CltvLimit:      math.MaxUint32,
+		MaxParts:       maxParts,
+	}
+}
+
+// newPaymentSession creates a payment session for the payment on the mock
+// graph, with bandwidth hints based on the local channel balances.
+func (c *integratedRoutingContext) newPaymentSession(
+	payment *LightningPayment, mc MissionController) *paymentSession {
+
+	getBandwidthHints := func() (map[uint64]lnwire.MilliSatoshi, er.R) {
+		// Create bandwidth hints based on local channel balances.
+		bandwidthHints := map[uint64]lnwire.MilliSatoshi{}
+		for _, ch := range c.graph.nodes[c.source.pubkey].channels {
+			bandwidthHints[ch.id] = ch.balance
+		}
+
+		return bandwidthHints, nil
+	}
+
+	session, err := newPaymentSession(
+		payment, getBandwidthHints,
+		func() (routingGraph, func(), er.R) {
+			return c.graph, func() {}, nil
+		},
+		mc, c.pathFindingCfg,
+	)
+	if err != nil {
+		c.t.Fatal(err)
+	}
+
+	// Override default minimum shard amount.
+	session.minShardAmt = lnwire.NewMSatFromSatoshis(5000)
+
+	return session
+}
+
 // getNodeIndex returns the zero-based index of the given node in the route.
 func getNodeIndex(route *route.Route, failureSource route.Vertex) *int {
 	if failureSource == route.SourcePubKey {
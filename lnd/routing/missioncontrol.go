@@ -1,9 +1,11 @@
 package routing
 
 import (
+	"fmt"
 	"sync"
 	"time"
 
+	"github.com/pkt-cash/pktd/btcutil"
 	"github.com/pkt-cash/pktd/btcutil/er"
 	"github.com/pkt-cash/pktd/lnd/channeldb"
 	"github.com/pkt-cash/pktd/lnd/channeldb/kvdb"
@@ -57,8 +59,40 @@ const (
 	// have passed since the previously recorded failure before the failure
 	// amount may be raised.
 	DefaultMinFailureRelaxInterval = time.Minute
+
+	// AprioriEstimatorName is the name of the a priori probability
+	// estimator, which is the default estimator. It assumes a fixed
+	// probability for connections without history and extrapolates the
+	// results of a node to its untried connections.
+	AprioriEstimatorName = "apriori"
+
+	// BimodalEstimatorName is the name of the bimodal probability
+	// estimator. It estimates probabilities from a model of the liquidity
+	// of channels, taking their capacity into account.
+	BimodalEstimatorName = "bimodal"
+
+	// DefaultEstimator is the name of the default probability estimator.
+	DefaultEstimator = AprioriEstimatorName
+
+	// DefaultBimodalScale is the default scale of the liquidity
+	// distribution of the bimodal estimator.
+	DefaultBimodalScale = lnwire.MilliSatoshi(300_000_000)
+
+	// DefaultBimodalNodeWeight is the default weight of the results of
+	// the other channels of a node in the bimodal estimator.
+	DefaultBimodalNodeWeight = 0.2
+
+	// DefaultBimodalDecayTime is the default time after which the
+	// liquidity bounds of the bimodal estimator have relaxed by a factor of
+	// e.
+	DefaultBimodalDecayTime = 7 * 24 * time.Hour
 )
 
+// ErrInvalidMcConfig is returned when mission control is configured with a
+// value that is out of range, the offending parameter is given in the error.
+var ErrInvalidMcConfig = Err.CodeWithDetail("ErrInvalidMcConfig",
+	"invalid mission control config")
+
 // NodeResults contains previous results from a node to its peers.
 type NodeResults map[route.Vertex]TimedPairResult
 
@@ -86,7 +120,7 @@ type MissionControl struct {
 
 	// estimator is the probability estimator that is used with the payment
 	// results that mission control collects.
-	estimator *probabilityEstimator
+	estimator estimator
 
 	sync.Mutex
 
@@ -125,10 +159,104 @@ type MissionControlConfig struct {
 	// be raised.
 	MinFailureRelaxInterval time.Duration
 
+	// Estimator is the name of the probability estimator, either
+	// AprioriEstimatorName or BimodalEstimatorName. The a priori estimator
+	// is used if it is empty.
+	Estimator string
+
+	// BimodalScale defines how far the liquidity of channels spreads from
+	// their edges in the bimodal estimator.
+	BimodalScale lnwire.MilliSatoshi
+
+	// BimodalNodeWeight is a value in the range [0, 1] that defines to
+	// what extent the bimodal estimator takes the results of the other
+	// channels of a node into account.
+	BimodalNodeWeight float64
+
+	// BimodalDecayTime is the time after which the liquidity bounds that
+	// payment results set in the bimodal estimator have relaxed by a
+	// factor of e.
+	BimodalDecayTime time.Duration
+
 	// SelfNode is our own pubkey.
 	SelfNode route.Vertex
 }
 
+// validate checks that the probability estimation parameters of the config
+// are within range.
+func (c *MissionControlConfig) validate() er.R {
+	switch {
+	case c.PenaltyHalfLife <= 0:
+		return ErrInvalidMcConfig.New(fmt.Sprintf("penalty half-life "+
+			"must be positive, got %v", c.PenaltyHalfLife), nil)
+
+	case c.AprioriHopProbability < 0 || c.AprioriHopProbability > 1:
+		return ErrInvalidMcConfig.New(fmt.Sprintf("a priori hop "+
+			"probability must be in [0, 1], got %v",
+			c.AprioriHopProbability), nil)
+
+	case c.AprioriWeight < 0 || c.AprioriWeight > 1:
+		return ErrInvalidMcConfig.New(fmt.Sprintf("a priori weight "+
+			"must be in [0, 1], got %v", c.AprioriWeight), nil)
+	}
+
+	return c.validateEstimator()
+}
+
+// validateEstimator checks that the config selects a known probability
+// estimator, and that the parameters of the bimodal estimator are within range
+// if it is selected.
+func (c *MissionControlConfig) validateEstimator() er.R {
+	switch {
+	case c.Estimator == "" || c.Estimator == AprioriEstimatorName:
+		return nil
+
+	case c.Estimator != BimodalEstimatorName:
+		return ErrInvalidMcConfig.New(fmt.Sprintf("unknown estimator "+
+			"%q, expected %q or %q", c.Estimator,
+			AprioriEstimatorName, BimodalEstimatorName), nil)
+
+	case c.BimodalScale == 0:
+		return ErrInvalidMcConfig.New("bimodal scale must be positive",
+			nil)
+
+	case c.BimodalNodeWeight < 0 || c.BimodalNodeWeight > 1:
+		return ErrInvalidMcConfig.New(fmt.Sprintf("bimodal node "+
+			"weight must be in [0, 1], got %v",
+			c.BimodalNodeWeight), nil)
+
+	case c.BimodalDecayTime <= 0:
+		return ErrInvalidMcConfig.New(fmt.Sprintf("bimodal decay "+
+			"time must be positive, got %v", c.BimodalDecayTime),
+			nil)
+	}
+
+	return nil
+}
+
+// newEstimator returns the probability estimator that the config selects. The
+// bimodal estimator falls back to the a priori estimator for local channels and
+// channels of unknown capacity.
+func newEstimator(cfg *MissionControlConfig) estimator {
+	apriori := &probabilityEstimator{
+		aprioriHopProbability:  cfg.AprioriHopProbability,
+		aprioriWeight:          cfg.AprioriWeight,
+		penaltyHalfLife:        cfg.PenaltyHalfLife,
+		prevSuccessProbability: prevSuccessProbability,
+	}
+
+	if cfg.Estimator != BimodalEstimatorName {
+		return apriori
+	}
+
+	return &bimodalEstimator{
+		scale:      cfg.BimodalScale,
+		nodeWeight: cfg.BimodalNodeWeight,
+		decayTime:  cfg.BimodalDecayTime,
+		fallback:   apriori,
+	}
+}
+
 // TimedPairResult describes a timestamped pair result.
 type TimedPairResult struct {
 	// FailTime is the time of the last failure.
@@ -183,19 +311,16 @@ func NewMissionControl(db kvdb.Backend, cfg *MissionControlConfig) (
 	*MissionControl, er.R) {
 	log.Debugf("Instantiating mission control with config: "+
 		"PenaltyHalfLife=%v, AprioriHopProbability=%v, "+
-		"AprioriWeight=%v", cfg.PenaltyHalfLife,
-		cfg.AprioriHopProbability, cfg.AprioriWeight)
+		"AprioriWeight=%v, Estimator=%v", cfg.PenaltyHalfLife,
+		cfg.AprioriHopProbability, cfg.AprioriWeight, cfg.Estimator)
 
-	store, err := newMissionControlStore(db, cfg.MaxMcHistory)
-	if err != nil {
+	if err := cfg.validateEstimator(); err != nil {
 		return nil, err
 	}
 
-	estimator := &probabilityEstimator{
-		aprioriHopProbability:  cfg.AprioriHopProbability,
-		aprioriWeight:          cfg.AprioriWeight,
-		penaltyHalfLife:        cfg.PenaltyHalfLife,
-		prevSuccessProbability: prevSuccessProbability,
+	store, err := newMissionControlStore(db, cfg.MaxMcHistory)
+	if err != nil {
+		return nil, err
 	}
 
 	mc := &MissionControl{
@@ -203,7 +328,7 @@ func NewMissionControl(db kvdb.Backend, cfg *MissionControlConfig) (
 		now:       time.Now,
 		cfg:       cfg,
 		store:     store,
-		estimator: estimator,
+		estimator: newEstimator(cfg),
 	}
 
 	if err := mc.init(); err != nil {
@@ -251,10 +376,60 @@ func (m *MissionControl) ResetHistory() er.R {
 	return nil
 }
 
+// GetConfig returns a copy of the current mission control config.
+func (m *MissionControl) GetConfig() *MissionControlConfig {
+	m.Lock()
+	defer m.Unlock()
+
+	cfg := *m.cfg
+	return &cfg
+}
+
+// SetConfig updates the probability estimation parameters of mission control,
+// which are the penalty half-life, the a priori hop probability, the a priori
+// weight, the estimator and the parameters of the bimodal estimator. The new
+// values are used from the next probability query on. The recorded payment
+// results aren't touched, so the change, including a switch to another
+// estimator, can be reverted without losing any history.
+func (m *MissionControl) SetConfig(cfg *MissionControlConfig) er.R {
+	if err := cfg.validate(); err != nil {
+		return err
+	}
+
+	m.Lock()
+	defer m.Unlock()
+
+	log.Infof("Updating mission control config: PenaltyHalfLife=%v, "+
+		"AprioriHopProbability=%v, AprioriWeight=%v, Estimator=%v, "+
+		"BimodalScale=%v, BimodalNodeWeight=%v, BimodalDecayTime=%v",
+		cfg.PenaltyHalfLife, cfg.AprioriHopProbability,
+		cfg.AprioriWeight, cfg.Estimator, cfg.BimodalScale,
+		cfg.BimodalNodeWeight, cfg.BimodalDecayTime)
+
+	// The config may be shared with the caller, so it is replaced rather
+	// than modified.
+	newCfg := *m.cfg
+	newCfg.PenaltyHalfLife = cfg.PenaltyHalfLife
+	newCfg.AprioriHopProbability = cfg.AprioriHopProbability
+	newCfg.AprioriWeight = cfg.AprioriWeight
+	newCfg.Estimator = cfg.Estimator
+	newCfg.BimodalScale = cfg.BimodalScale
+	newCfg.BimodalNodeWeight = cfg.BimodalNodeWeight
+	newCfg.BimodalDecayTime = cfg.BimodalDecayTime
+	m.cfg = &newCfg
+
+	// Estimators only look at the results when they are queried, so the
+	// new one takes over without any need to process the history.
+	m.estimator = newEstimator(&newCfg)
+
+	return nil
+}
+
 // GetProbability is expected to return the success probability of a payment
-// from fromNode along edge.
+// from fromNode along edge. The capacity is the capacity of the channels from
+// fromNode to toNode, or zero if it is unknown.
 func (m *MissionControl) GetProbability(fromNode, toNode route.Vertex,
-	amt lnwire.MilliSatoshi) float64 {
+	amt lnwire.MilliSatoshi, capacity btcutil.Amount) float64 {
 	m.Lock()
 	defer m.Unlock()
 
@@ -266,7 +441,9 @@ func (m *MissionControl) GetProbability(fromNode, toNode route.Vertex,
 		return m.estimator.getLocalPairProbability(now, results, toNode)
 	}
 
-	return m.estimator.getPairProbability(now, results, toNode, amt)
+	return m.estimator.getPairProbability(
+		now, results, toNode, amt, capacity,
+	)
 }
 
 // GetHistorySnapshot takes a snapshot from the current mission control state
@@ -280,6 +457,28 @@ func (m *MissionControl) GetHistorySnapshot() *MissionControlSnapshot {
 	return m.state.getSnapshot()
 }
 
+// ImportHistory merges the pair results of a previously taken snapshot into
+// the current mission control state. For every pair, the imported failure and
+// success results are only applied if they are more recent than the ones
+// already known. Imported results are held in memory only and are not written
+// to the payment result store.
+func (m *MissionControl) ImportHistory(history *MissionControlSnapshot) er.R {
+	if history == nil {
+		return er.New("cannot import nil history")
+	}
+
+	m.Lock()
+	defer m.Unlock()
+
+	log.Infof("Importing %v pairs to mission control", len(history.Pairs))
+
+	imported := m.state.importSnapshot(history)
+
+	log.Infof("Imported %v results to mission control", imported)
+
+	return nil
+}
+
 // GetPairHistorySnapshot returns the stored history for a given node pair.
 func (m *MissionControl) GetPairHistorySnapshot(
 	fromNode, toNode route.Vertex) TimedPairResult {
@@ -205,3 +205,49 @@ func (m *missionControlState) getSnapshot() *MissionControlSnapshot {
 
 	return &snapshot
 }
+
+// importSnapshot merges the given snapshot into the state, keeping the most
+// recent failure and success result for each pair. It returns the number of
+// pairs that were updated.
+func (m *missionControlState) importSnapshot(
+	snapshot *MissionControlSnapshot) int {
+	var imported int
+
+	for _, pair := range snapshot.Pairs {
+		fromNode := pair.Pair.From
+		toNode := pair.Pair.To
+
+		nodePairs, ok := m.lastPairResult[fromNode]
+		if !ok {
+			nodePairs = make(NodeResults)
+			m.lastPairResult[fromNode] = nodePairs
+		}
+
+		current := nodePairs[toNode]
+		updated := false
+
+		if pair.FailTime.After(current.FailTime) {
+			current.FailTime = pair.FailTime
+			current.FailAmt = pair.FailAmt
+			updated = true
+		}
+
+		if pair.SuccessTime.After(current.SuccessTime) {
+			current.SuccessTime = pair.SuccessTime
+			current.SuccessAmt = pair.SuccessAmt
+			updated = true
+		}
+
+		if !updated {
+			continue
+		}
+
+		log.Debugf("Imported %v->%v range [%v-%v]", fromNode, toNode,
+			current.SuccessAmt, current.FailAmt)
+
+		nodePairs[toNode] = current
+		imported++
+	}
+
+	return imported
+}
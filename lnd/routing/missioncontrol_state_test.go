@@ -45,3 +45,66 @@ func TestMissionControlStateFailureResult(t *testing.T) {
 		t.Fatalf("unexpected fail amount %v", result[to].FailAmt)
 	}
 }
+
+// TestMissionControlStateImport tests that importing a snapshot only applies
+// results that are more recent than the ones already known.
+func TestMissionControlStateImport(t *testing.T) {
+	state := newMissionControlState(time.Minute)
+
+	var (
+		from      = route.Vertex{1}
+		to        = route.Vertex{2}
+		other     = route.Vertex{3}
+		timestamp = testTime
+	)
+
+	// Report a 1000 sat failure.
+	state.setLastPairResult(from, to, timestamp, &pairResult{amt: 1000})
+
+	snapshot := &MissionControlSnapshot{
+		Pairs: []MissionControlPairSnapshot{
+			{
+				// An older failure and a new success for the
+				// known pair.
+				Pair: NewDirectedNodePair(from, to),
+				TimedPairResult: TimedPairResult{
+					FailTime:    timestamp.Add(-time.Hour),
+					FailAmt:     500,
+					SuccessTime: timestamp,
+					SuccessAmt:  200,
+				},
+			},
+			{
+				// A failure for an unknown pair.
+				Pair: NewDirectedNodePair(from, other),
+				TimedPairResult: TimedPairResult{
+					FailTime: timestamp,
+					FailAmt:  300,
+				},
+			},
+		},
+	}
+
+	if imported := state.importSnapshot(snapshot); imported != 2 {
+		t.Fatalf("expected 2 imported pairs, got %v", imported)
+	}
+
+	result, _ := state.getLastPairResult(from)
+	expected := TimedPairResult{
+		FailTime:    timestamp,
+		FailAmt:     1000,
+		SuccessTime: timestamp,
+		SuccessAmt:  200,
+	}
+	if result[to] != expected {
+		t.Fatalf("unexpected result %v", result[to])
+	}
+	if result[other].FailAmt != 300 {
+		t.Fatalf("unexpected fail amount %v", result[other].FailAmt)
+	}
+
+	// Importing the same snapshot again should not change anything.
+	if imported := state.importSnapshot(snapshot); imported != 0 {
+		t.Fatalf("expected no imported pairs, got %v", imported)
+	}
+}
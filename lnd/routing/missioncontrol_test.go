@@ -2,10 +2,12 @@ package routing
 
 import (
 	"io/ioutil"
+	"math"
 	"os"
 	"testing"
 	"time"
 
+	"github.com/pkt-cash/pktd/btcutil"
 	"github.com/pkt-cash/pktd/btcutil/er"
 	"github.com/pkt-cash/pktd/lnd/channeldb/kvdb"
 	"github.com/pkt-cash/pktd/lnd/lnwire"
@@ -38,6 +40,10 @@ var (
 	testPenaltyHalfLife       = 30 * time.Minute
 	testAprioriHopProbability = 0.9
 	testAprioriWeight         = 0.5
+
+	// mcTestCapacity is the capacity of the channel between the test
+	// nodes, which only the bimodal estimator takes into account.
+	mcTestCapacity = btcutil.Amount(100000)
 )
 
 type mcTestContext struct {
@@ -104,7 +110,9 @@ func (ctx *mcTestContext) cleanup() {
 func (ctx *mcTestContext) expectP(amt lnwire.MilliSatoshi, expected float64) {
 	ctx.t.Helper()
 
-	p := ctx.mc.GetProbability(mcTestNode1, mcTestNode2, amt)
+	p := ctx.mc.GetProbability(
+		mcTestNode1, mcTestNode2, amt, mcTestCapacity,
+	)
 	if p != expected {
 		ctx.t.Fatalf("expected probability %v but got %v", expected, p)
 	}
@@ -142,7 +150,7 @@ func TestMissionControl(t *testing.T) {
 
 	// For local channels, we expect a higher probability than our a prior
 	// test probability.
-	selfP := ctx.mc.GetProbability(mcTestSelf, mcTestNode1, 100)
+	selfP := ctx.mc.GetProbability(mcTestSelf, mcTestNode1, 100, 0)
 	if selfP != prevSuccessProbability {
 		t.Fatalf("expected prev success prob for untried local chans")
 	}
@@ -214,3 +222,118 @@ func TestMissionControlChannelUpdate(t *testing.T) {
 	)
 	ctx.expectP(100, 0)
 }
+
+// TestMissionControlSetConfig tests that a config update applies to the next
+// probability query without affecting the recorded history, and that
+// out-of-range values are rejected.
+func TestMissionControlSetConfig(t *testing.T) {
+	ctx := createMcTestContext(t)
+	defer ctx.cleanup()
+
+	ctx.reportFailure(1000, lnwire.NewTemporaryChannelFailure(nil))
+	ctx.expectP(1000, 0)
+	ctx.expectP(500, testAprioriHopProbability)
+	history := ctx.mc.GetPairHistorySnapshot(mcTestNode1, mcTestNode2)
+
+	cfg := ctx.mc.GetConfig()
+	cfg.AprioriHopProbability = 0.3
+	if err := ctx.mc.SetConfig(cfg); err != nil {
+		t.Fatalf("unable to set config: %v", err)
+	}
+
+	// Untried amounts now fall back to the new a priori probability,
+	// while the recorded failure still applies.
+	ctx.expectP(500, 0.3)
+	ctx.expectP(1000, 0)
+	if p := ctx.mc.GetConfig().AprioriHopProbability; p != 0.3 {
+		t.Fatalf("expected a priori hop probability 0.3, got %v", p)
+	}
+	if ctx.mc.GetPairHistorySnapshot(mcTestNode1, mcTestNode2) != history {
+		t.Fatal("expected history to be retained")
+	}
+
+	for _, invalid := range []MissionControlConfig{
+		{PenaltyHalfLife: 0, AprioriHopProbability: 0.5},
+		{PenaltyHalfLife: time.Hour, AprioriHopProbability: 1.1},
+		{PenaltyHalfLife: time.Hour, AprioriWeight: -0.1},
+	} {
+		invalid := invalid
+		if err := ctx.mc.SetConfig(&invalid); !ErrInvalidMcConfig.Is(err) {
+			t.Fatalf("expected ErrInvalidMcConfig for %+v, got %v",
+				invalid, err)
+		}
+	}
+	ctx.expectP(500, 0.3)
+}
+
+// TestMissionControlSwitchEstimator tests that mission control can switch
+// between the a priori and the bimodal estimator on existing history, and that
+// invalid estimator configs are rejected.
+func TestMissionControlSwitchEstimator(t *testing.T) {
+	ctx := createMcTestContext(t)
+	defer ctx.cleanup()
+
+	ctx.reportFailure(1000, lnwire.NewTemporaryChannelFailure(nil))
+	ctx.expectP(500, testAprioriHopProbability)
+	history := ctx.mc.GetPairHistorySnapshot(mcTestNode1, mcTestNode2)
+
+	cfg := ctx.mc.GetConfig()
+	cfg.Estimator = BimodalEstimatorName
+	cfg.BimodalScale = DefaultBimodalScale
+	cfg.BimodalDecayTime = DefaultBimodalDecayTime
+	if err := ctx.mc.SetConfig(cfg); err != nil {
+		t.Fatalf("unable to set config: %v", err)
+	}
+
+	// The recorded failure bounds the liquidity of the channel, which is
+	// about evenly distributed over such a small range.
+	ctx.expectP(1000, 0)
+	p := ctx.mc.GetProbability(
+		mcTestNode1, mcTestNode2, 500, mcTestCapacity,
+	)
+	if math.Abs(p-0.5) > 0.01 {
+		t.Fatalf("expected probability 0.5, got %v", p)
+	}
+
+	// Without the capacity, the a priori estimator is used.
+	p = ctx.mc.GetProbability(mcTestNode1, mcTestNode2, 500, 0)
+	if p != testAprioriHopProbability {
+		t.Fatalf("expected probability %v, got %v",
+			testAprioriHopProbability, p)
+	}
+
+	cfg.Estimator = AprioriEstimatorName
+	if err := ctx.mc.SetConfig(cfg); err != nil {
+		t.Fatalf("unable to set config: %v", err)
+	}
+	ctx.expectP(500, testAprioriHopProbability)
+	if ctx.mc.GetPairHistorySnapshot(mcTestNode1, mcTestNode2) != history {
+		t.Fatal("expected history to be retained")
+	}
+
+	for _, invalid := range []MissionControlConfig{
+		{Estimator: "unknown"},
+		{Estimator: BimodalEstimatorName, BimodalDecayTime: time.Hour},
+		{
+			Estimator:         BimodalEstimatorName,
+			BimodalScale:      DefaultBimodalScale,
+			BimodalNodeWeight: 2,
+			BimodalDecayTime:  time.Hour,
+		},
+		{
+			Estimator:    BimodalEstimatorName,
+			BimodalScale: DefaultBimodalScale,
+		},
+	} {
+		invalid := invalid
+		invalid.PenaltyHalfLife = time.Hour
+		if err := ctx.mc.SetConfig(&invalid); !ErrInvalidMcConfig.Is(err) {
+			t.Fatalf("expected ErrInvalidMcConfig for %+v, got %v",
+				invalid, err)
+		}
+	}
+	if e := ctx.mc.GetConfig().Estimator; e != AprioriEstimatorName {
+		t.Fatalf("expected estimator %v, got %v",
+			AprioriEstimatorName, e)
+	}
+}
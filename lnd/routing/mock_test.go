@@ -3,6 +3,7 @@ package routing
 import (
 	"sync"
 
+	"github.com/pkt-cash/pktd/btcutil"
 	"github.com/pkt-cash/pktd/btcutil/er"
 	"github.com/pkt-cash/pktd/lnd/channeldb"
 	"github.com/pkt-cash/pktd/lnd/htlcswitch"
@@ -80,13 +81,17 @@ func (m *mockPaymentAttemptDispatcher) setPaymentResult(
 
 type mockPaymentSessionSource struct {
 	routes []*route.Route
+
+	// emptyRequests, if non-nil, receives a signal each time a route is
+	// requested from an empty payment session.
+	emptyRequests chan struct{}
 }
 
 var _ PaymentSessionSource = (*mockPaymentSessionSource)(nil)
 
 func (m *mockPaymentSessionSource) NewPaymentSession(
 	_ *LightningPayment) (PaymentSession, er.R) {
-	return &mockPaymentSession{m.routes}, nil
+	return &mockPaymentSession{routes: m.routes}, nil
 }
 
 func (m *mockPaymentSessionSource) NewPaymentSessionForRoute(
@@ -95,7 +100,7 @@ func (m *mockPaymentSessionSource) NewPaymentSessionForRoute(
 }
 
 func (m *mockPaymentSessionSource) NewPaymentSessionEmpty() PaymentSession {
-	return &mockPaymentSession{}
+	return &mockPaymentSession{requests: m.emptyRequests}
 }
 
 type mockMissionControl struct {
@@ -122,20 +127,25 @@ func (m *mockMissionControl) ReportPaymentSuccess(paymentID uint64,
 }
 
 func (m *mockMissionControl) GetProbability(fromNode, toNode route.Vertex,
-	amt lnwire.MilliSatoshi) float64 {
+	amt lnwire.MilliSatoshi, capacity btcutil.Amount) float64 {
 	return 0
 }
 
 type mockPaymentSession struct {
-	routes []*route.Route
+	routes   []*route.Route
+	requests chan struct{}
 }
 
 var _ PaymentSession = (*mockPaymentSession)(nil)
 
 func (m *mockPaymentSession) RequestRoute(_, _ lnwire.MilliSatoshi,
 	_, height uint32) (*route.Route, er.R) {
+	if m.requests != nil {
+		m.requests <- struct{}{}
+	}
+
 	if len(m.routes) == 0 {
-		return nil, er.E(errNoPathFound)
+		return nil, ErrNoRouteFound.Default()
 	}
 
 	r := m.routes[0]
@@ -285,6 +295,18 @@ func (m *mockControlTower) RegisterAttempt(phash lntypes.Hash,
 		return channeldb.ErrPaymentNotInitiated.Default()
 	}
 
+	// Like the database, refuse attempts that would take the fees of all
+	// attempts, including the failed ones, above the maximum total fee.
+	if p.info.MaxTotalFee != 0 {
+		fees := a.Route.TotalFees()
+		for _, attempt := range p.attempts {
+			fees += attempt.Route.TotalFees()
+		}
+		if fees > p.info.MaxTotalFee {
+			return channeldb.ErrFeeCapExceeded.Default()
+		}
+	}
+
 	p.attempts = append(p.attempts, channeldb.HTLCAttempt{
 		HTLCAttemptInfo: *a,
 	})
@@ -444,7 +466,39 @@ func (m *mockControlTower) FetchInFlightPayments() (
 	return fl, nil
 }
 
+func (m *mockControlTower) DeletePayment(phash lntypes.Hash, force bool) er.R {
+	m.Lock()
+	defer m.Unlock()
+
+	// Payment must be known.
+	if _, ok := m.payments[phash]; !ok {
+		return channeldb.ErrPaymentNotInitiated.Default()
+	}
+
+	_, succeeded := m.successful[phash]
+	_, failed := m.failed[phash]
+	if !succeeded && !failed && !force {
+		return channeldb.ErrPaymentInFlight.Default()
+	}
+
+	delete(m.payments, phash)
+	delete(m.successful, phash)
+	delete(m.failed, phash)
+
+	return nil
+}
+
+func (m *mockControlTower) QueryPayments(query channeldb.PaymentsQuery) (
+	channeldb.PaymentsResponse, er.R) {
+	return channeldb.PaymentsResponse{}, er.New("not implemented")
+}
+
 func (m *mockControlTower) SubscribePayment(paymentHash lntypes.Hash) (
 	*ControlTowerSubscriber, er.R) {
 	return nil, er.New("not implemented")
 }
+
+func (m *mockControlTower) SubscribePaymentFrom(paymentHash lntypes.Hash,
+	fromSeq uint64) (*ControlTowerSubscriber, er.R) {
+	return nil, er.New("not implemented")
+}
@@ -345,40 +345,11 @@ func addToTopologyChange(graph *channeldb.ChannelGraph, update *TopologyChange,
 				err)
 		}
 
-		// If the flag is one, then the advertising node is actually
-		// the second node.
-		sourceNode := edgeInfo.NodeKey1
-		connectingNode := edgeInfo.NodeKey2
-		if m.ChannelFlags&lnwire.ChanUpdateDirection == 1 {
-			sourceNode = edgeInfo.NodeKey2
-			connectingNode = edgeInfo.NodeKey1
-		}
-
-		aNode, err := sourceNode()
-		if err != nil {
-			return err
-		}
-		cNode, err := connectingNode()
+		edgeUpdate, err := newChannelEdgeUpdate(edgeInfo, m)
 		if err != nil {
 			return err
 		}
 
-		edgeUpdate := &ChannelEdgeUpdate{
-			ChanID:          m.ChannelID,
-			ChanPoint:       edgeInfo.ChannelPoint,
-			TimeLockDelta:   m.TimeLockDelta,
-			Capacity:        edgeInfo.Capacity,
-			MinHTLC:         m.MinHTLC,
-			MaxHTLC:         m.MaxHTLC,
-			BaseFee:         m.FeeBaseMSat,
-			FeeRate:         m.FeeProportionalMillionths,
-			AdvertisingNode: aNode,
-			ConnectingNode:  cNode,
-			Disabled:        m.ChannelFlags&lnwire.ChanUpdateDisabled != 0,
-		}
-		edgeUpdate.AdvertisingNode.Curve = nil
-		edgeUpdate.ConnectingNode.Curve = nil
-
 		// TODO(roasbeef): add bit to toggle
 		update.ChannelEdgeUpdates = append(update.ChannelEdgeUpdates,
 			edgeUpdate)
@@ -390,6 +361,94 @@ func addToTopologyChange(graph *channeldb.ChannelGraph, update *TopologyChange,
 	}
 }
 
+// newChannelEdgeUpdate creates the ChannelEdgeUpdate describing the given
+// policy of the channel.
+func newChannelEdgeUpdate(edgeInfo *channeldb.ChannelEdgeInfo,
+	policy *channeldb.ChannelEdgePolicy) (*ChannelEdgeUpdate, er.R) {
+
+	// If the flag is one, then the advertising node is actually the second
+	// node.
+	sourceNode := edgeInfo.NodeKey1
+	connectingNode := edgeInfo.NodeKey2
+	if policy.ChannelFlags&lnwire.ChanUpdateDirection == 1 {
+		sourceNode = edgeInfo.NodeKey2
+		connectingNode = edgeInfo.NodeKey1
+	}
+
+	aNode, err := sourceNode()
+	if err != nil {
+		return nil, err
+	}
+	cNode, err := connectingNode()
+	if err != nil {
+		return nil, err
+	}
+
+	edgeUpdate := &ChannelEdgeUpdate{
+		ChanID:          policy.ChannelID,
+		ChanPoint:       edgeInfo.ChannelPoint,
+		TimeLockDelta:   policy.TimeLockDelta,
+		Capacity:        edgeInfo.Capacity,
+		MinHTLC:         policy.MinHTLC,
+		MaxHTLC:         policy.MaxHTLC,
+		BaseFee:         policy.FeeBaseMSat,
+		FeeRate:         policy.FeeProportionalMillionths,
+		AdvertisingNode: aNode,
+		ConnectingNode:  cNode,
+		Disabled:        policy.ChannelFlags&lnwire.ChanUpdateDisabled != 0,
+	}
+	edgeUpdate.AdvertisingNode.Curve = nil
+	edgeUpdate.ConnectingNode.Curve = nil
+
+	return edgeUpdate, nil
+}
+
+// TopologySnapshot returns the current channel graph as a single
+// TopologyChange, holding an update for every known node and for every known
+// channel policy. Together with SubscribeTopology, it allows a client to mirror
+// the graph: subscribing first and then taking the snapshot ensures that no
+// change is missed, at the cost of possibly seeing some of them twice.
+func (r *ChannelRouter) TopologySnapshot() (*TopologyChange, er.R) {
+	snapshot := &TopologyChange{}
+	err := r.ForEachNode(func(node *channeldb.LightningNode) er.R {
+		// Nodes we only know from channel announcements have no
+		// attributes to report yet.
+		if !node.HaveNodeAnnouncement {
+			return nil
+		}
+		return addToTopologyChange(r.cfg.Graph, snapshot, node)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.ForEachChannel(func(edgeInfo *channeldb.ChannelEdgeInfo,
+		e1, e2 *channeldb.ChannelEdgePolicy) er.R {
+
+		// A channel is only announced to clients once one of its
+		// directions has a policy.
+		for _, policy := range []*channeldb.ChannelEdgePolicy{e1, e2} {
+			if policy == nil {
+				continue
+			}
+
+			edgeUpdate, err := newChannelEdgeUpdate(edgeInfo, policy)
+			if err != nil {
+				return err
+			}
+			snapshot.ChannelEdgeUpdates = append(
+				snapshot.ChannelEdgeUpdates, edgeUpdate,
+			)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return snapshot, nil
+}
+
 // EncodeHexColor takes a color and returns it in hex code format.
 func EncodeHexColor(color color.RGBA) string {
 	return fmt.Sprintf("#%02x%02x%02x", color.R, color.G, color.B)
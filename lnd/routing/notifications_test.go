@@ -953,3 +953,116 @@ func TestEncodeHexColor(t *testing.T) {
 		}
 	}
 }
+
+// TestTopologySnapshot asserts that the topology snapshot contains every
+// announced node and every known channel policy.
+func TestTopologySnapshot(t *testing.T) {
+	t.Parallel()
+
+	const startingBlockHeight = 101
+	ctx, cleanUp, err := createTestCtxSingleNode(startingBlockHeight)
+	defer cleanUp()
+	if err != nil {
+		t.Fatalf("unable to create router: %v", err)
+	}
+
+	const chanValue = 10000
+	fundingTx, chanPoint, chanID, err := createChannelEdge(ctx,
+		bitcoinKey1.SerializeCompressed(),
+		bitcoinKey2.SerializeCompressed(),
+		chanValue, startingBlockHeight)
+	if err != nil {
+		t.Fatalf("unable create channel edge: %v", err)
+	}
+	fundingBlock := &wire.MsgBlock{
+		Transactions: []*wire.MsgTx{fundingTx},
+	}
+	ctx.chain.addBlock(fundingBlock, chanID.BlockHeight, chanID.BlockHeight)
+
+	node1, err := createTestNode()
+	if err != nil {
+		t.Fatalf("unable to create test node: %v", err)
+	}
+	node2, err := createTestNode()
+	if err != nil {
+		t.Fatalf("unable to create test node: %v", err)
+	}
+
+	edge := &channeldb.ChannelEdgeInfo{
+		ChannelID:     chanID.ToUint64(),
+		NodeKey1Bytes: node1.PubKeyBytes,
+		NodeKey2Bytes: node2.PubKeyBytes,
+		AuthProof: &channeldb.ChannelAuthProof{
+			NodeSig1Bytes:    testSig.Serialize(),
+			NodeSig2Bytes:    testSig.Serialize(),
+			BitcoinSig1Bytes: testSig.Serialize(),
+			BitcoinSig2Bytes: testSig.Serialize(),
+		},
+	}
+	copy(edge.BitcoinKey1Bytes[:], bitcoinKey1.SerializeCompressed())
+	copy(edge.BitcoinKey2Bytes[:], bitcoinKey2.SerializeCompressed())
+	if err := ctx.router.AddEdge(edge); err != nil {
+		t.Fatalf("unable to add edge: %v", err)
+	}
+
+	// Only the first node announces itself, and only the first direction
+	// of the channel has a policy.
+	if err := ctx.router.AddNode(node1); err != nil {
+		t.Fatalf("unable to add node: %v", err)
+	}
+	policy := randEdgePolicy(chanID, node1)
+	policy.ChannelFlags = 0
+	if err := ctx.router.UpdateEdge(policy); err != nil {
+		t.Fatalf("unable to add edge update: %v", err)
+	}
+
+	snapshot, err := ctx.router.TopologySnapshot()
+	if err != nil {
+		t.Fatalf("unable to take snapshot: %v", err)
+	}
+
+	// We expect our own node and the first node, but not the second one as
+	// it never announced itself.
+	nodes := make(map[route.Vertex]*NetworkNodeUpdate)
+	for _, nodeUpdate := range snapshot.NodeUpdates {
+		nodes[route.NewVertex(nodeUpdate.IdentityKey)] = nodeUpdate
+	}
+	if len(nodes) != 2 {
+		t.Fatalf("expected 2 node updates, got %v", len(nodes))
+	}
+	if _, ok := nodes[ctx.router.selfNode.PubKeyBytes]; !ok {
+		t.Fatalf("self node missing from snapshot")
+	}
+	nodeUpdate, ok := nodes[node1.PubKeyBytes]
+	if !ok {
+		t.Fatalf("node1 missing from snapshot")
+	}
+	if nodeUpdate.Alias != node1.Alias {
+		t.Fatalf("node alias doesn't match: expected %v, got %v",
+			node1.Alias, nodeUpdate.Alias)
+	}
+
+	if len(snapshot.ChannelEdgeUpdates) != 1 {
+		t.Fatalf("expected 1 edge update, got %v",
+			len(snapshot.ChannelEdgeUpdates))
+	}
+	edgeUpdate := snapshot.ChannelEdgeUpdates[0]
+	if edgeUpdate.ChanID != chanID.ToUint64() ||
+		edgeUpdate.ChanPoint != *chanPoint ||
+		edgeUpdate.Capacity != chanValue {
+
+		t.Fatalf("unexpected edge update: %v", edgeUpdate)
+	}
+	if route.NewVertex(edgeUpdate.AdvertisingNode) != node1.PubKeyBytes ||
+		route.NewVertex(edgeUpdate.ConnectingNode) != node2.PubKeyBytes {
+
+		t.Fatalf("unexpected edge direction: %v", edgeUpdate)
+	}
+	if edgeUpdate.FeeRate != policy.FeeProportionalMillionths {
+		t.Fatalf("fee rate of edge doesn't match: expected %v, got %v",
+			policy.FeeProportionalMillionths, edgeUpdate.FeeRate)
+	}
+	if len(snapshot.ClosedChannels) != 0 {
+		t.Fatalf("expected no closed channels in snapshot")
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"math"
 	"time"
 
+	"github.com/pkt-cash/pktd/btcutil"
 	"github.com/pkt-cash/pktd/btcutil/er"
 	sphinx "github.com/pkt-cash/pktd/lightning-onion"
 	"github.com/pkt-cash/pktd/lnd/channeldb"
@@ -287,9 +288,10 @@ type graphParams struct {
 // found path must adhere to.
 type RestrictParams struct {
 	// ProbabilitySource is a callback that is expected to return the
-	// success probability of traversing the channel from the node.
+	// success probability of traversing the channel from the node. It is
+	// passed the capacity of the channel, which is zero if it is unknown.
 	ProbabilitySource func(route.Vertex, route.Vertex,
-		lnwire.MilliSatoshi) float64
+		lnwire.MilliSatoshi, btcutil.Amount) float64
 
 	// FeeLimit is a maximum fee amount allowed to be used on the path from
 	// the source to the target.
@@ -303,6 +305,10 @@ type RestrictParams struct {
 	// is reached. If nil, any node may be used.
 	LastHop *route.Vertex
 
+	// IncomingChannelID is the channel that must be taken for the last
+	// hop, into the target. If nil, any channel may be used.
+	IncomingChannelID *uint64
+
 	// CltvLimit is the maximum time lock of the route excluding the final
 	// ctlv. After path finding is complete, the caller needs to increase
 	// all cltv expiry heights with the required final cltv delta.
@@ -321,6 +327,17 @@ type RestrictParams struct {
 	// mitigate probing vectors and payment sniping attacks on overpaid
 	// invoices.
 	PaymentAddr *[32]byte
+
+	// ExcludedNodes is the set of nodes that may not be used as
+	// intermediate hops. If nil, any node may be used.
+	ExcludedNodes map[route.Vertex]struct{}
+
+	// TimePref expresses the preference for a reliable route over a cheap
+	// one, in the range [-1, 1]. Towards -1 the attempt cost shrinks and
+	// cheaper routes are chosen, towards 1 it grows and more probable
+	// routes are chosen. At 0, the default, the attempt cost of the path
+	// finding config is used as is.
+	TimePref float64
 }
 
 // PathFindingConfig defines global parameters that control the trade-off in
@@ -494,7 +511,7 @@ func findPath(g *graphParams, r *RestrictParams, cfg *PathFindingConfig,
 		// If there is only not enough capacity on a single route, it
 		// may still be possible to complete the payment by splitting.
 		if max < amt {
-			return nil, er.E(errNoPathFound)
+			return nil, ErrNoRouteFound.Default()
 		}
 	}
 
@@ -564,9 +581,15 @@ func findPath(g *graphParams, r *RestrictParams, cfg *PathFindingConfig,
 	absoluteCltvLimit := uint64(r.CltvLimit) + uint64(finalHtlcExpiry)
 
 	// Calculate the absolute attempt cost that is used for probability
-	// estimation.
-	absoluteAttemptCost := int64(cfg.AttemptCost) +
-		int64(amt)*cfg.AttemptCostPPM/1000000
+	// estimation, scaled by the time preference. The preference is damped
+	// to stay clear of the extremes, where the scale factor would be zero
+	// or infinite.
+	timePref := 0.9 * r.TimePref
+	absoluteAttemptCost := int64(
+		float64(int64(cfg.AttemptCost)+
+			int64(amt)*cfg.AttemptCostPPM/1000000) *
+			(1 + timePref) / (1 - timePref),
+	)
 
 	log.Debugf("Pathfinding absolute attempt cost: %v sats",
 		float64(absoluteAttemptCost)/1000)
@@ -575,16 +598,26 @@ func findPath(g *graphParams, r *RestrictParams, cfg *PathFindingConfig,
 	// satisfy our specific requirements.
 	processEdge := func(fromVertex route.Vertex,
 		fromFeatures *lnwire.FeatureVector,
-		edge *channeldb.ChannelEdgePolicy, toNodeDist *nodeWithDist) {
+		edge *channeldb.ChannelEdgePolicy, capacity btcutil.Amount,
+		toNodeDist *nodeWithDist) {
+
 		edgesExpanded++
 
+		// Never route through an excluded node. Our own node can't be
+		// excluded as it isn't forwarding.
+		if fromVertex != source {
+			if _, ok := r.ExcludedNodes[fromVertex]; ok {
+				return
+			}
+		}
+
 		// Calculate amount that the candidate node would have to send
 		// out.
 		amountToSend := toNodeDist.amountToReceive
 
 		// Request the success probability for this edge.
 		edgeProbability := r.ProbabilitySource(
-			fromVertex, toNodeDist.node, amountToSend,
+			fromVertex, toNodeDist.node, amountToSend, capacity,
 		)
 
 		log.Trace(log.C(func() string {
@@ -811,6 +844,11 @@ func findPath(g *graphParams, r *RestrictParams, cfg *PathFindingConfig,
 		// Create unified policies for all incoming connections.
 		u := newUnifiedPolicies(self, pivot, outgoingChanMap)
 
+		// Apply the incoming channel restriction to the last hop.
+		if pivot == target {
+			u.inChanRestr = r.IncomingChannelID
+		}
+
 		err := u.addGraphPolicies(g.graph)
 		if err != nil {
 			return nil, err
@@ -862,7 +900,10 @@ func findPath(g *graphParams, r *RestrictParams, cfg *PathFindingConfig,
 
 			// Check if this candidate node is better than what we
 			// already have.
-			processEdge(fromNode, fromFeatures, policy, partialPath)
+			processEdge(
+				fromNode, fromFeatures, policy,
+				unifiedPolicy.maxCapacity(), partialPath,
+			)
 		}
 
 		if nodeHeap.Len() == 0 {
@@ -890,7 +931,7 @@ func findPath(g *graphParams, r *RestrictParams, cfg *PathFindingConfig,
 		currentNodeWithDist, ok := distance[currentNode]
 		if !ok {
 			// If the node doesnt have a next hop it means we didn't find a path.
-			return nil, er.E(errNoPathFound)
+			return nil, ErrNoRouteFound.Default()
 		}
 
 		// Add the next hop to the list of path edges.
@@ -105,7 +105,9 @@ var (
 
 // noProbabilitySource is used in testing to return the same probability 1 for
 // all edges.
-func noProbabilitySource(route.Vertex, route.Vertex, lnwire.MilliSatoshi) float64 {
+func noProbabilitySource(route.Vertex, route.Vertex, lnwire.MilliSatoshi,
+	btcutil.Amount) float64 {
+
 	return 1
 }
 
@@ -2371,6 +2373,58 @@ func TestRestrictLastHop(t *testing.T) {
 	}
 }
 
+// TestExcludedNodes asserts that path finding doesn't route through excluded
+// nodes, and fails if there is no path left.
+func TestExcludedNodes(t *testing.T) {
+	t.Parallel()
+
+	// Set up a test graph with two possible paths from roasbeef to
+	// target. The path via channel 1 and 2 is the lowest cost path.
+	testChannels := []*testChannel{
+		symmetricTestChannel("source", "a", 100000, &testChannelPolicy{
+			Expiry: 144,
+		}, 1),
+		symmetricTestChannel("a", "target", 100000, &testChannelPolicy{
+			Expiry:  144,
+			FeeRate: 400,
+		}, 2),
+		symmetricTestChannel("source", "b", 100000, &testChannelPolicy{
+			Expiry: 144,
+		}, 3),
+		symmetricTestChannel("b", "target", 100000, &testChannelPolicy{
+			Expiry:  144,
+			FeeRate: 800,
+		}, 4),
+	}
+
+	ctx := newPathFindingTestContext(t, testChannels, "source")
+	defer ctx.cleanup()
+
+	paymentAmt := lnwire.NewMSatFromSatoshis(100)
+	target := ctx.keyFromAlias("target")
+
+	// Excluding a forces path finding to take the higher cost path via b.
+	ctx.restrictParams.ExcludedNodes = map[route.Vertex]struct{}{
+		ctx.keyFromAlias("a"): {},
+	}
+	path, err := ctx.findPath(target, paymentAmt)
+	if err != nil {
+		t.Fatalf("unable to find path: %v", err)
+	}
+	if path[0].ChannelID != 3 {
+		t.Fatalf("expected route to pass through channel 3, "+
+			"but channel %v was selected instead",
+			path[0].ChannelID)
+	}
+
+	// With b excluded as well, there is no path left.
+	ctx.restrictParams.ExcludedNodes[ctx.keyFromAlias("b")] = struct{}{}
+	_, err = ctx.findPath(target, paymentAmt)
+	if er.Wrapped(err) != errNoPathFound {
+		t.Fatalf("expected no path, got %v", err)
+	}
+}
+
 // TestCltvLimit asserts that a cltv limit is obeyed by the path finding
 // algorithm.
 func TestCltvLimit(t *testing.T) {
@@ -2465,6 +2519,7 @@ func TestProbabilityRouting(t *testing.T) {
 		name           string
 		p10, p11, p20  float64
 		minProbability float64
+		timePref       float64
 		expectedChan   uint64
 		amount         btcutil.Amount
 	}{
@@ -2539,6 +2594,32 @@ func TestProbabilityRouting(t *testing.T) {
 			expectedChan:   0,
 			amount:         100,
 		},
+
+		// A time preference of 0.5 scales the attempt cost of 10 by
+		// (1 + 0.45) / (1 - 0.45) to 26. The three hop distance becomes
+		// 13 + 26 / 0.4 = 78 and the two hop distance 25 + 26 / 0.7 =
+		// 62, so the more reliable two hop route is chosen.
+		{
+			name: "time pref reliable",
+			p10:  0.8, p11: 0.5, p20: 0.7,
+			minProbability: 0.1,
+			timePref:       0.5,
+			expectedChan:   20,
+			amount:         100,
+		},
+
+		// A time preference of -0.5 scales the attempt cost of 10 down
+		// to 3. The three hop distance becomes 13 + 3 / 0.4 = 20 and the
+		// two hop distance 25 + 3 / 0.85 = 28, so the cheaper three hop
+		// route is chosen.
+		{
+			name: "time pref cheap",
+			p10:  0.5, p11: 0.8, p20: 0.85,
+			minProbability: 0.1,
+			timePref:       -0.5,
+			expectedChan:   10,
+			amount:         100,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -2547,14 +2628,14 @@ func TestProbabilityRouting(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			testProbabilityRouting(
 				t, tc.amount, tc.p10, tc.p11, tc.p20,
-				tc.minProbability, tc.expectedChan,
+				tc.minProbability, tc.timePref, tc.expectedChan,
 			)
 		})
 	}
 }
 
 func testProbabilityRouting(t *testing.T, paymentAmt btcutil.Amount,
-	p10, p11, p20, minProbability float64, expectedChan uint64) {
+	p10, p11, p20, minProbability, timePref float64, expectedChan uint64) {
 	t.Parallel()
 
 	// Set up a test graph with two possible paths to the target: a three
@@ -2589,7 +2670,7 @@ func testProbabilityRouting(t *testing.T, paymentAmt btcutil.Amount,
 
 	// Configure a probability source with the test parameters.
 	ctx.restrictParams.ProbabilitySource = func(fromNode, toNode route.Vertex,
-		amt lnwire.MilliSatoshi) float64 {
+		amt lnwire.MilliSatoshi, capacity btcutil.Amount) float64 {
 		if amt == 0 {
 			t.Fatal("expected non-zero amount")
 		}
@@ -2611,6 +2692,7 @@ func testProbabilityRouting(t *testing.T, paymentAmt btcutil.Amount,
 		AttemptCostPPM: 10000,
 		MinProbability: minProbability,
 	}
+	ctx.restrictParams.TimePref = timePref
 
 	path, err := ctx.findPath(
 		target, lnwire.NewMSatFromSatoshis(paymentAmt),
@@ -2671,7 +2753,7 @@ func TestEqualCostRouteSelection(t *testing.T) {
 	target := ctx.testGraphInstance.aliasMap["target"]
 
 	ctx.restrictParams.ProbabilitySource = func(fromNode, toNode route.Vertex,
-		amt lnwire.MilliSatoshi) float64 {
+		amt lnwire.MilliSatoshi, capacity btcutil.Amount) float64 {
 		switch {
 		case fromNode == alias["source"] && toNode == alias["a"]:
 			return 0.25
@@ -2819,6 +2901,62 @@ func TestRouteToSelf(t *testing.T) {
 	ctx.assertPath(path, []uint64{1, 3, 2})
 }
 
+// TestRouteToSelfIncomingChannel tests that a circular route can be pinned to
+// both an outgoing and an incoming channel, even if a cheaper channel to the
+// same last hop exists.
+func TestRouteToSelfIncomingChannel(t *testing.T) {
+	t.Parallel()
+
+	testChannels := []*testChannel{
+		symmetricTestChannel("source", "a", 100000, &testChannelPolicy{
+			Expiry:      144,
+			FeeBaseMsat: 500,
+		}, 1),
+		symmetricTestChannel("source", "b", 100000, &testChannelPolicy{
+			Expiry:      144,
+			FeeBaseMsat: 1000,
+		}, 2),
+		symmetricTestChannel("source", "b", 100000, &testChannelPolicy{
+			Expiry:      144,
+			FeeBaseMsat: 2000,
+		}, 3),
+		symmetricTestChannel("a", "b", 100000, &testChannelPolicy{
+			Expiry:      144,
+			FeeBaseMsat: 1000,
+		}, 4),
+		symmetricTestChannel("source", "c", 100000, &testChannelPolicy{
+			Expiry:      144,
+			FeeBaseMsat: 1000,
+		}, 5),
+	}
+
+	ctx := newPathFindingTestContext(t, testChannels, "source")
+	defer ctx.cleanup()
+
+	paymentAmt := lnwire.NewMSatFromSatoshis(100)
+	target := ctx.source
+
+	// Go out via channel 1 and return through the more expensive channel
+	// 3 rather than channel 2.
+	incomingChanID := uint64(3)
+	ctx.restrictParams.OutgoingChannelIDs = []uint64{1}
+	ctx.restrictParams.IncomingChannelID = &incomingChanID
+
+	path, err := ctx.findPath(target, paymentAmt)
+	if err != nil {
+		t.Fatalf("unable to find path: %v", err)
+	}
+	ctx.assertPath(path, []uint64{1, 4, 3})
+
+	// Node c has no other channels, so there is no circular route
+	// returning through channel 5.
+	incomingChanID = 5
+	_, err = ctx.findPath(target, paymentAmt)
+	if er.Wrapped(err) != errNoPathFound {
+		t.Fatalf("not route error expected, but got %v", err)
+	}
+}
+
 type pathFindingTestContext struct {
 	t                 *testing.T
 	graph             *channeldb.ChannelGraph
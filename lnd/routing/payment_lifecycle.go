@@ -25,6 +25,15 @@ type paymentLifecycle struct {
 	paySession    PaymentSession
 	timeoutChan   <-chan time.Time
 	currentHeight int32
+
+	// maxInflightHtlcs caps the number of shards that may be in flight
+	// concurrently. A value of zero means no limit.
+	maxInflightHtlcs uint32
+
+	// maxAttempts caps the number of htlc attempts that are made for the
+	// payment, including the ones that failed. A value of zero means no
+	// limit.
+	maxAttempts uint32
 }
 
 // payemntState holds a number of key insights learned from a given MPPayment
@@ -156,7 +165,13 @@ func (p *paymentLifecycle) resumePayment() ([32]byte, *route.Route, er.R) {
 			}
 
 			// Payment failed.
-			return [32]byte{}, nil, er.E(*payment.FailureReason)
+			reason := *payment.FailureReason
+			if reason == channeldb.FailureReasonNoRoute {
+				return [32]byte{}, nil, ErrNoRouteFound.New(
+					"", er.E(reason),
+				)
+			}
+			return [32]byte{}, nil, er.E(reason)
 
 		// If we either reached a terminal error condition (but had
 		// active shards still) or there is no remaining value to send,
@@ -169,6 +184,17 @@ func (p *paymentLifecycle) resumePayment() ([32]byte, *route.Route, er.R) {
 				return [32]byte{}, nil, err
 			}
 			continue
+
+		// If we already have the maximum number of shards in flight,
+		// we'll wait for one of them to resolve before launching
+		// another.
+		case p.maxInflightHtlcs != 0 &&
+			uint32(state.numShardsInFlight) >= p.maxInflightHtlcs:
+
+			if err := shardHandler.waitForShard(); err != nil {
+				return [32]byte{}, nil, err
+			}
+			continue
 		}
 
 		// Before we attempt any new shard, we'll check to see if
@@ -201,6 +227,26 @@ func (p *paymentLifecycle) resumePayment() ([32]byte, *route.Route, er.R) {
 		default:
 		}
 
+		// Before we make another attempt, we'll check that the payment
+		// hasn't used up all of its attempts. Like a timeout, this
+		// stops the payment the moment all active shards have
+		// finished.
+		if p.maxAttempts != 0 &&
+			uint32(len(payment.HTLCs)) >= p.maxAttempts {
+
+			log.Warnf("Payment %v reached its limit of %v attempts",
+				p.paymentHash, p.maxAttempts)
+
+			saveErr := p.router.cfg.Control.Fail(
+				p.paymentHash, channeldb.FailureReasonAttemptLimit,
+			)
+			if saveErr != nil {
+				return [32]byte{}, nil, saveErr
+			}
+
+			continue
+		}
+
 		// Create a new payment attempt from the given payment session.
 		rt, err := p.paySession.RequestRoute(
 			state.remainingAmt, state.remainingFees,
@@ -245,7 +291,26 @@ func (p *paymentLifecycle) resumePayment() ([32]byte, *route.Route, er.R) {
 
 		// We found a route to try, launch a new shard.
 		attempt, outcome, err := shardHandler.launchShard(rt)
-		if err != nil {
+		switch {
+		// The control tower refused to register the attempt, because
+		// its fees would take the fees of all attempts above the
+		// maximum total fee of the payment. We mark the payment failed
+		// and return the moment all active shards have finished.
+		case channeldb.ErrFeeCapExceeded.Is(err):
+			log.Warnf("Attempt for payment %v would exceed the "+
+				"maximum total fee", p.paymentHash)
+
+			saveErr := p.router.cfg.Control.Fail(
+				p.paymentHash,
+				channeldb.FailureReasonFeeCapExceeded,
+			)
+			if saveErr != nil {
+				return [32]byte{}, nil, saveErr
+			}
+
+			continue
+
+		case err != nil:
 			return [32]byte{}, nil, err
 		}
 
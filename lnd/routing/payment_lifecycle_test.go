@@ -102,6 +102,18 @@ func TestRouterPaymentStateMachine(t *testing.T) {
 		// routes is the sequence of routes we will provide to the
 		// router when it requests a new route.
 		routes []*route.Route
+
+		// maxAttempts is the attempt limit of the payment, zero means
+		// no limit.
+		maxAttempts uint32
+
+		// timeout is the timeout of the payment, zero means no
+		// timeout.
+		timeout time.Duration
+
+		// maxTotalFee is the maximum of the fees of all attempts of
+		// the payment, zero means no maximum.
+		maxTotalFee lnwire.MilliSatoshi
 	}
 
 	const (
@@ -127,6 +139,25 @@ func TestRouterPaymentStateMachine(t *testing.T) {
 		// to call the Fail method on the control tower.
 		routerFailPayment = "Router:fail-payment"
 
+		// routerFailPaymentAttemptLimit is a test step where we expect
+		// the router to call the Fail method on the control tower,
+		// because the payment used up its attempts.
+		routerFailPaymentAttemptLimit = "Router:fail-payment-attempt-limit"
+
+		// routerFailPaymentTimeout is a test step where we expect the
+		// router to call the Fail method on the control tower, because
+		// the payment timed out.
+		routerFailPaymentTimeout = "Router:fail-payment-timeout"
+
+		// routerFailPaymentFeeCap is a test step where we expect the
+		// router to call the Fail method on the control tower, because
+		// another attempt would exceed the maximum total fee.
+		routerFailPaymentFeeCap = "Router:fail-payment-fee-cap"
+
+		// waitPaymentTimeout is a test step where we wait until the
+		// timeout of the payment has passed.
+		waitPaymentTimeout = "WaitPaymentTimeout"
+
 		// sendToSwitchSuccess is a step where we expect the router to
 		// call send the payment attempt to the switch, and we will
 		// respond with a non-error, indicating that the payment
@@ -550,6 +581,68 @@ func TestRouterPaymentStateMachine(t *testing.T) {
 				shard, shard, shard, shard, shard, shard,
 			},
 		},
+		{
+			// A payment that fails on its first two attempts and
+			// then used up its attempts, although there are more
+			// routes to try.
+			steps: []string{
+				routerInitPayment,
+				routerRegisterAttempt,
+				sendToSwitchSuccess,
+				getPaymentResultTempFailure,
+				routerFailAttempt,
+
+				routerRegisterAttempt,
+				sendToSwitchSuccess,
+				getPaymentResultTempFailure,
+				routerFailAttempt,
+
+				// No third attempt is made.
+				routerFailPaymentAttemptLimit,
+				paymentError,
+			},
+			routes:      []*route.Route{rt, rt, rt},
+			maxAttempts: 2,
+		},
+		{
+			// A payment whose first attempt is still in flight when
+			// its timeout passes. Once the attempt fails, no further
+			// attempt is made, although there are more routes to
+			// try.
+			steps: []string{
+				routerInitPayment,
+				routerRegisterAttempt,
+				sendToSwitchSuccess,
+
+				waitPaymentTimeout,
+				getPaymentResultTempFailure,
+				routerFailAttempt,
+
+				routerFailPaymentTimeout,
+				paymentError,
+			},
+			routes:  []*route.Route{rt, rt},
+			timeout: 100 * time.Millisecond,
+		},
+		{
+			// A payment whose second attempt is refused by the
+			// control tower, because the fees of both attempts
+			// would exceed the maximum total fee of the payment.
+			steps: []string{
+				routerInitPayment,
+				routerRegisterAttempt,
+				sendToSwitchSuccess,
+				getPaymentResultTempFailure,
+				routerFailAttempt,
+
+				// The second attempt isn't sent.
+				routerRegisterAttempt,
+				routerFailPaymentFeeCap,
+				paymentError,
+			},
+			routes:      []*route.Route{rt, rt},
+			maxTotalFee: rt.TotalFees() + rt.TotalFees()/2,
+		},
 	}
 
 	// Create a mock control tower with channels set up, that we use to
@@ -654,10 +747,13 @@ func TestRouterPaymentStateMachine(t *testing.T) {
 		payHash := preImage.Hash()
 
 		payment := LightningPayment{
-			Target:      testGraph.aliasMap["c"],
-			Amount:      paymentAmt,
-			FeeLimit:    noFeeLimit,
-			PaymentHash: payHash,
+			Target:            testGraph.aliasMap["c"],
+			Amount:            paymentAmt,
+			FeeLimit:          noFeeLimit,
+			PaymentHash:       payHash,
+			MaxAttempts:       test.maxAttempts,
+			PayAttemptTimeout: test.timeout,
+			MaxTotalFee:       test.maxTotalFee,
 		}
 
 		router.cfg.SessionSource = &mockPaymentSessionSource{
@@ -737,6 +833,37 @@ func TestRouterPaymentStateMachine(t *testing.T) {
 						"registered with control")
 				}
 
+			// In this step we expect the router to call the
+			// ControlTower's Fail method with the given reason.
+			case routerFailPaymentAttemptLimit,
+				routerFailPaymentTimeout, routerFailPaymentFeeCap:
+
+				expReason := channeldb.FailureReasonAttemptLimit
+				switch step {
+				case routerFailPaymentTimeout:
+					expReason = channeldb.FailureReasonTimeout
+				case routerFailPaymentFeeCap:
+					expReason = channeldb.FailureReasonFeeCapExceeded
+				}
+
+				select {
+				case args := <-control.failPayment:
+					if args.reason != expReason {
+						t.Fatalf("expected failure "+
+							"reason %v, got %v",
+							expReason, args.reason)
+					}
+
+				case <-time.After(stepTimeout):
+					t.Fatalf("payment fail not " +
+						"registered with control")
+				}
+
+			// In this step we wait for the payment timeout to
+			// pass.
+			case waitPaymentTimeout:
+				time.Sleep(2 * test.timeout)
+
 			// In this step we expect the SendToSwitch method to be
 			// called, and we respond with a nil-error.
 			case sendToSwitchSuccess:
@@ -893,3 +1020,185 @@ func TestRouterPaymentStateMachine(t *testing.T) {
 		}
 	}
 }
+
+// TestRouterResumePayment tests that a payment which is resumed on startup is
+// still subject to the limits it was sent with.
+func TestRouterResumePayment(t *testing.T) {
+	t.Parallel()
+
+	const startingBlockHeight = 101
+
+	chanCapSat := btcutil.Amount(100000)
+	testChannels := []*testChannel{
+		symmetricTestChannel("a", "b", chanCapSat, &testChannelPolicy{
+			Expiry:  144,
+			FeeRate: 400,
+			MinHTLC: 1,
+			MaxHTLC: lnwire.NewMSatFromSatoshis(chanCapSat),
+		}, 1),
+		symmetricTestChannel("b", "c", chanCapSat, &testChannelPolicy{
+			Expiry:  144,
+			FeeRate: 400,
+			MinHTLC: 1,
+			MaxHTLC: lnwire.NewMSatFromSatoshis(chanCapSat),
+		}, 2),
+	}
+
+	testGraph, err := createTestGraphFromChannels(testChannels, "a")
+	if err != nil {
+		t.Fatalf("unable to create graph: %v", err)
+	}
+	defer testGraph.cleanUp()
+
+	paymentAmt := lnwire.NewMSatFromSatoshis(1000)
+	shard, err := createTestRoute(paymentAmt/4, testGraph.aliasMap)
+	if err != nil {
+		t.Fatalf("unable to create route: %v", err)
+	}
+
+	tests := []struct {
+		name string
+
+		// maxInflightHtlcs is the in-flight htlc limit the payment was
+		// sent with.
+		maxInflightHtlcs uint32
+
+		// maxAttempts is the attempt limit the payment was sent with.
+		maxAttempts uint32
+
+		// attemptLimit is set if the resumed payment is expected to
+		// fail for having used up its attempts.
+		attemptLimit bool
+	}{{
+		// A payment with one of its shards in flight has reached its
+		// in-flight htlc limit, so after the restart it must wait for
+		// the shard rather than look for a route for the remainder.
+		name:             "in-flight htlc limit",
+		maxInflightHtlcs: 1,
+	}, {
+		// A payment which has used up its attempts must fail after the
+		// restart rather than look for a route for the remainder.
+		name:         "attempt limit",
+		maxAttempts:  1,
+		attemptLimit: true,
+	}}
+	for _, test := range tests {
+		var paymentHash lntypes.Hash
+		if _, err := rand.Read(paymentHash[:]); err != nil {
+			t.Fatal(err)
+		}
+
+		control := makeMockControlTower()
+		control.settleAttempt = make(chan settleAttemptArgs, 20)
+		control.failPayment = make(chan failPaymentArgs, 20)
+
+		// Seed the control tower with a payment that has a shard in
+		// flight, as it would be found after a restart.
+		err := control.InitPayment(paymentHash, &channeldb.PaymentCreationInfo{
+			PaymentHash:      paymentHash,
+			Value:            paymentAmt,
+			MaxInflightHtlcs: test.maxInflightHtlcs,
+			MaxAttempts:      test.maxAttempts,
+		})
+		if err != nil {
+			t.Fatalf("%s: unable to init payment: %v", test.name, err)
+		}
+		err = control.RegisterAttempt(paymentHash, &channeldb.HTLCAttemptInfo{
+			AttemptID:  atomic.AddUint64(&uniquePaymentID, 1),
+			SessionKey: priv,
+			Route:      *shard,
+		})
+		if err != nil {
+			t.Fatalf("%s: unable to register attempt: %v", test.name,
+				err)
+		}
+
+		paymentResult := make(chan *htlcswitch.PaymentResult, 1)
+		quit := make(chan struct{})
+		sessionSource := &mockPaymentSessionSource{
+			emptyRequests: make(chan struct{}, 20),
+		}
+
+		chain := newMockChain(startingBlockHeight)
+		router, err := New(Config{
+			Graph:          testGraph.graph,
+			Chain:          chain,
+			ChainView:      newMockChainView(chain),
+			Control:        control,
+			SessionSource:  sessionSource,
+			MissionControl: &mockMissionControl{},
+			Payer: &mockPayer{
+				sendResult:       make(chan er.R),
+				paymentResult:    paymentResult,
+				paymentResultErr: make(chan er.R),
+				quit:             quit,
+			},
+			ChannelPruneExpiry: time.Hour * 24,
+			GraphPruneInterval: time.Hour * 2,
+			QueryBandwidth: func(e *channeldb.ChannelEdgeInfo) lnwire.MilliSatoshi {
+				return lnwire.NewMSatFromSatoshis(e.Capacity)
+			},
+			NextPaymentID: func() (uint64, er.R) {
+				next := atomic.AddUint64(&uniquePaymentID, 1)
+				return next, nil
+			},
+			Clock: clock.NewTestClock(time.Unix(1, 0)),
+		})
+		if err != nil {
+			t.Fatalf("%s: unable to create router: %v", test.name, err)
+		}
+		if err := router.Start(); err != nil {
+			t.Fatalf("%s: unable to start router: %v", test.name, err)
+		}
+
+		if test.attemptLimit {
+			select {
+			case args := <-control.failPayment:
+				if args.reason != channeldb.FailureReasonAttemptLimit {
+					t.Fatalf("%s: expected failure reason %v, "+
+						"got %v", test.name,
+						channeldb.FailureReasonAttemptLimit,
+						args.reason)
+				}
+			case <-sessionSource.emptyRequests:
+				t.Fatalf("%s: route requested for resumed "+
+					"payment", test.name)
+			case <-time.After(stepTimeout):
+				t.Fatalf("%s: resumed payment not failed",
+					test.name)
+			}
+		}
+
+		// Give the resumed payment a chance to ask for a route before
+		// the shard settles.
+		select {
+		case <-sessionSource.emptyRequests:
+			t.Fatalf("%s: route requested for resumed payment",
+				test.name)
+		case <-time.After(100 * time.Millisecond):
+		}
+
+		var preimage lntypes.Preimage
+		paymentResult <- &htlcswitch.PaymentResult{Preimage: preimage}
+
+		select {
+		case <-control.settleAttempt:
+		case <-control.failPayment:
+			t.Fatalf("%s: resumed payment failed", test.name)
+		case <-time.After(stepTimeout):
+			t.Fatalf("%s: shard of resumed payment not settled",
+				test.name)
+		}
+
+		close(quit)
+		if err := router.Stop(); err != nil {
+			t.Fatalf("%s: unable to stop router: %v", test.name, err)
+		}
+		select {
+		case <-sessionSource.emptyRequests:
+			t.Fatalf("%s: route requested for resumed payment",
+				test.name)
+		default:
+		}
+	}
+}
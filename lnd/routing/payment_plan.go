@@ -0,0 +1,110 @@
+package routing
+
+import (
+	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/lnd/channeldb"
+	"github.com/pkt-cash/pktd/lnd/lnwire"
+	"github.com/pkt-cash/pktd/lnd/routing/route"
+	"github.com/pkt-cash/pktd/pktlog/log"
+)
+
+// PaymentPlan describes the shards a payment would be split into if it were
+// sent right away, so that its cost can be confirmed before sending it.
+type PaymentPlan struct {
+	// Routes are the routes of the planned shards, in the order they were
+	// found.
+	Routes []*route.Route
+
+	// Amount is the total amount the shards deliver to the receiver. It is
+	// less than the payment amount if the plan is incomplete.
+	Amount lnwire.MilliSatoshi
+
+	// TotalFees is the sum of the fees of all shards.
+	TotalFees lnwire.MilliSatoshi
+
+	// TotalTimeLock is the largest time lock of the shards, which is the
+	// height until which the funds of the payment may be locked up.
+	TotalTimeLock uint32
+
+	// FailureReason is set if the full amount couldn't be split into
+	// shards within the constraints of the payment.
+	FailureReason *channeldb.FailureReason
+}
+
+// Complete returns whether the shards of the plan deliver the full amount of
+// the payment.
+func (p *PaymentPlan) Complete() bool {
+	return p.FailureReason == nil
+}
+
+// PlanPayment runs path finding and splitting for the passed payment the same
+// way SendPayment does, but returns the resulting shards instead of sending
+// them. Nothing is recorded with the control tower, so the payment can be sent
+// for real afterwards. Because no HTLCs are sent, the local bandwidth of the
+// earlier shards is reserved in the payment session instead. The plan is
+// only an estimate, a real payment learns about failures along the way and
+// may end up with different shards.
+func (r *ChannelRouter) PlanPayment(payment *LightningPayment) (*PaymentPlan,
+	er.R) {
+
+	paySession, err := r.cfg.SessionSource.NewPaymentSession(payment)
+	if err != nil {
+		return nil, err
+	}
+
+	// We'll also fetch the current block height so we can properly
+	// calculate the required HTLC time locks within the routes.
+	_, currentHeight, err := r.cfg.Chain.GetBestBlock()
+	if err != nil {
+		return nil, err
+	}
+
+	log.Tracef("Planning payment: %v", spewPayment(payment))
+
+	return planPayment(payment, paySession, uint32(currentHeight))
+}
+
+// planPayment requests routes from the payment session until they carry the
+// full amount of the payment or no more routes can be found.
+func planPayment(payment *LightningPayment, paySession PaymentSession,
+	height uint32) (*PaymentPlan, er.R) {
+
+	reserver, _ := paySession.(bandwidthReserver)
+
+	plan := &PaymentPlan{}
+	for plan.Amount < payment.Amount {
+		rt, err := paySession.RequestRoute(
+			payment.Amount-plan.Amount,
+			payment.FeeLimit-plan.TotalFees,
+			uint32(len(plan.Routes)), height,
+		)
+		if err != nil {
+			routeErr, ok := er.Wrapped(err).(noRouteError)
+			if !ok {
+				return nil, err
+			}
+
+			log.Debugf("Payment plan incomplete after %v shards: %v",
+				len(plan.Routes), err)
+
+			reason := routeErr.FailureReason()
+			plan.FailureReason = &reason
+			break
+		}
+
+		plan.Routes = append(plan.Routes, rt)
+		plan.Amount += rt.ReceiverAmt()
+		plan.TotalFees += rt.TotalFees()
+		if rt.TotalTimeLock > plan.TotalTimeLock {
+			plan.TotalTimeLock = rt.TotalTimeLock
+		}
+
+		// The shard isn't sent out, so its local bandwidth must be set
+		// aside for the following shards.
+		if reserver != nil {
+			reserver.reserveBandwidth(rt)
+		}
+	}
+
+	return plan, nil
+}
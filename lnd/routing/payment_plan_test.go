@@ -0,0 +1,174 @@
+package routing
+
+import (
+	"testing"
+
+	"github.com/pkt-cash/pktd/btcutil"
+	"github.com/pkt-cash/pktd/lnd/channeldb"
+	"github.com/pkt-cash/pktd/lnd/lnwire"
+)
+
+// TestPlanPayment tests that a payment plan splits the payment the way it
+// would be sent, reserving the local bandwidth of the planned shards, and that
+// it notes when the full amount can't be split within the constraints.
+func TestPlanPayment(t *testing.T) {
+	const (
+		im1NodeID = 3
+		im2NodeID = 4
+		height    = 100
+	)
+
+	testCases := []struct {
+		name string
+
+		amt      btcutil.Amount
+		maxParts uint32
+
+		// im2Balance is the local balance of the channel to
+		// intermediate2, if it is not left at its default.
+		im2Balance btcutil.Amount
+
+		expectedShards []expectedHtlcSuccess
+		expectedFees   lnwire.MilliSatoshi
+		expectedReason *channeldb.FailureReason
+	}{
+		{
+			// The full amount doesn't fit in any of the local
+			// channels, so it is split in halves. Both halves
+			// would take the cheaper route if the bandwidth of the
+			// first one wasn't reserved.
+			name:     "split",
+			amt:      150000,
+			maxParts: 10,
+			expectedShards: []expectedHtlcSuccess{
+				{
+					amt:   75001,
+					chans: []uint64{chanSourceIm1, chanIm1Target},
+				},
+				{
+					amt:   75002,
+					chans: []uint64{chanSourceIm2, chanIm2Target},
+				},
+			},
+			expectedFees: 3000,
+		},
+		{
+			name:           "no splitting",
+			amt:            150000,
+			maxParts:       1,
+			expectedReason: reasonPtr(channeldb.FailureReasonNoRoute),
+		},
+		{
+			name:       "shard limit reached",
+			amt:        140000,
+			maxParts:   2,
+			im2Balance: 50000,
+			expectedShards: []expectedHtlcSuccess{
+				{
+					amt:   70001,
+					chans: []uint64{chanSourceIm1, chanIm1Target},
+				},
+			},
+			expectedFees:   1000,
+			expectedReason: reasonPtr(channeldb.FailureReasonNoRoute),
+		},
+		{
+			name:     "insufficient total balance",
+			amt:      300000,
+			maxParts: 10,
+			expectedReason: reasonPtr(
+				channeldb.FailureReasonInsufficientBalance,
+			),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			ctx := newIntegratedRoutingContext(t)
+			g := ctx.graph
+			twoPathGraph(g, 200000, 200000)
+			g.nodes[createPubkey(im1NodeID)].baseFee = 1000
+			g.nodes[createPubkey(im2NodeID)].baseFee = 2000
+			if testCase.im2Balance != 0 {
+				g.source.channels[createPubkey(im2NodeID)].balance =
+					lnwire.NewMSatFromSatoshis(
+						testCase.im2Balance,
+					)
+			}
+
+			ctx.amt = lnwire.NewMSatFromSatoshis(testCase.amt)
+
+			mc, cleanup := ctx.newMissionControl()
+			defer cleanup()
+
+			payment := ctx.newPayment(testCase.maxParts)
+			session := ctx.newPaymentSession(payment, mc)
+
+			plan, err := planPayment(payment, session, height)
+			if err != nil {
+				t.Fatalf("unable to plan payment: %v", err)
+			}
+
+			var attempts []htlcAttempt
+			var amt lnwire.MilliSatoshi
+			for _, rt := range plan.Routes {
+				attempts = append(attempts, htlcAttempt{
+					route:   rt,
+					success: true,
+				})
+				amt += rt.ReceiverAmt()
+
+				expiry := uint32(height+ctx.finalExpiry) +
+					uint32(BlockPadding)
+				if rt.TotalTimeLock != expiry {
+					t.Fatalf("expected time lock %v, got %v",
+						expiry, rt.TotalTimeLock)
+				}
+				if plan.TotalTimeLock != expiry {
+					t.Fatalf("expected total time lock %v, "+
+						"got %v", expiry,
+						plan.TotalTimeLock)
+				}
+			}
+			assertSuccessAttempts(
+				t, attempts, testCase.expectedShards,
+			)
+
+			if plan.Amount != amt {
+				t.Fatalf("expected amount %v, got %v", amt,
+					plan.Amount)
+			}
+			if plan.TotalFees != testCase.expectedFees {
+				t.Fatalf("expected fees %v, got %v",
+					testCase.expectedFees, plan.TotalFees)
+			}
+
+			switch {
+			case testCase.expectedReason == nil && !plan.Complete():
+				t.Fatalf("expected a complete plan, got "+
+					"failure reason %v", *plan.FailureReason)
+
+			case testCase.expectedReason == nil:
+				if plan.Amount != ctx.amt {
+					t.Fatalf("expected amount %v, got %v",
+						ctx.amt, plan.Amount)
+				}
+
+			case plan.Complete():
+				t.Fatalf("expected failure reason %v",
+					*testCase.expectedReason)
+
+			case *plan.FailureReason != *testCase.expectedReason:
+				t.Fatalf("expected failure reason %v, got %v",
+					*testCase.expectedReason,
+					*plan.FailureReason)
+			}
+		})
+	}
+}
+
+func reasonPtr(reason channeldb.FailureReason) *channeldb.FailureReason {
+	return &reason
+}
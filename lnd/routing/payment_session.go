@@ -5,6 +5,7 @@ import (
 	"github.com/pkt-cash/pktd/lnd/channeldb"
 	"github.com/pkt-cash/pktd/lnd/lnwire"
 	"github.com/pkt-cash/pktd/lnd/routing/route"
+	"github.com/pkt-cash/pktd/lnd/zpay32"
 	"github.com/pkt-cash/pktd/pktlog/log"
 )
 
@@ -127,7 +128,10 @@ type PaymentSession interface {
 // loop if payment attempts take long enough. An additional set of edges can
 // also be provided to assist in reaching the payment's destination.
 type paymentSession struct {
-	additionalEdges map[route.Vertex][]*channeldb.ChannelEdgePolicy
+	// hintSets are the sets of additional edges path finding is attempted
+	// with, in order of preference. Unless the payment pins specific route
+	// hints, this holds a single set built from all of its route hints.
+	hintSets []routeHintSet
 
 	getBandwidthHints func() (map[uint64]lnwire.MilliSatoshi, er.R)
 
@@ -145,11 +149,85 @@ type paymentSession struct {
 
 	missionControl MissionController
 
+	// excludedNodes is the set of nodes the payment may not be routed
+	// through.
+	excludedNodes map[route.Vertex]struct{}
+
 	// minShardAmt is the amount beyond which we won't try to further split
 	// the payment if no route is found. If the maximum number of htlcs
 	// specified in the payment is one, under no circumstances splitting
 	// will happen and this value remains unused.
 	minShardAmt lnwire.MilliSatoshi
+
+	// reservedBandwidth is the local bandwidth per outgoing channel that
+	// is set aside for shards which have been planned but not dispatched.
+	// It is taken off the bandwidth hints before path finding.
+	reservedBandwidth map[uint64]lnwire.MilliSatoshi
+}
+
+// bandwidthReserver is implemented by payment sessions which can set aside the
+// local bandwidth of a route that isn't sent out. This keeps the shards of a
+// payment plan from all counting on the same local balance.
+type bandwidthReserver interface {
+	// reserveBandwidth sets aside the amount the route takes up in its
+	// first hop for the following path finding attempts.
+	reserveBandwidth(rt *route.Route)
+}
+
+// routeHintSet is a set of additional edges derived from route hints, along
+// with the last hop restriction that forces path finding through them.
+type routeHintSet struct {
+	edges map[route.Vertex][]*channeldb.ChannelEdgePolicy
+
+	lastHop *route.Vertex
+}
+
+// newRouteHintSets builds the hint sets to try for the given payment. Each of
+// the payment's preferred route hints gets a set of its own, restricting the
+// last hop to the hint's final node so that the route is pinned to the hint.
+// Unless the preference is strict, a final set containing all route hints is
+// added as fallback.
+func newRouteHintSets(p *LightningPayment) ([]routeHintSet, er.R) {
+	var sets []routeHintSet
+	for _, idx := range p.PreferredRouteHints {
+		if idx < 0 || idx >= len(p.RouteHints) {
+			return nil, er.Errorf("preferred route hint %v out "+
+				"of range", idx)
+		}
+
+		hint := p.RouteHints[idx]
+		if len(hint) == 0 {
+			return nil, er.Errorf("preferred route hint %v is "+
+				"empty", idx)
+		}
+
+		edges, err := RouteHintsToEdges(
+			[][]zpay32.HopHint{hint}, p.Target,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		lastHop := route.NewVertex(hint[len(hint)-1].NodeID)
+		sets = append(sets, routeHintSet{
+			edges:   edges,
+			lastHop: &lastHop,
+		})
+	}
+
+	if len(sets) > 0 && p.StrictRouteHints {
+		return sets, nil
+	}
+
+	edges, err := RouteHintsToEdges(p.RouteHints, p.Target)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(sets, routeHintSet{
+		edges:   edges,
+		lastHop: p.LastHop,
+	}), nil
 }
 
 // newPaymentSession instantiates a new payment session.
@@ -158,13 +236,13 @@ func newPaymentSession(p *LightningPayment,
 	getRoutingGraph func() (routingGraph, func(), er.R),
 	missionControl MissionController, pathFindingConfig PathFindingConfig) (
 	*paymentSession, er.R) {
-	edges, err := RouteHintsToEdges(p.RouteHints, p.Target)
+	hintSets, err := newRouteHintSets(p)
 	if err != nil {
 		return nil, err
 	}
 
 	return &paymentSession{
-		additionalEdges:   edges,
+		hintSets:          hintSets,
 		getBandwidthHints: getBandwidthHints,
 		payment:           p,
 		pathFinder:        findPath,
@@ -209,11 +287,12 @@ func (p *paymentSession) RequestRoute(maxAmt, feeLimit lnwire.MilliSatoshi,
 		ProbabilitySource:  p.missionControl.GetProbability,
 		FeeLimit:           feeLimit,
 		OutgoingChannelIDs: p.payment.OutgoingChannelIDs,
-		LastHop:            p.payment.LastHop,
+		IncomingChannelID:  p.payment.IncomingChannelID,
 		CltvLimit:          cltvLimit,
 		DestCustomRecords:  p.payment.DestCustomRecords,
 		DestFeatures:       p.payment.DestFeatures,
 		PaymentAddr:        p.payment.PaymentAddr,
+		ExcludedNodes:      p.excludedNodes,
 	}
 
 	finalHtlcExpiry := int32(height) + int32(finalCltvDelta)
@@ -230,6 +309,21 @@ func (p *paymentSession) RequestRoute(maxAmt, feeLimit lnwire.MilliSatoshi,
 			return nil, err
 		}
 
+		// Take off the bandwidth that is reserved for shards which
+		// haven't been dispatched.
+		for chanID, amt := range p.reservedBandwidth {
+			bandwidth, ok := bandwidthHints[chanID]
+			if !ok {
+				continue
+			}
+			if bandwidth > amt {
+				bandwidth -= amt
+			} else {
+				bandwidth = 0
+			}
+			bandwidthHints[chanID] = bandwidth
+		}
+
 		log.Debugf("pathfinding for amt=%v", maxAmt)
 
 		// Get a routing graph.
@@ -240,17 +334,31 @@ func (p *paymentSession) RequestRoute(maxAmt, feeLimit lnwire.MilliSatoshi,
 
 		sourceVertex := routingGraph.sourceNode()
 
-		// Find a route for the current amount.
-		path, err := p.pathFinder(
-			&graphParams{
-				additionalEdges: p.additionalEdges,
-				bandwidthHints:  bandwidthHints,
-				graph:           routingGraph,
-			},
-			restrictions, &p.pathFindingConfig,
-			sourceVertex, p.payment.Target,
-			maxAmt, finalHtlcExpiry,
-		)
+		// Find a route for the current amount, trying each set of route
+		// hints in order of preference until a path is found.
+		var path []*channeldb.ChannelEdgePolicy
+		for i, hintSet := range p.hintSets {
+			restrictions.LastHop = hintSet.lastHop
+
+			path, err = p.pathFinder(
+				&graphParams{
+					additionalEdges: hintSet.edges,
+					bandwidthHints:  bandwidthHints,
+					graph:           routingGraph,
+				},
+				restrictions, &p.pathFindingConfig,
+				sourceVertex, p.payment.Target,
+				maxAmt, finalHtlcExpiry,
+			)
+			if er.Wrapped(err) != errNoPathFound ||
+				i == len(p.hintSets)-1 {
+
+				break
+			}
+
+			log.Debugf("no path found using route hint set %v, "+
+				"trying next", i)
+		}
 
 		// Close routing graph.
 		cleanup()
@@ -264,7 +372,7 @@ func (p *paymentSession) RequestRoute(maxAmt, feeLimit lnwire.MilliSatoshi,
 				log.Debugf("not splitting because payment " +
 					"address is unspecified")
 
-				return nil, er.E(errNoPathFound)
+				return nil, ErrNoRouteFound.Default()
 			}
 
 			// No splitting if this is the last shard.
@@ -274,7 +382,7 @@ func (p *paymentSession) RequestRoute(maxAmt, feeLimit lnwire.MilliSatoshi,
 					"limit %v has been reached",
 					p.payment.MaxParts)
 
-				return nil, er.E(errNoPathFound)
+				return nil, ErrNoRouteFound.Default()
 			}
 
 			// This is where the magic happens. If we can't find a
@@ -287,7 +395,7 @@ func (p *paymentSession) RequestRoute(maxAmt, feeLimit lnwire.MilliSatoshi,
 					"shard amount %v has been reached",
 					p.minShardAmt)
 
-				return nil, er.E(errNoPathFound)
+				return nil, ErrNoRouteFound.Default()
 			}
 
 			// Go pathfinding.
@@ -327,3 +435,18 @@ func (p *paymentSession) RequestRoute(maxAmt, feeLimit lnwire.MilliSatoshi,
 		return route, err
 	}
 }
+
+// reserveBandwidth sets aside the amount the route takes up in its first hop,
+// so that the following path finding attempts of the session don't count on
+// it.
+//
+// NOTE: Part of the bandwidthReserver interface.
+func (p *paymentSession) reserveBandwidth(rt *route.Route) {
+	if len(rt.Hops) == 0 {
+		return
+	}
+	if p.reservedBandwidth == nil {
+		p.reservedBandwidth = make(map[uint64]lnwire.MilliSatoshi)
+	}
+	p.reservedBandwidth[rt.Hops[0].ChannelID] += rt.TotalAmount
+}
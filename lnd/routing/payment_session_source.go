@@ -1,6 +1,9 @@
 package routing
 
 import (
+	"bytes"
+	"fmt"
+
 	"github.com/pkt-cash/pktd/btcec"
 	"github.com/pkt-cash/pktd/btcutil/er"
 	"github.com/pkt-cash/pktd/lnd/channeldb"
@@ -40,6 +43,11 @@ type SessionSource struct {
 	// PathFindingConfig defines global parameters that control the
 	// trade-off in path finding between fees and probabiity.
 	PathFindingConfig PathFindingConfig
+
+	// ExcludedNodes is the persistent set of nodes that payments are never
+	// routed through. If nil, only the nodes excluded by the payment
+	// itself are avoided.
+	ExcludedNodes *NodeExclusions
 }
 
 // getRoutingGraph returns a routing graph and a clean-up function for
@@ -80,6 +88,7 @@ func (m *SessionSource) NewPaymentSession(p *LightningPayment) (
 	if err != nil {
 		return nil, err
 	}
+	session.excludedNodes = m.ExcludedNodes.Union(p.ExcludedNodes)
 
 	return session, nil
 }
@@ -93,12 +102,50 @@ func (m *SessionSource) NewPaymentSessionEmpty() PaymentSession {
 	}
 }
 
+// ErrConflictingRouteHints is returned when route hints describe the same
+// channel with different policies or between different nodes.
+var ErrConflictingRouteHints = Err.CodeWithDetail("ErrConflictingRouteHints",
+	"route hints contain contradictory policies for the same channel")
+
+// hintEdgeKey identifies a hinted channel in one direction.
+type hintEdgeKey struct {
+	chanID uint64
+	from   route.Vertex
+}
+
+// sameHintPolicy returns true if both edges derived from route hints lead to
+// the same node and share the same policy.
+func sameHintPolicy(a, b *channeldb.ChannelEdgePolicy) bool {
+	return a.Node.PubKeyBytes == b.Node.PubKeyBytes &&
+		a.FeeBaseMSat == b.FeeBaseMSat &&
+		a.FeeProportionalMillionths == b.FeeProportionalMillionths &&
+		a.TimeLockDelta == b.TimeLockDelta
+}
+
+// hintNodePair returns the nodes of a hinted channel in a canonical order,
+// independent of the direction of the hint.
+func hintNodePair(a, b route.Vertex) [2]route.Vertex {
+	if bytes.Compare(a[:], b[:]) > 0 {
+		return [2]route.Vertex{b, a}
+	}
+	return [2]route.Vertex{a, b}
+}
+
 // RouteHintsToEdges converts a list of invoice route hints to an edge map that
-// can be passed into pathfinding.
+// can be passed into pathfinding. Hop hints which are repeated, within a route
+// hint or across route hints, only result in a single edge. Hop hints which
+// describe the same channel with a different policy, or between a different
+// pair of nodes, can't be told apart and are rejected with
+// ErrConflictingRouteHints.
 func RouteHintsToEdges(routeHints [][]zpay32.HopHint, target route.Vertex) (
 	map[route.Vertex][]*channeldb.ChannelEdgePolicy, er.R) {
 	edges := make(map[route.Vertex][]*channeldb.ChannelEdgePolicy)
 
+	// Track the edges and node pairs of the hinted channels, so that
+	// repeated hints can be skipped and contradictory ones detected.
+	seen := make(map[hintEdgeKey]*channeldb.ChannelEdgePolicy)
+	nodePairs := make(map[uint64][2]route.Vertex)
+
 	// Traverse through all of the available hop hints and include them in
 	// our edges map, indexed by the public key of the channel's starting
 	// node.
@@ -140,9 +187,48 @@ func RouteHintsToEdges(routeHints [][]zpay32.HopHint, target route.Vertex) (
 			}
 
 			v := route.NewVertex(hopHint.NodeID)
+
+			// A channel connects a single pair of nodes, so it can
+			// only be hinted again in either direction between the
+			// same nodes.
+			pair := hintNodePair(v, endNode.PubKeyBytes)
+			known, ok := nodePairs[hopHint.ChannelID]
+			switch {
+			case !ok:
+				nodePairs[hopHint.ChannelID] = pair
+
+			case known != pair:
+				return nil, ErrConflictingRouteHints.New(
+					fmt.Sprintf("channel %v is hinted "+
+						"between different nodes",
+						hopHint.ChannelID), nil)
+			}
+
+			key := hintEdgeKey{chanID: hopHint.ChannelID, from: v}
+			if prev, ok := seen[key]; ok {
+				if !sameHintPolicy(prev, edge) {
+					return nil, ErrConflictingRouteHints.New(
+						fmt.Sprintf("channel %v is hinted "+
+							"with different policies",
+							hopHint.ChannelID), nil)
+				}
+				continue
+			}
+			seen[key] = edge
+
 			edges[v] = append(edges[v], edge)
 		}
 	}
 
 	return edges, nil
 }
+
+// ValidateRouteHints checks that the route hints of a payment to target are
+// consistent, so that a payment can be rejected before it is attempted. See
+// RouteHintsToEdges.
+func ValidateRouteHints(routeHints [][]zpay32.HopHint,
+	target route.Vertex) er.R {
+
+	_, err := RouteHintsToEdges(routeHints, target)
+	return err
+}
@@ -0,0 +1,140 @@
+package routing
+
+import (
+	"testing"
+
+	"github.com/pkt-cash/pktd/btcec"
+	"github.com/pkt-cash/pktd/lnd/routing/route"
+	"github.com/pkt-cash/pktd/lnd/zpay32"
+)
+
+// TestRouteHintsToEdges asserts that repeated hop hints result in a single
+// edge and that contradictory hop hints for the same channel are rejected.
+func TestRouteHintsToEdges(t *testing.T) {
+	newKey := func() *btcec.PublicKey {
+		privKey, err := btcec.NewPrivateKey(btcec.S256())
+		if err != nil {
+			t.Fatal(err)
+		}
+		return privKey.PubKey()
+	}
+
+	target := newKey()
+	nodeA, nodeB, nodeC := newKey(), newKey(), newKey()
+
+	hintA := zpay32.HopHint{
+		NodeID:                    nodeA,
+		ChannelID:                 1,
+		FeeBaseMSat:               1000,
+		FeeProportionalMillionths: 10,
+		CLTVExpiryDelta:           40,
+	}
+	hintB := zpay32.HopHint{
+		NodeID:          nodeB,
+		ChannelID:       2,
+		CLTVExpiryDelta: 40,
+	}
+
+	// hintAFee is channel 1 with a different fee than hintA.
+	hintAFee := hintA
+	hintAFee.FeeBaseMSat = 2000
+
+	// hintC is channel 1, but starting at a different node than hintA.
+	hintC := hintA
+	hintC.NodeID = nodeC
+
+	// hintBA is channel 1 in the opposite direction of hintA, when
+	// followed by hintAT.
+	hintBA := zpay32.HopHint{NodeID: nodeB, ChannelID: 1}
+	hintAT := zpay32.HopHint{NodeID: nodeA, ChannelID: 3}
+
+	testCases := []struct {
+		name       string
+		routeHints [][]zpay32.HopHint
+		expEdges   map[route.Vertex]int
+		expErr     bool
+	}{
+		{
+			name:       "distinct hints",
+			routeHints: [][]zpay32.HopHint{{hintA}, {hintB}},
+			expEdges: map[route.Vertex]int{
+				route.NewVertex(nodeA): 1,
+				route.NewVertex(nodeB): 1,
+			},
+		},
+		{
+			name: "duplicate hints",
+			routeHints: [][]zpay32.HopHint{
+				{hintA}, {hintB}, {hintA},
+			},
+			expEdges: map[route.Vertex]int{
+				route.NewVertex(nodeA): 1,
+				route.NewVertex(nodeB): 1,
+			},
+		},
+		{
+			name: "opposite directions",
+			routeHints: [][]zpay32.HopHint{
+				{hintA, hintB}, {hintBA, hintAT},
+			},
+			expEdges: map[route.Vertex]int{
+				route.NewVertex(nodeA): 2,
+				route.NewVertex(nodeB): 2,
+			},
+		},
+		{
+			name: "contradictory policies",
+			routeHints: [][]zpay32.HopHint{
+				{hintA}, {hintAFee},
+			},
+			expErr: true,
+		},
+		{
+			name: "contradictory nodes",
+			routeHints: [][]zpay32.HopHint{
+				{hintA}, {hintC},
+			},
+			expErr: true,
+		},
+		{
+			// The same channel leads to the target in the first
+			// hint, but to node B in the second one.
+			name: "contradictory end nodes",
+			routeHints: [][]zpay32.HopHint{
+				{hintA}, {hintA, hintB},
+			},
+			expErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			edges, err := RouteHintsToEdges(
+				testCase.routeHints, route.NewVertex(target),
+			)
+			if testCase.expErr {
+				if !ErrConflictingRouteHints.Is(err) {
+					t.Fatalf("expected conflicting route "+
+						"hints, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if len(edges) != len(testCase.expEdges) {
+				t.Fatalf("expected edges from %v nodes, got %v",
+					len(testCase.expEdges), len(edges))
+			}
+			for v, n := range testCase.expEdges {
+				if len(edges[v]) != n {
+					t.Fatalf("expected %v edges from %v, "+
+						"got %v", n, v, len(edges[v]))
+				}
+			}
+		})
+	}
+}
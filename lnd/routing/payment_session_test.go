@@ -1,12 +1,15 @@
 package routing
 
 import (
+	"reflect"
 	"testing"
 
+	"github.com/pkt-cash/pktd/btcec"
 	"github.com/pkt-cash/pktd/btcutil/er"
 	"github.com/pkt-cash/pktd/lnd/channeldb"
 	"github.com/pkt-cash/pktd/lnd/lnwire"
 	"github.com/pkt-cash/pktd/lnd/routing/route"
+	"github.com/pkt-cash/pktd/lnd/zpay32"
 )
 
 func TestRequestRoute(t *testing.T) {
@@ -79,6 +82,142 @@ func TestRequestRoute(t *testing.T) {
 	}
 }
 
+// TestRequestRoutePreferredHints asserts that path finding tries the preferred
+// route hints in order before falling back to the full set of hints, and that
+// the fallback is skipped for strict preferences.
+func TestRequestRoutePreferredHints(t *testing.T) {
+	newKey := func() *btcec.PublicKey {
+		privKey, err := btcec.NewPrivateKey(btcec.S256())
+		if err != nil {
+			t.Fatal(err)
+		}
+		return privKey.PubKey()
+	}
+
+	target := newKey()
+	hintNodeA, hintNodeB := newKey(), newKey()
+	routeHints := [][]zpay32.HopHint{
+		{{NodeID: hintNodeA, ChannelID: 1}},
+		{{NodeID: hintNodeB, ChannelID: 2}},
+	}
+
+	testCases := []struct {
+		name     string
+		strict   bool
+		routable map[int]bool
+		expTries []*route.Vertex
+		expErr   bool
+	}{
+		{
+			name:     "first preference routable",
+			routable: map[int]bool{0: true},
+			expTries: []*route.Vertex{vertexPtr(hintNodeB)},
+		},
+		{
+			name:     "second preference routable",
+			routable: map[int]bool{1: true},
+			expTries: []*route.Vertex{
+				vertexPtr(hintNodeB), vertexPtr(hintNodeA),
+			},
+		},
+		{
+			name:     "fallback to all hints",
+			routable: map[int]bool{2: true},
+			expTries: []*route.Vertex{
+				vertexPtr(hintNodeB), vertexPtr(hintNodeA), nil,
+			},
+		},
+		{
+			name:     "strict preference",
+			strict:   true,
+			routable: map[int]bool{2: true},
+			expTries: []*route.Vertex{
+				vertexPtr(hintNodeB), vertexPtr(hintNodeA),
+			},
+			expErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			payment := &LightningPayment{
+				Target:              route.NewVertex(target),
+				CltvLimit:           30,
+				FinalCLTVDelta:      8,
+				Amount:              1000,
+				FeeLimit:            1000,
+				RouteHints:          routeHints,
+				PreferredRouteHints: []int{1, 0},
+				StrictRouteHints:    testCase.strict,
+			}
+
+			session, err := newPaymentSession(
+				payment,
+				func() (map[uint64]lnwire.MilliSatoshi,
+					er.R) {
+					return nil, nil
+				},
+				func() (routingGraph, func(), er.R) {
+					return &sessionGraph{}, func() {}, nil
+				},
+				&MissionControl{cfg: &MissionControlConfig{}},
+				PathFindingConfig{},
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var tries []*route.Vertex
+			session.pathFinder = func(
+				g *graphParams, r *RestrictParams,
+				cfg *PathFindingConfig,
+				source, target route.Vertex,
+				amt lnwire.MilliSatoshi,
+				finalHtlcExpiry int32) (
+				[]*channeldb.ChannelEdgePolicy, er.R) {
+
+				try := len(tries)
+				tries = append(tries, r.LastHop)
+				if !testCase.routable[try] {
+					return nil, ErrNoRouteFound.Default()
+				}
+
+				return []*channeldb.ChannelEdgePolicy{
+					{
+						Node: &channeldb.LightningNode{
+							Features: lnwire.NewFeatureVector(
+								nil, nil,
+							),
+						},
+					},
+				}, nil
+			}
+
+			_, err = session.RequestRoute(
+				payment.Amount, payment.FeeLimit, 0, 10,
+			)
+			switch {
+			case testCase.expErr && !ErrNoRouteFound.Is(err):
+				t.Fatalf("expected no route error, got %v", err)
+			case !testCase.expErr && err != nil:
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(tries, testCase.expTries) {
+				t.Fatalf("unexpected last hops tried: %v",
+					tries)
+			}
+		})
+	}
+}
+
+func vertexPtr(pubKey *btcec.PublicKey) *route.Vertex {
+	v := route.NewVertex(pubKey)
+	return &v
+}
+
 type sessionGraph struct {
 	routingGraph
 }
@@ -4,12 +4,31 @@ import (
 	"math"
 	"time"
 
+	"github.com/pkt-cash/pktd/btcutil"
 	"github.com/pkt-cash/pktd/lnd/lnwire"
 	"github.com/pkt-cash/pktd/lnd/routing/route"
 )
 
-// probabilityEstimator returns node and pair probabilities based on historical
-// payment results.
+// estimator estimates success probabilities from the payment results that
+// mission control collects. Estimators don't keep state of their own, so the
+// one in use can be replaced at any time.
+type estimator interface {
+	// getPairProbability estimates the probability of successfully
+	// traversing from the node to toNode, given the results of the node.
+	// The capacity of the channels to toNode is zero if it is unknown.
+	getPairProbability(now time.Time, results NodeResults,
+		toNode route.Vertex, amt lnwire.MilliSatoshi,
+		capacity btcutil.Amount) float64
+
+	// getLocalPairProbability estimates the probability of successfully
+	// traversing our own local channels to toNode.
+	getLocalPairProbability(now time.Time, results NodeResults,
+		toNode route.Vertex) float64
+}
+
+// probabilityEstimator is the a priori estimator. It returns node and pair
+// probabilities based on historical payment results and an assumed probability
+// for connections without history.
 type probabilityEstimator struct {
 	// penaltyHalfLife defines after how much time a penalized node or
 	// channel is back at 50% probability.
@@ -111,10 +130,12 @@ func (p *probabilityEstimator) getWeight(age time.Duration) float64 {
 
 // getPairProbability estimates the probability of successfully traversing to
 // toNode based on historical payment outcomes for the from node. Those outcomes
-// are passed in via the results parameter.
+// are passed in via the results parameter. The capacity isn't taken into
+// account.
 func (p *probabilityEstimator) getPairProbability(
 	now time.Time, results NodeResults,
-	toNode route.Vertex, amt lnwire.MilliSatoshi) float64 {
+	toNode route.Vertex, amt lnwire.MilliSatoshi,
+	capacity btcutil.Amount) float64 {
 	nodeProbability := p.getNodeProbability(now, results, amt)
 
 	return p.calculateProbability(
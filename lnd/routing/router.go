@@ -68,6 +68,12 @@ const (
 	// bitcoin (160 for litecoin), though we now clamp the lower end of this
 	// range for user-chosen deltas to 18 blocks to be conservative.
 	MinCLTVDelta = 18
+
+	// MaxInflightHtlcs is the ceiling for the number of shards a single
+	// payment may keep in flight concurrently. A channel can't carry more
+	// than this many htlcs in one direction, so a higher value can never be
+	// reached anyway.
+	MaxInflightHtlcs = input.MaxHTLCNumber / 2
 )
 
 var (
@@ -76,6 +82,18 @@ var (
 	// shutting down.
 	ErrRouterShuttingDown = Err.CodeWithDetail("ErrRouterShuttingDown",
 		"router shutting down")
+
+	// ErrNoRouteFound is returned when path finding is unable to find a
+	// route to the destination, or when a payment failed because no
+	// route could be found for it.
+	ErrNoRouteFound = Err.CodeWithDefault("ErrNoRouteFound",
+		errNoPathFound)
+
+	// ErrInvalidCircularRoute is returned when a route back to ourselves
+	// is requested that has no other hop, or that doesn't pin the
+	// outgoing channel.
+	ErrInvalidCircularRoute = Err.CodeWithDetail("ErrInvalidCircularRoute",
+		"a route back to self needs another hop and an outgoing channel")
 )
 
 // ChannelGraphSource represents the source of information about the topology
@@ -216,9 +234,10 @@ type MissionController interface {
 	ReportPaymentSuccess(paymentID uint64, rt *route.Route) er.R
 
 	// GetProbability is expected to return the success probability of a
-	// payment from fromNode along edge.
+	// payment from fromNode along edge. The capacity of the channels is
+	// zero if it is unknown.
 	GetProbability(fromNode, toNode route.Vertex,
-		amt lnwire.MilliSatoshi) float64
+		amt lnwire.MilliSatoshi, capacity btcutil.Amount) float64
 }
 
 // FeeSchema is the set fee configuration for a Lightning Node on the network.
@@ -587,10 +606,14 @@ func (r *ChannelRouter) Start() er.R {
 			// don't need it to timeout. It will stop immediately
 			// after the existing attempt has finished anyway. We
 			// also set a zero fee limit, as no more routes should
-			// be tried.
+			// be tried. The in-flight htlc and attempt limits are
+			// kept, so the payment waits for its shards or fails
+			// rather than asking the empty session for another
+			// route.
 			_, _, err := r.sendPayment(
-				payment.Info.Value, 0,
-				payment.Info.PaymentHash, 0, paySession,
+				payment.Info.Value, 0, payment.Info.PaymentHash,
+				0, payment.Info.MaxInflightHtlcs,
+				payment.Info.MaxAttempts, paySession,
 			)
 			if err != nil {
 				log.Errorf("Resuming payment with hash %v "+
@@ -1597,6 +1620,21 @@ func generateSphinxPacket(rt *route.Route, paymentHash []byte,
 	}, nil
 }
 
+// BuildOnion generates a fresh session key and uses it to construct the
+// serialized onion packet for the given route, committing to the passed
+// payment hash. The returned circuit can be used to derive the per-hop shared
+// secrets of the onion.
+func BuildOnion(rt *route.Route, paymentHash []byte) ([]byte,
+	*sphinx.Circuit, er.R) {
+
+	sessionKey, err := generateNewSessionKey()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return generateSphinxPacket(rt, paymentHash, sessionKey)
+}
+
 // LightningPayment describes a payment to be sent through the network to the
 // final destination.
 type LightningPayment struct {
@@ -1612,6 +1650,12 @@ type LightningPayment struct {
 	// if there isn't a route with lower fees than this limit.
 	FeeLimit lnwire.MilliSatoshi
 
+	// MaxTotalFee is the maximum in millisatoshis that the fees of all
+	// attempts of the payment may add up to, including the attempts that
+	// failed. The control tower fails the payment once another attempt
+	// would exceed it. Zero means no limit.
+	MaxTotalFee lnwire.MilliSatoshi
+
 	// CltvLimit is the maximum time lock that is allowed for attempts to
 	// complete this payment.
 	CltvLimit uint32
@@ -1633,6 +1677,12 @@ type LightningPayment struct {
 	// TODO(halseth): make wallclock time to allow resume after startup.
 	PayAttemptTimeout time.Duration
 
+	// MaxAttempts is the maximum number of htlc attempts that are made for
+	// the payment, including the attempts that failed. Once they are used
+	// up, the payment fails with FailureReasonAttemptLimit. A zero value
+	// means no limit.
+	MaxAttempts uint32
+
 	// RouteHints represents the different routing hints that can be used to
 	// assist a payment in reaching its destination successfully. These
 	// hints will act as intermediate hops along the route.
@@ -1651,6 +1701,21 @@ type LightningPayment struct {
 	// is reached. If nil, any node may be used.
 	LastHop *route.Vertex
 
+	// IncomingChannelID is the channel that must be taken for the last
+	// hop, into the final destination. If nil, any channel may be used.
+	// It is used to pin both ends of a circular payment to self.
+	IncomingChannelID *uint64
+
+	// PreferredRouteHints is an ordered list of indexes into RouteHints.
+	// Path finding first tries to route through each of these hints in
+	// turn, pinning the last hop to the hint's final node, before falling
+	// back to the full set of route hints.
+	PreferredRouteHints []int
+
+	// StrictRouteHints, if set, disables the fallback to the full set of
+	// route hints when none of the PreferredRouteHints are routable.
+	StrictRouteHints bool
+
 	// DestFeatures specifies the set of features we assume the final node
 	// has for pathfinding. Typically these will be taken directly from an
 	// invoice, but they can also be manually supplied or assumed by the
@@ -1677,6 +1742,15 @@ type LightningPayment struct {
 	// MaxParts is the maximum number of partial payments that may be used
 	// to complete the full amount.
 	MaxParts uint32
+
+	// MaxInflightHtlcs is the maximum number of partial payments that may
+	// be in flight at the same time. Zero means no limit.
+	MaxInflightHtlcs uint32
+
+	// ExcludedNodes is an optional list of nodes the payment may not be
+	// routed through, in addition to the nodes that are excluded for all
+	// payments.
+	ExcludedNodes []route.Vertex
 }
 
 // SendPayment attempts to send a payment as described within the passed
@@ -1700,7 +1774,8 @@ func (r *ChannelRouter) SendPayment(payment *LightningPayment) ([32]byte,
 	// for the existing attempt.
 	return r.sendPayment(
 		payment.Amount, payment.FeeLimit, payment.PaymentHash,
-		payment.PayAttemptTimeout, paySession,
+		payment.PayAttemptTimeout, payment.MaxInflightHtlcs,
+		payment.MaxAttempts, paySession,
 	)
 }
 
@@ -1723,7 +1798,8 @@ func (r *ChannelRouter) SendPaymentAsync(payment *LightningPayment) er.R {
 
 		_, _, err := r.sendPayment(
 			payment.Amount, payment.FeeLimit, payment.PaymentHash,
-			payment.PayAttemptTimeout, paySession,
+			payment.PayAttemptTimeout, payment.MaxInflightHtlcs,
+			payment.MaxAttempts, paySession,
 		)
 		if err != nil {
 			log.Errorf("Payment with hash %x failed: %v",
@@ -1773,10 +1849,13 @@ func (r *ChannelRouter) preparePayment(payment *LightningPayment) (
 	//
 	// TODO(roasbeef): store records as part of creation info?
 	info := &channeldb.PaymentCreationInfo{
-		PaymentHash:    payment.PaymentHash,
-		Value:          payment.Amount,
-		CreationTime:   r.cfg.Clock.Now(),
-		PaymentRequest: payment.PaymentRequest,
+		PaymentHash:      payment.PaymentHash,
+		Value:            payment.Amount,
+		CreationTime:     r.cfg.Clock.Now(),
+		PaymentRequest:   payment.PaymentRequest,
+		MaxTotalFee:      payment.MaxTotalFee,
+		MaxInflightHtlcs: payment.MaxInflightHtlcs,
+		MaxAttempts:      payment.MaxAttempts,
 	}
 
 	err = r.cfg.Control.InitPayment(payment.PaymentHash, info)
@@ -1923,7 +2002,7 @@ func (r *ChannelRouter) SendToRoute(hash lntypes.Hash, rt *route.Route) (
 // the ControlTower.
 func (r *ChannelRouter) sendPayment(
 	totalAmt, feeLimit lnwire.MilliSatoshi, paymentHash lntypes.Hash,
-	timeout time.Duration,
+	timeout time.Duration, maxInflightHtlcs, maxAttempts uint32,
 	paySession PaymentSession) ([32]byte, *route.Route, er.R) {
 	// We'll also fetch the current block height so we can properly
 	// calculate the required HTLC time locks within the route.
@@ -1941,6 +2020,9 @@ func (r *ChannelRouter) sendPayment(
 		paymentHash:   paymentHash,
 		paySession:    paySession,
 		currentHeight: currentHeight,
+
+		maxInflightHtlcs: maxInflightHtlcs,
+		maxAttempts:      maxAttempts,
 	}
 
 	// If a timeout is specified, create a timeout channel. If no timeout is
@@ -2407,12 +2489,24 @@ func (e ErrNoChannel) Error() string {
 // BuildRoute returns a fully specified route based on a list of pubkeys. If
 // amount is nil, the minimum routable amount is used. To force a specific
 // outgoing channel, use the outgoingChan parameter.
+//
+// The list may consist of a single hop to a peer, or end with our own node to
+// build a circular route, for example to probe the liquidity of the outgoing
+// channel. A circular route requires the outgoing channel to be set and
+// returns over a different channel, because nodes refuse to forward an htlc
+// back over the channel that it arrived on.
 func (r *ChannelRouter) BuildRoute(amt *lnwire.MilliSatoshi,
 	hops []route.Vertex, outgoingChan *uint64,
 	finalCltvDelta int32) (*route.Route, er.R) {
 	log.Tracef("BuildRoute called: hopsCount=%v, amt=%v",
 		len(hops), amt)
 
+	source := r.selfNode.PubKeyBytes
+	circular := len(hops) > 0 && hops[len(hops)-1] == source
+	if circular && (len(hops) < 2 || outgoingChan == nil) {
+		return nil, ErrInvalidCircularRoute.Default()
+	}
+
 	var outgoingChans map[uint64]struct{}
 	if outgoingChan != nil {
 		outgoingChans = map[uint64]struct{}{
@@ -2470,7 +2564,6 @@ func (r *ChannelRouter) BuildRoute(amt *lnwire.MilliSatoshi,
 	}()
 
 	// Traverse hops backwards to accumulate fees in the running amounts.
-	source := r.selfNode.PubKeyBytes
 	for i := len(hops) - 1; i >= 0; i-- {
 		toNode := hops[i]
 
@@ -2487,6 +2580,12 @@ func (r *ChannelRouter) BuildRoute(amt *lnwire.MilliSatoshi,
 		// known in the graph.
 		u := newUnifiedPolicies(source, toNode, outgoingChans)
 
+		// The last hop of a circular route may not arrive over the
+		// channel that the route leaves on.
+		if circular && i == len(hops)-1 {
+			u.inChanExcl = outgoingChans
+		}
+
 		err := u.addGraphPolicies(routingTx)
 		if err != nil {
 			return nil, err
@@ -243,6 +243,52 @@ func TestFindRoutesWithFeeLimit(t *testing.T) {
 	}
 }
 
+// TestFindRouteNoRoute asserts that FindRoute returns ErrNoRouteFound when the
+// destination is not reachable from the source node.
+func TestFindRouteNoRoute(t *testing.T) {
+	t.Parallel()
+
+	// Set up a graph with two islands: a -> b and c -> d. There is no path
+	// from a to d.
+	testChannels := []*testChannel{
+		symmetricTestChannel("a", "b", 100000, &testChannelPolicy{
+			Expiry:  144,
+			MinHTLC: 1,
+		}, 1),
+		symmetricTestChannel("c", "d", 100000, &testChannelPolicy{
+			Expiry:  144,
+			MinHTLC: 1,
+		}, 2),
+	}
+
+	testGraph, err := createTestGraphFromChannels(testChannels, "a")
+	if err != nil {
+		t.Fatalf("unable to create graph: %v", err)
+	}
+	defer testGraph.cleanUp()
+
+	const startingBlockHeight = 101
+	ctx, cleanUp, err := createTestCtxFromGraphInstance(
+		startingBlockHeight, testGraph,
+	)
+	if err != nil {
+		t.Fatalf("unable to create router: %v", err)
+	}
+	defer cleanUp()
+
+	_, err = ctx.router.FindRoute(
+		ctx.router.selfNode.PubKeyBytes, ctx.aliases["d"],
+		lnwire.NewMSatFromSatoshis(100), noRestrictions, nil, nil,
+		MinCLTVDelta,
+	)
+	if !ErrNoRouteFound.Is(err) {
+		t.Fatalf("expected ErrNoRouteFound, got: %v", err)
+	}
+	if er.Wrapped(err) != errNoPathFound {
+		t.Fatalf("expected errNoPathFound to be wrapped, got: %v", err)
+	}
+}
+
 // TestSendPaymentRouteFailureFallback tests that when sending a payment, if
 // one of the target routes is seen as unavailable, then the next route in the
 // queue is used instead. This process should continue until either a payment
@@ -3030,4 +3076,43 @@ func TestBuildRoute(t *testing.T) {
 	if errNoChannel.fromNode != ctx.aliases["a"] {
 		t.Fatalf("unexpected no channel error node")
 	}
+
+	// Build a single hop route to our direct peer. No fees are paid,
+	// because the only hop is the destination.
+	hops = []route.Vertex{ctx.aliases["b"]}
+	rt, err = ctx.router.BuildRoute(&amt, hops, nil, 40)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkHops(rt, []uint64{1})
+	if rt.TotalAmount != 100000 {
+		t.Fatalf("unexpected total amount %v", rt.TotalAmount)
+	}
+
+	// Build a circular route that goes out to b over channel 1 and back.
+	// The way back must use the other channel between a and b, for which
+	// b charges 2 sats.
+	hops = []route.Vertex{ctx.aliases["b"], ctx.aliases["a"]}
+	outgoingChan := uint64(1)
+	rt, err = ctx.router.BuildRoute(&amt, hops, &outgoingChan, 40)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkHops(rt, []uint64{1, 6})
+	if rt.TotalAmount != 102000 {
+		t.Fatalf("unexpected total amount %v", rt.TotalAmount)
+	}
+
+	// A circular route without a pinned outgoing channel or without
+	// another hop is rejected.
+	_, err = ctx.router.BuildRoute(&amt, hops, nil, 40)
+	if !ErrInvalidCircularRoute.Is(err) {
+		t.Fatalf("expected ErrInvalidCircularRoute, but got %v", err)
+	}
+
+	hops = []route.Vertex{ctx.aliases["a"]}
+	_, err = ctx.router.BuildRoute(&amt, hops, &outgoingChan, 40)
+	if !ErrInvalidCircularRoute.Is(err) {
+		t.Fatalf("expected ErrInvalidCircularRoute, but got %v", err)
+	}
 }
@@ -23,6 +23,14 @@ type unifiedPolicies struct {
 	// outChanRestr is an optional outgoing channel restriction for the
 	// local channel to use.
 	outChanRestr map[uint64]struct{}
+
+	// inChanRestr is an optional restriction on the channel towards
+	// toNode.
+	inChanRestr *uint64
+
+	// inChanExcl is an optional set of channels towards toNode that may
+	// not be used.
+	inChanExcl map[uint64]struct{}
 }
 
 // newUnifiedPolicies instantiates a new unifiedPolicies object. Channel
@@ -50,6 +58,16 @@ func (u *unifiedPolicies) addPolicy(fromNode route.Vertex,
 		}
 	}
 
+	// Skip channels if there is an incoming channel restriction.
+	if u.inChanRestr != nil && edge.ChannelID != *u.inChanRestr {
+		return
+	}
+
+	// Skip channels that are excluded towards toNode.
+	if _, ok := u.inChanExcl[edge.ChannelID]; ok {
+		return
+	}
+
 	// Update the policies map.
 	policy, ok := u.policies[fromNode]
 	if !ok {
@@ -271,3 +289,17 @@ func (u *unifiedPolicy) minAmt() lnwire.MilliSatoshi {
 
 	return min
 }
+
+// maxCapacity returns the largest capacity of the channels of this connection,
+// or zero if the capacities are unknown. Nodes forward non-strict, so a payment
+// may end up on any of the channels.
+func (u *unifiedPolicy) maxCapacity() btcutil.Amount {
+	var max btcutil.Amount
+	for _, edge := range u.edges {
+		if edge.capacity > max {
+			max = edge.capacity
+		}
+	}
+
+	return max
+}
@@ -564,6 +564,10 @@ func newRPCServer(cfg *Config, s *server, macService *macaroons.Service,
 		return nil, err
 	}
 	graph := s.localChanDB.ChannelGraph()
+	peerFeeLimits, err := cfg.Routing.ParsePeerFeeLimits()
+	if err != nil {
+		return nil, err
+	}
 	routerBackend := &routerrpc.RouterBackend{
 		SelfNode: selfNode.PubKeyBytes,
 		FetchChannelCapacity: func(chanID uint64) (btcutil.Amount,
@@ -574,6 +578,30 @@ func newRPCServer(cfg *Config, s *server, macService *macaroons.Service,
 			}
 			return info.Capacity, nil
 		},
+		FetchPairCapacity: func(fromNode, toNode route.Vertex) (
+			btcutil.Amount, er.R) {
+
+			var capacity btcutil.Amount
+			err := graph.ForEachNodeChannel(nil, fromNode[:],
+				func(_ kvdb.RTx, info *channeldb.ChannelEdgeInfo,
+					_, _ *channeldb.ChannelEdgePolicy) er.R {
+
+					if info.NodeKey1Bytes != toNode &&
+						info.NodeKey2Bytes != toNode {
+
+						return nil
+					}
+					if info.Capacity > capacity {
+						capacity = info.Capacity
+					}
+					return nil
+				},
+			)
+			if err != nil {
+				return 0, err
+			}
+			return capacity, nil
+		},
 		FetchChannelEndpoints: func(chanID uint64) (route.Vertex,
 			route.Vertex, er.R) {
 			info, _, _, err := graph.FetchChannelEdgesByID(
@@ -595,7 +623,12 @@ func newRPCServer(cfg *Config, s *server, macService *macaroons.Service,
 		MaxTotalTimelock:       cfg.MaxOutgoingCltvExpiry,
 		DefaultFinalCltvDelta:  uint16(cfg.Bitcoin.TimeLockDelta),
 		SubscribeHtlcEvents:    s.htlcNotifier.SubscribeHtlcEvents,
+		SubscribeTopology:      s.chanRouter.SubscribeTopology,
+		TopologySnapshot:       s.chanRouter.TopologySnapshot,
 		InterceptableForwarder: s.interceptableSwitch,
+		ExcludedNodes:          s.excludedNodes,
+		PeerFeeLimits:          peerFeeLimits,
+		ChannelGraph:           autopilot.ChannelGraphFromDatabase(graph),
 	}
 
 	genInvoiceFeatures := func() *lnwire.FeatureVector {
@@ -5457,7 +5490,7 @@ func (r *rpcServer) SubscribeChannelGraph(req *lnrpc.GraphTopologySubscription,
 			// Convert the struct from the channel router into the
 			// form expected by the gRPC service then send it off
 			// to the client.
-			graphUpdate := marshalTopologyChange(topChange)
+			graphUpdate := routerrpc.MarshalTopologyChange(topChange)
 			if err := updateStream.Send(graphUpdate); err != nil {
 				return er.Native(er.E(err))
 			}
@@ -5470,79 +5503,6 @@ func (r *rpcServer) SubscribeChannelGraph(req *lnrpc.GraphTopologySubscription,
 	}
 }
 
-// marshalTopologyChange performs a mapping from the topology change struct
-// returned by the router to the form of notifications expected by the current
-// gRPC service.
-func marshalTopologyChange(topChange *routing.TopologyChange) *lnrpc.GraphTopologyUpdate {
-	// encodeKey is a simple helper function that converts a live public
-	// key into a hex-encoded version of the compressed serialization for
-	// the public key.
-	encodeKey := func(k *btcec.PublicKey) string {
-		return hex.EncodeToString(k.SerializeCompressed())
-	}
-
-	nodeUpdates := make([]*lnrpc.NodeUpdate, len(topChange.NodeUpdates))
-	for i, nodeUpdate := range topChange.NodeUpdates {
-		addrs := make([]string, len(nodeUpdate.Addresses))
-		for i, addr := range nodeUpdate.Addresses {
-			addrs[i] = addr.String()
-		}
-
-		nodeUpdates[i] = &lnrpc.NodeUpdate{
-			Addresses:      addrs,
-			IdentityKey:    encodeKey(nodeUpdate.IdentityKey),
-			GlobalFeatures: nodeUpdate.GlobalFeatures,
-			Alias:          nodeUpdate.Alias,
-			Color:          nodeUpdate.Color,
-		}
-	}
-
-	channelUpdates := make([]*lnrpc.ChannelEdgeUpdate, len(topChange.ChannelEdgeUpdates))
-	for i, channelUpdate := range topChange.ChannelEdgeUpdates {
-		channelUpdates[i] = &lnrpc.ChannelEdgeUpdate{
-			ChanId: channelUpdate.ChanID,
-			ChanPoint: &lnrpc.ChannelPoint{
-				FundingTxid: &lnrpc.ChannelPoint_FundingTxidBytes{
-					FundingTxidBytes: channelUpdate.ChanPoint.Hash[:],
-				},
-				OutputIndex: channelUpdate.ChanPoint.Index,
-			},
-			Capacity: int64(channelUpdate.Capacity),
-			RoutingPolicy: &lnrpc.RoutingPolicy{
-				TimeLockDelta:    uint32(channelUpdate.TimeLockDelta),
-				MinHtlc:          int64(channelUpdate.MinHTLC),
-				MaxHtlcMsat:      uint64(channelUpdate.MaxHTLC),
-				FeeBaseMsat:      int64(channelUpdate.BaseFee),
-				FeeRateMilliMsat: int64(channelUpdate.FeeRate),
-				Disabled:         channelUpdate.Disabled,
-			},
-			AdvertisingNode: encodeKey(channelUpdate.AdvertisingNode),
-			ConnectingNode:  encodeKey(channelUpdate.ConnectingNode),
-		}
-	}
-
-	closedChans := make([]*lnrpc.ClosedChannelUpdate, len(topChange.ClosedChannels))
-	for i, closedChan := range topChange.ClosedChannels {
-		closedChans[i] = &lnrpc.ClosedChannelUpdate{
-			ChanId:       closedChan.ChanID,
-			Capacity:     int64(closedChan.Capacity),
-			ClosedHeight: closedChan.ClosedHeight,
-			ChanPoint: &lnrpc.ChannelPoint{
-				FundingTxid: &lnrpc.ChannelPoint_FundingTxidBytes{
-					FundingTxidBytes: closedChan.ChanPoint.Hash[:],
-				},
-				OutputIndex: closedChan.ChanPoint.Index,
-			},
-		}
-	}
-
-	return &lnrpc.GraphTopologyUpdate{
-		NodeUpdates:    nodeUpdates,
-		ChannelUpdates: channelUpdates,
-		ClosedChans:    closedChans,
-	}
-}
-
 // ListPayments returns a list of outgoing payments determined by a paginated
 // database query.
 func (r *rpcServer) ListPayments(ctx context.Context,
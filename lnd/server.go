@@ -232,6 +232,8 @@ type server struct {
 
 	missionControl *routing.MissionControl
 
+	excludedNodes *routing.NodeExclusions
+
 	chanRouter *routing.ChannelRouter
 
 	controlTower routing.ControlTower
@@ -719,12 +721,21 @@ func newServer(cfg *Config, listenAddrs []net.Addr,
 			AprioriWeight:           routingConfig.AprioriWeight,
 			SelfNode:                selfNode.PubKeyBytes,
 			MinFailureRelaxInterval: routing.DefaultMinFailureRelaxInterval,
+			Estimator:               routingConfig.Estimator,
+			BimodalScale:            routingConfig.BimodalScale,
+			BimodalNodeWeight:       routingConfig.BimodalNodeWeight,
+			BimodalDecayTime:        routingConfig.BimodalDecayTime,
 		},
 	)
 	if err != nil {
 		return nil, er.Errorf("can't create mission control: %v", err)
 	}
 
+	s.excludedNodes, err = routing.NewNodeExclusions(remoteChanDB)
+	if err != nil {
+		return nil, er.Errorf("can't load excluded nodes: %v", err)
+	}
+
 	log.Debugf("Instantiating payment session source with config: "+
 		"AttemptCost=%v + %v%%, MinRouteProbability=%v",
 		int64(routingConfig.AttemptCost),
@@ -744,6 +755,7 @@ func newServer(cfg *Config, listenAddrs []net.Addr,
 		MissionControl:    s.missionControl,
 		QueryBandwidth:    queryBandwidth,
 		PathFindingConfig: pathFindingConfig,
+		ExcludedNodes:     s.excludedNodes,
 	}
 
 	paymentControl := channeldb.NewPaymentControl(remoteChanDB)
@@ -846,7 +858,11 @@ func newServer(cfg *Config, listenAddrs []net.Addr,
 		MaxSweepAttempts:     sweep.DefaultMaxSweepAttempts,
 		NextAttemptDeltaFunc: sweep.DefaultNextAttemptDeltaFunc,
 		MaxFeeRate:           sweep.DefaultMaxFeeRate,
+		MinSweepFeeRate:      sweepFeeRate(cfg.Sweeper.MinFeeRate),
+		MaxSweepFeeRate:      sweepFeeRate(cfg.Sweeper.MaxFeeRate),
+		WalletInputPolicy:    sweepWalletInputPolicy(cfg.Sweeper),
 		FeeRateBucketSize:    sweep.DefaultFeeRateBucketSize,
+		SweepConfDepth:       sweep.DefaultSweepConfDepth,
 	})
 
 	s.utxoNursery = newUtxoNursery(&NurseryConfig{
@@ -3767,3 +3783,26 @@ func newSweepPkScriptGen(
 		return txscript.PayToAddrScript(sweepAddr)
 	}
 }
+
+// sweepFeeRate converts a fee rate in sat/byte, which is how the sweeper fee
+// rate limits are configured, into a fee rate in sat/kw. Zero stays zero, which
+// means that the limit isn't set.
+func sweepFeeRate(satPerByte uint64) chainfee.SatPerKWeight {
+	return chainfee.SatPerKVByte(1000 * satPerByte).FeePerKWeight()
+}
+
+// sweepWalletInputPolicy returns the policy that restricts which wallet utxos
+// the sweeper may use to bump the fee of sweep txes, such as the cpfp of an
+// anchor.
+func sweepWalletInputPolicy(cfg *lncfg.Sweeper) sweep.WalletInputPolicy {
+	selection := sweep.SelectLargestFirst
+	if cfg.AnchorCoinSelection == "smallest" {
+		selection = sweep.SelectSmallestFirst
+	}
+
+	return sweep.WalletInputPolicy{
+		Reserve:   btcutil.Amount(cfg.AnchorReserve),
+		MinConfs:  int32(cfg.AnchorMinConfs),
+		Selection: selection,
+	}
+}
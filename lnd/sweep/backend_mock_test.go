@@ -9,6 +9,7 @@ import (
 	"github.com/pkt-cash/pktd/chaincfg/chainhash"
 	"github.com/pkt-cash/pktd/lnd/lnwallet"
 	"github.com/pkt-cash/pktd/pktlog/log"
+	"github.com/pkt-cash/pktd/pktwallet/wtxmgr"
 	"github.com/pkt-cash/pktd/wire"
 )
 
@@ -28,8 +29,19 @@ type mockBackend struct {
 
 	publishChan chan wire.MsgTx
 
+	// labels holds the label of every published tx.
+	labels map[chainhash.Hash]string
+
 	walletUtxos []*lnwallet.Utxo
 	utxoCnt     int
+
+	// leases holds the lock id of every outpoint leased by the sweeper.
+	leases map[wire.OutPoint]wtxmgr.LockID
+
+	// externalLeases holds the lock ids of wallet utxos that are leased
+	// outside of the sweeper, keyed by their index in walletUtxos. They
+	// stay with the utxo when its outpoint changes.
+	externalLeases map[int]wtxmgr.LockID
 }
 
 func newMockBackend(t *testing.T, notifier *MockNotifier) *mockBackend {
@@ -40,6 +52,9 @@ func newMockBackend(t *testing.T, notifier *MockNotifier) *mockBackend {
 		confirmedSpendInputs:   make(map[wire.OutPoint]struct{}),
 		unconfirmedSpendInputs: make(map[wire.OutPoint]struct{}),
 		publishChan:            make(chan wire.MsgTx, 2),
+		labels:                 make(map[chainhash.Hash]string),
+		leases:                 make(map[wire.OutPoint]wtxmgr.LockID),
+		externalLeases:         make(map[int]wtxmgr.LockID),
 	}
 }
 
@@ -78,9 +93,14 @@ func (b *mockBackend) publishTransaction(tx *wire.MsgTx) er.R {
 	return nil
 }
 
-func (b *mockBackend) PublishTransaction(tx *wire.MsgTx, _ string) er.R {
+func (b *mockBackend) PublishTransaction(tx *wire.MsgTx, label string) er.R {
 	log.Tracef("Publishing tx %v", tx.TxHash())
 	err := b.publishTransaction(tx)
+
+	b.lock.Lock()
+	b.labels[tx.TxHash()] = label
+	b.lock.Unlock()
+
 	select {
 	case b.publishChan <- *tx:
 	case <-time.After(defaultTestTimeout):
@@ -98,11 +118,75 @@ func (b *mockBackend) ListUnspentWitness(minconfirms, maxconfirms int32) (
 	// ensure we don't return the same outpoint every time.
 	b.utxoCnt++
 
+	var utxos []*lnwallet.Utxo
 	for i := range b.walletUtxos {
 		b.walletUtxos[i].OutPoint.Hash[0] = byte(b.utxoCnt)
+
+		// Leased utxos aren't available for coin selection.
+		if _, ok := b.externalLeases[i]; ok {
+			continue
+		}
+		utxos = append(utxos, b.walletUtxos[i])
+	}
+
+	return utxos, nil
+}
+
+// leaseHolder returns the lock id that the given outpoint is leased to and
+// whether it is leased at all.
+func (b *mockBackend) leaseHolder(op wire.OutPoint) (wtxmgr.LockID, bool) {
+	for i, utxo := range b.walletUtxos {
+		if id, ok := b.externalLeases[i]; ok && utxo.OutPoint == op {
+			return id, true
+		}
+	}
+
+	id, ok := b.leases[op]
+	return id, ok
+}
+
+func (b *mockBackend) LeaseOutput(id wtxmgr.LockID, op wire.OutPoint) (
+	time.Time, er.R) {
+
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	if leaseID, ok := b.leaseHolder(op); ok && leaseID != id {
+		return time.Time{}, wtxmgr.ErrOutputAlreadyLocked.Default()
+	}
+	b.leases[op] = id
+
+	return time.Now().Add(wtxmgr.DefaultLockDuration), nil
+}
+
+func (b *mockBackend) ReleaseOutput(id wtxmgr.LockID, op wire.OutPoint) er.R {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	if leaseID, ok := b.leaseHolder(op); ok && leaseID != id {
+		return wtxmgr.ErrOutputUnlockNotAllowed.Default()
 	}
+	delete(b.leases, op)
+
+	return nil
+}
+
+// leaseUtxo leases the wallet utxo with the given index to the given id, as
+// if it was leased outside of the sweeper.
+func (b *mockBackend) leaseUtxo(i int, id wtxmgr.LockID) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	b.externalLeases[i] = id
+}
+
+// leaseID returns the lock id that the given outpoint is leased to and whether
+// it is leased at all.
+func (b *mockBackend) leaseID(op wire.OutPoint) (wtxmgr.LockID, bool) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
 
-	return b.walletUtxos, nil
+	return b.leaseHolder(op)
 }
 
 func (b *mockBackend) WithCoinSelectLock(f func() er.R) er.R {
@@ -152,3 +236,11 @@ func (b *mockBackend) mine() {
 func (b *mockBackend) isDone() bool {
 	return len(b.unconfirmedTxes) == 0
 }
+
+// label returns the label that the tx was published with.
+func (b *mockBackend) label(txHash chainhash.Hash) string {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	return b.labels[txHash]
+}
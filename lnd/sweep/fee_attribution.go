@@ -0,0 +1,125 @@
+package sweep
+
+import (
+	"math/bits"
+	"sort"
+
+	"github.com/pkt-cash/pktd/blockchain"
+	"github.com/pkt-cash/pktd/btcutil"
+	"github.com/pkt-cash/pktd/lnd/input"
+	"github.com/pkt-cash/pktd/wire"
+)
+
+// publishedSweep holds the details of a sweep tx published by us that are
+// needed to attribute its fee once it confirms.
+type publishedSweep struct {
+	// fee is the total fee paid by the sweep tx.
+	fee btcutil.Amount
+
+	// outpoints are all inputs spent by the sweep tx.
+	outpoints []wire.OutPoint
+}
+
+// newPublishedSweep calculates the fee paid by the sweep tx from the values
+// of the inputs it was created from.
+func newPublishedSweep(tx *wire.MsgTx, inputs []input.Input) *publishedSweep {
+	values := make(map[wire.OutPoint]btcutil.Amount, len(inputs))
+	for _, inp := range inputs {
+		values[*inp.OutPoint()] = btcutil.Amount(
+			inp.SignDesc().Output.Value,
+		)
+	}
+
+	var (
+		fee       btcutil.Amount
+		outpoints = make([]wire.OutPoint, 0, len(tx.TxIn))
+	)
+	for _, txIn := range tx.TxIn {
+		fee += values[txIn.PreviousOutPoint]
+		outpoints = append(outpoints, txIn.PreviousOutPoint)
+	}
+	for _, txOut := range tx.TxOut {
+		fee -= btcutil.Amount(txOut.Value)
+	}
+
+	return &publishedSweep{
+		fee:       fee,
+		outpoints: outpoints,
+	}
+}
+
+// spends returns true if the published sweep tx spends the given outpoint.
+func (p *publishedSweep) spends(outpoint wire.OutPoint) bool {
+	for _, op := range p.outpoints {
+		if op == outpoint {
+			return true
+		}
+	}
+	return false
+}
+
+// inputWeight returns the weight the input contributes to its tx, including
+// its witness.
+func inputWeight(txIn *wire.TxIn) uint64 {
+	return uint64(txIn.SerializeSize()*blockchain.WitnessScaleFactor +
+		txIn.Witness.SerializeSize())
+}
+
+// attributeFee splits the fee of the tx across its inputs proportionally to
+// the weight each of them contributes. The shares are rounded down and the
+// satoshis lost by rounding are handed out one by one to the inputs with the
+// largest remainders, so that the shares always sum up to exactly fee.
+func attributeFee(tx *wire.MsgTx,
+	fee btcutil.Amount) map[wire.OutPoint]btcutil.Amount {
+
+	if len(tx.TxIn) == 0 || fee < 0 {
+		return nil
+	}
+
+	var totalWeight uint64
+	weights := make([]uint64, len(tx.TxIn))
+	for i, txIn := range tx.TxIn {
+		weights[i] = inputWeight(txIn)
+		totalWeight += weights[i]
+	}
+
+	type share struct {
+		index     int
+		amount    uint64
+		remainder uint64
+	}
+
+	// Every weight is at most the total weight, so the quotient always
+	// fits into 64 bits.
+	var distributed uint64
+	shares := make([]share, len(tx.TxIn))
+	for i, weight := range weights {
+		hi, lo := bits.Mul64(uint64(fee), weight)
+		amount, remainder := bits.Div64(hi, lo, totalWeight)
+
+		shares[i] = share{
+			index:     i,
+			amount:    amount,
+			remainder: remainder,
+		}
+		distributed += amount
+	}
+
+	// Fewer satoshis than inputs are left over, so each input receives at
+	// most one of them. Ties are broken by input index to keep the result
+	// deterministic.
+	sort.SliceStable(shares, func(i, j int) bool {
+		return shares[i].remainder > shares[j].remainder
+	})
+	for i := uint64(0); i < uint64(fee)-distributed; i++ {
+		shares[i].amount++
+	}
+
+	fees := make(map[wire.OutPoint]btcutil.Amount, len(tx.TxIn))
+	for _, s := range shares {
+		outpoint := tx.TxIn[s.index].PreviousOutPoint
+		fees[outpoint] += btcutil.Amount(s.amount)
+	}
+
+	return fees
+}
@@ -0,0 +1,90 @@
+package sweep
+
+import (
+	"testing"
+
+	"github.com/pkt-cash/pktd/btcutil"
+	"github.com/pkt-cash/pktd/wire"
+)
+
+// TestAttributeFee asserts that the fee of a sweep tx is split across its
+// inputs by weight and that no satoshi is lost or double counted by rounding.
+func TestAttributeFee(t *testing.T) {
+	// Witnesses of different sizes give the inputs weights of 165, 237 and
+	// 310 weight units.
+	witness := func(size int) wire.TxWitness {
+		return wire.TxWitness{make([]byte, size)}
+	}
+	tx := &wire.MsgTx{
+		TxIn: []*wire.TxIn{
+			{
+				PreviousOutPoint: wire.OutPoint{Index: 0},
+			},
+			{
+				PreviousOutPoint: wire.OutPoint{Index: 1},
+				Witness:          witness(71),
+			},
+			{
+				PreviousOutPoint: wire.OutPoint{Index: 2},
+				Witness:          witness(144),
+			},
+		},
+	}
+
+	testCases := []struct {
+		name     string
+		fee      btcutil.Amount
+		expected []btcutil.Amount
+	}{
+		{
+			name:     "zero fee",
+			fee:      0,
+			expected: []btcutil.Amount{0, 0, 0},
+		},
+		{
+			name:     "exact split",
+			fee:      712,
+			expected: []btcutil.Amount{165, 237, 310},
+		},
+		{
+			name:     "rounding",
+			fee:      1000,
+			expected: []btcutil.Amount{232, 333, 435},
+		},
+		{
+			name:     "single satoshi",
+			fee:      1,
+			expected: []btcutil.Amount{0, 0, 1},
+		},
+		{
+			name: "large fee",
+			fee:  btcutil.Amount(1) << 62,
+			expected: []btcutil.Amount{
+				1068719372247919950,
+				1535069643774285018,
+				2007897002405182936,
+			},
+		},
+	}
+
+	for _, test := range testCases {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			fees := attributeFee(tx, test.fee)
+
+			var total btcutil.Amount
+			for i, txIn := range tx.TxIn {
+				fee := fees[txIn.PreviousOutPoint]
+				if fee != test.expected[i] {
+					t.Fatalf("input %v: expected fee %v, "+
+						"got %v", i, test.expected[i], fee)
+				}
+				total += fee
+			}
+			if total != test.fee {
+				t.Fatalf("expected total fee %v, got %v",
+					test.fee, total)
+			}
+		})
+	}
+}
@@ -1,8 +1,11 @@
 package sweep
 
 import (
+	"time"
+
 	"github.com/pkt-cash/pktd/btcutil/er"
 	"github.com/pkt-cash/pktd/lnd/lnwallet"
+	"github.com/pkt-cash/pktd/pktwallet/wtxmgr"
 	"github.com/pkt-cash/pktd/wire"
 )
 
@@ -15,7 +18,8 @@ type Wallet interface {
 	// ListUnspentWitness returns all unspent outputs which are version 0
 	// witness programs. The 'minconfirms' and 'maxconfirms' parameters
 	// indicate the minimum and maximum number of confirmations an output
-	// needs in order to be returned by this method.
+	// needs in order to be returned by this method. Outputs that are
+	// leased or locked aren't returned.
 	ListUnspentWitness(minconfirms, maxconfirms int32) ([]*lnwallet.Utxo,
 		er.R)
 
@@ -25,4 +29,19 @@ type Wallet interface {
 	// ability to execute a function closure under an exclusive coin
 	// selection lock.
 	WithCoinSelectLock(f func() er.R) er.R
+
+	// LeaseOutput locks an output to the given ID, preventing it from
+	// being available for any future coin selection attempts. If the
+	// output has already been locked to a different ID, then
+	// wtxmgr.ErrOutputAlreadyLocked is returned.
+	//
+	// NOTE: This method requires the global coin selection lock to be held.
+	LeaseOutput(id wtxmgr.LockID, op wire.OutPoint) (time.Time, er.R)
+
+	// ReleaseOutput unlocks an output, allowing it to be available for
+	// coin selection if it remains unspent. The ID should match the one
+	// used to originally lock the output.
+	//
+	// NOTE: This method requires the global coin selection lock to be held.
+	ReleaseOutput(id wtxmgr.LockID, op wire.OutPoint) er.R
 }
@@ -0,0 +1,81 @@
+package sweep
+
+import (
+	"github.com/pkt-cash/pktd/lnd/input"
+	"github.com/pkt-cash/pktd/lnd/labels"
+)
+
+// The kinds of sweeps that sweep transactions are labeled with.
+const (
+	sweepKindCommitment  = "commitment"
+	sweepKindAnchor      = "anchor"
+	sweepKindHtlcTimeout = "htlc-timeout"
+	sweepKindHtlcSuccess = "htlc-success"
+	sweepKindJustice     = "justice"
+	sweepKindWallet      = "wallet"
+	sweepKindOther       = "other"
+	sweepKindMixed       = "mixed"
+)
+
+// sweepKind returns the kind of sweep that an input of the given witness type
+// belongs to.
+func sweepKind(witnessType input.WitnessType) string {
+	switch witnessType {
+	case input.CommitmentTimeLock, input.CommitmentNoDelay,
+		input.CommitSpendNoDelayTweakless,
+		input.CommitmentToRemoteConfirmed:
+
+		return sweepKindCommitment
+
+	case input.CommitmentAnchor:
+		return sweepKindAnchor
+
+	case input.HtlcOfferedTimeoutSecondLevel,
+		input.HtlcOfferedRemoteTimeout:
+
+		return sweepKindHtlcTimeout
+
+	case input.HtlcAcceptedSuccessSecondLevel,
+		input.HtlcAcceptedRemoteSuccess:
+
+		return sweepKindHtlcSuccess
+
+	case input.CommitmentRevoke, input.HtlcOfferedRevoke,
+		input.HtlcAcceptedRevoke, input.HtlcSecondLevelRevoke:
+
+		return sweepKindJustice
+
+	case input.WitnessKeyHash, input.NestedWitnessKeyHash:
+		return sweepKindWallet
+
+	default:
+		return sweepKindOther
+	}
+}
+
+// sweepLabel returns the label of a sweep transaction which spends the given
+// inputs, noting the kind of outputs that are swept. Wallet inputs don't count
+// if there are other inputs, because they are only added to pay for the fee.
+// Inputs of different kinds are labeled as a mixed sweep.
+func sweepLabel(inputs []input.Input) string {
+	kind := ""
+	for _, inp := range inputs {
+		inpKind := sweepKind(inp.WitnessType())
+		switch {
+		case inpKind == sweepKindWallet:
+			continue
+
+		case kind == "":
+			kind = inpKind
+
+		case kind != inpKind:
+			return labels.MakeSweepLabel(sweepKindMixed)
+		}
+	}
+
+	if kind == "" {
+		kind = sweepKindWallet
+	}
+
+	return labels.MakeSweepLabel(kind)
+}
@@ -0,0 +1,68 @@
+package sweep
+
+import (
+	"testing"
+
+	"github.com/pkt-cash/pktd/lnd/input"
+)
+
+// TestSweepLabelKind tests that sweep txes are labeled with the kind of the
+// outputs that they spend, leaving out wallet inputs which pay for the fee.
+func TestSweepLabelKind(t *testing.T) {
+	testCases := []struct {
+		name          string
+		witnessTypes  []input.WitnessType
+		expectedLabel string
+	}{
+		{
+			name: "htlc timeout",
+			witnessTypes: []input.WitnessType{
+				input.HtlcOfferedTimeoutSecondLevel,
+				input.HtlcOfferedRemoteTimeout,
+			},
+			expectedLabel: "0:sweep:kind-htlc-timeout",
+		},
+		{
+			name: "fee paid by wallet",
+			witnessTypes: []input.WitnessType{
+				input.HtlcAcceptedRemoteSuccess,
+				input.WitnessKeyHash,
+			},
+			expectedLabel: "0:sweep:kind-htlc-success",
+		},
+		{
+			name: "wallet only",
+			witnessTypes: []input.WitnessType{
+				input.NestedWitnessKeyHash,
+			},
+			expectedLabel: "0:sweep:kind-wallet",
+		},
+		{
+			name: "mixed",
+			witnessTypes: []input.WitnessType{
+				input.CommitmentTimeLock,
+				input.WitnessKeyHash,
+				input.HtlcSecondLevelRevoke,
+			},
+			expectedLabel: "0:sweep:kind-mixed",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			var inputs []input.Input
+			for _, witnessType := range testCase.witnessTypes {
+				inp := createTestInput(10000, witnessType)
+				inputs = append(inputs, &inp)
+			}
+
+			label := sweepLabel(inputs)
+			if label != testCase.expectedLabel {
+				t.Fatalf("expected label %q, got %q",
+					testCase.expectedLabel, label)
+			}
+		})
+	}
+}
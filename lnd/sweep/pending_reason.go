@@ -0,0 +1,120 @@
+package sweep
+
+import (
+	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/lnd/lnwallet"
+	"github.com/pkt-cash/pktd/pktlog/log"
+	"github.com/pkt-cash/pktd/wire"
+)
+
+// PendingReason describes why an input that is offered to the UtxoSweeper
+// hasn't been swept yet.
+type PendingReason uint8
+
+const (
+	// PendingBatchWindow indicates that the input is part of a sweep tx
+	// that is published once the batch timer expires, which gives other
+	// inputs the opportunity to join the tx.
+	PendingBatchWindow PendingReason = iota
+
+	// PendingBelowDust indicates that the input can't be swept at the
+	// current fee rate, because either it doesn't pay for itself or the
+	// output of the sweep tx would be below the dust limit. It stays
+	// pending until the fee rate drops or other inputs are offered that
+	// make the sweep tx economical.
+	PendingBelowDust
+
+	// PendingNextAttempt indicates that a sweep tx spending the input was
+	// published and that the input isn't published again until the height
+	// of the next attempt is reached.
+	PendingNextAttempt
+
+	// PendingConflict indicates that the last sweep tx spending the input
+	// was rejected because another tx already spends the input. The input
+	// isn't published again until the height of the next attempt is
+	// reached.
+	PendingConflict
+)
+
+// String returns a human readable description of the reason.
+func (r PendingReason) String() string {
+	switch r {
+	case PendingBatchWindow:
+		return "waiting for batch window"
+	case PendingBelowDust:
+		return "below dust limit"
+	case PendingNextAttempt:
+		return "waiting for next attempt height"
+	case PendingConflict:
+		return "conflicting spend"
+	default:
+		return "unknown"
+	}
+}
+
+// PendingInputReason returns the reason why the given input hasn't been swept
+// yet. An error is returned if the UtxoSweeper isn't attempting to sweep the
+// input.
+func (s *UtxoSweeper) PendingInputReason(op wire.OutPoint) (PendingReason,
+	er.R) {
+
+	pendingInputs, err := s.PendingInputs()
+	if err != nil {
+		return 0, err
+	}
+
+	pendingInput, ok := pendingInputs[op]
+	if !ok {
+		return 0, lnwallet.ErrNotMine.Default()
+	}
+
+	return pendingInput.Reason, nil
+}
+
+// pendingReasons determines the reasons why each of the pending inputs hasn't
+// been swept yet at the given height. The inputs are clustered and partitioned
+// the same way as when the batch timer expires, so inputs which don't end up
+// in any of the sweep txes are below the dust limit.
+func (s *UtxoSweeper) pendingReasons(
+	bestHeight int32) map[wire.OutPoint]PendingReason {
+
+	sweepable := make(map[wire.OutPoint]struct{})
+	for _, cluster := range s.createInputClusters() {
+		cluster.sweepFeeRate = s.clampFeeRate(cluster.sweepFeeRate)
+
+		inputLists, err := s.getInputLists(cluster, bestHeight)
+		if err != nil {
+			log.Errorf("Unable to examine pending inputs: %v", err)
+			continue
+		}
+
+		for _, inputs := range inputLists {
+			for _, inp := range inputs {
+				sweepable[*inp.OutPoint()] = struct{}{}
+			}
+		}
+	}
+
+	reasons := make(map[wire.OutPoint]PendingReason, len(s.pendingInputs))
+	for op, pendingInput := range s.pendingInputs {
+		_, isSweepable := sweepable[op]
+
+		switch {
+		case pendingInput.minPublishHeight > bestHeight &&
+			pendingInput.conflicted:
+
+			reasons[op] = PendingConflict
+
+		case pendingInput.minPublishHeight > bestHeight:
+			reasons[op] = PendingNextAttempt
+
+		case isSweepable:
+			reasons[op] = PendingBatchWindow
+
+		default:
+			reasons[op] = PendingBelowDust
+		}
+	}
+
+	return reasons
+}
@@ -3,10 +3,15 @@ package sweep
 import (
 	"bytes"
 	"encoding/binary"
+	"io"
 
+	"github.com/pkt-cash/pktd/btcutil"
 	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/btcutil/util"
 	"github.com/pkt-cash/pktd/chaincfg/chainhash"
 	"github.com/pkt-cash/pktd/lnd/channeldb/kvdb"
+	"github.com/pkt-cash/pktd/lnd/input"
+	"github.com/pkt-cash/pktd/lnd/lnwallet/chainfee"
 	"github.com/pkt-cash/pktd/pktlog/log"
 	"github.com/pkt-cash/pktd/wire"
 )
@@ -27,6 +32,13 @@ var (
 	// maps: txHash -> empty slice
 	txHashesBucketKey = []byte("sweeper-tx-hashes")
 
+	// pendingInputsBucketKey is the key that points to a bucket containing
+	// the inputs that the sweeper is attempting to sweep, so that they
+	// survive a restart.
+	//
+	// maps: outpoint -> serialized_pending_input
+	pendingInputsBucketKey = []byte("sweeper-pending-inputs")
+
 	// utxnChainPrefix is the bucket prefix for nursery buckets.
 	utxnChainPrefix = []byte("utxn")
 
@@ -42,8 +54,26 @@ var (
 
 	errNoTxHashesBucket = Err.CodeWithDetail("errNoTxHashesBucket",
 		"tx hashes bucket does not exist")
+
+	errNoPendingInputsBucket = Err.CodeWithDetail(
+		"errNoPendingInputsBucket",
+		"pending inputs bucket does not exist")
 )
 
+// PersistedInput is a pending input of the sweeper as it is kept in the store.
+type PersistedInput struct {
+	// Input is the input to sweep. Inputs which are fetched from the store
+	// are restored as base inputs.
+	Input input.Input
+
+	// Params are the sweep parameters of the input.
+	Params Params
+
+	// PublishAttempts is the number of times that the input has been
+	// published in a sweep tx.
+	PublishAttempts int
+}
+
 // SweeperStore stores published txes.
 type SweeperStore interface {
 	// IsOurTx determines whether a tx is published by us, based on its
@@ -59,6 +89,18 @@ type SweeperStore interface {
 
 	// ListSweeps lists all the sweeps we have successfully published.
 	ListSweeps() ([]chainhash.Hash, er.R)
+
+	// PutPendingInput adds or updates an input that the sweeper is
+	// attempting to sweep.
+	PutPendingInput(*PersistedInput) er.R
+
+	// RemovePendingInput removes an input that the sweeper is no longer
+	// attempting to sweep.
+	RemovePendingInput(wire.OutPoint) er.R
+
+	// FetchPendingInputs returns all inputs that the sweeper was
+	// attempting to sweep.
+	FetchPendingInputs() ([]*PersistedInput, er.R)
 }
 
 type sweeperStore struct {
@@ -76,6 +118,13 @@ func NewSweeperStore(db kvdb.Backend, chainHash *chainhash.Hash) (
 			return err
 		}
 
+		_, err = tx.CreateTopLevelBucket(
+			pendingInputsBucketKey,
+		)
+		if err != nil {
+			return err
+		}
+
 		if tx.ReadWriteBucket(txHashesBucketKey) != nil {
 			return nil
 		}
@@ -281,5 +330,210 @@ func (s *sweeperStore) ListSweeps() ([]chainhash.Hash, er.R) {
 	return sweepTxns, nil
 }
 
+// PutPendingInput adds or updates an input that the sweeper is attempting to
+// sweep.
+func (s *sweeperStore) PutPendingInput(pi *PersistedInput) er.R {
+	var b bytes.Buffer
+	if err := serializePendingInput(&b, pi); err != nil {
+		return err
+	}
+
+	return kvdb.Update(s.db, func(tx kvdb.RwTx) er.R {
+		inputsBucket := tx.ReadWriteBucket(pendingInputsBucketKey)
+		if inputsBucket == nil {
+			return errNoPendingInputsBucket.Default()
+		}
+
+		return inputsBucket.Put(
+			outpointKey(*pi.Input.OutPoint()), b.Bytes(),
+		)
+	}, func() {})
+}
+
+// RemovePendingInput removes an input that the sweeper is no longer attempting
+// to sweep.
+func (s *sweeperStore) RemovePendingInput(op wire.OutPoint) er.R {
+	return kvdb.Update(s.db, func(tx kvdb.RwTx) er.R {
+		inputsBucket := tx.ReadWriteBucket(pendingInputsBucketKey)
+		if inputsBucket == nil {
+			return errNoPendingInputsBucket.Default()
+		}
+
+		return inputsBucket.Delete(outpointKey(op))
+	}, func() {})
+}
+
+// FetchPendingInputs returns all inputs that the sweeper was attempting to
+// sweep.
+func (s *sweeperStore) FetchPendingInputs() ([]*PersistedInput, er.R) {
+	var inputs []*PersistedInput
+
+	err := kvdb.View(s.db, func(tx kvdb.RTx) er.R {
+		inputsBucket := tx.ReadBucket(pendingInputsBucketKey)
+		if inputsBucket == nil {
+			return errNoPendingInputsBucket.Default()
+		}
+
+		return inputsBucket.ForEach(func(k, v []byte) er.R {
+			if len(k) != chainhash.HashSize+4 {
+				return er.Errorf("invalid pending input key "+
+					"length %v", len(k))
+			}
+
+			var op wire.OutPoint
+			copy(op.Hash[:], k[:chainhash.HashSize])
+			op.Index = byteOrder.Uint32(k[chainhash.HashSize:])
+
+			pi, err := deserializePendingInput(
+				bytes.NewReader(v), op,
+			)
+			if err != nil {
+				return er.Errorf("pending input %v: %v", op,
+					err)
+			}
+
+			inputs = append(inputs, pi)
+
+			return nil
+		})
+	}, func() {
+		inputs = nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return inputs, nil
+}
+
+// outpointKey returns the key under which the pending input spending the given
+// outpoint is stored.
+func outpointKey(op wire.OutPoint) []byte {
+	key := make([]byte, chainhash.HashSize+4)
+	copy(key, op.Hash[:])
+	byteOrder.PutUint32(key[chainhash.HashSize:], op.Index)
+
+	return key
+}
+
+// serializePendingInput writes everything that is needed to restore a pending
+// input to w. Only inputs with a standard witness type can be serialized.
+func serializePendingInput(w io.Writer, pi *PersistedInput) er.R {
+	witnessType, ok := pi.Input.WitnessType().(input.StandardWitnessType)
+	if !ok {
+		return er.Errorf("unsupported witness type %v",
+			pi.Input.WitnessType())
+	}
+
+	var (
+		hasParent    bool
+		parentFee    int64
+		parentWeight int64
+	)
+	if parent := pi.Input.UnconfParent(); parent != nil {
+		hasParent = true
+		parentFee = int64(parent.Fee)
+		parentWeight = parent.Weight
+	}
+
+	var (
+		hasGroup bool
+		group    uint64
+	)
+	if pi.Params.ExclusiveGroup != nil {
+		hasGroup = true
+		group = *pi.Params.ExclusiveGroup
+	}
+
+	for _, field := range []interface{}{
+		uint16(witnessType), pi.Input.HeightHint(),
+		pi.Input.BlocksToMaturity(), hasParent, parentFee, parentWeight,
+		pi.Params.Fee.ConfTarget, int64(pi.Params.Fee.FeeRate),
+		pi.Params.Force, hasGroup, group, uint32(pi.PublishAttempts),
+	} {
+		if err := util.WriteBin(w, byteOrder, field); err != nil {
+			return err
+		}
+	}
+
+	return input.WriteSignDescriptor(w, pi.Input.SignDesc())
+}
+
+// deserializePendingInput reads a pending input spending the given outpoint
+// from r. The input is restored as a base input.
+func deserializePendingInput(r io.Reader,
+	op wire.OutPoint) (*PersistedInput, er.R) {
+
+	var (
+		witnessType      uint16
+		heightHint       uint32
+		blocksToMaturity uint32
+		hasParent        bool
+		parentFee        int64
+		parentWeight     int64
+		confTarget       uint32
+		feeRate          int64
+		force            bool
+		hasGroup         bool
+		group            uint64
+		publishAttempts  uint32
+	)
+	for _, field := range []interface{}{
+		&witnessType, &heightHint, &blocksToMaturity, &hasParent,
+		&parentFee, &parentWeight, &confTarget, &feeRate, &force,
+		&hasGroup, &group, &publishAttempts,
+	} {
+		if err := util.ReadBin(r, byteOrder, field); err != nil {
+			return nil, err
+		}
+	}
+
+	var signDesc input.SignDescriptor
+	if err := input.ReadSignDescriptor(r, &signDesc); err != nil {
+		return nil, err
+	}
+
+	// A csv delay and an unconfirmed parent are mutually exclusive, because
+	// a csv locked output can only be spent once its parent confirmed.
+	var inp input.Input
+	if blocksToMaturity > 0 {
+		inp = input.NewCsvInput(
+			&op, input.StandardWitnessType(witnessType), &signDesc,
+			heightHint, blocksToMaturity,
+		)
+	} else {
+		var parent *input.TxInfo
+		if hasParent {
+			parent = &input.TxInfo{
+				Fee:    btcutil.Amount(parentFee),
+				Weight: parentWeight,
+			}
+		}
+
+		baseInput := input.MakeBaseInput(
+			&op, input.StandardWitnessType(witnessType), &signDesc,
+			heightHint, parent,
+		)
+		inp = &baseInput
+	}
+
+	pi := &PersistedInput{
+		Input: inp,
+		Params: Params{
+			Fee: FeePreference{
+				ConfTarget: confTarget,
+				FeeRate:    chainfee.SatPerKWeight(feeRate),
+			},
+			Force: force,
+		},
+		PublishAttempts: int(publishAttempts),
+	}
+	if hasGroup {
+		pi.Params.ExclusiveGroup = &group
+	}
+
+	return pi, nil
+}
+
 // Compile-time constraint to ensure sweeperStore implements SweeperStore.
 var _ SweeperStore = (*sweeperStore)(nil)
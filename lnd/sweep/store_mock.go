@@ -9,14 +9,16 @@ import (
 // MockSweeperStore is a mock implementation of sweeper store. This type is
 // exported, because it is currently used in nursery tests too.
 type MockSweeperStore struct {
-	lastTx  *wire.MsgTx
-	ourTxes map[chainhash.Hash]struct{}
+	lastTx        *wire.MsgTx
+	ourTxes       map[chainhash.Hash]struct{}
+	pendingInputs map[wire.OutPoint]*PersistedInput
 }
 
 // NewMockSweeperStore returns a new instance.
 func NewMockSweeperStore() *MockSweeperStore {
 	return &MockSweeperStore{
-		ourTxes: make(map[chainhash.Hash]struct{}),
+		ourTxes:       make(map[chainhash.Hash]struct{}),
+		pendingInputs: make(map[wire.OutPoint]*PersistedInput),
 	}
 }
 
@@ -52,5 +54,34 @@ func (s *MockSweeperStore) ListSweeps() ([]chainhash.Hash, er.R) {
 	return txns, nil
 }
 
+// PutPendingInput adds or updates an input that the sweeper is attempting to
+// sweep.
+func (s *MockSweeperStore) PutPendingInput(pi *PersistedInput) er.R {
+	persisted := *pi
+	s.pendingInputs[*pi.Input.OutPoint()] = &persisted
+
+	return nil
+}
+
+// RemovePendingInput removes an input that the sweeper is no longer attempting
+// to sweep.
+func (s *MockSweeperStore) RemovePendingInput(op wire.OutPoint) er.R {
+	delete(s.pendingInputs, op)
+
+	return nil
+}
+
+// FetchPendingInputs returns all inputs that the sweeper was attempting to
+// sweep.
+func (s *MockSweeperStore) FetchPendingInputs() ([]*PersistedInput, er.R) {
+	var inputs []*PersistedInput
+	for _, pi := range s.pendingInputs {
+		persisted := *pi
+		inputs = append(inputs, &persisted)
+	}
+
+	return inputs, nil
+}
+
 // Compile-time constraint to ensure MockSweeperStore implements SweeperStore.
 var _ SweeperStore = (*MockSweeperStore)(nil)
@@ -1,11 +1,14 @@
 package sweep
 
 import (
+	"reflect"
 	"testing"
 
 	"github.com/pkt-cash/pktd/btcutil/er"
 	"github.com/pkt-cash/pktd/chaincfg/chainhash"
 	"github.com/pkt-cash/pktd/lnd/channeldb"
+	"github.com/pkt-cash/pktd/lnd/input"
+	"github.com/pkt-cash/pktd/lnd/keychain"
 	"github.com/pkt-cash/pktd/wire"
 )
 
@@ -147,4 +150,146 @@ func testStore(t *testing.T, createStore func() (SweeperStore, er.R)) {
 			t.Fatalf("unexpected tx: %v", tx)
 		}
 	}
+
+	testStorePendingInputs(t, createStore)
+}
+
+// testStorePendingInputs asserts that the store persists the pending inputs of
+// the sweeper and is able to restore them.
+func testStorePendingInputs(t *testing.T,
+	createStore func() (SweeperStore, er.R)) {
+
+	store, err := createStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	signDesc := input.SignDescriptor{
+		Output: &wire.TxOut{
+			Value:    10000,
+			PkScript: []byte{0, 1, 2},
+		},
+		KeyDesc: keychain.KeyDescriptor{
+			PubKey: testPubKey,
+		},
+	}
+
+	group := uint64(5)
+	csvInput := input.NewCsvInput(
+		&wire.OutPoint{Index: 1}, input.CommitmentTimeLock, &signDesc,
+		100, 144,
+	)
+	cpfpInput := input.MakeBaseInput(
+		&wire.OutPoint{Index: 2}, input.CommitmentAnchor, &signDesc,
+		200, &input.TxInfo{Fee: 1000, Weight: 500},
+	)
+	removedInput := input.NewBaseInput(
+		&wire.OutPoint{Index: 3}, input.WitnessKeyHash, &signDesc, 300,
+	)
+
+	persisted := []*PersistedInput{
+		{
+			Input: csvInput,
+			Params: Params{
+				Fee: FeePreference{ConfTarget: 6},
+			},
+			PublishAttempts: 2,
+		},
+		{
+			Input: &cpfpInput,
+			Params: Params{
+				Fee:            FeePreference{FeeRate: 2500},
+				Force:          true,
+				ExclusiveGroup: &group,
+			},
+		},
+		{
+			Input: removedInput,
+		},
+	}
+	for _, pi := range persisted {
+		if err := store.PutPendingInput(pi); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Updating an input replaces the stored sweep state.
+	persisted[0].PublishAttempts = 3
+	if err := store.PutPendingInput(persisted[0]); err != nil {
+		t.Fatal(err)
+	}
+
+	err = store.RemovePendingInput(*removedInput.OutPoint())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Recreate the sweeper store.
+	store, err = createStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fetched, err := store.FetchPendingInputs()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(fetched) != 2 {
+		t.Fatalf("expected 2 pending inputs, got %v", len(fetched))
+	}
+
+	for _, expected := range persisted[:2] {
+		var found *PersistedInput
+		for _, pi := range fetched {
+			if *pi.Input.OutPoint() == *expected.Input.OutPoint() {
+				found = pi
+			}
+		}
+		if found == nil {
+			t.Fatalf("input %v not restored",
+				expected.Input.OutPoint())
+		}
+
+		if found.Input.WitnessType() != expected.Input.WitnessType() {
+			t.Fatalf("expected witness type %v, got %v",
+				expected.Input.WitnessType(),
+				found.Input.WitnessType())
+		}
+		if found.Input.HeightHint() != expected.Input.HeightHint() {
+			t.Fatalf("expected height hint %v, got %v",
+				expected.Input.HeightHint(),
+				found.Input.HeightHint())
+		}
+		if found.Input.BlocksToMaturity() !=
+			expected.Input.BlocksToMaturity() {
+
+			t.Fatalf("expected blocks to maturity %v, got %v",
+				expected.Input.BlocksToMaturity(),
+				found.Input.BlocksToMaturity())
+		}
+		if !reflect.DeepEqual(
+			found.Input.UnconfParent(), expected.Input.UnconfParent(),
+		) {
+			t.Fatalf("expected unconfirmed parent %v, got %v",
+				expected.Input.UnconfParent(),
+				found.Input.UnconfParent())
+		}
+		if !reflect.DeepEqual(
+			found.Input.SignDesc().Output,
+			expected.Input.SignDesc().Output,
+		) {
+			t.Fatal("sign descriptor output mismatch")
+		}
+		if !found.Input.SignDesc().KeyDesc.PubKey.IsEqual(testPubKey) {
+			t.Fatal("sign descriptor public key mismatch")
+		}
+		if !reflect.DeepEqual(found.Params, expected.Params) {
+			t.Fatalf("expected params %v, got %v",
+				expected.Params, found.Params)
+		}
+		if found.PublishAttempts != expected.PublishAttempts {
+			t.Fatalf("expected %v publish attempts, got %v",
+				expected.PublishAttempts, found.PublishAttempts)
+		}
+	}
 }
@@ -16,6 +16,7 @@ import (
 	"github.com/pkt-cash/pktd/lnd/input"
 	"github.com/pkt-cash/pktd/lnd/lnwallet"
 	"github.com/pkt-cash/pktd/lnd/lnwallet/chainfee"
+	"github.com/pkt-cash/pktd/lnd/subscribe"
 	"github.com/pkt-cash/pktd/pktlog/log"
 	"github.com/pkt-cash/pktd/wire"
 )
@@ -37,6 +38,10 @@ const (
 	//   #1: min = 1 sat/vbyte, max = 10 sat/vbyte
 	//   #2: min = 11 sat/vbyte, max = 20 sat/vbyte...
 	DefaultFeeRateBucketSize = 10
+
+	// DefaultSweepConfDepth is the default number of confirmations a sweep
+	// tx needs before confirmation subscribers are notified.
+	DefaultSweepConfDepth = 1
 )
 
 var (
@@ -132,6 +137,10 @@ type pendingInput struct {
 	// lastFeeRate is the most recent fee rate used for this input within a
 	// transaction broadcast to the network.
 	lastFeeRate chainfee.SatPerKWeight
+
+	// conflicted is set if the last transaction spending this input was
+	// rejected as a double spend.
+	conflicted bool
 }
 
 // parameters returns the sweep parameters for this input.
@@ -186,6 +195,9 @@ type PendingInput struct {
 
 	// Params contains the sweep parameters for this pending request.
 	Params Params
+
+	// Reason is the reason why the input hasn't been swept yet.
+	Reason PendingReason
 }
 
 // updateReq is an internal message we'll use to represent an external caller's
@@ -235,8 +247,21 @@ type UtxoSweeper struct {
 
 	relayFeeRate chainfee.SatPerKWeight
 
+	// confNtfnServer dispatches sweep confirmations to the subscribed
+	// clients.
+	confNtfnServer *subscribe.Server
+
+	// publishedSweeps tracks the sweep txes published by us that haven't
+	// been seen spending their inputs yet, so that their fee can be
+	// attributed once they confirm.
+	publishedSweeps map[chainhash.Hash]*publishedSweep
+
 	quit chan struct{}
 	wg   sync.WaitGroup
+
+	// confWg tracks the goroutines waiting for sweep txes to confirm. They
+	// are kept apart from wg, because they outlive the inputs they swept.
+	confWg sync.WaitGroup
 }
 
 // UtxoSweeperConfig contains dependencies of UtxoSweeper.
@@ -287,6 +312,22 @@ type UtxoSweeperConfig struct {
 	// UtxoSweeper.
 	MaxFeeRate chainfee.SatPerKWeight
 
+	// MinSweepFeeRate is the floor that the fee rate of every sweep tx is
+	// raised to before it is published. The relay fee rate is used if it
+	// is higher, because a tx below it wouldn't propagate.
+	MinSweepFeeRate chainfee.SatPerKWeight
+
+	// MaxSweepFeeRate is the ceiling that the fee rate of every sweep tx is
+	// lowered to before it is published. If zero, MaxFeeRate is used. The
+	// floor takes precedence if it is higher.
+	MaxSweepFeeRate chainfee.SatPerKWeight
+
+	// WalletInputPolicy restricts which wallet utxos may be attached to
+	// sweep txes that can't pay for themselves, such as the cpfp of an
+	// anchor. Attached utxos are leased with SweeperLockID, so that they
+	// aren't selected elsewhere while the sweep tx is being published.
+	WalletInputPolicy WalletInputPolicy
+
 	// FeeRateBucketSize is the default size of fee rate buckets we'll use
 	// when clustering inputs into buckets with similar fee rates within the
 	// UtxoSweeper.
@@ -298,6 +339,11 @@ type UtxoSweeperConfig struct {
 	//   #1: min = 1 sat/vbyte, max (exclusive) = 11 sat/vbyte
 	//   #2: min = 11 sat/vbyte, max (exclusive) = 21 sat/vbyte...
 	FeeRateBucketSize int
+
+	// SweepConfDepth is the number of confirmations a sweep tx needs
+	// before it is reported to the confirmation subscribers. If zero,
+	// DefaultSweepConfDepth is used.
+	SweepConfDepth uint32
 }
 
 // Result is the struct that is pushed through the result channel. Callers can
@@ -313,6 +359,41 @@ type Result struct {
 	Tx *wire.MsgTx
 }
 
+// SweepConfirmation is sent to the confirmation subscribers once a sweep tx
+// published by us has reached the configured confirmation depth.
+type SweepConfirmation struct {
+	// Outpoints are the pending inputs that were swept by the tx.
+	Outpoints []wire.OutPoint
+
+	// SweepTxid is the hash of the sweep tx.
+	SweepTxid chainhash.Hash
+
+	// ConfirmationHeight is the height of the block the sweep tx confirmed
+	// in.
+	ConfirmationHeight uint32
+
+	// Fee is the total fee paid by the sweep tx. It is zero if the fee is
+	// unknown, which is the case for sweeps published before a restart.
+	Fee btcutil.Amount
+
+	// InputFees attributes Fee to all inputs of the sweep tx, including
+	// wallet inputs that were added to it, proportionally to the weight
+	// each input contributes. The values sum up to exactly Fee. It is nil
+	// if the fee is unknown.
+	InputFees map[wire.OutPoint]btcutil.Amount
+}
+
+// ConfirmationSubscription is returned by SubscribeSweepConfirmations and
+// delivers the sweep confirmations the caller is interested in.
+type ConfirmationSubscription struct {
+	// Confirmations receives the sweep confirmations. It is closed when
+	// the subscription is canceled or the sweeper shuts down.
+	Confirmations <-chan *SweepConfirmation
+
+	// Cancel ends the subscription.
+	Cancel func()
+}
+
 // sweepInputMessage structs are used in the internal channel between the
 // SweepInput call and the sweeper main loop.
 type sweepInputMessage struct {
@@ -329,6 +410,8 @@ func New(cfg *UtxoSweeperConfig) *UtxoSweeper {
 		spendChan:         make(chan *chainntnfs.SpendDetail),
 		updateReqs:        make(chan *updateReq),
 		pendingSweepsReqs: make(chan *pendingSweepsReq),
+		confNtfnServer:    subscribe.NewServer(),
+		publishedSweeps:   make(map[chainhash.Hash]*publishedSweep),
 		quit:              make(chan struct{}),
 		pendingInputs:     make(pendingInputs),
 	}
@@ -370,6 +453,10 @@ func (s *UtxoSweeper) Start() er.R {
 	// not change from here on.
 	s.relayFeeRate = s.cfg.FeeEstimator.RelayFeePerKW()
 
+	if err := s.confNtfnServer.Start(); err != nil {
+		return er.Errorf("start conf ntfn server: %v", err)
+	}
+
 	// We need to register for block epochs and retry sweeping every block.
 	// We should get a notification with the current best block immediately
 	// if we don't provide any epoch. We'll wait for that in the collector.
@@ -434,6 +521,11 @@ func (s *UtxoSweeper) Stop() er.R {
 
 	close(s.quit)
 	s.wg.Wait()
+	s.confWg.Wait()
+
+	if err := s.confNtfnServer.Stop(); err != nil {
+		log.Warnf("error stopping conf ntfn server: %v", err)
+	}
 
 	log.Debugf("Sweeper shut down")
 
@@ -523,6 +615,10 @@ func (s *UtxoSweeper) collector(blockEpochs <-chan *chainntnfs.BlockEpoch) {
 		return
 	}
 
+	// Resume sweeping the inputs that were pending when the sweeper was
+	// shut down.
+	s.restorePendingInputs(bestHeight)
+
 	for {
 		select {
 		// A new inputs is offered to the sweeper. We check to see if we
@@ -540,6 +636,7 @@ func (s *UtxoSweeper) collector(blockEpochs <-chan *chainntnfs.BlockEpoch) {
 				// change to the unconfirmed parent tx info.
 				pendInput.params = input.params
 				pendInput.Input = input.input
+				s.persistInput(pendInput)
 
 				// Add additional result channel to signal
 				// spend of this input.
@@ -574,6 +671,7 @@ func (s *UtxoSweeper) collector(blockEpochs <-chan *chainntnfs.BlockEpoch) {
 				continue
 			}
 			pendInput.ntfnRegCancel = cancel
+			s.persistInput(pendInput)
 
 			// Check to see if with this new input a sweep tx can be
 			// formed.
@@ -609,6 +707,7 @@ func (s *UtxoSweeper) collector(blockEpochs <-chan *chainntnfs.BlockEpoch) {
 
 			// Signal sweep results for inputs in this confirmed
 			// tx.
+			var swept []wire.OutPoint
 			for _, txIn := range spend.SpendingTx.TxIn {
 				outpoint := txIn.PreviousOutPoint
 
@@ -624,7 +723,9 @@ func (s *UtxoSweeper) collector(blockEpochs <-chan *chainntnfs.BlockEpoch) {
 
 				// Return either a nil or a remote spend result.
 				var err er.R
-				if !isOurTx {
+				if isOurTx {
+					swept = append(swept, outpoint)
+				} else {
 					err = ErrRemoteSpend.Default()
 				}
 
@@ -643,6 +744,25 @@ func (s *UtxoSweeper) collector(blockEpochs <-chan *chainntnfs.BlockEpoch) {
 				}
 			}
 
+			// Sweep txes of ours that spend the same inputs can no
+			// longer confirm, so stop tracking them. The fee of the
+			// spending tx is kept for its confirmation.
+			var fee *btcutil.Amount
+			if published, ok := s.publishedSweeps[spendHash]; ok {
+				fee = &published.fee
+			}
+			s.forgetPublishedSweeps(spend.SpendingTx)
+
+			// If our own sweep tx spent any of the pending inputs,
+			// watch it for confirmation so that subscribers learn
+			// when the funds are finally recovered.
+			if len(swept) > 0 {
+				err := s.waitForSweepConf(spend, swept, fee)
+				if err != nil {
+					log.Errorf("wait for sweep conf: %v", err)
+				}
+			}
+
 			// Now that an input of ours is spent, we can try to
 			// resweep the remaining inputs.
 			if err := s.scheduleSweep(bestHeight); err != nil {
@@ -652,7 +772,7 @@ func (s *UtxoSweeper) collector(blockEpochs <-chan *chainntnfs.BlockEpoch) {
 		// A new external request has been received to retrieve all of
 		// the inputs we're currently attempting to sweep.
 		case req := <-s.pendingSweepsReqs:
-			req.respChan <- s.handlePendingSweepsReq(req)
+			req.respChan <- s.handlePendingSweepsReq(req, bestHeight)
 
 		// A new external request has been received to bump the fee rate
 		// of a given input.
@@ -740,6 +860,10 @@ func (s *UtxoSweeper) removeExclusiveGroup(group uint64) {
 // sweepCluster tries to sweep the given input cluster.
 func (s *UtxoSweeper) sweepCluster(cluster inputCluster,
 	currentHeight int32) er.R {
+	// The fee rate is clamped before the inputs are partitioned, so that
+	// the input sets are built for the fee rate that is actually paid.
+	cluster.sweepFeeRate = s.clampFeeRate(cluster.sweepFeeRate)
+
 	// Execute the sweep within a coin select lock. Otherwise the coins that
 	// we are going to spend may be selected for other transactions like
 	// funding of a channel.
@@ -763,6 +887,37 @@ func (s *UtxoSweeper) sweepCluster(cluster inputCluster,
 	})
 }
 
+// clampFeeRate clamps the fee rate of a sweep tx to the configured floor and
+// ceiling. Fee estimators may return absurd values, which would either keep the
+// tx from propagating or overpay during fee spikes.
+func (s *UtxoSweeper) clampFeeRate(
+	feeRate chainfee.SatPerKWeight) chainfee.SatPerKWeight {
+
+	ceiling := s.cfg.MaxSweepFeeRate
+	if ceiling == 0 {
+		ceiling = s.cfg.MaxFeeRate
+	}
+	if ceiling != 0 && feeRate > ceiling {
+		log.Warnf("Sweep fee rate %v exceeds the ceiling, clamping it "+
+			"to %v", feeRate, ceiling)
+
+		feeRate = ceiling
+	}
+
+	floor := s.cfg.MinSweepFeeRate
+	if floor < s.relayFeeRate {
+		floor = s.relayFeeRate
+	}
+	if feeRate < floor {
+		log.Warnf("Sweep fee rate %v is below the floor, clamping it "+
+			"to %v", feeRate, floor)
+
+		feeRate = floor
+	}
+
+	return feeRate
+}
+
 // bucketForFeeReate determines the proper bucket for a fee rate. This is done
 // in order to batch inputs with similar fee rates together.
 func (s *UtxoSweeper) bucketForFeeRate(
@@ -1106,6 +1261,94 @@ func (s *UtxoSweeper) signalAndRemove(outpoint *wire.OutPoint, result Result) {
 
 	// Inputs are no longer pending after result has been sent.
 	delete(s.pendingInputs, *outpoint)
+
+	if err := s.cfg.Store.RemovePendingInput(*outpoint); err != nil {
+		log.Errorf("Unable to remove persisted input %v: %v",
+			outpoint, err)
+	}
+}
+
+// persistInput stores the sweep state of a pending input, so that sweeping is
+// resumed after a restart. Inputs which can't be restored as a base input, for
+// example because spending them requires a preimage, aren't persisted. Those
+// are re-offered by their owners after a restart.
+func (s *UtxoSweeper) persistInput(pi *pendingInput) {
+	if !isPersistable(pi.Input) {
+		return
+	}
+
+	err := s.cfg.Store.PutPendingInput(&PersistedInput{
+		Input:           pi.Input,
+		Params:          pi.params,
+		PublishAttempts: pi.publishAttempts,
+	})
+	if err != nil {
+		log.Errorf("Unable to persist input %v: %v", pi.OutPoint(),
+			err)
+	}
+}
+
+// isPersistable returns whether the input can be restored from its witness
+// type and sign descriptor alone.
+func isPersistable(inp input.Input) bool {
+	witnessType, ok := inp.WitnessType().(input.StandardWitnessType)
+	if !ok || witnessType == input.HtlcAcceptedRemoteSuccess {
+		return false
+	}
+
+	if inp.RequiredTxOut() != nil {
+		return false
+	}
+
+	_, hasLockTime := inp.RequiredLockTime()
+
+	return !hasLockTime
+}
+
+// restorePendingInputs loads the inputs that were pending when the sweeper was
+// shut down and resumes sweeping them. The inputs don't have to wait for their
+// next attempt height again, the back-off only continues from the number of
+// publish attempts. Inputs which were spent while we were offline are removed
+// once the spend notification is delivered.
+func (s *UtxoSweeper) restorePendingInputs(bestHeight int32) {
+	inputs, err := s.cfg.Store.FetchPendingInputs()
+	if err != nil {
+		log.Errorf("Unable to fetch persisted inputs: %v", err)
+		return
+	}
+	if len(inputs) == 0 {
+		return
+	}
+
+	log.Infof("Restoring %v pending inputs", len(inputs))
+
+	for _, persisted := range inputs {
+		outpoint := *persisted.Input.OutPoint()
+
+		pendInput := &pendingInput{
+			Input:            persisted.Input,
+			minPublishHeight: bestHeight,
+			publishAttempts:  persisted.PublishAttempts,
+			params:           persisted.Params,
+		}
+		s.pendingInputs[outpoint] = pendInput
+
+		cancel, err := s.waitForSpend(
+			outpoint,
+			persisted.Input.SignDesc().Output.PkScript,
+			persisted.Input.HeightHint(),
+		)
+		if err != nil {
+			err := er.Errorf("wait for spend: %v", err)
+			s.signalAndRemove(&outpoint, Result{Err: err})
+			continue
+		}
+		pendInput.ntfnRegCancel = cancel
+	}
+
+	if err := s.scheduleSweep(bestHeight); err != nil {
+		log.Errorf("schedule sweep: %v", err)
+	}
 }
 
 // getInputLists goes through the given inputs and constructs multiple distinct
@@ -1149,7 +1392,7 @@ func (s *UtxoSweeper) getInputLists(cluster inputCluster,
 		allSets, err = generateInputPartitionings(
 			append(retryInputs, newInputs...), s.relayFeeRate,
 			cluster.sweepFeeRate, s.cfg.MaxInputsPerTx,
-			s.cfg.Wallet,
+			s.cfg.Wallet, s.cfg.WalletInputPolicy,
 		)
 		if err != nil {
 			return nil, er.Errorf("input partitionings: %v", err)
@@ -1159,7 +1402,7 @@ func (s *UtxoSweeper) getInputLists(cluster inputCluster,
 	// Create sets for just the new inputs.
 	newSets, err := generateInputPartitionings(
 		newInputs, s.relayFeeRate, cluster.sweepFeeRate,
-		s.cfg.MaxInputsPerTx, s.cfg.Wallet,
+		s.cfg.MaxInputsPerTx, s.cfg.Wallet, s.cfg.WalletInputPolicy,
 	)
 	if err != nil {
 		return nil, er.Errorf("input partitionings: %v", err)
@@ -1173,6 +1416,43 @@ func (s *UtxoSweeper) getInputLists(cluster inputCluster,
 	return append(allSets, newSets...), nil
 }
 
+// leaseWalletInputs leases the inputs of the given set that were attached from
+// the wallet and returns their outpoints. If one of them can't be leased, the
+// leases that were already acquired are released again.
+func (s *UtxoSweeper) leaseWalletInputs(inputs inputSet) ([]wire.OutPoint,
+	er.R) {
+
+	var leased []wire.OutPoint
+	for _, inp := range inputs {
+		if _, ok := inp.(*walletInput); !ok {
+			continue
+		}
+
+		op := *inp.OutPoint()
+		_, err := s.cfg.Wallet.LeaseOutput(SweeperLockID, op)
+		if err != nil {
+			s.releaseWalletInputs(leased)
+			return nil, er.Errorf("lease %v: %v", op, err)
+		}
+
+		leased = append(leased, op)
+	}
+
+	return leased, nil
+}
+
+// releaseWalletInputs releases the leases of the given wallet inputs. Errors
+// are only logged, because the leases expire by themselves.
+func (s *UtxoSweeper) releaseWalletInputs(ops []wire.OutPoint) {
+	for _, op := range ops {
+		err := s.cfg.Wallet.ReleaseOutput(SweeperLockID, op)
+		if err != nil {
+			log.Errorf("Unable to release wallet input %v: %v",
+				op, err)
+		}
+	}
+}
+
 // sweep takes a set of preselected inputs, creates a sweep tx and publishes the
 // tx. The output address is only marked as used if the publish succeeds.
 func (s *UtxoSweeper) sweep(inputs inputSet, feeRate chainfee.SatPerKWeight,
@@ -1195,6 +1475,14 @@ func (s *UtxoSweeper) sweep(inputs inputSet, feeRate chainfee.SatPerKWeight,
 		return er.Errorf("create sweep tx: %v", err)
 	}
 
+	// Lease the wallet inputs that were attached to the tx, so that they
+	// aren't spent elsewhere in the meantime. This fails if an input has
+	// been leased by someone else since the inputs were selected.
+	walletInputs, err := s.leaseWalletInputs(inputs)
+	if err != nil {
+		return er.Errorf("lease wallet inputs: %v", err)
+	}
+
 	// Add tx before publication, so that we will always know that a spend
 	// by this tx is ours. Otherwise if the publish doesn't return, but did
 	// publish, we loose track of this tx. Even republication on startup
@@ -1204,6 +1492,7 @@ func (s *UtxoSweeper) sweep(inputs inputSet, feeRate chainfee.SatPerKWeight,
 	if err != nil {
 		return er.Errorf("notify publish tx: %v", err)
 	}
+	s.publishedSweeps[tx.TxHash()] = newPublishedSweep(tx, inputs)
 
 	// Publish sweep tx.
 	log.Debugf("Publishing sweep tx %v, num_inputs=%v, height=%v",
@@ -1215,7 +1504,15 @@ func (s *UtxoSweeper) sweep(inputs inputSet, feeRate chainfee.SatPerKWeight,
 		}),
 	)
 
-	err = s.cfg.Wallet.PublishTransaction(tx, "")
+	// The label notes which kind of outputs are swept, so that the tx can
+	// be identified in the wallet later.
+	err = s.cfg.Wallet.PublishTransaction(tx, sweepLabel(inputs))
+
+	// The wallet inputs are available again if the tx didn't make it into
+	// the mempool.
+	if err != nil {
+		s.releaseWalletInputs(walletInputs)
+	}
 
 	// In case of an unexpected error, don't try to recover.
 	if err != nil && !lnwallet.ErrDoubleSpend.Is(err) {
@@ -1240,8 +1537,10 @@ func (s *UtxoSweeper) sweep(inputs inputSet, feeRate chainfee.SatPerKWeight,
 			continue
 		}
 
-		// Record another publish attempt.
+		// Record another publish attempt and whether it was rejected
+		// because the input is already spent by another tx.
 		pi.publishAttempts++
+		pi.conflicted = err != nil
 
 		// We don't care what the result of the publish call was. Even
 		// if it is published successfully, it can still be that it
@@ -1263,7 +1562,12 @@ func (s *UtxoSweeper) sweep(inputs inputSet, feeRate chainfee.SatPerKWeight,
 			s.signalAndRemove(&input.PreviousOutPoint, Result{
 				Err: ErrTooManyAttempts.Default(),
 			})
+			continue
 		}
+
+		// Persist the attempt, so that the back-off continues after a
+		// restart.
+		s.persistInput(pi)
 	}
 
 	return nil
@@ -1309,6 +1613,143 @@ func (s *UtxoSweeper) waitForSpend(outpoint wire.OutPoint,
 	return spendEvent.Cancel, nil
 }
 
+// forgetPublishedSweeps stops tracking all published sweep txes that spend
+// any of the inputs of the given tx.
+func (s *UtxoSweeper) forgetPublishedSweeps(tx *wire.MsgTx) {
+	for txid, published := range s.publishedSweeps {
+		for _, txIn := range tx.TxIn {
+			if published.spends(txIn.PreviousOutPoint) {
+				delete(s.publishedSweeps, txid)
+				break
+			}
+		}
+	}
+}
+
+// waitForSweepConf registers a confirmation notification for a sweep tx of
+// ours and notifies the confirmation subscribers once the tx has reached the
+// configured confirmation depth. If the fee of the sweep tx is known, it is
+// attributed to the inputs in the confirmation.
+func (s *UtxoSweeper) waitForSweepConf(spend *chainntnfs.SpendDetail,
+	outpoints []wire.OutPoint, fee *btcutil.Amount) er.R {
+	sweepTx := spend.SpendingTx
+	if len(sweepTx.TxOut) == 0 {
+		return er.Errorf("sweep tx %v has no outputs",
+			spend.SpenderTxHash)
+	}
+
+	numConfs := s.cfg.SweepConfDepth
+	if numConfs == 0 {
+		numConfs = DefaultSweepConfDepth
+	}
+
+	log.Debugf("Wait for %v confirmations of sweep tx %v", numConfs,
+		spend.SpenderTxHash)
+
+	confEvent, err := s.cfg.Notifier.RegisterConfirmationsNtfn(
+		spend.SpenderTxHash, sweepTx.TxOut[0].PkScript, numConfs,
+		uint32(spend.SpendingHeight),
+	)
+	if err != nil {
+		return er.Errorf("register conf ntfn: %v", err)
+	}
+
+	sweepTxid := *spend.SpenderTxHash
+
+	sweepConf := &SweepConfirmation{
+		Outpoints: outpoints,
+		SweepTxid: sweepTxid,
+	}
+	if fee != nil {
+		sweepConf.Fee = *fee
+		sweepConf.InputFees = attributeFee(sweepTx, *fee)
+	}
+
+	s.confWg.Add(1)
+	go func() {
+		defer s.confWg.Done()
+		defer confEvent.Cancel()
+
+		select {
+		case conf, ok := <-confEvent.Confirmed:
+			if !ok {
+				log.Debugf("Conf ntfn for sweep tx %v canceled",
+					sweepTxid)
+				return
+			}
+
+			log.Debugf("Sweep tx %v confirmed at height %v",
+				sweepTxid, conf.BlockHeight)
+
+			sweepConf.ConfirmationHeight = conf.BlockHeight
+			err := s.confNtfnServer.SendUpdate(sweepConf)
+			if err != nil {
+				log.Debugf("Unable to send sweep conf for "+
+					"%v: %v", sweepTxid, err)
+			}
+
+		case <-s.quit:
+		}
+	}()
+
+	return nil
+}
+
+// SubscribeSweepConfirmations returns a subscription that is notified every
+// time a sweep tx of ours reaches the configured confirmation depth. If
+// outpoint is non-nil, only confirmations of sweeps that spent this outpoint
+// are delivered.
+func (s *UtxoSweeper) SubscribeSweepConfirmations(
+	outpoint *wire.OutPoint) (*ConfirmationSubscription, er.R) {
+	client, err := s.confNtfnServer.Subscribe()
+	if err != nil {
+		return nil, err
+	}
+
+	confChan := make(chan *SweepConfirmation)
+	go func() {
+		defer close(confChan)
+
+		for {
+			select {
+			case update, ok := <-client.Updates():
+				if !ok {
+					return
+				}
+
+				conf := update.(*SweepConfirmation)
+				if outpoint != nil && !conf.spends(*outpoint) {
+					continue
+				}
+
+				select {
+				case confChan <- conf:
+				case <-client.Quit():
+					return
+				}
+
+			case <-client.Quit():
+				return
+			}
+		}
+	}()
+
+	return &ConfirmationSubscription{
+		Confirmations: confChan,
+		Cancel:        client.Cancel,
+	}, nil
+}
+
+// spends returns true if the given outpoint was swept by the confirmed tx.
+func (c *SweepConfirmation) spends(outpoint wire.OutPoint) bool {
+	for _, op := range c.Outpoints {
+		if op == outpoint {
+			return true
+		}
+	}
+	return false
+}
+
 // PendingInputs returns the set of inputs that the UtxoSweeper is currently
 // attempting to sweep.
 func (s *UtxoSweeper) PendingInputs() (map[wire.OutPoint]*PendingInput, er.R) {
@@ -1335,8 +1776,9 @@ func (s *UtxoSweeper) PendingInputs() (map[wire.OutPoint]*PendingInput, er.R) {
 
 // handlePendingSweepsReq handles a request to retrieve all pending inputs the
 // UtxoSweeper is attempting to sweep.
-func (s *UtxoSweeper) handlePendingSweepsReq(
-	req *pendingSweepsReq) map[wire.OutPoint]*PendingInput {
+func (s *UtxoSweeper) handlePendingSweepsReq(req *pendingSweepsReq,
+	bestHeight int32) map[wire.OutPoint]*PendingInput {
+	reasons := s.pendingReasons(bestHeight)
 	pendingInputs := make(map[wire.OutPoint]*PendingInput, len(s.pendingInputs))
 	for _, pendingInput := range s.pendingInputs {
 		// Only the exported fields are set, as we expect the response
@@ -1352,6 +1794,7 @@ func (s *UtxoSweeper) handlePendingSweepsReq(
 			BroadcastAttempts:   pendingInput.publishAttempts,
 			NextBroadcastHeight: uint32(pendingInput.minPublishHeight),
 			Params:              pendingInput.params,
+			Reason:              reasons[op],
 		}
 	}
 
@@ -1426,6 +1869,7 @@ func (s *UtxoSweeper) handleUpdateReq(req *updateReq, bestHeight int32) (
 		pendingInput.params, newParams)
 
 	pendingInput.params = newParams
+	s.persistInput(pendingInput)
 
 	// We'll reset the input's publish height to the current so that a new
 	// transaction can be created that replaces the transaction currently
@@ -1470,6 +1914,7 @@ func (s *UtxoSweeper) CreateSweepTx(inputs []input.Input, feePref FeePreference,
 	if err != nil {
 		return nil, err
 	}
+	feePerKw = s.clampFeeRate(feePerKw)
 
 	// Generate the receiving script to which the funds will be swept.
 	pkScript, err := s.cfg.GenSweepScript()
@@ -21,6 +21,7 @@ import (
 	"github.com/pkt-cash/pktd/lnd/lnwallet"
 	"github.com/pkt-cash/pktd/lnd/lnwallet/chainfee"
 	"github.com/pkt-cash/pktd/pktlog/log"
+	"github.com/pkt-cash/pktd/pktwallet/wtxmgr"
 	"github.com/pkt-cash/pktd/wire"
 	"github.com/stretchr/testify/require"
 )
@@ -413,6 +414,111 @@ func TestSuccess(t *testing.T) {
 	}
 }
 
+// TestSweepLabel asserts that a sweep tx is published with a label that notes
+// the kind of outputs it sweeps, so that it can be found in the wallet.
+func TestSweepLabel(t *testing.T) {
+	ctx := createSweeperTestContext(t)
+
+	htlcInput := createTestInput(100000, input.HtlcOfferedRemoteTimeout)
+	_, err := ctx.sweeper.SweepInput(&htlcInput, defaultFeePref)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx.tick()
+
+	sweepTx := ctx.receiveTx()
+	label := ctx.backend.label(sweepTx.TxHash())
+	if label != "0:sweep:kind-htlc-timeout" {
+		t.Fatalf("unexpected sweep tx label %q", label)
+	}
+
+	ctx.backend.mine()
+	ctx.finish(1)
+}
+
+// TestSweepConfirmation asserts that subscribers are notified once a sweep tx
+// confirms, and that the outpoint filter is applied.
+func TestSweepConfirmation(t *testing.T) {
+	ctx := createSweeperTestContext(t)
+
+	sweptInput := spendableInputs[0]
+	sub, err := ctx.sweeper.SubscribeSweepConfirmations(
+		sweptInput.OutPoint(),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sub.Cancel()
+
+	otherSub, err := ctx.sweeper.SubscribeSweepConfirmations(
+		spendableInputs[1].OutPoint(),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer otherSub.Cancel()
+
+	resultChan, err := ctx.sweeper.SweepInput(sweptInput, defaultFeePref)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx.tick()
+
+	sweepTx := ctx.receiveTx()
+
+	ctx.backend.mine()
+	ctx.expectResult(resultChan, nil)
+
+	sweepTxid := sweepTx.TxHash()
+	if err := ctx.notifier.ConfirmTx(&sweepTxid, 150); err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case conf := <-sub.Confirmations:
+		if conf.SweepTxid != sweepTxid {
+			t.Fatalf("expected sweep tx %v, got %v", sweepTxid,
+				conf.SweepTxid)
+		}
+		if conf.ConfirmationHeight != 150 {
+			t.Fatalf("expected confirmation height 150, got %v",
+				conf.ConfirmationHeight)
+		}
+		if len(conf.Outpoints) != 1 ||
+			conf.Outpoints[0] != *sweptInput.OutPoint() {
+
+			t.Fatalf("unexpected outpoints %v", conf.Outpoints)
+		}
+
+		expectedFee := btcutil.Amount(
+			sweptInput.SignDesc().Output.Value -
+				sweepTx.TxOut[0].Value,
+		)
+		if conf.Fee != expectedFee {
+			t.Fatalf("expected fee %v, got %v", expectedFee,
+				conf.Fee)
+		}
+		if conf.InputFees[*sweptInput.OutPoint()] != expectedFee {
+			t.Fatalf("expected input fee %v, got %v", expectedFee,
+				conf.InputFees)
+		}
+
+	case <-time.After(defaultTestTimeout):
+		t.Fatalf("no sweep confirmation received")
+	}
+
+	// The other subscriber filters on an input that wasn't swept.
+	select {
+	case conf := <-otherSub.Confirmations:
+		t.Fatalf("unexpected sweep confirmation %v", conf.SweepTxid)
+	case <-time.After(processingDelay):
+	}
+
+	ctx.finish(1)
+}
+
 // TestDust asserts that inputs that are not big enough to raise above the dust
 // limit, are held back until the total set does surpass the limit.
 func TestDust(t *testing.T) {
@@ -506,6 +612,92 @@ func TestWalletUtxo(t *testing.T) {
 	ctx.finish(1)
 }
 
+// TestWalletUtxoLease asserts that wallet utxos that are leased elsewhere aren't
+// attached to sweep txes and that attached wallet utxos are leased by the
+// sweeper.
+func TestWalletUtxoLease(t *testing.T) {
+	ctx := createSweeperTestContext(t)
+
+	// Lease the largest wallet utxo, which would otherwise be attached
+	// first, outside of the sweeper.
+	ctx.backend.walletUtxos = []*lnwallet.Utxo{
+		{
+			Value:       btcutil.Amount(1_000_000),
+			AddressType: lnwallet.WitnessPubKey,
+		},
+		{
+			Value:       btcutil.Amount(2_000_000),
+			AddressType: lnwallet.WitnessPubKey,
+			OutPoint:    wire.OutPoint{Index: 1},
+		},
+	}
+	otherLockID := wtxmgr.LockID{1}
+	ctx.backend.leaseUtxo(1, otherLockID)
+
+	// Offer an input that needs a wallet utxo to be swept, see
+	// TestWalletUtxo.
+	dustInput := createTestInput(294, input.WitnessKeyHash)
+	_, err := ctx.sweeper.SweepInput(
+		&dustInput,
+		Params{Fee: FeePreference{FeeRate: chainfee.FeePerKwFloor}},
+	)
+	util.RequireNoErr(t, err)
+
+	ctx.tick()
+
+	// The sweep tx is expected to spend the smaller wallet utxo.
+	sweepTx := ctx.receiveTx()
+	require.Len(t, sweepTx.TxIn, 2)
+	require.Equal(
+		t, int64(294+1_000_000-180), sweepTx.TxOut[0].Value,
+	)
+
+	// The attached wallet utxo is leased by the sweeper, while the lease
+	// taken elsewhere is left alone.
+	for _, txIn := range sweepTx.TxIn {
+		if txIn.PreviousOutPoint == *dustInput.OutPoint() {
+			continue
+		}
+
+		id, ok := ctx.backend.leaseID(txIn.PreviousOutPoint)
+		require.True(t, ok)
+		require.Equal(t, SweeperLockID, id)
+	}
+
+	id, ok := ctx.backend.leaseID(ctx.backend.walletUtxos[1].OutPoint)
+	require.True(t, ok)
+	require.Equal(t, otherLockID, id)
+
+	ctx.backend.mine()
+	ctx.finish(1)
+}
+
+// TestWalletUtxoReserve asserts that no wallet utxos are attached to sweep txes
+// if that would spend part of the configured reserve.
+func TestWalletUtxoReserve(t *testing.T) {
+	ctx := createSweeperTestContext(t)
+
+	// Reserve the full value of the single wallet utxo.
+	ctx.sweeper.cfg.WalletInputPolicy = WalletInputPolicy{
+		Reserve: 1_000_000,
+	}
+	ctx.restartSweeper()
+
+	// Offer an input that needs a wallet utxo to be swept, see
+	// TestWalletUtxo. Because the wallet utxo can't be used, the sweep
+	// output would be below the dust limit and no sweep is scheduled.
+	dustInput := createTestInput(294, input.WitnessKeyHash)
+	_, err := ctx.sweeper.SweepInput(
+		&dustInput,
+		Params{Fee: FeePreference{FeeRate: chainfee.FeePerKwFloor}},
+	)
+	util.RequireNoErr(t, err)
+
+	ctx.assertNoTick()
+
+	ctx.finish(1)
+}
+
 // TestNegativeInput asserts that no inputs with a negative yield are swept.
 // Negative yield means that the value minus the added fee is negative.
 func TestNegativeInput(t *testing.T) {
@@ -964,6 +1156,109 @@ func TestRestartRepublish(t *testing.T) {
 	ctx.finish(1)
 }
 
+// TestRestartPendingInputs asserts that the sweeper resumes sweeping the inputs
+// that were pending when it was shut down during the batch window.
+func TestRestartPendingInputs(t *testing.T) {
+	ctx := createSweeperTestContext(t)
+
+	input1 := spendableInputs[0]
+	if _, err := ctx.sweeper.SweepInput(input1, defaultFeePref); err != nil {
+		t.Fatal(err)
+	}
+
+	input2 := spendableInputs[1]
+	if _, err := ctx.sweeper.SweepInput(input2, defaultFeePref); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx.assertPendingInputs(input1, input2)
+
+	// Restart the sweeper before the batch timer expires. The timer of the
+	// sweeper that is shut down is dropped.
+	<-ctx.timeoutChan
+	ctx.restartSweeper()
+
+	// Both inputs are expected to be restored without being offered again.
+	ctx.assertPendingInputs(input1, input2)
+
+	// Simulate other subsystem (e.g. contract resolver) re-offering only
+	// one of the inputs.
+	resultChan, err := ctx.sweeper.SweepInput(input1, defaultFeePref)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The batch timer that is started for the restored inputs should
+	// trigger a sweep of both of them.
+	ctx.tick()
+
+	sweepTx := ctx.receiveTx()
+	assertTxSweepsInputs(t, &sweepTx, input1, input2)
+
+	ctx.backend.mine()
+
+	ctx.expectResult(resultChan, nil)
+	ctx.assertPendingInputs()
+
+	// Restart sweeper again. The swept inputs should not be restored.
+	ctx.restartSweeper()
+
+	// Expect last tx to be republished.
+	ctx.receiveTx()
+
+	ctx.assertPendingInputs()
+
+	ctx.finish(1)
+}
+
+// TestRestartSweptOffline asserts that a restored input which was spent while
+// the sweeper was shut down is dropped.
+func TestRestartSweptOffline(t *testing.T) {
+	ctx := createSweeperTestContext(t)
+
+	input1 := spendableInputs[0]
+	if _, err := ctx.sweeper.SweepInput(input1, defaultFeePref); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx.assertPendingInputs(input1)
+
+	// Shut down the sweeper before the batch timer expires and spend the
+	// input remotely while it is offline.
+	<-ctx.timeoutChan
+	ctx.sweeper.Stop()
+
+	remoteTx := &wire.MsgTx{
+		TxIn: []*wire.TxIn{
+			{
+				PreviousOutPoint: *(input1.OutPoint()),
+			},
+		},
+	}
+	if err := ctx.backend.publishTransaction(remoteTx); err != nil {
+		t.Fatal(err)
+	}
+	ctx.backend.mine()
+
+	ctx.restartSweeper()
+
+	// Simulate other subsystem (e.g. contract resolver) re-offering the
+	// input. The restored input is dropped because of the remote spend.
+	resultChan, err := ctx.sweeper.SweepInput(input1, defaultFeePref)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx.expectResult(resultChan, ErrRemoteSpend)
+	ctx.assertPendingInputs()
+
+	// Timer started for the restored input but not needed because spend
+	// ntfn was sent.
+	ctx.tick()
+
+	ctx.finish(1)
+}
+
 // TestRetry tests the sweeper retry flow.
 func TestRetry(t *testing.T) {
 	ctx := createSweeperTestContext(t)
@@ -1110,6 +1405,75 @@ func TestDifferentFeePreferences(t *testing.T) {
 	ctx.finish(1)
 }
 
+// TestFeeRateClamps asserts that the fee rate of sweep txes is raised to the
+// configured floor and lowered to the configured ceiling.
+func TestFeeRateClamps(t *testing.T) {
+	testCases := []struct {
+		name            string
+		minSweepFeeRate chainfee.SatPerKWeight
+		maxSweepFeeRate chainfee.SatPerKWeight
+		feeRate         chainfee.SatPerKWeight
+		expectedFeeRate chainfee.SatPerKWeight
+	}{
+		{
+			name:            "floor",
+			minSweepFeeRate: 6000,
+			feeRate:         5000,
+			expectedFeeRate: 6000,
+		},
+		{
+			name:            "ceiling",
+			maxSweepFeeRate: 8000,
+			feeRate:         10000,
+			expectedFeeRate: 8000,
+		},
+		{
+			name:            "within bounds",
+			minSweepFeeRate: 6000,
+			maxSweepFeeRate: 8000,
+			feeRate:         7000,
+			expectedFeeRate: 7000,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			ctx := createSweeperTestContext(t)
+
+			cfg := ctx.sweeper.cfg
+			cfg.MinSweepFeeRate = testCase.minSweepFeeRate
+			cfg.MaxSweepFeeRate = testCase.maxSweepFeeRate
+			ctx.restartSweeper()
+
+			feePref := FeePreference{ConfTarget: 6}
+			ctx.estimator.blocksToFee[feePref.ConfTarget] =
+				testCase.feeRate
+
+			input := spendableInputs[0]
+			resultChan, err := ctx.sweeper.SweepInput(
+				input, Params{Fee: feePref},
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			ctx.tick()
+
+			sweepTx := ctx.receiveTx()
+			assertTxFeeRate(
+				t, &sweepTx, testCase.expectedFeeRate, input,
+			)
+
+			ctx.backend.mine()
+			ctx.expectResult(resultChan, nil)
+
+			ctx.finish(1)
+		})
+	}
+}
+
 // TestPendingInputs ensures that the sweeper correctly determines the inputs
 // pending to be swept.
 func TestPendingInputs(t *testing.T) {
@@ -1185,6 +1549,70 @@ func TestPendingInputs(t *testing.T) {
 	ctx.finish(1)
 }
 
+// TestPendingInputReason asserts that the sweeper reports why each of its
+// pending inputs hasn't been swept yet.
+func TestPendingInputReason(t *testing.T) {
+	ctx := createSweeperTestContext(t)
+
+	assertReason := func(inp input.Input, expected PendingReason) {
+		t.Helper()
+
+		reason, err := ctx.sweeper.PendingInputReason(*inp.OutPoint())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if reason != expected {
+			t.Fatalf("expected reason %v for %v, got %v", expected,
+				inp.OutPoint(), reason)
+		}
+	}
+
+	// An input whose sweep tx output would be below the dust limit on its
+	// own is kept pending.
+	dustInput := createTestInput(5260, input.CommitmentTimeLock)
+	resultChan1, err := ctx.sweeper.SweepInput(&dustInput, defaultFeePref)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertReason(&dustInput, PendingBelowDust)
+
+	// Another input brings the sweep tx output above the dust limit, so
+	// both inputs wait for the batch timer to expire.
+	largeInput := spendableInputs[0]
+	resultChan2, err := ctx.sweeper.SweepInput(largeInput, defaultFeePref)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertReason(&dustInput, PendingBatchWindow)
+	assertReason(largeInput, PendingBatchWindow)
+
+	// Once the sweep tx is published, the inputs wait for the height of
+	// the next attempt.
+	ctx.tick()
+	ctx.receiveTx()
+	assertReason(&dustInput, PendingNextAttempt)
+	assertReason(largeInput, PendingNextAttempt)
+
+	// The next attempt conflicts with the unconfirmed sweep tx.
+	ctx.notifier.NotifyEpoch(101)
+	ctx.tick()
+	ctx.receiveTx()
+	assertReason(&dustInput, PendingConflict)
+	assertReason(largeInput, PendingConflict)
+
+	// Inputs that aren't pending have no reason.
+	_, err = ctx.sweeper.PendingInputReason(*spendableInputs[1].OutPoint())
+	if !lnwallet.ErrNotMine.Is(err) {
+		t.Fatalf("expected ErrNotMine, got %v", err)
+	}
+
+	ctx.backend.mine()
+	ctx.expectResult(resultChan1, nil)
+	ctx.expectResult(resultChan2, nil)
+
+	ctx.finish(1)
+}
+
 // TestBumpFeeRBF ensures that the UtxoSweeper can properly handle a fee bump
 // request for an input it is currently attempting to sweep. When sweeping the
 // input with the higher fee rate, a replacement transaction is created.
@@ -112,6 +112,7 @@ func (m *MockNotifier) RegisterConfirmationsNtfn(txid *chainhash.Hash,
 	er.R) {
 	return &chainntnfs.ConfirmationEvent{
 		Confirmed: m.getConfChannel(txid),
+		Cancel:    func() {},
 	}, nil
 }
 
@@ -120,6 +120,9 @@ type txInputSet struct {
 	// wallet contains wallet functionality required by the input set to
 	// retrieve utxos.
 	wallet Wallet
+
+	// walletPolicy restricts which wallet utxos may be added to the set.
+	walletPolicy WalletInputPolicy
 }
 
 func dustLimit(relayFee chainfee.SatPerKWeight) btcutil.Amount {
@@ -130,7 +133,7 @@ func dustLimit(relayFee chainfee.SatPerKWeight) btcutil.Amount {
 }
 
 // newTxInputSet constructs a new, empty input set.
-func newTxInputSet(wallet Wallet, feePerKW,
+func newTxInputSet(wallet Wallet, walletPolicy WalletInputPolicy, feePerKW,
 	relayFee chainfee.SatPerKWeight, maxInputs int) *txInputSet {
 	dustLimit := dustLimit(relayFee)
 
@@ -142,6 +145,7 @@ func newTxInputSet(wallet Wallet, feePerKW,
 		dustLimit:       dustLimit,
 		maxInputs:       maxInputs,
 		wallet:          wallet,
+		walletPolicy:    walletPolicy,
 		txInputSetState: state,
 	}
 
@@ -313,7 +317,9 @@ func (t *txInputSet) addPositiveYieldInputs(sweepableInputs []txInput) {
 }
 
 // tryAddWalletInputsIfNeeded retrieves utxos from the wallet and tries adding as
-// many as required to bring the tx output value above the given minimum.
+// many as required to bring the tx output value above the given minimum. The
+// utxos are added in the order of the wallet input policy and the total value
+// added never eats into its reserve.
 func (t *txInputSet) tryAddWalletInputsIfNeeded() er.R {
 	// If we've already have enough to pay the transaction fees and have at
 	// least one output materialize, no action is needed.
@@ -322,13 +328,26 @@ func (t *txInputSet) tryAddWalletInputsIfNeeded() er.R {
 	}
 
 	// Retrieve wallet utxos. Only consider confirmed utxos to prevent
-	// problems around RBF rules for unconfirmed inputs.
-	utxos, err := t.wallet.ListUnspentWitness(1, math.MaxInt32)
+	// problems around RBF rules for unconfirmed inputs. Utxos that are
+	// leased or locked elsewhere aren't returned by the wallet.
+	utxos, err := t.wallet.ListUnspentWitness(
+		t.walletPolicy.minConfs(), math.MaxInt32,
+	)
 	if err != nil {
 		return err
 	}
 
-	for _, utxo := range utxos {
+	budget := t.walletPolicy.budget(utxos)
+	for _, utxo := range t.walletPolicy.sortedUtxos(utxos) {
+		// Skip utxos that would make us spend part of the reserve.
+		if t.walletInputTotal+utxo.Value > budget {
+			log.Debugf("Skipping wallet input %v of %v, because "+
+				"only %v of the wallet balance may be used",
+				utxo.OutPoint, utxo.Value, budget)
+
+			continue
+		}
+
 		input, err := createWalletTxInput(utxo)
 		if err != nil {
 			return err
@@ -376,7 +395,16 @@ func createWalletTxInput(utxo *lnwallet.Utxo) (input.Input, er.R) {
 	// inputs for spend.
 	heightHint := uint32(0)
 
-	return input.NewBaseInput(
-		&utxo.OutPoint, witnessType, signDesc, heightHint,
-	), nil
+	return &walletInput{
+		BaseInput: input.NewBaseInput(
+			&utxo.OutPoint, witnessType, signDesc, heightHint,
+		),
+	}, nil
+}
+
+// walletInput is a wallet utxo that is attached to a sweep tx to bring up its
+// output value. It is kept apart from the inputs offered to the sweeper, so
+// that it can be leased before the tx is published.
+type walletInput struct {
+	*input.BaseInput
 }
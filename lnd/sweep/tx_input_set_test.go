@@ -19,7 +19,9 @@ func TestTxInputSet(t *testing.T) {
 		relayFee  = 300
 		maxInputs = 10
 	)
-	set := newTxInputSet(nil, feeRate, relayFee, maxInputs)
+	set := newTxInputSet(
+		nil, WalletInputPolicy{}, feeRate, relayFee, maxInputs,
+	)
 
 	if set.dustLimit != 537 {
 		t.Fatalf("incorrect dust limit")
@@ -73,7 +75,9 @@ func TestTxInputSetFromWallet(t *testing.T) {
 	)
 
 	wallet := &mockWallet{}
-	set := newTxInputSet(wallet, feeRate, relayFee, maxInputs)
+	set := newTxInputSet(
+		wallet, WalletInputPolicy{}, feeRate, relayFee, maxInputs,
+	)
 
 	// Add a 700 sat input to the set. It yields positively, but doesn't
 	// reach the output dust limit.
@@ -110,12 +114,109 @@ func createP2WKHInput(amt btcutil.Amount) input.Input {
 	return &input
 }
 
+// TestTxInputSetWalletPolicy tests that wallet inputs are added in the order of
+// the wallet input policy and that its reserve is never spent.
+func TestTxInputSetWalletPolicy(t *testing.T) {
+	const (
+		feeRate   = 500
+		relayFee  = 300
+		maxInputs = 10
+	)
+
+	testCases := []struct {
+		name   string
+		policy WalletInputPolicy
+
+		// expectedWalletInput is the value of the wallet input that
+		// is expected to be added, or zero if none is expected.
+		expectedWalletInput btcutil.Amount
+	}{
+		{
+			name:                "largest first",
+			policy:              WalletInputPolicy{},
+			expectedWalletInput: 20000,
+		},
+		{
+			name: "smallest first",
+			policy: WalletInputPolicy{
+				Selection: SelectSmallestFirst,
+			},
+			expectedWalletInput: 10000,
+		},
+		{
+			// Only 15000 sats of the 30000 sats in the wallet may
+			// be used, so the largest utxo is skipped.
+			name: "reserve skips largest",
+			policy: WalletInputPolicy{
+				Reserve: 15000,
+			},
+			expectedWalletInput: 10000,
+		},
+		{
+			name: "reserve exceeds utxos",
+			policy: WalletInputPolicy{
+				Reserve: 25000,
+			},
+		},
+	}
+
+	for _, test := range testCases {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			wallet := &mockWallet{
+				utxos: []*lnwallet.Utxo{
+					{
+						AddressType: lnwallet.WitnessPubKey,
+						Value:       10000,
+					},
+					{
+						AddressType: lnwallet.WitnessPubKey,
+						Value:       20000,
+					},
+				},
+			}
+			set := newTxInputSet(
+				wallet, test.policy, feeRate, relayFee,
+				maxInputs,
+			)
+
+			// Add a 700 sat input that doesn't reach the output
+			// dust limit by itself.
+			require.True(t, set.add(
+				createP2WKHInput(700), constraintsRegular,
+			))
+			require.False(t, set.enoughInput())
+
+			util.RequireNoErr(t, set.tryAddWalletInputsIfNeeded())
+
+			require.Equal(
+				t, test.expectedWalletInput,
+				set.walletInputTotal,
+			)
+			require.Equal(
+				t, test.expectedWalletInput != 0,
+				set.enoughInput(),
+			)
+		})
+	}
+}
+
+// mockWallet is a wallet that returns a single 10000 sat utxo, unless other
+// utxos are set.
 type mockWallet struct {
 	Wallet
+
+	utxos []*lnwallet.Utxo
 }
 
 func (m *mockWallet) ListUnspentWitness(minconfirms, maxconfirms int32) (
 	[]*lnwallet.Utxo, er.R) {
+
+	if m.utxos != nil {
+		return m.utxos, nil
+	}
+
 	return []*lnwallet.Utxo{
 		{
 			AddressType: lnwallet.WitnessPubKey,
@@ -142,7 +243,9 @@ func TestTxInputSetRequiredOutput(t *testing.T) {
 		relayFee  = 300
 		maxInputs = 10
 	)
-	set := newTxInputSet(nil, feeRate, relayFee, maxInputs)
+	set := newTxInputSet(
+		nil, WalletInputPolicy{}, feeRate, relayFee, maxInputs,
+	)
 	if set.dustLimit != 537 {
 		t.Fatalf("incorrect dust limit")
 	}
@@ -38,7 +38,8 @@ type inputSet []input.Input
 // dust limit are returned.
 func generateInputPartitionings(sweepableInputs []txInput,
 	relayFeePerKW, feePerKW chainfee.SatPerKWeight,
-	maxInputsPerTx int, wallet Wallet) ([]inputSet, er.R) {
+	maxInputsPerTx int, wallet Wallet,
+	walletPolicy WalletInputPolicy) ([]inputSet, er.R) {
 	// Sort input by yield. We will start constructing input sets starting
 	// with the highest yield inputs. This is to prevent the construction
 	// of a set with an output below the dust limit, causing the sweep
@@ -85,7 +86,8 @@ func generateInputPartitionings(sweepableInputs []txInput,
 		// condition that the tx will be published with the specified
 		// fee rate.
 		txInputs := newTxInputSet(
-			wallet, feePerKW, relayFeePerKW, maxInputsPerTx,
+			wallet, walletPolicy, feePerKW, relayFeePerKW,
+			maxInputsPerTx,
 		)
 
 		// From the set of sweepable inputs, keep adding inputs to the
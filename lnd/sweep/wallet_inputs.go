@@ -0,0 +1,115 @@
+package sweep
+
+import (
+	"sort"
+
+	"github.com/pkt-cash/pktd/btcutil"
+	"github.com/pkt-cash/pktd/lnd/lnwallet"
+	"github.com/pkt-cash/pktd/pktwallet/wtxmgr"
+)
+
+var (
+	// SweeperLockID is the binary representation of the SHA256 hash of the
+	// string "lnd-sweeper-lock-id" and is used for UTXO lock leases to
+	// identify that the sweeper is locking a wallet UTXO that it attached
+	// to a sweep tx. The ID corresponds to the hex value of
+	// 3884133f5717d2edd2a4be4e142306698297ab317b60be037cd496ecad6442e8.
+	SweeperLockID = wtxmgr.LockID{
+		0x38, 0x84, 0x13, 0x3f, 0x57, 0x17, 0xd2, 0xed,
+		0xd2, 0xa4, 0xbe, 0x4e, 0x14, 0x23, 0x06, 0x69,
+		0x82, 0x97, 0xab, 0x31, 0x7b, 0x60, 0xbe, 0x03,
+		0x7c, 0xd4, 0x96, 0xec, 0xad, 0x64, 0x42, 0xe8,
+	}
+)
+
+// WalletInputSelection defines the order in which wallet utxos are attached to
+// sweep txes that can't pay for themselves, such as the cpfp of an anchor.
+type WalletInputSelection uint8
+
+const (
+	// SelectLargestFirst attaches the largest wallet utxos first, which
+	// keeps the number of attached inputs and thereby the fee low.
+	SelectLargestFirst WalletInputSelection = iota
+
+	// SelectSmallestFirst attaches the smallest wallet utxos first, which
+	// keeps the large utxos available for other uses such as channel
+	// funding.
+	SelectSmallestFirst
+)
+
+// String returns a human readable name of the selection order.
+func (s WalletInputSelection) String() string {
+	switch s {
+	case SelectLargestFirst:
+		return "largest"
+	case SelectSmallestFirst:
+		return "smallest"
+	default:
+		return "unknown"
+	}
+}
+
+// WalletInputPolicy restricts which wallet utxos the sweeper may attach to a
+// sweep tx to bump its fee. Utxos that are leased or locked elsewhere are
+// never considered, because the wallet doesn't list them as unspent.
+type WalletInputPolicy struct {
+	// Reserve is the amount of the confirmed wallet balance that is never
+	// spent on sweep txes, so that it stays available for other uses.
+	Reserve btcutil.Amount
+
+	// MinConfs is the number of confirmations that a wallet utxo needs
+	// before it is attached. If zero, DefaultWalletInputMinConfs is used.
+	MinConfs int32
+
+	// Selection is the order in which wallet utxos are attached.
+	Selection WalletInputSelection
+}
+
+// DefaultWalletInputMinConfs is the number of confirmations that a wallet utxo
+// needs by default before it is attached to a sweep tx. Unconfirmed utxos are
+// never attached to prevent problems around RBF rules.
+const DefaultWalletInputMinConfs = 1
+
+// minConfs returns the number of confirmations that is required of wallet
+// utxos.
+func (p *WalletInputPolicy) minConfs() int32 {
+	if p.MinConfs < DefaultWalletInputMinConfs {
+		return DefaultWalletInputMinConfs
+	}
+
+	return p.MinConfs
+}
+
+// budget returns the total value of the given wallet utxos that may be
+// attached to a single sweep tx, which is what is left after the reserve.
+func (p *WalletInputPolicy) budget(utxos []*lnwallet.Utxo) btcutil.Amount {
+	var total btcutil.Amount
+	for _, utxo := range utxos {
+		total += utxo.Value
+	}
+
+	if total <= p.Reserve {
+		return 0
+	}
+
+	return total - p.Reserve
+}
+
+// sortedUtxos returns a copy of the given wallet utxos, ordered according to
+// the selection order of the policy.
+func (p *WalletInputPolicy) sortedUtxos(
+	utxos []*lnwallet.Utxo) []*lnwallet.Utxo {
+
+	sorted := make([]*lnwallet.Utxo, len(utxos))
+	copy(sorted, utxos)
+
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if p.Selection == SelectSmallestFirst {
+			return sorted[i].Value < sorted[j].Value
+		}
+
+		return sorted[i].Value > sorted[j].Value
+	})
+
+	return sorted
+}
@@ -0,0 +1,91 @@
+package neutrino
+
+import (
+	"github.com/pkt-cash/pktd/btcutil/er"
+)
+
+// SyncProgress describes how far the chain service has come syncing block
+// headers and filter headers.
+type SyncProgress struct {
+	// HeaderHeight is the height of the best block header.
+	HeaderHeight int32
+
+	// FilterHeaderHeight is the height of the best filter header, it
+	// lags behind the block headers while syncing.
+	FilterHeaderHeight int32
+
+	// TargetHeight is the height of the best block announced by any of the
+	// connected peers, or the header height if that is higher. It moves
+	// along as new blocks arrive.
+	TargetHeight int32
+
+	// Current is set once the chain service considers itself synced with
+	// the network.
+	Current bool
+}
+
+// newSyncProgress creates the sync progress from the heights of the header
+// chains and the best heights of the peers.
+func newSyncProgress(headerHeight, filterHeaderHeight int32,
+	peerHeights []int32, current bool) *SyncProgress {
+
+	target := headerHeight
+	for _, height := range peerHeights {
+		if height > target {
+			target = height
+		}
+	}
+	return &SyncProgress{
+		HeaderHeight:       headerHeight,
+		FilterHeaderHeight: filterHeaderHeight,
+		TargetHeight:       target,
+		Current:            current,
+	}
+}
+
+// Synced returns whether both the block headers and the filter headers have
+// been synced up to the target height.
+func (p *SyncProgress) Synced() bool {
+	return p.Current && p.HeaderHeight >= p.TargetHeight &&
+		p.FilterHeaderHeight >= p.HeaderHeight
+}
+
+// Percent estimates the sync progress in percent. Block headers and filter
+// headers both have to be synced, so each counts for half of the progress.
+func (p *SyncProgress) Percent() float64 {
+	if p.Synced() {
+		return 100
+	}
+	if p.TargetHeight <= 0 {
+		return 0
+	}
+	synced := float64(p.HeaderHeight) + float64(p.FilterHeaderHeight)
+	percent := synced / (2 * float64(p.TargetHeight)) * 100
+	if percent > 100 {
+		percent = 100
+	}
+	return percent
+}
+
+// SyncProgress returns the current progress of syncing block headers and
+// filter headers.
+func (s *ChainService) SyncProgress() (*SyncProgress, er.R) {
+	_, headerHeight, err := s.BlockHeaders.ChainTip()
+	if err != nil {
+		return nil, err
+	}
+	_, filterHeaderHeight, err := s.RegFilterHeaders.ChainTip()
+	if err != nil {
+		return nil, err
+	}
+
+	var peerHeights []int32
+	for _, sp := range s.Peers() {
+		peerHeights = append(peerHeights, sp.LastBlock())
+	}
+
+	return newSyncProgress(
+		int32(headerHeight), int32(filterHeaderHeight), peerHeights,
+		s.IsCurrent(),
+	), nil
+}
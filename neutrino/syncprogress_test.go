@@ -0,0 +1,91 @@
+package neutrino
+
+import (
+	"testing"
+)
+
+// TestSyncProgress tests that the target height follows the best peer, even
+// when it moves during the sync, and that the estimated percentage accounts
+// for both block headers and filter headers.
+func TestSyncProgress(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		headerHeight int32
+		filterHeight int32
+		peerHeights  []int32
+		current      bool
+		expTarget    int32
+		expPercent   float64
+		expSynced    bool
+	}{
+		{
+			name:        "no headers",
+			peerHeights: []int32{1000},
+			expTarget:   1000,
+			expPercent:  0,
+		},
+		{
+			name:         "no peers",
+			headerHeight: 100,
+			filterHeight: 50,
+			expTarget:    100,
+			expPercent:   75,
+		},
+		{
+			name:         "filter headers lag",
+			headerHeight: 1000,
+			filterHeight: 500,
+			peerHeights:  []int32{900, 1000},
+			expTarget:    1000,
+			expPercent:   75,
+		},
+		{
+			name:         "target moved",
+			headerHeight: 1000,
+			filterHeight: 1000,
+			peerHeights:  []int32{1000, 1250},
+			current:      true,
+			expTarget:    1250,
+			expPercent:   80,
+		},
+		{
+			name:         "peers behind",
+			headerHeight: 1000,
+			filterHeight: 1000,
+			peerHeights:  []int32{990},
+			current:      true,
+			expTarget:    1000,
+			expPercent:   100,
+			expSynced:    true,
+		},
+		{
+			name:         "caught up but not current",
+			headerHeight: 1000,
+			filterHeight: 1000,
+			peerHeights:  []int32{1000},
+			expTarget:    1000,
+			expPercent:   100,
+		},
+	}
+
+	for _, test := range tests {
+		p := newSyncProgress(
+			test.headerHeight, test.filterHeight, test.peerHeights,
+			test.current,
+		)
+		if p.TargetHeight != test.expTarget {
+			t.Fatalf("%s: expected target height %d, got %d",
+				test.name, test.expTarget, p.TargetHeight)
+		}
+		if p.Percent() != test.expPercent {
+			t.Fatalf("%s: expected %v%%, got %v%%", test.name,
+				test.expPercent, p.Percent())
+		}
+		if p.Synced() != test.expSynced {
+			t.Fatalf("%s: expected synced %v, got %v", test.name,
+				test.expSynced, p.Synced())
+		}
+	}
+}
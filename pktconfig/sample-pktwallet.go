@@ -11,6 +11,10 @@ const PktwalletSampleConfig = `
 ; output files.
 ; appdata=~/.pktwallet
 
+; The database driver used for the wallet and neutrino databases. It must be
+; one of the drivers compiled into pktwallet.
+; dbdriver=bdb
+
 
 ; ------------------------------------------------------------------------------
 ; RPC client settings
@@ -85,6 +89,9 @@ const PktwalletSampleConfig = `
 ; Valid options are {trace, debug, info, warn, error, critical}
 ; debuglevel=info
 
+; Don't write the log file in the log directory, only log to stdout.
+; nologfile=1
+
 ; The port used to listen for HTTP profile requests.  The profile server will
 ; be disabled if this option is not specified.  The profile information can be
 ; accessed at http://localhost:<profileport>/debug/pprof once running.
@@ -7,7 +7,6 @@ package pktconfig
 import (
 	"crypto/rand"
 	"encoding/base64"
-	"io/ioutil"
 	"os"
 	"path/filepath"
 	"strings"
@@ -15,6 +14,7 @@ import (
 	flags "github.com/jessevdk/go-flags"
 
 	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/rpcclient"
 )
 
 // CreateDefaultConfigFile copies the file sample-pktd.conf to the given destination path,
@@ -65,9 +65,24 @@ type userpass struct {
 	Password    string `long:"rpcpass"`
 	OldUsername string `long:"username"`
 	OldPassword string `long:"password"`
+	CookieKey   string `long:"rpccookiekey"`
 }
 
-// ReadUserPass reads out the username and password from a config file
+// expandPath expands an initial ~ to the home directory of the user and
+// environment variables in path, like the server does with its options.
+func expandPath(path string) string {
+	if strings.HasPrefix(path, "~") {
+		if homeDir, errr := os.UserHomeDir(); errr == nil {
+			path = strings.Replace(path, "~", homeDir, 1)
+		}
+	}
+	return filepath.Clean(os.ExpandEnv(path))
+}
+
+// ReadUserPass reads out the username and password from a config file.
+// If the file has no credentials, they are read from the cookie file next to
+// it, which is verified with the key of the rpccookiekey option if the config
+// file sets one.
 func ReadUserPass(filePath string) ([]string, er.R) {
 	cfg := userpass{}
 	parser := flags.NewParser(&cfg, flags.IgnoreUnknown)
@@ -89,15 +104,16 @@ func ReadUserPass(filePath string) ([]string, er.R) {
 	cookiePath := strings.ReplaceAll(filePath, "pktd.conf", ".pktcookie")
 	if cookiePath == filePath {
 		return nil, nil
-	} else if cookie, errr := ioutil.ReadFile(cookiePath); errr != nil {
-		if _, ok := errr.(*os.PathError); !ok {
-			return nil, er.E(errr)
-		} else {
-			return nil, nil
-		}
-	} else if up := strings.Split(string(cookie), ":"); len(up) != 2 {
-		return nil, er.Errorf("Unexpected cookie file format")
-	} else {
-		return []string{up[0], up[1]}, nil
+	} else if _, errr := os.Stat(cookiePath); os.IsNotExist(errr) {
+		return nil, nil
+	}
+	keyPath := ""
+	if cfg.CookieKey != "" {
+		keyPath = expandPath(cfg.CookieKey)
+	}
+	user, pass, err := rpcclient.ReadCookieFile(cookiePath, keyPath)
+	if err != nil {
+		return nil, err
 	}
+	return []string{user, pass}, nil
 }
@@ -0,0 +1,75 @@
+package pktconfig
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/pkt-cash/pktd/rpcclient"
+)
+
+// TestReadUserPassCookie ensures that the credentials are read from the cookie
+// file next to a config file without credentials, and that the cookie is
+// verified with the key of the rpccookiekey option.
+func TestReadUserPassCookie(t *testing.T) {
+	dir, errr := ioutil.TempDir("", "pktconfig-cookie")
+	if errr != nil {
+		t.Fatalf("unable to create temp dir: %v", errr)
+	}
+	defer os.RemoveAll(dir)
+
+	confPath := filepath.Join(dir, "pktd.conf")
+	cookiePath := filepath.Join(dir, ".pktcookie")
+	keyPath := filepath.Join(dir, ".pktcookiekey")
+	writeFile := func(path, content string) {
+		if errr := ioutil.WriteFile(path, []byte(content), 0600); errr != nil {
+			t.Fatalf("unable to write %s: %v", path, errr)
+		}
+	}
+
+	// Without a cookie file there are no credentials.
+	writeFile(confPath, "rpccookiekey="+keyPath+"\n")
+	up, err := ReadUserPass(confPath)
+	if err != nil || up != nil {
+		t.Fatalf("expected no credentials, got %v, %v", up, err)
+	}
+
+	// A cookie written with the configured key verifies.
+	err = rpcclient.WriteCookieFile(cookiePath, "user", "pass", keyPath)
+	if err != nil {
+		t.Fatalf("unable to write cookie file: %v", err)
+	}
+	up, err = ReadUserPass(confPath)
+	if err != nil {
+		t.Fatalf("unable to read credentials: %v", err)
+	}
+	if len(up) != 2 || up[0] != "user" || up[1] != "pass" {
+		t.Fatalf("expected user:pass, got %v", up)
+	}
+
+	// A modified cookie is rejected, unless no key is configured.
+	writeFile(cookiePath, "evil:pass")
+	_, err = ReadUserPass(confPath)
+	if !rpcclient.ErrCookieIntegrity.Is(err) {
+		t.Fatalf("expected ErrCookieIntegrity, got %v", err)
+	}
+	writeFile(confPath, "")
+	up, err = ReadUserPass(confPath)
+	if err != nil {
+		t.Fatalf("unable to read credentials: %v", err)
+	}
+	if len(up) != 2 || up[0] != "evil" || up[1] != "pass" {
+		t.Fatalf("expected evil:pass, got %v", up)
+	}
+
+	// Credentials in the config file take precedence over the cookie.
+	writeFile(confPath, "rpcuser=confuser\nrpcpass=confpass\n")
+	up, err = ReadUserPass(confPath)
+	if err != nil {
+		t.Fatalf("unable to read credentials: %v", err)
+	}
+	if len(up) != 2 || up[0] != "confuser" || up[1] != "confpass" {
+		t.Fatalf("expected confuser:confpass, got %v", up)
+	}
+}
@@ -20,6 +20,7 @@ import (
 
 	"github.com/arl/statsviz"
 	"github.com/pkt-cash/pktd/blockchain/indexers"
+	"github.com/pkt-cash/pktd/blockchain/packetcrypt/cryptocycle"
 	"github.com/pkt-cash/pktd/btcutil/er"
 	"github.com/pkt-cash/pktd/database"
 	"github.com/pkt-cash/pktd/limits"
@@ -78,6 +79,13 @@ func pktdMain(serverChan chan<- *server) er.R {
 		)
 	}
 
+	// Make sure the PacketCrypt primitives compute correct results on this
+	// build and hardware before validating any blocks with them.
+	if errr := cryptocycle.SelfTest(); errr != nil {
+		log.Errorf("%v", errr)
+		return er.E(errr)
+	}
+
 	//
 	// Get a channel that will be closed when a shutdown signal has been
 	// triggered either from an OS signal such as SIGINT (Ctrl+C) or from
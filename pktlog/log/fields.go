@@ -0,0 +1,155 @@
+// Copyright (c) 2021 The pktd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package log
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// levelNames defines the names of each logging level in structured logs.
+var levelNames = [...]string{
+	"trace", "debug", "info", "warn", "error", "critical", "off",
+}
+
+// colorRegex matches the ANSI escape sequences which the color helpers embed
+// in log messages.
+var colorRegex = regexp.MustCompile("\x1b\\[[0-9;]*m")
+
+// Field is a key/value pair which is attached to a log message.  Fields are
+// output as typed JSON values in structured logs and left out of the
+// human-readable format.
+type Field struct {
+	Key   string
+	Value interface{}
+}
+
+// F creates a field with the given key and value.
+func F(key string, value interface{}) Field {
+	return Field{Key: key, Value: value}
+}
+
+// Entry is a set of fields which is attached to each message that is logged
+// through it.
+type Entry struct {
+	fields []Field
+}
+
+// With returns an entry which logs messages with the given fields, e.g.
+// log.With(log.F("height", height)).Infof("Synced to tip [%d]", height)
+func With(fields ...Field) Entry {
+	return Entry{fields: fields}
+}
+
+func (e Entry) Trace(args ...interface{}) {
+	doLog(LevelTrace, e.fields, "%s", fmt.Sprintln(args...))
+}
+
+func (e Entry) Tracef(format string, args ...interface{}) {
+	doLog(LevelTrace, e.fields, format, args...)
+}
+
+func (e Entry) Debug(args ...interface{}) {
+	doLog(LevelDebug, e.fields, "%s", fmt.Sprintln(args...))
+}
+
+func (e Entry) Debugf(format string, args ...interface{}) {
+	doLog(LevelDebug, e.fields, format, args...)
+}
+
+func (e Entry) Info(args ...interface{}) {
+	doLog(LevelInfo, e.fields, "%s", fmt.Sprintln(args...))
+}
+
+func (e Entry) Infof(format string, args ...interface{}) {
+	doLog(LevelInfo, e.fields, format, args...)
+}
+
+func (e Entry) Warn(args ...interface{}) {
+	doLog(LevelWarn, e.fields, "%s", fmt.Sprintln(args...))
+}
+
+func (e Entry) Warnf(format string, args ...interface{}) {
+	doLog(LevelWarn, e.fields, format, args...)
+}
+
+func (e Entry) Error(args ...interface{}) {
+	doLog(LevelError, e.fields, "%s", fmt.Sprintln(args...))
+}
+
+func (e Entry) Errorf(format string, args ...interface{}) {
+	doLog(LevelError, e.fields, format, args...)
+}
+
+func (e Entry) Critical(args ...interface{}) {
+	doLog(LevelCritical, e.fields, "%s", fmt.Sprintln(args...))
+}
+
+func (e Entry) Criticalf(format string, args ...interface{}) {
+	doLog(LevelCritical, e.fields, format, args...)
+}
+
+// fieldValue returns the value of a field as it is encoded in JSON.  Values
+// which have their own JSON encoding keep it, other values which can be
+// printed as a string, such as hashes and errors, are output as that string.
+func fieldValue(v interface{}) interface{} {
+	switch val := v.(type) {
+	case json.Marshaler:
+		return val
+	case fmt.Stringer:
+		return val.String()
+	case error:
+		return val.Error()
+	}
+	return v
+}
+
+// formatJSON writes a log message to buf as a single line JSON object with the
+// time, level, subsystem, callsite and message followed by the fields.
+func formatJSON(
+	buf *[]byte,
+	t time.Time,
+	lvl Level,
+	subsystem string,
+	file string,
+	line int,
+	fields []Field,
+	format string,
+	args ...interface{},
+) {
+	msg := strings.TrimSuffix(fmt.Sprintf(format, args...), "\n")
+	msg = colorRegex.ReplaceAllString(msg, "")
+
+	*buf = append(*buf, `{"time":`...)
+	*buf = appendJSON(*buf, t.Format(time.RFC3339Nano))
+	*buf = append(*buf, `,"level":`...)
+	*buf = appendJSON(*buf, levelNames[lvl])
+	*buf = append(*buf, `,"subsystem":`...)
+	*buf = appendJSON(*buf, subsystem)
+	*buf = append(*buf, `,"caller":`...)
+	*buf = appendJSON(*buf, fmt.Sprintf("%s:%d", file, line))
+	*buf = append(*buf, `,"message":`...)
+	*buf = appendJSON(*buf, msg)
+	for _, f := range fields {
+		*buf = append(*buf, ',')
+		*buf = appendJSON(*buf, f.Key)
+		*buf = append(*buf, ':')
+		*buf = appendJSON(*buf, fieldValue(f.Value))
+	}
+	*buf = append(*buf, "}\n"...)
+}
+
+// appendJSON appends the JSON encoding of v to buf, if v can't be encoded then
+// the string form of it is appended instead.
+func appendJSON(buf []byte, v interface{}) []byte {
+	out, errr := json.Marshal(v)
+	if errr != nil {
+		out, _ = json.Marshal(fmt.Sprint(v))
+	}
+	return append(buf, out...)
+}
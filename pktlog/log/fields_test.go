@@ -0,0 +1,76 @@
+// Copyright (c) 2021 The pktd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package log
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+// hash is a stand-in for types which are logged through their String method.
+type hash string
+
+func (h hash) String() string {
+	return "hash:" + string(h)
+}
+
+// TestFormatJSON asserts that a structured log line holds the message without
+// color and each of the fields as a typed value.
+func TestFormatJSON(t *testing.T) {
+	now := time.Date(2021, 3, 4, 5, 6, 7, 0, time.UTC)
+	fields := []Field{
+		F("height", int32(1234)),
+		F("txid", hash("abcd")),
+		F("coinbase", true),
+	}
+
+	buf := buffer()
+	defer recycleBuffer(buf)
+	formatJSON(buf, now, LevelInfo, "wallet", "wallet.go", 42, fields,
+		"Synced to %s", Height(1234))
+
+	line := string(*buf)
+	if line[len(line)-1] != '\n' {
+		t.Fatalf("log line not terminated by a newline: %q", line)
+	}
+
+	var out map[string]interface{}
+	if errr := json.Unmarshal(*buf, &out); errr != nil {
+		t.Fatalf("unable to decode log line %q: %v", line, errr)
+	}
+	expected := map[string]interface{}{
+		"time":      "2021-03-04T05:06:07Z",
+		"level":     "info",
+		"subsystem": "wallet",
+		"caller":    "wallet.go:42",
+		"message":   "Synced to 1234",
+		"height":    float64(1234),
+		"txid":      "hash:abcd",
+		"coinbase":  true,
+	}
+	if len(out) != len(expected) {
+		t.Fatalf("expected %d keys, got %q", len(expected), line)
+	}
+	for k, v := range expected {
+		if out[k] != v {
+			t.Fatalf("expected %v to be %v, got %v", k, v, out[k])
+		}
+	}
+}
+
+// TestSetLogFormat asserts that unknown log formats are rejected.
+func TestSetLogFormat(t *testing.T) {
+	defer SetLogFormat("text")
+
+	for _, format := range []string{"", "text", "json", "JSON"} {
+		if err := SetLogFormat(format); err != nil {
+			t.Fatalf("unable to set log format %q: %v", format, err)
+		}
+	}
+	if err := SetLogFormat("xml"); err == nil {
+		t.Fatalf("expected log format xml to be rejected")
+	}
+}
@@ -37,6 +37,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"path"
 	"runtime"
 	"strconv"
 	"strings"
@@ -206,12 +207,53 @@ func newBackend(w io.Writer) *backend {
 		for {
 			l := <-b.ch
 			w.Write(*l)
+			b.lock.RLock()
+			if b.file != nil {
+				b.file.Write(stripColor(*l))
+			}
+			b.lock.RUnlock()
 			recycleBuffer(l)
 		}
 	}()
 	return b
 }
 
+// stripColor returns the log line without the ANSI color sequences, which
+// only make sense on a terminal.
+func stripColor(line []byte) []byte {
+	if bytes.IndexByte(line, '\x1b') < 0 {
+		return line
+	}
+	return colorRegex.ReplaceAll(line, nil)
+}
+
+// SetLogFile makes the logger write to w, typically a Rotator, in addition
+// to stdout.  Colors are left out of what is written to w.  A nil w stops
+// writing to the previous one.
+func SetLogFile(w io.Writer) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	b.file = w
+}
+
+// SetLogFormat selects the format of the log output, either "text" for the
+// human-readable format or "json" for one JSON object per line.  An
+// appropriate error is returned if the format is unknown.
+func SetLogFormat(format string) er.R {
+	var asJSON bool
+	switch strings.ToLower(format) {
+	case "", "text":
+	case "json":
+		asJSON = true
+	default:
+		return er.Errorf("The specified log format [%v] is invalid", format)
+	}
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	b.json = asJSON
+	return nil
+}
+
 // bufferPool defines a concurrent safe free list of byte slices used to provide
 // temporary buffers for formatting log messages prior to outputting them.
 var bufferPool = sync.Pool{
@@ -386,11 +428,12 @@ func formatHeader(flags uint32, buf *[]byte, t time.Time, lvl Level, file string
 const calldepth = 3
 
 // callsite returns the file name and line number of the callsite to the
-// subsystem logger.
-func callsite(flag uint32) (string, string, int) {
+// subsystem logger, along with the name of the directory of the file, which
+// is the subsystem in structured logs.
+func callsite(flag uint32) (string, string, string, int) {
 	_, file, line, ok := runtime.Caller(calldepth)
 	if !ok {
-		return "???", "", 0
+		return "???", "", "", 0
 	}
 	short := file
 	for i := len(file) - 1; i > 0; i-- {
@@ -399,10 +442,11 @@ func callsite(flag uint32) (string, string, int) {
 			break
 		}
 	}
+	subsystem := path.Base(path.Dir(file))
 	if flag&Lshortfile != 0 {
 		file = short
 	}
-	return file, short, line
+	return file, short, subsystem, line
 }
 
 func (b *backend) write(buf *[]byte) {
@@ -425,6 +469,8 @@ type backend struct {
 	lock sync.RWMutex
 	lvl  Level
 	lmap map[string]Level
+	file io.Writer
+	json bool
 }
 
 var b *backend
@@ -434,7 +480,7 @@ func init() {
 	pktlog := os.Getenv("PKTLOG")
 	if pktlog != "" {
 		if err := SetLogLevels(pktlog); err != nil {
-			Errorf("Error setting log param: ", err.String())
+			Errorf("Error setting log param: %s", err.String())
 		}
 	}
 }
@@ -442,13 +488,16 @@ func init() {
 // doLog outputs a log message to the writer associated with the backend after
 // creating a prefix for the given level and tag according to the formatHeader
 // function and formatting the provided arguments according to the given format
-// specifier.
+// specifier.  The fields are only output in the JSON format.  The functions
+// which don't take a format string format their arguments like fmt.Println
+// and pass the resulting line on.
 func doLog(
 	lvl Level,
+	fields []Field,
 	format string,
 	args ...interface{},
 ) {
-	file, shortFile, line := callsite(b.flag)
+	file, shortFile, subsystem, line := callsite(b.flag)
 	doit := true
 	b.lock.RLock()
 	if lvl >= b.lvl {
@@ -456,6 +505,7 @@ func doLog(
 	} else {
 		doit = false
 	}
+	asJSON := b.json
 	b.lock.RUnlock()
 	if !doit {
 		return
@@ -463,13 +513,15 @@ func doLog(
 
 	t := time.Now()
 	bytebuf := buffer()
+	if asJSON {
+		formatJSON(bytebuf, t, lvl, subsystem, shortFile, line,
+			fields, format, args...)
+		b.write(bytebuf)
+		return
+	}
 	hasColor := formatHeader(b.flag, bytebuf, t, lvl, file, line)
 	buf := bytes.NewBuffer(*bytebuf)
-	if format == "" {
-		fmt.Fprintln(buf, args...)
-	} else {
-		fmt.Fprintf(buf, format, args...)
-	}
+	fmt.Fprintf(buf, format, args...)
 	*bytebuf = buf.Bytes()
 	if hasColor {
 		*bytebuf = append(*bytebuf, Reset...)
@@ -480,51 +532,51 @@ func doLog(
 }
 
 func Trace(args ...interface{}) {
-	doLog(LevelTrace, "", args...)
+	doLog(LevelTrace, nil, "%s", fmt.Sprintln(args...))
 }
 
 func Tracef(format string, args ...interface{}) {
-	doLog(LevelTrace, format, args...)
+	doLog(LevelTrace, nil, format, args...)
 }
 
 func Debug(args ...interface{}) {
-	doLog(LevelDebug, "", args...)
+	doLog(LevelDebug, nil, "%s", fmt.Sprintln(args...))
 }
 
 func Debugf(format string, args ...interface{}) {
-	doLog(LevelDebug, format, args...)
+	doLog(LevelDebug, nil, format, args...)
 }
 
 func Info(args ...interface{}) {
-	doLog(LevelInfo, "", args...)
+	doLog(LevelInfo, nil, "%s", fmt.Sprintln(args...))
 }
 
 func Infof(format string, args ...interface{}) {
-	doLog(LevelInfo, format, args...)
+	doLog(LevelInfo, nil, format, args...)
 }
 
 func Warn(args ...interface{}) {
-	doLog(LevelWarn, "", args...)
+	doLog(LevelWarn, nil, "%s", fmt.Sprintln(args...))
 }
 
 func Warnf(format string, args ...interface{}) {
-	doLog(LevelWarn, format, args...)
+	doLog(LevelWarn, nil, format, args...)
 }
 
 func Error(args ...interface{}) {
-	doLog(LevelError, "", args...)
+	doLog(LevelError, nil, "%s", fmt.Sprintln(args...))
 }
 
 func Errorf(format string, args ...interface{}) {
-	doLog(LevelError, format, args...)
+	doLog(LevelError, nil, format, args...)
 }
 
 func Critical(args ...interface{}) {
-	doLog(LevelCritical, "", args...)
+	doLog(LevelCritical, nil, "%s", fmt.Sprintln(args...))
 }
 
 func Criticalf(format string, args ...interface{}) {
-	doLog(LevelCritical, format, args...)
+	doLog(LevelCritical, nil, format, args...)
 }
 
 // logClosure is used to provide a closure over expensive logging operations so
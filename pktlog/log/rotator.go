@@ -0,0 +1,218 @@
+// Copyright (c) 2021 The pktd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package log
+
+import (
+	"compress/gzip"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+
+	"github.com/pkt-cash/pktd/btcutil/er"
+)
+
+// Rotator is an io.WriteCloser which writes to a log file and rotates it
+// before it would grow beyond a maximum size.  Rotated files are named after
+// the log file with a .1, .2, ... suffix, .1 being the most recent one, and
+// are additionally suffixed with .gz when they are compressed.
+type Rotator struct {
+	filename string
+	maxSize  int64
+	maxRolls int
+	compress bool
+
+	mtx    sync.Mutex
+	file   *os.File
+	size   int64
+	closed bool
+}
+
+// renameFile renames a file, it is replaced by tests to make the rotation
+// fail.
+var renameFile = os.Rename
+
+// NewRotator opens or creates the log file, creating its directory if
+// needed.  The file is rotated before it would exceed maxSize bytes, and at
+// most maxRolls rotated files are kept, older ones are removed.  If compress
+// is set, rotated files are gzip compressed.
+func NewRotator(filename string, maxSize int64, maxRolls int,
+	compress bool) (*Rotator, er.R) {
+
+	if maxSize <= 0 {
+		return nil, er.Errorf("invalid maximum log file size %d",
+			maxSize)
+	}
+	if maxRolls < 0 {
+		return nil, er.Errorf("invalid number of rotated log files %d",
+			maxRolls)
+	}
+	if errr := os.MkdirAll(filepath.Dir(filename), 0o700); errr != nil {
+		return nil, er.E(errr)
+	}
+
+	r := &Rotator{
+		filename: filename,
+		maxSize:  maxSize,
+		maxRolls: maxRolls,
+		compress: compress,
+	}
+	if err := r.open(os.O_APPEND); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// open opens the log file with the extra flags and records its size.
+func (r *Rotator) open(flag int) er.R {
+	file, errr := os.OpenFile(r.filename, os.O_CREATE|os.O_WRONLY|flag,
+		0o600)
+	if errr != nil {
+		return er.E(errr)
+	}
+	fi, errr := file.Stat()
+	if errr != nil {
+		file.Close()
+		return er.E(errr)
+	}
+	r.file = file
+	r.size = fi.Size()
+	return nil
+}
+
+// Write writes p to the log file, rotating the file first if p would make it
+// exceed the maximum size.  A write which is larger than the maximum size by
+// itself goes to a fresh file.  If the rotation fails, p is still written to
+// the current log file and the rotation error is returned, the rotation is
+// tried again on the next write.
+func (r *Rotator) Write(p []byte) (int, error) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	if r.closed {
+		return 0, os.ErrClosed
+	}
+	// The log file may be missing after a failed rotation, in which case
+	// it is reopened.
+	if r.file == nil {
+		if err := r.open(os.O_APPEND); err != nil {
+			return 0, er.Native(err)
+		}
+	}
+	var rotateErr er.R
+	if r.size > 0 && r.size+int64(len(p)) > r.maxSize {
+		if rotateErr = r.rotate(); r.file == nil {
+			return 0, er.Native(rotateErr)
+		}
+	}
+
+	n, errr := r.file.Write(p)
+	r.size += int64(n)
+	if errr == nil && rotateErr != nil {
+		errr = er.Native(rotateErr)
+	}
+	return n, errr
+}
+
+// Close closes the log file, later writes fail.
+func (r *Rotator) Close() er.R {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	if r.closed {
+		return nil
+	}
+	r.closed = true
+	if r.file == nil {
+		return nil
+	}
+	errr := r.file.Close()
+	r.file = nil
+	return er.E(errr)
+}
+
+// rolledName returns the name of the n-th rotated log file.
+func (r *Rotator) rolledName(n int) string {
+	name := r.filename + "." + strconv.Itoa(n)
+	if r.compress {
+		name += ".gz"
+	}
+	return name
+}
+
+// rotate moves the current log file to the first rotated file, shifting the
+// other rotated files and removing the oldest one, and starts a new log file.
+// If the rotation fails, the current log file is reopened for appending so
+// that logging goes on.
+func (r *Rotator) rotate() er.R {
+	err := er.E(r.file.Close())
+	r.file = nil
+	if err == nil {
+		if err = r.roll(); err == nil {
+			return r.open(os.O_TRUNC)
+		}
+	}
+
+	if errOpen := r.open(os.O_APPEND); errOpen != nil {
+		return errOpen
+	}
+	return err
+}
+
+// roll shifts the rotated files, removing the oldest one, and moves the log
+// file to the first rotated file.
+func (r *Rotator) roll() er.R {
+	if r.maxRolls == 0 {
+		return nil
+	}
+
+	errr := os.Remove(r.rolledName(r.maxRolls))
+	if errr != nil && !os.IsNotExist(errr) {
+		return er.E(errr)
+	}
+	for n := r.maxRolls - 1; n > 0; n-- {
+		errr := renameFile(r.rolledName(n), r.rolledName(n+1))
+		if errr != nil && !os.IsNotExist(errr) {
+			return er.E(errr)
+		}
+	}
+
+	if r.compress {
+		return compressFile(r.filename, r.rolledName(1))
+	}
+	return er.E(renameFile(r.filename, r.rolledName(1)))
+}
+
+// compressFile writes the gzip compressed content of the src file to dst and
+// removes src.
+func compressFile(src, dst string) er.R {
+	in, errr := os.Open(src)
+	if errr != nil {
+		return er.E(errr)
+	}
+	defer in.Close()
+
+	out, errr := os.OpenFile(dst, os.O_CREATE|os.O_WRONLY|os.O_TRUNC,
+		0o600)
+	if errr != nil {
+		return er.E(errr)
+	}
+
+	gz := gzip.NewWriter(out)
+	if _, errr := io.Copy(gz, in); errr != nil {
+		out.Close()
+		return er.E(errr)
+	}
+	if errr := gz.Close(); errr != nil {
+		out.Close()
+		return er.E(errr)
+	}
+	if errr := out.Close(); errr != nil {
+		return er.E(errr)
+	}
+
+	return er.E(os.Remove(src))
+}
@@ -0,0 +1,222 @@
+// Copyright (c) 2021 The pktd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package log
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// readLog returns the content of the log file, decompressing it if needed.
+func readLog(t *testing.T, name string) string {
+	t.Helper()
+
+	content, errr := ioutil.ReadFile(name)
+	if errr != nil {
+		t.Fatalf("unable to read %v: %v", name, errr)
+	}
+	if filepath.Ext(name) != ".gz" {
+		return string(content)
+	}
+
+	gz, errr := gzip.NewReader(bytes.NewReader(content))
+	if errr != nil {
+		t.Fatalf("unable to decompress %v: %v", name, errr)
+	}
+	content, errr = ioutil.ReadAll(gz)
+	if errr != nil {
+		t.Fatalf("unable to decompress %v: %v", name, errr)
+	}
+	return string(content)
+}
+
+// TestRotator asserts that the log file is rotated once a write would exceed
+// the maximum size and that only the configured number of rotated files is
+// kept.
+func TestRotator(t *testing.T) {
+	for _, compress := range []bool{false, true} {
+		compress := compress
+		name := "plain"
+		if compress {
+			name = "compressed"
+		}
+
+		t.Run(name, func(t *testing.T) {
+			dir, errr := ioutil.TempDir("", "rotator")
+			if errr != nil {
+				t.Fatal(errr)
+			}
+			defer os.RemoveAll(dir)
+
+			filename := filepath.Join(dir, "logs", "test.log")
+			r, err := NewRotator(filename, 10, 2, compress)
+			if err != nil {
+				t.Fatalf("unable to create rotator: %v", err)
+			}
+			defer r.Close()
+
+			write := func(s string) {
+				t.Helper()
+				if _, errr := r.Write([]byte(s)); errr != nil {
+					t.Fatalf("unable to write: %v", errr)
+				}
+			}
+
+			// Writes up to the maximum size don't rotate.
+			write("aaaaa")
+			write("bbbbb")
+			if _, errr := os.Stat(r.rolledName(1)); !os.IsNotExist(errr) {
+				t.Fatalf("log file rotated below the maximum size")
+			}
+
+			// The next write would exceed the maximum size.
+			write("c")
+			if got := readLog(t, r.rolledName(1)); got != "aaaaabbbbb" {
+				t.Fatalf("unexpected rotated log %q", got)
+			}
+			if got := readLog(t, filename); got != "c" {
+				t.Fatalf("unexpected log %q", got)
+			}
+
+			// Rotate twice more, the oldest file is removed.
+			write("dddddddddd")
+			write("eeeeeeeeee")
+			if got := readLog(t, r.rolledName(1)); got != "dddddddddd" {
+				t.Fatalf("unexpected rotated log %q", got)
+			}
+			if got := readLog(t, r.rolledName(2)); got != "c" {
+				t.Fatalf("unexpected rotated log %q", got)
+			}
+			if _, errr := os.Stat(r.rolledName(3)); !os.IsNotExist(errr) {
+				t.Fatalf("more rotated log files than configured")
+			}
+			if got := readLog(t, filename); got != "eeeeeeeeee" {
+				t.Fatalf("unexpected log %q", got)
+			}
+		})
+	}
+}
+
+// TestRotatorReopen asserts that an existing log file is appended to and that
+// its size counts towards the maximum size.
+func TestRotatorReopen(t *testing.T) {
+	dir, errr := ioutil.TempDir("", "rotator")
+	if errr != nil {
+		t.Fatal(errr)
+	}
+	defer os.RemoveAll(dir)
+
+	filename := filepath.Join(dir, "test.log")
+	if errr := ioutil.WriteFile(filename, []byte("aaaaaaaa"), 0o600); errr != nil {
+		t.Fatal(errr)
+	}
+
+	r, err := NewRotator(filename, 10, 1, false)
+	if err != nil {
+		t.Fatalf("unable to create rotator: %v", err)
+	}
+	defer r.Close()
+
+	if _, errr := r.Write([]byte("bbb")); errr != nil {
+		t.Fatalf("unable to write: %v", errr)
+	}
+	if got := readLog(t, r.rolledName(1)); got != "aaaaaaaa" {
+		t.Fatalf("unexpected rotated log %q", got)
+	}
+	if got := readLog(t, filename); got != "bbb" {
+		t.Fatalf("unexpected log %q", got)
+	}
+}
+
+// TestRotatorRenameFailure asserts that logging goes on in the current log
+// file when the rotation fails, and that the rotation is done once it can
+// succeed.
+func TestRotatorRenameFailure(t *testing.T) {
+	dir, errr := ioutil.TempDir("", "rotator")
+	if errr != nil {
+		t.Fatal(errr)
+	}
+	defer os.RemoveAll(dir)
+
+	filename := filepath.Join(dir, "test.log")
+	r, err := NewRotator(filename, 10, 1, false)
+	if err != nil {
+		t.Fatalf("unable to create rotator: %v", err)
+	}
+	defer r.Close()
+
+	defer func() {
+		renameFile = os.Rename
+	}()
+	renameFile = func(oldpath, newpath string) error {
+		return &os.LinkError{
+			Op:  "rename",
+			Old: oldpath,
+			New: newpath,
+			Err: os.ErrPermission,
+		}
+	}
+
+	if _, errr := r.Write([]byte("aaaaaaaa")); errr != nil {
+		t.Fatalf("unable to write: %v", errr)
+	}
+	n, errr := r.Write([]byte("bbb"))
+	if errr == nil {
+		t.Fatal("expected the failed rotation to be reported")
+	}
+	if n != 3 {
+		t.Fatalf("expected the write to go to the log file, wrote %d "+
+			"bytes", n)
+	}
+	if got := readLog(t, filename); got != "aaaaaaaabbb" {
+		t.Fatalf("unexpected log %q", got)
+	}
+
+	// Once renaming works again, the next write rotates the log file.
+	renameFile = os.Rename
+	if _, errr := r.Write([]byte("c")); errr != nil {
+		t.Fatalf("unable to write: %v", errr)
+	}
+	if got := readLog(t, r.rolledName(1)); got != "aaaaaaaabbb" {
+		t.Fatalf("unexpected rotated log %q", got)
+	}
+	if got := readLog(t, filename); got != "c" {
+		t.Fatalf("unexpected log %q", got)
+	}
+}
+
+// lineWriter passes each written line on to a channel.
+type lineWriter chan string
+
+func (w lineWriter) Write(p []byte) (int, error) {
+	w <- string(p)
+	return len(p), nil
+}
+
+// TestLogFileStripsColor asserts that the log file gets the log lines without
+// the colors which are written to stdout.
+func TestLogFileStripsColor(t *testing.T) {
+	stdout := make(lineWriter, 1)
+	file := make(lineWriter, 1)
+	bk := newBackend(stdout)
+	bk.file = file
+
+	colored := "[" + fgRed + "ERR" + Reset + "] wallet.go:42 " + FgGreen +
+		"1234" + Reset + "\n"
+	line := []byte(colored)
+	bk.ch <- &line
+
+	if got := <-stdout; got != colored {
+		t.Fatalf("expected %q on stdout, got %q", colored, got)
+	}
+	if got := <-file; got != "[ERR] wallet.go:42 1234\n" {
+		t.Fatalf("expected the log file line without colors, got %q",
+			got)
+	}
+}
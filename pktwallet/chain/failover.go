@@ -0,0 +1,291 @@
+package chain
+
+import (
+	"sync"
+	"time"
+
+	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/pktlog/log"
+
+	"github.com/pkt-cash/pktd/chaincfg/chainhash"
+	"github.com/pkt-cash/pktd/pktwallet/waddrmgr"
+	"github.com/pkt-cash/pktd/rpcclient"
+	"github.com/pkt-cash/pktd/wire"
+)
+
+// DefaultFailbackInterval is how long a FailoverClient keeps using its
+// secondary backend after the primary failed before trying the primary again.
+const DefaultFailbackInterval = time.Minute
+
+// FailoverClient is an implementation of the chain.Interface interface which
+// delegates to a primary backend, such as a pktd RPC client, and falls back to
+// a secondary backend, such as neutrino, while requests to the primary fail.
+// Once the failback interval has passed the primary is tried again, and used
+// from then on if it answers.
+type FailoverClient struct {
+	primary          Interface
+	secondary        Interface
+	failbackInterval time.Duration
+
+	mtx      sync.Mutex
+	failedAt time.Time // When the primary last failed, zero if in use.
+}
+
+var _ Interface = (*FailoverClient)(nil)
+
+// NewFailoverClient creates a new FailoverClient which prefers primary over
+// secondary, and retries primary failbackInterval after it failed.
+func NewFailoverClient(primary, secondary Interface,
+	failbackInterval time.Duration) *FailoverClient {
+
+	return &FailoverClient{
+		primary:          primary,
+		secondary:        secondary,
+		failbackInterval: failbackInterval,
+	}
+}
+
+// ActiveBackEnd returns the backend which currently serves the requests of
+// chainClient, which is chainClient itself unless it is a FailoverClient.
+func ActiveBackEnd(chainClient Interface) Interface {
+	if c, ok := chainClient.(*FailoverClient); ok {
+		return c.Active()
+	}
+	return chainClient
+}
+
+// Primary returns the preferred backend.
+func (c *FailoverClient) Primary() Interface {
+	return c.primary
+}
+
+// Secondary returns the backend which is used while the primary fails.
+func (c *FailoverClient) Secondary() Interface {
+	return c.secondary
+}
+
+// Active returns the backend which currently serves requests.
+func (c *FailoverClient) Active() Interface {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	if c.failedAt.IsZero() {
+		return c.primary
+	}
+	return c.secondary
+}
+
+// BackEnd returns the name of the driver of the active backend.
+func (c *FailoverClient) BackEnd() string {
+	return c.Active().BackEnd()
+}
+
+// Start starts both backends and returns once either of them is started, so
+// a primary which is not reachable does not hold up the secondary. An error
+// is only returned if both backends fail to start.
+func (c *FailoverClient) Start() er.R {
+	type result struct {
+		backend Interface
+		err     er.R
+	}
+	results := make(chan result, 2)
+	for _, backend := range []Interface{c.primary, c.secondary} {
+		go func(backend Interface) {
+			results <- result{backend, backend.Start()}
+		}(backend)
+	}
+
+	var err er.R
+	for i := 0; i < 2; i++ {
+		res := <-results
+		if res.err == nil {
+			return nil
+		}
+		log.Warnf("Unable to start %s chain backend: %v",
+			res.backend.BackEnd(), res.err)
+		err = res.err
+	}
+	return err
+}
+
+// Stop stops both backends.
+func (c *FailoverClient) Stop() {
+	c.primary.Stop()
+	c.secondary.Stop()
+}
+
+// WaitForShutdown blocks until both backends are shut down.
+func (c *FailoverClient) WaitForShutdown() {
+	c.primary.WaitForShutdown()
+	c.secondary.WaitForShutdown()
+}
+
+// order returns the backends in the order in which they should be tried.
+func (c *FailoverClient) order() (Interface, Interface) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	if c.failedAt.IsZero() || time.Since(c.failedAt) >= c.failbackInterval {
+		return c.primary, c.secondary
+	}
+	return c.secondary, c.primary
+}
+
+// primaryAnswered records that the primary answered a request, switching back
+// to it if it was failed.
+func (c *FailoverClient) primaryAnswered() {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	if !c.failedAt.IsZero() {
+		log.Infof("Switching back to %s chain backend", c.primary.BackEnd())
+		c.failedAt = time.Time{}
+	}
+}
+
+// primaryFailed records that the primary failed a request which the
+// secondary answered, switching to the secondary for the failback interval.
+func (c *FailoverClient) primaryFailed(err er.R) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	if c.failedAt.IsZero() {
+		log.Warnf("Chain backend %s failed, switching to %s: %v",
+			c.primary.BackEnd(), c.secondary.BackEnd(), err)
+	}
+	c.failedAt = time.Now()
+}
+
+// do calls f with the backends in order until one of them succeeds, and
+// returns the error of the first backend if none does. The failover state is
+// only changed if one of the backends succeeded, so an error which both
+// backends agree on, such as an unknown block, does not cause a failover.
+func (c *FailoverClient) do(f func(Interface) er.R) er.R {
+	first, second := c.order()
+	err := f(first)
+	if err == nil {
+		if first == c.primary {
+			c.primaryAnswered()
+		}
+		return nil
+	}
+	if f(second) != nil {
+		return err
+	}
+	if second == c.primary {
+		c.primaryAnswered()
+	} else {
+		c.primaryFailed(err)
+	}
+	return nil
+}
+
+// GetBestBlock returns the hash and height of the best block known to the
+// active backend.
+func (c *FailoverClient) GetBestBlock() (*chainhash.Hash, int32, er.R) {
+	var (
+		hash   *chainhash.Hash
+		height int32
+	)
+	err := c.do(func(backend Interface) er.R {
+		var err er.R
+		hash, height, err = backend.GetBestBlock()
+		return err
+	})
+	return hash, height, err
+}
+
+// GetBlock returns the block with the given hash.
+func (c *FailoverClient) GetBlock(hash *chainhash.Hash) (*wire.MsgBlock, er.R) {
+	var block *wire.MsgBlock
+	err := c.do(func(backend Interface) er.R {
+		var err er.R
+		block, err = backend.GetBlock(hash)
+		return err
+	})
+	return block, err
+}
+
+// GetBlockHash returns the hash of the block at the given height.
+func (c *FailoverClient) GetBlockHash(height int64) (*chainhash.Hash, er.R) {
+	var hash *chainhash.Hash
+	err := c.do(func(backend Interface) er.R {
+		var err er.R
+		hash, err = backend.GetBlockHash(height)
+		return err
+	})
+	return hash, err
+}
+
+// GetBlockHeader returns the header of the block with the given hash.
+func (c *FailoverClient) GetBlockHeader(
+	hash *chainhash.Hash) (*wire.BlockHeader, er.R) {
+
+	var header *wire.BlockHeader
+	err := c.do(func(backend Interface) er.R {
+		var err er.R
+		header, err = backend.GetBlockHeader(hash)
+		return err
+	})
+	return header, err
+}
+
+// IsCurrent returns whether the active backend considers its view of the
+// network as "current".
+func (c *FailoverClient) IsCurrent() bool {
+	return c.Active().IsCurrent()
+}
+
+// FilterBlocks scans the blocks of the request for relevant transactions.
+func (c *FailoverClient) FilterBlocks(
+	req *FilterBlocksRequest) (*FilterBlocksResponse, er.R) {
+
+	var resp *FilterBlocksResponse
+	err := c.do(func(backend Interface) er.R {
+		var err er.R
+		resp, err = backend.FilterBlocks(req)
+		return err
+	})
+	return resp, err
+}
+
+// BlockStamp returns the latest block known to the active backend.
+func (c *FailoverClient) BlockStamp() (*waddrmgr.BlockStamp, er.R) {
+	var bs *waddrmgr.BlockStamp
+	err := c.do(func(backend Interface) er.R {
+		var err er.R
+		bs, err = backend.BlockStamp()
+		return err
+	})
+	return bs, err
+}
+
+// SendRawTransaction broadcasts the transaction. Unlike other requests, a
+// transaction is only handed to the other backend if the first one could not
+// be reached at all, so a transaction which pktd rejected is not relayed to
+// the peers of neutrino anyway and reported as sent.
+func (c *FailoverClient) SendRawTransaction(tx *wire.MsgTx,
+	allowHighFees bool) (*chainhash.Hash, er.R) {
+
+	var (
+		hash     *chainhash.Hash
+		rejected er.R
+	)
+	err := c.do(func(backend Interface) er.R {
+		if rejected != nil {
+			return rejected
+		}
+		var err er.R
+		hash, err = backend.SendRawTransaction(tx, allowHighFees)
+		if err != nil && !isUnreachable(err) {
+			rejected = err
+		}
+		return err
+	})
+	return hash, err
+}
+
+// isUnreachable returns whether err means that a backend could not be
+// reached, rather than that it refused a request.
+func isUnreachable(err er.R) bool {
+	return rpcclient.ErrClientNotConnected.Is(err) ||
+		rpcclient.ErrClientDisconnect.Is(err) ||
+		rpcclient.ErrClientShutdown.Is(err) ||
+		rpcclient.ErrCircuitOpen.Is(err)
+}
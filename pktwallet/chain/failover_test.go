@@ -0,0 +1,183 @@
+package chain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/chaincfg/chainhash"
+	"github.com/pkt-cash/pktd/pktwallet/chain"
+	"github.com/pkt-cash/pktd/pktwallet/waddrmgr"
+	"github.com/pkt-cash/pktd/rpcclient"
+	"github.com/pkt-cash/pktd/wire"
+)
+
+// mockBackend is a chain.Interface whose best block is its height, and which
+// fails every request with err while err is set.
+type mockBackend struct {
+	name     string
+	height   int32
+	startErr er.R
+	err      er.R
+	calls    int
+	sent     int
+}
+
+var _ chain.Interface = (*mockBackend)(nil)
+
+func (m *mockBackend) Start() er.R      { return m.startErr }
+func (m *mockBackend) Stop()            {}
+func (m *mockBackend) WaitForShutdown() {}
+func (m *mockBackend) IsCurrent() bool  { return m.err == nil }
+func (m *mockBackend) BackEnd() string  { return m.name }
+
+func (m *mockBackend) GetBestBlock() (*chainhash.Hash, int32, er.R) {
+	m.calls++
+	if m.err != nil {
+		return nil, 0, m.err
+	}
+	return &chainhash.Hash{}, m.height, nil
+}
+
+func (m *mockBackend) GetBlock(*chainhash.Hash) (*wire.MsgBlock, er.R) {
+	m.calls++
+	return &wire.MsgBlock{}, m.err
+}
+
+func (m *mockBackend) GetBlockHash(int64) (*chainhash.Hash, er.R) {
+	m.calls++
+	return &chainhash.Hash{}, m.err
+}
+
+func (m *mockBackend) GetBlockHeader(*chainhash.Hash) (*wire.BlockHeader, er.R) {
+	m.calls++
+	return &wire.BlockHeader{}, m.err
+}
+
+func (m *mockBackend) FilterBlocks(
+	*chain.FilterBlocksRequest) (*chain.FilterBlocksResponse, er.R) {
+	m.calls++
+	return &chain.FilterBlocksResponse{}, m.err
+}
+
+func (m *mockBackend) BlockStamp() (*waddrmgr.BlockStamp, er.R) {
+	m.calls++
+	return &waddrmgr.BlockStamp{Height: m.height}, m.err
+}
+
+func (m *mockBackend) SendRawTransaction(tx *wire.MsgTx, _ bool) (
+	*chainhash.Hash, er.R) {
+	m.calls++
+	if m.err != nil {
+		return nil, m.err
+	}
+	m.sent++
+	hash := tx.TxHash()
+	return &hash, nil
+}
+
+// TestFailoverClient asserts that a FailoverClient switches from the RPC
+// backend to neutrino when requests to the RPC backend fail, keeps using
+// neutrino for the failback interval, and switches back to RPC once it
+// answers again.
+func TestFailoverClient(t *testing.T) {
+	rpc := &mockBackend{name: "pktd", height: 100}
+	neut := &mockBackend{name: "neutrino", height: 99}
+	c := chain.NewFailoverClient(rpc, neut, time.Hour)
+
+	checkBest := func(expBackEnd string, expHeight int32) {
+		t.Helper()
+		_, height, err := c.GetBestBlock()
+		if err != nil {
+			t.Fatalf("unable to get best block: %v", err)
+		}
+		if height != expHeight {
+			t.Fatalf("expected height %d, got %d", expHeight, height)
+		}
+		if backEnd := c.BackEnd(); backEnd != expBackEnd {
+			t.Fatalf("expected backend %s, got %s", expBackEnd, backEnd)
+		}
+		if active := chain.ActiveBackEnd(c); active.BackEnd() != expBackEnd {
+			t.Fatalf("expected active backend %s, got %s", expBackEnd,
+				active.BackEnd())
+		}
+	}
+
+	if err := c.Start(); err != nil {
+		t.Fatalf("unable to start client: %v", err)
+	}
+	checkBest("pktd", 100)
+
+	// An error which both backends return does not cause a failover.
+	rpc.err = rpcclient.ErrClientDisconnect.Default()
+	neut.err = er.New("block not found")
+	if _, err := c.GetBlockHash(1000); !rpcclient.ErrClientDisconnect.Is(err) {
+		t.Fatalf("expected the error of the primary, got %v", err)
+	}
+	neut.err = nil
+	if backEnd := c.BackEnd(); backEnd != "pktd" {
+		t.Fatalf("expected backend pktd, got %s", backEnd)
+	}
+
+	// Once the RPC backend fails a request which neutrino answers, the
+	// client switches to neutrino and stops asking the RPC backend.
+	checkBest("neutrino", 99)
+	rpc.calls = 0
+	if _, err := c.BlockStamp(); err != nil {
+		t.Fatalf("unable to get block stamp: %v", err)
+	}
+	checkBest("neutrino", 99)
+	if rpc.calls != 0 {
+		t.Fatalf("expected no requests to the failed backend, got %d",
+			rpc.calls)
+	}
+
+	// A transaction is broadcast by neutrino while the RPC backend is not
+	// reachable, but one which was rejected is not broadcast at all.
+	tx := wire.NewMsgTx(1)
+	if _, err := c.SendRawTransaction(tx, false); err != nil {
+		t.Fatalf("unable to send transaction: %v", err)
+	}
+	if neut.sent != 1 {
+		t.Fatalf("expected transaction to be sent by neutrino")
+	}
+	neut.err = er.New("transaction rejected")
+	if _, err := c.SendRawTransaction(tx, false); err == nil {
+		t.Fatalf("expected rejected transaction to fail")
+	}
+	if rpc.calls != 0 {
+		t.Fatalf("expected rejected transaction not to be sent by pktd")
+	}
+	neut.err = nil
+
+	// After the failback interval the RPC backend is tried again, and used
+	// from then on once it answers.
+	c = chain.NewFailoverClient(rpc, neut, 0)
+	checkBest("neutrino", 99)
+	rpc.err = nil
+	checkBest("pktd", 100)
+	neut.calls = 0
+	if _, err := c.GetBlockHeader(&chainhash.Hash{}); err != nil {
+		t.Fatalf("unable to get block header: %v", err)
+	}
+	if neut.calls != 0 {
+		t.Fatalf("expected no requests to the secondary backend, got %d",
+			neut.calls)
+	}
+}
+
+// TestFailoverClientStart asserts that a FailoverClient starts when only one
+// of its backends starts, and fails to start when neither does.
+func TestFailoverClientStart(t *testing.T) {
+	rpc := &mockBackend{name: "pktd", startErr: er.New("connection refused")}
+	neut := &mockBackend{name: "neutrino"}
+	c := chain.NewFailoverClient(rpc, neut, time.Minute)
+	if err := c.Start(); err != nil {
+		t.Fatalf("expected client to start with neutrino, got %v", err)
+	}
+
+	neut.startErr = er.New("no peers")
+	if err := c.Start(); err == nil {
+		t.Fatalf("expected client without any backend not to start")
+	}
+}
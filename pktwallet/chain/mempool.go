@@ -0,0 +1,147 @@
+package chain
+
+import (
+	"bytes"
+	"encoding/hex"
+
+	"github.com/pkt-cash/pktd/btcjson"
+	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/pktlog/log"
+	"github.com/pkt-cash/pktd/wire"
+)
+
+// mempoolSubscriptionBuffer is the number of transactions which are queued for
+// a mempool subscriber before further transactions are dropped.
+const mempoolSubscriptionBuffer = 100
+
+// MempoolSubscription delivers the transactions which are accepted to the
+// mempool of the chain backend.
+type MempoolSubscription struct {
+	// Txs receives the transactions accepted to the mempool. Transactions
+	// are dropped if the subscriber falls behind. It is closed once the
+	// subscription is canceled or the client is stopped.
+	Txs <-chan *wire.MsgTx
+
+	txs    chan *wire.MsgTx
+	id     uint64
+	client *RPCClient
+}
+
+// Cancel ends the subscription.
+func (s *MempoolSubscription) Cancel() {
+	s.client.mempoolMtx.Lock()
+	defer s.client.mempoolMtx.Unlock()
+
+	if _, ok := s.client.mempoolSubs[s.id]; ok {
+		delete(s.client.mempoolSubs, s.id)
+		close(s.txs)
+	}
+}
+
+// SubscribeMempoolTxs subscribes to the transactions which are accepted to the
+// mempool of the pktd backend. The backend is asked to notify new transactions
+// when the first subscription is made.
+func (c *RPCClient) SubscribeMempoolTxs() (*MempoolSubscription, er.R) {
+	c.mempoolMtx.Lock()
+	notifying := c.notifyingMempool
+	stopped := c.mempoolStopped
+	c.mempoolMtx.Unlock()
+
+	if stopped {
+		return nil, errMempoolClientStopped()
+	}
+
+	// The notifications are handled under the lock, so it must not be held
+	// while waiting for the backend to answer.
+	if !notifying {
+		if err := c.NotifyNewTransactions(true); err != nil {
+			return nil, err
+		}
+	}
+
+	c.mempoolMtx.Lock()
+	defer c.mempoolMtx.Unlock()
+
+	// The client may have been stopped while waiting for the backend.
+	if c.mempoolStopped {
+		return nil, errMempoolClientStopped()
+	}
+	c.notifyingMempool = true
+	c.mempoolSubID++
+	txs := make(chan *wire.MsgTx, mempoolSubscriptionBuffer)
+	sub := &MempoolSubscription{
+		Txs:    txs,
+		txs:    txs,
+		id:     c.mempoolSubID,
+		client: c,
+	}
+	c.mempoolSubs[sub.id] = sub
+	return sub, nil
+}
+
+// endMempoolSubs closes every mempool subscription once the client is stopped,
+// since no more transactions are delivered to them, and refuses further ones.
+func (c *RPCClient) endMempoolSubs() {
+	c.mempoolMtx.Lock()
+	defer c.mempoolMtx.Unlock()
+
+	c.mempoolStopped = true
+	for id, sub := range c.mempoolSubs {
+		delete(c.mempoolSubs, id)
+		close(sub.txs)
+	}
+}
+
+func errMempoolClientStopped() er.R {
+	return er.New("the chain client is stopped, mempool transactions " +
+		"are no longer delivered")
+}
+
+// onClientConnected is called whenever the client connects or reconnects to
+// the backend. A new connection does not carry the mempool notifications of
+// the last one, so they are requested again if there are subscribers, and
+// requested by the next subscriber otherwise.
+func (c *RPCClient) onClientConnected() {
+	c.mempoolMtx.Lock()
+	reconnect := c.mempoolConnected
+	c.mempoolConnected = true
+	subscribed := len(c.mempoolSubs) > 0
+	if reconnect && !subscribed {
+		c.notifyingMempool = false
+	}
+	c.mempoolMtx.Unlock()
+
+	if !reconnect || !subscribed {
+		return
+	}
+	if err := c.NotifyNewTransactions(true); err != nil {
+		log.Warnf("Unable to request mempool notifications: %v", err)
+	}
+}
+
+// onTxAcceptedVerbose passes a transaction accepted to the mempool of the
+// backend on to the mempool subscribers.
+func (c *RPCClient) onTxAcceptedVerbose(txDetails *btcjson.TxRawResult) {
+	serializedTx, errr := hex.DecodeString(txDetails.Hex)
+	if errr != nil {
+		log.Warnf("Invalid mempool transaction %s: %v", txDetails.Txid, errr)
+		return
+	}
+	tx := new(wire.MsgTx)
+	if err := tx.Deserialize(bytes.NewReader(serializedTx)); err != nil {
+		log.Warnf("Invalid mempool transaction %s: %v", txDetails.Txid, err)
+		return
+	}
+
+	c.mempoolMtx.Lock()
+	defer c.mempoolMtx.Unlock()
+
+	for _, sub := range c.mempoolSubs {
+		select {
+		case sub.txs <- tx:
+		default:
+			log.Warnf("Mempool subscriber is falling behind, dropping "+
+				"transaction %s", txDetails.Txid)
+		}
+	}
+}
@@ -0,0 +1,320 @@
+package chain_test
+
+import (
+	"bytes"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	jsoniter "github.com/json-iterator/go"
+
+	"github.com/pkt-cash/pktd/btcjson"
+	"github.com/pkt-cash/pktd/chaincfg"
+	"github.com/pkt-cash/pktd/pktwallet/chain"
+	"github.com/pkt-cash/pktd/wire"
+)
+
+// mempoolBackend is a websocket server which answers every request with a
+// null result, counts the notifynewtransactions requests made on each
+// connection and can send transactions to the client on its last connection.
+type mempoolBackend struct {
+	t        *testing.T
+	upgrader websocket.Upgrader
+
+	mtx     sync.Mutex
+	conn    *websocket.Conn
+	conns   int
+	notify  map[int]int
+	changed chan struct{}
+}
+
+func newMempoolBackend(t *testing.T) *mempoolBackend {
+	return &mempoolBackend{
+		t:       t,
+		notify:  make(map[int]int),
+		changed: make(chan struct{}, 100),
+	}
+}
+
+func (b *mempoolBackend) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	conn, errr := b.upgrader.Upgrade(w, r, nil)
+	if errr != nil {
+		b.t.Errorf("unable to upgrade connection: %v", errr)
+		return
+	}
+	defer conn.Close()
+
+	b.mtx.Lock()
+	b.conns++
+	b.conn = conn
+	connNum := b.conns
+	b.mtx.Unlock()
+	b.signal()
+
+	for {
+		_, msg, errr := conn.ReadMessage()
+		if errr != nil {
+			return
+		}
+		var req struct {
+			Method string      `json:"method"`
+			ID     interface{} `json:"id"`
+		}
+		if errr := jsoniter.Unmarshal(msg, &req); errr != nil {
+			b.t.Errorf("invalid request %s: %v", msg, errr)
+			return
+		}
+		reply, errr := jsoniter.Marshal(map[string]interface{}{
+			"result": nil,
+			"error":  nil,
+			"id":     req.ID,
+		})
+		if errr != nil {
+			b.t.Errorf("unable to marshal reply: %v", errr)
+			return
+		}
+
+		b.mtx.Lock()
+		if req.Method == "notifynewtransactions" {
+			b.notify[connNum]++
+		}
+		errr = conn.WriteMessage(websocket.TextMessage, reply)
+		b.mtx.Unlock()
+		if errr != nil {
+			return
+		}
+		b.signal()
+	}
+}
+
+// signal wakes up a waiter for the state of the backend.
+func (b *mempoolBackend) signal() {
+	select {
+	case b.changed <- struct{}{}:
+	default:
+	}
+}
+
+// notifications returns the number of notifynewtransactions requests made on
+// the given connection, counting from 1.
+func (b *mempoolBackend) notifications(conn int) int {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+	return b.notify[conn]
+}
+
+// waitNotifications waits until the client requested mempool notifications on
+// the given connection.
+func (b *mempoolBackend) waitNotifications(conn int) {
+	timeout := time.After(5 * time.Second)
+	for b.notifications(conn) == 0 {
+		select {
+		case <-b.changed:
+		case <-timeout:
+			b.t.Fatalf("mempool notifications not requested on "+
+				"connection %d", conn)
+		}
+	}
+}
+
+// waitConnections waits until the client made the given number of
+// connections.
+func (b *mempoolBackend) waitConnections(n int) {
+	timeout := time.After(5 * time.Second)
+	for {
+		b.mtx.Lock()
+		conns := b.conns
+		b.mtx.Unlock()
+		if conns >= n {
+			return
+		}
+		select {
+		case <-b.changed:
+		case <-timeout:
+			b.t.Fatalf("client did not make %d connections", n)
+		}
+	}
+}
+
+// dropConnection closes the last connection of the client.
+func (b *mempoolBackend) dropConnection() {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+	b.conn.Close()
+}
+
+// sendTx notifies the client of a transaction accepted to the mempool.
+func (b *mempoolBackend) sendTx(tx *wire.MsgTx) {
+	var buf bytes.Buffer
+	if err := tx.Serialize(&buf); err != nil {
+		b.t.Fatalf("unable to serialize tx: %v", err)
+	}
+	ntfn, errr := jsoniter.Marshal(map[string]interface{}{
+		"jsonrpc": "1.0",
+		"method":  btcjson.TxAcceptedVerboseNtfnMethod,
+		"params": []interface{}{&btcjson.TxRawResult{
+			Hex:  hex.EncodeToString(buf.Bytes()),
+			Txid: tx.TxHash().String(),
+		}},
+		"id": nil,
+	})
+	if errr != nil {
+		b.t.Fatalf("unable to marshal notification: %v", errr)
+	}
+
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+	if errr := b.conn.WriteMessage(websocket.TextMessage, ntfn); errr != nil {
+		b.t.Fatalf("unable to send notification: %v", errr)
+	}
+}
+
+// receiveTx waits for the subscription to deliver the transaction.
+func receiveTx(t *testing.T, sub *chain.MempoolSubscription, want *wire.MsgTx) {
+	t.Helper()
+
+	select {
+	case tx, ok := <-sub.Txs:
+		if !ok {
+			t.Fatal("subscription closed unexpectedly")
+		}
+		if tx.TxHash() != want.TxHash() {
+			t.Fatalf("expected tx %v, got %v", want.TxHash(),
+				tx.TxHash())
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("tx %v not delivered", want.TxHash())
+	}
+}
+
+// TestMempoolSubscription ensures that the mempool notifications of the pktd
+// backend are requested once for all subscribers, that every subscriber
+// receives the transactions until it cancels its subscription, and that the
+// notifications are requested again when the client reconnects.
+func TestMempoolSubscription(t *testing.T) {
+	backend := newMempoolBackend(t)
+	server := httptest.NewServer(backend)
+	defer server.Close()
+
+	client, err := chain.NewRPCClient(&chaincfg.SimNetParams,
+		strings.TrimPrefix(server.URL, "http://"), "user", "pass", nil,
+		true, 1)
+	if err != nil {
+		t.Fatalf("unable to create client: %v", err)
+	}
+	if err := client.Connect(1); err != nil {
+		t.Fatalf("unable to connect: %v", err)
+	}
+	defer func() {
+		client.Stop()
+		client.WaitForShutdown()
+	}()
+
+	sub1, err := client.SubscribeMempoolTxs()
+	if err != nil {
+		t.Fatalf("unable to subscribe: %v", err)
+	}
+	sub2, err := client.SubscribeMempoolTxs()
+	if err != nil {
+		t.Fatalf("unable to subscribe: %v", err)
+	}
+	if n := backend.notifications(1); n != 1 {
+		t.Fatalf("expected 1 notifynewtransactions request, got %d", n)
+	}
+
+	tx1 := &wire.MsgTx{
+		Version: 1,
+		TxIn:    []*wire.TxIn{{Sequence: 1}},
+		TxOut:   []*wire.TxOut{{Value: 1000}},
+	}
+	backend.sendTx(tx1)
+	receiveTx(t, sub1, tx1)
+	receiveTx(t, sub2, tx1)
+
+	// A canceled subscription is closed and may be canceled again.
+	sub1.Cancel()
+	sub1.Cancel()
+	select {
+	case _, ok := <-sub1.Txs:
+		if ok {
+			t.Fatal("expected the canceled subscription to be closed")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("canceled subscription not closed")
+	}
+
+	// After a reconnect, the remaining subscriber keeps receiving the
+	// transactions of the backend.
+	backend.dropConnection()
+	backend.waitNotifications(2)
+	tx2 := &wire.MsgTx{
+		Version: 1,
+		TxIn:    []*wire.TxIn{{Sequence: 2}},
+		TxOut:   []*wire.TxOut{{Value: 2000}},
+	}
+	backend.sendTx(tx2)
+	receiveTx(t, sub2, tx2)
+
+	// Subscribers which come after a reconnect without subscribers are
+	// served as well.
+	sub2.Cancel()
+	backend.dropConnection()
+	backend.waitConnections(3)
+	sub3, err := client.SubscribeMempoolTxs()
+	if err != nil {
+		t.Fatalf("unable to subscribe: %v", err)
+	}
+	defer sub3.Cancel()
+	backend.waitNotifications(3)
+	tx3 := &wire.MsgTx{
+		Version: 1,
+		TxIn:    []*wire.TxIn{{Sequence: 3}},
+		TxOut:   []*wire.TxOut{{Value: 3000}},
+	}
+	backend.sendTx(tx3)
+	receiveTx(t, sub3, tx3)
+}
+
+// TestMempoolSubscriptionStop ensures that stopping the client closes its
+// mempool subscriptions and refuses new ones.
+func TestMempoolSubscriptionStop(t *testing.T) {
+	backend := newMempoolBackend(t)
+	server := httptest.NewServer(backend)
+	defer server.Close()
+
+	client, err := chain.NewRPCClient(&chaincfg.SimNetParams,
+		strings.TrimPrefix(server.URL, "http://"), "user", "pass", nil,
+		true, 1)
+	if err != nil {
+		t.Fatalf("unable to create client: %v", err)
+	}
+	if err := client.Connect(1); err != nil {
+		t.Fatalf("unable to connect: %v", err)
+	}
+
+	sub, err := client.SubscribeMempoolTxs()
+	if err != nil {
+		t.Fatalf("unable to subscribe: %v", err)
+	}
+	client.Stop()
+	client.WaitForShutdown()
+
+	select {
+	case _, ok := <-sub.Txs:
+		if ok {
+			t.Fatal("expected the subscription to be closed")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("subscription not closed when the client stopped")
+	}
+	sub.Cancel()
+
+	if _, err := client.SubscribeMempoolTxs(); err == nil {
+		t.Fatal("expected subscribing to a stopped client to fail")
+	}
+}
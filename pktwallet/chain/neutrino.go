@@ -1,6 +1,7 @@
 package chain
 
 import (
+	"bytes"
 	"time"
 
 	"github.com/pkt-cash/pktd/btcutil/er"
@@ -205,6 +206,93 @@ func (s *NeutrinoClient) FilterBlocks(
 	return nil, nil
 }
 
+// ScanBlocksMatch is a block whose compact filter matched one of the scripts
+// of a ScanBlocks request.
+type ScanBlocksMatch struct {
+	Height int32
+	Hash   chainhash.Hash
+
+	// TxHashes are the hashes of the transactions of the block with outputs
+	// paying to one of the scripts. They are only set if the block was
+	// fetched.
+	TxHashes []chainhash.Hash
+}
+
+// ScanBlocks matches the compact filters of the blocks from startHeight to
+// stopHeight, inclusive, against the output scripts and returns the blocks
+// which match, without importing the scripts into the wallet. Compact filters
+// have false positives and also match blocks which spend from the scripts, so
+// a match only means that the block is likely relevant. Full blocks are only
+// downloaded if fetchBlocks is set, to find the transactions which pay to the
+// scripts.
+func (s *NeutrinoClient) ScanBlocks(scripts [][]byte, startHeight,
+	stopHeight int32, fetchBlocks bool) ([]ScanBlocksMatch, er.R) {
+
+	var matches []ScanBlocksMatch
+	for height := startHeight; height <= stopHeight; height++ {
+		select {
+		case <-s.stop:
+			return nil, er.New("neutrino client is shutting down")
+		default:
+		}
+
+		hash, err := s.CS.GetBlockHash(int64(height))
+		if err != nil {
+			return nil, err
+		}
+
+		filter, err := s.pollCFilter(hash)
+		if err != nil {
+			return nil, err
+		}
+
+		// Skip any empty filters.
+		if filter == nil || filter.N() == 0 {
+			continue
+		}
+
+		key := builder.DeriveKey(hash)
+		matched, err := filter.MatchAny(key, scripts)
+		if err != nil {
+			return nil, err
+		} else if !matched {
+			continue
+		}
+
+		match := ScanBlocksMatch{Height: height, Hash: *hash}
+		if fetchBlocks {
+			log.Tracef("Fetching block height=%d hash=%v", height, hash)
+
+			block, err := s.CS.GetBlock(*hash, neutrino.Encoding(wire.BaseEncoding))
+			if err != nil {
+				return nil, err
+			}
+			match.TxHashes = TxsPayingToScripts(block.MsgBlock(), scripts)
+		}
+		matches = append(matches, match)
+	}
+
+	return matches, nil
+}
+
+// TxsPayingToScripts returns the hashes of the transactions of the block with
+// at least one output paying to one of the scripts.
+func TxsPayingToScripts(block *wire.MsgBlock, scripts [][]byte) []chainhash.Hash {
+	var hashes []chainhash.Hash
+	for _, tx := range block.Transactions {
+	outputs:
+		for _, txOut := range tx.TxOut {
+			for _, script := range scripts {
+				if bytes.Equal(txOut.PkScript, script) {
+					hashes = append(hashes, tx.TxHash())
+					break outputs
+				}
+			}
+		}
+	}
+	return hashes
+}
+
 // buildFilterBlocksWatchList constructs a watchlist used for matching against a
 // cfilter from a FilterBlocksRequest. The watchlist will be populated with all
 // external addresses, internal addresses, and outpoints contained in the
@@ -0,0 +1,66 @@
+package chain_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/pkt-cash/pktd/chaincfg/chainhash"
+	"github.com/pkt-cash/pktd/pktwallet/chain"
+	"github.com/pkt-cash/pktd/wire"
+)
+
+// TestTxsPayingToScripts asserts that the transactions of a block with
+// outputs paying to one of the scripts are found, each of them once.
+func TestTxsPayingToScripts(t *testing.T) {
+	scriptA := []byte{0x00, 0x14, 0x01}
+	scriptB := []byte{0x00, 0x14, 0x02}
+	other := []byte{0x00, 0x14, 0x03}
+
+	newTx := func(lockTime uint32, scripts ...[]byte) *wire.MsgTx {
+		tx := wire.NewMsgTx(1)
+		tx.LockTime = lockTime
+		for _, script := range scripts {
+			tx.AddTxOut(wire.NewTxOut(1000, script))
+		}
+		return tx
+	}
+
+	payA := newTx(1, other, scriptA)
+	payOther := newTx(2, other)
+	payBoth := newTx(3, scriptB, scriptA, scriptB)
+	block := &wire.MsgBlock{
+		Transactions: []*wire.MsgTx{payA, payOther, payBoth},
+	}
+
+	tests := []struct {
+		name    string
+		scripts [][]byte
+		expTxs  []chainhash.Hash
+	}{
+		{
+			name:    "no scripts",
+			scripts: nil,
+			expTxs:  nil,
+		},
+		{
+			name:    "single script",
+			scripts: [][]byte{scriptB},
+			expTxs:  []chainhash.Hash{payBoth.TxHash()},
+		},
+		{
+			name:    "multiple scripts",
+			scripts: [][]byte{scriptA, scriptB},
+			expTxs: []chainhash.Hash{
+				payA.TxHash(), payBoth.TxHash(),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		txs := chain.TxsPayingToScripts(block, test.scripts)
+		if !reflect.DeepEqual(txs, test.expTxs) {
+			t.Fatalf("%s: expected transactions %v, got %v",
+				test.name, test.expTxs, txs)
+		}
+	}
+}
@@ -5,6 +5,7 @@
 package chain
 
 import (
+	"context"
 	"sync"
 	"time"
 
@@ -31,6 +32,13 @@ type RPCClient struct {
 	wg      sync.WaitGroup
 	started bool
 	quitMtx sync.Mutex
+
+	mempoolMtx       sync.Mutex
+	mempoolSubs      map[uint64]*MempoolSubscription
+	mempoolSubID     uint64
+	notifyingMempool bool
+	mempoolConnected bool
+	mempoolStopped   bool
 }
 
 var _ Interface = (*RPCClient)(nil)
@@ -61,8 +69,12 @@ func NewRPCClient(chainParams *chaincfg.Params, connect, user, pass string, cert
 		chainParams:       chainParams,
 		reconnectAttempts: reconnectAttempts,
 		quit:              make(chan struct{}),
+		mempoolSubs:       make(map[uint64]*MempoolSubscription),
 	}
-	rpcClient, err := rpcclient.New(client.connConfig, nil)
+	rpcClient, err := rpcclient.New(client.connConfig, &rpcclient.NotificationHandlers{
+		OnClientConnected:   client.onClientConnected,
+		OnTxAcceptedVerbose: client.onTxAcceptedVerbose,
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -79,13 +91,31 @@ func (c *RPCClient) BackEnd() string {
 // If successful, handler goroutines are started to process notifications
 // sent by the server.  After a limited number of connection attempts, this
 // function gives up, and therefore will not block forever waiting for the
-// connection to be established to a server that may not exist.
+// connection to be established to a server that may not exist.  Once
+// connected, Start waits until the server answers requests or the client is
+// stopped.
 func (c *RPCClient) Start() er.R {
 	err := c.Connect(c.reconnectAttempts)
 	if err != nil {
 		return err
 	}
 
+	// Wait for the server to become responsive, giving up if the client is
+	// stopped in the meantime.
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		select {
+		case <-c.quit:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+	if err := c.WaitForConnection(ctx); err != nil {
+		c.Disconnect()
+		return err
+	}
+
 	// Verify that the server is running on the expected network.
 	net, err := c.GetCurrentNet()
 	if err != nil {
@@ -113,6 +143,7 @@ func (c *RPCClient) Stop() {
 	default:
 		close(c.quit)
 		c.Client.Shutdown()
+		c.endMempoolSubs()
 	}
 	c.quitMtx.Unlock()
 }
@@ -27,14 +27,20 @@ import (
 	"github.com/pkt-cash/pktd/pktwallet/internal/cfgutil"
 	"github.com/pkt-cash/pktd/pktwallet/internal/legacy/keystore"
 	"github.com/pkt-cash/pktd/pktwallet/netparams"
+	"github.com/pkt-cash/pktd/pktwallet/testrand"
 	"github.com/pkt-cash/pktd/pktwallet/wallet"
+	"github.com/pkt-cash/pktd/pktwallet/walletdb"
 )
 
 const (
 	defaultCAFilename       = "pktd.cert"
 	defaultConfigFilename   = "pktwallet.conf"
 	defaultLogLevel         = "info"
+	defaultLogFormat        = "text"
 	defaultLogDirname       = "logs"
+	defaultLogFilename      = "pktwallet.log"
+	defaultMaxLogFileSize   = 10
+	defaultMaxLogFiles      = 3
 	defaultRPCMaxClients    = 10
 	defaultRPCMaxWebsockets = 25
 )
@@ -55,21 +61,35 @@ type config struct {
 	ShowVersion   bool                    `short:"V" long:"version" description:"Display version information and exit"`
 	Create        bool                    `long:"create" description:"Create the wallet if it does not exist"`
 	CreateTemp    bool                    `long:"createtemp" description:"Create a temporary simulation wallet (pass=password) in the data directory indicated; must call with --datadir"`
+	Birthday      string                  `long:"birthday" description:"When restoring a seed with --create, the date (YYYY-MM-DD), unix timestamp or block height to start scanning the chain from; a birthday after the first use of the seed misses funds"`
+	CheckWalletDB bool                    `long:"checkwalletdb" description:"Check the wallet database for inconsistencies without modifying it and exit"`
 	AppDataDir    *cfgutil.ExplicitString `short:"A" long:"appdata" description:"Application data directory for wallet config, databases and logs"`
 	Wallet        string                  `short:"w" long:"wallet" description:"Wallet file name or path, if a simple word such as 'personal' then pktwallet will look for wallet_personal.db, if prefixed with a / then pktwallet will consider it an absolute path."`
+	DbDriver      string                  `long:"dbdriver" description:"Database driver used for the wallet and neutrino databases"`
+	AddressReuse  string                  `long:"addressreuse" description:"How to handle reuse of already used receive addresses {allow, warn, block}"`
+	ChangeType    string                  `long:"changetype" description:"Type of the addresses change is sent to, input sends it back to an input address {input, bech32, legacy}"`
 	TestNet3      bool                    `long:"testnet" description:"Use the test Bitcoin network (version 3) (default mainnet)"`
 	PktTestNet    bool                    `long:"pkttest" description:"Use the test pkt.cash test network"`
 	BtcMainNet    bool                    `long:"btc" description:"Use the test bitcoin main network"`
 	PktMainNet    bool                    `long:"pkt" description:"Use the test pkt.cash main network"`
 	SimNet        bool                    `long:"simnet" description:"Use the simulation test network (default mainnet)"`
 	NoInitialLoad bool                    `long:"noinitialload" description:"Defer wallet creation/opening on startup and enable loading wallets over RPC"`
+	CompactDB     bool                    `long:"compactdb" description:"Compact the wallet database on startup to reclaim the space left behind by deleted data, requires free disk space for a copy of the database"`
 	DebugLevel    string                  `short:"d" long:"debuglevel" description:"Logging level {trace, debug, info, warn, error, critical}"`
+	LogFormat     string                  `long:"logformat" description:"Format of the log output, json writes one object per line with typed fields {text, json}"`
 	LogDir        string                  `long:"logdir" description:"Directory to log output."`
+	MaxLogSize    int                     `long:"maxlogfilesize" description:"Maximum size of the log file in MB before it is rotated"`
+	MaxLogFiles   int                     `long:"maxlogfiles" description:"Number of rotated log files to keep, 0 keeps none"`
+	CompressLogs  bool                    `long:"compresslogs" description:"Compress rotated log files with gzip"`
+	NoLogFile     bool                    `long:"nologfile" description:"Don't write a log file, only log to stdout"`
 	StatsViz      string                  `long:"statsviz" description:"Enable StatsViz runtime visualization on given port -- NOTE port must be between 1024 and 65535"`
-	Profile       string                  `long:"profile" description:"Enable HTTP profiling on given port -- NOTE port must be between 1024 and 65535"`
+	Profile       string                  `long:"profile" description:"Enable HTTP profiling on given port, or on a unix socket given as unix:/path -- NOTE port must be between 1024 and 65535"`
 
 	// Wallet options
-	WalletPass string `long:"walletpass" default-mask:"-" description:"The public wallet password -- Only required if the wallet was created with one"`
+	WalletPass       string `long:"walletpass" default-mask:"-" description:"The public wallet password -- Only required if the wallet was created with one"`
+	MaxWatchedAddrs  int    `long:"maxwatchedaddrs" description:"Maximum number of addresses to watch for transactions, deriving or rescanning more fails with an error instead of using unbounded memory, 0 means no limit"`
+	ReorgSafetyDepth int32  `long:"reorgsafetydepth" description:"Number of confirmations after which a transaction is considered final, a warning is logged if a chain reorganization reverses a block at this depth"`
+	TxSequence       uint32 `long:"txsequence" description:"Default sequence number of the inputs of sent transactions, below 4294967295 enables their locktime and below 4294967294 signals replaceability (BIP0125)"`
 
 	// RPC client options
 	RPCConnect       string                  `short:"c" long:"rpcconnect" description:"Hostname/IP and port of pktd RPC server to connect to (default localhost:8334, testnet: localhost:18334, simnet: localhost:18556)"`
@@ -99,15 +119,19 @@ type config struct {
 	// Usernames can also be used for the consensus RPC client, so they
 	// aren't considered legacy.
 	UseRPC                 bool                    `long:"userpc" description:"Use an RPC connection to pktd rather than using neutrino, the default behavior is to connect to a single local pktd instance using neutrino, UseSPV will make neutrino connect to multiple nodes"`
+	UseHybrid              bool                    `long:"usehybrid" description:"Use an RPC connection to pktd while it is available and fall back to neutrino when it is not, implies --userpc"`
 	RPCCert                *cfgutil.ExplicitString `long:"rpccert" description:"File containing the certificate file"`
 	RPCKey                 *cfgutil.ExplicitString `long:"rpckey" description:"File containing the certificate key"`
 	OneTimeTLSKey          bool                    `long:"onetimetlskey" description:"Generate a new TLS certpair at startup, but only write the certificate to disk"`
 	DisableServerTLS       bool                    `long:"noservertls" description:"Disable TLS for the RPC server -- NOTE: This is only allowed if the RPC server is bound to localhost"`
+	DisableLegacyRPC       bool                    `long:"nolegacyrpc" description:"Disable the legacy JSON-RPC server, only the experimental gRPC server is started, which offers no wallet services yet"`
 	LegacyRPCListeners     []string                `long:"rpclisten" description:"Listen for legacy RPC connections on this interface/port (default port: 8332, testnet: 18332, simnet: 18554)"`
 	LegacyRPCMaxClients    int64                   `long:"rpcmaxclients" description:"Max number of legacy RPC clients for standard connections"`
 	LegacyRPCMaxWebsockets int64                   `long:"rpcmaxwebsockets" description:"Max number of legacy RPC websocket connections"`
 	Username               string                  `short:"u" long:"rpcuser" description:"Username for legacy RPC and pktd authentication (if pktdusername is unset)"`
 	Password               string                  `short:"P" long:"rpcpass" default-mask:"-" description:"Password for legacy RPC and pktd authentication (if pktdpassword is unset)"`
+	LimitUsername          string                  `long:"rpclimituser" description:"Username for read-only legacy RPC connections"`
+	LimitPassword          string                  `long:"rpclimitpass" default-mask:"-" description:"Password for read-only legacy RPC connections"`
 
 	// These exist because btcwallet took it upon themselves to specify a username and password differently from btcd
 	// in case any of these are existing in the wild, they'll be accepted.
@@ -120,8 +144,35 @@ type config struct {
 	// when the new gRPC server is enabled.
 	ExperimentalRPCListeners []string `long:"experimentalrpclisten" description:"Listen for RPC connections on this interface/port"`
 
+	// Test options
+	//
+	// TestSeed is only honored by rpctest builds, any other build refuses
+	// to start when it is set.
+	TestSeed string `long:"testseed" hidden:"true" description:"Seed the wallet's randomness deterministically so generated seeds and addresses are predictable -- NOTE: only available in rpctest builds, NEVER use this with real funds"`
+
 	// Deprecated options
 	DataDir *cfgutil.ExplicitString `short:"b" long:"datadir" default-mask:"-" description:"DEPRECATED -- use appdata instead"`
+
+	// addressReusePolicy is the parsed value of AddressReuse.
+	addressReusePolicy wallet.AddressReusePolicy
+
+	// changeType is the parsed value of ChangeType.
+	changeType wallet.ChangeType
+
+	// logRotator writes the log file in LogDir, it is nil if NoLogFile is
+	// set.
+	logRotator *log.Rotator
+}
+
+// isSupportedDbDriver returns whether the named walletdb driver has been
+// registered.
+func isSupportedDbDriver(driver string) bool {
+	for _, supported := range walletdb.SupportedDrivers() {
+		if driver == supported {
+			return true
+		}
+	}
+	return false
 }
 
 // cleanAndExpandPath expands environement variables and leading ~ in the
@@ -208,11 +259,19 @@ func loadConfig() (*config, []string, er.R) {
 	// Default config.
 	cfg := config{
 		DebugLevel:             defaultLogLevel,
+		LogFormat:              defaultLogFormat,
 		Wallet:                 "wallet.db",
+		DbDriver:               wallet.DefaultDbDriver,
+		AddressReuse:           wallet.AddressReuseAllow.String(),
+		ChangeType:             wallet.ChangeTypeInput.String(),
 		ConfigFile:             cfgutil.NewExplicitString(defaultConfigFile),
 		AppDataDir:             cfgutil.NewExplicitString(defaultAppDataDir),
 		LogDir:                 defaultLogDir,
+		MaxLogSize:             defaultMaxLogFileSize,
+		MaxLogFiles:            defaultMaxLogFiles,
 		WalletPass:             wallet.InsecurePubPassphrase,
+		ReorgSafetyDepth:       wallet.DefaultReorgSafetyDepth,
+		TxSequence:             wallet.DefaultTxSequence,
 		CAFile:                 cfgutil.NewExplicitString(""),
 		RPCKey:                 cfgutil.NewExplicitString(defaultRPCKeyFile),
 		RPCCert:                cfgutil.NewExplicitString(defaultRPCCertFile),
@@ -380,6 +439,37 @@ func loadConfig() (*config, []string, er.R) {
 		return nil, nil, err
 	}
 
+	// Select the format of the log output.
+	if err := log.SetLogFormat(cfg.LogFormat); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		parser.WriteHelp(os.Stderr)
+		return nil, nil, err
+	}
+
+	// Validate the log rotation options and start writing the log file.
+	if cfg.MaxLogSize < 1 || cfg.MaxLogFiles < 0 {
+		err := er.Errorf("%s: maxlogfilesize must be at least 1 and "+
+			"maxlogfiles must not be negative", "loadConfig")
+		fmt.Fprintln(os.Stderr, err)
+		parser.WriteHelp(os.Stderr)
+		return nil, nil, err
+	}
+	if !cfg.NoLogFile {
+		logRotator, err := log.NewRotator(
+			filepath.Join(cfg.LogDir, defaultLogFilename),
+			int64(cfg.MaxLogSize)*1024*1024, cfg.MaxLogFiles,
+			cfg.CompressLogs,
+		)
+		if err != nil {
+			err := er.Errorf("%s: unable to create the log file: %v",
+				"loadConfig", err)
+			fmt.Fprintln(os.Stderr, err)
+			return nil, nil, err
+		}
+		log.SetLogFile(logRotator)
+		cfg.logRotator = logRotator
+	}
+
 	// Exit if you try to use a simulation wallet with a standard
 	// data directory.
 	if !(cfg.AppDataDir.ExplicitlySet() || cfg.DataDir.ExplicitlySet()) && cfg.CreateTemp {
@@ -396,6 +486,89 @@ func loadConfig() (*config, []string, er.R) {
 		os.Exit(0)
 	}
 
+	// Validate the database driver against the ones compiled in.
+	if !isSupportedDbDriver(cfg.DbDriver) {
+		err := er.Errorf("%s: unknown database driver %q -- "+
+			"supported drivers are %v", "loadConfig", cfg.DbDriver,
+			walletdb.SupportedDrivers())
+		fmt.Fprintln(os.Stderr, err)
+		parser.WriteHelp(os.Stderr)
+		return nil, nil, err
+	}
+
+	// Validate the address reuse policy.
+	policy, err := wallet.ParseAddressReusePolicy(cfg.AddressReuse)
+	if err != nil {
+		err := er.Errorf("%s: %v", "loadConfig", err)
+		fmt.Fprintln(os.Stderr, err)
+		parser.WriteHelp(os.Stderr)
+		return nil, nil, err
+	}
+	cfg.addressReusePolicy = policy
+
+	// Validate the change type.
+	changeType, err := wallet.ParseChangeType(cfg.ChangeType)
+	if err != nil {
+		err := er.Errorf("%s: %v", "loadConfig", err)
+		fmt.Fprintln(os.Stderr, err)
+		parser.WriteHelp(os.Stderr)
+		return nil, nil, err
+	}
+	cfg.changeType = changeType
+
+	// Validate the maximum number of watched addresses.
+	if cfg.MaxWatchedAddrs < 0 {
+		err := er.Errorf("%s: maxwatchedaddrs must be non-negative, "+
+			"got %d", "loadConfig", cfg.MaxWatchedAddrs)
+		fmt.Fprintln(os.Stderr, err)
+		parser.WriteHelp(os.Stderr)
+		return nil, nil, err
+	}
+
+	// Validate the reorg safety depth.
+	if cfg.ReorgSafetyDepth < 1 {
+		err := er.Errorf("%s: reorgsafetydepth must be at least 1, "+
+			"got %d", "loadConfig", cfg.ReorgSafetyDepth)
+		fmt.Fprintln(os.Stderr, err)
+		parser.WriteHelp(os.Stderr)
+		return nil, nil, err
+	}
+
+	// Seed the wallet's randomness deterministically for tests, this fails
+	// unless built with the rpctest tag.
+	if cfg.TestSeed != "" {
+		if err := testrand.Seed([]byte(cfg.TestSeed)); err != nil {
+			err := er.Errorf("%s: unable to use testseed: %v",
+				"loadConfig", err)
+			fmt.Fprintln(os.Stderr, err)
+			return nil, nil, err
+		}
+		log.Warnf("The wallet's randomness is seeded deterministically " +
+			"with testseed, NEVER use this wallet with real funds")
+	}
+
+	// Validate the profile unix socket path.  Plain port values are
+	// passed through as they were before.
+	if strings.HasPrefix(cfg.Profile, profileUnixPrefix) {
+		socketPath := strings.TrimPrefix(cfg.Profile, profileUnixPrefix)
+		if socketPath == "" {
+			err := er.Errorf("%s: the profile unix socket path must "+
+				"not be empty", "loadConfig")
+			fmt.Fprintln(os.Stderr, err)
+			parser.WriteHelp(os.Stderr)
+			return nil, nil, err
+		}
+		socketPath = cleanAndExpandPath(socketPath)
+		if fi, errr := os.Stat(filepath.Dir(socketPath)); errr != nil || !fi.IsDir() {
+			err := er.Errorf("%s: the directory of the profile unix "+
+				"socket %v does not exist", "loadConfig", socketPath)
+			fmt.Fprintln(os.Stderr, err)
+			parser.WriteHelp(os.Stderr)
+			return nil, nil, err
+		}
+		cfg.Profile = profileUnixPrefix + socketPath
+	}
+
 	// Ensure the wallet exists or create it when the create flag is set.
 	netDir := networkDir(cfg.AppDataDir.Value, activeNet.Params)
 	dbPath := wallet.WalletDbPath(netDir, cfg.Wallet)
@@ -407,6 +580,25 @@ func loadConfig() (*config, []string, er.R) {
 		return nil, nil, err
 	}
 
+	// Check the wallet database and exit, failing if it is inconsistent.
+	if cfg.CheckWalletDB {
+		if cfg.Create || cfg.CreateTemp {
+			err := er.Errorf("The flag --checkwalletdb can not be " +
+				"specified with --create or --createtemp.")
+			fmt.Fprintln(os.Stderr, err)
+			return nil, nil, err
+		}
+		n, err := checkWalletDB(&cfg, dbPath)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "Unable to check wallet database:", err)
+			return nil, nil, err
+		}
+		if n > 0 {
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+
 	dbFileExists, err := cfgutil.FileExists(dbPath)
 	if err != nil {
 		fmt.Fprintln(os.Stderr, err)
@@ -478,11 +670,17 @@ func loadConfig() (*config, []string, er.R) {
 		"::1":       {},
 	}
 
-	if !cfg.UseRPC {
+	// The hybrid mode needs the options of both neutrino and the RPC
+	// connection.
+	if cfg.UseHybrid {
+		cfg.UseRPC = true
+	}
+	if !cfg.UseRPC || cfg.UseHybrid {
 		neutrino.MaxPeers = cfg.MaxPeers
 		neutrino.BanDuration = cfg.BanDuration
 		neutrino.BanThreshold = cfg.BanThreshold
-	} else {
+	}
+	if cfg.UseRPC {
 		if cfg.RPCConnect == "" {
 			cfg.RPCConnect = net.JoinHostPort("localhost", activeNet.RPCClientPort)
 		}
@@ -529,6 +727,30 @@ func loadConfig() (*config, []string, er.R) {
 		}
 	}
 
+	// Without the legacy RPC server only the experimental RPC server can
+	// be used, so it needs its own listeners.
+	if cfg.DisableLegacyRPC {
+		if len(cfg.ExperimentalRPCListeners) == 0 {
+			err := er.Errorf("%s: the --nolegacyrpc option requires "+
+				"--experimentalrpclisten", funcName)
+			fmt.Fprintln(os.Stderr, err)
+			fmt.Fprintln(os.Stderr, usageMessage)
+			return nil, nil, err
+		}
+		if cfg.DisableServerTLS {
+			err := er.Errorf("%s: the --nolegacyrpc and --noservertls "+
+				"options may not be used together", funcName)
+			fmt.Fprintln(os.Stderr, err)
+			fmt.Fprintln(os.Stderr, usageMessage)
+			return nil, nil, err
+		}
+		cfg.LegacyRPCListeners = nil
+
+		log.Warnf("The --nolegacyrpc option disables the only RPC " +
+			"server with wallet services, the wallet can't be used " +
+			"over RPC as the experimental RPC server offers none yet")
+	}
+
 	// Only set default RPC listeners when there are no listeners set for
 	// the experimental RPC server.  This is required to prevent the old RPC
 	// server from sharing listen addresses, since it is impossible to
@@ -620,6 +842,28 @@ func loadConfig() (*config, []string, er.R) {
 		cfg.Password = cfg.OldPassword
 	}
 
+	// Make sure that the read-only credentials can't be mistaken for the
+	// ones with full access.
+	if cfg.LimitUsername == cfg.Username && cfg.Username != "" {
+		str := "%s: --rpcuser and --rpclimituser must not specify the " +
+			"same username"
+		err := er.Errorf(str, funcName)
+		fmt.Fprintln(os.Stderr, err)
+		fmt.Fprintln(os.Stderr, usageMessage)
+		return nil, nil, err
+	}
+
+	// The read-only credentials are only enabled with both a username and
+	// a password, so don't silently ignore one of them.
+	if (cfg.LimitUsername == "") != (cfg.LimitPassword == "") {
+		str := "%s: --rpclimituser and --rpclimitpass must be " +
+			"specified together"
+		err := er.Errorf(str, funcName)
+		fmt.Fprintln(os.Stderr, err)
+		fmt.Fprintln(os.Stderr, usageMessage)
+		return nil, nil, err
+	}
+
 	// If the pktd username or password are unset, use the same auth as for
 	// the client.  The two settings were previously shared for pktd and
 	// client auth, so this avoids breaking backwards compatibility while
@@ -0,0 +1,29 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/pkt-cash/pktd/pktwallet/wallet"
+)
+
+// TestIsSupportedDbDriver tests that only registered walletdb drivers are
+// accepted for the dbdriver option.
+func TestIsSupportedDbDriver(t *testing.T) {
+	tests := []struct {
+		driver    string
+		supported bool
+	}{
+		{wallet.DefaultDbDriver, true},
+		{"bdb", true},
+		{"", false},
+		{"BDB", false},
+		{"bdb ", false},
+		{"leveldb", false},
+	}
+	for _, test := range tests {
+		if got := isSupportedDbDriver(test.driver); got != test.supported {
+			t.Fatalf("driver %q: expected supported %v, got %v",
+				test.driver, test.supported, got)
+		}
+	}
+}
@@ -67,6 +67,24 @@ func ProvidePrivPassphrase() ([]byte, er.R) {
 	}
 }
 
+// ProvidePubPassphrase is used to prompt for the public passphrase of the
+// wallet when the configured one is incorrect.
+func ProvidePubPassphrase() ([]byte, er.R) {
+	fmt.Print("Enter the public passphrase of your wallet: ")
+	pass, err := terminal.ReadPassword(int(os.Stdin.Fd()))
+	if err != nil {
+		return nil, er.E(err)
+	}
+	fmt.Print("\n")
+	return bytes.TrimSpace(pass), nil
+}
+
+// IsInteractive returns whether standard input is a terminal, in which case
+// the user may be prompted for input.
+func IsInteractive() bool {
+	return terminal.IsTerminal(int(os.Stdin.Fd()))
+}
+
 // promptList prompts the user with the given prefix, list of valid responses,
 // and default list entry to use.  The function will repeat the prompt to the
 // user until they enter a valid response.
@@ -33,8 +33,35 @@ var helpDescsEnUS = map[string]string{
 	"createtransaction-inputminheight": "The minimum block height to take inputs from (default: 0)",
 	"createtransaction-maxinputs":      "Maximum number of transaction inputs that are allowed",
 	"createtransaction-autolock":       "If specified, all txouts spent for this transaction will be locked under this name",
+	"createtransaction-locktime":       "Locktime of the transaction, a block height or unix timestamp which may be in the future; a non-zero value also locktime-activates the inputs unless sequence is given",
+	"createtransaction-sequence":       "Sequence number of all inputs, below 4294967294 signals replaceability (BIP0125) (default: the txsequence option)",
 	"createtransaction--result0":       "The hex encoded transaction result",
 
+	// CreateWalletCmd help.
+	"createwallet--synopsis": "Create a new wallet in the wallet directory, next to the loaded wallet.\n" +
+		"An existing wallet of the same name is never overwritten.",
+	"createwallet-walletname":       "The name of the new wallet, which is stored in the file walletname if it ends in .db and in wallet_<walletname>.db otherwise",
+	"createwallet-passphrase":       "The private passphrase used to encrypt the keys of the new wallet",
+	"createwallet-publicpassphrase": "The passphrase used to encrypt the public data of the new wallet, if unset the default public passphrase is used",
+	"createwallet-seed":             "Seed words or a hex encoded legacy seed to restore the wallet from, if unset a new seed is generated",
+	"createwallet-seedpassphrase":   "The passphrase of the seed words, if they are encrypted",
+	"createwallet-watchonly":        "Remove all private keys from the new wallet so that it can only watch addresses",
+	"createwallet-load":             "Load the new wallet, this is only possible if no wallet is loaded yet",
+
+	// CreateWalletResult help.
+	"createwalletresult-name":        "The name of the new wallet",
+	"createwalletresult-fingerprint": "The hex encoded BIP32 fingerprint of the wallet's master key, which identifies the wallet",
+	"createwalletresult-seed":        "The seed words of the new wallet, only set if the seed was generated",
+	"createwalletresult-loaded":      "Whether the new wallet has been loaded",
+	"createwalletresult-warning":     "Why the new wallet was not loaded although load was requested, the wallet exists nonetheless",
+
+	// GetAddressesByLabelCmd help.
+	"getaddressesbylabel--synopsis":       "Returns the addresses in the wallet's address book which have the given label.",
+	"getaddressesbylabel-label":           "The label to look up",
+	"getaddressesbylabel--result0--desc":  "JSON object using the labeled addresses as keys",
+	"getaddressesbylabel--result0--key":   "The labeled address",
+	"getaddressesbylabel--result0--value": `Object with the "purpose" of the address: "receive" if it belongs to the wallet, "send" otherwise`,
+
 	// GetAddressBalancesCmd help.
 	"getaddressbalances--synopsis":             "Get balances for each address",
 	"getaddressbalances-minconf":               "Minimum number of confirmations for coins to be considered received",
@@ -53,6 +80,12 @@ var helpDescsEnUS = map[string]string{
 	"getwalletseed--synopsis": "Get the wallet seed words for this wallet",
 	"getwalletseed--result0":  "The seed words used, along with the wallet passphrase, to create the wallet",
 
+	// VerifyWalletSeedCmd help.
+	"verifywalletseed--synopsis": "Check that a recorded seed backup matches the wallet seed, without revealing the wallet seed.\n" +
+		"Attempts are rate limited.",
+	"verifywalletseed-seed":     "The seed words to check",
+	"verifywalletseed--result0": "Whether the seed words match the wallet seed",
+
 	"getsecret--synopsis": "Get a secret seed which is generated using the wallet's private key, this can be used as a password for another application",
 	"getsecret-name":      "A name which will be used to generate the secret seed, the same seed will always be provided given the same name",
 	"getsecret--result0":  "A 32 byte secret seed in hex form",
@@ -87,7 +120,7 @@ var helpDescsEnUS = map[string]string{
 	"createmultisigresult-redeemScript": "The script required to redeem outputs paid to the multisig address",
 
 	// DumpPrivKeyCmd help.
-	"dumpprivkey--synopsis": "Returns the private key in WIF encoding that controls some wallet address.",
+	"dumpprivkey--synopsis": "Returns the private key in WIF encoding that controls some wallet address. The wallet must be unlocked.",
 	"dumpprivkey-address":   "The address to return a private key for",
 	"dumpprivkey--result0":  "The WIF-encoded private key",
 
@@ -100,6 +133,29 @@ var helpDescsEnUS = map[string]string{
 	"getbalance--result0":    "The balance of 'account' valued in bitcoin",
 	"getbalance--result1":    "The balance of all accounts valued in bitcoin",
 
+	// GetBalancesCmd help.
+	"getbalances--synopsis":                  "Calculates and returns the total, spendable, immature, unconfirmed and final balance of the wallet.",
+	"getbalances-minconf":                    "Minimum number of block confirmations required before an unspent output's value is considered spendable",
+	"getbalances-maturewithin":               "If set, break the immature balance down by the number of blocks until it matures, including only the coins which mature within this number of blocks",
+	"getbalancesresult-total":                "Total balance",
+	"getbalancesresult-stotal":               "Total balance (atomic units as base 10 string)",
+	"getbalancesresult-spendable":            "Balance which is currently spendable",
+	"getbalancesresult-sspendable":           "Balance which is currently spendable (atomic units as base 10 string)",
+	"getbalancesresult-immaturereward":       "Mined coins which have not yet matured",
+	"getbalancesresult-simmaturereward":      "Mined coins which have not yet matured (atomic units as base 10 string)",
+	"getbalancesresult-unconfirmed":          "Balance which does not yet have minconf confirmations",
+	"getbalancesresult-sunconfirmed":         "Balance which does not yet have minconf confirmations (atomic units as base 10 string)",
+	"getbalancesresult-final":                "Balance which has at least reorgsafetydepth confirmations and is not expected to be reversed by a chain reorganization",
+	"getbalancesresult-sfinal":               "Balance which has at least reorgsafetydepth confirmations and is not expected to be reversed by a chain reorganization (atomic units as base 10 string)",
+	"getbalancesresult-outputcount":          "The number of transaction outputs which make up the balance",
+	"getbalancesresult-immaturerewarddetail": "The mined coins which have not yet matured grouped by the number of blocks until they mature, soonest first, only present if maturewithin is set",
+
+	// ImmatureRewardResult help.
+	"immaturerewardresult-blockstomaturity": "The number of blocks which must be mined before the coins can be spent",
+	"immaturerewardresult-amount":           "The value of the coins which mature after this number of blocks",
+	"immaturerewardresult-samount":          "The value of the coins which mature after this number of blocks (atomic units as base 10 string)",
+	"immaturerewardresult-outputcount":      "The number of transaction outputs which mature after this number of blocks",
+
 	// GetBestBlockHashCmd help.
 	"getbestblockhash--synopsis": "Returns the hash of the newest block in the best chain that wallet has finished syncing with.",
 	"getbestblockhash--result0":  "The hash of the most recent synced-to block",
@@ -157,8 +213,10 @@ var helpDescsEnUS = map[string]string{
 	// GetTransactionResult help.
 	"gettransactionresult-amount":          "The total amount this transaction credits to the wallet, valued in bitcoin",
 	"gettransactionresult-fee":             "The total input value minus the total output value, or 0 if 'txid' is not a sent transaction",
+	"gettransactionresult-net":             "The total value of all wallet credits, change included, minus the total value of all wallet debits",
 	"gettransactionresult-confirmations":   "The number of block confirmations of the transaction",
 	"gettransactionresult-blockhash":       "The hash of the block this transaction is mined in, or the empty string if unmined",
+	"gettransactionresult-blockheight":     "The height of the block this transaction is mined in, or 0 if unmined",
 	"gettransactionresult-blockindex":      "Unset",
 	"gettransactionresult-blocktime":       "The Unix time of the block header this transaction is mined in, or 0 if unmined",
 	"gettransactionresult-txid":            "The transaction hash",
@@ -166,6 +224,7 @@ var helpDescsEnUS = map[string]string{
 	"gettransactionresult-time":            "The earliest Unix time this transaction was known to exist",
 	"gettransactionresult-timereceived":    "The earliest Unix time this transaction was known to exist",
 	"gettransactionresult-details":         "Additional details for each recorded wallet credit and debit",
+	"gettransactionresult-outputs":         "Every output of the transaction and who it pays",
 	"gettransactionresult-hex":             "The transaction encoded as a hexadecimal string",
 
 	// GetTransactionDetailsResult help.
@@ -177,12 +236,32 @@ var helpDescsEnUS = map[string]string{
 	"gettransactiondetailsresult-vout":              "The transaction output index",
 	"gettransactiondetailsresult-involveswatchonly": "Unset",
 
+	// GetTransactionOutputResult help.
+	"gettransactionoutputresult-vout":     "The transaction output index",
+	"gettransactionoutputresult-amount":   "The value of the output, valued in bitcoin",
+	"gettransactionoutputresult-address":  "The address the output pays, or the empty string if the output is nonstandard",
+	"gettransactionoutputresult-category": `Who the output pays: "received" for our receiving addresses, "change" for our change addresses, or "external" for all other outputs`,
+
 	// ImportPrivKeyCmd help.
 	"importprivkey--synopsis": "Imports a WIF-encoded private key to the 'imported' account.",
 	"importprivkey-privkey":   "The WIF-encoded private key",
 	"importprivkey-label":     "Unused (must be unset or 'imported')",
 	"importprivkey-rescan":    "Rescan the blockchain (since the genesis block) for outputs controlled by the imported key",
 
+	// ImportWalletCmd help.
+	"importwallet--synopsis": "Imports the keys of a wallet dump file in the format of the bitcoind dumpwallet command, applying their labels. Every line of the file is validated before any key is imported. A single rescan is started from the earliest creation time of the imported keys.",
+	"importwallet-filename":  "The wallet dump file to import, on the host running the wallet",
+	"importwallet-legacy":    "Import the keys as legacy (non-segwit) addresses",
+
+	// ImportWalletResult help.
+	"importwalletresult-imported":     "The number of keys imported",
+	"importwalletresult-existing":     "The number of keys which were already in the wallet, only their labels are applied",
+	"importwalletresult-rescanheight": "The height the rescan starts from, -1 if no key was imported",
+
+	// ListLabelsCmd help.
+	"listlabels--synopsis": "Returns the sorted list of labels in the wallet's address book.",
+	"listlabels--result0":  "The list of labels",
+
 	// ListLockUnspentCmd help.
 	"listlockunspent--synopsis": "Returns a JSON array of outpoints marked as locked (with lockunspent) for this wallet session.",
 
@@ -226,6 +305,7 @@ var helpDescsEnUS = map[string]string{
 	// ListTransactionsResult help.
 	"listtransactionsresult-account":            "DEPRECATED -- Unset",
 	"listtransactionsresult-address":            "Payment address for a transaction output",
+	"listtransactionsresult-label":              "Address book label of the payment address, if any",
 	"listtransactionsresult-category":           `The kind of transaction: "send" for sent transactions, "immature" for immature coinbase outputs, "generate" for mature coinbase outputs, or "recv" for all other received outputs.  Note: A single output may be included multiple times under different categories`,
 	"listtransactionsresult-amount":             "The value of the transaction output valued in bitcoin",
 	"listtransactionsresult-fee":                "The total input value minus the total output value for sent transactions",
@@ -272,6 +352,12 @@ var helpDescsEnUS = map[string]string{
 	"listunspentresult-blockHash":     "The hash of the block which the transaction was included in",
 	"listunspentresult-height":        "The height of the block which the transaction was included in",
 
+	// LoadWalletCmd help.
+	"loadwallet--synopsis": "Load a wallet from the wallet directory, this is only possible if no wallet is loaded.\n" +
+		"The wallet takes over the connection to the blockchain of the previously loaded wallet.",
+	"loadwallet-walletname":       "The name of the wallet, which is stored as wallet_<walletname>.db",
+	"loadwallet-publicpassphrase": "The passphrase used to encrypt the public data of the wallet, if unset the default public passphrase is used",
+
 	// LockUnspentCmd help.
 	"lockunspent--synopsis": "Locks or unlocks an unspent output.\n" +
 		"Locked outputs are not chosen for transaction inputs of authored transactions and are not included in 'listunspent' results.\n" +
@@ -318,16 +404,23 @@ var helpDescsEnUS = map[string]string{
 	"sendtoaddress-commentto": "Unused",
 	"sendtoaddress--result0":  "The transaction hash of the sent transaction",
 
+	// SetAddressLabelCmd help.
+	"setaddresslabel--synopsis": "Stores a label for an address in the wallet's address book.\n" +
+		"The address does not need to belong to the wallet. Labels are limited to 500 bytes of UTF-8 and an empty label removes the address from the address book.",
+	"setaddresslabel-address": "The address to label",
+	"setaddresslabel-label":   "The label to assign to the address",
+
 	// SetTxFeeCmd help.
 	"settxfee--synopsis": "Modify the increment used each time more fee is required for an authored transaction.",
 	"settxfee-amount":    "The new fee increment valued in bitcoin",
 	"settxfee--result0":  "The boolean 'true'",
 
 	// SignMessageCmd help.
-	"signmessage--synopsis": "Signs a message using the private key of a payment address.",
-	"signmessage-address":   "Payment address of private key used to sign the message with",
-	"signmessage-message":   "Message to sign",
-	"signmessage--result0":  "The signed message encoded as a base64 string",
+	"signmessage--synopsis": "Signs a message using the private key of a payment address.\n" +
+		"Signatures for segwit addresses use the BIP137 header bytes.",
+	"signmessage-address":  "Payment address of private key used to sign the message with",
+	"signmessage-message":  "Message to sign",
+	"signmessage--result0": "The signed message encoded as a base64 string",
 
 	// SignRawTransactionCmd help.
 	"signrawtransaction--synopsis": "Signs transaction inputs using private keys from this wallet and request.\n" +
@@ -349,6 +442,22 @@ var helpDescsEnUS = map[string]string{
 	"signrawtransactionerror-txid":      "The transaction hash of the referenced previous output",
 	"signrawtransactionerror-vout":      "The output index of the referenced previous output",
 
+	// SignWithAddressCmd help.
+	"signwithaddress--synopsis": "Signs a message or a single PSBT input using exclusively the private key of a wallet address.\n" +
+		"Unlike signrawtransaction, no other keys of the wallet are used and PSBT inputs are not finalized, which makes this suitable for multisig participation.",
+	"signwithaddress-address":    "Wallet address whose private key is used to sign",
+	"signwithaddress-data":       "The message to sign, or a base64 encoded PSBT if inputindex is set",
+	"signwithaddress-inputindex": "Index of the PSBT input to sign; if unset, data is signed as a message",
+
+	// SignWithAddressResult help.
+	"signwithaddressresult-pubkey":    "The hex encoded public key of the address",
+	"signwithaddressresult-signature": "The base64 encoded message signature, or the hex encoded partial signature (including the sighash type) of the PSBT input",
+	"signwithaddressresult-psbt":      "The base64 encoded PSBT with the partial signature attached (only for PSBT inputs)",
+
+	// UnloadWalletCmd help.
+	"unloadwallet--synopsis": "Unload the loaded wallet and close its database, so that another wallet can be loaded.\n" +
+		"Requests which are running against the wallet are completed first, the connection to the blockchain is kept.",
+
 	// ValidateAddressCmd help.
 	"validateaddress--synopsis": "Verify that an address is valid.\n" +
 		"Extra details are returned if the address is controlled by this wallet.\n" +
@@ -373,7 +482,8 @@ var helpDescsEnUS = map[string]string{
 	"validateaddresswalletresult-sigsrequired": "The number of required signatures to redeem outputs to the multisig address",
 
 	// VerifyMessageCmd help.
-	"verifymessage--synopsis": "Verify a message was signed with the associated private key of some address.",
+	"verifymessage--synopsis": "Verify a message was signed with the associated private key of some address.\n" +
+		"P2PKH, P2WKH and P2SH nested P2WKH addresses are supported.",
 	"verifymessage-address":   "Address used to sign message",
 	"verifymessage-signature": "The signature to verify",
 	"verifymessage-message":   "The message to verify",
@@ -410,6 +520,164 @@ var helpDescsEnUS = map[string]string{
 	"getbestblockresult-hash":   "The hash of the block",
 	"getbestblockresult-height": "The blockchain height of the block",
 
+	// GetBlockChainInfoCmd help.
+	"getblockchaininfo--synopsis": "Returns information about the best chain as seen by the chain backend. " +
+		"If the backend can't be reached, the last known chain state is returned and marked as stale.",
+
+	// GetBlockChainInfoWalletResult help.
+	"getblockchaininfowalletresult-chain":                "The name of the chain",
+	"getblockchaininfowalletresult-blocks":               "The height of the best block",
+	"getblockchaininfowalletresult-bestblockhash":        "The hash of the best block",
+	"getblockchaininfowalletresult-bestblocktime":        "The timestamp of the best block, if known",
+	"getblockchaininfowalletresult-initialblockdownload": "Whether the chain backend is still catching up with the network",
+	"getblockchaininfowalletresult-verificationprogress": "An estimate of the fraction of the chain which has been verified",
+	"getblockchaininfowalletresult-stale":                "True if the chain backend could not be reached and this is the last known chain state",
+	"getblockchaininfowalletresult-cachedat":             "The unix time at which a stale chain state was cached",
+
+	// WaitForSyncCmd help.
+	"waitforsync--synopsis": "Waits until the wallet is synced with the chain backend or the timeout elapses. " +
+		"Returns right away if the wallet is already synced, the result reports the sync progress either way.",
+	"waitforsync-timeout": "The maximum number of seconds to wait, at most 3600, 0 to return the sync state without waiting",
+
+	// WaitForSyncResult help.
+	"waitforsyncresult-synced":        "Whether the wallet is synced with the chain backend",
+	"waitforsyncresult-height":        "The height of the block the wallet is synced to",
+	"waitforsyncresult-backendheight": "The height of the best block known to the chain backend, if it is connected",
+
+	// GetSyncProgressCmd help.
+	"getsyncprogress--synopsis": "Returns the progress of the neutrino chain backend syncing block headers and filter headers.",
+
+	// ScanBlocksCmd help.
+	"scanblocks--synopsis": "Matches the compact filters of a range of blocks against a set of scripts and returns the blocks which may be relevant to them, without importing the scripts into the wallet. " +
+		"Only available with the neutrino chain backend, at most 10000 blocks can be scanned at once.",
+	"scanblocks-scripts":     "The addresses or hex encoded output scripts to match",
+	"scanblocks-startheight": "The height of the first block to scan",
+	"scanblocks-stopheight":  "The height of the last block to scan, defaults to the best block",
+	"scanblocks-fetchblocks": "Download the matching blocks to find the transactions paying to the scripts",
+
+	// ScanBlocksResult help.
+	"scanblocksresult-fromheight":     "The height of the first block scanned",
+	"scanblocksresult-toheight":       "The height of the last block scanned",
+	"scanblocksresult-relevantblocks": "The blocks whose compact filter matches any of the scripts",
+
+	// ScanBlocksBlockResult help.
+	"scanblocksblockresult-height": "The height of the block",
+	"scanblocksblockresult-hash":   "The hash of the block",
+	"scanblocksblockresult-txids":  "The hashes of the transactions paying to the scripts, only set if fetchblocks is true",
+
+	// NotifySyncProgressCmd help.
+	"notifysyncprogress--synopsis": "Sends a syncprogress notification with the same fields as the getsyncprogress result every interval. " +
+		"Only available over websockets with the neutrino chain backend.",
+	"notifysyncprogress-interval": "The number of seconds between notifications",
+
+	// NotifyMempoolTxsCmd help.
+	"notifymempooltxs--synopsis": "Sends a mempooltx notification for every transaction paying to or spending from the wallet which is accepted to the mempool. " +
+		"The notification carries the txid, the amounts received and sent by the wallet, the wallet addresses paid and the serialized transaction. " +
+		"Only available over websockets with the pktd RPC backend (--userpc mode). " +
+		"The notifications end when the chain backend is replaced or stopped, the request must then be repeated.",
+
+	// ConsolidateCmd help.
+	"consolidate--synopsis": "Merges the wallet outputs below the threshold into a single output paying back to the wallet, smallest outputs first. " +
+		"Locked outputs are never consolidated. The consolidation is skipped if fewer than two outputs qualify or if the fee would exceed the value consolidated.",
+	"consolidate-threshold": "Outputs worth less than this amount are consolidated",
+	"consolidate-maxinputs": "Maximum number of outputs to consolidate, by default as many as fit in a transaction",
+	"consolidate-feerate":   "The fee rate in coins per kilobyte, by default the rate estimated by the chain backend",
+	"consolidate-minconf":   "Do not consolidate outputs which don't have at least this number of confirmations",
+	"consolidate-dryrun":    "If true, report what would be consolidated without sending the transaction",
+
+	// ConsolidateResult help.
+	"consolidateresult-txid":    "The hash of the consolidation transaction, omitted if the consolidation was skipped",
+	"consolidateresult-inputs":  "The number of outputs consolidated",
+	"consolidateresult-amount":  "The total value of the outputs consolidated",
+	"consolidateresult-fee":     "The fee paid by the consolidation transaction",
+	"consolidateresult-skipped": "Whether the consolidation was skipped because there was nothing worth consolidating",
+
+	// ExportUtxosCmd help.
+	"exportutxos--synopsis": "Exports a consistent snapshot of the unspent outputs of the wallet, including locked and frozen ones, ordered by outpoint. " +
+		"Large sets are exported in pages: pass the next value of the result as after to get the following page, the pages of one export are only consistent with each other if the wallet didn't change in between.",
+	"exportutxos-count": "Maximum number of outputs to return, 0 to only return the totals",
+	"exportutxos-after": "Only return the outputs after this outpoint, in the form txid:vout",
+
+	// ExportUtxosResult help.
+	"exportutxosresult-height":    "The height of the block the wallet was synced to when the snapshot was taken",
+	"exportutxosresult-blockhash": "The hash of the block the wallet was synced to when the snapshot was taken",
+	"exportutxosresult-count":     "The number of unspent outputs in the whole snapshot",
+	"exportutxosresult-amount":    "The total value of the unspent outputs in the whole snapshot",
+	"exportutxosresult-utxos":     "The unspent outputs of this page",
+	"exportutxosresult-next":      "The after value to get the next page, omitted if this is the last page",
+
+	// ExportedUtxoResult help.
+	"exportedutxoresult-txid":           "The transaction hash of the output",
+	"exportedutxoresult-vout":           "The output index of the output",
+	"exportedutxoresult-address":        "The address the output pays to, omitted if it doesn't pay to a single address",
+	"exportedutxoresult-account":        "The account of the address",
+	"exportedutxoresult-scriptPubKey":   "The output script encoded as hex",
+	"exportedutxoresult-amount":         "The value of the output",
+	"exportedutxoresult-confirmations":  "The number of block confirmations of the output, 0 if it is unconfirmed",
+	"exportedutxoresult-height":         "The height of the block containing the output, -1 if it is unconfirmed",
+	"exportedutxoresult-coinbase":       "Whether the output is from a coinbase transaction",
+	"exportedutxoresult-spendable":      "Whether the output can be spent, false for immature or burned coinbase outputs",
+	"exportedutxoresult-derivationpath": "The BIP32 derivation path of the key of the address, omitted for imported keys",
+	"exportedutxoresult-locked":         "Whether the output is locked with lockunspent",
+	"exportedutxoresult-lockname":       "The name of the lock, omitted if the output is not locked",
+	"exportedutxoresult-frozen":         "Whether the output is frozen by a lease and can't be spent until the lease expires",
+	"exportedutxoresult-frozenuntil":    "The time in seconds since 1 Jan 1970 GMT the lease expires, omitted if the output is not frozen",
+
+	// ListDescriptorsCmd help.
+	"listdescriptors--synopsis": "Lists the output descriptors of the external and internal addresses of every account, to watch or restore the wallet with descriptor aware software. " +
+		"Private descriptors hold the extended private keys of the accounts, they require the wallet to be unlocked and full access credentials.",
+	"listdescriptors-private":  "Export the extended private keys instead of the extended public keys",
+	"listdescriptors-checksum": "Append the checksum to the descriptors",
+
+	// ListDescriptorsResult help.
+	"listdescriptorsresult-descriptors": "The output descriptors",
+
+	// DescriptorResult help.
+	"descriptorresult-desc":          "The output descriptor",
+	"descriptorresult-account":       "The name of the account",
+	"descriptorresult-accountnumber": "The number of the account",
+	"descriptorresult-internal":      "Whether the descriptor describes the change addresses of the account",
+	"descriptorresult-next":          "The index of the next address which will be derived from the descriptor",
+
+	// GetFeeHistoryCmd help.
+	"getfeehistory--synopsis": "Returns the fee rates paid by the most recent transactions broadcast by the wallet and how many blocks it took for them to confirm, newest first. " +
+		"Only transactions which spend nothing but the wallet's own outputs are tracked, because the fee of other transactions is not known.",
+	"getfeehistory-count": "The maximum number of transactions to return",
+
+	// FeeHistoryResult help.
+	"feehistoryresult-txid":              "The hash of the transaction",
+	"feehistoryresult-feerate":           "The fee rate paid by the transaction in BTC per kilobyte of virtual size",
+	"feehistoryresult-time":              "The time in seconds since 1 Jan 1970 GMT the transaction was broadcast",
+	"feehistoryresult-confirmed":         "Whether the transaction has been mined",
+	"feehistoryresult-confirmationdelay": "The number of blocks between the broadcast and the confirmation of the transaction, omitted if it is unconfirmed",
+	"feehistoryresult-age":               "The number of seconds since an unconfirmed transaction was broadcast, omitted if it is confirmed",
+
+	// AbandonTransactionCmd help.
+	"abandontransaction--synopsis": "Abandons an unconfirmed transaction which is stuck, so the outputs it spends can be spent by another transaction. " +
+		"The transaction is removed from the wallet together with any unconfirmed transactions spending its outputs, and locks on the outputs it spends are released. " +
+		"Confirmed and already abandoned transactions can't be abandoned. " +
+		"The transaction is no longer abandoned if it is seen again in the mempool or in a block.",
+	"abandontransaction-txid": "The hash of the transaction to abandon",
+
+	// GetDescriptorInfoCmd help.
+	"getdescriptorinfo--synopsis": "Analyzes an output descriptor, which does not need to belong to the wallet, and returns it in canonical form with its checksum. " +
+		"If the descriptor has a checksum it is verified. Private keys are replaced by their public keys in the canonical form.",
+	"getdescriptorinfo-descriptor": "The output descriptor, optionally followed by its checksum",
+
+	// GetDescriptorInfoResult help.
+	"getdescriptorinforesult-descriptor":     "The descriptor in canonical form followed by its checksum",
+	"getdescriptorinforesult-checksum":       "The checksum of the descriptor as it was given",
+	"getdescriptorinforesult-isrange":        "Whether the descriptor describes a range of scripts",
+	"getdescriptorinforesult-issolvable":     "Whether the scripts of the descriptor can be signed for given the private keys, false for addr and raw descriptors",
+	"getdescriptorinforesult-hasprivatekeys": "Whether the descriptor holds at least one private key",
+
+	// SyncProgressResult help.
+	"syncprogressresult-currentheight":      "The height of the best block header",
+	"syncprogressresult-targetheight":       "The height of the best block announced by the connected peers, it moves along as new blocks arrive",
+	"syncprogressresult-filterheaderheight": "The height of the best filter header",
+	"syncprogressresult-percent":            "An estimate of the sync progress in percent",
+	"syncprogressresult-synced":             "Whether block headers and filter headers are synced up to the target height",
+
 	// GetUnconfirmedBalanceCmd help.
 	"getunconfirmedbalance--synopsis": "Calculates the unspent output value of all unmined transaction outputs for an account.",
 	"getunconfirmedbalance-account":   "The account to query the unconfirmed balance for (default=\"default\")",
@@ -25,7 +25,15 @@ var Methods = []struct {
 	{"addmultisigaddress", returnsString},
 	{"createmultisig", []interface{}{(*btcjson.CreateMultiSigResult)(nil)}},
 	{"createtransaction", returnsString},
+	{"consolidate", []interface{}{(*btcjson.ConsolidateResult)(nil)}},
+	{"exportutxos", []interface{}{(*btcjson.ExportUtxosResult)(nil)}},
+	{"listdescriptors", []interface{}{(*btcjson.ListDescriptorsResult)(nil)}},
+	{"getfeehistory", []interface{}{(*[]btcjson.FeeHistoryResult)(nil)}},
+	{"abandontransaction", nil},
+	{"getdescriptorinfo", []interface{}{(*btcjson.GetDescriptorInfoResult)(nil)}},
+	{"createwallet", []interface{}{(*btcjson.CreateWalletResult)(nil)}},
 	{"getaddressbalances", []interface{}{(*[]btcjson.GetAddressBalancesResult)(nil)}},
+	{"getaddressesbylabel", []interface{}{(*map[string]btcjson.GetAddressesByLabelResult)(nil)}},
 	{"setnetworkstewardvote", []interface{}{(*btcjson.SetNetworkStewardVoteResult)(nil)}},
 	{"getnetworkstewardvote", []interface{}{(*btcjson.GetNetworkStewardVoteResult)(nil)}},
 	{"resync", nil},
@@ -33,6 +41,7 @@ var Methods = []struct {
 	{"addp2shscript", returnsString},
 	{"dumpprivkey", returnsString},
 	{"getbalance", append(returnsNumber, returnsNumber[0])},
+	{"getbalances", []interface{}{(*btcjson.GetBalancesResult)(nil)}},
 	{"getbestblockhash", returnsString},
 	{"getblockcount", returnsNumber},
 	{"getinfo", []interface{}{(*btcjson.InfoWalletResult)(nil)}},
@@ -40,21 +49,28 @@ var Methods = []struct {
 	{"getreceivedbyaddress", returnsNumber},
 	{"gettransaction", []interface{}{(*btcjson.GetTransactionResult)(nil)}},
 	{"getwalletseed", returnsString},
+	{"verifywalletseed", returnsBool},
 	{"getsecret", returnsString},
 	{"help", append(returnsString, returnsString[0])},
 	{"importprivkey", nil},
+	{"importwallet", []interface{}{(*btcjson.ImportWalletResult)(nil)}},
+	{"listlabels", []interface{}{(*[]string)(nil)}},
 	{"listlockunspent", []interface{}{(*[]btcjson.TransactionInput)(nil)}},
 	{"listreceivedbyaddress", []interface{}{(*[]btcjson.ListReceivedByAddressResult)(nil)}},
 	{"listsinceblock", []interface{}{(*btcjson.ListSinceBlockResult)(nil)}},
 	{"listtransactions", returnsLTRArray},
 	{"listunspent", []interface{}{(*btcjson.ListUnspentResult)(nil)}},
+	{"loadwallet", nil},
 	{"lockunspent", returnsBool},
 	{"sendfrom", returnsString},
 	{"sendmany", returnsString},
 	{"sendtoaddress", returnsString},
+	{"setaddresslabel", nil},
 	{"settxfee", returnsBool},
 	{"signmessage", returnsString},
 	{"signrawtransaction", []interface{}{(*btcjson.SignRawTransactionResult)(nil)}},
+	{"signwithaddress", []interface{}{(*btcjson.SignWithAddressResult)(nil)}},
+	{"unloadwallet", nil},
 	{"validateaddress", []interface{}{(*btcjson.ValidateAddressWalletResult)(nil)}},
 	{"verifymessage", returnsBool},
 	{"walletlock", nil},
@@ -63,6 +79,12 @@ var Methods = []struct {
 	{"walletmempool", []interface{}{(*btcjson.WalletMempoolRes)(nil)}},
 	{"exportwatchingwallet", returnsString},
 	{"getbestblock", []interface{}{(*btcjson.GetBestBlockResult)(nil)}},
+	{"getblockchaininfo", []interface{}{(*btcjson.GetBlockChainInfoWalletResult)(nil)}},
+	{"waitforsync", []interface{}{(*btcjson.WaitForSyncResult)(nil)}},
+	{"getsyncprogress", []interface{}{(*btcjson.SyncProgressResult)(nil)}},
+	{"scanblocks", []interface{}{(*btcjson.ScanBlocksResult)(nil)}},
+	{"notifysyncprogress", nil},
+	{"notifymempooltxs", nil},
 	{"getunconfirmedbalance", returnsNumber},
 	{"listaddresstransactions", returnsLTRArray},
 	{"listalltransactions", returnsLTRArray},
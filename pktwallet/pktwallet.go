@@ -14,7 +14,9 @@ import (
 	"os"
 	"path/filepath"
 	"runtime"
+	"strings"
 	"sync"
+	"time"
 
 	"github.com/pkt-cash/pktd/btcutil/er"
 	"github.com/pkt-cash/pktd/pktconfig/version"
@@ -23,12 +25,21 @@ import (
 	"github.com/arl/statsviz"
 	"github.com/pkt-cash/pktd/neutrino"
 	"github.com/pkt-cash/pktd/pktwallet/chain"
+	"github.com/pkt-cash/pktd/pktwallet/internal/prompt"
 	"github.com/pkt-cash/pktd/pktwallet/rpc/legacyrpc"
 	"github.com/pkt-cash/pktd/pktwallet/wallet"
 	"github.com/pkt-cash/pktd/pktwallet/walletdb"
 	pktwalletLegal "go4.org/legal"
 )
 
+// profileUnixPrefix marks a profile value which names a unix socket path
+// rather than a TCP port.
+const profileUnixPrefix = "unix:"
+
+// chainClientRetryDelay is how long rpcClientConnectLoop waits before it
+// creates a new chain client after the previous one failed or shut down.
+const chainClientRetryDelay = 5 * time.Second
+
 var cfg *config
 
 func main() {
@@ -61,6 +72,12 @@ func walletMain() er.R {
 		return err
 	}
 	cfg = tcfg
+	if cfg.logRotator != nil {
+		defer func() {
+			log.SetLogFile(nil)
+			cfg.logRotator.Close()
+		}()
+	}
 
 	// Show version at startup.
 	log.Infof("Version %s", version.Version())
@@ -68,14 +85,12 @@ func walletMain() er.R {
 
 	// Enable Profile server if requested.
 	if cfg.Profile != "" {
-		go func() {
-			listenAddr := net.JoinHostPort("", cfg.Profile)
-			log.Infof("Profile server listening on %s", listenAddr)
-			profileRedirect := http.RedirectHandler("/debug/pprof",
-				http.StatusSeeOther)
-			http.Handle("/", profileRedirect)
-			log.Errorf("%v", http.ListenAndServe(listenAddr, nil))
-		}()
+		stopProfile, err := startProfileServer(cfg.Profile)
+		if err != nil {
+			log.Errorf("Unable to start profile server: %v", err)
+			return err
+		}
+		defer stopProfile()
 	}
 
 	// Enable StatsViz server if requested.
@@ -96,6 +111,23 @@ func walletMain() er.R {
 	dbDir := networkDir(cfg.AppDataDir.Value, activeNet.Params)
 	// TODO(cjd): noFreelistSync ?
 	loader := wallet.NewLoader(activeNet.Params, dbDir, cfg.Wallet, false, 250)
+	loader.SetDbDriver(cfg.DbDriver)
+	loader.SetAddressReusePolicy(cfg.addressReusePolicy)
+	loader.SetChangeType(cfg.changeType)
+	loader.SetMaxWatchedAddrs(cfg.MaxWatchedAddrs)
+	loader.SetReorgSafetyDepth(cfg.ReorgSafetyDepth)
+	loader.SetTxSequence(cfg.TxSequence)
+
+	// Compact the wallet database before anything can open it.
+	if cfg.CompactDB {
+		before, after, err := loader.CompactWalletDB()
+		if err != nil {
+			log.Errorf("Unable to compact wallet database: %v", err)
+			return err
+		}
+		log.Infof("Compacted wallet database from %d to %d bytes",
+			before, after)
+	}
 
 	// Create and start HTTP server to serve wallet client connections.
 	// This will be updated with the wallet and chain server RPC client
@@ -118,10 +150,24 @@ func walletMain() er.R {
 
 	if !cfg.NoInitialLoad {
 		// Load the wallet database.  It must have been created already
-		// or this will return an appropriate error.
-		_, err = loader.OpenExistingWallet([]byte(cfg.WalletPass), true)
+		// or this will return an appropriate error.  When running in a
+		// terminal, the user gets a few tries to correct a wrong public
+		// passphrase.
+		pubPass := []byte(cfg.WalletPass)
+		if prompt.IsInteractive() {
+			_, err = loader.OpenExistingWalletInteractive(
+				pubPass, prompt.ProvidePubPassphrase,
+			)
+		} else {
+			_, err = loader.OpenExistingWallet(pubPass, true)
+		}
 		if err != nil {
-			log.Error(err)
+			if wallet.ErrWrongPassphrase.Is(err) {
+				log.Errorf("Unable to open wallet, check the "+
+					"walletpass option: %v", err)
+			} else {
+				log.Error(err)
+			}
 			return err
 		}
 	}
@@ -180,21 +226,26 @@ func rpcClientConnectLoop(legacyRPCServer *legacyrpc.Server, loader *wallet.Load
 		certs = readCAFile()
 	}
 
-	for {
+	for attempt := 0; ; attempt++ {
+		// Don't hammer a backend which keeps failing.
+		if attempt > 0 {
+			time.Sleep(chainClientRetryDelay)
+		}
+
 		var (
 			chainClient chain.Interface
 			err         er.R
 		)
 
-		if !cfg.UseRPC {
-			var (
-				chainService *neutrino.ChainService
-				spvdb        walletdb.DB
-			)
+		var (
+			neutrinoClient *chain.NeutrinoClient
+			spvdb          walletdb.DB
+		)
+		if !cfg.UseRPC || cfg.UseHybrid {
+			var chainService *neutrino.ChainService
 			netDir := networkDir(cfg.AppDataDir.Value, activeNet.Params)
-			spvdb, err = walletdb.Create("bdb",
+			spvdb, err = walletdb.Create(cfg.DbDriver,
 				filepath.Join(netDir, "neutrino.db"), false)
-			defer spvdb.Close()
 			if err != nil {
 				log.Errorf("Unable to create Neutrino DB: %s", err)
 				continue
@@ -210,14 +261,39 @@ func rpcClientConnectLoop(legacyRPCServer *legacyrpc.Server, loader *wallet.Load
 				})
 			if err != nil {
 				log.Errorf("Couldn't create Neutrino ChainService: %s", err)
+				closeNeutrino(nil, spvdb, false)
 				continue
 			}
-			chainClient = chain.NewNeutrinoClient(activeNet.Params, chainService)
+			neutrinoClient = chain.NewNeutrinoClient(activeNet.Params, chainService)
+		}
+
+		switch {
+		case cfg.UseHybrid:
+			// The RPC connection is preferred, neutrino takes over
+			// whenever it fails without restarting the wallet.
+			var rpcc *chain.RPCClient
+			rpcc, err = newChainRPC(certs)
+			if err != nil {
+				log.Errorf("Unable to create consensus RPC client: %v", err)
+				closeNeutrino(neutrinoClient, spvdb, false)
+				continue
+			}
+			chainClient = chain.NewFailoverClient(rpcc, neutrinoClient,
+				chain.DefaultFailbackInterval)
+			err = chainClient.Start()
+			if err != nil {
+				log.Errorf("Couldn't start hybrid chain client: %s", err)
+				chainClient.Stop()
+				closeNeutrino(neutrinoClient, spvdb, true)
+				continue
+			}
+		case !cfg.UseRPC:
+			chainClient = neutrinoClient
 			err = chainClient.Start()
 			if err != nil {
 				log.Errorf("Couldn't start Neutrino client: %s", err)
 			}
-		} else {
+		default:
 			chainClient, err = startChainRPC(certs)
 			if err != nil {
 				log.Errorf("Unable to open connection to consensus RPC server: %v", err)
@@ -253,6 +329,11 @@ func rpcClientConnectLoop(legacyRPCServer *legacyrpc.Server, loader *wallet.Load
 		associateRPCClient = nil
 		mu.Unlock()
 
+		// The next chain client opens the neutrino database again.
+		if spvdb != nil {
+			closeNeutrino(neutrinoClient, spvdb, true)
+		}
+
 		loadedWallet, ok := loader.LoadedWallet()
 		if ok {
 			// Do not attempt a reconnect when the wallet was
@@ -261,15 +342,34 @@ func rpcClientConnectLoop(legacyRPCServer *legacyrpc.Server, loader *wallet.Load
 				return
 			}
 
+			// The wallet keeps running without a chain client
+			// until the next one is associated with it.
+			if loadedWallet.ChainClient() == chainClient {
+				loadedWallet.DetachChainClient()
+			}
 			loadedWallet.SetChainSynced(false)
+		}
+	}
+}
 
-			// TODO: Rework the wallet so changing the RPC client
-			// does not require stopping and restarting everything.
-			loadedWallet.Stop()
-			loadedWallet.WaitForShutdown()
-			loadedWallet.Start()
+// closeNeutrino stops the neutrino client and, if it was started, its chain
+// service, and closes the neutrino database so that they can be created again.
+// The client may be nil if it was never created.
+func closeNeutrino(neutrinoClient *chain.NeutrinoClient, spvdb walletdb.DB,
+	started bool) {
+
+	if neutrinoClient != nil {
+		neutrinoClient.Stop()
+		if started {
+			if err := neutrinoClient.CS.Stop(); err != nil {
+				log.Warnf("Unable to stop Neutrino ChainService: %v",
+					err)
+			}
 		}
 	}
+	if err := spvdb.Close(); err != nil {
+		log.Warnf("Unable to close Neutrino DB: %v", err)
+	}
 }
 
 func readCAFile() []byte {
@@ -291,12 +391,10 @@ func readCAFile() []byte {
 
 // startChainRPC opens a RPC client connection to a pktd server for blockchain
 // services.  This function uses the RPC options from the global config and
-// there is no recovery in case the server is not available or if there is an
-// authentication error.  Instead, all requests to the client will simply error.
+// blocks until the server is reachable and answering requests.  There is no
+// recovery in case of an authentication error.
 func startChainRPC(certs []byte) (*chain.RPCClient, er.R) {
-	log.Infof("Attempting RPC client connection to %v", cfg.RPCConnect)
-	rpcc, err := chain.NewRPCClient(activeNet.Params, cfg.RPCConnect,
-		cfg.BtcdUsername, cfg.BtcdPassword, certs, !cfg.ClientTLS, 0)
+	rpcc, err := newChainRPC(certs)
 	if err != nil {
 		return nil, err
 	}
@@ -304,9 +402,76 @@ func startChainRPC(certs []byte) (*chain.RPCClient, er.R) {
 	return rpcc, err
 }
 
+// newChainRPC creates a RPC client for the pktd server from the global config
+// without starting it.
+func newChainRPC(certs []byte) (*chain.RPCClient, er.R) {
+	log.Infof("Attempting RPC client connection to %v", cfg.RPCConnect)
+	return chain.NewRPCClient(activeNet.Params, cfg.RPCConnect,
+		cfg.BtcdUsername, cfg.BtcdPassword, certs, !cfg.ClientTLS, 0)
+}
+
 func init() {
 	// Register licensing
 	pktwalletLegal.RegisterLicense(
 		"\nISC License\n\nCopyright © 2021 Anode LLC.\nCopyright © 2019-2021 Caleb James DeLisle.\nCopyright © 2021 Gridfinity, LLC.\nCopyright © 2021 Jeffrey H. Johnson <trnsz@pobox.com>\nCopyright © 2021 Filippo Valsorda.\nCopyright © 2020 Frank Denis <j at pureftpd dot org>.\nCopyright © 2019 The Go Authors.\nCopyright © 2015-2021 Lightning Labs and The Lightning Network Developers.\nCopyright © 2015-2018 Lightning Labs\nCopyright © 2016-2017 The Lightning Network Developers.\nCopyright © 2013-2017 The btcsuite developers.\nCopyright © 2015-2016 The Decred developers.\nCopyright © 2015 Google, Inc.\n\nPermission to use, copy, modify, and distribute this software for any\npurpose with or without fee is hereby granted, provided that the above\ncopyright notice and this permission notice appear in all copies.\n\nTHE SOFTWARE IS PROVIDED \"AS IS\" AND THE AUTHOR DISCLAIMS ALL WARRANTIES\nWITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF\nMERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR\nANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES\nWHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN\nACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF\nOR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.\n",
 	)
 }
+
+// profileRedirectOnce guards the registration of the redirect to the profiles,
+// which may only be registered once with the default mux.
+var profileRedirectOnce sync.Once
+
+// startProfileServer starts the pprof HTTP server on the TCP port or, when
+// prefixed with profileUnixPrefix, the unix socket given by profile.  The
+// returned function stops the server and removes the unix socket file.
+func startProfileServer(profile string) (func(), er.R) {
+	profileRedirectOnce.Do(func() {
+		profileRedirect := http.RedirectHandler("/debug/pprof",
+			http.StatusSeeOther)
+		http.Handle("/", profileRedirect)
+	})
+
+	if !strings.HasPrefix(profile, profileUnixPrefix) {
+		go func() {
+			listenAddr := net.JoinHostPort("", profile)
+			log.Infof("Profile server listening on %s", listenAddr)
+			log.Errorf("%v", http.ListenAndServe(listenAddr, nil))
+		}()
+		return func() {}, nil
+	}
+
+	// A socket left behind by an unclean shutdown would make the listen
+	// fail, so remove it, but never anything which is not a socket.
+	socketPath := strings.TrimPrefix(profile, profileUnixPrefix)
+	if fi, errr := os.Lstat(socketPath); errr == nil {
+		if fi.Mode()&os.ModeSocket == 0 {
+			return nil, er.Errorf("profile socket path %v exists "+
+				"and is not a socket", socketPath)
+		}
+		if errr := os.Remove(socketPath); errr != nil {
+			return nil, er.E(errr)
+		}
+	}
+
+	listener, errr := listenPrivateUnix(socketPath)
+	if errr != nil {
+		return nil, er.E(errr)
+	}
+
+	server := &http.Server{}
+	go func() {
+		log.Infof("Profile server listening on %s", socketPath)
+		errr := server.Serve(listener)
+		if errr != nil && errr != http.ErrServerClosed {
+			log.Errorf("%v", errr)
+		}
+	}()
+
+	// Closing the server closes the unix listener, which also unlinks
+	// the socket file.
+	return func() {
+		if errr := server.Close(); errr != nil {
+			log.Errorf("Unable to stop profile server: %v", errr)
+		}
+	}, nil
+}
@@ -0,0 +1,89 @@
+// +build !windows,!plan9
+
+package main
+
+import (
+	"context"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// TestStartProfileServerUnix tests that the profile server is served on a unix
+// socket only its owner can connect to, that a stale socket is replaced while
+// other files are left alone, and that stopping the server removes the socket.
+func TestStartProfileServerUnix(t *testing.T) {
+	dir, errr := ioutil.TempDir("", "pktwallet-profile")
+	if errr != nil {
+		t.Fatal(errr)
+	}
+	defer os.RemoveAll(dir)
+	socketPath := filepath.Join(dir, "profile.sock")
+	profile := profileUnixPrefix + socketPath
+
+	// A file which is not a socket is never removed.
+	if errr := ioutil.WriteFile(socketPath, nil, 0o600); errr != nil {
+		t.Fatal(errr)
+	}
+	if _, err := startProfileServer(profile); err == nil {
+		t.Fatal("expected a regular file to be refused")
+	}
+	if errr := os.Remove(socketPath); errr != nil {
+		t.Fatal(errr)
+	}
+
+	// Leave a stale socket behind, as an unclean shutdown would.
+	stale, errr := net.Listen("unix", socketPath)
+	if errr != nil {
+		t.Fatal(errr)
+	}
+	stale.(*net.UnixListener).SetUnlinkOnClose(false)
+	stale.Close()
+
+	stop, err := startProfileServer(profile)
+	if err != nil {
+		t.Fatalf("unable to start profile server: %v", err)
+	}
+
+	fi, errr := os.Lstat(socketPath)
+	if errr != nil {
+		t.Fatal(errr)
+	}
+	if fi.Mode()&os.ModeSocket == 0 {
+		t.Fatalf("expected a socket, got mode %v", fi.Mode())
+	}
+	if perm := fi.Mode().Perm(); perm != 0o600 {
+		t.Fatalf("expected socket mode 0600, got %#o", perm)
+	}
+
+	client := &http.Client{
+		Transport: &http.Transport{
+			DialContext: func(ctx context.Context, _,
+				_ string) (net.Conn, error) {
+
+				var d net.Dialer
+				return d.DialContext(ctx, "unix", socketPath)
+			},
+		},
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+	resp, errr := client.Get("http://profile/")
+	if errr != nil {
+		t.Fatalf("unable to reach profile server: %v", errr)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusSeeOther {
+		t.Fatalf("expected a redirect to the profiles, got %v",
+			resp.Status)
+	}
+
+	stop()
+	if _, errr := os.Lstat(socketPath); !os.IsNotExist(errr) {
+		t.Fatalf("expected socket to be removed, got %v", errr)
+	}
+}
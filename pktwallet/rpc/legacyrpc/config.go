@@ -9,6 +9,11 @@ type Options struct {
 	Username string
 	Password string
 
+	// LimitUsername and LimitPassword are optional credentials which only
+	// permit calling the read-only methods listed in rpcPermissions.
+	LimitUsername string
+	LimitPassword string
+
 	MaxPOSTClients      int64
 	MaxWebsocketClients int64
 }
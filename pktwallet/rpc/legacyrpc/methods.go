@@ -10,8 +10,11 @@ import (
 	"encoding/base64"
 	"encoding/hex"
 	"fmt"
+	"math"
 	"net"
+	"os"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -24,16 +27,18 @@ import (
 	"github.com/pkt-cash/pktd/txscript/params"
 	"github.com/pkt-cash/pktd/wire/ruleerror"
 
-	"github.com/pkt-cash/pktd/btcec"
 	"github.com/pkt-cash/pktd/btcjson"
 	"github.com/pkt-cash/pktd/btcutil"
+	"github.com/pkt-cash/pktd/btcutil/psbt"
 	"github.com/pkt-cash/pktd/chaincfg"
 	"github.com/pkt-cash/pktd/chaincfg/chainhash"
 	"github.com/pkt-cash/pktd/pktwallet/chain"
 	"github.com/pkt-cash/pktd/pktwallet/waddrmgr"
 	"github.com/pkt-cash/pktd/pktwallet/wallet"
+	"github.com/pkt-cash/pktd/pktwallet/wallet/seedwords"
 	"github.com/pkt-cash/pktd/pktwallet/wallet/txauthor"
 	"github.com/pkt-cash/pktd/pktwallet/wallet/txrules"
+	"github.com/pkt-cash/pktd/pktwallet/walletdb"
 	"github.com/pkt-cash/pktd/pktwallet/wtxmgr"
 	"github.com/pkt-cash/pktd/rpcclient"
 	"github.com/pkt-cash/pktd/txscript"
@@ -66,11 +71,26 @@ type handlerRPC func(interface{}, *wallet.Wallet, *chain.RPCClient) (interface{}
 
 type handlerNeutrino func(interface{}, *wallet.Wallet, *chain.NeutrinoClient) (interface{}, er.R)
 
+// handlerLoader is a request handler which manages wallets through the wallet
+// loader and so works even if no wallet is loaded.
+type handlerLoader func(interface{}, *wallet.Loader) (interface{}, er.R)
+
+// handlerServer is a request handler which switches the wallet of the server,
+// so it must take care of the server's wallet lock itself.
+type handlerServer func(interface{}, *Server) (interface{}, er.R)
+
 var rpcHandlers = map[string]struct {
 	handler         requestHandler
 	handlerChain    handlerChain
 	handlerRPC      handlerRPC
 	handlerNeutrino handlerNeutrino
+	handlerLoader   handlerLoader
+	handlerServer   handlerServer
+
+	// Handlers which may block for a long time don't hold the wallet lock
+	// of the server, so that they don't hold up unloading the wallet.  They
+	// must cope with the wallet being stopped while they wait.
+	noWalletLock bool
 
 	// Function variables cannot be compared against anything but nil, so
 	// use a boolean to record whether help generation is necessary.  This
@@ -85,8 +105,11 @@ var rpcHandlers = map[string]struct {
 	// Reference implementation wallet methods (implemented)
 	"addmultisigaddress":     {handler: addMultiSigAddress},
 	"createmultisig":         {handler: createMultiSig},
+	"createwallet":           {handlerLoader: createWallet},
 	"dumpprivkey":            {handler: dumpPrivKey},
+	"getaddressesbylabel":    {handler: getAddressesByLabel},
 	"getbalance":             {handler: getBalance},
+	"getbalances":            {handler: getBalances},
 	"getbestblockhash":       {handler: getBestBlockHash},
 	"getblockcount":          {handler: getBlockCount},
 	"getinfo":                {handlerChain: getInfo},
@@ -95,11 +118,14 @@ var rpcHandlers = map[string]struct {
 	"gettransaction":         {handler: getTransaction},
 	"help":                   {handler: helpNoChainRPC, handlerRPC: helpWithChainRPC},
 	"importprivkey":          {handler: importPrivKey},
+	"importwallet":           {handler: importWallet},
+	"listlabels":             {handler: listLabels},
 	"listlockunspent":        {handler: listLockUnspent},
 	"listreceivedbyaddress":  {handler: listReceivedByAddress},
 	"listsinceblock":         {handlerChain: listSinceBlock},
 	"listtransactions":       {handler: listTransactions},
 	"listunspent":            {handler: listUnspent},
+	"loadwallet":             {handlerServer: loadWallet},
 	"lockunspent":            {handler: lockUnspent},
 	"sendfrom":               {handler: sendFrom},
 	"sendmany":               {handler: sendMany},
@@ -107,6 +133,8 @@ var rpcHandlers = map[string]struct {
 	"settxfee":               {handler: setTxFee},
 	"signmessage":            {handler: signMessage},
 	"signrawtransaction":     {handlerChain: signRawTransaction},
+	"signwithaddress":        {handler: signWithAddress},
+	"unloadwallet":           {handlerServer: unloadWallet},
 	"validateaddress":        {handler: validateAddress},
 	"verifymessage":          {handler: verifyMessage},
 	"walletlock":             {handler: walletLock},
@@ -115,14 +143,26 @@ var rpcHandlers = map[string]struct {
 
 	// Extensions to the reference client JSON-RPC API
 	"getbestblock":          {handler: getBestBlock},
+	"getblockchaininfo":     {handler: getBlockChainInfoCached, handlerChain: getBlockChainInfo},
+	"waitforsync":           {handler: waitForSync, handlerChain: waitForSyncChain, noWalletLock: true},
+	"getsyncprogress":       {handlerNeutrino: getSyncProgress},
+	"scanblocks":            {handlerNeutrino: scanBlocks, noWalletLock: true},
 	"setnetworkstewardvote": {handler: setNetworkStewardVote},
 	"getnetworkstewardvote": {handler: getNetworkStewardVote},
 	"addp2shscript":         {handler: addP2shScript},
 	"createtransaction":     {handler: createTransaction},
+	"consolidate":           {handlerChain: consolidate},
+	"exportutxos":           {handler: exportUtxos},
+	"listdescriptors":       {handler: listDescriptors},
+	"getfeehistory":         {handler: getFeeHistory},
+	"abandontransaction":    {handler: abandonTransaction},
+	"getdescriptorinfo":     {handler: getDescriptorInfo},
 	"resync":                {handler: resync},
 	"stopresync":            {handler: stopResync},
 	"getaddressbalances":    {handler: getAddressBalances},
+	"setaddresslabel":       {handler: setAddressLabel},
 	"getwalletseed":         {handler: getWalletSeed},
+	"verifywalletseed":      {handler: verifyWalletSeed},
 	"getsecret":             {handler: getSecret},
 	"walletmempool":         {handler: walletMempool},
 	// This was an extension but the reference implementation added it as
@@ -144,7 +184,11 @@ type lazyHandler func() (interface{}, er.R)
 // returning a closure that will execute it with the (required) wallet and
 // (optional) consensus RPC server.  If no handlers are found and the
 // chainClient is not nil, the returned handler performs RPC passthrough.
-func lazyApplyHandler(request *btcjson.Request, w *wallet.Wallet, chainClient chain.Interface) lazyHandler {
+// Handlers which only need the wallet loader are run even if no wallet is
+// loaded.
+func lazyApplyHandler(request *btcjson.Request, w *wallet.Wallet,
+	chainClient chain.Interface, loader *wallet.Loader) lazyHandler {
+
 	hndlr, ok := rpcHandlers[request.Method]
 	var err er.R
 	unm := func(f func(interface{}) (interface{}, er.R)) func() (interface{}, er.R) {
@@ -156,16 +200,22 @@ func lazyApplyHandler(request *btcjson.Request, w *wallet.Wallet, chainClient ch
 			}
 		}
 	}
-	if w == nil {
+	if ok && hndlr.handlerLoader != nil {
+		if loader == nil {
+			err = btcjson.ErrRPCMisc.New("The wallet loader is not available", nil)
+		} else {
+			return unm(func(cmd interface{}) (interface{}, er.R) { return hndlr.handlerLoader(cmd, loader) })
+		}
+	} else if w == nil {
 		err = btcjson.ErrRPCMisc.New("The wallet is not loaded", nil)
 	} else if !ok {
 		err = btcjson.ErrRPCMisc.New(
 			fmt.Sprintf("[%s] does not seem to be a wallet comand", request.Method), nil)
 	} else if chainClient == nil {
 		// fallthrough
-	} else if rpc, ok := chainClient.(*chain.RPCClient); ok && hndlr.handlerRPC != nil {
+	} else if rpc, ok := chain.ActiveBackEnd(chainClient).(*chain.RPCClient); ok && hndlr.handlerRPC != nil {
 		return unm(func(cmd interface{}) (interface{}, er.R) { return hndlr.handlerRPC(cmd, w, rpc) })
-	} else if neut, ok := chainClient.(*chain.NeutrinoClient); ok && hndlr.handlerNeutrino != nil {
+	} else if neut, ok := chain.ActiveBackEnd(chainClient).(*chain.NeutrinoClient); ok && hndlr.handlerNeutrino != nil {
 		return unm(func(cmd interface{}) (interface{}, er.R) { return hndlr.handlerNeutrino(cmd, w, neut) })
 	} else if hndlr.handlerChain != nil {
 		return unm(func(cmd interface{}) (interface{}, er.R) { return hndlr.handlerChain(cmd, w, chainClient) })
@@ -337,12 +387,23 @@ func dumpPrivKey(icmd interface{}, w *wallet.Wallet) (interface{}, er.R) {
 	}
 
 	key, err := w.DumpWIFPrivateKey(addr)
-	if waddrmgr.ErrLocked.Is(err) {
+	switch {
+	case waddrmgr.ErrLocked.Is(err):
 		// Address was found, but the private key isn't
 		// accessible.
 		return nil, btcjson.ErrRPCWalletUnlockNeeded.Default()
+	case waddrmgr.ErrAddressNotFound.Is(err):
+		return nil, btcjson.ErrRPCInvalidAddressOrKey.New(
+			"Address does not refer to a key", err)
+	case waddrmgr.ErrWatchingOnly.Is(err), waddrmgr.ErrCrypto.Is(err):
+		// Address was found, but the wallet has no private key
+		// for it or can't decrypt it.
+		return nil, btcjson.ErrRPCWallet.New(
+			"Private key is not available", err)
+	case err != nil:
+		return nil, err
 	}
-	return key, err
+	return key, nil
 }
 
 func getAddressBalances(icmd interface{}, w *wallet.Wallet) (interface{}, er.R) {
@@ -375,6 +436,178 @@ func getAddressBalances(icmd interface{}, w *wallet.Wallet) (interface{}, er.R)
 	}
 }
 
+// createWallet handles a createwallet request by creating a new wallet next to
+// the loaded one.  The seed words are only returned if the seed was generated,
+// and the new wallet is only loaded on request if no wallet is loaded yet.  If
+// loading fails, the result is still returned with a warning, since it holds
+// the only copy of a generated seed.
+func createWallet(icmd interface{}, loader *wallet.Loader) (interface{}, er.R) {
+	cmd := icmd.(*btcjson.CreateWalletCmd)
+
+	if cmd.Passphrase == "" {
+		return nil, btcjson.ErrRPCInvalidParameter.New(
+			"A private passphrase is required", nil)
+	}
+	load := cmd.Load != nil && *cmd.Load
+	if _, loaded := loader.LoadedWallet(); load && loaded {
+		return nil, btcjson.ErrRPCWallet.New(
+			"Another wallet is loaded already", nil)
+	}
+
+	privPass := []byte(cmd.Passphrase)
+	pubPass := []byte(wallet.InsecurePubPassphrase)
+	if cmd.PublicPassphrase != nil {
+		pubPass = []byte(*cmd.PublicPassphrase)
+	}
+
+	var (
+		result    = btcjson.CreateWalletResult{Name: cmd.WalletName}
+		seedInput []byte
+		seed      *seedwords.Seed
+		err       er.R
+	)
+	if cmd.Seed == nil {
+		seed, err = seedwords.RandomSeed()
+		if err != nil {
+			return nil, err
+		}
+	} else if _, errr := hex.DecodeString(*cmd.Seed); errr == nil {
+		// It's a legacy hex encoded seed.
+		seedInput = []byte(*cmd.Seed)
+	} else {
+		seedEnc, err := seedwords.SeedFromWords(*cmd.Seed)
+		if err != nil {
+			return nil, btcjson.ErrRPCInvalidParameter.New(
+				"Invalid seed", err)
+		}
+		var seedPass []byte
+		if cmd.SeedPassphrase != nil {
+			seedPass = []byte(*cmd.SeedPassphrase)
+		} else if seedEnc.NeedsPassphrase() {
+			return nil, btcjson.ErrRPCInvalidParameter.New(
+				"The provided seed requires a passphrase", nil)
+		}
+		seed, err = seedEnc.Decrypt(seedPass, false)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if seed != nil {
+		defer seed.Zero()
+	}
+
+	watchOnly := cmd.WatchOnly != nil && *cmd.WatchOnly
+	result.Fingerprint, err = loader.CreateWallet(
+		cmd.WalletName, pubPass, privPass, seedInput, seed, watchOnly,
+	)
+	switch {
+	case wallet.ErrInvalidWalletName.Is(err):
+		return nil, btcjson.ErrRPCInvalidParameter.New(
+			"Invalid wallet name", err)
+	case wallet.ErrExists.Is(err):
+		return nil, btcjson.ErrRPCWallet.New(
+			"A wallet with this name exists already", err)
+	case err != nil:
+		return nil, err
+	}
+
+	// A generated seed is the only backup of the new wallet, so it must
+	// be handed out.  Watch-only wallets have no use for it.
+	if cmd.Seed == nil && !watchOnly {
+		seedEnc := seed.Encrypt(privPass)
+		result.Seed, err = seedEnc.Words("english")
+		seedEnc.Zero()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// The wallet exists from here on, so a failure to load it must not
+	// keep the seed from the caller.
+	if load {
+		if _, err := loader.OpenWallet(cmd.WalletName, pubPass); err != nil {
+			log.Warnf("Unable to load created wallet [%s]: %v",
+				cmd.WalletName, err)
+			result.Warning = "Wallet created but not loaded: " +
+				err.Message()
+		} else {
+			result.Loaded = true
+		}
+	}
+
+	return result, nil
+}
+
+// loadWallet handles a loadwallet request by opening the named wallet from the
+// wallet directory.  This is only possible if no wallet is loaded.  The wallet
+// takes over the chain client of the previously loaded wallet, if any.
+func loadWallet(icmd interface{}, s *Server) (interface{}, er.R) {
+	cmd := icmd.(*btcjson.LoadWalletCmd)
+
+	if s.walletLoader == nil {
+		return nil, btcjson.ErrRPCMisc.New("The wallet loader is not available", nil)
+	}
+	pubPass := []byte(wallet.InsecurePubPassphrase)
+	if cmd.PublicPassphrase != nil {
+		pubPass = []byte(*cmd.PublicPassphrase)
+	}
+
+	s.walletMu.Lock()
+	defer s.walletMu.Unlock()
+
+	w, err := s.walletLoader.OpenWallet(cmd.WalletName, pubPass)
+	switch {
+	case wallet.ErrInvalidWalletName.Is(err):
+		return nil, btcjson.ErrRPCInvalidParameter.New(
+			"Invalid wallet name", err)
+	case wallet.ErrLoaded.Is(err):
+		return nil, btcjson.ErrRPCWallet.New(
+			"Another wallet is loaded already, unload it first", err)
+	case walletdb.ErrDbDoesNotExist.Is(err):
+		return nil, btcjson.ErrRPCWallet.New(
+			"No wallet with this name exists", err)
+	case wallet.ErrWrongPassphrase.Is(err):
+		return nil, btcjson.ErrRPCWalletPassphraseIncorrect.New("", err)
+	case err != nil:
+		return nil, err
+	}
+	s.RegisterWallet(w)
+
+	return nil, nil
+}
+
+// unloadWallet handles an unloadwallet request by stopping the loaded wallet
+// and closing its database.  Requests which are running against the wallet
+// are completed first, those which arrive in the meantime wait for the unload
+// and fail because no wallet is loaded.  Requests which may wait for a long
+// time, like waitforsync, are ended by stopping the wallet.  The chain client
+// is kept running for the next wallet to be loaded.
+func unloadWallet(icmd interface{}, s *Server) (interface{}, er.R) {
+	if s.walletLoader == nil {
+		return nil, btcjson.ErrRPCMisc.New("The wallet loader is not available", nil)
+	}
+
+	s.walletMu.Lock()
+	defer s.walletMu.Unlock()
+
+	_, chainClient := s.walletAndChainClient()
+	err := s.walletLoader.UnloadWalletKeepChainClient()
+	if wallet.ErrNotLoaded.Is(err) {
+		return nil, btcjson.ErrRPCWallet.New("No wallet is loaded", err)
+	} else if err != nil {
+		return nil, err
+	}
+	s.RegisterWallet(nil)
+
+	// Keep the chain client for passthrough requests until the next wallet
+	// is loaded, be it by loadwallet or by createwallet.
+	if chainClient != nil {
+		s.SetChainServer(chainClient)
+	}
+
+	return nil, nil
+}
+
 func getWalletSeed(icmd interface{}, w *wallet.Wallet) (interface{}, er.R) {
 	if w.Manager.IsLocked() {
 		return nil, btcjson.ErrRPCWalletUnlockNeeded.Default()
@@ -386,6 +619,13 @@ func getWalletSeed(icmd interface{}, w *wallet.Wallet) (interface{}, er.R) {
 	return seed.Words("english")
 }
 
+// verifyWalletSeed handles a verifywalletseed request by reporting whether the
+// seed words match the wallet seed. The words must never be logged.
+func verifyWalletSeed(icmd interface{}, w *wallet.Wallet) (interface{}, er.R) {
+	cmd := icmd.(*btcjson.VerifyWalletSeedCmd)
+	return w.VerifySeed(cmd.Seed)
+}
+
 func getSecret(icmd interface{}, w *wallet.Wallet) (interface{}, er.R) {
 	cmd := icmd.(*btcjson.GetSecretCmd)
 	return w.GetSecret(cmd.Name)
@@ -418,6 +658,95 @@ func getBalance(icmd interface{}, w *wallet.Wallet) (interface{}, er.R) {
 	}
 }
 
+// getAddressesByLabel handles a getaddressesbylabel request by returning the
+// addresses in the wallet's address book which have the given label. The
+// purpose of each address is "receive" if it belongs to the wallet and "send"
+// otherwise.
+func getAddressesByLabel(icmd interface{}, w *wallet.Wallet) (interface{}, er.R) {
+	cmd := icmd.(*btcjson.GetAddressesByLabelCmd)
+
+	addrs, err := w.AddressesByLabel(cmd.Label)
+	if err != nil {
+		return nil, err
+	}
+	if len(addrs) == 0 {
+		msg := fmt.Sprintf("No addresses with label %s", cmd.Label)
+		return nil, btcjson.ErrRPCWalletInvalidAccountName.New(msg, nil)
+	}
+
+	result := make(map[string]btcjson.GetAddressesByLabelResult, len(addrs))
+	for _, addr := range addrs {
+		purpose := "send"
+		if mine, err := w.HaveAddress(addr); err != nil {
+			return nil, err
+		} else if mine {
+			purpose = "receive"
+		}
+		result[addr.EncodeAddress()] = btcjson.GetAddressesByLabelResult{
+			Purpose: purpose,
+		}
+	}
+	return result, nil
+}
+
+// getBalances handles a getbalances request by returning the total,
+// spendable, immature and unconfirmed balance of the wallet, optionally with
+// the immature balance broken down by the number of blocks until maturity.
+func getBalances(icmd interface{}, w *wallet.Wallet) (interface{}, er.R) {
+	cmd := icmd.(*btcjson.GetBalancesCmd)
+	bals, err := w.CalculateAccountBalances(int32(*cmd.MinConf))
+	if err != nil {
+		return nil, err
+	}
+	var sum wallet.Balances
+	for _, bal := range bals {
+		sum.Total += bal.Total
+		sum.Spendable += bal.Spendable
+		sum.ImmatureReward += bal.ImmatureReward
+		sum.Unconfirmed += bal.Unconfirmed
+		sum.Final += bal.Final
+		sum.OutputCount += bal.OutputCount
+	}
+	result := btcjson.GetBalancesResult{
+		Total:  sum.Total.ToBTC(),
+		Stotal: strconv.FormatInt(int64(sum.Total), 10),
+
+		Spendable:  sum.Spendable.ToBTC(),
+		Sspendable: strconv.FormatInt(int64(sum.Spendable), 10),
+
+		ImmatureReward:  sum.ImmatureReward.ToBTC(),
+		SimmatureReward: strconv.FormatInt(int64(sum.ImmatureReward), 10),
+
+		Unconfirmed:  sum.Unconfirmed.ToBTC(),
+		Sunconfirmed: strconv.FormatInt(int64(sum.Unconfirmed), 10),
+
+		Final:  sum.Final.ToBTC(),
+		Sfinal: strconv.FormatInt(int64(sum.Final), 10),
+
+		OutputCount: sum.OutputCount,
+	}
+
+	// Break the immature balance down by the number of blocks until it
+	// matures, if requested.
+	if cmd.MatureWithin != nil {
+		rewards, err := w.ImmatureRewards(int32(*cmd.MatureWithin))
+		if err != nil {
+			return nil, err
+		}
+		result.ImmatureRewardDetail = make([]btcjson.ImmatureRewardResult, 0, len(rewards))
+		for _, r := range rewards {
+			result.ImmatureRewardDetail = append(result.ImmatureRewardDetail,
+				btcjson.ImmatureRewardResult{
+					BlocksToMaturity: r.BlocksToMaturity,
+					Amount:           r.Amount.ToBTC(),
+					Samount:          strconv.FormatInt(int64(r.Amount), 10),
+					OutputCount:      r.OutputCount,
+				})
+		}
+	}
+	return result, nil
+}
+
 // getBestBlock handles a getbestblock request by returning a JSON object
 // with the height and hash of the most recently processed block.
 func getBestBlock(icmd interface{}, w *wallet.Wallet) (interface{}, er.R) {
@@ -443,6 +772,295 @@ func getBlockCount(icmd interface{}, w *wallet.Wallet) (interface{}, er.R) {
 	return blk.Height, nil
 }
 
+// getBlockChainInfo handles a getblockchaininfo request by asking the chain
+// backend for the state of the best chain.  If the backend can't be reached,
+// the chain info cached by the wallet is returned instead.
+func getBlockChainInfo(icmd interface{}, w *wallet.Wallet, chainClient chain.Interface) (interface{}, er.R) {
+	ci, err := fetchChainInfo(w, chainClient)
+	if err != nil {
+		log.Debugf("Unable to get chain info from backend, using "+
+			"cached chain info [%s]", err.String())
+		return getBlockChainInfoCached(icmd, w)
+	}
+	w.CacheChainInfo(ci)
+	return chainInfoResult(ci, false), nil
+}
+
+// getBlockChainInfoCached handles a getblockchaininfo request while there is
+// no chain backend by returning the last chain info cached by the wallet.
+func getBlockChainInfoCached(icmd interface{}, w *wallet.Wallet) (interface{}, er.R) {
+	ci := w.CachedChainInfo()
+	if ci == nil {
+		return nil, btcjson.ErrRPCMisc.New("No chain information available yet", nil)
+	}
+	return chainInfoResult(ci, true), nil
+}
+
+// fetchChainInfo queries the chain backend for the state of the best chain.
+// A pktd backend is asked directly, for other backends the info is derived
+// from the best block.
+func fetchChainInfo(w *wallet.Wallet, chainClient chain.Interface) (*wallet.ChainInfo, er.R) {
+	bs, err := chainClient.BlockStamp()
+	if err != nil {
+		return nil, err
+	}
+	header, err := chainClient.GetBlockHeader(&bs.Hash)
+	if err != nil {
+		return nil, err
+	}
+	ci := &wallet.ChainInfo{
+		Chain:                w.ChainParams().Name,
+		Height:               bs.Height,
+		Hash:                 bs.Hash,
+		BlockTime:            header.Timestamp,
+		InitialBlockDownload: !chainClient.IsCurrent(),
+		VerificationProgress: 1,
+	}
+
+	if rpc, ok := chain.ActiveBackEnd(chainClient).(*chain.RPCClient); ok {
+		raw, err := rpc.RawRequest("getblockchaininfo", nil)
+		if err != nil {
+			return nil, err
+		}
+		var info btcjson.GetBlockChainInfoResult
+		if errr := jsoniter.Unmarshal(raw, &info); errr != nil {
+			return nil, er.E(errr)
+		}
+		ci.Chain = info.Chain
+		ci.InitialBlockDownload = info.InitialBlockDownload
+		ci.VerificationProgress = info.VerificationProgress
+	} else if ci.InitialBlockDownload {
+		genesis, err := chainClient.GetBlockHeader(w.ChainParams().GenesisHash)
+		if err != nil {
+			return nil, err
+		}
+		ci.VerificationProgress = estimateProgress(
+			genesis.Timestamp, header.Timestamp,
+		)
+	}
+	return ci, nil
+}
+
+// estimateProgress estimates the fraction of the chain which has been synced
+// from the timestamp of the best block.
+func estimateProgress(genesisTime, bestTime time.Time) float64 {
+	total := time.Since(genesisTime)
+	if total <= 0 {
+		return 1
+	}
+	progress := float64(bestTime.Sub(genesisTime)) / float64(total)
+	if progress < 0 {
+		return 0
+	} else if progress > 1 {
+		return 1
+	}
+	return progress
+}
+
+// chainInfoResult converts chain info to a getblockchaininfo result.
+func chainInfoResult(ci *wallet.ChainInfo, stale bool) *btcjson.GetBlockChainInfoWalletResult {
+	result := &btcjson.GetBlockChainInfoWalletResult{
+		Chain:                ci.Chain,
+		Blocks:               ci.Height,
+		BestBlockHash:        ci.Hash.String(),
+		InitialBlockDownload: ci.InitialBlockDownload,
+		VerificationProgress: ci.VerificationProgress,
+		Stale:                stale,
+	}
+	if !ci.BlockTime.IsZero() {
+		result.BestBlockTime = ci.BlockTime.Unix()
+	}
+	if stale {
+		result.CachedAt = ci.CachedAt.Unix()
+	}
+	return result
+}
+
+// maxWaitForSyncTimeout is the maximum number of seconds a single waitforsync
+// request may wait, so that a request can not tie up a connection for good.
+const maxWaitForSyncTimeout = 3600
+
+// waitForSync handles a waitforsync request by blocking until the wallet is
+// synced with the chain backend or the timeout elapses, and reporting the
+// height the wallet is synced to.
+func waitForSync(icmd interface{}, w *wallet.Wallet) (interface{}, er.R) {
+	cmd := icmd.(*btcjson.WaitForSyncCmd)
+
+	timeout := 0
+	if cmd.Timeout != nil {
+		timeout = *cmd.Timeout
+	}
+	switch {
+	case timeout < 0:
+		return nil, btcjson.ErrRPCInvalidParameter.New(
+			"The timeout must not be negative", nil)
+	case timeout > maxWaitForSyncTimeout:
+		return nil, btcjson.ErrRPCInvalidParameter.New(fmt.Sprintf(
+			"The timeout must not exceed %d seconds",
+			maxWaitForSyncTimeout), nil)
+	}
+
+	synced := w.ChainSynced()
+	if !synced && timeout > 0 {
+		synced = w.WaitForChainSynced(time.Duration(timeout) * time.Second)
+	}
+	return &btcjson.WaitForSyncResult{
+		Synced: synced,
+		Height: w.Manager.SyncedTo().Height,
+	}, nil
+}
+
+// waitForSyncChain handles a waitforsync request like waitForSync, and
+// additionally reports the height of the best block known to the chain
+// backend.
+func waitForSyncChain(icmd interface{}, w *wallet.Wallet, chainClient chain.Interface) (interface{}, er.R) {
+	res, err := waitForSync(icmd, w)
+	if err != nil {
+		return nil, err
+	}
+	result := res.(*btcjson.WaitForSyncResult)
+	if bs, err := chainClient.BlockStamp(); err == nil {
+		result.BackendHeight = bs.Height
+	}
+	return result, nil
+}
+
+// getSyncProgress handles a getsyncprogress request by reporting how far the
+// neutrino chain service has come syncing block headers and filter headers.
+func getSyncProgress(icmd interface{}, w *wallet.Wallet, neut *chain.NeutrinoClient) (interface{}, er.R) {
+	return syncProgressResult(neut)
+}
+
+// syncProgressResult returns the sync progress of the neutrino chain service
+// as JSON-RPC result.
+func syncProgressResult(neut *chain.NeutrinoClient) (*btcjson.SyncProgressResult, er.R) {
+	progress, err := neut.CS.SyncProgress()
+	if err != nil {
+		return nil, err
+	}
+	return &btcjson.SyncProgressResult{
+		CurrentHeight:      progress.HeaderHeight,
+		TargetHeight:       progress.TargetHeight,
+		FilterHeaderHeight: progress.FilterHeaderHeight,
+		Percent:            progress.Percent(),
+		Synced:             progress.Synced(),
+	}, nil
+}
+
+// maxScanBlocksRange is the maximum number of blocks a single scanblocks
+// request may scan, as the compact filter of every block may have to be
+// fetched from the network.
+const maxScanBlocksRange = 10000
+
+// scanBlocksBackend is the part of the neutrino client which scanblocks uses.
+type scanBlocksBackend interface {
+	GetBestBlock() (*chainhash.Hash, int32, er.R)
+	ScanBlocks(scripts [][]byte, startHeight, stopHeight int32,
+		fetchBlocks bool) ([]chain.ScanBlocksMatch, er.R)
+}
+
+// scanBlocks handles a scanblocks request by matching the compact filters of
+// a range of blocks against the requested scripts, which are given as
+// addresses or hex encoded output scripts. The scripts are not imported into
+// the wallet.
+func scanBlocks(icmd interface{}, w *wallet.Wallet, neut *chain.NeutrinoClient) (interface{}, er.R) {
+	return scanBlocksWith(icmd.(*btcjson.ScanBlocksCmd), w.ChainParams(), neut)
+}
+
+// scanBlocksWith handles a scanblocks request using the given backend.
+func scanBlocksWith(cmd *btcjson.ScanBlocksCmd, params *chaincfg.Params,
+	neut scanBlocksBackend) (*btcjson.ScanBlocksResult, er.R) {
+
+	if len(cmd.Scripts) == 0 {
+		return nil, btcjson.ErrRPCInvalidParameter.New(
+			"At least one script is required", nil)
+	}
+	scripts := make([][]byte, 0, len(cmd.Scripts))
+	for _, s := range cmd.Scripts {
+		script, err := decodeScanScript(s, params)
+		if err != nil {
+			return nil, err
+		}
+		scripts = append(scripts, script)
+	}
+
+	_, bestHeight, err := neut.GetBestBlock()
+	if err != nil {
+		return nil, err
+	}
+
+	startHeight := int32(0)
+	if cmd.StartHeight != nil {
+		startHeight = *cmd.StartHeight
+	}
+	stopHeight := bestHeight
+	if cmd.StopHeight != nil && *cmd.StopHeight >= 0 {
+		stopHeight = *cmd.StopHeight
+	}
+	switch {
+	case startHeight < 0:
+		return nil, btcjson.ErrRPCInvalidParameter.New(
+			"startheight must not be negative", nil)
+	case stopHeight > bestHeight:
+		return nil, btcjson.ErrRPCInvalidParameter.New(fmt.Sprintf(
+			"stopheight %d is above the best block %d", stopHeight,
+			bestHeight), nil)
+	case startHeight > stopHeight:
+		return nil, btcjson.ErrRPCInvalidParameter.New(fmt.Sprintf(
+			"startheight %d is above stopheight %d", startHeight,
+			stopHeight), nil)
+	case stopHeight-startHeight >= maxScanBlocksRange:
+		return nil, btcjson.ErrRPCInvalidParameter.New(fmt.Sprintf(
+			"At most %d blocks can be scanned at once",
+			maxScanBlocksRange), nil)
+	}
+
+	fetchBlocks := cmd.FetchBlocks != nil && *cmd.FetchBlocks
+	matches, err := neut.ScanBlocks(scripts, startHeight, stopHeight, fetchBlocks)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &btcjson.ScanBlocksResult{
+		FromHeight:     startHeight,
+		ToHeight:       stopHeight,
+		RelevantBlocks: make([]btcjson.ScanBlocksBlockResult, 0, len(matches)),
+	}
+	for _, match := range matches {
+		block := btcjson.ScanBlocksBlockResult{
+			Height: match.Height,
+			Hash:   match.Hash.String(),
+		}
+		for _, txHash := range match.TxHashes {
+			block.TxIDs = append(block.TxIDs, txHash.String())
+		}
+		result.RelevantBlocks = append(result.RelevantBlocks, block)
+	}
+	return result, nil
+}
+
+// decodeScanScript decodes a script of a scanblocks request, which is either
+// an address or a hex encoded output script.
+func decodeScanScript(s string, params *chaincfg.Params) ([]byte, er.R) {
+	if addr, err := btcutil.DecodeAddress(s, params); err == nil {
+		if !addr.IsForNet(params) {
+			msg := fmt.Sprintf("Invalid address %q: not intended "+
+				"for use on %s", addr, params.Name)
+			return nil, btcjson.ErrRPCInvalidAddressOrKey.New(msg, nil)
+		}
+		return txscript.PayToAddrScript(addr)
+	}
+	script, err := decodeHexStr(s)
+	if err != nil {
+		return nil, err
+	}
+	if len(script) == 0 {
+		return nil, btcjson.ErrRPCInvalidParameter.New(
+			"Script must not be empty", nil)
+	}
+	return script, nil
+}
+
 // getInfo handles a getinfo request by returning the a structure containing
 // information about the current state of pktwallet.
 // exist.
@@ -456,6 +1074,8 @@ func getInfo(icmd interface{}, w *wallet.Wallet, chainClient chain.Interface) (i
 	w.ReadStats(func(ws *btcjson.WalletStats) {
 		walletStats = *ws
 	})
+	walletStats.WatchedAddrs = w.NumWatchedAddrs()
+	walletStats.MaxWatchedAddrs = w.MaxWatchedAddrs()
 
 	mgrStamp := w.Manager.SyncedTo()
 
@@ -472,7 +1092,7 @@ func getInfo(icmd interface{}, w *wallet.Wallet, chainClient chain.Interface) (i
 		WalletStats:           &walletStats,
 	}
 
-	if rpc, ok := chainClient.(*chain.RPCClient); ok {
+	if rpc, ok := chain.ActiveBackEnd(chainClient).(*chain.RPCClient); ok {
 		// Call down to pktd for all of the information in this command known
 		// by them.
 		info, err := rpc.GetInfo()
@@ -480,7 +1100,7 @@ func getInfo(icmd interface{}, w *wallet.Wallet, chainClient chain.Interface) (i
 			return nil, err
 		}
 		out.RPCInfo = info
-	} else if neut, ok := chainClient.(*chain.NeutrinoClient); ok {
+	} else if neut, ok := chain.ActiveBackEnd(chainClient).(*chain.NeutrinoClient); ok {
 		ni := btcjson.NeutrinoInfo{}
 		out.NeutrinoInfo = &ni
 		for _, p := range neut.CS.Peers() {
@@ -579,7 +1199,7 @@ func getNetworkStewardVote(icmd interface{}, w *wallet.Wallet) (interface{}, er.
 // getUnconfirmedBalance handles a getunconfirmedbalance extension request
 // by returning the current unconfirmed balance of an account.
 func getUnconfirmedBalance(icmd interface{}, w *wallet.Wallet) (interface{}, er.R) {
-	bals, err := w.CalculateAddressBalances(0, false)
+	bals, err := w.CalculateAccountBalances(1)
 	if err != nil {
 		return nil, err
 	}
@@ -630,6 +1250,42 @@ func importPrivKey(icmd interface{}, w *wallet.Wallet) (interface{}, er.R) {
 	return addr, err
 }
 
+// importWallet handles an importwallet request by importing all keys of a
+// wallet dump file, which is validated as a whole before any key is imported.
+func importWallet(icmd interface{}, w *wallet.Wallet) (interface{}, er.R) {
+	cmd := icmd.(*btcjson.ImportWalletCmd)
+
+	f, errr := os.Open(cmd.Filename)
+	if errr != nil {
+		return nil, btcjson.ErrRPCInvalidParameter.New(
+			"Cannot open wallet dump file", er.E(errr))
+	}
+	defer f.Close()
+
+	keys, err := wallet.ParseWalletDump(f, w.ChainParams())
+	if err != nil {
+		return nil, btcjson.ErrRPCDeserialization.New(
+			"Invalid wallet dump file", err)
+	}
+
+	scope := waddrmgr.KeyScopeBIP0084
+	if cmd.Legacy != nil && *cmd.Legacy {
+		scope = waddrmgr.KeyScopeBIP0044
+	}
+	res, err := w.ImportWalletDump(scope, keys)
+	if err != nil {
+		if waddrmgr.ErrLocked.Is(err) {
+			return nil, btcjson.ErrRPCWalletUnlockNeeded.Default()
+		}
+		return nil, err
+	}
+	return btcjson.ImportWalletResult{
+		Imported:     res.Imported,
+		Existing:     res.Existing,
+		RescanHeight: res.RescanHeight,
+	}, nil
+}
+
 // getNewAddress handles a getnewaddress request by returning a new
 // address for an account.  If the account does not exist an appropriate
 // error is returned.
@@ -709,14 +1365,17 @@ func getTransaction(icmd interface{}, w *wallet.Wallet) (interface{}, er.R) {
 
 	if details.Block.Height != -1 {
 		ret.BlockHash = details.Block.Hash.String()
+		ret.BlockHeight = details.Block.Height
 		ret.BlockTime = details.Block.Time.Unix()
 		ret.Confirmations = int64(confirms(details.Block.Height, syncBlock.Height))
 	}
 
+	effect := wallet.TxDetailsEffect(details)
+	ret.Net = effect.Net.ToBTC()
+
 	var (
 		debitTotal  btcutil.Amount
 		creditTotal btcutil.Amount // Excludes change
-		fee         btcutil.Amount
 		feeF64      float64
 	)
 	for _, deb := range details.Debits {
@@ -727,14 +1386,24 @@ func getTransaction(icmd interface{}, w *wallet.Wallet) (interface{}, er.R) {
 			creditTotal += cred.Amount
 		}
 	}
-	// Fee can only be determined if every input is a debit.
-	if len(details.Debits) == len(details.MsgTx.TxIn) {
-		var outputTotal btcutil.Amount
-		for _, output := range details.MsgTx.TxOut {
-			outputTotal += btcutil.Amount(output.Value)
+	if effect.FeeKnown {
+		feeF64 = effect.Fee.ToBTC()
+	}
+
+	ret.Outputs = make([]btcjson.GetTransactionOutputResult, len(details.MsgTx.TxOut))
+	for i, output := range details.MsgTx.TxOut {
+		var address string
+		_, addrs, _, err := txscript.ExtractPkScriptAddrs(
+			output.PkScript, w.ChainParams())
+		if err == nil && len(addrs) == 1 {
+			address = addrs[0].EncodeAddress()
+		}
+		ret.Outputs[i] = btcjson.GetTransactionOutputResult{
+			Vout:     uint32(i),
+			Amount:   btcutil.Amount(output.Value).ToBTC(),
+			Address:  address,
+			Category: effect.Outputs[i].String(),
 		}
-		fee = debitTotal - outputTotal
-		feeF64 = fee.ToBTC()
 	}
 
 	if len(details.Debits) == 0 {
@@ -922,6 +1591,19 @@ func help(icmd interface{}, w *wallet.Wallet, chainClient *chain.RPCClient) (int
 		fmt.Sprintf("No help for method '%s'", *cmd.Command), nil)
 }
 
+// listLabels handles a listlabels request by returning the sorted list of
+// labels in the wallet's address book.
+func listLabels(icmd interface{}, w *wallet.Wallet) (interface{}, er.R) {
+	labels, err := w.AddressLabels()
+	if err != nil {
+		return nil, err
+	}
+	if labels == nil {
+		labels = []string{}
+	}
+	return labels, nil
+}
+
 // listLockUnspent handles a listlockunspent request by returning an slice of
 // all locked outpoints.
 func listLockUnspent(icmd interface{}, w *wallet.Wallet) (interface{}, er.R) {
@@ -1198,6 +1880,8 @@ func sendOutputs(
 	changeAddress *string,
 	inputMinHeight int,
 	maxInputs int,
+	lockTime uint32,
+	sequence *uint32,
 ) (*txauthor.AuthoredTx, er.R) {
 	req := wallet.CreateTxReq{
 		Minconf:        minconf,
@@ -1206,6 +1890,8 @@ func sendOutputs(
 		InputMinHeight: inputMinHeight,
 		MaxInputs:      maxInputs,
 		Label:          "",
+		LockTime:       lockTime,
+		Sequence:       sequence,
 	}
 	if inputMinHeight > 0 {
 		// TODO(cjd): Ideally we would expose the comparator choice to the
@@ -1246,6 +1932,15 @@ func sendOutputs(
 		if waddrmgr.ErrLocked.Is(err) {
 			return nil, btcjson.ErrRPCWalletUnlockNeeded.Default()
 		}
+		if wallet.ErrAddressReuse.Is(err) {
+			return nil, btcjson.ErrRPCInvalidAddressOrKey.New(
+				"address reuse is blocked", err)
+		}
+		if wallet.ErrLockTimeIgnored.Is(err) ||
+			wallet.ErrLockTimeNotReached.Is(err) {
+			return nil, btcjson.ErrRPCInvalidParameter.New(
+				"invalid locktime", err)
+		}
 		if btcjson.Err.Is(err) {
 			return nil, err
 		}
@@ -1264,7 +1959,7 @@ func sendPairs(w *wallet.Wallet, amounts map[string]btcutil.Amount,
 		return "", err
 	}
 
-	tx, err := sendOutputs(w, amounts, vote, fromAddressses, minconf, feeSatPerKb, false, nil, inputMinHeight, maxInputs)
+	tx, err := sendOutputs(w, amounts, vote, fromAddressses, minconf, feeSatPerKb, false, nil, inputMinHeight, maxInputs, 0, nil)
 	if err != nil {
 		return "", err
 	}
@@ -1363,8 +2058,29 @@ func createTransaction(icmd interface{}, w *wallet.Wallet) (interface{}, er.R) {
 		maxInputs = *cmd.MaxInputs
 	}
 
+	// A locktime in the future is kept, the transaction is not sent
+	// before it can be mined.
+	var lockTime uint32
+	if cmd.LockTime != nil {
+		if *cmd.LockTime < 0 || *cmd.LockTime > math.MaxUint32 {
+			return nil, btcjson.ErrRPCInvalidParameter.New(
+				"locktime out of range", nil)
+		}
+		lockTime = uint32(*cmd.LockTime)
+	}
+	var sequence *uint32
+	if cmd.Sequence != nil {
+		if *cmd.Sequence < 0 || *cmd.Sequence > math.MaxUint32 {
+			return nil, btcjson.ErrRPCInvalidParameter.New(
+				"sequence out of range", nil)
+		}
+		seq := uint32(*cmd.Sequence)
+		sequence = &seq
+	}
+
 	tx, err := sendOutputs(w, amounts, vote, cmd.FromAddresses, minconf,
-		feeSatPerKb, true, cmd.ChangeAddress, inputMinHeight, maxInputs)
+		feeSatPerKb, true, cmd.ChangeAddress, inputMinHeight, maxInputs,
+		lockTime, sequence)
 	if err != nil {
 		return "", err
 	}
@@ -1391,6 +2107,297 @@ func createTransaction(icmd interface{}, w *wallet.Wallet) (interface{}, er.R) {
 	return hex.EncodeToString(b.Bytes()), nil
 }
 
+// consolidateFeeRate returns the fee rate for a consolidate request, this is
+// the rate requested by the caller or, failing that, the rate estimated by
+// the backend if it is a full node.
+func consolidateFeeRate(cmd *btcjson.ConsolidateCmd,
+	chainClient chain.Interface) (btcutil.Amount, er.R) {
+
+	if cmd.FeeRate != nil {
+		if *cmd.FeeRate <= 0 {
+			return 0, btcjson.ErrRPCInvalidParameter.New(
+				"feerate must be positive", nil)
+		}
+		return btcutil.NewAmount(*cmd.FeeRate)
+	}
+	if rpc, ok := chain.ActiveBackEnd(chainClient).(*chain.RPCClient); ok {
+		feeRate, err := rpc.EstimateFee(6)
+		if err != nil {
+			log.Debugf("Unable to estimate fee, using default: %v", err)
+		} else if feeRate > 0 {
+			return btcutil.NewAmount(feeRate)
+		}
+	}
+	return txrules.DefaultRelayFeePerKb, nil
+}
+
+// consolidate handles a consolidate request by merging the outputs below the
+// threshold into a single output paying back to the wallet.
+func consolidate(icmd interface{}, w *wallet.Wallet, chainClient chain.Interface) (interface{}, er.R) {
+	cmd := icmd.(*btcjson.ConsolidateCmd)
+
+	if cmd.Threshold <= 0 {
+		return nil, errNeedPositiveAmount()
+	}
+	threshold, err := btcutil.NewAmount(cmd.Threshold)
+	if err != nil {
+		return nil, err
+	}
+	minconf := int32(1)
+	if cmd.MinConf != nil {
+		minconf = int32(*cmd.MinConf)
+		if minconf < 0 {
+			return nil, errNeedPositiveMinconf()
+		}
+	}
+	feeSatPerKb, err := consolidateFeeRate(cmd, chainClient)
+	if err != nil {
+		return nil, err
+	}
+
+	req := wallet.ConsolidateReq{
+		Threshold:   threshold,
+		Minconf:     minconf,
+		FeeSatPerKB: feeSatPerKb,
+		DryRun:      cmd.DryRun != nil && *cmd.DryRun,
+	}
+	if cmd.MaxInputs != nil {
+		req.MaxInputs = *cmd.MaxInputs
+	}
+	res, err := w.Consolidate(req)
+	if err != nil {
+		if waddrmgr.ErrLocked.Is(err) {
+			return nil, btcjson.ErrRPCWalletUnlockNeeded.Default()
+		}
+		if btcjson.Err.Is(err) {
+			return nil, err
+		}
+		return nil, btcjson.ErrRPCInternal.New("Consolidate failed", err)
+	}
+
+	result := btcjson.ConsolidateResult{
+		Inputs:  res.InputCount,
+		Amount:  res.InputAmount.ToBTC(),
+		Fee:     res.Fee.ToBTC(),
+		Skipped: res.Skipped,
+	}
+	if res.Tx != nil {
+		result.TxID = res.Tx.Tx.TxHash().String()
+		if !req.DryRun {
+			log.Infof("Consolidated [%d] outputs in transaction [%s]",
+				res.InputCount, log.Txid(result.TxID))
+		}
+	}
+	return result, nil
+}
+
+// parseOutPoint parses an outpoint in the form txid:vout.
+func parseOutPoint(s string) (*wire.OutPoint, er.R) {
+	i := strings.LastIndexByte(s, ':')
+	if i < 0 {
+		return nil, btcjson.ErrRPCInvalidParameter.New(
+			"outpoint must be in the form txid:vout", nil)
+	}
+	txHash, err := chainhash.NewHashFromStr(s[:i])
+	if err != nil {
+		return nil, errParse("unable to parse hash", err)
+	}
+	vout, errr := strconv.ParseUint(s[i+1:], 10, 32)
+	if errr != nil {
+		return nil, btcjson.ErrRPCInvalidParameter.New(
+			"invalid output index", er.E(errr))
+	}
+	return wire.NewOutPoint(txHash, uint32(vout)), nil
+}
+
+// listDescriptors handles a listdescriptors request by returning the output
+// descriptors of the external and internal addresses of every account.
+// Private descriptors require the wallet to be unlocked.
+func listDescriptors(icmd interface{}, w *wallet.Wallet) (interface{}, er.R) {
+	cmd := icmd.(*btcjson.ListDescriptorsCmd)
+
+	private := cmd.Private != nil && *cmd.Private
+	descs, err := w.ExportDescriptors(private)
+	switch {
+	case waddrmgr.ErrLocked.Is(err):
+		return nil, btcjson.ErrRPCWalletUnlockNeeded.Default()
+	case waddrmgr.ErrWatchingOnly.Is(err):
+		return nil, btcjson.ErrRPCWallet.New(
+			"Private keys are not available", err)
+	case err != nil:
+		return nil, err
+	}
+
+	result := &btcjson.ListDescriptorsResult{
+		Descriptors: make([]btcjson.DescriptorResult, 0, len(descs)),
+	}
+	for _, desc := range descs {
+		descStr := desc.Descriptor.String()
+		if cmd.Checksum == nil || *cmd.Checksum {
+			descStr = desc.Descriptor.StringWithChecksum()
+		}
+		result.Descriptors = append(result.Descriptors,
+			btcjson.DescriptorResult{
+				Desc:          descStr,
+				Account:       desc.AccountName,
+				AccountNumber: desc.Account,
+				Internal:      desc.Internal,
+				Next:          desc.NextIndex,
+			})
+	}
+	return result, nil
+}
+
+// getFeeHistory handles a getfeehistory request by returning the fee rates
+// and confirmation delays of the most recent transactions broadcast by the
+// wallet.
+func getFeeHistory(icmd interface{}, w *wallet.Wallet) (interface{}, er.R) {
+	cmd := icmd.(*btcjson.GetFeeHistoryCmd)
+
+	count := 10
+	if cmd.Count != nil {
+		count = *cmd.Count
+	}
+	if count <= 0 {
+		return nil, btcjson.ErrRPCInvalidParameter.New(
+			"count must be positive", nil)
+	}
+
+	records, err := w.FeeHistory(count)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	result := make([]btcjson.FeeHistoryResult, 0, len(records))
+	for _, r := range records {
+		entry := btcjson.FeeHistoryResult{
+			TxID:      r.Hash.String(),
+			FeeRate:   r.FeeRate().ToBTC(),
+			Time:      r.Broadcast.Unix(),
+			Confirmed: r.Confirmed(),
+		}
+		if r.Confirmed() {
+			entry.ConfirmationDelay = r.ConfirmationDelay()
+		} else {
+			entry.Age = int64(now.Sub(r.Broadcast).Seconds())
+		}
+		result = append(result, entry)
+	}
+	return result, nil
+}
+
+// abandonTransaction handles an abandontransaction request by abandoning an
+// unconfirmed transaction, releasing the outputs it spends.
+func abandonTransaction(icmd interface{}, w *wallet.Wallet) (interface{}, er.R) {
+	cmd := icmd.(*btcjson.AbandonTransactionCmd)
+
+	txHash, err := chainhash.NewHashFromStr(cmd.TxID)
+	if err != nil {
+		return nil, btcjson.ErrRPCDecodeHexString.New(
+			"Transaction hash string decode failed", err)
+	}
+
+	err = w.AbandonTransaction(*txHash)
+	switch {
+	case wallet.ErrUnknownTransaction.Is(err):
+		return nil, btcjson.ErrRPCNoTxInfo.Default()
+	case wtxmgr.ErrTxConfirmed.Is(err):
+		return nil, btcjson.ErrRPCInvalidParameter.New(
+			"Transaction is confirmed and can't be abandoned", nil)
+	case wtxmgr.ErrTxAbandoned.Is(err):
+		return nil, btcjson.ErrRPCInvalidParameter.New(
+			"Transaction is already abandoned", nil)
+	}
+	return nil, err
+}
+
+// getDescriptorInfo handles a getdescriptorinfo request by returning the
+// canonical form and checksum of an output descriptor along with its
+// properties.
+func getDescriptorInfo(icmd interface{}, w *wallet.Wallet) (interface{}, er.R) {
+	cmd := icmd.(*btcjson.GetDescriptorInfoCmd)
+
+	info, err := wallet.GetDescriptorInfo(cmd.Descriptor, w.ChainParams())
+	if wallet.ErrMalformedDescriptor.Is(err) {
+		return nil, btcjson.ErrRPCInvalidAddressOrKey.New(
+			"Invalid descriptor", err)
+	} else if err != nil {
+		return nil, err
+	}
+	return &btcjson.GetDescriptorInfoResult{
+		Descriptor:     info.Descriptor,
+		Checksum:       info.Checksum,
+		IsRange:        info.IsRange,
+		IsSolvable:     info.IsSolvable,
+		HasPrivateKeys: info.HasPrivateKeys,
+	}, nil
+}
+
+// exportUtxos handles an exportutxos request by returning a page of a
+// consistent snapshot of the unspent outputs of the wallet.
+func exportUtxos(icmd interface{}, w *wallet.Wallet) (interface{}, er.R) {
+	cmd := icmd.(*btcjson.ExportUtxosCmd)
+
+	count := 1000
+	if cmd.Count != nil {
+		count = *cmd.Count
+		if count < 0 {
+			return nil, btcjson.ErrRPCInvalidParameter.New(
+				"count must not be negative", nil)
+		}
+	}
+	var after *wire.OutPoint
+	if cmd.After != nil && *cmd.After != "" {
+		op, err := parseOutPoint(*cmd.After)
+		if err != nil {
+			return nil, err
+		}
+		after = op
+	}
+
+	snapshot, err := w.ExportUtxos(after, count)
+	if err != nil {
+		return nil, err
+	}
+
+	result := btcjson.ExportUtxosResult{
+		Height:    snapshot.SyncedTo.Height,
+		BlockHash: snapshot.SyncedTo.Hash.String(),
+		Count:     snapshot.Count,
+		Amount:    snapshot.Amount.ToBTC(),
+		Utxos:     make([]btcjson.ExportedUtxoResult, 0, len(snapshot.Utxos)),
+	}
+	for _, utxo := range snapshot.Utxos {
+		res := btcjson.ExportedUtxoResult{
+			TxID:           utxo.OutPoint.Hash.String(),
+			Vout:           utxo.OutPoint.Index,
+			Account:        utxo.Account,
+			ScriptPubKey:   hex.EncodeToString(utxo.PkScript),
+			Amount:         utxo.Amount.ToBTC(),
+			Confirmations:  int64(utxo.Confirmations),
+			Height:         utxo.Height,
+			Coinbase:       utxo.FromCoinBase,
+			Spendable:      utxo.Spendable,
+			DerivationPath: utxo.DerivationPath,
+			Locked:         utxo.Locked,
+			LockName:       utxo.LockName,
+			Frozen:         utxo.Frozen,
+		}
+		if utxo.Address != nil {
+			res.Address = utxo.Address.EncodeAddress()
+		}
+		if utxo.Frozen {
+			res.FrozenUntil = utxo.FrozenUntil.Unix()
+		}
+		result.Utxos = append(result.Utxos, res)
+	}
+	if snapshot.More && len(snapshot.Utxos) > 0 {
+		result.Next = snapshot.Utxos[len(snapshot.Utxos)-1].OutPoint.String()
+	}
+	return result, nil
+}
+
 func stopResync(icmd interface{}, w *wallet.Wallet) (interface{}, er.R) {
 	return w.StopResync()
 }
@@ -1483,6 +2490,24 @@ func sendToAddress(icmd interface{}, w *wallet.Wallet) (interface{}, er.R) {
 	return sendPairs(w, pairs, nil, 1, txrules.DefaultRelayFeePerKb, -1, 0)
 }
 
+// setAddressLabel handles a setaddresslabel request by storing a label for an
+// address in the wallet's address book. The address does not need to belong
+// to the wallet and an empty label removes it from the address book.
+func setAddressLabel(icmd interface{}, w *wallet.Wallet) (interface{}, er.R) {
+	cmd := icmd.(*btcjson.SetAddressLabelCmd)
+
+	addr, err := decodeAddress(cmd.Address, w.ChainParams())
+	if err != nil {
+		return nil, err
+	}
+
+	err = w.SetAddressLabel(addr, cmd.Label)
+	if wallet.ErrAddrLabelTooLong.Is(err) || wallet.ErrAddrLabelInvalid.Is(err) {
+		return nil, btcjson.ErrRPCInvalidParameter.New("Invalid label", err)
+	}
+	return nil, err
+}
+
 // setTxFee sets the transaction fee per kilobyte added to transactions.
 func setTxFee(icmd interface{}, w *wallet.Wallet) (interface{}, er.R) {
 	cmd := icmd.(*btcjson.SetTxFeeCmd)
@@ -1497,7 +2522,7 @@ func setTxFee(icmd interface{}, w *wallet.Wallet) (interface{}, er.R) {
 }
 
 // signMessage signs the given message with the private key for the given
-// address
+// address. Segwit addresses are signed using the BIP137 header bytes.
 func signMessage(icmd interface{}, w *wallet.Wallet) (interface{}, er.R) {
 	cmd := icmd.(*btcjson.SignMessageCmd)
 
@@ -1506,22 +2531,73 @@ func signMessage(icmd interface{}, w *wallet.Wallet) (interface{}, er.R) {
 		return nil, err
 	}
 
-	privKey, err := w.PrivKeyForAddress(addr)
-	if err != nil {
+	_, sig, err := w.SignMessageWithAddress(addr, cmd.Message)
+	switch {
+	case waddrmgr.ErrLocked.Is(err):
+		return nil, btcjson.ErrRPCWalletUnlockNeeded.Default()
+	case wallet.ErrNoAddressKey.Is(err):
+		return nil, btcjson.ErrRPCInvalidAddressOrKey.New(
+			"Private key for address is not known", err)
+	case err != nil:
 		return nil, err
 	}
 
-	var buf bytes.Buffer
-	wire.WriteVarString(&buf, 0, "Bitcoin Signed Message:\n")
-	wire.WriteVarString(&buf, 0, cmd.Message)
-	messageHash := chainhash.DoubleHashB(buf.Bytes())
-	sigbytes, err := btcec.SignCompact(btcec.S256(), privKey,
-		messageHash, true)
+	return base64.StdEncoding.EncodeToString(sig), nil
+}
+
+// signWithAddress handles the signwithaddress command by signing either a
+// message or a single PSBT input using exclusively the private key of the
+// given wallet address. Unlike signrawtransaction, no other wallet keys are
+// used, which gives the caller deterministic single-key control.
+func signWithAddress(icmd interface{}, w *wallet.Wallet) (interface{}, er.R) {
+	cmd := icmd.(*btcjson.SignWithAddressCmd)
+
+	addr, err := decodeAddress(cmd.Address, w.ChainParams())
 	if err != nil {
 		return nil, err
 	}
 
-	return base64.StdEncoding.EncodeToString(sigbytes), nil
+	var (
+		result  btcjson.SignWithAddressResult
+		pubKey  []byte
+		sig     []byte
+		encoded string
+	)
+	if cmd.InputIndex == nil {
+		pubKey, sig, err = w.SignMessageWithAddress(addr, cmd.Data)
+		encoded = base64.StdEncoding.EncodeToString(sig)
+	} else {
+		packet, errr := psbt.NewFromRawBytes(
+			bytes.NewReader([]byte(cmd.Data)), true,
+		)
+		if errr != nil {
+			return nil, errDeserialization("PSBT decode failed", errr)
+		}
+
+		pubKey, sig, err = w.SignPsbtInputWithAddress(
+			packet, *cmd.InputIndex, addr,
+		)
+		if err == nil {
+			result.Psbt, err = packet.B64Encode()
+		}
+		encoded = hex.EncodeToString(sig)
+	}
+	switch {
+	case waddrmgr.ErrLocked.Is(err):
+		return nil, btcjson.ErrRPCWalletUnlockNeeded.Default()
+	case wallet.ErrNoAddressKey.Is(err):
+		return nil, btcjson.ErrRPCInvalidAddressOrKey.New(
+			"Private key for address is not known", err)
+	case wallet.ErrKeyNotInInput.Is(err):
+		return nil, btcjson.ErrRPCInvalidParameter.New(
+			"Input does not use the address key", err)
+	case err != nil:
+		return nil, err
+	}
+
+	result.PubKey = hex.EncodeToString(pubKey)
+	result.Signature = encoded
+	return result, nil
 }
 
 // signRawTransaction handles the signrawtransaction command.
@@ -1616,7 +2692,7 @@ func signRawTransaction(icmd interface{}, w *wallet.Wallet, chainClient chain.In
 			continue
 		}
 
-		rpc, ok := chainClient.(*chain.RPCClient)
+		rpc, ok := chain.ActiveBackEnd(chainClient).(*chain.RPCClient)
 		if !ok {
 			return nil, er.New("You must specify all transaction inputs explicitly, " +
 				"or use --userpc to load them from pktd.")
@@ -1803,33 +2879,12 @@ func verifyMessage(icmd interface{}, w *wallet.Wallet) (interface{}, er.R) {
 		return nil, er.E(errr)
 	}
 
-	// Validate the signature - this just shows that it was valid at all.
-	// we will compare it with the key next.
-	var buf bytes.Buffer
-	wire.WriteVarString(&buf, 0, "Bitcoin Signed Message:\n")
-	wire.WriteVarString(&buf, 0, cmd.Message)
-	expectedMessageHash := chainhash.DoubleHashB(buf.Bytes())
-	pk, wasCompressed, err := btcec.RecoverCompact(btcec.S256(), sig,
-		expectedMessageHash)
-	if err != nil {
-		return nil, err
-	}
-
-	var serializedPubKey []byte
-	if wasCompressed {
-		serializedPubKey = pk.SerializeCompressed()
-	} else {
-		serializedPubKey = pk.SerializeUncompressed()
-	}
-	// Verify that the signed-by address matches the given address
-	switch checkAddr := addr.(type) {
-	case *btcutil.AddressPubKeyHash: // ok
-		return bytes.Equal(btcutil.Hash160(serializedPubKey), checkAddr.Hash160()[:]), nil
-	case *btcutil.AddressPubKey: // ok
-		return string(serializedPubKey) == checkAddr.String(), nil
-	default:
-		return nil, er.New("address type not supported")
+	valid, err := wallet.VerifyMessage(addr, sig, cmd.Message)
+	if wallet.ErrUnsupportedMessageAddr.Is(err) {
+		return nil, btcjson.ErrRPCInvalidAddressOrKey.New(
+			"Address type not supported", err)
 	}
+	return valid, err
 }
 
 // walletIsLocked handles the walletislocked extension request by
@@ -0,0 +1,142 @@
+package legacyrpc
+
+import (
+	"testing"
+
+	"github.com/pkt-cash/pktd/btcjson"
+	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/chaincfg"
+	"github.com/pkt-cash/pktd/chaincfg/chainhash"
+	"github.com/pkt-cash/pktd/pktwallet/chain"
+)
+
+// TestWaitForSyncTimeout ensures that waitforsync refuses timeouts which are
+// negative or would let a request wait for longer than allowed.
+func TestWaitForSyncTimeout(t *testing.T) {
+	for _, timeout := range []int{-1, maxWaitForSyncTimeout + 1, 1 << 30} {
+		timeout := timeout
+		cmd := btcjson.NewWaitForSyncCmd(&timeout)
+		// The timeout is checked before the wallet is used.
+		_, err := waitForSync(cmd, nil)
+		if !btcjson.ErrRPCInvalidParameter.Is(err) {
+			t.Errorf("timeout %d: expected invalid parameter "+
+				"error, got %v", timeout, err)
+		}
+	}
+}
+
+// mockScanBlocksBackend is a scanblocks backend with a fixed best block which
+// records the range it is asked to scan.
+type mockScanBlocksBackend struct {
+	bestHeight int32
+	scanned    bool
+	start      int32
+	stop       int32
+}
+
+func (m *mockScanBlocksBackend) GetBestBlock() (*chainhash.Hash, int32, er.R) {
+	return &chainhash.Hash{}, m.bestHeight, nil
+}
+
+func (m *mockScanBlocksBackend) ScanBlocks(scripts [][]byte, startHeight,
+	stopHeight int32, fetchBlocks bool) ([]chain.ScanBlocksMatch, er.R) {
+
+	m.scanned = true
+	m.start, m.stop = startHeight, stopHeight
+	return nil, nil
+}
+
+// TestScanBlocksRange ensures that scanblocks refuses height ranges which are
+// negative, above the best block, reversed or too large, and that it fills
+// in the default heights of valid ones.
+func TestScanBlocksRange(t *testing.T) {
+	const bestHeight = 2 * maxScanBlocksRange
+
+	height := func(h int32) *int32 { return &h }
+
+	tests := []struct {
+		name     string
+		start    *int32
+		stop     *int32
+		valid    bool
+		expStart int32
+		expStop  int32
+	}{{
+		name:  "negative start",
+		start: height(-1),
+		stop:  height(10),
+	}, {
+		name:  "stop above best block",
+		start: height(bestHeight - 10),
+		stop:  height(bestHeight + 1),
+	}, {
+		name:  "start above stop",
+		start: height(11),
+		stop:  height(10),
+	}, {
+		name:  "too many blocks",
+		start: height(0),
+		stop:  height(maxScanBlocksRange),
+	}, {
+		name: "default start",
+		stop: height(maxScanBlocksRange),
+	}, {
+		name:     "maximum range",
+		start:    height(1),
+		stop:     height(maxScanBlocksRange),
+		valid:    true,
+		expStart: 1,
+		expStop:  maxScanBlocksRange,
+	}, {
+		name:     "default stop",
+		start:    height(bestHeight - 10),
+		valid:    true,
+		expStart: bestHeight - 10,
+		expStop:  bestHeight,
+	}, {
+		name:     "negative stop is the best block",
+		start:    height(bestHeight),
+		stop:     height(-1),
+		valid:    true,
+		expStart: bestHeight,
+		expStop:  bestHeight,
+	}}
+
+	// A hex encoded P2WPKH script, so that no address has to be decoded.
+	script := "0014" + "0102030405060708090a0b0c0d0e0f1011121314"
+	for _, test := range tests {
+		backend := &mockScanBlocksBackend{bestHeight: bestHeight}
+		cmd := btcjson.NewScanBlocksCmd(
+			[]string{script}, test.start, test.stop, nil,
+		)
+		res, err := scanBlocksWith(
+			cmd, &chaincfg.MainNetParams, backend,
+		)
+
+		if !test.valid {
+			if !btcjson.ErrRPCInvalidParameter.Is(err) {
+				t.Errorf("%s: expected invalid parameter "+
+					"error, got %v", test.name, err)
+			}
+			if backend.scanned {
+				t.Errorf("%s: blocks scanned", test.name)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("%s: unable to scan blocks: %v", test.name, err)
+			continue
+		}
+		if backend.start != test.expStart || backend.stop != test.expStop {
+			t.Errorf("%s: expected to scan %d-%d, scanned %d-%d",
+				test.name, test.expStart, test.expStop,
+				backend.start, backend.stop)
+		}
+		if res.FromHeight != test.expStart || res.ToHeight != test.expStop {
+			t.Errorf("%s: expected result range %d-%d, got %d-%d",
+				test.name, test.expStart, test.expStop,
+				res.FromHeight, res.ToHeight)
+		}
+	}
+}
@@ -0,0 +1,151 @@
+// Copyright (c) 2021 The pktd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package legacyrpc
+
+import (
+	jsoniter "github.com/json-iterator/go"
+
+	"github.com/pkt-cash/pktd/btcjson"
+)
+
+// permission is the level of access which is granted by a set of credentials
+// or which is required to call a method.
+type permission uint8
+
+const (
+	// readPermission permits calling methods which neither change the
+	// state of the wallet nor reveal any secrets.
+	readPermission permission = iota
+
+	// fullPermission permits calling every method.
+	fullPermission
+)
+
+// String returns a human readable name of the permission.
+func (p permission) String() string {
+	switch p {
+	case readPermission:
+		return "read"
+	case fullPermission:
+		return "full"
+	default:
+		return "unknown"
+	}
+}
+
+// rpcPermissions maps RPC methods to the permission they require. Methods
+// which aren't listed, including the ones which are passed through to the
+// chain server, require full permission.
+var rpcPermissions = map[string]permission{
+	// Reference implementation wallet methods
+	"addmultisigaddress":     fullPermission,
+	"createmultisig":         readPermission,
+	"createwallet":           fullPermission,
+	"dumpprivkey":            fullPermission,
+	"getaddressesbylabel":    readPermission,
+	"getbalance":             readPermission,
+	"getbalances":            readPermission,
+	"getbestblockhash":       readPermission,
+	"getblockcount":          readPermission,
+	"getinfo":                readPermission,
+	"getnewaddress":          fullPermission,
+	"getreceivedbyaddress":   readPermission,
+	"gettransaction":         readPermission,
+	"help":                   readPermission,
+	"importprivkey":          fullPermission,
+	"importwallet":           fullPermission,
+	"listlabels":             readPermission,
+	"listlockunspent":        readPermission,
+	"listreceivedbyaddress":  readPermission,
+	"listsinceblock":         readPermission,
+	"listtransactions":       readPermission,
+	"listunspent":            readPermission,
+	"loadwallet":             fullPermission,
+	"lockunspent":            fullPermission,
+	"sendfrom":               fullPermission,
+	"sendmany":               fullPermission,
+	"sendtoaddress":          fullPermission,
+	"settxfee":               fullPermission,
+	"signmessage":            fullPermission,
+	"signrawtransaction":     fullPermission,
+	"signwithaddress":        fullPermission,
+	"unloadwallet":           fullPermission,
+	"validateaddress":        readPermission,
+	"verifymessage":          readPermission,
+	"walletlock":             fullPermission,
+	"walletpassphrase":       fullPermission,
+	"walletpassphrasechange": fullPermission,
+
+	// Extensions to the reference client JSON-RPC API
+	"getbestblock":            readPermission,
+	"getblockchaininfo":       readPermission,
+	"waitforsync":             readPermission,
+	"getsyncprogress":         readPermission,
+	"scanblocks":              readPermission,
+	"setnetworkstewardvote":   fullPermission,
+	"getnetworkstewardvote":   readPermission,
+	"addp2shscript":           fullPermission,
+	"createtransaction":       fullPermission,
+	"consolidate":             fullPermission,
+	"exportutxos":             readPermission,
+	"listdescriptors":         readPermission,
+	"getfeehistory":           readPermission,
+	"abandontransaction":      fullPermission,
+	"getdescriptorinfo":       readPermission,
+	"resync":                  fullPermission,
+	"stopresync":              fullPermission,
+	"getaddressbalances":      readPermission,
+	"setaddresslabel":         fullPermission,
+	"getwalletseed":           fullPermission,
+	"verifywalletseed":        fullPermission,
+	"getsecret":               fullPermission,
+	"walletmempool":           readPermission,
+	"getunconfirmedbalance":   readPermission,
+	"listaddresstransactions": readPermission,
+	"listalltransactions":     readPermission,
+	"walletislocked":          readPermission,
+
+	// Methods which are handled by the server itself
+	"stop":               fullPermission,
+	"notifysyncprogress": readPermission,
+	"notifymempooltxs":   readPermission,
+}
+
+// allows returns whether a client with permission p may call the method.
+func (p permission) allows(method string) bool {
+	required, ok := rpcPermissions[method]
+	if !ok {
+		required = fullPermission
+	}
+	return p >= required
+}
+
+// privateParams maps methods which only require read permission unless they
+// are asked to reveal private keys to the position of the boolean parameter
+// asking for them.
+var privateParams = map[string]int{
+	"listdescriptors": 0,
+}
+
+// allowsRequest returns whether a client with permission p may make the
+// request. Unlike allows, it requires full permission for methods which are
+// asked to reveal private keys by their parameters.
+func (p permission) allowsRequest(req *btcjson.Request) bool {
+	if !p.allows(req.Method) {
+		return false
+	}
+	i, ok := privateParams[req.Method]
+	if !ok || p >= fullPermission || len(req.Params) <= i {
+		return true
+	}
+
+	// Treat a parameter which isn't a boolean as asking for private keys,
+	// the method rejects it anyway.
+	var private *bool
+	if err := jsoniter.Unmarshal(req.Params[i], &private); err != nil {
+		return false
+	}
+	return private == nil || !*private
+}
@@ -6,11 +6,23 @@ package legacyrpc
 
 import (
 	"fmt"
+	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
+	"os"
 	"reflect"
+	"strings"
 	"sync/atomic"
 	"testing"
+	"time"
+
+	jsoniter "github.com/json-iterator/go"
+
+	"github.com/pkt-cash/pktd/btcjson"
+	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/chaincfg"
+	"github.com/pkt-cash/pktd/pktwallet/wallet"
+	"github.com/pkt-cash/pktd/pktwallet/wallet/seedwords"
 )
 
 func TestThrottle(t *testing.T) {
@@ -54,3 +66,224 @@ func TestThrottle(t *testing.T) {
 		t.Fail()
 	}
 }
+
+// TestRPCPermissions ensures that every method has an explicit permission and
+// that read-only clients can't change the state of the wallet.
+func TestRPCPermissions(t *testing.T) {
+	for method := range rpcHandlers {
+		if _, ok := rpcPermissions[method]; !ok {
+			t.Errorf("method %s has no permission", method)
+		}
+	}
+
+	for _, method := range []string{
+		"sendtoaddress", "walletpassphrase", "importprivkey",
+		"dumpprivkey", "stop", "getrawtransaction",
+	} {
+		if readPermission.allows(method) {
+			t.Errorf("read-only user is allowed to call %s", method)
+		}
+		if !fullPermission.allows(method) {
+			t.Errorf("full user is not allowed to call %s", method)
+		}
+	}
+
+	for _, method := range []string{"getbalance", "listunspent"} {
+		if !readPermission.allows(method) {
+			t.Errorf("read-only user is not allowed to call %s",
+				method)
+		}
+	}
+}
+
+// TestRPCPermissionsPrivateParams ensures that read-only clients can list the
+// public descriptors of the wallet but not the private ones.
+func TestRPCPermissionsPrivateParams(t *testing.T) {
+	tests := []struct {
+		params string
+		read   bool
+	}{
+		{params: `[]`, read: true},
+		{params: `[null]`, read: true},
+		{params: `[false]`, read: true},
+		{params: `[false,true]`, read: true},
+		{params: `[true]`, read: false},
+		{params: `["true"]`, read: false},
+	}
+	for _, test := range tests {
+		var req btcjson.Request
+		body := `{"jsonrpc":"1.0","method":"listdescriptors","params":` +
+			test.params + `,"id":1}`
+		if err := jsoniter.Unmarshal([]byte(body), &req); err != nil {
+			t.Fatalf("unable to unmarshal request: %v", err)
+		}
+		if readPermission.allowsRequest(&req) != test.read {
+			t.Errorf("params %s: expected read-only user allowed: "+
+				"%v", test.params, test.read)
+		}
+		if !fullPermission.allowsRequest(&req) {
+			t.Errorf("params %s: full user is not allowed",
+				test.params)
+		}
+	}
+}
+
+// TestCheckAuthHeader ensures that both the full access and the read-only
+// credentials are accepted with their respective permission.
+func TestCheckAuthHeader(t *testing.T) {
+	server := NewServer(&Options{
+		Username:      "user",
+		Password:      "pass",
+		LimitUsername: "limituser",
+		LimitPassword: "limitpass",
+	}, nil, nil)
+
+	tests := []struct {
+		username string
+		password string
+		perm     permission
+		valid    bool
+	}{
+		{"user", "pass", fullPermission, true},
+		{"limituser", "limitpass", readPermission, true},
+		{"limituser", "pass", readPermission, false},
+		{"", "", readPermission, false},
+	}
+	for _, test := range tests {
+		r := httptest.NewRequest("POST", "/", nil)
+		r.SetBasicAuth(test.username, test.password)
+
+		perm, err := server.checkAuthHeader(r)
+		if (err == nil) != test.valid {
+			t.Fatalf("%s: unexpected auth error: %v", test.username,
+				err)
+		}
+		if err == nil && perm != test.perm {
+			t.Fatalf("%s: expected %v permission, got %v",
+				test.username, test.perm, perm)
+		}
+	}
+
+	// Without read-only credentials, an empty login must not grant access.
+	server = NewServer(&Options{Username: "user", Password: "pass"}, nil, nil)
+	r := httptest.NewRequest("POST", "/", nil)
+	r.SetBasicAuth("", "")
+	if _, err := server.checkAuthHeader(r); err == nil {
+		t.Fatal("empty credentials accepted")
+	}
+}
+
+// TestUnauthorizedMethod ensures that read-only clients which call a method
+// they are not permitted to call get a distinct error code.
+func TestUnauthorizedMethod(t *testing.T) {
+	server := NewServer(&Options{}, nil, nil)
+	body := `{"jsonrpc":"1.0","id":1,"method":"walletpassphrase","params":["pass",60]}`
+	r := httptest.NewRequest("POST", "/", strings.NewReader(body))
+	w := httptest.NewRecorder()
+	server.postClientRPC(w, r, readPermission)
+
+	var resp btcjson.Response
+	if errr := jsoniter.Unmarshal(w.Body.Bytes(), &resp); errr != nil {
+		t.Fatalf("unable to decode response: %v", errr)
+	}
+	if resp.Error == nil {
+		t.Fatal("read-only user allowed to call walletpassphrase")
+	}
+	if resp.Error.Code != btcjson.ErrRPCForbidden.Number {
+		t.Fatalf("expected error code %d, got %d",
+			btcjson.ErrRPCForbidden.Number, resp.Error.Code)
+	}
+}
+
+// TestUnloadWalletInFlight ensures that the wallet can be unloaded while a
+// waitforsync request waits on it, that requests fail while no wallet is
+// loaded and that the wallet loaded again serves the following requests.
+func TestUnloadWalletInFlight(t *testing.T) {
+	dir, errr := ioutil.TempDir("", "test_legacyrpc_unload")
+	if errr != nil {
+		t.Fatalf("Failed to create db dir: %v", errr)
+	}
+	defer os.RemoveAll(dir)
+
+	seed, err := seedwords.RandomSeed()
+	if err != nil {
+		t.Fatalf("unable to create seed: %v", err)
+	}
+	loader := wallet.NewLoader(
+		&chaincfg.TestNet3Params, dir, "wallet.db", true, 250,
+	)
+	_, err = loader.CreateNewWallet(
+		[]byte(wallet.InsecurePubPassphrase), []byte("world"), nil,
+		time.Time{}, seed,
+	)
+	if err != nil {
+		t.Fatalf("unable to create wallet: %v", err)
+	}
+	defer loader.UnloadWallet()
+
+	server := NewServer(&Options{}, loader, nil)
+	call := func(method string, params ...interface{}) (interface{}, er.R) {
+		req, err := btcjson.NewRequest(1, method, params)
+		if err != nil {
+			t.Fatalf("unable to create %s request: %v", method, err)
+		}
+		return server.handlerClosure(req)()
+	}
+
+	// Without a chain client the wallet never syncs, so the request waits
+	// until it times out or the wallet is stopped.
+	type reply struct {
+		result interface{}
+		err    er.R
+	}
+	waiting := make(chan reply, 1)
+	go func() {
+		result, err := call("waitforsync", maxWaitForSyncTimeout)
+		waiting <- reply{result, err}
+	}()
+	time.Sleep(100 * time.Millisecond)
+	select {
+	case r := <-waiting:
+		t.Fatalf("waitforsync returned early: %v", r.err)
+	default:
+	}
+
+	unloaded := make(chan er.R, 1)
+	go func() {
+		_, err := call("unloadwallet")
+		unloaded <- err
+	}()
+	select {
+	case err := <-unloaded:
+		if err != nil {
+			t.Fatalf("unable to unload wallet: %v", err)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("unloadwallet is blocked by waitforsync")
+	}
+	select {
+	case r := <-waiting:
+		if r.err != nil {
+			t.Fatalf("waitforsync failed: %v", r.err)
+		}
+		if r.result.(*btcjson.WaitForSyncResult).Synced {
+			t.Fatal("expected waitforsync to report no sync")
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("waitforsync didn't return after the unload")
+	}
+
+	// Requests fail until a wallet is loaded again.
+	if _, err := call("getbestblock"); err == nil {
+		t.Fatal("getbestblock succeeded without a wallet")
+	}
+	if _, err := call("unloadwallet"); !btcjson.ErrRPCWallet.Is(err) {
+		t.Fatalf("expected a wallet error, got %v", err)
+	}
+	if _, err := call("loadwallet", "wallet.db"); err != nil {
+		t.Fatalf("unable to load wallet: %v", err)
+	}
+	if _, err := call("getbestblock"); err != nil {
+		t.Fatalf("getbestblock failed after the load: %v", err)
+	}
+}
@@ -6,48 +6,70 @@ func helpDescsEnUS() map[string]string {
 	return map[string]string{
 		"addmultisigaddress":      "addmultisigaddress nrequired [\"key\",...]\n\nGenerates and imports a multisig address and redeeming script to the 'imported' account.\n\nArguments:\n1. nrequired (numeric, required)         The number of signatures required to redeem outputs paid to this address\n2. keys      (array of string, required) Pubkeys and/or pay-to-pubkey-hash addresses to partially control the multisig address\n\nResult:\n\"value\" (string) The imported pay-to-script-hash address\n",
 		"createmultisig":          "createmultisig nrequired [\"key\",...]\n\nGenerate a multisig address and redeem script.\n\nArguments:\n1. nrequired (numeric, required)         The number of signatures required to redeem outputs paid to this address\n2. keys      (array of string, required) Pubkeys and/or pay-to-pubkey-hash addresses to partially control the multisig address\n\nResult:\n{\n \"address\": \"value\",      (string) The generated pay-to-script-hash address\n \"redeemScript\": \"value\", (string) The script required to redeem outputs paid to the multisig address\n}                         \n",
-		"createtransaction":       "createtransaction \"toaddress\" amount ([\"fromaddress\",...] electrumformat \"changeaddress\" inputminheight minconf=1 vote maxinputs \"autolock\")\n\nCreate a transaction but do not send it to the chain\n\nArguments:\n1.  toaddress      (string, required)             The recipient to send the coins to\n2.  amount         (numeric, required)            The amount of coins to send\n3.  fromaddresses  (array of string, optional)    Addresses to use for selecting coins to spend\n4.  electrumformat (boolean, optional)            If true, then the transaction result will be output in electrum incomplete transaction format, useful for signing later\n5.  changeaddress  (string, optional)             Return extra coins to this address, if unspecified then one will be created\n6.  inputminheight (numeric, optional)            The minimum block height to take inputs from (default: 0)\n7.  minconf        (numeric, optional, default=1) Do not spend any outputs which don't have at least this number of confirmations (default 1)\n8.  vote           (boolean, optional)            True if you wish for this transaction to contain a network steward vote\n9.  maxinputs      (numeric, optional)            Maximum number of transaction inputs that are allowed\n10. autolock       (string, optional)             If specified, all txouts spent for this transaction will be locked under this name\n\nResult:\n\"value\" (string) The hex encoded transaction result\n",
+		"createtransaction":       "createtransaction \"toaddress\" amount ([\"fromaddress\",...] electrumformat \"changeaddress\" inputminheight minconf=1 vote maxinputs \"autolock\" locktime sequence)\n\nCreate a transaction but do not send it to the chain\n\nArguments:\n1.  toaddress      (string, required)             The recipient to send the coins to\n2.  amount         (numeric, required)            The amount of coins to send\n3.  fromaddresses  (array of string, optional)    Addresses to use for selecting coins to spend\n4.  electrumformat (boolean, optional)            If true, then the transaction result will be output in electrum incomplete transaction format, useful for signing later\n5.  changeaddress  (string, optional)             Return extra coins to this address, if unspecified then one will be created\n6.  inputminheight (numeric, optional)            The minimum block height to take inputs from (default: 0)\n7.  minconf        (numeric, optional, default=1) Do not spend any outputs which don't have at least this number of confirmations (default 1)\n8.  vote           (boolean, optional)            True if you wish for this transaction to contain a network steward vote\n9.  maxinputs      (numeric, optional)            Maximum number of transaction inputs that are allowed\n10. autolock       (string, optional)             If specified, all txouts spent for this transaction will be locked under this name\n11. locktime       (numeric, optional)            Locktime of the transaction, a block height or unix timestamp which may be in the future; a non-zero value also locktime-activates the inputs unless sequence is given\n12. sequence       (numeric, optional)            Sequence number of all inputs, below 4294967294 signals replaceability (BIP0125) (default: the txsequence option)\n\nResult:\n\"value\" (string) The hex encoded transaction result\n",
+		"consolidate":             "consolidate threshold (maxinputs feerate minconf=1 dryrun=false)\n\nMerges the wallet outputs below the threshold into a single output paying back to the wallet, smallest outputs first. Locked outputs are never consolidated. The consolidation is skipped if fewer than two outputs qualify or if the fee would exceed the value consolidated.\n\nArguments:\n1. threshold (numeric, required)                Outputs worth less than this amount are consolidated\n2. maxinputs (numeric, optional)                Maximum number of outputs to consolidate, by default as many as fit in a transaction\n3. feerate   (numeric, optional)                The fee rate in coins per kilobyte, by default the rate estimated by the chain backend\n4. minconf   (numeric, optional, default=1)     Do not consolidate outputs which don't have at least this number of confirmations\n5. dryrun    (boolean, optional, default=false) If true, report what would be consolidated without sending the transaction\n\nResult:\n{\n \"txid\": \"value\",       (string)  The hash of the consolidation transaction, omitted if the consolidation was skipped\n \"inputs\": n,           (numeric) The number of outputs consolidated\n \"amount\": n.nnn,       (numeric) The total value of the outputs consolidated\n \"fee\": n.nnn,          (numeric) The fee paid by the consolidation transaction\n \"skipped\": true|false, (boolean) Whether the consolidation was skipped because there was nothing worth consolidating\n}                       \n",
+		"exportutxos":             "exportutxos (count=1000 \"after\")\n\nExports a consistent snapshot of the unspent outputs of the wallet, including locked and frozen ones, ordered by outpoint. Large sets are exported in pages: pass the next value of the result as after to get the following page, the pages of one export are only consistent with each other if the wallet didn't change in between.\n\nArguments:\n1. count (numeric, optional, default=1000) Maximum number of outputs to return, 0 to only return the totals\n2. after (string, optional)                Only return the outputs after this outpoint, in the form txid:vout\n\nResult:\n{\n \"height\": n,                (numeric)         The height of the block the wallet was synced to when the snapshot was taken\n \"blockhash\": \"value\",       (string)          The hash of the block the wallet was synced to when the snapshot was taken\n \"count\": n,                 (numeric)         The number of unspent outputs in the whole snapshot\n \"amount\": n.nnn,            (numeric)         The total value of the unspent outputs in the whole snapshot\n \"utxos\": [{                 (array of object) The unspent outputs of this page\n  \"txid\": \"value\",           (string)          The transaction hash of the output\n  \"vout\": n,                 (numeric)         The output index of the output\n  \"address\": \"value\",        (string)          The address the output pays to, omitted if it doesn't pay to a single address\n  \"account\": \"value\",        (string)          The account of the address\n  \"scriptPubKey\": \"value\",   (string)          The output script encoded as hex\n  \"amount\": n.nnn,           (numeric)         The value of the output\n  \"confirmations\": n,        (numeric)         The number of block confirmations of the output, 0 if it is unconfirmed\n  \"height\": n,               (numeric)         The height of the block containing the output, -1 if it is unconfirmed\n  \"coinbase\": true|false,    (boolean)         Whether the output is from a coinbase transaction\n  \"spendable\": true|false,   (boolean)         Whether the output can be spent, false for immature or burned coinbase outputs\n  \"derivationpath\": \"value\", (string)          The BIP32 derivation path of the key of the address, omitted for imported keys\n  \"locked\": true|false,      (boolean)         Whether the output is locked with lockunspent\n  \"lockname\": \"value\",       (string)          The name of the lock, omitted if the output is not locked\n  \"frozen\": true|false,      (boolean)         Whether the output is frozen by a lease and can't be spent until the lease expires\n  \"frozenuntil\": n,          (numeric)         The time in seconds since 1 Jan 1970 GMT the lease expires, omitted if the output is not frozen\n },...],                                       \n \"next\": \"value\",            (string)          The after value to get the next page, omitted if this is the last page\n}                            \n",
+		"listdescriptors":         "listdescriptors (private=false checksum=true)\n\nLists the output descriptors of the external and internal addresses of every account, to watch or restore the wallet with descriptor aware software. Private descriptors hold the extended private keys of the accounts, they require the wallet to be unlocked and full access credentials.\n\nArguments:\n1. private  (boolean, optional, default=false) Export the extended private keys instead of the extended public keys\n2. checksum (boolean, optional, default=true)  Append the checksum to the descriptors\n\nResult:\n{\n \"descriptors\": [{        (array of object) The output descriptors\n  \"desc\": \"value\",        (string)          The output descriptor\n  \"account\": \"value\",     (string)          The name of the account\n  \"accountnumber\": n,     (numeric)         The number of the account\n  \"internal\": true|false, (boolean)         Whether the descriptor describes the change addresses of the account\n  \"next\": n,              (numeric)         The index of the next address which will be derived from the descriptor\n },...],                                    \n}                         \n",
+		"getfeehistory":           "getfeehistory (count=10)\n\nReturns the fee rates paid by the most recent transactions broadcast by the wallet and how many blocks it took for them to confirm, newest first. Only transactions which spend nothing but the wallet's own outputs are tracked, because the fee of other transactions is not known.\n\nArguments:\n1. count (numeric, optional, default=10) The maximum number of transactions to return\n\nResult:\n[{\n \"txid\": \"value\",         (string)  The hash of the transaction\n \"feerate\": n.nnn,        (numeric) The fee rate paid by the transaction in BTC per kilobyte of virtual size\n \"time\": n,               (numeric) The time in seconds since 1 Jan 1970 GMT the transaction was broadcast\n \"confirmed\": true|false, (boolean) Whether the transaction has been mined\n \"confirmationdelay\": n,  (numeric) The number of blocks between the broadcast and the confirmation of the transaction, omitted if it is unconfirmed\n \"age\": n,                (numeric) The number of seconds since an unconfirmed transaction was broadcast, omitted if it is confirmed\n},...]\n",
+		"abandontransaction":      "abandontransaction \"txid\"\n\nAbandons an unconfirmed transaction which is stuck, so the outputs it spends can be spent by another transaction. The transaction is removed from the wallet together with any unconfirmed transactions spending its outputs, and locks on the outputs it spends are released. Confirmed and already abandoned transactions can't be abandoned. The transaction is no longer abandoned if it is seen again in the mempool or in a block.\n\nArguments:\n1. txid (string, required) The hash of the transaction to abandon\n\nResult:\nNothing\n",
+		"getdescriptorinfo":       "getdescriptorinfo \"descriptor\"\n\nAnalyzes an output descriptor, which does not need to belong to the wallet, and returns it in canonical form with its checksum. If the descriptor has a checksum it is verified. Private keys are replaced by their public keys in the canonical form.\n\nArguments:\n1. descriptor (string, required) The output descriptor, optionally followed by its checksum\n\nResult:\n{\n \"descriptor\": \"value\",        (string)  The descriptor in canonical form followed by its checksum\n \"checksum\": \"value\",          (string)  The checksum of the descriptor as it was given\n \"isrange\": true|false,        (boolean) Whether the descriptor describes a range of scripts\n \"issolvable\": true|false,     (boolean) Whether the scripts of the descriptor can be signed for given the private keys, false for addr and raw descriptors\n \"hasprivatekeys\": true|false, (boolean) Whether the descriptor holds at least one private key\n}                              \n",
+		"createwallet":            "createwallet \"walletname\" \"passphrase\" (\"publicpassphrase\" \"seed\" \"seedpassphrase\" watchonly=false load=false)\n\nCreate a new wallet in the wallet directory, next to the loaded wallet.\nAn existing wallet of the same name is never overwritten.\n\nArguments:\n1. walletname       (string, required)                 The name of the new wallet, which is stored in the file walletname if it ends in .db and in wallet_<walletname>.db otherwise\n2. passphrase       (string, required)                 The private passphrase used to encrypt the keys of the new wallet\n3. publicpassphrase (string, optional)                 The passphrase used to encrypt the public data of the new wallet, if unset the default public passphrase is used\n4. seed             (string, optional)                 Seed words or a hex encoded legacy seed to restore the wallet from, if unset a new seed is generated\n5. seedpassphrase   (string, optional)                 The passphrase of the seed words, if they are encrypted\n6. watchonly        (boolean, optional, default=false) Remove all private keys from the new wallet so that it can only watch addresses\n7. load             (boolean, optional, default=false) Load the new wallet, this is only possible if no wallet is loaded yet\n\nResult:\n{\n \"name\": \"value\",        (string)  The name of the new wallet\n \"fingerprint\": \"value\", (string)  The hex encoded BIP32 fingerprint of the wallet's master key, which identifies the wallet\n \"seed\": \"value\",        (string)  The seed words of the new wallet, only set if the seed was generated\n \"loaded\": true|false,   (boolean) Whether the new wallet has been loaded\n \"warning\": \"value\",     (string)  Why the new wallet was not loaded although load was requested, the wallet exists nonetheless\n}                        \n",
 		"getaddressbalances":      "getaddressbalances (minconf=1 showzerobalance)\n\nGet balances for each address\n\nArguments:\n1. minconf         (numeric, optional, default=1) Minimum number of confirmations for coins to be considered received\n2. showzerobalance (boolean, optional)            If true then addresses which have been created but carry zero balance will be included\n\nResult:\n[{\n \"address\": \"value\",         (string)  The address which has this balance\n \"total\": n.nnn,             (numeric) Total balance\n \"stotal\": \"value\",          (string)  Total balance (atomic units as base 10 string)\n \"spendable\": n.nnn,         (numeric) Balance which is currently spendable\n \"sspendable\": \"value\",      (string)  Balance which is currently spendable (atomic units as base 10 string)\n \"immaturereward\": n.nnn,    (numeric) Mined coins which have not yet matured\n \"simmaturereward\": \"value\", (string)  Mined coins which have not yet matured (atomic units as base 10 string)\n \"unconfirmed\": n.nnn,       (numeric) Unconfirmed balance\n \"sunconfirmed\": \"value\",    (string)  Unconfirmed balance (atomic units as base 10 string)\n \"outputcount\": n,           (numeric) The number of transaction outputs which make up the balance\n},...]\n",
+		"getaddressesbylabel":     "getaddressesbylabel \"label\"\n\nReturns the addresses in the wallet's address book which have the given label.\n\nArguments:\n1. label (string, required) The label to look up\n\nResult:\n{\n \"The labeled address\": Object with the \"purpose\" of the address: \"receive\" if it belongs to the wallet, \"send\" otherwise, (object) JSON object using the labeled addresses as keys\n ...\n}\n",
 		"setnetworkstewardvote":   "setnetworkstewardvote (\"votefor\" \"voteagainst\")\n\nConfigure the wallet to vote for a network steward when making payments (note: payments to segwit addresses cannot vote)\n\nArguments:\n1. votefor     (string, optional) The address to vote for (in the event of an election, this is the address who should win)\n2. voteagainst (string, optional) The address to vote against (if this is the current NS then this will cause a vote for an election)\n\nResult:\n{\n} \n",
 		"getnetworkstewardvote":   "getnetworkstewardvote\n\nFind out how the wallet is currently configured to vote in a network steward election\n\nArguments:\nNone\n\nResult:\n{\n \"votefor\": \"value\",     (string) The address which your wallet is currently voting for\n \"voteagainst\": \"value\", (string) The address which your wallet is currently voting against\n}                        \n",
 		"resync":                  "resync (fromheight toheight [\"address\",...] dropdb)\n\nRe-synchronize the wallet to the chain, scan from the first block to find any missing coins\n\nArguments:\n1. fromheight (numeric, optional)         Start re-syncing to the chain from specified height, default or -1 will use the height of the chain when the wallet was created\n2. toheight   (numeric, optional)         Stop resyncing when this height is reached, default or -1 will use the tip of the chain\n3. addresses  (array of string, optional) If specified, the wallet will ONLY scan the chain for these addresses, not others. If dropdb is specified then it will scan all addresses including these\n4. dropdb     (boolean, optional)         Clean most of the data out of the wallet transaction store, this is not a real resync, it just drops the wallet and then lets it begin working again\n\nResult:\nNothing\n",
 		"stopresync":              "stopresync\n\nStop a re-synchronization job before it's completion\n\nArguments:\nNone\n\nResult:\n\"value\" (string) The name of the sync job which was stopped\n",
 		"addp2shscript":           "addp2shscript \"script\" segwit\n\nImport a p2sh script in order to be able to watch a multisig wallet\n\nArguments:\n1. script (string, required)  The redeem script to import\n2. segwit (boolean, required) If true then this will create a segwit address\n\nResult:\n\"value\" (string) The address corresponding to this script\n",
-		"dumpprivkey":             "dumpprivkey \"address\"\n\nReturns the private key in WIF encoding that controls some wallet address.\n\nArguments:\n1. address (string, required) The address to return a private key for\n\nResult:\n\"value\" (string) The WIF-encoded private key\n",
+		"dumpprivkey":             "dumpprivkey \"address\"\n\nReturns the private key in WIF encoding that controls some wallet address. The wallet must be unlocked.\n\nArguments:\n1. address (string, required) The address to return a private key for\n\nResult:\n\"value\" (string) The WIF-encoded private key\n",
 		"getbalance":              "getbalance (minconf=1)\n\nCalculates and returns the balance of one or all accounts.\n\nArguments:\n1. minconf (numeric, optional, default=1) Minimum number of block confirmations required before an unspent output's value is included in the balance\n\nResult (account != \"*\"):\nn.nnn (numeric) The balance of 'account' valued in bitcoin\n\nResult (account = \"*\"):\nn.nnn (numeric) The balance of all accounts valued in bitcoin\n",
+		"getbalances":             "getbalances (minconf=1 maturewithin)\n\nCalculates and returns the total, spendable, immature, unconfirmed and final balance of the wallet.\n\nArguments:\n1. minconf      (numeric, optional, default=1) Minimum number of block confirmations required before an unspent output's value is considered spendable\n2. maturewithin (numeric, optional)            If set, break the immature balance down by the number of blocks until it matures, including only the coins which mature within this number of blocks\n\nResult:\n{\n \"total\": n.nnn,             (numeric)         Total balance\n \"stotal\": \"value\",          (string)          Total balance (atomic units as base 10 string)\n \"spendable\": n.nnn,         (numeric)         Balance which is currently spendable\n \"sspendable\": \"value\",      (string)          Balance which is currently spendable (atomic units as base 10 string)\n \"immaturereward\": n.nnn,    (numeric)         Mined coins which have not yet matured\n \"simmaturereward\": \"value\", (string)          Mined coins which have not yet matured (atomic units as base 10 string)\n \"unconfirmed\": n.nnn,       (numeric)         Balance which does not yet have minconf confirmations\n \"sunconfirmed\": \"value\",    (string)          Balance which does not yet have minconf confirmations (atomic units as base 10 string)\n \"final\": n.nnn,             (numeric)         Balance which has at least reorgsafetydepth confirmations and is not expected to be reversed by a chain reorganization\n \"sfinal\": \"value\",          (string)          Balance which has at least reorgsafetydepth confirmations and is not expected to be reversed by a chain reorganization (atomic units as base 10 string)\n \"outputcount\": n,           (numeric)         The number of transaction outputs which make up the balance\n \"immaturerewarddetail\": [{  (array of object) The mined coins which have not yet matured grouped by the number of blocks until they mature, soonest first, only present if maturewithin is set\n  \"blockstomaturity\": n,     (numeric)         The number of blocks which must be mined before the coins can be spent\n  \"amount\": n.nnn,           (numeric)         The value of the coins which mature after this number of blocks\n  \"samount\": \"value\",        (string)          The value of the coins which mature after this number of blocks (atomic units as base 10 string)\n  \"outputcount\": n,          (numeric)         The number of transaction outputs which mature after this number of blocks\n },...],                                       \n}                            \n",
 		"getbestblockhash":        "getbestblockhash\n\nReturns the hash of the newest block in the best chain that wallet has finished syncing with.\n\nArguments:\nNone\n\nResult:\n\"value\" (string) The hash of the most recent synced-to block\n",
 		"getblockcount":           "getblockcount\n\nReturns the blockchain height of the newest block in the best chain that wallet has finished syncing with.\n\nArguments:\nNone\n\nResult:\nn.nnn (numeric) The blockchain height of the most recent synced-to block\n",
 		"getinfo":                 "getinfo\n\nReturns a JSON object containing various state info.\n\nArguments:\nNone\n\nResult:\n{\n \"version\": n,          (numeric) The version of the server\n \"protocolversion\": n,  (numeric) The latest supported protocol version\n \"walletversion\": n,    (numeric) The version of the address manager database\n \"balance\": n.nnn,      (numeric) The balance of all accounts calculated with one block confirmation\n \"blocks\": n,           (numeric) The number of blocks processed\n \"timeoffset\": n,       (numeric) The time offset\n \"connections\": n,      (numeric) The number of connected peers\n \"difficulty\": n.nnn,   (numeric) The current target difficulty\n \"testnet\": true|false, (boolean) Whether or not server is using testnet\n \"keypoololdest\": n,    (numeric) Unset\n \"keypoolsize\": n,      (numeric) Unset\n \"unlocked_until\": n,   (numeric) Unset\n \"paytxfee\": n.nnn,     (numeric) The increment used each time more fee is required for an authored transaction\n \"relayfee\": n.nnn,     (numeric) The minimum relay fee for non-free transactions in BTC/KB\n \"errors\": \"value\",     (string)  Any current errors\n}                       \n",
 		"getnewaddress":           "getnewaddress (legacy)\n\nGenerates and returns a new payment address.\n\nArguments:\n1. legacy (boolean, optional) If true then this will create a legacy form address rather than a new segwit address\n\nResult:\n\"value\" (string) The payment address\n",
 		"getreceivedbyaddress":    "getreceivedbyaddress \"address\" (minconf=1)\n\nReturns the total amount received by a single address, including spent outputs.\n\nArguments:\n1. address (string, required)             Payment address which received outputs to include in total\n2. minconf (numeric, optional, default=1) Minimum number of block confirmations required before an output's value is included in the total\n\nResult:\nn.nnn (numeric) The total received amount valued in bitcoin\n",
-		"gettransaction":          "gettransaction \"txid\" (includewatchonly=false)\n\nReturns a JSON object with details regarding a transaction relevant to this wallet.\n\nArguments:\n1. txid             (string, required)                 Hash of the transaction to query\n2. includewatchonly (boolean, optional, default=false) Also consider transactions involving watched addresses\n\nResult:\n{\n \"amount\": n.nnn,                  (numeric)         The total amount this transaction credits to the wallet, valued in bitcoin\n \"fee\": n.nnn,                     (numeric)         The total input value minus the total output value, or 0 if 'txid' is not a sent transaction\n \"confirmations\": n,               (numeric)         The number of block confirmations of the transaction\n \"blockhash\": \"value\",             (string)          The hash of the block this transaction is mined in, or the empty string if unmined\n \"blockindex\": n,                  (numeric)         Unset\n \"blocktime\": n,                   (numeric)         The Unix time of the block header this transaction is mined in, or 0 if unmined\n \"txid\": \"value\",                  (string)          The transaction hash\n \"walletconflicts\": [\"value\",...], (array of string) Unset\n \"time\": n,                        (numeric)         The earliest Unix time this transaction was known to exist\n \"timereceived\": n,                (numeric)         The earliest Unix time this transaction was known to exist\n \"details\": [{                     (array of object) Additional details for each recorded wallet credit and debit\n  \"account\": \"value\",              (string)          DEPRECATED -- Unset\n  \"address\": \"value\",              (string)          The address an output was paid to, or the empty string if the output is nonstandard or this detail is regarding a transaction input\n  \"amount\": n.nnn,                 (numeric)         The amount of a received output\n  \"category\": \"value\",             (string)          The kind of detail: \"send\" for sent transactions, \"immature\" for immature coinbase outputs, \"generate\" for mature coinbase outputs, or \"recv\" for all other received outputs\n  \"involveswatchonly\": true|false, (boolean)         Unset\n  \"fee\": n.nnn,                    (numeric)         The included fee for a sent transaction\n  \"vout\": n,                       (numeric)         The transaction output index\n },...],                                             \n \"hex\": \"value\",                   (string)          The transaction encoded as a hexadecimal string\n}                                  \n",
+		"gettransaction":          "gettransaction \"txid\" (includewatchonly=false)\n\nReturns a JSON object with details regarding a transaction relevant to this wallet.\n\nArguments:\n1. txid             (string, required)                 Hash of the transaction to query\n2. includewatchonly (boolean, optional, default=false) Also consider transactions involving watched addresses\n\nResult:\n{\n \"amount\": n.nnn,                  (numeric)         The total amount this transaction credits to the wallet, valued in bitcoin\n \"fee\": n.nnn,                     (numeric)         The total input value minus the total output value, or 0 if 'txid' is not a sent transaction\n \"net\": n.nnn,                     (numeric)         The total value of all wallet credits, change included, minus the total value of all wallet debits\n \"confirmations\": n,               (numeric)         The number of block confirmations of the transaction\n \"blockhash\": \"value\",             (string)          The hash of the block this transaction is mined in, or the empty string if unmined\n \"blockheight\": n,                 (numeric)         The height of the block this transaction is mined in, or 0 if unmined\n \"blockindex\": n,                  (numeric)         Unset\n \"blocktime\": n,                   (numeric)         The Unix time of the block header this transaction is mined in, or 0 if unmined\n \"txid\": \"value\",                  (string)          The transaction hash\n \"walletconflicts\": [\"value\",...], (array of string) Unset\n \"time\": n,                        (numeric)         The earliest Unix time this transaction was known to exist\n \"timereceived\": n,                (numeric)         The earliest Unix time this transaction was known to exist\n \"details\": [{                     (array of object) Additional details for each recorded wallet credit and debit\n  \"account\": \"value\",              (string)          DEPRECATED -- Unset\n  \"address\": \"value\",              (string)          The address an output was paid to, or the empty string if the output is nonstandard or this detail is regarding a transaction input\n  \"amount\": n.nnn,                 (numeric)         The amount of a received output\n  \"category\": \"value\",             (string)          The kind of detail: \"send\" for sent transactions, \"immature\" for immature coinbase outputs, \"generate\" for mature coinbase outputs, or \"recv\" for all other received outputs\n  \"involveswatchonly\": true|false, (boolean)         Unset\n  \"fee\": n.nnn,                    (numeric)         The included fee for a sent transaction\n  \"vout\": n,                       (numeric)         The transaction output index\n },...],                                             \n \"outputs\": [{                     (array of object) Every output of the transaction and who it pays\n  \"vout\": n,                       (numeric)         The transaction output index\n  \"amount\": n.nnn,                 (numeric)         The value of the output, valued in bitcoin\n  \"address\": \"value\",              (string)          The address the output pays, or the empty string if the output is nonstandard\n  \"category\": \"value\",             (string)          Who the output pays: \"received\" for our receiving addresses, \"change\" for our change addresses, or \"external\" for all other outputs\n },...],                                             \n \"hex\": \"value\",                   (string)          The transaction encoded as a hexadecimal string\n}                                  \n",
 		"getwalletseed":           "getwalletseed\n\nGet the wallet seed words for this wallet\n\nArguments:\nNone\n\nResult:\n\"value\" (string) The seed words used, along with the wallet passphrase, to create the wallet\n",
+		"verifywalletseed":        "verifywalletseed \"seed\"\n\nCheck that a recorded seed backup matches the wallet seed, without revealing the wallet seed.\nAttempts are rate limited.\n\nArguments:\n1. seed (string, required) The seed words to check\n\nResult:\ntrue|false (boolean) Whether the seed words match the wallet seed\n",
 		"getsecret":               "getsecret \"name\"\n\nGet a secret seed which is generated using the wallet's private key, this can be used as a password for another application\n\nArguments:\n1. name (string, required) A name which will be used to generate the secret seed, the same seed will always be provided given the same name\n\nResult:\n\"value\" (string) A 32 byte secret seed in hex form\n",
 		"help":                    "help (\"command\")\n\nReturns a list of all commands or help for a specified command.\n\nArguments:\n1. command (string, optional) The command to retrieve help for\n\nResult (no command provided):\n\"value\" (string) List of commands\n\nResult (command specified):\n\"value\" (string) Help for specified command\n",
 		"importprivkey":           "importprivkey \"privkey\" (\"label\" rescan=true)\n\nImports a WIF-encoded private key to the 'imported' account.\n\nArguments:\n1. privkey (string, required)                The WIF-encoded private key\n2. label   (string, optional)                Unused (must be unset or 'imported')\n3. rescan  (boolean, optional, default=true) Rescan the blockchain (since the genesis block) for outputs controlled by the imported key\n\nResult:\nNothing\n",
+		"importwallet":            "importwallet \"filename\" (legacy=false)\n\nImports the keys of a wallet dump file in the format of the bitcoind dumpwallet command, applying their labels. Every line of the file is validated before any key is imported. A single rescan is started from the earliest creation time of the imported keys.\n\nArguments:\n1. filename (string, required)                 The wallet dump file to import, on the host running the wallet\n2. legacy   (boolean, optional, default=false) Import the keys as legacy (non-segwit) addresses\n\nResult:\n{\n \"imported\": n,     (numeric) The number of keys imported\n \"existing\": n,     (numeric) The number of keys which were already in the wallet, only their labels are applied\n \"rescanheight\": n, (numeric) The height the rescan starts from, -1 if no key was imported\n}                   \n",
+		"listlabels":              "listlabels\n\nReturns the sorted list of labels in the wallet's address book.\n\nArguments:\nNone\n\nResult:\n[\"value\",...] (array of string) The list of labels\n",
 		"listlockunspent":         "listlockunspent\n\nReturns a JSON array of outpoints marked as locked (with lockunspent) for this wallet session.\n\nArguments:\nNone\n\nResult:\n[{\n \"txid\": \"value\", (string)  The transaction hash of the referenced output\n \"vout\": n,       (numeric) The output index of the referenced output\n},...]\n",
 		"listreceivedbyaddress":   "listreceivedbyaddress (minconf=1 includeempty=false includewatchonly=false)\n\nReturns a JSON array of objects listing wallet payment addresses and their total received amounts.\n\nArguments:\n1. minconf          (numeric, optional, default=1)     Minimum number of block confirmations required before a transaction is considered\n2. includeempty     (boolean, optional, default=false) Unused\n3. includewatchonly (boolean, optional, default=false) Unused\n\nResult:\n[{\n \"account\": \"value\",              (string)          DEPRECATED -- Unset\n \"address\": \"value\",              (string)          The payment address\n \"amount\": n.nnn,                 (numeric)         Total amount received by the payment address valued in bitcoin\n \"confirmations\": n,              (numeric)         Number of block confirmations of the most recent transaction relevant to the address\n \"txids\": [\"value\",...],          (array of string) Transaction hashes of all transactions involving this address\n \"involvesWatchonly\": true|false, (boolean)         Unset\n},...]\n",
-		"listsinceblock":          "listsinceblock (\"blockhash\" targetconfirmations=1 includewatchonly=false)\n\nReturns a JSON array of objects listing details of all wallet transactions after some block.\n\nArguments:\n1. blockhash           (string, optional)                 Hash of the parent block of the first block to consider transactions from, or unset to list all transactions\n2. targetconfirmations (numeric, optional, default=1)     Minimum number of block confirmations of the last block in the result object.  Must be 1 or greater.  Note: The transactions array in the result object is not affected by this parameter\n3. includewatchonly    (boolean, optional, default=false) Unused\n\nResult:\n{\n \"transactions\": [{                 (array of object) JSON array of objects containing verbose details of the each transaction\n  \"abandoned\": true|false,          (boolean)         Unset\n  \"account\": \"value\",               (string)          DEPRECATED -- Unset\n  \"address\": \"value\",               (string)          Payment address for a transaction output\n  \"amount\": n.nnn,                  (numeric)         The value of the transaction output valued in bitcoin\n  \"bip125-replaceable\": \"value\",    (string)          Unset\n  \"blockhash\": \"value\",             (string)          The hash of the block this transaction is mined in, or the empty string if unmined\n  \"blockindex\": n,                  (numeric)         Unset\n  \"blocktime\": n,                   (numeric)         The Unix time of the block header this transaction is mined in, or 0 if unmined\n  \"category\": \"value\",              (string)          The kind of transaction: \"send\" for sent transactions, \"immature\" for immature coinbase outputs, \"generate\" for mature coinbase outputs, or \"recv\" for all other received outputs.  Note: A single output may be included multiple times under different categories\n  \"confirmations\": n,               (numeric)         The number of block confirmations of the transaction\n  \"fee\": n.nnn,                     (numeric)         The total input value minus the total output value for sent transactions\n  \"generated\": true|false,          (boolean)         Whether the transaction output is a coinbase output\n  \"involveswatchonly\": true|false,  (boolean)         Unset\n  \"time\": n,                        (numeric)         The earliest Unix time this transaction was known to exist\n  \"timereceived\": n,                (numeric)         The earliest Unix time this transaction was known to exist\n  \"trusted\": true|false,            (boolean)         Unset\n  \"txid\": \"value\",                  (string)          The hash of the transaction\n  \"vout\": n,                        (numeric)         The transaction output index\n  \"walletconflicts\": [\"value\",...], (array of string) Unset\n  \"comment\": \"value\",               (string)          Unset\n  \"otheraccount\": \"value\",          (string)          Unset\n },...],                                              \n \"lastblock\": \"value\",              (string)          Hash of the latest-synced block to be used in later calls to listsinceblock\n}                                   \n",
-		"listtransactions":        "listtransactions (count=10 from=0)\n\nReturns a JSON array of objects containing verbose details for wallet transactions.\n\nArguments:\n1. count (numeric, optional, default=10) Maximum number of transactions to create results from\n2. from  (numeric, optional, default=0)  Number of transactions to skip before results are created\n\nResult:\n[{\n \"abandoned\": true|false,          (boolean)         Unset\n \"account\": \"value\",               (string)          DEPRECATED -- Unset\n \"address\": \"value\",               (string)          Payment address for a transaction output\n \"amount\": n.nnn,                  (numeric)         The value of the transaction output valued in bitcoin\n \"bip125-replaceable\": \"value\",    (string)          Unset\n \"blockhash\": \"value\",             (string)          The hash of the block this transaction is mined in, or the empty string if unmined\n \"blockindex\": n,                  (numeric)         Unset\n \"blocktime\": n,                   (numeric)         The Unix time of the block header this transaction is mined in, or 0 if unmined\n \"category\": \"value\",              (string)          The kind of transaction: \"send\" for sent transactions, \"immature\" for immature coinbase outputs, \"generate\" for mature coinbase outputs, or \"recv\" for all other received outputs.  Note: A single output may be included multiple times under different categories\n \"confirmations\": n,               (numeric)         The number of block confirmations of the transaction\n \"fee\": n.nnn,                     (numeric)         The total input value minus the total output value for sent transactions\n \"generated\": true|false,          (boolean)         Whether the transaction output is a coinbase output\n \"involveswatchonly\": true|false,  (boolean)         Unset\n \"time\": n,                        (numeric)         The earliest Unix time this transaction was known to exist\n \"timereceived\": n,                (numeric)         The earliest Unix time this transaction was known to exist\n \"trusted\": true|false,            (boolean)         Unset\n \"txid\": \"value\",                  (string)          The hash of the transaction\n \"vout\": n,                        (numeric)         The transaction output index\n \"walletconflicts\": [\"value\",...], (array of string) Unset\n \"comment\": \"value\",               (string)          Unset\n \"otheraccount\": \"value\",          (string)          Unset\n},...]\n",
+		"listsinceblock":          "listsinceblock (\"blockhash\" targetconfirmations=1 includewatchonly=false)\n\nReturns a JSON array of objects listing details of all wallet transactions after some block.\n\nArguments:\n1. blockhash           (string, optional)                 Hash of the parent block of the first block to consider transactions from, or unset to list all transactions\n2. targetconfirmations (numeric, optional, default=1)     Minimum number of block confirmations of the last block in the result object.  Must be 1 or greater.  Note: The transactions array in the result object is not affected by this parameter\n3. includewatchonly    (boolean, optional, default=false) Unused\n\nResult:\n{\n \"transactions\": [{                 (array of object) JSON array of objects containing verbose details of the each transaction\n  \"abandoned\": true|false,          (boolean)         Unset\n  \"account\": \"value\",               (string)          DEPRECATED -- Unset\n  \"address\": \"value\",               (string)          Payment address for a transaction output\n  \"label\": \"value\",                 (string)          Address book label of the payment address, if any\n  \"amount\": n.nnn,                  (numeric)         The value of the transaction output valued in bitcoin\n  \"bip125-replaceable\": \"value\",    (string)          Unset\n  \"blockhash\": \"value\",             (string)          The hash of the block this transaction is mined in, or the empty string if unmined\n  \"blockindex\": n,                  (numeric)         Unset\n  \"blocktime\": n,                   (numeric)         The Unix time of the block header this transaction is mined in, or 0 if unmined\n  \"category\": \"value\",              (string)          The kind of transaction: \"send\" for sent transactions, \"immature\" for immature coinbase outputs, \"generate\" for mature coinbase outputs, or \"recv\" for all other received outputs.  Note: A single output may be included multiple times under different categories\n  \"confirmations\": n,               (numeric)         The number of block confirmations of the transaction\n  \"fee\": n.nnn,                     (numeric)         The total input value minus the total output value for sent transactions\n  \"generated\": true|false,          (boolean)         Whether the transaction output is a coinbase output\n  \"involveswatchonly\": true|false,  (boolean)         Unset\n  \"time\": n,                        (numeric)         The earliest Unix time this transaction was known to exist\n  \"timereceived\": n,                (numeric)         The earliest Unix time this transaction was known to exist\n  \"trusted\": true|false,            (boolean)         Unset\n  \"txid\": \"value\",                  (string)          The hash of the transaction\n  \"vout\": n,                        (numeric)         The transaction output index\n  \"walletconflicts\": [\"value\",...], (array of string) Unset\n  \"comment\": \"value\",               (string)          Unset\n  \"otheraccount\": \"value\",          (string)          Unset\n },...],                                              \n \"lastblock\": \"value\",              (string)          Hash of the latest-synced block to be used in later calls to listsinceblock\n}                                   \n",
+		"listtransactions":        "listtransactions (count=10 from=0)\n\nReturns a JSON array of objects containing verbose details for wallet transactions.\n\nArguments:\n1. count (numeric, optional, default=10) Maximum number of transactions to create results from\n2. from  (numeric, optional, default=0)  Number of transactions to skip before results are created\n\nResult:\n[{\n \"abandoned\": true|false,          (boolean)         Unset\n \"account\": \"value\",               (string)          DEPRECATED -- Unset\n \"address\": \"value\",               (string)          Payment address for a transaction output\n \"label\": \"value\",                 (string)          Address book label of the payment address, if any\n \"amount\": n.nnn,                  (numeric)         The value of the transaction output valued in bitcoin\n \"bip125-replaceable\": \"value\",    (string)          Unset\n \"blockhash\": \"value\",             (string)          The hash of the block this transaction is mined in, or the empty string if unmined\n \"blockindex\": n,                  (numeric)         Unset\n \"blocktime\": n,                   (numeric)         The Unix time of the block header this transaction is mined in, or 0 if unmined\n \"category\": \"value\",              (string)          The kind of transaction: \"send\" for sent transactions, \"immature\" for immature coinbase outputs, \"generate\" for mature coinbase outputs, or \"recv\" for all other received outputs.  Note: A single output may be included multiple times under different categories\n \"confirmations\": n,               (numeric)         The number of block confirmations of the transaction\n \"fee\": n.nnn,                     (numeric)         The total input value minus the total output value for sent transactions\n \"generated\": true|false,          (boolean)         Whether the transaction output is a coinbase output\n \"involveswatchonly\": true|false,  (boolean)         Unset\n \"time\": n,                        (numeric)         The earliest Unix time this transaction was known to exist\n \"timereceived\": n,                (numeric)         The earliest Unix time this transaction was known to exist\n \"trusted\": true|false,            (boolean)         Unset\n \"txid\": \"value\",                  (string)          The hash of the transaction\n \"vout\": n,                        (numeric)         The transaction output index\n \"walletconflicts\": [\"value\",...], (array of string) Unset\n \"comment\": \"value\",               (string)          Unset\n \"otheraccount\": \"value\",          (string)          Unset\n},...]\n",
 		"listunspent":             "listunspent (minconf=1 maxconf=9999999 [\"address\",...])\n\nReturns a JSON array of objects representing unlocked unspent outputs controlled by wallet keys.\n\nArguments:\n1. minconf   (numeric, optional, default=1)       Minimum number of block confirmations required before a transaction output is considered\n2. maxconf   (numeric, optional, default=9999999) Maximum number of block confirmations required before a transaction output is excluded\n3. addresses (array of string, optional)          If set, limits the returned details to unspent outputs received by any of these payment addresses\n\nResult:\n{\n \"txid\": \"value\",         (string)  The transaction hash of the referenced output\n \"vout\": n,               (numeric) The output index of the referenced output\n \"address\": \"value\",      (string)  The payment address that received the output\n \"account\": \"value\",      (string)  The account associated with the receiving payment address\n \"scriptPubKey\": \"value\", (string)  The output script encoded as a hexadecimal string\n \"redeemScript\": \"value\", (string)  Unset\n \"amount\": n.nnn,         (numeric) The amount of the output valued in bitcoin\n \"confirmations\": n,      (numeric) The number of block confirmations of the transaction\n \"height\": n,             (numeric) The height of the block which the transaction was included in\n \"blockHash\": \"value\",    (string)  The hash of the block which the transaction was included in\n \"spendable\": true|false, (boolean) Whether the output is entirely controlled by wallet keys/scripts (false for partially controlled multisig outputs or outputs to watch-only addresses)\n}                         \n",
+		"loadwallet":              "loadwallet \"walletname\" (\"publicpassphrase\")\n\nLoad a wallet from the wallet directory, this is only possible if no wallet is loaded.\nThe wallet takes over the connection to the blockchain of the previously loaded wallet.\n\nArguments:\n1. walletname       (string, required) The name of the wallet, which is stored as wallet_<walletname>.db\n2. publicpassphrase (string, optional) The passphrase used to encrypt the public data of the wallet, if unset the default public passphrase is used\n\nResult:\nNothing\n",
 		"lockunspent":             "lockunspent unlock [{\"txid\":\"value\",\"vout\":n},...] (\"lockname\")\n\nLocks or unlocks an unspent output.\nLocked outputs are not chosen for transaction inputs of authored transactions and are not included in 'listunspent' results.\nLocked outputs are volatile and are not saved across wallet restarts.\nIf unlock is true and no transaction outputs are specified, all locked outputs are marked unlocked.\n\nArguments:\n1. unlock       (boolean, required)         True to unlock outputs, false to lock\n2. transactions (array of object, required) Transaction outputs to lock or unlock\n[{\n \"txid\": \"value\", (string)  The transaction hash of the referenced output\n \"vout\": n,       (numeric) The output index of the referenced output\n},...]\n3. lockname (string, optional) Name of the lock to apply, allows groups of locks to be cleared at once\n\nResult:\ntrue|false (boolean) The boolean 'true'\n",
 		"sendfrom":                "sendfrom \"toaddress\" amount ([\"fromaddress\",...] minconf=1 \"comment\" \"commentto\" maxinputs minheight)\n\nDEPRECATED -- Authors, signs, and sends a transaction that outputs some amount to a payment address.\nA change output is automatically included to send extra output value back to the original account.\n\nArguments:\n1. toaddress     (string, required)             Address to pay\n2. amount        (numeric, required)            Amount to send to the payment address valued in bitcoin\n3. fromaddresses (array of string, optional)    Addresses to use for selecting coins to spend\n4. minconf       (numeric, optional, default=1) Minimum number of block confirmations required before a transaction output is eligible to be spent\n5. comment       (string, optional)             Unused\n6. commentto     (string, optional)             Unused\n7. maxinputs     (numeric, optional)            Maximum number of transaction inputs that are allowed\n8. minheight     (numeric, optional)            Only select transactions from this height or above\n\nResult:\n\"value\" (string) The transaction hash of the sent transaction\n",
 		"sendmany":                "sendmany {\"address\":amount,...} ([\"fromaddress\",...] minconf=1 \"comment\" maxinputs)\n\nAuthors, signs, and sends a transaction that outputs to many payment addresses.\nA change output is automatically included to send extra output value back to the original account.\n\nArguments:\n1. amounts (object, required) Pairs of payment addresses and the output amount to pay each\n{\n \"Address to pay\": Amount to send to the payment address valued in bitcoin, (object) JSON object using payment addresses as keys and output amounts valued in bitcoin to send to each address\n ...\n}\n2. fromaddresses (array of string, optional)    Addresses to use for selecting coins to spend\n3. minconf       (numeric, optional, default=1) Minimum number of block confirmations required before a transaction output is eligible to be spent\n4. comment       (string, optional)             Unused\n5. maxinputs     (numeric, optional)            Maximum number of transaction inputs that are allowed\n\nResult:\n\"value\" (string) The transaction hash of the sent transaction\n",
 		"sendtoaddress":           "sendtoaddress \"address\" amount (\"comment\" \"commentto\")\n\nAuthors, signs, and sends a transaction that outputs some amount to a payment address.\nUnlike sendfrom, outputs are always chosen from the default account.\nA change output is automatically included to send extra output value back to the original account.\n\nArguments:\n1. address   (string, required)  Address to pay\n2. amount    (numeric, required) Amount to send to the payment address valued in bitcoin\n3. comment   (string, optional)  Unused\n4. commentto (string, optional)  Unused\n\nResult:\n\"value\" (string) The transaction hash of the sent transaction\n",
+		"setaddresslabel":         "setaddresslabel \"address\" \"label\"\n\nStores a label for an address in the wallet's address book.\nThe address does not need to belong to the wallet. Labels are limited to 500 bytes of UTF-8 and an empty label removes the address from the address book.\n\nArguments:\n1. address (string, required) The address to label\n2. label   (string, required) The label to assign to the address\n\nResult:\nNothing\n",
 		"settxfee":                "settxfee amount\n\nModify the increment used each time more fee is required for an authored transaction.\n\nArguments:\n1. amount (numeric, required) The new fee increment valued in bitcoin\n\nResult:\ntrue|false (boolean) The boolean 'true'\n",
-		"signmessage":             "signmessage \"address\" \"message\"\n\nSigns a message using the private key of a payment address.\n\nArguments:\n1. address (string, required) Payment address of private key used to sign the message with\n2. message (string, required) Message to sign\n\nResult:\n\"value\" (string) The signed message encoded as a base64 string\n",
+		"signmessage":             "signmessage \"address\" \"message\"\n\nSigns a message using the private key of a payment address.\nSignatures for segwit addresses use the BIP137 header bytes.\n\nArguments:\n1. address (string, required) Payment address of private key used to sign the message with\n2. message (string, required) Message to sign\n\nResult:\n\"value\" (string) The signed message encoded as a base64 string\n",
 		"signrawtransaction":      "signrawtransaction \"rawtx\" ([{\"txid\":\"value\",\"vout\":n,\"scriptpubkey\":\"value\",\"redeemscript\":\"value\"},...] [\"privkey\",...] flags=\"ALL\")\n\nSigns transaction inputs using private keys from this wallet and request.\nThe valid flags options are ALL, NONE, SINGLE, ALL|ANYONECANPAY, NONE|ANYONECANPAY, and SINGLE|ANYONECANPAY.\n\nArguments:\n1. rawtx    (string, required)                Unsigned or partially unsigned transaction to sign encoded as a hexadecimal string\n2. inputs   (array of object, optional)       Additional data regarding inputs that this wallet may not be tracking\n3. privkeys (array of string, optional)       Additional WIF-encoded private keys to use when creating signatures\n4. flags    (string, optional, default=\"ALL\") Sighash flags\n\nResult:\n{\n \"hex\": \"value\",         (string)          The resulting transaction encoded as a hexadecimal string\n \"complete\": true|false, (boolean)         Whether all input signatures have been created\n \"errors\": [{            (array of object) Script verification errors (if exists)\n  \"txid\": \"value\",       (string)          The transaction hash of the referenced previous output\n  \"vout\": n,             (numeric)         The output index of the referenced previous output\n  \"scriptSig\": \"value\",  (string)          The hex-encoded signature script\n  \"sequence\": n,         (numeric)         Script sequence number\n  \"error\": \"value\",      (string)          Verification or signing error related to the input\n },...],                                   \n}                        \n",
+		"signwithaddress":         "signwithaddress \"address\" \"data\" (inputindex)\n\nSigns a message or a single PSBT input using exclusively the private key of a wallet address.\nUnlike signrawtransaction, no other keys of the wallet are used and PSBT inputs are not finalized, which makes this suitable for multisig participation.\n\nArguments:\n1. address    (string, required)  Wallet address whose private key is used to sign\n2. data       (string, required)  The message to sign, or a base64 encoded PSBT if inputindex is set\n3. inputindex (numeric, optional) Index of the PSBT input to sign; if unset, data is signed as a message\n\nResult:\n{\n \"pubkey\": \"value\",    (string) The hex encoded public key of the address\n \"signature\": \"value\", (string) The base64 encoded message signature, or the hex encoded partial signature (including the sighash type) of the PSBT input\n \"psbt\": \"value\",      (string) The base64 encoded PSBT with the partial signature attached (only for PSBT inputs)\n}                      \n",
+		"unloadwallet":            "unloadwallet\n\nUnload the loaded wallet and close its database, so that another wallet can be loaded.\nRequests which are running against the wallet are completed first, the connection to the blockchain is kept.\n\nArguments:\nNone\n\nResult:\nNothing\n",
 		"validateaddress":         "validateaddress \"address\"\n\nVerify that an address is valid.\nExtra details are returned if the address is controlled by this wallet.\nThe following fields are valid only when the address is controlled by this wallet (ismine=true): isscript, pubkey, iscompressed, account, addresses, hex, script, and sigsrequired.\nThe following fields are only valid when address has an associated public key: pubkey, iscompressed.\nThe following fields are only valid when address is a pay-to-script-hash address: addresses, hex, and script.\nIf the address is a multisig address controlled by this wallet, the multisig fields will be left unset if the wallet is locked since the redeem script cannot be decrypted.\n\nArguments:\n1. address (string, required) Address to validate\n\nResult:\n{\n \"isvalid\": true|false,      (boolean)         Whether or not the address is valid\n \"address\": \"value\",         (string)          The payment address (only when isvalid is true)\n \"ismine\": true|false,       (boolean)         Whether this address is controlled by the wallet (only when isvalid is true)\n \"iswatchonly\": true|false,  (boolean)         Unset\n \"isscript\": true|false,     (boolean)         Whether the payment address is a pay-to-script-hash address (only when isvalid is true)\n \"pubkey\": \"value\",          (string)          The associated public key of the payment address, if any (only when isvalid is true)\n \"iscompressed\": true|false, (boolean)         Whether the address was created by hashing a compressed public key, if any (only when isvalid is true)\n \"account\": \"value\",         (string)          The account this payment address belongs to (only when isvalid is true)\n \"addresses\": [\"value\",...], (array of string) All associated payment addresses of the script if address is a multisig address (only when isvalid is true)\n \"hex\": \"value\",             (string)          The redeem script \n \"script\": \"value\",          (string)          The class of redeem script for a multisig address\n \"sigsrequired\": n,          (numeric)         The number of required signatures to redeem outputs to the multisig address\n}                            \n",
-		"verifymessage":           "verifymessage \"address\" \"signature\" \"message\"\n\nVerify a message was signed with the associated private key of some address.\n\nArguments:\n1. address   (string, required) Address used to sign message\n2. signature (string, required) The signature to verify\n3. message   (string, required) The message to verify\n\nResult:\ntrue|false (boolean) Whether the message was signed with the private key of 'address'\n",
+		"verifymessage":           "verifymessage \"address\" \"signature\" \"message\"\n\nVerify a message was signed with the associated private key of some address.\nP2PKH, P2WKH and P2SH nested P2WKH addresses are supported.\n\nArguments:\n1. address   (string, required) Address used to sign message\n2. signature (string, required) The signature to verify\n3. message   (string, required) The message to verify\n\nResult:\ntrue|false (boolean) Whether the message was signed with the private key of 'address'\n",
 		"walletlock":              "walletlock\n\nLock the wallet.\n\nArguments:\nNone\n\nResult:\nNothing\n",
 		"walletpassphrase":        "walletpassphrase \"passphrase\" timeout\n\nUnlock the wallet.\n\nArguments:\n1. passphrase (string, required)  The wallet passphrase\n2. timeout    (numeric, required) The number of seconds to wait before the wallet automatically locks\n\nResult:\nNothing\n",
 		"walletpassphrasechange":  "walletpassphrasechange \"oldpassphrase\" \"newpassphrase\"\n\nChange the wallet passphrase.\n\nArguments:\n1. oldpassphrase (string, required) The old wallet passphrase\n2. newpassphrase (string, required) The new wallet passphrase\n\nResult:\nNothing\n",
 		"walletmempool":           "walletmempool\n\nShow the unconfirmed transactions which are being broadcasted by the wallet\n\nArguments:\nNone\n\nResult:\n[{\n \"txid\": \"value\",     (string) Transaction id\n \"received\": \"value\", (string) The time when the transaction was first seen/made\n},...]\n",
 		"exportwatchingwallet":    "exportwatchingwallet (\"account\" download=false)\n\nCreates and returns a duplicate of the wallet database without any private keys to be used as a watching-only wallet.\n\nArguments:\n1. account  (string, optional)                 Unused (must be unset or \"*\")\n2. download (boolean, optional, default=false) Unused\n\nResult:\n\"value\" (string) The watching-only database encoded as a base64 string\n",
 		"getbestblock":            "getbestblock\n\nReturns the hash and height of the newest block in the best chain that wallet has finished syncing with.\n\nArguments:\nNone\n\nResult:\n{\n \"hash\": \"value\", (string)  The hash of the block\n \"height\": n,     (numeric) The blockchain height of the block\n}                 \n",
+		"getblockchaininfo":       "getblockchaininfo\n\nReturns information about the best chain as seen by the chain backend. If the backend can't be reached, the last known chain state is returned and marked as stale.\n\nArguments:\nNone\n\nResult:\n{\n \"chain\": \"value\",                   (string)  The name of the chain\n \"blocks\": n,                        (numeric) The height of the best block\n \"bestblockhash\": \"value\",           (string)  The hash of the best block\n \"bestblocktime\": n,                 (numeric) The timestamp of the best block, if known\n \"initialblockdownload\": true|false, (boolean) Whether the chain backend is still catching up with the network\n \"verificationprogress\": n.nnn,      (numeric) An estimate of the fraction of the chain which has been verified\n \"stale\": true|false,                (boolean) True if the chain backend could not be reached and this is the last known chain state\n \"cachedat\": n,                      (numeric) The unix time at which a stale chain state was cached\n}                                    \n",
+		"waitforsync":             "waitforsync (timeout=60)\n\nWaits until the wallet is synced with the chain backend or the timeout elapses. Returns right away if the wallet is already synced, the result reports the sync progress either way.\n\nArguments:\n1. timeout (numeric, optional, default=60) The maximum number of seconds to wait, at most 3600, 0 to return the sync state without waiting\n\nResult:\n{\n \"synced\": true|false, (boolean) Whether the wallet is synced with the chain backend\n \"height\": n,          (numeric) The height of the block the wallet is synced to\n \"backendheight\": n,   (numeric) The height of the best block known to the chain backend, if it is connected\n}                      \n",
+		"getsyncprogress":         "getsyncprogress\n\nReturns the progress of the neutrino chain backend syncing block headers and filter headers.\n\nArguments:\nNone\n\nResult:\n{\n \"currentheight\": n,      (numeric) The height of the best block header\n \"targetheight\": n,       (numeric) The height of the best block announced by the connected peers, it moves along as new blocks arrive\n \"filterheaderheight\": n, (numeric) The height of the best filter header\n \"percent\": n.nnn,        (numeric) An estimate of the sync progress in percent\n \"synced\": true|false,    (boolean) Whether block headers and filter headers are synced up to the target height\n}                         \n",
+		"scanblocks":              "scanblocks [\"script\",...] (startheight stopheight fetchblocks=false)\n\nMatches the compact filters of a range of blocks against a set of scripts and returns the blocks which may be relevant to them, without importing the scripts into the wallet. Only available with the neutrino chain backend, at most 10000 blocks can be scanned at once.\n\nArguments:\n1. scripts     (array of string, required)        The addresses or hex encoded output scripts to match\n2. startheight (numeric, optional)                The height of the first block to scan\n3. stopheight  (numeric, optional)                The height of the last block to scan, defaults to the best block\n4. fetchblocks (boolean, optional, default=false) Download the matching blocks to find the transactions paying to the scripts\n\nResult:\n{\n \"fromheight\": n,         (numeric)         The height of the first block scanned\n \"toheight\": n,           (numeric)         The height of the last block scanned\n \"relevantblocks\": [{     (array of object) The blocks whose compact filter matches any of the scripts\n  \"height\": n,            (numeric)         The height of the block\n  \"hash\": \"value\",        (string)          The hash of the block\n  \"txids\": [\"value\",...], (array of string) The hashes of the transactions paying to the scripts, only set if fetchblocks is true\n },...],                                    \n}                         \n",
+		"notifysyncprogress":      "notifysyncprogress (interval=5)\n\nSends a syncprogress notification with the same fields as the getsyncprogress result every interval. Only available over websockets with the neutrino chain backend.\n\nArguments:\n1. interval (numeric, optional, default=5) The number of seconds between notifications\n\nResult:\nNothing\n",
+		"notifymempooltxs":        "notifymempooltxs\n\nSends a mempooltx notification for every transaction paying to or spending from the wallet which is accepted to the mempool. The notification carries the txid, the amounts received and sent by the wallet, the wallet addresses paid and the serialized transaction. Only available over websockets with the pktd RPC backend (--userpc mode). The notifications end when the chain backend is replaced or stopped, the request must then be repeated.\n\nArguments:\nNone\n\nResult:\nNothing\n",
 		"getunconfirmedbalance":   "getunconfirmedbalance (\"account\")\n\nCalculates the unspent output value of all unmined transaction outputs for an account.\n\nArguments:\n1. account (string, optional) The account to query the unconfirmed balance for (default=\"default\")\n\nResult:\nn.nnn (numeric) Total amount of all unmined unspent outputs of the account valued in bitcoin.\n",
-		"listaddresstransactions": "listaddresstransactions [\"address\",...] (\"account\")\n\nReturns a JSON array of objects containing verbose details for wallet transactions pertaining some addresses.\n\nArguments:\n1. addresses (array of string, required) Addresses to filter transaction results by\n2. account   (string, optional)          Unused (must be unset or \"*\")\n\nResult:\n[{\n \"abandoned\": true|false,          (boolean)         Unset\n \"account\": \"value\",               (string)          DEPRECATED -- Unset\n \"address\": \"value\",               (string)          Payment address for a transaction output\n \"amount\": n.nnn,                  (numeric)         The value of the transaction output valued in bitcoin\n \"bip125-replaceable\": \"value\",    (string)          Unset\n \"blockhash\": \"value\",             (string)          The hash of the block this transaction is mined in, or the empty string if unmined\n \"blockindex\": n,                  (numeric)         Unset\n \"blocktime\": n,                   (numeric)         The Unix time of the block header this transaction is mined in, or 0 if unmined\n \"category\": \"value\",              (string)          The kind of transaction: \"send\" for sent transactions, \"immature\" for immature coinbase outputs, \"generate\" for mature coinbase outputs, or \"recv\" for all other received outputs.  Note: A single output may be included multiple times under different categories\n \"confirmations\": n,               (numeric)         The number of block confirmations of the transaction\n \"fee\": n.nnn,                     (numeric)         The total input value minus the total output value for sent transactions\n \"generated\": true|false,          (boolean)         Whether the transaction output is a coinbase output\n \"involveswatchonly\": true|false,  (boolean)         Unset\n \"time\": n,                        (numeric)         The earliest Unix time this transaction was known to exist\n \"timereceived\": n,                (numeric)         The earliest Unix time this transaction was known to exist\n \"trusted\": true|false,            (boolean)         Unset\n \"txid\": \"value\",                  (string)          The hash of the transaction\n \"vout\": n,                        (numeric)         The transaction output index\n \"walletconflicts\": [\"value\",...], (array of string) Unset\n \"comment\": \"value\",               (string)          Unset\n \"otheraccount\": \"value\",          (string)          Unset\n},...]\n",
-		"listalltransactions":     "listalltransactions (\"account\")\n\nReturns a JSON array of objects in the same format as 'listtransactions' without limiting the number of returned objects.\n\nArguments:\n1. account (string, optional) Unused (must be unset or \"*\")\n\nResult:\n[{\n \"abandoned\": true|false,          (boolean)         Unset\n \"account\": \"value\",               (string)          DEPRECATED -- Unset\n \"address\": \"value\",               (string)          Payment address for a transaction output\n \"amount\": n.nnn,                  (numeric)         The value of the transaction output valued in bitcoin\n \"bip125-replaceable\": \"value\",    (string)          Unset\n \"blockhash\": \"value\",             (string)          The hash of the block this transaction is mined in, or the empty string if unmined\n \"blockindex\": n,                  (numeric)         Unset\n \"blocktime\": n,                   (numeric)         The Unix time of the block header this transaction is mined in, or 0 if unmined\n \"category\": \"value\",              (string)          The kind of transaction: \"send\" for sent transactions, \"immature\" for immature coinbase outputs, \"generate\" for mature coinbase outputs, or \"recv\" for all other received outputs.  Note: A single output may be included multiple times under different categories\n \"confirmations\": n,               (numeric)         The number of block confirmations of the transaction\n \"fee\": n.nnn,                     (numeric)         The total input value minus the total output value for sent transactions\n \"generated\": true|false,          (boolean)         Whether the transaction output is a coinbase output\n \"involveswatchonly\": true|false,  (boolean)         Unset\n \"time\": n,                        (numeric)         The earliest Unix time this transaction was known to exist\n \"timereceived\": n,                (numeric)         The earliest Unix time this transaction was known to exist\n \"trusted\": true|false,            (boolean)         Unset\n \"txid\": \"value\",                  (string)          The hash of the transaction\n \"vout\": n,                        (numeric)         The transaction output index\n \"walletconflicts\": [\"value\",...], (array of string) Unset\n \"comment\": \"value\",               (string)          Unset\n \"otheraccount\": \"value\",          (string)          Unset\n},...]\n",
+		"listaddresstransactions": "listaddresstransactions [\"address\",...] (\"account\")\n\nReturns a JSON array of objects containing verbose details for wallet transactions pertaining some addresses.\n\nArguments:\n1. addresses (array of string, required) Addresses to filter transaction results by\n2. account   (string, optional)          Unused (must be unset or \"*\")\n\nResult:\n[{\n \"abandoned\": true|false,          (boolean)         Unset\n \"account\": \"value\",               (string)          DEPRECATED -- Unset\n \"address\": \"value\",               (string)          Payment address for a transaction output\n \"label\": \"value\",                 (string)          Address book label of the payment address, if any\n \"amount\": n.nnn,                  (numeric)         The value of the transaction output valued in bitcoin\n \"bip125-replaceable\": \"value\",    (string)          Unset\n \"blockhash\": \"value\",             (string)          The hash of the block this transaction is mined in, or the empty string if unmined\n \"blockindex\": n,                  (numeric)         Unset\n \"blocktime\": n,                   (numeric)         The Unix time of the block header this transaction is mined in, or 0 if unmined\n \"category\": \"value\",              (string)          The kind of transaction: \"send\" for sent transactions, \"immature\" for immature coinbase outputs, \"generate\" for mature coinbase outputs, or \"recv\" for all other received outputs.  Note: A single output may be included multiple times under different categories\n \"confirmations\": n,               (numeric)         The number of block confirmations of the transaction\n \"fee\": n.nnn,                     (numeric)         The total input value minus the total output value for sent transactions\n \"generated\": true|false,          (boolean)         Whether the transaction output is a coinbase output\n \"involveswatchonly\": true|false,  (boolean)         Unset\n \"time\": n,                        (numeric)         The earliest Unix time this transaction was known to exist\n \"timereceived\": n,                (numeric)         The earliest Unix time this transaction was known to exist\n \"trusted\": true|false,            (boolean)         Unset\n \"txid\": \"value\",                  (string)          The hash of the transaction\n \"vout\": n,                        (numeric)         The transaction output index\n \"walletconflicts\": [\"value\",...], (array of string) Unset\n \"comment\": \"value\",               (string)          Unset\n \"otheraccount\": \"value\",          (string)          Unset\n},...]\n",
+		"listalltransactions":     "listalltransactions (\"account\")\n\nReturns a JSON array of objects in the same format as 'listtransactions' without limiting the number of returned objects.\n\nArguments:\n1. account (string, optional) Unused (must be unset or \"*\")\n\nResult:\n[{\n \"abandoned\": true|false,          (boolean)         Unset\n \"account\": \"value\",               (string)          DEPRECATED -- Unset\n \"address\": \"value\",               (string)          Payment address for a transaction output\n \"label\": \"value\",                 (string)          Address book label of the payment address, if any\n \"amount\": n.nnn,                  (numeric)         The value of the transaction output valued in bitcoin\n \"bip125-replaceable\": \"value\",    (string)          Unset\n \"blockhash\": \"value\",             (string)          The hash of the block this transaction is mined in, or the empty string if unmined\n \"blockindex\": n,                  (numeric)         Unset\n \"blocktime\": n,                   (numeric)         The Unix time of the block header this transaction is mined in, or 0 if unmined\n \"category\": \"value\",              (string)          The kind of transaction: \"send\" for sent transactions, \"immature\" for immature coinbase outputs, \"generate\" for mature coinbase outputs, or \"recv\" for all other received outputs.  Note: A single output may be included multiple times under different categories\n \"confirmations\": n,               (numeric)         The number of block confirmations of the transaction\n \"fee\": n.nnn,                     (numeric)         The total input value minus the total output value for sent transactions\n \"generated\": true|false,          (boolean)         Whether the transaction output is a coinbase output\n \"involveswatchonly\": true|false,  (boolean)         Unset\n \"time\": n,                        (numeric)         The earliest Unix time this transaction was known to exist\n \"timereceived\": n,                (numeric)         The earliest Unix time this transaction was known to exist\n \"trusted\": true|false,            (boolean)         Unset\n \"txid\": \"value\",                  (string)          The hash of the transaction\n \"vout\": n,                        (numeric)         The transaction output index\n \"walletconflicts\": [\"value\",...], (array of string) Unset\n \"comment\": \"value\",               (string)          Unset\n \"otheraccount\": \"value\",          (string)          Unset\n},...]\n",
 		"walletislocked":          "walletislocked\n\nReturns whether or not the wallet is locked.\n\nArguments:\nNone\n\nResult:\ntrue|false (boolean) Whether the wallet is locked\n",
 	}
 }
@@ -56,4 +78,4 @@ var localeHelpDescs = map[string]func() map[string]string{
 	"en_US": helpDescsEnUS,
 }
 
-var requestUsages = "addmultisigaddress nrequired [\"key\",...]\ncreatemultisig nrequired [\"key\",...]\ncreatetransaction \"toaddress\" amount ([\"fromaddress\",...] electrumformat \"changeaddress\" inputminheight minconf=1 vote maxinputs \"autolock\")\ngetaddressbalances (minconf=1 showzerobalance)\nsetnetworkstewardvote (\"votefor\" \"voteagainst\")\ngetnetworkstewardvote\nresync (fromheight toheight [\"address\",...] dropdb)\nstopresync\naddp2shscript \"script\" segwit\ndumpprivkey \"address\"\ngetbalance (minconf=1)\ngetbestblockhash\ngetblockcount\ngetinfo\ngetnewaddress (legacy)\ngetreceivedbyaddress \"address\" (minconf=1)\ngettransaction \"txid\" (includewatchonly=false)\ngetwalletseed\ngetsecret \"name\"\nhelp (\"command\")\nimportprivkey \"privkey\" (\"label\" rescan=true)\nlistlockunspent\nlistreceivedbyaddress (minconf=1 includeempty=false includewatchonly=false)\nlistsinceblock (\"blockhash\" targetconfirmations=1 includewatchonly=false)\nlisttransactions (count=10 from=0)\nlistunspent (minconf=1 maxconf=9999999 [\"address\",...])\nlockunspent unlock [{\"txid\":\"value\",\"vout\":n},...] (\"lockname\")\nsendfrom \"toaddress\" amount ([\"fromaddress\",...] minconf=1 \"comment\" \"commentto\" maxinputs minheight)\nsendmany {\"address\":amount,...} ([\"fromaddress\",...] minconf=1 \"comment\" maxinputs)\nsendtoaddress \"address\" amount (\"comment\" \"commentto\")\nsettxfee amount\nsignmessage \"address\" \"message\"\nsignrawtransaction \"rawtx\" ([{\"txid\":\"value\",\"vout\":n,\"scriptpubkey\":\"value\",\"redeemscript\":\"value\"},...] [\"privkey\",...] flags=\"ALL\")\nvalidateaddress \"address\"\nverifymessage \"address\" \"signature\" \"message\"\nwalletlock\nwalletpassphrase \"passphrase\" timeout\nwalletpassphrasechange \"oldpassphrase\" \"newpassphrase\"\nwalletmempool\nexportwatchingwallet (\"account\" download=false)\ngetbestblock\ngetunconfirmedbalance (\"account\")\nlistaddresstransactions [\"address\",...] (\"account\")\nlistalltransactions (\"account\")\nwalletislocked"
+var requestUsages = "addmultisigaddress nrequired [\"key\",...]\ncreatemultisig nrequired [\"key\",...]\ncreatetransaction \"toaddress\" amount ([\"fromaddress\",...] electrumformat \"changeaddress\" inputminheight minconf=1 vote maxinputs \"autolock\" locktime sequence)\nconsolidate threshold (maxinputs feerate minconf=1 dryrun=false)\nexportutxos (count=1000 \"after\")\nlistdescriptors (private=false checksum=true)\ngetfeehistory (count=10)\nabandontransaction \"txid\"\ngetdescriptorinfo \"descriptor\"\ncreatewallet \"walletname\" \"passphrase\" (\"publicpassphrase\" \"seed\" \"seedpassphrase\" watchonly=false load=false)\ngetaddressbalances (minconf=1 showzerobalance)\ngetaddressesbylabel \"label\"\nsetnetworkstewardvote (\"votefor\" \"voteagainst\")\ngetnetworkstewardvote\nresync (fromheight toheight [\"address\",...] dropdb)\nstopresync\naddp2shscript \"script\" segwit\ndumpprivkey \"address\"\ngetbalance (minconf=1)\ngetbalances (minconf=1 maturewithin)\ngetbestblockhash\ngetblockcount\ngetinfo\ngetnewaddress (legacy)\ngetreceivedbyaddress \"address\" (minconf=1)\ngettransaction \"txid\" (includewatchonly=false)\ngetwalletseed\nverifywalletseed \"seed\"\ngetsecret \"name\"\nhelp (\"command\")\nimportprivkey \"privkey\" (\"label\" rescan=true legacy=false)\nimportwallet \"filename\" (legacy=false)\nlistlabels\nlistlockunspent\nlistreceivedbyaddress (minconf=1 includeempty=false includewatchonly=false)\nlistsinceblock (\"blockhash\" targetconfirmations=1 includewatchonly=false)\nlisttransactions (count=10 from=0)\nlistunspent (minconf=1 maxconf=9999999 [\"address\",...])\nloadwallet \"walletname\" (\"publicpassphrase\")\nlockunspent unlock [{\"txid\":\"value\",\"vout\":n},...] (\"lockname\")\nsendfrom \"toaddress\" amount ([\"fromaddress\",...] minconf=1 \"comment\" \"commentto\" maxinputs minheight)\nsendmany {\"address\":amount,...} ([\"fromaddress\",...] minconf=1 \"comment\" maxinputs)\nsendtoaddress \"address\" amount (\"comment\" \"commentto\")\nsetaddresslabel \"address\" \"label\"\nsettxfee amount\nsignmessage \"address\" \"message\"\nsignrawtransaction \"rawtx\" ([{\"txid\":\"value\",\"vout\":n,\"scriptpubkey\":\"value\",\"redeemscript\":\"value\"},...] [\"privkey\",...] flags=\"ALL\")\nsignwithaddress \"address\" \"data\" (inputindex)\nunloadwallet\nvalidateaddress \"address\"\nverifymessage \"address\" \"signature\" \"message\"\nwalletlock\nwalletpassphrase \"passphrase\" timeout\nwalletpassphrasechange \"oldpassphrase\" \"newpassphrase\"\nwalletmempool\nexportwatchingwallet (\"account\" download=false)\ngetbestblock\ngetblockchaininfo\nwaitforsync (timeout=60)\ngetsyncprogress\nscanblocks [\"script\",...] (startheight stopheight fetchblocks=false)\nnotifysyncprogress (interval=5)\nnotifymempooltxs\ngetunconfirmedbalance (\"account\")\nlistaddresstransactions [\"address\",...] (\"account\")\nlistalltransactions (\"account\")\nwalletislocked"
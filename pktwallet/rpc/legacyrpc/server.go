@@ -5,8 +5,10 @@
 package legacyrpc
 
 import (
+	"bytes"
 	"crypto/subtle"
 	"encoding/base64"
+	"encoding/hex"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -33,6 +35,7 @@ import (
 type websocketClient struct {
 	conn          *websocket.Conn
 	authenticated bool
+	perm          permission
 	remoteAddr    string
 	allRequests   chan []byte
 	responses     chan []byte
@@ -40,10 +43,13 @@ type websocketClient struct {
 	wg            sync.WaitGroup
 }
 
-func newWebsocketClient(c *websocket.Conn, authenticated bool, remoteAddr string) *websocketClient {
+func newWebsocketClient(c *websocket.Conn, authenticated bool, perm permission,
+	remoteAddr string) *websocketClient {
+
 	return &websocketClient{
 		conn:          c,
 		authenticated: authenticated,
+		perm:          perm,
 		remoteAddr:    remoteAddr,
 		allRequests:   make(chan []byte),
 		responses:     make(chan []byte),
@@ -69,9 +75,16 @@ type Server struct {
 	chainClient  chain.Interface
 	handlerMu    sync.Mutex
 
-	listeners []net.Listener
-	authsha   [sha256.Size]byte
-	upgrader  websocket.Upgrader
+	// walletMu is held for reading while a request is handled and for
+	// writing while the wallet is unloaded or loaded, so that no request
+	// runs against a wallet which is going away.  Handlers which may wait
+	// for a long time release it before they run.
+	walletMu sync.RWMutex
+
+	listeners    []net.Listener
+	authsha      [sha256.Size]byte
+	limitauthsha [sha256.Size]byte
+	upgrader     websocket.Upgrader
 
 	maxPostClients      int64 // Max concurrent HTTP POST clients.
 	maxWebsocketClients int64 // Max concurrent websocket clients.
@@ -117,6 +130,11 @@ func NewServer(opts *Options, walletLoader *wallet.Loader, listeners []net.Liste
 		quit:                make(chan struct{}),
 		requestShutdownChan: make(chan struct{}, 1),
 	}
+	if opts.LimitUsername != "" && opts.LimitPassword != "" {
+		server.limitauthsha = sha256.Sum256(
+			httpBasicAuth(opts.LimitUsername, opts.LimitPassword),
+		)
+	}
 
 	serveMux.Handle("/", throttledFn(opts.MaxPOSTClients,
 		func(w http.ResponseWriter, r *http.Request) {
@@ -124,7 +142,8 @@ func NewServer(opts *Options, walletLoader *wallet.Loader, listeners []net.Liste
 			w.Header().Set("Content-Type", "application/json")
 			r.Close = true
 
-			if err := server.checkAuthHeader(r); err != nil {
+			perm, err := server.checkAuthHeader(r)
+			if err != nil {
 				log.Warnf("Unauthorized client connection attempt")
 				jsonAuthFail(w)
 				return
@@ -136,14 +155,14 @@ func NewServer(opts *Options, walletLoader *wallet.Loader, listeners []net.Liste
 				}
 			}
 			server.wg.Add(1)
-			server.postClientRPC(w, r)
+			server.postClientRPC(w, r, perm)
 			server.wg.Done()
 		}))
 
 	serveMux.Handle("/ws", throttledFn(opts.MaxWebsocketClients,
 		func(w http.ResponseWriter, r *http.Request) {
 			authenticated := false
-			err := server.checkAuthHeader(r)
+			perm, err := server.checkAuthHeader(r)
 			if ErrNoAuth.Is(err) {
 			} else if err == nil {
 				authenticated = true
@@ -162,7 +181,9 @@ func NewServer(opts *Options, walletLoader *wallet.Loader, listeners []net.Liste
 					r.RemoteAddr, er.E(errr))
 				return
 			}
-			wsc := newWebsocketClient(conn, authenticated, r.RemoteAddr)
+			wsc := newWebsocketClient(
+				conn, authenticated, perm, r.RemoteAddr,
+			)
 			server.websocketClientRPC(wsc)
 		}))
 
@@ -229,6 +250,7 @@ func (s *Server) Stop() {
 	s.handlerMu.Lock()
 	wallet := s.wallet
 	chainClient := s.chainClient
+	loader := s.walletLoader
 	s.handlerMu.Unlock()
 	if wallet != nil {
 		wallet.Stop()
@@ -281,17 +303,51 @@ func (s *Server) SetChainServer(chainClient chain.Interface) {
 // method.  Each of these must be checked beforehand (the method is already
 // known) and handled accordingly.
 func (s *Server) handlerClosure(request *btcjson.Request) lazyHandler {
-	s.handlerMu.Lock()
-	// With the lock held, make copies of these pointers for the closure.
-	wallet := s.wallet
-	chainClient := s.chainClient
-	if wallet != nil && chainClient == nil {
-		chainClient = wallet.ChainClient()
-		s.chainClient = chainClient
+	hndlr, ok := rpcHandlers[request.Method]
+	if ok && hndlr.handlerServer != nil {
+		return func() (interface{}, er.R) {
+			cmd, err := btcjson.UnmarshalCmd(request)
+			if err != nil {
+				return nil, btcjson.ErrRPCInvalidRequest.Default()
+			}
+			return hndlr.handlerServer(cmd, s)
+		}
 	}
-	s.handlerMu.Unlock()
 
-	return lazyApplyHandler(request, wallet, chainClient)
+	return func() (interface{}, er.R) {
+		s.walletMu.RLock()
+
+		// A wallet which has been created by a createwallet request
+		// after the previous one was unloaded is registered when it is
+		// first used.
+		loader := s.walletLoader
+		if w, _ := s.walletAndChainClient(); w == nil && loader != nil {
+			if w, ok := loader.LoadedWallet(); ok {
+				s.RegisterWallet(w)
+			}
+		}
+
+		s.handlerMu.Lock()
+		// With the lock held, make copies of these pointers for the
+		// handler.
+		wallet := s.wallet
+		chainClient := s.chainClient
+		if wallet != nil && chainClient == nil {
+			chainClient = wallet.ChainClient()
+			s.chainClient = chainClient
+		}
+		s.handlerMu.Unlock()
+
+		if hndlr.noWalletLock {
+			// The wallet may be unloaded while the handler waits,
+			// which stops the wallet and so ends the wait.
+			s.walletMu.RUnlock()
+		} else {
+			defer s.walletMu.RUnlock()
+		}
+
+		return lazyApplyHandler(request, wallet, chainClient, loader)()
+	}
 }
 
 // ErrNoAuth represents an error where authentication could not succeed
@@ -300,23 +356,37 @@ var ErrNoAuth = er.GenericErrorType.CodeWithDetail("legacyrpc.ErrNoAuth",
 	"no auth")
 
 // checkAuthHeader checks the HTTP Basic authentication supplied by a client
-// in the HTTP request r.  It errors with ErrNoAuth if the request does not
-// contain the Authorization header, or another non-nil error if the
-// authentication was provided but incorrect.
+// in the HTTP request r and returns the permission granted by it.  It errors
+// with ErrNoAuth if the request does not contain the Authorization header, or
+// another non-nil error if the authentication was provided but incorrect.
 //
 // This check is time-constant.
-func (s *Server) checkAuthHeader(r *http.Request) er.R {
+func (s *Server) checkAuthHeader(r *http.Request) (permission, er.R) {
 	authhdr := r.Header["Authorization"]
 	if len(authhdr) == 0 {
-		return ErrNoAuth.Default()
+		return readPermission, ErrNoAuth.Default()
 	}
 
-	authsha := sha256.Sum256([]byte(authhdr[0]))
+	return s.checkAuth([]byte(authhdr[0]))
+}
+
+// checkAuth returns the permission granted by the HTTP Basic authentication
+// string auth, or an error if it matches neither the full access nor the
+// read-only credentials.
+//
+// This check is time-constant.
+func (s *Server) checkAuth(auth []byte) (permission, er.R) {
+	authsha := sha256.Sum256(auth)
 	cmp := subtle.ConstantTimeCompare(authsha[:], s.authsha[:])
-	if cmp != 1 {
-		return er.New("bad auth")
+	limitcmp := subtle.ConstantTimeCompare(authsha[:], s.limitauthsha[:])
+	switch {
+	case cmp == 1:
+		return fullPermission, nil
+	case limitcmp == 1:
+		return readPermission, nil
+	default:
+		return readPermission, er.New("bad auth")
 	}
-	return nil
 }
 
 // throttledFn wraps an http.HandlerFunc with throttling of concurrent active
@@ -355,23 +425,29 @@ func idPointer(id interface{}) (p *interface{}) {
 	return
 }
 
-// invalidAuth checks whether a websocket request is a valid (parsable)
+// checkAuthCmd checks whether a websocket request is a valid (parsable)
 // authenticate request and checks the supplied username and passphrase
-// against the server auth.
-func (s *Server) invalidAuth(req *btcjson.Request) bool {
+// against the server auth.  The permission granted by the credentials is
+// returned.
+func (s *Server) checkAuthCmd(req *btcjson.Request) (permission, er.R) {
 	cmd, err := btcjson.UnmarshalCmd(req)
 	if err != nil {
-		return false
+		return readPermission, err
 	}
 	authCmd, ok := cmd.(*btcjson.AuthenticateCmd)
 	if !ok {
-		return false
+		return readPermission, er.New("not an authenticate request")
 	}
 	// Check credentials.
-	login := authCmd.Username + ":" + authCmd.Passphrase
-	auth := "Basic " + base64.StdEncoding.EncodeToString([]byte(login))
-	authSha := sha256.Sum256([]byte(auth))
-	return subtle.ConstantTimeCompare(authSha[:], s.authsha[:]) != 1
+	return s.checkAuth(httpBasicAuth(authCmd.Username, authCmd.Passphrase))
+}
+
+// unauthorizedMethod returns the error which is sent to clients that call a
+// method which they don't have the permission for.
+func unauthorizedMethod(method string) er.R {
+	return btcjson.ErrRPCForbidden.New(
+		fmt.Sprintf("read-only user not authorized for method %s",
+			method), nil)
 }
 
 func (s *Server) websocketClientRead(wsc *websocketClient) {
@@ -390,6 +466,10 @@ func (s *Server) websocketClientRead(wsc *websocketClient) {
 }
 
 func (s *Server) websocketClientRespond(wsc *websocketClient) {
+	// done is closed once no more requests are handled, to stop the
+	// notifications the client subscribed to.
+	done := make(chan struct{})
+
 	// A for-select with a read of the quit channel is used instead of a
 	// for-range to provide clean shutdown.  This is necessary due to
 	// WebsocketClientRead (which sends to the allRequests chan) not closing
@@ -428,11 +508,17 @@ out:
 			}
 
 			if req.Method == "authenticate" {
-				if wsc.authenticated || s.invalidAuth(&req) {
+				if wsc.authenticated {
+					// Disconnect immediately.
+					break out
+				}
+				perm, authErr := s.checkAuthCmd(&req)
+				if authErr != nil {
 					// Disconnect immediately.
 					break out
 				}
 				wsc.authenticated = true
+				wsc.perm = perm
 				resp := makeResponse(req.ID, nil, nil)
 				// Expected to never fail.
 				mresp, errr := jsoniter.Marshal(resp)
@@ -451,6 +537,21 @@ out:
 				break out
 			}
 
+			// Reject methods which the credentials of the client
+			// don't permit.
+			if !wsc.perm.allowsRequest(&req) {
+				mresp, err := btcjson.MarshalResponse(req.ID, nil,
+					unauthorizedMethod(req.Method))
+				if err != nil {
+					log.Errorf("Unable to marshal response: %v", err)
+					break out
+				}
+				if err := wsc.send(mresp); err != nil {
+					break out
+				}
+				continue
+			}
+
 			switch req.Method {
 			case "stop":
 				resp := makeResponse(req.ID,
@@ -466,6 +567,22 @@ out:
 				}
 				s.requestProcessShutdown()
 
+			case "notifysyncprogress":
+				req := req // Copy for the closure
+				wsc.wg.Add(1)
+				go func() {
+					s.notifySyncProgress(wsc, &req, done)
+					wsc.wg.Done()
+				}()
+
+			case "notifymempooltxs":
+				req := req // Copy for the closure
+				wsc.wg.Add(1)
+				go func() {
+					s.notifyMempoolTxs(wsc, &req, done)
+					wsc.wg.Done()
+				}()
+
 			default:
 				req := req // Copy for the closure
 				f := s.handlerClosure(&req)
@@ -488,11 +605,214 @@ out:
 	}
 
 	// allow client to disconnect after all handler goroutines are done
+	close(done)
 	wsc.wg.Wait()
 	close(wsc.responses)
 	s.wg.Done()
 }
 
+// walletAndChainClient returns the loaded wallet and the chain client, either
+// of which may be nil.
+func (s *Server) walletAndChainClient() (*wallet.Wallet, chain.Interface) {
+	s.handlerMu.Lock()
+	defer s.handlerMu.Unlock()
+
+	chainClient := s.chainClient
+	if s.wallet != nil && chainClient == nil {
+		chainClient = s.wallet.ChainClient()
+	}
+	return s.wallet, chainClient
+}
+
+// neutrinoClient returns the active backend of the chain client if it is a
+// neutrino client, or nil otherwise.
+func (s *Server) neutrinoClient() *chain.NeutrinoClient {
+	_, chainClient := s.walletAndChainClient()
+	neut, _ := chain.ActiveBackEnd(chainClient).(*chain.NeutrinoClient)
+	return neut
+}
+
+// notifySyncProgress handles a notifysyncprogress request by sending the
+// client a syncprogress notification every interval until done is closed or
+// the client disconnects. The progress is computed anew for every
+// notification so it follows the target height as new blocks arrive.
+func (s *Server) notifySyncProgress(wsc *websocketClient, req *btcjson.Request,
+	done <-chan struct{}) {
+
+	var jsonErr er.R
+	interval := 0
+	cmd, err := btcjson.UnmarshalCmd(req)
+	if err != nil {
+		jsonErr = btcjson.ErrRPCInvalidRequest.Default()
+	} else if c := cmd.(*btcjson.NotifySyncProgressCmd); c.Interval != nil {
+		interval = *c.Interval
+	}
+	if jsonErr == nil && interval <= 0 {
+		jsonErr = btcjson.ErrRPCInvalidParameter.New(
+			"The interval must be positive", nil)
+	}
+	mresp, err := btcjson.MarshalResponse(req.ID, nil, jsonErr)
+	if err != nil {
+		log.Errorf("Unable to marshal response: %v", err)
+		return
+	}
+	if err := wsc.send(mresp); err != nil || jsonErr != nil {
+		return
+	}
+
+	ticker := time.NewTicker(time.Duration(interval) * time.Second)
+	defer ticker.Stop()
+
+	for {
+		// The chain client isn't available until the chain service
+		// has been started, skip notifications until then.
+		if neut := s.neutrinoClient(); neut != nil {
+			progress, err := syncProgressResult(neut)
+			if err != nil {
+				log.Warnf("Unable to get sync progress: %v", err)
+			} else {
+				ntfn := btcjson.NewSyncProgressNtfn(*progress)
+				mntfn, err := btcjson.MarshalCmd(nil, ntfn)
+				if err != nil {
+					log.Errorf("Unable to marshal notification: %v", err)
+					return
+				}
+				if err := wsc.send(mntfn); err != nil {
+					return
+				}
+			}
+		}
+
+		select {
+		case <-ticker.C:
+		case <-done:
+			return
+		case <-wsc.quit:
+			return
+		}
+	}
+}
+
+// mempoolTxResult returns the mempool transaction as JSON-RPC result.
+func mempoolTxResult(mtx *wallet.MempoolTx) (*btcjson.MempoolTxResult, er.R) {
+	var buf bytes.Buffer
+	if err := mtx.Tx.Serialize(&buf); err != nil {
+		return nil, err
+	}
+	addrs := make([]string, len(mtx.Addresses))
+	for i, addr := range mtx.Addresses {
+		addrs[i] = addr.EncodeAddress()
+	}
+	return &btcjson.MempoolTxResult{
+		TxID:      mtx.Tx.TxHash().String(),
+		Received:  mtx.Received.ToBTC(),
+		Sent:      mtx.Sent.ToBTC(),
+		Addresses: addrs,
+		Hex:       hex.EncodeToString(buf.Bytes()),
+	}, nil
+}
+
+// mempoolSubCheckInterval is how often a mempool subscription checks whether
+// the wallet or its chain client has been replaced while no transactions arrive.
+const mempoolSubCheckInterval = time.Second
+
+// notifyMempoolTxs handles a notifymempooltxs request by sending the client a
+// mempooltx notification for every transaction paying to or spending from the
+// wallet which is accepted to the mempool of the chain backend, until done is
+// closed, the client disconnects, or the wallet or its chain client is
+// replaced. The mempool is only available with the pktd RPC backend.
+func (s *Server) notifyMempoolTxs(wsc *websocketClient, req *btcjson.Request,
+	done <-chan struct{}) {
+
+	w, chainClient := s.walletAndChainClient()
+
+	var (
+		sub     *chain.MempoolSubscription
+		jsonErr er.R
+	)
+	if _, err := btcjson.UnmarshalCmd(req); err != nil {
+		jsonErr = btcjson.ErrRPCInvalidRequest.Default()
+	} else if w == nil {
+		jsonErr = btcjson.ErrRPCMisc.New("The wallet is not loaded", nil)
+	} else if chainClient == nil {
+		jsonErr = btcjson.ErrRPCMisc.New("This RPC requires a connection to the blockchain", nil)
+	} else if rpc, ok := chain.ActiveBackEnd(chainClient).(*chain.RPCClient); !ok {
+		jsonErr = btcjson.ErrRPCMisc.New("Mempool transactions are not "+
+			"available with the neutrino backend, this RPC requires "+
+			"RPC backend (--userpc mode)", nil)
+	} else {
+		sub, jsonErr = rpc.SubscribeMempoolTxs()
+	}
+	if sub != nil {
+		defer sub.Cancel()
+	}
+	mresp, err := btcjson.MarshalResponse(req.ID, nil, jsonErr)
+	if err != nil {
+		log.Errorf("Unable to marshal response: %v", err)
+		return
+	}
+	if err := wsc.send(mresp); err != nil || jsonErr != nil {
+		return
+	}
+
+	// The subscription belongs to the chain client it was made with, so it
+	// ends if the wallet is unloaded or the chain client is swapped, and
+	// the request must be repeated to follow the new one.
+	replaced := func() bool {
+		curW, curChainClient := s.walletAndChainClient()
+		return curW != w || curChainClient != chainClient
+	}
+	ticker := time.NewTicker(mempoolSubCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case tx, ok := <-sub.Txs:
+			if !ok {
+				return
+			}
+			s.walletMu.RLock()
+			if replaced() {
+				s.walletMu.RUnlock()
+				return
+			}
+			mtx, err := w.RelevantMempoolTx(tx)
+			s.walletMu.RUnlock()
+			if err != nil {
+				log.Warnf("Unable to check mempool transaction %v: %v",
+					tx.TxHash(), err)
+				continue
+			}
+			if mtx == nil {
+				continue
+			}
+			result, err := mempoolTxResult(mtx)
+			if err != nil {
+				log.Errorf("Unable to serialize transaction: %v", err)
+				continue
+			}
+			ntfn := btcjson.NewMempoolTxNtfn(*result)
+			mntfn, err := btcjson.MarshalCmd(nil, ntfn)
+			if err != nil {
+				log.Errorf("Unable to marshal notification: %v", err)
+				return
+			}
+			if err := wsc.send(mntfn); err != nil {
+				return
+			}
+
+		case <-ticker.C:
+			if replaced() {
+				return
+			}
+		case <-done:
+			return
+		case <-wsc.quit:
+			return
+		}
+	}
+}
+
 func (s *Server) websocketClientSend(wsc *websocketClient) {
 	const deadline time.Duration = 2 * time.Second
 out:
@@ -553,8 +873,11 @@ func (s *Server) websocketClientRPC(wsc *websocketClient) {
 // that may be read from a client.  This is currently limited to 4MB.
 const maxRequestSize = 1024 * 1024 * 4
 
-// postClientRPC processes and replies to a JSON-RPC client request.
-func (s *Server) postClientRPC(w http.ResponseWriter, r *http.Request) {
+// postClientRPC processes and replies to a JSON-RPC client request of a client
+// with the given permission.
+func (s *Server) postClientRPC(w http.ResponseWriter, r *http.Request,
+	perm permission) {
+
 	body := http.MaxBytesReader(w, r.Body, maxRequestSize)
 	rpcRequest, errr := ioutil.ReadAll(body)
 	if errr != nil {
@@ -592,11 +915,13 @@ func (s *Server) postClientRPC(w http.ResponseWriter, r *http.Request) {
 	var res interface{}
 	var jsonErr er.R
 	var stop bool
-	switch req.Method {
-	case "authenticate":
+	switch {
+	case req.Method == "authenticate":
 		// Drop it.
 		return
-	case "stop":
+	case !perm.allowsRequest(&req):
+		jsonErr = unauthorizedMethod(req.Method)
+	case req.Method == "stop":
 		stop = true
 		res = "pktwallet stopping"
 	default:
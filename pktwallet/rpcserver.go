@@ -155,7 +155,9 @@ func startRPCServers(walletLoader *wallet.Loader) (*grpc.Server, *legacyrpc.Serv
 		}
 	}
 
-	if cfg.Username == "" || cfg.Password == "" {
+	if cfg.DisableLegacyRPC {
+		log.Info("Legacy RPC server disabled")
+	} else if cfg.Username == "" || cfg.Password == "" {
 		log.Info("Legacy RPC server disabled (requires username and password)")
 	} else if len(cfg.LegacyRPCListeners) != 0 {
 		listeners := makeListeners(cfg.LegacyRPCListeners, legacyListen)
@@ -166,6 +168,8 @@ func startRPCServers(walletLoader *wallet.Loader) (*grpc.Server, *legacyrpc.Serv
 		opts := legacyrpc.Options{
 			Username:            cfg.Username,
 			Password:            cfg.Password,
+			LimitUsername:       cfg.LimitUsername,
+			LimitPassword:       cfg.LimitPassword,
 			MaxPOSTClients:      cfg.LegacyRPCMaxClients,
 			MaxWebsocketClients: cfg.LegacyRPCMaxWebsockets,
 		}
@@ -0,0 +1,109 @@
+package main
+
+import (
+	"context"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/pkt-cash/pktd/pktwallet/internal/cfgutil"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+// freeAddr returns a local address which nothing is listening on.
+func freeAddr(t *testing.T) string {
+	l, err := net.Listen("tcp4", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("unable to listen: %v", err)
+	}
+	addr := l.Addr().String()
+	if err := l.Close(); err != nil {
+		t.Fatalf("unable to close listener: %v", err)
+	}
+	return addr
+}
+
+// checkConnect connects to the experimental RPC server at addr over TLS and
+// fails the test unless the connection becomes ready.
+func checkConnect(t *testing.T, addr, certFile string) {
+	t.Helper()
+
+	creds, errr := credentials.NewClientTLSFromFile(certFile, "localhost")
+	if errr != nil {
+		t.Fatalf("unable to load certificate: %v", errr)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	conn, errr := grpc.DialContext(
+		ctx, addr, grpc.WithTransportCredentials(creds), grpc.WithBlock(),
+	)
+	if errr != nil {
+		t.Fatalf("unable to connect to experimental RPC server: %v",
+			errr)
+	}
+	conn.Close()
+}
+
+// TestStartRPCServersNoLegacyRPC checks that the legacy RPC server is only
+// started when it is not disabled, while the experimental RPC server is
+// started and accepts connections either way.
+func TestStartRPCServersNoLegacyRPC(t *testing.T) {
+	defer func(c *config) { cfg = c }(cfg)
+
+	for _, disabled := range []bool{false, true} {
+		dir, errr := ioutil.TempDir("", "rpcserver")
+		if errr != nil {
+			t.Fatal(errr)
+		}
+		defer os.RemoveAll(dir)
+		legacyAddr := freeAddr(t)
+		experimentalAddr := freeAddr(t)
+		certFile := filepath.Join(dir, "rpc.cert")
+		cfg = &config{
+			RPCCert:                  cfgutil.NewExplicitString(certFile),
+			RPCKey:                   cfgutil.NewExplicitString(filepath.Join(dir, "rpc.key")),
+			DisableLegacyRPC:         disabled,
+			LegacyRPCListeners:       []string{legacyAddr},
+			Username:                 "user",
+			Password:                 "pass",
+			ExperimentalRPCListeners: []string{experimentalAddr},
+		}
+
+		server, legacyServer, err := startRPCServers(nil)
+		if err != nil {
+			t.Fatalf("disabled=%v: unable to start RPC servers: %v",
+				disabled, err)
+		}
+		if server == nil {
+			t.Fatalf("disabled=%v: experimental RPC server not started",
+				disabled)
+		}
+		checkConnect(t, experimentalAddr, certFile)
+
+		conn, errr := net.Dial("tcp4", legacyAddr)
+		if errr == nil {
+			conn.Close()
+		}
+		switch {
+		case disabled && legacyServer != nil:
+			t.Fatalf("legacy RPC server started although disabled")
+		case disabled && errr == nil:
+			t.Fatalf("legacy RPC endpoint %s is listening although "+
+				"disabled", legacyAddr)
+		case !disabled && legacyServer == nil:
+			t.Fatalf("legacy RPC server not started")
+		case !disabled && errr != nil:
+			t.Fatalf("legacy RPC endpoint %s is not listening: %v",
+				legacyAddr, errr)
+		}
+
+		server.Stop()
+		if legacyServer != nil {
+			legacyServer.Stop()
+		}
+	}
+}
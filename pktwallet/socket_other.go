@@ -0,0 +1,13 @@
+// +build windows plan9
+
+package main
+
+import (
+	"net"
+)
+
+// listenPrivateUnix listens on a unix socket at path.  There is no file mode
+// to restrict access with on this platform.
+func listenPrivateUnix(path string) (net.Listener, error) {
+	return net.Listen("unix", path)
+}
@@ -0,0 +1,18 @@
+// +build !windows,!plan9
+
+package main
+
+import (
+	"net"
+	"syscall"
+)
+
+// listenPrivateUnix listens on a unix socket at path which only the current
+// user can connect to.  The socket is created with that mode right away by
+// narrowing the umask, so it is never accessible to others, not even briefly.
+func listenPrivateUnix(path string) (net.Listener, error) {
+	oldMask := syscall.Umask(0o177)
+	defer syscall.Umask(oldMask)
+
+	return net.Listen("unix", path)
+}
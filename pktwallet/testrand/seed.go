@@ -0,0 +1,12 @@
+// +build !rpctest
+
+package testrand
+
+import "github.com/pkt-cash/pktd/btcutil/er"
+
+// Seed is not available in production builds, it always returns an error
+// and leaves the source of randomness untouched.
+func Seed(seed []byte) er.R {
+	return er.New("deterministic randomness is only available in rpctest " +
+		"builds")
+}
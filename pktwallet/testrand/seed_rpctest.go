@@ -0,0 +1,57 @@
+// +build rpctest
+
+package testrand
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+
+	"github.com/pkt-cash/pktd/btcutil/er"
+)
+
+// Seed replaces the source of randomness by a deterministic stream derived
+// from the seed, every run seeded with the same value generates the same
+// wallet seeds and addresses.
+//
+// NOTE: This is only available in rpctest builds and must never be used with
+// real funds, anyone who knows the seed can derive the wallet's keys.
+func Seed(seed []byte) er.R {
+	if len(seed) == 0 {
+		return er.New("the deterministic seed must not be empty")
+	}
+
+	mtx.Lock()
+	defer mtx.Unlock()
+
+	reader = &stream{seed: append([]byte(nil), seed...)}
+	deterministic = true
+	return nil
+}
+
+// stream is a deterministic reader which outputs the blocks
+// sha256(seed || counter) for an incrementing counter.
+type stream struct {
+	seed    []byte
+	counter uint64
+	buf     []byte
+}
+
+// Read fills b from the stream, it never fails.
+func (s *stream) Read(b []byte) (int, error) {
+	n := 0
+	for n < len(b) {
+		if len(s.buf) == 0 {
+			var ctr [8]byte
+			binary.BigEndian.PutUint64(ctr[:], s.counter)
+			s.counter++
+			h := sha256.New()
+			h.Write(s.seed)
+			h.Write(ctr[:])
+			s.buf = h.Sum(nil)
+		}
+		c := copy(b[n:], s.buf)
+		s.buf = s.buf[c:]
+		n += c
+	}
+	return n, nil
+}
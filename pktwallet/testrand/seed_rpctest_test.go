@@ -0,0 +1,41 @@
+// +build rpctest
+
+package testrand
+
+import (
+	"bytes"
+	"testing"
+)
+
+// TestSeedDeterministic makes sure that the seeded source of randomness
+// generates the same bytes for the same seed.
+func TestSeedDeterministic(t *testing.T) {
+	if err := Seed(nil); err == nil {
+		t.Fatal("seeding with an empty seed succeeded")
+	}
+
+	read := func(seed string) []byte {
+		if err := Seed([]byte(seed)); err != nil {
+			t.Fatalf("unable to seed: %v", err)
+		}
+		b := make([]byte, 50)
+		if err := Read(b[:10]); err != nil {
+			t.Fatalf("unable to read randomness: %v", err)
+		}
+		if err := Read(b[10:]); err != nil {
+			t.Fatalf("unable to read randomness: %v", err)
+		}
+		return b
+	}
+
+	a := read("seed")
+	if !Deterministic() {
+		t.Fatal("source of randomness isn't deterministic after seeding")
+	}
+	if !bytes.Equal(a, read("seed")) {
+		t.Fatal("same seed generated different bytes")
+	}
+	if bytes.Equal(a, read("other seed")) {
+		t.Fatal("different seeds generated the same bytes")
+	}
+}
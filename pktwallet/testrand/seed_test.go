@@ -0,0 +1,33 @@
+// +build !rpctest
+
+package testrand
+
+import (
+	"bytes"
+	"testing"
+)
+
+// TestSeedRejected makes sure that the source of randomness can't be seeded
+// deterministically in production builds.
+func TestSeedRejected(t *testing.T) {
+	if err := Seed([]byte("seed")); err == nil {
+		t.Fatal("seeding the source of randomness succeeded in a " +
+			"production build")
+	}
+	if Deterministic() {
+		t.Fatal("source of randomness is deterministic in a " +
+			"production build")
+	}
+
+	a := make([]byte, 32)
+	b := make([]byte, 32)
+	if err := Read(a); err != nil {
+		t.Fatalf("unable to read randomness: %v", err)
+	}
+	if err := Read(b); err != nil {
+		t.Fatalf("unable to read randomness: %v", err)
+	}
+	if bytes.Equal(a, b) {
+		t.Fatal("read the same bytes twice")
+	}
+}
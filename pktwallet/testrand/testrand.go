@@ -0,0 +1,50 @@
+// Package testrand provides the source of randomness which pktwallet uses to
+// generate wallet seeds and to pick the position of change outputs.
+//
+// By default all randomness comes from crypto/rand. Builds with the rpctest
+// build tag additionally allow seeding the source deterministically with
+// Seed, which makes generated seeds, and thereby all derived addresses,
+// predictable so integration tests can assert exact addresses. In any other
+// build Seed always fails, so the deterministic source can never be enabled
+// in production. Signatures don't depend on this source, their nonces are
+// derived deterministically following RFC 6979 anyway.
+package testrand
+
+import (
+	"crypto/rand"
+	"io"
+	"sync"
+
+	"github.com/pkt-cash/pktd/btcutil/er"
+)
+
+var (
+	mtx sync.Mutex
+
+	// reader is the current source of randomness, it is only ever
+	// replaced by Seed in rpctest builds.
+	reader io.Reader = rand.Reader
+
+	// deterministic is set once the source has been seeded.
+	deterministic bool
+)
+
+// Read fills b with random bytes from the current source of randomness.
+func Read(b []byte) er.R {
+	mtx.Lock()
+	defer mtx.Unlock()
+
+	if _, errr := io.ReadFull(reader, b); errr != nil {
+		return er.E(errr)
+	}
+	return nil
+}
+
+// Deterministic returns whether the source of randomness has been seeded
+// deterministically, which is only possible in rpctest builds.
+func Deterministic() bool {
+	mtx.Lock()
+	defer mtx.Unlock()
+
+	return deterministic
+}
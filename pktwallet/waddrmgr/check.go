@@ -0,0 +1,69 @@
+package waddrmgr
+
+import (
+	"fmt"
+
+	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/pktwallet/walletdb"
+)
+
+// CheckAccounts verifies that the accounts of every key scope are numbered
+// contiguously from 0 up to the last account, aside from the imported
+// account, and that each of them is found by both its number and its name.
+// It only reads from ns and returns a description of each inconsistency
+// found, an error is returned only if the manager could not be read.
+func CheckAccounts(ns walletdb.ReadBucket) ([]string, er.R) {
+	var problems []string
+	report := func(format string, args ...interface{}) {
+		problems = append(problems, fmt.Sprintf(format, args...))
+	}
+
+	err := forEachKeyScope(ns, func(scope KeyScope) er.R {
+		prefix := scope.String()
+		lastAccount, err := fetchLastAccount(ns, &scope)
+		if err != nil {
+			report("scope %s: %v", prefix, err)
+			return nil
+		}
+
+		accounts := make(map[uint32]struct{})
+		err = forEachAccount(ns, &scope, func(account uint32) er.R {
+			accounts[account] = struct{}{}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		for account := uint32(0); account <= lastAccount; account++ {
+			if _, ok := accounts[account]; !ok {
+				report("scope %s: account %d is missing, last "+
+					"account is %d", prefix, account, lastAccount)
+			}
+		}
+		for account := range accounts {
+			if account > lastAccount && account != ImportedAddrAccount {
+				report("scope %s: account %d is beyond the last "+
+					"account %d", prefix, account, lastAccount)
+				continue
+			}
+			name, err := fetchAccountName(ns, &scope, account)
+			if err != nil {
+				report("scope %s: account %d has no name", prefix,
+					account)
+				continue
+			}
+			byName, err := fetchAccountByName(ns, &scope, name)
+			if err != nil || byName != account {
+				report("scope %s: account %d is not indexed by "+
+					"its name %q", prefix, account, name)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return problems, nil
+}
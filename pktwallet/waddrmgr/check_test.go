@@ -0,0 +1,48 @@
+package waddrmgr
+
+import (
+	"testing"
+
+	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/pktwallet/walletdb"
+)
+
+// TestCheckAccounts tests that the accounts of a new manager are consistent
+// and that a gap in the account numbers is reported.
+func TestCheckAccounts(t *testing.T) {
+	t.Parallel()
+
+	teardown, db, _ := setupManager(t)
+	defer teardown()
+
+	checkProblems := func(expected int) {
+		t.Helper()
+
+		err := walletdb.View(db, func(tx walletdb.ReadTx) er.R {
+			ns := tx.ReadBucket(waddrmgrNamespaceKey)
+			problems, err := CheckAccounts(ns)
+			if err != nil {
+				return err
+			}
+			if len(problems) != expected {
+				t.Fatalf("expected %d problems, got %d: %v",
+					expected, len(problems), problems)
+			}
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("unable to check accounts: %v", err)
+		}
+	}
+	checkProblems(0)
+
+	// Claiming a later last account leaves accounts 1 and 2 missing.
+	err := walletdb.Update(db, func(tx walletdb.ReadWriteTx) er.R {
+		ns := tx.ReadWriteBucket(waddrmgrNamespaceKey)
+		return putLastAccount(ns, &KeyScopeBIP0044, 2)
+	})
+	if err != nil {
+		t.Fatalf("unable to put last account: %v", err)
+	}
+	checkProblems(2)
+}
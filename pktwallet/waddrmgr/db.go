@@ -258,6 +258,12 @@ var (
 	// sync state of the root manager.
 	syncBucketName = []byte("sync")
 
+	// addrLabelBucketName is the name of the bucket that stores the
+	// user-defined address book labels. It is keyed by the encoded
+	// address, which does not need to belong to the wallet, and it is
+	// only created once the first label is stored.
+	addrLabelBucketName = []byte("addrlabels")
+
 	// Db related key names (main bucket).
 	mgrVersionName    = []byte("mgrver")
 	mgrCreateDateName = []byte("mgrcreated")
@@ -1755,6 +1761,10 @@ func deletePrivateKeys(ns walletdb.ReadWriteBucket) er.R {
 		str := "failed to delete master HD priv key"
 		return managerError(ErrDatabase, str, err)
 	}
+	if err := bucket.Delete(cryptoSeedName); err != nil {
+		str := "failed to delete encrypted seed"
+		return managerError(ErrDatabase, str, err)
+	}
 
 	// With the master key and meta encryption keys deleted, we'll need to
 	// delete the keys for all known scopes as well.
@@ -2185,6 +2195,84 @@ func putBirthdayBlockVerification(ns walletdb.ReadWriteBucket, verified bool) er
 	return nil
 }
 
+// PutAddrLabel stores the label for the encoded address, replacing any label
+// which was previously stored. No validation is performed on the label.
+//
+// The label is stored in length value format:
+//   [0:2]     label length
+//   [2:+len]  label
+func PutAddrLabel(ns walletdb.ReadWriteBucket, addr string, label string) er.R {
+	bucket, err := ns.CreateBucketIfNotExists(addrLabelBucketName)
+	if err != nil {
+		str := "failed to create address label bucket"
+		return managerError(ErrDatabase, str, err)
+	}
+
+	// Labels are expected to be length limited by the caller so the
+	// length always fits in a uint16.
+	v := make([]byte, 2+len(label))
+	binary.BigEndian.PutUint16(v[:2], uint16(len(label)))
+	copy(v[2:], label)
+
+	if err := bucket.Put([]byte(addr), v); err != nil {
+		str := fmt.Sprintf("failed to store label for address %s", addr)
+		return managerError(ErrDatabase, str, err)
+	}
+	return nil
+}
+
+// DeleteAddrLabel removes the label of the encoded address, if any.
+func DeleteAddrLabel(ns walletdb.ReadWriteBucket, addr string) er.R {
+	bucket := ns.NestedReadWriteBucket(addrLabelBucketName)
+	if bucket == nil {
+		return nil
+	}
+	if err := bucket.Delete([]byte(addr)); err != nil {
+		str := fmt.Sprintf("failed to remove label for address %s", addr)
+		return managerError(ErrDatabase, str, err)
+	}
+	return nil
+}
+
+// deserializeAddrLabel decodes a label which was stored by PutAddrLabel.
+func deserializeAddrLabel(addr string, v []byte) (string, er.R) {
+	if len(v) < 2 || int(binary.BigEndian.Uint16(v[:2])) != len(v)-2 {
+		str := fmt.Sprintf("malformed label stored for address %s", addr)
+		return "", managerError(ErrDatabase, str, nil)
+	}
+	return string(v[2:]), nil
+}
+
+// FetchAddrLabel returns the label of the encoded address, or an empty
+// string if the address has no label.
+func FetchAddrLabel(ns walletdb.ReadBucket, addr string) (string, er.R) {
+	bucket := ns.NestedReadBucket(addrLabelBucketName)
+	if bucket == nil {
+		return "", nil
+	}
+	v := bucket.Get([]byte(addr))
+	if v == nil {
+		return "", nil
+	}
+	return deserializeAddrLabel(addr, v)
+}
+
+// ForEachAddrLabel calls fn with every labeled address and its label.
+func ForEachAddrLabel(ns walletdb.ReadBucket, fn func(addr, label string) er.R) er.R {
+	bucket := ns.NestedReadBucket(addrLabelBucketName)
+	if bucket == nil {
+		return nil
+	}
+	return bucket.ForEach(func(k, v []byte) er.R {
+		addr := string(k)
+		label, err := deserializeAddrLabel(addr, v)
+		if err != nil {
+			return err
+		}
+		return fn(addr, label)
+	})
+}
+
 // managerExists returns whether or not the manager has already been created
 // in the given database namespace.
 func managerExists(ns walletdb.ReadBucket) bool {
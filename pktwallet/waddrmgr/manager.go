@@ -7,6 +7,7 @@ package waddrmgr
 import (
 	"crypto/rand"
 	"crypto/sha512"
+	"encoding/binary"
 	"fmt"
 	"sync"
 	"time"
@@ -701,6 +702,41 @@ func (m *Manager) ForEachAccountAddress(ns walletdb.ReadBucket, account uint32,
 	return nil
 }
 
+// MasterKeyFingerprint returns the fingerprint of the master extended public
+// key, which identifies the root of the derivation paths of the keys of the
+// manager. False is returned if the manager doesn't know its master key.
+func (m *Manager) MasterKeyFingerprint(ns walletdb.ReadBucket) (uint32, bool, er.R) {
+	m.mtx.RLock()
+	defer m.mtx.RUnlock()
+
+	_, masterHDPubEnc, err := fetchMasterHDKeys(ns)
+	if err != nil {
+		return 0, false, err
+	}
+	if masterHDPubEnc == nil {
+		return 0, false, nil
+	}
+
+	serializedMasterPub, err := m.cryptoKeyPub.Decrypt(masterHDPubEnc)
+	if err != nil {
+		str := "failed to decrypt master extended public key"
+		return 0, false, managerError(ErrCrypto, str, err)
+	}
+	masterPub, err := hdkeychain.NewKeyFromString(string(serializedMasterPub))
+	if err != nil {
+		str := "failed to create master extended public key"
+		return 0, false, managerError(ErrKeyChain, str, err)
+	}
+	pubKey, err := masterPub.ECPubKey()
+	if err != nil {
+		str := "failed to get master public key"
+		return 0, false, managerError(ErrKeyChain, str, err)
+	}
+
+	fingerprint := btcutil.Hash160(pubKey.SerializeCompressed())[:4]
+	return binary.BigEndian.Uint32(fingerprint), true, nil
+}
+
 func (m *Manager) Seed() *seedwords.SeedEnc {
 	return m.xseed
 }
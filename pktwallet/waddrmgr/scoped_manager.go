@@ -556,6 +556,40 @@ func (s *ScopedKeyManager) AccountProperties(ns walletdb.ReadBucket,
 	return props, nil
 }
 
+// AccountExtendedKey returns the extended public key of the account, or its
+// extended private key if private is true, from which all addresses of the
+// account are derived. The private key requires the manager to be unlocked.
+func (s *ScopedKeyManager) AccountExtendedKey(ns walletdb.ReadBucket,
+	account uint32, private bool) (*hdkeychain.ExtendedKey, er.R) {
+
+	if private && s.rootManager.WatchOnly() {
+		return nil, ErrWatchingOnly.Default()
+	}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if private && s.rootManager.IsLocked() {
+		return nil, ErrLocked.Default()
+	}
+
+	acctInfo, err := s.loadAccountInfo(ns, account)
+	if err != nil {
+		return nil, err
+	}
+	acctKey := acctInfo.acctKeyPub
+	if private {
+		acctKey = acctInfo.acctKeyPriv
+		if acctKey == nil {
+			return nil, ErrLocked.Default()
+		}
+	}
+
+	// Return a copy, as the cached private key is zeroed when the manager
+	// is locked.
+	return hdkeychain.NewKeyFromString(acctKey.String())
+}
+
 // DeriveFromKeyPath attempts to derive a maximal child key (under the BIP0044
 // scheme) from a given key path. If key derivation isn't possible, then an
 // error will be returned.
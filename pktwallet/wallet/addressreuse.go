@@ -0,0 +1,107 @@
+package wallet
+
+import (
+	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/pktlog/log"
+	"github.com/pkt-cash/pktd/pktwallet/walletdb"
+	"github.com/pkt-cash/pktd/txscript"
+	"github.com/pkt-cash/pktd/wire"
+)
+
+// AddressReusePolicy decides how the wallet reacts to the reuse of receive
+// addresses which have already been used.
+type AddressReusePolicy uint8
+
+const (
+	// AddressReuseAllow reuses addresses without complaint.
+	AddressReuseAllow AddressReusePolicy = iota
+
+	// AddressReuseWarn logs a warning when an address is reused.
+	AddressReuseWarn
+
+	// AddressReuseBlock never hands out a used address and refuses to send
+	// to a used receive address of the wallet.
+	AddressReuseBlock
+)
+
+// ErrAddressReuse is returned when a send targets a receive address of the
+// wallet which has already been used, and address reuse is blocked.
+var ErrAddressReuse = Err.CodeWithDetail("ErrAddressReuse",
+	"refusing to reuse an already used wallet address")
+
+// addressReusePolicyNames are the names of the address reuse policies.
+var addressReusePolicyNames = enumNames{
+	kind:  "address reuse policy",
+	names: []string{"allow", "warn", "block"},
+}
+
+// String returns the name of the policy as used in the configuration.
+func (p AddressReusePolicy) String() string {
+	return addressReusePolicyNames.name(uint8(p))
+}
+
+// ParseAddressReusePolicy parses an address reuse policy from its name, one
+// of allow, warn or block.
+func ParseAddressReusePolicy(s string) (AddressReusePolicy, er.R) {
+	p, err := addressReusePolicyNames.parse(s)
+	return AddressReusePolicy(p), err
+}
+
+// SetAddressReusePolicy sets how the wallet reacts to address reuse. It must
+// be called before the wallet is used.
+func (w *Wallet) SetAddressReusePolicy(policy AddressReusePolicy) {
+	w.addressReuse = policy
+}
+
+// usedReceiveAddress returns whether the output script pays to a receive
+// address of the wallet which has already been used. Change addresses and
+// addresses foreign to the wallet are not considered.
+func (w *Wallet) usedReceiveAddress(addrmgrNs walletdb.ReadBucket,
+	pkScript []byte) (bool, string) {
+
+	_, addrs, _, err := txscript.ExtractPkScriptAddrs(
+		pkScript, w.chainParams,
+	)
+	if err != nil {
+		return false, ""
+	}
+
+	for _, addr := range addrs {
+		ma, err := w.Manager.Address(addrmgrNs, addr)
+		if err != nil {
+			continue
+		}
+		if !ma.Internal() && ma.Used(addrmgrNs) {
+			return true, addr.EncodeAddress()
+		}
+	}
+
+	return false, ""
+}
+
+// checkAddressReuse applies the address reuse policy to the outputs of a
+// transaction about to be sent.
+func (w *Wallet) checkAddressReuse(outputs []*wire.TxOut) er.R {
+	if w.addressReuse == AddressReuseAllow {
+		return nil
+	}
+
+	return walletdb.View(w.db, func(tx walletdb.ReadTx) er.R {
+		addrmgrNs := tx.ReadBucket(waddrmgrNamespaceKey)
+		for _, output := range outputs {
+			used, addr := w.usedReceiveAddress(
+				addrmgrNs, output.PkScript,
+			)
+			if !used {
+				continue
+			}
+
+			if w.addressReuse == AddressReuseBlock {
+				return ErrAddressReuse.New(addr, nil)
+			}
+			log.Warnf("Sending to already used wallet address %v",
+				addr)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,77 @@
+package wallet
+
+import (
+	"testing"
+
+	"github.com/pkt-cash/pktd/btcutil"
+	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/pktwallet/waddrmgr"
+	"github.com/pkt-cash/pktd/pktwallet/walletdb"
+	"github.com/pkt-cash/pktd/txscript"
+	"github.com/pkt-cash/pktd/wire"
+)
+
+// TestCheckAddressReuse tests that sends to used receive addresses of the
+// wallet are only refused when address reuse is blocked.
+func TestCheckAddressReuse(t *testing.T) {
+	t.Parallel()
+
+	w, cleanup := testWallet(t)
+	defer cleanup()
+
+	payTo := func(addr btcutil.Address) []*wire.TxOut {
+		pkScript, err := txscript.PayToAddrScript(addr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return []*wire.TxOut{wire.NewTxOut(1000, pkScript)}
+	}
+
+	used, err := w.NewAddress(0, waddrmgr.KeyScopeBIP0084)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fresh, err := w.NewAddress(0, waddrmgr.KeyScopeBIP0084)
+	if err != nil {
+		t.Fatal(err)
+	}
+	foreign, err := btcutil.NewAddressPubKeyHash(
+		make([]byte, 20), w.chainParams,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = walletdb.Update(w.db, func(tx walletdb.ReadWriteTx) er.R {
+		ns := tx.ReadWriteBucket(waddrmgrNamespaceKey)
+		return w.Manager.MarkUsed(ns, used)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		policy  AddressReusePolicy
+		addr    btcutil.Address
+		blocked bool
+	}{
+		{policy: AddressReuseAllow, addr: used},
+		{policy: AddressReuseWarn, addr: used},
+		{policy: AddressReuseBlock, addr: used, blocked: true},
+		{policy: AddressReuseBlock, addr: fresh},
+		{policy: AddressReuseBlock, addr: foreign},
+	}
+
+	for _, test := range tests {
+		w.SetAddressReusePolicy(test.policy)
+		err := w.checkAddressReuse(payTo(test.addr))
+		switch {
+		case test.blocked && !ErrAddressReuse.Is(err):
+			t.Fatalf("%v: expected ErrAddressReuse sending to %v, "+
+				"got %v", test.policy, test.addr, err)
+		case !test.blocked && err != nil:
+			t.Fatalf("%v: unexpected error sending to %v: %v",
+				test.policy, test.addr, err)
+		}
+	}
+}
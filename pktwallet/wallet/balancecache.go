@@ -0,0 +1,143 @@
+package wallet
+
+import (
+	"sync"
+
+	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/chaincfg/chainhash"
+	"github.com/pkt-cash/pktd/pktwallet/waddrmgr"
+	"github.com/pkt-cash/pktd/pktwallet/walletdb"
+	"github.com/pkt-cash/pktd/pktwallet/wtxmgr"
+	"github.com/pkt-cash/pktd/txscript"
+)
+
+// balanceCacheEntry holds the per-account balances which were computed for a
+// given number of confirmations while the wallet was synced to tip.
+type balanceCacheEntry struct {
+	tip      chainhash.Hash
+	accounts map[uint32]Balances
+}
+
+// balanceCache is an in-memory cache of per-account balances. Computing the
+// balance requires a walk of every unspent output in the wallet so we keep
+// the result around until either a relevant transaction is stored, a block
+// is rolled back, or the wallet moves to a new tip (which changes the number
+// of confirmations of every output).
+type balanceCache struct {
+	mtx     sync.Mutex
+	gen     uint64
+	entries map[int32]*balanceCacheEntry
+}
+
+// invalidate drops every cached entry.
+func (c *balanceCache) invalidate() {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	c.gen++
+	c.entries = nil
+}
+
+// get returns the cached balances for confirms if they were computed at tip.
+func (c *balanceCache) get(confirms int32, tip *chainhash.Hash) (map[uint32]Balances, uint64) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	if e := c.entries[confirms]; e != nil && e.tip == *tip {
+		return e.accounts, c.gen
+	}
+	return nil, c.gen
+}
+
+// put stores balances for confirms, unless the cache has been invalidated
+// since gen was read, in which case the balances might already be stale.
+func (c *balanceCache) put(gen uint64, confirms int32, tip *chainhash.Hash, accounts map[uint32]Balances) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	if c.gen != gen {
+		return
+	}
+	if c.entries == nil {
+		c.entries = make(map[int32]*balanceCacheEntry)
+	}
+	c.entries[confirms] = &balanceCacheEntry{tip: *tip, accounts: accounts}
+}
+
+// invalidateBalances drops the cached balances because dbtx is changing the
+// transaction store. The cache is dropped both immediately and once again when
+// dbtx commits so that a balance computed from a read transaction which began
+// before the commit can never be left in the cache.
+func (w *Wallet) invalidateBalances(dbtx walletdb.ReadWriteTx) {
+	w.balances.invalidate()
+	dbtx.OnCommit(w.balances.invalidate)
+}
+
+// CalculateAccountBalances returns the total, spendable, immature, unconfirmed
+// and final balance of every account which has at least one unspent output.
+// Outputs are considered spendable once they have at least confirms
+// confirmations and final once they have the reorg safety depth. Results are
+// served from an in-memory cache when the transaction store has not changed
+// and the wallet has not moved to a new block since they were last computed.
+func (w *Wallet) CalculateAccountBalances(confirms int32) (map[uint32]Balances, er.R) {
+	syncBlock := w.Manager.SyncedTo()
+	cached, gen := w.balances.get(confirms, &syncBlock.Hash)
+	if cached == nil {
+		accounts := make(map[uint32]Balances)
+		if err := walletdb.View(w.db, func(tx walletdb.ReadTx) er.R {
+			addrmgrNs := tx.ReadBucket(waddrmgrNamespaceKey)
+			txmgrNs := tx.ReadBucket(wtxmgrNamespaceKey)
+			// Re-read the tip inside of the transaction so that it is
+			// consistent with the outputs we are about to visit.
+			syncBlock = w.Manager.SyncedTo()
+			safety := w.ReorgSafetyDepth()
+			return w.TxStore.ForEachUnspentOutput(txmgrNs, nil, func(_ []byte, output *wtxmgr.Credit) er.R {
+				_, addrs, _, err := txscript.ExtractPkScriptAddrs(output.PkScript, w.chainParams)
+				if err != nil || len(addrs) == 0 {
+					// Non-standard outputs are skipped.
+					return nil
+				}
+				_, account, err := w.Manager.AddrAccount(addrmgrNs, addrs[0])
+				if waddrmgr.ErrAddressNotFound.Is(err) {
+					return nil
+				} else if err != nil {
+					return err
+				}
+				bal := accounts[account]
+				bal.Total += output.Amount
+				bal.OutputCount++
+				if output.FromCoinBase && !confirmed(int32(w.chainParams.CoinbaseMaturity),
+					output.Height, syncBlock.Height) {
+					bal.ImmatureReward += output.Amount
+				} else if confirmed(confirms, output.Height, syncBlock.Height) {
+					bal.Spendable += output.Amount
+				} else {
+					bal.Unconfirmed += output.Amount
+				}
+				if confirmed(safety, output.Height, syncBlock.Height) {
+					bal.Final += output.Amount
+				}
+				accounts[account] = bal
+				return nil
+			})
+		}); err != nil {
+			return nil, err
+		}
+		w.balances.put(gen, confirms, &syncBlock.Hash, accounts)
+		cached = accounts
+	}
+
+	// Hand out a copy so that callers cannot modify the cache.
+	out := make(map[uint32]Balances, len(cached))
+	for account, bal := range cached {
+		out[account] = bal
+	}
+	return out, nil
+}
+
+// CalculateAccountBalance returns the balance breakdown of a single account,
+// see CalculateAccountBalances.
+func (w *Wallet) CalculateAccountBalance(account uint32, confirms int32) (Balances, er.R) {
+	bals, err := w.CalculateAccountBalances(confirms)
+	if err != nil {
+		return Balances{}, err
+	}
+	return bals[account], nil
+}
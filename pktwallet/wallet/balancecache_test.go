@@ -0,0 +1,153 @@
+package wallet
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pkt-cash/pktd/btcutil"
+	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/chaincfg/chainhash"
+	"github.com/pkt-cash/pktd/pktwallet/waddrmgr"
+	"github.com/pkt-cash/pktd/pktwallet/walletdb"
+	"github.com/pkt-cash/pktd/pktwallet/wtxmgr"
+	"github.com/pkt-cash/pktd/txscript"
+	"github.com/pkt-cash/pktd/wire"
+	"github.com/pkt-cash/pktd/wire/constants"
+)
+
+// payWalletNonce makes the transactions created by payWallet unique.
+var payWalletNonce uint32
+
+// payWallet stores a transaction with numOutputs outputs of amt each paying
+// to the wallet's current address, as if it had been sent by the chain
+// backend. If block is nil, the transaction is unconfirmed.
+func payWallet(t testing.TB, w *Wallet, numOutputs int, amt btcutil.Amount,
+	block *wtxmgr.BlockMeta) *wtxmgr.TxRecord {
+
+	addr, err := w.CurrentAddress(0, waddrmgr.KeyScopeBIP0084)
+	if err != nil {
+		t.Fatalf("unable to get current address: %v", err)
+	}
+	pkScript, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		t.Fatalf("unable to create pkScript: %v", err)
+	}
+	payWalletNonce++
+	tx := wire.NewMsgTx(constants.TxVersion)
+	tx.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: wire.OutPoint{Index: payWalletNonce},
+	})
+	for i := 0; i < numOutputs; i++ {
+		tx.AddTxOut(wire.NewTxOut(int64(amt), pkScript))
+	}
+	rec, err := wtxmgr.NewTxRecordFromMsgTx(tx, time.Now())
+	if err != nil {
+		t.Fatalf("unable to create tx record: %v", err)
+	}
+	if err := walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) er.R {
+		return w.addRelevantTx(dbtx, rec, block)
+	}); err != nil {
+		t.Fatalf("unable to add relevant tx: %v", err)
+	}
+	return rec
+}
+
+// setSyncedTo moves the wallet's tip to a block at height.
+func setSyncedTo(t testing.TB, w *Wallet, height int32) {
+	bs := waddrmgr.BlockStamp{
+		Height:    height,
+		Hash:      chainhash.DoubleHashH([]byte{byte(height), byte(height >> 8)}),
+		Timestamp: time.Now(),
+	}
+	if err := walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) er.R {
+		return w.Manager.SetSyncedTo(dbtx.ReadWriteBucket(waddrmgrNamespaceKey), &bs)
+	}); err != nil {
+		t.Fatalf("unable to set synced to: %v", err)
+	}
+}
+
+// TestAccountBalanceCache ensures that cached balances are dropped when a
+// relevant transaction is stored, when a block is rolled back and when the
+// wallet moves to a new tip.
+func TestAccountBalanceCache(t *testing.T) {
+	w, cleanup := testWallet(t)
+	defer cleanup()
+
+	check := func(confirms int32, want Balances) {
+		t.Helper()
+		bal, err := w.CalculateAccountBalance(waddrmgr.DefaultAccountNum, confirms)
+		if err != nil {
+			t.Fatalf("unable to calculate balance: %v", err)
+		}
+		if bal != want {
+			t.Fatalf("unexpected balance with %d confirms: want %+v, got %+v",
+				confirms, want, bal)
+		}
+	}
+
+	setSyncedTo(t, w, 100)
+	check(1, Balances{})
+
+	// An unconfirmed payment must show up right away even though an empty
+	// balance has been cached at the same tip.
+	payWallet(t, w, 1, 1000, nil)
+	check(1, Balances{Total: 1000, Unconfirmed: 1000, OutputCount: 1})
+	check(0, Balances{Total: 1000, Spendable: 1000, OutputCount: 1})
+
+	// Payments which are confirmed at the tip are spendable with one
+	// confirmation but not with two, until the next block comes in.
+	block := &wtxmgr.BlockMeta{
+		Block: wtxmgr.Block{
+			Hash:   chainhash.DoubleHashH([]byte("block 101")),
+			Height: 101,
+		},
+		Time: time.Now(),
+	}
+	payWallet(t, w, 2, 500, block)
+	setSyncedTo(t, w, 101)
+	check(1, Balances{Total: 2000, Spendable: 1000, Unconfirmed: 1000, OutputCount: 3})
+	check(2, Balances{Total: 2000, Unconfirmed: 2000, OutputCount: 3})
+	setSyncedTo(t, w, 102)
+	check(2, Balances{Total: 2000, Spendable: 1000, Unconfirmed: 1000, OutputCount: 3})
+
+	// Rolling back the block must drop its outputs from the balance.
+	if err := walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) er.R {
+		w.invalidateBalances(dbtx)
+		return w.TxStore.RollbackOne(dbtx.ReadWriteBucket(wtxmgrNamespaceKey), 101)
+	}); err != nil {
+		t.Fatalf("unable to roll back block: %v", err)
+	}
+	check(2, Balances{Total: 2000, Unconfirmed: 2000, OutputCount: 3})
+}
+
+func benchmarkCalculateBalance(b *testing.B, cached bool) {
+	w, cleanup := testWallet(b)
+	defer cleanup()
+
+	setSyncedTo(b, w, 100)
+	for i := 0; i < 50; i++ {
+		payWallet(b, w, 100, 1000, nil)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if !cached {
+			w.balances.invalidate()
+		}
+		if _, err := w.CalculateBalance(1); err != nil {
+			b.Fatalf("unable to calculate balance: %v", err)
+		}
+	}
+}
+
+// BenchmarkCalculateBalanceUncached measures the cost of walking every
+// unspent output of a wallet with 5000 UTXOs.
+func BenchmarkCalculateBalanceUncached(b *testing.B) {
+	benchmarkCalculateBalance(b, false)
+}
+
+// BenchmarkCalculateBalanceCached measures the cost of a balance query which
+// is served from the balance cache.
+func BenchmarkCalculateBalanceCached(b *testing.B) {
+	benchmarkCalculateBalance(b, true)
+}
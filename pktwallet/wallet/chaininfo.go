@@ -0,0 +1,71 @@
+package wallet
+
+import (
+	"time"
+
+	"github.com/pkt-cash/pktd/chaincfg/chainhash"
+	"github.com/pkt-cash/pktd/pktwallet/waddrmgr"
+)
+
+// ChainInfo is a snapshot of the state of the best chain as last seen by the
+// wallet.  It is kept so that chain information can still be served while the
+// chain backend is unreachable.
+type ChainInfo struct {
+	Chain                string
+	Height               int32
+	Hash                 chainhash.Hash
+	BlockTime            time.Time
+	InitialBlockDownload bool
+	VerificationProgress float64
+
+	// CachedAt is the time at which this snapshot was taken.
+	CachedAt time.Time
+}
+
+// CachedChainInfo returns the most recently cached chain info, or nil if no
+// chain info has been cached yet.
+func (w *Wallet) CachedChainInfo() *ChainInfo {
+	w.chainInfoLock.RLock()
+	defer w.chainInfoLock.RUnlock()
+	if w.chainInfo == nil {
+		return nil
+	}
+	ci := *w.chainInfo
+	return &ci
+}
+
+// CacheChainInfo replaces the cached chain info with a copy of ci, stamping it
+// with the current time.
+func (w *Wallet) CacheChainInfo(ci *ChainInfo) {
+	cached := *ci
+	cached.CachedAt = time.Now()
+	w.chainInfoLock.Lock()
+	w.chainInfo = &cached
+	w.chainInfoLock.Unlock()
+}
+
+// cacheChainTip updates the tip of the cached chain info from a newly
+// connected block, leaving the remaining fields as they were last seen.  A
+// block below the cached tip is ignored as the cache may already hold a
+// better tip learned from the chain backend.
+func (w *Wallet) cacheChainTip(bs *waddrmgr.BlockStamp) {
+	w.chainInfoLock.Lock()
+	defer w.chainInfoLock.Unlock()
+	ci := w.chainInfo
+	if ci == nil {
+		ci = &ChainInfo{
+			Chain:                w.chainParams.Name,
+			InitialBlockDownload: true,
+		}
+	} else if bs.Height < ci.Height {
+		return
+	} else {
+		cp := *ci
+		ci = &cp
+	}
+	ci.Height = bs.Height
+	ci.Hash = bs.Hash
+	ci.BlockTime = bs.Timestamp
+	ci.CachedAt = time.Now()
+	w.chainInfo = ci
+}
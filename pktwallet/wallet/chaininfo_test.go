@@ -0,0 +1,71 @@
+package wallet
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pkt-cash/pktd/chaincfg"
+	"github.com/pkt-cash/pktd/chaincfg/chainhash"
+	"github.com/pkt-cash/pktd/pktwallet/waddrmgr"
+)
+
+// TestCachedChainInfo asserts that connected blocks advance the cached chain
+// tip without clobbering a better tip learned from the chain backend.
+func TestCachedChainInfo(t *testing.T) {
+	w := &Wallet{chainParams: &chaincfg.TestNet3Params}
+
+	if ci := w.CachedChainInfo(); ci != nil {
+		t.Fatalf("expected no cached chain info, got %v", ci)
+	}
+
+	// A connected block populates the cache.
+	blockTime := time.Unix(1600000000, 0)
+	w.cacheChainTip(&waddrmgr.BlockStamp{
+		Height:    10,
+		Hash:      chainhash.Hash{10},
+		Timestamp: blockTime,
+	})
+	ci := w.CachedChainInfo()
+	if ci == nil {
+		t.Fatal("expected cached chain info")
+	}
+	if ci.Chain != chaincfg.TestNet3Params.Name || ci.Height != 10 ||
+		ci.Hash != (chainhash.Hash{10}) || !ci.BlockTime.Equal(blockTime) {
+
+		t.Fatalf("unexpected cached chain info %v", ci)
+	}
+	if ci.CachedAt.IsZero() {
+		t.Fatal("expected cache time to be set")
+	}
+
+	// Info from the backend replaces the cache entirely.
+	w.CacheChainInfo(&ChainInfo{
+		Chain:                "backend",
+		Height:               20,
+		Hash:                 chainhash.Hash{20},
+		VerificationProgress: 0.5,
+	})
+
+	// A block below the backend tip leaves the cache untouched.
+	w.cacheChainTip(&waddrmgr.BlockStamp{
+		Height: 11,
+		Hash:   chainhash.Hash{11},
+	})
+	ci = w.CachedChainInfo()
+	if ci.Height != 20 || ci.Hash != (chainhash.Hash{20}) {
+		t.Fatalf("expected tip 20, got %v", ci.Height)
+	}
+
+	// A block above it advances the tip but keeps the backend's info.
+	w.cacheChainTip(&waddrmgr.BlockStamp{
+		Height: 21,
+		Hash:   chainhash.Hash{21},
+	})
+	ci = w.CachedChainInfo()
+	if ci.Height != 21 || ci.Hash != (chainhash.Hash{21}) {
+		t.Fatalf("expected tip 21, got %v", ci.Height)
+	}
+	if ci.Chain != "backend" || ci.VerificationProgress != 0.5 {
+		t.Fatalf("expected backend info to be kept, got %v", ci)
+	}
+}
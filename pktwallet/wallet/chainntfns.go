@@ -55,7 +55,9 @@ func (w *Wallet) storeTxns(
 // return nil
 
 func (w *Wallet) _rollbackBlock(dbtx walletdb.ReadWriteTx, bs waddrmgr.BlockStamp) er.R {
-	log.Infof("Rollback of block [%v @ %v]", bs.Hash, bs.Height)
+	log.With(
+		log.F("height", bs.Height), log.F("hash", bs.Hash),
+	).Infof("Rollback of block [%v @ %v]", bs.Hash, bs.Height)
 	addrmgrNs := dbtx.ReadWriteBucket(waddrmgrNamespaceKey)
 	txmgrNs := dbtx.ReadWriteBucket(wtxmgrNamespaceKey)
 
@@ -73,7 +75,11 @@ func (w *Wallet) _rollbackBlock(dbtx walletdb.ReadWriteTx, bs waddrmgr.BlockStam
 	// the timestamp so we're sure to get an answer.
 	// If this actually ever matters, it means the next block is about to be rolled
 	// back as well.
-	realBsP, err := getBlockStamp(w.chainClient, bsP.Height)
+	chainClient, err := w.requireChainClient()
+	if err != nil {
+		return err
+	}
+	realBsP, err := getBlockStamp(chainClient, bsP.Height)
 	if err != nil {
 		return err
 	}
@@ -84,6 +90,8 @@ func (w *Wallet) _rollbackBlock(dbtx walletdb.ReadWriteTx, bs waddrmgr.BlockStam
 		return err
 	}
 
+	w.noteRollback(bs.Height, bs.Height)
+	w.invalidateBalances(dbtx)
 	err = w.TxStore.RollbackOne(txmgrNs, bs.Height)
 	if err != nil && !wtxmgr.ErrNoExists.Is(err) {
 		return err
@@ -103,6 +111,7 @@ func (w *Wallet) addRelevantTx(dbtx walletdb.ReadWriteTx, rec *wtxmgr.TxRecord,
 	// relevant.  This assumption will not hold true when SPV support is
 	// added, but until then, simply insert the transaction because there
 	// should either be one or more relevant inputs or outputs.
+	w.invalidateBalances(dbtx)
 	err := w.TxStore.InsertTx2(txmgrNs, rec, block)
 	if err != nil {
 		return err
@@ -311,6 +320,14 @@ func birthdaySanityCheck(chainConn chainConn,
 		return &birthdayBlock, nil
 	}
 
+	// A birthday block with only a height was given when the wallet was
+	// created, it is looked up along with the initial sync state when the
+	// wallet first syncs.
+	if birthdayBlock.Hash == (chainhash.Hash{}) {
+		return nil, waddrmgr.ErrBirthdayBlockNotSet.New(
+			"birthday block not located yet", nil)
+	}
+
 	// Otherwise, we'll attempt to locate a better one now that we have
 	// access to the chain.
 	newBirthdayBlock, err := locateBirthdayBlock(chainConn, birthdayTimestamp)
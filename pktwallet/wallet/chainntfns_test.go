@@ -296,3 +296,58 @@ func TestBirthdaySanityCheckHigherEstimate(t *testing.T) {
 			"%v vs %v", birthdayStore.syncedTo, birthdayBlock)
 	}
 }
+
+// TestBirthdayHeight ensures that a birthday height given on creation is only
+// looked up on the first sync, where it is clamped to the best block, and that
+// it can't be set once the birthday block has been located.
+func TestBirthdayHeight(t *testing.T) {
+	t.Parallel()
+
+	w, cleanup := testWallet(t)
+	defer cleanup()
+
+	if _, hasHeight, err := w.birthdayHeight(); err != nil || hasHeight {
+		t.Fatalf("expected no birthday height, got %v %v", hasHeight, err)
+	}
+
+	if err := w.SetBirthdayHeight(1337); err != nil {
+		t.Fatalf("unable to set birthday height: %v", err)
+	}
+	height, hasHeight, err := w.birthdayHeight()
+	if err != nil || !hasHeight || height != 1337 {
+		t.Fatalf("expected birthday height 1337, got %d %v %v", height,
+			hasHeight, err)
+	}
+
+	// The sanity check leaves the lookup to the initial sync.
+	birthdayStore := &walletBirthdayStore{db: w.db, manager: w.Manager}
+	chainConn := createMockChainConn(
+		genesis.Block(chainParams.GenesisHash), 1000, defaultBlockInterval,
+	)
+	_, err = birthdaySanityCheck(chainConn, birthdayStore)
+	if !waddrmgr.ErrBirthdayBlockNotSet.Is(err) {
+		t.Fatalf("expected ErrBirthdayBlockNotSet, got %v", err)
+	}
+
+	// The chain doesn't reach the birthday height yet, so the best block
+	// is used.
+	birthdayBlock, err := birthdayBlockAtHeight(chainConn, height)
+	if err != nil {
+		t.Fatalf("unable to locate birthday block: %v", err)
+	}
+	if birthdayBlock.Height != 1000 ||
+		birthdayBlock.Hash != chainConn.blockHashes[1000] {
+
+		t.Fatalf("expected the best block, got %v", birthdayBlock)
+	}
+
+	if err := birthdayStore.SetBirthdayBlock(*birthdayBlock); err != nil {
+		t.Fatalf("unable to set birthday block: %v", err)
+	}
+	if _, hasHeight, err := w.birthdayHeight(); err != nil || hasHeight {
+		t.Fatalf("expected no birthday height, got %v %v", hasHeight, err)
+	}
+	if err := w.SetBirthdayHeight(10); err == nil {
+		t.Fatal("expected birthday height of a synced wallet to fail")
+	}
+}
@@ -0,0 +1,99 @@
+package wallet
+
+import (
+	"github.com/pkt-cash/pktd/btcutil"
+	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/pktwallet/waddrmgr"
+	"github.com/pkt-cash/pktd/pktwallet/walletdb"
+	"github.com/pkt-cash/pktd/pktwallet/wtxmgr"
+	"github.com/pkt-cash/pktd/txscript"
+)
+
+// ChangeType decides the type of the address which change is sent to when a
+// transaction doesn't specify a change address.
+type ChangeType uint8
+
+const (
+	// ChangeTypeInput sends change back to the address of one of the
+	// inputs, whatever its type.
+	ChangeTypeInput ChangeType = iota
+
+	// ChangeTypeBech32 sends change to a native segwit (p2wpkh) address.
+	ChangeTypeBech32
+
+	// ChangeTypeLegacy sends change to a legacy (p2pkh) address.
+	ChangeTypeLegacy
+)
+
+// changeTypeNames are the names of the change types.
+var changeTypeNames = enumNames{
+	kind:  "change type",
+	names: []string{"input", "bech32", "legacy"},
+}
+
+// String returns the name of the change type as used in the configuration.
+func (t ChangeType) String() string {
+	return changeTypeNames.name(uint8(t))
+}
+
+// ParseChangeType parses a change type from its name, one of input, bech32
+// or legacy.
+func ParseChangeType(s string) (ChangeType, er.R) {
+	t, err := changeTypeNames.parse(s)
+	return ChangeType(t), err
+}
+
+// SetChangeType sets the type of the addresses change is sent to. It must be
+// called before the wallet is used.
+func (w *Wallet) SetChangeType(changeType ChangeType) {
+	w.changeType = changeType
+}
+
+// matches returns whether the address is of the change type.
+func (t ChangeType) matches(addr btcutil.Address) bool {
+	switch t {
+	case ChangeTypeBech32:
+		_, ok := addr.(*btcutil.AddressWitnessPubKeyHash)
+		return ok
+	case ChangeTypeLegacy:
+		_, ok := addr.(*btcutil.AddressPubKeyHash)
+		return ok
+	default:
+		return true
+	}
+}
+
+// changeAddress returns the address which change of a transaction spending
+// the credits is sent to. Change goes back to the address of one of the
+// inputs as long as it is of the configured change type, otherwise a new
+// address of that type is derived. As a hack to allow spending from the
+// imported account, new change addresses are created from account 0.
+func (w *Wallet) changeAddress(addrmgrNs walletdb.ReadWriteBucket,
+	credits []*wtxmgr.Credit) (btcutil.Address, er.R) {
+
+	var changeAddr btcutil.Address
+	for _, c := range credits {
+		_, addrs, _, _ := txscript.ExtractPkScriptAddrs(c.PkScript, w.chainParams)
+		if len(addrs) == 1 && w.changeType.matches(addrs[0]) {
+			changeAddr = addrs[0]
+		}
+	}
+	if changeAddr != nil {
+		return changeAddr, nil
+	}
+
+	var scope waddrmgr.KeyScope
+	switch w.changeType {
+	case ChangeTypeBech32:
+		scope = waddrmgr.KeyScopeBIP0084
+	case ChangeTypeLegacy:
+		scope = waddrmgr.KeyScopeBIP0044
+	default:
+		return nil, er.New("Unable to find qualifying change address")
+	}
+	changeAddr, _, err := w.newAddress(addrmgrNs, 0, scope)
+	if err != nil {
+		return nil, err
+	}
+	return changeAddr, nil
+}
@@ -0,0 +1,118 @@
+package wallet
+
+import (
+	"testing"
+
+	"github.com/pkt-cash/pktd/btcutil"
+	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/pktwallet/waddrmgr"
+	"github.com/pkt-cash/pktd/pktwallet/walletdb"
+	"github.com/pkt-cash/pktd/pktwallet/wtxmgr"
+	"github.com/pkt-cash/pktd/txscript"
+)
+
+// TestChangeAddress tests that change is sent back to an input address of the
+// configured change type, and to a new address of that type if no input
+// matches.
+func TestChangeAddress(t *testing.T) {
+	t.Parallel()
+
+	w, cleanup := testWallet(t)
+	defer cleanup()
+
+	legacy, err := w.NewAddress(0, waddrmgr.KeyScopeBIP0044)
+	if err != nil {
+		t.Fatal(err)
+	}
+	bech32, err := w.NewAddress(0, waddrmgr.KeyScopeBIP0084)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	credits := func(addrs ...btcutil.Address) []*wtxmgr.Credit {
+		var credits []*wtxmgr.Credit
+		for _, addr := range addrs {
+			pkScript, err := txscript.PayToAddrScript(addr)
+			if err != nil {
+				t.Fatal(err)
+			}
+			credits = append(credits, &wtxmgr.Credit{
+				PkScript: pkScript,
+			})
+		}
+		return credits
+	}
+
+	tests := []struct {
+		name       string
+		changeType ChangeType
+		credits    []*wtxmgr.Credit
+		expAddr    btcutil.Address
+		expNew     func(btcutil.Address) bool
+	}{
+		{
+			name:       "input legacy",
+			changeType: ChangeTypeInput,
+			credits:    credits(legacy),
+			expAddr:    legacy,
+		},
+		{
+			name:       "bech32 from mixed inputs",
+			changeType: ChangeTypeBech32,
+			credits:    credits(bech32, legacy),
+			expAddr:    bech32,
+		},
+		{
+			name:       "bech32 from legacy input",
+			changeType: ChangeTypeBech32,
+			credits:    credits(legacy),
+			expNew: func(addr btcutil.Address) bool {
+				_, ok := addr.(*btcutil.AddressWitnessPubKeyHash)
+				return ok
+			},
+		},
+		{
+			name:       "legacy from bech32 input",
+			changeType: ChangeTypeLegacy,
+			credits:    credits(bech32),
+			expNew: func(addr btcutil.Address) bool {
+				_, ok := addr.(*btcutil.AddressPubKeyHash)
+				return ok
+			},
+		},
+	}
+
+	for _, test := range tests {
+		w.SetChangeType(test.changeType)
+
+		var changeAddr btcutil.Address
+		err := walletdb.Update(w.db, func(tx walletdb.ReadWriteTx) er.R {
+			ns := tx.ReadWriteBucket(waddrmgrNamespaceKey)
+			var err er.R
+			changeAddr, err = w.changeAddress(ns, test.credits)
+			return err
+		})
+		if err != nil {
+			t.Fatalf("%s: unable to get change address: %v",
+				test.name, err)
+		}
+
+		if test.expAddr != nil {
+			if changeAddr.EncodeAddress() != test.expAddr.EncodeAddress() {
+				t.Fatalf("%s: expected change to %v, got %v",
+					test.name, test.expAddr, changeAddr)
+			}
+			continue
+		}
+		if changeAddr.EncodeAddress() == legacy.EncodeAddress() ||
+			changeAddr.EncodeAddress() == bech32.EncodeAddress() {
+
+			t.Fatalf("%s: expected a new change address, got %v",
+				test.name, changeAddr)
+		}
+		if !test.expNew(changeAddr) {
+			t.Fatalf("%s: change address %v is not of type %v",
+				test.name, changeAddr, test.changeType)
+		}
+	}
+}
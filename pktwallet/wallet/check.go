@@ -0,0 +1,36 @@
+package wallet
+
+import (
+	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/pktwallet/waddrmgr"
+	"github.com/pkt-cash/pktd/pktwallet/walletdb"
+	"github.com/pkt-cash/pktd/pktwallet/wtxmgr"
+)
+
+// CheckDB verifies the structural invariants of the address and transaction
+// managers stored in db, see waddrmgr.CheckAccounts and wtxmgr.Check.  It only
+// reads from db, which may have been opened with walletdb.OpenReadOnly, and
+// returns a description of each inconsistency found.
+func CheckDB(db walletdb.DB) ([]string, er.R) {
+	var problems []string
+	err := walletdb.View(db, func(tx walletdb.ReadTx) er.R {
+		addrmgrNs := tx.ReadBucket(waddrmgrNamespaceKey)
+		txmgrNs := tx.ReadBucket(wtxmgrNamespaceKey)
+		if addrmgrNs == nil || txmgrNs == nil {
+			return er.New("missing address or transaction manager " +
+				"namespace, not a wallet database")
+		}
+
+		accountProblems, err := waddrmgr.CheckAccounts(addrmgrNs)
+		if err != nil {
+			return err
+		}
+		txProblems, err := wtxmgr.Check(txmgrNs)
+		if err != nil {
+			return err
+		}
+		problems = append(accountProblems, txProblems...)
+		return nil
+	})
+	return problems, err
+}
@@ -0,0 +1,255 @@
+package wallet
+
+import (
+	"sort"
+
+	"github.com/pkt-cash/pktd/btcutil"
+	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/pktlog/log"
+	"github.com/pkt-cash/pktd/pktwallet/wallet/internal/txsizes"
+	"github.com/pkt-cash/pktd/pktwallet/wallet/txauthor"
+	"github.com/pkt-cash/pktd/pktwallet/wallet/txrules"
+	"github.com/pkt-cash/pktd/pktwallet/walletdb"
+	"github.com/pkt-cash/pktd/pktwallet/wtxmgr"
+	"github.com/pkt-cash/pktd/txscript"
+	"github.com/pkt-cash/pktd/wire"
+)
+
+type (
+	// ConsolidateReq describes which outputs of the wallet to consolidate
+	// into a single output paying back to the wallet.
+	ConsolidateReq struct {
+		// Threshold is the value below which outputs are consolidated.
+		Threshold btcutil.Amount
+
+		// MaxInputs is the maximum number of outputs to consolidate, if
+		// it is zero or less as many as fit in a transaction are used.
+		MaxInputs int
+
+		// Minconf is the number of confirmations an output needs to be
+		// consolidated.
+		Minconf int32
+
+		// FeeSatPerKB is the fee rate of the consolidation transaction.
+		FeeSatPerKB btcutil.Amount
+
+		// DryRun creates the transaction without signing, storing or
+		// publishing it.
+		DryRun bool
+	}
+
+	// ConsolidateResult describes the outcome of a consolidation.
+	ConsolidateResult struct {
+		// Tx is the consolidation transaction, it is nil if the
+		// consolidation was skipped.
+		Tx *txauthor.AuthoredTx
+
+		// InputCount is the number of outputs which were consolidated.
+		InputCount int
+
+		// InputAmount is the total value of the consolidated outputs.
+		InputAmount btcutil.Amount
+
+		// Fee is the fee paid by the consolidation transaction.
+		Fee btcutil.Amount
+
+		// Skipped is set if there was nothing worth consolidating,
+		// because fewer than two outputs are below the threshold or
+		// because the fee would eat up the value consolidated.
+		Skipped bool
+	}
+
+	consolidateRequest struct {
+		req  ConsolidateReq
+		resp chan consolidateResponse
+	}
+	consolidateResponse struct {
+		res *ConsolidateResult
+		err er.R
+	}
+)
+
+// Consolidate combines the outputs of the wallet which are below the threshold
+// of the request into a single output paying back to the wallet, smallest
+// outputs first. Locked outputs are never consolidated. Like CreateSimpleTx,
+// the input selection is serialized with all other transaction creation.
+func (w *Wallet) Consolidate(req ConsolidateReq) (*ConsolidateResult, er.R) {
+	cr := consolidateRequest{
+		req:  req,
+		resp: make(chan consolidateResponse),
+	}
+	w.consolidateRequests <- cr
+	resp := <-cr.resp
+	if resp.err != nil || resp.res.Skipped || req.DryRun {
+		return resp.res, resp.err
+	}
+
+	tx := resp.res.Tx.Tx
+	txHash, err := w.reliablyPublishTransaction(tx, "")
+	if err != nil {
+		return nil, err
+	}
+	if *txHash != tx.TxHash() {
+		return nil, er.New("tx hash mismatch")
+	}
+	return resp.res, nil
+}
+
+// consolidationCredits returns the spendable credits below the threshold,
+// smallest first, limited to the number of inputs which fit in a
+// transaction.
+func (w *Wallet) consolidationCredits(txmgrNs walletdb.ReadBucket,
+	req ConsolidateReq, curHeight int32) ([]*wtxmgr.Credit, er.R) {
+
+	var credits []*wtxmgr.Credit
+	err := w.TxStore.ForEachUnspentOutput(txmgrNs, nil, func(_ []byte,
+		output *wtxmgr.Credit) er.R {
+
+		if output.Amount >= req.Threshold {
+			return nil
+		}
+		if output.FromCoinBase {
+			maturity := int32(w.chainParams.CoinbaseMaturity)
+			if !confirmed(maturity, output.Height, curHeight) ||
+				txrules.IsBurned(output, w.chainParams, curHeight+1440) {
+				return nil
+			}
+		}
+		if req.Minconf > 0 && !confirmed(req.Minconf, output.Height, curHeight) {
+			return nil
+		}
+		if w.LockedOutpoint(output.OutPoint) {
+			return nil
+		}
+		credits = append(credits, output)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(credits, func(i, j int) bool {
+		return credits[i].Amount < credits[j].Amount
+	})
+
+	maxInputs := MaxInputsPerTx
+	if req.MaxInputs > 0 && req.MaxInputs < maxInputs {
+		maxInputs = req.MaxInputs
+	}
+	var selected []*wtxmgr.Credit
+	for _, c := range credits {
+		if !txscript.IsWitnessProgram(c.PkScript) &&
+			maxInputs > MaxInputsPerTxLegacy {
+			maxInputs = MaxInputsPerTxLegacy
+		}
+		if len(selected) >= maxInputs {
+			break
+		}
+		selected = append(selected, c)
+	}
+	return selected, nil
+}
+
+// consolidate creates the consolidation transaction, it must only be called
+// by the txCreator.
+func (w *Wallet) consolidate(req ConsolidateReq) (*ConsolidateResult, er.R) {
+	chainClient, err := w.requireChainClient()
+	if err != nil {
+		return nil, err
+	}
+
+	dbtx, err := w.db.BeginReadWriteTx()
+	if err != nil {
+		return nil, err
+	}
+	defer dbtx.Rollback()
+
+	addrmgrNs := dbtx.ReadWriteBucket(waddrmgrNamespaceKey)
+	txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+
+	bs, err := chainClient.BlockStamp()
+	if err != nil {
+		return nil, err
+	}
+
+	credits, err := w.consolidationCredits(txmgrNs, req, bs.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &ConsolidateResult{InputCount: len(credits)}
+	var p2pkh, p2wpkh, nested int
+	for _, c := range credits {
+		res.InputAmount += c.Amount
+		switch {
+		case txscript.IsPayToScriptHash(c.PkScript):
+			nested++
+		case txscript.IsPayToWitnessPubKeyHash(c.PkScript):
+			p2wpkh++
+		default:
+			p2pkh++
+		}
+	}
+	if len(credits) < 2 {
+		log.Debugf("Skipping consolidation, only [%d] outputs below [%s]",
+			len(credits), req.Threshold)
+		res.Skipped = true
+		return res, nil
+	}
+
+	changeAddr, err := w.changeAddress(addrmgrNs, credits)
+	if err != nil {
+		return nil, err
+	}
+	pkScript, err := txscript.PayToAddrScript(changeAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	// Skip the consolidation if the fee would eat up the value of the
+	// consolidated outputs, or leave only dust. A zero value output sweeps
+	// all inputs minus the fee to it.
+	outputs := []*wire.TxOut{wire.NewTxOut(0, pkScript)}
+	size := txsizes.EstimateVirtualSize(p2pkh, p2wpkh, nested, outputs, true)
+	res.Fee = txrules.FeeForSerializeSize(req.FeeSatPerKB, size)
+	if res.Fee >= res.InputAmount || txrules.IsDustAmount(
+		res.InputAmount-res.Fee, len(pkScript),
+		txrules.DefaultRelayFeePerKb) {
+
+		log.Debugf("Skipping consolidation of [%d] outputs worth [%s], "+
+			"the fee would be [%s]", len(credits), res.InputAmount,
+			res.Fee)
+		res.Skipped = true
+		return res, nil
+	}
+
+	tx, err := txauthor.NewUnsignedTransaction(
+		outputs, req.FeeSatPerKB, makeInputSource(credits),
+		nil, false,
+	)
+	if err != nil {
+		return nil, err
+	}
+	res.Tx = tx
+	res.Fee = tx.TotalInput - btcutil.Amount(tx.Tx.TxOut[0].Value)
+
+	if req.DryRun {
+		return res, nil
+	}
+
+	err = tx.AddAllInputScripts(secretSource{w.Manager, addrmgrNs})
+	if err != nil {
+		return nil, err
+	}
+	if err := validateMsgTx1(tx.Tx); err != nil {
+		return nil, err
+	}
+	if err := dbtx.Commit(); err != nil {
+		return nil, err
+	}
+
+	if err := w.watch.WatchAddr(changeAddr); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
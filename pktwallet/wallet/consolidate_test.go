@@ -0,0 +1,146 @@
+package wallet
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pkt-cash/pktd/btcutil"
+	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/pktwallet/waddrmgr"
+	"github.com/pkt-cash/pktd/pktwallet/walletdb"
+	"github.com/pkt-cash/pktd/pktwallet/wtxmgr"
+	"github.com/pkt-cash/pktd/txscript"
+	"github.com/pkt-cash/pktd/wire"
+)
+
+// TestConsolidate tests that only unlocked outputs below the threshold are
+// consolidated, and that the consolidation is skipped if the fee would eat up
+// the value consolidated.
+func TestConsolidate(t *testing.T) {
+	t.Parallel()
+
+	w, cleanup := testWallet(t)
+	defer cleanup()
+
+	addr, err := w.NewAddress(0, waddrmgr.KeyScopeBIP0084)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pkScript, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Add confirmed outputs paying to the wallet, three small ones and a
+	// large one.
+	block := &wtxmgr.BlockMeta{
+		Block: wtxmgr.Block{Hash: *testBlockHash, Height: testBlockHeight},
+		Time:  time.Unix(1387737310, 0),
+	}
+	var outPoints []wire.OutPoint
+	for i, value := range []int64{10000, 20000, 30000, 10000000} {
+		tx := &wire.MsgTx{
+			TxIn: []*wire.TxIn{{
+				PreviousOutPoint: wire.OutPoint{Index: uint32(i)},
+			}},
+			TxOut: []*wire.TxOut{wire.NewTxOut(value, pkScript)},
+		}
+		rec, err := wtxmgr.NewTxRecordFromMsgTx(tx, time.Now())
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) er.R {
+			ns := dbtx.ReadWriteBucket(wtxmgrNamespaceKey)
+			if err := w.TxStore.InsertTx(ns, rec, block); err != nil {
+				return err
+			}
+			return w.TxStore.AddCredit(ns, rec, block, 0, false)
+		})
+		if err != nil {
+			t.Fatalf("unable to add credit: %v", err)
+		}
+		outPoints = append(outPoints, wire.OutPoint{Hash: rec.Hash})
+	}
+
+	req := ConsolidateReq{
+		Threshold:   100000,
+		Minconf:     1,
+		FeeSatPerKB: 1000,
+		DryRun:      true,
+	}
+	res, err := w.consolidate(req)
+	if err != nil {
+		t.Fatalf("unable to consolidate: %v", err)
+	}
+	if res.Skipped {
+		t.Fatal("consolidation was skipped")
+	}
+	if res.InputCount != 3 || res.InputAmount != 60000 {
+		t.Fatalf("expected 3 inputs worth 60000, got %d worth %v",
+			res.InputCount, res.InputAmount)
+	}
+	tx := res.Tx.Tx
+	if len(tx.TxIn) != 3 || len(tx.TxOut) != 1 {
+		t.Fatalf("expected 3 inputs and 1 output, got %d and %d",
+			len(tx.TxIn), len(tx.TxOut))
+	}
+	if btcutil.Amount(tx.TxOut[0].Value)+res.Fee != res.InputAmount {
+		t.Fatalf("output %v and fee %v don't add up to %v",
+			tx.TxOut[0].Value, res.Fee, res.InputAmount)
+	}
+
+	// The smallest outputs are consolidated first.
+	req.MaxInputs = 2
+	res, err = w.consolidate(req)
+	if err != nil {
+		t.Fatalf("unable to consolidate: %v", err)
+	}
+	if res.InputCount != 2 || res.InputAmount != 30000 {
+		t.Fatalf("expected 2 inputs worth 30000, got %d worth %v",
+			res.InputCount, res.InputAmount)
+	}
+
+	// Locked outputs are excluded, leaving too few to consolidate.
+	req.MaxInputs = 0
+	w.LockOutpoint(outPoints[0], "test")
+	w.LockOutpoint(outPoints[1], "test")
+	res, err = w.consolidate(req)
+	if err != nil {
+		t.Fatalf("unable to consolidate: %v", err)
+	}
+	if !res.Skipped || res.InputCount != 1 {
+		t.Fatalf("expected consolidation of 1 input to be skipped, "+
+			"got %+v", res)
+	}
+	w.ResetLockedOutpoints(nil)
+
+	// A fee exceeding the value consolidated skips the consolidation.
+	req.FeeSatPerKB = 1000000
+	res, err = w.consolidate(req)
+	if err != nil {
+		t.Fatalf("unable to consolidate: %v", err)
+	}
+	if !res.Skipped || res.Tx != nil {
+		t.Fatalf("expected consolidation to be skipped, got %+v", res)
+	}
+	if res.Fee < res.InputAmount {
+		t.Fatalf("expected fee %v to exceed %v", res.Fee,
+			res.InputAmount)
+	}
+
+	// A dry run works on a locked wallet, a real consolidation needs the
+	// wallet to be unlocked.
+	w.Lock()
+	req.FeeSatPerKB = 1000
+	res, err = w.Consolidate(req)
+	if err != nil {
+		t.Fatalf("unable to consolidate locked wallet: %v", err)
+	}
+	if res.Skipped || res.InputCount != 3 {
+		t.Fatalf("expected 3 inputs to be consolidated, got %+v", res)
+	}
+	req.DryRun = false
+	if _, err := w.Consolidate(req); !waddrmgr.ErrLocked.Is(err) {
+		t.Fatalf("expected locked wallet error, got %v", err)
+	}
+}
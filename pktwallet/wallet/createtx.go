@@ -172,23 +172,16 @@ func (w *Wallet) txToOutputs(txr CreateTxReq) (tx *txauthor.AuthoredTx, err er.R
 
 	inputSource := makeInputSource(eligibleOuts.credits)
 	changeSource := func() ([]byte, er.R) {
-		// Derive the change output script.  As a hack to allow
-		// spending from the imported account, change addresses are
-		// created from account 0.
+		// Derive the change output script, unless a change address
+		// was given it is of the configured change type.
 		var changeAddr btcutil.Address
 		var err er.R
 		if txr.ChangeAddress != nil {
 			changeAddr = *txr.ChangeAddress
 		} else {
-			for _, c := range eligibleOuts.credits {
-				_, addrs, _, _ := txscript.ExtractPkScriptAddrs(c.PkScript, w.chainParams)
-				if len(addrs) == 1 {
-					changeAddr = addrs[0]
-				}
-			}
-			if changeAddr == nil {
-				err = er.New("Unable to find qualifying change address")
-			}
+			changeAddr, err = w.changeAddress(
+				addrmgrNs, eligibleOuts.credits,
+			)
 		}
 		if err != nil {
 			return nil, err
@@ -219,6 +212,12 @@ func (w *Wallet) txToOutputs(txr CreateTxReq) (tx *txauthor.AuthoredTx, err er.R
 		}
 	}
 
+	// The locktime and sequence numbers are committed to by the input
+	// signatures, so they are set before signing.
+	if err := w.setLockTime(tx.Tx, txr.LockTime, txr.Sequence); err != nil {
+		return nil, err
+	}
+
 	// Randomize change position, if change exists, before signing.  This
 	// doesn't affect the serialize size, so the change amount will still
 	// be valid.
@@ -258,7 +257,9 @@ func (w *Wallet) txToOutputs(txr CreateTxReq) (tx *txauthor.AuthoredTx, err er.R
 		if err != nil {
 			return nil, err
 		}
-		w.watch.WatchAddrs(addrs)
+		if err := w.watch.WatchAddrs(addrs); err != nil {
+			return nil, err
+		}
 	}
 
 	return tx, nil
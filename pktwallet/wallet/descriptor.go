@@ -0,0 +1,294 @@
+package wallet
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/btcutil/hdkeychain"
+	"github.com/pkt-cash/pktd/chaincfg"
+	"github.com/pkt-cash/pktd/pktwallet/waddrmgr"
+	"github.com/pkt-cash/pktd/pktwallet/walletdb"
+)
+
+// ErrMalformedDescriptor is returned when an output descriptor can not be
+// parsed, the reason is given in the error.
+var ErrMalformedDescriptor = Err.CodeWithDetail("ErrMalformedDescriptor",
+	"malformed output descriptor")
+
+// descriptorScripts maps the address types which can be described by an
+// output descriptor to the script expression wrapping the key expression.
+var descriptorScripts = map[waddrmgr.AddressType][2]string{
+	waddrmgr.PubKeyHash:          {"pkh(", ")"},
+	waddrmgr.NestedWitnessPubKey: {"sh(wpkh(", "))"},
+	waddrmgr.WitnessPubKey:       {"wpkh(", ")"},
+}
+
+// Descriptor is a BIP0380 output descriptor of the addresses derived from one
+// branch of an account, such as
+// wpkh([d34db33f/84'/0'/0']xpub.../0/*).
+type Descriptor struct {
+	// AddrType is the type of the addresses, it is one of PubKeyHash,
+	// NestedWitnessPubKey and WitnessPubKey.
+	AddrType waddrmgr.AddressType
+
+	// Key is the extended key of the account, the descriptor holds the
+	// private key if it is an extended private key.
+	Key *hdkeychain.ExtendedKey
+
+	// Fingerprint is the fingerprint of the master key and Path is the
+	// derivation path of Key from the master key. Together they are the
+	// origin of the key, which is left out if both are empty.
+	Fingerprint uint32
+	Path        []uint32
+
+	// Branch is the branch of the account the addresses are derived from.
+	Branch uint32
+}
+
+// String returns the descriptor without checksum.
+func (d *Descriptor) String() string {
+	var b strings.Builder
+	script := descriptorScripts[d.AddrType]
+	b.WriteString(script[0])
+	if d.Fingerprint != 0 || len(d.Path) > 0 {
+		fmt.Fprintf(&b, "[%08x", d.Fingerprint)
+		for _, index := range d.Path {
+			if index >= hdkeychain.HardenedKeyStart {
+				fmt.Fprintf(&b, "/%d'",
+					index-hdkeychain.HardenedKeyStart)
+			} else {
+				fmt.Fprintf(&b, "/%d", index)
+			}
+		}
+		b.WriteByte(']')
+	}
+	fmt.Fprintf(&b, "%s/%d/*", d.Key, d.Branch)
+	b.WriteString(script[1])
+	return b.String()
+}
+
+// StringWithChecksum returns the descriptor followed by its checksum.
+func (d *Descriptor) StringWithChecksum() string {
+	desc := d.String()
+	checksum, _ := descriptorChecksum(desc)
+	return desc + "#" + checksum
+}
+
+const (
+	descriptorInputCharset = "0123456789()[],'/*abcdefgh@:$%{}" +
+		"IJKLMNOPQRSTUVWXYZ&+-.;<=>?!^_|~" +
+		"ijklmnopqrstuvwxyzABCDEFGH`#\"\\ "
+	descriptorChecksumCharset = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+)
+
+// descriptorPolyMod computes the BCH code over the symbols of a descriptor
+// which is used as its checksum.
+func descriptorPolyMod(c uint64, value int) uint64 {
+	generator := [5]uint64{
+		0xf5dee51989, 0xa9fdca3312, 0x1bab10e32d, 0x3706b1677a,
+		0x644d626ffd,
+	}
+	top := c >> 35
+	c = (c&0x7ffffffff)<<5 ^ uint64(value)
+	for i, g := range generator {
+		if (top>>uint(i))&1 != 0 {
+			c ^= g
+		}
+	}
+	return c
+}
+
+// descriptorChecksum returns the 8 character checksum of a descriptor as
+// defined by BIP0380. False is returned if the descriptor contains a character
+// which can't be used in a descriptor.
+func descriptorChecksum(desc string) (string, bool) {
+	c := uint64(1)
+	class, classCount := 0, 0
+	for _, ch := range desc {
+		pos := strings.IndexRune(descriptorInputCharset, ch)
+		if pos < 0 {
+			return "", false
+		}
+		c = descriptorPolyMod(c, pos&31)
+		class = class*3 + pos>>5
+		classCount++
+		if classCount == 3 {
+			c = descriptorPolyMod(c, class)
+			class, classCount = 0, 0
+		}
+	}
+	if classCount > 0 {
+		c = descriptorPolyMod(c, class)
+	}
+	for i := 0; i < 8; i++ {
+		c = descriptorPolyMod(c, 0)
+	}
+	c ^= 1
+
+	checksum := make([]byte, 8)
+	for i := range checksum {
+		checksum[i] = descriptorChecksumCharset[(c>>(5*(7-uint(i))))&31]
+	}
+	return string(checksum), true
+}
+
+// ParseDescriptor parses an output descriptor in the form returned by
+// Descriptor.String, optionally followed by its checksum which is verified.
+// It accepts what GetDescriptorInfo accepts, as long as the descriptor
+// describes one branch of an account. The extended key must be for the given
+// network.
+func ParseDescriptor(desc string, params *chaincfg.Params) (*Descriptor, er.R) {
+	p, err := parseDescriptor(desc, params)
+	if err != nil {
+		return nil, err
+	}
+
+	d := &Descriptor{}
+	scripts := strings.Join(p.scripts, "(") + "("
+	found := false
+	for addrType, script := range descriptorScripts {
+		if script[0] == scripts {
+			d.AddrType = addrType
+			found = true
+			break
+		}
+	}
+	if !found || len(p.keys) != 1 {
+		return nil, ErrMalformedDescriptor.New(
+			"unsupported script expression", nil)
+	}
+
+	key := p.keys[0]
+	if key.extKey == nil || len(key.steps) != 1 || !key.ranged ||
+		key.hardenedRange || key.steps[0] >= hdkeychain.HardenedKeyStart {
+
+		return nil, ErrMalformedDescriptor.New("key must be an "+
+			"extended key followed by a branch and /*", nil)
+	}
+	d.Key = key.extKey
+	d.Branch = key.steps[0]
+	d.Fingerprint = key.fingerprint
+	d.Path = key.path
+
+	return d, nil
+}
+
+// ExportedDescriptor is the output descriptor of one branch of an account.
+type ExportedDescriptor struct {
+	Scope       waddrmgr.KeyScope
+	Account     uint32
+	AccountName string
+
+	// Internal is true for the descriptor of the change addresses.
+	Internal bool
+
+	// NextIndex is the index of the next address which will be derived
+	// from the branch.
+	NextIndex uint32
+
+	Descriptor *Descriptor
+}
+
+// ExportDescriptors returns the output descriptors of the external and
+// internal branches of every account of the wallet, so the wallet can be
+// watched or, if private is true, restored by descriptor aware software.
+// Exporting the private descriptors requires the wallet to be unlocked and
+// fails for watching-only wallets.
+func (w *Wallet) ExportDescriptors(private bool) ([]ExportedDescriptor, er.R) {
+	var descs []ExportedDescriptor
+	err := walletdb.View(w.db, func(tx walletdb.ReadTx) er.R {
+		addrmgrNs := tx.ReadBucket(waddrmgrNamespaceKey)
+
+		fingerprint, haveOrigin, err := w.Manager.MasterKeyFingerprint(
+			addrmgrNs,
+		)
+		if err != nil {
+			return err
+		}
+
+		for _, scope := range waddrmgr.DefaultKeyScopes {
+			manager, err := w.Manager.FetchScopedKeyManager(scope)
+			if waddrmgr.ErrScopeNotFound.Is(err) {
+				continue
+			} else if err != nil {
+				return err
+			}
+			schema := manager.AddrSchema()
+
+			var accounts []uint32
+			err = manager.ForEachAccount(addrmgrNs, func(account uint32) er.R {
+				if account != waddrmgr.ImportedAddrAccount {
+					accounts = append(accounts, account)
+				}
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+			sort.Slice(accounts, func(i, j int) bool {
+				return accounts[i] < accounts[j]
+			})
+
+			for _, account := range accounts {
+				props, err := manager.AccountProperties(
+					addrmgrNs, account,
+				)
+				if err != nil {
+					return err
+				}
+				key, err := manager.AccountExtendedKey(
+					addrmgrNs, account, private,
+				)
+				if err != nil {
+					return err
+				}
+
+				var path []uint32
+				if haveOrigin {
+					path = []uint32{
+						scope.Purpose + hdkeychain.HardenedKeyStart,
+						scope.Coin + hdkeychain.HardenedKeyStart,
+						account + hdkeychain.HardenedKeyStart,
+					}
+				}
+				branches := []struct {
+					branch    uint32
+					addrType  waddrmgr.AddressType
+					nextIndex uint32
+				}{{
+					branch:    waddrmgr.ExternalBranch,
+					addrType:  schema.ExternalAddrType,
+					nextIndex: props.ExternalKeyCount,
+				}, {
+					branch:    waddrmgr.InternalBranch,
+					addrType:  schema.InternalAddrType,
+					nextIndex: props.InternalKeyCount,
+				}}
+				for _, b := range branches {
+					if _, ok := descriptorScripts[b.addrType]; !ok {
+						return er.Errorf("address type %v "+
+							"can't be described", b.addrType)
+					}
+					descs = append(descs, ExportedDescriptor{
+						Scope:       scope,
+						Account:     account,
+						AccountName: props.AccountName,
+						Internal:    b.branch == waddrmgr.InternalBranch,
+						NextIndex:   b.nextIndex,
+						Descriptor: &Descriptor{
+							AddrType:    b.addrType,
+							Key:         key,
+							Fingerprint: fingerprint,
+							Path:        path,
+							Branch:      b.branch,
+						},
+					})
+				}
+			}
+		}
+		return nil
+	})
+	return descs, err
+}
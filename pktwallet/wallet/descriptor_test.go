@@ -0,0 +1,253 @@
+package wallet
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/pkt-cash/pktd/btcutil"
+	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/btcutil/hdkeychain"
+	"github.com/pkt-cash/pktd/chaincfg"
+	"github.com/pkt-cash/pktd/pktwallet/waddrmgr"
+	"github.com/pkt-cash/pktd/pktwallet/walletdb"
+)
+
+// TestDescriptorChecksum tests the descriptor checksum against the test vector
+// of BIP0380.
+func TestDescriptorChecksum(t *testing.T) {
+	t.Parallel()
+
+	checksum, ok := descriptorChecksum("raw(deadbeef)")
+	if !ok || checksum != "89f8spxm" {
+		t.Fatalf("expected checksum 89f8spxm, got %q", checksum)
+	}
+	if _, ok := descriptorChecksum("raw(deadbeef)\n"); ok {
+		t.Fatalf("expected invalid character to be rejected")
+	}
+}
+
+// TestParseDescriptor tests that ParseDescriptor accepts the descriptors of
+// account branches in any form GetDescriptorInfo accepts, returning them in
+// the same canonical form, and rejects other descriptors.
+func TestParseDescriptor(t *testing.T) {
+	t.Parallel()
+
+	params := &chaincfg.MainNetParams
+	tests := []struct {
+		name        string
+		desc        string
+		addrType    waddrmgr.AddressType
+		fingerprint uint32
+		path        []uint32
+		branch      uint32
+		private     bool
+	}{{
+		name:        "fingerprint only origin",
+		desc:        "wpkh([d34db33f]" + testVectorXpub + "/0/*)",
+		addrType:    waddrmgr.WitnessPubKey,
+		fingerprint: 0xd34db33f,
+	}, {
+		name: "hardened origin marked with h",
+		desc: "sh(wpkh([d34db33f/49h/0h/0']" + testVectorXpub +
+			"/1/*))",
+		addrType:    waddrmgr.NestedWitnessPubKey,
+		fingerprint: 0xd34db33f,
+		path: []uint32{
+			49 + hdkeychain.HardenedKeyStart,
+			hdkeychain.HardenedKeyStart,
+			hdkeychain.HardenedKeyStart,
+		},
+		branch: 1,
+	}, {
+		name:     "private key without origin",
+		desc:     "pkh(" + testVectorXprv + "/0/*)",
+		addrType: waddrmgr.PubKeyHash,
+		private:  true,
+	}}
+	for _, test := range tests {
+		d, err := ParseDescriptor(test.desc, params)
+		if err != nil {
+			t.Fatalf("%s: unable to parse descriptor: %v", test.name,
+				err)
+		}
+		if d.AddrType != test.addrType || d.Branch != test.branch ||
+			d.Fingerprint != test.fingerprint ||
+			!reflect.DeepEqual(d.Path, test.path) ||
+			d.Key.IsPrivate() != test.private {
+
+			t.Fatalf("%s: unexpected descriptor %+v", test.name, d)
+		}
+
+		info, err := GetDescriptorInfo(test.desc, params)
+		if err != nil {
+			t.Fatalf("%s: unable to get descriptor info: %v",
+				test.name, err)
+		}
+		if test.private {
+			continue
+		}
+		if d.StringWithChecksum() != info.Descriptor {
+			t.Fatalf("%s: expected canonical descriptor %s, got %s",
+				test.name, info.Descriptor, d.StringWithChecksum())
+		}
+	}
+
+	unsupported := []struct {
+		name string
+		desc string
+	}{{
+		name: "multisig",
+		desc: "sh(multi(1," + testPubKey1 + "," + testPubKey2 + "))",
+	}, {
+		name: "single key",
+		desc: "wpkh(" + testPubKey1 + ")",
+	}, {
+		name: "no range",
+		desc: "wpkh(" + testVectorXpub + "/0/1)",
+	}, {
+		name: "derivation below the branch",
+		desc: "wpkh(" + testVectorXpub + "/0/1/*)",
+	}, {
+		name: "hardened branch",
+		desc: "wpkh(" + testVectorXprv + "/0h/*)",
+	}, {
+		name: "hardened range",
+		desc: "wpkh(" + testVectorXprv + "/0/*h)",
+	}, {
+		name: "invalid fingerprint",
+		desc: "wpkh([d34db3/0]" + testVectorXpub + "/0/*)",
+	}}
+	for _, test := range unsupported {
+		_, err := ParseDescriptor(test.desc, params)
+		if !ErrMalformedDescriptor.Is(err) {
+			t.Fatalf("%s: expected ErrMalformedDescriptor, got %v",
+				test.name, err)
+		}
+	}
+}
+
+// TestExportDescriptors tests that the exported native segwit descriptor of
+// the default account describes the addresses of the account and survives a
+// round trip through ParseDescriptor, and that private descriptors require
+// the wallet to be unlocked.
+func TestExportDescriptors(t *testing.T) {
+	w, cleanup := testWallet(t)
+	defer cleanup()
+
+	descs, err := w.ExportDescriptors(false)
+	if err != nil {
+		t.Fatalf("unable to export descriptors: %v", err)
+	}
+
+	var exported *ExportedDescriptor
+	for i := range descs {
+		desc := &descs[i]
+		if desc.Scope == waddrmgr.KeyScopeBIP0084 &&
+			desc.Account == waddrmgr.DefaultAccountNum &&
+			!desc.Internal {
+
+			exported = desc
+		}
+	}
+	if exported == nil {
+		t.Fatalf("no external descriptor of the default account")
+	}
+	if exported.Descriptor.Key.IsPrivate() {
+		t.Fatalf("public descriptor holds a private key")
+	}
+
+	descStr := exported.Descriptor.StringWithChecksum()
+	if !strings.HasPrefix(descStr, "wpkh([") ||
+		!strings.Contains(descStr, "/84'/0'/0']") ||
+		!strings.Contains(descStr, "/0/*)#") {
+
+		t.Fatalf("unexpected descriptor %s", descStr)
+	}
+
+	parsed, err := ParseDescriptor(descStr, w.ChainParams())
+	if err != nil {
+		t.Fatalf("unable to parse descriptor %s: %v", descStr, err)
+	}
+	if parsed.StringWithChecksum() != descStr {
+		t.Fatalf("expected descriptor %s, got %s", descStr,
+			parsed.StringWithChecksum())
+	}
+	if parsed.AddrType != waddrmgr.WitnessPubKey {
+		t.Fatalf("unexpected address type %v", parsed.AddrType)
+	}
+	tampered := strings.Replace(descStr, "/0/*", "/1/*", 1)
+	_, err = ParseDescriptor(tampered, w.ChainParams())
+	if !ErrMalformedDescriptor.Is(err) {
+		t.Fatalf("expected ErrMalformedDescriptor, got %v", err)
+	}
+
+	// The first address derived from the parsed descriptor must be the
+	// first address of the account.
+	branchKey, err := parsed.Key.Derive(parsed.Branch)
+	if err != nil {
+		t.Fatalf("unable to derive branch key: %v", err)
+	}
+	addrKey, err := branchKey.Derive(0)
+	if err != nil {
+		t.Fatalf("unable to derive address key: %v", err)
+	}
+	pubKey, err := addrKey.ECPubKey()
+	if err != nil {
+		t.Fatalf("unable to get public key: %v", err)
+	}
+	addr, err := btcutil.NewAddressWitnessPubKeyHash(
+		btcutil.Hash160(pubKey.SerializeCompressed()), w.ChainParams(),
+	)
+	if err != nil {
+		t.Fatalf("unable to create address: %v", err)
+	}
+
+	manager, err := w.Manager.FetchScopedKeyManager(waddrmgr.KeyScopeBIP0084)
+	if err != nil {
+		t.Fatalf("unable to fetch scoped manager: %v", err)
+	}
+	var expected waddrmgr.ManagedAddress
+	err = walletdb.View(w.db, func(tx walletdb.ReadTx) er.R {
+		var err er.R
+		expected, err = manager.DeriveFromKeyPath(
+			tx.ReadBucket(waddrmgrNamespaceKey),
+			waddrmgr.DerivationPath{},
+		)
+		return err
+	})
+	if err != nil {
+		t.Fatalf("unable to derive address: %v", err)
+	}
+	if addr.EncodeAddress() != expected.Address().EncodeAddress() {
+		t.Fatalf("expected address %v, got %v",
+			expected.Address().EncodeAddress(), addr.EncodeAddress())
+	}
+
+	// Private descriptors hold the account's private key, which is only
+	// available while the wallet is unlocked.
+	privDescs, err := w.ExportDescriptors(true)
+	if err != nil {
+		t.Fatalf("unable to export private descriptors: %v", err)
+	}
+	if len(privDescs) != len(descs) {
+		t.Fatalf("expected %d private descriptors, got %d",
+			len(descs), len(privDescs))
+	}
+	for _, desc := range privDescs {
+		if !desc.Descriptor.Key.IsPrivate() {
+			t.Fatalf("private descriptor holds a public key")
+		}
+	}
+
+	w.Lock()
+	if !w.Locked() {
+		t.Fatalf("wallet not locked")
+	}
+	if _, err := w.ExportDescriptors(true); !waddrmgr.ErrLocked.Is(err) {
+		t.Fatalf("expected ErrLocked, got %v", err)
+	}
+	if _, err := w.ExportDescriptors(false); err != nil {
+		t.Fatalf("unable to export descriptors while locked: %v", err)
+	}
+}
@@ -0,0 +1,442 @@
+package wallet
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/pkt-cash/pktd/btcec"
+	"github.com/pkt-cash/pktd/btcutil"
+	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/btcutil/hdkeychain"
+	"github.com/pkt-cash/pktd/chaincfg"
+)
+
+// maxMultisigKeys is the maximum number of keys of a multi or sortedmulti
+// expression.
+const maxMultisigKeys = 20
+
+// DescriptorInfo describes an output descriptor analyzed by
+// GetDescriptorInfo.
+type DescriptorInfo struct {
+	// Descriptor is the descriptor in canonical form followed by its
+	// checksum. Private keys are replaced by their public keys and
+	// hardened derivation steps are marked with '.
+	Descriptor string
+
+	// Checksum is the checksum of the descriptor as it was given.
+	Checksum string
+
+	// IsRange is true if the descriptor describes a range of scripts
+	// because one of its keys ends in a /* derivation step.
+	IsRange bool
+
+	// IsSolvable is true if the wallet knows how to sign for the scripts
+	// of the descriptor given the private keys, which is not the case for
+	// addr and raw descriptors.
+	IsSolvable bool
+
+	// HasPrivateKeys is true if the descriptor holds at least one private
+	// key.
+	HasPrivateKeys bool
+}
+
+// descriptorContext is the script expression a nested expression appears in,
+// it decides which expressions and keys are allowed.
+type descriptorContext int
+
+const (
+	descriptorTop descriptorContext = iota
+	descriptorSh
+	descriptorWsh
+)
+
+// descriptorKey is a key expression of a descriptor as recorded by
+// descriptorParser.
+type descriptorKey struct {
+	// origin is true if the key has a key origin, the fingerprint of the
+	// master key followed by the derivation path, which may be empty.
+	origin      bool
+	fingerprint uint32
+	path        []uint32
+
+	// extKey is the extended key as it was given, it is nil for other
+	// kinds of keys. steps are its derivation steps without the final *
+	// of a range, ranged is true if there is one and hardenedRange if it
+	// is hardened.
+	extKey        *hdkeychain.ExtendedKey
+	steps         []uint32
+	ranged        bool
+	hardenedRange bool
+}
+
+// descriptorParser parses a descriptor, building its canonical form while
+// recording what GetDescriptorInfo reports about it and the script and key
+// expressions ParseDescriptor needs.
+type descriptorParser struct {
+	desc   string
+	pos    int
+	params *chaincfg.Params
+	out    strings.Builder
+	info   DescriptorInfo
+
+	// scripts are the names of the script expressions in the order they
+	// are nested and keys the key expressions in the order they appear.
+	scripts []string
+	keys    []*descriptorKey
+}
+
+// GetDescriptorInfo verifies the checksum of an output descriptor if it has
+// one, and returns the descriptor in canonical form with its checksum along
+// with whether it describes a range of scripts, whether it is solvable and
+// whether it holds private keys. The descriptor does not need to belong to
+// the wallet, but its keys and addresses must be for the given network. If
+// the descriptor can't be parsed, the ErrMalformedDescriptor returned names
+// the offending token and its position.
+func GetDescriptorInfo(desc string, params *chaincfg.Params) (*DescriptorInfo, er.R) {
+	p, err := parseDescriptor(desc, params)
+	if err != nil {
+		return nil, err
+	}
+	return &p.info, nil
+}
+
+// parseDescriptor verifies the checksum of a descriptor if it has one and
+// parses it.
+func parseDescriptor(desc string, params *chaincfg.Params) (*descriptorParser, er.R) {
+	checksum, hasChecksum := "", false
+	if i := strings.IndexByte(desc, '#'); i >= 0 {
+		checksum, hasChecksum = desc[i+1:], true
+		desc = desc[:i]
+	}
+	for i, r := range desc {
+		if !strings.ContainsRune(descriptorInputCharset, r) {
+			return nil, ErrMalformedDescriptor.New(fmt.Sprintf(
+				"invalid character %q at position %d", r, i),
+				nil)
+		}
+	}
+	expected, _ := descriptorChecksum(desc)
+	if hasChecksum && checksum != expected {
+		return nil, ErrMalformedDescriptor.New(fmt.Sprintf(
+			"checksum mismatch, expected %s but got %s", expected,
+			checksum), nil)
+	}
+
+	p := &descriptorParser{desc: desc, params: params}
+	p.info.IsSolvable = true
+	if err := p.parseScript(descriptorTop); err != nil {
+		return nil, err
+	}
+	if p.pos != len(desc) {
+		return nil, p.errorf(p.pos, "unexpected %q after the "+
+			"descriptor", desc[p.pos:])
+	}
+
+	canonical := p.out.String()
+	canonicalChecksum, _ := descriptorChecksum(canonical)
+	p.info.Descriptor = canonical + "#" + canonicalChecksum
+	p.info.Checksum = expected
+	return p, nil
+}
+
+// errorf returns an ErrMalformedDescriptor for the token at position pos.
+func (p *descriptorParser) errorf(pos int, format string,
+	args ...interface{}) er.R {
+
+	return ErrMalformedDescriptor.New(fmt.Sprintf("%s at position %d",
+		fmt.Sprintf(format, args...), pos), nil)
+}
+
+// token returns the text from the current position up to, but not including,
+// the next character of stop or the end of the descriptor.
+func (p *descriptorParser) token(stop string) string {
+	end := strings.IndexAny(p.desc[p.pos:], stop)
+	if end < 0 {
+		return p.desc[p.pos:]
+	}
+	return p.desc[p.pos : p.pos+end]
+}
+
+// expect consumes the character c.
+func (p *descriptorParser) expect(c byte) er.R {
+	if p.pos >= len(p.desc) {
+		return p.errorf(p.pos, "expected %q but the descriptor ends", c)
+	}
+	if p.desc[p.pos] != c {
+		return p.errorf(p.pos, "expected %q but got %q", c,
+			p.desc[p.pos:p.pos+1])
+	}
+	p.pos++
+	p.out.WriteByte(c)
+	return nil
+}
+
+// parseScript parses a script expression, the function name followed by its
+// arguments in parentheses.
+func (p *descriptorParser) parseScript(ctx descriptorContext) er.R {
+	start := p.pos
+	name := p.token("(")
+	if p.pos+len(name) == len(p.desc) {
+		return p.errorf(start, "expected a script expression but got %q",
+			name)
+	}
+	p.pos += len(name)
+	p.out.WriteString(name)
+	p.scripts = append(p.scripts, name)
+	if err := p.expect('('); err != nil {
+		return err
+	}
+
+	var err er.R
+	switch {
+	case name == "sh" && ctx == descriptorTop:
+		err = p.parseScript(descriptorSh)
+	case name == "wsh" && ctx != descriptorWsh:
+		err = p.parseScript(descriptorWsh)
+	case name == "wpkh" && ctx != descriptorWsh:
+		err = p.parseKey(true)
+	case name == "combo" && ctx == descriptorTop:
+		err = p.parseKey(false)
+	case name == "pk", name == "pkh":
+		err = p.parseKey(ctx == descriptorWsh)
+	case name == "multi", name == "sortedmulti":
+		err = p.parseMulti(ctx == descriptorWsh)
+	case name == "addr" && ctx == descriptorTop:
+		p.info.IsSolvable = false
+		err = p.parseAddr()
+	case name == "raw" && ctx == descriptorTop:
+		p.info.IsSolvable = false
+		err = p.parseRaw()
+	case name == "sh", name == "wsh", name == "wpkh", name == "combo",
+		name == "addr", name == "raw":
+
+		return p.errorf(start, "%s is not allowed here", name)
+	default:
+		return p.errorf(start, "unknown script expression %q", name)
+	}
+	if err != nil {
+		return err
+	}
+	return p.expect(')')
+}
+
+// parseMulti parses the arguments of a multi or sortedmulti expression, the
+// number of required signatures followed by the keys.
+func (p *descriptorParser) parseMulti(witness bool) er.R {
+	start := p.pos
+	tok := p.token(",)")
+	threshold, errr := strconv.ParseUint(tok, 10, 31)
+	if errr != nil || threshold == 0 {
+		return p.errorf(start, "invalid number of signatures %q", tok)
+	}
+	p.pos += len(tok)
+	p.out.WriteString(strconv.FormatUint(threshold, 10))
+
+	keys := 0
+	for p.pos < len(p.desc) && p.desc[p.pos] == ',' {
+		p.pos++
+		p.out.WriteByte(',')
+		if err := p.parseKey(witness); err != nil {
+			return err
+		}
+		keys++
+	}
+	switch {
+	case keys > maxMultisigKeys:
+		return p.errorf(start, "%d keys exceed the maximum of %d", keys,
+			maxMultisigKeys)
+	case uint64(keys) < threshold:
+		return p.errorf(start, "%d signatures required of only %d keys",
+			threshold, keys)
+	}
+	return nil
+}
+
+// parseAddr parses the argument of an addr expression.
+func (p *descriptorParser) parseAddr() er.R {
+	start := p.pos
+	tok := p.token(")")
+	addr, err := btcutil.DecodeAddress(tok, p.params)
+	if err != nil {
+		return p.errorf(start, "invalid address %q", tok)
+	}
+	if !addr.IsForNet(p.params) {
+		return p.errorf(start, "address %q is not for %s", tok,
+			p.params.Name)
+	}
+	p.pos += len(tok)
+	p.out.WriteString(tok)
+	return nil
+}
+
+// parseRaw parses the argument of a raw expression.
+func (p *descriptorParser) parseRaw() er.R {
+	start := p.pos
+	tok := p.token(")")
+	script, errr := hex.DecodeString(tok)
+	if errr != nil || len(script) == 0 {
+		return p.errorf(start, "invalid script %q", tok)
+	}
+	p.pos += len(tok)
+	p.out.WriteString(hex.EncodeToString(script))
+	return nil
+}
+
+// parseKey parses a key expression, an optional key origin followed by a hex
+// encoded public key, a WIF private key or an extended key with its
+// derivation steps. Keys of segwit scripts must be compressed. Errors don't
+// quote the key as it may be private.
+func (p *descriptorParser) parseKey(witness bool) er.R {
+	k := &descriptorKey{}
+	p.keys = append(p.keys, k)
+	if p.pos < len(p.desc) && p.desc[p.pos] == '[' {
+		if err := p.parseOrigin(k); err != nil {
+			return err
+		}
+	}
+
+	start := p.pos
+	tok := p.token("/,)")
+	p.pos += len(tok)
+	if tok == "" {
+		return p.errorf(start, "expected a key")
+	}
+
+	if pubKey, errr := hex.DecodeString(tok); errr == nil {
+		if _, err := btcec.ParsePubKey(pubKey, btcec.S256()); err != nil {
+			return p.errorf(start, "invalid public key %q", tok)
+		}
+		if witness && len(pubKey) != btcec.PubKeyBytesLenCompressed {
+			return p.errorf(start, "uncompressed public key %q "+
+				"is not allowed in segwit scripts", tok)
+		}
+		p.out.WriteString(hex.EncodeToString(pubKey))
+		return nil
+	}
+
+	if wif, err := btcutil.DecodeWIF(tok); err == nil {
+		if !wif.IsForNet(p.params) {
+			return p.errorf(start, "private key is not for %s",
+				p.params.Name)
+		}
+		if witness && !wif.CompressPubKey {
+			return p.errorf(start, "uncompressed private key is "+
+				"not allowed in segwit scripts")
+		}
+		p.info.HasPrivateKeys = true
+		p.out.WriteString(hex.EncodeToString(wif.SerializePubKey()))
+		return nil
+	}
+
+	key, err := hdkeychain.NewKeyFromString(tok)
+	if err != nil {
+		return p.errorf(start, "invalid key")
+	}
+	if !key.IsForNet(p.params) {
+		return p.errorf(start, "extended key is not for %s",
+			p.params.Name)
+	}
+	k.extKey = key
+	private := key.IsPrivate()
+	if private {
+		p.info.HasPrivateKeys = true
+		if key, err = key.Neuter(); err != nil {
+			return err
+		}
+	}
+	p.out.WriteString(key.String())
+	return p.parseDerivation(k, private)
+}
+
+// parseDerivation parses the derivation steps which follow the extended key
+// k, the last of which may be * to describe a range of keys. Hardened steps
+// require an extended private key.
+func (p *descriptorParser) parseDerivation(k *descriptorKey, private bool) er.R {
+	ranged := false
+	for p.pos < len(p.desc) && p.desc[p.pos] == '/' {
+		if ranged {
+			return p.errorf(p.pos, "derivation step after /*")
+		}
+		p.pos++
+		start := p.pos
+		tok := p.token("/,)")
+		p.pos += len(tok)
+
+		step := tok
+		hardened := strings.HasSuffix(step, "'") ||
+			strings.HasSuffix(step, "h")
+		if hardened {
+			step = step[:len(step)-1]
+			if !private {
+				return p.errorf(start, "hardened derivation "+
+					"step %q requires a private key", tok)
+			}
+		}
+		if step == "*" {
+			ranged = true
+			p.info.IsRange = true
+			k.ranged, k.hardenedRange = true, hardened
+		} else if index, errr := strconv.ParseUint(step, 10, 31); errr != nil {
+			return p.errorf(start, "invalid derivation step %q", tok)
+		} else {
+			if hardened {
+				index += hdkeychain.HardenedKeyStart
+			}
+			k.steps = append(k.steps, uint32(index))
+		}
+		p.out.WriteString("/" + step)
+		if hardened {
+			p.out.WriteByte('\'')
+		}
+	}
+	return nil
+}
+
+// parseOrigin parses the key origin of k in brackets, the fingerprint of the
+// master key followed by the derivation path of the key, which may be empty.
+func (p *descriptorParser) parseOrigin(k *descriptorKey) er.R {
+	open := p.pos
+	end := strings.IndexByte(p.desc[p.pos:], ']')
+	if end < 0 {
+		return p.errorf(open, "unterminated key origin")
+	}
+	p.pos++
+
+	parts := strings.Split(p.desc[p.pos:open+end], "/")
+	fingerprint, errr := hex.DecodeString(parts[0])
+	if errr != nil || len(fingerprint) != 4 {
+		return p.errorf(p.pos, "invalid fingerprint %q", parts[0])
+	}
+	p.out.WriteString("[" + hex.EncodeToString(fingerprint))
+	p.pos += len(parts[0])
+	k.origin = true
+	k.fingerprint = binary.BigEndian.Uint32(fingerprint)
+
+	for _, part := range parts[1:] {
+		p.pos++
+		index := part
+		hardened := strings.HasSuffix(index, "'") ||
+			strings.HasSuffix(index, "h")
+		if hardened {
+			index = index[:len(index)-1]
+		}
+		step, errr := strconv.ParseUint(index, 10, 31)
+		if errr != nil {
+			return p.errorf(p.pos, "invalid derivation path "+
+				"element %q", part)
+		}
+		p.out.WriteString("/" + index)
+		if hardened {
+			p.out.WriteByte('\'')
+			step += hdkeychain.HardenedKeyStart
+		}
+		k.path = append(k.path, uint32(step))
+		p.pos += len(part)
+	}
+	p.pos++
+	p.out.WriteByte(']')
+	return nil
+}
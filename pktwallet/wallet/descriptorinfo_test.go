@@ -0,0 +1,117 @@
+package wallet
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/pkt-cash/pktd/chaincfg"
+)
+
+// Extended keys of the first test vector of BIP0032 and compressed public
+// keys of the generator point and its double.
+const (
+	testVectorXprv = "xprv9s21ZrQH143K3QTDL4LXw2F7HEK3wJUD2nW2nRk4stbPy6cq3jPPq" +
+		"jiChkVvvNKmPGJxWUtg6LnF5kejMRNNU3TGtRBeJgk33yuGBxrMPHi"
+	testVectorXpub = "xpub661MyMwAqRbcFtXgS5sYJABqqG9YLmC4Q1Rdap9gSE8NqtwybGheP" +
+		"Y2gZ29ESFjqJoCu1Rupje8YtGqsefD265TMg7usUDFdp6W1EGMcet8"
+	testPubKey1 = "0279be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798"
+	testPubKey2 = "02c6047f9441ed7d6d3045406e95c07cd85c778e4b8cef3ca7abac09b95c709ee5"
+)
+
+// TestGetDescriptorInfo tests the canonical form, checksum and properties of
+// descriptors returned by GetDescriptorInfo, and that malformed descriptors
+// are rejected with an error naming the offending token.
+func TestGetDescriptorInfo(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		desc string
+		info DescriptorInfo
+	}{{
+		name: "key origin",
+		desc: "wpkh([d34db33f/84h/0h/0h]" + testVectorXpub + "/0/*)",
+		info: DescriptorInfo{
+			Descriptor: "wpkh([d34db33f/84'/0'/0']" + testVectorXpub +
+				"/0/*)#zpuvqnlw",
+			Checksum:   "3srn6fgd",
+			IsRange:    true,
+			IsSolvable: true,
+		},
+	}, {
+		name: "private key",
+		desc: "pkh(" + testVectorXprv + "/1h/*)#k2mvtma6",
+		info: DescriptorInfo{
+			Descriptor:     "pkh(" + testVectorXpub + "/1'/*)#xrjgl8l6",
+			Checksum:       "k2mvtma6",
+			IsRange:        true,
+			IsSolvable:     true,
+			HasPrivateKeys: true,
+		},
+	}, {
+		name: "multisig",
+		desc: "sh(multi(1," + strings.ToUpper(testPubKey1) + "," +
+			testPubKey2 + "))",
+		info: DescriptorInfo{
+			Descriptor: "sh(multi(1," + testPubKey1 + "," +
+				testPubKey2 + "))#vncv7gv3",
+			Checksum:   "9xnta40y",
+			IsSolvable: true,
+		},
+	}, {
+		name: "raw script",
+		desc: "raw(deadbeef)",
+		info: DescriptorInfo{
+			Descriptor: "raw(deadbeef)#89f8spxm",
+			Checksum:   "89f8spxm",
+		},
+	}}
+	for _, test := range tests {
+		info, err := GetDescriptorInfo(test.desc, &chaincfg.MainNetParams)
+		if err != nil {
+			t.Fatalf("%s: unable to get descriptor info: %v",
+				test.name, err)
+		}
+		if *info != test.info {
+			t.Fatalf("%s: expected %+v, got %+v", test.name,
+				test.info, *info)
+		}
+	}
+
+	malformed := []struct {
+		name  string
+		desc  string
+		token string
+	}{{
+		name:  "invalid derivation step",
+		desc:  "wpkh(" + testVectorXpub + "/0/x)",
+		token: `"x" at position 119`,
+	}, {
+		name:  "hardened step of public key",
+		desc:  "pkh(" + testVectorXpub + "/1'/*)",
+		token: `"1'" at position 116`,
+	}, {
+		name:  "nested wpkh",
+		desc:  "wsh(wpkh(" + testPubKey1 + "))",
+		token: "wpkh is not allowed here at position 4",
+	}, {
+		name:  "too few keys",
+		desc:  "multi(2," + testPubKey1 + ")",
+		token: "2 signatures required of only 1 keys at position 6",
+	}, {
+		name:  "checksum mismatch",
+		desc:  "raw(deadbeef)#89f8spxq",
+		token: "expected 89f8spxm",
+	}}
+	for _, test := range malformed {
+		_, err := GetDescriptorInfo(test.desc, &chaincfg.MainNetParams)
+		if !ErrMalformedDescriptor.Is(err) {
+			t.Fatalf("%s: expected ErrMalformedDescriptor, got %v",
+				test.name, err)
+		}
+		if !strings.Contains(err.Message(), test.token) {
+			t.Fatalf("%s: expected error to contain %s, got %v",
+				test.name, test.token, err)
+		}
+	}
+}
@@ -0,0 +1,36 @@
+package wallet
+
+import (
+	"strings"
+
+	"github.com/pkt-cash/pktd/btcutil/er"
+)
+
+// enumNames holds the names of the values of an enumerated wallet option as
+// used in the configuration, indexed by value.
+type enumNames struct {
+	// kind describes the option in error messages.
+	kind  string
+	names []string
+}
+
+// name returns the name of the value v, or "unknown" if it has none.
+func (e *enumNames) name(v uint8) string {
+	if int(v) >= len(e.names) {
+		return "unknown"
+	}
+	return e.names[v]
+}
+
+// parse returns the value of the option which is named s.
+func (e *enumNames) parse(s string) (uint8, er.R) {
+	for v, name := range e.names {
+		if name == s {
+			return uint8(v), nil
+		}
+	}
+
+	last := len(e.names) - 1
+	return 0, er.Errorf("unknown %s %q, must be one of %s or %s", e.kind,
+		s, strings.Join(e.names[:last], ", "), e.names[last])
+}
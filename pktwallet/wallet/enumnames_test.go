@@ -0,0 +1,61 @@
+package wallet
+
+import (
+	"fmt"
+	"testing"
+)
+
+// TestEnumNames tests that the values of every enumerated option round trip
+// through their names and that unknown names are rejected.
+func TestEnumNames(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		values  []fmt.Stringer
+		parse   func(string) (fmt.Stringer, error)
+		unknown string
+	}{{
+		values: []fmt.Stringer{
+			AddressReuseAllow, AddressReuseWarn, AddressReuseBlock,
+		},
+		parse: func(s string) (fmt.Stringer, error) {
+			p, err := ParseAddressReusePolicy(s)
+			if err != nil {
+				return nil, err.Native()
+			}
+			return p, nil
+		},
+		unknown: "never",
+	}, {
+		values: []fmt.Stringer{
+			ChangeTypeInput, ChangeTypeBech32, ChangeTypeLegacy,
+		},
+		parse: func(s string) (fmt.Stringer, error) {
+			changeType, err := ParseChangeType(s)
+			if err != nil {
+				return nil, err.Native()
+			}
+			return changeType, nil
+		},
+		unknown: "p2sh",
+	}}
+
+	for _, test := range tests {
+		for _, v := range test.values {
+			parsed, err := test.parse(v.String())
+			if err != nil {
+				t.Fatalf("unable to parse %v: %v", v, err)
+			}
+			if parsed != v {
+				t.Fatalf("expected %v, got %v", v, parsed)
+			}
+		}
+		if _, err := test.parse(test.unknown); err == nil {
+			t.Fatalf("expected %q to be rejected", test.unknown)
+		}
+	}
+
+	if s := ChangeType(42).String(); s != "unknown" {
+		t.Fatalf("expected unknown, got %v", s)
+	}
+}
@@ -16,7 +16,7 @@ import (
 var defaultDBTimeout = 10 * time.Second
 
 // testWallet creates a test wallet and unlocks it.
-func testWallet(t *testing.T) (*Wallet, func()) {
+func testWallet(t testing.TB) (*Wallet, func()) {
 	// Set up a wallet.
 	dir, errr := ioutil.TempDir("", "test_wallet")
 	if errr != nil {
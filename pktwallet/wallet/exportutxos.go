@@ -0,0 +1,201 @@
+package wallet
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/pkt-cash/pktd/btcutil"
+	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/pktwallet/waddrmgr"
+	"github.com/pkt-cash/pktd/pktwallet/wallet/txrules"
+	"github.com/pkt-cash/pktd/pktwallet/walletdb"
+	"github.com/pkt-cash/pktd/pktwallet/wtxmgr"
+	"github.com/pkt-cash/pktd/txscript"
+	"github.com/pkt-cash/pktd/wire"
+)
+
+type (
+	// ExportedUtxo is an unspent output of the wallet along with the
+	// information needed to manage it externally.
+	ExportedUtxo struct {
+		wtxmgr.Credit
+
+		// Confirmations is the number of confirmations of the output,
+		// zero if it is unconfirmed.
+		Confirmations int32
+
+		// Address is the address the output pays to, it is nil if the
+		// script doesn't pay to a single address.
+		Address btcutil.Address
+
+		// Account is the name of the account the address belongs to.
+		Account string
+
+		// DerivationPath is the BIP32 path of the key of the address,
+		// it is empty for imported keys and scripts.
+		DerivationPath string
+
+		// Spendable is false if the output is an immature coinbase
+		// output, or if it has been burned.
+		Spendable bool
+
+		// Locked is set if the output is locked with lockunspent, with
+		// the name given in LockName.
+		Locked   bool
+		LockName string
+
+		// Frozen is set if the output is leased with LeaseOutput until
+		// FrozenUntil.
+		Frozen      bool
+		FrozenUntil time.Time
+	}
+
+	// UtxoSnapshot is a consistent snapshot of the unspent outputs of the
+	// wallet, or of a page of them.
+	UtxoSnapshot struct {
+		// SyncedTo is the block the wallet was synced to when the
+		// snapshot was taken.
+		SyncedTo waddrmgr.BlockStamp
+
+		// Count and Amount are the number and the total value of all
+		// unspent outputs, regardless of the page.
+		Count  int
+		Amount btcutil.Amount
+
+		// Utxos are the outputs of the requested page, ordered by
+		// outpoint.
+		Utxos []ExportedUtxo
+
+		// More is set if there are outputs after the last one of the
+		// page.
+		More bool
+	}
+)
+
+// lessOutPoint orders outpoints by hash, then by index.
+func lessOutPoint(a, b *wire.OutPoint) bool {
+	if c := bytes.Compare(a.Hash[:], b.Hash[:]); c != 0 {
+		return c < 0
+	}
+	return a.Index < b.Index
+}
+
+// ExportUtxos takes a snapshot of the unspent outputs of the wallet, including
+// locked and leased ones, under a single database view so the snapshot is
+// internally consistent. The outputs are ordered by outpoint and at most count
+// of them are returned, starting after the outpoint after if it is non-nil, so
+// that a large set can be exported in pages. A count of zero only returns the
+// summary.
+func (w *Wallet) ExportUtxos(after *wire.OutPoint,
+	count int) (*UtxoSnapshot, er.R) {
+
+	// Take the set of locked outputs up front, so all outputs are checked
+	// against the same set.
+	w.lockedOutpointsMtx.Lock()
+	locked := make(map[wire.OutPoint]string, len(w.lockedOutpoints))
+	for op, name := range w.lockedOutpoints {
+		locked[op] = name
+	}
+	w.lockedOutpointsMtx.Unlock()
+
+	snapshot := &UtxoSnapshot{}
+	err := walletdb.View(w.db, func(tx walletdb.ReadTx) er.R {
+		addrmgrNs := tx.ReadBucket(waddrmgrNamespaceKey)
+		txmgrNs := tx.ReadBucket(wtxmgrNamespaceKey)
+
+		snapshot.SyncedTo = w.Manager.SyncedTo()
+
+		var credits []wtxmgr.Credit
+		err := w.TxStore.ForEachUnspentOutputInclLeased(txmgrNs, nil,
+			func(_ []byte, c *wtxmgr.Credit) er.R {
+				snapshot.Count++
+				snapshot.Amount += c.Amount
+				if after == nil || lessOutPoint(after, &c.OutPoint) {
+					credits = append(credits, *c)
+				}
+				return nil
+			},
+		)
+		if err != nil {
+			return err
+		}
+
+		sort.Slice(credits, func(i, j int) bool {
+			return lessOutPoint(&credits[i].OutPoint,
+				&credits[j].OutPoint)
+		})
+		if len(credits) > count {
+			credits = credits[:count]
+			snapshot.More = true
+		}
+
+		snapshot.Utxos = make([]ExportedUtxo, 0, len(credits))
+		for _, c := range credits {
+			utxo := w.exportUtxo(addrmgrNs, txmgrNs, c,
+				snapshot.SyncedTo.Height)
+			utxo.LockName, utxo.Locked = locked[c.OutPoint]
+			snapshot.Utxos = append(snapshot.Utxos, utxo)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return snapshot, nil
+}
+
+// exportUtxo looks up the confirmations, ownership and lease of a credit.
+func (w *Wallet) exportUtxo(addrmgrNs, txmgrNs walletdb.ReadBucket,
+	c wtxmgr.Credit, syncHeight int32) ExportedUtxo {
+
+	utxo := ExportedUtxo{
+		Credit:    c,
+		Spendable: true,
+	}
+	if c.Height != -1 {
+		utxo.Confirmations = confirms(c.Height, syncHeight)
+	}
+	if c.FromCoinBase {
+		maturity := int32(w.chainParams.CoinbaseMaturity)
+		if !confirmed(maturity, c.Height, syncHeight) ||
+			txrules.IsBurned(&c, w.chainParams, syncHeight+1) {
+
+			utxo.Spendable = false
+		}
+	}
+	_, utxo.FrozenUntil, utxo.Frozen = w.TxStore.OutputLease(
+		txmgrNs, c.OutPoint,
+	)
+
+	_, addrs, _, err := txscript.ExtractPkScriptAddrs(
+		c.PkScript, w.chainParams,
+	)
+	if err != nil || len(addrs) != 1 {
+		return utxo
+	}
+	utxo.Address = addrs[0]
+
+	smgr, acct, err := w.Manager.AddrAccount(addrmgrNs, utxo.Address)
+	if err != nil {
+		return utxo
+	}
+	if name, err := smgr.AccountName(addrmgrNs, acct); err == nil {
+		utxo.Account = name
+	}
+
+	maddr, err := w.Manager.Address(addrmgrNs, utxo.Address)
+	if err != nil {
+		return utxo
+	}
+	if pkAddr, ok := maddr.(waddrmgr.ManagedPubKeyAddress); ok {
+		scope, path, ok := pkAddr.DerivationInfo()
+		if ok {
+			utxo.DerivationPath = fmt.Sprintf("m/%d'/%d'/%d'/%d/%d",
+				scope.Purpose, scope.Coin, path.Account,
+				path.Branch, path.Index)
+		}
+	}
+	return utxo
+}
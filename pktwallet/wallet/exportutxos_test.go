@@ -0,0 +1,124 @@
+package wallet
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/pktwallet/waddrmgr"
+	"github.com/pkt-cash/pktd/pktwallet/walletdb"
+	"github.com/pkt-cash/pktd/pktwallet/wtxmgr"
+	"github.com/pkt-cash/pktd/txscript"
+	"github.com/pkt-cash/pktd/wire"
+)
+
+// TestExportUtxos tests that the snapshot includes locked and leased outputs
+// with their status and derivation info, and that paging through it visits
+// every output exactly once.
+func TestExportUtxos(t *testing.T) {
+	t.Parallel()
+
+	w, cleanup := testWallet(t)
+	defer cleanup()
+
+	addr, err := w.NewAddress(0, waddrmgr.KeyScopeBIP0084)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pkScript, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	block := &wtxmgr.BlockMeta{
+		Block: wtxmgr.Block{Hash: *testBlockHash, Height: testBlockHeight},
+		Time:  time.Unix(1387737310, 0),
+	}
+	var outPoints []wire.OutPoint
+	for i := 0; i < 5; i++ {
+		tx := &wire.MsgTx{
+			TxIn: []*wire.TxIn{{
+				PreviousOutPoint: wire.OutPoint{Index: uint32(i)},
+			}},
+			TxOut: []*wire.TxOut{wire.NewTxOut(10000, pkScript)},
+		}
+		rec, err := wtxmgr.NewTxRecordFromMsgTx(tx, time.Now())
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) er.R {
+			ns := dbtx.ReadWriteBucket(wtxmgrNamespaceKey)
+			if err := w.TxStore.InsertTx(ns, rec, block); err != nil {
+				return err
+			}
+			return w.TxStore.AddCredit(ns, rec, block, 0, false)
+		})
+		if err != nil {
+			t.Fatalf("unable to add credit: %v", err)
+		}
+		outPoints = append(outPoints, wire.OutPoint{Hash: rec.Hash})
+	}
+
+	w.LockOutpoint(outPoints[0], "test")
+	defer w.ResetLockedOutpoints(nil)
+	if _, err := w.LeaseOutput(wtxmgr.LockID{1}, outPoints[1]); err != nil {
+		t.Fatalf("unable to lease output: %v", err)
+	}
+
+	// A count of zero only returns the summary.
+	snapshot, err := w.ExportUtxos(nil, 0)
+	if err != nil {
+		t.Fatalf("unable to export utxos: %v", err)
+	}
+	if snapshot.Count != 5 || snapshot.Amount != 50000 ||
+		len(snapshot.Utxos) != 0 || !snapshot.More {
+
+		t.Fatalf("unexpected summary %+v", snapshot)
+	}
+
+	// Page through the outputs, two at a time.
+	seen := make(map[wire.OutPoint]ExportedUtxo)
+	var after *wire.OutPoint
+	for pages := 1; ; pages++ {
+		snapshot, err := w.ExportUtxos(after, 2)
+		if err != nil {
+			t.Fatalf("unable to export utxos: %v", err)
+		}
+		if snapshot.Count != 5 {
+			t.Fatalf("expected 5 outputs in total, got %d",
+				snapshot.Count)
+		}
+		for _, utxo := range snapshot.Utxos {
+			if _, ok := seen[utxo.OutPoint]; ok {
+				t.Fatalf("output %v exported twice", utxo.OutPoint)
+			}
+			seen[utxo.OutPoint] = utxo
+		}
+		if !snapshot.More {
+			if pages != 3 {
+				t.Fatalf("expected 3 pages, got %d", pages)
+			}
+			break
+		}
+		after = &snapshot.Utxos[len(snapshot.Utxos)-1].OutPoint
+	}
+	if len(seen) != 5 {
+		t.Fatalf("expected 5 outputs, got %d", len(seen))
+	}
+
+	for i, op := range outPoints {
+		utxo := seen[op]
+		if utxo.Locked != (i == 0) || utxo.Frozen != (i == 1) {
+			t.Fatalf("unexpected status of output %d: %+v", i, utxo)
+		}
+		if utxo.Address.EncodeAddress() != addr.EncodeAddress() ||
+			utxo.DerivationPath != "m/84'/0'/0'/0/0" {
+
+			t.Fatalf("unexpected ownership of output %d: %v %v", i,
+				utxo.Address, utxo.DerivationPath)
+		}
+	}
+	if seen[outPoints[0]].LockName != "test" {
+		t.Fatalf("unexpected lock name %q", seen[outPoints[0]].LockName)
+	}
+}
@@ -0,0 +1,79 @@
+package wallet
+
+import (
+	"sort"
+	"time"
+
+	"github.com/pkt-cash/pktd/blockchain"
+	"github.com/pkt-cash/pktd/btcutil"
+	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/pktwallet/walletdb"
+	"github.com/pkt-cash/pktd/pktwallet/wtxmgr"
+	"github.com/pkt-cash/pktd/wire"
+)
+
+// putFeeRecord records the fee rate paid by a transaction which is about to
+// be broadcast, so the confirmation delay can be filled in once it is mined.
+// Nothing is recorded if the fee is unknown because not every input spends
+// one of our outputs, or if the transaction was recorded already.
+func (w *Wallet) putFeeRecord(txmgrNs walletdb.ReadWriteBucket,
+	tx *wire.MsgTx) er.R {
+	txHash := tx.TxHash()
+	_, err := w.TxStore.FetchFeeRecord(txmgrNs, &txHash)
+	if err == nil {
+		return nil
+	} else if !wtxmgr.ErrFeeRecordNotFound.Is(err) {
+		return err
+	}
+
+	details, err := w.TxStore.TxDetails(txmgrNs, &txHash)
+	if err != nil {
+		return err
+	}
+	if details == nil {
+		return nil
+	}
+	effect := TxDetailsEffect(details)
+	if !effect.FeeKnown {
+		return nil
+	}
+
+	weight := blockchain.GetTransactionWeight(btcutil.NewTx(tx))
+	vsize := (weight + blockchain.WitnessScaleFactor - 1) /
+		blockchain.WitnessScaleFactor
+
+	return w.TxStore.PutFeeRecord(txmgrNs, &wtxmgr.FeeRecord{
+		Hash:            txHash,
+		Fee:             effect.Fee,
+		VSize:           uint32(vsize),
+		Broadcast:       time.Now(),
+		BroadcastHeight: w.Manager.SyncedTo().Height,
+		ConfirmHeight:   details.Block.Height,
+	})
+}
+
+// FeeHistory returns the fee records of the most recent count transactions
+// broadcast by the wallet, newest first. All records are returned if count is
+// not positive.
+func (w *Wallet) FeeHistory(count int) ([]wtxmgr.FeeRecord, er.R) {
+	var records []wtxmgr.FeeRecord
+	err := walletdb.View(w.db, func(tx walletdb.ReadTx) er.R {
+		txmgrNs := tx.ReadBucket(wtxmgrNamespaceKey)
+		return w.TxStore.ForEachFeeRecord(txmgrNs,
+			func(r *wtxmgr.FeeRecord) er.R {
+				records = append(records, *r)
+				return nil
+			})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(records, func(i, j int) bool {
+		return records[i].Broadcast.After(records[j].Broadcast)
+	})
+	if count > 0 && len(records) > count {
+		records = records[:count]
+	}
+	return records, nil
+}
@@ -0,0 +1,83 @@
+package wallet
+
+import (
+	"sort"
+
+	"github.com/pkt-cash/pktd/btcutil"
+	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/pktwallet/waddrmgr"
+	"github.com/pkt-cash/pktd/pktwallet/walletdb"
+	"github.com/pkt-cash/pktd/pktwallet/wtxmgr"
+	"github.com/pkt-cash/pktd/txscript"
+)
+
+// ImmatureReward is the value of the immature coinbase outputs of the wallet
+// which mature after the same number of blocks.
+type ImmatureReward struct {
+	// BlocksToMaturity is the number of blocks which must be mined on top
+	// of the current tip before the outputs can be spent.
+	BlocksToMaturity int32
+
+	Amount      btcutil.Amount
+	OutputCount int32
+}
+
+// ImmatureRewards returns the immature coinbase outputs of the wallet grouped
+// by the number of blocks until they mature, soonest first, so that upcoming
+// mining income can be shown.  Only outputs which mature within the given
+// number of blocks are included.  The outputs are the ones which make up the
+// ImmatureReward of CalculateAccountBalances, this is purely informational and
+// doesn't make any of them spendable before they actually mature.
+func (w *Wallet) ImmatureRewards(within int32) ([]ImmatureReward, er.R) {
+	maturity := int32(w.chainParams.CoinbaseMaturity)
+	groups := make(map[int32]*ImmatureReward)
+	err := walletdb.View(w.db, func(tx walletdb.ReadTx) er.R {
+		addrmgrNs := tx.ReadBucket(waddrmgrNamespaceKey)
+		txmgrNs := tx.ReadBucket(wtxmgrNamespaceKey)
+		syncBlock := w.Manager.SyncedTo()
+		return w.TxStore.ForEachUnspentOutput(txmgrNs, nil, func(_ []byte, output *wtxmgr.Credit) er.R {
+			if !output.FromCoinBase ||
+				confirmed(maturity, output.Height, syncBlock.Height) {
+				return nil
+			}
+			blocks := maturity - confirms(output.Height, syncBlock.Height)
+			if blocks > within {
+				return nil
+			}
+
+			// Only count the outputs which are part of the balance
+			// of an account.
+			_, addrs, _, err := txscript.ExtractPkScriptAddrs(output.PkScript, w.chainParams)
+			if err != nil || len(addrs) == 0 {
+				return nil
+			}
+			_, _, err = w.Manager.AddrAccount(addrmgrNs, addrs[0])
+			if waddrmgr.ErrAddressNotFound.Is(err) {
+				return nil
+			} else if err != nil {
+				return err
+			}
+
+			group := groups[blocks]
+			if group == nil {
+				group = &ImmatureReward{BlocksToMaturity: blocks}
+				groups[blocks] = group
+			}
+			group.Amount += output.Amount
+			group.OutputCount++
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	rewards := make([]ImmatureReward, 0, len(groups))
+	for _, group := range groups {
+		rewards = append(rewards, *group)
+	}
+	sort.Slice(rewards, func(i, j int) bool {
+		return rewards[i].BlocksToMaturity < rewards[j].BlocksToMaturity
+	})
+	return rewards, nil
+}
@@ -0,0 +1,107 @@
+package wallet
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pkt-cash/pktd/btcutil"
+	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/chaincfg/chainhash"
+	"github.com/pkt-cash/pktd/pktwallet/waddrmgr"
+	"github.com/pkt-cash/pktd/pktwallet/walletdb"
+	"github.com/pkt-cash/pktd/pktwallet/wtxmgr"
+	"github.com/pkt-cash/pktd/txscript"
+	"github.com/pkt-cash/pktd/wire"
+	"github.com/pkt-cash/pktd/wire/constants"
+)
+
+// mineToWallet stores a coinbase transaction paying amt to the wallet's
+// current address in a block at height.
+func mineToWallet(t *testing.T, w *Wallet, amt btcutil.Amount, height int32) {
+	addr, err := w.CurrentAddress(0, waddrmgr.KeyScopeBIP0084)
+	if err != nil {
+		t.Fatalf("unable to get current address: %v", err)
+	}
+	pkScript, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		t.Fatalf("unable to create pkScript: %v", err)
+	}
+
+	// The nonce in the signature script makes the coinbases unique.
+	payWalletNonce++
+	tx := wire.NewMsgTx(constants.TxVersion)
+	tx.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: wire.OutPoint{Index: constants.MaxPrevOutIndex},
+		SignatureScript: []byte{byte(payWalletNonce),
+			byte(payWalletNonce >> 8)},
+	})
+	tx.AddTxOut(wire.NewTxOut(int64(amt), pkScript))
+	rec, err := wtxmgr.NewTxRecordFromMsgTx(tx, time.Now())
+	if err != nil {
+		t.Fatalf("unable to create tx record: %v", err)
+	}
+	block := &wtxmgr.BlockMeta{
+		Block: wtxmgr.Block{
+			Hash:   chainhash.DoubleHashH([]byte{byte(height), byte(height >> 8)}),
+			Height: height,
+		},
+		Time: time.Now(),
+	}
+	if err := walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) er.R {
+		return w.addRelevantTx(dbtx, rec, block)
+	}); err != nil {
+		t.Fatalf("unable to add relevant tx: %v", err)
+	}
+}
+
+// TestImmatureRewards ensures that immature coinbase outputs are grouped by
+// the number of blocks until they mature, and that mature coinbase outputs
+// and other outputs are left out.
+func TestImmatureRewards(t *testing.T) {
+	w, cleanup := testWallet(t)
+	defer cleanup()
+
+	// With a maturity of 100 blocks, the coinbase at height 51 is mature
+	// at height 150, the ones at height 60 mature in 9 blocks and the one
+	// at height 140 in 89 blocks.
+	mineToWallet(t, w, 1000, 51)
+	mineToWallet(t, w, 2000, 60)
+	mineToWallet(t, w, 3000, 60)
+	mineToWallet(t, w, 4000, 140)
+	payWallet(t, w, 1, 5000, nil)
+	setSyncedTo(t, w, 150)
+
+	check := func(within int32, want []ImmatureReward) {
+		t.Helper()
+		rewards, err := w.ImmatureRewards(within)
+		if err != nil {
+			t.Fatalf("unable to get immature rewards: %v", err)
+		}
+		if len(rewards) != len(want) {
+			t.Fatalf("expected %+v, got %+v", want, rewards)
+		}
+		for i := range want {
+			if rewards[i] != want[i] {
+				t.Fatalf("expected %+v, got %+v", want, rewards)
+			}
+		}
+	}
+
+	check(100, []ImmatureReward{
+		{BlocksToMaturity: 9, Amount: 5000, OutputCount: 2},
+		{BlocksToMaturity: 89, Amount: 4000, OutputCount: 1},
+	})
+	check(10, []ImmatureReward{
+		{BlocksToMaturity: 9, Amount: 5000, OutputCount: 2},
+	})
+
+	// The breakdown adds up to the immature balance.
+	bal, err := w.CalculateAccountBalance(waddrmgr.DefaultAccountNum, 1)
+	if err != nil {
+		t.Fatalf("unable to calculate balance: %v", err)
+	}
+	if bal.ImmatureReward != 9000 {
+		t.Fatalf("expected immature reward 9000, got %v",
+			bal.ImmatureReward)
+	}
+}
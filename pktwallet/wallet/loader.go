@@ -5,6 +5,7 @@
 package wallet
 
 import (
+	"encoding/hex"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -12,10 +13,13 @@ import (
 	"sync"
 	"time"
 
+	"github.com/pkt-cash/pktd/btcutil"
 	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/btcutil/hdkeychain"
 	"github.com/pkt-cash/pktd/pktlog/log"
 
 	"github.com/pkt-cash/pktd/chaincfg"
+	"github.com/pkt-cash/pktd/pktwallet/chain"
 	"github.com/pkt-cash/pktd/pktwallet/internal/prompt"
 	"github.com/pkt-cash/pktd/pktwallet/waddrmgr"
 	"github.com/pkt-cash/pktd/pktwallet/wallet/seedwords"
@@ -24,6 +28,9 @@ import (
 
 var Err er.ErrorType = er.NewErrorType("wallet.Err")
 
+// DefaultDbDriver is the walletdb driver used when none has been configured.
+const DefaultDbDriver = "bdb"
+
 var (
 	// ErrLoaded describes the error condition of attempting to load or
 	// create a wallet when the loader has already done so.
@@ -39,8 +46,23 @@ var (
 	// wallet when one exists already.
 	ErrExists = Err.CodeWithDetail("ErrExists",
 		"wallet already exists")
+
+	// ErrWrongPassphrase describes the error condition of attempting to
+	// open an existing wallet with an incorrect public passphrase.
+	ErrWrongPassphrase = Err.CodeWithDetail("ErrWrongPassphrase",
+		"incorrect public passphrase for wallet")
+
+	// ErrInvalidWalletName describes the error condition of attempting to
+	// create or open a wallet with a name which can't be used as a file name
+	// in the wallet directory.
+	ErrInvalidWalletName = Err.CodeWithDetail("ErrInvalidWalletName",
+		"invalid wallet name")
 )
 
+// maxPassphraseAttempts is the number of times the public passphrase is tried
+// by OpenExistingWalletInteractive before giving up.
+const maxPassphraseAttempts = 3
+
 // Loader implements the creating of new and opening of existing wallets, while
 // providing a callback system for other subsystems to handle the loading of a
 // wallet.  This is primarily intended for use by the RPC servers, to enable
@@ -54,8 +76,15 @@ type Loader struct {
 	dbDirPath      string
 	walletName     string
 	recoveryWindow uint32
+	dbDriver       string
+	addressReuse   AddressReusePolicy
+	changeType     ChangeType
+	txSequence     uint32
+	maxWatched     int
+	reorgSafety    int32
 	wallet         *Wallet
 	db             walletdb.DB
+	chainClient    chain.Interface
 	mu             sync.Mutex
 }
 
@@ -70,16 +99,82 @@ func NewLoader(chainParams *chaincfg.Params, dbDirPath, walletName string,
 		walletName:     walletName,
 		dbDirPath:      dbDirPath,
 		recoveryWindow: recoveryWindow,
+		dbDriver:       DefaultDbDriver,
+		reorgSafety:    DefaultReorgSafetyDepth,
+		txSequence:     DefaultTxSequence,
 	}
 }
 
+// SetDbDriver selects the walletdb driver used to create and open the wallet
+// database.  It must be called before a wallet is loaded.
+func (l *Loader) SetDbDriver(driver string) {
+	l.mu.Lock()
+	l.dbDriver = driver
+	l.mu.Unlock()
+}
+
+// SetAddressReusePolicy selects how loaded wallets react to address reuse.
+// It must be called before a wallet is loaded.
+func (l *Loader) SetAddressReusePolicy(policy AddressReusePolicy) {
+	l.mu.Lock()
+	l.addressReuse = policy
+	l.mu.Unlock()
+}
+
+// SetChangeType selects the type of the addresses loaded wallets send change
+// to. It must be called before a wallet is loaded.
+func (l *Loader) SetChangeType(changeType ChangeType) {
+	l.mu.Lock()
+	l.changeType = changeType
+	l.mu.Unlock()
+}
+
+// SetTxSequence sets the sequence number of the inputs of transactions which
+// loaded wallets create without requesting one. It must be called before a
+// wallet is loaded.
+func (l *Loader) SetTxSequence(sequence uint32) {
+	l.mu.Lock()
+	l.txSequence = sequence
+	l.mu.Unlock()
+}
+
+// SetMaxWatchedAddrs limits the number of addresses loaded wallets watch, zero
+// means there is no limit. It must be called before a wallet is loaded.
+func (l *Loader) SetMaxWatchedAddrs(max int) {
+	l.mu.Lock()
+	l.maxWatched = max
+	l.mu.Unlock()
+}
+
+// SetReorgSafetyDepth sets the number of confirmations after which loaded
+// wallets consider a transaction final. It must be called before a wallet is
+// loaded.
+func (l *Loader) SetReorgSafetyDepth(depth int32) {
+	l.mu.Lock()
+	l.reorgSafety = depth
+	l.mu.Unlock()
+}
+
 // onLoaded executes each added callback and prevents loader from loading any
 // additional wallets.  Requires mutex to be locked.
 func (l *Loader) onLoaded(w *Wallet, db walletdb.DB) {
+	w.SetAddressReusePolicy(l.addressReuse)
+	w.SetChangeType(l.changeType)
+	w.SetTxSequence(l.txSequence)
+	w.SetMaxWatchedAddrs(l.maxWatched)
+	w.SetReorgSafetyDepth(l.reorgSafety)
+
 	for _, fn := range l.callbacks {
 		fn(w)
 	}
 
+	// A chain client kept from an unloaded wallet is handed over to the
+	// new one.  It is ignored if a callback has associated another client.
+	if l.chainClient != nil {
+		w.SynchronizeRPC(l.chainClient)
+		l.chainClient = nil
+	}
+
 	l.wallet = w
 	l.db = db
 	l.callbacks = nil // not needed anymore
@@ -134,12 +229,12 @@ func (l *Loader) CreateNewWallet(pubPassphrase, privPassphrase []byte,
 		return nil, ErrExists.Default()
 	}
 
-	// Create the wallet database backed by bolt db.
+	// Create the wallet database using the configured driver.
 	err = er.E(os.MkdirAll(l.dbDirPath, 0o700))
 	if err != nil {
 		return nil, err
 	}
-	db, err := walletdb.Create("bdb", dbPath, false)
+	db, err := walletdb.Create(l.dbDriver, dbPath, false)
 	if err != nil {
 		return nil, err
 	}
@@ -161,6 +256,136 @@ func (l *Loader) CreateNewWallet(pubPassphrase, privPassphrase []byte,
 	return w, nil
 }
 
+// validWalletName returns whether name can be used for a wallet database in
+// the loader's directory without escaping it.
+func validWalletName(name string) bool {
+	if name == "" || strings.HasPrefix(name, ".") {
+		return false
+	}
+	return !strings.ContainsAny(name, `/\`) && filepath.Base(name) == name
+}
+
+// masterKeyFingerprint returns the hex encoded BIP32 fingerprint of the master
+// key derived from the wallet seed.  It identifies a wallet without revealing
+// any of its keys.
+func masterKeyFingerprint(seedInput []byte, seed *seedwords.Seed,
+	params *chaincfg.Params) (string, er.R) {
+
+	var seedBytes []byte
+	if seed != nil {
+		seedBytes = seed.Bytes()
+	} else {
+		decoded, errr := hex.DecodeString(string(seedInput))
+		if errr != nil {
+			return "", er.E(errr)
+		}
+		seedBytes = decoded
+	}
+
+	rootKey, err := hdkeychain.NewMaster(seedBytes, params)
+	if err != nil {
+		return "", err
+	}
+	defer rootKey.Zero()
+
+	pubKey, err := rootKey.ECPubKey()
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(
+		btcutil.Hash160(pubKey.SerializeCompressed())[:4],
+	), nil
+}
+
+// CreateWallet creates a new wallet database called name in the loader's
+// database directory without loading it, so it can be used while another
+// wallet is loaded.  The seed is handled like in CreateNewWallet.  If
+// watchOnly is set, all private key material is removed from the new wallet.
+// ErrExists is returned if a wallet of the same name exists already.  On
+// success, the fingerprint of the wallet's master key is returned.
+func (l *Loader) CreateWallet(name string, pubPassphrase, privPassphrase,
+	seedInput []byte, seed *seedwords.Seed, watchOnly bool) (string, er.R) {
+
+	if !validWalletName(name) {
+		return "", ErrInvalidWalletName.New(name, nil)
+	}
+
+	defer l.mu.Unlock()
+	l.mu.Lock()
+
+	dbPath := WalletDbPath(l.dbDirPath, name)
+	exists, err := fileExists(dbPath)
+	if err != nil {
+		return "", err
+	}
+	if exists {
+		return "", ErrExists.New(name, nil)
+	}
+
+	err = er.E(os.MkdirAll(l.dbDirPath, 0o700))
+	if err != nil {
+		return "", err
+	}
+	db, err := walletdb.Create(l.dbDriver, dbPath, false)
+	if err != nil {
+		return "", err
+	}
+
+	var fingerprint string
+	err = Create(db, pubPassphrase, privPassphrase, seedInput, time.Time{},
+		seed, l.chainParams)
+	if err == nil {
+		fingerprint, err = masterKeyFingerprint(
+			seedInput, seed, l.chainParams,
+		)
+	}
+	if err == nil && watchOnly {
+		err = walletdb.Update(db, func(tx walletdb.ReadWriteTx) er.R {
+			ns := tx.ReadWriteBucket(waddrmgrNamespaceKey)
+			mgr, err := waddrmgr.Open(ns, pubPassphrase, l.chainParams)
+			if err != nil {
+				return err
+			}
+			defer mgr.Close()
+			return mgr.ConvertToWatchingOnly(ns)
+		})
+	}
+	if e := db.Close(); err == nil {
+		err = e
+	}
+	if err != nil {
+		// Don't leave a half initialized wallet behind, it would
+		// prevent creating the wallet again.
+		if errr := os.Remove(dbPath); errr != nil {
+			log.Warnf("Unable to remove wallet database [%s]: %v",
+				dbPath, errr)
+		}
+		return "", err
+	}
+
+	log.Infof("Created wallet [%s] with fingerprint [%s]", name, fingerprint)
+	return fingerprint, nil
+}
+
+// OpenWallet opens the wallet called name from the loader's database
+// directory like OpenExistingWallet, without console prompts.  The wallet
+// opened by the loader is changed only if no wallet is loaded yet.
+func (l *Loader) OpenWallet(name string, pubPassphrase []byte) (*Wallet, er.R) {
+	if !validWalletName(name) {
+		return nil, ErrInvalidWalletName.New(name, nil)
+	}
+
+	l.mu.Lock()
+	if l.wallet != nil {
+		l.mu.Unlock()
+		return nil, ErrLoaded.Default()
+	}
+	l.walletName = name
+	l.mu.Unlock()
+
+	return l.OpenExistingWallet(pubPassphrase, false)
+}
+
 func noConsole() ([]byte, er.R) {
 	return nil, er.New("db upgrade requires console access for additional input")
 }
@@ -168,7 +393,8 @@ func noConsole() ([]byte, er.R) {
 // OpenExistingWallet opens the wallet from the loader's wallet database path
 // and the public passphrase.  If the loader is being called by a context where
 // standard input prompts may be used during wallet upgrades, setting
-// canConsolePrompt will enables these prompts.
+// canConsolePrompt will enables these prompts.  ErrWrongPassphrase is returned
+// if the public passphrase is incorrect.
 func (l *Loader) OpenExistingWallet(pubPassphrase []byte, canConsolePrompt bool) (*Wallet, er.R) {
 	defer l.mu.Unlock()
 	l.mu.Lock()
@@ -182,9 +408,9 @@ func (l *Loader) OpenExistingWallet(pubPassphrase []byte, canConsolePrompt bool)
 		return nil, err
 	}
 
-	// Open the database using the boltdb backend.
+	// Open the database using the configured driver.
 	dbPath := WalletDbPath(l.dbDirPath, l.walletName)
-	db, err := walletdb.Open("bdb", dbPath, false)
+	db, err := walletdb.Open(l.dbDriver, dbPath, false)
 	if err != nil {
 		log.Errorf("Failed to open database: %v", err)
 		return nil, err
@@ -211,6 +437,9 @@ func (l *Loader) OpenExistingWallet(pubPassphrase []byte, canConsolePrompt bool)
 		if e != nil {
 			log.Warnf("Error closing database: %v", e)
 		}
+		if waddrmgr.ErrWrongPassphrase.Is(err) {
+			return nil, ErrWrongPassphrase.New("", err)
+		}
 		return nil, err
 	}
 	w.Start()
@@ -219,6 +448,28 @@ func (l *Loader) OpenExistingWallet(pubPassphrase []byte, canConsolePrompt bool)
 	return w, nil
 }
 
+// OpenExistingWalletInteractive opens the wallet like OpenExistingWallet with
+// console prompts enabled.  If the public passphrase is incorrect, a new one is
+// requested from obtainPubPass, up to maxPassphraseAttempts tries in total.
+func (l *Loader) OpenExistingWalletInteractive(pubPassphrase []byte,
+	obtainPubPass func() ([]byte, er.R)) (*Wallet, er.R) {
+
+	w, err := l.OpenExistingWallet(pubPassphrase, true)
+	for attempt := 1; attempt < maxPassphraseAttempts; attempt++ {
+		if !ErrWrongPassphrase.Is(err) {
+			break
+		}
+		log.Warnf("Incorrect public passphrase, %d attempts left",
+			maxPassphraseAttempts-attempt)
+		pass, errPrompt := obtainPubPass()
+		if errPrompt != nil {
+			return nil, errPrompt
+		}
+		w, err = l.OpenExistingWallet(pass, true)
+	}
+	return w, err
+}
+
 // WalletExists returns whether a file exists at the loader's database path.
 // This may return an error for unexpected I/O failures.
 func (l *Loader) WalletExists() (bool, er.R) {
@@ -260,6 +511,51 @@ func (l *Loader) UnloadWallet() er.R {
 	return nil
 }
 
+// UnloadWalletKeepChainClient unloads the wallet like UnloadWallet, but keeps
+// the chain client of the wallet running rather than stopping it.  The client
+// is associated with the next wallet which is created or opened by the loader,
+// so that wallets can be switched at runtime without reconnecting to the
+// chain.
+func (l *Loader) UnloadWalletKeepChainClient() er.R {
+	defer l.mu.Unlock()
+	l.mu.Lock()
+
+	if l.wallet == nil {
+		return ErrNotLoaded.Default()
+	}
+
+	chainClient := l.wallet.DetachChainClient()
+	if chainClient != nil {
+		l.chainClient = chainClient
+	}
+	l.wallet.Stop()
+	l.wallet.WaitForShutdown()
+	err := l.db.Close()
+	if err != nil {
+		return err
+	}
+
+	l.wallet = nil
+	l.db = nil
+	return nil
+}
+
+// CompactWalletDB compacts the database of the wallet to reclaim the space
+// left behind by deleted data, and returns its size before and after the
+// compaction.  It must be called before the wallet is loaded, the loader
+// refuses to load the wallet until the compaction is done.
+func (l *Loader) CompactWalletDB() (int64, int64, er.R) {
+	defer l.mu.Unlock()
+	l.mu.Lock()
+
+	if l.wallet != nil {
+		return 0, 0, ErrLoaded.Default()
+	}
+
+	dbPath := WalletDbPath(l.dbDirPath, l.walletName)
+	return walletdb.Compact(l.dbDriver, dbPath)
+}
+
 func fileExists(filePath string) (bool, er.R) {
 	_, err := os.Stat(filePath)
 	if err != nil {
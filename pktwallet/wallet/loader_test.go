@@ -0,0 +1,376 @@
+package wallet
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/chaincfg"
+	"github.com/pkt-cash/pktd/chaincfg/genesis"
+	"github.com/pkt-cash/pktd/pktwallet/wallet/seedwords"
+	"github.com/pkt-cash/pktd/pktwallet/walletdb"
+)
+
+// TestOpenExistingWalletWrongPassphrase ensures that opening a wallet with an
+// incorrect public passphrase fails with ErrWrongPassphrase, and that the
+// interactive variant retries a bounded number of times.
+func TestOpenExistingWalletWrongPassphrase(t *testing.T) {
+	dir, errr := ioutil.TempDir("", "test_wallet_pass")
+	if errr != nil {
+		t.Fatalf("Failed to create db dir: %v", errr)
+	}
+	defer os.RemoveAll(dir)
+
+	pubPass := []byte("hello")
+	seed, err := seedwords.RandomSeed()
+	if err != nil {
+		t.Fatalf("unable to create seed: %v", err)
+	}
+	loader := NewLoader(&chaincfg.TestNet3Params, dir, "wallet.db", true, 250)
+	w, err := loader.CreateNewWallet(
+		pubPass, []byte("world"), nil, time.Time{}, seed,
+	)
+	if err != nil {
+		t.Fatalf("unable to create wallet: %v", err)
+	}
+	w.Stop()
+	if err := loader.db.Close(); err != nil {
+		t.Fatalf("unable to close wallet db: %v", err)
+	}
+
+	newLoader := func() *Loader {
+		return NewLoader(
+			&chaincfg.TestNet3Params, dir, "wallet.db", true, 250,
+		)
+	}
+
+	// A missing database is not a passphrase problem.
+	missing := NewLoader(
+		&chaincfg.TestNet3Params, dir, "missing.db", true, 250,
+	)
+	_, err = missing.OpenExistingWallet(pubPass, false)
+	if err == nil || ErrWrongPassphrase.Is(err) {
+		t.Fatalf("expected a non-passphrase error, got %v", err)
+	}
+
+	// Non-interactive mode fails straight away with a typed error.
+	_, err = newLoader().OpenExistingWallet([]byte("wrong"), false)
+	if !ErrWrongPassphrase.Is(err) {
+		t.Fatalf("expected ErrWrongPassphrase, got %v", err)
+	}
+
+	// Interactive mode keeps prompting until the passphrase is correct.
+	replies := [][]byte{[]byte("still wrong"), pubPass}
+	prompts := 0
+	obtainPubPass := func() ([]byte, er.R) {
+		reply := replies[prompts]
+		prompts++
+		return reply, nil
+	}
+	l := newLoader()
+	w, err = l.OpenExistingWalletInteractive([]byte("wrong"), obtainPubPass)
+	if err != nil {
+		t.Fatalf("unable to open wallet: %v", err)
+	}
+	if prompts != 2 {
+		t.Fatalf("expected 2 prompts, got %d", prompts)
+	}
+	w.Stop()
+	if err := l.db.Close(); err != nil {
+		t.Fatalf("unable to close wallet db: %v", err)
+	}
+
+	// ... but gives up after a bounded number of attempts.
+	prompts = 0
+	obtainPubPass = func() ([]byte, er.R) {
+		prompts++
+		return []byte("wrong"), nil
+	}
+	_, err = newLoader().OpenExistingWalletInteractive(
+		[]byte("wrong"), obtainPubPass,
+	)
+	if !ErrWrongPassphrase.Is(err) {
+		t.Fatalf("expected ErrWrongPassphrase, got %v", err)
+	}
+	if prompts != maxPassphraseAttempts-1 {
+		t.Fatalf("expected %d prompts, got %d",
+			maxPassphraseAttempts-1, prompts)
+	}
+}
+
+// TestLoaderCreateWallet ensures that additional wallets can be created next
+// to the loaded one, that existing wallets are never overwritten and that
+// the new wallets can be opened later.
+func TestLoaderCreateWallet(t *testing.T) {
+	dir, errr := ioutil.TempDir("", "test_wallet_create")
+	if errr != nil {
+		t.Fatalf("Failed to create db dir: %v", errr)
+	}
+	defer os.RemoveAll(dir)
+
+	pubPass := []byte("hello")
+	privPass := []byte("world")
+	seed, err := seedwords.RandomSeed()
+	if err != nil {
+		t.Fatalf("unable to create seed: %v", err)
+	}
+
+	loader := NewLoader(&chaincfg.TestNet3Params, dir, "wallet.db", true, 250)
+	w, err := loader.CreateNewWallet(
+		pubPass, privPass, nil, time.Time{}, seed,
+	)
+	if err != nil {
+		t.Fatalf("unable to create wallet: %v", err)
+	}
+	defer func() {
+		if err := loader.UnloadWallet(); err != nil {
+			t.Fatalf("unable to unload wallet: %v", err)
+		}
+	}()
+
+	// Names which would escape the wallet directory are rejected.
+	for _, name := range []string{"", "..", "../other", "a/b", ".hidden"} {
+		_, err := loader.CreateWallet(
+			name, pubPass, privPass, nil, seed, false,
+		)
+		if !ErrInvalidWalletName.Is(err) {
+			t.Fatalf("expected ErrInvalidWalletName for %q, got %v",
+				name, err)
+		}
+	}
+
+	// Creating a wallet leaves the loaded wallet untouched, and a wallet of
+	// the same name can't be created twice.
+	fingerprint, err := loader.CreateWallet(
+		"second", pubPass, privPass, nil, seed, false,
+	)
+	if err != nil {
+		t.Fatalf("unable to create second wallet: %v", err)
+	}
+	if len(fingerprint) != 8 {
+		t.Fatalf("unexpected fingerprint %q", fingerprint)
+	}
+	if loaded, _ := loader.LoadedWallet(); loaded != w {
+		t.Fatal("expected the loaded wallet to be unchanged")
+	}
+	_, err = loader.CreateWallet(
+		"second", pubPass, privPass, nil, seed, false,
+	)
+	if !ErrExists.Is(err) {
+		t.Fatalf("expected ErrExists, got %v", err)
+	}
+
+	otherSeed, err := seedwords.RandomSeed()
+	if err != nil {
+		t.Fatalf("unable to create seed: %v", err)
+	}
+	otherFingerprint, err := loader.CreateWallet(
+		"watching", pubPass, privPass, nil, otherSeed, true,
+	)
+	if err != nil {
+		t.Fatalf("unable to create watch-only wallet: %v", err)
+	}
+	if otherFingerprint == fingerprint {
+		t.Fatal("expected different seeds to have different " +
+			"fingerprints")
+	}
+
+	// Another wallet can't be opened while one is loaded, but a new
+	// loader can open the created wallets.
+	if _, err := loader.OpenWallet("second", pubPass); !ErrLoaded.Is(err) {
+		t.Fatalf("expected ErrLoaded, got %v", err)
+	}
+	l := NewLoader(&chaincfg.TestNet3Params, dir, "unused.db", true, 250)
+	watching, err := l.OpenWallet("watching", pubPass)
+	if err != nil {
+		t.Fatalf("unable to open watch-only wallet: %v", err)
+	}
+	if !watching.Manager.WatchOnly() {
+		t.Fatal("expected wallet to be watch-only")
+	}
+	if watching.Manager.Seed() != nil {
+		t.Fatal("expected watch-only wallet to have no seed")
+	}
+	if err := l.UnloadWallet(); err != nil {
+		t.Fatalf("unable to unload wallet: %v", err)
+	}
+}
+
+// TestCreateWalletBirthday ensures that a given birthday takes precedence over
+// the birthday of the seed, and that a birthday before the genesis block is
+// clamped to it.
+func TestCreateWalletBirthday(t *testing.T) {
+	dir, errr := ioutil.TempDir("", "test_wallet_birthday")
+	if errr != nil {
+		t.Fatalf("Failed to create db dir: %v", errr)
+	}
+	defer os.RemoveAll(dir)
+
+	seed, err := seedwords.RandomSeed()
+	if err != nil {
+		t.Fatalf("unable to create seed: %v", err)
+	}
+	genesisTime := genesis.Block(
+		chaincfg.TestNet3Params.GenesisHash,
+	).Header.Timestamp
+	birthday := seed.Birthday().Add(-24 * time.Hour)
+
+	for i, test := range []struct {
+		birthday time.Time
+		expected time.Time
+	}{
+		{expected: seed.Birthday()},
+		{birthday: birthday, expected: birthday},
+		{birthday: time.Unix(1, 0), expected: genesisTime},
+	} {
+		loader := NewLoader(
+			&chaincfg.TestNet3Params, dir, fmt.Sprintf("wallet%d.db", i),
+			true, 250,
+		)
+		w, err := loader.CreateNewWallet(
+			[]byte("hello"), []byte("world"), nil, test.birthday, seed,
+		)
+		if err != nil {
+			t.Fatalf("unable to create wallet: %v", err)
+		}
+		if !w.Manager.Birthday().Equal(test.expected) {
+			t.Fatalf("expected birthday %v, got %v", test.expected,
+				w.Manager.Birthday())
+		}
+		if err := loader.UnloadWallet(); err != nil {
+			t.Fatalf("unable to unload wallet: %v", err)
+		}
+	}
+}
+
+// stopTrackingChainClient is a mock chain client which records whether it has
+// been stopped.
+type stopTrackingChainClient struct {
+	mockChainClient
+	stopped bool
+}
+
+func (c *stopTrackingChainClient) Stop() {
+	c.stopped = true
+}
+
+// TestLoaderUnloadWalletKeepChainClient ensures that unloading a wallet while
+// keeping its chain client closes the wallet without stopping the client, and
+// that the loader can load another wallet afterwards.
+func TestLoaderUnloadWalletKeepChainClient(t *testing.T) {
+	dir, errr := ioutil.TempDir("", "test_wallet_unload")
+	if errr != nil {
+		t.Fatalf("Failed to create db dir: %v", errr)
+	}
+	defer os.RemoveAll(dir)
+
+	pubPass := []byte("hello")
+	privPass := []byte("world")
+	seed, err := seedwords.RandomSeed()
+	if err != nil {
+		t.Fatalf("unable to create seed: %v", err)
+	}
+
+	loader := NewLoader(&chaincfg.TestNet3Params, dir, "wallet.db", true, 250)
+	w, err := loader.CreateNewWallet(
+		pubPass, privPass, nil, time.Time{}, seed,
+	)
+	if err != nil {
+		t.Fatalf("unable to create wallet: %v", err)
+	}
+	_, err = loader.CreateWallet(
+		"second", pubPass, privPass, nil, seed, false,
+	)
+	if err != nil {
+		t.Fatalf("unable to create second wallet: %v", err)
+	}
+
+	// The client is set directly so that the wallet doesn't start
+	// syncing against the mock.
+	chainClient := &stopTrackingChainClient{}
+	w.chainClient = chainClient
+
+	if err := loader.UnloadWalletKeepChainClient(); err != nil {
+		t.Fatalf("unable to unload wallet: %v", err)
+	}
+	if chainClient.stopped {
+		t.Fatal("expected the chain client to keep running")
+	}
+	if w.ChainClient() != nil {
+		t.Fatal("expected the chain client to be detached")
+	}
+	if loader.chainClient != chainClient {
+		t.Fatal("expected the loader to keep the chain client")
+	}
+	if _, loaded := loader.LoadedWallet(); loaded {
+		t.Fatal("expected no wallet to be loaded")
+	}
+	err = loader.UnloadWalletKeepChainClient()
+	if !ErrNotLoaded.Is(err) {
+		t.Fatalf("expected ErrNotLoaded, got %v", err)
+	}
+
+	// Another wallet can be loaded now.  The kept client is dropped
+	// first, handing it over would start syncing the wallet.
+	loader.chainClient = nil
+	second, err := loader.OpenWallet("second", pubPass)
+	if err != nil {
+		t.Fatalf("unable to open second wallet: %v", err)
+	}
+	if loaded, _ := loader.LoadedWallet(); loaded != second {
+		t.Fatal("expected the second wallet to be loaded")
+	}
+	if err := loader.UnloadWallet(); err != nil {
+		t.Fatalf("unable to unload wallet: %v", err)
+	}
+}
+
+// TestLoaderDbDriver tests that the loader creates the wallet database with
+// the configured driver and fails for an unknown one.
+func TestLoaderDbDriver(t *testing.T) {
+	tests := []struct {
+		driver string
+		expErr *er.ErrorCode
+	}{
+		{DefaultDbDriver, nil},
+		{"", walletdb.ErrDbUnknownType},
+		{"nosuchdriver", walletdb.ErrDbUnknownType},
+	}
+	for _, test := range tests {
+		dir, errr := ioutil.TempDir("", "test_wallet_driver")
+		if errr != nil {
+			t.Fatalf("Failed to create db dir: %v", errr)
+		}
+		defer os.RemoveAll(dir)
+
+		seed, err := seedwords.RandomSeed()
+		if err != nil {
+			t.Fatalf("unable to create seed: %v", err)
+		}
+		loader := NewLoader(
+			&chaincfg.TestNet3Params, dir, "wallet.db", true, 250,
+		)
+		loader.SetDbDriver(test.driver)
+		w, err := loader.CreateNewWallet(
+			[]byte("hello"), []byte("world"), nil, time.Time{}, seed,
+		)
+		if test.expErr != nil {
+			if !test.expErr.Is(err) {
+				t.Fatalf("driver %q: expected %v, got %v",
+					test.driver, test.expErr, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("driver %q: unable to create wallet: %v",
+				test.driver, err)
+		}
+		w.Stop()
+		if err := loader.db.Close(); err != nil {
+			t.Fatalf("unable to close wallet db: %v", err)
+		}
+	}
+}
@@ -0,0 +1,87 @@
+package wallet
+
+import (
+	"sync/atomic"
+
+	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/pktwallet/waddrmgr"
+	"github.com/pkt-cash/pktd/txscript/params"
+	"github.com/pkt-cash/pktd/wire"
+	"github.com/pkt-cash/pktd/wire/constants"
+)
+
+// DefaultTxSequence is the sequence number of the inputs of transactions
+// created by the wallet unless configured otherwise. It makes the inputs
+// final, so transactions are neither replaceable nor locked.
+const DefaultTxSequence = constants.MaxTxInSequenceNum
+
+// ErrLockTimeIgnored is returned when a transaction is given a locktime while
+// the sequence numbers of its inputs are final, which disables the locktime.
+var ErrLockTimeIgnored = Err.CodeWithDetail("ErrLockTimeIgnored",
+	"locktime has no effect because the input sequence numbers are final")
+
+// ErrLockTimeNotReached is returned when a transaction is to be broadcast
+// before its locktime, the network does not accept it until then.
+var ErrLockTimeNotReached = Err.CodeWithDetail("ErrLockTimeNotReached",
+	"locktime of the transaction has not been reached yet")
+
+// SetTxSequence sets the sequence number of the inputs of transactions which
+// don't request one. Sequence numbers below 0xffffffff make the locktime of a
+// transaction effective and below 0xfffffffe also signal replaceability
+// (BIP0125).
+func (w *Wallet) SetTxSequence(sequence uint32) {
+	atomic.StoreUint32(&w.txSequence, sequence)
+}
+
+// TxSequence returns the sequence number of the inputs of transactions which
+// don't request one.
+func (w *Wallet) TxSequence() uint32 {
+	return atomic.LoadUint32(&w.txSequence)
+}
+
+// setLockTime sets the locktime of tx and the sequence number of each of its
+// inputs, which is the requested one or else the default of the wallet. A
+// default sequence number which is final is lowered by one for a non-zero
+// locktime so the locktime is honored, while a requested one which is final
+// is an error.
+func (w *Wallet) setLockTime(tx *wire.MsgTx, lockTime uint32,
+	sequence *uint32) er.R {
+
+	seq := w.TxSequence()
+	if sequence != nil {
+		seq = *sequence
+	} else if lockTime != 0 && seq == constants.MaxTxInSequenceNum {
+		seq = constants.MaxTxInSequenceNum - 1
+	}
+	if lockTime != 0 && seq == constants.MaxTxInSequenceNum {
+		return ErrLockTimeIgnored.Default()
+	}
+
+	tx.LockTime = lockTime
+	for _, in := range tx.TxIn {
+		in.Sequence = seq
+	}
+	return nil
+}
+
+// inputsFinal returns whether all of the inputs have a final sequence number,
+// which disables the locktime of their transaction.
+func inputsFinal(txIn []*wire.TxIn) bool {
+	for _, in := range txIn {
+		if in.Sequence != constants.MaxTxInSequenceNum {
+			return false
+		}
+	}
+	return true
+}
+
+// lockTimeReached returns whether a transaction with the locktime can be
+// included in the block following bs. Locktimes which are timestamps are
+// compared with the time of bs rather than the median time past, so a
+// transaction may be accepted somewhat later than reported.
+func lockTimeReached(lockTime uint32, bs *waddrmgr.BlockStamp) bool {
+	if lockTime < params.LockTimeThreshold {
+		return int64(lockTime) <= int64(bs.Height)
+	}
+	return int64(lockTime) < bs.Timestamp.Unix()
+}
@@ -0,0 +1,129 @@
+package wallet
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/pktwallet/waddrmgr"
+	"github.com/pkt-cash/pktd/wire"
+	"github.com/pkt-cash/pktd/wire/constants"
+)
+
+// TestSetLockTime tests that the inputs of a transaction get the requested
+// sequence number or else the default of the wallet, which is lowered if it
+// would disable the locktime, and that a requested final sequence number
+// with a locktime is rejected.
+func TestSetLockTime(t *testing.T) {
+	t.Parallel()
+
+	replaceable := constants.MaxTxInSequenceNum - 2
+	final := constants.MaxTxInSequenceNum
+	tests := []struct {
+		name        string
+		txSequence  uint32
+		lockTime    uint32
+		sequence    *uint32
+		expSequence uint32
+		expErr      *er.ErrorCode
+	}{{
+		name:        "default",
+		txSequence:  DefaultTxSequence,
+		expSequence: final,
+	}, {
+		name:        "locktime activates default",
+		txSequence:  DefaultTxSequence,
+		lockTime:    650000,
+		expSequence: final - 1,
+	}, {
+		name:        "configured default",
+		txSequence:  replaceable,
+		lockTime:    650000,
+		expSequence: replaceable,
+	}, {
+		name:        "requested sequence",
+		txSequence:  DefaultTxSequence,
+		sequence:    &replaceable,
+		expSequence: replaceable,
+	}, {
+		name:       "requested final sequence with locktime",
+		txSequence: DefaultTxSequence,
+		lockTime:   650000,
+		sequence:   &final,
+		expErr:     ErrLockTimeIgnored,
+	}}
+	for _, test := range tests {
+		w := &Wallet{txSequence: test.txSequence}
+		tx := &wire.MsgTx{
+			TxIn: []*wire.TxIn{
+				{Sequence: final}, {Sequence: final},
+			},
+		}
+		err := w.setLockTime(tx, test.lockTime, test.sequence)
+		if test.expErr != nil {
+			if !test.expErr.Is(err) {
+				t.Fatalf("%s: expected %v, got %v", test.name,
+					test.expErr, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%s: unable to set locktime: %v", test.name, err)
+		}
+		if tx.LockTime != test.lockTime {
+			t.Fatalf("%s: expected locktime %d, got %d", test.name,
+				test.lockTime, tx.LockTime)
+		}
+		for i, in := range tx.TxIn {
+			if in.Sequence != test.expSequence {
+				t.Fatalf("%s: expected sequence %d of input %d, "+
+					"got %d", test.name, test.expSequence, i,
+					in.Sequence)
+			}
+		}
+	}
+}
+
+// TestLockTimeReached tests that height and timestamp locktimes are compared
+// with the block following the best block.
+func TestLockTimeReached(t *testing.T) {
+	t.Parallel()
+
+	bs := &waddrmgr.BlockStamp{
+		Height:    650000,
+		Timestamp: time.Unix(1600000000, 0),
+	}
+	tests := []struct {
+		lockTime uint32
+		reached  bool
+	}{
+		{650000, true},
+		{650001, false},
+		{1599999999, true},
+		{1600000000, false},
+	}
+	for _, test := range tests {
+		if reached := lockTimeReached(test.lockTime, bs); reached != test.reached {
+			t.Fatalf("locktime %d: expected reached %v, got %v",
+				test.lockTime, test.reached, reached)
+		}
+	}
+}
+
+// TestSendOutputsLockTime tests that a transaction is not sent before its
+// locktime.
+func TestSendOutputsLockTime(t *testing.T) {
+	w, cleanup := testWallet(t)
+	defer cleanup()
+
+	// The best block of the mock chain client is at height 500000.
+	_, err := w.SendOutputs(CreateTxReq{
+		Outputs:     []*wire.TxOut{{Value: 1e8, PkScript: testScriptP2WSH}},
+		Minconf:     1,
+		FeeSatPerKB: 1000,
+		LockTime:    500001,
+	})
+	if !ErrLockTimeNotReached.Is(err) {
+		t.Fatalf("expected ErrLockTimeNotReached, got %v", err)
+	}
+}
@@ -0,0 +1,84 @@
+package wallet
+
+import (
+	"github.com/pkt-cash/pktd/btcutil"
+	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/pktwallet/waddrmgr"
+	"github.com/pkt-cash/pktd/pktwallet/walletdb"
+	"github.com/pkt-cash/pktd/txscript"
+	"github.com/pkt-cash/pktd/wire"
+)
+
+// MempoolTx describes an unconfirmed transaction which pays to or spends from
+// the wallet.
+type MempoolTx struct {
+	Tx *wire.MsgTx
+
+	// Received is the total value of the outputs paying to wallet
+	// addresses, change included.
+	Received btcutil.Amount
+
+	// Sent is the total value of the wallet outputs spent by the
+	// transaction.
+	Sent btcutil.Amount
+
+	// Addresses are the wallet addresses which are paid by the
+	// transaction.
+	Addresses []btcutil.Address
+}
+
+// RelevantMempoolTx checks whether a transaction which was accepted to the
+// mempool pays to a wallet address or spends a wallet output. It returns nil if
+// the transaction does neither.
+func (w *Wallet) RelevantMempoolTx(tx *wire.MsgTx) (*MempoolTx, er.R) {
+	mtx := &MempoolTx{Tx: tx}
+	relevant := false
+	err := walletdb.View(w.db, func(dbtx walletdb.ReadTx) er.R {
+		addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+		txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+
+		for _, output := range tx.TxOut {
+			_, addrs, _, err := txscript.ExtractPkScriptAddrs(
+				output.PkScript, w.chainParams,
+			)
+			if err != nil {
+				// Non-standard outputs can't pay the wallet.
+				continue
+			}
+			for _, addr := range addrs {
+				_, err := w.Manager.Address(addrmgrNs, addr)
+				if waddrmgr.ErrAddressNotFound.Is(err) {
+					continue
+				} else if err != nil {
+					return err
+				}
+				mtx.Received += btcutil.Amount(output.Value)
+				mtx.Addresses = append(mtx.Addresses, addr)
+				relevant = true
+				break
+			}
+		}
+
+		for _, input := range tx.TxIn {
+			prevOut := &input.PreviousOutPoint
+			details, err := w.TxStore.TxDetails(txmgrNs, &prevOut.Hash)
+			if err != nil {
+				return err
+			}
+			if details == nil {
+				continue
+			}
+			for _, cred := range details.Credits {
+				if cred.Index == prevOut.Index {
+					mtx.Sent += cred.Amount
+					relevant = true
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil || !relevant {
+		return nil, err
+	}
+	return mtx, nil
+}
@@ -0,0 +1,98 @@
+package wallet
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/pktwallet/waddrmgr"
+	"github.com/pkt-cash/pktd/pktwallet/walletdb"
+	"github.com/pkt-cash/pktd/pktwallet/wtxmgr"
+	"github.com/pkt-cash/pktd/txscript"
+	"github.com/pkt-cash/pktd/wire"
+)
+
+// TestRelevantMempoolTx tests that mempool transactions paying to or spending
+// from the wallet are recognized, and that others are ignored.
+func TestRelevantMempoolTx(t *testing.T) {
+	t.Parallel()
+
+	w, cleanup := testWallet(t)
+	defer cleanup()
+
+	addr, err := w.NewAddress(0, waddrmgr.KeyScopeBIP0084)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pkScript, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherScript := []byte{txscript.OP_TRUE}
+
+	// An unconfirmed payment to the wallet is reported as received.
+	receiveTx := &wire.MsgTx{
+		TxIn: []*wire.TxIn{{}},
+		TxOut: []*wire.TxOut{
+			{PkScript: otherScript, Value: 5000},
+			{PkScript: pkScript, Value: 1000},
+		},
+	}
+	mtx, err := w.RelevantMempoolTx(receiveTx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mtx == nil {
+		t.Fatal("expected the payment to be relevant")
+	}
+	if mtx.Received != 1000 || mtx.Sent != 0 {
+		t.Fatalf("expected 1000 received and nothing sent, got %v and %v",
+			mtx.Received, mtx.Sent)
+	}
+	if len(mtx.Addresses) != 1 ||
+		mtx.Addresses[0].EncodeAddress() != addr.EncodeAddress() {
+
+		t.Fatalf("unexpected addresses %v", mtx.Addresses)
+	}
+
+	// Once the payment is credited, spending it is reported as sent.
+	rec, err := wtxmgr.NewTxRecordFromMsgTx(receiveTx, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := walletdb.Update(w.db, func(tx walletdb.ReadWriteTx) er.R {
+		ns := tx.ReadWriteBucket(wtxmgrNamespaceKey)
+		if err := w.TxStore.InsertTx(ns, rec, nil); err != nil {
+			return err
+		}
+		return w.TxStore.AddCredit(ns, rec, nil, 1, false)
+	}); err != nil {
+		t.Fatalf("failed inserting tx: %v", err)
+	}
+	spendTx := &wire.MsgTx{
+		TxIn: []*wire.TxIn{{
+			PreviousOutPoint: wire.OutPoint{Hash: rec.Hash, Index: 1},
+		}},
+		TxOut: []*wire.TxOut{{PkScript: otherScript, Value: 900}},
+	}
+	mtx, err = w.RelevantMempoolTx(spendTx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mtx == nil || mtx.Sent != 1000 || mtx.Received != 0 {
+		t.Fatalf("expected 1000 sent, got %+v", mtx)
+	}
+
+	// Transactions which don't touch the wallet are ignored.
+	otherTx := &wire.MsgTx{
+		TxIn:  []*wire.TxIn{{}},
+		TxOut: []*wire.TxOut{{PkScript: otherScript, Value: 5000}},
+	}
+	mtx, err = w.RelevantMempoolTx(otherTx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mtx != nil {
+		t.Fatalf("expected the transaction to be ignored, got %+v", mtx)
+	}
+}
@@ -0,0 +1,120 @@
+package wallet
+
+import (
+	"bytes"
+
+	"github.com/pkt-cash/pktd/btcec"
+	"github.com/pkt-cash/pktd/btcutil"
+	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/chaincfg/chainhash"
+	"github.com/pkt-cash/pktd/txscript/opcode"
+	"github.com/pkt-cash/pktd/wire"
+)
+
+const (
+	// compactSigHeaderP2PKH is the base header byte of a compact message
+	// signature made with a compressed key for a legacy P2PKH address.
+	compactSigHeaderP2PKH = 31
+
+	// compactSigHeaderNestedP2WKH is the base header byte used by BIP137
+	// for signatures made for a P2SH nested P2WKH address.
+	compactSigHeaderNestedP2WKH = 35
+
+	// compactSigHeaderP2WKH is the base header byte used by BIP137 for
+	// signatures made for a native P2WKH address.
+	compactSigHeaderP2WKH = 39
+)
+
+// ErrUnsupportedMessageAddr is returned when a message signature is checked
+// against an address type which can't be used for signed messages.
+var ErrUnsupportedMessageAddr = Err.CodeWithDetail("ErrUnsupportedMessageAddr",
+	"address type not supported for signed messages")
+
+// messageHash returns the digest which is signed for a signed message.
+func messageHash(message string) []byte {
+	var buf bytes.Buffer
+	wire.WriteVarString(&buf, 0, "Bitcoin Signed Message:\n")
+	wire.WriteVarString(&buf, 0, message)
+	return chainhash.DoubleHashB(buf.Bytes())
+}
+
+// VerifyMessage checks that sig is a valid compact signature of message made
+// with the key of address a. Legacy P2PKH and pubkey addresses as well as
+// native and nested P2WKH addresses are supported. Both the plain compact
+// signature headers and the BIP137 segwit headers are accepted.
+func VerifyMessage(a btcutil.Address, sig []byte,
+	message string) (bool, er.R) {
+
+	// Map the BIP137 segwit headers back to the compressed key header so
+	// that the public key can be recovered.
+	if len(sig) > 0 && sig[0] >= compactSigHeaderNestedP2WKH &&
+		sig[0] < compactSigHeaderP2WKH+4 {
+
+		header := compactSigHeaderP2PKH +
+			(sig[0]-compactSigHeaderNestedP2WKH)%4
+		sig = append([]byte{header}, sig[1:]...)
+	}
+
+	pubKey, compressed, err := btcec.RecoverCompact(
+		btcec.S256(), sig, messageHash(message),
+	)
+	if err != nil {
+		return false, err
+	}
+
+	serializedPubKey := pubKey.SerializeUncompressed()
+	if compressed {
+		serializedPubKey = pubKey.SerializeCompressed()
+	}
+	pubKeyHash := btcutil.Hash160(serializedPubKey)
+
+	switch addr := a.(type) {
+	case *btcutil.AddressPubKeyHash:
+		return bytes.Equal(pubKeyHash, addr.Hash160()[:]), nil
+
+	case *btcutil.AddressPubKey:
+		return pubKey.IsEqual(addr.PubKey()), nil
+
+	// Segwit outputs can only be spent with compressed keys, so a
+	// signature made with an uncompressed key can't prove ownership.
+	case *btcutil.AddressWitnessPubKeyHash:
+		return compressed && bytes.Equal(pubKeyHash, addr.Hash160()[:]),
+			nil
+
+	case *btcutil.AddressScriptHash:
+		if !compressed {
+			return false, nil
+		}
+		redeemScript := append(
+			[]byte{opcode.OP_0, opcode.OP_DATA_20}, pubKeyHash...,
+		)
+		return bytes.Equal(
+			btcutil.Hash160(redeemScript), addr.Hash160()[:],
+		), nil
+
+	default:
+		return false, ErrUnsupportedMessageAddr.Default()
+	}
+}
+
+// signMessageCompact signs message with privKey and sets the header byte of
+// the compact signature according to the type of address a.
+func signMessageCompact(a btcutil.Address, privKey *btcec.PrivateKey,
+	compressed bool, message string) ([]byte, er.R) {
+
+	sig, err := btcec.SignCompact(
+		btcec.S256(), privKey, messageHash(message), compressed,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	switch a.(type) {
+	case *btcutil.AddressWitnessPubKeyHash:
+		sig[0] += compactSigHeaderP2WKH - compactSigHeaderP2PKH
+	case *btcutil.AddressScriptHash:
+		sig[0] += compactSigHeaderNestedP2WKH - compactSigHeaderP2PKH
+	}
+
+	return sig, nil
+}
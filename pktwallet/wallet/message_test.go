@@ -0,0 +1,82 @@
+package wallet
+
+import (
+	"testing"
+
+	"github.com/pkt-cash/pktd/pktwallet/waddrmgr"
+)
+
+// TestSignVerifyMessage tests that messages signed with the keys of the
+// different wallet address types can be verified against the address, and
+// that a tampered message or a different address is rejected.
+func TestSignVerifyMessage(t *testing.T) {
+	testCases := []struct {
+		name   string
+		scope  waddrmgr.KeyScope
+		header byte
+	}{{
+		name:   "BIP044 P2PKH",
+		scope:  waddrmgr.KeyScopeBIP0044,
+		header: compactSigHeaderP2PKH,
+	}, {
+		name:   "BIP049 nested P2WKH",
+		scope:  waddrmgr.KeyScopeBIP0049Plus,
+		header: compactSigHeaderNestedP2WKH,
+	}, {
+		name:   "BIP084 P2WKH",
+		scope:  waddrmgr.KeyScopeBIP0084,
+		header: compactSigHeaderP2WKH,
+	}}
+
+	w, cleanup := testWallet(t)
+	defer cleanup()
+
+	const message = "I own this address"
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			addr, err := w.NewAddress(0, tc.scope)
+			if err != nil {
+				t.Fatalf("unable to get new address: %v", err)
+			}
+			otherAddr, err := w.NewAddress(0, tc.scope)
+			if err != nil {
+				t.Fatalf("unable to get new address: %v", err)
+			}
+
+			_, sig, err := w.SignMessageWithAddress(addr, message)
+			if err != nil {
+				t.Fatalf("unable to sign message: %v", err)
+			}
+			if sig[0] < tc.header || sig[0] >= tc.header+4 {
+				t.Fatalf("unexpected signature header %d",
+					sig[0])
+			}
+
+			valid, err := VerifyMessage(addr, sig, message)
+			if err != nil {
+				t.Fatalf("unable to verify message: %v", err)
+			}
+			if !valid {
+				t.Fatal("expected signature to be valid")
+			}
+
+			valid, err = VerifyMessage(addr, sig, message+"!")
+			if err != nil {
+				t.Fatalf("unable to verify message: %v", err)
+			}
+			if valid {
+				t.Fatal("expected tampered message to be " +
+					"rejected")
+			}
+
+			valid, err = VerifyMessage(otherAddr, sig, message)
+			if err != nil {
+				t.Fatalf("unable to verify message: %v", err)
+			}
+			if valid {
+				t.Fatal("expected other address to be rejected")
+			}
+		})
+	}
+}
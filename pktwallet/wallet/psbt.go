@@ -6,6 +6,7 @@ package wallet
 
 import (
 	"bytes"
+	"fmt"
 
 	"github.com/pkt-cash/pktd/btcutil"
 	"github.com/pkt-cash/pktd/btcutil/er"
@@ -106,12 +107,14 @@ func (w *Wallet) FundPsbt(packet *psbt.Packet, account uint32,
 	case len(txIn) == 0:
 		// We ask the underlying wallet to fund a TX for us. This
 		// includes everything we need, specifically fee estimation and
-		// change address creation.
+		// change address creation. The selected inputs get sequence
+		// numbers which keep the locktime of the packet effective.
 		tx, err = w.CreateSimpleTx(CreateTxReq{
 			FeeSatPerKB: feeSatPerKB,
 			Minconf:     1,
 			Outputs:     packet.UnsignedTx.TxOut,
 			DryRun:      false,
+			LockTime:    packet.UnsignedTx.LockTime,
 		})
 		if err != nil {
 			return 0, er.Errorf("error creating funding TX: %v",
@@ -131,6 +134,13 @@ func (w *Wallet) FundPsbt(packet *psbt.Packet, account uint32,
 	// If there are inputs, we need to check if they're sufficient and add
 	// a change output if necessary.
 	default:
+		// The inputs keep the sequence numbers given by the caller, so
+		// a locktime which they all disable is rejected rather than
+		// silently dropped.
+		if packet.UnsignedTx.LockTime != 0 && inputsFinal(txIn) {
+			return 0, ErrLockTimeIgnored.Default()
+		}
+
 		// Make sure all inputs provided are actually ours.
 		err = addInputInfo(txIn)
 		if err != nil {
@@ -331,6 +341,127 @@ func (w *Wallet) FinalizePsbt(packet *psbt.Packet) er.R {
 	return nil
 }
 
+// SignPsbtInputWithAddress signs the input at inputIndex of the passed packet
+// using exclusively the private key of the wallet address addr, and attaches
+// the resulting signature to the input as a partial signature. Unlike
+// FinalizePsbt, no other keys of the wallet are used and the input is not
+// finalized, which makes this suitable for participating in multisig spends.
+// The serialized public key and the signature, including the sighash type,
+// are returned.
+//
+// The input must carry its UTXO information, and the script being signed
+// (the witness script, redeem script or output script, in that order) must
+// commit to the address's key, otherwise ErrKeyNotInInput is returned. If the
+// wallet does not hold the key, ErrNoAddressKey is returned.
+func (w *Wallet) SignPsbtInputWithAddress(packet *psbt.Packet, inputIndex int,
+	addr btcutil.Address) ([]byte, []byte, er.R) {
+
+	tx := packet.UnsignedTx
+	if inputIndex < 0 || inputIndex >= len(tx.TxIn) ||
+		inputIndex >= len(packet.Inputs) {
+
+		return nil, nil, er.Errorf("input index %d out of range",
+			inputIndex)
+	}
+	in := packet.Inputs[inputIndex]
+
+	// Find out what UTXO we are signing.
+	var signOutput *wire.TxOut
+	switch {
+	case in.WitnessUtxo != nil:
+		signOutput = in.WitnessUtxo
+
+	case in.NonWitnessUtxo != nil:
+		prevIndex := tx.TxIn[inputIndex].PreviousOutPoint.Index
+		if int(prevIndex) >= len(in.NonWitnessUtxo.TxOut) {
+			return nil, nil, er.Errorf("input %d spends unknown "+
+				"output %d", inputIndex, prevIndex)
+		}
+		signOutput = in.NonWitnessUtxo.TxOut[prevIndex]
+
+	default:
+		return nil, nil, er.Errorf("input %d has no UTXO information",
+			inputIndex)
+	}
+
+	privKey, compressed, err := w.addressKey(addr)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pubKey := privKey.PubKey().SerializeUncompressed()
+	if compressed {
+		pubKey = privKey.PubKey().SerializeCompressed()
+	}
+
+	// Figure out which script the signature commits to and whether it
+	// uses the witness sighash algorithm.
+	var (
+		subScript []byte
+		isWitness bool
+	)
+	switch {
+	case in.WitnessScript != nil:
+		subScript = in.WitnessScript
+		isWitness = true
+
+	case in.RedeemScript != nil:
+		subScript = in.RedeemScript
+		isWitness = txscript.IsPayToWitnessPubKeyHash(subScript)
+
+	default:
+		subScript = signOutput.PkScript
+		isWitness = txscript.IsPayToWitnessPubKeyHash(subScript)
+	}
+
+	// Refuse to sign if the script doesn't refer to our key, either
+	// directly or by its hash.
+	if !bytes.Contains(subScript, pubKey) &&
+		!bytes.Contains(subScript, btcutil.Hash160(pubKey)) {
+
+		return nil, nil, ErrKeyNotInInput.New(
+			fmt.Sprintf("input %d, address %v", inputIndex, addr),
+			nil,
+		)
+	}
+
+	hashType := in.SighashType
+	if hashType == 0 {
+		hashType = params.SigHashAll
+	}
+
+	var sig []byte
+	if isWitness {
+		sig, err = txscript.RawTxInWitnessSignature(
+			tx, txscript.NewTxSigHashes(tx), inputIndex,
+			signOutput.Value, subScript, hashType, privKey,
+		)
+	} else {
+		sig, err = txscript.RawTxInSignature(
+			tx, inputIndex, subScript, hashType, privKey,
+		)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	updater, err := psbt.NewUpdater(packet)
+	if err != nil {
+		return nil, nil, err
+	}
+	outcome, err := updater.Sign(inputIndex, sig, pubKey, nil, nil)
+	if err != nil {
+		return nil, nil, er.Errorf("error adding signature to input "+
+			"%d: %v", inputIndex, err)
+	}
+	if outcome == psbt.SignFinalized {
+		return nil, nil, er.Errorf("input %d is already finalized",
+			inputIndex)
+	}
+
+	return pubKey, sig, nil
+}
+
 // constantInputSource creates an input source function that always returns the
 // static set of user-selected UTXOs.
 func constantInputSource(eligible []wtxmgr.Credit) txauthor.InputSource {
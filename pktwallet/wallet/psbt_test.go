@@ -6,6 +6,7 @@ package wallet
 
 import (
 	"bytes"
+	"crypto/sha256"
 	"encoding/hex"
 	"strings"
 	"testing"
@@ -16,6 +17,7 @@ import (
 	"github.com/pkt-cash/pktd/txscript"
 	"github.com/pkt-cash/pktd/txscript/params"
 	"github.com/pkt-cash/pktd/wire"
+	"github.com/pkt-cash/pktd/wire/constants"
 )
 
 var (
@@ -219,6 +221,77 @@ func TestFundPsbt(t *testing.T) {
 				}
 			},
 		},
+		{
+			name: "locktime, no inputs",
+			packet: &psbt.Packet{
+				UnsignedTx: &wire.MsgTx{
+					TxOut: []*wire.TxOut{{
+						PkScript: testScriptP2WSH,
+						Value:    1500000,
+					}},
+					LockTime: 650000,
+				},
+				Outputs: []psbt.POutput{{}},
+			},
+			feeRateSatPerKB:  4000, // 4 sat/byte
+			expectedErr:      "",
+			validatePackage:  true,
+			expectedFee:      980,
+			expectedChange:   1900000 - 1500000 - 980,
+			expectedInputs:   []wire.OutPoint{utxo1, utxo2},
+			additionalChecks: assertLockTimeActive(650000),
+		},
+		{
+			name: "locktime, final inputs",
+			packet: &psbt.Packet{
+				UnsignedTx: &wire.MsgTx{
+					TxIn: []*wire.TxIn{{
+						PreviousOutPoint: utxo1,
+						Sequence:         constants.MaxTxInSequenceNum,
+					}},
+					TxOut: []*wire.TxOut{{
+						PkScript: testScriptP2WSH,
+						Value:    100000,
+					}},
+					LockTime: 650000,
+				},
+				Inputs:  []psbt.PInput{{}},
+				Outputs: []psbt.POutput{{}},
+			},
+			feeRateSatPerKB: 2000, // 2 sat/byte
+			expectedErr:     "ErrLockTimeIgnored",
+		},
+		{
+			name: "locktime, non-final inputs",
+			packet: &psbt.Packet{
+				UnsignedTx: &wire.MsgTx{
+					TxIn: []*wire.TxIn{{
+						PreviousOutPoint: utxo1,
+						Sequence:         constants.MaxTxInSequenceNum - 1,
+					}, {
+						PreviousOutPoint: utxo2,
+						Sequence:         constants.MaxTxInSequenceNum - 1,
+					}},
+					TxOut: []*wire.TxOut{{
+						PkScript: testScriptP2WSH,
+						Value:    100000,
+					}, {
+						PkScript: testScriptP2WKH,
+						Value:    50000,
+					}},
+					LockTime: 650000,
+				},
+				Inputs:  []psbt.PInput{{}, {}},
+				Outputs: []psbt.POutput{{}, {}},
+			},
+			feeRateSatPerKB:  2000, // 2 sat/byte
+			expectedErr:      "",
+			validatePackage:  true,
+			expectedFee:      552,
+			expectedChange:   1900000 - 150000 - 552,
+			expectedInputs:   []wire.OutPoint{utxo1, utxo2},
+			additionalChecks: assertLockTimeActive(650000),
+		},
 	}
 
 	for _, tc := range testCases {
@@ -316,6 +389,25 @@ func assertTxInputs(t *testing.T, packet *psbt.Packet,
 	}
 }
 
+// assertLockTimeActive returns a check that the packet keeps the locktime and
+// that its inputs don't disable it.
+func assertLockTimeActive(lockTime uint32) func(*testing.T, *psbt.Packet,
+	int32) {
+
+	return func(t *testing.T, packet *psbt.Packet, _ int32) {
+		if packet.UnsignedTx.LockTime != lockTime {
+			t.Fatalf("expected locktime %d, got %d", lockTime,
+				packet.UnsignedTx.LockTime)
+		}
+		for _, txIn := range packet.UnsignedTx.TxIn {
+			if txIn.Sequence == constants.MaxTxInSequenceNum {
+				t.Fatalf("expected input %v to be non-final",
+					txIn.PreviousOutPoint)
+			}
+		}
+	}
+}
+
 func containsUtxo(list []wire.OutPoint, candidate wire.OutPoint) bool {
 	for _, utxo := range list {
 		if utxo == candidate {
@@ -414,3 +506,122 @@ func TestFinalizePsbt(t *testing.T) {
 		t.Fatalf("error validating tx: %v", err)
 	}
 }
+
+// TestSignPsbtInputWithAddress tests that a 2-of-2 multisig PSBT input can be
+// signed key by key using the keys of specific wallet addresses.
+func TestSignPsbtInputWithAddress(t *testing.T) {
+	w, cleanup := testWallet(t)
+	defer cleanup()
+
+	// Create the three P2WKH addresses, two of which are used in the
+	// multisig script.
+	var (
+		addrs   []btcutil.Address
+		pubKeys []*btcutil.AddressPubKey
+	)
+	for i := 0; i < 3; i++ {
+		addr, err := w.NewAddress(0, waddrmgr.KeyScopeBIP0084)
+		if err != nil {
+			t.Fatalf("unable to get new address: %v", err)
+		}
+		privKey, _, err := w.addressKey(addr)
+		if err != nil {
+			t.Fatalf("unable to get address key: %v", err)
+		}
+		pubKey, err := btcutil.NewAddressPubKey(
+			privKey.PubKey().SerializeCompressed(), w.chainParams,
+		)
+		if err != nil {
+			t.Fatalf("unable to create pubkey address: %v", err)
+		}
+		addrs = append(addrs, addr)
+		pubKeys = append(pubKeys, pubKey)
+	}
+
+	witnessScript, err := txscript.MultiSigScript(pubKeys[:2], 2)
+	if err != nil {
+		t.Fatalf("unable to create multisig script: %v", err)
+	}
+	scriptHash := sha256.Sum256(witnessScript)
+	p2wshAddr, err := btcutil.NewAddressWitnessScriptHash(
+		scriptHash[:], w.chainParams,
+	)
+	if err != nil {
+		t.Fatalf("unable to create p2wsh address: %v", err)
+	}
+	p2wshScript, err := txscript.PayToAddrScript(p2wshAddr)
+	if err != nil {
+		t.Fatalf("unable to create p2wsh script: %v", err)
+	}
+
+	utxo := wire.NewTxOut(1000000, p2wshScript)
+	packet := &psbt.Packet{
+		UnsignedTx: &wire.MsgTx{
+			TxIn: []*wire.TxIn{{
+				PreviousOutPoint: wire.OutPoint{Index: 0},
+			}},
+			TxOut: []*wire.TxOut{{
+				PkScript: testScriptP2WKH,
+				Value:    990000,
+			}},
+		},
+		Inputs: []psbt.PInput{{
+			WitnessUtxo:   utxo,
+			WitnessScript: witnessScript,
+			SighashType:   params.SigHashAll,
+		}},
+		Outputs: []psbt.POutput{{}},
+	}
+
+	// The third address isn't part of the script, so it must not be used
+	// to sign.
+	_, _, err = w.SignPsbtInputWithAddress(packet, 0, addrs[2])
+	if !ErrKeyNotInInput.Is(err) {
+		t.Fatalf("expected ErrKeyNotInInput, got %v", err)
+	}
+
+	// An address that doesn't belong to the wallet can't be used either.
+	foreignAddr, err := btcutil.DecodeAddress(
+		"tb1qw508d6qejxtdg4y5r3zarvary0c5xw7kxpjzsx", w.chainParams,
+	)
+	if err != nil {
+		t.Fatalf("unable to decode address: %v", err)
+	}
+	_, _, err = w.SignPsbtInputWithAddress(packet, 0, foreignAddr)
+	if !ErrNoAddressKey.Is(err) {
+		t.Fatalf("expected ErrNoAddressKey, got %v", err)
+	}
+
+	// Now sign with both keys of the script, one at a time.
+	for i := 0; i < 2; i++ {
+		pubKey, _, err := w.SignPsbtInputWithAddress(
+			packet, 0, addrs[i],
+		)
+		if err != nil {
+			t.Fatalf("unable to sign with address %d: %v", i, err)
+		}
+		if !bytes.Equal(pubKey, pubKeys[i].ScriptAddress()) {
+			t.Fatalf("unexpected pubkey %x", pubKey)
+		}
+		if len(packet.Inputs[0].PartialSigs) != i+1 {
+			t.Fatalf("expected %d partial sigs, got %d", i+1,
+				len(packet.Inputs[0].PartialSigs))
+		}
+	}
+
+	// With both signatures attached, the input can be finalized and the
+	// resulting transaction must be valid.
+	if err := psbt.MaybeFinalizeAll(packet); err != nil {
+		t.Fatalf("error finalizing PSBT: %v", err)
+	}
+	finalTx, err := psbt.Extract(packet)
+	if err != nil {
+		t.Fatalf("error extracting final TX from PSBT: %v", err)
+	}
+	err = validateMsgTx(
+		finalTx, [][]byte{p2wshScript}, []btcutil.Amount{1000000},
+	)
+	if err != nil {
+		t.Fatalf("error validating tx: %v", err)
+	}
+}
@@ -0,0 +1,50 @@
+package wallet
+
+import (
+	"sync/atomic"
+
+	"github.com/pkt-cash/pktd/pktlog/log"
+)
+
+// DefaultReorgSafetyDepth is the number of confirmations after which the
+// wallet considers a transaction final unless configured otherwise. Chain
+// reorganizations of this depth are not expected to happen.
+const DefaultReorgSafetyDepth = 6
+
+// SetReorgSafetyDepth sets the number of confirmations after which the wallet
+// considers a transaction final, it must be at least 1. Outputs with fewer
+// confirmations are reported as unconfirmed in the final balance, and a
+// warning is logged if a reorganization reverses a block at or below this
+// depth.
+func (w *Wallet) SetReorgSafetyDepth(depth int32) {
+	atomic.StoreInt32(&w.reorgSafetyDepth, depth)
+	w.balances.invalidate()
+}
+
+// ReorgSafetyDepth returns the number of confirmations after which the
+// wallet considers a transaction final.
+func (w *Wallet) ReorgSafetyDepth() int32 {
+	return atomic.LoadInt32(&w.reorgSafetyDepth)
+}
+
+// noteRollback is called before the block at height is rolled back while the
+// wallet is synced to tip. The first rollback after a block was connected
+// remembers the tip the reorganization started from, so that every rolled
+// back block can be checked against the reorg safety depth.
+func (w *Wallet) noteRollback(tip, height int32) {
+	atomic.CompareAndSwapInt32(&w.reorgTip, 0, tip)
+	tip = atomic.LoadInt32(&w.reorgTip)
+	depth := tip - height + 1
+	if safety := w.ReorgSafetyDepth(); depth >= safety {
+		log.Warnf("Chain reorganization of %d blocks from height %d "+
+			"reaches the reorg safety depth of %d, transactions "+
+			"which were considered final are being reversed",
+			depth, tip, safety)
+	}
+}
+
+// noteConnect is called once a block was connected, ending any
+// reorganization in progress.
+func (w *Wallet) noteConnect() {
+	atomic.StoreInt32(&w.reorgTip, 0)
+}
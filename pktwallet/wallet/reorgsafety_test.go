@@ -0,0 +1,92 @@
+package wallet
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/chaincfg/chainhash"
+	"github.com/pkt-cash/pktd/pktwallet/waddrmgr"
+	"github.com/pkt-cash/pktd/pktwallet/walletdb"
+	"github.com/pkt-cash/pktd/pktwallet/wtxmgr"
+)
+
+// TestReorgSafetyDepth ensures that outputs only count towards the final
+// balance once they have the reorg safety depth of confirmations, and that a
+// reorganization shallower than the depth turns a confirmed balance back into
+// an unconfirmed one while leaving the final balance alone.
+func TestReorgSafetyDepth(t *testing.T) {
+	w, cleanup := testWallet(t)
+	defer cleanup()
+
+	if depth := w.ReorgSafetyDepth(); depth != DefaultReorgSafetyDepth {
+		t.Fatalf("expected default reorg safety depth %d, got %d",
+			DefaultReorgSafetyDepth, depth)
+	}
+	w.SetReorgSafetyDepth(4)
+
+	check := func(want Balances) {
+		t.Helper()
+		bal, err := w.CalculateAccountBalance(waddrmgr.DefaultAccountNum, 1)
+		if err != nil {
+			t.Fatalf("unable to calculate balance: %v", err)
+		}
+		if bal != want {
+			t.Fatalf("unexpected balance: want %+v, got %+v", want, bal)
+		}
+	}
+	blockAt := func(height int32) *wtxmgr.BlockMeta {
+		return &wtxmgr.BlockMeta{
+			Block: wtxmgr.Block{
+				Hash:   chainhash.DoubleHashH([]byte{byte(height)}),
+				Height: height,
+			},
+			Time: time.Now(),
+		}
+	}
+	rollback := func(height int32) {
+		t.Helper()
+		if err := walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) er.R {
+			w.noteRollback(height, height)
+			w.invalidateBalances(dbtx)
+			err := w.TxStore.RollbackOne(dbtx.ReadWriteBucket(wtxmgrNamespaceKey), height)
+			if wtxmgr.ErrNoExists.Is(err) {
+				return nil
+			}
+			return err
+		}); err != nil {
+			t.Fatalf("unable to roll back block: %v", err)
+		}
+	}
+
+	// A payment with fewer confirmations than the reorg safety depth is
+	// spendable but not final.
+	payWallet(t, w, 1, 1000, blockAt(98))
+	setSyncedTo(t, w, 100)
+	check(Balances{Total: 1000, Spendable: 1000, OutputCount: 1})
+	setSyncedTo(t, w, 101)
+	check(Balances{Total: 1000, Spendable: 1000, Final: 1000, OutputCount: 1})
+
+	// Two blocks later, another payment is confirmed twice when the chain
+	// reorganizes back to height 101.
+	payWallet(t, w, 1, 500, blockAt(102))
+	setSyncedTo(t, w, 103)
+	w.noteConnect()
+	check(Balances{Total: 1500, Spendable: 1500, Final: 1000, OutputCount: 2})
+
+	rollback(103)
+	rollback(102)
+	if w.reorgTip != 103 {
+		t.Fatalf("expected the reorg to start from height 103, got %d",
+			w.reorgTip)
+	}
+	setSyncedTo(t, w, 101)
+	check(Balances{Total: 1500, Spendable: 1000, Unconfirmed: 500,
+		Final: 1000, OutputCount: 2})
+
+	// Connecting a block ends the reorganization.
+	w.noteConnect()
+	if w.reorgTip != 0 {
+		t.Fatalf("expected no reorg in progress, got tip %d", w.reorgTip)
+	}
+}
@@ -5,7 +5,7 @@
 package seedwords
 
 import (
-	"crypto/rand"
+	"crypto/subtle"
 	"encoding/binary"
 	"math/big"
 	"strings"
@@ -14,6 +14,7 @@ import (
 	"github.com/dchest/blake2b"
 	"github.com/pkt-cash/pktd/btcutil/er"
 	"github.com/pkt-cash/pktd/pktwallet/internal/zero"
+	"github.com/pkt-cash/pktd/pktwallet/testrand"
 	"golang.org/x/crypto/argon2"
 )
 
@@ -188,14 +189,27 @@ type Seed struct {
 	seedBin SeedEnc
 }
 
-// Generate a random seed with a birthday of right now
+// minBirthday is the time the seed derivation code was written, seeds with an
+// earlier birthday are considered invalid.
+var minBirthday = time.Unix(1586276691, 0)
+
+// Generate a random seed with a birthday of right now. If the randomness
+// has been seeded deterministically for tests, the birthday is fixed to the
+// first day which is not before minBirthday so that the same seed is
+// generated on every day.
 func RandomSeed() (*Seed, er.R) {
 	out := Seed{}
-	_, err := rand.Read(out.seedBin.Bytes[4:])
-	if err != nil {
-		return nil, er.E(err)
+	if err := testrand.Read(out.seedBin.Bytes[4:]); err != nil {
+		return nil, err
+	}
+	bday := time.Now()
+	if testrand.Deterministic() {
+		// Birthdays are stored as days, round up so that the stored
+		// birthday doesn't end up before minBirthday.
+		const day = 60 * 60 * 24
+		bday = time.Unix((minBirthday.Unix()+day-1)/day*day, 0)
 	}
-	out.seedBin.putBday(time.Now())
+	out.seedBin.putBday(bday)
 	return &out, nil
 }
 
@@ -318,6 +332,17 @@ func (s *SeedEnc) Zero() {
 	zero.Bytes(s.Bytes[:])
 }
 
+// Equal reports whether two encrypted seeds are the same, the comparison
+// takes constant time and ignores the unused bits.
+func (s *SeedEnc) Equal(other *SeedEnc) bool {
+	a, b := *s, *other
+	defer a.Zero()
+	defer b.Zero()
+	a.Bytes[0] &= 0x1f
+	b.Bytes[0] &= 0x1f
+	return subtle.ConstantTimeCompare(a.Bytes[:], b.Bytes[:]) == 1
+}
+
 // NeedsPassphrase returns true if the seed requires a passphrase in order
 // to decrypt.
 func (s *SeedEnc) NeedsPassphrase() bool {
@@ -342,7 +367,7 @@ func (s *SeedEnc) Decrypt(passphrase []byte, force bool) (*Seed, er.R) {
 		err = er.Errorf("The birthday of this seed appears to be "+
 			"[%s] which is in the future, the seed is probably invalid",
 			bday.String())
-	} else if time.Unix(1586276691, 0).After(bday) {
+	} else if minBirthday.After(bday) {
 		err = er.Errorf("The birthday of this seed appears to be "+
 			"[%s] which is before this code was written so the seed is "+
 			"probably invalid", bday.String())
@@ -0,0 +1,48 @@
+// +build rpctest
+
+package seedwords_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/pkt-cash/pktd/pktwallet/testrand"
+	"github.com/pkt-cash/pktd/pktwallet/wallet/seedwords"
+)
+
+// TestRandomSeedDeterministic makes sure that a seed generated from
+// deterministic randomness is the same on every run and survives encryption
+// and decryption, which rejects birthdays before the seed code was written.
+func TestRandomSeedDeterministic(t *testing.T) {
+	if err := testrand.Seed([]byte("seed")); err != nil {
+		t.Fatalf("unable to seed: %v", err)
+	}
+	seed, err := seedwords.RandomSeed()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := testrand.Seed([]byte("seed")); err != nil {
+		t.Fatalf("unable to seed: %v", err)
+	}
+	seed1, err := seedwords.RandomSeed()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(seed.Bytes(), seed1.Bytes()) {
+		t.Fatal("same randomness generated different seeds")
+	}
+
+	for _, passphrase := range [][]byte{nil, []byte("password")} {
+		seed2, err := seed.Encrypt(passphrase).Decrypt(passphrase, false)
+		if err != nil {
+			t.Fatalf("unable to decrypt seed: %v", err)
+		}
+		if !bytes.Equal(seed2.Bytes(), seed.Bytes()) {
+			t.Fatal("decrypted seed is not the same")
+		}
+		if !seed2.Birthday().Equal(seed.Birthday()) {
+			t.Fatalf("expected birthday %v, got %v",
+				seed.Birthday(), seed2.Birthday())
+		}
+	}
+}
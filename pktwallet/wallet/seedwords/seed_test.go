@@ -30,3 +30,30 @@ func TestEncrypt(t *testing.T) {
 		t.Error("Seed decrypt is not the same")
 	}
 }
+
+func TestSeedEncEqual(t *testing.T) {
+	seed, err := seedwords.RandomSeed()
+	if err != nil {
+		t.Fatal(err)
+	}
+	se := seed.Encrypt(nil)
+	words, err := se.Words("english")
+	if err != nil {
+		t.Fatal(err)
+	}
+	se1, err := seedwords.SeedFromWords(words)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !se.Equal(se1) || !se1.Equal(se) {
+		t.Error("Seed decoded from words is not equal to the original")
+	}
+
+	other, err := seedwords.RandomSeed()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if se.Equal(other.Encrypt(nil)) {
+		t.Error("Different seeds compare as equal")
+	}
+}
@@ -5,34 +5,37 @@
 package txauthor
 
 import (
-	"crypto/rand"
 	"encoding/binary"
 	mrand "math/rand"
 	"sync"
+
+	"github.com/pkt-cash/pktd/pktwallet/testrand"
 )
 
 // cprng is a cryptographically random-seeded math/rand prng.  It is seeded
-// during package init.  Any initialization errors result in panics.  It is safe
-// for concurrent access.
+// on first use rather than during package init, so that it picks up a
+// deterministic source of randomness configured for tests.  Any
+// initialization errors result in panics.  It is safe for concurrent access.
 var cprng = cprngType{}
 
 type cprngType struct {
-	r  *mrand.Rand
-	mu sync.Mutex
+	r    *mrand.Rand
+	mu   sync.Mutex
+	once sync.Once
 }
 
-func init() {
+func (c *cprngType) seed() {
 	buf := make([]byte, 8)
-	_, err := rand.Read(buf)
-	if err != nil {
-		panic("Failed to seed prng: " + err.Error())
+	if err := testrand.Read(buf); err != nil {
+		panic("Failed to seed prng: " + err.String())
 	}
 
 	seed := int64(binary.LittleEndian.Uint64(buf))
-	cprng.r = mrand.New(mrand.NewSource(seed))
+	c.r = mrand.New(mrand.NewSource(seed))
 }
 
 func (c *cprngType) Int31n(n int32) int32 {
+	c.once.Do(c.seed)
 	defer c.mu.Unlock() // Int31n may panic
 	c.mu.Lock()
 	return c.r.Int31n(n)
@@ -0,0 +1,95 @@
+package wallet
+
+import (
+	"github.com/pkt-cash/pktd/btcutil"
+	"github.com/pkt-cash/pktd/pktwallet/wtxmgr"
+)
+
+// OutputOwner describes who a transaction output pays from the point of view
+// of the wallet.
+type OutputOwner byte
+
+// These constants define the possible output owners.
+const (
+	// OutputExternal is an output paying an address of another wallet.
+	OutputExternal OutputOwner = iota
+
+	// OutputReceived is an output paying one of our external addresses.
+	OutputReceived
+
+	// OutputChange is an output paying one of our change addresses.
+	OutputChange
+)
+
+// String returns the owner as a string.  This string may be used as the JSON
+// string for output categories as part of the gettransaction RPC response.
+func (o OutputOwner) String() string {
+	switch o {
+	case OutputExternal:
+		return "external"
+	case OutputReceived:
+		return "received"
+	case OutputChange:
+		return "change"
+	default:
+		return "unknown"
+	}
+}
+
+// TxEffect summarizes how a transaction affects the wallet.
+type TxEffect struct {
+	// Net is the total value of all credits, change included, minus the
+	// total value of all debits.  It is negative for transactions which
+	// spend more of our coins than they pay back to us.
+	Net btcutil.Amount
+
+	// Fee is the total input value minus the total output value.  It is
+	// only known when every input spends one of our outputs, in which case
+	// FeeKnown is set.
+	Fee      btcutil.Amount
+	FeeKnown bool
+
+	// Outputs holds the owner of every output of the transaction, indexed
+	// by output index.
+	Outputs []OutputOwner
+}
+
+// TxDetailsEffect computes the effect of the transaction described by details
+// on the wallet.
+func TxDetailsEffect(details *wtxmgr.TxDetails) TxEffect {
+	effect := TxEffect{
+		Outputs: make([]OutputOwner, len(details.MsgTx.TxOut)),
+	}
+
+	var debitTotal btcutil.Amount
+	for _, deb := range details.Debits {
+		debitTotal += deb.Amount
+	}
+	effect.Net = -debitTotal
+
+	for _, cred := range details.Credits {
+		effect.Net += cred.Amount
+		if int(cred.Index) >= len(effect.Outputs) {
+			continue
+		}
+		if cred.Change {
+			effect.Outputs[cred.Index] = OutputChange
+		} else {
+			effect.Outputs[cred.Index] = OutputReceived
+		}
+	}
+
+	// The fee can only be determined if every input is a debit.
+	if len(details.Debits) > 0 &&
+		len(details.Debits) == len(details.MsgTx.TxIn) {
+
+		var outputTotal btcutil.Amount
+		for _, output := range details.MsgTx.TxOut {
+			outputTotal += btcutil.Amount(output.Value)
+		}
+		effect.Fee = debitTotal - outputTotal
+		effect.FeeKnown = true
+	}
+
+	return effect
+}
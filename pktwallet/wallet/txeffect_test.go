@@ -0,0 +1,99 @@
+package wallet
+
+import (
+	"testing"
+
+	"github.com/pkt-cash/pktd/btcutil"
+	"github.com/pkt-cash/pktd/pktwallet/wtxmgr"
+	"github.com/pkt-cash/pktd/wire"
+)
+
+// TestTxDetailsEffect tests that the net effect, fee and output owners of a
+// transaction are computed from its credits and debits.
+func TestTxDetailsEffect(t *testing.T) {
+	t.Parallel()
+
+	newDetails := func(numIn int, outs ...int64) *wtxmgr.TxDetails {
+		details := &wtxmgr.TxDetails{}
+		for i := 0; i < numIn; i++ {
+			details.MsgTx.AddTxIn(&wire.TxIn{})
+		}
+		for _, out := range outs {
+			details.MsgTx.AddTxOut(&wire.TxOut{Value: out})
+		}
+		return details
+	}
+
+	// A payment to another wallet with change back to us, spending only
+	// our coins.
+	send := newDetails(2, 600, 300)
+	send.Debits = []wtxmgr.DebitRecord{
+		{Amount: 500, Index: 0}, {Amount: 500, Index: 1},
+	}
+	send.Credits = []wtxmgr.CreditRecord{
+		{Amount: 300, Index: 1, Change: true},
+	}
+
+	// A payment to us from another wallet.
+	recv := newDetails(1, 700, 200)
+	recv.Credits = []wtxmgr.CreditRecord{{Amount: 200, Index: 1}}
+
+	// A transaction spending both our coins and coins of another wallet.
+	mixed := newDetails(2, 400, 100)
+	mixed.Debits = []wtxmgr.DebitRecord{{Amount: 250, Index: 1}}
+	mixed.Credits = []wtxmgr.CreditRecord{{Amount: 100, Index: 1}}
+
+	tests := []struct {
+		name     string
+		details  *wtxmgr.TxDetails
+		net      btcutil.Amount
+		fee      btcutil.Amount
+		feeKnown bool
+		outputs  []OutputOwner
+	}{
+		{
+			name:     "send",
+			details:  send,
+			net:      -700,
+			fee:      100,
+			feeKnown: true,
+			outputs:  []OutputOwner{OutputExternal, OutputChange},
+		},
+		{
+			name:    "receive",
+			details: recv,
+			net:     200,
+			outputs: []OutputOwner{OutputExternal, OutputReceived},
+		},
+		{
+			name:    "mixed inputs",
+			details: mixed,
+			net:     -150,
+			outputs: []OutputOwner{OutputExternal, OutputReceived},
+		},
+	}
+
+	for _, test := range tests {
+		effect := TxDetailsEffect(test.details)
+		if effect.Net != test.net {
+			t.Errorf("%s: expected net %v, got %v", test.name,
+				test.net, effect.Net)
+		}
+		if effect.FeeKnown != test.feeKnown || effect.Fee != test.fee {
+			t.Errorf("%s: expected fee %v (known %v), got %v "+
+				"(known %v)", test.name, test.fee, test.feeKnown,
+				effect.Fee, effect.FeeKnown)
+		}
+		if len(effect.Outputs) != len(test.outputs) {
+			t.Fatalf("%s: expected %d outputs, got %d", test.name,
+				len(test.outputs), len(effect.Outputs))
+		}
+		for i, owner := range test.outputs {
+			if effect.Outputs[i] != owner {
+				t.Errorf("%s: expected output %d to be %v, "+
+					"got %v", test.name, i, owner,
+					effect.Outputs[i])
+			}
+		}
+	}
+}
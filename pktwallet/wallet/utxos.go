@@ -48,7 +48,11 @@ func (w *Wallet) FetchInputInfo(prevOut *wire.OutPoint) (*wire.MsgTx,
 	}
 
 	// Determine the number of confirmations the output currently has.
-	_, currentHeight, err := w.chainClient.GetBestBlock()
+	chainClient, err := w.requireChainClient()
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	_, currentHeight, err := chainClient.GetBestBlock()
 	if err != nil {
 		return nil, nil, 0, er.Errorf("unable to retrieve current "+
 			"height: %v", err)
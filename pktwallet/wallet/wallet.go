@@ -13,6 +13,7 @@ import (
 	"strings"
 	"sync"
 	"time"
+	"unicode/utf8"
 
 	"github.com/emirpasic/gods/utils"
 	"github.com/pkt-cash/pktd/btcutil/er"
@@ -40,6 +41,7 @@ import (
 	"github.com/pkt-cash/pktd/pktwallet/wtxmgr"
 	"github.com/pkt-cash/pktd/txscript"
 	"github.com/pkt-cash/pktd/wire"
+	"golang.org/x/time/rate"
 )
 
 const (
@@ -52,6 +54,14 @@ const (
 	// NOTE: at time of writing, public encryption only applies to public
 	// data in the waddrmgr namespace.  Transactions are not yet encrypted.
 	InsecurePubPassphrase = "public"
+
+	// seedCheckInterval is the rate at which attempts to verify a seed
+	// backup are replenished.
+	seedCheckInterval = 10 * time.Second
+
+	// seedCheckBurst is the number of seed backup verifications which can
+	// be made in a row before being rate limited.
+	seedCheckBurst = 3
 )
 
 var (
@@ -72,6 +82,36 @@ var (
 	ErrTxLabelExists = Err.CodeWithDetail("ErrTxLabelExists",
 		"transaction already labeled")
 
+	// ErrAddrLabelTooLong is returned when an attempt is made to label an
+	// address with a label which is longer than wtxmgr.TxLabelLimit.
+	ErrAddrLabelTooLong = Err.CodeWithDetail("ErrAddrLabelTooLong",
+		"address label exceeds limit")
+
+	// ErrAddrLabelInvalid is returned when an attempt is made to label an
+	// address with a label which is not valid UTF-8.
+	ErrAddrLabelInvalid = Err.CodeWithDetail("ErrAddrLabelInvalid",
+		"address label is not valid UTF-8")
+
+	// ErrNoAddressKey is returned when an attempt is made to sign with the
+	// key of an address for which the wallet does not hold a private key.
+	ErrNoAddressKey = Err.CodeWithDetail("ErrNoAddressKey",
+		"wallet does not hold the private key for the address")
+
+	// ErrKeyNotInInput is returned when an attempt is made to sign a PSBT
+	// input with the key of an address that the input does not commit to.
+	ErrKeyNotInInput = Err.CodeWithDetail("ErrKeyNotInInput",
+		"address key is not used by the input")
+
+	// ErrNoSeed is returned when the wallet has no seed, which is the case
+	// for legacy wallets.
+	ErrNoSeed = Err.CodeWithDetail("ErrNoSeed",
+		"no seed found, this is probably a legacy wallet")
+
+	// ErrSeedCheckRateLimited is returned when seed backup verifications
+	// are attempted faster than they are allowed.
+	ErrSeedCheckRateLimited = Err.CodeWithDetail("ErrSeedCheckRateLimited",
+		"too many seed verification attempts, try again later")
+
 	// Namespace bucket keys.
 	waddrmgrNamespaceKey = []byte("waddrmgr")
 	wtxmgrNamespaceKey   = []byte("wtxmgr")
@@ -102,16 +142,31 @@ type Wallet struct {
 
 	chainClient        chain.Interface
 	chainClientLock    sync.Mutex
+	mainLoopQuit       <-chan struct{} // quit chan of the running main loop
 	chainClientSynced  bool
 	chainClientSyncMtx sync.Mutex
+	chainSyncedNtfn    chan struct{} // closed once synced
 
 	lockedOutpoints    map[wire.OutPoint]string
 	lockedOutpointsMtx sync.Mutex
 
 	recoveryWindow uint32
 
-	// Channel for transaction creation requests.
-	createTxRequests chan createTxRequest
+	// addressReuse decides how the wallet reacts to the reuse of
+	// already used receive addresses.
+	addressReuse AddressReusePolicy
+
+	// changeType is the type of the addresses change is sent to when no
+	// change address is given.
+	changeType ChangeType
+
+	// txSequence is the sequence number of the inputs of created
+	// transactions which don't request one.
+	txSequence uint32
+
+	// Channels for transaction creation requests.
+	createTxRequests    chan createTxRequest
+	consolidateRequests chan consolidateRequest
 
 	// Channels for the manager locker.
 	unlockRequests     chan unlockRequest
@@ -133,10 +188,23 @@ type Wallet struct {
 	wsLock sync.RWMutex
 	ws     btcjson.WalletStats
 
+	balances balanceCache
+
+	// reorgSafetyDepth is the number of confirmations after which a
+	// transaction is considered final and reorgTip the height of the tip a
+	// reorganization in progress started from, or 0.
+	reorgSafetyDepth int32
+	reorgTip         int32
+
 	watch watcher.Watcher
 
 	rescanJLock sync.Mutex
 	rescanJ     *rescanJob
+
+	seedCheckLimiter *rate.Limiter
+
+	chainInfoLock sync.RWMutex
+	chainInfo     *ChainInfo
 }
 
 type rescanJob struct {
@@ -178,34 +246,45 @@ func (w *Wallet) Start() {
 // synchronizes the wallet with the latest changes to the blockchain, and
 // continuously updates the wallet through RPC notifications.
 //
+// A client which is already associated is replaced without stopping the
+// wallet, so its subscriptions, locks and in-flight operations survive a
+// reconnect.  The replaced client is not stopped, that is up to the caller.
+//
 // This method is unstable and will be removed when all syncing logic is moved
 // outside of the wallet package.
 func (w *Wallet) SynchronizeRPC(chainClient chain.Interface) {
-	w.quitMu.Lock()
+	if w.setChainClient(chainClient) {
+		go w.goMainLoop()
+	}
+}
+
+// setChainClient associates the wallet with chainClient in place of the
+// current client, if any, and returns whether the main loop needs to be
+// started because none is running since the wallet was last started.
+func (w *Wallet) setChainClient(chainClient chain.Interface) bool {
+	quit := w.quitChan()
 	select {
-	case <-w.quit:
-		w.quitMu.Unlock()
-		return
+	case <-quit:
+		return false
 	default:
 	}
-	w.quitMu.Unlock()
 
-	// TODO: Ignoring the new client when one is already set breaks callers
-	// who are replacing the client, perhaps after a disconnect.
 	w.chainClientLock.Lock()
-	if w.chainClient != nil {
-		w.chainClientLock.Unlock()
-		return
-	}
+	old := w.chainClient
 	w.chainClient = chainClient
-
+	startLoop := w.mainLoopQuit != quit
+	w.mainLoopQuit = quit
 	w.chainClientLock.Unlock()
 
-	// TODO: It would be preferable to either run these goroutines
-	// separately from the wallet (use wallet mutator functions to
-	// make changes from the RPC client) and not have to stop and
-	// restart them each time the client disconnects and reconnets.
-	go w.goMainLoop()
+	if old != nil && old != chainClient {
+		log.Infof("Switching chain backend from %s to %s",
+			old.BackEnd(), chainClient.BackEnd())
+
+		// The new backend may be behind the old one, so the wallet
+		// is only synced again once checked against it.
+		w.SetChainSynced(false)
+	}
+	return startLoop
 }
 
 // requireChainClient marks that a wallet method can only be completed when the
@@ -234,6 +313,17 @@ func (w *Wallet) ChainClient() chain.Interface {
 	return chainClient
 }
 
+// DetachChainClient disassociates the consensus RPC client from the wallet
+// and returns it without stopping it, so that it can be handed over to another
+// wallet.  The returned client is nil if none was associated.
+func (w *Wallet) DetachChainClient() chain.Interface {
+	w.chainClientLock.Lock()
+	chainClient := w.chainClient
+	w.chainClient = nil
+	w.chainClientLock.Unlock()
+	return chainClient
+}
+
 // quitChan atomically reads the quit channel.
 func (w *Wallet) quitChan() <-chan struct{} {
 	w.quitMu.Lock()
@@ -330,9 +420,40 @@ func (w *Wallet) ChainSynced() bool {
 func (w *Wallet) SetChainSynced(synced bool) {
 	w.chainClientSyncMtx.Lock()
 	w.chainClientSynced = synced
+	if synced && w.chainSyncedNtfn != nil {
+		close(w.chainSyncedNtfn)
+		w.chainSyncedNtfn = nil
+	}
 	w.chainClientSyncMtx.Unlock()
 }
 
+// WaitForChainSynced blocks until the wallet is marked as synced with the
+// chain server, the timeout elapses or the wallet shuts down, and returns
+// whether the wallet is synced.
+func (w *Wallet) WaitForChainSynced(timeout time.Duration) bool {
+	w.chainClientSyncMtx.Lock()
+	if w.chainClientSynced {
+		w.chainClientSyncMtx.Unlock()
+		return true
+	}
+	if w.chainSyncedNtfn == nil {
+		w.chainSyncedNtfn = make(chan struct{})
+	}
+	ntfn := w.chainSyncedNtfn
+	w.chainClientSyncMtx.Unlock()
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	select {
+	case <-ntfn:
+		return true
+	case <-timer.C:
+	case <-w.quitChan():
+	}
+	return w.ChainSynced()
+}
+
 // activeData returns the currently-active receiving addresses and all unspent
 // outputs.  This is primarely intended to provide the parameters for a
 // rescan request.
@@ -351,6 +472,7 @@ func (w *Wallet) activeData(dbtx walletdb.ReadWriteTx) ([]btcutil.Address, []wtx
 
 	// Before requesting the list of spendable UTXOs, we'll delete any
 	// expired output locks.
+	w.invalidateBalances(dbtx)
 	err = w.TxStore.DeleteExpiredLockedOutputs(
 		dbtx.ReadWriteBucket(wtxmgrNamespaceKey),
 	)
@@ -366,9 +488,12 @@ func (w *Wallet) activeData(dbtx walletdb.ReadWriteTx) ([]btcutil.Address, []wtx
 // connection. It creates a rescan request and blocks until the rescan has
 // finished. The birthday block can be passed in, if set, to ensure we can
 // properly detect if it gets rolled back.
-func (w *Wallet) syncWithChain(birthdayStamp *waddrmgr.BlockStamp) er.R {
+func (w *Wallet) syncWithChain(chainClient chain.Interface,
+	birthdayStamp *waddrmgr.BlockStamp) er.R {
+
 	if err := walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) er.R {
 		b := dbtx.ReadWriteBucket(wtxmgrNamespaceKey)
+		w.invalidateBalances(dbtx)
 		if txns, err := w.TxStore.UnminedTxs(b); err != nil {
 			return err
 		} else {
@@ -386,11 +511,6 @@ func (w *Wallet) syncWithChain(birthdayStamp *waddrmgr.BlockStamp) er.R {
 		return err
 	}
 
-	chainClient, err := w.requireChainClient()
-	if err != nil {
-		return err
-	}
-
 	// We'll wait until the backend is synced to ensure we get the latest
 	// MaxReorgDepth blocks to store. We don't do this for development
 	// environments as we can't guarantee a lively chain.
@@ -402,12 +522,23 @@ func (w *Wallet) syncWithChain(birthdayStamp *waddrmgr.BlockStamp) er.R {
 		log.Info("Chain backend synced to tip! 👍")
 	}
 
-	// If we've yet to find our birthday block, we'll do so now.
+	// If we've yet to find our birthday block, we'll do so now. If the
+	// wallet was created with a birthday height, that's where it is,
+	// otherwise we search for it by the birthday timestamp.
 	if birthdayStamp == nil {
-		var err er.R
-		birthdayStamp, err = locateBirthdayBlock(
-			chainClient, w.Manager.Birthday(),
-		)
+		height, hasHeight, err := w.birthdayHeight()
+		if err != nil {
+			return err
+		}
+		if hasHeight {
+			birthdayStamp, err = birthdayBlockAtHeight(
+				chainClient, height,
+			)
+		} else {
+			birthdayStamp, err = locateBirthdayBlock(
+				chainClient, w.Manager.Birthday(),
+			)
+		}
 		if err != nil {
 			return er.Errorf("unable to locate birthday block: %v",
 				err)
@@ -441,6 +572,14 @@ func (w *Wallet) syncWithChain(birthdayStamp *waddrmgr.BlockStamp) er.R {
 			if err != nil {
 				return err
 			}
+			if hasHeight {
+				err := w.Manager.SetBirthday(
+					ns, birthdayStamp.Timestamp,
+				)
+				if err != nil {
+					return err
+				}
+			}
 			return w.Manager.SetBirthdayBlock(ns, *birthdayStamp, true)
 		})
 		if err != nil {
@@ -476,7 +615,14 @@ func (w *Wallet) syncWithChain(birthdayStamp *waddrmgr.BlockStamp) er.R {
 	for _, a := range addrs {
 		log.Debugf("Watching address [%s]", a.String())
 	}
-	w.watch.WatchAddrs(addrs)
+	// The addresses of the wallet are watched even if there are more of them
+	// than allowed, refusing to sync would only hide its funds. Only new
+	// addresses are refused then.
+	if err := w.watch.ForceWatchAddrs(addrs); err != nil {
+		log.Warnf("The wallet has more addresses than maxwatchedaddrs "+
+			"allows, new addresses can't be created until it is "+
+			"raised: %v", err)
+	}
 	w.watch.WatchOutpoints(ao)
 
 	return nil
@@ -495,7 +641,7 @@ func (w *Wallet) isDevEnv() bool {
 }
 
 // waitUntilBackendSynced blocks until the chain backend considers itself
-// "current".
+// "current", or fails if the chain client is detached or swapped meanwhile.
 func (w *Wallet) waitUntilBackendSynced(chainClient chain.Interface) er.R {
 	// We'll poll every 100ms to determine if our chain considers itself
 	// "current".
@@ -505,6 +651,10 @@ func (w *Wallet) waitUntilBackendSynced(chainClient chain.Interface) er.R {
 	for {
 		select {
 		case <-t.C:
+			if w.ChainClient() != chainClient {
+				return er.New("chain client replaced while " +
+					"waiting for it to sync")
+			}
 			if chainClient.IsCurrent() {
 				return nil
 			}
@@ -592,7 +742,85 @@ func locateBirthdayBlock(chainClient chainConn,
 	return birthdayBlock, nil
 }
 
-func getBlockStamp(chainClient chain.Interface, height int32) (*waddrmgr.BlockStamp, er.R) {
+// birthdayBlockAtHeight returns the block at the given birthday height, or the
+// best block if the chain hasn't reached that height yet.
+func birthdayBlockAtHeight(chainClient chainConn,
+	height int32) (*waddrmgr.BlockStamp, er.R) {
+
+	_, bestHeight, err := chainClient.GetBestBlock()
+	if err != nil {
+		return nil, err
+	}
+	if height > bestHeight {
+		log.Warnf("Birthday height %d is beyond the best block %d, "+
+			"using the best block", height, bestHeight)
+		height = bestHeight
+	}
+
+	birthdayBlock, err := getBlockStamp(chainClient, height)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Debugf("Found birthday block: height=%d, hash=%v, timestamp=%v",
+		birthdayBlock.Height, birthdayBlock.Hash,
+		birthdayBlock.Timestamp)
+
+	return birthdayBlock, nil
+}
+
+// birthdayHeight returns the birthday height the wallet was created with, if
+// any, which is stored as an unverified birthday block with only a height
+// until the block is looked up on the first sync.
+func (w *Wallet) birthdayHeight() (int32, bool, er.R) {
+	var (
+		birthdayBlock waddrmgr.BlockStamp
+		verified      bool
+	)
+	err := walletdb.View(w.db, func(tx walletdb.ReadTx) er.R {
+		ns := tx.ReadBucket(waddrmgrNamespaceKey)
+		var err er.R
+		birthdayBlock, verified, err = w.Manager.BirthdayBlock(ns)
+		return err
+	})
+	switch {
+	case waddrmgr.ErrBirthdayBlockNotSet.Is(err):
+		return 0, false, nil
+	case err != nil:
+		return 0, false, err
+	}
+	if verified || birthdayBlock.Hash != (chainhash.Hash{}) {
+		return 0, false, nil
+	}
+	return birthdayBlock.Height, true, nil
+}
+
+// SetBirthdayHeight sets the birthday of a newly created wallet to the block
+// at the given height, so that the initial sync starts there rather than at
+// the block of the birthday timestamp. This speeds up restoring a seed when it
+// is known at which height the wallet was first used, but any transaction of
+// the wallet in an earlier block will be missed. A negative height is clamped
+// to the genesis block, a height beyond the best block to the best block.
+func (w *Wallet) SetBirthdayHeight(height int32) er.R {
+	if height < 0 {
+		height = 0
+	}
+	return walletdb.Update(w.db, func(tx walletdb.ReadWriteTx) er.R {
+		ns := tx.ReadWriteBucket(waddrmgrNamespaceKey)
+		birthdayBlock, _, err := w.Manager.BirthdayBlock(ns)
+		if err == nil && birthdayBlock.Hash != (chainhash.Hash{}) {
+			return er.Errorf("the wallet is already synced from "+
+				"block %d, use resync to scan again", birthdayBlock.Height)
+		} else if err != nil && !waddrmgr.ErrBirthdayBlockNotSet.Is(err) {
+			return err
+		}
+		return w.Manager.SetBirthdayBlock(
+			ns, waddrmgr.BlockStamp{Height: height}, false,
+		)
+	})
+}
+
+func getBlockStamp(chainClient chainConn, height int32) (*waddrmgr.BlockStamp, er.R) {
 	hash, err := chainClient.GetBlockHash(int64(height))
 	if err != nil {
 		return nil, err
@@ -620,6 +848,12 @@ type (
 		InputComparator utils.Comparator
 		MaxInputs       int
 		Label           string
+
+		// LockTime is the locktime of the transaction and Sequence the
+		// sequence number of its inputs, the default of the wallet is
+		// used if it is nil.
+		LockTime uint32
+		Sequence *uint32
 	}
 	createTxRequest struct {
 		req  CreateTxReq
@@ -655,6 +889,22 @@ out:
 			tx, err := w.txToOutputs(txr.req)
 			heldUnlock.release()
 			txr.resp <- createTxResponse{tx, err}
+		case cr := <-w.consolidateRequests:
+			// A dry run doesn't sign, so it works on a locked
+			// wallet as well.
+			if cr.req.DryRun {
+				res, err := w.consolidate(cr.req)
+				cr.resp <- consolidateResponse{res, err}
+				continue
+			}
+			heldUnlock, err := w.holdUnlock()
+			if err != nil {
+				cr.resp <- consolidateResponse{nil, err}
+				continue
+			}
+			res, err := w.consolidate(cr.req)
+			heldUnlock.release()
+			cr.resp <- consolidateResponse{res, err}
 		case <-quit:
 			break out
 		}
@@ -901,6 +1151,29 @@ func (w *Wallet) AccountAddresses(account uint32) (addrs []btcutil.Address, err
 	return
 }
 
+// VerifySeed reports whether the seed words match the seed of the wallet,
+// without revealing the wallet's seed. Words which don't decode to a valid
+// seed are reported as not matching. Attempts are rate limited, and the words
+// must never be logged.
+func (w *Wallet) VerifySeed(words string) (bool, er.R) {
+	if !w.seedCheckLimiter.Allow() {
+		return false, ErrSeedCheckRateLimited.Default()
+	}
+	if w.Manager.IsLocked() {
+		return false, btcjson.ErrRPCWalletUnlockNeeded.Default()
+	}
+	seed := w.Manager.Seed()
+	if seed == nil {
+		return false, ErrNoSeed.Default()
+	}
+	candidate, err := seedwords.SeedFromWords(strings.TrimSpace(words))
+	if err != nil {
+		return false, nil
+	}
+	defer candidate.Zero()
+	return seed.Equal(candidate), nil
+}
+
 func (w *Wallet) GetSecret(name string) (*string, er.R) {
 	if w.Manager.IsLocked() {
 		return nil, btcjson.ErrRPCWalletUnlockNeeded.Default()
@@ -934,24 +1207,27 @@ func (w *Wallet) GetSecret(name string) (*string, er.R) {
 // the balance will be calculated based on how many how many blocks
 // include a UTXO.
 func (w *Wallet) CalculateBalance(confirms int32) (btcutil.Amount, er.R) {
+	bals, err := w.CalculateAccountBalances(confirms)
+	if err != nil {
+		return 0, err
+	}
 	var balance btcutil.Amount
-	err := walletdb.View(w.db, func(tx walletdb.ReadTx) er.R {
-		txmgrNs := tx.ReadBucket(wtxmgrNamespaceKey)
-		var err er.R
-		blk := w.Manager.SyncedTo()
-		balance, err = w.TxStore.Balance(txmgrNs, confirms, blk.Height)
-		return err
-	})
-	return balance, err
+	for _, bal := range bals {
+		balance += bal.Spendable
+	}
+	return balance, nil
 }
 
 // Balances records total, spendable (by policy), and immature coinbase
-// reward balance amounts.
+// reward balance amounts. Final is the part of the total which has at least
+// the reorg safety depth of confirmations and is not expected to be reversed
+// by a chain reorganization.
 type Balances struct {
 	Total          btcutil.Amount
 	Spendable      btcutil.Amount
 	ImmatureReward btcutil.Amount
 	Unconfirmed    btcutil.Amount
+	Final          btcutil.Amount
 	OutputCount    int32
 }
 
@@ -966,6 +1242,7 @@ func (w *Wallet) CalculateAddressBalances(
 		// Get current block.  The block height used for calculating
 		// the number of tx confirmations.
 		syncBlock := w.Manager.SyncedTo()
+		safety := w.ReorgSafetyDepth()
 		if showZeroBalances {
 			addrmgrNs := tx.ReadBucket(waddrmgrNamespaceKey)
 			if err := w.Manager.ForEachActiveAddress(addrmgrNs, func(addr btcutil.Address) er.R {
@@ -999,6 +1276,9 @@ func (w *Wallet) CalculateAddressBalances(
 				} else {
 					bal.Unconfirmed += output.Amount
 				}
+				if confirmed(safety, output.Height, syncBlock.Height) {
+					bal.Final += output.Amount
+				}
 			}
 			return nil
 		})
@@ -1052,7 +1332,9 @@ func (w *Wallet) CurrentAddress(account uint32, scope waddrmgr.KeyScope) (btcuti
 	// If the props have been initially, then we had to create a new address
 	// to satisfy the query. Notify the rpc server about the new address.
 	if props != nil {
-		w.watch.WatchAddr(addr)
+		if err := w.watch.WatchAddr(addr); err != nil {
+			return nil, err
+		}
 	}
 
 	return addr, nil
@@ -1128,6 +1410,132 @@ func (w *Wallet) LabelTransaction(hash chainhash.Hash, label string,
 	})
 }
 
+// AbandonTransaction abandons an unconfirmed transaction which is stuck, it is
+// removed from the wallet together with any unconfirmed transactions spending
+// its outputs, and the outputs it spent become available for coin selection
+// again. The wallet stops rebroadcasting the transaction, but the transaction
+// is not abandoned anymore if it is seen again, in the mempool or in a block.
+// The call fails if the transaction is unknown, confirmed or abandoned
+// already.
+func (w *Wallet) AbandonTransaction(hash chainhash.Hash) er.R {
+	var spent []wire.OutPoint
+	err := walletdb.Update(w.db, func(tx walletdb.ReadWriteTx) er.R {
+		txmgrNs := tx.ReadWriteBucket(wtxmgrNamespaceKey)
+
+		details, err := w.TxStore.TxDetails(txmgrNs, &hash)
+		if err != nil {
+			return err
+		}
+		if details == nil && !w.TxStore.IsAbandoned(txmgrNs, &hash) {
+			return ErrUnknownTransaction.Default()
+		}
+
+		w.invalidateBalances(tx)
+		if err := w.TxStore.AbandonTx(txmgrNs, &hash); err != nil {
+			return err
+		}
+		for _, txIn := range details.MsgTx.TxIn {
+			spent = append(spent, txIn.PreviousOutPoint)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// Outpoints locked while creating the transaction were locked to be
+	// spent by it, so they are released along with it.
+	for _, op := range spent {
+		w.UnlockOutpoint(op)
+	}
+	return nil
+}
+
+// SetAddressLabel stores a label for the address in the wallet's address book.
+// The address does not need to belong to the wallet. Labels are subject to the
+// same length limit as transaction labels and must be valid UTF-8. Setting an
+// empty label removes the address from the address book.
+func (w *Wallet) SetAddressLabel(a btcutil.Address, label string) er.R {
+	if len(label) > wtxmgr.TxLabelLimit {
+		return ErrAddrLabelTooLong.Default()
+	}
+	if !utf8.ValidString(label) {
+		return ErrAddrLabelInvalid.Default()
+	}
+	return walletdb.Update(w.db, func(tx walletdb.ReadWriteTx) er.R {
+		addrmgrNs := tx.ReadWriteBucket(waddrmgrNamespaceKey)
+		if label == "" {
+			return waddrmgr.DeleteAddrLabel(addrmgrNs, a.EncodeAddress())
+		}
+		return waddrmgr.PutAddrLabel(addrmgrNs, a.EncodeAddress(), label)
+	})
+}
+
+// AddressLabel returns the address book label of the address, or an empty
+// string if it has none.
+func (w *Wallet) AddressLabel(a btcutil.Address) (string, er.R) {
+	var label string
+	err := walletdb.View(w.db, func(tx walletdb.ReadTx) er.R {
+		addrmgrNs := tx.ReadBucket(waddrmgrNamespaceKey)
+		var err er.R
+		label, err = waddrmgr.FetchAddrLabel(addrmgrNs, a.EncodeAddress())
+		return err
+	})
+	return label, err
+}
+
+// AddressesByLabel returns every address in the address book which has the
+// given label, sorted by their encoding.
+func (w *Wallet) AddressesByLabel(label string) ([]btcutil.Address, er.R) {
+	var addrStrs []string
+	err := walletdb.View(w.db, func(tx walletdb.ReadTx) er.R {
+		addrmgrNs := tx.ReadBucket(waddrmgrNamespaceKey)
+		return waddrmgr.ForEachAddrLabel(addrmgrNs, func(addr, l string) er.R {
+			if l == label {
+				addrStrs = append(addrStrs, addr)
+			}
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Strings(addrStrs)
+	addrs := make([]btcutil.Address, 0, len(addrStrs))
+	for _, addrStr := range addrStrs {
+		addr, err := btcutil.DecodeAddress(addrStr, w.chainParams)
+		if err != nil {
+			return nil, err
+		}
+		addrs = append(addrs, addr)
+	}
+	return addrs, nil
+}
+
+// AddressLabels returns the sorted list of distinct labels in the address
+// book.
+func (w *Wallet) AddressLabels() ([]string, er.R) {
+	var labels []string
+	err := walletdb.View(w.db, func(tx walletdb.ReadTx) er.R {
+		addrmgrNs := tx.ReadBucket(waddrmgrNamespaceKey)
+		seen := make(map[string]struct{})
+		return waddrmgr.ForEachAddrLabel(addrmgrNs, func(_, label string) er.R {
+			if _, ok := seen[label]; !ok {
+				seen[label] = struct{}{}
+				labels = append(labels, label)
+			}
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Strings(labels)
+	return labels, nil
+}
+
 // PrivKeyForAddress looks up the associated private key for a P2PKH or P2PK
 // address.
 func (w *Wallet) PrivKeyForAddress(a btcutil.Address) (*btcec.PrivateKey, er.R) {
@@ -1148,6 +1556,61 @@ func (w *Wallet) PrivKeyForAddress(a btcutil.Address) (*btcec.PrivateKey, er.R)
 	return privKey, err
 }
 
+// addressKey returns the private key of the wallet address a, along with
+// whether its public key is used in compressed form. ErrNoAddressKey is
+// returned if the wallet does not know the address or it is not backed by a
+// single private key.
+func (w *Wallet) addressKey(a btcutil.Address) (*btcec.PrivateKey, bool, er.R) {
+	var (
+		privKey    *btcec.PrivateKey
+		compressed bool
+	)
+	err := walletdb.View(w.db, func(tx walletdb.ReadTx) er.R {
+		addrmgrNs := tx.ReadBucket(waddrmgrNamespaceKey)
+		managedAddr, err := w.Manager.Address(addrmgrNs, a)
+		if waddrmgr.ErrAddressNotFound.Is(err) {
+			return ErrNoAddressKey.New(a.String(), err)
+		}
+		if err != nil {
+			return err
+		}
+		managedPubKeyAddr, ok := managedAddr.(waddrmgr.ManagedPubKeyAddress)
+		if !ok {
+			return ErrNoAddressKey.New(a.String(), nil)
+		}
+		compressed = managedPubKeyAddr.Compressed()
+		privKey, err = managedPubKeyAddr.PrivKey()
+		return err
+	})
+	return privKey, compressed, err
+}
+
+// SignMessageWithAddress signs the message using exclusively the private key
+// of the wallet address a. The serialized public key and the compact
+// signature are returned. Signatures for segwit addresses use the BIP137
+// header bytes. ErrNoAddressKey is returned if the wallet does not hold the
+// key.
+func (w *Wallet) SignMessageWithAddress(a btcutil.Address,
+	message string) ([]byte, []byte, er.R) {
+
+	privKey, compressed, err := w.addressKey(a)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pubKey := privKey.PubKey().SerializeUncompressed()
+	if compressed {
+		pubKey = privKey.PubKey().SerializeCompressed()
+	}
+
+	sig, err := signMessageCompact(a, privKey, compressed, message)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return pubKey, sig, nil
+}
+
 // HaveAddress returns whether the wallet is the owner of the address a.
 func (w *Wallet) HaveAddress(a btcutil.Address) (bool, er.R) {
 	err := walletdb.View(w.db, func(tx walletdb.ReadTx) er.R {
@@ -1317,10 +1780,12 @@ outputs:
 
 		var address string
 		var accountName string
+		var label string
 		_, addrs, _, _ := txscript.ExtractPkScriptAddrs(output.PkScript, net)
 		if len(addrs) == 1 {
 			addr := addrs[0]
 			address = addr.EncodeAddress()
+			label, _ = waddrmgr.FetchAddrLabel(addrmgrNs, address)
 			mgr, account, err := addrMgr.AddrAccount(addrmgrNs, addrs[0])
 			if err == nil {
 				accountName, err = mgr.AccountName(addrmgrNs, account)
@@ -1342,6 +1807,7 @@ outputs:
 			//   Amount
 			//   Fee
 			Address:         address,
+			Label:           label,
 			Vout:            uint32(i),
 			Confirmations:   confirmations,
 			Generated:       generated,
@@ -1590,7 +2056,7 @@ func (w *Wallet) GetTransactions(
 			if chainClient == nil {
 				return nil, er.New("no chain server client")
 			}
-			switch client := chainClient.(type) {
+			switch client := chain.ActiveBackEnd(chainClient).(type) {
 			case *chain.RPCClient:
 				startHeader, err := client.GetBlockHeaderVerbose(
 					startBlock.hash,
@@ -1615,7 +2081,7 @@ func (w *Wallet) GetTransactions(
 			if chainClient == nil {
 				return nil, er.New("no chain server client")
 			}
-			switch client := chainClient.(type) {
+			switch client := chain.ActiveBackEnd(chainClient).(type) {
 			case *chain.RPCClient:
 				endHeader, err := client.GetBlockHeaderVerbose(
 					endBlock.hash,
@@ -1875,8 +2341,9 @@ func (w *Wallet) ImportPrivateKey(scope waddrmgr.KeyScope, wif *btcutil.WIF,
 	} else if bs.Timestamp.IsZero() {
 		// Only update the new birthday time from default value if we
 		// actually have timestamp info in the header.
-		header, err := w.chainClient.GetBlockHeader(&bs.Hash)
-		if err == nil {
+		if chainClient, err := w.requireChainClient(); err != nil {
+			log.Debugf("Unable to look up birthday timestamp [%s]", err.String())
+		} else if header, err := chainClient.GetBlockHeader(&bs.Hash); err == nil {
 			bs.Timestamp = header.Timestamp
 		}
 	}
@@ -1905,7 +2372,9 @@ func (w *Wallet) ImportPrivateKey(scope waddrmgr.KeyScope, wif *btcutil.WIF,
 		// required to be read, so discard the return value.
 		name := fmt.Sprintf("import-%s-resync", addr.EncodeAddress())
 		watch := watcher.New()
-		watch.WatchAddr(addr)
+		if err := watch.WatchAddr(addr); err != nil {
+			return "", err
+		}
 		w.rescanJ = &rescanJob{
 			name:       name,
 			height:     bs.Height,
@@ -1913,7 +2382,9 @@ func (w *Wallet) ImportPrivateKey(scope waddrmgr.KeyScope, wif *btcutil.WIF,
 			watch:      &watch,
 		}
 	}
-	w.watch.WatchAddr(addr)
+	if err := w.watch.WatchAddr(addr); err != nil {
+		return "", err
+	}
 
 	addrStr := addr.EncodeAddress()
 	log.Infof("Imported payment address %s", addrStr)
@@ -1998,6 +2469,7 @@ func (w *Wallet) LeaseOutput(id wtxmgr.LockID, op wire.OutPoint) (time.Time, er.
 	var expiry time.Time
 	err := walletdb.Update(w.db, func(tx walletdb.ReadWriteTx) er.R {
 		ns := tx.ReadWriteBucket(wtxmgrNamespaceKey)
+		w.invalidateBalances(tx)
 		var err er.R
 		expiry, err = w.TxStore.LockOutput(ns, id, op)
 		return err
@@ -2011,6 +2483,7 @@ func (w *Wallet) LeaseOutput(id wtxmgr.LockID, op wire.OutPoint) (time.Time, er.
 func (w *Wallet) ReleaseOutput(id wtxmgr.LockID, op wire.OutPoint) er.R {
 	return walletdb.Update(w.db, func(tx walletdb.ReadWriteTx) er.R {
 		ns := tx.ReadWriteBucket(wtxmgrNamespaceKey)
+		w.invalidateBalances(tx)
 		return w.TxStore.UnlockOutput(ns, id, op)
 	})
 }
@@ -2037,19 +2510,53 @@ func (w *Wallet) SortedActivePaymentAddresses() ([]string, er.R) {
 // NewAddress returns the next external chained address for a wallet.
 func (w *Wallet) NewAddress(account uint32,
 	scope waddrmgr.KeyScope) (btcutil.Address, er.R) {
+
+	// Don't derive an address which can't be watched.
+	if err := w.watch.CanWatch(1); err != nil {
+		return nil, err
+	}
+
 	var addr btcutil.Address
 	err := walletdb.Update(w.db, func(tx walletdb.ReadWriteTx) er.R {
 		addrmgrNs := tx.ReadWriteBucket(waddrmgrNamespaceKey)
 		var err er.R
 		addr, _, err = w.newAddress(addrmgrNs, account, scope)
-		return err
+		if err != nil || w.addressReuse == AddressReuseAllow {
+			return err
+		}
+
+		// The next address may already have been used, for instance
+		// when it was paid to by an older copy of the wallet. Skip over
+		// those unless reuse is allowed.
+		for {
+			pkScript, err := txscript.PayToAddrScript(addr)
+			if err != nil {
+				return err
+			}
+			if used, _ := w.usedReceiveAddress(
+				addrmgrNs, pkScript,
+			); !used {
+				return nil
+			}
+			if w.addressReuse == AddressReuseWarn {
+				log.Warnf("Handing out already used address "+
+					"%v", addr.EncodeAddress())
+				return nil
+			}
+			addr, _, err = w.newAddress(addrmgrNs, account, scope)
+			if err != nil {
+				return err
+			}
+		}
 	})
 	if err != nil {
 		return nil, err
 	}
 
 	// Notify the rpc server about the newly created address.
-	w.watch.WatchAddr(addr)
+	if err := w.watch.WatchAddr(addr); err != nil {
+		return nil, err
+	}
 
 	return addr, nil
 }
@@ -2165,6 +2672,27 @@ func (w *Wallet) SendOutputs(txr CreateTxReq) (*txauthor.AuthoredTx, er.R) {
 		}
 	}
 
+	if err := w.checkAddressReuse(txr.Outputs); err != nil {
+		return nil, err
+	}
+
+	// A transaction which is broadcast right away must not be locked.
+	if !txr.DryRun && txr.LockTime != 0 {
+		chainClient, err := w.requireChainClient()
+		if err != nil {
+			return nil, err
+		}
+		bs, err := chainClient.BlockStamp()
+		if err != nil {
+			return nil, err
+		}
+		if !lockTimeReached(txr.LockTime, bs) {
+			return nil, ErrLockTimeNotReached.New(fmt.Sprintf(
+				"locktime %d, best block %d", txr.LockTime,
+				bs.Height), nil)
+		}
+	}
+
 	// Create the transaction and broadcast it to the network. The
 	// transaction will be added to the database in order to ensure that we
 	// continue to re-broadcast the transaction upon restarts until it has
@@ -2380,39 +2908,61 @@ func (w *Wallet) reliablyPublishTransaction(tx *wire.MsgTx, label string) (*chai
 			return err
 		}
 
+		// Record the fee rate of the transaction so we can learn how
+		// long it takes for our transactions to confirm.
+		txmgrNs := dbTx.ReadWriteBucket(wtxmgrNamespaceKey)
+		if err := w.putFeeRecord(txmgrNs, tx); err != nil {
+			return err
+		}
+
 		// If the tx label is empty, we can return early.
 		if label == "" {
 			return nil
 		}
 
-		// If there is a label we should write, get the namespace key
-		// and record it in the tx store.
-		txmgrNs := dbTx.ReadWriteBucket(wtxmgrNamespaceKey)
+		// If there is a label we should write, record it in the tx
+		// store.
 		return w.TxStore.PutTxLabel(txmgrNs, tx.TxHash(), label)
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	// We'll also ask to be notified of the transaction once it confirms
-	// on-chain. This is done outside of the database transaction to prevent
-	// backend interaction within it.
-	//
-	// NOTE: In some cases, it's possible that the transaction to be
-	// broadcast is not directly relevant to the user's wallet, e.g.,
-	// multisig. In either case, we'll still ask to be notified of when it
-	// confirms to maintain consistency.
-	for _, txOut := range tx.TxOut {
-		_, addrs, _, err := txscript.ExtractPkScriptAddrs(
-			txOut.PkScript, w.chainParams,
-		)
-		if err != nil {
-			// Non-standard outputs can safely be skipped because
-			// they're not supported by the wallet.
-			continue
+	// We'll also ask to be notified of the outputs of the transaction
+	// which pay to the wallet. This is done outside of the database
+	// transaction to prevent backend interaction within it. Outputs paying
+	// to others, e.g. a multisig, aren't watched so that they don't count
+	// against the limit of watched addresses.
+	var owned []btcutil.Address
+	err = walletdb.View(w.db, func(dbtx walletdb.ReadTx) er.R {
+		addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+		for _, txOut := range tx.TxOut {
+			_, addrs, _, err := txscript.ExtractPkScriptAddrs(
+				txOut.PkScript, w.chainParams,
+			)
+			if err != nil {
+				// Non-standard outputs can safely be skipped
+				// because they're not supported by the wallet.
+				continue
+			}
+			for _, addr := range addrs {
+				_, err := w.Manager.Address(addrmgrNs, addr)
+				if waddrmgr.ErrAddressNotFound.Is(err) {
+					continue
+				} else if err != nil {
+					return err
+				}
+				owned = append(owned, addr)
+			}
 		}
-
-		w.watch.WatchAddrs(addrs)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := w.watch.WatchAddrs(owned); err != nil {
+		log.Warnf("Unable to watch outputs of tx %v: %v",
+			tx.TxHash(), err)
 	}
 
 	return w.publishTransaction(tx)
@@ -2438,6 +2988,8 @@ func (w *Wallet) publishTransaction(tx *wire.MsgTx) (*chainhash.Hash, er.R) {
 	txid := tx.TxHash()
 	switch {
 	case err == nil:
+		log.With(log.F("txid", txid)).Infof(
+			"Broadcast transaction [%v]", txid)
 		return &txid, nil
 
 	// This error is returned when broadcasting a transaction to a bitcoind
@@ -2468,6 +3020,7 @@ func (w *Wallet) publishTransaction(tx *wire.MsgTx) (*chainhash.Hash, er.R) {
 			if err != nil {
 				return err
 			}
+			w.invalidateBalances(dbTx)
 			return w.TxStore.RemoveUnminedTx(txmgrNs, txRec)
 		})
 		if dbErr != nil {
@@ -2488,7 +3041,14 @@ func (w *Wallet) publishTransaction(tx *wire.MsgTx) (*chainhash.Hash, er.R) {
 			if err != nil {
 				return err
 			}
-			return w.TxStore.RemoveUnminedTx(txmgrNs, txRec)
+			w.invalidateBalances(dbTx)
+			if err := w.TxStore.RemoveUnminedTx(txmgrNs, txRec); err != nil {
+				return err
+			}
+
+			// The transaction was never broadcast, so it should not
+			// show up in the fee history.
+			return w.TxStore.DeleteFeeRecord(txmgrNs, &txid)
 		})
 		if dbErr != nil {
 			log.Warnf("Unable to remove invalid transaction %v: %v",
@@ -2510,7 +3070,10 @@ func (w *Wallet) ChainParams() *chaincfg.Params {
 
 // Create creates an new wallet, writing it to an empty database.  If the passed
 // seed is non-nil, it is used.  Otherwise, a secure random seed of the
-// recommended length is generated.
+// recommended length is generated.  The birthday of the wallet is seedBirthday
+// if it is set, otherwise the birthday of the seed if it has one.  A birthday
+// before the genesis block is clamped to it, note that a birthday after the
+// first transaction of the wallet causes that transaction to be missed.
 func Create(db walletdb.DB, pubPass, privPass, seedInput []byte, seedBirthday time.Time,
 	seedx *seedwords.Seed, params *chaincfg.Params) er.R {
 	// If a seed was provided, ensure that it is of valid length. Otherwise,
@@ -2530,10 +3093,14 @@ func Create(db walletdb.DB, pubPass, privPass, seedInput []byte, seedBirthday ti
 	}
 
 	var birthday time.Time
-	if seedx != nil {
-		birthday = seedx.Birthday()
-	} else if seedBirthday != (time.Time{}) {
+	if seedBirthday != (time.Time{}) {
 		birthday = seedBirthday
+		genesisTime := genesis.Block(params.GenesisHash).Header.Timestamp
+		if birthday.Before(genesisTime) {
+			birthday = genesisTime
+		}
+	} else if seedx != nil {
+		birthday = seedx.Birthday()
 	} else {
 		// If we don't know the bday, put it before all of this began
 		birthday = time.Unix(1231006505, 0)
@@ -2607,6 +3174,7 @@ func (w *Wallet) ResyncChain(fromHeight, toHeight int32, addresses []string, dro
 	if addresses != nil {
 		if !dropDb {
 			ww := watcher.New()
+			ww.SetMaxAddrs(w.watch.MaxAddrs())
 			watch = &ww
 		}
 		for _, addrStr := range addresses {
@@ -2614,7 +3182,9 @@ func (w *Wallet) ResyncChain(fromHeight, toHeight int32, addresses []string, dro
 			if err != nil {
 				return err
 			}
-			watch.WatchAddr(addr)
+			if err := watch.WatchAddr(addr); err != nil {
+				return err
+			}
 		}
 	}
 
@@ -2869,7 +3439,7 @@ func (w *Wallet) connectBlocks(blks []SyncerResp, isRescan bool) er.R {
 		}
 	}
 	bs := w.Manager.SyncedTo()
-	return walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) er.R {
+	err := walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) er.R {
 		for _, b := range blks {
 			if b.height > bs.Height+1 {
 				// This happens if we get a resync/dropdb triggered while we're syncing
@@ -2879,6 +3449,8 @@ func (w *Wallet) connectBlocks(blks []SyncerResp, isRescan bool) er.R {
 				txmgrNs := dbtx.ReadWriteBucket(wtxmgrNamespaceKey)
 				log.Infof("Invalid block detected at [%d] replacing [%s] -> [%s]",
 					b.height, b.rollbackHash, b.header.BlockHash())
+				w.noteRollback(bs.Height, b.height)
+				w.invalidateBalances(dbtx)
 				if err := w.TxStore.RollbackOne(txmgrNs, b.height); err != nil {
 					return err
 				}
@@ -2901,6 +3473,7 @@ func (w *Wallet) connectBlocks(blks []SyncerResp, isRescan bool) er.R {
 			if err := w.Manager.SetSyncedTo(addrmgrNs, &bs); err != nil {
 				return err
 			}
+			w.noteConnect()
 			w.NtfnServer.notifyAttachedBlock(dbtx, &wtxmgr.BlockMeta{
 				Block: wtxmgr.Block{
 					Hash:   hash,
@@ -2911,6 +3484,10 @@ func (w *Wallet) connectBlocks(blks []SyncerResp, isRescan bool) er.R {
 		}
 		return nil
 	})
+	if err == nil && !isRescan {
+		w.cacheChainTip(&bs)
+	}
+	return err
 }
 
 const syncerBatchSz = 8
@@ -2992,12 +3569,12 @@ func (w *Wallet) rescan2(
 	}
 }
 
-func (w *Wallet) rollbackIfNeeded() er.R {
+func (w *Wallet) rollbackIfNeeded(cc chain.Interface) er.R {
 	for {
 		st := w.Manager.SyncedTo()
-		if nextHash, err := w.chainClient.GetBlockHash(int64(st.Height + 1)); err != nil {
+		if nextHash, err := cc.GetBlockHash(int64(st.Height + 1)); err != nil {
 			return err
-		} else if nextHdr, err := w.chainClient.GetBlockHeader(nextHash); err != nil {
+		} else if nextHdr, err := cc.GetBlockHeader(nextHash); err != nil {
 			return err
 		} else if nextHdr.PrevBlock.IsEqual(&st.Hash) {
 			return nil
@@ -3011,8 +3588,8 @@ func (w *Wallet) rollbackIfNeeded() er.R {
 	}
 }
 
-func (w *Wallet) block(bm wtxmgr.Block) er.R {
-	header, err := w.chainClient.GetBlockHeader(&bm.Hash)
+func (w *Wallet) block(cc chain.Interface, bm wtxmgr.Block) er.R {
+	header, err := cc.GetBlockHeader(&bm.Hash)
 	if err != nil {
 		return err
 	}
@@ -3034,7 +3611,7 @@ func (w *Wallet) block(bm wtxmgr.Block) er.R {
 				Time: header.Timestamp,
 			},
 		}
-		if res, err := w.chainClient.FilterBlocks(filterReq); err != nil {
+		if res, err := cc.FilterBlocks(filterReq); err != nil {
 			return err
 		} else {
 			return w.connectBlocks([]SyncerResp{
@@ -3046,7 +3623,7 @@ func (w *Wallet) block(bm wtxmgr.Block) er.R {
 			}, false)
 		}
 	}
-	if err := w.rollbackIfNeeded(); err != nil {
+	if err := w.rollbackIfNeeded(cc); err != nil {
 		return err
 	}
 	// Remember to re-check the stamp because it might have been rolled back
@@ -3064,6 +3641,11 @@ func (w *Wallet) block(bm wtxmgr.Block) er.R {
 }
 
 func (w *Wallet) rescan() {
+	cc := w.ChainClient()
+	if cc == nil {
+		// The job resumes once a chain client is associated again.
+		return
+	}
 	w.rescanJLock.Lock()
 	defer w.rescanJLock.Unlock()
 	rj := w.rescanJ
@@ -3074,12 +3656,13 @@ func (w *Wallet) rescan() {
 
 	// Process dropdb requests
 	if !rj.dropDb {
-	} else if bs, err := getBlockStamp(w.chainClient, rj.height); err != nil {
+	} else if bs, err := getBlockStamp(cc, rj.height); err != nil {
 		log.Warnf("Error dropping db [%s]", err.String())
 		return
 	} else if err := walletdb.Update(w.db, func(tx walletdb.ReadWriteTx) er.R {
 		txNs := tx.ReadWriteBucket(wtxmgrNamespaceKey)
 		log.Infof("Dropping transaction db")
+		w.invalidateBalances(tx)
 		if err := wtxmgr.DropTransactionHistory(txNs); err != nil {
 			return err
 		}
@@ -3129,23 +3712,25 @@ func (w *Wallet) rescan() {
 }
 
 func (w *Wallet) checkBlock() {
-	cc := w.chainClient
+	cc := w.ChainClient()
 	if cc == nil {
-		/// shutting down
+		/// shutting down or switching chain backend
 		return
 	}
 	bestH, bestHeight, err := cc.GetBestBlock()
 	if err != nil {
 		log.Warnf("Error checking for best block [%s]", err.String())
+		return
 	}
 	st := w.Manager.SyncedTo()
 	if st.Height >= bestHeight {
 		synced := w.ChainSynced()
 		if !synced {
-			log.Infof("Wallet frontend synced to tip [%d]", st.Height)
+			log.With(log.F("height", st.Height)).Infof(
+				"Wallet frontend synced to tip [%d]", st.Height)
 			w.SetChainSynced(true)
 		}
-	} else if err := w.block(wtxmgr.Block{
+	} else if err := w.block(cc, wtxmgr.Block{
 		Hash:   *bestH,
 		Height: bestHeight,
 	}); err != nil {
@@ -3153,35 +3738,54 @@ func (w *Wallet) checkBlock() {
 	}
 }
 
-func (w *Wallet) walletInit() {
+func (w *Wallet) walletInit(cc chain.Interface) er.R {
 	birthdayStore := &walletBirthdayStore{
 		db:      w.db,
 		manager: w.Manager,
 	}
-	birthdayBlock, err := birthdaySanityCheck(
-		w.ChainClient(), birthdayStore,
-	)
+	birthdayBlock, err := birthdaySanityCheck(cc, birthdayStore)
 	if err != nil && !waddrmgr.ErrBirthdayBlockNotSet.Is(err) {
 		err.AddMessage("Unable to sanity check wallet birthday block")
-		panic(err.String())
+		return err
 	}
 
-	err = w.syncWithChain(birthdayBlock)
-	if err != nil && !w.ShuttingDown() {
+	if err := w.syncWithChain(cc, birthdayBlock); err != nil {
 		err.AddMessage("Unable to synchronize wallet to chain")
-		panic(err.String())
+		return err
 	}
+	return nil
 }
 
+// mainLoopRetryDelay is how long the main loop waits before trying again to
+// find a chain client and sync the wallet with it.
+var mainLoopRetryDelay = time.Second
+
 func (w *Wallet) goMainLoop() {
 	w.wg.Add(1)
 	for {
-		if w.ChainClient() != nil {
+		// The chain client may be detached or swapped at any time, in
+		// which case the initial sync is retried with the next one and
+		// must not block the shutdown.
+		if w.ShuttingDown() {
+			w.wg.Done()
+			return
+		}
+		cc := w.ChainClient()
+		if cc == nil {
+			time.Sleep(mainLoopRetryDelay)
+			continue
+		}
+		err := w.walletInit(cc)
+		if err == nil {
 			break
 		}
-		time.Sleep(time.Duration(1) * time.Second)
+		if w.ShuttingDown() {
+			w.wg.Done()
+			return
+		}
+		log.Warnf("Initial sync failed, retrying [%s]", err.String())
+		time.Sleep(mainLoopRetryDelay)
 	}
-	w.walletInit()
 	for {
 		w.rescan()
 		w.checkBlock()
@@ -3237,25 +3841,35 @@ func Open(db walletdb.DB, pubPass []byte, cbs *waddrmgr.OpenCallbacks,
 		return nil, err
 	}
 
-	log.Infof("Opened wallet") // TODO: log balance? last sync height?
+	syncedTo := addrMgr.SyncedTo()
+	log.With(
+		log.F("height", syncedTo.Height), log.F("hash", syncedTo.Hash),
+	).Infof("Opened wallet synced to [%v @ %v]", syncedTo.Hash,
+		syncedTo.Height) // TODO: log balance?
 
 	w := &Wallet{
-		publicPassphrase:   pubPass,
-		db:                 db,
-		Manager:            addrMgr,
-		TxStore:            txMgr,
-		lockedOutpoints:    map[wire.OutPoint]string{},
-		recoveryWindow:     recoveryWindow,
-		createTxRequests:   make(chan createTxRequest),
-		unlockRequests:     make(chan unlockRequest),
-		lockRequests:       make(chan struct{}),
-		holdUnlockRequests: make(chan chan heldUnlock),
-		lockState:          make(chan bool),
-		changePassphrase:   make(chan changePassphraseRequest),
-		changePassphrases:  make(chan changePassphrasesRequest),
-		chainParams:        params,
-		quit:               make(chan struct{}),
-		watch:              watcher.New(),
+		publicPassphrase:    pubPass,
+		db:                  db,
+		Manager:             addrMgr,
+		TxStore:             txMgr,
+		lockedOutpoints:     map[wire.OutPoint]string{},
+		recoveryWindow:      recoveryWindow,
+		createTxRequests:    make(chan createTxRequest),
+		consolidateRequests: make(chan consolidateRequest),
+		unlockRequests:      make(chan unlockRequest),
+		lockRequests:        make(chan struct{}),
+		holdUnlockRequests:  make(chan chan heldUnlock),
+		lockState:           make(chan bool),
+		changePassphrase:    make(chan changePassphraseRequest),
+		changePassphrases:   make(chan changePassphrasesRequest),
+		chainParams:         params,
+		quit:                make(chan struct{}),
+		watch:               watcher.New(),
+		reorgSafetyDepth:    DefaultReorgSafetyDepth,
+		txSequence:          DefaultTxSequence,
+		seedCheckLimiter: rate.NewLimiter(
+			rate.Every(seedCheckInterval), seedCheckBurst,
+		),
 	}
 
 	w.NtfnServer = newNotificationServer(w)
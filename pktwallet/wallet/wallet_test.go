@@ -2,14 +2,24 @@ package wallet
 
 import (
 	"encoding/hex"
+	"io/ioutil"
+	"os"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/pkt-cash/pktd/btcutil"
 	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/chaincfg"
+	"github.com/pkt-cash/pktd/chaincfg/chainhash"
 	"github.com/pkt-cash/pktd/chaincfg/genesis"
+	"github.com/pkt-cash/pktd/pktwallet/waddrmgr"
+	"github.com/pkt-cash/pktd/pktwallet/wallet/seedwords"
 	"github.com/pkt-cash/pktd/pktwallet/walletdb"
 	"github.com/pkt-cash/pktd/pktwallet/wtxmgr"
+	"github.com/pkt-cash/pktd/txscript"
+	"github.com/pkt-cash/pktd/txscript/opcode"
+	"github.com/pkt-cash/pktd/wire"
 )
 
 var (
@@ -201,3 +211,420 @@ func TestLabelTransaction(t *testing.T) {
 		})
 	}
 }
+
+// TestAbandonTransaction ensures that the outputs spent by an abandoned
+// transaction are released, including any locks on them, and are picked by
+// coin selection again.
+func TestAbandonTransaction(t *testing.T) {
+	t.Parallel()
+
+	w, cleanup := testWallet(t)
+	defer cleanup()
+
+	addr, err := w.NewAddress(0, waddrmgr.KeyScopeBIP0084)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pkScript, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Add a confirmed output paying to the wallet and an unconfirmed
+	// transaction spending it, the output is locked as it would be by
+	// createtransaction with autolock.
+	block := &wtxmgr.BlockMeta{
+		Block: wtxmgr.Block{Hash: *testBlockHash, Height: testBlockHeight},
+		Time:  time.Unix(1387737310, 0),
+	}
+	fundingRec, err := wtxmgr.NewTxRecordFromMsgTx(&wire.MsgTx{
+		TxIn:  []*wire.TxIn{{}},
+		TxOut: []*wire.TxOut{wire.NewTxOut(100000, pkScript)},
+	}, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	op := wire.OutPoint{Hash: fundingRec.Hash}
+	spendRec, err := wtxmgr.NewTxRecordFromMsgTx(&wire.MsgTx{
+		TxIn:  []*wire.TxIn{{PreviousOutPoint: op}},
+		TxOut: []*wire.TxOut{wire.NewTxOut(90000, []byte{opcode.OP_TRUE})},
+	}, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) er.R {
+		ns := dbtx.ReadWriteBucket(wtxmgrNamespaceKey)
+		if err := w.TxStore.InsertTx(ns, fundingRec, block); err != nil {
+			return err
+		}
+		if err := w.TxStore.AddCredit(ns, fundingRec, block, 0, false); err != nil {
+			return err
+		}
+		return w.TxStore.InsertTx(ns, spendRec, nil)
+	})
+	if err != nil {
+		t.Fatalf("unable to add transactions: %v", err)
+	}
+	w.LockOutpoint(op, "autolock")
+
+	txr := CreateTxReq{
+		Outputs:     []*wire.TxOut{wire.NewTxOut(50000, pkScript)},
+		Minconf:     1,
+		FeeSatPerKB: 1000,
+		DryRun:      true,
+	}
+	if _, err := w.txToOutputs(txr); err == nil {
+		t.Fatalf("expected coin selection to fail while the output " +
+			"is spent")
+	}
+
+	if err := w.AbandonTransaction(spendRec.Hash); err != nil {
+		t.Fatalf("unable to abandon transaction: %v", err)
+	}
+	if w.LockedOutpoint(op) {
+		t.Fatalf("output still locked after abandoning the " +
+			"transaction spending it")
+	}
+	tx, err := w.txToOutputs(txr)
+	if err != nil {
+		t.Fatalf("unable to select the released output: %v", err)
+	}
+	if len(tx.Tx.TxIn) != 1 || tx.Tx.TxIn[0].PreviousOutPoint != op {
+		t.Fatalf("expected the released output %v to be spent, got %v",
+			op, tx.Tx.TxIn)
+	}
+
+	// Abandoning the transaction again, or an unknown transaction, fails.
+	err = w.AbandonTransaction(spendRec.Hash)
+	if !wtxmgr.ErrTxAbandoned.Is(err) {
+		t.Fatalf("expected: %v, got: %v", wtxmgr.ErrTxAbandoned, err)
+	}
+	err = w.AbandonTransaction(*TstTxHash)
+	if !ErrUnknownTransaction.Is(err) {
+		t.Fatalf("expected: %v, got: %v", ErrUnknownTransaction, err)
+	}
+}
+
+// TestAddressLabels ensures that address book labels can be stored for both
+// wallet and foreign addresses, looked up by label and removed again.
+func TestAddressLabels(t *testing.T) {
+	w, cleanup := testWallet(t)
+	defer cleanup()
+
+	own, err := w.CurrentAddress(0, waddrmgr.KeyScopeBIP0084)
+	if err != nil {
+		t.Fatalf("unable to get current address: %v", err)
+	}
+	foreign, err := btcutil.NewAddressPubKeyHash(make([]byte, 20), w.chainParams)
+	if err != nil {
+		t.Fatalf("unable to create address: %v", err)
+	}
+
+	if err := w.SetAddressLabel(own, "friends"); err != nil {
+		t.Fatalf("unable to label address: %v", err)
+	}
+	if err := w.SetAddressLabel(foreign, "friends"); err != nil {
+		t.Fatalf("unable to label address: %v", err)
+	}
+	if err := w.SetAddressLabel(foreign, "shop"); err != nil {
+		t.Fatalf("unable to relabel address: %v", err)
+	}
+
+	label, err := w.AddressLabel(foreign)
+	if err != nil || label != "shop" {
+		t.Fatalf("expected label shop, got %q (%v)", label, err)
+	}
+	labels, err := w.AddressLabels()
+	if err != nil || len(labels) != 2 || labels[0] != "friends" || labels[1] != "shop" {
+		t.Fatalf("unexpected labels %v (%v)", labels, err)
+	}
+	addrs, err := w.AddressesByLabel("friends")
+	if err != nil || len(addrs) != 1 || addrs[0].EncodeAddress() != own.EncodeAddress() {
+		t.Fatalf("unexpected addresses %v (%v)", addrs, err)
+	}
+
+	// An empty label removes the address from the address book.
+	if err := w.SetAddressLabel(foreign, ""); err != nil {
+		t.Fatalf("unable to remove label: %v", err)
+	}
+	if label, err := w.AddressLabel(foreign); err != nil || label != "" {
+		t.Fatalf("expected no label, got %q (%v)", label, err)
+	}
+
+	err = w.SetAddressLabel(foreign, strings.Repeat("x", wtxmgr.TxLabelLimit+1))
+	if !ErrAddrLabelTooLong.Is(err) {
+		t.Fatalf("expected %v, got %v", ErrAddrLabelTooLong, err)
+	}
+	err = w.SetAddressLabel(foreign, "\xff")
+	if !ErrAddrLabelInvalid.Is(err) {
+		t.Fatalf("expected %v, got %v", ErrAddrLabelInvalid, err)
+	}
+}
+
+// TestVerifySeed ensures that a seed backup is only verified when it matches
+// the wallet's seed and that verification attempts are rate limited.
+func TestVerifySeed(t *testing.T) {
+	dir, errr := ioutil.TempDir("", "test_wallet_seed")
+	if errr != nil {
+		t.Fatalf("Failed to create db dir: %v", errr)
+	}
+	defer os.RemoveAll(dir)
+
+	seed, err := seedwords.RandomSeed()
+	if err != nil {
+		t.Fatalf("unable to create seed: %v", err)
+	}
+	privPass := []byte("world")
+	loader := NewLoader(
+		&chaincfg.TestNet3Params, dir, "wallet.db", true, 250,
+	)
+	w, err := loader.CreateNewWallet(
+		[]byte("hello"), privPass, nil, time.Time{}, seed,
+	)
+	if err != nil {
+		t.Fatalf("unable to create wallet: %v", err)
+	}
+	defer w.Stop()
+	if err := w.Unlock(privPass, time.After(10*time.Minute)); err != nil {
+		t.Fatalf("unable to unlock wallet: %v", err)
+	}
+
+	words, err := w.Manager.Seed().Words("english")
+	if err != nil {
+		t.Fatalf("unable to get seed words: %v", err)
+	}
+	otherSeed, err := seedwords.RandomSeed()
+	if err != nil {
+		t.Fatalf("unable to create seed: %v", err)
+	}
+	otherWords, err := otherSeed.Encrypt(nil).Words("english")
+	if err != nil {
+		t.Fatalf("unable to get seed words: %v", err)
+	}
+
+	tests := []struct {
+		name  string
+		words string
+		match bool
+	}{
+		{"correct", words, true},
+		{"other seed", otherWords, false},
+		{"garbage", "not a seed", false},
+	}
+	for _, test := range tests {
+		match, err := w.VerifySeed(test.words)
+		if err != nil {
+			t.Fatalf("%s: unable to verify seed: %v", test.name, err)
+		}
+		if match != test.match {
+			t.Fatalf("%s: expected match=%v, got %v", test.name,
+				test.match, match)
+		}
+	}
+
+	// The burst of attempts is used up, so the next one must be refused.
+	_, err = w.VerifySeed(words)
+	if !ErrSeedCheckRateLimited.Is(err) {
+		t.Fatalf("expected ErrSeedCheckRateLimited, got %v", err)
+	}
+}
+
+// TestWaitForChainSynced asserts that waiting returns right away once the
+// wallet is synced, blocks until it becomes synced otherwise, and gives up
+// after the timeout.
+func TestWaitForChainSynced(t *testing.T) {
+	w := &Wallet{quit: make(chan struct{})}
+
+	// Not synced, the wait times out.
+	start := time.Now()
+	if w.WaitForChainSynced(50 * time.Millisecond) {
+		t.Fatal("expected wallet not to be synced")
+	}
+	if time.Since(start) < 50*time.Millisecond {
+		t.Fatal("wait returned before the timeout")
+	}
+
+	// A waiter is released once the wallet becomes synced.
+	done := make(chan bool)
+	go func() {
+		done <- w.WaitForChainSynced(time.Minute)
+	}()
+	time.Sleep(10 * time.Millisecond)
+	w.SetChainSynced(true)
+	select {
+	case synced := <-done:
+		if !synced {
+			t.Fatal("expected wallet to be synced")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("waiter not released")
+	}
+
+	// Already synced, the wait returns without blocking.
+	start = time.Now()
+	if !w.WaitForChainSynced(time.Minute) {
+		t.Fatal("expected wallet to be synced")
+	}
+	if time.Since(start) > time.Second {
+		t.Fatal("wait blocked although the wallet is synced")
+	}
+
+	// Falling out of sync makes waits block again.
+	w.SetChainSynced(false)
+	if w.WaitForChainSynced(10 * time.Millisecond) {
+		t.Fatal("expected wallet not to be synced")
+	}
+}
+
+// namedChainClient is a mockChainClient which can be told apart from other
+// instances by its backend name.
+type namedChainClient struct {
+	mockChainClient
+	name string
+}
+
+func (c *namedChainClient) BackEnd() string {
+	return c.name
+}
+
+// TestSwapChainClient asserts that the chain client of a running wallet can be
+// replaced without restarting the wallet, keeping its notification clients,
+// locks and operations which already hold the previous client.
+func TestSwapChainClient(t *testing.T) {
+	w, cleanup := testWallet(t)
+	defer cleanup()
+
+	// The first client needs the main loop to be started.
+	oldClient := &namedChainClient{name: "pktd"}
+	if !w.setChainClient(oldClient) {
+		t.Fatal("expected the main loop to be started")
+	}
+	inFlight, err := w.requireChainClient()
+	if err != nil {
+		t.Fatalf("unable to get chain client: %v", err)
+	}
+	sub := w.NtfnServer.TransactionNotifications()
+	defer sub.Done()
+	op := wire.OutPoint{Index: 1}
+	w.LockOutpoint(op, "test")
+	w.SetChainSynced(true)
+
+	newClient := &namedChainClient{name: "neutrino"}
+	if w.setChainClient(newClient) {
+		t.Fatal("expected the running main loop to be kept")
+	}
+
+	if w.ChainClient() != newClient {
+		t.Fatal("expected the new chain client to be associated")
+	}
+	if w.ShuttingDown() {
+		t.Fatal("expected the wallet to keep running")
+	}
+	if w.Locked() {
+		t.Fatal("expected the wallet to stay unlocked")
+	}
+	if !w.LockedOutpoint(op) {
+		t.Fatal("expected the outpoint to stay locked")
+	}
+	if w.ChainSynced() {
+		t.Fatal("expected the wallet to be unsynced with the new backend")
+	}
+	w.NtfnServer.mu.Lock()
+	subscribed := len(w.NtfnServer.transactions)
+	w.NtfnServer.mu.Unlock()
+	if subscribed != 1 {
+		t.Fatalf("expected 1 notification client, got %d", subscribed)
+	}
+	select {
+	case _, ok := <-sub.C:
+		if !ok {
+			t.Fatal("expected the notification client to stay open")
+		}
+	default:
+	}
+	if inFlight.BackEnd() != "pktd" {
+		t.Fatalf("expected in-flight operation to keep its client, "+
+			"got %s", inFlight.BackEnd())
+	}
+	if _, err := inFlight.BlockStamp(); err != nil {
+		t.Fatalf("unable to complete in-flight operation: %v", err)
+	}
+
+	// Once the wallet was restarted its main loop needs to be started
+	// again.
+	w.Stop()
+	w.WaitForShutdown()
+	w.Start()
+	if !w.setChainClient(oldClient) {
+		t.Fatal("expected the main loop to be started after a restart")
+	}
+}
+
+// syncedChainClient is a mockChainClient serving the blocks of a mockChainConn
+// which considers itself current.
+type syncedChainClient struct {
+	mockChainClient
+	conn *mockChainConn
+}
+
+func (c *syncedChainClient) GetBestBlock() (*chainhash.Hash, int32, er.R) {
+	return c.conn.GetBestBlock()
+}
+
+func (c *syncedChainClient) GetBlockHash(height int64) (*chainhash.Hash, er.R) {
+	return c.conn.GetBlockHash(height)
+}
+
+func (c *syncedChainClient) GetBlockHeader(
+	hash *chainhash.Hash) (*wire.BlockHeader, er.R) {
+
+	return c.conn.GetBlockHeader(hash)
+}
+
+func (c *syncedChainClient) IsCurrent() bool {
+	return true
+}
+
+// TestMainLoopReattachChainClient asserts that the main loop retries the
+// initial sync when the chain client is detached while the wallet waits for
+// it, and syncs with the chain client which is attached next.
+func TestMainLoopReattachChainClient(t *testing.T) {
+	defer func(delay time.Duration) {
+		mainLoopRetryDelay = delay
+	}(mainLoopRetryDelay)
+	mainLoopRetryDelay = 10 * time.Millisecond
+
+	w, cleanup := testWallet(t)
+	defer cleanup()
+	defer func() {
+		w.Stop()
+		w.WaitForShutdown()
+	}()
+
+	// The first backend never catches up, so the initial sync waits for it
+	// until it is detached.
+	w.SynchronizeRPC(&namedChainClient{name: "stalled"})
+	time.Sleep(200 * time.Millisecond)
+	if w.DetachChainClient() == nil {
+		t.Fatal("expected a chain client to be detached")
+	}
+	if w.WaitForChainSynced(200 * time.Millisecond) {
+		t.Fatal("expected the wallet not to be synced while detached")
+	}
+	if w.ShuttingDown() {
+		t.Fatal("expected the wallet to keep running while detached")
+	}
+
+	genesisBlock := genesis.Block(w.chainParams.GenesisHash)
+	w.SynchronizeRPC(&syncedChainClient{
+		conn: createMockChainConn(genesisBlock, 0, time.Minute),
+	})
+	if !w.WaitForChainSynced(5 * time.Second) {
+		t.Fatal("expected the wallet to sync with the reattached " +
+			"chain client")
+	}
+	if st := w.Manager.SyncedTo(); st.Height != 0 {
+		t.Fatalf("expected the wallet to be synced to height 0, got %d",
+			st.Height)
+	}
+}
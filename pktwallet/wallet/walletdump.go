@@ -0,0 +1,258 @@
+package wallet
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/pkt-cash/pktd/btcutil"
+	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/chaincfg"
+	"github.com/pkt-cash/pktd/chaincfg/genesis"
+	"github.com/pkt-cash/pktd/pktlog/log"
+	"github.com/pkt-cash/pktd/pktwallet/waddrmgr"
+	"github.com/pkt-cash/pktd/pktwallet/wallet/watcher"
+	"github.com/pkt-cash/pktd/pktwallet/walletdb"
+	"github.com/pkt-cash/pktd/pktwallet/wtxmgr"
+)
+
+// ErrMalformedWalletDump is returned when a line of a wallet dump can not be
+// parsed, the line number and the reason are given in the error.
+var ErrMalformedWalletDump = Err.CodeWithDetail("ErrMalformedWalletDump",
+	"malformed wallet dump")
+
+// DumpedKey is a private key read from a wallet dump.
+type DumpedKey struct {
+	// WIF is the private key, converted to the network of the wallet.
+	WIF *btcutil.WIF
+
+	// Birthday is the time the key was created, the chain needs to be
+	// rescanned from this time on to find the outputs paying to the key.
+	Birthday time.Time
+
+	// Label is the address label of the key, it is empty if the key has
+	// no label.
+	Label string
+}
+
+// ImportDumpResult describes the outcome of ImportWalletDump.
+type ImportDumpResult struct {
+	// Imported is the number of keys which were imported.
+	Imported int
+
+	// Existing is the number of keys which were already in the wallet,
+	// only their labels are applied.
+	Existing int
+
+	// RescanHeight is the height from which the chain is rescanned, it is
+	// -1 if no key was imported.
+	RescanHeight int32
+}
+
+// ParseWalletDump parses a wallet dump in the format of the dumpwallet command
+// of bitcoind. Each key is on a line of its own, holding the WIF encoded key,
+// the RFC 3339 creation time and optional key=value pairs, of which only the
+// URI encoded label is used, followed by a comment. Blank lines and comment
+// lines are skipped.
+//
+// Every line is validated, so a malformed dump is rejected as a whole rather
+// than partially imported.
+func ParseWalletDump(r io.Reader, params *chaincfg.Params) ([]DumpedKey, er.R) {
+	var keys []DumpedKey
+	scanner := bufio.NewScanner(r)
+	for lineNum := 1; scanner.Scan(); lineNum++ {
+		line := scanner.Text()
+		if i := strings.IndexByte(line, '#'); i >= 0 {
+			line = line[:i]
+		}
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+		key, err := parseDumpedKey(fields, params)
+		if err != nil {
+			return nil, ErrMalformedWalletDump.New(
+				fmt.Sprintf("line %d", lineNum), err)
+		}
+		keys = append(keys, *key)
+	}
+	if errr := scanner.Err(); errr != nil {
+		return nil, er.E(errr)
+	}
+	return keys, nil
+}
+
+// parseDumpedKey parses the fields of a wallet dump line.
+func parseDumpedKey(fields []string, params *chaincfg.Params) (*DumpedKey, er.R) {
+	if len(fields) < 2 {
+		return nil, er.New("expected a key and its creation time")
+	}
+
+	wif, err := btcutil.DecodeWIF(fields[0])
+	if err != nil {
+		return nil, err
+	}
+	if !wif.IsForNet(params) {
+		// Like importprivkey, keys for another chain are imported anyway.
+		wif, err = btcutil.NewWIF(wif.PrivKey, params, wif.CompressPubKey)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	birthday, errr := time.Parse(time.RFC3339, fields[1])
+	if errr != nil {
+		return nil, er.E(errr)
+	}
+
+	key := &DumpedKey{WIF: wif, Birthday: birthday}
+	for _, field := range fields[2:] {
+		kv := strings.SplitN(field, "=", 2)
+		if len(kv) != 2 {
+			return nil, er.Errorf("expected key=value, got [%s]", field)
+		}
+		if kv[0] != "label" {
+			continue
+		}
+		label, errr := url.PathUnescape(kv[1])
+		if errr != nil {
+			return nil, er.E(errr)
+		}
+		if len(label) > wtxmgr.TxLabelLimit {
+			return nil, ErrAddrLabelTooLong.Default()
+		}
+		if !utf8.ValidString(label) {
+			return nil, ErrAddrLabelInvalid.Default()
+		}
+		key.Label = label
+	}
+	return key, nil
+}
+
+// ImportWalletDump imports the keys of a wallet dump, applying their labels,
+// and schedules a single rescan from the earliest birthday of the imported
+// keys. Keys which are already in the wallet are not imported again, but their
+// labels are applied. The keys are imported in a single database transaction
+// so either all or none of them are imported.
+func (w *Wallet) ImportWalletDump(scope waddrmgr.KeyScope,
+	keys []DumpedKey) (*ImportDumpResult, er.R) {
+
+	chainClient, err := w.requireChainClient()
+	if err != nil {
+		return nil, err
+	}
+
+	w.rescanJLock.Lock()
+	defer w.rescanJLock.Unlock()
+	if w.rescanJ != nil {
+		return nil, er.Errorf(
+			"Importing a wallet dump requires a rescan but there is "+
+				"already a rescan job ([%v]) running, use `stopresync` "+
+				"to stop it", w.rescanJ.name)
+	}
+
+	manager, err := w.Manager.FetchScopedKeyManager(scope)
+	if err != nil {
+		return nil, err
+	}
+
+	// All keys share the start block of the earliest key, as they are found
+	// by a single rescan.
+	var birthday time.Time
+	for i, key := range keys {
+		if i == 0 || key.Birthday.Before(birthday) {
+			birthday = key.Birthday
+		}
+	}
+	bs := &waddrmgr.BlockStamp{
+		Hash:      *w.chainParams.GenesisHash,
+		Height:    0,
+		Timestamp: genesis.Block(w.chainParams.GenesisHash).Header.Timestamp,
+	}
+	if len(keys) > 0 && birthday.After(bs.Timestamp) {
+		bs, err = locateBirthdayBlock(chainClient, birthday)
+		if err != nil {
+			return nil, er.Errorf("unable to locate birthday block: %v",
+				err)
+		}
+	}
+
+	res := &ImportDumpResult{RescanHeight: -1}
+	var addrs []btcutil.Address
+	err = walletdb.Update(w.db, func(tx walletdb.ReadWriteTx) er.R {
+		addrmgrNs := tx.ReadWriteBucket(waddrmgrNamespaceKey)
+		for _, key := range keys {
+			// Keys are looked up by their pubkey hash, which all
+			// scopes share.
+			pkh, err := btcutil.NewAddressPubKeyHash(
+				btcutil.Hash160(key.WIF.SerializePubKey()),
+				w.chainParams,
+			)
+			if err != nil {
+				return err
+			}
+
+			var addr btcutil.Address
+			maddr, err := w.Manager.Address(addrmgrNs, pkh)
+			switch {
+			case err == nil:
+				addr = maddr.Address()
+				res.Existing++
+			case waddrmgr.ErrAddressNotFound.Is(err):
+				maddr, err := manager.ImportPrivateKey(
+					addrmgrNs, key.WIF, bs,
+				)
+				if err != nil {
+					return err
+				}
+				addr = maddr.Address()
+				addrs = append(addrs, addr)
+				res.Imported++
+			default:
+				return err
+			}
+
+			if key.Label != "" {
+				err := waddrmgr.PutAddrLabel(
+					addrmgrNs, addr.EncodeAddress(), key.Label,
+				)
+				if err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(addrs) == 0 {
+		return res, nil
+	}
+
+	// Rescan blockchain for transactions with txout scripts paying to the
+	// imported addresses. Like for a single imported key, do not block on
+	// finishing the rescan.
+	res.RescanHeight = bs.Height
+	watch := watcher.New()
+	if err := watch.WatchAddrs(addrs); err != nil {
+		return nil, err
+	}
+	if err := w.watch.WatchAddrs(addrs); err != nil {
+		return nil, err
+	}
+	w.rescanJ = &rescanJob{
+		name:       fmt.Sprintf("importwallet-%d-keys-resync", len(addrs)),
+		height:     bs.Height,
+		stopHeight: -1,
+		watch:      &watch,
+	}
+
+	log.Infof("Imported [%d] keys from wallet dump, rescanning from "+
+		"height [%d]", len(addrs), bs.Height)
+	return res, nil
+}
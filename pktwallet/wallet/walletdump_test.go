@@ -0,0 +1,149 @@
+package wallet
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/pkt-cash/pktd/btcec"
+	"github.com/pkt-cash/pktd/btcutil"
+	"github.com/pkt-cash/pktd/chaincfg"
+	"github.com/pkt-cash/pktd/pktwallet/waddrmgr"
+)
+
+// newTestWIF returns a new random private key for the test network.
+func newTestWIF(t *testing.T) *btcutil.WIF {
+	privKey, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		t.Fatalf("unable to create private key: %v", err)
+	}
+	wif, err := btcutil.NewWIF(privKey, &chaincfg.TestNet3Params, true)
+	if err != nil {
+		t.Fatalf("unable to create wif: %v", err)
+	}
+	return wif
+}
+
+// TestParseWalletDump tests that the keys, birthdays and labels of a wallet
+// dump are parsed, and that a single malformed line rejects the whole dump.
+func TestParseWalletDump(t *testing.T) {
+	t.Parallel()
+
+	wif1, wif2 := newTestWIF(t), newTestWIF(t)
+	dump := fmt.Sprintf(`# Wallet dump created by Bitcoin
+# * Best block at time of backup was 1000 (00ab),
+
+%s 2019-08-01T10:00:00Z label=my%%20savings # addr=abc
+%s 2018-01-01T00:00:00Z reserve=1 hdkeypath=m/0'/0'/1' # addr=def
+
+# End of dump
+`, wif1, wif2)
+
+	keys, err := ParseWalletDump(
+		strings.NewReader(dump), &chaincfg.TestNet3Params,
+	)
+	if err != nil {
+		t.Fatalf("unable to parse wallet dump: %v", err)
+	}
+	if len(keys) != 2 {
+		t.Fatalf("expected 2 keys, got %d", len(keys))
+	}
+	if keys[0].WIF.String() != wif1.String() ||
+		keys[1].WIF.String() != wif2.String() {
+
+		t.Fatalf("keys don't match")
+	}
+	if keys[0].Label != "my savings" || keys[1].Label != "" {
+		t.Fatalf("unexpected labels %q and %q", keys[0].Label,
+			keys[1].Label)
+	}
+	if keys[1].Birthday.Year() != 2018 {
+		t.Fatalf("unexpected birthday %v", keys[1].Birthday)
+	}
+
+	for _, line := range []string{
+		"notawif 2019-08-01T10:00:00Z",
+		wif1.String(),
+		wif1.String() + " yesterday",
+		wif1.String() + " 2019-08-01T10:00:00Z reserve",
+		wif1.String() + " 2019-08-01T10:00:00Z label=%zz",
+		wif1.String() + " 2019-08-01T10:00:00Z label=" +
+			strings.Repeat("a", 1000),
+	} {
+		_, err := ParseWalletDump(
+			strings.NewReader(dump+line+"\n"),
+			&chaincfg.TestNet3Params,
+		)
+		if !ErrMalformedWalletDump.Is(err) {
+			t.Fatalf("expected ErrMalformedWalletDump for %q, got %v",
+				line, err)
+		}
+	}
+}
+
+// TestImportWalletDump tests that the keys of a wallet dump are imported with
+// their labels, and that keys already in the wallet are not imported again.
+func TestImportWalletDump(t *testing.T) {
+	t.Parallel()
+
+	w, cleanup := testWallet(t)
+	defer cleanup()
+
+	wif1, wif2 := newTestWIF(t), newTestWIF(t)
+	dump := fmt.Sprintf("%s 1970-01-01T00:00:01Z label=first\n"+
+		"%s 1970-01-01T00:00:01Z\n", wif1, wif2)
+	keys, err := ParseWalletDump(
+		strings.NewReader(dump), &chaincfg.TestNet3Params,
+	)
+	if err != nil {
+		t.Fatalf("unable to parse wallet dump: %v", err)
+	}
+
+	res, err := w.ImportWalletDump(waddrmgr.KeyScopeBIP0084, keys)
+	if err != nil {
+		t.Fatalf("unable to import wallet dump: %v", err)
+	}
+	if res.Imported != 2 || res.Existing != 0 || res.RescanHeight != 0 {
+		t.Fatalf("unexpected result %+v", res)
+	}
+
+	// The imported keys can be dumped again, and the label is applied.
+	for i, wif := range []*btcutil.WIF{wif1, wif2} {
+		addr, err := btcutil.NewAddressWitnessPubKeyHash(
+			btcutil.Hash160(wif.SerializePubKey()),
+			&chaincfg.TestNet3Params,
+		)
+		if err != nil {
+			t.Fatal(err)
+		}
+		dumped, err := w.DumpWIFPrivateKey(addr)
+		if err != nil {
+			t.Fatalf("unable to dump key %d: %v", i, err)
+		}
+		if dumped != wif.String() {
+			t.Fatalf("expected key %v, got %v", wif, dumped)
+		}
+		if i == 0 {
+			label, err := w.AddressLabel(addr)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if label != "first" {
+				t.Fatalf("expected label first, got %q", label)
+			}
+		}
+	}
+
+	// Importing the dump again doesn't import any key, nor rescan.
+	w.rescanJ = nil
+	res, err = w.ImportWalletDump(waddrmgr.KeyScopeBIP0084, keys)
+	if err != nil {
+		t.Fatalf("unable to import wallet dump: %v", err)
+	}
+	if res.Imported != 0 || res.Existing != 2 || res.RescanHeight != -1 {
+		t.Fatalf("unexpected result %+v", res)
+	}
+	if w.rescanJ != nil {
+		t.Fatalf("unexpected rescan job %v", w.rescanJ.name)
+	}
+}
@@ -0,0 +1,23 @@
+package wallet
+
+// SetMaxWatchedAddrs limits the number of addresses the wallet watches for
+// transactions, zero means there is no limit. Once the limit is reached,
+// deriving, importing or rescanning further addresses fails with
+// watcher.ErrTooManyAddrs instead of growing the watch list without bound. The
+// addresses the wallet already has are watched when it syncs even if they
+// exceed the limit. It must be called before the wallet is synced.
+func (w *Wallet) SetMaxWatchedAddrs(max int) {
+	w.watch.SetMaxAddrs(max)
+}
+
+// NumWatchedAddrs returns the number of addresses the wallet currently watches
+// for transactions.
+func (w *Wallet) NumWatchedAddrs() int {
+	return w.watch.NumAddrs()
+}
+
+// MaxWatchedAddrs returns the maximum number of addresses the wallet watches,
+// zero means there is no limit.
+func (w *Wallet) MaxWatchedAddrs() int {
+	return w.watch.MaxAddrs()
+}
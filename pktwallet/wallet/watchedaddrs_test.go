@@ -0,0 +1,51 @@
+package wallet
+
+import (
+	"testing"
+
+	"github.com/pkt-cash/pktd/pktwallet/waddrmgr"
+	"github.com/pkt-cash/pktd/pktwallet/wallet/watcher"
+)
+
+// TestMaxWatchedAddrs tests that deriving addresses beyond the maximum number
+// of watched addresses fails with an error and that the number of watched
+// addresses is reported.
+func TestMaxWatchedAddrs(t *testing.T) {
+	t.Parallel()
+
+	w, cleanup := testWallet(t)
+	defer cleanup()
+
+	const maxWatched = 2
+	w.SetMaxWatchedAddrs(maxWatched + w.NumWatchedAddrs())
+	watched := w.NumWatchedAddrs()
+
+	for i := 0; i < maxWatched; i++ {
+		if _, err := w.NewAddress(0, waddrmgr.KeyScopeBIP0084); err != nil {
+			t.Fatalf("unable to derive address %d: %v", i, err)
+		}
+	}
+	if n := w.NumWatchedAddrs(); n != watched+maxWatched {
+		t.Fatalf("expected %d watched addresses, got %d",
+			watched+maxWatched, n)
+	}
+
+	_, err := w.NewAddress(0, waddrmgr.KeyScopeBIP0084)
+	if !watcher.ErrTooManyAddrs.Is(err) {
+		t.Fatalf("expected ErrTooManyAddrs, got %v", err)
+	}
+	if n := w.NumWatchedAddrs(); n != watched+maxWatched {
+		t.Fatalf("expected %d watched addresses, got %d",
+			watched+maxWatched, n)
+	}
+
+	// Removing the limit allows deriving addresses again.
+	w.SetMaxWatchedAddrs(0)
+	if _, err := w.NewAddress(0, waddrmgr.KeyScopeBIP0084); err != nil {
+		t.Fatalf("unable to derive address without limit: %v", err)
+	}
+	if n := w.NumWatchedAddrs(); n != watched+maxWatched+1 {
+		t.Fatalf("expected %d watched addresses, got %d",
+			watched+maxWatched+1, n)
+	}
+}
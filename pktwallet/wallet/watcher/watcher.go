@@ -1,15 +1,24 @@
 package watcher
 
 import (
+	"fmt"
 	"sort"
 	"sync"
 
 	"github.com/pkt-cash/pktd/btcutil"
+	"github.com/pkt-cash/pktd/btcutil/er"
 	"github.com/pkt-cash/pktd/pktwallet/chain"
 	"github.com/pkt-cash/pktd/pktwallet/waddrmgr"
 	"github.com/pkt-cash/pktd/wire"
 )
 
+var Err er.ErrorType = er.NewErrorType("watcher.Err")
+
+// ErrTooManyAddrs is returned when watching more addresses would exceed the
+// maximum number of watched addresses.
+var ErrTooManyAddrs = Err.CodeWithDetail("ErrTooManyAddrs",
+	"too many addresses to watch, raise maxwatchedaddrs to watch them all")
+
 type OutPointWatch struct {
 	BeginHeight int32
 	OutPoint    wire.OutPoint
@@ -18,26 +27,99 @@ type OutPointWatch struct {
 
 type Watcher struct {
 	watchAddrsLock sync.RWMutex
-	watchAddrs     map[btcutil.Address]struct{}
-	watchPoints    []OutPointWatch
+	// watchAddrs is keyed by the encoded address so that an address is
+	// only watched once, no matter how many instances of it are watched.
+	watchAddrs  map[string]btcutil.Address
+	watchPoints []OutPointWatch
+
+	// maxAddrs is the maximum number of watched addresses, zero means
+	// there is no limit.
+	maxAddrs int
 }
 
 func New() Watcher {
 	return Watcher{
-		watchAddrs:  make(map[btcutil.Address]struct{}),
+		watchAddrs:  make(map[string]btcutil.Address),
 		watchPoints: make([]OutPointWatch, 0),
 	}
 }
 
+// SetMaxAddrs limits the number of watched addresses, zero removes the limit.
+// Addresses which are already watched stay watched.
+func (w *Watcher) SetMaxAddrs(max int) {
+	w.watchAddrsLock.Lock()
+	defer w.watchAddrsLock.Unlock()
+	w.maxAddrs = max
+}
+
+// MaxAddrs returns the maximum number of watched addresses, zero means there is
+// no limit.
+func (w *Watcher) MaxAddrs() int {
+	w.watchAddrsLock.RLock()
+	defer w.watchAddrsLock.RUnlock()
+	return w.maxAddrs
+}
+
+// NumAddrs returns the number of watched addresses.
+func (w *Watcher) NumAddrs() int {
+	w.watchAddrsLock.RLock()
+	defer w.watchAddrsLock.RUnlock()
+	return len(w.watchAddrs)
+}
+
+// CanWatch returns ErrTooManyAddrs if watching n more addresses would exceed
+// the limit, so that callers can check before creating the addresses.
+func (w *Watcher) CanWatch(n int) er.R {
+	w.watchAddrsLock.RLock()
+	defer w.watchAddrsLock.RUnlock()
+	return w.checkTotal(len(w.watchAddrs) + n)
+}
+
+// checkTotal returns ErrTooManyAddrs if watching total addresses exceeds the
+// limit. Requires watchAddrsLock.
+func (w *Watcher) checkTotal(total int) er.R {
+	if w.maxAddrs > 0 && total > w.maxAddrs {
+		return ErrTooManyAddrs.New(fmt.Sprintf("watching %d addresses "+
+			"exceeds the limit of %d", total, w.maxAddrs), nil)
+	}
+	return nil
+}
+
+// checkMaxAddrs returns ErrTooManyAddrs if watching addrs in addition to the
+// already watched addresses exceeds the limit. Requires watchAddrsLock.
+func (w *Watcher) checkMaxAddrs(addrs []btcutil.Address) er.R {
+	if w.maxAddrs <= 0 {
+		return nil
+	}
+
+	newAddrs := make(map[string]struct{})
+	for _, addr := range addrs {
+		enc := addr.EncodeAddress()
+		if _, ok := w.watchAddrs[enc]; !ok {
+			newAddrs[enc] = struct{}{}
+		}
+	}
+	return w.checkTotal(len(w.watchAddrs) + len(newAddrs))
+}
+
 func (w *Watcher) watchStuff(
 	addrs []btcutil.Address,
 	ao []OutPointWatch,
-) {
+	force bool,
+) er.R {
 	w.watchAddrsLock.Lock()
 	defer w.watchAddrsLock.Unlock()
 
+	// Nothing is watched if the addresses don't fit, so that the caller
+	// either watches all of them or gets an error, unless forced to watch
+	// them anyway.
+	err := w.checkMaxAddrs(addrs)
+	if err != nil && !force {
+		return err
+	}
+
 	for _, addr := range addrs {
-		w.watchAddrs[addr] = struct{}{}
+		w.watchAddrs[addr.EncodeAddress()] = addr
 	}
 	if len(ao) > 0 {
 		w.watchPoints = append(w.watchPoints, ao...)
@@ -45,18 +127,29 @@ func (w *Watcher) watchStuff(
 			return w.watchPoints[i].BeginHeight < w.watchPoints[j].BeginHeight
 		})
 	}
+	return err
 }
 
 func (w *Watcher) WatchOutpoints(ao []OutPointWatch) {
-	w.watchStuff(nil, ao)
+	// Outpoints don't count against the address limit so this can't fail.
+	_ = w.watchStuff(nil, ao, false)
+}
+
+// WatchAddrs watches all of addrs, or none of them and returns
+// ErrTooManyAddrs if they exceed the limit of watched addresses.
+func (w *Watcher) WatchAddrs(addrs []btcutil.Address) er.R {
+	return w.watchStuff(addrs, nil, false)
 }
 
-func (w *Watcher) WatchAddrs(addrs []btcutil.Address) {
-	w.watchStuff(addrs, nil)
+// ForceWatchAddrs watches all of addrs even if they exceed the limit of watched
+// addresses, in which case ErrTooManyAddrs is returned nonetheless. This is for
+// addresses which already belong to the wallet and must not be missed.
+func (w *Watcher) ForceWatchAddrs(addrs []btcutil.Address) er.R {
+	return w.watchStuff(addrs, nil, true)
 }
 
-func (w *Watcher) WatchAddr(addr btcutil.Address) {
-	w.watchStuff([]btcutil.Address{addr}, nil)
+func (w *Watcher) WatchAddr(addr btcutil.Address) er.R {
+	return w.watchStuff([]btcutil.Address{addr}, nil, false)
 }
 
 func (w *Watcher) FilterReq(height int32) *chain.FilterBlocksRequest {
@@ -69,7 +162,7 @@ func (w *Watcher) FilterReq(height int32) *chain.FilterBlocksRequest {
 		ImportedAddrs:    make([]btcutil.Address, 0, len(w.watchAddrs)),
 		WatchedOutPoints: make(map[wire.OutPoint]btcutil.Address),
 	}
-	for wa := range w.watchAddrs {
+	for _, wa := range w.watchAddrs {
 		filterReq.ImportedAddrs = append(filterReq.ImportedAddrs, wa)
 	}
 	for _, opw := range w.watchPoints {
@@ -0,0 +1,120 @@
+package watcher
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/pkt-cash/pktd/btcutil"
+	"github.com/pkt-cash/pktd/chaincfg"
+	"github.com/pkt-cash/pktd/wire"
+)
+
+func testAddr(t *testing.T, b byte) btcutil.Address {
+	addr, err := btcutil.NewAddressPubKeyHash(
+		bytes.Repeat([]byte{b}, 20), &chaincfg.MainNetParams,
+	)
+	if err != nil {
+		t.Fatalf("unable to create address: %v", err)
+	}
+	return addr
+}
+
+// TestMaxAddrs tests that addresses are either all watched or, if they exceed
+// the limit, none of them, and that outpoints don't count against the limit.
+func TestMaxAddrs(t *testing.T) {
+	w := New()
+	w.SetMaxAddrs(3)
+
+	a, b, c, d := testAddr(t, 1), testAddr(t, 2), testAddr(t, 3),
+		testAddr(t, 4)
+
+	if err := w.WatchAddrs([]btcutil.Address{a, b, a}); err != nil {
+		t.Fatalf("unable to watch addresses: %v", err)
+	}
+	if n := w.NumAddrs(); n != 2 {
+		t.Fatalf("expected 2 watched addresses, got %d", n)
+	}
+
+	// Watching an address again doesn't count twice.
+	if err := w.WatchAddr(b); err != nil {
+		t.Fatalf("unable to watch address again: %v", err)
+	}
+
+	err := w.WatchAddrs([]btcutil.Address{c, d})
+	if !ErrTooManyAddrs.Is(err) {
+		t.Fatalf("expected ErrTooManyAddrs, got %v", err)
+	}
+	if n := w.NumAddrs(); n != 2 {
+		t.Fatalf("expected 2 watched addresses, got %d", n)
+	}
+
+	w.WatchOutpoints([]OutPointWatch{{
+		OutPoint: wire.OutPoint{Index: 1},
+		Addr:     d,
+	}})
+	if err := w.WatchAddr(c); err != nil {
+		t.Fatalf("unable to watch address: %v", err)
+	}
+	if err := w.WatchAddr(d); !ErrTooManyAddrs.Is(err) {
+		t.Fatalf("expected ErrTooManyAddrs, got %v", err)
+	}
+
+	filterReq := w.FilterReq(0)
+	if len(filterReq.ImportedAddrs) != 3 {
+		t.Fatalf("expected 3 addresses in filter, got %d",
+			len(filterReq.ImportedAddrs))
+	}
+	if len(filterReq.WatchedOutPoints) != 1 {
+		t.Fatalf("expected 1 outpoint in filter, got %d",
+			len(filterReq.WatchedOutPoints))
+	}
+
+	// Without a limit any number of addresses is watched.
+	w.SetMaxAddrs(0)
+	if err := w.WatchAddr(d); err != nil {
+		t.Fatalf("unable to watch address without limit: %v", err)
+	}
+	if n := w.NumAddrs(); n != 4 {
+		t.Fatalf("expected 4 watched addresses, got %d", n)
+	}
+}
+
+// TestWatchAddrsByEncoding tests that distinct instances of an address are
+// watched once, that CanWatch checks the limit ahead of watching and that
+// ForceWatchAddrs watches addresses beyond the limit while reporting it.
+func TestWatchAddrsByEncoding(t *testing.T) {
+	w := New()
+	w.SetMaxAddrs(2)
+
+	if err := w.WatchAddrs([]btcutil.Address{
+		testAddr(t, 1), testAddr(t, 1),
+	}); err != nil {
+		t.Fatalf("unable to watch addresses: %v", err)
+	}
+	if err := w.WatchAddr(testAddr(t, 1)); err != nil {
+		t.Fatalf("unable to watch address again: %v", err)
+	}
+	if n := w.NumAddrs(); n != 1 {
+		t.Fatalf("expected 1 watched address, got %d", n)
+	}
+
+	if err := w.CanWatch(1); err != nil {
+		t.Fatalf("expected room for 1 address, got %v", err)
+	}
+	if err := w.CanWatch(2); !ErrTooManyAddrs.Is(err) {
+		t.Fatalf("expected ErrTooManyAddrs, got %v", err)
+	}
+
+	err := w.ForceWatchAddrs([]btcutil.Address{
+		testAddr(t, 2), testAddr(t, 3),
+	})
+	if !ErrTooManyAddrs.Is(err) {
+		t.Fatalf("expected ErrTooManyAddrs, got %v", err)
+	}
+	if n := w.NumAddrs(); n != 3 {
+		t.Fatalf("expected 3 watched addresses, got %d", n)
+	}
+	if err := w.WatchAddr(testAddr(t, 4)); !ErrTooManyAddrs.Is(err) {
+		t.Fatalf("expected ErrTooManyAddrs, got %v", err)
+	}
+}
@@ -0,0 +1,229 @@
+package bdb
+
+import (
+	"os"
+	"runtime"
+	"time"
+
+	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/pktlog/log"
+	"github.com/pkt-cash/pktd/pktwallet/walletdb"
+	"go.etcd.io/bbolt"
+)
+
+const (
+	// compactTempSuffix is appended to the path of the database to get the
+	// path of the temporary file it is compacted into.
+	compactTempSuffix = ".compact"
+
+	// compactTxMaxSize is the number of bytes of keys and values copied
+	// per transaction, to keep memory in check for large databases.
+	compactTxMaxSize = 65536
+
+	// compactLockTimeout is how long compaction waits for the exclusive
+	// lock on the database before giving up because it is in use.
+	compactLockTimeout = time.Second
+)
+
+// compactDB compacts the database at dbPath into a temporary file, checks that
+// the compacted copy opens cleanly and is consistent, and then atomically
+// renames it over the original.  The source database is held open read-write
+// the whole time, so the exclusive lock bolt takes on it keeps other processes
+// from writing to it until the swap is done.
+func compactDB(dbPath string) (int64, int64, er.R) {
+	fi, errr := os.Stat(dbPath)
+	if os.IsNotExist(errr) {
+		return 0, 0, walletdb.ErrDbDoesNotExist.Default()
+	} else if errr != nil {
+		return 0, 0, er.E(errr)
+	}
+	initialSize := fi.Size()
+
+	src, errr := bbolt.Open(dbPath, fi.Mode(), &bbolt.Options{
+		Timeout: compactLockTimeout,
+	})
+	if errr == bbolt.ErrTimeout {
+		return 0, 0, er.Errorf("database %s is in use", dbPath)
+	} else if errr != nil {
+		return 0, 0, convertErr(errr)
+	}
+	srcOpen := true
+	defer func() {
+		if !srcOpen {
+			return
+		}
+		if err := src.Close(); err != nil {
+			log.Errorf("Compact error: closing source DB: %v", err)
+		}
+	}()
+
+	// Remove a temporary file left behind by an interrupted compaction.
+	// If the compaction is successful, the file won't exist on exit
+	// anymore, otherwise it is removed again.
+	tempPath := dbPath + compactTempSuffix
+	if errr := os.Remove(tempPath); errr != nil && !os.IsNotExist(errr) {
+		return 0, 0, er.Errorf("unable to remove old temp DB file: %v",
+			errr)
+	}
+	defer func() {
+		_ = os.Remove(tempPath)
+	}()
+
+	dst, errr := bbolt.Open(tempPath, fi.Mode(), nil)
+	if errr != nil {
+		return 0, 0, er.Errorf("error opening destination database: "+
+			"%v", errr)
+	}
+	err := compact(dst, src)
+	if errr := dst.Close(); err == nil && errr != nil {
+		err = er.E(errr)
+	}
+	if err != nil {
+		return 0, 0, er.Errorf("error running compaction: %v", err)
+	}
+
+	// Never replace the original with a copy which doesn't open cleanly.
+	if err := verifyDB(tempPath); err != nil {
+		return 0, 0, er.Errorf("compacted database is invalid: %v", err)
+	}
+	fi, errr = os.Stat(tempPath)
+	if errr != nil {
+		return 0, 0, er.E(errr)
+	}
+	newSize := fi.Size()
+
+	// Windows can't replace a file which is still open, so the lock has
+	// to be given up right before the swap there.
+	if runtime.GOOS == "windows" {
+		srcOpen = false
+		if errr := src.Close(); errr != nil {
+			return 0, 0, convertErr(errr)
+		}
+	}
+	if errr := os.Rename(tempPath, dbPath); errr != nil {
+		return 0, 0, er.Errorf("unable to swap in compacted database: "+
+			"%v", errr)
+	}
+
+	log.Infof("DB compaction of %v successful, %d -> %d bytes", dbPath,
+		initialSize, newSize)
+	return initialSize, newSize, nil
+}
+
+// verifyDB opens the database at dbPath read-only and runs bolt's consistency
+// check on it, returning the first inconsistency found.
+func verifyDB(dbPath string) er.R {
+	db, errr := bbolt.Open(dbPath, 0o600, &bbolt.Options{ReadOnly: true})
+	if errr != nil {
+		return er.E(errr)
+	}
+	defer db.Close()
+
+	return er.E(db.View(func(tx *bbolt.Tx) error {
+		// The check runs in a goroutine which only finishes once all
+		// errors have been received, so the channel must be drained.
+		var first error
+		for err := range tx.Check() {
+			if first == nil {
+				first = err
+			}
+		}
+		return first
+	}))
+}
+
+// compact copies all buckets, keys and values of src into the empty database
+// dst, committing every compactTxMaxSize bytes.
+func compact(dst, src *bbolt.DB) er.R {
+	var size int64
+	tx, errr := dst.Begin(true)
+	if errr != nil {
+		return er.E(errr)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	err := walk(src, func(keys [][]byte, k, v []byte, seq uint64) er.R {
+		sz := int64(len(k) + len(v))
+		if size+sz > compactTxMaxSize {
+			if errr := tx.Commit(); errr != nil {
+				return er.E(errr)
+			}
+			tx, errr = dst.Begin(true)
+			if errr != nil {
+				return er.E(errr)
+			}
+			size = 0
+		}
+		size += sz
+
+		// Top level buckets are created on the transaction.
+		if len(keys) == 0 {
+			bkt, errr := tx.CreateBucket(k)
+			if errr != nil {
+				return er.E(errr)
+			}
+			return er.E(bkt.SetSequence(seq))
+		}
+
+		b := tx.Bucket(keys[0])
+		for _, key := range keys[1:] {
+			b = b.Bucket(key)
+		}
+
+		// Data is only appended, so pages can be filled entirely.
+		b.FillPercent = 1.0
+
+		if v == nil {
+			bkt, errr := b.CreateBucket(k)
+			if errr != nil {
+				return er.E(errr)
+			}
+			return er.E(bkt.SetSequence(seq))
+		}
+		return er.E(b.Put(k, v))
+	})
+	if err != nil {
+		return err
+	}
+
+	return er.E(tx.Commit())
+}
+
+// walkFunc is called for every bucket (with a nil value) and key/value pair
+// found by walk.  keys is the path of buckets leading to the bucket owning k.
+type walkFunc func(keys [][]byte, k, v []byte, seq uint64) er.R
+
+// walk calls fn for every bucket and key/value pair of db, parents first.
+func walk(db *bbolt.DB, fn walkFunc) er.R {
+	return er.E(db.View(func(tx *bbolt.Tx) error {
+		return tx.ForEach(func(name []byte, b *bbolt.Bucket) error {
+			return er.Native(walkBucket(b, nil, name, nil,
+				b.Sequence(), fn))
+		})
+	}))
+}
+
+// walkBucket calls fn for k and, if k is the bucket b, recursively for all of
+// its contents.
+func walkBucket(b *bbolt.Bucket, keys [][]byte, k, v []byte, seq uint64,
+	fn walkFunc) er.R {
+
+	if err := fn(keys, k, v, seq); err != nil {
+		return err
+	}
+	if v != nil {
+		return nil
+	}
+
+	keys = append(keys, k)
+	return er.E(b.ForEach(func(k, v []byte) error {
+		if v == nil {
+			bkt := b.Bucket(k)
+			return er.Native(walkBucket(bkt, keys, k, nil,
+				bkt.Sequence(), fn))
+		}
+		return er.Native(walkBucket(b, keys, k, v, b.Sequence(), fn))
+	}))
+}
@@ -0,0 +1,125 @@
+package bdb_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"os"
+	"testing"
+
+	"github.com/pkt-cash/pktd/btcutil/er"
+
+	"github.com/pkt-cash/pktd/pktwallet/walletdb"
+	_ "github.com/pkt-cash/pktd/pktwallet/walletdb/bdb"
+)
+
+// TestCompact ensures that compacting a database after most of its data was
+// deleted shrinks it while keeping the remaining buckets, values and bucket
+// sequences, and that a database which is open can't be compacted.
+func TestCompact(t *testing.T) {
+	dbPath := "compacttest.db"
+	db, err := walletdb.Create(dbType, dbPath, true)
+	if err != nil {
+		t.Fatalf("Failed to create test database (%s) %v", dbType, err)
+	}
+	defer os.Remove(dbPath)
+
+	nsKey := []byte("ns")
+	nestedKey := []byte("nested")
+	value := bytes.Repeat([]byte{0xaa}, 1024)
+	key := func(i int) []byte {
+		var k [4]byte
+		binary.BigEndian.PutUint32(k[:], uint32(i))
+		return k[:]
+	}
+	err = walletdb.Update(db, func(tx walletdb.ReadWriteTx) er.R {
+		ns, err := tx.CreateTopLevelBucket(nsKey)
+		if err != nil {
+			return err
+		}
+		nested, err := ns.CreateBucket(nestedKey)
+		if err != nil {
+			return err
+		}
+		if err := nested.SetSequence(42); err != nil {
+			return err
+		}
+		for i := 0; i < 2000; i++ {
+			if err := nested.Put(key(i), value); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Update: unexpected error: %v", err)
+	}
+	err = walletdb.Update(db, func(tx walletdb.ReadWriteTx) er.R {
+		nested := tx.ReadWriteBucket(nsKey).NestedReadWriteBucket(
+			nestedKey,
+		)
+		for i := 10; i < 2000; i++ {
+			if err := nested.Delete(key(i)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Update: unexpected error: %v", err)
+	}
+
+	// The database holds an exclusive lock while it is open.
+	if _, _, err := walletdb.Compact(dbType, dbPath); err == nil {
+		t.Fatalf("Compact: expected an error for an open database")
+	}
+	if err := db.Close(); err != nil {
+		t.Fatalf("Close: unexpected error: %v", err)
+	}
+
+	before, after, err := walletdb.Compact(dbType, dbPath)
+	if err != nil {
+		t.Fatalf("Compact: unexpected error: %v", err)
+	}
+	if after >= before {
+		t.Fatalf("Compact: expected the database to shrink, got %d "+
+			"-> %d bytes", before, after)
+	}
+	if _, err := os.Stat(dbPath + ".compact"); !os.IsNotExist(err) {
+		t.Fatalf("Compact: temporary file was left behind")
+	}
+
+	db, err = walletdb.Open(dbType, dbPath, true)
+	if err != nil {
+		t.Fatalf("Open: unexpected error: %v", err)
+	}
+	defer db.Close()
+	err = walletdb.Update(db, func(tx walletdb.ReadWriteTx) er.R {
+		nested := tx.ReadWriteBucket(nsKey).NestedReadWriteBucket(
+			nestedKey,
+		)
+		if nested == nil {
+			t.Fatalf("nested bucket is missing")
+		}
+		if seq := nested.Sequence(); seq != 42 {
+			t.Fatalf("expected bucket sequence 42, got %d", seq)
+		}
+		count := 0
+		err := nested.ForEach(func(k, v []byte) er.R {
+			if !bytes.Equal(v, value) {
+				t.Fatalf("unexpected value for key %x", k)
+			}
+			count++
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		if count != 10 {
+			t.Fatalf("expected 10 values, got %d", count)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Update: unexpected error: %v", err)
+	}
+}
@@ -7,6 +7,7 @@ package bdb
 import (
 	"io"
 	"os"
+	"time"
 
 	"github.com/pkt-cash/pktd/btcutil/er"
 
@@ -409,3 +410,21 @@ func openDB(dbPath string, create, noFreeListSync bool) (walletdb.DB, er.R) {
 	boltDB, err := bbolt.Open(dbPath, 0o600, nil)
 	return (*db)(boltDB), convertErr(err)
 }
+
+// openReadOnlyDB opens the existing database at the provided path without
+// allowing any writes to it.  Since a database which is opened for writing is
+// locked exclusively, this fails after a second if the database is in use.
+func openReadOnlyDB(dbPath string) (walletdb.DB, er.R) {
+	if !fileExists(dbPath) {
+		return nil, walletdb.ErrDbDoesNotExist.Default()
+	}
+
+	boltDB, err := bbolt.Open(dbPath, 0o600, &bbolt.Options{
+		ReadOnly: true,
+		Timeout:  time.Second,
+	})
+	if err != nil {
+		return nil, convertErr(err)
+	}
+	return (*db)(boltDB), nil
+}
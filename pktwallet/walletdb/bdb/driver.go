@@ -44,12 +44,20 @@ func createDBDriver(dbPath string, noFreeListSync bool) (walletdb.DB, er.R) {
 	return openDB(dbPath, true, noFreeListSync)
 }
 
+// openReadOnlyDBDriver is the callback provided during driver registration
+// that opens an existing database without allowing writes to it.
+func openReadOnlyDBDriver(dbPath string) (walletdb.DB, er.R) {
+	return openReadOnlyDB(dbPath)
+}
+
 func init() {
 	// Register the driver.
 	driver := walletdb.Driver{
-		DbType: dbType,
-		Create: createDBDriver,
-		Open:   openDBDriver,
+		DbType:       dbType,
+		Create:       createDBDriver,
+		Open:         openDBDriver,
+		OpenReadOnly: openReadOnlyDBDriver,
+		Compact:      compactDB,
 	}
 	if err := walletdb.RegisterDriver(driver); err != nil {
 		panic(fmt.Sprintf("Failed to regiser database driver '%s': %v",
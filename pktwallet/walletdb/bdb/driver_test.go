@@ -122,3 +122,66 @@ func TestPersistence(t *testing.T) {
 		return
 	}
 }
+
+// TestOpenReadOnly ensures that a database opened read-only can be read from
+// but not written to.
+func TestOpenReadOnly(t *testing.T) {
+	wantErr := walletdb.ErrDbDoesNotExist.Default()
+	if _, err := walletdb.OpenReadOnly(dbType, "noexist.db"); !er.Equals(err, wantErr) {
+		t.Errorf("OpenReadOnly: did not receive expected error - got %v, "+
+			"want %v", err, wantErr)
+		return
+	}
+
+	dbPath := "readonlytest.db"
+	db, err := walletdb.Create(dbType, dbPath, true)
+	if err != nil {
+		t.Errorf("Failed to create test database (%s) %v", dbType, err)
+		return
+	}
+	defer os.Remove(dbPath)
+
+	ns1Key := []byte("ns1")
+	err = walletdb.Update(db, func(tx walletdb.ReadWriteTx) er.R {
+		ns1, err := tx.CreateTopLevelBucket(ns1Key)
+		if err != nil {
+			return err
+		}
+		return ns1.Put([]byte("ns1key1"), []byte("foo1"))
+	})
+	if err != nil {
+		t.Errorf("Update: unexpected error: %v", err)
+		return
+	}
+	db.Close()
+
+	db, err = walletdb.OpenReadOnly(dbType, dbPath)
+	if err != nil {
+		t.Errorf("OpenReadOnly: unexpected error: %v", err)
+		return
+	}
+	defer db.Close()
+
+	err = walletdb.View(db, func(tx walletdb.ReadTx) er.R {
+		ns1 := tx.ReadBucket(ns1Key)
+		if ns1 == nil {
+			return er.Errorf("ReadBucket: unexpected nil bucket")
+		}
+		if v := ns1.Get([]byte("ns1key1")); string(v) != "foo1" {
+			return er.Errorf("Get: key 'ns1key1' does not match "+
+				"expected value - got %s, want foo1", v)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Errorf("View: unexpected error: %v", err)
+		return
+	}
+
+	err = walletdb.Update(db, func(tx walletdb.ReadWriteTx) er.R {
+		return tx.ReadWriteBucket(ns1Key).Put([]byte("ns1key2"), []byte("foo2"))
+	})
+	if err == nil {
+		t.Errorf("Update: unexpected success on read-only database")
+	}
+}
@@ -38,6 +38,16 @@ var (
 	// ErrInvalid is returned if the specified database is not valid.
 	ErrInvalid = Err.CodeWithDetail("ErrInvalid",
 		"invalid database")
+
+	// ErrDbReadOnlyNotSupported is returned when a read-only open is called
+	// for a database type whose driver can't open read-only databases.
+	ErrDbReadOnlyNotSupported = Err.CodeWithDetail("ErrDbReadOnlyNotSupported",
+		"database type does not support read-only access")
+
+	// ErrDbCompactNotSupported is returned when compact is called for a
+	// database type whose driver can't compact databases.
+	ErrDbCompactNotSupported = Err.CodeWithDetail("ErrDbCompactNotSupported",
+		"database type does not support compaction")
 )
 
 // Errors that can occur when beginning or committing a transaction.
@@ -307,6 +307,17 @@ type Driver struct {
 	// arguments to open the database.  This function must return
 	// ErrDbDoesNotExist if the database has not already been created.
 	Open func(path string, noFreeListSync bool) (DB, er.R)
+
+	// OpenReadOnly is the function that will be invoked to open an existing
+	// database which can only be read from.  It is nil if the driver does
+	// not support read-only databases.
+	OpenReadOnly func(path string) (DB, er.R)
+
+	// Compact is the function that will be invoked to compact the closed
+	// database at the given path in place, returning the size of the
+	// database before and after the compaction.  It is nil if the driver
+	// does not support compaction.
+	Compact func(path string) (int64, int64, er.R)
 }
 
 // driverList holds all of the registered database backends.
@@ -361,3 +372,39 @@ func Open(dbType, path string, noFreeListSync bool) (DB, er.R) {
 
 	return drv.Open(path, noFreeListSync)
 }
+
+// OpenReadOnly opens an existing database of the specified type which can
+// only be read from, any attempt to update it fails.
+//
+// ErrDbUnknownType will be returned if the the database type is not registered
+// and ErrDbReadOnlyNotSupported if the driver can't open read-only databases.
+func OpenReadOnly(dbType, path string) (DB, er.R) {
+	drv, exists := drivers[dbType]
+	if !exists {
+		return nil, ErrDbUnknownType.Default()
+	}
+	if drv.OpenReadOnly == nil {
+		return nil, ErrDbReadOnlyNotSupported.Default()
+	}
+
+	return drv.OpenReadOnly(path)
+}
+
+// Compact rewrites the closed database of the specified type at the given path
+// to reclaim the space left behind by deleted data, and returns the size of
+// the database before and after the compaction.  The database must not be
+// open while it is compacted.
+//
+// ErrDbUnknownType will be returned if the the database type is not registered
+// and ErrDbCompactNotSupported if the driver can't compact databases.
+func Compact(dbType, path string) (int64, int64, er.R) {
+	drv, exists := drivers[dbType]
+	if !exists {
+		return 0, 0, ErrDbUnknownType.Default()
+	}
+	if drv.Compact == nil {
+		return 0, 0, ErrDbCompactNotSupported.Default()
+	}
+
+	return drv.Compact(path)
+}
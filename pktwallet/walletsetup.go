@@ -11,6 +11,7 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"strconv"
 	"time"
 
 	jsoniter "github.com/json-iterator/go"
@@ -29,6 +30,7 @@ import (
 	"github.com/pkt-cash/pktd/pktwallet/wallet/seedwords"
 	"github.com/pkt-cash/pktd/pktwallet/walletdb"
 	_ "github.com/pkt-cash/pktd/pktwallet/walletdb/bdb"
+	"github.com/pkt-cash/pktd/txscript/params"
 )
 
 // networkDir returns the directory name of a network directory to hold wallet
@@ -110,6 +112,26 @@ type WalletSetupCfg struct {
 	PublicPassphrase *string `json:"viewpassphrase"`
 	Seed             *string `json:"seed"`
 	SeedPassphrase   *string `json:"seedpassphrase"`
+	Birthday         *string `json:"birthday"`
+}
+
+// parseBirthday parses the birthday of a restored wallet, which is either a
+// date, a unix timestamp or, if it is below the lock time threshold, a block
+// height.  The height is -1 if a time is given.
+func parseBirthday(birthday string) (time.Time, int32, er.R) {
+	if t, errr := time.Parse("2006-01-02", birthday); errr == nil {
+		return t, -1, nil
+	}
+	n, errr := strconv.ParseInt(birthday, 10, 64)
+	if errr != nil || n < 0 {
+		return time.Time{}, -1, er.Errorf("invalid birthday [%s], "+
+			"expecting a date (YYYY-MM-DD), a unix timestamp or a "+
+			"block height", birthday)
+	}
+	if n < params.LockTimeThreshold {
+		return time.Time{}, int32(n), nil
+	}
+	return time.Unix(n, 0), -1, nil
 }
 
 // createWallet prompts the user for information needed to generate a new wallet
@@ -119,6 +141,11 @@ func createWallet(cfg *config) er.R {
 	dbDir := networkDir(cfg.AppDataDir.Value, activeNet.Params)
 	// TODO(cjd): noFreelistSync ?
 	loader := wallet.NewLoader(activeNet.Params, dbDir, cfg.Wallet, false, 250)
+	loader.SetDbDriver(cfg.DbDriver)
+	loader.SetAddressReusePolicy(cfg.addressReusePolicy)
+	loader.SetChangeType(cfg.changeType)
+	loader.SetMaxWatchedAddrs(cfg.MaxWatchedAddrs)
+	loader.SetReorgSafetyDepth(cfg.ReorgSafetyDepth)
 
 	// When there is a legacy keystore, open it now to ensure any errors
 	// don't end up exiting the process after the user has spent time
@@ -150,6 +177,7 @@ func createWallet(cfg *config) er.R {
 	var seedInput []byte
 	var seed *seedwords.Seed
 	setupCfg := WalletSetupCfg{}
+	birthday := cfg.Birthday
 	if (fi.Mode() & os.ModeCharDevice) != 0 {
 		tty = true
 	} else if bytes, err := ioutil.ReadAll(os.Stdin); err != nil {
@@ -163,6 +191,9 @@ func createWallet(cfg *config) er.R {
 		if setupCfg.PublicPassphrase != nil {
 			pubPass = []byte(*setupCfg.PublicPassphrase)
 		}
+		if setupCfg.Birthday != nil {
+			birthday = *setupCfg.Birthday
+		}
 		if setupCfg.Seed != nil {
 			if decoded, err := hex.DecodeString(*setupCfg.Seed); err == nil {
 				zero.Bytes(decoded)
@@ -275,13 +306,30 @@ func createWallet(cfg *config) er.R {
 		seed = sd
 	}
 
+	// Without a birthday, the wallet takes the one of the seed, or scans
+	// from genesis if the seed has none.
+	seedBirthday := time.Time{}
+	birthdayHeight := int32(-1)
+	if birthday != "" {
+		t, height, err := parseBirthday(birthday)
+		if err != nil {
+			return err
+		}
+		seedBirthday, birthdayHeight = t, height
+	}
+
 	if tty {
 		fmt.Println("Creating the wallet...")
 	}
-	w, werr := loader.CreateNewWallet(pubPass, privPass, seedInput, time.Now(), seed)
+	w, werr := loader.CreateNewWallet(pubPass, privPass, seedInput, seedBirthday, seed)
 	if werr != nil {
 		return werr
 	}
+	if birthdayHeight >= 0 {
+		if err := w.SetBirthdayHeight(birthdayHeight); err != nil {
+			return err
+		}
+	}
 
 	w.Manager.Close()
 	if tty {
@@ -303,6 +351,27 @@ func createWallet(cfg *config) er.R {
 	return nil
 }
 
+// checkWalletDB opens the wallet database at dbPath read-only and prints each
+// inconsistency found in it, returning the number of them.
+func checkWalletDB(cfg *config, dbPath string) (int, er.R) {
+	db, err := walletdb.OpenReadOnly(cfg.DbDriver, dbPath)
+	if err != nil {
+		return 0, err
+	}
+	defer db.Close()
+
+	problems, err := wallet.CheckDB(db)
+	if err != nil {
+		return 0, err
+	}
+	for _, problem := range problems {
+		fmt.Println(problem)
+	}
+	fmt.Printf("Checked wallet database %s: %d inconsistencies found\n",
+		dbPath, len(problems))
+	return len(problems), nil
+}
+
 // createSimulationWallet is intended to be called from the rpcclient
 // and used to create a wallet for actors involved in simulations.
 func createSimulationWallet(cfg *config) er.R {
@@ -318,8 +387,8 @@ func createSimulationWallet(cfg *config) er.R {
 	dbPath := wallet.WalletDbPath(netDir, cfg.Wallet)
 	fmt.Println("Creating the wallet...")
 
-	// Create the wallet database backed by bolt db.
-	db, err := walletdb.Create("bdb", dbPath, false)
+	// Create the wallet database using the configured driver.
+	db, err := walletdb.Create(cfg.DbDriver, dbPath, false)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,111 @@
+package wtxmgr
+
+import (
+	"fmt"
+
+	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/chaincfg/chainhash"
+	"github.com/pkt-cash/pktd/pktwallet/walletdb"
+	"github.com/pkt-cash/pktd/wire"
+)
+
+// Check verifies the structural invariants of the transaction store: every
+// unspent output and every credit references a known transaction, no credit
+// is left unspent without an unspent output entry, and unmined credits and
+// inputs reference known unmined transactions.  It only reads from ns and
+// returns a description of each inconsistency found, an error is returned
+// only if the store could not be read.
+func Check(ns walletdb.ReadBucket) ([]string, er.R) {
+	var problems []string
+	report := func(format string, args ...interface{}) {
+		problems = append(problems, fmt.Sprintf(format, args...))
+	}
+
+	err := ns.NestedReadBucket(bucketUnspent).ForEach(func(k, v []byte) er.R {
+		var op wire.OutPoint
+		if err := readCanonicalOutPoint(k, &op); err != nil {
+			report("unspent output with malformed key %x", k)
+			return nil
+		}
+		credKey := existsRawUnspent(ns, k)
+		if credKey == nil {
+			report("unspent output %v has a malformed value", op)
+			return nil
+		}
+		if existsRawCredit(ns, credKey) == nil {
+			report("unspent output %v has no credit", op)
+		}
+		if existsRawTxRecord(ns, extractRawCreditTxRecordKey(credKey)) == nil {
+			report("unspent output %v references an unknown "+
+				"transaction", op)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = ns.NestedReadBucket(bucketCredits).ForEach(func(k, v []byte) er.R {
+		if len(k) < 72 || len(v) < 9 {
+			report("malformed credit %x", k)
+			return nil
+		}
+		var op wire.OutPoint
+		copy(op.Hash[:], k[:32])
+		op.Index = extractRawCreditIndex(k)
+		if existsRawTxRecord(ns, extractRawCreditTxRecordKey(k)) == nil {
+			report("credit %v references an unknown transaction", op)
+		}
+		if v[8]&(1<<0) != 0 {
+			return nil
+		}
+		if _, credKey := existsUnspent(ns, &op); credKey == nil {
+			report("credit %v is unspent but has no unspent "+
+				"output", op)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = ns.NestedReadBucket(bucketUnminedCredits).ForEach(func(k, v []byte) er.R {
+		var op wire.OutPoint
+		if err := readCanonicalOutPoint(k, &op); err != nil {
+			report("unmined credit with malformed key %x", k)
+			return nil
+		}
+		if existsRawUnmined(ns, op.Hash[:]) == nil {
+			report("unmined credit %v references an unknown "+
+				"unmined transaction", op)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = ns.NestedReadBucket(bucketUnminedInputs).ForEach(func(k, v []byte) er.R {
+		var op wire.OutPoint
+		if err := readCanonicalOutPoint(k, &op); err != nil {
+			report("unmined input with malformed key %x", k)
+			return nil
+		}
+		if len(v)%chainhash.HashSize != 0 {
+			report("unmined input %v has a malformed value", op)
+			return nil
+		}
+		for _, spender := range fetchUnminedInputSpendTxHashes(ns, k) {
+			if existsRawUnmined(ns, spender[:]) == nil {
+				report("output %v is spent by unknown unmined "+
+					"transaction %v", op, spender)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return problems, nil
+}
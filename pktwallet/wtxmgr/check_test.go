@@ -0,0 +1,80 @@
+package wtxmgr
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pkt-cash/pktd/pktwallet/walletdb"
+)
+
+// TestCheck tests that a consistent store passes the check and that credits
+// and unspent outputs left behind by missing transactions are reported.
+func TestCheck(t *testing.T) {
+	t.Parallel()
+
+	store, db, teardown, err := testStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer teardown()
+
+	// Record a mined coinbase credit and an unmined transaction which
+	// spends it to a new credit.
+	b100 := &BlockMeta{
+		Block: Block{Height: 100},
+		Time:  time.Now(),
+	}
+	cb := newCoinBase(1e8)
+	cbRec, err := NewTxRecordFromMsgTx(cb, b100.Time)
+	if err != nil {
+		t.Fatal(err)
+	}
+	spend := spendOutput(&cbRec.Hash, 0, 5e7)
+	spendRec, err := NewTxRecordFromMsgTx(spend, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	commitDBTx(t, store, db, func(ns walletdb.ReadWriteBucket) {
+		if err := store.InsertTx(ns, cbRec, b100); err != nil {
+			t.Fatal(err)
+		}
+		if err := store.AddCredit(ns, cbRec, b100, 0, false); err != nil {
+			t.Fatal(err)
+		}
+		if err := store.InsertTx(ns, spendRec, nil); err != nil {
+			t.Fatal(err)
+		}
+		if err := store.AddCredit(ns, spendRec, nil, 0, false); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	checkProblems := func(expected int) {
+		t.Helper()
+
+		commitDBTx(t, store, db, func(ns walletdb.ReadWriteBucket) {
+			problems, err := Check(ns)
+			if err != nil {
+				t.Fatalf("unable to check store: %v", err)
+			}
+			if len(problems) != expected {
+				t.Fatalf("expected %d problems, got %d: %v",
+					expected, len(problems), problems)
+			}
+		})
+	}
+	checkProblems(0)
+
+	// Dropping both transactions orphans the unspent output and the
+	// credit of the coinbase, the unmined credit and the unmined input.
+	commitDBTx(t, store, db, func(ns walletdb.ReadWriteBucket) {
+		err := deleteTxRecord(ns, &cbRec.Hash, &b100.Block)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := deleteRawUnmined(ns, spendRec.Hash[:]); err != nil {
+			t.Fatal(err)
+		}
+	})
+	checkProblems(4)
+}
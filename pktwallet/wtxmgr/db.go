@@ -72,6 +72,8 @@ var (
 	bucketUnminedCredits = []byte("mc")
 	bucketUnminedInputs  = []byte("mi")
 	bucketLockedOutputs  = []byte("lo")
+	bucketFeeRecords     = []byte("fr")
+	bucketAbandonedTxs   = []byte("ab")
 )
 
 // Root (namespace) bucket keys
@@ -0,0 +1,172 @@
+package wtxmgr
+
+import (
+	"time"
+
+	"github.com/pkt-cash/pktd/btcutil"
+	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/chaincfg/chainhash"
+	"github.com/pkt-cash/pktd/pktwallet/walletdb"
+)
+
+// ErrFeeRecordNotFound is returned when no fee record is stored for a
+// transaction hash.
+var ErrFeeRecordNotFound = Err.CodeWithDetail("ErrFeeRecordNotFound",
+	"fee record for transaction not found")
+
+// FeeRecord records the fee rate paid by a transaction which was broadcast by
+// the wallet and how many blocks it took for the transaction to confirm.
+type FeeRecord struct {
+	Hash chainhash.Hash
+
+	// Fee is the fee paid by the transaction and VSize its virtual size
+	// in bytes.
+	Fee   btcutil.Amount
+	VSize uint32
+
+	// Broadcast is the time the transaction was first broadcast and
+	// BroadcastHeight the height the wallet was synced to at the time.
+	Broadcast       time.Time
+	BroadcastHeight int32
+
+	// ConfirmHeight is the height of the block the transaction was mined
+	// in, or -1 while it is unconfirmed.
+	ConfirmHeight int32
+}
+
+// FeeRate returns the fee rate paid by the transaction per 1000 bytes of
+// virtual size.
+func (r *FeeRecord) FeeRate() btcutil.Amount {
+	if r.VSize == 0 {
+		return 0
+	}
+	return r.Fee * 1000 / btcutil.Amount(r.VSize)
+}
+
+// Confirmed returns whether the transaction has been mined.
+func (r *FeeRecord) Confirmed() bool {
+	return r.ConfirmHeight >= 0
+}
+
+// ConfirmationDelay returns the number of blocks between the broadcast of the
+// transaction and its confirmation, a transaction mined in the block following
+// the broadcast has a delay of 1.
+func (r *FeeRecord) ConfirmationDelay() int32 {
+	if !r.Confirmed() {
+		return 0
+	}
+	return r.ConfirmHeight - r.BroadcastHeight
+}
+
+// The fee record is keyed by the transaction hash:
+// [0:32] Transaction hash (32 bytes)
+//
+// The value is serialized as such:
+// [0:8] Fee (8 bytes)
+// [8:12] Virtual size (4 bytes)
+// [12:20] Broadcast time, unix seconds (8 bytes)
+// [20:24] Broadcast height (4 bytes)
+// [24:28] Confirm height, or -1 if unconfirmed (4 bytes)
+const feeRecordSize = 28
+
+func valueFeeRecord(r *FeeRecord) []byte {
+	v := make([]byte, feeRecordSize)
+	byteOrder.PutUint64(v[0:8], uint64(r.Fee))
+	byteOrder.PutUint32(v[8:12], r.VSize)
+	byteOrder.PutUint64(v[12:20], uint64(r.Broadcast.Unix()))
+	byteOrder.PutUint32(v[20:24], uint32(r.BroadcastHeight))
+	byteOrder.PutUint32(v[24:28], uint32(r.ConfirmHeight))
+	return v
+}
+
+func readFeeRecord(k, v []byte, r *FeeRecord) er.R {
+	if len(k) != 32 || len(v) != feeRecordSize {
+		return storeError(ErrData, "bad fee record", nil)
+	}
+	copy(r.Hash[:], k)
+	r.Fee = btcutil.Amount(byteOrder.Uint64(v[0:8]))
+	r.VSize = byteOrder.Uint32(v[8:12])
+	r.Broadcast = time.Unix(int64(byteOrder.Uint64(v[12:20])), 0)
+	r.BroadcastHeight = int32(byteOrder.Uint32(v[20:24]))
+	r.ConfirmHeight = int32(byteOrder.Uint32(v[24:28]))
+	return nil
+}
+
+// PutFeeRecord writes the fee record of a transaction broadcast by the
+// wallet, replacing any existing record for the transaction.
+func (s *Store) PutFeeRecord(ns walletdb.ReadWriteBucket, r *FeeRecord) er.R {
+	feeBucket, err := ns.CreateBucketIfNotExists(bucketFeeRecords)
+	if err != nil {
+		return err
+	}
+	return feeBucket.Put(r.Hash[:], valueFeeRecord(r))
+}
+
+// FetchFeeRecord reads the fee record of a transaction, ErrFeeRecordNotFound
+// is returned if no record exists.
+func (s *Store) FetchFeeRecord(ns walletdb.ReadBucket,
+	txid *chainhash.Hash) (*FeeRecord, er.R) {
+	feeBucket := ns.NestedReadBucket(bucketFeeRecords)
+	if feeBucket == nil {
+		return nil, ErrFeeRecordNotFound.Default()
+	}
+	v := feeBucket.Get(txid[:])
+	if v == nil {
+		return nil, ErrFeeRecordNotFound.Default()
+	}
+	var r FeeRecord
+	if err := readFeeRecord(txid[:], v, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// DeleteFeeRecord removes the fee record of a transaction, if one exists.
+func (s *Store) DeleteFeeRecord(ns walletdb.ReadWriteBucket,
+	txid *chainhash.Hash) er.R {
+	feeBucket := ns.NestedReadWriteBucket(bucketFeeRecords)
+	if feeBucket == nil {
+		return nil
+	}
+	return feeBucket.Delete(txid[:])
+}
+
+// ForEachFeeRecord calls f with every fee record in undefined order.
+func (s *Store) ForEachFeeRecord(ns walletdb.ReadBucket,
+	f func(*FeeRecord) er.R) er.R {
+	feeBucket := ns.NestedReadBucket(bucketFeeRecords)
+	if feeBucket == nil {
+		return nil
+	}
+	return feeBucket.ForEach(func(k, v []byte) er.R {
+		var r FeeRecord
+		if err := readFeeRecord(k, v, &r); err != nil {
+			return err
+		}
+		return f(&r)
+	})
+}
+
+// setFeeRecordConfirmHeight updates the confirm height of the fee record of a
+// transaction when it is mined or rolled back, transactions which were not
+// broadcast by the wallet have no record and are left alone.
+func setFeeRecordConfirmHeight(ns walletdb.ReadWriteBucket,
+	txid *chainhash.Hash, height int32) er.R {
+	feeBucket := ns.NestedReadWriteBucket(bucketFeeRecords)
+	if feeBucket == nil {
+		return nil
+	}
+	v := feeBucket.Get(txid[:])
+	if v == nil {
+		return nil
+	}
+	var r FeeRecord
+	if err := readFeeRecord(txid[:], v, &r); err != nil {
+		return err
+	}
+	if r.ConfirmHeight == height {
+		return nil
+	}
+	r.ConfirmHeight = height
+	return feeBucket.Put(txid[:], valueFeeRecord(&r))
+}
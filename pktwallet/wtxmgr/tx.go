@@ -63,6 +63,20 @@ var (
 	// is attempted with a different ID than the one which locked it.
 	ErrOutputUnlockNotAllowed = Err.CodeWithDetail("ErrOutputUnlockNotAllowed",
 		"output unlock not allowed")
+
+	// ErrUnknownTx is returned when a transaction not known to the wallet
+	// is attempted to be abandoned.
+	ErrUnknownTx = Err.CodeWithDetail("ErrUnknownTx", "unknown transaction")
+
+	// ErrTxConfirmed is returned when a mined transaction is attempted to
+	// be abandoned.
+	ErrTxConfirmed = Err.CodeWithDetail("ErrTxConfirmed",
+		"transaction is confirmed")
+
+	// ErrTxAbandoned is returned when a transaction which was abandoned
+	// already is attempted to be abandoned again.
+	ErrTxAbandoned = Err.CodeWithDetail("ErrTxAbandoned",
+		"transaction is already abandoned")
 )
 
 // Block contains the minimum amount of data to uniquely identify any block on
@@ -392,12 +406,23 @@ func (s *Store) insertMinedTx(ns walletdb.ReadWriteBucket, rec *TxRecord,
 		return err
 	}
 
+	// If the wallet broadcast this transaction, record when it confirmed.
+	if err := setFeeRecordConfirmHeight(ns, &rec.Hash, block.Height); err != nil {
+		return err
+	}
+
 	// Determine if this transaction has affected our balance, and if so,
 	// update it.
 	if err := s.updateMinedBalance(ns, rec, block); err != nil {
 		return err
 	}
 
+	// An abandoned transaction which is mined after all is no longer
+	// abandoned.
+	if err := unabandonTx(ns, &rec.Hash); err != nil {
+		return err
+	}
+
 	// If this transaction previously existed within the store as unmined,
 	// we'll need to remove it from the unmined bucket.
 	if v := existsRawUnmined(ns, rec.Hash[:]); v != nil {
@@ -520,6 +545,9 @@ func rollbackTransaction(
 	if err = deleteTxRecord(ns, txHash, block); err != nil {
 		return
 	}
+	if err = setFeeRecordConfirmHeight(ns, txHash, -1); err != nil {
+		return
+	}
 
 	// Handle coinbase transactions specially since they are
 	// not moved to the unconfirmed store.  A coinbase cannot
@@ -757,6 +785,26 @@ func (s *Store) ForEachUnspentOutput(
 	ns walletdb.ReadBucket,
 	beginKey []byte,
 	visitor func(key []byte, c *Credit) er.R,
+) er.R {
+	return s.forEachUnspentOutput(ns, beginKey, false, visitor)
+}
+
+// ForEachUnspentOutputInclLeased is like ForEachUnspentOutput, except that
+// outputs which are locked through LockOutput are visited too. OutputLease
+// tells them apart.
+func (s *Store) ForEachUnspentOutputInclLeased(
+	ns walletdb.ReadBucket,
+	beginKey []byte,
+	visitor func(key []byte, c *Credit) er.R,
+) er.R {
+	return s.forEachUnspentOutput(ns, beginKey, true, visitor)
+}
+
+func (s *Store) forEachUnspentOutput(
+	ns walletdb.ReadBucket,
+	beginKey []byte,
+	inclLeased bool,
+	visitor func(key []byte, c *Credit) er.R,
 ) er.R {
 	var op wire.OutPoint
 	var block Block
@@ -771,7 +819,7 @@ func (s *Store) ForEachUnspentOutput(
 
 		// Skip the output if it's locked.
 		_, _, isLocked := isLockedOutput(ns, op, s.clock.Now())
-		if isLocked {
+		if isLocked && !inclLeased {
 			return nil
 		}
 
@@ -843,7 +891,7 @@ func (s *Store) ForEachUnspentOutput(
 
 		// Skip the output if it's locked.
 		_, _, isLocked := isLockedOutput(ns, op, s.clock.Now())
-		if isLocked {
+		if isLocked && !inclLeased {
 			return nil
 		}
 
@@ -1040,6 +1088,15 @@ func (s *Store) LockOutput(ns walletdb.ReadWriteBucket, id LockID,
 	return expiry, nil
 }
 
+// OutputLease returns the ID an output is locked to through LockOutput and the
+// absolute time of the lock's expiration. The last return value is false if
+// the output isn't locked.
+func (s *Store) OutputLease(ns walletdb.ReadBucket,
+	op wire.OutPoint) (LockID, time.Time, bool) {
+
+	return isLockedOutput(ns, op, s.clock.Now())
+}
+
 // UnlockOutput unlocks an output, allowing it to be available for coin
 // selection if it remains unspent. The ID should match the one used to
 // originally lock the output.
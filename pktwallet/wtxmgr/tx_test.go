@@ -2248,6 +2248,211 @@ func TestTxLabel(t *testing.T) {
 	}
 }
 
+// TestFeeRecords tests that the fee record of a broadcast transaction is
+// marked confirmed when the transaction is mined and unconfirmed again when
+// its block is rolled back.
+func TestFeeRecords(t *testing.T) {
+	t.Parallel()
+
+	store, db, teardown, err := testStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer teardown()
+
+	rec, err := NewTxRecord(TstSpendingSerializedTx, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	block := TstSignedTxBlockDetails
+
+	// fetchRecord reads the fee record of the transaction.
+	fetchRecord := func() *FeeRecord {
+		t.Helper()
+
+		var feeRec *FeeRecord
+		commitDBTx(t, store, db, func(ns walletdb.ReadWriteBucket) {
+			var err er.R
+			feeRec, err = store.FetchFeeRecord(ns, &rec.Hash)
+			if err != nil {
+				t.Fatal(err)
+			}
+		})
+		return feeRec
+	}
+
+	commitDBTx(t, store, db, func(ns walletdb.ReadWriteBucket) {
+		_, err := store.FetchFeeRecord(ns, &rec.Hash)
+		if !ErrFeeRecordNotFound.Is(err) {
+			t.Fatalf("expected: %v, got: %v", ErrFeeRecordNotFound, err)
+		}
+
+		if err := store.InsertTx(ns, rec, nil); err != nil {
+			t.Fatal(err)
+		}
+		err = store.PutFeeRecord(ns, &FeeRecord{
+			Hash:            rec.Hash,
+			Fee:             10000,
+			VSize:           500,
+			Broadcast:       time.Unix(1389113000, 0),
+			BroadcastHeight: block.Height - 3,
+			ConfirmHeight:   -1,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	feeRec := fetchRecord()
+	if feeRec.Confirmed() {
+		t.Fatalf("expected unconfirmed fee record")
+	}
+	if feeRec.FeeRate() != 20000 {
+		t.Fatalf("expected fee rate 20000, got %v", int64(feeRec.FeeRate()))
+	}
+	if feeRec.Broadcast.Unix() != 1389113000 {
+		t.Fatalf("unexpected broadcast time %v", feeRec.Broadcast)
+	}
+
+	// Mining the transaction sets the confirmation delay.
+	commitDBTx(t, store, db, func(ns walletdb.ReadWriteBucket) {
+		if err := store.InsertTx(ns, rec, block); err != nil {
+			t.Fatal(err)
+		}
+	})
+	feeRec = fetchRecord()
+	if !feeRec.Confirmed() || feeRec.ConfirmationDelay() != 3 {
+		t.Fatalf("expected confirmation delay 3, got confirmed=%v "+
+			"delay=%d", feeRec.Confirmed(), feeRec.ConfirmationDelay())
+	}
+
+	// Rolling back the block makes the transaction unconfirmed again.
+	commitDBTx(t, store, db, func(ns walletdb.ReadWriteBucket) {
+		if err := store.RollbackOne(ns, block.Height); err != nil {
+			t.Fatal(err)
+		}
+	})
+	if fetchRecord().Confirmed() {
+		t.Fatalf("expected unconfirmed fee record after rollback")
+	}
+
+	commitDBTx(t, store, db, func(ns walletdb.ReadWriteBucket) {
+		var n int
+		err := store.ForEachFeeRecord(ns, func(r *FeeRecord) er.R {
+			if r.Hash != rec.Hash {
+				t.Fatalf("unexpected fee record %v", r.Hash)
+			}
+			n++
+			return nil
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if n != 1 {
+			t.Fatalf("expected 1 fee record, got %d", n)
+		}
+
+		if err := store.DeleteFeeRecord(ns, &rec.Hash); err != nil {
+			t.Fatal(err)
+		}
+		_, err = store.FetchFeeRecord(ns, &rec.Hash)
+		if !ErrFeeRecordNotFound.Is(err) {
+			t.Fatalf("expected: %v, got: %v", ErrFeeRecordNotFound, err)
+		}
+	})
+}
+
+// TestAbandonTx tests that abandoning an unmined transaction makes the outputs
+// it spends unspent again, and that mined and already abandoned transactions
+// can't be abandoned.
+func TestAbandonTx(t *testing.T) {
+	t.Parallel()
+
+	store, db, teardown, err := testStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer teardown()
+
+	recvRec, err := NewTxRecord(TstRecvSerializedTx, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	spendRec, err := NewTxRecord(TstSpendingSerializedTx, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	recvOp := wire.OutPoint{Hash: recvRec.Hash, Index: 0}
+
+	// assertSpent checks that the received output is spent.
+	assertSpent := func(ns walletdb.ReadWriteBucket) {
+		t.Helper()
+
+		utxos, err := store.GetUnspentOutputs(ns)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(utxos) != 0 {
+			t.Fatalf("expected no utxos, got %v", utxos)
+		}
+	}
+
+	commitDBTx(t, store, db, func(ns walletdb.ReadWriteBucket) {
+		err := store.InsertTx(ns, recvRec, TstRecvTxBlockDetails)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = store.AddCredit(ns, recvRec, TstRecvTxBlockDetails, 0, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := store.InsertTx(ns, spendRec, nil); err != nil {
+			t.Fatal(err)
+		}
+		assertSpent(ns)
+
+		if err := store.AbandonTx(ns, &spendRec.Hash); err != nil {
+			t.Fatal(err)
+		}
+		if !store.IsAbandoned(ns, &spendRec.Hash) {
+			t.Fatalf("expected transaction to be abandoned")
+		}
+		if existsRawUnmined(ns, spendRec.Hash[:]) != nil {
+			t.Fatalf("expected abandoned transaction to be removed " +
+				"from the unconfirmed bucket")
+		}
+		assertUtxos(t, store, ns, []wire.OutPoint{recvOp})
+
+		err = store.AbandonTx(ns, &spendRec.Hash)
+		if !ErrTxAbandoned.Is(err) {
+			t.Fatalf("expected: %v, got: %v", ErrTxAbandoned, err)
+		}
+		err = store.AbandonTx(ns, &chainhash.Hash{})
+		if !ErrUnknownTx.Is(err) {
+			t.Fatalf("expected: %v, got: %v", ErrUnknownTx, err)
+		}
+
+		// The transaction is no longer abandoned once it shows up
+		// again, and can't be abandoned after it is mined.
+		if err := store.InsertTx(ns, spendRec, nil); err != nil {
+			t.Fatal(err)
+		}
+		if store.IsAbandoned(ns, &spendRec.Hash) {
+			t.Fatalf("expected transaction to not be abandoned")
+		}
+		assertSpent(ns)
+
+		err = store.InsertTx(ns, spendRec, TstSignedTxBlockDetails)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = store.AbandonTx(ns, &spendRec.Hash)
+		if !ErrTxConfirmed.Is(err) {
+			t.Fatalf("expected: %v, got: %v", ErrTxConfirmed, err)
+		}
+	})
+}
+
 func assertBalance(t *testing.T, s *Store, ns walletdb.ReadWriteBucket,
 	confirmed bool, blockHeight int32, exp btcutil.Amount) {
 	t.Helper()
@@ -2595,6 +2800,42 @@ func TestOutputLocks(t *testing.T) {
 				})
 			},
 		},
+		{
+			// Asserts that locked outputs are visited when leased
+			// outputs are requested, along with their lease.
+			name: "utxos including leased outputs",
+			run: func(t *testing.T, s *Store, ns walletdb.ReadWriteBucket) {
+				lockID := LockID{1}
+				expiry := lock(
+					t, s, ns, lockID, unconfirmedOutPoint, nil,
+				)
+
+				leased := make(map[wire.OutPoint]bool)
+				err := s.ForEachUnspentOutputInclLeased(ns, nil,
+					func(_ []byte, c *Credit) er.R {
+						id, exp, ok := s.OutputLease(
+							ns, c.OutPoint,
+						)
+						if ok && (id != lockID ||
+							exp.Unix() != expiry.Unix()) {
+
+							t.Fatalf("unexpected lease "+
+								"%x until %v", id, exp)
+						}
+						leased[c.OutPoint] = ok
+						return nil
+					},
+				)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if len(leased) != 2 || !leased[unconfirmedOutPoint] ||
+					leased[confirmedOutPoint] {
+
+					t.Fatalf("unexpected utxos %v", leased)
+				}
+			},
+		},
 		{
 			// Asserts that output locks are removed for outputs
 			// which have had a confirmed spend, ensuring the
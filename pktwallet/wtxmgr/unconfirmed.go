@@ -60,7 +60,9 @@ func (s *Store) insertMemPoolTx(ns walletdb.ReadWriteBucket, rec *TxRecord) er.R
 	// TODO: increment credit amount for each credit (but those are unknown
 	// here currently).
 
-	return nil
+	// An abandoned transaction which shows up in the mempool again is no
+	// longer abandoned, it still spends its inputs.
+	return unabandonTx(ns, &rec.Hash)
 }
 
 // removeDoubleSpends checks for any unmined transactions which would introduce
@@ -167,6 +169,75 @@ func removeConflict(ns walletdb.ReadWriteBucket, rec *TxRecord) er.R {
 	return deleteRawUnmined(ns, rec.Hash[:])
 }
 
+// AbandonTx removes an unmined transaction, and all unmined transactions
+// spending its outputs, from the store and marks it abandoned. The outputs it
+// spent become unspent again and any locks on them are removed, so they can be
+// spent by another transaction. The transaction is no longer abandoned if it
+// is seen again, either in the mempool or mined.
+//
+// ErrTxConfirmed is returned if the transaction is mined, ErrTxAbandoned if it
+// was abandoned already and ErrUnknownTx if it is not known to the store.
+func (s *Store) AbandonTx(ns walletdb.ReadWriteBucket, txHash *chainhash.Hash) er.R {
+	if s.IsAbandoned(ns, txHash) {
+		return ErrTxAbandoned.Default()
+	}
+
+	v := existsRawUnmined(ns, txHash[:])
+	if v == nil {
+		details, err := s.TxDetails(ns, txHash)
+		if err != nil {
+			return err
+		}
+		if details != nil {
+			return ErrTxConfirmed.Default()
+		}
+		return ErrUnknownTx.Default()
+	}
+
+	var rec TxRecord
+	rec.Hash = *txHash
+	if err := readRawTxRecord(&rec.Hash, v, &rec); err != nil {
+		return err
+	}
+
+	log.Infof("Abandoning unconfirmed transaction [%s]",
+		log.Txid(txHash.String()))
+	if err := removeConflict(ns, &rec); err != nil {
+		return err
+	}
+	for _, input := range rec.MsgTx.TxIn {
+		if err := unlockOutput(ns, input.PreviousOutPoint); err != nil {
+			return err
+		}
+	}
+
+	abandoned, err := ns.CreateBucketIfNotExists(bucketAbandonedTxs)
+	if err != nil {
+		return err
+	}
+	var t [8]byte
+	byteOrder.PutUint64(t[:], uint64(s.clock.Now().Unix()))
+	return abandoned.Put(txHash[:], t[:])
+}
+
+// IsAbandoned returns whether the transaction was abandoned and has not been
+// seen since.
+func (s *Store) IsAbandoned(ns walletdb.ReadBucket, txHash *chainhash.Hash) bool {
+	abandoned := ns.NestedReadBucket(bucketAbandonedTxs)
+	return abandoned != nil && abandoned.Get(txHash[:]) != nil
+}
+
+// unabandonTx removes the abandoned mark of a transaction, if it has one.
+func unabandonTx(ns walletdb.ReadWriteBucket, txHash *chainhash.Hash) er.R {
+	abandoned := ns.NestedReadWriteBucket(bucketAbandonedTxs)
+	if abandoned == nil || abandoned.Get(txHash[:]) == nil {
+		return nil
+	}
+	log.Infof("Abandoned transaction [%s] was seen again",
+		log.Txid(txHash.String()))
+	return abandoned.Delete(txHash[:])
+}
+
 // UnminedTxs returns the underlying transactions for all unmined transactions
 // which are not known to have been mined in a block.  Transactions are
 // guaranteed to be sorted by their dependency order.
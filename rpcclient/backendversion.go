@@ -0,0 +1,96 @@
+package rpcclient
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/pkt-cash/pktd/btcjson"
+	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/pktlog/log"
+)
+
+// apiSuffix is the suffix of the API names in the result of the version RPC,
+// the name of the backend software precedes it.
+const apiSuffix = "jsonrpcapi"
+
+// BackendVersion describes the software of the RPC server the client is
+// connected to.
+type BackendVersion struct {
+	// Name is the name of the backend software, such as pktd. It is empty
+	// if the backend doesn't report its version, in which case none of
+	// the other fields are set either.
+	Name string
+
+	// Version is the version string reported by the backend.
+	Version string
+
+	// Major, Minor and Patch are the components of the version.
+	Major uint32
+	Minor uint32
+	Patch uint32
+}
+
+// parseBackendVersion returns the backend version described by the result of
+// the version RPC. If the result names more than one API, the first one in
+// alphabetical order is used.
+func parseBackendVersion(
+	apis map[string]btcjson.VersionResult) *BackendVersion {
+
+	names := make([]string, 0, len(apis))
+	for name := range apis {
+		if strings.HasSuffix(name, apiSuffix) && name != apiSuffix {
+			names = append(names, name)
+		}
+	}
+	if len(names) == 0 {
+		return &BackendVersion{}
+	}
+	sort.Strings(names)
+
+	api := apis[names[0]]
+	return &BackendVersion{
+		Name:    strings.TrimSuffix(names[0], apiSuffix),
+		Version: api.VersionString,
+		Major:   api.Major,
+		Minor:   api.Minor,
+		Patch:   api.Patch,
+	}
+}
+
+// BackendVersion returns the name and version of the backend software, so that
+// callers can tell which RPCs it supports. The backend is only queried once,
+// the result is cached until the client reconnects. A backend which doesn't
+// report its version results in a BackendVersion with an empty Name.
+func (c *Client) BackendVersion() (*BackendVersion, er.R) {
+	c.backendVersionMtx.Lock()
+	defer c.backendVersionMtx.Unlock()
+
+	if c.backendVersion != nil {
+		return c.backendVersion, nil
+	}
+
+	apis, err := c.Version()
+	switch {
+	case btcjson.ErrRPCMethodNotFound.Is(err):
+		c.backendVersion = &BackendVersion{}
+
+	case err != nil:
+		return nil, err
+
+	default:
+		c.backendVersion = parseBackendVersion(apis)
+	}
+
+	log.Debugf("RPC server %s runs backend %q version %q", c.config.Host,
+		c.backendVersion.Name, c.backendVersion.Version)
+
+	return c.backendVersion, nil
+}
+
+// resetBackendVersion clears the cached backend version, so that it is queried
+// again from the backend the client reconnected to.
+func (c *Client) resetBackendVersion() {
+	c.backendVersionMtx.Lock()
+	c.backendVersion = nil
+	c.backendVersionMtx.Unlock()
+}
@@ -0,0 +1,143 @@
+package rpcclient
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/pkt-cash/pktd/btcjson"
+)
+
+// TestParseBackendVersion ensures that the backend name and version are taken
+// from the API of the version result named after the backend.
+func TestParseBackendVersion(t *testing.T) {
+	tests := []struct {
+		name     string
+		apis     map[string]btcjson.VersionResult
+		expected BackendVersion
+	}{
+		{
+			name: "pktd",
+			apis: map[string]btcjson.VersionResult{
+				"pktdjsonrpcapi": {
+					VersionString: "1.3.0",
+					Major:         1,
+					Minor:         3,
+				},
+			},
+			expected: BackendVersion{
+				Name:    "pktd",
+				Version: "1.3.0",
+				Major:   1,
+				Minor:   3,
+			},
+		},
+		{
+			name: "several apis",
+			apis: map[string]btcjson.VersionResult{
+				"pktwalletjsonrpcapi": {VersionString: "2.0.0", Major: 2},
+				"pktdjsonrpcapi":      {VersionString: "1.3.0", Major: 1},
+				"unrelated":           {VersionString: "3.0.0", Major: 3},
+			},
+			expected: BackendVersion{
+				Name:    "pktd",
+				Version: "1.3.0",
+				Major:   1,
+			},
+		},
+		{
+			name: "no backend api",
+			apis: map[string]btcjson.VersionResult{
+				"jsonrpcapi": {VersionString: "1.0.0", Major: 1},
+			},
+		},
+		{
+			name: "empty",
+		},
+	}
+
+	for _, test := range tests {
+		v := parseBackendVersion(test.apis)
+		if *v != test.expected {
+			t.Errorf("%s: expected %+v, got %+v", test.name,
+				test.expected, *v)
+		}
+	}
+}
+
+// TestBackendVersion ensures that the backend version is only queried once,
+// and that a backend which doesn't implement the version RPC is reported
+// without a name rather than as an error.
+func TestBackendVersion(t *testing.T) {
+	var (
+		mtx      sync.Mutex
+		requests int
+	)
+	newServer := func(response string) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				mtx.Lock()
+				requests++
+				mtx.Unlock()
+				w.Write([]byte(response))
+			},
+		))
+	}
+
+	for _, test := range []struct {
+		response string
+		expected BackendVersion
+	}{
+		{
+			response: `{"result":{"pktdjsonrpcapi":{"versionstring":` +
+				`"1.3.0","major":1,"minor":3,"patch":0}},` +
+				`"error":null,"id":1}`,
+			expected: BackendVersion{
+				Name:    "pktd",
+				Version: "1.3.0",
+				Major:   1,
+				Minor:   3,
+			},
+		},
+		{
+			response: `{"result":null,"error":{"code":-32601,` +
+				`"message":"Method not found"},"id":1}`,
+		},
+	} {
+		server := newServer(test.response)
+		mtx.Lock()
+		requests = 0
+		mtx.Unlock()
+
+		client, err := New(&ConnConfig{
+			Host:         strings.TrimPrefix(server.URL, "http://"),
+			User:         "user",
+			Pass:         "pass",
+			DisableTLS:   true,
+			HTTPPostMode: true,
+		}, nil)
+		if err != nil {
+			t.Fatalf("unable to create client: %v", err)
+		}
+		for i := 0; i < 2; i++ {
+			v, err := client.BackendVersion()
+			if err != nil {
+				t.Fatalf("unable to get backend version: %v", err)
+			}
+			if *v != test.expected {
+				t.Fatalf("expected %+v, got %+v", test.expected, *v)
+			}
+		}
+		client.Shutdown()
+		client.WaitForShutdown()
+		server.Close()
+
+		mtx.Lock()
+		if requests != 1 {
+			t.Fatalf("expected 1 request, got %d", requests)
+		}
+		mtx.Unlock()
+	}
+}
@@ -0,0 +1,195 @@
+package rpcclient
+
+import (
+	"bytes"
+	"io/ioutil"
+
+	jsoniter "github.com/json-iterator/go"
+
+	"github.com/pkt-cash/pktd/btcjson"
+	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/pktlog/log"
+)
+
+// Batch is a set of commands which are sent to the server together as a
+// single JSON-RPC batch request, so that they only take one round-trip in
+// HTTP POST mode.  Each command gets its own response, a command which fails
+// doesn't affect the others.
+//
+// The result of each command is delivered to the future returned when adding
+// it, which can be converted to the future type of the corresponding Async
+// method to unmarshal the result, for example:
+//
+//	batch := client.NewBatch()
+//	blockHash := rpcclient.FutureGetBlockHashResult(
+//		batch.Add(btcjson.NewGetBlockHashCmd(height)),
+//	)
+//	if err := batch.Send(); err != nil {
+//		// Handle the error
+//	}
+//	hash, err := blockHash.Receive()
+//
+// A Batch is not safe for concurrent access.
+type Batch struct {
+	client   *Client
+	requests []*jsonRequest
+}
+
+// NewBatch returns an empty batch of commands for the client.
+func (c *Client) NewBatch() *Batch {
+	return &Batch{client: c}
+}
+
+// Add queues the passed command, which must be a registered btcjson command,
+// and returns a future for its result.  The result is only delivered once the
+// batch is sent.
+func (b *Batch) Add(cmd interface{}) FutureRawResult {
+	method, err := btcjson.CmdMethod(cmd)
+	if err != nil {
+		return newFutureError(err)
+	}
+
+	id := b.client.NextID()
+	marshaledJSON, err := btcjson.MarshalCmd(id, cmd)
+	if err != nil {
+		return newFutureError(err)
+	}
+
+	responseChan := make(chan *response, 1)
+	b.requests = append(b.requests, &jsonRequest{
+		id:            id,
+		method:        method,
+		cmd:           cmd,
+		marshaledJSON: marshaledJSON,
+		responseChan:  responseChan,
+		traceID:       b.client.traceID(id),
+	})
+
+	return responseChan
+}
+
+// Len returns the number of commands queued in the batch.
+func (b *Batch) Len() int {
+	return len(b.requests)
+}
+
+// Send sends the queued commands to the server and delivers their responses
+// to the futures returned by Add, after which the batch is empty again.  In
+// websocket mode the commands are sent one after the other over the
+// connection, which doesn't wait for a response before sending the next one.
+//
+// An error is returned if the batch as a whole failed, for example because
+// the server couldn't be reached, in which case the error is delivered to
+// each of the futures as well.  Errors of individual commands are only
+// delivered to their futures.
+func (b *Batch) Send() er.R {
+	requests := b.requests
+	b.requests = nil
+	if len(requests) == 0 {
+		return nil
+	}
+
+	c := b.client
+	if !c.config.HTTPPostMode {
+		for _, jReq := range requests {
+			c.sendRequest(jReq)
+		}
+		return nil
+	}
+
+	responses, err := c.sendPostBatch(requests)
+	if err != nil {
+		for _, jReq := range requests {
+			jReq.responseChan <- &response{err: err}
+		}
+		return err
+	}
+	for _, jReq := range requests {
+		resp, ok := responses[jReq.id]
+		if !ok {
+			err := er.Errorf("no response to %v in batch", jReq)
+			jReq.responseChan <- &response{err: err}
+			continue
+		}
+		res, err := resp.result()
+		jReq.responseChan <- &response{result: res, err: err}
+	}
+	return nil
+}
+
+// batchResponse is a partially-unmarshaled response of a JSON-RPC batch
+// request, which is correlated with its request by the id.
+type batchResponse struct {
+	ID *uint64 `json:"id"`
+	rawResponse
+}
+
+// sendPostBatch sends the passed requests to the server in a single HTTP POST
+// request and returns their responses by request id.
+func (c *Client) sendPostBatch(
+	requests []*jsonRequest) (map[uint64]*rawResponse, er.R) {
+
+	// Don't send the batch if shutting down.
+	select {
+	case <-c.shutdown:
+		return nil, ErrClientShutdown.Default()
+	default:
+	}
+
+	marshaledRequests := make([][]byte, 0, len(requests))
+	for _, jReq := range requests {
+		marshaledRequests = append(marshaledRequests, jReq.marshaledJSON)
+	}
+	body := append([]byte{'['}, bytes.Join(marshaledRequests, []byte{','})...)
+	body = append(body, ']')
+
+	httpReq, err := c.newPostRequest(body, requests[0].traceID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.breakerAllow(); err != nil {
+		return nil, err
+	}
+
+	log.Tracef("Sending batch of %d commands, starting with %v",
+		len(requests), requests[0])
+	httpResponse, errr := c.httpClient.Do(httpReq)
+	if errr != nil {
+		c.breakerRecord(false)
+		return nil, er.E(errr)
+	}
+
+	// Read the raw bytes and close the response.
+	respBytes, errr := ioutil.ReadAll(httpResponse.Body)
+	httpResponse.Body.Close()
+	if errr != nil {
+		c.breakerRecord(false)
+		return nil, er.Errorf("error reading json reply: %v", errr)
+	}
+
+	var batch []batchResponse
+	if errr := jsoniter.Unmarshal(respBytes, &batch); errr != nil {
+		// A server which rejects the batch as a whole replies with a
+		// single error response.
+		var resp rawResponse
+		errr := jsoniter.Unmarshal(respBytes, &resp)
+		if errr == nil && resp.Error != nil {
+			c.breakerRecord(true)
+			_, err := resp.result()
+			return nil, err
+		}
+		c.breakerRecord(false)
+		return nil, er.Errorf("status code: %d, response: %q",
+			httpResponse.StatusCode, string(respBytes))
+	}
+	c.breakerRecord(true)
+
+	responses := make(map[uint64]*rawResponse, len(batch))
+	for i := range batch {
+		if batch[i].ID != nil {
+			responses[*batch[i].ID] = &batch[i].rawResponse
+		}
+	}
+	return responses, nil
+}
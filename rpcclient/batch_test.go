@@ -0,0 +1,112 @@
+package rpcclient
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	jsoniter "github.com/json-iterator/go"
+
+	"github.com/pkt-cash/pktd/btcjson"
+)
+
+// TestBatch ensures that the commands of a batch are sent in a single HTTP
+// request, and that each response is delivered to the future of its command,
+// regardless of the order of the responses and of the other commands failing.
+func TestBatch(t *testing.T) {
+	const hash = "00000000839a8e6886ab5951d76f411475428afc90947ee320161bbf18eb6048"
+
+	var posts int32
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&posts, 1)
+			body, errr := ioutil.ReadAll(r.Body)
+			if errr != nil {
+				t.Errorf("unable to read request: %v", errr)
+				return
+			}
+			var requests []btcjson.Request
+			if errr := jsoniter.Unmarshal(body, &requests); errr != nil {
+				w.Write([]byte(`{"result":null,"error":{"code":` +
+					`-32700,"message":"Parse error"},"id":null}`))
+				return
+			}
+
+			// Reply in reverse order, failing getpeerinfo and
+			// leaving out getcurrentnet.
+			var responses []string
+			for i := len(requests) - 1; i >= 0; i-- {
+				id, _ := jsoniter.Marshal(requests[i].ID)
+				switch requests[i].Method {
+				case "getblockhash":
+					responses = append(responses, `{"result":"`+
+						hash+`","error":null,"id":`+
+						string(id)+`}`)
+				case "getpeerinfo":
+					responses = append(responses, `{"result":null,`+
+						`"error":{"code":-32601,"message":`+
+						`"Method not found"},"id":`+
+						string(id)+`}`)
+				}
+			}
+			w.Write([]byte("[" + strings.Join(responses, ",") + "]"))
+		},
+	))
+	defer server.Close()
+
+	client, err := New(&ConnConfig{
+		Host:         strings.TrimPrefix(server.URL, "http://"),
+		User:         "user",
+		Pass:         "pass",
+		DisableTLS:   true,
+		HTTPPostMode: true,
+	}, nil)
+	if err != nil {
+		t.Fatalf("unable to create client: %v", err)
+	}
+	defer client.Shutdown()
+
+	batch := client.NewBatch()
+	blockHash := FutureGetBlockHashResult(
+		batch.Add(btcjson.NewGetBlockHashCmd(1)),
+	)
+	peerInfo := FutureGetPeerInfoResult(
+		batch.Add(btcjson.NewGetPeerInfoCmd()),
+	)
+	currentNet := FutureGetCurrentNetResult(
+		batch.Add(btcjson.NewGetCurrentNetCmd()),
+	)
+	if batch.Len() != 3 {
+		t.Fatalf("expected 3 commands, got %d", batch.Len())
+	}
+	if err := batch.Send(); err != nil {
+		t.Fatalf("unable to send batch: %v", err)
+	}
+	if n := atomic.LoadInt32(&posts); n != 1 {
+		t.Fatalf("expected 1 request, got %d", n)
+	}
+	if batch.Len() != 0 {
+		t.Fatalf("expected an empty batch, got %d commands", batch.Len())
+	}
+
+	if h, err := blockHash.Receive(); err != nil || h.String() != hash {
+		t.Fatalf("expected block hash %s, got %v (%v)", hash, h, err)
+	}
+	if _, err := peerInfo.Receive(); !btcjson.ErrRPCMethodNotFound.Is(err) {
+		t.Fatalf("expected ErrRPCMethodNotFound, got %v", err)
+	}
+	if _, err := currentNet.Receive(); err == nil {
+		t.Fatal("expected missing response to fail")
+	}
+
+	// An empty batch isn't sent at all.
+	if err := batch.Send(); err != nil {
+		t.Fatalf("unable to send empty batch: %v", err)
+	}
+	if n := atomic.LoadInt32(&posts); n != 1 {
+		t.Fatalf("expected 1 request, got %d", n)
+	}
+}
@@ -0,0 +1,269 @@
+package rpcclient
+
+import (
+	"sync"
+	"time"
+
+	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/pktlog/log"
+)
+
+const (
+	// DefaultBreakerMaxFailures is the default number of consecutive
+	// failures which trip a circuit breaker.
+	DefaultBreakerMaxFailures = 5
+
+	// DefaultBreakerWindow is the default window within which the
+	// consecutive failures must occur to trip a circuit breaker.
+	DefaultBreakerWindow = time.Minute
+
+	// DefaultBreakerCooldown is the default time a tripped circuit breaker
+	// short-circuits calls before probing for recovery.
+	DefaultBreakerCooldown = 30 * time.Second
+)
+
+// BreakerState is the state of a circuit breaker.
+type BreakerState int
+
+const (
+	// BreakerClosed is the state of a healthy backend, calls go through.
+	BreakerClosed BreakerState = iota
+
+	// BreakerOpen is the state of a failing backend, calls are
+	// short-circuited with ErrCircuitOpen until the cooldown has passed.
+	BreakerOpen
+
+	// BreakerHalfOpen is the state after the cooldown, a single call is let
+	// through to probe whether the backend has recovered.
+	BreakerHalfOpen
+)
+
+// String returns the name of the state.
+func (s BreakerState) String() string {
+	switch s {
+	case BreakerClosed:
+		return "closed"
+	case BreakerOpen:
+		return "open"
+	case BreakerHalfOpen:
+		return "half-open"
+	}
+	return "unknown"
+}
+
+// CircuitBreakerConfig configures a circuit breaker.  Zero values are replaced
+// by the defaults.
+type CircuitBreakerConfig struct {
+	// MaxFailures is the number of consecutive failures which trip the
+	// breaker.
+	MaxFailures int
+
+	// Window is the time within which MaxFailures consecutive failures
+	// must occur to trip the breaker.  A failure more than Window after
+	// the first failure of a run starts a new run.
+	Window time.Duration
+
+	// Cooldown is the time the tripped breaker short-circuits calls
+	// before it lets a call through to probe for recovery.
+	Cooldown time.Duration
+
+	// OnStateChange, if set, is called with the old and the new state
+	// whenever the state of the breaker changes, for example to fail over
+	// to another backend.  It must not block.
+	OnStateChange func(from, to BreakerState)
+}
+
+// CircuitBreaker stops calls to a backend which keeps failing, so that it
+// isn't hammered while it is down and callers learn about it right away.
+// After MaxFailures consecutive failures within the Window it opens and
+// short-circuits calls with ErrCircuitOpen.  Once the Cooldown has passed it
+// is half-open and lets a single call through, which closes it again if it
+// succeeds and reopens it otherwise.
+//
+// A CircuitBreaker is safe for concurrent access.
+type CircuitBreaker struct {
+	cfg CircuitBreakerConfig
+
+	// now returns the current time, it is replaced in tests.
+	now func() time.Time
+
+	mtx          sync.Mutex
+	state        BreakerState
+	failures     int
+	firstFailure time.Time
+	openedAt     time.Time
+	probing      bool
+}
+
+// NewCircuitBreaker returns a closed circuit breaker.  A nil config uses the
+// defaults.
+func NewCircuitBreaker(cfg *CircuitBreakerConfig) *CircuitBreaker {
+	b := &CircuitBreaker{now: time.Now}
+	if cfg != nil {
+		b.cfg = *cfg
+	}
+	if b.cfg.MaxFailures <= 0 {
+		b.cfg.MaxFailures = DefaultBreakerMaxFailures
+	}
+	if b.cfg.Window <= 0 {
+		b.cfg.Window = DefaultBreakerWindow
+	}
+	if b.cfg.Cooldown <= 0 {
+		b.cfg.Cooldown = DefaultBreakerCooldown
+	}
+	return b
+}
+
+// State returns the current state of the breaker.
+func (b *CircuitBreaker) State() BreakerState {
+	b.mtx.Lock()
+	from, to := b.state, b.currentState()
+	b.mtx.Unlock()
+
+	b.notify(from, to)
+	return to
+}
+
+// Allow returns ErrCircuitOpen if a call must be short-circuited.  Each call
+// which is allowed must be followed by Success or Failure with its outcome.
+func (b *CircuitBreaker) Allow() er.R {
+	b.mtx.Lock()
+	from, to := b.state, b.currentState()
+	var err er.R
+	switch {
+	case to == BreakerOpen:
+		err = ErrCircuitOpen.Default()
+
+	// Only a single probe is let through while half-open.
+	case to == BreakerHalfOpen && b.probing:
+		err = ErrCircuitOpen.New("probing for recovery", nil)
+
+	case to == BreakerHalfOpen:
+		b.probing = true
+	}
+	b.mtx.Unlock()
+
+	b.notify(from, to)
+	return err
+}
+
+// Success records a successful call, which closes the breaker.
+func (b *CircuitBreaker) Success() {
+	b.mtx.Lock()
+	from := b.state
+	b.state = BreakerClosed
+	b.failures = 0
+	b.probing = false
+	b.mtx.Unlock()
+
+	b.notify(from, BreakerClosed)
+}
+
+// Failure records a failed call, which opens the breaker if it is half-open or
+// if it was the last of MaxFailures consecutive failures within the Window.
+func (b *CircuitBreaker) Failure() {
+	b.mtx.Lock()
+	now := b.now()
+	from, to := b.state, b.currentState()
+	switch to {
+	case BreakerHalfOpen:
+		to = b.open(now)
+
+	case BreakerClosed:
+		if b.failures == 0 ||
+			now.Sub(b.firstFailure) > b.cfg.Window {
+
+			b.failures = 0
+			b.firstFailure = now
+		}
+		b.failures++
+		if b.failures >= b.cfg.MaxFailures {
+			to = b.open(now)
+		}
+	}
+	b.mtx.Unlock()
+
+	b.notify(from, to)
+}
+
+// Call calls f unless the breaker short-circuits it, and records its outcome.
+func (b *CircuitBreaker) Call(f func() er.R) er.R {
+	if err := b.Allow(); err != nil {
+		return err
+	}
+	err := f()
+	if err != nil {
+		b.Failure()
+	} else {
+		b.Success()
+	}
+	return err
+}
+
+// currentState moves an open breaker to half-open once the cooldown has passed
+// and returns the resulting state.
+//
+// This function MUST be called with the mutex held.
+func (b *CircuitBreaker) currentState() BreakerState {
+	if b.state == BreakerOpen &&
+		b.now().Sub(b.openedAt) >= b.cfg.Cooldown {
+
+		b.state = BreakerHalfOpen
+		b.probing = false
+	}
+	return b.state
+}
+
+// open trips the breaker.
+//
+// This function MUST be called with the mutex held.
+func (b *CircuitBreaker) open(now time.Time) BreakerState {
+	b.state = BreakerOpen
+	b.openedAt = now
+	b.failures = 0
+	b.probing = false
+	return b.state
+}
+
+// notify calls the OnStateChange callback if the state changed.  It must be
+// called without the mutex held, so that the callback can query the breaker.
+func (b *CircuitBreaker) notify(from, to BreakerState) {
+	if from == to {
+		return
+	}
+	log.Debugf("Circuit breaker changed from %v to %v", from, to)
+	if b.cfg.OnStateChange != nil {
+		b.cfg.OnStateChange(from, to)
+	}
+}
+
+// BreakerState returns the state of the circuit breaker of the client, so that
+// callers can react to a failing server.  It is always BreakerClosed unless
+// ConnConfig.CircuitBreaker is set.
+func (c *Client) BreakerState() BreakerState {
+	if c.breaker == nil {
+		return BreakerClosed
+	}
+	return c.breaker.State()
+}
+
+// breakerAllow returns ErrCircuitOpen if the circuit breaker of the client
+// short-circuits the request.
+func (c *Client) breakerAllow() er.R {
+	if c.breaker == nil {
+		return nil
+	}
+	return c.breaker.Allow()
+}
+
+// breakerRecord records the outcome of a request or reconnect attempt with the
+// circuit breaker of the client.
+func (c *Client) breakerRecord(ok bool) {
+	switch {
+	case c.breaker == nil:
+	case ok:
+		c.breaker.Success()
+	default:
+		c.breaker.Failure()
+	}
+}
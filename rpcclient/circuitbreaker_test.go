@@ -0,0 +1,142 @@
+package rpcclient
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/pkt-cash/pktd/btcjson"
+	"github.com/pkt-cash/pktd/btcutil/er"
+)
+
+// TestCircuitBreaker ensures that the breaker trips after consecutive failures
+// within the window, short-circuits calls for the cooldown, lets a single probe
+// through afterwards and closes or reopens depending on its outcome.
+func TestCircuitBreaker(t *testing.T) {
+	now := time.Unix(1000, 0)
+	var changes []BreakerState
+	b := NewCircuitBreaker(&CircuitBreakerConfig{
+		MaxFailures: 3,
+		Window:      time.Minute,
+		Cooldown:    10 * time.Second,
+		OnStateChange: func(from, to BreakerState) {
+			changes = append(changes, to)
+		},
+	})
+	b.now = func() time.Time { return now }
+
+	assertState := func(state BreakerState) {
+		t.Helper()
+		if s := b.State(); s != state {
+			t.Fatalf("expected state %v, got %v", state, s)
+		}
+	}
+
+	// Failures which are too far apart don't trip the breaker, and a
+	// success resets the count.
+	b.Failure()
+	b.Failure()
+	now = now.Add(2 * time.Minute)
+	b.Failure()
+	b.Success()
+	b.Failure()
+	b.Failure()
+	assertState(BreakerClosed)
+
+	// The third consecutive failure within the window trips it.
+	b.Failure()
+	assertState(BreakerOpen)
+	if err := b.Allow(); !ErrCircuitOpen.Is(err) {
+		t.Fatalf("expected ErrCircuitOpen, got %v", err)
+	}
+
+	// After the cooldown a single probe is let through, a failed probe
+	// reopens the breaker.
+	now = now.Add(10 * time.Second)
+	assertState(BreakerHalfOpen)
+	if err := b.Allow(); err != nil {
+		t.Fatalf("expected the probe to be allowed, got %v", err)
+	}
+	if err := b.Allow(); !ErrCircuitOpen.Is(err) {
+		t.Fatalf("expected ErrCircuitOpen while probing, got %v", err)
+	}
+	b.Failure()
+	assertState(BreakerOpen)
+
+	// A successful probe closes it.
+	now = now.Add(10 * time.Second)
+	err := b.Call(func() er.R { return nil })
+	if err != nil {
+		t.Fatalf("expected the probe to be allowed, got %v", err)
+	}
+	assertState(BreakerClosed)
+
+	expected := []BreakerState{
+		BreakerOpen, BreakerHalfOpen, BreakerOpen, BreakerHalfOpen,
+		BreakerClosed,
+	}
+	if len(changes) != len(expected) {
+		t.Fatalf("expected state changes %v, got %v", expected, changes)
+	}
+	for i := range expected {
+		if changes[i] != expected[i] {
+			t.Fatalf("expected state changes %v, got %v", expected,
+				changes)
+		}
+	}
+}
+
+// TestClientCircuitBreaker ensures that a client in HTTP POST mode stops
+// sending requests to a failing server once its breaker trips.
+func TestClientCircuitBreaker(t *testing.T) {
+	var posts int32
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&posts, 1)
+			http.Error(w, "unavailable", http.StatusServiceUnavailable)
+		},
+	))
+	defer server.Close()
+
+	client, err := New(&ConnConfig{
+		Host:         strings.TrimPrefix(server.URL, "http://"),
+		User:         "user",
+		Pass:         "pass",
+		DisableTLS:   true,
+		HTTPPostMode: true,
+		CircuitBreaker: &CircuitBreakerConfig{
+			MaxFailures: 2,
+			Cooldown:    time.Hour,
+		},
+	}, nil)
+	if err != nil {
+		t.Fatalf("unable to create client: %v", err)
+	}
+	defer client.Shutdown()
+
+	for i := 0; i < 2; i++ {
+		_, err := client.RawRequest("getblockcount", nil)
+		if err == nil || ErrCircuitOpen.Is(err) {
+			t.Fatalf("expected the server error, got %v", err)
+		}
+	}
+	if s := client.BreakerState(); s != BreakerOpen {
+		t.Fatalf("expected state %v, got %v", BreakerOpen, s)
+	}
+
+	_, err = client.RawRequest("getblockcount", nil)
+	if !ErrCircuitOpen.Is(err) {
+		t.Fatalf("expected ErrCircuitOpen, got %v", err)
+	}
+	batch := client.NewBatch()
+	batch.Add(btcjson.NewGetBestBlockHashCmd())
+	if err := batch.Send(); !ErrCircuitOpen.Is(err) {
+		t.Fatalf("expected ErrCircuitOpen, got %v", err)
+	}
+	if n := atomic.LoadInt32(&posts); n != 2 {
+		t.Fatalf("expected 2 requests, got %d", n)
+	}
+}
@@ -10,34 +10,167 @@ package rpcclient
 
 import (
 	"bufio"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
 	"os"
 	"strings"
 
 	"github.com/pkt-cash/pktd/btcutil/er"
 )
 
-func readCookieFile(path string) (username, password string, err er.R) {
+const (
+	// cookieKeySize is the size in bytes of a generated cookie key.
+	cookieKeySize = 32
+
+	// cookieHMACPrefix prefixes the line of a cookie file which holds the
+	// HMAC of the credentials.
+	cookieHMACPrefix = "hmac:"
+
+	// MaxCookieLineSize is the maximum size in bytes of a line of a cookie
+	// file. Generated credentials are far shorter, so a longer line means
+	// the cookie file is corrupt and it is rejected with ErrCookieTooLong.
+	MaxCookieLineSize = 4096
+)
+
+// cookieHMAC returns the hex encoded HMAC-SHA256 of the credentials line of a
+// cookie file.
+func cookieHMAC(key []byte, credentials string) string {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(credentials))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// readCookieKey reads the hex encoded cookie key at path.
+func readCookieKey(path string) ([]byte, er.R) {
+	b, errr := ioutil.ReadFile(path)
+	if errr != nil {
+		return nil, er.E(errr)
+	}
+	key, errr := hex.DecodeString(strings.TrimSpace(string(b)))
+	if errr != nil {
+		return nil, er.Errorf("Malformed pktcookie key file: %v", errr)
+	}
+	if len(key) == 0 {
+		return nil, er.New("Empty pktcookie key file")
+	}
+	return key, nil
+}
+
+// loadOrCreateCookieKey reads the cookie key at path, generating a new random
+// key and writing it to path if the file does not exist yet.
+func loadOrCreateCookieKey(path string) ([]byte, er.R) {
+	if _, errr := os.Stat(path); !os.IsNotExist(errr) {
+		return readCookieKey(path)
+	}
+	key := make([]byte, cookieKeySize)
+	if _, errr := rand.Read(key); errr != nil {
+		return nil, er.E(errr)
+	}
+	errr := ioutil.WriteFile(path, []byte(hex.EncodeToString(key)), 0o600)
+	if errr != nil {
+		return nil, er.E(errr)
+	}
+	return key, nil
+}
+
+// WriteCookieFile writes a cookie file containing the username and password
+// to path. If keyPath is non-empty, an HMAC of the credentials keyed by the
+// secret stored at keyPath is appended, so that readers configured with the
+// same key can detect a modified cookie file. The key is generated if keyPath
+// does not exist yet.
+func WriteCookieFile(path, username, password, keyPath string) er.R {
+	cookie := username + ":" + password
+	if keyPath != "" {
+		key, err := loadOrCreateCookieKey(keyPath)
+		if err != nil {
+			return err
+		}
+		cookie += "\n" + cookieHMACPrefix + cookieHMAC(key, cookie)
+	}
+	if errr := ioutil.WriteFile(path, []byte(cookie), 0o600); errr != nil {
+		return er.E(errr)
+	}
+	return nil
+}
+
+// ReadCookieFile reads the username and password from the cookie file at
+// path. If keyPath is non-empty, the HMAC line of the cookie file is verified
+// against the key stored at keyPath and ErrCookieIntegrity is returned if it
+// is missing or does not match. Lines longer than MaxCookieLineSize are
+// rejected with ErrCookieTooLong rather than being truncated.
+func ReadCookieFile(path, keyPath string) (username, password string, err er.R) {
 	f, errr := os.Open(path)
 	if errr != nil {
-		return
+		return "", "", er.E(errr)
 	}
 	defer f.Close()
 
+	// The scanner needs room for a CRLF line terminator too, which means
+	// that it accepts lines which are slightly too long, so the length of
+	// the lines is checked as well.
 	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 256), MaxCookieLineSize+2)
 	scanner.Scan()
+	s := scanner.Text()
+	var mac string
+	if scanner.Scan() {
+		mac = scanner.Text()
+	}
 	errr = scanner.Err()
-	if errr != nil {
-		return
+	if errr == bufio.ErrTooLong ||
+		len(s) > MaxCookieLineSize || len(mac) > MaxCookieLineSize {
+
+		return "", "", ErrCookieTooLong.New(
+			fmt.Sprintf("line exceeds %d bytes", MaxCookieLineSize), nil)
+	} else if errr != nil {
+		return "", "", er.E(errr)
 	}
-	s := scanner.Text()
 
 	parts := strings.SplitN(s, ":", 2)
 	if len(parts) != 2 {
-		err := er.E(errr)
-		err.AddMessage("Corrupt or malformed pktcookie file")
-		return "", "", err
+		return "", "", er.New("Corrupt or malformed pktcookie file")
+	}
+
+	if keyPath != "" {
+		key, err := readCookieKey(keyPath)
+		if err != nil {
+			return "", "", err
+		}
+		expected := cookieHMACPrefix + cookieHMAC(key, s)
+		if !hmac.Equal([]byte(mac), []byte(expected)) {
+			return "", "", ErrCookieIntegrity.Default()
+		}
 	}
 
 	username, password = parts[0], parts[1]
 	return
 }
+
+// resolveAuth selects the username and passphrase to authenticate with. The
+// cookie file is preferred when it exists and is valid, otherwise the
+// explicitly configured username and passphrase are used. If neither is
+// available an error is returned, which includes the reason why the cookie
+// file couldn't be used if one was configured.
+func resolveAuth(config *ConnConfig) (username, passphrase string, err er.R) {
+	var cookieErr er.R
+	if config.CookiePath != "" {
+		username, passphrase, cookieErr = config.retrieveCookie()
+		if cookieErr == nil {
+			return username, passphrase, nil
+		}
+	}
+
+	if config.User != "" || config.Pass != "" {
+		return config.User, config.Pass, nil
+	}
+
+	if cookieErr != nil {
+		return "", "", cookieErr
+	}
+	return "", "", er.New("No RPC credentials configured, either a " +
+		"pktcookie file or a username and passphrase is required")
+}
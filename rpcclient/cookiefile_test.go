@@ -0,0 +1,268 @@
+package rpcclient
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestResolveAuth ensures that a valid cookie file is preferred over the
+// configured username and passphrase, which are used as a fallback.
+func TestResolveAuth(t *testing.T) {
+	dir, errr := ioutil.TempDir("", "rpcclient-cookie")
+	if errr != nil {
+		t.Fatalf("unable to create temp dir: %v", errr)
+	}
+	defer os.RemoveAll(dir)
+
+	cookiePath := filepath.Join(dir, ".cookie")
+	errr = ioutil.WriteFile(cookiePath, []byte("cookieuser:cookiepass"), 0600)
+	if errr != nil {
+		t.Fatalf("unable to write cookie file: %v", errr)
+	}
+	malformedPath := filepath.Join(dir, ".malformed")
+	errr = ioutil.WriteFile(malformedPath, []byte("nocolon"), 0600)
+	if errr != nil {
+		t.Fatalf("unable to write cookie file: %v", errr)
+	}
+	missingPath := filepath.Join(dir, ".missing")
+
+	tests := []struct {
+		name       string
+		cookiePath string
+		user       string
+		pass       string
+		expectUser string
+		expectPass string
+		expectErr  bool
+	}{
+		{
+			name:       "cookie and credentials",
+			cookiePath: cookiePath,
+			user:       "user",
+			pass:       "pass",
+			expectUser: "cookieuser",
+			expectPass: "cookiepass",
+		},
+		{
+			name:       "cookie only",
+			cookiePath: cookiePath,
+			expectUser: "cookieuser",
+			expectPass: "cookiepass",
+		},
+		{
+			name:       "credentials only",
+			user:       "user",
+			pass:       "pass",
+			expectUser: "user",
+			expectPass: "pass",
+		},
+		{
+			name:      "neither",
+			expectErr: true,
+		},
+		{
+			name:       "missing cookie falls back to credentials",
+			cookiePath: missingPath,
+			user:       "user",
+			pass:       "pass",
+			expectUser: "user",
+			expectPass: "pass",
+		},
+		{
+			name:       "malformed cookie falls back to credentials",
+			cookiePath: malformedPath,
+			user:       "user",
+			pass:       "pass",
+			expectUser: "user",
+			expectPass: "pass",
+		},
+		{
+			name:       "missing cookie without credentials",
+			cookiePath: missingPath,
+			expectErr:  true,
+		},
+	}
+
+	for _, test := range tests {
+		config := &ConnConfig{
+			CookiePath: test.cookiePath,
+			User:       test.user,
+			Pass:       test.pass,
+		}
+		user, pass, err := resolveAuth(config)
+		if test.expectErr {
+			if err == nil {
+				t.Errorf("%s: expected an error", test.name)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+		if user != test.expectUser || pass != test.expectPass {
+			t.Errorf("%s: expected %s:%s, got %s:%s", test.name,
+				test.expectUser, test.expectPass, user, pass)
+		}
+	}
+}
+
+// TestCookieIntegrity ensures that a cookie file written with a key is
+// verified when read with the same key, and that a modified cookie file fails
+// verification.
+func TestCookieIntegrity(t *testing.T) {
+	dir, errr := ioutil.TempDir("", "rpcclient-cookie")
+	if errr != nil {
+		t.Fatalf("unable to create temp dir: %v", errr)
+	}
+	defer os.RemoveAll(dir)
+
+	cookiePath := filepath.Join(dir, ".cookie")
+	keyPath := filepath.Join(dir, ".cookiekey")
+	err := WriteCookieFile(cookiePath, "user", "pass", keyPath)
+	if err != nil {
+		t.Fatalf("unable to write cookie file: %v", err)
+	}
+
+	// The cookie verifies with the generated key, and is read as before
+	// when integrity checking is disabled.
+	for _, kp := range []string{keyPath, ""} {
+		user, pass, err := ReadCookieFile(cookiePath, kp)
+		if err != nil {
+			t.Fatalf("unable to read cookie file with key %q: %v",
+				kp, err)
+		}
+		if user != "user" || pass != "pass" {
+			t.Fatalf("expected user:pass, got %s:%s", user, pass)
+		}
+	}
+
+	// Rewriting the cookie reuses the existing key.
+	err = WriteCookieFile(cookiePath, "user", "pass2", keyPath)
+	if err != nil {
+		t.Fatalf("unable to write cookie file: %v", err)
+	}
+	if _, _, err := ReadCookieFile(cookiePath, keyPath); err != nil {
+		t.Fatalf("unable to read rewritten cookie file: %v", err)
+	}
+
+	// Swapping the credentials, with or without the HMAC line, fails
+	// verification but not when integrity checking is disabled.
+	cookie, errr := ioutil.ReadFile(cookiePath)
+	if errr != nil {
+		t.Fatalf("unable to read cookie file: %v", errr)
+	}
+	lines := strings.SplitN(string(cookie), "\n", 2)
+	for _, tampered := range []string{
+		"evil:pass2\n" + lines[1],
+		"evil:pass2",
+	} {
+		errr = ioutil.WriteFile(cookiePath, []byte(tampered), 0600)
+		if errr != nil {
+			t.Fatalf("unable to write cookie file: %v", errr)
+		}
+		_, _, err := ReadCookieFile(cookiePath, keyPath)
+		if !ErrCookieIntegrity.Is(err) {
+			t.Fatalf("expected ErrCookieIntegrity for %q, got %v",
+				tampered, err)
+		}
+		user, _, err := ReadCookieFile(cookiePath, "")
+		if err != nil || user != "evil" {
+			t.Fatalf("expected unverified read of %q, got %s, %v",
+				tampered, user, err)
+		}
+	}
+}
+
+// TestCookieTooLong ensures that a cookie file with an over-length line is
+// rejected with ErrCookieTooLong rather than being truncated.
+func TestCookieTooLong(t *testing.T) {
+	dir, errr := ioutil.TempDir("", "rpcclient-cookie")
+	if errr != nil {
+		t.Fatalf("unable to create temp dir: %v", errr)
+	}
+	defer os.RemoveAll(dir)
+
+	tests := []struct {
+		name      string
+		cookie    string
+		expectErr bool
+	}{
+		{
+			name:   "maximum length",
+			cookie: "user:" + strings.Repeat("p", MaxCookieLineSize-5),
+		},
+		{
+			name: "maximum length with hmac line",
+			cookie: "user:" + strings.Repeat("p", MaxCookieLineSize-5) +
+				"\n" + cookieHMACPrefix,
+		},
+		{
+			name: "maximum length with crlf",
+			cookie: "user:" + strings.Repeat("p", MaxCookieLineSize-5) +
+				"\r\n" + cookieHMACPrefix,
+		},
+		{
+			name:      "credentials too long",
+			cookie:    "user:" + strings.Repeat("p", MaxCookieLineSize),
+			expectErr: true,
+		},
+		{
+			name: "credentials one byte too long",
+			cookie: "user:" +
+				strings.Repeat("p", MaxCookieLineSize-4),
+			expectErr: true,
+		},
+		{
+			name: "credentials one byte too long with newline",
+			cookie: "user:" +
+				strings.Repeat("p", MaxCookieLineSize-4) + "\n",
+			expectErr: true,
+		},
+		{
+			name: "credentials one byte too long with crlf",
+			cookie: "user:" +
+				strings.Repeat("p", MaxCookieLineSize-4) + "\r\n",
+			expectErr: true,
+		},
+		{
+			name: "hmac line one byte too long",
+			cookie: "user:pass\n" + cookieHMACPrefix + strings.Repeat(
+				"0", MaxCookieLineSize-len(cookieHMACPrefix)+1),
+			expectErr: true,
+		},
+		{
+			name: "hmac line too long",
+			cookie: "user:pass\n" + cookieHMACPrefix +
+				strings.Repeat("0", MaxCookieLineSize),
+			expectErr: true,
+		},
+	}
+
+	cookiePath := filepath.Join(dir, ".cookie")
+	for _, test := range tests {
+		errr := ioutil.WriteFile(cookiePath, []byte(test.cookie), 0600)
+		if errr != nil {
+			t.Fatalf("unable to write cookie file: %v", errr)
+		}
+		user, pass, err := ReadCookieFile(cookiePath, "")
+		if test.expectErr {
+			if !ErrCookieTooLong.Is(err) {
+				t.Errorf("%s: expected ErrCookieTooLong, got "+
+					"%s:%d, %v", test.name, user, len(pass), err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+		line := strings.SplitN(test.cookie, "\n", 2)[0]
+		if user+":"+pass != strings.TrimSuffix(line, "\r") {
+			t.Errorf("%s: cookie was truncated", test.name)
+		}
+	}
+}
@@ -83,6 +83,14 @@ will cause a deadlock as more server responses won't be read until the callback
 returns, but the callback would be waiting for a response.   Thus, any
 additional RPCs must be issued an a completely decoupled manner.
 
+Subscriptions
+
+As an alternative to the handlers, notifications can be received over a
+buffered channel from a subscription such as SubscribeBlocks, which decouples
+the speed of the subscriber from the read loop.  When the buffer is full, the
+OverflowBlock policy waits for the subscriber so nothing is lost, while
+OverflowDropOldest drops the oldest notification and counts it in Dropped.
+
 Automatic Reconnection
 
 By default, when running in websockets mode, this client will automatically
@@ -100,6 +108,37 @@ commands.
 The automatic reconnection can be disabled by setting the DisableAutoReconnect
 flag to true in the connection config when creating the client.
 
+To detect connections which were silently dropped, for example by a NAT or a
+firewall, the client sends keepalive pings every PingInterval and disconnects
+when nothing was received from the server within the IdleTimeout, which starts
+the reconnection.
+
+Circuit Breaker
+
+Setting the CircuitBreaker of the connection config stops the client from
+hammering a server which keeps failing.  After a number of consecutive failures
+within a window, requests fail right away with ErrCircuitOpen for a cooldown,
+after which a single request probes whether the server has recovered.  The
+state is available from BreakerState and can be watched with OnStateChange, for
+example to fail over to another server.
+
+Request Tracing
+
+To reconstruct what a client did against a server, the TraceID of the
+connection config can be set to tag the connection and each request.  Each
+request is traced as TraceID-<request id> in the log lines of the client, and
+the trace id is sent to the server in the X-Trace-Id header of the websocket
+handshake or of each HTTP POST request.  pktd logs it with the requests of the
+client, so that its log lines can be matched with those of the client.
+Tracing is disabled by default.
+
+Batch Requests
+
+Several commands can be sent to the server at once with a Batch, which puts
+them into a single JSON-RPC batch request.  In HTTP POST mode this saves a
+round-trip per command.  The result of each command is delivered to its own
+future, so a command which fails doesn't affect the others in the batch.
+
 Minor RPC Server Differences and Chain/Wallet Separation
 
 Some of the commands are extensions specific to a particular RPC server.  For
@@ -109,6 +148,11 @@ an RPC server that doesn't provide them, you will get an unimplemented error
 from the server.  An effort has been made to call out which commmands are
 extensions in their documentation.
 
+To find out which server the client is talking to, BackendVersion returns the
+name and version of the backend software.  It is queried once and cached until
+the client reconnects, and has an empty name for servers, such as bitcoin core,
+which don't report their version.
+
 Also, it is important to realize that pktd intentionally separates the wallet
 functionality into a separate process named pktwallet.  This means if you are
 connected to the pktd RPC server directly, only the RPCs which are related to
@@ -101,3 +101,47 @@ func (c *Client) GetCurrentNetAsync() FutureGetCurrentNetResult {
 func (c *Client) GetCurrentNet() (protocol.BitcoinNet, er.R) {
 	return c.GetCurrentNetAsync().Receive()
 }
+
+// FutureVersionResult is a future promise to deliver the result of a version
+// RPC invocation (or an applicable error).
+type FutureVersionResult chan *response
+
+// Receive waits for the response promised by the future and returns the
+// version of each API of the server, keyed by the API name.
+func (r FutureVersionResult) Receive() (map[string]btcjson.VersionResult,
+	er.R) {
+
+	res, err := receiveFuture(r)
+	if err != nil {
+		return nil, err
+	}
+
+	// Unmarshal result as a version result object.
+	var vr map[string]btcjson.VersionResult
+	errr := jsoniter.Unmarshal(res, &vr)
+	if errr != nil {
+		return nil, er.E(errr)
+	}
+
+	return vr, nil
+}
+
+// VersionAsync returns an instance of a type that can be used to get the
+// result of the RPC at some future time by invoking the Receive function on the
+// returned instance.
+//
+// See Version for the blocking version and more details.
+//
+// NOTE: This is a pktd extension.
+func (c *Client) VersionAsync() FutureVersionResult {
+	cmd := btcjson.NewVersionCmd()
+	return c.sendCmd(cmd)
+}
+
+// Version returns the version of each API of the server, keyed by the API
+// name.
+//
+// NOTE: This is a pktd extension.
+func (c *Client) Version() (map[string]btcjson.VersionResult, er.R) {
+	return c.VersionAsync().Receive()
+}
@@ -7,6 +7,7 @@ package rpcclient
 import (
 	"bytes"
 	"container/list"
+	"context"
 	"crypto/tls"
 	"crypto/x509"
 	"encoding/base64"
@@ -17,6 +18,7 @@ import (
 	"net"
 	"net/http"
 	"os"
+	"strconv"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -73,6 +75,18 @@ var (
 	ErrNotWebsocketClient = Err.CodeWithDetail("ErrNotWebsocketClient",
 		"client is not configured for websockets")
 
+	// ErrCookieIntegrity is an error to describe the condition where the
+	// HMAC of a cookie file is missing or does not match its contents,
+	// meaning the credentials have been modified.
+	ErrCookieIntegrity = Err.CodeWithDetail("ErrCookieIntegrity",
+		"pktcookie file failed integrity verification")
+
+	// ErrCookieTooLong is an error to describe the condition where a line
+	// of a cookie file exceeds MaxCookieLineSize, meaning the cookie file
+	// is corrupt.
+	ErrCookieTooLong = Err.CodeWithDetail("ErrCookieTooLong",
+		"pktcookie file line is too long")
+
 	// ErrClientAlreadyConnected is an error to describe the condition where
 	// a new client connection cannot be established due to a websocket
 	// client having already connected to the RPC server.
@@ -85,6 +99,12 @@ var (
 	// configured to run in HTTP POST mode.
 	ErrWebsocketsRequired = Err.CodeWithDetail("ErrWebsocketsRequired",
 		"a websocket connection is required to use this feature")
+
+	// ErrCircuitOpen is an error to describe the condition where a request
+	// is short-circuited by the circuit breaker of the client because the
+	// RPC server kept failing.
+	ErrCircuitOpen = Err.CodeWithDetail("ErrCircuitOpen",
+		"circuit breaker is open, the RPC server is failing")
 )
 
 const (
@@ -99,8 +119,29 @@ const (
 	// connectionRetryInterval is the amount of time to wait in between
 	// retries when automatically reconnecting to an RPC server.
 	connectionRetryInterval = time.Second * 5
+
+	// waitForConnectionInterval is the amount of time WaitForConnection
+	// waits in between failed handshake requests.
+	waitForConnectionInterval = time.Second
+
+	// waitForConnectionPostAttempts is the number of requests after which
+	// WaitForConnection gives up on a client in HTTP POST mode.
+	waitForConnectionPostAttempts = 5
+
+	// defaultPingInterval is the default interval at which keepalive pings
+	// are sent over the websocket connection.
+	defaultPingInterval = time.Second * 30
+
+	// pingWriteTimeout is the amount of time allowed to write a keepalive
+	// ping before the connection is considered dead.
+	pingWriteTimeout = time.Second * 10
 )
 
+// TraceIDHeader is the HTTP header carrying the trace id of the client, on the
+// websocket handshake and on each HTTP POST request, when ConnConfig.TraceID
+// is set.
+const TraceIDHeader = "X-Trace-Id"
+
 // sendPostDetails houses an HTTP POST request to send to an RPC server as well
 // as the original JSON-RPC command and a channel to reply on when the server
 // responds with the result.
@@ -117,6 +158,19 @@ type jsonRequest struct {
 	cmd           interface{}
 	marshaledJSON []byte
 	responseChan  chan *response
+
+	// traceID correlates the request in the logs, it is empty unless
+	// ConnConfig.TraceID is set.
+	traceID string
+}
+
+// String returns a description of the request for log lines.
+func (r *jsonRequest) String() string {
+	if r.traceID == "" {
+		return fmt.Sprintf("[%s] with id %d", r.method, r.id)
+	}
+	return fmt.Sprintf("[%s] with id %d (trace %s)", r.method, r.id,
+		r.traceID)
 }
 
 // Client represents a Bitcoin RPC client which allows easy access to the
@@ -160,11 +214,24 @@ type Client struct {
 	requestMap  map[uint64]*list.Element
 	requestList *list.List
 
+	// backendVersion caches the version of the backend for the lifetime
+	// of the connection.
+	backendVersionMtx sync.Mutex
+	backendVersion    *BackendVersion
+
 	// Notifications.
 	ntfnHandlers  *NotificationHandlers
 	ntfnStateLock sync.Mutex
 	ntfnState     *notificationState
 
+	// subscriptions are the channel based subscriptions to notifications.
+	subscriptionsMtx sync.Mutex
+	subscriptions    map[*Subscription]struct{}
+
+	// breaker short-circuits requests while the server keeps failing, it
+	// is nil unless ConnConfig.CircuitBreaker is set.
+	breaker *CircuitBreaker
+
 	// Networking infrastructure.
 	sendChan        chan []byte
 	sendPostChan    chan *sendPostDetails
@@ -184,6 +251,16 @@ func (c *Client) NextID() uint64 {
 	return atomic.AddUint64(&c.id, 1)
 }
 
+// traceID returns the trace id of the request with the passed id, which is the
+// trace id of the client followed by the request id, or the empty string if
+// tracing is disabled.
+func (c *Client) traceID(id uint64) string {
+	if c.config.TraceID == "" {
+		return ""
+	}
+	return c.config.TraceID + "-" + strconv.FormatUint(id, 10)
+}
+
 // addRequest associates the passed jsonRequest with its id.  This allows the
 // response from the remote server to be unmarshaled to the appropriate type
 // and sent to the specified channel when it is received.
@@ -246,7 +323,7 @@ func (c *Client) removeAllRequests() {
 // to automatically re-establish registered notifications on reconnects.
 func (c *Client) trackRegisteredNtfns(cmd interface{}) {
 	// Nothing to do if the caller is not interested in notifications.
-	if c.ntfnHandlers == nil {
+	if !c.wantsNotifications() {
 		return
 	}
 
@@ -373,7 +450,6 @@ func (c *Client) handleMessage(msg []byte) {
 	}
 
 	id := uint64(*in.ID)
-	log.Tracef("Received response for id %d (result %s)", id, in.Result)
 	request := c.removeRequest(id)
 
 	// Nothing more to do if there is no request associated with this reply.
@@ -382,6 +458,7 @@ func (c *Client) handleMessage(msg []byte) {
 			id)
 		return
 	}
+	log.Tracef("Received response for %v (result %s)", request, in.Result)
 
 	// Since the command was successful, examine it to see if it's a
 	// notification, and if is, add it to the notification state so it
@@ -418,6 +495,20 @@ func (c *Client) shouldLogReadError(err error) bool {
 // wsInHandler handles all incoming messages for the websocket connection
 // associated with the client.  It must be run as a goroutine.
 func (c *Client) wsInHandler() {
+	// Consider the connection dead when neither a message nor the reply to
+	// a keepalive ping is received within the idle timeout.
+	wsConn := c.wsConn
+	idleTimeout := c.config.idleTimeout()
+	extendDeadline := func() error {
+		if idleTimeout <= 0 {
+			return nil
+		}
+		return wsConn.SetReadDeadline(time.Now().Add(idleTimeout))
+	}
+	wsConn.SetPongHandler(func(string) error {
+		return extendDeadline()
+	})
+
 out:
 	for {
 		// Break out of the loop once the shutdown channel has been
@@ -429,10 +520,16 @@ out:
 		default:
 		}
 
-		_, msg, errr := c.wsConn.ReadMessage()
+		if errr := extendDeadline(); errr != nil {
+			break out
+		}
+		_, msg, errr := wsConn.ReadMessage()
 		if errr != nil {
 			// Log the error if it's not due to disconnecting.
-			if c.shouldLogReadError(errr) {
+			if netErr, ok := errr.(net.Error); ok && netErr.Timeout() {
+				log.Warnf("No response from %s in %v, "+
+					"disconnecting", c.config.Host, idleTimeout)
+			} else if c.shouldLogReadError(errr) {
 				log.Errorf("Websocket receive error from "+
 					"%s: %v", c.config.Host, errr)
 			}
@@ -461,6 +558,16 @@ func (c *Client) disconnectChan() <-chan struct{} {
 // uses a buffered channel to serialize output messages while allowing the
 // sender to continue running asynchronously.  It must be run as a goroutine.
 func (c *Client) wsOutHandler() {
+	// Send keepalive pings so that idle connections aren't dropped by
+	// NATs and firewalls, and so that a dead connection is detected and
+	// reconnected by the idle timeout of wsInHandler.
+	var pingChan <-chan time.Time
+	if interval := c.config.pingInterval(); interval > 0 {
+		pingTicker := time.NewTicker(interval)
+		defer pingTicker.Stop()
+		pingChan = pingTicker.C
+	}
+
 out:
 	for {
 		// Send any messages ready for send until the client is
@@ -473,6 +580,16 @@ out:
 				break out
 			}
 
+		case <-pingChan:
+			err := c.wsConn.WriteControl(websocket.PingMessage, nil,
+				time.Now().Add(pingWriteTimeout))
+			if err != nil {
+				log.Warnf("Unable to send keepalive ping to %s, "+
+					"disconnecting: %v", c.config.Host, err)
+				c.Disconnect()
+				break out
+			}
+
 		case <-c.disconnectChan():
 			break out
 		}
@@ -509,7 +626,7 @@ func (c *Client) sendMessage(marshaledJSON []byte) {
 // on reconnect by the resendRequests function.
 func (c *Client) reregisterNtfns() er.R {
 	// Nothing to do if the caller is not interested in notifications.
-	if c.ntfnHandlers == nil {
+	if !c.wantsNotifications() {
 		return nil
 	}
 
@@ -622,8 +739,7 @@ func (c *Client) resendRequests() {
 			return
 		}
 
-		log.Tracef("Sending command [%s] with id %d", jReq.method,
-			jReq.id)
+		log.Tracef("Sending command %v", jReq)
 		c.sendMessage(jReq.marshaledJSON)
 	}
 }
@@ -658,6 +774,7 @@ out:
 
 			wsConn, err := dial(c.config)
 			if err != nil {
+				c.breakerRecord(false)
 				c.retryCount++
 				log.Infof("Failed to connect to %s: %v",
 					c.config.Host, err)
@@ -676,8 +793,9 @@ out:
 				continue reconnect
 			}
 
-			log.Infof("Reestablished connection to RPC server %s",
-				c.config.Host)
+			log.Infof("Reestablished connection to RPC server %s%s",
+				c.config.Host, c.config.traceSuffix())
+			c.breakerRecord(true)
 
 			// Reset the connection state and signal the reconnect
 			// has happened.
@@ -689,6 +807,10 @@ out:
 			c.disconnected = false
 			c.mtx.Unlock()
 
+			// The backend may have been upgraded while the client
+			// was disconnected.
+			c.resetBackendVersion()
+
 			// Start processing input and output for the
 			// new connection.
 			c.start()
@@ -711,9 +833,15 @@ out:
 // provided response channel.
 func (c *Client) handleSendPostMessage(details *sendPostDetails) {
 	jReq := details.jsonRequest
-	log.Tracef("Sending command [%s] with id %d", jReq.method, jReq.id)
+	if err := c.breakerAllow(); err != nil {
+		jReq.responseChan <- &response{err: err}
+		return
+	}
+
+	log.Tracef("Sending command %v", jReq)
 	httpResponse, errr := c.httpClient.Do(details.httpRequest)
 	if errr != nil {
+		c.breakerRecord(false)
 		jReq.responseChan <- &response{err: er.E(errr)}
 		return
 	}
@@ -722,6 +850,7 @@ func (c *Client) handleSendPostMessage(details *sendPostDetails) {
 	respBytes, errr := ioutil.ReadAll(httpResponse.Body)
 	httpResponse.Body.Close()
 	if errr != nil {
+		c.breakerRecord(false)
 		err := er.Errorf("error reading json reply: %v", errr)
 		jReq.responseChan <- &response{err: err}
 		return
@@ -734,12 +863,15 @@ func (c *Client) handleSendPostMessage(details *sendPostDetails) {
 		// When the response itself isn't a valid JSON-RPC response
 		// return an error which includes the HTTP status code and raw
 		// response bytes.
+		c.breakerRecord(false)
 		err = er.Errorf("status code: %d, response: %q",
 			httpResponse.StatusCode, string(respBytes))
 		jReq.responseChan <- &response{err: err}
 		return
 	}
 
+	// A JSON-RPC error response still means the server is working.
+	c.breakerRecord(true)
 	res, err := resp.result()
 	jReq.responseChan <- &response{result: res, err: err}
 }
@@ -817,36 +949,49 @@ func receiveFuture(f chan *response) ([]byte, er.R) {
 	return r.result, r.err
 }
 
-// sendPost sends the passed request to the server by issuing an HTTP POST
-// request using the provided response channel for the reply.  Typically a new
-// connection is opened and closed for each command when using this method,
-// however, the underlying HTTP client might coalesce multiple commands
-// depending on several factors including the remote server configuration.
-func (c *Client) sendPost(jReq *jsonRequest) {
+// newPostRequest returns an HTTP POST request carrying the passed JSON-RPC
+// body to the configured RPC server.
+func (c *Client) newPostRequest(body []byte, traceID string) (*http.Request, er.R) {
 	// Generate a request to the configured RPC server.
 	protocol := "http"
 	if !c.config.DisableTLS {
 		protocol = "https"
 	}
 	url := protocol + "://" + c.config.Host
-	bodyReader := bytes.NewReader(jReq.marshaledJSON)
+	bodyReader := bytes.NewReader(body)
 	httpReq, err := http.NewRequest("POST", url, bodyReader)
 	if err != nil {
-		jReq.responseChan <- &response{result: nil, err: er.E(err)}
-		return
+		return nil, er.E(err)
 	}
 	httpReq.Close = true
 	httpReq.Header.Set("Content-Type", "application/json")
+	if traceID != "" {
+		httpReq.Header.Set(TraceIDHeader, traceID)
+	}
 
 	// Configure basic access authorization.
 	user, pass, errr := c.config.getAuth()
 	if errr != nil {
-		jReq.responseChan <- &response{result: nil, err: errr}
-		return
+		return nil, errr
 	}
 	httpReq.SetBasicAuth(user, pass)
 
-	log.Tracef("Sending command [%s] with id %d", jReq.method, jReq.id)
+	return httpReq, nil
+}
+
+// sendPost sends the passed request to the server by issuing an HTTP POST
+// request using the provided response channel for the reply.  Typically a new
+// connection is opened and closed for each command when using this method,
+// however, the underlying HTTP client might coalesce multiple commands
+// depending on several factors including the remote server configuration.
+func (c *Client) sendPost(jReq *jsonRequest) {
+	httpReq, err := c.newPostRequest(jReq.marshaledJSON, jReq.traceID)
+	if err != nil {
+		jReq.responseChan <- &response{result: nil, err: err}
+		return
+	}
+
+	log.Tracef("Sending command %v", jReq)
 	c.sendPostRequest(httpReq, jReq)
 }
 
@@ -872,6 +1017,13 @@ func (c *Client) sendRequest(jReq *jsonRequest) {
 		return
 	}
 
+	// Don't queue the request until the connection is reestablished when
+	// reconnecting keeps failing.
+	if c.BreakerState() == BreakerOpen {
+		jReq.responseChan <- &response{err: ErrCircuitOpen.Default()}
+		return
+	}
+
 	// Add the request to the internal tracking map so the response from the
 	// remote server can be properly detected and routed to the response
 	// channel.  Then send the marshaled request via the websocket
@@ -880,7 +1032,7 @@ func (c *Client) sendRequest(jReq *jsonRequest) {
 		jReq.responseChan <- &response{err: err}
 		return
 	}
-	log.Tracef("Sending command [%s] with id %d", jReq.method, jReq.id)
+	log.Tracef("Sending command %v", jReq)
 	c.sendMessage(jReq.marshaledJSON)
 }
 
@@ -910,6 +1062,7 @@ func (c *Client) sendCmd(cmd interface{}) chan *response {
 		cmd:           cmd,
 		marshaledJSON: marshaledJSON,
 		responseChan:  responseChan,
+		traceID:       c.traceID(id),
 	}
 	c.sendRequest(jReq)
 
@@ -1029,6 +1182,10 @@ func (c *Client) Shutdown() {
 	}
 	c.removeAllRequests()
 
+	// Close the channels of the subscriptions so the subscribers know no
+	// more notifications will arrive.
+	c.closeSubscriptions()
+
 	// Disconnect the client if needed.
 	c.doDisconnect()
 }
@@ -1082,7 +1239,8 @@ type ConnConfig struct {
 
 	// CookiePath is the path to a cookie file containing the username and
 	// passphrase to use to authenticate to the RPC server. It is used instead
-	// of the User and Pass, if non-empty. cookieLast* is used for caching.
+	// of the User and Pass, if non-empty and the file exists and is valid,
+	// otherwise User and Pass are used. cookieLast* is used for caching.
 	CookiePath          string
 	cookieLastCheckTime time.Time
 	cookieLastModTime   time.Time
@@ -1090,6 +1248,12 @@ type ConnConfig struct {
 	cookieLastPass      string
 	cookieLastErr       er.R
 
+	// CookieKeyPath is the path to the secret key the cookie file was
+	// authenticated with when it was written. If non-empty, the HMAC stored
+	// in the cookie file is verified and the cookie file is rejected with
+	// ErrCookieIntegrity if it has been modified.
+	CookieKeyPath string
+
 	// DisableTLS specifies whether transport layer security should be
 	// disabled.  It is recommended to always use TLS if the RPC server
 	// supports it as otherwise your username and password is sent across
@@ -1105,12 +1269,54 @@ type ConnConfig struct {
 	// try to reconnect to the server when it has been disconnected.
 	DisableAutoReconnect bool
 
+	// ConnectRetries is the number of times New retries to establish the
+	// websocket connection when it fails, so that a backend which is
+	// briefly unavailable is tolerated. The credentials are resolved again
+	// on each attempt, to pick up a rotated cookie file. The default of 0
+	// returns the error of the first attempt.
+	ConnectRetries int
+
+	// ConnectRetryBackoff is the time to wait before the first retry, it
+	// doubles with each further retry up to a maximum of one minute. It
+	// defaults to connectionRetryInterval.
+	ConnectRetryBackoff time.Duration
+
+	// PingInterval is the interval at which keepalive pings are sent over
+	// the websocket connection, so that long-lived connections aren't
+	// silently dropped by NATs and firewalls. It defaults to 30 seconds, a
+	// negative value disables the pings.
+	PingInterval time.Duration
+
+	// IdleTimeout is the time after which the websocket connection is
+	// considered dead and disconnected, and reconnected unless
+	// DisableAutoReconnect is set, when nothing, not even the reply to a
+	// keepalive ping, was received. It defaults to three ping intervals, a
+	// negative value disables it.
+	IdleTimeout time.Duration
+
+	// TraceID, if set, tags the connection and each request for
+	// correlation in the logs of the client and the server. It is sent in
+	// the TraceIDHeader of the websocket handshake, and each request is
+	// traced as TraceID-<request id>, which is also sent in the
+	// TraceIDHeader of each HTTP POST request. Tracing is disabled by
+	// default.
+	TraceID string
+
 	// DisableConnectOnNew specifies that a websocket client connection
 	// should not be tried when creating the client with New.  Instead, the
 	// client is created and returned unconnected, and Connect must be
 	// called manually.
 	DisableConnectOnNew bool
 
+	// CircuitBreaker, if set, enables a circuit breaker which short-
+	// circuits requests with ErrCircuitOpen while the RPC server keeps
+	// failing, see CircuitBreaker.  In HTTP POST mode each request which
+	// gets no valid JSON-RPC response counts as a failure.  In websocket
+	// mode each failed reconnect attempt counts as a failure, and requests
+	// fail right away while the breaker is open instead of waiting for
+	// the connection to be reestablished.
+	CircuitBreaker *CircuitBreakerConfig
+
 	// HTTPPostMode instructs the client to run using multiple independent
 	// connections issuing HTTP POST requests instead of using the default
 	// of websockets.  Websockets are generally preferred as some of the
@@ -1175,6 +1381,9 @@ func dial(config *ConnConfig) (*websocket.Conn, er.R) {
 	auth := "Basic " + base64.StdEncoding.EncodeToString([]byte(login))
 	requestHeader := make(http.Header)
 	requestHeader.Add("Authorization", auth)
+	if config.TraceID != "" {
+		requestHeader.Add(TraceIDHeader, config.TraceID)
+	}
 
 	// Dial the connection.
 	url := fmt.Sprintf("%s://%s/%s", scheme, config.Host, config.Endpoint)
@@ -1205,17 +1414,11 @@ func dial(config *ConnConfig) (*websocket.Conn, er.R) {
 }
 
 // getAuth returns the username and passphrase that will actually be used for
-// this connection. This will be the result of checking for a pktcookie file
-// if the cookie path is configured; if not, it will be the user-configured
-// username and passphrase.
+// this connection. This will be the contents of the pktcookie file if the
+// cookie path is configured and the file is valid; if not, it will be the
+// user-configured username and passphrase.
 func (config *ConnConfig) getAuth() (username, passphrase string, error er.R) {
-	// Try standard authorization first.
-	if config.Pass != "" {
-		return config.User, config.Pass, nil
-	}
-
-	// Now we try cookie auth
-	return config.retrieveCookie()
+	return resolveAuth(config)
 }
 
 // retrieveCookie returns the username and passphrase from the cookie
@@ -1227,20 +1430,83 @@ func (config *ConnConfig) retrieveCookie() (username, passphrase string, err er.
 	config.cookieLastCheckTime = time.Now()
 	st, errr := os.Stat(config.CookiePath)
 	if errr != nil {
-		err.AddMessage("Error reading pktcookie file")
 		config.cookieLastErr = er.E(errr)
+		config.cookieLastErr.AddMessage("Error reading pktcookie file")
 		return config.cookieLastUser, config.cookieLastPass, config.cookieLastErr
 	}
 
 	modTime := st.ModTime()
 	if !modTime.Equal(config.cookieLastModTime) {
 		config.cookieLastModTime = modTime
-		config.cookieLastUser, config.cookieLastPass, config.cookieLastErr = readCookieFile(config.CookiePath)
+		config.cookieLastUser, config.cookieLastPass, config.cookieLastErr = ReadCookieFile(config.CookiePath, config.CookieKeyPath)
 	}
 
 	return config.cookieLastUser, config.cookieLastPass, config.cookieLastErr
 }
 
+// pingInterval returns the keepalive ping interval of the config, it is zero if
+// keepalive pings are disabled.
+func (config *ConnConfig) pingInterval() time.Duration {
+	switch {
+	case config.PingInterval < 0:
+		return 0
+	case config.PingInterval == 0:
+		return defaultPingInterval
+	}
+	return config.PingInterval
+}
+
+// idleTimeout returns the idle timeout of the config, it is zero if the idle
+// timeout is disabled.
+func (config *ConnConfig) idleTimeout() time.Duration {
+	switch {
+	case config.IdleTimeout < 0:
+		return 0
+	case config.IdleTimeout == 0:
+		// Without keepalive pings a quiet connection can't be told
+		// apart from a dead one.
+		return 3 * config.pingInterval()
+	}
+	return config.IdleTimeout
+}
+
+// traceSuffix returns the trace id of the config for connection log lines, it
+// is empty if tracing is disabled.
+func (config *ConnConfig) traceSuffix() string {
+	if config.TraceID == "" {
+		return ""
+	}
+	return " (trace " + config.TraceID + ")"
+}
+
+// connectWithRetry calls connect until it succeeds or the ConnectRetries of
+// the config are exhausted, in which case the error of the last attempt is
+// returned.
+func (config *ConnConfig) connectWithRetry(connect func() er.R) er.R {
+	backoff := config.ConnectRetryBackoff
+	if backoff <= 0 {
+		backoff = connectionRetryInterval
+	}
+	for attempt := 0; ; attempt++ {
+		err := connect()
+		if err == nil || attempt >= config.ConnectRetries {
+			return err
+		}
+		log.Warnf("Unable to connect to RPC server %s, retrying in %v "+
+			"(%d of %d): %v", config.Host, backoff, attempt+1,
+			config.ConnectRetries, err)
+		time.Sleep(backoff)
+		backoff *= 2
+		if backoff > time.Minute {
+			backoff = time.Minute
+		}
+
+		// Don't rely on the cached cookie, the credentials may have
+		// been rotated while the backend was unavailable.
+		config.cookieLastCheckTime = time.Time{}
+	}
+}
+
 // New creates a new RPC client based on the provided connection configuration
 // details.  The notification handlers parameter may be nil if you are not
 // interested in receiving notifications and will be ignored if the
@@ -1264,8 +1530,11 @@ func New(config *ConnConfig, ntfnHandlers *NotificationHandlers) (*Client, er.R)
 		}
 	} else {
 		if !config.DisableConnectOnNew {
-			var err er.R
-			wsConn, err = dial(config)
+			err := config.connectWithRetry(func() er.R {
+				var err er.R
+				wsConn, err = dial(config)
+				return err
+			})
 			if err != nil {
 				return nil, err
 			}
@@ -1281,6 +1550,7 @@ func New(config *ConnConfig, ntfnHandlers *NotificationHandlers) (*Client, er.R)
 		requestList:     list.New(),
 		ntfnHandlers:    ntfnHandlers,
 		ntfnState:       newNotificationState(),
+		subscriptions:   make(map[*Subscription]struct{}),
 		sendChan:        make(chan []byte, sendBufferSize),
 		sendPostChan:    make(chan *sendPostDetails, sendPostBufferSize),
 		connEstablished: connEstablished,
@@ -1288,9 +1558,13 @@ func New(config *ConnConfig, ntfnHandlers *NotificationHandlers) (*Client, er.R)
 		shutdown:        make(chan struct{}),
 	}
 
+	if config.CircuitBreaker != nil {
+		client.breaker = NewCircuitBreaker(config.CircuitBreaker)
+	}
+
 	if start {
-		log.Infof("Established connection to RPC server %s",
-			config.Host)
+		log.Infof("Established connection to RPC server %s%s",
+			config.Host, config.traceSuffix())
 		close(connEstablished)
 		client.start()
 		if !client.config.HTTPPostMode && !client.config.DisableAutoReconnect {
@@ -1343,8 +1617,8 @@ func (c *Client) Connect(tries int) er.R {
 		// Connection was established.  Set the websocket connection
 		// member of the client and start the goroutines necessary
 		// to run the client.
-		log.Infof("Established connection to RPC server %s",
-			c.config.Host)
+		log.Infof("Established connection to RPC server %s%s",
+			c.config.Host, c.config.traceSuffix())
 		c.wsConn = wsConn
 		close(c.connEstablished)
 		c.start()
@@ -1358,3 +1632,42 @@ func (c *Client) Connect(tries int) er.R {
 	// All connection attempts failed, so return the last error.
 	return err
 }
+
+// WaitForConnection blocks until the RPC server has successfully answered a
+// trivial request, retrying every second, or until ctx is done.  This allows
+// startup code to deterministically wait for the server to become reachable
+// rather than having requests fail until it is.
+//
+// In websocket mode, requests which are made while the client is reconnecting
+// are queued until the connection is re-established, so this also waits for
+// any ongoing reconnect.  In HTTP POST mode there is no reconnect to wait for,
+// so the error of the last request is returned after a few failed attempts.
+// An error is returned immediately if the client is shut down, since retrying
+// would not help.
+func (c *Client) WaitForConnection(ctx context.Context) er.R {
+	for attempt := 1; ; attempt++ {
+		var err er.R
+		select {
+		case r := <-c.GetCurrentNetAsync():
+			err = r.err
+		case <-ctx.Done():
+			return er.E(ctx.Err())
+		}
+		if err == nil {
+			return nil
+		}
+		if ErrClientShutdown.Is(err) {
+			return err
+		}
+		if c.config.HTTPPostMode && attempt >= waitForConnectionPostAttempts {
+			return err
+		}
+		log.Debugf("RPC server %s is not ready yet: %v", c.config.Host, err)
+
+		select {
+		case <-time.After(waitForConnectionInterval):
+		case <-ctx.Done():
+			return er.E(ctx.Err())
+		}
+	}
+}
@@ -0,0 +1,367 @@
+package rpcclient
+
+import (
+	"context"
+	"encoding/base64"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// TestConnectRetry ensures that New retries to connect to a backend which
+// comes up after two failed attempts, resolving the credentials again on each
+// attempt, and that the error of the last attempt is returned once the
+// retries are exhausted.
+func TestConnectRetry(t *testing.T) {
+	dir, errr := ioutil.TempDir("", "rpcclient-connect")
+	if errr != nil {
+		t.Fatalf("unable to create temp dir: %v", errr)
+	}
+	defer os.RemoveAll(dir)
+
+	cookiePath := filepath.Join(dir, ".cookie")
+	writeCookie := func(cookie string, modTime time.Time) {
+		errr := ioutil.WriteFile(cookiePath, []byte(cookie), 0600)
+		if errr == nil {
+			errr = os.Chtimes(cookiePath, modTime, modTime)
+		}
+		if errr != nil {
+			t.Fatalf("unable to write cookie file: %v", errr)
+		}
+	}
+	writeCookie("user:old", time.Now().Add(-time.Hour))
+
+	// The backend is unavailable for the first two attempts, during which
+	// it rotates its credentials.
+	var (
+		mtx      sync.Mutex
+		attempts int
+		upgrader websocket.Upgrader
+	)
+	getAttempts := func() int {
+		mtx.Lock()
+		defer mtx.Unlock()
+		return attempts
+	}
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			mtx.Lock()
+			attempts++
+			attempt := attempts
+			mtx.Unlock()
+
+			if attempt <= 2 {
+				writeCookie("user:new", time.Now())
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			auth := "Basic " + base64.StdEncoding.EncodeToString(
+				[]byte("user:new"),
+			)
+			if r.Header.Get("Authorization") != auth {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			conn, errr := upgrader.Upgrade(w, r, nil)
+			if errr != nil {
+				t.Errorf("unable to upgrade connection: %v", errr)
+				return
+			}
+			conn.Close()
+		},
+	))
+	defer server.Close()
+
+	config := func(retries int) *ConnConfig {
+		return &ConnConfig{
+			Host:                 strings.TrimPrefix(server.URL, "http://"),
+			Endpoint:             "ws",
+			CookiePath:           cookiePath,
+			DisableTLS:           true,
+			DisableAutoReconnect: true,
+			ConnectRetries:       retries,
+			ConnectRetryBackoff:  time.Millisecond,
+		}
+	}
+
+	// Without enough retries, the error of the last attempt is returned.
+	if _, err := New(config(1), nil); err == nil {
+		t.Fatal("expected connection to fail")
+	}
+	if n := getAttempts(); n != 2 {
+		t.Fatalf("expected 2 attempts, got %d", n)
+	}
+
+	mtx.Lock()
+	attempts = 0
+	mtx.Unlock()
+	writeCookie("user:old", time.Now().Add(-time.Hour))
+	client, err := New(config(3), nil)
+	if err != nil {
+		t.Fatalf("unable to connect: %v", err)
+	}
+	client.Shutdown()
+	client.WaitForShutdown()
+	if n := getAttempts(); n != 3 {
+		t.Fatalf("expected 3 attempts, got %d", n)
+	}
+}
+
+// TestKeepalive ensures that keepalive pings are sent over an idle connection,
+// and that a connection on which the pings go unanswered is disconnected and
+// reconnected, while a healthy one is kept.
+func TestKeepalive(t *testing.T) {
+	var (
+		mtx      sync.Mutex
+		conns    int
+		upgrader websocket.Upgrader
+	)
+	pings := make(chan int, 100)
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			conn, errr := upgrader.Upgrade(w, r, nil)
+			if errr != nil {
+				t.Errorf("unable to upgrade connection: %v", errr)
+				return
+			}
+			defer conn.Close()
+
+			mtx.Lock()
+			conns++
+			connNum := conns
+			mtx.Unlock()
+
+			// The first connection is dead, its pings are never
+			// answered.
+			conn.SetPingHandler(func(data string) error {
+				select {
+				case pings <- connNum:
+				default:
+				}
+				if connNum == 1 {
+					return nil
+				}
+				return conn.WriteControl(websocket.PongMessage,
+					[]byte(data), time.Now().Add(time.Second))
+			})
+			for {
+				if _, _, errr := conn.ReadMessage(); errr != nil {
+					return
+				}
+			}
+		},
+	))
+	defer server.Close()
+
+	client, err := New(&ConnConfig{
+		Host:         strings.TrimPrefix(server.URL, "http://"),
+		Endpoint:     "ws",
+		User:         "user",
+		Pass:         "pass",
+		DisableTLS:   true,
+		PingInterval: 10 * time.Millisecond,
+		IdleTimeout:  100 * time.Millisecond,
+	}, nil)
+	if err != nil {
+		t.Fatalf("unable to connect: %v", err)
+	}
+	defer func() {
+		client.Shutdown()
+		client.WaitForShutdown()
+	}()
+
+	// Wait for the pings of the dead connection to go unanswered and the
+	// client to reconnect.
+	timeout := time.After(5 * time.Second)
+	for connNum := 0; connNum < 2; {
+		select {
+		case connNum = <-pings:
+		case <-timeout:
+			t.Fatal("client didn't reconnect")
+		}
+	}
+
+	// The healthy connection is kept, even though nothing but pings is
+	// exchanged for several idle timeouts.
+	time.Sleep(500 * time.Millisecond)
+	mtx.Lock()
+	defer mtx.Unlock()
+	if conns != 2 {
+		t.Fatalf("expected 2 connections, got %d", conns)
+	}
+}
+
+// TestTraceID ensures that the trace id of the client is sent on the websocket
+// handshake and, suffixed with the request id, on each HTTP POST request, and
+// that nothing is sent when tracing is disabled.
+func TestTraceID(t *testing.T) {
+	var (
+		mtx      sync.Mutex
+		traceIDs []string
+		upgrader websocket.Upgrader
+	)
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			mtx.Lock()
+			traceIDs = append(traceIDs, r.Header.Get(TraceIDHeader))
+			mtx.Unlock()
+
+			if r.URL.Path == "/ws" {
+				conn, errr := upgrader.Upgrade(w, r, nil)
+				if errr != nil {
+					t.Errorf("unable to upgrade connection: %v", errr)
+					return
+				}
+				conn.Close()
+				return
+			}
+			w.Write([]byte(`{"result":null,"error":null,"id":1}`))
+		},
+	))
+	defer server.Close()
+
+	for _, test := range []struct {
+		traceID  string
+		postMode bool
+		expected string
+	}{
+		{traceID: "wallet", postMode: true, expected: "wallet-1"},
+		{traceID: "wallet", expected: "wallet"},
+		{postMode: true},
+		{},
+	} {
+		mtx.Lock()
+		traceIDs = nil
+		mtx.Unlock()
+
+		client, err := New(&ConnConfig{
+			Host:                 strings.TrimPrefix(server.URL, "http://"),
+			Endpoint:             "ws",
+			User:                 "user",
+			Pass:                 "pass",
+			DisableTLS:           true,
+			DisableAutoReconnect: true,
+			HTTPPostMode:         test.postMode,
+			TraceID:              test.traceID,
+		}, nil)
+		if err != nil {
+			t.Fatalf("unable to connect: %v", err)
+		}
+		if test.postMode {
+			if _, err := client.RawRequest("getinfo", nil); err != nil {
+				t.Fatalf("unable to send request: %v", err)
+			}
+		}
+		client.Shutdown()
+		client.WaitForShutdown()
+
+		mtx.Lock()
+		if len(traceIDs) != 1 || traceIDs[0] != test.expected {
+			t.Fatalf("expected trace id %q, got %q", test.expected,
+				traceIDs)
+		}
+		mtx.Unlock()
+	}
+
+	jReq := &jsonRequest{id: 3, method: "getinfo"}
+	if s := jReq.String(); s != "[getinfo] with id 3" {
+		t.Fatalf("unexpected request description %q", s)
+	}
+	jReq.traceID = "wallet-3"
+	if s := jReq.String(); s != "[getinfo] with id 3 (trace wallet-3)" {
+		t.Fatalf("unexpected request description %q", s)
+	}
+}
+
+// TestWaitForConnection ensures that WaitForConnection waits for a server in
+// HTTP POST mode to answer, gives up on one which keeps failing after a few
+// attempts and returns as soon as its context is done.
+func TestWaitForConnection(t *testing.T) {
+	var (
+		mtx      sync.Mutex
+		attempts int
+		failures int
+	)
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			mtx.Lock()
+			attempts++
+			fail := attempts <= failures
+			mtx.Unlock()
+
+			if fail {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			w.Write([]byte(`{"result":3652501241,"error":null,"id":1}`))
+		},
+	))
+	defer server.Close()
+
+	client, err := New(&ConnConfig{
+		Host:         strings.TrimPrefix(server.URL, "http://"),
+		User:         "user",
+		Pass:         "pass",
+		DisableTLS:   true,
+		HTTPPostMode: true,
+	}, nil)
+	if err != nil {
+		t.Fatalf("unable to create client: %v", err)
+	}
+	defer func() {
+		client.Shutdown()
+		client.WaitForShutdown()
+	}()
+
+	reset := func(n int) {
+		mtx.Lock()
+		attempts = 0
+		failures = n
+		mtx.Unlock()
+	}
+	getAttempts := func() int {
+		mtx.Lock()
+		defer mtx.Unlock()
+		return attempts
+	}
+
+	// The server answers the second request.
+	reset(1)
+	if err := client.WaitForConnection(context.Background()); err != nil {
+		t.Fatalf("unable to wait for connection: %v", err)
+	}
+	if n := getAttempts(); n != 2 {
+		t.Fatalf("expected 2 attempts, got %d", n)
+	}
+
+	// The server never answers, so the error of the last attempt is
+	// returned.
+	reset(waitForConnectionPostAttempts + 1)
+	if err := client.WaitForConnection(context.Background()); err == nil {
+		t.Fatal("expected waiting for the connection to fail")
+	}
+	if n := getAttempts(); n != waitForConnectionPostAttempts {
+		t.Fatalf("expected %d attempts, got %d",
+			waitForConnectionPostAttempts, n)
+	}
+
+	// A canceled context ends the wait before the next attempt.
+	reset(waitForConnectionPostAttempts + 1)
+	ctx, cancel := context.WithTimeout(context.Background(),
+		waitForConnectionInterval/2)
+	defer cancel()
+	if err := client.WaitForConnection(ctx); err == nil {
+		t.Fatal("expected waiting for the connection to fail")
+	}
+	if n := getAttempts(); n != 1 {
+		t.Fatalf("expected 1 attempt, got %d", n)
+	}
+}
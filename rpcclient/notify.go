@@ -206,6 +206,8 @@ type NotificationHandlers struct {
 // delivers the notification to the appropriate On<X> handler registered with
 // the client.
 func (c *Client) handleNotification(ntfn *rawNotification) {
+	c.deliverToSubscriptions(ntfn)
+
 	// Ignore the notification if the client is not interested in any
 	// notifications.
 	if c.ntfnHandlers == nil {
@@ -869,7 +871,7 @@ func (c *Client) NotifyBlocksAsync() FutureNotifyBlocksResult {
 
 	// Ignore the notification if the client is not interested in
 	// notifications.
-	if c.ntfnHandlers == nil {
+	if !c.wantsNotifications() {
 		return newNilFutureResult()
 	}
 
@@ -879,9 +881,10 @@ func (c *Client) NotifyBlocksAsync() FutureNotifyBlocksResult {
 
 // NotifyBlocks registers the client to receive notifications when blocks are
 // connected and disconnected from the main chain.  The notifications are
-// delivered to the notification handlers associated with the client.  Calling
-// this function has no effect if there are no notification handlers and will
-// result in an error if the client is configured to run in HTTP POST mode.
+// delivered to the notification handlers and subscriptions associated with
+// the client.  Calling this function has no effect if there are neither
+// notification handlers nor subscriptions and will result in an error if the
+// client is configured to run in HTTP POST mode.
 //
 // The notifications delivered as a result of this call will be via one of
 // OnBlockConnected or OnBlockDisconnected.
@@ -915,7 +918,7 @@ func (c *Client) notifySpentInternal(outpoints []btcjson.OutPoint) FutureNotifyS
 
 	// Ignore the notification if the client is not interested in
 	// notifications.
-	if c.ntfnHandlers == nil {
+	if !c.wantsNotifications() {
 		return newNilFutureResult()
 	}
 
@@ -958,7 +961,7 @@ func (c *Client) NotifyNewTransactionsAsync(verbose bool) FutureNotifyNewTransac
 
 	// Ignore the notification if the client is not interested in
 	// notifications.
-	if c.ntfnHandlers == nil {
+	if !c.wantsNotifications() {
 		return newNilFutureResult()
 	}
 
@@ -968,9 +971,10 @@ func (c *Client) NotifyNewTransactionsAsync(verbose bool) FutureNotifyNewTransac
 
 // NotifyNewTransactions registers the client to receive notifications every
 // time a new transaction is accepted to the memory pool.  The notifications are
-// delivered to the notification handlers associated with the client.  Calling
-// this function has no effect if there are no notification handlers and will
-// result in an error if the client is configured to run in HTTP POST mode.
+// delivered to the notification handlers and subscriptions associated with
+// the client.  Calling this function has no effect if there are neither
+// notification handlers nor subscriptions and will result in an error if the
+// client is configured to run in HTTP POST mode.
 //
 // The notifications delivered as a result of this call will be via one of
 // OnTxAccepted (when verbose is false) or OnTxAcceptedVerbose (when verbose is
@@ -1005,7 +1009,7 @@ func (c *Client) notifyReceivedInternal(addresses []string) FutureNotifyReceived
 
 	// Ignore the notification if the client is not interested in
 	// notifications.
-	if c.ntfnHandlers == nil {
+	if !c.wantsNotifications() {
 		return newNilFutureResult()
 	}
 
@@ -1031,7 +1035,7 @@ func (c *Client) NotifyReceivedAsync(addresses []btcutil.Address) FutureNotifyRe
 
 	// Ignore the notification if the client is not interested in
 	// notifications.
-	if c.ntfnHandlers == nil {
+	if !c.wantsNotifications() {
 		return newNilFutureResult()
 	}
 
@@ -1050,9 +1054,10 @@ func (c *Client) NotifyReceivedAsync(addresses []btcutil.Address) FutureNotifyRe
 // one of these transactions is detected, the client is also automatically
 // registered for notifications when the new transaction outpoints the address
 // now has available are spent (See NotifySpent).  The notifications are
-// delivered to the notification handlers associated with the client.  Calling
-// this function has no effect if there are no notification handlers and will
-// result in an error if the client is configured to run in HTTP POST mode.
+// delivered to the notification handlers and subscriptions associated with
+// the client.  Calling this function has no effect if there are neither
+// notification handlers nor subscriptions and will result in an error if the
+// client is configured to run in HTTP POST mode.
 //
 // The notifications delivered as a result of this call will be via one of
 // *OnRecvTx (for transactions that receive funds to one of the passed
@@ -1105,7 +1110,7 @@ func (c *Client) RescanAsync(startBlock *chainhash.Hash,
 
 	// Ignore the notification if the client is not interested in
 	// notifications.
-	if c.ntfnHandlers == nil {
+	if !c.wantsNotifications() {
 		return newNilFutureResult()
 	}
 
@@ -1235,7 +1240,7 @@ func (c *Client) NotifySpentAsync(outpoints []*wire.OutPoint) FutureNotifySpentR
 
 	// Ignore the notification if the client is not interested in
 	// notifications.
-	if c.ntfnHandlers == nil {
+	if !c.wantsNotifications() {
 		return newNilFutureResult()
 	}
 
@@ -1249,9 +1254,10 @@ func (c *Client) NotifySpentAsync(outpoints []*wire.OutPoint) FutureNotifySpentR
 
 // NotifySpent registers the client to receive notifications when the passed
 // transaction outputs are spent.  The notifications are delivered to the
-// notification handlers associated with the client.  Calling this function has
-// no effect if there are no notification handlers and will result in an error
-// if the client is configured to run in HTTP POST mode.
+// notification handlers and subscriptions associated with the client.  Calling
+// this function has no effect if there are neither notification handlers nor
+// subscriptions and will result in an error if the client is configured to run
+// in HTTP POST mode.
 //
 // The notifications delivered as a result of this call will be via
 // OnRedeemingTx.
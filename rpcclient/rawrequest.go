@@ -55,7 +55,14 @@ func (c *Client) RawRequestAsync(method string, params []jsoniter.RawMessage) Fu
 		return newFutureError(er.E(errr))
 	}
 
-	// Generate the request and send it along with a channel to respond on.
+	return c.sendRawRequest(id, method, marshaledJSON)
+}
+
+// sendRawRequest sends the already marshaled request along with a channel to
+// respond on.
+func (c *Client) sendRawRequest(id uint64, method string,
+	marshaledJSON []byte) FutureRawResult {
+
 	responseChan := make(chan *response, 1)
 	jReq := &jsonRequest{
 		id:            id,
@@ -63,6 +70,7 @@ func (c *Client) RawRequestAsync(method string, params []jsoniter.RawMessage) Fu
 		cmd:           nil,
 		marshaledJSON: marshaledJSON,
 		responseChan:  responseChan,
+		traceID:       c.traceID(id),
 	}
 	c.sendRequest(jReq)
 
@@ -77,3 +85,56 @@ func (c *Client) RawRequestAsync(method string, params []jsoniter.RawMessage) Fu
 func (c *Client) RawRequest(method string, params []jsoniter.RawMessage) (jsoniter.RawMessage, er.R) {
 	return c.RawRequestAsync(method, params).Receive()
 }
+
+// namedRequest is a raw JSON-RPC request which passes its parameters by name
+// as a JSON object rather than by position.
+type namedRequest struct {
+	Jsonrpc string                 `json:"jsonrpc"`
+	Method  string                 `json:"method"`
+	Params  map[string]interface{} `json:"params"`
+	ID      interface{}            `json:"id"`
+}
+
+// RawRequestNamedAsync returns an instance of a type that can be used to get
+// the result of a custom RPC request with named parameters at some future time
+// by invoking the Receive function on the returned instance.
+//
+// See RawRequestNamed for the blocking version and more details.
+func (c *Client) RawRequestNamedAsync(method string,
+	params map[string]interface{}) FutureRawResult {
+
+	// Method may not be empty.
+	if method == "" {
+		return newFutureError(er.New("no method"))
+	}
+
+	// Marshal parameters as "{}" instead of "null" when no parameters
+	// are passed.
+	if params == nil {
+		params = map[string]interface{}{}
+	}
+
+	id := c.NextID()
+	rawRequest := &namedRequest{
+		Jsonrpc: "1.0",
+		ID:      id,
+		Method:  method,
+		Params:  params,
+	}
+	marshaledJSON, errr := jsoniter.Marshal(rawRequest)
+	if errr != nil {
+		return newFutureError(er.E(errr))
+	}
+
+	return c.sendRawRequest(id, method, marshaledJSON)
+}
+
+// RawRequestNamed works like RawRequest, but the parameters are sent by name
+// as a JSON object in the params field.  This allows to set only some of the
+// optional parameters of a method, for example a later one without the ones
+// before it, if the server supports named parameters.
+func (c *Client) RawRequestNamed(method string,
+	params map[string]interface{}) (jsoniter.RawMessage, er.R) {
+
+	return c.RawRequestNamedAsync(method, params).Receive()
+}
@@ -0,0 +1,79 @@
+package rpcclient
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	jsoniter "github.com/json-iterator/go"
+)
+
+// TestRawRequestNamed ensures that named parameters are sent as a JSON object
+// which only holds the parameters set by the caller, so that an optional
+// parameter can be set without the ones before it.
+func TestRawRequestNamed(t *testing.T) {
+	var request map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			body, errr := ioutil.ReadAll(r.Body)
+			if errr != nil {
+				t.Errorf("unable to read request: %v", errr)
+				return
+			}
+			if errr := jsoniter.Unmarshal(body, &request); errr != nil {
+				t.Errorf("unable to decode request: %v", errr)
+				return
+			}
+			_, _ = w.Write([]byte(`{"result":"ok","error":null,"id":1}`))
+		},
+	))
+	defer server.Close()
+
+	client, err := New(&ConnConfig{
+		Host:         strings.TrimPrefix(server.URL, "http://"),
+		User:         "user",
+		Pass:         "pass",
+		DisableTLS:   true,
+		HTTPPostMode: true,
+	}, nil)
+	if err != nil {
+		t.Fatalf("unable to create client: %v", err)
+	}
+	defer client.Shutdown()
+
+	// getblock takes the optional verbose and verbosetx parameters, only
+	// the latter is set.
+	result, err := client.RawRequestNamed("getblock", map[string]interface{}{
+		"hash":      "000000000019d6689c085ae165831e93",
+		"verbosetx": true,
+	})
+	if err != nil {
+		t.Fatalf("unable to send request: %v", err)
+	}
+	if string(result) != `"ok"` {
+		t.Fatalf("unexpected result %s", result)
+	}
+
+	if request["method"] != "getblock" {
+		t.Fatalf("unexpected method %v", request["method"])
+	}
+	expected := map[string]interface{}{
+		"hash":      "000000000019d6689c085ae165831e93",
+		"verbosetx": true,
+	}
+	if !reflect.DeepEqual(request["params"], expected) {
+		t.Fatalf("expected params %v, got %v", expected,
+			request["params"])
+	}
+
+	// Without parameters, an empty object is sent rather than null.
+	if _, err := client.RawRequestNamed("getinfo", nil); err != nil {
+		t.Fatalf("unable to send request: %v", err)
+	}
+	if !reflect.DeepEqual(request["params"], map[string]interface{}{}) {
+		t.Fatalf("expected empty params, got %v", request["params"])
+	}
+}
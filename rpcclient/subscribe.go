@@ -0,0 +1,367 @@
+package rpcclient
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkt-cash/pktd/btcjson"
+	"github.com/pkt-cash/pktd/btcutil"
+	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/chaincfg/chainhash"
+	"github.com/pkt-cash/pktd/pktlog/log"
+	"github.com/pkt-cash/pktd/wire"
+)
+
+// DefaultSubscriptionBufferSize is the number of notifications buffered by a
+// subscription when SubscriptionConfig.BufferSize is not set.
+const DefaultSubscriptionBufferSize = 100
+
+// OverflowPolicy determines what a subscription does with a notification when
+// its buffer is full.
+type OverflowPolicy int
+
+const (
+	// OverflowBlock waits for the subscriber to make room in the buffer,
+	// so no notification is lost.  While it waits, no further
+	// notifications or responses are read from the server.
+	OverflowBlock OverflowPolicy = iota
+
+	// OverflowDropOldest drops the oldest buffered notification to make
+	// room for the new one, so the subscriber always sees the latest
+	// notifications and never holds up the client.
+	OverflowDropOldest
+)
+
+// SubscriptionConfig configures how a subscription delivers notifications.
+type SubscriptionConfig struct {
+	// BufferSize is the number of notifications which are buffered until
+	// the subscriber receives them.  It defaults to
+	// DefaultSubscriptionBufferSize.
+	BufferSize int
+
+	// Overflow is what happens to a notification when the buffer is full.
+	Overflow OverflowPolicy
+}
+
+type (
+	// BlockConnectedNtfn is delivered by a block subscription when a
+	// block is connected to the best chain.
+	BlockConnectedNtfn struct {
+		Height       int32
+		Header       *wire.BlockHeader
+		Transactions []*btcutil.Tx
+	}
+
+	// BlockDisconnectedNtfn is delivered by a block subscription when a
+	// block is disconnected from the best chain.
+	BlockDisconnectedNtfn struct {
+		Height int32
+		Header *wire.BlockHeader
+	}
+
+	// RelevantTxAcceptedNtfn is delivered by a transaction subscription
+	// when a transaction matching the loaded filter is accepted to the
+	// mempool.
+	RelevantTxAcceptedNtfn struct {
+		Transaction []byte
+	}
+
+	// TxAcceptedNtfn is delivered by a transaction subscription when a
+	// transaction is accepted to the mempool.
+	TxAcceptedNtfn struct {
+		Hash   *chainhash.Hash
+		Amount btcutil.Amount
+	}
+
+	// TxAcceptedVerboseNtfn is delivered by a transaction subscription
+	// instead of TxAcceptedNtfn if NotifyNewTransactions was called with
+	// verbose set.
+	TxAcceptedVerboseNtfn struct {
+		Details *btcjson.TxRawResult
+	}
+
+	// RescanProgressNtfn is delivered by a rescan subscription as a rescan
+	// progresses.  Finished is set on the last one.
+	RescanProgressNtfn struct {
+		Hash     *chainhash.Hash
+		Height   int32
+		Time     time.Time
+		Finished bool
+	}
+)
+
+// Subscription delivers notifications of the server over a buffered channel,
+// so that a slow subscriber doesn't hold up the client as long as the buffer
+// has room.  What happens once the buffer is full is up to the overflow policy
+// of the subscription.
+//
+// A subscription only delivers the notifications which have been registered
+// with the server, for example with NotifyBlocks.
+type Subscription struct {
+	client   *Client
+	methods  map[string]struct{}
+	overflow OverflowPolicy
+
+	// dropped is the number of notifications dropped due to overflow.  It
+	// must be accessed atomically.
+	dropped uint64
+
+	// mtx serializes the delivery of notifications with closing ntfns.
+	mtx   sync.Mutex
+	ntfns chan interface{}
+
+	quit     chan struct{}
+	quitOnce sync.Once
+}
+
+// Notifications returns the channel the notifications are delivered on.  It is
+// closed when the subscription is cancelled or the client shuts down.
+func (s *Subscription) Notifications() <-chan interface{} {
+	return s.ntfns
+}
+
+// Dropped returns the number of notifications which have been dropped because
+// the buffer was full.  It is always zero with OverflowBlock.
+func (s *Subscription) Dropped() uint64 {
+	return atomic.LoadUint64(&s.dropped)
+}
+
+// Unsubscribe stops the delivery of notifications and closes the channel of
+// the subscription.  It is safe to call more than once.
+func (s *Subscription) Unsubscribe() {
+	s.quitOnce.Do(func() {
+		close(s.quit)
+
+		s.client.subscriptionsMtx.Lock()
+		delete(s.client.subscriptions, s)
+		s.client.subscriptionsMtx.Unlock()
+
+		// Wait for a delivery in progress before closing the channel.
+		s.mtx.Lock()
+		close(s.ntfns)
+		s.mtx.Unlock()
+	})
+}
+
+// deliver hands the notification to the subscriber, applying the overflow
+// policy if the buffer is full.
+func (s *Subscription) deliver(ntfn interface{}) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	select {
+	case <-s.quit:
+		return
+	default:
+	}
+
+	if s.overflow == OverflowBlock {
+		select {
+		case s.ntfns <- ntfn:
+		case <-s.quit:
+		case <-s.client.shutdown:
+		}
+		return
+	}
+
+	for {
+		select {
+		case s.ntfns <- ntfn:
+			return
+		default:
+		}
+
+		// Make room by dropping the oldest notification, unless the
+		// subscriber took it in the meantime.
+		select {
+		case <-s.ntfns:
+			atomic.AddUint64(&s.dropped, 1)
+		default:
+		}
+	}
+}
+
+// subscribe returns a new subscription to the notifications of the passed
+// methods.
+func (c *Client) subscribe(cfg *SubscriptionConfig,
+	methods ...string) *Subscription {
+
+	bufferSize := DefaultSubscriptionBufferSize
+	overflow := OverflowBlock
+	if cfg != nil {
+		if cfg.BufferSize > 0 {
+			bufferSize = cfg.BufferSize
+		}
+		overflow = cfg.Overflow
+	}
+
+	s := &Subscription{
+		client:   c,
+		methods:  make(map[string]struct{}, len(methods)),
+		overflow: overflow,
+		ntfns:    make(chan interface{}, bufferSize),
+		quit:     make(chan struct{}),
+	}
+	for _, method := range methods {
+		s.methods[method] = struct{}{}
+	}
+
+	c.subscriptionsMtx.Lock()
+	c.subscriptions[s] = struct{}{}
+	c.subscriptionsMtx.Unlock()
+
+	// A client which already shut down won't deliver anything.
+	select {
+	case <-c.shutdown:
+		s.Unsubscribe()
+	default:
+	}
+
+	return s
+}
+
+// SubscribeBlocks returns a subscription delivering a *BlockConnectedNtfn or a
+// *BlockDisconnectedNtfn for each block connected to or disconnected from the
+// best chain, once NotifyBlocks has been called.  A nil config uses the
+// defaults.
+func (c *Client) SubscribeBlocks(cfg *SubscriptionConfig) *Subscription {
+	return c.subscribe(cfg, btcjson.FilteredBlockConnectedNtfnMethod,
+		btcjson.FilteredBlockDisconnectedNtfnMethod)
+}
+
+// SubscribeTransactions returns a subscription delivering a
+// *RelevantTxAcceptedNtfn for each transaction matching the filter loaded
+// with LoadTxFilter, and a *TxAcceptedNtfn or *TxAcceptedVerboseNtfn for each
+// transaction accepted to the mempool once NotifyNewTransactions has been
+// called.  A nil config uses the defaults.
+func (c *Client) SubscribeTransactions(cfg *SubscriptionConfig) *Subscription {
+	return c.subscribe(cfg, btcjson.RelevantTxAcceptedNtfnMethod,
+		btcjson.TxAcceptedNtfnMethod, btcjson.TxAcceptedVerboseNtfnMethod)
+}
+
+// SubscribeRescan returns a subscription delivering a *RescanProgressNtfn for
+// the progress of rescans.  A nil config uses the defaults.
+func (c *Client) SubscribeRescan(cfg *SubscriptionConfig) *Subscription {
+	return c.subscribe(cfg, btcjson.RescanProgressNtfnMethod,
+		btcjson.RescanFinishedNtfnMethod)
+}
+
+// wantsNotifications returns whether the client has notification handlers or
+// subscriptions, which is when registering for notifications with the server
+// has an effect.
+func (c *Client) wantsNotifications() bool {
+	if c.ntfnHandlers != nil {
+		return true
+	}
+
+	c.subscriptionsMtx.Lock()
+	defer c.subscriptionsMtx.Unlock()
+	return len(c.subscriptions) > 0
+}
+
+// closeSubscriptions cancels all subscriptions of the client.
+func (c *Client) closeSubscriptions() {
+	c.subscriptionsMtx.Lock()
+	subscriptions := make([]*Subscription, 0, len(c.subscriptions))
+	for s := range c.subscriptions {
+		subscriptions = append(subscriptions, s)
+	}
+	c.subscriptionsMtx.Unlock()
+
+	for _, s := range subscriptions {
+		s.Unsubscribe()
+	}
+}
+
+// deliverToSubscriptions parses the notification into its typed form and
+// delivers it to each subscription of its method.
+func (c *Client) deliverToSubscriptions(ntfn *rawNotification) {
+	c.subscriptionsMtx.Lock()
+	var subscriptions []*Subscription
+	for s := range c.subscriptions {
+		if _, ok := s.methods[ntfn.Method]; ok {
+			subscriptions = append(subscriptions, s)
+		}
+	}
+	c.subscriptionsMtx.Unlock()
+
+	if len(subscriptions) == 0 {
+		return
+	}
+
+	typed, err := parseSubscriptionNtfn(ntfn)
+	if err != nil {
+		log.Warnf("Received invalid %s notification: %v", ntfn.Method,
+			err)
+		return
+	}
+	for _, s := range subscriptions {
+		s.deliver(typed)
+	}
+}
+
+// parseSubscriptionNtfn returns the typed form of a notification which can be
+// subscribed to.
+func parseSubscriptionNtfn(ntfn *rawNotification) (interface{}, er.R) {
+	switch ntfn.Method {
+	case btcjson.FilteredBlockConnectedNtfnMethod:
+		height, header, txs, err := parseFilteredBlockConnectedParams(
+			ntfn.Params,
+		)
+		if err != nil {
+			return nil, err
+		}
+		return &BlockConnectedNtfn{
+			Height:       height,
+			Header:       header,
+			Transactions: txs,
+		}, nil
+
+	case btcjson.FilteredBlockDisconnectedNtfnMethod:
+		height, header, err := parseFilteredBlockDisconnectedParams(
+			ntfn.Params,
+		)
+		if err != nil {
+			return nil, err
+		}
+		return &BlockDisconnectedNtfn{Height: height, Header: header}, nil
+
+	case btcjson.RelevantTxAcceptedNtfnMethod:
+		transaction, err := parseRelevantTxAcceptedParams(ntfn.Params)
+		if err != nil {
+			return nil, err
+		}
+		return &RelevantTxAcceptedNtfn{Transaction: transaction}, nil
+
+	case btcjson.TxAcceptedNtfnMethod:
+		hash, amt, err := parseTxAcceptedNtfnParams(ntfn.Params)
+		if err != nil {
+			return nil, err
+		}
+		return &TxAcceptedNtfn{Hash: hash, Amount: amt}, nil
+
+	case btcjson.TxAcceptedVerboseNtfnMethod:
+		rawTx, err := parseTxAcceptedVerboseNtfnParams(ntfn.Params)
+		if err != nil {
+			return nil, err
+		}
+		return &TxAcceptedVerboseNtfn{Details: rawTx}, nil
+
+	case btcjson.RescanProgressNtfnMethod, btcjson.RescanFinishedNtfnMethod:
+		hash, height, blkTime, err := parseRescanProgressParams(
+			ntfn.Params,
+		)
+		if err != nil {
+			return nil, err
+		}
+		return &RescanProgressNtfn{
+			Hash:     hash,
+			Height:   height,
+			Time:     blkTime,
+			Finished: ntfn.Method == btcjson.RescanFinishedNtfnMethod,
+		}, nil
+	}
+
+	return nil, er.Errorf("unsupported notification %s", ntfn.Method)
+}
@@ -0,0 +1,227 @@
+package rpcclient
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	jsoniter "github.com/json-iterator/go"
+
+	"github.com/pkt-cash/pktd/btcjson"
+)
+
+// rescanProgressNtfn returns a rescanprogress notification for the passed
+// height.
+func rescanProgressNtfn(height int32) *rawNotification {
+	return &rawNotification{
+		Method: btcjson.RescanProgressNtfnMethod,
+		Params: []jsoniter.RawMessage{
+			jsoniter.RawMessage(`"00000000839a8e6886ab5951d76f411475428afc90947ee320161bbf18eb6048"`),
+			jsoniter.RawMessage(strconv.Itoa(int(height))),
+			jsoniter.RawMessage(`1231469665`),
+		},
+	}
+}
+
+// TestSubscriptionOverflow ensures that a subscription with the drop-oldest
+// policy keeps the latest notifications and counts the dropped ones, and that
+// one with the block policy holds up delivery until the subscriber makes room
+// without losing anything.
+func TestSubscriptionOverflow(t *testing.T) {
+	client, err := New(&ConnConfig{
+		Host:         "127.0.0.1:0",
+		User:         "user",
+		Pass:         "pass",
+		DisableTLS:   true,
+		HTTPPostMode: true,
+	}, nil)
+	if err != nil {
+		t.Fatalf("unable to create client: %v", err)
+	}
+
+	dropping := client.SubscribeRescan(&SubscriptionConfig{
+		BufferSize: 3,
+		Overflow:   OverflowDropOldest,
+	})
+	blocking := client.SubscribeRescan(&SubscriptionConfig{BufferSize: 3})
+
+	// Other notifications aren't delivered to the subscriptions.
+	blocks := client.SubscribeBlocks(nil)
+
+	// Deliver notifications from a separate goroutine as the read loop
+	// would, which is held up once the buffer of the blocking subscription
+	// is full.
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for height := int32(1); height <= 5; height++ {
+			client.handleNotification(rescanProgressNtfn(height))
+		}
+	}()
+	select {
+	case <-done:
+		t.Fatal("delivery wasn't held up by the full buffer")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	// The blocking subscription receives every notification in order.
+	for height := int32(1); height <= 5; height++ {
+		select {
+		case ntfn := <-blocking.Notifications():
+			progress, ok := ntfn.(*RescanProgressNtfn)
+			if !ok || progress.Height != height || progress.Finished {
+				t.Fatalf("unexpected notification %+v", ntfn)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("notification %d not delivered", height)
+		}
+	}
+	<-done
+	if n := blocking.Dropped(); n != 0 {
+		t.Fatalf("expected no dropped notifications, got %d", n)
+	}
+
+	// The dropping subscription only kept the latest notifications.
+	if n := dropping.Dropped(); n != 2 {
+		t.Fatalf("expected 2 dropped notifications, got %d", n)
+	}
+	for height := int32(3); height <= 5; height++ {
+		ntfn := <-dropping.Notifications()
+		progress, ok := ntfn.(*RescanProgressNtfn)
+		if !ok || progress.Height != height {
+			t.Fatalf("unexpected notification %+v", ntfn)
+		}
+	}
+
+	if len(blocks.Notifications()) != 0 {
+		t.Fatal("unexpected notification for the block subscription")
+	}
+
+	// Unsubscribing closes the channel, as does shutting down the client.
+	dropping.Unsubscribe()
+	dropping.Unsubscribe()
+	if _, ok := <-dropping.Notifications(); ok {
+		t.Fatal("expected closed channel")
+	}
+	client.Shutdown()
+	client.WaitForShutdown()
+	for _, s := range []*Subscription{blocking, blocks} {
+		if _, ok := <-s.Notifications(); ok {
+			t.Fatal("expected closed channel")
+		}
+	}
+}
+
+// TestSubscriptionRegisters ensures that a client without notification
+// handlers registers for notifications with the server once it has a
+// subscription, and delivers the notifications to the subscription.
+func TestSubscriptionRegisters(t *testing.T) {
+	const txid = "00000000839a8e6886ab5951d76f411475428afc90947ee320161bbf18eb6048"
+
+	methods := make(chan string, 10)
+	var upgrader websocket.Upgrader
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			conn, errr := upgrader.Upgrade(w, r, nil)
+			if errr != nil {
+				t.Errorf("unable to upgrade connection: %v", errr)
+				return
+			}
+			defer conn.Close()
+
+			for {
+				_, msg, errr := conn.ReadMessage()
+				if errr != nil {
+					return
+				}
+				var req struct {
+					Method string `json:"method"`
+					ID     uint64 `json:"id"`
+				}
+				if errr := jsoniter.Unmarshal(msg, &req); errr != nil {
+					t.Errorf("invalid request %s: %v", msg, errr)
+					return
+				}
+				methods <- req.Method
+				reply := `{"result":null,"error":null,"id":` +
+					strconv.FormatUint(req.ID, 10) + `}`
+				errr = conn.WriteMessage(websocket.TextMessage,
+					[]byte(reply))
+				if errr != nil || req.Method != "notifynewtransactions" {
+					continue
+				}
+				ntfn := `{"jsonrpc":"1.0","method":"txaccepted",` +
+					`"params":["` + txid + `",1.5],"id":null}`
+				errr = conn.WriteMessage(websocket.TextMessage,
+					[]byte(ntfn))
+				if errr != nil {
+					t.Errorf("unable to send notification: %v",
+						errr)
+					return
+				}
+			}
+		},
+	))
+	defer server.Close()
+
+	client, err := New(&ConnConfig{
+		Host:                 strings.TrimPrefix(server.URL, "http://"),
+		Endpoint:             "ws",
+		User:                 "user",
+		Pass:                 "pass",
+		DisableTLS:           true,
+		DisableAutoReconnect: true,
+	}, nil)
+	if err != nil {
+		t.Fatalf("unable to create client: %v", err)
+	}
+	defer func() {
+		client.Shutdown()
+		client.WaitForShutdown()
+	}()
+
+	// Without handlers or subscriptions, nothing is registered.
+	if err := client.NotifyNewTransactions(false); err != nil {
+		t.Fatalf("unable to register: %v", err)
+	}
+	select {
+	case method := <-methods:
+		t.Fatalf("unexpected request %s", method)
+	default:
+	}
+
+	sub := client.SubscribeTransactions(nil)
+	defer sub.Unsubscribe()
+	if err := client.NotifyNewTransactions(false); err != nil {
+		t.Fatalf("unable to register: %v", err)
+	}
+	select {
+	case method := <-methods:
+		if method != "notifynewtransactions" {
+			t.Fatalf("expected notifynewtransactions, got %s",
+				method)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("notifynewtransactions not sent")
+	}
+
+	select {
+	case ntfn := <-sub.Notifications():
+		txAccepted, ok := ntfn.(*TxAcceptedNtfn)
+		if !ok {
+			t.Fatalf("unexpected notification %T", ntfn)
+		}
+		if txAccepted.Hash.String() != txid ||
+			txAccepted.Amount.ToBTC() != 1.5 {
+
+			t.Fatalf("unexpected notification %v %v",
+				txAccepted.Hash, txAccepted.Amount)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("notification not delivered")
+	}
+}
@@ -48,6 +48,7 @@ import (
 	"github.com/pkt-cash/pktd/peer"
 	"github.com/pkt-cash/pktd/pktconfig/version"
 	"github.com/pkt-cash/pktd/pktlog/log"
+	"github.com/pkt-cash/pktd/rpcclient"
 	"github.com/pkt-cash/pktd/txscript"
 	"github.com/pkt-cash/pktd/txscript/scriptbuilder"
 	"github.com/pkt-cash/pktd/wire"
@@ -532,12 +533,33 @@ func handleCreateRawTransaction(s *rpcServer, cmd interface{}, closeChan <-chan
 
 		prevOut := wire.NewOutPoint(txHash, input.Vout)
 		txIn := wire.NewTxIn(prevOut, []byte{}, nil)
-		if c.LockTime != nil && *c.LockTime != 0 {
+		if input.Sequence != nil {
+			txIn.Sequence = *input.Sequence
+		} else if c.LockTime != nil && *c.LockTime != 0 {
 			txIn.Sequence = constants.MaxTxInSequenceNum - 1
 		}
 		mtx.AddTxIn(txIn)
 	}
 
+	// A locktime is only honored if at least one input is not final, so
+	// rather than silently dropping it the request is rejected.
+	if c.LockTime != nil && *c.LockTime != 0 && len(mtx.TxIn) > 0 {
+		final := true
+		for _, txIn := range mtx.TxIn {
+			if txIn.Sequence != constants.MaxTxInSequenceNum {
+				final = false
+				break
+			}
+		}
+		if final {
+			return nil, btcjson.NewRPCError(
+				btcjson.ErrRPCInvalidParameter,
+				"Locktime has no effect when all inputs are final",
+				nil,
+			)
+		}
+	}
+
 	// Add all transaction outputs to the transaction after performing
 	// some validity checks.
 	params := s.cfg.ChainParams
@@ -4188,6 +4210,7 @@ func (s *rpcServer) jsonRPCReq(
 	request *btcjson.Request,
 	closeChan chan struct{},
 	isAdmin bool,
+	trace string,
 ) (*btcjson.Response, er.R) {
 	var jsonErr er.R
 	var result interface{}
@@ -4211,8 +4234,8 @@ func (s *rpcServer) jsonRPCReq(
 	reqNum := atomic.AddInt64(&s.reqNum, 1)
 	reqCompl := atomic.LoadInt64(&s.reqCompl)
 
-	log.Infof("> %d:%d RPC %s %s",
-		reqNum, (reqNum - reqCompl), request.Method, strings.Join(ps, " "))
+	log.Infof("> %d:%d RPC %s %s%s", reqNum, (reqNum - reqCompl),
+		request.Method, strings.Join(ps, " "), trace)
 
 	// Attempt to parse the JSON-RPC request into a known concrete
 	// command.
@@ -4231,7 +4254,7 @@ func (s *rpcServer) jsonRPCReq(
 	if err != nil {
 		resStr = err.Message()
 	}
-	log.Infof("< %d:%d RPC %s %s", reqNum, (reqNum - reqCompl), request.Method, resStr)
+	log.Infof("< %d:%d RPC %s %s%s", reqNum, (reqNum - reqCompl), request.Method, resStr, trace)
 	atomic.AddInt64(&s.reqCompl, 1)
 
 	return resp, err
@@ -4312,8 +4335,9 @@ func (s *rpcServer) jsonRPCRead(w http.ResponseWriter, r *http.Request, isAdmin
 
 	responses := make([]*btcjson.Response, 0, len(requests))
 	if jsonErr == nil {
+		trace := traceSuffix(r)
 		for _, req := range requests {
-			res, jsonErr := s.jsonRPCReq(&req, closeChan, isAdmin)
+			res, jsonErr := s.jsonRPCReq(&req, closeChan, isAdmin, trace)
 			if jsonErr != nil {
 				break
 			}
@@ -4369,6 +4393,25 @@ func (s *rpcServer) jsonRPCRead(w http.ResponseWriter, r *http.Request, isAdmin
 	}
 }
 
+// maxTraceIDLen is the maximum length of a trace id sent by a client which is
+// logged, longer ids are truncated.
+const maxTraceIDLen = 64
+
+// traceSuffix returns the trace id which a client sent in the
+// rpcclient.TraceIDHeader of the request, formatted to be appended to log
+// lines, or "" if the client didn't send one. The id is quoted so that a
+// client can't forge log lines with it.
+func traceSuffix(r *http.Request) string {
+	id := r.Header.Get(rpcclient.TraceIDHeader)
+	if id == "" {
+		return ""
+	}
+	if len(id) > maxTraceIDLen {
+		id = id[:maxTraceIDLen]
+	}
+	return fmt.Sprintf(" trace=%q", id)
+}
+
 // jsonAuthFail sends a message back to the client if the http auth is rejected.
 func jsonAuthFail(w http.ResponseWriter) {
 	w.Header().Add("WWW-Authenticate", `Basic realm="pktd RPC"`)
@@ -4436,7 +4479,8 @@ func (s *rpcServer) Start() {
 			http.Error(w, "400 Bad Request.", http.StatusBadRequest)
 			return
 		}
-		s.WebsocketHandler(ws, r.RemoteAddr, authenticated, isAdmin)
+		s.WebsocketHandler(ws, r.RemoteAddr+traceSuffix(r),
+			authenticated, isAdmin)
 	})
 
 	for _, listener := range s.cfg.Listeners {
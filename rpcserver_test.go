@@ -0,0 +1,160 @@
+package main
+
+import (
+	"bytes"
+	"encoding/hex"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/pkt-cash/pktd/btcjson"
+	"github.com/pkt-cash/pktd/chaincfg"
+	"github.com/pkt-cash/pktd/rpcclient"
+	"github.com/pkt-cash/pktd/wire"
+	"github.com/pkt-cash/pktd/wire/constants"
+)
+
+// TestTraceSuffix ensures that the trace id sent by a client is logged quoted
+// and truncated, and that nothing is logged for clients without one.
+func TestTraceSuffix(t *testing.T) {
+	tests := []struct {
+		name    string
+		traceID string
+		suffix  string
+	}{
+		{
+			name: "no trace id",
+		},
+		{
+			name:    "trace id",
+			traceID: "client-42",
+			suffix:  ` trace="client-42"`,
+		},
+		{
+			name:    "forged log line",
+			traceID: "x\n[INF] forged",
+			suffix:  ` trace="x\n[INF] forged"`,
+		},
+		{
+			name:    "too long",
+			traceID: strings.Repeat("a", maxTraceIDLen+1),
+			suffix: ` trace="` + strings.Repeat("a", maxTraceIDLen) +
+				`"`,
+		},
+	}
+	for _, test := range tests {
+		r := httptest.NewRequest("POST", "/", nil)
+		if test.traceID != "" {
+			r.Header.Set(rpcclient.TraceIDHeader, test.traceID)
+		}
+		if suffix := traceSuffix(r); suffix != test.suffix {
+			t.Errorf("%s: expected %q, got %q", test.name,
+				test.suffix, suffix)
+		}
+	}
+}
+
+// TestCreateRawTransactionSequence ensures that createrawtransaction applies
+// the sequence numbers of the inputs, locktime-activates the inputs without
+// one, and rejects a locktime which all final inputs would disable.
+func TestCreateRawTransactionSequence(t *testing.T) {
+	txid := strings.Repeat("01", 32)
+	input := func(sequence *uint32) btcjson.CreateRawTransactionInput {
+		return btcjson.CreateRawTransactionInput{
+			Txid:     txid,
+			Vout:     1,
+			Sequence: sequence,
+		}
+	}
+	final := btcjson.Uint32(constants.MaxTxInSequenceNum)
+
+	tests := []struct {
+		name      string
+		inputs    []btcjson.CreateRawTransactionInput
+		lockTime  *int64
+		sequences []uint32
+		err       bool
+	}{
+		{
+			name:      "no locktime",
+			inputs:    []btcjson.CreateRawTransactionInput{input(nil)},
+			sequences: []uint32{constants.MaxTxInSequenceNum},
+		},
+		{
+			name:      "sequence without locktime",
+			inputs:    []btcjson.CreateRawTransactionInput{input(btcjson.Uint32(5))},
+			sequences: []uint32{5},
+		},
+		{
+			name:      "locktime",
+			inputs:    []btcjson.CreateRawTransactionInput{input(nil)},
+			lockTime:  btcjson.Int64(650000),
+			sequences: []uint32{constants.MaxTxInSequenceNum - 1},
+		},
+		{
+			name: "locktime with replaceable input",
+			inputs: []btcjson.CreateRawTransactionInput{
+				input(btcjson.Uint32(constants.MaxTxInSequenceNum - 2)),
+			},
+			lockTime:  btcjson.Int64(650000),
+			sequences: []uint32{constants.MaxTxInSequenceNum - 2},
+		},
+		{
+			name: "locktime with one final input",
+			inputs: []btcjson.CreateRawTransactionInput{
+				input(final), input(nil),
+			},
+			lockTime: btcjson.Int64(650000),
+			sequences: []uint32{
+				constants.MaxTxInSequenceNum,
+				constants.MaxTxInSequenceNum - 1,
+			},
+		},
+		{
+			name:     "locktime with final inputs",
+			inputs:   []btcjson.CreateRawTransactionInput{input(final)},
+			lockTime: btcjson.Int64(650000),
+			err:      true,
+		},
+	}
+
+	s := &rpcServer{cfg: rpcserverConfig{
+		ChainParams: &chaincfg.SimNetParams,
+	}}
+	for _, test := range tests {
+		cmd := btcjson.NewCreateRawTransactionCmd(test.inputs,
+			map[string]float64{}, test.lockTime)
+		result, err := handleCreateRawTransaction(s, cmd, nil)
+		if test.err {
+			if !btcjson.ErrRPCInvalidParameter.Is(err) {
+				t.Errorf("%s: expected invalid parameter error, "+
+					"got %v", test.name, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+
+		serialized, errr := hex.DecodeString(result.(string))
+		if errr != nil {
+			t.Fatalf("%s: invalid hex: %v", test.name, errr)
+		}
+		var tx wire.MsgTx
+		if err := tx.Deserialize(bytes.NewReader(serialized)); err != nil {
+			t.Fatalf("%s: unable to decode tx: %v", test.name, err)
+		}
+		if test.lockTime != nil && int64(tx.LockTime) != *test.lockTime {
+			t.Errorf("%s: expected locktime %d, got %d", test.name,
+				*test.lockTime, tx.LockTime)
+		}
+		for i, in := range tx.TxIn {
+			if in.Sequence != test.sequences[i] {
+				t.Errorf("%s: expected sequence %d for input %d, "+
+					"got %d", test.name, test.sequences[i], i,
+					in.Sequence)
+			}
+		}
+	}
+}
@@ -49,9 +49,10 @@ var helpDescsEnUS = map[string]string{
 	"node-target":        "Either the IP address and port of the peer to operate on, or a valid peer ID.",
 	"node-connectsubcmd": "'perm' to make the connected peer a permanent one, 'temp' to try a single connect to a peer",
 
-	// TransactionInput help.
-	"transactioninput-txid": "The hash of the input transaction",
-	"transactioninput-vout": "The specific output of the input transaction to redeem",
+	// CreateRawTransactionInput help.
+	"createrawtransactioninput-txid":     "The hash of the input transaction",
+	"createrawtransactioninput-vout":     "The specific output of the input transaction to redeem",
+	"createrawtransactioninput-sequence": "The sequence number of the input, overrides the one implied by locktime",
 
 	// CreateRawTransactionCmd help.
 	"createrawtransaction--synopsis": "Returns a new transaction spending the provided inputs and sending to the provided addresses.\n" +
@@ -62,7 +63,7 @@ var helpDescsEnUS = map[string]string{
 	"createrawtransaction-amounts--key":   "address",
 	"createrawtransaction-amounts--value": "n.nnn",
 	"createrawtransaction-amounts--desc":  "The destination address as the key and the amount in BTC as the value",
-	"createrawtransaction-locktime":       "Locktime value; a non-zero value will also locktime-activate the inputs",
+	"createrawtransaction-locktime":       "Locktime value; a non-zero value will also locktime-activate the inputs without a sequence number, it is an error if all inputs are final",
 	"createrawtransaction--result0":       "Hex-encoded bytes of the serialized transaction",
 
 	// ScriptSig help.